@@ -53,6 +53,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteTaskList(
+		ctx context.Context,
+		Request *admin.DeleteTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DeleteWorkflow(
 		ctx context.Context,
 		Request *admin.AdminDeleteWorkflowRequest,
@@ -76,18 +82,36 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeQueueResponse, error)
 
+	DescribeScanResults(
+		ctx context.Context,
+		Request *admin.DescribeScanResultsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeScanResultsResponse, error)
+
 	DescribeShardDistribution(
 		ctx context.Context,
 		Request *shared.DescribeShardDistributionRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeShardDistributionResponse, error)
 
+	DescribeTaskListConfig(
+		ctx context.Context,
+		Request *admin.DescribeTaskListConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeTaskListConfigResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	FixScanResults(
+		ctx context.Context,
+		Request *admin.FixScanResultsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.FixScanResultsResponse, error)
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -118,18 +142,48 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	GetTaskListBacklog(
+		ctx context.Context,
+		Request *admin.GetTaskListBacklogRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetTaskListBacklogResponse, error)
+
 	GetWorkflowExecutionRawHistoryV2(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	ListDeadLetterTasks(
+		ctx context.Context,
+		Request *admin.ListDeadLetterTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListDeadLetterTasksResponse, error)
+
 	ListDynamicConfig(
 		ctx context.Context,
 		Request *admin.ListDynamicConfigRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListScanCorruptions(
+		ctx context.Context,
+		Request *admin.ListScanCorruptionsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListScanCorruptionsResponse, error)
+
+	ListScanRuns(
+		ctx context.Context,
+		Request *admin.ListScanRunsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListScanRunsResponse, error)
+
+	ListStickyTaskLists(
+		ctx context.Context,
+		Request *admin.ListStickyTaskListsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListStickyTaskListsResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -142,12 +196,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateTaskList(
+		ctx context.Context,
+		Request *admin.MigrateTaskListRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.MigrateTaskListResponse, error)
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	PurgeDeadLetterTasks(
+		ctx context.Context,
+		Request *admin.PurgeDeadLetterTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.PurgeDeadLetterTasksResponse, error)
+
 	ReadDLQMessages(
 		ctx context.Context,
 		Request *replicator.ReadDLQMessagesRequest,
@@ -172,6 +238,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RequeueDeadLetterTasks(
+		ctx context.Context,
+		Request *admin.RequeueDeadLetterTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.RequeueDeadLetterTasksResponse, error)
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -184,6 +256,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetStickyTaskLists(
+		ctx context.Context,
+		Request *admin.ResetStickyTaskListsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ResetStickyTaskListsResponse, error)
+
 	RespondCrossClusterTasksCompleted(
 		ctx context.Context,
 		Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -201,6 +279,12 @@ type Interface interface {
 		Request *admin.UpdateDynamicConfigRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateTaskListConfig(
+		ctx context.Context,
+		Request *admin.UpdateTaskListConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.UpdateTaskListConfigResponse, error)
 }
 
 // New builds a new client for the AdminService service.
@@ -288,6 +372,34 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteTaskList(
+	ctx context.Context,
+	_Request *admin.DeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_DeleteTaskList_Result
+	args := admin.AdminService_DeleteTaskList_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_DeleteTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeleteWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminDeleteWorkflowRequest,
@@ -399,6 +511,34 @@ func (c client) DescribeQueue(
 	return
 }
 
+func (c client) DescribeScanResults(
+	ctx context.Context,
+	_Request *admin.DescribeScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeScanResultsResponse, err error) {
+
+	var result admin.AdminService_DescribeScanResults_Result
+	args := admin.AdminService_DescribeScanResults_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_DescribeScanResults_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeShardDistribution(
 	ctx context.Context,
 	_Request *shared.DescribeShardDistributionRequest,
@@ -427,6 +567,34 @@ func (c client) DescribeShardDistribution(
 	return
 }
 
+func (c client) DescribeTaskListConfig(
+	ctx context.Context,
+	_Request *admin.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeTaskListConfigResponse, err error) {
+
+	var result admin.AdminService_DescribeTaskListConfig_Result
+	args := admin.AdminService_DescribeTaskListConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_DescribeTaskListConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -455,6 +623,34 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) FixScanResults(
+	ctx context.Context,
+	_Request *admin.FixScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.FixScanResultsResponse, err error) {
+
+	var result admin.AdminService_FixScanResults_Result
+	args := admin.AdminService_FixScanResults_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_FixScanResults_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetCrossClusterTasks(
 	ctx context.Context,
 	_Request *shared.GetCrossClusterTasksRequest,
@@ -595,6 +791,34 @@ func (c client) GetReplicationMessages(
 	return
 }
 
+func (c client) GetTaskListBacklog(
+	ctx context.Context,
+	_Request *admin.GetTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetTaskListBacklogResponse, err error) {
+
+	var result admin.AdminService_GetTaskListBacklog_Result
+	args := admin.AdminService_GetTaskListBacklog_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_GetTaskListBacklog_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetWorkflowExecutionRawHistoryV2(
 	ctx context.Context,
 	_GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
@@ -623,6 +847,34 @@ func (c client) GetWorkflowExecutionRawHistoryV2(
 	return
 }
 
+func (c client) ListDeadLetterTasks(
+	ctx context.Context,
+	_Request *admin.ListDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListDeadLetterTasksResponse, err error) {
+
+	var result admin.AdminService_ListDeadLetterTasks_Result
+	args := admin.AdminService_ListDeadLetterTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListDeadLetterTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListDynamicConfig(
 	ctx context.Context,
 	_Request *admin.ListDynamicConfigRequest,
@@ -651,6 +903,90 @@ func (c client) ListDynamicConfig(
 	return
 }
 
+func (c client) ListScanCorruptions(
+	ctx context.Context,
+	_Request *admin.ListScanCorruptionsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListScanCorruptionsResponse, err error) {
+
+	var result admin.AdminService_ListScanCorruptions_Result
+	args := admin.AdminService_ListScanCorruptions_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListScanCorruptions_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListScanRuns(
+	ctx context.Context,
+	_Request *admin.ListScanRunsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListScanRunsResponse, err error) {
+
+	var result admin.AdminService_ListScanRuns_Result
+	args := admin.AdminService_ListScanRuns_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListScanRuns_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListStickyTaskLists(
+	ctx context.Context,
+	_Request *admin.ListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListStickyTaskListsResponse, err error) {
+
+	var result admin.AdminService_ListStickyTaskLists_Result
+	args := admin.AdminService_ListStickyTaskLists_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListStickyTaskLists_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MaintainCorruptWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminMaintainWorkflowRequest,
@@ -707,6 +1043,34 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) MigrateTaskList(
+	ctx context.Context,
+	_Request *admin.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateTaskListResponse, err error) {
+
+	var result admin.AdminService_MigrateTaskList_Result
+	args := admin.AdminService_MigrateTaskList_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_MigrateTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PurgeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.PurgeDLQMessagesRequest,
@@ -735,6 +1099,34 @@ func (c client) PurgeDLQMessages(
 	return
 }
 
+func (c client) PurgeDeadLetterTasks(
+	ctx context.Context,
+	_Request *admin.PurgeDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.PurgeDeadLetterTasksResponse, err error) {
+
+	var result admin.AdminService_PurgeDeadLetterTasks_Result
+	args := admin.AdminService_PurgeDeadLetterTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_PurgeDeadLetterTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReadDLQMessages(
 	ctx context.Context,
 	_Request *replicator.ReadDLQMessagesRequest,
@@ -847,6 +1239,34 @@ func (c client) RemoveTask(
 	return
 }
 
+func (c client) RequeueDeadLetterTasks(
+	ctx context.Context,
+	_Request *admin.RequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RequeueDeadLetterTasksResponse, err error) {
+
+	var result admin.AdminService_RequeueDeadLetterTasks_Result
+	args := admin.AdminService_RequeueDeadLetterTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_RequeueDeadLetterTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResendReplicationTasks(
 	ctx context.Context,
 	_Request *admin.ResendReplicationTasksRequest,
@@ -903,6 +1323,34 @@ func (c client) ResetQueue(
 	return
 }
 
+func (c client) ResetStickyTaskLists(
+	ctx context.Context,
+	_Request *admin.ResetStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ResetStickyTaskListsResponse, err error) {
+
+	var result admin.AdminService_ResetStickyTaskLists_Result
+	args := admin.AdminService_ResetStickyTaskLists_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ResetStickyTaskLists_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	_Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -986,3 +1434,31 @@ func (c client) UpdateDynamicConfig(
 	err = admin.AdminService_UpdateDynamicConfig_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateTaskListConfig(
+	ctx context.Context,
+	_Request *admin.UpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateTaskListConfigResponse, err error) {
+
+	var result admin.AdminService_UpdateTaskListConfig_Result
+	args := admin.AdminService_UpdateTaskListConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_UpdateTaskListConfig_Helper.UnwrapResponse(&result)
+	return
+}
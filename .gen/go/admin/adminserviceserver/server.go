@@ -51,6 +51,11 @@ type Interface interface {
 		Request *shared.CloseShardRequest,
 	) error
 
+	DeleteTaskList(
+		ctx context.Context,
+		Request *admin.DeleteTaskListRequest,
+	) error
+
 	DeleteWorkflow(
 		ctx context.Context,
 		Request *admin.AdminDeleteWorkflowRequest,
@@ -70,16 +75,31 @@ type Interface interface {
 		Request *shared.DescribeQueueRequest,
 	) (*shared.DescribeQueueResponse, error)
 
+	DescribeScanResults(
+		ctx context.Context,
+		Request *admin.DescribeScanResultsRequest,
+	) (*admin.DescribeScanResultsResponse, error)
+
 	DescribeShardDistribution(
 		ctx context.Context,
 		Request *shared.DescribeShardDistributionRequest,
 	) (*shared.DescribeShardDistributionResponse, error)
 
+	DescribeTaskListConfig(
+		ctx context.Context,
+		Request *admin.DescribeTaskListConfigRequest,
+	) (*admin.DescribeTaskListConfigResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	FixScanResults(
+		ctx context.Context,
+		Request *admin.FixScanResultsRequest,
+	) (*admin.FixScanResultsResponse, error)
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -105,16 +125,41 @@ type Interface interface {
 		Request *replicator.GetReplicationMessagesRequest,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	GetTaskListBacklog(
+		ctx context.Context,
+		Request *admin.GetTaskListBacklogRequest,
+	) (*admin.GetTaskListBacklogResponse, error)
+
 	GetWorkflowExecutionRawHistoryV2(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	ListDeadLetterTasks(
+		ctx context.Context,
+		Request *admin.ListDeadLetterTasksRequest,
+	) (*admin.ListDeadLetterTasksResponse, error)
+
 	ListDynamicConfig(
 		ctx context.Context,
 		Request *admin.ListDynamicConfigRequest,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListScanCorruptions(
+		ctx context.Context,
+		Request *admin.ListScanCorruptionsRequest,
+	) (*admin.ListScanCorruptionsResponse, error)
+
+	ListScanRuns(
+		ctx context.Context,
+		Request *admin.ListScanRunsRequest,
+	) (*admin.ListScanRunsResponse, error)
+
+	ListStickyTaskLists(
+		ctx context.Context,
+		Request *admin.ListStickyTaskListsRequest,
+	) (*admin.ListStickyTaskListsResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -125,11 +170,21 @@ type Interface interface {
 		Request *replicator.MergeDLQMessagesRequest,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateTaskList(
+		ctx context.Context,
+		Request *admin.MigrateTaskListRequest,
+	) (*admin.MigrateTaskListResponse, error)
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
 	) error
 
+	PurgeDeadLetterTasks(
+		ctx context.Context,
+		Request *admin.PurgeDeadLetterTasksRequest,
+	) (*admin.PurgeDeadLetterTasksResponse, error)
+
 	ReadDLQMessages(
 		ctx context.Context,
 		Request *replicator.ReadDLQMessagesRequest,
@@ -150,6 +205,11 @@ type Interface interface {
 		Request *shared.RemoveTaskRequest,
 	) error
 
+	RequeueDeadLetterTasks(
+		ctx context.Context,
+		Request *admin.RequeueDeadLetterTasksRequest,
+	) (*admin.RequeueDeadLetterTasksResponse, error)
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -160,6 +220,11 @@ type Interface interface {
 		Request *shared.ResetQueueRequest,
 	) error
 
+	ResetStickyTaskLists(
+		ctx context.Context,
+		Request *admin.ResetStickyTaskListsRequest,
+	) (*admin.ResetStickyTaskListsResponse, error)
+
 	RespondCrossClusterTasksCompleted(
 		ctx context.Context,
 		Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -174,6 +239,11 @@ type Interface interface {
 		ctx context.Context,
 		Request *admin.UpdateDynamicConfigRequest,
 	) error
+
+	UpdateTaskListConfig(
+		ctx context.Context,
+		Request *admin.UpdateTaskListConfigRequest,
+	) (*admin.UpdateTaskListConfigResponse, error)
 }
 
 // New prepares an implementation of the AdminService service for
@@ -211,6 +281,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DeleteTaskList),
+					NoWire: deletetasklist_NoWireHandler{impl},
+				},
+				Signature:    "DeleteTaskList(Request *admin.DeleteTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DeleteWorkflow",
 				HandlerSpec: thrift.HandlerSpec{
@@ -259,6 +341,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeScanResults",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeScanResults),
+					NoWire: describescanresults_NoWireHandler{impl},
+				},
+				Signature:    "DescribeScanResults(Request *admin.DescribeScanResultsRequest) (*admin.DescribeScanResultsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeShardDistribution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -271,6 +365,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeTaskListConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeTaskListConfig),
+					NoWire: describetasklistconfig_NoWireHandler{impl},
+				},
+				Signature:    "DescribeTaskListConfig(Request *admin.DescribeTaskListConfigRequest) (*admin.DescribeTaskListConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -283,6 +389,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "FixScanResults",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.FixScanResults),
+					NoWire: fixscanresults_NoWireHandler{impl},
+				},
+				Signature:    "FixScanResults(Request *admin.FixScanResultsRequest) (*admin.FixScanResultsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetCrossClusterTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -343,6 +461,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetTaskListBacklog",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetTaskListBacklog),
+					NoWire: gettasklistbacklog_NoWireHandler{impl},
+				},
+				Signature:    "GetTaskListBacklog(Request *admin.GetTaskListBacklogRequest) (*admin.GetTaskListBacklogResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetWorkflowExecutionRawHistoryV2",
 				HandlerSpec: thrift.HandlerSpec{
@@ -355,6 +485,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListDeadLetterTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListDeadLetterTasks),
+					NoWire: listdeadlettertasks_NoWireHandler{impl},
+				},
+				Signature:    "ListDeadLetterTasks(Request *admin.ListDeadLetterTasksRequest) (*admin.ListDeadLetterTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListDynamicConfig",
 				HandlerSpec: thrift.HandlerSpec{
@@ -367,6 +509,42 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListScanCorruptions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListScanCorruptions),
+					NoWire: listscancorruptions_NoWireHandler{impl},
+				},
+				Signature:    "ListScanCorruptions(Request *admin.ListScanCorruptionsRequest) (*admin.ListScanCorruptionsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListScanRuns",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListScanRuns),
+					NoWire: listscanruns_NoWireHandler{impl},
+				},
+				Signature:    "ListScanRuns(Request *admin.ListScanRunsRequest) (*admin.ListScanRunsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListStickyTaskLists",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListStickyTaskLists),
+					NoWire: liststickytasklists_NoWireHandler{impl},
+				},
+				Signature:    "ListStickyTaskLists(Request *admin.ListStickyTaskListsRequest) (*admin.ListStickyTaskListsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "MaintainCorruptWorkflow",
 				HandlerSpec: thrift.HandlerSpec{
@@ -391,6 +569,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MigrateTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.MigrateTaskList),
+					NoWire: migratetasklist_NoWireHandler{impl},
+				},
+				Signature:    "MigrateTaskList(Request *admin.MigrateTaskListRequest) (*admin.MigrateTaskListResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PurgeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -403,6 +593,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PurgeDeadLetterTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PurgeDeadLetterTasks),
+					NoWire: purgedeadlettertasks_NoWireHandler{impl},
+				},
+				Signature:    "PurgeDeadLetterTasks(Request *admin.PurgeDeadLetterTasksRequest) (*admin.PurgeDeadLetterTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReadDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -451,6 +653,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RequeueDeadLetterTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RequeueDeadLetterTasks),
+					NoWire: requeuedeadlettertasks_NoWireHandler{impl},
+				},
+				Signature:    "RequeueDeadLetterTasks(Request *admin.RequeueDeadLetterTasksRequest) (*admin.RequeueDeadLetterTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResendReplicationTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -475,6 +689,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetStickyTaskLists",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResetStickyTaskLists),
+					NoWire: resetstickytasklists_NoWireHandler{impl},
+				},
+				Signature:    "ResetStickyTaskLists(Request *admin.ResetStickyTaskListsRequest) (*admin.ResetStickyTaskListsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RespondCrossClusterTasksCompleted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -510,10 +736,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateDynamicConfig(Request *admin.UpdateDynamicConfigRequest)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateTaskListConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateTaskListConfig),
+					NoWire: updatetasklistconfig_NoWireHandler{impl},
+				},
+				Signature:    "UpdateTaskListConfig(Request *admin.UpdateTaskListConfigRequest) (*admin.UpdateTaskListConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 27)
+	procedures := make([]transport.Procedure, 0, 41)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -584,6 +822,36 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) DeleteTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DeleteTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DeleteTaskList': %w", err)
+	}
+
+	appErr := h.impl.DeleteTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DeleteTaskList_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DeleteWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DeleteWorkflow_Args
 	if err := args.FromWire(body); err != nil {
@@ -704,17 +972,17 @@ func (h handler) DescribeQueue(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_DescribeShardDistribution_Args
+func (h handler) DescribeScanResults(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeScanResults_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'DescribeShardDistribution': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeScanResults': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeShardDistribution(ctx, args.Request)
+	success, appErr := h.impl.DescribeScanResults(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_DescribeScanResults_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -734,17 +1002,17 @@ func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_DescribeWorkflowExecution_Args
+func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeShardDistribution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'DescribeWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeShardDistribution': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.Request)
+	success, appErr := h.impl.DescribeShardDistribution(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -764,17 +1032,17 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetCrossClusterTasks_Args
+func (h handler) DescribeTaskListConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeTaskListConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetCrossClusterTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeTaskListConfig': %w", err)
 	}
 
-	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
+	success, appErr := h.impl.DescribeTaskListConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_DescribeTaskListConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -794,17 +1062,17 @@ func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetDLQReplicationMessages_Args
+func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetDLQReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -824,17 +1092,17 @@ func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetDomainReplicationMessages_Args
+func (h handler) FixScanResults(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_FixScanResults_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'FixScanResults': %w", err)
 	}
 
-	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.FixScanResults(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_FixScanResults_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -854,17 +1122,17 @@ func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetDynamicConfig_Args
+func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetCrossClusterTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetCrossClusterTasks': %w", err)
 	}
 
-	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -884,17 +1152,17 @@ func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetReplicationMessages_Args
+func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDLQReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDLQReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -914,17 +1182,17 @@ func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
+func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDomainReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
+	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -944,17 +1212,17 @@ func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire
 	return response, err
 }
 
-func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ListDynamicConfig_Args
+func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
 	}
 
-	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -974,17 +1242,17 @@ func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift
 	return response, err
 }
 
-func (h handler) MaintainCorruptWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_MaintainCorruptWorkflow_Args
+func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'MaintainCorruptWorkflow': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.MaintainCorruptWorkflow(ctx, args.Request)
+	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_MaintainCorruptWorkflow_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1004,17 +1272,17 @@ func (h handler) MaintainCorruptWorkflow(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
-func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_MergeDLQMessages_Args
+func (h handler) GetTaskListBacklog(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetTaskListBacklog_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetTaskListBacklog': %w", err)
 	}
 
-	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.GetTaskListBacklog(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetTaskListBacklog_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1034,17 +1302,17 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_PurgeDLQMessages_Args
+func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
 	}
 
-	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1064,17 +1332,17 @@ func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ReadDLQMessages_Args
+func (h handler) ListDeadLetterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListDeadLetterTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ReadDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ListDeadLetterTasks': %w", err)
 	}
 
-	success, appErr := h.impl.ReadDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.ListDeadLetterTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ReadDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListDeadLetterTasks_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1094,17 +1362,17 @@ func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
-func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ReapplyEvents_Args
+func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ReapplyEvents': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
 	}
 
-	appErr := h.impl.ReapplyEvents(ctx, args.ReapplyEventsRequest)
+	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ReapplyEvents_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1124,17 +1392,17 @@ func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RefreshWorkflowTasks_Args
+func (h handler) ListScanCorruptions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListScanCorruptions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RefreshWorkflowTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ListScanCorruptions': %w", err)
 	}
 
-	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+	success, appErr := h.impl.ListScanCorruptions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ListScanCorruptions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1154,17 +1422,17 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RemoveTask_Args
+func (h handler) ListScanRuns(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListScanRuns_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTask': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ListScanRuns': %w", err)
 	}
 
-	appErr := h.impl.RemoveTask(ctx, args.Request)
+	success, appErr := h.impl.ListScanRuns(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RemoveTask_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ListScanRuns_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1184,17 +1452,17 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ResendReplicationTasks_Args
+func (h handler) ListStickyTaskLists(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListStickyTaskLists_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ResendReplicationTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ListStickyTaskLists': %w", err)
 	}
 
-	appErr := h.impl.ResendReplicationTasks(ctx, args.Request)
+	success, appErr := h.impl.ListStickyTaskLists(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ResendReplicationTasks_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ListStickyTaskLists_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1214,17 +1482,17 @@ func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ResetQueue_Args
+func (h handler) MaintainCorruptWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MaintainCorruptWorkflow_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ResetQueue': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'MaintainCorruptWorkflow': %w", err)
 	}
 
-	appErr := h.impl.ResetQueue(ctx, args.Request)
+	success, appErr := h.impl.MaintainCorruptWorkflow(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ResetQueue_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_MaintainCorruptWorkflow_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1244,17 +1512,17 @@ func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RespondCrossClusterTasksCompleted_Args
+func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MergeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RespondCrossClusterTasksCompleted': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
 	}
 
-	success, appErr := h.impl.RespondCrossClusterTasksCompleted(ctx, args.Request)
+	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1274,17 +1542,17 @@ func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wir
 	return response, err
 }
 
-func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RestoreDynamicConfig_Args
+func (h handler) MigrateTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MigrateTaskList_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RestoreDynamicConfig': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'MigrateTaskList': %w", err)
 	}
 
-	appErr := h.impl.RestoreDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.MigrateTaskList(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RestoreDynamicConfig_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_MigrateTaskList_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1304,17 +1572,17 @@ func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_UpdateDynamicConfig_Args
+func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'UpdateDynamicConfig': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
 	}
 
-	appErr := h.impl.UpdateDynamicConfig(ctx, args.Request)
+	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_UpdateDynamicConfig_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1334,25 +1602,822 @@ func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-type addsearchattribute_NoWireHandler struct{ impl Interface }
+func (h handler) PurgeDeadLetterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeDeadLetterTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDeadLetterTasks': %w", err)
+	}
 
-func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
-	var (
-		args admin.AdminService_AddSearchAttribute_Args
-		rw   stream.ResponseWriter
-		err  error
-	)
+	success, appErr := h.impl.PurgeDeadLetterTasks(ctx, args.Request)
 
-	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
-	if err != nil {
-		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'AddSearchAttribute': %w", err)
+	hadError := appErr != nil
+	result, err := admin.AdminService_PurgeDeadLetterTasks_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
 	}
 
-	appErr := h.impl.AddSearchAttribute(ctx, args.Request)
+	return response, err
+}
+
+func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReadDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.ReadDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReadDLQMessages_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReapplyEvents_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReapplyEvents': %w", err)
+	}
+
+	appErr := h.impl.ReapplyEvents(ctx, args.ReapplyEventsRequest)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReapplyEvents_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefreshWorkflowTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RefreshWorkflowTasks': %w", err)
+	}
+
+	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTask': %w", err)
+	}
+
+	appErr := h.impl.RemoveTask(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RemoveTask_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RequeueDeadLetterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RequeueDeadLetterTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RequeueDeadLetterTasks': %w", err)
+	}
+
+	success, appErr := h.impl.RequeueDeadLetterTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RequeueDeadLetterTasks_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResendReplicationTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResendReplicationTasks': %w", err)
+	}
+
+	appErr := h.impl.ResendReplicationTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResendReplicationTasks_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetQueue_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResetQueue': %w", err)
+	}
+
+	appErr := h.impl.ResetQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetQueue_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResetStickyTaskLists(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetStickyTaskLists_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResetStickyTaskLists': %w", err)
+	}
+
+	success, appErr := h.impl.ResetStickyTaskLists(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetStickyTaskLists_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RespondCrossClusterTasksCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RespondCrossClusterTasksCompleted': %w", err)
+	}
+
+	success, appErr := h.impl.RespondCrossClusterTasksCompleted(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RestoreDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RestoreDynamicConfig': %w", err)
+	}
+
+	appErr := h.impl.RestoreDynamicConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RestoreDynamicConfig_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateDynamicConfig': %w", err)
+	}
+
+	appErr := h.impl.UpdateDynamicConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateDynamicConfig_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateTaskListConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateTaskListConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateTaskListConfig': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateTaskListConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateTaskListConfig_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+type addsearchattribute_NoWireHandler struct{ impl Interface }
+
+func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_AddSearchAttribute_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'AddSearchAttribute': %w", err)
+	}
+
+	appErr := h.impl.AddSearchAttribute(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_AddSearchAttribute_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type closeshard_NoWireHandler struct{ impl Interface }
+
+func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_CloseShard_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'CloseShard': %w", err)
+	}
+
+	appErr := h.impl.CloseShard(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_CloseShard_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type deletetasklist_NoWireHandler struct{ impl Interface }
+
+func (h deletetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DeleteTaskList_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DeleteTaskList': %w", err)
+	}
+
+	appErr := h.impl.DeleteTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DeleteTaskList_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type deleteworkflow_NoWireHandler struct{ impl Interface }
+
+func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DeleteWorkflow_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DeleteWorkflow': %w", err)
+	}
+
+	success, appErr := h.impl.DeleteWorkflow(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DeleteWorkflow_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describecluster_NoWireHandler struct{ impl Interface }
+
+func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeCluster_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeCluster': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeCluster(ctx)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeCluster_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describehistoryhost_NoWireHandler struct{ impl Interface }
+
+func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeHistoryHost_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeHistoryHost': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeHistoryHost(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeHistoryHost_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describequeue_NoWireHandler struct{ impl Interface }
+
+func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeQueue_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeQueue': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeQueue_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describescanresults_NoWireHandler struct{ impl Interface }
+
+func (h describescanresults_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeScanResults_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeScanResults': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeScanResults(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeScanResults_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describesharddistribution_NoWireHandler struct{ impl Interface }
+
+func (h describesharddistribution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeShardDistribution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeShardDistribution': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeShardDistribution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describetasklistconfig_NoWireHandler struct{ impl Interface }
+
+func (h describetasklistconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeTaskListConfig_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeTaskListConfig': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeTaskListConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeTaskListConfig_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describeworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type fixscanresults_NoWireHandler struct{ impl Interface }
+
+func (h fixscanresults_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_FixScanResults_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'FixScanResults': %w", err)
+	}
+
+	success, appErr := h.impl.FixScanResults(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_AddSearchAttribute_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_FixScanResults_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1371,11 +2436,11 @@ func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 
 }
 
-type closeshard_NoWireHandler struct{ impl Interface }
+type getcrossclustertasks_NoWireHandler struct{ impl Interface }
 
-func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_CloseShard_Args
+		args admin.AdminService_GetCrossClusterTasks_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1383,13 +2448,13 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'CloseShard': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetCrossClusterTasks': %w", err)
 	}
 
-	appErr := h.impl.CloseShard(ctx, args.Request)
+	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_CloseShard_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1408,11 +2473,11 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
-type deleteworkflow_NoWireHandler struct{ impl Interface }
+type getdlqreplicationmessages_NoWireHandler struct{ impl Interface }
 
-func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DeleteWorkflow_Args
+		args admin.AdminService_GetDLQReplicationMessages_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1420,13 +2485,13 @@ func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DeleteWorkflow': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDLQReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.DeleteWorkflow(ctx, args.Request)
+	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DeleteWorkflow_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1445,11 +2510,11 @@ func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 
 }
 
-type describecluster_NoWireHandler struct{ impl Interface }
+type getdomainreplicationmessages_NoWireHandler struct{ impl Interface }
 
-func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeCluster_Args
+		args admin.AdminService_GetDomainReplicationMessages_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1457,13 +2522,13 @@ func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeCluster': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeCluster(ctx)
+	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeCluster_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1482,11 +2547,11 @@ func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 
 }
 
-type describehistoryhost_NoWireHandler struct{ impl Interface }
+type getdynamicconfig_NoWireHandler struct{ impl Interface }
 
-func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeHistoryHost_Args
+		args admin.AdminService_GetDynamicConfig_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1494,13 +2559,13 @@ func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeHistoryHost': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeHistoryHost(ctx, args.Request)
+	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeHistoryHost_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1519,11 +2584,11 @@ func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 
 }
 
-type describequeue_NoWireHandler struct{ impl Interface }
+type getreplicationmessages_NoWireHandler struct{ impl Interface }
 
-func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeQueue_Args
+		args admin.AdminService_GetReplicationMessages_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1531,13 +2596,13 @@ func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeQueue': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeQueue(ctx, args.Request)
+	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeQueue_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1556,11 +2621,11 @@ func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
-type describesharddistribution_NoWireHandler struct{ impl Interface }
+type gettasklistbacklog_NoWireHandler struct{ impl Interface }
 
-func (h describesharddistribution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h gettasklistbacklog_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeShardDistribution_Args
+		args admin.AdminService_GetTaskListBacklog_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1568,13 +2633,13 @@ func (h describesharddistribution_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeShardDistribution': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetTaskListBacklog': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeShardDistribution(ctx, args.Request)
+	success, appErr := h.impl.GetTaskListBacklog(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetTaskListBacklog_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1593,11 +2658,11 @@ func (h describesharddistribution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
-type describeworkflowexecution_NoWireHandler struct{ impl Interface }
+type getworkflowexecutionrawhistoryv2_NoWireHandler struct{ impl Interface }
 
-func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeWorkflowExecution_Args
+		args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1605,13 +2670,13 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeWorkflowExecution': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.Request)
+	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1630,11 +2695,11 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
-type getcrossclustertasks_NoWireHandler struct{ impl Interface }
+type listdeadlettertasks_NoWireHandler struct{ impl Interface }
 
-func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listdeadlettertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetCrossClusterTasks_Args
+		args admin.AdminService_ListDeadLetterTasks_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1642,13 +2707,13 @@ func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nw
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetCrossClusterTasks': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListDeadLetterTasks': %w", err)
 	}
 
-	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
+	success, appErr := h.impl.ListDeadLetterTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListDeadLetterTasks_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1667,11 +2732,11 @@ func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
-type getdlqreplicationmessages_NoWireHandler struct{ impl Interface }
+type listdynamicconfig_NoWireHandler struct{ impl Interface }
 
-func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetDLQReplicationMessages_Args
+		args admin.AdminService_ListDynamicConfig_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1679,13 +2744,13 @@ func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDLQReplicationMessages': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
 	}
 
-	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1704,11 +2769,11 @@ func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
-type getdomainreplicationmessages_NoWireHandler struct{ impl Interface }
+type listscancorruptions_NoWireHandler struct{ impl Interface }
 
-func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listscancorruptions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetDomainReplicationMessages_Args
+		args admin.AdminService_ListScanCorruptions_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1716,13 +2781,13 @@ func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Con
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListScanCorruptions': %w", err)
 	}
 
-	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.ListScanCorruptions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListScanCorruptions_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1741,11 +2806,11 @@ func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Con
 
 }
 
-type getdynamicconfig_NoWireHandler struct{ impl Interface }
+type listscanruns_NoWireHandler struct{ impl Interface }
 
-func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listscanruns_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetDynamicConfig_Args
+		args admin.AdminService_ListScanRuns_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1753,13 +2818,13 @@ func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListScanRuns': %w", err)
 	}
 
-	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.ListScanRuns(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListScanRuns_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1778,11 +2843,11 @@ func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
-type getreplicationmessages_NoWireHandler struct{ impl Interface }
+type liststickytasklists_NoWireHandler struct{ impl Interface }
 
-func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h liststickytasklists_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetReplicationMessages_Args
+		args admin.AdminService_ListStickyTaskLists_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1790,13 +2855,13 @@ func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListStickyTaskLists': %w", err)
 	}
 
-	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.ListStickyTaskLists(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListStickyTaskLists_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1815,11 +2880,11 @@ func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
-type getworkflowexecutionrawhistoryv2_NoWireHandler struct{ impl Interface }
+type maintaincorruptworkflow_NoWireHandler struct{ impl Interface }
 
-func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h maintaincorruptworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
+		args admin.AdminService_MaintainCorruptWorkflow_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1827,13 +2892,13 @@ func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'MaintainCorruptWorkflow': %w", err)
 	}
 
-	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
+	success, appErr := h.impl.MaintainCorruptWorkflow(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_MaintainCorruptWorkflow_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1852,11 +2917,11 @@ func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context
 
 }
 
-type listdynamicconfig_NoWireHandler struct{ impl Interface }
+type mergedlqmessages_NoWireHandler struct{ impl Interface }
 
-func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_ListDynamicConfig_Args
+		args admin.AdminService_MergeDLQMessages_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1864,13 +2929,13 @@ func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
 	}
 
-	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1889,11 +2954,11 @@ func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *
 
 }
 
-type maintaincorruptworkflow_NoWireHandler struct{ impl Interface }
+type migratetasklist_NoWireHandler struct{ impl Interface }
 
-func (h maintaincorruptworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h migratetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_MaintainCorruptWorkflow_Args
+		args admin.AdminService_MigrateTaskList_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1901,13 +2966,13 @@ func (h maintaincorruptworkflow_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'MaintainCorruptWorkflow': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'MigrateTaskList': %w", err)
 	}
 
-	success, appErr := h.impl.MaintainCorruptWorkflow(ctx, args.Request)
+	success, appErr := h.impl.MigrateTaskList(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_MaintainCorruptWorkflow_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_MigrateTaskList_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1926,11 +2991,11 @@ func (h maintaincorruptworkflow_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
-type mergedlqmessages_NoWireHandler struct{ impl Interface }
+type purgedlqmessages_NoWireHandler struct{ impl Interface }
 
-func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h purgedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_MergeDLQMessages_Args
+		args admin.AdminService_PurgeDLQMessages_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1938,13 +3003,13 @@ func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
 	}
 
-	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1963,11 +3028,11 @@ func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
-type purgedlqmessages_NoWireHandler struct{ impl Interface }
+type purgedeadlettertasks_NoWireHandler struct{ impl Interface }
 
-func (h purgedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h purgedeadlettertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_PurgeDLQMessages_Args
+		args admin.AdminService_PurgeDeadLetterTasks_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1975,13 +3040,13 @@ func (h purgedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'PurgeDeadLetterTasks': %w", err)
 	}
 
-	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.PurgeDeadLetterTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_PurgeDeadLetterTasks_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2148,6 +3213,43 @@ func (h removetask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type requeuedeadlettertasks_NoWireHandler struct{ impl Interface }
+
+func (h requeuedeadlettertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RequeueDeadLetterTasks_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RequeueDeadLetterTasks': %w", err)
+	}
+
+	success, appErr := h.impl.RequeueDeadLetterTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RequeueDeadLetterTasks_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resendreplicationtasks_NoWireHandler struct{ impl Interface }
 
 func (h resendreplicationtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2222,6 +3324,43 @@ func (h resetqueue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type resetstickytasklists_NoWireHandler struct{ impl Interface }
+
+func (h resetstickytasklists_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ResetStickyTaskLists_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ResetStickyTaskLists': %w", err)
+	}
+
+	success, appErr := h.impl.ResetStickyTaskLists(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetStickyTaskLists_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type respondcrossclustertaskscompleted_NoWireHandler struct{ impl Interface }
 
 func (h respondcrossclustertaskscompleted_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2332,3 +3471,40 @@ func (h updatedynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 	return response, err
 
 }
+
+type updatetasklistconfig_NoWireHandler struct{ impl Interface }
+
+func (h updatetasklistconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_UpdateTaskListConfig_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'UpdateTaskListConfig': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateTaskListConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateTaskListConfig_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
@@ -130,6 +130,37 @@ func (mr *_MockClientRecorder) CloseShard(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
 }
 
+// DeleteTaskList responds to a DeleteTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteTaskList(gomock.Any(), ...).Return(...)
+//	... := client.DeleteTaskList(...)
+func (m *MockClient) DeleteTaskList(
+	ctx context.Context,
+	_Request *admin.DeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteTaskList", args...)
+}
+
 // DeleteWorkflow responds to a DeleteWorkflow call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -260,6 +291,39 @@ func (mr *_MockClientRecorder) DescribeQueue(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeQueue", args...)
 }
 
+// DescribeScanResults responds to a DescribeScanResults call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeScanResults(gomock.Any(), ...).Return(...)
+//	... := client.DescribeScanResults(...)
+func (m *MockClient) DescribeScanResults(
+	ctx context.Context,
+	_Request *admin.DescribeScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeScanResultsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeScanResults", args...)
+	success, _ = ret[i].(*admin.DescribeScanResultsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeScanResults(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeScanResults", args...)
+}
+
 // DescribeShardDistribution responds to a DescribeShardDistribution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -293,6 +357,39 @@ func (mr *_MockClientRecorder) DescribeShardDistribution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeShardDistribution", args...)
 }
 
+// DescribeTaskListConfig responds to a DescribeTaskListConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeTaskListConfig(gomock.Any(), ...).Return(...)
+//	... := client.DescribeTaskListConfig(...)
+func (m *MockClient) DescribeTaskListConfig(
+	ctx context.Context,
+	_Request *admin.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeTaskListConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", args...)
+	success, _ = ret[i].(*admin.DescribeTaskListConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeTaskListConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskListConfig", args...)
+}
+
 // DescribeWorkflowExecution responds to a DescribeWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -326,6 +423,39 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// FixScanResults responds to a FixScanResults call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().FixScanResults(gomock.Any(), ...).Return(...)
+//	... := client.FixScanResults(...)
+func (m *MockClient) FixScanResults(
+	ctx context.Context,
+	_Request *admin.FixScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.FixScanResultsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "FixScanResults", args...)
+	success, _ = ret[i].(*admin.FixScanResultsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) FixScanResults(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "FixScanResults", args...)
+}
+
 // GetCrossClusterTasks responds to a GetCrossClusterTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -491,6 +621,39 @@ func (mr *_MockClientRecorder) GetReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationMessages", args...)
 }
 
+// GetTaskListBacklog responds to a GetTaskListBacklog call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetTaskListBacklog(gomock.Any(), ...).Return(...)
+//	... := client.GetTaskListBacklog(...)
+func (m *MockClient) GetTaskListBacklog(
+	ctx context.Context,
+	_Request *admin.GetTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetTaskListBacklogResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetTaskListBacklog", args...)
+	success, _ = ret[i].(*admin.GetTaskListBacklogResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetTaskListBacklog(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListBacklog", args...)
+}
+
 // GetWorkflowExecutionRawHistoryV2 responds to a GetWorkflowExecutionRawHistoryV2 call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -524,6 +687,39 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistoryV2(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistoryV2", args...)
 }
 
+// ListDeadLetterTasks responds to a ListDeadLetterTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListDeadLetterTasks(gomock.Any(), ...).Return(...)
+//	... := client.ListDeadLetterTasks(...)
+func (m *MockClient) ListDeadLetterTasks(
+	ctx context.Context,
+	_Request *admin.ListDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListDeadLetterTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListDeadLetterTasks", args...)
+	success, _ = ret[i].(*admin.ListDeadLetterTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListDeadLetterTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListDeadLetterTasks", args...)
+}
+
 // ListDynamicConfig responds to a ListDynamicConfig call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -557,6 +753,105 @@ func (mr *_MockClientRecorder) ListDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListDynamicConfig", args...)
 }
 
+// ListScanCorruptions responds to a ListScanCorruptions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListScanCorruptions(gomock.Any(), ...).Return(...)
+//	... := client.ListScanCorruptions(...)
+func (m *MockClient) ListScanCorruptions(
+	ctx context.Context,
+	_Request *admin.ListScanCorruptionsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListScanCorruptionsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListScanCorruptions", args...)
+	success, _ = ret[i].(*admin.ListScanCorruptionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListScanCorruptions(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListScanCorruptions", args...)
+}
+
+// ListScanRuns responds to a ListScanRuns call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListScanRuns(gomock.Any(), ...).Return(...)
+//	... := client.ListScanRuns(...)
+func (m *MockClient) ListScanRuns(
+	ctx context.Context,
+	_Request *admin.ListScanRunsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListScanRunsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListScanRuns", args...)
+	success, _ = ret[i].(*admin.ListScanRunsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListScanRuns(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListScanRuns", args...)
+}
+
+// ListStickyTaskLists responds to a ListStickyTaskLists call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListStickyTaskLists(gomock.Any(), ...).Return(...)
+//	... := client.ListStickyTaskLists(...)
+func (m *MockClient) ListStickyTaskLists(
+	ctx context.Context,
+	_Request *admin.ListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListStickyTaskListsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListStickyTaskLists", args...)
+	success, _ = ret[i].(*admin.ListStickyTaskListsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListStickyTaskLists(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListStickyTaskLists", args...)
+}
+
 // MaintainCorruptWorkflow responds to a MaintainCorruptWorkflow call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -623,6 +918,39 @@ func (mr *_MockClientRecorder) MergeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "MergeDLQMessages", args...)
 }
 
+// MigrateTaskList responds to a MigrateTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().MigrateTaskList(gomock.Any(), ...).Return(...)
+//	... := client.MigrateTaskList(...)
+func (m *MockClient) MigrateTaskList(
+	ctx context.Context,
+	_Request *admin.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateTaskListResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MigrateTaskList", args...)
+	success, _ = ret[i].(*admin.MigrateTaskListResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MigrateTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MigrateTaskList", args...)
+}
+
 // PurgeDLQMessages responds to a PurgeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -654,6 +982,39 @@ func (mr *_MockClientRecorder) PurgeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "PurgeDLQMessages", args...)
 }
 
+// PurgeDeadLetterTasks responds to a PurgeDeadLetterTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PurgeDeadLetterTasks(gomock.Any(), ...).Return(...)
+//	... := client.PurgeDeadLetterTasks(...)
+func (m *MockClient) PurgeDeadLetterTasks(
+	ctx context.Context,
+	_Request *admin.PurgeDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.PurgeDeadLetterTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PurgeDeadLetterTasks", args...)
+	success, _ = ret[i].(*admin.PurgeDeadLetterTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PurgeDeadLetterTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PurgeDeadLetterTasks", args...)
+}
+
 // ReadDLQMessages responds to a ReadDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -780,6 +1141,39 @@ func (mr *_MockClientRecorder) RemoveTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
 
+// RequeueDeadLetterTasks responds to a RequeueDeadLetterTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RequeueDeadLetterTasks(gomock.Any(), ...).Return(...)
+//	... := client.RequeueDeadLetterTasks(...)
+func (m *MockClient) RequeueDeadLetterTasks(
+	ctx context.Context,
+	_Request *admin.RequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RequeueDeadLetterTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RequeueDeadLetterTasks", args...)
+	success, _ = ret[i].(*admin.RequeueDeadLetterTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RequeueDeadLetterTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RequeueDeadLetterTasks", args...)
+}
+
 // ResendReplicationTasks responds to a ResendReplicationTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -842,6 +1236,39 @@ func (mr *_MockClientRecorder) ResetQueue(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ResetQueue", args...)
 }
 
+// ResetStickyTaskLists responds to a ResetStickyTaskLists call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetStickyTaskLists(gomock.Any(), ...).Return(...)
+//	... := client.ResetStickyTaskLists(...)
+func (m *MockClient) ResetStickyTaskLists(
+	ctx context.Context,
+	_Request *admin.ResetStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ResetStickyTaskListsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetStickyTaskLists", args...)
+	success, _ = ret[i].(*admin.ResetStickyTaskListsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetStickyTaskLists(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetStickyTaskLists", args...)
+}
+
 // RespondCrossClusterTasksCompleted responds to a RespondCrossClusterTasksCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -936,3 +1363,36 @@ func (mr *_MockClientRecorder) UpdateDynamicConfig(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDynamicConfig", args...)
 }
+
+// UpdateTaskListConfig responds to a UpdateTaskListConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateTaskListConfig(gomock.Any(), ...).Return(...)
+//	... := client.UpdateTaskListConfig(...)
+func (m *MockClient) UpdateTaskListConfig(
+	ctx context.Context,
+	_Request *admin.UpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateTaskListConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListConfig", args...)
+	success, _ = ret[i].(*admin.UpdateTaskListConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListConfig", args...)
+}
@@ -34,6 +34,7 @@ import (
 	transport "go.uber.org/yarpc/api/transport"
 	thrift "go.uber.org/yarpc/encoding/thrift"
 
+	admin "github.com/uber/cadence/.gen/go/admin"
 	matching "github.com/uber/cadence/.gen/go/matching"
 	shared "github.com/uber/cadence/.gen/go/shared"
 )
@@ -58,24 +59,48 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteTaskList(
+		ctx context.Context,
+		Request *matching.DeleteTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *matching.DescribeTaskListRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	DescribeTaskListConfig(
+		ctx context.Context,
+		Request *matching.DescribeTaskListConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeTaskListConfigResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.GetTaskListsByDomainResponse, error)
 
+	ListStickyTaskLists(
+		ctx context.Context,
+		Request *matching.ListStickyTaskListsRequest,
+		opts ...yarpc.CallOption,
+	) (*matching.ListStickyTaskListsResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *matching.ListTaskListPartitionsRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.ListTaskListPartitionsResponse, error)
 
+	MigrateTaskList(
+		ctx context.Context,
+		Request *matching.MigrateTaskListRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.MigrateTaskListResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -94,11 +119,23 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.QueryWorkflowResponse, error)
 
+	RequeueDeadLetterTasks(
+		ctx context.Context,
+		Request *matching.RequeueDeadLetterTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.RequeueDeadLetterTasksResponse, error)
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateTaskListConfig(
+		ctx context.Context,
+		Request *matching.UpdateTaskListConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.UpdateTaskListConfigResponse, error)
 }
 
 // New builds a new client for the MatchingService service.
@@ -214,6 +251,34 @@ func (c client) CancelOutstandingPoll(
 	return
 }
 
+func (c client) DeleteTaskList(
+	ctx context.Context,
+	_Request *matching.DeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result matching.MatchingService_DeleteTaskList_Result
+	args := matching.MatchingService_DeleteTaskList_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = matching.MatchingService_DeleteTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeTaskList(
 	ctx context.Context,
 	_Request *matching.DescribeTaskListRequest,
@@ -242,6 +307,34 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) DescribeTaskListConfig(
+	ctx context.Context,
+	_Request *matching.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeTaskListConfigResponse, err error) {
+
+	var result matching.MatchingService_DescribeTaskListConfig_Result
+	args := matching.MatchingService_DescribeTaskListConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_DescribeTaskListConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetTaskListsByDomain(
 	ctx context.Context,
 	_Request *shared.GetTaskListsByDomainRequest,
@@ -270,6 +363,34 @@ func (c client) GetTaskListsByDomain(
 	return
 }
 
+func (c client) ListStickyTaskLists(
+	ctx context.Context,
+	_Request *matching.ListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.ListStickyTaskListsResponse, err error) {
+
+	var result matching.MatchingService_ListStickyTaskLists_Result
+	args := matching.MatchingService_ListStickyTaskLists_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_ListStickyTaskLists_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListTaskListPartitions(
 	ctx context.Context,
 	_Request *matching.ListTaskListPartitionsRequest,
@@ -298,6 +419,34 @@ func (c client) ListTaskListPartitions(
 	return
 }
 
+func (c client) MigrateTaskList(
+	ctx context.Context,
+	_Request *matching.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateTaskListResponse, err error) {
+
+	var result matching.MatchingService_MigrateTaskList_Result
+	args := matching.MatchingService_MigrateTaskList_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_MigrateTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *matching.PollForActivityTaskRequest,
@@ -382,6 +531,34 @@ func (c client) QueryWorkflow(
 	return
 }
 
+func (c client) RequeueDeadLetterTasks(
+	ctx context.Context,
+	_Request *matching.RequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RequeueDeadLetterTasksResponse, err error) {
+
+	var result matching.MatchingService_RequeueDeadLetterTasks_Result
+	args := matching.MatchingService_RequeueDeadLetterTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_RequeueDeadLetterTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondQueryTaskCompleted(
 	ctx context.Context,
 	_Request *matching.RespondQueryTaskCompletedRequest,
@@ -409,3 +586,31 @@ func (c client) RespondQueryTaskCompleted(
 	err = matching.MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateTaskListConfig(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateTaskListConfigResponse, err error) {
+
+	var result matching.MatchingService_UpdateTaskListConfig_Result
+	args := matching.MatchingService_UpdateTaskListConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_UpdateTaskListConfig_Helper.UnwrapResponse(&result)
+	return
+}
@@ -31,6 +31,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	yarpc "go.uber.org/yarpc"
 
+	admin "github.com/uber/cadence/.gen/go/admin"
 	matching "github.com/uber/cadence/.gen/go/matching"
 	matchingserviceclient "github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
 	shared "github.com/uber/cadence/.gen/go/shared"
@@ -160,6 +161,37 @@ func (mr *_MockClientRecorder) CancelOutstandingPoll(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CancelOutstandingPoll", args...)
 }
 
+// DeleteTaskList responds to a DeleteTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteTaskList(gomock.Any(), ...).Return(...)
+//	... := client.DeleteTaskList(...)
+func (m *MockClient) DeleteTaskList(
+	ctx context.Context,
+	_Request *matching.DeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteTaskList", args...)
+}
+
 // DescribeTaskList responds to a DescribeTaskList call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -193,6 +225,39 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// DescribeTaskListConfig responds to a DescribeTaskListConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeTaskListConfig(gomock.Any(), ...).Return(...)
+//	... := client.DescribeTaskListConfig(...)
+func (m *MockClient) DescribeTaskListConfig(
+	ctx context.Context,
+	_Request *matching.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeTaskListConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", args...)
+	success, _ = ret[i].(*admin.DescribeTaskListConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeTaskListConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskListConfig", args...)
+}
+
 // GetTaskListsByDomain responds to a GetTaskListsByDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -226,6 +291,39 @@ func (mr *_MockClientRecorder) GetTaskListsByDomain(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListsByDomain", args...)
 }
 
+// ListStickyTaskLists responds to a ListStickyTaskLists call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListStickyTaskLists(gomock.Any(), ...).Return(...)
+//	... := client.ListStickyTaskLists(...)
+func (m *MockClient) ListStickyTaskLists(
+	ctx context.Context,
+	_Request *matching.ListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.ListStickyTaskListsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListStickyTaskLists", args...)
+	success, _ = ret[i].(*matching.ListStickyTaskListsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListStickyTaskLists(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListStickyTaskLists", args...)
+}
+
 // ListTaskListPartitions responds to a ListTaskListPartitions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -259,6 +357,39 @@ func (mr *_MockClientRecorder) ListTaskListPartitions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskListPartitions", args...)
 }
 
+// MigrateTaskList responds to a MigrateTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().MigrateTaskList(gomock.Any(), ...).Return(...)
+//	... := client.MigrateTaskList(...)
+func (m *MockClient) MigrateTaskList(
+	ctx context.Context,
+	_Request *matching.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateTaskListResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MigrateTaskList", args...)
+	success, _ = ret[i].(*admin.MigrateTaskListResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MigrateTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MigrateTaskList", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -358,6 +489,39 @@ func (mr *_MockClientRecorder) QueryWorkflow(
 	return mr.mock.ctrl.RecordCall(mr.mock, "QueryWorkflow", args...)
 }
 
+// RequeueDeadLetterTasks responds to a RequeueDeadLetterTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RequeueDeadLetterTasks(gomock.Any(), ...).Return(...)
+//	... := client.RequeueDeadLetterTasks(...)
+func (m *MockClient) RequeueDeadLetterTasks(
+	ctx context.Context,
+	_Request *matching.RequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RequeueDeadLetterTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RequeueDeadLetterTasks", args...)
+	success, _ = ret[i].(*admin.RequeueDeadLetterTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RequeueDeadLetterTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RequeueDeadLetterTasks", args...)
+}
+
 // RespondQueryTaskCompleted responds to a RespondQueryTaskCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -388,3 +552,36 @@ func (mr *_MockClientRecorder) RespondQueryTaskCompleted(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondQueryTaskCompleted", args...)
 }
+
+// UpdateTaskListConfig responds to a UpdateTaskListConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateTaskListConfig(gomock.Any(), ...).Return(...)
+//	... := client.UpdateTaskListConfig(...)
+func (m *MockClient) UpdateTaskListConfig(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateTaskListConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListConfig", args...)
+	success, _ = ret[i].(*admin.UpdateTaskListConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListConfig", args...)
+}
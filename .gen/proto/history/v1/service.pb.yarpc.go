@@ -49,6 +49,9 @@ type HistoryAPIYARPCClient interface {
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error)
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest, ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
 	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
 	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
 	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest, ...yarpc.CallOption) (*ResetStickyTaskListResponse, error)
@@ -112,6 +115,9 @@ type HistoryAPIYARPCServer interface {
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error)
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
 	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
 	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
 	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest) (*ResetStickyTaskListResponse, error)
@@ -213,6 +219,36 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "PauseWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PauseWorkflowExecution,
+							NewRequest:  newHistoryAPIServicePauseWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UnpauseWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UnpauseWorkflowExecution,
+							NewRequest:  newHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateWorkflowExecution,
+							NewRequest:  newHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "DescribeWorkflowExecution",
 					Handler: protobuf.NewUnaryHandler(
@@ -765,6 +801,42 @@ func (c *_HistoryAPIYARPCCaller) TerminateWorkflowExecution(ctx context.Context,
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest, options ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PauseWorkflowExecution", request, newHistoryAPIServicePauseWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServicePauseWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) UnpauseWorkflowExecution(ctx context.Context, request *UnpauseWorkflowExecutionRequest, options ...yarpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UnpauseWorkflowExecution", request, newHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest, options ...yarpc.CallOption) (*UpdateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateWorkflowExecution", request, newHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newHistoryAPIServiceDescribeWorkflowExecutionYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1305,6 +1377,54 @@ func (h *_HistoryAPIYARPCHandler) TerminateWorkflowExecution(ctx context.Context
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) PauseWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PauseWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PauseWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServicePauseWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PauseWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) UnpauseWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UnpauseWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UnpauseWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UnpauseWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) UpdateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *DescribeWorkflowExecutionRequest
 	var ok bool
@@ -1953,6 +2073,30 @@ func newHistoryAPIServiceTerminateWorkflowExecutionYARPCResponse() proto.Message
 	return &TerminateWorkflowExecutionResponse{}
 }
 
+func newHistoryAPIServicePauseWorkflowExecutionYARPCRequest() proto.Message {
+	return &PauseWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServicePauseWorkflowExecutionYARPCResponse() proto.Message {
+	return &PauseWorkflowExecutionResponse{}
+}
+
+func newHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest() proto.Message {
+	return &UnpauseWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse() proto.Message {
+	return &UnpauseWorkflowExecutionResponse{}
+}
+
+func newHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest() proto.Message {
+	return &UpdateWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse() proto.Message {
+	return &UpdateWorkflowExecutionResponse{}
+}
+
 func newHistoryAPIServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
 	return &DescribeWorkflowExecutionRequest{}
 }
@@ -2268,6 +2412,12 @@ var (
 	emptyHistoryAPIServiceResetWorkflowExecutionYARPCResponse            = &ResetWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceTerminateWorkflowExecutionYARPCRequest         = &TerminateWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceTerminateWorkflowExecutionYARPCResponse        = &TerminateWorkflowExecutionResponse{}
+	emptyHistoryAPIServicePauseWorkflowExecutionYARPCRequest             = &PauseWorkflowExecutionRequest{}
+	emptyHistoryAPIServicePauseWorkflowExecutionYARPCResponse            = &PauseWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest           = &UnpauseWorkflowExecutionRequest{}
+	emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse          = &UnpauseWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest            = &UpdateWorkflowExecutionRequest{}
+	emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse           = &UpdateWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceDescribeWorkflowExecutionYARPCRequest          = &DescribeWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceDescribeWorkflowExecutionYARPCResponse         = &DescribeWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceQueryWorkflowYARPCRequest                      = &QueryWorkflowRequest{}
@@ -2349,306 +2499,354 @@ var (
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
-		0x76, 0x68, 0x8e, 0xf8, 0xf5, 0x48, 0x0e, 0xc9, 0x12, 0x3f, 0x86, 0x43, 0x89, 0x22, 0xdb, 0x96,
-		0x4d, 0xcb, 0xeb, 0xa1, 0x45, 0xdb, 0xfa, 0xb2, 0xbc, 0x5a, 0x89, 0x94, 0xe4, 0x31, 0xf4, 0xd9,
-		0x64, 0xe4, 0x24, 0x48, 0xdc, 0xdb, 0xec, 0xae, 0x21, 0x3b, 0xea, 0xe9, 0x1e, 0x75, 0xf7, 0x90,
-		0x1a, 0x1f, 0x02, 0x07, 0x1b, 0x04, 0xd8, 0x45, 0x90, 0xdd, 0x2c, 0x36, 0x41, 0x80, 0x00, 0x0b,
-		0x04, 0x1b, 0x60, 0xb3, 0x46, 0x6e, 0xc9, 0x2d, 0xc8, 0x29, 0x40, 0x90, 0xbf, 0x90, 0x53, 0x2e,
-		0x9b, 0x43, 0x02, 0xe4, 0x94, 0x3d, 0x07, 0x41, 0x7d, 0x74, 0x4f, 0x7f, 0x54, 0x57, 0x0f, 0xc9,
-		0x00, 0xf2, 0x3a, 0xbe, 0x4d, 0x57, 0xd5, 0x7b, 0xf5, 0xea, 0xd5, 0xab, 0x57, 0xef, 0xab, 0x06,
-		0x2e, 0x76, 0xf7, 0xb0, 0xbf, 0x61, 0x1a, 0x16, 0x76, 0x4d, 0xbc, 0x71, 0x60, 0x07, 0xa1, 0xe7,
-		0xf7, 0x36, 0x0e, 0x2f, 0x6f, 0x04, 0xd8, 0x3f, 0xb4, 0x4d, 0xdc, 0xe8, 0xf8, 0x5e, 0xe8, 0xa1,
-		0x45, 0x32, 0xac, 0xc1, 0x87, 0x35, 0xf8, 0xb0, 0xc6, 0xe1, 0xe5, 0xfa, 0xca, 0xbe, 0xe7, 0xed,
-		0x3b, 0x78, 0x83, 0x0e, 0xdb, 0xeb, 0xb6, 0x36, 0xac, 0xae, 0x6f, 0x84, 0xb6, 0xe7, 0x32, 0xc0,
-		0xfa, 0x85, 0x6c, 0x7f, 0x68, 0xb7, 0x71, 0x10, 0x1a, 0xed, 0x0e, 0x1f, 0x90, 0x43, 0x70, 0xe4,
-		0x1b, 0x9d, 0x0e, 0xf6, 0x03, 0xde, 0xbf, 0x9a, 0x22, 0xd0, 0xe8, 0xd8, 0x84, 0x38, 0xd3, 0x6b,
-		0xb7, 0xe3, 0x29, 0xd6, 0x44, 0x23, 0x22, 0x12, 0x39, 0x15, 0xa2, 0x21, 0x2f, 0xba, 0x38, 0x1e,
-		0xa0, 0x8a, 0x06, 0x84, 0x46, 0xf0, 0xdc, 0xb1, 0x83, 0x50, 0x36, 0xe6, 0xc8, 0xf3, 0x9f, 0xb7,
-		0x1c, 0xef, 0x88, 0x8f, 0xb9, 0x24, 0x1a, 0xc3, 0x59, 0xa9, 0x67, 0xc6, 0xae, 0x97, 0x8d, 0xc5,
-		0x3e, 0x1f, 0xf9, 0x5a, 0x7a, 0xa4, 0xd5, 0xb6, 0x5d, 0xca, 0x05, 0xa7, 0x1b, 0x84, 0x65, 0x83,
-		0xd2, 0x8c, 0x58, 0x13, 0x0f, 0x7a, 0xd1, 0xc5, 0x5d, 0xbe, 0xd5, 0xf5, 0x37, 0xc5, 0x43, 0x7c,
-		0xdc, 0x71, 0x6c, 0x33, 0xb9, 0xb5, 0xaf, 0xa7, 0x06, 0x06, 0x07, 0x86, 0x8f, 0xad, 0xfc, 0x8c,
-		0x17, 0x0b, 0x46, 0xa5, 0x99, 0xa1, 0xfe, 0xcd, 0x30, 0x9c, 0xdf, 0x09, 0x0d, 0x3f, 0xfc, 0x94,
-		0xb7, 0xdf, 0x7d, 0x89, 0xcd, 0x2e, 0x99, 0x4d, 0xc3, 0x2f, 0xba, 0x38, 0x08, 0xd1, 0x03, 0x18,
-		0xf5, 0xd9, 0xcf, 0x9a, 0xb2, 0xaa, 0xac, 0x4f, 0x6c, 0x6e, 0x36, 0x52, 0x42, 0x69, 0x74, 0xec,
-		0xc6, 0xe1, 0xe5, 0x86, 0x14, 0x89, 0x16, 0xa1, 0x40, 0xcb, 0x30, 0x6e, 0x79, 0x6d, 0xc3, 0x76,
-		0x75, 0xdb, 0xaa, 0x0d, 0xad, 0x2a, 0xeb, 0xe3, 0xda, 0x18, 0x6b, 0x68, 0x5a, 0xe8, 0x77, 0x60,
-		0xbe, 0x63, 0xf8, 0xd8, 0x0d, 0x75, 0x1c, 0x21, 0xd0, 0x6d, 0xb7, 0xe5, 0xd5, 0x2a, 0x74, 0xe2,
-		0x75, 0xe1, 0xc4, 0x4f, 0x28, 0x44, 0x3c, 0x63, 0xd3, 0x6d, 0x79, 0xda, 0xd9, 0x4e, 0xbe, 0x11,
-		0xd5, 0x60, 0xd4, 0x08, 0x43, 0xdc, 0xee, 0x84, 0xb5, 0x33, 0xab, 0xca, 0xfa, 0xb0, 0x16, 0x7d,
-		0xa2, 0x2d, 0x98, 0xc6, 0x2f, 0x3b, 0x36, 0x3b, 0x40, 0x3a, 0x39, 0x29, 0xb5, 0x61, 0x3a, 0x63,
-		0xbd, 0xc1, 0x4e, 0x49, 0x23, 0x3a, 0x25, 0x8d, 0xdd, 0xe8, 0x18, 0x69, 0xd5, 0x3e, 0x08, 0x69,
-		0x44, 0x2d, 0x58, 0x32, 0x3d, 0x37, 0xb4, 0xdd, 0x2e, 0xd6, 0x8d, 0x40, 0x77, 0xf1, 0x91, 0x6e,
-		0xbb, 0x76, 0x68, 0x1b, 0xa1, 0xe7, 0xd7, 0x46, 0x56, 0x95, 0xf5, 0xea, 0xe6, 0xdb, 0xc2, 0x05,
-		0x6c, 0x71, 0xa8, 0xdb, 0xc1, 0x23, 0x7c, 0xd4, 0x8c, 0x40, 0xb4, 0x05, 0x53, 0xd8, 0x8e, 0x9a,
-		0x30, 0x1b, 0xf5, 0x58, 0x7a, 0xcb, 0xb0, 0x9d, 0xae, 0x8f, 0x6b, 0xa3, 0x94, 0xdc, 0x73, 0x42,
-		0xfc, 0xf7, 0xd8, 0x18, 0x6d, 0x26, 0x06, 0xe3, 0x2d, 0x48, 0x83, 0x05, 0xc7, 0x08, 0x42, 0xdd,
-		0xf4, 0xda, 0x1d, 0x07, 0xd3, 0xc5, 0xfb, 0x38, 0xe8, 0x3a, 0x61, 0x6d, 0x4c, 0x82, 0xef, 0x89,
-		0xd1, 0x73, 0x3c, 0xc3, 0xd2, 0xe6, 0x08, 0xec, 0x56, 0x0c, 0xaa, 0x51, 0x48, 0xf4, 0x9b, 0xb0,
-		0xdc, 0xb2, 0xfd, 0x20, 0xd4, 0x2d, 0x6c, 0xda, 0x01, 0xe5, 0xa7, 0x11, 0x3c, 0xd7, 0xf7, 0x0c,
-		0xf3, 0xb9, 0xd7, 0x6a, 0xd5, 0xc6, 0x29, 0xe2, 0xa5, 0x1c, 0x5f, 0xb7, 0xb9, 0xfa, 0xd2, 0x6a,
-		0x14, 0x7a, 0x9b, 0x03, 0xef, 0x1a, 0xc1, 0xf3, 0x3b, 0x0c, 0x54, 0xbd, 0x0a, 0x2b, 0x45, 0x42,
-		0x16, 0x74, 0x3c, 0x37, 0xc0, 0x68, 0x1e, 0x46, 0xfc, 0x2e, 0x95, 0x2c, 0x85, 0x4a, 0xd6, 0xb0,
-		0xdf, 0x75, 0x9b, 0x96, 0xfa, 0xd7, 0x43, 0xb0, 0xb2, 0x63, 0xef, 0xbb, 0x86, 0x53, 0x28, 0xe4,
-		0x0f, 0xb3, 0x42, 0xfe, 0x9e, 0x58, 0xc8, 0xa5, 0x58, 0x06, 0x94, 0xf2, 0x16, 0x2c, 0xe3, 0x97,
-		0x21, 0xf6, 0x5d, 0xc3, 0x89, 0x55, 0x53, 0x5f, 0xe0, 0xb9, 0xac, 0xbf, 0x21, 0x9c, 0x3f, 0x3f,
-		0xf3, 0x52, 0x84, 0x2a, 0xd7, 0x85, 0x1a, 0x70, 0xd6, 0x3c, 0xb0, 0x1d, 0xab, 0x3f, 0x89, 0xe7,
-		0x3a, 0x3d, 0x2a, 0xfb, 0x63, 0xda, 0x2c, 0xed, 0x8a, 0x80, 0x1e, 0xbb, 0x4e, 0x4f, 0x5d, 0x83,
-		0x0b, 0x85, 0xeb, 0x63, 0x0c, 0x56, 0x7f, 0xaa, 0xc0, 0x9b, 0x7c, 0x8c, 0x1d, 0x1e, 0xc8, 0xf5,
-		0xc6, 0xb3, 0x2c, 0x4b, 0x6f, 0xca, 0x58, 0x5a, 0x86, 0x6e, 0x30, 0xde, 0xaa, 0xb7, 0x61, 0xbd,
-		0x1c, 0xa1, 0x5c, 0x5a, 0x7e, 0xa0, 0xc0, 0x79, 0x0d, 0x07, 0xf8, 0xd4, 0x1a, 0x51, 0x8a, 0x64,
-		0xc0, 0xf5, 0x5c, 0x85, 0x95, 0x22, 0x34, 0xf2, 0x55, 0x7c, 0x39, 0x04, 0x6b, 0xbb, 0xd8, 0x6f,
-		0xdb, 0xae, 0x11, 0xe2, 0xc2, 0x95, 0x3c, 0xc9, 0xae, 0xe4, 0x8a, 0x70, 0x25, 0xa5, 0x88, 0x7e,
-		0xcd, 0x25, 0xff, 0x75, 0x50, 0x65, 0x4b, 0xe4, 0xc2, 0xff, 0x23, 0x05, 0x56, 0xb7, 0x71, 0x60,
-		0xfa, 0xf6, 0x5e, 0x31, 0x47, 0x1f, 0x67, 0x39, 0xfa, 0x81, 0x70, 0x39, 0x65, 0x78, 0x06, 0x14,
-		0x8f, 0xff, 0xa9, 0xc0, 0x9a, 0x04, 0x15, 0x17, 0x11, 0x07, 0x16, 0xfb, 0xf7, 0xa9, 0xe9, 0xb9,
-		0x2d, 0x7b, 0x9f, 0x6b, 0x5b, 0xa9, 0xb2, 0xcb, 0x21, 0xdc, 0x4a, 0x82, 0x6a, 0x0b, 0x58, 0xd8,
-		0x8e, 0xf6, 0x60, 0x31, 0xbf, 0xb7, 0xec, 0x1a, 0x1f, 0xa2, 0xb3, 0x5d, 0x1a, 0x6c, 0x36, 0x7a,
-		0x91, 0xcf, 0x1f, 0x89, 0x9a, 0xd1, 0xa7, 0x80, 0x3a, 0xd8, 0xb5, 0x6c, 0x77, 0x5f, 0x37, 0xcc,
-		0xd0, 0x3e, 0xb4, 0x43, 0x1b, 0x07, 0xb5, 0xca, 0x6a, 0xa5, 0xd8, 0x4a, 0x60, 0xc3, 0x6f, 0xb3,
-		0xd1, 0x3d, 0x8a, 0x7c, 0xb6, 0x93, 0x6a, 0xb4, 0x71, 0x80, 0x7e, 0x0b, 0x66, 0x22, 0xc4, 0x54,
-		0x4c, 0x7c, 0xec, 0xd6, 0xce, 0x50, 0xb4, 0x0d, 0x19, 0xda, 0x2d, 0x32, 0x36, 0x4d, 0xf9, 0x74,
-		0x27, 0xd1, 0xe5, 0x63, 0x17, 0xed, 0xf4, 0x51, 0x47, 0x57, 0x23, 0xb7, 0x32, 0xa4, 0x14, 0x47,
-		0x37, 0x61, 0x0a, 0x69, 0xd4, 0xa8, 0xbe, 0x84, 0xb9, 0xa7, 0xc4, 0x9c, 0x8e, 0xb8, 0x17, 0x89,
-		0xe1, 0x56, 0x56, 0x0c, 0xdf, 0x12, 0xce, 0x21, 0x82, 0x1d, 0x50, 0xf4, 0x7e, 0xa6, 0xc0, 0x7c,
-		0x06, 0x9c, 0x8b, 0xdb, 0x2d, 0x98, 0xa4, 0x26, 0x7e, 0x64, 0x4b, 0x28, 0x03, 0xd8, 0x12, 0x13,
-		0x14, 0x82, 0x9b, 0x10, 0x4d, 0xa8, 0x46, 0x08, 0x7e, 0x0f, 0x9b, 0x21, 0xb6, 0xb8, 0xe0, 0xa8,
-		0xc5, 0x6b, 0xd0, 0xf8, 0x48, 0x6d, 0xea, 0x45, 0xf2, 0x53, 0xfd, 0x43, 0x05, 0xea, 0x54, 0x81,
-		0xee, 0x84, 0xb6, 0xf9, 0xbc, 0x47, 0xcc, 0x89, 0x07, 0x76, 0x10, 0x46, 0x6c, 0x6a, 0x66, 0xd9,
-		0xb4, 0x51, 0xac, 0xc9, 0x85, 0x18, 0x06, 0x64, 0xd6, 0x79, 0x58, 0x16, 0xe2, 0xe0, 0x9a, 0xe5,
-		0xbf, 0x15, 0x58, 0xb8, 0x8f, 0xc3, 0x87, 0xdd, 0xd0, 0xd8, 0x73, 0xf0, 0x4e, 0x68, 0x84, 0x58,
-		0x13, 0xa1, 0x55, 0x32, 0xfa, 0xf4, 0x37, 0x00, 0x09, 0xd4, 0xe8, 0xd0, 0xb1, 0xd4, 0xe8, 0x6c,
-		0xee, 0x84, 0xa1, 0xf7, 0x60, 0x01, 0xbf, 0xec, 0x50, 0x06, 0xea, 0x2e, 0x7e, 0x19, 0xea, 0xf8,
-		0x90, 0xd8, 0xe4, 0xb6, 0x45, 0x35, 0x74, 0x45, 0x3b, 0x1b, 0xf5, 0x3e, 0xc2, 0x2f, 0xc3, 0xbb,
-		0xa4, 0xaf, 0x69, 0xa1, 0x77, 0x61, 0xce, 0xec, 0xfa, 0xd4, 0x78, 0xdf, 0xf3, 0x0d, 0xd7, 0x3c,
-		0xd0, 0x43, 0xef, 0x39, 0x3d, 0x3d, 0xca, 0xfa, 0xa4, 0x86, 0x78, 0xdf, 0x1d, 0xda, 0xb5, 0x4b,
-		0x7a, 0xd4, 0x9f, 0x8c, 0xc3, 0x62, 0x6e, 0xd5, 0x5c, 0x86, 0xc4, 0x2b, 0x53, 0x4e, 0xbb, 0xb2,
-		0x7b, 0x30, 0x15, 0xa3, 0x0d, 0x7b, 0x1d, 0xcc, 0x79, 0xb5, 0x26, 0xc5, 0xb8, 0xdb, 0xeb, 0x60,
-		0x6d, 0xf2, 0x28, 0xf1, 0x85, 0x54, 0x98, 0x12, 0x31, 0x66, 0xc2, 0x4d, 0x30, 0xe4, 0x19, 0x2c,
-		0x75, 0x7c, 0x7c, 0x68, 0x7b, 0xdd, 0x40, 0x0f, 0x88, 0x25, 0x82, 0xad, 0xfe, 0xf8, 0x33, 0x74,
-		0xde, 0xe5, 0x9c, 0x19, 0xdc, 0x74, 0xc3, 0x2b, 0xef, 0x3f, 0x33, 0x9c, 0x2e, 0xd6, 0x16, 0x22,
-		0xe8, 0x1d, 0x06, 0x1c, 0xe1, 0x7d, 0x07, 0xce, 0x52, 0xa3, 0x9d, 0x59, 0xd9, 0x31, 0xc6, 0x61,
-		0x4a, 0xc1, 0x0c, 0xe9, 0xba, 0x47, 0x7a, 0xa2, 0xe1, 0x37, 0x60, 0x9c, 0x1a, 0xe0, 0xc4, 0xa1,
-		0xa6, 0x6e, 0xc8, 0xc4, 0xe6, 0x79, 0xf1, 0x25, 0x1f, 0x49, 0xe5, 0x58, 0xc8, 0x7f, 0xa1, 0xfb,
-		0x30, 0x13, 0x50, 0x89, 0xd5, 0xfb, 0x28, 0x46, 0x07, 0x41, 0x51, 0x0d, 0x52, 0x82, 0x8e, 0xde,
-		0x87, 0x05, 0xd3, 0xb1, 0x09, 0xa5, 0x8e, 0xbd, 0xe7, 0x1b, 0x7e, 0x4f, 0x3f, 0xc4, 0x3e, 0xd5,
-		0x80, 0x63, 0x54, 0xa4, 0xe7, 0x58, 0xef, 0x03, 0xd6, 0xf9, 0x8c, 0xf5, 0x25, 0xa0, 0x5a, 0xd8,
-		0x08, 0xbb, 0x3e, 0x8e, 0xa1, 0xc6, 0x93, 0x50, 0xf7, 0x58, 0x67, 0x04, 0x75, 0x01, 0x26, 0x38,
-		0x94, 0xdd, 0xee, 0x38, 0x35, 0xa0, 0x43, 0x81, 0x35, 0x35, 0xdb, 0x1d, 0x07, 0x05, 0x70, 0x29,
-		0xbb, 0x2a, 0x3d, 0x30, 0x0f, 0xb0, 0xd5, 0x75, 0xb0, 0x1e, 0x7a, 0x6c, 0xb3, 0xa8, 0x17, 0xe8,
-		0x75, 0xc3, 0xda, 0x44, 0x99, 0xc3, 0xf2, 0x7a, 0x7a, 0xad, 0x3b, 0x1c, 0xd3, 0xae, 0x47, 0xf7,
-		0x6d, 0x97, 0xa1, 0x21, 0x26, 0x09, 0xdb, 0x2a, 0xe2, 0xa3, 0xf7, 0x17, 0x32, 0x49, 0x1d, 0xd1,
-		0x59, 0xda, 0xb5, 0x43, 0x7a, 0xa2, 0x55, 0x14, 0x1d, 0xa7, 0xa9, 0xa2, 0xe3, 0x84, 0x1e, 0x40,
-		0x35, 0x96, 0xed, 0x80, 0x1c, 0xa6, 0x5a, 0x95, 0x3a, 0x9d, 0x17, 0xd3, 0x5b, 0xc5, 0x22, 0x01,
-		0x49, 0xf9, 0x66, 0x27, 0x2f, 0x3e, 0x18, 0xf4, 0x13, 0x99, 0x30, 0x17, 0x63, 0x33, 0x1d, 0x2f,
-		0xc0, 0x1c, 0xe7, 0x34, 0xc5, 0x79, 0x79, 0x40, 0x83, 0x81, 0x00, 0x12, 0x7c, 0xdd, 0x40, 0x8b,
-		0xcf, 0x73, 0xdc, 0x48, 0x4e, 0xf9, 0x2c, 0x67, 0x84, 0xce, 0x82, 0x17, 0xe4, 0x16, 0x9f, 0x11,
-		0xdd, 0x89, 0x7d, 0xaa, 0x39, 0x83, 0x3e, 0x8e, 0xc6, 0x6b, 0x33, 0x87, 0x99, 0x16, 0x74, 0x13,
-		0x96, 0x6d, 0x72, 0xe6, 0x32, 0x7b, 0x8c, 0x5d, 0xa2, 0x67, 0xac, 0xda, 0x2c, 0x35, 0x03, 0x17,
-		0xed, 0x20, 0xad, 0x8d, 0xef, 0xb2, 0x6e, 0xf5, 0x57, 0x0a, 0x2c, 0x3e, 0xf1, 0x1c, 0xe7, 0xff,
-		0x99, 0x36, 0xfe, 0xf9, 0x18, 0xd4, 0xf2, 0xcb, 0xfe, 0x46, 0x1d, 0x7f, 0xa3, 0x8e, 0xbf, 0x8e,
-		0xea, 0xb8, 0xe8, 0x7c, 0x4c, 0x16, 0xaa, 0x57, 0xa1, 0xae, 0x9a, 0x3a, 0xb5, 0xae, 0xfa, 0xf5,
-		0xd3, 0xda, 0xea, 0x3f, 0x0d, 0xc1, 0xaa, 0x86, 0x4d, 0xcf, 0xb7, 0x92, 0x51, 0x3a, 0x7e, 0x2c,
-		0x5e, 0xa5, 0xa6, 0xbc, 0x00, 0x13, 0xb1, 0xe0, 0xc4, 0x4a, 0x00, 0xa2, 0xa6, 0xa6, 0x85, 0x16,
-		0x61, 0x94, 0xca, 0x18, 0x3f, 0xf1, 0x15, 0x6d, 0x84, 0x7c, 0x36, 0x2d, 0x74, 0x1e, 0x80, 0xdb,
-		0xf1, 0xd1, 0xd9, 0x1d, 0xd7, 0xc6, 0x79, 0x4b, 0xd3, 0x42, 0x1a, 0x4c, 0x76, 0x3c, 0xc7, 0xd1,
-		0x23, 0x5f, 0x61, 0x44, 0xe2, 0x2b, 0x10, 0x1d, 0x7a, 0xcf, 0xf3, 0x93, 0xac, 0x89, 0x7c, 0x85,
-		0x09, 0x82, 0x84, 0x7f, 0xa8, 0xff, 0x36, 0x0a, 0x6b, 0x12, 0x2e, 0x72, 0xc5, 0x9b, 0xd3, 0x90,
-		0xca, 0xc9, 0x34, 0xa4, 0x54, 0xfb, 0x0d, 0x9d, 0x5c, 0xfb, 0x7d, 0x0b, 0x50, 0xc4, 0x5f, 0x2b,
-		0xab, 0x7e, 0x67, 0xe2, 0x9e, 0x68, 0xf4, 0x3a, 0x51, 0x60, 0x02, 0xd5, 0x5b, 0x21, 0x1a, 0x2a,
-		0x85, 0x37, 0xa7, 0xd1, 0x87, 0xf3, 0x1a, 0x3d, 0x11, 0xcf, 0x1f, 0x49, 0xc7, 0xf3, 0xaf, 0x41,
-		0x8d, 0xab, 0x94, 0x7e, 0x00, 0x22, 0xba, 0xfd, 0x47, 0xe9, 0xed, 0xbf, 0xc0, 0xfa, 0x63, 0xd9,
-		0xe1, 0x97, 0x3f, 0xd2, 0x60, 0x2a, 0x8e, 0x5b, 0xd3, 0x90, 0x05, 0x0b, 0x84, 0xbf, 0x53, 0x74,
-		0x1a, 0x77, 0x7d, 0xc3, 0x0d, 0x88, 0x2a, 0x4b, 0xb9, 0xe9, 0x93, 0x56, 0xe2, 0x0b, 0x7d, 0x06,
-		0xe7, 0x04, 0x01, 0x91, 0xbe, 0x0a, 0x1f, 0x1f, 0x44, 0x85, 0x2f, 0xe5, 0xc4, 0x3d, 0xd6, 0xe6,
-		0x05, 0xa6, 0x25, 0x14, 0x99, 0x96, 0x6b, 0x30, 0x99, 0xd2, 0x79, 0x13, 0x54, 0xe7, 0x4d, 0xec,
-		0x25, 0x94, 0xdd, 0x6d, 0xa8, 0xf6, 0xb7, 0x95, 0xe6, 0x43, 0x26, 0x4b, 0xf3, 0x21, 0x53, 0x31,
-		0x04, 0x4d, 0x87, 0x7c, 0x04, 0x93, 0xd1, 0x5e, 0x53, 0x04, 0x53, 0xa5, 0x08, 0x26, 0xf8, 0x78,
-		0x0a, 0x6e, 0xc0, 0x28, 0xf1, 0xe4, 0x89, 0x92, 0xad, 0xd2, 0xf8, 0xcb, 0xfd, 0x46, 0x41, 0x2a,
-		0xb4, 0x51, 0x7a, 0x8a, 0x68, 0x88, 0xc0, 0xc6, 0xc1, 0x5d, 0x37, 0xf4, 0x7b, 0x5a, 0x84, 0xb7,
-		0xfe, 0x19, 0x4c, 0x26, 0x3b, 0xd0, 0x0c, 0x54, 0x9e, 0xe3, 0x1e, 0x57, 0x56, 0xe4, 0x27, 0xba,
-		0x06, 0xc3, 0x87, 0x44, 0xfc, 0xa5, 0xf1, 0x87, 0xe8, 0xd4, 0xb1, 0x38, 0x04, 0x03, 0xb8, 0x31,
-		0x74, 0x4d, 0x49, 0xe8, 0xc9, 0x28, 0xea, 0xf4, 0x8d, 0x9e, 0xcc, 0xe9, 0xc9, 0x24, 0x6b, 0x84,
-		0x7a, 0xf2, 0x97, 0x95, 0x48, 0x4f, 0x0a, 0xb9, 0xc8, 0xf5, 0xe4, 0x27, 0x30, 0x9d, 0xd1, 0x43,
-		0x52, 0x4d, 0xc9, 0xee, 0xdf, 0x1e, 0xd5, 0x24, 0x5a, 0x35, 0xad, 0xa7, 0x72, 0x92, 0x3b, 0x74,
-		0x3c, 0xc9, 0x4d, 0xa8, 0xa5, 0x4a, 0x5a, 0x2d, 0x7d, 0x06, 0x2b, 0xe9, 0x53, 0xa5, 0x7b, 0x2d,
-		0x3d, 0x3c, 0xb0, 0x03, 0x3d, 0x99, 0x97, 0x94, 0x4f, 0x55, 0x4f, 0x9d, 0xb2, 0xc7, 0xad, 0xdd,
-		0x03, 0x3b, 0xb8, 0xcd, 0xf1, 0x37, 0x61, 0xf6, 0x00, 0x1b, 0x7e, 0xb8, 0x87, 0x8d, 0x50, 0xb7,
-		0x70, 0x68, 0xd8, 0x4e, 0xc0, 0x43, 0x8c, 0xf2, 0xe8, 0xdb, 0x4c, 0x0c, 0xb6, 0xcd, 0xa0, 0xf2,
-		0xf7, 0xce, 0xc8, 0xc9, 0xee, 0x9d, 0x37, 0x61, 0x3a, 0xc6, 0xc3, 0xc4, 0x9a, 0x2a, 0xe0, 0x71,
-		0x2d, 0xb6, 0x7a, 0xb6, 0x69, 0xab, 0xfa, 0xe7, 0x0a, 0xbc, 0xc6, 0x76, 0x33, 0x75, 0x92, 0x79,
-		0x7a, 0xb1, 0x7f, 0x5e, 0xb4, 0x6c, 0xc4, 0xee, 0x5a, 0x51, 0xc4, 0xae, 0x0c, 0xd5, 0x80, 0xa1,
-		0xbb, 0xbf, 0xab, 0xc0, 0xeb, 0x72, 0x6c, 0x5c, 0x04, 0x71, 0xff, 0x72, 0xf3, 0x79, 0x1b, 0x27,
-		0xf1, 0xc6, 0xc9, 0x55, 0x97, 0x36, 0x1d, 0x64, 0x24, 0xfd, 0x67, 0x0a, 0xac, 0xf4, 0x63, 0xde,
-		0xc4, 0x40, 0xb6, 0xec, 0xa0, 0x63, 0x84, 0xe6, 0x81, 0xee, 0x78, 0xa6, 0xe1, 0x38, 0xbd, 0xda,
-		0x10, 0x55, 0x98, 0x9f, 0x49, 0x66, 0x2d, 0x5f, 0x4e, 0xa3, 0x1f, 0x14, 0xdf, 0xf5, 0xb6, 0xf9,
-		0x0c, 0x0f, 0xd8, 0x04, 0x4c, 0x8f, 0x2e, 0x1b, 0xc5, 0x23, 0xea, 0xbf, 0x0f, 0xab, 0x65, 0x08,
-		0x04, 0xfa, 0x76, 0x3b, 0xad, 0x6f, 0xc5, 0x21, 0xf7, 0x48, 0x0d, 0x50, 0x5c, 0x11, 0x62, 0x7a,
-		0xed, 0x26, 0x74, 0xef, 0x8f, 0x14, 0xa2, 0x7b, 0x73, 0xcb, 0xbc, 0x67, 0xd8, 0x4e, 0x5f, 0x96,
-		0x06, 0xcc, 0xd5, 0x94, 0xe1, 0x19, 0x50, 0x90, 0x5e, 0x23, 0x7a, 0xac, 0x10, 0x13, 0x8f, 0x04,
-		0xff, 0x44, 0x01, 0x35, 0xaf, 0xed, 0x3e, 0x8e, 0x8e, 0x67, 0x44, 0xf9, 0xd3, 0x2c, 0xe5, 0x57,
-		0x0b, 0x28, 0x2f, 0xc3, 0x34, 0x20, 0xed, 0x4f, 0xc8, 0xe1, 0x94, 0xe0, 0xe2, 0xb2, 0xf9, 0x16,
-		0xcc, 0x98, 0x86, 0x6b, 0xe2, 0xf8, 0x06, 0xc0, 0xec, 0x4e, 0x1b, 0xd3, 0xa6, 0x59, 0xbb, 0x16,
-		0x35, 0x27, 0xcf, 0x7b, 0x12, 0xe7, 0x29, 0xcf, 0xbb, 0x0c, 0xd5, 0x80, 0x4b, 0x7d, 0x23, 0x3e,
-		0xee, 0x05, 0xc8, 0x12, 0xd9, 0x40, 0xc1, 0xc0, 0xd3, 0x48, 0x58, 0x21, 0x9e, 0x63, 0x4b, 0x98,
-		0x08, 0x53, 0x4a, 0xc2, 0xf2, 0x0b, 0xa4, 0xfb, 0xd3, 0xa7, 0x7c, 0x60, 0x09, 0x2b, 0xc3, 0x34,
-		0x20, 0xed, 0x17, 0xc5, 0xe2, 0x10, 0xe3, 0xe2, 0xd4, 0xff, 0xbd, 0x02, 0x17, 0x34, 0xdc, 0xf6,
-		0x0e, 0x31, 0x4b, 0xf3, 0x7f, 0x55, 0x82, 0x74, 0x69, 0xc3, 0xa8, 0x92, 0x31, 0x8c, 0x54, 0x95,
-		0xc8, 0x4a, 0x11, 0xd5, 0x7c, 0x69, 0xff, 0x30, 0x04, 0x17, 0xf9, 0x12, 0xd8, 0xb2, 0x0b, 0x73,
-		0xcc, 0xd2, 0x05, 0x1a, 0x50, 0x4d, 0x9f, 0x41, 0xbe, 0xb8, 0x1b, 0x05, 0xfb, 0x37, 0xc0, 0x84,
-		0xda, 0x54, 0xea, 0xf4, 0xa2, 0x3d, 0x58, 0x8c, 0xd3, 0xf8, 0xc2, 0x42, 0x2d, 0x71, 0x86, 0xf7,
-		0x2e, 0x87, 0xc9, 0x64, 0x78, 0xb1, 0xa8, 0xf9, 0xd8, 0x29, 0xfc, 0x75, 0x78, 0xa3, 0x6c, 0x2d,
-		0x9c, 0xcf, 0xff, 0xa8, 0xc0, 0x72, 0x14, 0x15, 0x12, 0x78, 0xe9, 0xaf, 0x44, 0x7c, 0x2e, 0xc1,
-		0xac, 0x1d, 0xe8, 0xe9, 0xba, 0x29, 0xca, 0xcb, 0x31, 0x6d, 0xda, 0x0e, 0xee, 0x25, 0x2b, 0xa2,
-		0xd4, 0x15, 0x38, 0x27, 0x26, 0x9f, 0xaf, 0xef, 0x97, 0x43, 0x44, 0x83, 0x11, 0x65, 0x9d, 0xce,
-		0x4a, 0xe7, 0x54, 0xeb, 0xab, 0x58, 0xe8, 0x1a, 0x4c, 0xf2, 0xa2, 0x38, 0x6c, 0x25, 0x02, 0xb5,
-		0x71, 0x5b, 0xd3, 0x42, 0x9f, 0xc2, 0x59, 0x33, 0x22, 0x35, 0x31, 0xf5, 0x99, 0x63, 0x4d, 0x8d,
-		0x62, 0x14, 0xfd, 0xb9, 0x1f, 0xc0, 0x4c, 0xa2, 0xd0, 0x8d, 0x39, 0x09, 0xc3, 0x83, 0x3a, 0x09,
-		0xd3, 0x7d, 0x50, 0xda, 0xa0, 0xbe, 0x49, 0x4e, 0xab, 0x94, 0xcb, 0x7c, 0x3f, 0xfe, 0x63, 0x08,
-		0x6a, 0x1a, 0x2f, 0xe2, 0xc4, 0x14, 0x36, 0x78, 0xb6, 0xf9, 0x2a, 0xf7, 0xe0, 0x77, 0x61, 0x3e,
-		0x1d, 0xc9, 0xec, 0xe9, 0x76, 0x88, 0xdb, 0x51, 0xfd, 0x44, 0xb6, 0x52, 0xc0, 0x6a, 0xdb, 0x6e,
-		0x2e, 0x98, 0xd9, 0x6b, 0x86, 0xb8, 0xad, 0x9d, 0x3d, 0xcc, 0xb5, 0x05, 0xe8, 0x03, 0x18, 0xa1,
-		0xbc, 0x0d, 0xf8, 0x96, 0x89, 0x03, 0x1b, 0xdb, 0x46, 0x68, 0xdc, 0x71, 0xbc, 0x3d, 0x8d, 0x0f,
-		0x46, 0x5b, 0x50, 0x75, 0xf1, 0x91, 0xee, 0x77, 0xf9, 0xd6, 0x44, 0x9e, 0x4b, 0x09, 0xf8, 0xa4,
-		0x8b, 0x8f, 0xb4, 0x2e, 0xdb, 0x93, 0x40, 0x5d, 0x86, 0x25, 0x01, 0xab, 0xf9, 0x46, 0xfc, 0x40,
-		0x81, 0x85, 0x9d, 0x9e, 0x6b, 0xee, 0x1c, 0x18, 0xbe, 0xc5, 0xe3, 0x9b, 0x7c, 0x1b, 0x2e, 0x42,
-		0x35, 0xf0, 0xba, 0xbe, 0x89, 0x75, 0x5e, 0xdb, 0xcb, 0xf7, 0x62, 0x8a, 0xb5, 0x6e, 0xb1, 0x46,
-		0xb4, 0x04, 0x63, 0x01, 0x01, 0x8e, 0x2e, 0xb0, 0x61, 0x6d, 0x94, 0x7e, 0x37, 0x2d, 0xd4, 0x80,
-		0x33, 0xd4, 0x59, 0xac, 0x94, 0x7a, 0x70, 0x74, 0x9c, 0xba, 0x04, 0x8b, 0x39, 0x5a, 0x38, 0x9d,
-		0xff, 0x32, 0x0c, 0x67, 0x49, 0x5f, 0x74, 0x11, 0xbe, 0x4a, 0x59, 0xa9, 0xc1, 0x68, 0x14, 0x4f,
-		0x62, 0x47, 0x35, 0xfa, 0x24, 0x27, 0xb9, 0xef, 0xcc, 0xc6, 0x81, 0x82, 0x38, 0xb0, 0x40, 0x78,
-		0x92, 0x8f, 0x22, 0x0d, 0x1f, 0x37, 0x8a, 0x74, 0x1e, 0x20, 0x72, 0xaa, 0x6c, 0x8b, 0x3a, 0xa1,
-		0x15, 0x6d, 0x9c, 0xb7, 0x34, 0xad, 0x9c, 0xab, 0x3e, 0x7a, 0x3c, 0x57, 0xfd, 0x13, 0x9e, 0xbb,
-		0xe9, 0x7b, 0xcd, 0x14, 0xcb, 0x58, 0x29, 0x96, 0x59, 0x02, 0x16, 0xdb, 0xbf, 0x14, 0xd7, 0x15,
-		0x18, 0x8d, 0x5c, 0xee, 0xf1, 0x01, 0x5c, 0xee, 0x68, 0x70, 0x32, 0x5c, 0x00, 0xe9, 0x70, 0xc1,
-		0x2d, 0x98, 0x64, 0x99, 0x25, 0x5e, 0xe3, 0x3b, 0x31, 0x40, 0x8d, 0xef, 0x04, 0x4d, 0x38, 0xf1,
-		0xf2, 0xde, 0x77, 0x81, 0x96, 0xe8, 0xf2, 0x9a, 0x76, 0xdd, 0xb6, 0xb0, 0x1b, 0xda, 0x61, 0x8f,
-		0xc6, 0xf2, 0xc6, 0x35, 0x44, 0xfa, 0x3e, 0xa5, 0x5d, 0x4d, 0xde, 0x83, 0x1e, 0xc1, 0x74, 0x46,
-		0x35, 0xf0, 0xb8, 0xdd, 0xc5, 0x81, 0x94, 0x82, 0x56, 0x4d, 0x2b, 0x04, 0x75, 0x01, 0xe6, 0xd2,
-		0x92, 0xcc, 0x45, 0xfc, 0x4f, 0x15, 0x58, 0x8e, 0xea, 0xd6, 0xbe, 0x22, 0x26, 0x9c, 0xfa, 0x27,
-		0x0a, 0x9c, 0x13, 0xd3, 0xc4, 0xbd, 0x9b, 0xf7, 0x60, 0xa1, 0xcd, 0xda, 0x59, 0x56, 0x45, 0xb7,
-		0x5d, 0xdd, 0x34, 0xcc, 0x03, 0xcc, 0x29, 0x3c, 0xdb, 0x4e, 0x40, 0x35, 0xdd, 0x2d, 0xd2, 0x85,
-		0xae, 0xc3, 0x52, 0x0e, 0xc8, 0x32, 0x42, 0x63, 0xcf, 0x08, 0x30, 0x37, 0x82, 0x17, 0xd2, 0x70,
-		0xdb, 0xbc, 0x57, 0x3d, 0x07, 0xf5, 0x88, 0x1e, 0xce, 0xcf, 0x8f, 0xbd, 0xb8, 0xf0, 0x48, 0xfd,
-		0x83, 0xa1, 0x3e, 0x0b, 0x53, 0xdd, 0x9c, 0xda, 0x75, 0x98, 0x71, 0xbb, 0xed, 0x3d, 0xec, 0xeb,
-		0x5e, 0x4b, 0xa7, 0x5a, 0x2a, 0xa0, 0x74, 0x0e, 0x6b, 0x55, 0xd6, 0xfe, 0xb8, 0x45, 0x95, 0x4f,
-		0x40, 0x98, 0x1d, 0x69, 0xb5, 0x80, 0xc6, 0x0e, 0x86, 0xb5, 0x31, 0xae, 0xd6, 0x02, 0xd4, 0x84,
-		0x49, 0xbe, 0x13, 0x6c, 0xa9, 0xe2, 0x1a, 0xcd, 0x48, 0x1c, 0x58, 0x30, 0x87, 0xae, 0x9c, 0x1a,
-		0x77, 0x13, 0x56, 0xbf, 0x01, 0x5d, 0x81, 0x45, 0x36, 0x8f, 0xe9, 0xb9, 0xa1, 0xef, 0x39, 0x0e,
-		0xf6, 0x29, 0x4f, 0xba, 0xec, 0xa6, 0x18, 0xd7, 0xe6, 0x69, 0xf7, 0x56, 0xdc, 0xcb, 0xf4, 0x22,
-		0x3d, 0x21, 0x96, 0xe5, 0xe3, 0x20, 0xe0, 0x11, 0xc7, 0xe8, 0x53, 0x6d, 0xc0, 0x2c, 0xcb, 0x4b,
-		0x11, 0xb8, 0x48, 0x76, 0x92, 0x4a, 0x5a, 0x49, 0x29, 0x69, 0x75, 0x0e, 0x50, 0x72, 0x3c, 0x17,
-		0xc6, 0xff, 0x52, 0x60, 0x96, 0x59, 0xe7, 0x49, 0x33, 0xb0, 0x18, 0x0d, 0xba, 0xc9, 0x73, 0xb8,
-		0x71, 0xca, 0xba, 0xba, 0x79, 0xa1, 0x80, 0x21, 0x04, 0x23, 0x0d, 0x8b, 0xd1, 0x2c, 0x2e, 0x0d,
-		0x89, 0x25, 0x82, 0xab, 0x95, 0x54, 0x70, 0x75, 0x0b, 0xa6, 0x0f, 0xed, 0xc0, 0xde, 0xb3, 0x1d,
-		0x3b, 0xec, 0x31, 0x4d, 0x54, 0x1e, 0x0f, 0xac, 0xf6, 0x41, 0xa8, 0x1a, 0x5a, 0x83, 0x49, 0x7e,
-		0x85, 0xe9, 0xae, 0xc1, 0x35, 0xee, 0xb8, 0x36, 0xc1, 0xdb, 0x1e, 0x19, 0x6d, 0x4c, 0xb8, 0x90,
-		0x5c, 0x2e, 0xe7, 0xc2, 0x0f, 0x29, 0x17, 0x02, 0x1c, 0x3e, 0xed, 0xe2, 0x2e, 0x1e, 0x80, 0x0b,
-		0xd9, 0x99, 0x86, 0x72, 0x33, 0xa5, 0x19, 0x55, 0x39, 0x26, 0xa3, 0x18, 0x9d, 0x7d, 0x82, 0x38,
-		0x9d, 0x3f, 0x56, 0x60, 0x2e, 0x92, 0xfb, 0xaf, 0x0c, 0xa9, 0x8f, 0x61, 0x3e, 0x43, 0x13, 0x3f,
-		0x85, 0x57, 0x60, 0xb1, 0xe3, 0x7b, 0x26, 0x0e, 0x02, 0xdb, 0xdd, 0xd7, 0xe9, 0x73, 0x1f, 0xa6,
-		0x07, 0xc8, 0x61, 0xac, 0x10, 0x99, 0xef, 0x77, 0x53, 0x48, 0xaa, 0x04, 0x02, 0xf5, 0x7b, 0x0a,
-		0x9c, 0xbf, 0x8f, 0x43, 0xad, 0xff, 0xf8, 0xe7, 0x21, 0x0e, 0x02, 0x63, 0x1f, 0xc7, 0x26, 0xcb,
-		0x2d, 0x18, 0xa1, 0xe9, 0x1b, 0x86, 0x68, 0x62, 0xf3, 0xcd, 0x02, 0x6a, 0x13, 0x28, 0x68, 0x6e,
-		0x47, 0xe3, 0x60, 0x03, 0x30, 0x85, 0xe8, 0x98, 0x95, 0x22, 0x2a, 0xf8, 0x02, 0x5f, 0x40, 0x95,
-		0x71, 0xbd, 0xcd, 0x7b, 0x38, 0x39, 0x9f, 0x14, 0x46, 0x1f, 0xe5, 0x08, 0x1b, 0xf4, 0x6c, 0x46,
-		0xad, 0x2c, 0xd2, 0x38, 0x15, 0x24, 0xdb, 0xea, 0x0e, 0xa0, 0xfc, 0xa0, 0x64, 0x34, 0x71, 0x98,
-		0x45, 0x13, 0xbf, 0x93, 0x8e, 0x26, 0x5e, 0x2a, 0x67, 0x50, 0x4c, 0x4c, 0x22, 0x92, 0xd8, 0x86,
-		0xd5, 0xfb, 0x38, 0xdc, 0x7e, 0xf0, 0x54, 0xb2, 0x17, 0x4d, 0x00, 0x76, 0xa4, 0xdd, 0x96, 0x17,
-		0x31, 0x60, 0x80, 0xe9, 0x88, 0x20, 0x51, 0x35, 0x49, 0x45, 0x8f, 0xfc, 0x0a, 0xd4, 0x97, 0xb0,
-		0x26, 0x99, 0x8e, 0x33, 0x7d, 0x07, 0x66, 0x13, 0xcf, 0xc2, 0x68, 0x2a, 0x31, 0x9a, 0xf6, 0x8d,
-		0xc1, 0xa6, 0xd5, 0x66, 0xfc, 0x74, 0x43, 0xa0, 0xfe, 0xab, 0x02, 0x73, 0x1a, 0x36, 0x3a, 0x1d,
-		0x87, 0xb9, 0x3c, 0xf1, 0xea, 0x16, 0x60, 0x84, 0x87, 0xee, 0xd9, 0x3d, 0xc7, 0xbf, 0xe4, 0xa5,
-		0xfe, 0xe2, 0x4b, 0xba, 0x72, 0x5a, 0x7b, 0xf4, 0x64, 0xce, 0x85, 0xba, 0x08, 0xf3, 0x99, 0xa5,
-		0x71, 0x6d, 0xf2, 0x0b, 0x05, 0x96, 0x35, 0xdc, 0xf2, 0x71, 0x70, 0x10, 0x67, 0x31, 0x08, 0x37,
-		0xbe, 0x82, 0x6b, 0x27, 0x8e, 0xbf, 0x98, 0x54, 0xbe, 0x96, 0xeb, 0xb0, 0xb8, 0xe5, 0x75, 0x5d,
-		0x22, 0x3c, 0x59, 0x01, 0x5d, 0x01, 0x68, 0x79, 0xbe, 0x89, 0xef, 0xe1, 0xd0, 0x3c, 0xe0, 0x21,
-		0xd9, 0x44, 0x8b, 0x6a, 0x40, 0x2d, 0x0f, 0xca, 0x85, 0xed, 0x2e, 0x8c, 0x62, 0x37, 0xa4, 0x99,
-		0x58, 0x26, 0x62, 0x6f, 0x17, 0x88, 0x18, 0xb7, 0x42, 0xb6, 0x1f, 0x3c, 0xa5, 0xb8, 0x78, 0xb6,
-		0x95, 0xc3, 0xaa, 0xbf, 0x18, 0x82, 0x05, 0x0d, 0x1b, 0x96, 0x80, 0xba, 0x4d, 0x38, 0x13, 0xd7,
-		0x36, 0x54, 0x37, 0x57, 0x8a, 0x6c, 0x8b, 0x07, 0x4f, 0xa9, 0xd6, 0xa5, 0x63, 0x65, 0xae, 0x58,
-		0xde, 0x99, 0xab, 0x88, 0x9c, 0xb9, 0x5d, 0xa8, 0xd9, 0x2e, 0x19, 0x61, 0x1f, 0x62, 0x1d, 0xbb,
-		0xb1, 0x06, 0x1b, 0xb0, 0x1e, 0x6c, 0x3e, 0x06, 0xbe, 0xeb, 0x46, 0xaa, 0xa8, 0x69, 0x11, 0xc1,
-		0xe8, 0x10, 0x24, 0x81, 0xfd, 0x39, 0xbb, 0x7c, 0x87, 0xb5, 0x31, 0xd2, 0xb0, 0x63, 0x7f, 0x8e,
-		0xd1, 0x1b, 0x30, 0x4d, 0xab, 0x1a, 0xe8, 0x08, 0x96, 0x7c, 0x1f, 0xa1, 0xc9, 0x77, 0x5a, 0xec,
-		0xf0, 0xc4, 0xd8, 0xc7, 0xac, 0x16, 0xef, 0x6f, 0x87, 0x60, 0x31, 0xc7, 0x2b, 0xbe, 0x1d, 0x27,
-		0x61, 0x96, 0x50, 0x5f, 0x0c, 0x9d, 0x4e, 0x5f, 0xa0, 0xef, 0xc2, 0x42, 0x0e, 0x69, 0x14, 0x04,
-		0x3c, 0xae, 0x02, 0x9c, 0xcb, 0x62, 0xa7, 0x31, 0x40, 0x01, 0xbb, 0xce, 0x88, 0xd8, 0xf5, 0xef,
-		0x0a, 0x2c, 0x3e, 0xe9, 0xfa, 0xfb, 0xf8, 0xeb, 0x2d, 0x5b, 0x6a, 0x1d, 0x6a, 0xf9, 0x65, 0xf2,
-		0xc3, 0xff, 0xe5, 0x10, 0x2c, 0x3e, 0xc4, 0x5f, 0x7b, 0x1e, 0xfc, 0xdf, 0x9c, 0xaf, 0x3b, 0x50,
-		0xcb, 0xf3, 0x8a, 0x9f, 0x2f, 0x01, 0x0e, 0x45, 0x84, 0xe3, 0x0b, 0x05, 0xce, 0x3d, 0xf2, 0x42,
-		0xbb, 0xd5, 0x23, 0xee, 0xb6, 0x77, 0x88, 0xfd, 0x87, 0x06, 0xf1, 0xa5, 0x63, 0xae, 0x7f, 0x17,
-		0x16, 0x5a, 0xbc, 0x47, 0x6f, 0xd3, 0x2e, 0x3d, 0x65, 0xb0, 0x15, 0x9d, 0x8f, 0x34, 0x3a, 0x66,
-		0xb3, 0xcd, 0xb5, 0xf2, 0x8d, 0x81, 0x7a, 0x01, 0xce, 0x17, 0x50, 0xc0, 0x85, 0xc2, 0x80, 0xe5,
-		0xfb, 0x38, 0xdc, 0xf2, 0xbd, 0x20, 0xe0, 0xbb, 0x92, 0xba, 0xdc, 0x52, 0x8e, 0x9f, 0x92, 0x71,
-		0xfc, 0x2e, 0x42, 0x35, 0x34, 0xfc, 0x7d, 0x1c, 0xc6, 0xbb, 0xcc, 0xae, 0xb9, 0x29, 0xd6, 0xca,
-		0xf1, 0xa9, 0xbf, 0xaa, 0xc0, 0x39, 0xf1, 0x1c, 0x9c, 0x9f, 0x6d, 0x82, 0x87, 0xa8, 0x86, 0xbd,
-		0x1e, 0x73, 0x43, 0xf9, 0xf2, 0xef, 0xcb, 0x0c, 0xc4, 0x42, 0x74, 0xd4, 0xf8, 0x0e, 0xee, 0xf4,
-		0xa8, 0x01, 0xc8, 0x6e, 0x98, 0xc9, 0x30, 0xd1, 0x84, 0xbe, 0x50, 0x60, 0xbe, 0x45, 0x33, 0x5e,
-		0xba, 0x69, 0x74, 0x03, 0xdc, 0x9f, 0x96, 0xe9, 0xbb, 0x87, 0x27, 0x9b, 0x96, 0x25, 0xd1, 0xb6,
-		0x08, 0xc6, 0xd4, 0xe4, 0xa8, 0x95, 0xeb, 0xa8, 0x77, 0x60, 0x36, 0x47, 0xa5, 0xc0, 0x3c, 0xbd,
-		0x9b, 0x36, 0x4f, 0x37, 0x0a, 0xc4, 0x21, 0x4b, 0x13, 0xdf, 0xbc, 0xa4, 0x8d, 0x5a, 0xef, 0xc0,
-		0x62, 0x01, 0x81, 0x82, 0x79, 0x6f, 0x25, 0xe7, 0xad, 0x16, 0x86, 0x7b, 0xef, 0xe3, 0xb0, 0x9f,
-		0x3d, 0xa4, 0x78, 0x93, 0x56, 0xf1, 0x7f, 0x2a, 0xb0, 0xce, 0xf3, 0x75, 0x39, 0xa6, 0xe5, 0x12,
-		0x0d, 0x12, 0xcf, 0x6c, 0x30, 0x29, 0x43, 0xcf, 0x98, 0x10, 0xc5, 0x85, 0x15, 0x51, 0xac, 0x7a,
-		0x70, 0xa6, 0xf1, 0x72, 0x8a, 0xa9, 0x30, 0xf1, 0x15, 0xa0, 0xd7, 0x61, 0xaa, 0x45, 0x0c, 0xa0,
-		0x47, 0x98, 0xd9, 0x52, 0x3c, 0xbf, 0x94, 0x6e, 0x54, 0x7d, 0x78, 0x6b, 0x80, 0xb5, 0xc6, 0xe6,
-		0xd2, 0x70, 0x64, 0x8f, 0x9f, 0x6c, 0x5b, 0x29, 0xb4, 0xfa, 0x01, 0x7d, 0x11, 0x16, 0x1d, 0x6c,
-		0x7a, 0x49, 0x0e, 0x10, 0x1b, 0x53, 0x43, 0xfa, 0xa4, 0x2a, 0x0d, 0x16, 0x1b, 0x0e, 0xf3, 0xfd,
-		0xbc, 0x4a, 0x14, 0x88, 0xe9, 0xf2, 0x42, 0xa9, 0x61, 0xad, 0x9f, 0x74, 0xd9, 0x61, 0x51, 0x98,
-		0xae, 0x4b, 0xe3, 0xe2, 0xd1, 0x9b, 0x45, 0x1e, 0x42, 0x62, 0xf1, 0xa1, 0x29, 0xde, 0xca, 0x22,
-		0x48, 0x9b, 0xff, 0xfc, 0x0e, 0x00, 0xb7, 0xfe, 0x6e, 0x3f, 0x69, 0xa2, 0xef, 0x2b, 0xb0, 0x20,
-		0x7e, 0x7b, 0x8d, 0xae, 0x14, 0x1e, 0x3f, 0xe9, 0xeb, 0xef, 0xfa, 0xd5, 0x63, 0xc3, 0xf1, 0x55,
-		0xff, 0xb1, 0x02, 0x8b, 0x05, 0xaf, 0xda, 0x91, 0x04, 0xa9, 0xf4, 0x9d, 0x7f, 0xfd, 0xda, 0xf1,
-		0x01, 0x39, 0x39, 0x3f, 0x57, 0x60, 0xb5, 0xec, 0x81, 0x3a, 0xfa, 0x4e, 0x19, 0xfa, 0xb2, 0xc7,
-		0xf2, 0xf5, 0xdb, 0xa7, 0xc0, 0xc0, 0x29, 0x25, 0x9b, 0x28, 0x7e, 0x7a, 0x2e, 0xd9, 0x44, 0xe9,
-		0x93, 0x77, 0xc9, 0x26, 0x96, 0xbc, 0x71, 0xff, 0x33, 0x05, 0xea, 0xc5, 0x0f, 0xb4, 0x51, 0x71,
-		0x7d, 0x55, 0xe9, 0xc3, 0xf5, 0xfa, 0x87, 0x27, 0x82, 0xe5, 0x74, 0xfd, 0x58, 0x81, 0xa5, 0xc2,
-		0xe7, 0xd7, 0xe8, 0x7a, 0x21, 0xea, 0xb2, 0xd7, 0xdf, 0xf5, 0x1b, 0x27, 0x01, 0xe5, 0x44, 0xb9,
-		0x30, 0x95, 0x7a, 0x97, 0x8b, 0xde, 0x29, 0x44, 0x26, 0x7a, 0xfe, 0x5b, 0x6f, 0x0c, 0x3a, 0x9c,
-		0xcf, 0xf7, 0x85, 0x02, 0x67, 0x05, 0x8f, 0x5b, 0xd1, 0x7b, 0xf2, 0xdd, 0x16, 0x3e, 0xa7, 0xad,
-		0xbf, 0x7f, 0x3c, 0x20, 0x4e, 0x42, 0x08, 0xd3, 0x99, 0x87, 0xa4, 0x68, 0x43, 0x76, 0xcf, 0x0b,
-		0x52, 0x0e, 0xf5, 0x77, 0x07, 0x07, 0xe0, 0xb3, 0x1e, 0xc1, 0x4c, 0xf6, 0xc1, 0x14, 0x2a, 0xc6,
-		0x52, 0xf0, 0xa4, 0xac, 0x7e, 0xf9, 0x18, 0x10, 0x09, 0xb1, 0x2b, 0xac, 0x1c, 0x94, 0x88, 0x5d,
-		0xd9, 0xa3, 0x8d, 0xfa, 0x29, 0x0a, 0x15, 0xd1, 0x5f, 0x2a, 0x70, 0x4e, 0x56, 0x58, 0x88, 0x6e,
-		0x9e, 0xb0, 0x1e, 0x91, 0x91, 0xf6, 0xd1, 0xa9, 0xaa, 0x19, 0x39, 0xcb, 0x0a, 0xaa, 0xef, 0xa4,
-		0x2c, 0x93, 0xd7, 0xfe, 0x49, 0x59, 0x56, 0x52, 0xec, 0x97, 0xd8, 0x47, 0x41, 0x69, 0x73, 0xe9,
-		0x3e, 0x16, 0x17, 0x95, 0x97, 0xee, 0xa3, 0xac, 0x92, 0x3a, 0xb1, 0x8f, 0xc2, 0x02, 0xb8, 0xf2,
-		0x7d, 0x94, 0x15, 0xe1, 0x95, 0xef, 0xa3, 0xb4, 0xea, 0x2e, 0xb9, 0x8f, 0xf9, 0x1a, 0xb7, 0xf2,
-		0x7d, 0x2c, 0xac, 0xb0, 0x2b, 0xdf, 0xc7, 0xe2, 0x92, 0x3a, 0xf4, 0x17, 0x34, 0x88, 0x58, 0x58,
-		0xbc, 0x86, 0x3e, 0x3c, 0xd6, 0x9a, 0xd3, 0xe5, 0x73, 0xf5, 0x9b, 0x27, 0x03, 0x4e, 0x91, 0x56,
-		0x58, 0xb9, 0x29, 0x25, 0xad, 0xac, 0x76, 0x54, 0x4a, 0x5a, 0x79, 0xb1, 0xe8, 0x5f, 0x29, 0xb0,
-		0x22, 0x2f, 0xd9, 0x42, 0xdf, 0x96, 0x4c, 0x30, 0x40, 0xdd, 0x5a, 0xfd, 0xd6, 0x89, 0xe1, 0x39,
-		0x8d, 0x3f, 0x54, 0xa0, 0x56, 0x54, 0xb8, 0x87, 0xae, 0x49, 0xb0, 0x4b, 0x2b, 0x14, 0xeb, 0xd7,
-		0x4f, 0x00, 0xc9, 0x29, 0xfa, 0x9e, 0x02, 0x73, 0xa2, 0xf2, 0x2f, 0x54, 0x7c, 0x73, 0x4a, 0x8a,
-		0xdd, 0xea, 0x1f, 0x1c, 0x13, 0x8a, 0x53, 0xf1, 0x53, 0xfa, 0x1f, 0x49, 0x92, 0xea, 0x27, 0xf4,
-		0x51, 0x89, 0x6c, 0xc8, 0x6b, 0xd3, 0xea, 0xdf, 0x3e, 0x29, 0x38, 0x27, 0xf0, 0x73, 0x98, 0xcd,
-		0x15, 0x02, 0xa1, 0xcb, 0x12, 0xa4, 0xe2, 0xfa, 0xac, 0xfa, 0xe6, 0x71, 0x40, 0xfa, 0xd6, 0x48,
-		0xa6, 0xb4, 0x47, 0x62, 0x8d, 0x88, 0x0b, 0x92, 0x24, 0xd6, 0x48, 0x41, 0xd5, 0x10, 0x7a, 0x0e,
-		0x93, 0xc9, 0x52, 0x0b, 0xf4, 0x2d, 0x29, 0x86, 0x4c, 0x6d, 0x51, 0xfd, 0x9d, 0x01, 0x47, 0x27,
-		0xa4, 0x50, 0x54, 0x2b, 0x21, 0x91, 0x42, 0x49, 0xb9, 0x87, 0x44, 0x0a, 0xa5, 0x05, 0x19, 0xc4,
-		0xf2, 0x14, 0x94, 0x40, 0x48, 0x2c, 0xcf, 0xe2, 0x7a, 0x8a, 0xfa, 0xfb, 0xc7, 0x03, 0x8a, 0x1f,
-		0x7d, 0x40, 0xbf, 0xa2, 0x00, 0x5d, 0x2a, 0xc4, 0x91, 0x2b, 0x53, 0xa8, 0xbf, 0x3d, 0xd0, 0xd8,
-		0xfe, 0x34, 0xfd, 0x94, 0xbd, 0x64, 0x9a, 0x5c, 0x19, 0x83, 0x64, 0x9a, 0x7c, 0x0d, 0x00, 0x9b,
-		0x26, 0xca, 0xb8, 0x4b, 0xa7, 0xc9, 0xd4, 0x09, 0x48, 0xa7, 0xc9, 0xa6, 0xf0, 0x89, 0x87, 0x92,
-		0xca, 0x96, 0x4b, 0x3c, 0x14, 0x51, 0xa6, 0x5f, 0xe2, 0xa1, 0x88, 0x93, 0xf0, 0xdf, 0x67, 0x7f,
-		0xaf, 0x23, 0xc8, 0xa8, 0x4a, 0x5c, 0x59, 0x69, 0xf6, 0x5d, 0xe2, 0xca, 0x96, 0xe4, 0xcb, 0x89,
-		0x01, 0x53, 0x98, 0xe0, 0x95, 0x18, 0x30, 0x65, 0x39, 0x68, 0x89, 0x01, 0x53, 0x9e, 0x4f, 0x76,
-		0x61, 0x2a, 0x95, 0x1e, 0x95, 0x6c, 0x88, 0x28, 0x43, 0x2c, 0xd9, 0x10, 0x61, 0xd6, 0x95, 0xaa,
-		0x0f, 0x51, 0x2a, 0x13, 0xc9, 0xdc, 0xbf, 0xc2, 0x24, 0xad, 0x44, 0x7d, 0xc8, 0xf2, 0xa5, 0xc4,
-		0x7f, 0xcb, 0x26, 0x3d, 0x25, 0xfe, 0x5b, 0x41, 0x6a, 0x55, 0xe2, 0xbf, 0x15, 0x66, 0x54, 0x43,
-		0x98, 0xce, 0x64, 0xf7, 0x24, 0x17, 0x84, 0x38, 0x67, 0x2a, 0xb9, 0x20, 0x8a, 0x12, 0x87, 0xc4,
-		0x5d, 0xcd, 0x64, 0x8f, 0x64, 0xee, 0xaa, 0x38, 0x9f, 0x26, 0x73, 0x57, 0x0b, 0x52, 0x53, 0x64,
-		0xe2, 0x6c, 0xb6, 0x45, 0x32, 0x71, 0x41, 0x12, 0x4b, 0x32, 0x71, 0x61, 0x2a, 0xe7, 0x8f, 0x14,
-		0x98, 0x17, 0x26, 0x48, 0x50, 0xb1, 0xc4, 0xc8, 0x52, 0x3a, 0xf5, 0x2b, 0xc7, 0x05, 0x4b, 0xc8,
-		0xbb, 0x28, 0xbd, 0x20, 0x91, 0x77, 0x49, 0xde, 0x46, 0x22, 0xef, 0xd2, 0x4c, 0xcc, 0x97, 0x4a,
-		0xfc, 0x3e, 0xa8, 0x38, 0x8e, 0x8d, 0x6e, 0x97, 0xf9, 0x1b, 0xa5, 0xf1, 0xfe, 0xfa, 0x9d, 0xd3,
-		0xa0, 0x48, 0x85, 0x74, 0x92, 0x81, 0x6c, 0x79, 0x48, 0x47, 0x10, 0x29, 0x97, 0x87, 0x74, 0x44,
-		0x31, 0xf2, 0x3b, 0xd7, 0x7f, 0xfb, 0xea, 0xbe, 0x1d, 0x1e, 0x74, 0xf7, 0x1a, 0xa6, 0xd7, 0xde,
-		0x48, 0xfd, 0x83, 0x72, 0x63, 0x1f, 0xbb, 0xec, 0xcf, 0xb2, 0x13, 0xff, 0xd6, 0xfd, 0x21, 0xff,
-		0x79, 0x78, 0x79, 0x6f, 0x84, 0xf6, 0xbd, 0xf7, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0xd0,
-		0xca, 0x61, 0xd9, 0x5b, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x5d, 0x6f, 0x1c, 0x47,
+		0x72, 0x99, 0x5d, 0xf1, 0xab, 0x48, 0x2e, 0xc9, 0x16, 0x3f, 0x96, 0x43, 0xf1, 0x6b, 0x24, 0xd9,
+		0xb4, 0x74, 0x5e, 0x49, 0xb4, 0xf5, 0x61, 0x9d, 0x6c, 0x1d, 0x45, 0x52, 0xd2, 0x1a, 0xd4, 0xd7,
+		0x90, 0x92, 0xe3, 0x20, 0xe7, 0xb9, 0xe1, 0x6e, 0x2f, 0x39, 0xd1, 0xee, 0xcc, 0x7a, 0x66, 0x96,
+		0x12, 0x8d, 0x20, 0x70, 0x70, 0xc1, 0x01, 0x39, 0x04, 0xb9, 0xc4, 0xb8, 0x04, 0x01, 0x02, 0x04,
+		0x48, 0x2e, 0xc0, 0xe1, 0x8c, 0xbc, 0x25, 0x0f, 0x01, 0xf2, 0xf1, 0x90, 0xbc, 0x04, 0xf9, 0x01,
+		0x01, 0xf2, 0x74, 0x09, 0x70, 0x79, 0x48, 0x80, 0x3c, 0xdd, 0xbd, 0x27, 0xe8, 0xaf, 0xd9, 0x99,
+		0x9d, 0x9e, 0xd9, 0xd9, 0xa5, 0x03, 0x7f, 0xc4, 0x6f, 0xdc, 0xee, 0xaa, 0xea, 0xea, 0xea, 0xea,
+		0xea, 0xaa, 0xea, 0xea, 0x21, 0x9c, 0x6f, 0xed, 0x63, 0xf7, 0x52, 0xc5, 0xac, 0x62, 0xbb, 0x82,
+		0x2f, 0x1d, 0x5a, 0x9e, 0xef, 0xb8, 0xc7, 0x97, 0x8e, 0xae, 0x5c, 0xf2, 0xb0, 0x7b, 0x64, 0x55,
+		0x70, 0xa9, 0xe9, 0x3a, 0xbe, 0x83, 0xe6, 0x08, 0x58, 0x89, 0x83, 0x95, 0x38, 0x58, 0xe9, 0xe8,
+		0x8a, 0xba, 0x74, 0xe0, 0x38, 0x07, 0x75, 0x7c, 0x89, 0x82, 0xed, 0xb7, 0x6a, 0x97, 0xaa, 0x2d,
+		0xd7, 0xf4, 0x2d, 0xc7, 0x66, 0x88, 0xea, 0x72, 0x67, 0xbf, 0x6f, 0x35, 0xb0, 0xe7, 0x9b, 0x8d,
+		0x26, 0x07, 0x88, 0x11, 0x78, 0xe1, 0x9a, 0xcd, 0x26, 0x76, 0x3d, 0xde, 0xbf, 0x12, 0x61, 0xd0,
+		0x6c, 0x5a, 0x84, 0xb9, 0x8a, 0xd3, 0x68, 0x04, 0x43, 0xac, 0xca, 0x20, 0x04, 0x8b, 0x9c, 0x0b,
+		0x19, 0xc8, 0x87, 0x2d, 0x1c, 0x00, 0x68, 0x32, 0x00, 0xdf, 0xf4, 0x9e, 0xd7, 0x2d, 0xcf, 0x4f,
+		0x83, 0x79, 0xe1, 0xb8, 0xcf, 0x6b, 0x75, 0xe7, 0x05, 0x87, 0xb9, 0x20, 0x83, 0xe1, 0xa2, 0x34,
+		0x3a, 0x60, 0xd7, 0xba, 0xc1, 0x62, 0x97, 0x43, 0x9e, 0x8d, 0x42, 0x56, 0x1b, 0x96, 0x4d, 0xa5,
+		0x50, 0x6f, 0x79, 0x7e, 0x37, 0xa0, 0xa8, 0x20, 0x56, 0xe5, 0x40, 0x1f, 0xb6, 0x70, 0x8b, 0x2f,
+		0xb5, 0xfa, 0xaa, 0x1c, 0xc4, 0xc5, 0xcd, 0xba, 0x55, 0x09, 0x2f, 0xed, 0xb9, 0x08, 0xa0, 0x77,
+		0x68, 0xba, 0xb8, 0x1a, 0x1f, 0xf1, 0x7c, 0x02, 0x54, 0x54, 0x18, 0xda, 0xbf, 0x0d, 0xc2, 0xe2,
+		0xae, 0x6f, 0xba, 0xfe, 0x7b, 0xbc, 0x7d, 0xfb, 0x25, 0xae, 0xb4, 0xc8, 0x68, 0x3a, 0xfe, 0xb0,
+		0x85, 0x3d, 0x1f, 0xed, 0xc0, 0x90, 0xcb, 0xfe, 0x2c, 0x2a, 0x2b, 0xca, 0xda, 0xe8, 0xfa, 0x7a,
+		0x29, 0xa2, 0x94, 0x66, 0xd3, 0x2a, 0x1d, 0x5d, 0x29, 0xa5, 0x12, 0xd1, 0x05, 0x09, 0xb4, 0x00,
+		0x23, 0x55, 0xa7, 0x61, 0x5a, 0xb6, 0x61, 0x55, 0x8b, 0xb9, 0x15, 0x65, 0x6d, 0x44, 0x1f, 0x66,
+		0x0d, 0xe5, 0x2a, 0xfa, 0x55, 0x98, 0x69, 0x9a, 0x2e, 0xb6, 0x7d, 0x03, 0x0b, 0x02, 0x86, 0x65,
+		0xd7, 0x9c, 0x62, 0x9e, 0x0e, 0xbc, 0x26, 0x1d, 0xf8, 0x31, 0xc5, 0x08, 0x46, 0x2c, 0xdb, 0x35,
+		0x47, 0x3f, 0xdd, 0x8c, 0x37, 0xa2, 0x22, 0x0c, 0x99, 0xbe, 0x8f, 0x1b, 0x4d, 0xbf, 0x78, 0x6a,
+		0x45, 0x59, 0x1b, 0xd0, 0xc5, 0x4f, 0xb4, 0x09, 0x13, 0xf8, 0x65, 0xd3, 0x62, 0x1b, 0xc8, 0x20,
+		0x3b, 0xa5, 0x38, 0x40, 0x47, 0x54, 0x4b, 0x6c, 0x97, 0x94, 0xc4, 0x2e, 0x29, 0xed, 0x89, 0x6d,
+		0xa4, 0x17, 0xda, 0x28, 0xa4, 0x11, 0xd5, 0x60, 0xbe, 0xe2, 0xd8, 0xbe, 0x65, 0xb7, 0xb0, 0x61,
+		0x7a, 0x86, 0x8d, 0x5f, 0x18, 0x96, 0x6d, 0xf9, 0x96, 0xe9, 0x3b, 0x6e, 0x71, 0x70, 0x45, 0x59,
+		0x2b, 0xac, 0x5f, 0x94, 0x4e, 0x60, 0x93, 0x63, 0x6d, 0x78, 0x0f, 0xf1, 0x8b, 0xb2, 0x40, 0xd1,
+		0x67, 0x2b, 0xd2, 0x76, 0x54, 0x86, 0x29, 0xd1, 0x53, 0x35, 0x6a, 0xa6, 0x55, 0x6f, 0xb9, 0xb8,
+		0x38, 0x44, 0xd9, 0x3d, 0x23, 0xa5, 0x7f, 0x97, 0xc1, 0xe8, 0x93, 0x01, 0x1a, 0x6f, 0x41, 0x3a,
+		0xcc, 0xd6, 0x4d, 0xcf, 0x37, 0x2a, 0x4e, 0xa3, 0x59, 0xc7, 0x74, 0xf2, 0x2e, 0xf6, 0x5a, 0x75,
+		0xbf, 0x38, 0x9c, 0x42, 0xef, 0xb1, 0x79, 0x5c, 0x77, 0xcc, 0xaa, 0x3e, 0x4d, 0x70, 0x37, 0x03,
+		0x54, 0x9d, 0x62, 0xa2, 0x5f, 0x86, 0x85, 0x9a, 0xe5, 0x7a, 0xbe, 0x51, 0xc5, 0x15, 0xcb, 0xa3,
+		0xf2, 0x34, 0xbd, 0xe7, 0xc6, 0xbe, 0x59, 0x79, 0xee, 0xd4, 0x6a, 0xc5, 0x11, 0x4a, 0x78, 0x3e,
+		0x26, 0xd7, 0x2d, 0x6e, 0xbe, 0xf4, 0x22, 0xc5, 0xde, 0xe2, 0xc8, 0x7b, 0xa6, 0xf7, 0xfc, 0x0e,
+		0x43, 0x45, 0xf7, 0x01, 0x59, 0xb6, 0x59, 0xf1, 0xad, 0x23, 0xcb, 0x3f, 0xa6, 0xab, 0xe4, 0xb4,
+		0xfc, 0x22, 0x74, 0x23, 0x38, 0xd5, 0x46, 0xda, 0x63, 0x38, 0xa8, 0x0e, 0xf3, 0x71, 0x4a, 0x46,
+		0xd3, 0xa9, 0x5b, 0x95, 0xe3, 0xe2, 0x28, 0x5d, 0xaa, 0xcb, 0xa5, 0x04, 0xcb, 0x5b, 0x2a, 0x77,
+		0x92, 0x7b, 0x4c, 0xf1, 0xf4, 0x39, 0x4b, 0xde, 0xa1, 0x5d, 0x87, 0xa5, 0xa4, 0xcd, 0xe1, 0x35,
+		0x1d, 0xdb, 0xc3, 0x68, 0x06, 0x06, 0xdd, 0x16, 0xdd, 0x11, 0x0a, 0xdd, 0x11, 0x03, 0x6e, 0xcb,
+		0x2e, 0x57, 0xb5, 0x3f, 0xcf, 0xc1, 0xd2, 0xae, 0x75, 0x60, 0x9b, 0xf5, 0xc4, 0xcd, 0xf9, 0xa0,
+		0x73, 0x73, 0xbe, 0x21, 0xdf, 0x9c, 0xa9, 0x54, 0x32, 0xee, 0xce, 0x1a, 0x2c, 0xe0, 0x97, 0x3e,
+		0x76, 0x6d, 0xb3, 0x1e, 0x98, 0xd4, 0xf6, 0x46, 0xe5, 0x7b, 0xf4, 0x15, 0xe9, 0xf8, 0xf1, 0x91,
+		0xe7, 0x05, 0xa9, 0x58, 0x17, 0x2a, 0xc1, 0xe9, 0xca, 0xa1, 0x55, 0xaf, 0xb6, 0x07, 0x71, 0xec,
+		0xfa, 0x31, 0xdd, 0xb3, 0xc3, 0xfa, 0x14, 0xed, 0x12, 0x48, 0x8f, 0xec, 0xfa, 0xb1, 0xb6, 0x0a,
+		0xcb, 0x89, 0xf3, 0x63, 0x02, 0xd6, 0xfe, 0x25, 0x07, 0xaf, 0x72, 0x18, 0xcb, 0x3f, 0x4c, 0xb7,
+		0x77, 0xcf, 0x3a, 0x45, 0x7a, 0x2b, 0x4d, 0xa4, 0xdd, 0xc8, 0x65, 0x94, 0xad, 0x5c, 0xb7, 0xf3,
+		0x9f, 0xb5, 0x6e, 0x9f, 0xfa, 0xac, 0x75, 0x7b, 0x03, 0xd6, 0xba, 0x0b, 0x22, 0x5d, 0xcb, 0xff,
+		0x21, 0x07, 0x8b, 0x3a, 0xf6, 0xf0, 0x89, 0x4f, 0xa0, 0x54, 0x22, 0x19, 0xd7, 0xe1, 0x3d, 0x40,
+		0x2e, 0x21, 0x63, 0x30, 0x0d, 0xe4, 0x62, 0xcb, 0x53, 0xb1, 0xbd, 0x96, 0x28, 0x36, 0x3a, 0xf2,
+		0x26, 0xc1, 0xe0, 0xf2, 0x9a, 0x74, 0x3b, 0x5a, 0xd0, 0xb7, 0x61, 0x9a, 0x11, 0x76, 0xb1, 0xd9,
+		0x6c, 0xd6, 0x8f, 0xc3, 0x2b, 0x32, 0xba, 0x7e, 0x31, 0x9d, 0xb4, 0xce, 0x70, 0x38, 0x71, 0xe4,
+		0xc6, 0xda, 0x88, 0x8d, 0x49, 0x9a, 0x7e, 0xba, 0xf4, 0x3f, 0xcd, 0xc1, 0xea, 0x1e, 0x76, 0x1b,
+		0x96, 0x6d, 0xfa, 0x38, 0x71, 0x05, 0x1e, 0x77, 0xae, 0xc0, 0x35, 0xe9, 0x0a, 0x74, 0x25, 0xf4,
+		0x25, 0xb7, 0x34, 0xe7, 0x40, 0x4b, 0x9b, 0x22, 0x37, 0x36, 0xbf, 0xa7, 0xc0, 0xca, 0x16, 0xf6,
+		0x2a, 0xae, 0xb5, 0x9f, 0x2c, 0xd1, 0x47, 0x9d, 0x12, 0xbd, 0x2a, 0x9d, 0x4e, 0x37, 0x3a, 0xd9,
+		0x04, 0xaa, 0x7d, 0x32, 0x00, 0xab, 0x29, 0xa4, 0xb8, 0x8a, 0xd4, 0x61, 0xae, 0xed, 0x77, 0x55,
+		0x1c, 0xbb, 0x66, 0x1d, 0x70, 0x43, 0x93, 0x7a, 0xb8, 0xc4, 0x08, 0x6e, 0x86, 0x51, 0xf5, 0x59,
+		0x2c, 0x6d, 0x47, 0xfb, 0x30, 0x17, 0x5f, 0x5b, 0xe6, 0xee, 0xe5, 0xe8, 0x68, 0x17, 0xb2, 0x8d,
+		0x46, 0x1d, 0xbe, 0x99, 0x17, 0xb2, 0x66, 0xb2, 0x9d, 0x9b, 0xd8, 0xae, 0x5a, 0xf6, 0x81, 0xc1,
+		0xed, 0x97, 0x85, 0xbd, 0x62, 0x7e, 0x25, 0x9f, 0xec, 0x4d, 0x32, 0xf0, 0x0d, 0x6e, 0xed, 0x28,
+		0xf1, 0xa9, 0x66, 0xa4, 0xd1, 0xc2, 0x1e, 0x7a, 0x1f, 0x26, 0x05, 0x61, 0xaa, 0x26, 0x2e, 0xb6,
+		0x8b, 0xa7, 0x28, 0xd9, 0x52, 0x1a, 0x59, 0x6a, 0x11, 0xa2, 0x9c, 0x4f, 0x34, 0x43, 0x5d, 0x2e,
+		0xb6, 0xd1, 0x6e, 0x9b, 0xb4, 0x70, 0xa1, 0xb8, 0x37, 0x9a, 0xca, 0xb1, 0xf0, 0x98, 0x22, 0x44,
+		0x45, 0x23, 0xd1, 0x0e, 0xcb, 0x33, 0x9a, 0x66, 0xcb, 0xc3, 0x55, 0xea, 0x8c, 0x0e, 0xeb, 0xc3,
+		0x96, 0xf7, 0x98, 0xfe, 0x46, 0x2d, 0x58, 0xec, 0x90, 0xd2, 0xb1, 0xc1, 0x5d, 0x63, 0xa3, 0xee,
+		0x1c, 0x78, 0xc5, 0xa1, 0x95, 0x7c, 0xdc, 0xea, 0x86, 0x8c, 0x54, 0x87, 0xd0, 0x36, 0x18, 0xee,
+		0x8e, 0x73, 0xa0, 0xab, 0xcd, 0xa4, 0x2e, 0x4f, 0xfb, 0x9e, 0x02, 0xf3, 0x89, 0x98, 0x68, 0x19,
+		0x46, 0x03, 0x66, 0x02, 0xa3, 0x05, 0xa2, 0x89, 0x1e, 0x99, 0xc3, 0x9c, 0x49, 0xaf, 0x98, 0xa3,
+		0x0c, 0x7e, 0x23, 0x91, 0xc1, 0x0e, 0xfa, 0x54, 0x46, 0x01, 0xb6, 0xf6, 0xcf, 0x79, 0x38, 0x2d,
+		0x81, 0x08, 0x07, 0x0c, 0x4a, 0x34, 0x60, 0x78, 0x1b, 0xc6, 0x3c, 0x72, 0xd8, 0xe1, 0x2a, 0x8b,
+		0x16, 0x72, 0x5d, 0xa3, 0x85, 0x51, 0x0e, 0x4f, 0x5a, 0xd0, 0x6d, 0x18, 0xaf, 0x59, 0xb6, 0xe5,
+		0x1d, 0x0a, 0xfc, 0x7c, 0x57, 0xfc, 0x31, 0x81, 0x40, 0x09, 0xa8, 0x30, 0x6c, 0x55, 0xb1, 0xed,
+		0x5b, 0x3e, 0xb3, 0x56, 0x23, 0x7a, 0xf0, 0x1b, 0x5d, 0x83, 0x21, 0x11, 0x15, 0x0c, 0x64, 0x88,
+		0x0a, 0x04, 0x30, 0x89, 0x2b, 0x0e, 0xb1, 0xe9, 0xfa, 0xfb, 0xd8, 0x24, 0xce, 0xbb, 0x6f, 0x5a,
+		0x75, 0xaf, 0x38, 0x98, 0x42, 0x41, 0xc4, 0x01, 0x93, 0x01, 0xda, 0x16, 0xc3, 0x42, 0x37, 0x61,
+		0x9e, 0x53, 0x15, 0x84, 0x0c, 0xdf, 0x6d, 0xd9, 0x15, 0xd3, 0xc7, 0x55, 0x1a, 0xaa, 0x0c, 0xeb,
+		0x73, 0x1c, 0x80, 0xa3, 0xec, 0x89, 0x6e, 0xf4, 0x0e, 0x2c, 0xc4, 0xd8, 0x08, 0x61, 0x0f, 0x53,
+		0xec, 0xf9, 0xce, 0x21, 0x03, 0x7c, 0xed, 0x25, 0x4c, 0x3f, 0x21, 0x09, 0x06, 0x61, 0x27, 0x84,
+		0xc1, 0xdd, 0xec, 0x34, 0xb8, 0xaf, 0x49, 0x27, 0x25, 0xc3, 0xcd, 0x68, 0x64, 0x7f, 0xa4, 0xc0,
+		0x4c, 0x07, 0x3a, 0x37, 0xac, 0xb7, 0x61, 0x8c, 0x26, 0x3d, 0x44, 0x74, 0xa5, 0x64, 0x90, 0xea,
+		0x28, 0xc5, 0xe0, 0x41, 0x55, 0x19, 0x0a, 0x82, 0xc0, 0xaf, 0xe1, 0x8a, 0x8f, 0xd9, 0xe0, 0xa3,
+		0xeb, 0x5a, 0xf2, 0x1c, 0x74, 0x0e, 0xa9, 0x8f, 0x7f, 0x18, 0xfe, 0xa9, 0xfd, 0x96, 0x02, 0x2a,
+		0x75, 0x15, 0x76, 0x7d, 0xab, 0xf2, 0xfc, 0x98, 0x04, 0x58, 0x3b, 0x96, 0xe7, 0x0b, 0x31, 0x95,
+		0x3b, 0xc5, 0x74, 0x29, 0xd9, 0xd7, 0x92, 0x52, 0xc8, 0x28, 0xac, 0x45, 0x58, 0x90, 0xd2, 0xe0,
+		0x67, 0xe8, 0xcf, 0x15, 0x98, 0xbd, 0x87, 0xfd, 0x07, 0x2d, 0xdf, 0xdc, 0xaf, 0xe3, 0x5d, 0xdf,
+		0xf4, 0xb1, 0x2e, 0x23, 0xab, 0x74, 0x78, 0x0e, 0x4f, 0x01, 0x49, 0x1c, 0x86, 0x5c, 0x4f, 0x0e,
+		0xc3, 0x54, 0xec, 0x2c, 0x41, 0x6f, 0xc0, 0x2c, 0x7e, 0xd9, 0xa4, 0x02, 0x34, 0x6c, 0xfc, 0xd2,
+		0x37, 0xf0, 0x11, 0xb6, 0x7d, 0xc2, 0x00, 0xd9, 0xb9, 0x79, 0xfd, 0xb4, 0xe8, 0x7d, 0x88, 0x5f,
+		0xfa, 0xdb, 0xa4, 0xaf, 0x5c, 0x45, 0x97, 0x61, 0xba, 0xd2, 0x72, 0x69, 0x3a, 0x63, 0xdf, 0x35,
+		0xed, 0xca, 0xa1, 0xe1, 0x3b, 0xcf, 0xe9, 0x39, 0xa1, 0xac, 0x8d, 0xe9, 0x88, 0xf7, 0xdd, 0xa1,
+		0x5d, 0x7b, 0xa4, 0x47, 0xfb, 0xe1, 0x08, 0xcc, 0xc5, 0x66, 0xcd, 0x75, 0x48, 0x3e, 0x33, 0xe5,
+		0xa4, 0x33, 0xbb, 0x0b, 0xe3, 0x01, 0x59, 0xff, 0xb8, 0x29, 0x4c, 0xd9, 0x6a, 0x2a, 0xc5, 0xbd,
+		0xe3, 0x26, 0xd6, 0xc7, 0x5e, 0x84, 0x7e, 0x21, 0x0d, 0xc6, 0x65, 0x82, 0x19, 0xb5, 0x43, 0x02,
+		0x79, 0x06, 0xf3, 0x4d, 0x17, 0x1f, 0x59, 0x4e, 0xcb, 0x33, 0x84, 0xf9, 0x0c, 0xe0, 0x99, 0x23,
+		0xbc, 0x10, 0x33, 0x81, 0x65, 0xdb, 0xbf, 0xf6, 0xe6, 0x33, 0xb3, 0xde, 0xc2, 0xfa, 0xac, 0xc0,
+		0xde, 0x65, 0xc8, 0x82, 0xee, 0xeb, 0x70, 0x9a, 0xa6, 0x31, 0x58, 0xde, 0x21, 0xa0, 0x38, 0x40,
+		0x39, 0x98, 0x24, 0x5d, 0x77, 0x49, 0x8f, 0x00, 0xbf, 0x09, 0x23, 0x34, 0x25, 0x51, 0xb7, 0x3c,
+		0x9f, 0x1b, 0xb8, 0x45, 0xb9, 0x3b, 0x2b, 0xb4, 0x72, 0xd8, 0xe7, 0x7f, 0xa1, 0x7b, 0x30, 0xe9,
+		0x51, 0x8d, 0x35, 0xda, 0x24, 0x86, 0xb2, 0x90, 0x28, 0x78, 0x11, 0x45, 0x47, 0x6f, 0xc2, 0x6c,
+		0xa5, 0x6e, 0x11, 0x4e, 0xeb, 0xd6, 0xbe, 0x6b, 0xba, 0xc7, 0xc6, 0x11, 0x76, 0xe9, 0x59, 0x3f,
+		0x4c, 0x55, 0x7a, 0x9a, 0xf5, 0xee, 0xb0, 0xce, 0x67, 0xac, 0x2f, 0x84, 0x55, 0xc3, 0xa6, 0x4f,
+		0xec, 0xab, 0xc0, 0x1a, 0x09, 0x63, 0xdd, 0x65, 0x9d, 0x02, 0x6b, 0x19, 0x46, 0x39, 0x96, 0xd5,
+		0x68, 0xd6, 0x69, 0xc6, 0x64, 0x44, 0x07, 0xd6, 0x54, 0x6e, 0x34, 0xeb, 0xc8, 0x83, 0x0b, 0x9d,
+		0xb3, 0x32, 0xbc, 0xca, 0x21, 0xae, 0xb6, 0xea, 0xd8, 0xf0, 0x1d, 0xb6, 0x58, 0x41, 0x54, 0x3a,
+		0xda, 0x2d, 0x2a, 0x3d, 0x17, 0x9d, 0xeb, 0x2e, 0xa7, 0xb4, 0xe7, 0xd0, 0x75, 0x13, 0x81, 0x6a,
+		0x09, 0x4e, 0xb3, 0xa5, 0x22, 0xa7, 0x74, 0x7b, 0x22, 0x63, 0xf4, 0xa4, 0x9d, 0xa2, 0x5d, 0xbb,
+		0xbe, 0xd3, 0x9e, 0x45, 0xd2, 0x76, 0x1a, 0x4f, 0xda, 0x4e, 0x68, 0x07, 0x0a, 0x81, 0x6e, 0x7b,
+		0x64, 0x33, 0x15, 0x0b, 0x34, 0x90, 0x3b, 0x1f, 0x5d, 0x2a, 0x96, 0x1b, 0x0d, 0xeb, 0x37, 0xdb,
+		0x79, 0xe3, 0x2f, 0xc2, 0x3f, 0x51, 0x05, 0xa6, 0x03, 0x6a, 0x95, 0xba, 0xe3, 0x61, 0x4e, 0x73,
+		0x82, 0xd2, 0xbc, 0x92, 0xd1, 0x35, 0x26, 0x88, 0x84, 0x5e, 0xcb, 0xd3, 0x83, 0xfd, 0x1c, 0x34,
+		0x92, 0x5d, 0x3e, 0xc5, 0x05, 0x61, 0x30, 0xf7, 0x85, 0xf8, 0xab, 0x93, 0x32, 0xef, 0xaf, 0xcd,
+		0x35, 0x17, 0xd0, 0x7d, 0x01, 0xaf, 0x4f, 0x1e, 0x75, 0xb4, 0xa0, 0x5b, 0xb0, 0x60, 0x79, 0x06,
+		0x5b, 0x96, 0xd0, 0x1a, 0x63, 0x9b, 0xd8, 0x99, 0x6a, 0x71, 0x8a, 0x1d, 0xc9, 0x96, 0x17, 0xb5,
+		0xc6, 0xdb, 0xac, 0x5b, 0xfb, 0x85, 0x02, 0x73, 0x8f, 0x9d, 0x7a, 0xfd, 0xff, 0x99, 0x35, 0xfe,
+		0xf1, 0x30, 0x14, 0xe3, 0xd3, 0xfe, 0xda, 0x1c, 0x7f, 0x6d, 0x8e, 0xbf, 0x8a, 0xe6, 0x38, 0x69,
+		0x7f, 0x8c, 0x25, 0x9a, 0x57, 0xa9, 0xad, 0x1a, 0x3f, 0xb1, 0xad, 0xfa, 0xf2, 0x59, 0x6d, 0xed,
+		0x1f, 0x73, 0xb0, 0xa2, 0xe3, 0x8a, 0xe3, 0x56, 0xc3, 0xf7, 0x16, 0x7c, 0x5b, 0x7c, 0x9e, 0x96,
+		0x72, 0x19, 0x46, 0x03, 0xc5, 0x09, 0x8c, 0x00, 0x88, 0xa6, 0x72, 0x15, 0xcd, 0xc1, 0x10, 0xd5,
+		0x31, 0xbe, 0xe3, 0xf3, 0xfa, 0x20, 0xf9, 0x59, 0xae, 0xa2, 0x45, 0x00, 0xee, 0xc7, 0x8b, 0xbd,
+		0x3b, 0xa2, 0x8f, 0xf0, 0x96, 0x72, 0x15, 0xe9, 0x30, 0xd6, 0x74, 0xea, 0x75, 0x83, 0xb7, 0x14,
+		0x07, 0x53, 0x62, 0x05, 0x62, 0x43, 0xef, 0x3a, 0x6e, 0x58, 0x34, 0x22, 0x56, 0x18, 0x25, 0x44,
+		0xf8, 0x0f, 0xed, 0xa7, 0x43, 0xb0, 0x9a, 0x22, 0x45, 0x6e, 0x78, 0x63, 0x16, 0x52, 0xe9, 0xcf,
+		0x42, 0xa6, 0x5a, 0xbf, 0x5c, 0xff, 0xd6, 0xef, 0x1b, 0x80, 0x84, 0x7c, 0xab, 0x9d, 0xe6, 0x77,
+		0x32, 0xe8, 0x11, 0xd0, 0x6b, 0xc4, 0x80, 0x49, 0x4c, 0x6f, 0x5e, 0x2f, 0xf0, 0x76, 0x01, 0x19,
+		0xb3, 0xe8, 0x03, 0x71, 0x8b, 0x1e, 0x4a, 0x58, 0x0c, 0x46, 0x13, 0x16, 0x37, 0xa0, 0xc8, 0x4d,
+		0x4a, 0x3b, 0xd5, 0x26, 0x4e, 0x7f, 0x16, 0x90, 0xcf, 0xb2, 0xfe, 0x40, 0x77, 0xf8, 0xe1, 0x8f,
+		0x74, 0x18, 0x0f, 0x6e, 0xf2, 0x68, 0x72, 0x8e, 0x5d, 0x0d, 0xbe, 0x9e, 0xb4, 0x1b, 0xf7, 0x5c,
+		0xd3, 0xf6, 0x88, 0x29, 0x8b, 0x24, 0xa4, 0xc6, 0xaa, 0xa1, 0x5f, 0xe8, 0x03, 0x38, 0x23, 0x49,
+		0xfd, 0xb5, 0x4d, 0xf8, 0x48, 0x16, 0x13, 0x3e, 0x1f, 0x53, 0x77, 0xd1, 0x95, 0xe4, 0x5a, 0x42,
+		0x92, 0x6b, 0xb9, 0x0a, 0x63, 0x11, 0x9b, 0x37, 0x4a, 0x6d, 0xde, 0xe8, 0x7e, 0xc8, 0xd8, 0x6d,
+		0x40, 0xa1, 0xbd, 0xac, 0x34, 0x67, 0x33, 0xd6, 0x35, 0x67, 0x33, 0x1e, 0x60, 0x90, 0xb6, 0x58,
+		0xd2, 0x68, 0xbc, 0xb7, 0xa4, 0x91, 0x09, 0x43, 0x24, 0x92, 0x27, 0x46, 0xb6, 0x40, 0xd3, 0x5d,
+		0xf7, 0x52, 0x2e, 0x0d, 0xba, 0xec, 0x22, 0x9a, 0x22, 0xb0, 0xb0, 0xb7, 0x6d, 0xfb, 0xee, 0xb1,
+		0x2e, 0xe8, 0xaa, 0x1f, 0xc0, 0x58, 0xb8, 0x03, 0x4d, 0x42, 0xfe, 0x39, 0x3e, 0xe6, 0xc6, 0x8a,
+		0xfc, 0x89, 0x6e, 0xc0, 0xc0, 0x11, 0x51, 0xff, 0xd4, 0xfc, 0x83, 0xd8, 0x75, 0x2c, 0x0f, 0xc1,
+		0x10, 0x6e, 0xe6, 0x6e, 0x28, 0x21, 0x3b, 0x29, 0xd2, 0x6d, 0x5f, 0xdb, 0xc9, 0x98, 0x9d, 0x0c,
+		0x8b, 0x46, 0x6a, 0x27, 0x7f, 0x96, 0x17, 0x76, 0x52, 0x2a, 0x45, 0x6e, 0x27, 0xdf, 0x85, 0x89,
+		0x0e, 0x3b, 0x94, 0x6a, 0x29, 0xd9, 0xf9, 0x7b, 0x4c, 0x2d, 0x89, 0x5e, 0x88, 0xda, 0xa9, 0x93,
+		0xa6, 0x3b, 0x43, 0x66, 0x29, 0x1f, 0x35, 0x4b, 0x1f, 0xc0, 0x52, 0x74, 0x57, 0x19, 0x4e, 0xcd,
+		0xf0, 0x0f, 0x2d, 0xcf, 0x08, 0x57, 0x6a, 0xa4, 0x0f, 0xa5, 0x46, 0x76, 0xd9, 0xa3, 0xda, 0xde,
+		0xa1, 0xe5, 0xf1, 0x2c, 0xae, 0x3c, 0xa7, 0x39, 0xd0, 0x57, 0x4e, 0x33, 0x76, 0xee, 0x0c, 0xf6,
+		0x77, 0xee, 0xbc, 0x0a, 0x13, 0x01, 0x1d, 0xa6, 0xd6, 0xd4, 0x00, 0x8f, 0xe8, 0x81, 0xd7, 0xb3,
+		0x45, 0x5b, 0xb5, 0xff, 0xc9, 0xc1, 0x59, 0xb6, 0x9a, 0x91, 0x9d, 0xcc, 0x0b, 0x2e, 0xda, 0xfb,
+		0x45, 0xef, 0xcc, 0xd8, 0xdd, 0x48, 0xca, 0xd8, 0x75, 0x23, 0x95, 0xf1, 0x76, 0xee, 0x08, 0x0a,
+		0xad, 0x66, 0xd5, 0xf4, 0x31, 0x4f, 0x67, 0x8a, 0x0b, 0x95, 0x47, 0x69, 0x97, 0x98, 0xdd, 0xc6,
+		0x2e, 0x3d, 0xa5, 0x24, 0x59, 0xbe, 0x93, 0xdb, 0xa5, 0xf1, 0x56, 0xb8, 0x4d, 0x75, 0x00, 0xc5,
+		0x81, 0x24, 0x36, 0x6a, 0x33, 0x6a, 0xa3, 0x5e, 0x4f, 0x64, 0x4b, 0xac, 0x52, 0x98, 0x6a, 0xd8,
+		0x5c, 0xfd, 0x65, 0x1e, 0xce, 0xa5, 0xb3, 0xce, 0xf7, 0x1a, 0x6e, 0x9f, 0xe2, 0x2e, 0x6f, 0xe3,
+		0x6b, 0x71, 0xb3, 0x7f, 0x1b, 0xad, 0x4f, 0x78, 0x1d, 0x5b, 0xfa, 0x47, 0x0a, 0x2c, 0xb5, 0xaf,
+		0xb1, 0x48, 0x24, 0x50, 0xb5, 0xbc, 0xa6, 0xe9, 0x57, 0x0e, 0x8d, 0xba, 0x53, 0x31, 0xeb, 0xf5,
+		0x63, 0x7e, 0x11, 0xf2, 0x41, 0x9f, 0x2b, 0xc1, 0x0f, 0x87, 0xf6, 0x3d, 0xd7, 0x9e, 0xb3, 0xc5,
+		0x47, 0xd8, 0x61, 0x03, 0xb0, 0x85, 0x59, 0x30, 0x93, 0x21, 0xd4, 0xdf, 0x80, 0x95, 0x6e, 0x04,
+		0x24, 0x8b, 0xb6, 0x15, 0x5d, 0x34, 0xf9, 0x2d, 0x9a, 0xb0, 0x77, 0x94, 0x96, 0x20, 0x4c, 0xfd,
+		0x8b, 0xd0, 0xaa, 0x91, 0xeb, 0x57, 0xc9, 0x34, 0xc9, 0x7d, 0x07, 0xae, 0xf6, 0x78, 0xfd, 0xda,
+		0x8d, 0x4e, 0xc6, 0x64, 0xf7, 0x59, 0x58, 0x4d, 0xa1, 0xc4, 0x53, 0xde, 0x3f, 0x54, 0x40, 0x8b,
+		0x9b, 0xf5, 0xfb, 0xc2, 0x0e, 0x09, 0xce, 0x9f, 0x74, 0x72, 0x7e, 0x3d, 0x81, 0xf3, 0x6e, 0x94,
+		0x32, 0xf2, 0xfe, 0x18, 0xce, 0xa6, 0xd2, 0xe2, 0xba, 0xf9, 0x1a, 0x4c, 0x56, 0x4c, 0xbb, 0x82,
+		0x83, 0xa3, 0x0e, 0xb3, 0xc3, 0x7b, 0x58, 0x9f, 0x60, 0xed, 0xba, 0x68, 0xd6, 0xfe, 0x50, 0x09,
+		0x0c, 0x5b, 0x98, 0xe6, 0x09, 0x0d, 0x5b, 0x1a, 0xa9, 0x8c, 0x53, 0x7d, 0x05, 0xce, 0xa5, 0x13,
+		0x0b, 0x5d, 0xf0, 0x4b, 0x00, 0x4f, 0xa2, 0x61, 0x89, 0x74, 0x7a, 0xd6, 0x30, 0x19, 0xa5, 0x88,
+		0x86, 0xc5, 0x27, 0x48, 0xd7, 0x07, 0x57, 0x7b, 0xd6, 0xb0, 0x6e, 0x94, 0x32, 0xf2, 0x7e, 0x1e,
+		0xce, 0xa6, 0xd2, 0xe2, 0xdc, 0xff, 0x95, 0x02, 0xcb, 0x3a, 0x6e, 0x38, 0x47, 0x98, 0x55, 0x1c,
+		0x7d, 0x51, 0xb2, 0x91, 0x51, 0x0f, 0x30, 0xdf, 0xe1, 0x01, 0x6a, 0x1a, 0xac, 0x24, 0x73, 0xcd,
+		0xa7, 0xf6, 0x37, 0x39, 0x38, 0xcf, 0xa7, 0xc0, 0xa6, 0x9d, 0x58, 0x36, 0x92, 0x3a, 0x41, 0x13,
+		0x0a, 0xd1, 0x3d, 0x58, 0xcc, 0xc9, 0x0e, 0xa1, 0x60, 0xfd, 0x32, 0x0c, 0xa8, 0x8f, 0x47, 0x76,
+		0x2f, 0x29, 0xda, 0x08, 0x2a, 0x73, 0xa4, 0x35, 0xba, 0xf2, 0xa2, 0x8d, 0x6d, 0x8e, 0xd3, 0x51,
+		0xb4, 0x81, 0x65, 0xcd, 0x3d, 0x57, 0xe5, 0xac, 0xc1, 0x2b, 0xdd, 0xe6, 0xc2, 0xe5, 0xfc, 0x77,
+		0x0a, 0x2c, 0x88, 0xf4, 0x97, 0x24, 0x1d, 0xf1, 0xb9, 0xa8, 0xcf, 0x05, 0x98, 0xb2, 0x3c, 0x23,
+		0x5a, 0x32, 0x4b, 0x65, 0x39, 0xac, 0x4f, 0x58, 0xde, 0xdd, 0x70, 0x31, 0xac, 0xb6, 0x04, 0x67,
+		0xe4, 0xec, 0xf3, 0xf9, 0xfd, 0x2c, 0x07, 0xe7, 0x98, 0xb1, 0x8e, 0x16, 0x9a, 0xc4, 0x4c, 0xeb,
+		0xe7, 0x31, 0xd1, 0x55, 0x18, 0xe3, 0xf5, 0xd0, 0xb8, 0x1a, 0xca, 0x48, 0x07, 0x6d, 0xb4, 0xfa,
+		0xee, 0x74, 0x45, 0xb0, 0x1a, 0x1a, 0xfa, 0x54, 0x4f, 0x43, 0xa3, 0x80, 0x44, 0x7b, 0xec, 0x1d,
+		0x98, 0x0c, 0xd5, 0x38, 0xb3, 0x68, 0x68, 0x20, 0x6b, 0x34, 0x34, 0xd1, 0x46, 0xa5, 0x0d, 0xda,
+		0xab, 0x70, 0xbe, 0x8b, 0x94, 0xf9, 0x7a, 0xfc, 0x67, 0x0e, 0x8a, 0x3a, 0xaf, 0xdf, 0xc7, 0x14,
+		0xd7, 0x7b, 0xb6, 0xfe, 0x79, 0xae, 0xc1, 0xb7, 0x61, 0x26, 0x9a, 0xb2, 0x3d, 0x36, 0x2c, 0x1f,
+		0x37, 0x84, 0x07, 0xdf, 0x59, 0x12, 0x41, 0xde, 0x20, 0xc4, 0xb2, 0xb6, 0xc7, 0x65, 0x1f, 0x37,
+		0xf4, 0xd3, 0x47, 0xb1, 0x36, 0x0f, 0x5d, 0x85, 0x41, 0x2a, 0x5b, 0xaf, 0x78, 0x2a, 0x25, 0x83,
+		0xb3, 0x65, 0xfa, 0xe6, 0x9d, 0xba, 0xb3, 0xaf, 0x73, 0x60, 0xb4, 0x09, 0x05, 0x52, 0x2d, 0x4f,
+		0xca, 0x13, 0x39, 0xfa, 0x40, 0x16, 0xf4, 0x31, 0x1b, 0xbf, 0xd0, 0x5b, 0x6c, 0x4d, 0x3c, 0x6d,
+		0x01, 0xe6, 0x25, 0xa2, 0xe6, 0x0b, 0xf1, 0x7d, 0x05, 0x66, 0x77, 0x8f, 0xed, 0xca, 0xee, 0xa1,
+		0xe9, 0x56, 0x79, 0x22, 0x97, 0x2f, 0xc3, 0x79, 0x28, 0x78, 0x4e, 0xcb, 0xad, 0x60, 0x83, 0x3f,
+		0xeb, 0xe0, 0x6b, 0x31, 0xce, 0x5a, 0x37, 0x59, 0x23, 0x9a, 0x87, 0x61, 0x92, 0xe3, 0xaa, 0x8a,
+		0x03, 0x6c, 0x40, 0x1f, 0xa2, 0xbf, 0xcb, 0x55, 0x54, 0x82, 0x53, 0x19, 0x8b, 0x78, 0x28, 0x9c,
+		0x36, 0x0f, 0x73, 0x31, 0x5e, 0x38, 0x9f, 0xff, 0x34, 0x00, 0xa7, 0x49, 0x9f, 0x38, 0x08, 0x3f,
+		0x4f, 0x5d, 0x29, 0xc2, 0x90, 0x48, 0x9c, 0xb1, 0xad, 0x2a, 0x7e, 0x92, 0x9d, 0xdc, 0x8e, 0xda,
+		0x83, 0x8c, 0x48, 0x90, 0x41, 0x21, 0x32, 0x89, 0xa7, 0xcb, 0x06, 0x7a, 0x4d, 0x97, 0x2d, 0x02,
+		0x88, 0xa0, 0xca, 0x62, 0x35, 0x6b, 0x79, 0x7d, 0x84, 0xb7, 0x94, 0xab, 0xb1, 0x9c, 0xc4, 0x50,
+		0x6f, 0x39, 0x89, 0x77, 0xf9, 0x25, 0x55, 0x3b, 0x3d, 0x40, 0xa9, 0x0c, 0x77, 0xa5, 0x32, 0x45,
+		0xd0, 0x02, 0xff, 0x97, 0xd2, 0xba, 0x06, 0x43, 0x22, 0xb7, 0x30, 0x92, 0x21, 0xb7, 0x20, 0x80,
+		0xc3, 0x79, 0x11, 0x88, 0xe6, 0x45, 0x6e, 0xc3, 0x18, 0xbb, 0x42, 0xe3, 0x85, 0x5c, 0xa3, 0x19,
+		0x0a, 0xb9, 0x46, 0xe9, 0xcd, 0x1a, 0xfb, 0x41, 0x6e, 0x73, 0x28, 0x01, 0xf6, 0x9c, 0xc9, 0x08,
+		0x8a, 0xc5, 0xc6, 0xa8, 0xee, 0x20, 0xd2, 0xf7, 0x1e, 0xed, 0x2a, 0xf3, 0x1e, 0xf4, 0x10, 0x26,
+		0x3a, 0x4c, 0x03, 0x4f, 0x50, 0x9e, 0xcf, 0x64, 0x14, 0xf4, 0x42, 0xd4, 0x20, 0x68, 0xb3, 0x30,
+		0x1d, 0xd5, 0x64, 0xae, 0xe2, 0xbf, 0xaf, 0xc0, 0x82, 0x28, 0x45, 0xfd, 0x82, 0xb8, 0x70, 0xda,
+		0xef, 0x2a, 0x70, 0x46, 0xce, 0x13, 0x8f, 0x6e, 0xde, 0x80, 0xd9, 0x06, 0x6b, 0x67, 0xd7, 0x47,
+		0x86, 0x65, 0x1b, 0x15, 0xb3, 0x72, 0x88, 0x39, 0x87, 0xa7, 0x1b, 0x21, 0xac, 0xb2, 0xbd, 0x49,
+		0xba, 0xd0, 0x5b, 0x30, 0x1f, 0x43, 0xaa, 0x9a, 0xbe, 0xb9, 0x6f, 0x7a, 0x98, 0x3b, 0xc1, 0xb3,
+		0x51, 0xbc, 0x2d, 0xde, 0xab, 0x9d, 0x01, 0x55, 0xf0, 0xc3, 0xe5, 0x79, 0xdf, 0x09, 0x2a, 0xac,
+		0xb4, 0xdf, 0xcc, 0xc1, 0x82, 0xb4, 0x9b, 0x73, 0xbb, 0x06, 0x93, 0x76, 0xab, 0xb1, 0x8f, 0x5d,
+		0x92, 0x4d, 0xa3, 0x56, 0xca, 0xe3, 0x05, 0x8c, 0x05, 0xd6, 0xfe, 0xa8, 0x46, 0x8d, 0x8f, 0x47,
+		0x84, 0x2d, 0xac, 0x1a, 0x2b, 0xa2, 0x1c, 0xd0, 0x87, 0xb9, 0x59, 0xf3, 0x50, 0x19, 0xc6, 0xf8,
+		0x4a, 0xb0, 0xa9, 0xca, 0xcb, 0xae, 0x85, 0x3a, 0xb0, 0xac, 0x15, 0x9d, 0x39, 0x75, 0xee, 0x46,
+		0xab, 0xed, 0x06, 0x74, 0x0d, 0xe6, 0xd8, 0x38, 0x15, 0xc7, 0xf6, 0x5d, 0xa7, 0x5e, 0xc7, 0x2e,
+		0x95, 0x49, 0xcb, 0xe3, 0xe5, 0x8b, 0x33, 0xb4, 0x7b, 0x33, 0xe8, 0x65, 0x76, 0x91, 0xee, 0x90,
+		0x6a, 0xd5, 0xc5, 0x9e, 0xc7, 0x53, 0xab, 0xe2, 0xa7, 0x56, 0x82, 0x29, 0x76, 0x01, 0x47, 0xf0,
+		0x84, 0xee, 0x84, 0x8d, 0xb4, 0x12, 0x31, 0xd2, 0xda, 0x34, 0xa0, 0x30, 0x3c, 0x57, 0xc6, 0xff,
+		0x56, 0x60, 0x8a, 0x79, 0xe7, 0x61, 0x37, 0x30, 0x99, 0x0c, 0xba, 0xc5, 0x2f, 0xab, 0x83, 0xbb,
+		0xf9, 0xc2, 0xfa, 0x72, 0x82, 0x40, 0x08, 0x45, 0x9a, 0xff, 0x1b, 0xf6, 0xf9, 0x5f, 0xe1, 0x2c,
+		0x72, 0x3e, 0x92, 0x45, 0xde, 0x84, 0x89, 0x23, 0xcb, 0xb3, 0xf6, 0xad, 0xba, 0x78, 0xb4, 0x91,
+		0x21, 0xf1, 0x59, 0x68, 0xa3, 0x90, 0x46, 0x62, 0x96, 0xf9, 0x11, 0x66, 0xd8, 0x26, 0xb7, 0xb8,
+		0x23, 0xfa, 0x28, 0x6f, 0x7b, 0x68, 0x36, 0x30, 0x91, 0x42, 0x78, 0xba, 0x5c, 0x0a, 0x3f, 0xa0,
+		0x52, 0xf0, 0xb0, 0xff, 0xa4, 0x85, 0x5b, 0x38, 0x83, 0x14, 0x3a, 0x47, 0xca, 0xc5, 0x46, 0x8a,
+		0x0a, 0x2a, 0xdf, 0xa3, 0xa0, 0x18, 0x9f, 0x6d, 0x86, 0x38, 0x9f, 0x9f, 0x28, 0x30, 0x2d, 0xf4,
+		0xfe, 0x0b, 0xc3, 0xea, 0x23, 0x98, 0xe9, 0xe0, 0x89, 0xef, 0xc2, 0x6b, 0x30, 0xd7, 0x74, 0x9d,
+		0x0a, 0xf6, 0x3c, 0x52, 0x58, 0x4d, 0x5f, 0x7a, 0x32, 0x3b, 0x40, 0x36, 0x63, 0x9e, 0xe8, 0x7c,
+		0xbb, 0x9b, 0x62, 0x52, 0x23, 0xe0, 0x69, 0xdf, 0x55, 0x60, 0xf1, 0x1e, 0xf6, 0xf5, 0xf6, 0xbb,
+		0xcf, 0x07, 0xd8, 0xf3, 0xcc, 0x03, 0x1c, 0xb8, 0x2c, 0xb7, 0x61, 0x90, 0xde, 0x53, 0x31, 0x42,
+		0xa3, 0xeb, 0xaf, 0x26, 0x70, 0x1b, 0x22, 0x41, 0x2f, 0xb1, 0x74, 0x8e, 0x96, 0x41, 0x28, 0xc4,
+		0xc6, 0x2c, 0x25, 0x71, 0xc1, 0x27, 0xf8, 0x21, 0x14, 0x98, 0xd4, 0x1b, 0xbc, 0x87, 0xb3, 0xf3,
+		0x6e, 0x62, 0xf6, 0x31, 0x9d, 0x60, 0x89, 0xee, 0x4d, 0xd1, 0xca, 0x53, 0xc0, 0x5e, 0xb8, 0x4d,
+		0xad, 0x03, 0x8a, 0x03, 0x85, 0xb3, 0x89, 0x03, 0x2c, 0x9b, 0xf8, 0xad, 0x68, 0x36, 0xf1, 0x42,
+		0x77, 0x01, 0x05, 0xcc, 0x84, 0x32, 0x89, 0x0d, 0x58, 0xb9, 0x87, 0xfd, 0xad, 0x9d, 0x27, 0x29,
+		0x6b, 0x51, 0x06, 0x60, 0x5b, 0xda, 0xae, 0x39, 0x42, 0x00, 0x19, 0x86, 0x23, 0x8a, 0x44, 0xcd,
+		0xe4, 0x88, 0xcf, 0xff, 0xf2, 0xb4, 0x97, 0xb0, 0x9a, 0x32, 0x1c, 0x17, 0xfa, 0x2e, 0x4c, 0x85,
+		0x5e, 0x04, 0xd3, 0x3b, 0x53, 0x31, 0xec, 0x2b, 0xd9, 0x86, 0x25, 0x8f, 0x93, 0x22, 0x0d, 0x9e,
+		0xf6, 0xaf, 0x0a, 0x4c, 0xf3, 0xf7, 0x44, 0xcc, 0x75, 0x16, 0xb3, 0x9b, 0x85, 0x41, 0x7e, 0x47,
+		0xc1, 0xce, 0x39, 0xfe, 0x2b, 0xfd, 0x7e, 0x40, 0x7e, 0x48, 0xe7, 0x4f, 0xea, 0x8f, 0xf6, 0x17,
+		0x5c, 0x68, 0x73, 0x30, 0xd3, 0x31, 0x35, 0x6e, 0x4d, 0x7e, 0xa2, 0x90, 0x12, 0xe4, 0x9a, 0x8b,
+		0xbd, 0xc3, 0xe0, 0xba, 0x86, 0x48, 0xe3, 0x0b, 0x38, 0x77, 0x12, 0xf8, 0xcb, 0x59, 0xe5, 0x73,
+		0x79, 0x0b, 0xe6, 0x36, 0x9d, 0x96, 0x4d, 0x94, 0xa7, 0x53, 0x41, 0x97, 0x00, 0x6a, 0x8e, 0x5b,
+		0xc1, 0x77, 0xb1, 0x5f, 0x39, 0xe4, 0x29, 0xd9, 0x50, 0x8b, 0x66, 0x42, 0x31, 0x8e, 0xca, 0x95,
+		0x6d, 0x1b, 0x86, 0xb0, 0xed, 0xd3, 0x2b, 0x67, 0xa6, 0x62, 0x17, 0x13, 0x54, 0x8c, 0x7b, 0x21,
+		0x5b, 0x3b, 0x4f, 0x28, 0x2d, 0x7e, 0xad, 0xcc, 0x71, 0xb5, 0x9f, 0xe4, 0x60, 0x56, 0xc7, 0x66,
+		0x55, 0xc2, 0xdd, 0x3a, 0x9c, 0x0a, 0x8a, 0x38, 0x0a, 0xeb, 0x4b, 0x49, 0xbe, 0xc5, 0xce, 0x13,
+		0x6a, 0x75, 0x29, 0x6c, 0x5a, 0x28, 0x16, 0x0f, 0xe6, 0xf2, 0xb2, 0x60, 0x6e, 0x0f, 0x8a, 0x96,
+		0x4d, 0x20, 0xac, 0x23, 0x6c, 0x60, 0x3b, 0xb0, 0x60, 0x19, 0x0b, 0xdf, 0x66, 0x02, 0xe4, 0x6d,
+		0x5b, 0x98, 0xa2, 0x72, 0x95, 0x28, 0x46, 0x93, 0x10, 0xf1, 0xac, 0x8f, 0xd8, 0xe1, 0x3b, 0xa0,
+		0x0f, 0x93, 0x86, 0x5d, 0xeb, 0x23, 0x8c, 0x5e, 0x81, 0x09, 0x5a, 0xbe, 0x41, 0x21, 0x58, 0x95,
+		0xc1, 0x20, 0xad, 0x32, 0xa0, 0x55, 0x1d, 0x8f, 0xcd, 0x03, 0xcc, 0x8a, 0x0e, 0xff, 0x22, 0x07,
+		0x73, 0x31, 0x59, 0xf1, 0xe5, 0xe8, 0x47, 0x58, 0x52, 0x7b, 0x91, 0x3b, 0x99, 0xbd, 0x40, 0xdf,
+		0x81, 0xd9, 0x18, 0x51, 0x91, 0x04, 0xec, 0xd5, 0x00, 0x4e, 0x77, 0x52, 0x27, 0xad, 0x32, 0x71,
+		0x9d, 0x92, 0x89, 0xeb, 0x3f, 0x48, 0x69, 0x6a, 0xcb, 0x3d, 0xc0, 0x5f, 0x6d, 0xdd, 0xd2, 0x54,
+		0x28, 0xc6, 0xa7, 0xc9, 0x37, 0xff, 0xa7, 0x39, 0x98, 0x7b, 0x80, 0xbf, 0xf2, 0x32, 0xf8, 0x6c,
+		0xf6, 0xd7, 0x1d, 0x28, 0x3e, 0xc0, 0x72, 0x41, 0xca, 0x68, 0x28, 0x32, 0x1a, 0x1f, 0x2b, 0x70,
+		0xe6, 0xa1, 0xe3, 0x5b, 0xb5, 0x63, 0x12, 0x6e, 0x3b, 0x47, 0xd8, 0x7d, 0x60, 0x92, 0x58, 0x3a,
+		0x90, 0xfa, 0x77, 0x60, 0xb6, 0xc6, 0x7b, 0x8c, 0x06, 0xed, 0x32, 0x22, 0x0e, 0x5b, 0xd2, 0xfe,
+		0x88, 0x92, 0xa3, 0x83, 0xe9, 0xd3, 0xb5, 0x78, 0xa3, 0xa7, 0x2d, 0xc3, 0x62, 0x02, 0x07, 0x5c,
+		0x29, 0x4c, 0x58, 0xb8, 0x87, 0xfd, 0x4d, 0xd7, 0xf1, 0x3c, 0xbe, 0x2a, 0x91, 0xc3, 0x2d, 0x12,
+		0xf8, 0x29, 0x1d, 0x81, 0xdf, 0x79, 0x28, 0xf8, 0xa6, 0x7b, 0x80, 0xfd, 0x60, 0x95, 0xd9, 0x31,
+		0x37, 0xce, 0x5a, 0x39, 0x3d, 0xed, 0x17, 0x79, 0x38, 0x23, 0x1f, 0x83, 0xcb, 0xb3, 0x01, 0x05,
+		0x66, 0x1a, 0xf6, 0x8f, 0x59, 0x18, 0x5a, 0x54, 0xba, 0x14, 0x2e, 0xa5, 0x91, 0xa3, 0xce, 0xb7,
+		0x77, 0xe7, 0x98, 0x3a, 0x80, 0xec, 0x84, 0x19, 0xf3, 0x43, 0x4d, 0xe8, 0x63, 0x05, 0x66, 0x6a,
+		0xf4, 0xc6, 0xcb, 0xa8, 0x98, 0x2d, 0x0f, 0xb7, 0x87, 0x65, 0xf6, 0xee, 0x41, 0x7f, 0xc3, 0xb2,
+		0x4b, 0xb4, 0x4d, 0x42, 0x31, 0x32, 0x38, 0xaa, 0xc5, 0x3a, 0xd4, 0x26, 0x4c, 0xc5, 0xb8, 0x94,
+		0xb8, 0xa7, 0xdb, 0x51, 0xf7, 0xf4, 0x52, 0x82, 0x3a, 0x74, 0xf2, 0xc4, 0x17, 0x2f, 0xec, 0xa3,
+		0xaa, 0x4d, 0x98, 0x4b, 0x60, 0x50, 0x32, 0xee, 0xed, 0xf0, 0xb8, 0x85, 0xc4, 0x74, 0xef, 0x3d,
+		0xec, 0xb7, 0x6f, 0x0f, 0x29, 0xdd, 0xb0, 0x57, 0xfc, 0x5f, 0x0a, 0xac, 0xf1, 0xfb, 0xba, 0x98,
+		0xd0, 0x62, 0x17, 0x0d, 0x29, 0x91, 0x59, 0x36, 0x2d, 0x43, 0xcf, 0x98, 0x12, 0x05, 0x85, 0x15,
+		0x22, 0x57, 0x9d, 0x5d, 0x68, 0x0c, 0x8f, 0xd0, 0x6d, 0xff, 0xf2, 0xd0, 0x39, 0x18, 0xaf, 0x11,
+		0x07, 0xe8, 0x21, 0x66, 0xbe, 0x14, 0xbf, 0x5f, 0x8a, 0x36, 0x6a, 0x2e, 0xbc, 0x96, 0x61, 0xae,
+		0x81, 0xbb, 0x34, 0x20, 0xfc, 0xf1, 0xfe, 0x96, 0x95, 0x62, 0x6b, 0x57, 0xe9, 0xd3, 0x37, 0xb1,
+		0xb1, 0xe9, 0x21, 0x99, 0x21, 0x37, 0xa6, 0xf9, 0x30, 0x17, 0x43, 0x0b, 0x1c, 0x87, 0x99, 0xf6,
+		0xbd, 0x8a, 0x48, 0xc4, 0xb4, 0x6c, 0xf1, 0xac, 0xb5, 0x7d, 0xe9, 0xb2, 0xcb, 0xb2, 0x30, 0x2d,
+		0x9b, 0xe6, 0xc5, 0xc5, 0xa3, 0x60, 0x9e, 0x42, 0x62, 0xf9, 0xa1, 0x71, 0xde, 0x4a, 0x41, 0x3d,
+		0xed, 0xef, 0x15, 0x1e, 0xaa, 0x47, 0xbe, 0x47, 0x40, 0xaa, 0x9c, 0xc4, 0x87, 0x0e, 0x3c, 0x7a,
+		0xef, 0xe9, 0x71, 0xd7, 0xb3, 0xc0, 0x9b, 0xd9, 0x6d, 0xa8, 0x87, 0xee, 0xc1, 0x8a, 0x00, 0x6c,
+		0x35, 0x3d, 0xec, 0xfa, 0x86, 0x87, 0x4d, 0xb7, 0x72, 0x68, 0x98, 0xbe, 0xef, 0x5a, 0xfb, 0x2d,
+		0x12, 0x2e, 0xe7, 0x28, 0xe6, 0x22, 0x87, 0x7b, 0x4a, 0xc1, 0x76, 0x29, 0xd4, 0x46, 0x00, 0x44,
+		0xc2, 0x6d, 0x41, 0x28, 0x7a, 0x09, 0xea, 0xf1, 0xdb, 0xb4, 0x19, 0xde, 0xbd, 0x19, 0xbe, 0xd0,
+		0xf4, 0xb4, 0x9f, 0x2a, 0xb0, 0x48, 0xdf, 0x41, 0xf7, 0x77, 0xe7, 0xda, 0x8e, 0x12, 0x72, 0x91,
+		0x28, 0xe1, 0xff, 0x28, 0x08, 0x9a, 0x85, 0x41, 0x17, 0x9b, 0x1e, 0xbf, 0x14, 0x1b, 0xd1, 0xf9,
+		0xaf, 0xc8, 0x83, 0xe0, 0x81, 0xe8, 0x83, 0x60, 0x6d, 0x05, 0x96, 0x92, 0x26, 0xc8, 0x0f, 0x8b,
+		0x7f, 0x57, 0x60, 0xf9, 0xa9, 0xdd, 0xfc, 0x4a, 0x4b, 0x41, 0x83, 0x95, 0xe4, 0x29, 0x72, 0x39,
+		0xfc, 0x6d, 0x0e, 0x96, 0x58, 0x31, 0xd8, 0x97, 0x42, 0x0c, 0xcb, 0x30, 0xca, 0x0b, 0xf1, 0x68,
+		0x16, 0x87, 0xc9, 0x02, 0x58, 0x13, 0xcd, 0x6c, 0xad, 0xc3, 0x80, 0x65, 0x37, 0x5b, 0x7e, 0xa6,
+		0x92, 0x47, 0x06, 0x1a, 0x91, 0xe1, 0x60, 0xc7, 0xd3, 0xf2, 0x68, 0xa9, 0xc3, 0x50, 0x67, 0xa9,
+		0xc3, 0xaf, 0xc3, 0x72, 0xa2, 0xf4, 0xb8, 0x25, 0x7a, 0x93, 0xac, 0x5c, 0xe6, 0x37, 0xd0, 0x1c,
+		0x16, 0x9d, 0x85, 0x71, 0xec, 0xba, 0x8e, 0x2b, 0xfc, 0x48, 0x2e, 0xde, 0x31, 0xda, 0xc8, 0xdd,
+		0x38, 0xed, 0xaf, 0x15, 0x98, 0x96, 0x55, 0xf4, 0xa1, 0x6d, 0x18, 0x65, 0x74, 0x8c, 0x90, 0x2b,
+		0x7c, 0x2e, 0xed, 0xe5, 0x34, 0x01, 0xa6, 0x0e, 0x31, 0xb8, 0xc1, 0xdf, 0x21, 0xd6, 0x73, 0x27,
+		0x61, 0x3d, 0x1f, 0x67, 0xfd, 0xc2, 0xf7, 0x15, 0x98, 0x4b, 0xf8, 0xf4, 0x0e, 0x3a, 0x0f, 0xab,
+		0xe5, 0x87, 0x1b, 0x9b, 0x7b, 0xe5, 0x67, 0xe5, 0xbd, 0xf7, 0x8d, 0xbd, 0xf2, 0x83, 0xed, 0x47,
+		0x4f, 0xf7, 0x8c, 0xc7, 0x8f, 0x76, 0xca, 0x9b, 0xef, 0x1b, 0xe5, 0x87, 0xcf, 0x36, 0x76, 0xca,
+		0x5b, 0x93, 0xbf, 0x84, 0x34, 0x58, 0x4a, 0x06, 0xbb, 0xbb, 0x51, 0xde, 0x99, 0x54, 0xd0, 0x39,
+		0x58, 0x49, 0x86, 0x79, 0xf8, 0x68, 0xaf, 0x7c, 0xf7, 0xfd, 0xc9, 0xdc, 0x85, 0x3f, 0x53, 0x60,
+		0xb2, 0xf3, 0x83, 0x36, 0x68, 0x09, 0x54, 0x7d, 0x7b, 0x77, 0x7b, 0xcf, 0xd8, 0xbc, 0x5f, 0xde,
+		0xd9, 0x8a, 0x0f, 0x2f, 0xef, 0xdf, 0xda, 0xbe, 0xbb, 0xf1, 0x74, 0x67, 0x6f, 0x52, 0x41, 0xcb,
+		0xb0, 0x20, 0xe9, 0xd7, 0xb7, 0x37, 0xf6, 0xf6, 0x36, 0x36, 0xef, 0x4f, 0xe6, 0xd0, 0xeb, 0xf0,
+		0x5a, 0x0a, 0x80, 0xb1, 0xf1, 0x70, 0xcb, 0xd8, 0xdb, 0xd6, 0x1f, 0x94, 0x1f, 0x6e, 0xec, 0x6d,
+		0x4f, 0xe6, 0xd7, 0x7f, 0x7e, 0x19, 0x80, 0x27, 0x1d, 0x36, 0x1e, 0x97, 0xd1, 0x6f, 0x93, 0xfb,
+		0x5d, 0xe9, 0xd7, 0x87, 0xd0, 0xb5, 0x44, 0xaf, 0x2f, 0xf5, 0xbb, 0x4d, 0xea, 0xf5, 0x9e, 0xf1,
+		0xb8, 0x8a, 0xff, 0x8e, 0x02, 0x73, 0x09, 0xdf, 0xa3, 0x42, 0x29, 0x44, 0x53, 0xbf, 0xd0, 0xa5,
+		0xde, 0xe8, 0x1d, 0x91, 0xb3, 0xf3, 0x63, 0x05, 0x56, 0xba, 0x7d, 0xa2, 0x09, 0x7d, 0xab, 0x1b,
+		0xf9, 0x6e, 0x9f, 0xb9, 0x52, 0x37, 0x4e, 0x40, 0x81, 0x73, 0x4a, 0x16, 0x51, 0xfe, 0x11, 0xa3,
+		0x94, 0x45, 0x4c, 0xfd, 0xe8, 0x93, 0x7a, 0xbd, 0x67, 0x3c, 0xce, 0xcb, 0x1f, 0x28, 0xa0, 0x26,
+		0x7f, 0xea, 0x07, 0x25, 0x97, 0xf5, 0x76, 0xfd, 0x04, 0x92, 0xfa, 0xcd, 0xbe, 0x70, 0x43, 0x32,
+		0x92, 0x1f, 0xe6, 0x29, 0x32, 0x4a, 0x75, 0x6f, 0xd4, 0xeb, 0x3d, 0xe3, 0x71, 0x5e, 0x7e, 0xa0,
+		0x40, 0x31, 0xe9, 0x48, 0x45, 0xc9, 0x0a, 0xdb, 0xc5, 0xd1, 0x50, 0xdf, 0xea, 0x03, 0x33, 0xb4,
+		0xf5, 0x12, 0x4e, 0xa0, 0x94, 0xad, 0x97, 0x7e, 0xe2, 0xab, 0x37, 0x7a, 0x47, 0xe4, 0xec, 0x7c,
+		0xa2, 0xc0, 0x7c, 0xe2, 0x57, 0x97, 0x50, 0xf2, 0x3c, 0xbb, 0x7d, 0xf4, 0x49, 0xbd, 0xd9, 0x0f,
+		0x2a, 0x67, 0xca, 0x86, 0xf1, 0xc8, 0x47, 0x4a, 0x50, 0x72, 0x7d, 0xbc, 0xec, 0x5b, 0x28, 0x6a,
+		0x29, 0x2b, 0x38, 0x1f, 0xef, 0x63, 0x05, 0x4e, 0x4b, 0xbe, 0xf4, 0x81, 0xde, 0x48, 0xdf, 0x9a,
+		0xd2, 0x6f, 0x8b, 0xa8, 0x6f, 0xf6, 0x86, 0xc4, 0x59, 0xf0, 0x61, 0xa2, 0xe3, 0xab, 0x1a, 0xe8,
+		0x52, 0x5a, 0x2e, 0x40, 0x52, 0x96, 0xa0, 0x5e, 0xce, 0x8e, 0xc0, 0x47, 0x7d, 0x01, 0x93, 0x9d,
+		0xaf, 0xc7, 0x51, 0x32, 0x95, 0x84, 0xf7, 0xf5, 0xea, 0x95, 0x1e, 0x30, 0x42, 0x6a, 0x97, 0xf8,
+		0xba, 0x20, 0x45, 0xed, 0xba, 0xbd, 0x60, 0x55, 0x4f, 0xf0, 0x98, 0x01, 0xfd, 0xb1, 0x02, 0x67,
+		0xd8, 0x0f, 0xf9, 0xe3, 0x03, 0x74, 0xeb, 0x24, 0xaf, 0x47, 0xd4, 0xb7, 0x4f, 0xf4, 0xe2, 0x81,
+		0x8b, 0x2c, 0xa1, 0x42, 0x3f, 0x55, 0x64, 0xe9, 0xef, 0x03, 0xd4, 0x9b, 0xfd, 0xa0, 0xc6, 0xd6,
+		0x51, 0xf2, 0xce, 0xab, 0xeb, 0x3a, 0x26, 0xbf, 0xb0, 0x53, 0x6f, 0xf6, 0x83, 0x1a, 0x5f, 0x47,
+		0x69, 0x91, 0x7c, 0xf7, 0x75, 0x4c, 0x2b, 0xd4, 0x57, 0xdf, 0xee, 0x13, 0x3b, 0xbe, 0x8e, 0xf1,
+		0x3a, 0xf8, 0xee, 0xeb, 0x98, 0x58, 0x85, 0xaf, 0xde, 0xec, 0x07, 0x95, 0x33, 0xf5, 0x47, 0xf4,
+		0xa2, 0x31, 0xb1, 0xc0, 0x1d, 0x7d, 0xb3, 0xa7, 0x39, 0x47, 0x4b, 0xec, 0xd5, 0x5b, 0xfd, 0x21,
+		0x47, 0x58, 0x4b, 0x7c, 0xdd, 0x91, 0xca, 0x5a, 0xb7, 0xf7, 0x25, 0xea, 0xad, 0xfe, 0x90, 0x39,
+		0x6b, 0x7f, 0xaa, 0xc0, 0x12, 0xa7, 0x94, 0x50, 0xd6, 0x8d, 0xde, 0x49, 0x19, 0x20, 0x43, 0x6d,
+		0xbb, 0x7a, 0xbb, 0x6f, 0xfc, 0x90, 0x07, 0x94, 0x54, 0xdc, 0x9f, 0xe2, 0x01, 0x75, 0x79, 0xc5,
+		0xa0, 0xbe, 0xd5, 0x07, 0x26, 0xe7, 0xe8, 0xbb, 0x0a, 0x4c, 0xcb, 0x4a, 0xc4, 0x51, 0xf2, 0xc9,
+		0x99, 0x52, 0x10, 0xaf, 0x5e, 0xed, 0x11, 0x8b, 0x73, 0xf1, 0x27, 0x0a, 0x2c, 0xb2, 0x35, 0x4e,
+		0xa8, 0x90, 0x46, 0x6f, 0x77, 0xd1, 0x8d, 0xf4, 0xfa, 0x75, 0xf5, 0x9d, 0x7e, 0xd1, 0x39, 0x83,
+		0x1f, 0x91, 0x82, 0xa7, 0x8e, 0x62, 0x61, 0x74, 0x25, 0x85, 0xa8, 0xbc, 0x86, 0x5b, 0x5d, 0xef,
+		0x05, 0xa5, 0xed, 0x8d, 0x74, 0x94, 0xff, 0xa6, 0x78, 0x23, 0xf2, 0xa2, 0x65, 0xf5, 0x72, 0x76,
+		0x04, 0x3e, 0xea, 0x73, 0x18, 0x0b, 0x97, 0x63, 0xa2, 0x6f, 0xa4, 0x52, 0xe8, 0xa8, 0x3f, 0x56,
+		0x5f, 0xcf, 0x08, 0x1d, 0xd2, 0x42, 0x59, 0x3d, 0x65, 0x8a, 0x16, 0xa6, 0x94, 0x84, 0xaa, 0x57,
+		0x7b, 0xc4, 0x0a, 0x79, 0x9e, 0x92, 0x32, 0xc9, 0x14, 0xcf, 0x33, 0xb9, 0xe6, 0x52, 0x7d, 0xb3,
+		0x37, 0xa4, 0xe0, 0x61, 0x28, 0xb4, 0xab, 0x0e, 0xd1, 0x85, 0x44, 0x1a, 0xb1, 0x52, 0x46, 0xf5,
+		0x62, 0x26, 0xd8, 0xf6, 0x30, 0xed, 0xb2, 0xbe, 0x94, 0x61, 0x62, 0xa5, 0x8e, 0xea, 0xc5, 0x4c,
+		0xb0, 0xe1, 0x61, 0x44, 0x55, 0x5e, 0xea, 0x30, 0x1d, 0xb5, 0x84, 0xea, 0xc5, 0x4c, 0xb0, 0xed,
+		0x08, 0x25, 0x52, 0x51, 0x97, 0x12, 0xa1, 0xc8, 0xaa, 0x01, 0xd5, 0x52, 0x56, 0xf0, 0x50, 0x4c,
+		0x2d, 0xaf, 0x4c, 0x4b, 0x89, 0xa9, 0x53, 0x2b, 0xf4, 0xd4, 0xeb, 0x3d, 0xe3, 0x85, 0x1c, 0x98,
+		0xc4, 0x22, 0xb0, 0x14, 0x07, 0xa6, 0x5b, 0x9d, 0x9a, 0x7a, 0xb3, 0x1f, 0xd4, 0xf6, 0x82, 0x44,
+		0x4a, 0xa8, 0x52, 0x16, 0x44, 0x56, 0x45, 0xa6, 0x96, 0xb2, 0x82, 0x87, 0xcc, 0x87, 0xac, 0xdc,
+		0x09, 0xa5, 0x85, 0x7f, 0x89, 0x85, 0x5c, 0xea, 0xd5, 0x1e, 0xb1, 0xda, 0xf1, 0x5b, 0x67, 0x61,
+		0x54, 0x4a, 0xfc, 0x96, 0x50, 0x7e, 0xa5, 0x5e, 0xe9, 0x01, 0xa3, 0x7d, 0x40, 0x74, 0x54, 0x00,
+		0xa5, 0x1c, 0x10, 0xf2, 0xba, 0x2a, 0xf5, 0x72, 0x76, 0x84, 0x50, 0xb8, 0xda, 0x51, 0x61, 0x92,
+		0x16, 0xae, 0xca, 0x6b, 0x6e, 0xd4, 0x2b, 0x3d, 0x60, 0xb4, 0x07, 0x7e, 0x80, 0x33, 0x0f, 0xfc,
+		0x00, 0xf7, 0x3a, 0x70, 0x62, 0xb9, 0xc7, 0xf7, 0x14, 0x98, 0x91, 0x16, 0x51, 0xa0, 0x64, 0x8d,
+		0x49, 0x2b, 0xfb, 0x50, 0xaf, 0xf5, 0x8a, 0x16, 0xd2, 0x77, 0x59, 0x09, 0x42, 0x8a, 0xbe, 0xa7,
+		0xd4, 0x76, 0xa8, 0x57, 0x7b, 0xc4, 0xe2, 0x5c, 0x7c, 0xaa, 0x04, 0x6f, 0x88, 0x93, 0xef, 0xba,
+		0xd1, 0x46, 0xb7, 0x78, 0xa3, 0x6b, 0x4d, 0x80, 0x7a, 0xe7, 0x24, 0x24, 0x22, 0x29, 0x9d, 0xf0,
+		0x65, 0x77, 0x7a, 0x4a, 0x47, 0x72, 0x9b, 0xae, 0x5e, 0xce, 0x8e, 0xc0, 0x46, 0xbd, 0xf3, 0xd6,
+		0xaf, 0x5c, 0x3f, 0xb0, 0xfc, 0xc3, 0xd6, 0x7e, 0xa9, 0xe2, 0x34, 0x2e, 0x45, 0xfe, 0xc1, 0x4e,
+		0xe9, 0x00, 0xdb, 0xec, 0x7f, 0x29, 0x85, 0xfe, 0x99, 0xd3, 0x37, 0xf9, 0x9f, 0x47, 0x57, 0xf6,
+		0x07, 0x69, 0xdf, 0x1b, 0xff, 0x3b, 0x00, 0x29, 0x38, 0x52, 0x91, 0xf8, 0x69, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -2657,13 +2855,13 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
 		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
 		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
-		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
-		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
-		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
-		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
-		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
-		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0x54, 0xc3, 0x25, 0x9c, 0x9c,
+		0x9f, 0xab, 0x87, 0x66, 0xa4, 0x13, 0x2f, 0xcc, 0xc0, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94, 0x56,
+		0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x7a, 0x7e, 0x4e, 0x62, 0x5e,
+		0x3a, 0xc2, 0x7d, 0x05, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x70, 0x67, 0xfe, 0x60, 0x64, 0x5c, 0xc4,
+		0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0x62, 0x6e, 0x00, 0x54, 0xa9, 0x5e, 0x78,
+		0x6a, 0x4e, 0x8e, 0x77, 0x5e, 0x7e, 0x79, 0x5e, 0x08, 0x48, 0x4b, 0x12, 0x1b, 0xd8, 0x0c, 0x63,
+		0xc0, 0x00, 0xdc, 0x84, 0x30, 0xff, 0xf3, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -2672,13 +2870,13 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
 		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
 		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
-		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
-		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
-		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
-		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
-		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
-		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x1d, 0x97, 0x70,
+		0x72, 0x7e, 0xae, 0x1e, 0x9a, 0x99, 0x4e, 0x7c, 0x70, 0x13, 0x03, 0x40, 0x42, 0x01, 0x8c, 0x51,
+		0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0x39, 0x89,
+		0x79, 0xe9, 0x08, 0x27, 0x16, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x23, 0x5c, 0xfa, 0x83, 0x91, 0x71,
+		0x11, 0x13, 0xb3, 0x7b, 0x80, 0xd3, 0x2a, 0x26, 0x39, 0x77, 0x88, 0xc9, 0x01, 0x50, 0xb5, 0x7a,
+		0xe1, 0xa9, 0x39, 0x39, 0xde, 0x79, 0xf9, 0xe5, 0x79, 0x21, 0x20, 0x3d, 0x49, 0x6c, 0x60, 0x43,
+		0x8c, 0x01, 0x03, 0x00, 0xbc, 0x77, 0x4a, 0x07, 0xf7, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -2691,931 +2889,937 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
 		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
 		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
-		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
-		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
-		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
-		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
-		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
-		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x0d,
+		0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xe8, 0x3a, 0xf1, 0x86, 0x43, 0x83, 0x3f, 0x00, 0x24,
+		0x12, 0xc0, 0x18, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f,
+		0x9e, 0x9f, 0x93, 0x98, 0x97, 0x8e, 0x88, 0xaa, 0x82, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x78, 0x8c,
+		0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0x62, 0x6e,
+		0x00, 0x54, 0xa9, 0x5e, 0x78, 0x6a, 0x4e, 0x8e, 0x77, 0x5e, 0x7e, 0x79, 0x5e, 0x08, 0x48, 0x4b,
+		0x12, 0x1b, 0xd8, 0x0c, 0x63, 0xc0, 0x00, 0x19, 0x6c, 0xb9, 0xb8, 0xfe, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x5d, 0x6f, 0xdb, 0x36,
-		0x14, 0x9d, 0xe2, 0xd8, 0x69, 0xaf, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xbe, 0x3c, 0x03, 0x43,
-		0xb3, 0x01, 0x93, 0x11, 0xf7, 0xa5, 0x58, 0x51, 0x0c, 0x49, 0xec, 0xac, 0x6a, 0xb7, 0xc4, 0x90,
-		0x8d, 0x06, 0xdb, 0x80, 0x09, 0xb4, 0x78, 0xe5, 0x72, 0x96, 0x48, 0x81, 0xa2, 0x9c, 0xf8, 0x6d,
-		0xbf, 0x64, 0x0f, 0xfb, 0x4b, 0xfb, 0x43, 0x03, 0x25, 0x3a, 0x76, 0x3a, 0x0f, 0x7d, 0x19, 0xf6,
-		0x46, 0xde, 0x73, 0xee, 0xb9, 0xe7, 0x12, 0x97, 0x24, 0xb4, 0xf3, 0x09, 0xaa, 0x6e, 0x48, 0x19,
-		0x8a, 0x10, 0xbb, 0x34, 0xe5, 0xdd, 0xf9, 0x71, 0x37, 0x94, 0x49, 0x22, 0x85, 0x9b, 0x2a, 0xa9,
-		0x25, 0xd9, 0x33, 0x0c, 0xd7, 0x32, 0x5c, 0x9a, 0x72, 0x77, 0x7e, 0x7c, 0xf8, 0xd9, 0x54, 0xca,
-		0x69, 0x8c, 0xdd, 0x82, 0x32, 0xc9, 0xa3, 0x2e, 0xcb, 0x15, 0xd5, 0x7c, 0x99, 0xd4, 0x79, 0x0d,
-		0x1f, 0x5e, 0x49, 0x35, 0x8b, 0x62, 0x79, 0x3d, 0xb8, 0xc1, 0x30, 0x37, 0x10, 0xf9, 0x1c, 0xea,
-		0xd7, 0x36, 0x18, 0x70, 0xd6, 0x72, 0xda, 0xce, 0xd1, 0x7d, 0x1f, 0x96, 0x21, 0x8f, 0x91, 0x47,
-		0x50, 0x53, 0xb9, 0x30, 0xd8, 0x56, 0x81, 0x55, 0x55, 0x2e, 0x3c, 0xd6, 0xe9, 0x40, 0x63, 0x29,
-		0x36, 0x5e, 0xa4, 0x48, 0x08, 0x6c, 0x0b, 0x9a, 0xa0, 0x15, 0x28, 0xd6, 0x86, 0x73, 0x12, 0x6a,
-		0x3e, 0xe7, 0x7a, 0xf1, 0xaf, 0x9c, 0x4f, 0x61, 0x67, 0x48, 0x17, 0xb1, 0xa4, 0xcc, 0xc0, 0x8c,
-		0x6a, 0x5a, 0xc0, 0x0d, 0xbf, 0x58, 0x77, 0x9e, 0xc3, 0xce, 0x39, 0xe5, 0x71, 0xae, 0x90, 0xec,
-		0x43, 0x4d, 0x21, 0xcd, 0xa4, 0xb0, 0xf9, 0x76, 0x47, 0x5a, 0xb0, 0xc3, 0x50, 0x53, 0x1e, 0x67,
-		0x85, 0xc3, 0x86, 0xbf, 0xdc, 0x76, 0xfe, 0x70, 0x60, 0xfb, 0x47, 0x4c, 0x24, 0x79, 0x01, 0xb5,
-		0x88, 0x63, 0xcc, 0xb2, 0x96, 0xd3, 0xae, 0x1c, 0xd5, 0x7b, 0x5f, 0xba, 0x1b, 0xce, 0xcf, 0x35,
-		0x54, 0xf7, 0xbc, 0xe0, 0x0d, 0x84, 0x56, 0x0b, 0xdf, 0x26, 0x1d, 0x5e, 0x41, 0x7d, 0x2d, 0x4c,
-		0x9a, 0x50, 0x99, 0xe1, 0xc2, 0xba, 0x30, 0x4b, 0xd2, 0x83, 0xea, 0x9c, 0xc6, 0x39, 0x16, 0x06,
-		0xea, 0xbd, 0x4f, 0x36, 0xca, 0xdb, 0x36, 0xfd, 0x92, 0xfa, 0xed, 0xd6, 0x33, 0xa7, 0xf3, 0xa7,
-		0x03, 0xb5, 0x97, 0x48, 0x19, 0x2a, 0xf2, 0xdd, 0x3b, 0x16, 0x9f, 0x6c, 0xd4, 0x28, 0xc9, 0xff,
-		0xaf, 0xc9, 0xbf, 0x1c, 0x68, 0x8e, 0x90, 0xaa, 0xf0, 0xed, 0x89, 0xd6, 0x8a, 0x4f, 0x72, 0x8d,
-		0x19, 0x09, 0x60, 0x97, 0x0b, 0x86, 0x37, 0xc8, 0x82, 0x3b, 0xb6, 0x9f, 0x6d, 0x54, 0x7d, 0x37,
-		0xdd, 0xf5, 0xca, 0xdc, 0xf5, 0x3e, 0x1e, 0xf0, 0xf5, 0xd8, 0xe1, 0xaf, 0x40, 0xfe, 0x49, 0xfa,
-		0x0f, 0xbb, 0x8a, 0xe0, 0x5e, 0x9f, 0x6a, 0x7a, 0x1a, 0xcb, 0x09, 0x39, 0x87, 0x07, 0x28, 0x42,
-		0xc9, 0xb8, 0x98, 0x06, 0x7a, 0x91, 0x96, 0x03, 0xba, 0xdb, 0xfb, 0x62, 0xa3, 0xd6, 0xc0, 0x32,
-		0xcd, 0x44, 0xfb, 0x0d, 0x5c, 0xdb, 0xdd, 0x0e, 0xf0, 0xd6, 0xda, 0x00, 0x0f, 0xcb, 0x4b, 0x87,
-		0xea, 0x0d, 0xaa, 0x8c, 0x4b, 0xe1, 0x89, 0x48, 0x1a, 0x22, 0x4f, 0xd2, 0x78, 0x79, 0x11, 0xcc,
-		0x9a, 0x3c, 0x81, 0x87, 0x11, 0x52, 0x9d, 0x2b, 0x0c, 0xe6, 0x25, 0xd5, 0x5e, 0xb8, 0x5d, 0x1b,
-		0xb6, 0x02, 0x9d, 0xd7, 0xf0, 0x78, 0x94, 0xa7, 0xa9, 0x54, 0x1a, 0xd9, 0x59, 0xcc, 0x51, 0x68,
-		0x8b, 0x64, 0xe6, 0xae, 0x4e, 0x65, 0x90, 0xb1, 0x99, 0x55, 0xae, 0x4e, 0xe5, 0x88, 0xcd, 0xc8,
-		0x01, 0xdc, 0xfb, 0x8d, 0xce, 0x69, 0x01, 0x94, 0x9a, 0x3b, 0x66, 0x3f, 0x62, 0xb3, 0xce, 0xef,
-		0x15, 0xa8, 0xfb, 0xa8, 0xd5, 0x62, 0x28, 0x63, 0x1e, 0x2e, 0x48, 0x1f, 0x9a, 0x5c, 0x70, 0xcd,
-		0x69, 0x1c, 0x70, 0xa1, 0x51, 0xcd, 0x69, 0xe9, 0xb2, 0xde, 0x3b, 0x70, 0xcb, 0xe7, 0xc5, 0x5d,
-		0x3e, 0x2f, 0x6e, 0xdf, 0x3e, 0x2f, 0xfe, 0x43, 0x9b, 0xe2, 0xd9, 0x0c, 0xd2, 0x85, 0xbd, 0x09,
-		0x0d, 0x67, 0x32, 0x8a, 0x82, 0x50, 0x62, 0x14, 0xf1, 0xd0, 0xd8, 0x2c, 0x6a, 0x3b, 0x3e, 0xb1,
-		0xd0, 0xd9, 0x0a, 0x31, 0x65, 0x13, 0x7a, 0xc3, 0x93, 0x3c, 0x59, 0x95, 0xad, 0xbc, 0xb7, 0xac,
-		0x4d, 0xb9, 0x2d, 0xfb, 0xd5, 0x4a, 0x85, 0x6a, 0x8d, 0x49, 0xaa, 0xb3, 0xd6, 0x76, 0xdb, 0x39,
-		0xaa, 0xde, 0x52, 0x4f, 0x6c, 0x98, 0xbc, 0x80, 0x8f, 0x85, 0x14, 0x81, 0x32, 0xad, 0xd3, 0x49,
-		0x8c, 0x01, 0x2a, 0x25, 0x55, 0x50, 0x3e, 0x29, 0x59, 0xab, 0xda, 0xae, 0x1c, 0xdd, 0xf7, 0x5b,
-		0x42, 0x0a, 0x7f, 0xc9, 0x18, 0x18, 0x82, 0x5f, 0xe2, 0xe4, 0x15, 0xec, 0xe1, 0x4d, 0xca, 0x4b,
-		0x23, 0x2b, 0xcb, 0xb5, 0xf7, 0x59, 0x26, 0xab, 0xac, 0xa5, 0xeb, 0xaf, 0xaf, 0xa1, 0xb1, 0x3e,
-		0x53, 0xe4, 0x00, 0x1e, 0x0d, 0x2e, 0xce, 0x2e, 0xfb, 0xde, 0xc5, 0xf7, 0xc1, 0xf8, 0xa7, 0xe1,
-		0x20, 0xf0, 0x2e, 0xde, 0x9c, 0xfc, 0xe0, 0xf5, 0x9b, 0x1f, 0x90, 0x43, 0xd8, 0xbf, 0x0b, 0x8d,
-		0x5f, 0xfa, 0xde, 0xf9, 0xd8, 0xbf, 0x6a, 0x3a, 0x64, 0x1f, 0xc8, 0x5d, 0xec, 0xd5, 0xe8, 0xf2,
-		0xa2, 0xb9, 0x45, 0x5a, 0xf0, 0xd1, 0xdd, 0xf8, 0xd0, 0xbf, 0x1c, 0x5f, 0x3e, 0x6d, 0x56, 0x4e,
-		0x7f, 0x81, 0xc7, 0xa1, 0x4c, 0x36, 0x0d, 0xf9, 0x69, 0xfd, 0xac, 0xf8, 0x6d, 0x86, 0xa6, 0x81,
-		0xa1, 0xf3, 0xf3, 0xf1, 0x94, 0xeb, 0xb7, 0xf9, 0xc4, 0x0d, 0x65, 0xd2, 0x5d, 0xff, 0x9b, 0xbe,
-		0xe1, 0x2c, 0xee, 0x4e, 0x65, 0xf9, 0xe3, 0xd8, 0x8f, 0xea, 0x39, 0x4d, 0xf9, 0xfc, 0x78, 0x52,
-		0x2b, 0x62, 0x4f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x13, 0xdb, 0xef, 0xb7, 0xcc, 0x06, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xd1, 0x6e, 0x1b, 0x45,
+		0x17, 0xfe, 0x37, 0x8e, 0x9d, 0xf6, 0xd8, 0x4d, 0xfd, 0x4f, 0x68, 0xea, 0x04, 0x08, 0xc6, 0x12,
+		0x6a, 0x40, 0x62, 0x57, 0x71, 0x6f, 0x2a, 0xaa, 0x0a, 0x25, 0xb1, 0x43, 0xb7, 0x85, 0xc4, 0x5a,
+		0x5b, 0x8d, 0xe0, 0x82, 0xd5, 0x78, 0xe7, 0xac, 0x3b, 0x78, 0x77, 0x66, 0x35, 0x3b, 0xeb, 0xc4,
+		0x77, 0x3c, 0x09, 0x17, 0x3c, 0x0d, 0x97, 0x48, 0xbc, 0x00, 0xca, 0x93, 0xa0, 0xdd, 0x1d, 0xc7,
+		0x4e, 0x31, 0xea, 0x0d, 0xe2, 0x6e, 0xe6, 0x7c, 0xdf, 0xf9, 0xce, 0x77, 0x46, 0x67, 0x66, 0xa0,
+		0x9d, 0x8d, 0x51, 0x39, 0x01, 0x65, 0x28, 0x02, 0x74, 0x68, 0xc2, 0x9d, 0xd9, 0x91, 0x13, 0xc8,
+		0x38, 0x96, 0xc2, 0x4e, 0x94, 0xd4, 0x92, 0xec, 0xe4, 0x0c, 0xdb, 0x30, 0x6c, 0x9a, 0x70, 0x7b,
+		0x76, 0xb4, 0x7f, 0x30, 0x91, 0x72, 0x12, 0xa1, 0x53, 0x50, 0xc6, 0x59, 0xe8, 0xb0, 0x4c, 0x51,
+		0xcd, 0x17, 0x49, 0x9d, 0xd7, 0xf0, 0xff, 0x4b, 0xa9, 0xa6, 0x61, 0x24, 0xaf, 0xfa, 0xd7, 0x18,
+		0x64, 0x39, 0x44, 0x3e, 0x81, 0xfa, 0x95, 0x09, 0xfa, 0x9c, 0xb5, 0xac, 0xb6, 0x75, 0x78, 0xdf,
+		0x83, 0x45, 0xc8, 0x65, 0xe4, 0x11, 0xd4, 0x54, 0x26, 0x72, 0x6c, 0xa3, 0xc0, 0xaa, 0x2a, 0x13,
+		0x2e, 0xeb, 0x74, 0xa0, 0xb1, 0x10, 0x1b, 0xcd, 0x13, 0x24, 0x04, 0x36, 0x05, 0x8d, 0xd1, 0x08,
+		0x14, 0xeb, 0x9c, 0x73, 0x1c, 0x68, 0x3e, 0xe3, 0x7a, 0xfe, 0x8f, 0x9c, 0x8f, 0x61, 0x6b, 0x40,
+		0xe7, 0x91, 0xa4, 0x2c, 0x87, 0x19, 0xd5, 0xb4, 0x80, 0x1b, 0x5e, 0xb1, 0xee, 0x3c, 0x87, 0xad,
+		0x33, 0xca, 0xa3, 0x4c, 0x21, 0xd9, 0x85, 0x9a, 0x42, 0x9a, 0x4a, 0x61, 0xf2, 0xcd, 0x8e, 0xb4,
+		0x60, 0x8b, 0xa1, 0xa6, 0x3c, 0x4a, 0x0b, 0x87, 0x0d, 0x6f, 0xb1, 0xed, 0xfc, 0x62, 0xc1, 0xe6,
+		0x77, 0x18, 0x4b, 0xf2, 0x02, 0x6a, 0x21, 0xc7, 0x88, 0xa5, 0x2d, 0xab, 0x5d, 0x39, 0xac, 0x77,
+		0x3f, 0xb3, 0xd7, 0x9c, 0x9f, 0x9d, 0x53, 0xed, 0xb3, 0x82, 0xd7, 0x17, 0x5a, 0xcd, 0x3d, 0x93,
+		0xb4, 0x7f, 0x09, 0xf5, 0x95, 0x30, 0x69, 0x42, 0x65, 0x8a, 0x73, 0xe3, 0x22, 0x5f, 0x92, 0x2e,
+		0x54, 0x67, 0x34, 0xca, 0xb0, 0x30, 0x50, 0xef, 0x7e, 0xb4, 0x56, 0xde, 0xb4, 0xe9, 0x95, 0xd4,
+		0xaf, 0x36, 0x9e, 0x59, 0x9d, 0x5f, 0x2d, 0xa8, 0xbd, 0x44, 0xca, 0x50, 0x91, 0xaf, 0xdf, 0xb1,
+		0xf8, 0x64, 0xad, 0x46, 0x49, 0xfe, 0x6f, 0x4d, 0xfe, 0x61, 0x41, 0x73, 0x88, 0x54, 0x05, 0x6f,
+		0x8f, 0xb5, 0x56, 0x7c, 0x9c, 0x69, 0x4c, 0x89, 0x0f, 0xdb, 0x5c, 0x30, 0xbc, 0x46, 0xe6, 0xdf,
+		0xb1, 0xfd, 0x6c, 0xad, 0xea, 0xbb, 0xe9, 0xb6, 0x5b, 0xe6, 0xae, 0xf6, 0xf1, 0x80, 0xaf, 0xc6,
+		0xf6, 0x7f, 0x04, 0xf2, 0x77, 0xd2, 0xbf, 0xd8, 0x55, 0x08, 0xf7, 0x7a, 0x54, 0xd3, 0x93, 0x48,
+		0x8e, 0xc9, 0x19, 0x3c, 0x40, 0x11, 0x48, 0xc6, 0xc5, 0xc4, 0xd7, 0xf3, 0xa4, 0x1c, 0xd0, 0xed,
+		0xee, 0xa7, 0x6b, 0xb5, 0xfa, 0x86, 0x99, 0x4f, 0xb4, 0xd7, 0xc0, 0x95, 0xdd, 0xed, 0x00, 0x6f,
+		0xac, 0x0c, 0xf0, 0xa0, 0xbc, 0x74, 0xa8, 0xde, 0xa0, 0x4a, 0xb9, 0x14, 0xae, 0x08, 0x65, 0x4e,
+		0xe4, 0x71, 0x12, 0x2d, 0x2e, 0x42, 0xbe, 0x26, 0x4f, 0xe0, 0x61, 0x88, 0x54, 0x67, 0x0a, 0xfd,
+		0x59, 0x49, 0x35, 0x17, 0x6e, 0xdb, 0x84, 0x8d, 0x40, 0xe7, 0x35, 0x3c, 0x1e, 0x66, 0x49, 0x22,
+		0x95, 0x46, 0x76, 0x1a, 0x71, 0x14, 0xda, 0x20, 0x69, 0x7e, 0x57, 0x27, 0xd2, 0x4f, 0xd9, 0xd4,
+		0x28, 0x57, 0x27, 0x72, 0xc8, 0xa6, 0x64, 0x0f, 0xee, 0xfd, 0x44, 0x67, 0xb4, 0x00, 0x4a, 0xcd,
+		0xad, 0x7c, 0x3f, 0x64, 0xd3, 0xce, 0xcf, 0x15, 0xa8, 0x7b, 0xa8, 0xd5, 0x7c, 0x20, 0x23, 0x1e,
+		0xcc, 0x49, 0x0f, 0x9a, 0x5c, 0x70, 0xcd, 0x69, 0xe4, 0x73, 0xa1, 0x51, 0xcd, 0x68, 0xe9, 0xb2,
+		0xde, 0xdd, 0xb3, 0xcb, 0xe7, 0xc5, 0x5e, 0x3c, 0x2f, 0x76, 0xcf, 0x3c, 0x2f, 0xde, 0x43, 0x93,
+		0xe2, 0x9a, 0x0c, 0xe2, 0xc0, 0xce, 0x98, 0x06, 0x53, 0x19, 0x86, 0x7e, 0x20, 0x31, 0x0c, 0x79,
+		0x90, 0xdb, 0x2c, 0x6a, 0x5b, 0x1e, 0x31, 0xd0, 0xe9, 0x12, 0xc9, 0xcb, 0xc6, 0xf4, 0x9a, 0xc7,
+		0x59, 0xbc, 0x2c, 0x5b, 0x79, 0x6f, 0x59, 0x93, 0x72, 0x5b, 0xf6, 0xf3, 0xa5, 0x0a, 0xd5, 0x1a,
+		0xe3, 0x44, 0xa7, 0xad, 0xcd, 0xb6, 0x75, 0x58, 0xbd, 0xa5, 0x1e, 0x9b, 0x30, 0x79, 0x01, 0x1f,
+		0x0a, 0x29, 0x7c, 0x95, 0xb7, 0x4e, 0xc7, 0x11, 0xfa, 0xa8, 0x94, 0x54, 0x7e, 0xf9, 0xa4, 0xa4,
+		0xad, 0x6a, 0xbb, 0x72, 0x78, 0xdf, 0x6b, 0x09, 0x29, 0xbc, 0x05, 0xa3, 0x9f, 0x13, 0xbc, 0x12,
+		0x27, 0xaf, 0x60, 0x07, 0xaf, 0x13, 0x5e, 0x1a, 0x59, 0x5a, 0xae, 0xbd, 0xcf, 0x32, 0x59, 0x66,
+		0x2d, 0x5c, 0x7f, 0x71, 0x05, 0x8d, 0xd5, 0x99, 0x22, 0x7b, 0xf0, 0xa8, 0x7f, 0x7e, 0x7a, 0xd1,
+		0x73, 0xcf, 0xbf, 0xf1, 0x47, 0xdf, 0x0f, 0xfa, 0xbe, 0x7b, 0xfe, 0xe6, 0xf8, 0x5b, 0xb7, 0xd7,
+		0xfc, 0x1f, 0xd9, 0x87, 0xdd, 0xbb, 0xd0, 0xe8, 0xa5, 0xe7, 0x9e, 0x8d, 0xbc, 0xcb, 0xa6, 0x45,
+		0x76, 0x81, 0xdc, 0xc5, 0x5e, 0x0d, 0x2f, 0xce, 0x9b, 0x1b, 0xa4, 0x05, 0x1f, 0xdc, 0x8d, 0x0f,
+		0xbc, 0x8b, 0xd1, 0xc5, 0xd3, 0x66, 0xe5, 0x64, 0xf2, 0xdb, 0xcd, 0x81, 0xf5, 0xfb, 0xcd, 0x81,
+		0xf5, 0xe7, 0xcd, 0x81, 0x05, 0x8f, 0x03, 0x19, 0xaf, 0x1b, 0xf8, 0x93, 0xfa, 0x69, 0xf1, 0xf3,
+		0x0c, 0xf2, 0x66, 0x06, 0xd6, 0x0f, 0x47, 0x13, 0xae, 0xdf, 0x66, 0x63, 0x3b, 0x90, 0xb1, 0xb3,
+		0xfa, 0x4f, 0x7d, 0xc9, 0x59, 0xe4, 0x4c, 0x64, 0xf9, 0xfb, 0x98, 0x4f, 0xeb, 0x39, 0x4d, 0xf8,
+		0xec, 0x68, 0x5c, 0x2b, 0x62, 0x4f, 0xff, 0x1a, 0x00, 0x22, 0xa2, 0x58, 0xf5, 0xd8, 0x06, 0x00,
 		0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x5f, 0xe2, 0xd8, 0xea, 0x4a,
-		0xfe, 0x12, 0x6b, 0xe2, 0xa4, 0x80, 0x2a, 0x2d, 0xe3, 0x99, 0xeb, 0x78, 0xe4, 0xdd, 0x9d, 0xed,
-		0xcc, 0xac, 0x37, 0x46, 0xe2, 0x89, 0x07, 0x24, 0xd4, 0x0a, 0xaa, 0x0a, 0x89, 0x0a, 0x10, 0x08,
-		0x09, 0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x90, 0x40, 0x85, 0x27, 0x5e,
-		0x78, 0x80, 0x07, 0x1e, 0xe8, 0x1b, 0x0f, 0x94, 0x37, 0x24, 0x34, 0x77, 0xee, 0xec, 0xce, 0x9f,
-		0x7b, 0x67, 0xee, 0xac, 0x37, 0x2d, 0xa8, 0x79, 0xf3, 0xdc, 0x39, 0xf7, 0xcc, 0xef, 0x9e, 0x7b,
-		0xce, 0xb9, 0xe7, 0x9e, 0x73, 0xd6, 0x70, 0xaa, 0xbd, 0x81, 0xad, 0x05, 0x4d, 0xd5, 0x71, 0x53,
-		0xc3, 0x0b, 0x6a, 0xcb, 0x58, 0xd8, 0xb9, 0xb4, 0xb0, 0x65, 0xd8, 0x8e, 0x69, 0xed, 0x56, 0x5a,
-		0x96, 0xe9, 0x98, 0xe8, 0x80, 0x4b, 0x52, 0xa1, 0x24, 0x15, 0xb5, 0x65, 0x54, 0x76, 0x2e, 0x1d,
-		0x39, 0xf1, 0xd0, 0x34, 0x1f, 0xd6, 0xf1, 0x02, 0x21, 0xd9, 0x68, 0x6f, 0x2e, 0xe8, 0x6d, 0x4b,
-		0x75, 0x0c, 0xb3, 0xe9, 0x4d, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x31, 0x1a, 0xd8, 0x76, 0xd4, 0x46,
-		0x8b, 0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x99, 0x8d, 0x46, 0x97, 0x85, 0xcc, 0xa2, 0x70, 0x54, 0x7b,
-		0xbb, 0x6e, 0xd8, 0x4e, 0x12, 0x4d, 0xc7, 0xb4, 0xb6, 0x37, 0xeb, 0x66, 0xc7, 0xa3, 0x91, 0x6f,
-		0x43, 0xf1, 0x25, 0x6f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x6e, 0x3a, 0xf6, 0x94, 0x34, 0x93,
-		0x9f, 0x2d, 0x2f, 0x9e, 0xaa, 0x30, 0xd6, 0x56, 0xa1, 0xd4, 0xcb, 0x2e, 0xa5, 0x42, 0x27, 0xc8,
-		0xef, 0x5f, 0x83, 0xd1, 0xe0, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19,
-		0x69, 0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xbc, 0x57, 0xee, 0xa2, 0xa7, 0x72,
-		0x33, 0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x93, 0x48, 0xc5, 0x97, 0x48, 0x65, 0xdd, 0x97, 0x88,
-		0x52, 0x22, 0xd4, 0xee, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x6c, 0xc3, 0x6c, 0x4e, 0xe5, 0x3d,
-		0xa6, 0xf4, 0x11, 0x1d, 0x86, 0xa2, 0xbb, 0x78, 0xf7, 0x73, 0x43, 0xe4, 0x4d, 0xc1, 0x7d, 0xac,
-		0xea, 0xe8, 0x6b, 0x12, 0x9c, 0xf7, 0x97, 0x5c, 0xc3, 0x8f, 0xb0, 0xd6, 0x76, 0xf7, 0xa1, 0x66,
-		0x3b, 0xaa, 0xe5, 0x60, 0xbd, 0xe6, 0x21, 0x51, 0x1d, 0xc7, 0x32, 0x36, 0xda, 0x0e, 0xb6, 0xa7,
-		0x86, 0x09, 0x9e, 0xff, 0x65, 0x2e, 0xfd, 0x65, 0xca, 0x67, 0xd9, 0x67, 0x73, 0xcf, 0xe3, 0x42,
-		0x96, 0x7c, 0xb3, 0xcb, 0xe3, 0xa5, 0xa7, 0x94, 0x73, 0x1d, 0x31, 0x52, 0xf4, 0x2d, 0x09, 0x2e,
-		0x30, 0xe0, 0x69, 0x66, 0xa3, 0x55, 0xc7, 0x4c, 0x80, 0x05, 0x02, 0xf0, 0x05, 0x31, 0x80, 0x4b,
-		0x3e, 0x9f, 0x38, 0xc4, 0x67, 0x3a, 0xa2, 0xc4, 0xe8, 0x2d, 0x09, 0xe6, 0x18, 0x20, 0x37, 0x55,
-		0xa3, 0xce, 0x42, 0x58, 0x24, 0x08, 0x6f, 0x88, 0x21, 0x5c, 0x21, 0x4c, 0xe2, 0xf0, 0xce, 0x76,
-		0x84, 0x28, 0xd1, 0x37, 0xd9, 0x02, 0x74, 0x75, 0x4b, 0xaf, 0x99, 0x6d, 0x27, 0x0e, 0x6f, 0x84,
-		0xc0, 0x7b, 0x5e, 0x0c, 0x9e, 0xab, 0x76, 0xfa, 0xdd, 0xb6, 0x13, 0x07, 0x38, 0xdb, 0x11, 0xa4,
-		0x45, 0x6f, 0x4a, 0x30, 0xab, 0x63, 0xcd, 0xb0, 0x09, 0x30, 0x57, 0x4b, 0x6d, 0x6d, 0x0b, 0xeb,
-		0x6d, 0xa6, 0xf0, 0x4a, 0x04, 0xdd, 0x75, 0x26, 0xba, 0xdb, 0x94, 0xc9, 0xba, 0x6a, 0x6f, 0xdf,
-		0xf3, 0x59, 0xc4, 0x91, 0x9d, 0xd1, 0x05, 0xe8, 0xd0, 0xeb, 0x12, 0x9c, 0x8d, 0xa0, 0xe2, 0xd9,
-		0x04, 0x10, 0x4c, 0xd7, 0xd2, 0x31, 0xf1, 0xcc, 0x41, 0xd6, 0x53, 0xa9, 0x18, 0x52, 0x4a, 0x30,
-		0x82, 0xb2, 0xa0, 0x94, 0x12, 0xf4, 0x3f, 0x24, 0x25, 0xae, 0xea, 0xbf, 0x11, 0x43, 0x95, 0xa0,
-		0x59, 0xa3, 0x04, 0xd5, 0xff, 0xa4, 0xa2, 0xe2, 0x2b, 0xd5, 0x69, 0x3d, 0x9d, 0x0c, 0x7d, 0x41,
-		0x82, 0xa7, 0xc3, 0x98, 0x78, 0x96, 0xb8, 0x8f, 0x00, 0xba, 0x9a, 0x0a, 0x88, 0x67, 0x84, 0xa7,
-		0xf4, 0x34, 0x22, 0xb2, 0x6d, 0xaa, 0xe6, 0x18, 0x3b, 0x86, 0xb3, 0x9b, 0xaa, 0xdc, 0x63, 0x09,
-		0xdb, 0x76, 0x93, 0x32, 0x49, 0x53, 0x6e, 0x55, 0x80, 0x8e, 0x28, 0x77, 0x04, 0x15, 0x4f, 0xb9,
-		0xf7, 0x27, 0x28, 0x77, 0x08, 0x13, 0x57, 0xb9, 0xd5, 0x54, 0x2a, 0x86, 0x94, 0x12, 0x94, 0x7b,
-		0x5c, 0x50, 0x4a, 0x49, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0x45, 0x0a, 0xa3, 0xe2, 0x29, 0xd2, 0x44,
-		0x82, 0x22, 0x05, 0x21, 0x71, 0x15, 0x49, 0x4d, 0x23, 0x22, 0x96, 0x16, 0x06, 0x93, 0x60, 0x69,
-		0x28, 0xc1, 0xd2, 0x82, 0x78, 0x12, 0x2c, 0x4d, 0x4d, 0x27, 0x43, 0x1d, 0x38, 0xe1, 0x82, 0xb0,
-		0xf8, 0xda, 0x73, 0x80, 0x00, 0xb9, 0xc8, 0x04, 0xe2, 0x72, 0xb5, 0xb8, 0x6a, 0x73, 0xd4, 0xe1,
-		0xbf, 0x46, 0xaf, 0xc2, 0x31, 0xef, 0xc3, 0x9b, 0x86, 0xc5, 0xfa, 0xec, 0x41, 0xf2, 0xd9, 0x0a,
-		0xff, 0xb3, 0x2b, 0xee, 0xbc, 0xf8, 0x47, 0xa7, 0x1d, 0xde, 0x4b, 0xf4, 0x1d, 0x09, 0x16, 0x22,
-		0x2a, 0xaa, 0x36, 0x35, 0x5c, 0xaf, 0x59, 0xf8, 0xd5, 0x36, 0xb6, 0x99, 0xab, 0x3f, 0x44, 0x60,
-		0xbc, 0x98, 0xae, 0xa9, 0x84, 0x93, 0xe2, 0x33, 0x8a, 0xe3, 0x9a, 0x53, 0x85, 0xa9, 0xd1, 0x0f,
-		0x25, 0xb8, 0x42, 0x31, 0xf9, 0x10, 0xc5, 0x94, 0x78, 0x92, 0xa0, 0x5d, 0x62, 0xa2, 0xa5, 0x5f,
-		0xf3, 0x3e, 0x2d, 0xa2, 0xd1, 0x15, 0x2b, 0xd3, 0x0c, 0xf4, 0x25, 0x09, 0xce, 0xb1, 0xc4, 0xcb,
-		0x02, 0x7a, 0x58, 0x50, 0xbb, 0x97, 0x28, 0x87, 0x14, 0xed, 0xe6, 0x90, 0xa1, 0xcf, 0xc0, 0x49,
-		0x4f, 0xc9, 0xf8, 0x48, 0xa6, 0x08, 0x92, 0x4b, 0x7c, 0x3d, 0xe3, 0x43, 0xf0, 0x14, 0x98, 0xf7,
-		0xed, 0xcf, 0x4b, 0x70, 0x86, 0x6e, 0x1e, 0x55, 0x74, 0xce, 0xa6, 0x4d, 0x13, 0x04, 0xcf, 0x32,
-		0x11, 0x78, 0xcc, 0x3d, 0x7d, 0xe7, 0x6c, 0xd3, 0x8c, 0x96, 0x42, 0x83, 0x3e, 0x0b, 0x33, 0x0d,
-		0xd5, 0xda, 0xc6, 0x56, 0xcd, 0xc2, 0x9a, 0x69, 0xe9, 0x2c, 0x10, 0x47, 0x08, 0x88, 0x45, 0x26,
-		0x88, 0xff, 0x27, 0x93, 0x15, 0x3a, 0x37, 0x8e, 0xe0, 0x78, 0x23, 0x89, 0x00, 0x7d, 0x43, 0x82,
-		0x79, 0xd6, 0xfd, 0xc4, 0x78, 0xd8, 0x54, 0x99, 0x02, 0x39, 0x9a, 0x25, 0x7c, 0xbd, 0x47, 0xd9,
-		0x88, 0x84, 0xaf, 0x1c, 0x5a, 0xf4, 0x6d, 0x09, 0x2a, 0xac, 0x08, 0x1b, 0x5b, 0x0d, 0xa3, 0xa9,
-		0x32, 0xfd, 0xc2, 0xb1, 0x04, 0xbf, 0x10, 0x0f, 0xb1, 0xbb, 0x8c, 0x18, 0x7e, 0xa1, 0x23, 0x4c,
-		0x8d, 0x7e, 0x24, 0xc1, 0x15, 0xd6, 0x55, 0x2a, 0xd5, 0x8b, 0x1d, 0x27, 0x68, 0x6f, 0x0b, 0xde,
-		0xa8, 0xd2, 0x5c, 0xd9, 0x42, 0x27, 0xdb, 0x14, 0x9e, 0x06, 0xf0, 0x8d, 0xf2, 0x44, 0x16, 0x0d,
-		0xe0, 0x1b, 0xe8, 0x6c, 0x47, 0x90, 0x16, 0xfd, 0x55, 0x82, 0xe5, 0x88, 0xc7, 0xc5, 0x8f, 0x1c,
-		0x6c, 0x35, 0xd5, 0x7a, 0x8d, 0x81, 0xdc, 0x68, 0x1a, 0x8e, 0xc1, 0x56, 0x8c, 0x93, 0x04, 0xfa,
-		0xbd, 0x74, 0x17, 0xbc, 0x4c, 0xf9, 0xc7, 0xd6, 0x53, 0xf5, 0x99, 0xc7, 0x17, 0xf4, 0x82, 0xb5,
-		0x27, 0x0e, 0xe8, 0x4f, 0x12, 0xdc, 0xca, 0xb0, 0x4c, 0x9e, 0xc7, 0x9a, 0x21, 0x6b, 0x5c, 0xdb,
-		0xc3, 0x1a, 0x79, 0xce, 0xec, 0x86, 0xd5, 0xff, 0x74, 0xf4, 0x9e, 0x04, 0xcf, 0x27, 0x2d, 0x27,
-		0xdd, 0x4e, 0x4e, 0x91, 0x85, 0xad, 0x32, 0x17, 0xc6, 0x05, 0x93, 0x6a, 0x2f, 0xd7, 0x70, 0x7f,
-		0x53, 0x49, 0x1c, 0xc0, 0x4c, 0x9d, 0x34, 0x1d, 0xa3, 0xd9, 0xc6, 0x7a, 0x4d, 0xb5, 0x6b, 0x4d,
-		0xdc, 0x89, 0xaf, 0x43, 0x4e, 0x88, 0x03, 0x18, 0x19, 0x14, 0xca, 0xee, 0xa6, 0x7d, 0x07, 0x77,
-		0x18, 0x71, 0x40, 0x27, 0xd3, 0x0c, 0xf4, 0x4b, 0x09, 0xae, 0x93, 0x68, 0xb2, 0xa6, 0x6d, 0x19,
-		0x75, 0x3d, 0xa3, 0xfd, 0x9c, 0x26, 0xd0, 0x5f, 0x62, 0x42, 0x27, 0xa1, 0xe4, 0x92, 0xcb, 0x34,
-		0x8b, 0xd1, 0x5c, 0xb6, 0xb3, 0x4f, 0x43, 0x3f, 0x95, 0xe0, 0x6a, 0xca, 0x22, 0x78, 0xd6, 0x71,
-		0x86, 0xac, 0x60, 0x39, 0xeb, 0x0a, 0x78, 0x26, 0x71, 0xd1, 0xce, 0x38, 0x07, 0x7d, 0x4f, 0x82,
-		0x4b, 0x5c, 0xd4, 0xdc, 0x38, 0xff, 0x69, 0x02, 0xfb, 0x26, 0x3b, 0x0c, 0x61, 0x7e, 0x9d, 0x1b,
-		0xf8, 0xcf, 0x6b, 0x19, 0xe8, 0xd1, 0x0f, 0x24, 0xb8, 0xcc, 0x85, 0x9b, 0x70, 0x89, 0x3c, 0x9b,
-		0xa0, 0xe4, 0x6c, 0xc0, 0x09, 0xd7, 0xc9, 0x8a, 0x96, 0x69, 0x06, 0x7a, 0x47, 0x82, 0x8b, 0x99,
-		0x35, 0xe3, 0x1c, 0x41, 0xfc, 0x7f, 0x19, 0x10, 0xf3, 0x94, 0xe2, 0xbc, 0x96, 0x41, 0x1f, 0xde,
-		0x95, 0x60, 0x91, 0x2f, 0x60, 0xee, 0x21, 0x3c, 0x4b, 0xd0, 0xde, 0xca, 0x22, 0x5f, 0xee, 0x49,
-		0x7c, 0x41, 0xcb, 0x32, 0x01, 0x7d, 0x3f, 0x49, 0x25, 0x12, 0x2e, 0xcd, 0xcf, 0x64, 0x86, 0xcc,
-		0xbf, 0x3e, 0x73, 0x20, 0xf3, 0x2e, 0xd2, 0x6e, 0x6c, 0xc6, 0x87, 0x9c, 0x10, 0x49, 0xce, 0x25,
-		0xc4, 0x66, 0x1c, 0xcc, 0x09, 0xe1, 0xe4, 0x82, 0x96, 0x6d, 0x0a, 0x39, 0x34, 0xbd, 0x50, 0xbc,
-		0xdf, 0x88, 0xe7, 0x7c, 0xc2, 0xa1, 0xe9, 0x45, 0xdc, 0xfd, 0x84, 0x3a, 0xd7, 0xec, 0xfe, 0xa6,
-		0xa2, 0x5f, 0x49, 0xf0, 0x9c, 0xc0, 0x82, 0x78, 0x36, 0x3a, 0x4f, 0x56, 0x53, 0xed, 0x67, 0x35,
-		0x3c, 0x63, 0xbd, 0x62, 0xf7, 0x31, 0x0f, 0xfd, 0x44, 0x82, 0x67, 0x93, 0x16, 0xc0, 0xbf, 0x3f,
-		0x5d, 0x48, 0x38, 0x80, 0xb8, 0x20, 0xf8, 0xf7, 0xa8, 0x8b, 0x38, 0xe3, 0x1c, 0xe2, 0x70, 0xda,
-		0x2d, 0x1b, 0x5b, 0x4e, 0x0f, 0xb8, 0x8d, 0x55, 0x4b, 0xdb, 0x0a, 0xc0, 0x8c, 0xe3, 0xae, 0x24,
-		0x58, 0xef, 0x7d, 0xc2, 0xce, 0x47, 0x70, 0x8f, 0x30, 0xeb, 0x7d, 0x91, 0x61, 0xbd, 0xed, 0x2c,
-		0x13, 0x6e, 0x8d, 0x02, 0xf4, 0x80, 0xc8, 0x7f, 0x29, 0xc3, 0x39, 0xd1, 0xd3, 0x6b, 0x05, 0xf6,
-		0x75, 0xd7, 0xe8, 0xec, 0xb6, 0x30, 0xa9, 0x05, 0xf2, 0x2a, 0x8b, 0x3e, 0xd3, 0xf5, 0xdd, 0x16,
-		0x56, 0x46, 0x3b, 0x81, 0x27, 0xf4, 0x0a, 0x1c, 0x6a, 0xa9, 0x96, 0x2b, 0x91, 0xa0, 0xd1, 0x6d,
-		0x9a, 0xb4, 0x7c, 0x38, 0xcb, 0xe4, 0xb7, 0x46, 0x66, 0x04, 0x6c, 0x62, 0xd3, 0x54, 0x0e, 0xb4,
-		0xe2, 0x83, 0xe8, 0x39, 0x28, 0x91, 0x8c, 0x4c, 0xdd, 0xb0, 0x1d, 0x52, 0x58, 0x2c, 0x2f, 0x1e,
-		0x67, 0xa7, 0x3c, 0x54, 0x7b, 0x7b, 0xd5, 0xb0, 0x1d, 0x65, 0xc4, 0xa1, 0x7f, 0xa1, 0x45, 0x18,
-		0x36, 0x9a, 0xad, 0xb6, 0x43, 0xca, 0x8e, 0xe5, 0xc5, 0x63, 0x1c, 0x24, 0xbb, 0x75, 0x53, 0xd5,
-		0x15, 0x8f, 0x14, 0xa9, 0x30, 0x13, 0x09, 0x39, 0x6a, 0x8e, 0x59, 0xd3, 0xea, 0xa6, 0x8d, 0x89,
-		0xff, 0x36, 0xdb, 0x0e, 0xad, 0x43, 0x4e, 0xc7, 0xea, 0xa2, 0xb7, 0x69, 0x25, 0x59, 0x39, 0x86,
-		0x43, 0xb2, 0x5f, 0x37, 0x97, 0xdc, 0xf9, 0xeb, 0xde, 0x74, 0xf4, 0x32, 0x1c, 0xed, 0xa5, 0xbd,
-		0xe3, 0xdc, 0x0b, 0x69, 0xdc, 0x0f, 0x3b, 0x7e, 0x32, 0x3b, 0xc2, 0xf8, 0x06, 0x1c, 0xe9, 0x45,
-		0xd8, 0xbd, 0x55, 0x58, 0xed, 0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xee, 0x52, 0x74,
-		0xe5, 0xac, 0xb4, 0x9b, 0x55, 0x1d, 0x55, 0xa1, 0x44, 0x5d, 0xa5, 0x69, 0x91, 0x3a, 0xdc, 0xd8,
-		0xe2, 0x79, 0xb6, 0x6b, 0xa7, 0x0c, 0x48, 0x08, 0x5d, 0xf5, 0xa7, 0x28, 0xbd, 0xd9, 0xa8, 0x0a,
-		0x13, 0x3d, 0x1c, 0xae, 0xbb, 0x6a, 0x5b, 0x98, 0x16, 0xcf, 0xd8, 0x7b, 0xb0, 0xe2, 0xd1, 0x28,
-		0xe3, 0xdd, 0x69, 0x74, 0x04, 0x29, 0x30, 0x59, 0x57, 0xdd, 0x3b, 0x9f, 0x17, 0xce, 0x90, 0xe5,
-		0x60, 0xbb, 0x5d, 0x77, 0x68, 0xe1, 0x2b, 0x79, 0x4f, 0x0f, 0xba, 0x73, 0x97, 0xba, 0x53, 0x15,
-		0x32, 0x13, 0x5d, 0x87, 0x69, 0xd3, 0x32, 0x1e, 0x1a, 0x9e, 0xa3, 0x8d, 0x48, 0xa9, 0x4c, 0xa4,
-		0x34, 0xe9, 0x13, 0x44, 0x84, 0x74, 0x04, 0x46, 0x0c, 0x1d, 0x37, 0x1d, 0xc3, 0xd9, 0x25, 0x15,
-		0xa5, 0x92, 0xd2, 0x7d, 0x46, 0x97, 0x61, 0x72, 0xd3, 0xb0, 0x6c, 0x27, 0xce, 0x73, 0x1f, 0xa1,
-		0x3c, 0x40, 0xde, 0x46, 0x18, 0x2e, 0xc1, 0xa8, 0x85, 0x1d, 0x6b, 0xb7, 0xd6, 0x32, 0xeb, 0x86,
-		0xb6, 0x4b, 0xab, 0x30, 0x33, 0x9c, 0x0b, 0xaa, 0x63, 0xed, 0xae, 0x11, 0x3a, 0xa5, 0x6c, 0xf5,
-		0x1e, 0xd0, 0x14, 0x14, 0x55, 0xc7, 0xc1, 0x8d, 0x96, 0x43, 0x2a, 0x26, 0xc3, 0x8a, 0xff, 0x88,
-		0x96, 0x60, 0x3f, 0x7e, 0xd4, 0x32, 0x3c, 0xc5, 0xf1, 0x8a, 0xfa, 0xe3, 0xa9, 0x45, 0xfd, 0xb1,
-		0xde, 0x14, 0x52, 0xd9, 0x3f, 0x0d, 0xfb, 0x34, 0xcb, 0xb5, 0x06, 0x5a, 0xd1, 0x21, 0x15, 0x87,
-		0x92, 0x32, 0xea, 0x0e, 0xfa, 0x55, 0x1e, 0xf4, 0x09, 0x38, 0xea, 0xad, 0x3e, 0x5c, 0xfd, 0xda,
-		0x50, 0xb5, 0x6d, 0x73, 0x73, 0x93, 0x16, 0x05, 0x12, 0x94, 0x7a, 0x8a, 0xcc, 0x0e, 0x16, 0xbe,
-		0x6e, 0x79, 0x53, 0xd1, 0x05, 0x18, 0x6a, 0xe0, 0x86, 0x49, 0xd3, 0xf9, 0xd3, 0xec, 0x44, 0x1f,
-		0x6e, 0x98, 0x0a, 0x21, 0x43, 0x0a, 0x4c, 0xc4, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b,
-		0x23, 0x1e, 0x56, 0x19, 0xb7, 0x23, 0x23, 0xe8, 0x3e, 0x4c, 0xb6, 0x2c, 0xbc, 0x53, 0x53, 0xdb,
-		0x8e, 0xe9, 0xea, 0x1f, 0x76, 0x6a, 0x2d, 0xd3, 0x68, 0x3a, 0x7e, 0x96, 0x9d, 0xb7, 0x5f, 0x36,
-		0x76, 0xd6, 0x08, 0x9d, 0x72, 0xc0, 0x9d, 0x7f, 0xb3, 0xed, 0x98, 0x81, 0x41, 0x74, 0x19, 0x0a,
-		0x5b, 0x58, 0xd5, 0xb1, 0x45, 0xd3, 0xdf, 0x47, 0xd9, 0x4d, 0x1d, 0x84, 0x44, 0xa1, 0xa4, 0xf2,
-		0x3b, 0x12, 0x3c, 0x23, 0x1e, 0xed, 0x5f, 0x81, 0x02, 0xb5, 0x17, 0x49, 0xc0, 0x5e, 0x28, 0x2d,
-		0x5a, 0x81, 0x99, 0xe4, 0x72, 0xaf, 0xa1, 0x13, 0xef, 0x9e, 0x57, 0x8e, 0xf1, 0x2b, 0xb5, 0x55,
-		0x5d, 0x7e, 0x5b, 0x82, 0xb3, 0x82, 0x41, 0xc3, 0x55, 0x28, 0xfa, 0x9e, 0x42, 0x12, 0xf0, 0x14,
-		0x3e, 0xf1, 0xc0, 0xa0, 0x9a, 0x30, 0x2b, 0x1c, 0x31, 0x2f, 0xc1, 0x28, 0x75, 0xd6, 0xbd, 0x83,
-		0x73, 0x8c, 0xa3, 0x04, 0xd4, 0x37, 0x93, 0x73, 0xb3, 0xec, 0xf4, 0x1e, 0xe4, 0xdf, 0x49, 0x70,
-		0x46, 0xa4, 0x69, 0x20, 0x7c, 0x02, 0x4a, 0xd9, 0x4e, 0xc0, 0x3b, 0x30, 0xc9, 0x39, 0x65, 0x72,
-		0x69, 0x06, 0x79, 0xc0, 0x66, 0x9c, 0x30, 0x01, 0x4f, 0x93, 0x0f, 0x79, 0x1a, 0xf9, 0x75, 0x09,
-		0xe4, 0xf4, 0x7e, 0x03, 0x34, 0x0f, 0x28, 0x5a, 0x83, 0xee, 0x76, 0x21, 0x8d, 0xdb, 0x21, 0x11,
-		0x44, 0xdc, 0x6d, 0x2e, 0xe2, 0x6e, 0x8f, 0x03, 0xf8, 0x09, 0x41, 0x43, 0x27, 0x68, 0x4a, 0x4a,
-		0x89, 0x8e, 0x54, 0x75, 0xf9, 0xef, 0x11, 0xf1, 0x72, 0x2d, 0x24, 0x1b, 0xa2, 0x59, 0x18, 0x0f,
-		0xe7, 0x21, 0xba, 0xea, 0x35, 0x66, 0x07, 0x56, 0x1c, 0xc1, 0x9e, 0x8f, 0x60, 0x3f, 0x07, 0xfb,
-		0x37, 0x8c, 0xa6, 0x6a, 0xed, 0xd6, 0xb4, 0x2d, 0xac, 0x6d, 0xdb, 0xed, 0x06, 0x09, 0x51, 0x4a,
-		0xca, 0x98, 0x37, 0xbc, 0x44, 0x47, 0xd1, 0x79, 0x98, 0x08, 0x67, 0xcf, 0xf0, 0x23, 0x2f, 0xfc,
-		0x18, 0x55, 0xc6, 0x71, 0x30, 0xa9, 0x85, 0x1f, 0x39, 0xf2, 0x6b, 0x79, 0x38, 0x2d, 0xd0, 0xca,
-		0xf0, 0xd8, 0x56, 0x1c, 0x35, 0x8b, 0x7c, 0x1f, 0x66, 0x81, 0x4e, 0x40, 0x79, 0x43, 0xb5, 0xb1,
-		0x7f, 0x74, 0x7a, 0x62, 0x29, 0xb9, 0x43, 0xde, 0x81, 0x79, 0x0c, 0xa0, 0x89, 0x3b, 0xfe, 0xeb,
-		0x61, 0x4f, 0xb0, 0x4d, 0xdc, 0xf1, 0xde, 0xce, 0x03, 0xda, 0x34, 0xad, 0x6d, 0x8a, 0xd4, 0xef,
-		0x47, 0x2b, 0x78, 0x4b, 0x73, 0xdf, 0x10, 0xac, 0x0f, 0x68, 0x63, 0xda, 0xa4, 0xeb, 0x1c, 0x55,
-		0xdb, 0x6c, 0xd2, 0xd8, 0x88, 0x3e, 0xa1, 0xdb, 0x30, 0xac, 0xa9, 0x6d, 0x1b, 0xd3, 0x30, 0xa8,
-		0x22, 0xdc, 0x34, 0xb2, 0xe4, 0xce, 0x52, 0xbc, 0xc9, 0xf2, 0xdb, 0x79, 0x38, 0x95, 0xda, 0xc8,
-		0xf1, 0xd8, 0x36, 0xe3, 0x96, 0xbf, 0x06, 0x6f, 0x17, 0xe6, 0x05, 0xfb, 0x4c, 0x82, 0x2b, 0x08,
-		0xfa, 0xe4, 0xa1, 0x2c, 0x3e, 0x39, 0xa8, 0xfa, 0xc3, 0x11, 0xd5, 0x8f, 0xec, 0x6f, 0x21, 0x79,
-		0x7f, 0x8b, 0x42, 0xfb, 0x3b, 0xc2, 0xd9, 0x5f, 0x86, 0x99, 0x95, 0x58, 0x66, 0x26, 0x7f, 0xbd,
-		0x00, 0x67, 0x44, 0x7a, 0x5c, 0xd0, 0x49, 0x28, 0x77, 0x0b, 0xc5, 0x74, 0x9b, 0x4a, 0x0a, 0xf8,
-		0x43, 0x55, 0xdd, 0xbd, 0x54, 0xf5, 0x2a, 0xc9, 0xae, 0x11, 0xe4, 0x12, 0x2e, 0x55, 0xdd, 0x4f,
-		0x92, 0x4b, 0x95, 0x1a, 0x78, 0x72, 0x55, 0x53, 0x37, 0x1b, 0xaa, 0xd1, 0xa4, 0xbe, 0x83, 0x3e,
-		0x85, 0x0f, 0x83, 0xa1, 0x3e, 0xaf, 0x43, 0x05, 0xf1, 0xeb, 0xd0, 0x3a, 0x4c, 0xfb, 0x4a, 0x18,
-		0x3f, 0x43, 0x8a, 0x69, 0x67, 0xc8, 0xa4, 0x3f, 0x37, 0x72, 0x8c, 0x44, 0xb8, 0xd2, 0x23, 0x8a,
-		0x72, 0x1d, 0xc9, 0xc0, 0xd5, 0xbb, 0x05, 0x51, 0xae, 0xfc, 0xc3, 0xae, 0xd4, 0xd7, 0x61, 0xb7,
-		0x02, 0x13, 0x5b, 0x58, 0xb5, 0x9c, 0x0d, 0xac, 0xf6, 0xd0, 0x41, 0x1a, 0xab, 0xf1, 0xee, 0x9c,
-		0x1e, 0x9f, 0xf4, 0x10, 0xa5, 0x9c, 0x1e, 0xa2, 0xc4, 0xee, 0x0a, 0xa3, 0xfd, 0xdc, 0x15, 0x7a,
-		0x31, 0xe7, 0x3e, 0xf1, 0x98, 0xf3, 0x6f, 0x12, 0xc8, 0xe9, 0xfd, 0x56, 0x1f, 0xda, 0xe1, 0x1e,
-		0x0c, 0x43, 0x86, 0xc2, 0x17, 0x9e, 0x17, 0x61, 0x94, 0xdc, 0x17, 0x7d, 0xbf, 0x35, 0x2c, 0xe0,
-		0xb7, 0xca, 0xee, 0x0c, 0xfa, 0x20, 0xff, 0x41, 0x0a, 0xbb, 0x82, 0x01, 0x47, 0xd6, 0x6c, 0x11,
-		0xe5, 0x32, 0xb8, 0xfb, 0x7c, 0x6a, 0xb4, 0x31, 0x14, 0x16, 0xa6, 0xfc, 0x7b, 0x09, 0x4e, 0xa5,
-		0x37, 0xc1, 0xf4, 0x1b, 0x80, 0x7f, 0x14, 0x2b, 0xfa, 0x59, 0x0e, 0x4e, 0x0b, 0xb4, 0x92, 0xb9,
-		0x6b, 0xd2, 0xb1, 0xa3, 0x1a, 0x75, 0x5b, 0x68, 0x93, 0x7c, 0xe2, 0xc7, 0xb6, 0xa6, 0x68, 0x84,
-		0x34, 0xd4, 0x4f, 0x84, 0xb4, 0x67, 0x15, 0xff, 0xb2, 0x04, 0x73, 0xe2, 0x1d, 0x60, 0x22, 0x67,
-		0xde, 0x60, 0xae, 0x60, 0xef, 0x4a, 0x90, 0xb1, 0xd7, 0x2b, 0x1d, 0xdb, 0x41, 0x3f, 0x0c, 0xf2,
-		0x3c, 0x0c, 0x0d, 0x6c, 0x44, 0x10, 0xe7, 0x05, 0x10, 0xbf, 0x15, 0xd1, 0x43, 0x5e, 0x55, 0xa8,
-		0x5f, 0x3d, 0x5c, 0x81, 0x99, 0xba, 0xea, 0x04, 0x7a, 0x1e, 0xa2, 0x1d, 0x00, 0x3d, 0xc9, 0x7a,
-		0x74, 0xac, 0xad, 0xf4, 0xc2, 0x26, 0x86, 0x3e, 0xe7, 0x33, 0xe8, 0xf3, 0x50, 0xaa, 0x8d, 0x46,
-		0x02, 0x3d, 0xf9, 0x3d, 0x09, 0x8e, 0x26, 0x74, 0x59, 0xa2, 0x69, 0x18, 0xf1, 0xba, 0xcb, 0xba,
-		0xfb, 0x56, 0x24, 0xcf, 0x55, 0x1d, 0xad, 0xc2, 0xa1, 0xee, 0x41, 0xbe, 0x69, 0x58, 0x19, 0x2e,
-		0xad, 0x88, 0x9e, 0xe3, 0x2b, 0x86, 0x85, 0xb3, 0x1c, 0xbf, 0x22, 0x9b, 0xfd, 0x69, 0x98, 0xe6,
-		0xb6, 0x6f, 0x26, 0xad, 0x46, 0x38, 0x66, 0x97, 0x7f, 0x2d, 0xc1, 0xb1, 0xa4, 0xce, 0xbd, 0x81,
-		0x7c, 0x65, 0x50, 0xf2, 0x48, 0x74, 0xd0, 0x3f, 0x96, 0x60, 0x26, 0xad, 0x03, 0x30, 0x69, 0x35,
-		0x8f, 0xd5, 0x6c, 0x13, 0x91, 0xff, 0xab, 0x08, 0x19, 0x1b, 0x4d, 0xd0, 0x02, 0x1c, 0x24, 0xbd,
-		0x2c, 0xd1, 0xb4, 0xaf, 0xb7, 0xa6, 0x89, 0x26, 0xee, 0x44, 0x92, 0xbe, 0xb1, 0xca, 0x4b, 0xae,
-		0xbf, 0xca, 0xcb, 0x93, 0xda, 0x88, 0x78, 0x6d, 0x44, 0x44, 0x77, 0x8a, 0x02, 0xba, 0x73, 0x17,
-		0x26, 0x69, 0x4e, 0x9b, 0x62, 0x34, 0x9a, 0x0e, 0xb6, 0x76, 0xd4, 0x7a, 0xfa, 0xbd, 0xe5, 0x20,
-		0x9d, 0x48, 0xe0, 0x55, 0xe9, 0xb4, 0x70, 0xdd, 0xa5, 0xb4, 0xa7, 0xba, 0x4b, 0x20, 0x84, 0x83,
-		0x2c, 0x21, 0x1c, 0xbf, 0xc8, 0x52, 0xee, 0xbb, 0xc8, 0xd2, 0xbb, 0x67, 0x8c, 0x0a, 0xdf, 0x33,
-		0xba, 0xa9, 0xfe, 0x7d, 0x7b, 0x48, 0xf5, 0x8f, 0xed, 0x29, 0xd5, 0xef, 0xfa, 0xe0, 0x85, 0xac,
-		0xdd, 0x6e, 0x5d, 0x6f, 0x25, 0x05, 0xbd, 0x55, 0xd2, 0xfd, 0x66, 0x03, 0x0e, 0x77, 0x2b, 0xe4,
-		0x91, 0xaa, 0xa9, 0x67, 0xc7, 0x73, 0x89, 0x35, 0xf0, 0x70, 0xdd, 0xf4, 0x10, 0x66, 0x0d, 0xcb,
-		0xdf, 0x95, 0x18, 0x29, 0x6d, 0xde, 0xc9, 0x22, 0x62, 0x1e, 0x92, 0x80, 0x79, 0x04, 0x22, 0x9d,
-		0x5c, 0x86, 0x48, 0x47, 0xfe, 0x40, 0x82, 0xe3, 0x89, 0xdd, 0xda, 0x6e, 0xa8, 0x47, 0x7b, 0xc1,
-		0x9b, 0x6a, 0xc3, 0x17, 0x35, 0x78, 0x43, 0x77, 0xd4, 0x06, 0xee, 0xf7, 0xd3, 0x03, 0x3b, 0x55,
-		0x7a, 0x1a, 0x3f, 0x24, 0x7e, 0xb3, 0xfe, 0x2a, 0x6b, 0x93, 0x78, 0xdd, 0x09, 0x27, 0xa1, 0x4c,
-		0xfb, 0x43, 0x82, 0x22, 0xf0, 0x86, 0x88, 0x08, 0xba, 0x4e, 0x3d, 0x27, 0xee, 0xd4, 0x13, 0xf2,
-		0xd4, 0xf2, 0x57, 0x24, 0x98, 0xcb, 0xd0, 0x91, 0xd3, 0xcb, 0xa7, 0x4a, 0xa1, 0x7c, 0x6a, 0xbf,
-		0x3b, 0x93, 0x04, 0xed, 0x17, 0x39, 0x78, 0x61, 0x6f, 0x5d, 0xc9, 0x03, 0xd3, 0xf9, 0x5e, 0xae,
-		0x2e, 0x17, 0xca, 0xd5, 0xdd, 0x07, 0x14, 0xef, 0x7e, 0xa1, 0xf6, 0x7d, 0x56, 0xac, 0xc3, 0x55,
-		0x99, 0x88, 0xb5, 0xb0, 0xa2, 0x29, 0x28, 0x6a, 0x66, 0xd3, 0xb1, 0xcc, 0x3a, 0x51, 0xb4, 0x51,
-		0xc5, 0x7f, 0x44, 0x15, 0x38, 0x10, 0x69, 0xe4, 0x32, 0x9b, 0x75, 0x2f, 0x32, 0x1f, 0x51, 0x26,
-		0x42, 0xfd, 0x55, 0x77, 0x9b, 0xf5, 0x5d, 0xf9, 0xcd, 0x3c, 0xdc, 0xd8, 0x43, 0xd7, 0x33, 0xba,
-		0x1f, 0xf4, 0x7b, 0x63, 0x9c, 0xdf, 0x14, 0x08, 0x71, 0x0e, 0xa5, 0x9d, 0x07, 0x74, 0x9f, 0xe4,
-		0xe6, 0x50, 0xd9, 0xfb, 0x32, 0xb4, 0xd7, 0x7d, 0x99, 0x07, 0x14, 0xed, 0x35, 0xa3, 0x15, 0x8a,
-		0xbc, 0x32, 0x6e, 0x84, 0x94, 0xd0, 0x4b, 0x61, 0xf9, 0xbb, 0x58, 0x08, 0xed, 0xa2, 0xfc, 0x47,
-		0x09, 0xae, 0xf5, 0xd9, 0xb2, 0xcd, 0xc1, 0x20, 0x71, 0x30, 0x7c, 0xb8, 0x8a, 0x2b, 0x7f, 0x31,
-		0x0f, 0xd7, 0xfa, 0x6c, 0xab, 0xfb, 0x6f, 0xb5, 0xd5, 0x88, 0xc7, 0x1e, 0xe2, 0x7b, 0xec, 0x61,
-		0x71, 0x8f, 0xcd, 0x55, 0x1d, 0x9e, 0x03, 0x28, 0xf2, 0x1c, 0xc0, 0x6b, 0x79, 0xb8, 0xd2, 0x4f,
-		0x6b, 0xa0, 0x98, 0xe5, 0x0b, 0x71, 0x7e, 0x62, 0xf9, 0x3d, 0xcb, 0x7f, 0x5f, 0x82, 0x8b, 0x59,
-		0xdb, 0x1c, 0xff, 0xa3, 0x4d, 0x9e, 0x7f, 0x56, 0xc9, 0xbf, 0x95, 0xe0, 0x42, 0xa6, 0xd6, 0xc8,
-		0x81, 0xb9, 0x00, 0xe6, 0xad, 0x21, 0xb7, 0xb7, 0x5b, 0xc3, 0x9f, 0x47, 0xe0, 0x72, 0x1f, 0xbf,
-		0xf1, 0x08, 0x6c, 0x87, 0x14, 0xda, 0x8e, 0x93, 0x50, 0xee, 0x6e, 0x07, 0xd5, 0xf9, 0x92, 0x02,
-		0xfe, 0x10, 0x2b, 0x85, 0x90, 0x1f, 0x40, 0x0a, 0xa1, 0xdf, 0x7a, 0xe2, 0xf0, 0x60, 0x53, 0x08,
-		0x85, 0xc7, 0x9a, 0x42, 0x28, 0xf6, 0x9d, 0x42, 0x78, 0x00, 0xb4, 0x43, 0x95, 0x72, 0xa4, 0x65,
-		0x38, 0xaf, 0x49, 0xe0, 0x6c, 0x42, 0x9b, 0x2b, 0xe1, 0x42, 0x8b, 0x71, 0x13, 0xad, 0xe8, 0x50,
-		0xd0, 0x48, 0x4a, 0x61, 0x7f, 0x2e, 0xa2, 0xf2, 0x20, 0xa0, 0xf2, 0x1a, 0x4c, 0x05, 0xd4, 0xa9,
-		0x66, 0xe1, 0x76, 0x0f, 0x7e, 0x99, 0xc0, 0x9f, 0x4b, 0x54, 0x9c, 0xaa, 0xae, 0xb8, 0x53, 0xe8,
-		0x12, 0x0e, 0x75, 0x58, 0xc3, 0xb1, 0xf2, 0xe4, 0xbe, 0x7e, 0xca, 0x93, 0xb1, 0x5e, 0xc3, 0x31,
-		0x46, 0xaf, 0x61, 0xef, 0xa6, 0xb5, 0x3f, 0x7b, 0x6e, 0x61, 0x7c, 0x0f, 0xb9, 0x85, 0x89, 0xbd,
-		0xb5, 0x11, 0x3e, 0x07, 0x65, 0x1d, 0xd7, 0xd5, 0x5d, 0x4f, 0x35, 0xd3, 0x7b, 0x22, 0x81, 0x50,
-		0x13, 0x55, 0x94, 0xdf, 0xc8, 0xc3, 0xc5, 0xac, 0xbf, 0xc1, 0xfa, 0xe8, 0xdd, 0xcb, 0xaa, 0x1f,
-		0x27, 0x78, 0x95, 0xae, 0xab, 0x99, 0x7f, 0x40, 0x14, 0x0a, 0x0f, 0x02, 0x86, 0x32, 0x1c, 0x36,
-		0x14, 0xf6, 0x21, 0x58, 0xe0, 0x1c, 0x82, 0x03, 0xca, 0x05, 0xca, 0xbf, 0xc9, 0xc1, 0x7c, 0x96,
-		0x1f, 0x98, 0x71, 0xf7, 0x83, 0x7d, 0xfa, 0xe6, 0xf6, 0x7a, 0xfa, 0x0e, 0x6a, 0x17, 0xd9, 0xd2,
-		0x1d, 0xe2, 0x48, 0xb7, 0x67, 0x9d, 0xc3, 0xe2, 0x79, 0x90, 0x0f, 0x72, 0x90, 0xf1, 0xa7, 0x6f,
-		0x1f, 0x0f, 0x61, 0xb2, 0xca, 0x3a, 0xc3, 0xcc, 0xb2, 0x4e, 0xaf, 0x1f, 0xa1, 0x20, 0xde, 0x8f,
-		0x20, 0xff, 0x23, 0x07, 0xe7, 0x07, 0xe1, 0x51, 0x3e, 0xa6, 0x42, 0x0f, 0x64, 0xdc, 0x0b, 0x19,
-		0x32, 0xee, 0xf2, 0x3f, 0x73, 0x70, 0x21, 0xd3, 0x2f, 0x11, 0x9f, 0x08, 0x3e, 0x26, 0x78, 0x3f,
-		0xa5, 0x58, 0xc8, 0x92, 0x67, 0xfe, 0x5c, 0x9e, 0x27, 0x78, 0x5e, 0x0f, 0xc9, 0x13, 0xc1, 0x27,
-		0xb6, 0xb0, 0x14, 0xfa, 0xe9, 0x7d, 0xff, 0x79, 0x0e, 0x16, 0x32, 0xfe, 0x42, 0xf4, 0xc9, 0x3e,
-		0x84, 0xf6, 0x61, 0xce, 0x81, 0xfd, 0xe4, 0xcf, 0x15, 0xa3, 0xee, 0x60, 0x8b, 0x7c, 0xea, 0x38,
-		0x4c, 0x2f, 0x3f, 0x58, 0xbe, 0xb3, 0x5e, 0x5b, 0xa9, 0xae, 0xae, 0x2f, 0x2b, 0xb5, 0xf5, 0x4f,
-		0xae, 0x2d, 0xd7, 0xaa, 0x77, 0x1e, 0xdc, 0x5c, 0xad, 0xde, 0x1e, 0x7f, 0x0a, 0x9d, 0x84, 0xa3,
-		0xf1, 0xd7, 0x37, 0x57, 0x57, 0x6b, 0x64, 0x74, 0x5c, 0x42, 0xa7, 0xe0, 0x78, 0x9c, 0x60, 0x69,
-		0xf5, 0xee, 0xbd, 0x65, 0x4a, 0x92, 0xbb, 0xf5, 0x0a, 0x1c, 0xd6, 0xcc, 0x06, 0x4b, 0x06, 0xb7,
-		0xfc, 0xff, 0x31, 0xba, 0xe6, 0x86, 0xb1, 0x6b, 0xd2, 0xa7, 0x2e, 0x3d, 0x34, 0x9c, 0xad, 0xf6,
-		0x46, 0x45, 0x33, 0x1b, 0x0b, 0xc1, 0xff, 0x75, 0x7a, 0xc1, 0xd0, 0xeb, 0x0b, 0x0f, 0x4d, 0xef,
-		0xff, 0xab, 0xd2, 0x7f, 0x7c, 0x7a, 0x43, 0x6d, 0x19, 0x3b, 0x97, 0x36, 0x0a, 0x64, 0xec, 0xf2,
-		0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x75, 0x55, 0x1d, 0xdb, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x47,
+		0x19, 0xef, 0xdc, 0xd9, 0x67, 0xdf, 0x77, 0x8e, 0x63, 0x4f, 0x12, 0xc7, 0x4e, 0x9c, 0xc4, 0xd9,
+		0xa4, 0x89, 0xeb, 0xd8, 0xe7, 0xc4, 0x49, 0x13, 0xd2, 0xd0, 0x96, 0xc4, 0xb1, 0x95, 0x93, 0x4c,
+		0x62, 0x6d, 0x9c, 0x14, 0x10, 0xd2, 0xb1, 0xde, 0x1d, 0xc7, 0x2b, 0xdf, 0xdd, 0x5e, 0x77, 0xf7,
+		0x7c, 0x31, 0x12, 0x4f, 0x3c, 0x20, 0xa1, 0x56, 0x50, 0x55, 0x48, 0x54, 0x80, 0x40, 0x48, 0xa0,
+		0x16, 0x21, 0x15, 0x81, 0x10, 0x20, 0x5e, 0x00, 0x09, 0x51, 0x09, 0x54, 0xfa, 0xc4, 0x0b, 0x0f,
+		0x54, 0x15, 0x0f, 0xf4, 0x8d, 0x07, 0xca, 0x1b, 0x12, 0xda, 0xd9, 0xd9, 0xfb, 0xb3, 0x3b, 0xb3,
+		0x3b, 0x7b, 0x76, 0x5a, 0x50, 0xf3, 0xe6, 0x9d, 0xfd, 0xe6, 0xdb, 0xdf, 0x37, 0xf3, 0x7d, 0xdf,
+		0x7c, 0xf3, 0x7d, 0xdf, 0x19, 0x4e, 0x36, 0xd6, 0x89, 0x3d, 0xaf, 0x6b, 0x06, 0xa9, 0xe9, 0x64,
+		0x5e, 0xab, 0x9b, 0xf3, 0xdb, 0x17, 0xe6, 0x37, 0x4d, 0xc7, 0xb5, 0xec, 0x9d, 0x62, 0xdd, 0xb6,
+		0x5c, 0x0b, 0x1f, 0xf0, 0x48, 0x8a, 0x8c, 0xa4, 0xa8, 0xd5, 0xcd, 0xe2, 0xf6, 0x85, 0x23, 0xc7,
+		0x1f, 0x58, 0xd6, 0x83, 0x0a, 0x99, 0xa7, 0x24, 0xeb, 0x8d, 0x8d, 0x79, 0xa3, 0x61, 0x6b, 0xae,
+		0x69, 0xd5, 0xfc, 0x49, 0x47, 0x4e, 0x84, 0xdf, 0xbb, 0x66, 0x95, 0x38, 0xae, 0x56, 0xad, 0x33,
+		0x82, 0x29, 0xde, 0x87, 0x75, 0xab, 0x5a, 0x6d, 0xb1, 0x50, 0x78, 0x14, 0xae, 0xe6, 0x6c, 0x55,
+		0x4c, 0xc7, 0x8d, 0xa3, 0x69, 0x5a, 0xf6, 0xd6, 0x46, 0xc5, 0x6a, 0xfa, 0x34, 0xca, 0x4d, 0x18,
+		0xb8, 0xe5, 0x0b, 0x84, 0xaf, 0x42, 0x8e, 0x6c, 0x93, 0x9a, 0xeb, 0x8c, 0xa3, 0xa9, 0xec, 0x74,
+		0x61, 0xe1, 0x64, 0x91, 0x23, 0x5b, 0x91, 0x51, 0x2f, 0x79, 0x94, 0x2a, 0x9b, 0xa0, 0xbc, 0x7f,
+		0x05, 0x86, 0x3a, 0x5f, 0xe0, 0x09, 0x18, 0xa4, 0xaf, 0xca, 0xa6, 0x31, 0x8e, 0xa6, 0xd0, 0x74,
+		0x56, 0x1d, 0xa0, 0xcf, 0x25, 0x03, 0x5f, 0x05, 0xf0, 0x5f, 0x79, 0x42, 0x8f, 0x67, 0xa6, 0xd0,
+		0x74, 0x61, 0xe1, 0x48, 0xd1, 0x5f, 0x91, 0x62, 0xb0, 0x22, 0xc5, 0xb5, 0x60, 0x45, 0xd4, 0x3c,
+		0xa5, 0xf6, 0x9e, 0xf1, 0x38, 0x0c, 0x6c, 0x13, 0xdb, 0x31, 0xad, 0xda, 0x78, 0xd6, 0x67, 0xca,
+		0x1e, 0xf1, 0x61, 0x18, 0xf0, 0x84, 0xf7, 0x3e, 0xd7, 0x47, 0xdf, 0xe4, 0xbc, 0xc7, 0x92, 0x81,
+		0xbf, 0x8d, 0xe0, 0x5c, 0x20, 0x72, 0x99, 0x3c, 0x24, 0x7a, 0xc3, 0xdb, 0x87, 0xb2, 0xe3, 0x6a,
+		0xb6, 0x4b, 0x8c, 0xb2, 0x8f, 0x44, 0x73, 0x5d, 0xdb, 0x5c, 0x6f, 0xb8, 0xc4, 0x19, 0xef, 0xa7,
+		0x78, 0x3e, 0xc9, 0x15, 0xfd, 0x05, 0xc6, 0x67, 0x29, 0x60, 0x73, 0xd7, 0xe7, 0x42, 0x45, 0xbe,
+		0xde, 0xe2, 0x71, 0xeb, 0x09, 0xf5, 0x6c, 0x53, 0x8e, 0x14, 0x7f, 0x1f, 0xc1, 0x1c, 0x07, 0x9e,
+		0x6e, 0x55, 0xeb, 0x15, 0xc2, 0x05, 0x98, 0xa3, 0x00, 0x9f, 0x93, 0x03, 0xb8, 0x18, 0xf0, 0x89,
+		0x42, 0x7c, 0xaa, 0x29, 0x4b, 0x8c, 0x5f, 0x43, 0x30, 0xc3, 0x01, 0xb9, 0xa1, 0x99, 0x15, 0x1e,
+		0xc2, 0x01, 0x8a, 0xf0, 0x9a, 0x1c, 0xc2, 0x65, 0xca, 0x24, 0x0a, 0xef, 0x4c, 0x53, 0x8a, 0x12,
+		0x7f, 0x8f, 0xbf, 0x80, 0x9e, 0x6e, 0x19, 0x65, 0xab, 0xe1, 0x46, 0xe1, 0x0d, 0x52, 0x78, 0xcf,
+		0xca, 0xc1, 0xf3, 0xd4, 0xce, 0xb8, 0xd3, 0x70, 0xa3, 0x00, 0xa7, 0x9b, 0x92, 0xb4, 0xf8, 0x55,
+		0x04, 0xd3, 0x06, 0xd1, 0x4d, 0x87, 0x02, 0xf3, 0xb4, 0xd4, 0xd1, 0x37, 0x89, 0xd1, 0xe0, 0x2e,
+		0x5e, 0x9e, 0xa2, 0xbb, 0xca, 0x45, 0x77, 0x93, 0x31, 0x59, 0xd3, 0x9c, 0xad, 0xbb, 0x01, 0x8b,
+		0x28, 0xb2, 0xd3, 0x86, 0x04, 0x1d, 0x7e, 0x19, 0xc1, 0x99, 0x10, 0x2a, 0x91, 0x4d, 0x00, 0xc5,
+		0x74, 0x25, 0x19, 0x93, 0xc8, 0x1c, 0x14, 0x23, 0x91, 0x8a, 0xb3, 0x4a, 0x31, 0x46, 0x50, 0x90,
+		0x5c, 0xa5, 0x18, 0xfd, 0x3f, 0x6d, 0x48, 0xd0, 0xe1, 0x57, 0x22, 0xa8, 0x62, 0x34, 0x6b, 0x88,
+		0xa2, 0xfa, 0x44, 0x22, 0x2a, 0xb1, 0x52, 0x9d, 0x32, 0x92, 0xc9, 0xf0, 0x57, 0x11, 0x3c, 0xd9,
+		0x8d, 0x49, 0x64, 0x89, 0xfb, 0x28, 0xa0, 0xcb, 0x89, 0x80, 0x44, 0x46, 0x78, 0xd2, 0x48, 0x22,
+		0xa2, 0xdb, 0xa6, 0xe9, 0xae, 0xb9, 0x6d, 0xba, 0x3b, 0x89, 0xca, 0x3d, 0x1c, 0xb3, 0x6d, 0xd7,
+		0x19, 0x93, 0x24, 0xe5, 0xd6, 0x24, 0xe8, 0xa8, 0x72, 0x87, 0x50, 0x89, 0x94, 0x7b, 0x7f, 0x8c,
+		0x72, 0x77, 0x61, 0x12, 0x2a, 0xb7, 0x96, 0x48, 0xc5, 0x59, 0xa5, 0x18, 0xe5, 0x1e, 0x91, 0x5c,
+		0xa5, 0x38, 0xe5, 0xd6, 0x24, 0xe8, 0xa8, 0x22, 0x75, 0xa3, 0x12, 0x29, 0xd2, 0x68, 0x8c, 0x22,
+		0x75, 0x42, 0x12, 0x2a, 0x92, 0x96, 0x44, 0x44, 0x2d, 0xad, 0x1b, 0x4c, 0x8c, 0xa5, 0xe1, 0x18,
+		0x4b, 0xeb, 0xc4, 0x13, 0x63, 0x69, 0x5a, 0x32, 0x19, 0x6e, 0xc2, 0x71, 0x0f, 0x84, 0x2d, 0xd6,
+		0x9e, 0x03, 0x14, 0xc8, 0x79, 0x2e, 0x10, 0x8f, 0xab, 0x2d, 0x54, 0x9b, 0xa3, 0xae, 0xf8, 0x35,
+		0x7e, 0x11, 0x26, 0xfd, 0x0f, 0x6f, 0x98, 0x36, 0xef, 0xb3, 0x07, 0xe9, 0x67, 0x8b, 0xe2, 0xcf,
+		0x2e, 0x9b, 0x76, 0x84, 0xeb, 0xad, 0x27, 0xd4, 0x09, 0x57, 0xf4, 0x12, 0xff, 0x10, 0xc1, 0x7c,
+		0x48, 0x45, 0xb5, 0x9a, 0x4e, 0x2a, 0x65, 0x9b, 0xbc, 0xd8, 0x20, 0x0e, 0x57, 0xfa, 0x43, 0x14,
+		0xc6, 0xf3, 0xc9, 0x9a, 0x4a, 0x39, 0xa9, 0x01, 0xa3, 0x28, 0xae, 0x19, 0x4d, 0x9a, 0x1a, 0xff,
+		0x0c, 0xc1, 0x25, 0x86, 0x29, 0x80, 0x28, 0xa7, 0xc4, 0x63, 0x14, 0xed, 0x22, 0x17, 0x2d, 0xfb,
+		0x9a, 0xff, 0x69, 0x19, 0x8d, 0x2e, 0xda, 0xa9, 0x66, 0xe0, 0xaf, 0x23, 0x38, 0xcb, 0x5b, 0x5e,
+		0x1e, 0xd0, 0xc3, 0x92, 0xda, 0xbd, 0xc8, 0x38, 0x24, 0x68, 0xb7, 0x80, 0x0c, 0x7f, 0x11, 0x4e,
+		0xf8, 0x4a, 0x26, 0x46, 0x32, 0x4e, 0x91, 0x5c, 0x10, 0xeb, 0x99, 0x18, 0xc2, 0xa4, 0x1b, 0xf3,
+		0x1e, 0x7f, 0x05, 0xc1, 0x69, 0xb6, 0x79, 0x4c, 0xd1, 0x05, 0x9b, 0x36, 0x41, 0x11, 0x3c, 0xcd,
+		0x45, 0xe0, 0x33, 0xf7, 0xf5, 0x5d, 0xb0, 0x4d, 0x53, 0x7a, 0x02, 0x0d, 0xfe, 0x12, 0x4c, 0x55,
+		0x35, 0x7b, 0x8b, 0xd8, 0x65, 0x9b, 0xe8, 0x96, 0x6d, 0xf0, 0x40, 0x1c, 0xa1, 0x20, 0x16, 0xb8,
+		0x20, 0x3e, 0x4d, 0x27, 0xab, 0x6c, 0x6e, 0x14, 0xc1, 0xb1, 0x6a, 0x1c, 0x01, 0xfe, 0x2e, 0x82,
+		0x59, 0xde, 0xfd, 0xc4, 0x7c, 0x50, 0xd3, 0xb8, 0x0b, 0x72, 0x34, 0x4d, 0xf8, 0x7a, 0x97, 0xb1,
+		0x91, 0x09, 0x5f, 0x05, 0xb4, 0xf8, 0x07, 0x08, 0x8a, 0x1c, 0x84, 0x2e, 0xb1, 0xab, 0x66, 0x4d,
+		0xe3, 0xfa, 0x85, 0xc9, 0x18, 0xbf, 0x10, 0x0d, 0xb1, 0x5b, 0x8c, 0x38, 0x7e, 0xa1, 0x29, 0x4d,
+		0x8d, 0x7f, 0x8e, 0xe0, 0x12, 0xef, 0x2a, 0x95, 0xe8, 0xc5, 0x8e, 0x51, 0xb4, 0x37, 0x25, 0x6f,
+		0x54, 0x49, 0xae, 0x6c, 0xbe, 0x99, 0x6e, 0x8a, 0x48, 0x03, 0xc4, 0x46, 0x79, 0x3c, 0x8d, 0x06,
+		0x88, 0x0d, 0x74, 0xba, 0x29, 0x49, 0x8b, 0xff, 0x8e, 0x60, 0x29, 0xe4, 0x71, 0xc9, 0x43, 0x97,
+		0xd8, 0x35, 0xad, 0x52, 0xe6, 0x20, 0x37, 0x6b, 0xa6, 0x6b, 0xf2, 0x15, 0xe3, 0x04, 0x85, 0x7e,
+		0x37, 0xd9, 0x05, 0x2f, 0x31, 0xfe, 0x11, 0x79, 0x4a, 0x01, 0xf3, 0xa8, 0x40, 0xcf, 0xd9, 0xbb,
+		0xe2, 0x80, 0xff, 0x8a, 0xe0, 0x46, 0x0a, 0x31, 0x45, 0x1e, 0x6b, 0x8a, 0xca, 0xb8, 0xba, 0x0b,
+		0x19, 0x45, 0xce, 0xec, 0x9a, 0xdd, 0xfb, 0x74, 0xfc, 0x36, 0x82, 0x67, 0xe3, 0xc4, 0x49, 0xb6,
+		0x93, 0x93, 0x54, 0xb0, 0x15, 0xae, 0x60, 0x42, 0x30, 0x89, 0xf6, 0x72, 0x85, 0xf4, 0x36, 0x95,
+		0xc6, 0x01, 0x3c, 0x39, 0xac, 0x9a, 0x6b, 0xd6, 0x1a, 0xc4, 0x28, 0x6b, 0x4e, 0xb9, 0x46, 0x9a,
+		0x51, 0x39, 0x94, 0x98, 0x38, 0x20, 0x0a, 0x22, 0x60, 0x77, 0xdd, 0xb9, 0x4d, 0x9a, 0x51, 0xf8,
+		0xc5, 0x66, 0xaa, 0x19, 0xf8, 0x77, 0x08, 0xae, 0xd2, 0x68, 0xb2, 0xac, 0x6f, 0x9a, 0x15, 0x23,
+		0xa5, 0xfd, 0x9c, 0xa2, 0xd0, 0x6f, 0x71, 0xa1, 0xd3, 0x50, 0x72, 0xd1, 0x63, 0x9a, 0xc6, 0x68,
+		0x2e, 0x3a, 0xe9, 0xa7, 0xe1, 0x5f, 0x21, 0xb8, 0x9c, 0x20, 0x84, 0xc8, 0x3a, 0x4e, 0x53, 0x09,
+		0x96, 0xd2, 0x4a, 0x20, 0x32, 0x89, 0xf3, 0x4e, 0xca, 0x39, 0xf8, 0xc7, 0x08, 0x2e, 0x08, 0x51,
+		0x0b, 0xe3, 0xfc, 0x27, 0x29, 0xec, 0xeb, 0xfc, 0x30, 0x84, 0xfb, 0x75, 0x61, 0xe0, 0x3f, 0xab,
+		0xa7, 0xa0, 0xc7, 0x3f, 0x45, 0x70, 0x51, 0x08, 0x37, 0xe6, 0x12, 0x79, 0x26, 0x46, 0xc9, 0xf9,
+		0x80, 0x63, 0xae, 0x93, 0x45, 0x3d, 0xd5, 0x0c, 0xfc, 0x06, 0x82, 0xf3, 0xa9, 0x35, 0xe3, 0x2c,
+		0x45, 0xfc, 0xa9, 0x14, 0x88, 0x45, 0x4a, 0x71, 0x4e, 0x4f, 0xa1, 0x0f, 0x6f, 0x22, 0x58, 0x10,
+		0x2f, 0xb0, 0xf0, 0x10, 0x9e, 0xa6, 0x68, 0x6f, 0xa4, 0x59, 0x5f, 0xe1, 0x49, 0x3c, 0xa7, 0xa7,
+		0x99, 0x80, 0x7f, 0x12, 0xa7, 0x12, 0x31, 0x97, 0xe6, 0xa7, 0x52, 0x43, 0x16, 0x5f, 0x9f, 0xe7,
+		0xf4, 0x34, 0x13, 0x68, 0x6c, 0x26, 0x86, 0x1c, 0x13, 0x49, 0xce, 0xc4, 0xc4, 0x66, 0x02, 0xcc,
+		0x31, 0xe1, 0xe4, 0xbc, 0x9e, 0x6e, 0x0a, 0x3d, 0x34, 0xfd, 0x50, 0xbc, 0xd7, 0x88, 0xe7, 0x5c,
+		0xcc, 0xa1, 0xe9, 0x47, 0xdc, 0xbd, 0x84, 0x3a, 0x57, 0x9c, 0xde, 0xa6, 0xe2, 0xdf, 0x23, 0x78,
+		0x46, 0x42, 0x20, 0x91, 0x8d, 0xce, 0x52, 0x69, 0x4a, 0xbd, 0x48, 0x23, 0x32, 0xd6, 0x4b, 0x4e,
+		0x0f, 0xf3, 0xf0, 0x2f, 0x11, 0x3c, 0x1d, 0x27, 0x80, 0xf8, 0xfe, 0x34, 0x17, 0x73, 0x00, 0x09,
+		0x41, 0x88, 0xef, 0x51, 0xe7, 0x49, 0xca, 0x39, 0xd4, 0xe1, 0x34, 0xea, 0x0e, 0xb1, 0xdd, 0x36,
+		0x70, 0x87, 0x68, 0xb6, 0xbe, 0xd9, 0x01, 0x33, 0x8a, 0xbb, 0x18, 0x63, 0xbd, 0xf7, 0x28, 0xbb,
+		0x00, 0xc1, 0x5d, 0xca, 0xac, 0xfd, 0x45, 0x8e, 0xf5, 0x36, 0xd2, 0x4c, 0xb8, 0x31, 0x04, 0xd0,
+		0x06, 0xa2, 0xbc, 0x5b, 0x80, 0xb3, 0xb2, 0xa7, 0xd7, 0x32, 0xec, 0x6b, 0xc9, 0xe8, 0xee, 0xd4,
+		0x09, 0xad, 0x05, 0x8a, 0x2a, 0x8b, 0x01, 0xd3, 0xb5, 0x9d, 0x3a, 0x51, 0x87, 0x9a, 0x1d, 0x4f,
+		0xf8, 0xf3, 0x70, 0xa8, 0xae, 0xd9, 0xde, 0x8a, 0x74, 0x1a, 0xdd, 0x86, 0xc5, 0xca, 0x87, 0xd3,
+		0x5c, 0x7e, 0xab, 0x74, 0x46, 0x87, 0x4d, 0x6c, 0x58, 0xea, 0x81, 0x7a, 0x74, 0x10, 0x3f, 0x03,
+		0x79, 0x9a, 0x91, 0xa9, 0x98, 0x8e, 0x4b, 0x0b, 0x8b, 0x85, 0x85, 0x63, 0xfc, 0x94, 0x87, 0xe6,
+		0x6c, 0xad, 0x98, 0x8e, 0xab, 0x0e, 0xba, 0xec, 0x2f, 0xbc, 0x00, 0xfd, 0x66, 0xad, 0xde, 0x70,
+		0x69, 0xd9, 0xb1, 0xb0, 0x30, 0x29, 0x40, 0xb2, 0x53, 0xb1, 0x34, 0x43, 0xf5, 0x49, 0xb1, 0x06,
+		0x53, 0xa1, 0x90, 0xa3, 0xec, 0x5a, 0x65, 0xbd, 0x62, 0x39, 0x84, 0xfa, 0x6f, 0xab, 0xe1, 0xb2,
+		0x3a, 0xe4, 0x44, 0xa4, 0x2e, 0x7a, 0x93, 0x55, 0x92, 0xd5, 0x49, 0xd2, 0xb5, 0xf6, 0x6b, 0xd6,
+		0xa2, 0x37, 0x7f, 0xcd, 0x9f, 0x8e, 0x5f, 0x80, 0xa3, 0xed, 0xb4, 0x77, 0x94, 0x7b, 0x2e, 0x89,
+		0xfb, 0x61, 0x37, 0x48, 0x66, 0x87, 0x18, 0x5f, 0x83, 0x23, 0xed, 0x08, 0xbb, 0x2d, 0x85, 0xdd,
+		0xa8, 0x79, 0xb5, 0x57, 0xaf, 0xf4, 0x97, 0x57, 0x0f, 0xb7, 0x28, 0x5a, 0xeb, 0xac, 0x36, 0x6a,
+		0x25, 0x03, 0x97, 0x20, 0xcf, 0x5c, 0xa5, 0x65, 0xd3, 0x3a, 0xdc, 0xf0, 0xc2, 0x39, 0xbe, 0x6b,
+		0x67, 0x0c, 0x68, 0x08, 0x5d, 0x0a, 0xa6, 0xa8, 0xed, 0xd9, 0xb8, 0x04, 0xa3, 0x6d, 0x1c, 0x9e,
+		0xbb, 0x6a, 0xd8, 0x64, 0x3c, 0x1f, 0xb3, 0x07, 0xcb, 0x3e, 0x8d, 0x3a, 0xd2, 0x9a, 0xc6, 0x46,
+		0xb0, 0x0a, 0x63, 0x15, 0xcd, 0xbb, 0xf3, 0xf9, 0xe1, 0x0c, 0x15, 0x87, 0x38, 0x8d, 0x8a, 0x3b,
+		0x0e, 0x31, 0xfc, 0x82, 0x3d, 0x3d, 0xe8, 0xcd, 0x5d, 0x6c, 0x4d, 0x55, 0xe9, 0x4c, 0x7c, 0x15,
+		0x26, 0x2c, 0xdb, 0x7c, 0x60, 0xfa, 0x8e, 0x36, 0xb4, 0x4a, 0x05, 0xba, 0x4a, 0x63, 0x01, 0x41,
+		0x68, 0x91, 0x8e, 0xc0, 0xa0, 0x69, 0x90, 0x9a, 0x6b, 0xba, 0x3b, 0xb4, 0xa2, 0x94, 0x57, 0x5b,
+		0xcf, 0xf8, 0x22, 0x8c, 0x6d, 0x98, 0xb6, 0xe3, 0x46, 0x79, 0xee, 0xa3, 0x94, 0x07, 0xe8, 0xdb,
+		0x10, 0xc3, 0x45, 0x18, 0xb2, 0x89, 0x6b, 0xef, 0x94, 0xeb, 0x56, 0xc5, 0xd4, 0x77, 0x58, 0x15,
+		0x66, 0x4a, 0x70, 0x41, 0x75, 0xed, 0x9d, 0x55, 0x4a, 0xa7, 0x16, 0xec, 0xf6, 0x83, 0x57, 0x7a,
+		0xd7, 0x5c, 0x97, 0x54, 0xeb, 0x2e, 0xad, 0x98, 0xf4, 0xab, 0xc1, 0x23, 0x5e, 0x84, 0xfd, 0xe4,
+		0x61, 0xdd, 0xf4, 0x15, 0xc7, 0x2f, 0xea, 0x8f, 0x24, 0x16, 0xf5, 0x87, 0xdb, 0x53, 0xbc, 0x41,
+		0x7c, 0x0a, 0xf6, 0xe9, 0xb6, 0x67, 0x0d, 0xac, 0xa2, 0x43, 0x2b, 0x0e, 0x79, 0x75, 0xc8, 0x1b,
+		0x0c, 0xaa, 0x3c, 0xf8, 0x33, 0x70, 0xd4, 0x97, 0xbe, 0xbb, 0xfa, 0xb5, 0xae, 0xe9, 0x5b, 0xd6,
+		0xc6, 0xc6, 0x38, 0x4e, 0x52, 0xea, 0x71, 0x3a, 0xbb, 0xb3, 0xf0, 0x75, 0xc3, 0x9f, 0x8a, 0xe7,
+		0xa0, 0xaf, 0x4a, 0xaa, 0x16, 0x4b, 0xe7, 0x4f, 0xf0, 0x13, 0x7d, 0xa4, 0x6a, 0xa9, 0x94, 0x0c,
+		0xab, 0x30, 0x1a, 0xf1, 0xd8, 0x2c, 0x27, 0xff, 0x24, 0xff, 0x6c, 0x0c, 0x79, 0x58, 0x75, 0xc4,
+		0x09, 0x8d, 0xe0, 0x7b, 0x30, 0x56, 0xb7, 0xc9, 0x76, 0x59, 0x6b, 0xb8, 0x96, 0xa7, 0x7f, 0xc4,
+		0x2d, 0xd7, 0x2d, 0xb3, 0xe6, 0x06, 0x59, 0x76, 0xd1, 0x7e, 0x39, 0xc4, 0x5d, 0xa5, 0x74, 0xea,
+		0x01, 0x6f, 0xfe, 0xf5, 0x86, 0x6b, 0x75, 0x0c, 0xe2, 0x8b, 0x90, 0xdb, 0x24, 0x9a, 0x41, 0x6c,
+		0x96, 0xfe, 0x3e, 0xca, 0x6f, 0xea, 0xa0, 0x24, 0x2a, 0x23, 0x55, 0xde, 0x40, 0xf0, 0x94, 0x7c,
+		0xb4, 0x7f, 0x09, 0x72, 0xcc, 0x5e, 0x90, 0x84, 0xbd, 0x30, 0x5a, 0xbc, 0x0c, 0x53, 0xf1, 0xe5,
+		0x5e, 0xd3, 0xa0, 0xde, 0x3d, 0xab, 0x4e, 0x8a, 0x2b, 0xb5, 0x25, 0x43, 0x79, 0x1d, 0xc1, 0x19,
+		0xc9, 0xa0, 0xe1, 0x32, 0x0c, 0x04, 0x9e, 0x02, 0x49, 0x78, 0x8a, 0x80, 0x78, 0xcf, 0xa0, 0x5a,
+		0x30, 0x2d, 0x1d, 0x31, 0x2f, 0xc2, 0x10, 0x73, 0xd6, 0xed, 0x83, 0x73, 0x58, 0xa0, 0x04, 0xcc,
+		0x37, 0xd3, 0x73, 0xb3, 0xe0, 0xb6, 0x1f, 0x94, 0x3f, 0x21, 0x38, 0x2d, 0xd3, 0x34, 0xd0, 0x7d,
+		0x02, 0xa2, 0x74, 0x27, 0xe0, 0x6d, 0x18, 0x13, 0x9c, 0x32, 0x99, 0x24, 0x83, 0x3c, 0xe0, 0x70,
+		0x4e, 0x98, 0x0e, 0x4f, 0x93, 0xed, 0xf2, 0x34, 0xca, 0xcb, 0x08, 0x94, 0xe4, 0x7e, 0x03, 0x3c,
+		0x0b, 0x38, 0x5c, 0x83, 0x6e, 0x75, 0x21, 0x8d, 0x38, 0x5d, 0x4b, 0x10, 0x72, 0xb7, 0x99, 0x90,
+		0xbb, 0x3d, 0x06, 0x10, 0x24, 0x04, 0x4d, 0x83, 0xa2, 0xc9, 0xab, 0x79, 0x36, 0x52, 0x32, 0x94,
+		0x7f, 0x86, 0x96, 0x57, 0x68, 0x21, 0xe9, 0x10, 0x4d, 0xc3, 0x48, 0x77, 0x1e, 0xa2, 0xa5, 0x5e,
+		0xc3, 0x4e, 0x87, 0xc4, 0x21, 0xec, 0xd9, 0x10, 0xf6, 0xb3, 0xb0, 0x7f, 0xdd, 0xac, 0x69, 0xf6,
+		0x4e, 0x59, 0xdf, 0x24, 0xfa, 0x96, 0xd3, 0xa8, 0xd2, 0x10, 0x25, 0xaf, 0x0e, 0xfb, 0xc3, 0x8b,
+		0x6c, 0x14, 0x9f, 0x83, 0xd1, 0xee, 0xec, 0x19, 0x79, 0xe8, 0x87, 0x1f, 0x43, 0xea, 0x08, 0xe9,
+		0x4c, 0x6a, 0x91, 0x87, 0xae, 0xf2, 0x52, 0x16, 0x4e, 0x49, 0xb4, 0x32, 0x3c, 0x32, 0x89, 0xc3,
+		0x66, 0x91, 0xed, 0xc1, 0x2c, 0xf0, 0x71, 0x28, 0xac, 0x6b, 0x0e, 0x09, 0x8e, 0x4e, 0x7f, 0x59,
+		0xf2, 0xde, 0x90, 0x7f, 0x60, 0x4e, 0x02, 0x78, 0x89, 0x43, 0xf6, 0xba, 0xdf, 0x5f, 0xd8, 0x1a,
+		0x69, 0xfa, 0x6f, 0x67, 0x01, 0x6f, 0x58, 0xf6, 0x16, 0x43, 0x1a, 0xf4, 0xa3, 0xe5, 0x7c, 0xd1,
+		0xbc, 0x37, 0x14, 0xeb, 0x7d, 0x7f, 0x1c, 0x8f, 0x79, 0xce, 0x51, 0x73, 0xac, 0x1a, 0x8b, 0x8d,
+		0xd8, 0x13, 0xbe, 0x09, 0xfd, 0xba, 0xd6, 0x70, 0x08, 0x0b, 0x83, 0x8a, 0xd2, 0x4d, 0x23, 0x8b,
+		0xde, 0x2c, 0xd5, 0x9f, 0xac, 0xbc, 0x9e, 0x85, 0x93, 0x89, 0x8d, 0x1c, 0x8f, 0x6c, 0x33, 0x6e,
+		0x04, 0x32, 0xf8, 0xbb, 0x30, 0x2b, 0xd9, 0x67, 0xd2, 0x29, 0x41, 0xa7, 0x4f, 0xee, 0x4b, 0xe3,
+		0x93, 0x3b, 0x55, 0xbf, 0x3f, 0xa4, 0xfa, 0xa1, 0xfd, 0xcd, 0xc5, 0xef, 0xef, 0x80, 0xd4, 0xfe,
+		0x0e, 0x0a, 0xf6, 0x97, 0x63, 0x66, 0x79, 0x9e, 0x99, 0x29, 0xdf, 0xc9, 0xc1, 0x69, 0x99, 0x1e,
+		0x17, 0x7c, 0x02, 0x0a, 0xad, 0x42, 0x31, 0xdb, 0xa6, 0xbc, 0x0a, 0xc1, 0x50, 0xc9, 0xf0, 0x2e,
+		0x55, 0x2d, 0x02, 0x6a, 0x04, 0x99, 0x98, 0x4b, 0x55, 0xeb, 0x93, 0xf4, 0x52, 0xa5, 0x75, 0x3c,
+		0x79, 0xaa, 0x69, 0x58, 0x55, 0xcd, 0xac, 0x31, 0xdf, 0xc1, 0x9e, 0xba, 0x0f, 0x83, 0xbe, 0x1e,
+		0xaf, 0x43, 0x39, 0xf9, 0xeb, 0xd0, 0x1a, 0x4c, 0x04, 0x4a, 0x18, 0x3d, 0x43, 0x06, 0x92, 0xce,
+		0x90, 0xb1, 0x60, 0x6e, 0xe8, 0x18, 0x09, 0x71, 0x65, 0x47, 0x14, 0xe3, 0x3a, 0x98, 0x82, 0xab,
+		0x7f, 0x0b, 0x62, 0x5c, 0xc5, 0x87, 0x5d, 0xbe, 0xa7, 0xc3, 0x6e, 0x19, 0x46, 0x37, 0x89, 0x66,
+		0xbb, 0xeb, 0x44, 0x6b, 0xa3, 0x83, 0x24, 0x56, 0x23, 0xad, 0x39, 0x6d, 0x3e, 0xc9, 0x21, 0x4a,
+		0x21, 0x39, 0x44, 0x89, 0xdc, 0x15, 0x86, 0x7a, 0xb9, 0x2b, 0xb4, 0x63, 0xce, 0x7d, 0xf2, 0x31,
+		0xe7, 0x3f, 0x10, 0x28, 0xc9, 0xfd, 0x56, 0x1f, 0xda, 0xe1, 0xde, 0x19, 0x86, 0xf4, 0x75, 0x5f,
+		0x78, 0x9e, 0x87, 0x21, 0x7a, 0x5f, 0x0c, 0xfc, 0x56, 0xbf, 0x84, 0xdf, 0x2a, 0x78, 0x33, 0xd8,
+		0x83, 0xf2, 0x0e, 0xea, 0x76, 0x05, 0x7b, 0x1c, 0x59, 0xf3, 0x97, 0x28, 0x93, 0xc2, 0xdd, 0x67,
+		0x13, 0xa3, 0x8d, 0xbe, 0xee, 0xc5, 0x54, 0xfe, 0x8c, 0xe0, 0x64, 0x72, 0x13, 0x4c, 0xaf, 0x01,
+		0xf8, 0x47, 0x21, 0xd1, 0xaf, 0x33, 0x70, 0x4a, 0xa2, 0x95, 0xcc, 0x93, 0xc9, 0x20, 0xae, 0x66,
+		0x56, 0x1c, 0xa9, 0x4d, 0x0a, 0x88, 0x1f, 0x99, 0x4c, 0xe1, 0x08, 0xa9, 0xaf, 0x97, 0x08, 0x69,
+		0xd7, 0x2a, 0xfe, 0x0d, 0x04, 0x33, 0xf2, 0x1d, 0x60, 0x32, 0x67, 0xde, 0xde, 0x5c, 0xc1, 0xde,
+		0x44, 0x90, 0xb2, 0xd7, 0x2b, 0x19, 0xdb, 0xc1, 0x20, 0x0c, 0xf2, 0x3d, 0x8c, 0xff, 0x20, 0x85,
+		0x38, 0x2b, 0x81, 0xf8, 0xb5, 0x90, 0x1e, 0x8a, 0xaa, 0x42, 0xbd, 0xea, 0xe1, 0x32, 0x4c, 0x55,
+		0x34, 0xb7, 0xa3, 0xe7, 0x21, 0xdc, 0x01, 0xd0, 0x5e, 0x59, 0x9f, 0x8e, 0xb7, 0x95, 0x7e, 0xd8,
+		0xc4, 0xd1, 0xe7, 0x6c, 0x0a, 0x7d, 0xee, 0x4b, 0xb4, 0xd1, 0x50, 0xa0, 0xa7, 0xbc, 0x8d, 0xe0,
+		0x68, 0x4c, 0x97, 0xa5, 0xf7, 0x2b, 0x14, 0xbf, 0xbb, 0xac, 0xb5, 0x6f, 0x03, 0xf4, 0xb9, 0x64,
+		0xe0, 0x15, 0x38, 0xd4, 0x3a, 0xc8, 0x37, 0x4c, 0x3b, 0xc5, 0xa5, 0x15, 0xb3, 0x73, 0xdc, 0xeb,
+		0xa2, 0x4c, 0x73, 0xfc, 0xca, 0x6c, 0xf6, 0x17, 0x60, 0x42, 0xd8, 0xbe, 0x19, 0x27, 0x8d, 0x74,
+		0xcc, 0xae, 0xfc, 0x01, 0xc1, 0x64, 0x5c, 0xe7, 0xde, 0x9e, 0x7c, 0x65, 0xaf, 0xd6, 0x23, 0xd6,
+		0x41, 0xff, 0x02, 0xc1, 0x54, 0x52, 0x07, 0x60, 0x9c, 0x34, 0x8f, 0xd4, 0x6c, 0x63, 0x91, 0xff,
+		0x67, 0x00, 0x52, 0x36, 0x9a, 0xe0, 0x79, 0x38, 0x48, 0x7b, 0x59, 0xc2, 0x69, 0x5f, 0x5f, 0xa6,
+		0xd1, 0x1a, 0x69, 0x86, 0x92, 0xbe, 0x91, 0xca, 0x4b, 0xa6, 0xb7, 0xca, 0xcb, 0xe3, 0xda, 0x88,
+		0x7c, 0x6d, 0x44, 0x46, 0x77, 0x06, 0x24, 0x74, 0xe7, 0x0e, 0x8c, 0xb1, 0x9c, 0x36, 0xc3, 0x68,
+		0xd6, 0x5c, 0x62, 0x6f, 0x6b, 0x95, 0xe4, 0x7b, 0xcb, 0x41, 0x36, 0x91, 0xc2, 0x2b, 0xb1, 0x69,
+		0xdd, 0x75, 0x97, 0xfc, 0xae, 0xea, 0x2e, 0x1d, 0x21, 0x1c, 0xa4, 0x09, 0xe1, 0xc4, 0x45, 0x96,
+		0x42, 0xcf, 0x45, 0x96, 0xf6, 0x3d, 0x63, 0x48, 0xfa, 0x9e, 0xd1, 0x4a, 0xf5, 0xef, 0xdb, 0x45,
+		0xaa, 0x7f, 0x78, 0x57, 0xa9, 0x7e, 0xcf, 0x07, 0xcf, 0xa7, 0xed, 0x76, 0x6b, 0x79, 0x2b, 0xd4,
+		0xe9, 0xad, 0xe2, 0xee, 0x37, 0xeb, 0x70, 0xb8, 0x55, 0x21, 0x0f, 0x55, 0x4d, 0x7d, 0x3b, 0x9e,
+		0x89, 0xad, 0x81, 0x77, 0xd7, 0x4d, 0x0f, 0x11, 0xde, 0xb0, 0xf2, 0x23, 0x04, 0xd3, 0x02, 0x49,
+		0x78, 0xc5, 0xe0, 0x64, 0xf3, 0x40, 0x12, 0xe6, 0xd1, 0x11, 0xe9, 0x64, 0x52, 0x44, 0x3a, 0xca,
+		0x07, 0x08, 0x8e, 0xc5, 0x76, 0x6b, 0x7b, 0xa1, 0x1e, 0xeb, 0x05, 0xaf, 0x69, 0xd5, 0x60, 0xa9,
+		0xc1, 0x1f, 0xba, 0xad, 0x55, 0x49, 0xaf, 0x9f, 0xde, 0xb3, 0x53, 0xa5, 0xad, 0xf1, 0x7d, 0xf2,
+		0x37, 0xeb, 0x6f, 0xf1, 0x36, 0x49, 0xd4, 0x9d, 0x70, 0x02, 0x0a, 0xac, 0x3f, 0xa4, 0x73, 0x09,
+		0xfc, 0x21, 0xba, 0x04, 0x2d, 0xa7, 0x9e, 0x91, 0x77, 0xea, 0x31, 0x79, 0x6a, 0xe5, 0x9b, 0x08,
+		0x66, 0x52, 0x74, 0xe4, 0xb4, 0xf3, 0xa9, 0xa8, 0x2b, 0x9f, 0xda, 0xeb, 0xce, 0xc4, 0x41, 0xfb,
+		0x6d, 0x06, 0x9e, 0xdb, 0x5d, 0x57, 0xf2, 0x9e, 0xe9, 0x7c, 0x3b, 0x57, 0x97, 0xe9, 0xca, 0xd5,
+		0xdd, 0x03, 0x1c, 0xed, 0x7e, 0x61, 0xf6, 0x7d, 0x46, 0xae, 0xc3, 0x55, 0x1d, 0x8d, 0xb4, 0xb0,
+		0x7a, 0xc9, 0x0f, 0xdd, 0xaa, 0xb9, 0xb6, 0x55, 0xa1, 0x8a, 0x36, 0xa4, 0x06, 0x8f, 0xb8, 0x08,
+		0x07, 0x42, 0x8d, 0x5c, 0x56, 0xad, 0xe2, 0x47, 0xe6, 0x83, 0xea, 0x68, 0x57, 0x7f, 0xd5, 0x9d,
+		0x5a, 0x65, 0x47, 0x79, 0x35, 0x0b, 0xd7, 0x76, 0xd1, 0xf5, 0x8c, 0xef, 0x75, 0xfa, 0xbd, 0x61,
+		0xc1, 0x6f, 0x0a, 0xa4, 0x38, 0x77, 0xa5, 0x9d, 0xf7, 0xe8, 0x3e, 0x29, 0xcc, 0xa1, 0xf2, 0xf7,
+		0xa5, 0x6f, 0xb7, 0xfb, 0x32, 0x0b, 0x38, 0xdc, 0x6b, 0xc6, 0x2a, 0x14, 0x59, 0x75, 0xc4, 0xec,
+		0x52, 0x42, 0x3f, 0x85, 0x15, 0xec, 0x62, 0xae, 0x6b, 0x17, 0x95, 0xbf, 0x20, 0xb8, 0xd2, 0x63,
+		0xcb, 0xb6, 0x00, 0x03, 0x12, 0x60, 0xf8, 0x70, 0x15, 0x57, 0xf9, 0x5a, 0x16, 0xae, 0xf4, 0xd8,
+		0x56, 0xf7, 0xff, 0x6a, 0xab, 0x21, 0x8f, 0xdd, 0x27, 0xf6, 0xd8, 0xfd, 0xf2, 0x1e, 0x5b, 0xa8,
+		0x3a, 0x22, 0x07, 0x30, 0x20, 0x72, 0x00, 0x2f, 0x65, 0xe1, 0x52, 0x2f, 0xad, 0x81, 0x72, 0x96,
+		0x2f, 0xc5, 0xf9, 0xb1, 0xe5, 0xb7, 0x2d, 0xff, 0x7d, 0x04, 0xe7, 0xd3, 0xb6, 0x39, 0xfe, 0x4f,
+		0x9b, 0xbc, 0xf8, 0xac, 0x52, 0xfe, 0x88, 0x60, 0x2e, 0x55, 0x6b, 0xe4, 0x9e, 0xb9, 0x00, 0xee,
+		0xad, 0x21, 0xb3, 0xbb, 0x5b, 0xc3, 0xdf, 0x06, 0xe1, 0x62, 0x0f, 0xbf, 0xf1, 0xe8, 0xd8, 0x0e,
+		0xd4, 0xb5, 0x1d, 0x27, 0xa0, 0xd0, 0xda, 0x0e, 0xa6, 0xf3, 0x79, 0x15, 0x82, 0x21, 0x5e, 0x0a,
+		0x21, 0xbb, 0x07, 0x29, 0x84, 0x5e, 0xeb, 0x89, 0xfd, 0x7b, 0x9b, 0x42, 0xc8, 0x3d, 0xd2, 0x14,
+		0xc2, 0x40, 0xcf, 0x29, 0x84, 0xfb, 0xc0, 0x3a, 0x54, 0x19, 0x47, 0x56, 0x86, 0xf3, 0x9b, 0x04,
+		0xce, 0xc4, 0xb4, 0xb9, 0x52, 0x2e, 0xac, 0x18, 0x37, 0x5a, 0x0f, 0x0f, 0x75, 0x1a, 0x49, 0xbe,
+		0xdb, 0x9f, 0xcb, 0xa8, 0x3c, 0x48, 0xa8, 0xbc, 0x0e, 0xe3, 0x1d, 0xea, 0x54, 0xb6, 0x49, 0xa3,
+		0x0d, 0xbf, 0x40, 0xe1, 0xcf, 0xc4, 0x2a, 0x4e, 0xc9, 0x50, 0x49, 0x23, 0xc0, 0xab, 0x1e, 0x6a,
+		0xf2, 0x86, 0x23, 0xe5, 0xc9, 0x7d, 0xbd, 0x94, 0x27, 0x23, 0xbd, 0x86, 0xc3, 0x9c, 0x5e, 0xc3,
+		0xf6, 0x4d, 0x6b, 0x7f, 0xfa, 0xdc, 0xc2, 0xc8, 0x2e, 0x72, 0x0b, 0xa3, 0xbb, 0x6b, 0x23, 0x7c,
+		0x06, 0x0a, 0x06, 0xa9, 0x68, 0x3b, 0xbe, 0x6a, 0x26, 0xf7, 0x44, 0x02, 0xa5, 0xa6, 0xaa, 0xa8,
+		0xbc, 0x92, 0x85, 0xf3, 0x69, 0x7f, 0x83, 0xf5, 0xd1, 0xbb, 0x97, 0x95, 0x20, 0x4e, 0xf0, 0x2b,
+		0x5d, 0x97, 0x53, 0xff, 0x80, 0xa8, 0x2b, 0x3c, 0xe8, 0x30, 0x94, 0xfe, 0x6e, 0x43, 0xe1, 0x1f,
+		0x82, 0x39, 0xc1, 0x21, 0xb8, 0x47, 0xb9, 0x40, 0xe5, 0xad, 0x0c, 0xcc, 0xa6, 0xf9, 0x81, 0x99,
+		0x70, 0x3f, 0xf8, 0xa7, 0x6f, 0x66, 0xb7, 0xa7, 0xef, 0x5e, 0xed, 0x22, 0x7f, 0x75, 0xfb, 0x04,
+		0xab, 0xdb, 0xb6, 0xce, 0x7e, 0xf9, 0x3c, 0xc8, 0x07, 0x19, 0x48, 0xf9, 0xd3, 0xb7, 0x8f, 0xc7,
+		0x62, 0xf2, 0xca, 0x3a, 0xfd, 0xdc, 0xb2, 0x4e, 0xbb, 0x1f, 0x21, 0x27, 0xdf, 0x8f, 0xa0, 0xfc,
+		0x2b, 0x03, 0xe7, 0xf6, 0xc2, 0xa3, 0x7c, 0x4c, 0x17, 0xbd, 0x23, 0xe3, 0x9e, 0x4b, 0x91, 0x71,
+		0x57, 0xfe, 0x9d, 0x81, 0xb9, 0x54, 0xbf, 0x44, 0x7c, 0xbc, 0xf0, 0x91, 0x85, 0x0f, 0x52, 0x8a,
+		0xb9, 0x34, 0x79, 0xe6, 0x2f, 0x67, 0x45, 0x0b, 0x2f, 0xea, 0x21, 0x79, 0xbc, 0xf0, 0xb1, 0x2d,
+		0x2c, 0xb9, 0x5e, 0x7a, 0xdf, 0x7f, 0x93, 0x81, 0xf9, 0x94, 0xbf, 0x10, 0x7d, 0xbc, 0x0f, 0x5d,
+		0xfb, 0x30, 0xe3, 0xc2, 0x7e, 0xfa, 0xe7, 0xb2, 0x59, 0x71, 0x89, 0x4d, 0x3f, 0x75, 0x0c, 0x26,
+		0x96, 0xee, 0x2f, 0xdd, 0x5e, 0x2b, 0x2f, 0x97, 0x56, 0xd6, 0x96, 0xd4, 0xf2, 0xda, 0x67, 0x57,
+		0x97, 0xca, 0xa5, 0xdb, 0xf7, 0xaf, 0xaf, 0x94, 0x6e, 0x8e, 0x3c, 0x81, 0x4f, 0xc0, 0xd1, 0xe8,
+		0xeb, 0xeb, 0x2b, 0x2b, 0x65, 0x3a, 0x3a, 0x82, 0xf0, 0x49, 0x38, 0x16, 0x25, 0x58, 0x5c, 0xb9,
+		0x73, 0x77, 0x89, 0x91, 0x64, 0x6e, 0x6c, 0xbe, 0xf5, 0xde, 0x71, 0xf4, 0xce, 0x7b, 0xc7, 0xd1,
+		0xbb, 0xef, 0x1d, 0x47, 0x70, 0x58, 0xb7, 0xaa, 0xbc, 0xf5, 0xb8, 0x11, 0xfc, 0xbf, 0xd1, 0x55,
+		0xdb, 0x72, 0xad, 0x55, 0xf4, 0xb9, 0x0b, 0x0f, 0x4c, 0x77, 0xb3, 0xb1, 0x5e, 0xd4, 0xad, 0xea,
+		0x7c, 0xe7, 0xff, 0x3d, 0x9d, 0x33, 0x8d, 0xca, 0xfc, 0x03, 0xcb, 0xff, 0x5f, 0xab, 0xec, 0x9f,
+		0xa0, 0x5e, 0xd3, 0xea, 0xe6, 0xf6, 0x85, 0xf5, 0x1c, 0x1d, 0xbb, 0xf8, 0xdf, 0x01, 0x00, 0xa3,
+		0xcd, 0x61, 0xee, 0xe7, 0x55, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0xe8, 0xd9, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xf3, 0x74, 0x51,
+		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0x98, 0x25, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x35, 0xf3, 0x74, 0x51,
 		0x04, 0xc5, 0x26, 0xc1, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0xb1, 0x83, 0x55, 0xb0, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x9b, 0xd0, 0x0f, 0x05, 0x92, 0x72, 0xe2, 0x17, 0xd9,
-		0xc3, 0xec, 0x89, 0xf6, 0x18, 0x03, 0x29, 0xd9, 0xf3, 0x12, 0x6f, 0x77, 0xe4, 0xf9, 0xce, 0x77,
-		0x7e, 0x3e, 0x9e, 0x43, 0xe0, 0x54, 0x31, 0x15, 0x5e, 0x82, 0x09, 0x2d, 0x12, 0xea, 0xe1, 0x92,
-		0x79, 0xeb, 0xa1, 0xa7, 0xb0, 0x4c, 0x33, 0x26, 0x95, 0x5b, 0x0a, 0xae, 0x38, 0xfc, 0x42, 0xfb,
-		0xb8, 0x8d, 0x8f, 0x8b, 0x4b, 0xe6, 0xae, 0x87, 0xfd, 0xaf, 0x97, 0x9c, 0x2f, 0x33, 0xea, 0x19,
-		0x97, 0xb8, 0xfa, 0xe8, 0x91, 0x4a, 0x60, 0xc5, 0x78, 0x51, 0x93, 0xfa, 0xdf, 0x3c, 0xc4, 0x15,
-		0xcb, 0xa9, 0x54, 0x38, 0x2f, 0x1b, 0x87, 0x47, 0x01, 0xee, 0x04, 0x2e, 0x4b, 0x2a, 0x64, 0x8d,
-		0x3b, 0x1f, 0xc0, 0x49, 0x84, 0x65, 0x3a, 0x63, 0x52, 0x41, 0x08, 0x8e, 0x0b, 0x9c, 0xd3, 0x33,
-		0x6b, 0x60, 0x9d, 0x9f, 0x06, 0xe6, 0x0c, 0x7f, 0x04, 0xc7, 0x29, 0x2b, 0xc8, 0xd9, 0xd1, 0xc0,
-		0x3a, 0xef, 0x5e, 0x7c, 0xeb, 0x1e, 0x28, 0xd2, 0xdd, 0x06, 0x98, 0xb2, 0x82, 0x04, 0xc6, 0xdd,
-		0xc1, 0xc0, 0xde, 0x5a, 0xaf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xaf, 0xc1, 0x97, 0x39, 0xbe, 0x47,
-		0xba, 0x6d, 0x89, 0x4a, 0x2a, 0x90, 0xa4, 0x09, 0x2f, 0x88, 0x49, 0xd7, 0xbe, 0xf8, 0xca, 0xad,
-		0x2b, 0x75, 0xb7, 0x95, 0xba, 0x13, 0x5e, 0xc5, 0x19, 0xbd, 0xc1, 0x59, 0x45, 0x83, 0xcf, 0x73,
-		0x7c, 0xaf, 0x03, 0xca, 0x05, 0x15, 0xa1, 0xa1, 0x39, 0x1f, 0x40, 0x6f, 0x9b, 0x62, 0x81, 0x85,
-		0x62, 0x5a, 0x95, 0x5d, 0x2e, 0x1b, 0xb4, 0x52, 0xba, 0x69, 0x3a, 0xd1, 0x47, 0xf8, 0x06, 0x3c,
-		0xe3, 0x77, 0x05, 0x15, 0x68, 0xc5, 0xa5, 0x42, 0xa6, 0xcf, 0x23, 0x83, 0x76, 0x8c, 0xf9, 0x1d,
-		0x97, 0x6a, 0x8e, 0x73, 0xea, 0xfc, 0x65, 0x81, 0xee, 0x36, 0x6e, 0xa8, 0xb0, 0xaa, 0x24, 0xfc,
-		0x0e, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x97, 0x28, 0xe1, 0x55, 0xa1, 0xd0, 0x8a, 0x15, 0xca, 0xc4,
-		0x6e, 0x05, 0x76, 0x83, 0x8c, 0x35, 0xf0, 0x8e, 0x15, 0x0a, 0xbe, 0x06, 0x40, 0x50, 0x4c, 0x50,
-		0x46, 0xd7, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xa9, 0xb6, 0xcc, 0xb4, 0x01, 0xbe, 0x02, 0xa7, 0x38,
-		0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x13, 0x9c, 0xa4, 0x35, 0xf8, 0x06, 0x3c, 0x13, 0x58, 0xd1, 0x7d,
-		0x75, 0x8e, 0x07, 0xd6, 0xb9, 0x15, 0x74, 0xb4, 0x79, 0xd7, 0x3b, 0x9c, 0x80, 0x8e, 0x96, 0x11,
-		0x31, 0x82, 0xe2, 0x8c, 0x27, 0xe9, 0xd9, 0x13, 0xa3, 0xe1, 0xe0, 0x3f, 0x9f, 0xc7, 0x9f, 0x5c,
-		0x6a, 0xbf, 0xa0, 0xad, 0x69, 0x3e, 0x31, 0x17, 0xe7, 0x67, 0xd0, 0xde, 0xc3, 0x60, 0x0f, 0x9c,
-		0x48, 0x85, 0x85, 0x42, 0x8c, 0x34, 0xcd, 0x7d, 0x6a, 0xee, 0x3e, 0x81, 0xcf, 0xc1, 0x53, 0x5a,
-		0x10, 0x0d, 0xd4, 0xfd, 0x3c, 0xa1, 0x05, 0xf1, 0x89, 0xf3, 0x87, 0x05, 0xc0, 0x82, 0x67, 0x19,
-		0x15, 0x7e, 0xf1, 0x91, 0xc3, 0x09, 0xb0, 0x33, 0x2c, 0x15, 0xc2, 0x49, 0x42, 0xa5, 0x44, 0x7a,
-		0x14, 0x9b, 0xc7, 0xed, 0x3f, 0x7a, 0xdc, 0x68, 0x3b, 0xa7, 0x41, 0x57, 0x73, 0x46, 0x86, 0xa2,
-		0x8d, 0xb0, 0x0f, 0x4e, 0x18, 0xa1, 0x85, 0x62, 0x6a, 0xd3, 0xbc, 0xd0, 0xee, 0x7e, 0x48, 0x9f,
-		0xd6, 0x01, 0x7d, 0x9c, 0x3f, 0x2d, 0xd0, 0x0b, 0x15, 0x4b, 0xd2, 0xcd, 0xd5, 0x3d, 0x4d, 0x2a,
-		0x3d, 0x1a, 0x23, 0xa5, 0x04, 0x8b, 0x2b, 0x45, 0x25, 0xfc, 0x05, 0xd8, 0x77, 0x5c, 0xa4, 0x54,
-		0x98, 0x59, 0x44, 0x7a, 0x07, 0x9b, 0x3a, 0x5f, 0xff, 0xef, 0x7c, 0x07, 0xdd, 0x9a, 0xb6, 0x5b,
-		0x98, 0x08, 0xf4, 0x64, 0xb2, 0xa2, 0xa4, 0xca, 0x28, 0x52, 0x1c, 0xd5, 0xea, 0xe9, 0xb6, 0x79,
-		0xa5, 0x4c, 0xed, 0xed, 0x8b, 0xde, 0xe3, 0xb1, 0x6e, 0x36, 0x38, 0x78, 0xb1, 0xe5, 0x46, 0x3c,
-		0xd4, 0xcc, 0xa8, 0x26, 0xbe, 0xfd, 0x1d, 0x7c, 0xb6, 0xbf, 0x51, 0xb0, 0x0f, 0x5e, 0x44, 0xa3,
-		0x70, 0x8a, 0x66, 0x7e, 0x18, 0xa1, 0xa9, 0x3f, 0x9f, 0x20, 0x7f, 0x7e, 0x33, 0x9a, 0xf9, 0x13,
-		0xfb, 0x13, 0xd8, 0x03, 0xcf, 0x1f, 0x60, 0xf3, 0xf7, 0xc1, 0xf5, 0x68, 0x66, 0x5b, 0x07, 0xa0,
-		0x30, 0xf2, 0xc7, 0xd3, 0x5b, 0xfb, 0xe8, 0x2d, 0xf9, 0x27, 0x43, 0xb4, 0x29, 0xe9, 0xbf, 0x33,
-		0x44, 0xb7, 0x8b, 0xab, 0xbd, 0x0c, 0xaf, 0xc0, 0xcb, 0x07, 0xd8, 0xe4, 0x6a, 0xec, 0x87, 0xfe,
-		0xfb, 0xb9, 0x6d, 0x1d, 0x00, 0x47, 0xe3, 0xc8, 0xbf, 0xf1, 0xa3, 0x5b, 0xfb, 0xe8, 0xf2, 0x37,
-		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
-		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
-		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
-		0x07, 0x00, 0x00, 0xff, 0xff, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+		0x1a, 0x20, 0xbb, 0xe1, 0x28, 0x91, 0xb1, 0x09, 0xfd, 0x50, 0x20, 0x29, 0x27, 0x7e, 0x91, 0x3d,
+		0xcc, 0x9e, 0x60, 0x97, 0x7b, 0x84, 0x21, 0x57, 0x7b, 0x8c, 0x81, 0x94, 0xec, 0x79, 0x89, 0xd7,
+		0x3b, 0xf2, 0x7c, 0xe7, 0x3b, 0x3f, 0x1f, 0xcf, 0x21, 0x70, 0xaa, 0x98, 0x0a, 0x2f, 0xc1, 0x84,
+		0x16, 0x09, 0xf5, 0x70, 0xc9, 0xbc, 0x45, 0xcf, 0x53, 0x58, 0xa6, 0x19, 0x93, 0xca, 0x2d, 0x05,
+		0x57, 0x1c, 0x7e, 0xa1, 0x7d, 0xdc, 0xc6, 0xc7, 0xc5, 0x25, 0x73, 0x17, 0xbd, 0xce, 0xc9, 0x8c,
+		0xf3, 0x59, 0x46, 0x3d, 0xe3, 0x12, 0x57, 0x37, 0x1e, 0xa9, 0x04, 0x56, 0x8c, 0x17, 0x35, 0xa9,
+		0xf3, 0xf5, 0x43, 0x5c, 0xb1, 0x9c, 0x4a, 0x85, 0xf3, 0xb2, 0x71, 0x78, 0x14, 0xe0, 0x56, 0xe0,
+		0xb2, 0xa4, 0x42, 0xd6, 0xb8, 0xf3, 0x01, 0xec, 0x45, 0x58, 0xa6, 0x63, 0x26, 0x15, 0x84, 0x60,
+		0xb7, 0xc0, 0x39, 0x3d, 0xb6, 0xba, 0xd6, 0xe9, 0x7e, 0x60, 0xce, 0xf0, 0x07, 0xb0, 0x9b, 0xb2,
+		0x82, 0x1c, 0xef, 0x74, 0xad, 0xd3, 0xa3, 0xb3, 0x6f, 0xdc, 0x2d, 0x45, 0xba, 0xab, 0x00, 0x23,
+		0x56, 0x90, 0xc0, 0xb8, 0x3b, 0x18, 0xd8, 0x2b, 0xeb, 0x25, 0x55, 0x98, 0x60, 0x85, 0xe1, 0x25,
+		0xf8, 0x32, 0xc7, 0x77, 0x48, 0xb7, 0x2d, 0x51, 0x49, 0x05, 0x92, 0x34, 0xe1, 0x05, 0x31, 0xe9,
+		0x0e, 0xce, 0xbe, 0x72, 0xeb, 0x4a, 0xdd, 0x55, 0xa5, 0xee, 0x90, 0x57, 0x71, 0x46, 0xaf, 0x70,
+		0x56, 0xd1, 0xe0, 0xf3, 0x1c, 0xdf, 0xe9, 0x80, 0x72, 0x4a, 0x45, 0x68, 0x68, 0xce, 0x07, 0xd0,
+		0x5e, 0xa5, 0x98, 0x62, 0xa1, 0x98, 0x56, 0x65, 0x9d, 0xcb, 0x06, 0xad, 0x94, 0x2e, 0x9b, 0x4e,
+		0xf4, 0x11, 0xbe, 0x01, 0xcf, 0xf8, 0x6d, 0x41, 0x05, 0x9a, 0x73, 0xa9, 0x90, 0xe9, 0x73, 0xc7,
+		0xa0, 0x87, 0xc6, 0xfc, 0x8e, 0x4b, 0x35, 0xc1, 0x39, 0x75, 0xfe, 0xb6, 0xc0, 0xd1, 0x2a, 0x6e,
+		0xa8, 0xb0, 0xaa, 0x24, 0xfc, 0x16, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x67, 0x28, 0xe1, 0x55, 0xa1,
+		0xd0, 0x9c, 0x15, 0xca, 0xc4, 0x6e, 0x05, 0x76, 0x83, 0x0c, 0x34, 0xf0, 0x8e, 0x15, 0x0a, 0xbe,
+		0x06, 0x40, 0x50, 0x4c, 0x50, 0x46, 0x17, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xbe, 0xb6, 0x8c, 0xb5,
+		0x01, 0xbe, 0x02, 0xfb, 0x38, 0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x3d, 0x9c, 0xa4, 0x35, 0xf8, 0x06,
+		0x3c, 0x13, 0x58, 0xd1, 0x4d, 0x75, 0x76, 0xbb, 0xd6, 0xa9, 0x15, 0x1c, 0x6a, 0xf3, 0xba, 0x77,
+		0x38, 0x04, 0x87, 0x5a, 0x46, 0xc4, 0x08, 0x8a, 0x33, 0x9e, 0xa4, 0xc7, 0x4f, 0x8c, 0x86, 0xdd,
+		0xff, 0x7d, 0x1e, 0x7f, 0x78, 0xae, 0xfd, 0x82, 0x03, 0x4d, 0xf3, 0x89, 0xb9, 0x38, 0x3f, 0x81,
+		0x83, 0x0d, 0x0c, 0xb6, 0xc1, 0x9e, 0x54, 0x58, 0x28, 0xc4, 0x48, 0xd3, 0xdc, 0xa7, 0xe6, 0xee,
+		0x13, 0xf8, 0x1c, 0x3c, 0xa5, 0x05, 0xd1, 0x40, 0xdd, 0xcf, 0x13, 0x5a, 0x10, 0x9f, 0x38, 0xbf,
+		0x59, 0x00, 0x4c, 0x79, 0x96, 0x51, 0xe1, 0x17, 0x37, 0x1c, 0x0e, 0x81, 0x9d, 0x61, 0xa9, 0x10,
+		0x4e, 0x12, 0x2a, 0x25, 0xd2, 0xa3, 0xd8, 0x3c, 0x6e, 0xe7, 0xd1, 0xe3, 0x46, 0xab, 0x39, 0x0d,
+		0x8e, 0x34, 0xa7, 0x6f, 0x28, 0xda, 0x08, 0x3b, 0x60, 0x8f, 0x11, 0x5a, 0x28, 0xa6, 0x96, 0xcd,
+		0x0b, 0xad, 0xef, 0xdb, 0xf4, 0x69, 0x6d, 0xd1, 0xc7, 0xf9, 0xdd, 0x02, 0xed, 0x50, 0xb1, 0x24,
+		0x5d, 0x5e, 0xdc, 0xd1, 0xa4, 0xd2, 0xa3, 0xd1, 0x57, 0x4a, 0xb0, 0xb8, 0x52, 0x54, 0xc2, 0x9f,
+		0x81, 0x7d, 0xcb, 0x45, 0x4a, 0x85, 0x99, 0x45, 0xa4, 0x77, 0xb0, 0xa9, 0xf3, 0xf5, 0x47, 0xe7,
+		0x3b, 0x38, 0xaa, 0x69, 0xeb, 0x85, 0x89, 0x40, 0x5b, 0x26, 0x73, 0x4a, 0xaa, 0x8c, 0x22, 0xc5,
+		0x51, 0xad, 0x9e, 0x6e, 0x9b, 0x57, 0xca, 0xd4, 0x7e, 0x70, 0xd6, 0x7e, 0x3c, 0xd6, 0xcd, 0x06,
+		0x07, 0x2f, 0x56, 0xdc, 0x88, 0x87, 0x9a, 0x19, 0xd5, 0xc4, 0xb7, 0xbf, 0x82, 0xcf, 0x36, 0x37,
+		0x0a, 0x76, 0xc0, 0x8b, 0xa8, 0x1f, 0x8e, 0xd0, 0xd8, 0x0f, 0x23, 0x34, 0xf2, 0x27, 0x43, 0xe4,
+		0x4f, 0xae, 0xfa, 0x63, 0x7f, 0x68, 0x7f, 0x02, 0xdb, 0xe0, 0xf9, 0x03, 0x6c, 0xf2, 0x3e, 0xb8,
+		0xec, 0x8f, 0x6d, 0x6b, 0x0b, 0x14, 0x46, 0xfe, 0x60, 0x74, 0x6d, 0xef, 0xbc, 0x25, 0xff, 0x66,
+		0x88, 0x96, 0x25, 0xfd, 0x6f, 0x86, 0xe8, 0x7a, 0x7a, 0xb1, 0x91, 0xe1, 0x15, 0x78, 0xf9, 0x00,
+		0x1b, 0x5e, 0x0c, 0xfc, 0xd0, 0x7f, 0x3f, 0xb1, 0xad, 0x2d, 0x60, 0x7f, 0x10, 0xf9, 0x57, 0x7e,
+		0x74, 0x6d, 0xef, 0x9c, 0xb3, 0x3f, 0xee, 0x4f, 0xac, 0x3f, 0xef, 0x4f, 0xac, 0xbf, 0xee, 0x4f,
+		0x2c, 0xf0, 0x32, 0xe1, 0xf9, 0x36, 0x75, 0xcf, 0x0f, 0xd7, 0x5b, 0xac, 0x15, 0x9a, 0x5a, 0xbf,
+		0xf4, 0x66, 0x4c, 0xcd, 0xab, 0xd8, 0x4d, 0x78, 0xee, 0x6d, 0xfe, 0x9b, 0xdf, 0x31, 0x92, 0x79,
+		0x33, 0x5e, 0x7f, 0x65, 0xcd, 0x27, 0xfa, 0x23, 0x2e, 0xd9, 0xa2, 0x17, 0x3f, 0x35, 0xb6, 0xef,
+		0xff, 0x19, 0x00, 0x8f, 0xdb, 0x5c, 0x7e, 0x68, 0x05, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-		0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0xb1, 0xf2, 0xdb, 0xd1, 0x6e, 0x12,
-		0x47, 0x5d, 0xdb, 0xeb, 0x64, 0xb3, 0x69, 0x36, 0x4d, 0x53, 0x9a, 0xa4, 0x63, 0x26, 0x32, 0xa5,
-		0x8e, 0xa8, 0x38, 0x5e, 0xb4, 0x25, 0x68, 0x69, 0x6c, 0x13, 0x91, 0x48, 0x81, 0x1c, 0x25, 0xf1,
-		0xbd, 0x40, 0xcf, 0xbd, 0x15, 0x3d, 0xf5, 0x0f, 0x28, 0x50, 0x14, 0x3d, 0x17, 0x2d, 0x7a, 0xe8,
-		0xad, 0xd7, 0x1e, 0x7b, 0xef, 0x7f, 0x51, 0xcc, 0x70, 0x28, 0x51, 0xd6, 0x0f, 0x2a, 0x2d, 0xb0,
-		0x7b, 0x33, 0x1f, 0xbf, 0xef, 0xe3, 0x9b, 0x37, 0xef, 0x7d, 0x1c, 0x5a, 0x50, 0xe8, 0x1c, 0x93,
-		0x60, 0xbb, 0xee, 0x34, 0x88, 0x57, 0x27, 0xdb, 0x4e, 0xdb, 0xdd, 0x7e, 0xbf, 0xb3, 0xfd, 0xc1,
-		0x0f, 0xde, 0x9d, 0x34, 0xfd, 0x0f, 0x5b, 0xed, 0xc0, 0xa7, 0x3e, 0x5a, 0x61, 0x98, 0x2d, 0x81,
-		0xd9, 0x72, 0xda, 0xee, 0xd6, 0xfb, 0x9d, 0x6b, 0xb7, 0x4e, 0x7d, 0xff, 0xb4, 0x49, 0xb6, 0x39,
-		0xe4, 0xb8, 0x73, 0xb2, 0xdd, 0xe8, 0x04, 0x0e, 0x75, 0x7d, 0x2f, 0x22, 0x5d, 0xbb, 0x7d, 0xf1,
-		0x3e, 0x75, 0x5b, 0x24, 0xa4, 0x4e, 0xab, 0x2d, 0x00, 0xeb, 0xc3, 0x9e, 0x5c, 0xf7, 0x5b, 0xad,
-		0xae, 0xc4, 0xd0, 0xdc, 0xa8, 0x13, 0xbe, 0x6b, 0xba, 0x21, 0x8d, 0x30, 0x85, 0xbf, 0xcd, 0xc0,
-		0xea, 0xa1, 0x48, 0x57, 0xff, 0x48, 0xea, 0x1d, 0x96, 0x82, 0xe1, 0x9d, 0xf8, 0xa8, 0x06, 0x28,
-		0x5e, 0x87, 0x4d, 0xe2, 0x3b, 0x79, 0x69, 0x5d, 0xda, 0xc8, 0x3d, 0xbc, 0xb7, 0x35, 0x64, 0x49,
-		0x5b, 0x03, 0x3a, 0x78, 0xf9, 0xc3, 0xc5, 0x10, 0x7a, 0x0c, 0x53, 0xf4, 0xbc, 0x4d, 0xf2, 0x19,
-		0x2e, 0x74, 0x67, 0xac, 0x90, 0x75, 0xde, 0x26, 0x98, 0xc3, 0xd1, 0x53, 0x80, 0x90, 0x3a, 0x01,
-		0xb5, 0x59, 0x19, 0xf2, 0x59, 0x4e, 0xbe, 0xb6, 0x15, 0xd5, 0x68, 0x2b, 0xae, 0xd1, 0x96, 0x15,
-		0xd7, 0x08, 0xcf, 0x71, 0x34, 0xbb, 0x66, 0xd4, 0x7a, 0xd3, 0x0f, 0x49, 0x44, 0x9d, 0x4a, 0xa7,
-		0x72, 0x34, 0xa7, 0x5a, 0x30, 0x1f, 0x51, 0x43, 0xea, 0xd0, 0x4e, 0x98, 0x9f, 0x5e, 0x97, 0x36,
-		0x16, 0x1f, 0xee, 0x4c, 0xb6, 0x7a, 0x95, 0x31, 0xab, 0x9c, 0x88, 0x73, 0xf5, 0xde, 0x05, 0xba,
-		0x0b, 0x8b, 0x67, 0x6e, 0x48, 0xfd, 0xe0, 0xdc, 0x6e, 0x12, 0xef, 0x94, 0x9e, 0xe5, 0x67, 0xd6,
-		0xa5, 0x8d, 0x2c, 0x5e, 0x10, 0xd1, 0x12, 0x0f, 0xa2, 0x9f, 0xc3, 0x6a, 0xdb, 0x09, 0x88, 0x47,
-		0x7b, 0xe5, 0xb7, 0x5d, 0xef, 0xc4, 0xcf, 0x5f, 0xe2, 0x4b, 0xd8, 0x18, 0x9a, 0x45, 0x85, 0x33,
-		0xfa, 0x76, 0x12, 0xaf, 0xb4, 0x07, 0x83, 0x48, 0x81, 0xc5, 0x9e, 0x2c, 0xaf, 0xcc, 0x6c, 0x6a,
-		0x65, 0x16, 0xba, 0x0c, 0x5e, 0x9d, 0x4d, 0x98, 0x6a, 0x91, 0x96, 0x9f, 0x9f, 0xe3, 0xc4, 0xab,
-		0x43, 0xf3, 0x39, 0x20, 0x2d, 0x1f, 0x73, 0x18, 0xc2, 0xb0, 0x1c, 0x12, 0x27, 0xa8, 0x9f, 0xd9,
-		0x0e, 0xa5, 0x81, 0x7b, 0xdc, 0xa1, 0x24, 0xcc, 0x03, 0xe7, 0xde, 0x1d, 0xca, 0xad, 0x72, 0xb4,
-		0xd2, 0x05, 0x63, 0x39, 0xbc, 0x10, 0x41, 0x25, 0x58, 0x76, 0x3a, 0xd4, 0xb7, 0x03, 0x12, 0x12,
-		0x6a, 0xb7, 0x7d, 0xd7, 0xa3, 0x61, 0x3e, 0xc7, 0x35, 0xd7, 0x87, 0x6a, 0x62, 0x06, 0xac, 0x70,
-		0x1c, 0x5e, 0x62, 0xd4, 0x44, 0x00, 0x5d, 0x87, 0x39, 0x36, 0x1e, 0x36, 0x9b, 0x8f, 0xfc, 0xfc,
-		0xba, 0xb4, 0x31, 0x87, 0x67, 0x59, 0xa0, 0xe4, 0x86, 0x14, 0xad, 0xc1, 0x25, 0x37, 0xb4, 0xeb,
-		0x81, 0xef, 0xe5, 0x17, 0xd6, 0xa5, 0x8d, 0x59, 0x3c, 0xe3, 0x86, 0x6a, 0xe0, 0x7b, 0x85, 0xdf,
-		0x66, 0xe0, 0xd6, 0xe0, 0xe6, 0xfb, 0xde, 0x89, 0x7b, 0x2a, 0x46, 0x1a, 0x7d, 0x93, 0x14, 0x8e,
-		0x46, 0xe8, 0xe6, 0xd0, 0xf4, 0x2c, 0xf1, 0xb4, 0xc4, 0x73, 0x1d, 0x58, 0xef, 0x6d, 0x94, 0x98,
-		0x01, 0xdf, 0xee, 0x75, 0xb4, 0xdf, 0xa1, 0x62, 0x98, 0xae, 0x0e, 0x6c, 0x9d, 0x26, 0x12, 0xc0,
-		0x37, 0xba, 0x12, 0x55, 0x3e, 0x17, 0xbe, 0x1a, 0xf7, 0xb8, 0xdf, 0xa1, 0xe8, 0x10, 0xae, 0xf3,
-		0xf4, 0x46, 0xa8, 0x67, 0xd3, 0xd4, 0xd7, 0x18, 0x7b, 0x88, 0x70, 0xe1, 0x9f, 0x12, 0xac, 0x0c,
-		0xe9, 0x48, 0x56, 0xe8, 0x86, 0xdf, 0x72, 0x5c, 0xcf, 0x76, 0x1b, 0xbc, 0x1e, 0x73, 0x78, 0x36,
-		0x0a, 0x18, 0x0d, 0x74, 0x1b, 0x72, 0xe2, 0xa6, 0xe7, 0xb4, 0x22, 0xa3, 0x98, 0xc3, 0x10, 0x85,
-		0x4c, 0xa7, 0x45, 0x46, 0x38, 0x53, 0xf6, 0xff, 0x75, 0xa6, 0x3b, 0x30, 0xef, 0x7a, 0x2e, 0x75,
-		0x1d, 0x4a, 0x1a, 0x2c, 0xaf, 0x29, 0x3e, 0x94, 0xb9, 0x6e, 0xcc, 0x68, 0x14, 0x7e, 0x23, 0xc1,
-		0xaa, 0xfe, 0x91, 0x92, 0xc0, 0x73, 0x9a, 0xdf, 0x89, 0x5b, 0x5e, 0xcc, 0x29, 0x33, 0x98, 0xd3,
-		0xbf, 0xa7, 0x61, 0xa5, 0x42, 0xbc, 0x86, 0xeb, 0x9d, 0x2a, 0x75, 0xea, 0xbe, 0x77, 0xe9, 0x39,
-		0xcf, 0xe8, 0x36, 0xe4, 0x1c, 0x71, 0xdd, 0xab, 0x32, 0xc4, 0x21, 0xa3, 0x81, 0xf6, 0x60, 0xa1,
-		0x0b, 0x48, 0xb5, 0xe4, 0x58, 0x9a, 0x5b, 0xf2, 0xbc, 0x93, 0xb8, 0x42, 0x2f, 0x60, 0x9a, 0xd9,
-		0x63, 0xe4, 0xca, 0x8b, 0x0f, 0x1f, 0x0c, 0xf7, 0xa5, 0xfe, 0x0c, 0x99, 0x13, 0x12, 0x1c, 0xf1,
-		0x90, 0x01, 0xcb, 0x67, 0xc4, 0x09, 0xe8, 0x31, 0x71, 0xa8, 0xdd, 0x20, 0xd4, 0x71, 0x9b, 0xa1,
-		0xf0, 0xe9, 0x1b, 0x23, 0x4c, 0xee, 0xbc, 0xe9, 0x3b, 0x0d, 0x2c, 0x77, 0x69, 0x5a, 0xc4, 0x42,
-		0xaf, 0x60, 0xa5, 0xe9, 0x84, 0xd4, 0xee, 0xe9, 0x71, 0x6b, 0x9b, 0x4e, 0xb5, 0xb6, 0x65, 0x46,
-		0xdb, 0x8f, 0x59, 0xdc, 0xde, 0xf6, 0x80, 0x07, 0xa3, 0xa9, 0x20, 0x8d, 0x48, 0x69, 0x26, 0x55,
-		0x69, 0x89, 0x91, 0xaa, 0x11, 0x87, 0xeb, 0xe4, 0xe1, 0x92, 0x43, 0x29, 0x69, 0xb5, 0x29, 0x77,
-		0xee, 0x69, 0x1c, 0x5f, 0xa2, 0x07, 0x20, 0xb7, 0x9c, 0x8f, 0x6e, 0xab, 0xd3, 0xb2, 0x45, 0x28,
-		0xe4, 0x2e, 0x3c, 0x8d, 0x97, 0x44, 0x5c, 0x11, 0x61, 0x66, 0xd7, 0x61, 0xfd, 0x8c, 0x34, 0x3a,
-		0xcd, 0x38, 0x93, 0xb9, 0x74, 0xbb, 0xee, 0x32, 0x78, 0x1e, 0x2a, 0x2c, 0x91, 0x8f, 0x6d, 0x37,
-		0x9a, 0xd9, 0x48, 0x03, 0x52, 0x35, 0x16, 0x7b, 0x14, 0x2e, 0xf2, 0x02, 0xe6, 0x79, 0x51, 0x4e,
-		0x1c, 0xb7, 0xd9, 0x09, 0x88, 0xf0, 0xda, 0xe1, 0xdb, 0xb4, 0x17, 0x61, 0x70, 0x8e, 0x31, 0xc4,
-		0x05, 0xfa, 0x12, 0x2e, 0x73, 0x01, 0xd6, 0xeb, 0x24, 0xb0, 0xdd, 0x06, 0xf1, 0xa8, 0x4b, 0xcf,
-		0x85, 0xdd, 0x22, 0x76, 0xef, 0x90, 0xdf, 0x32, 0xc4, 0x9d, 0xc2, 0x9f, 0x33, 0x70, 0x55, 0xb4,
-		0x8f, 0x7a, 0xe6, 0x36, 0x1b, 0xdf, 0xc9, 0xe0, 0x7d, 0x91, 0x90, 0x65, 0xc3, 0x91, 0xf4, 0x22,
-		0xf9, 0x43, 0xe2, 0x7c, 0xc2, 0x1d, 0xe9, 0xe2, 0x98, 0x66, 0x07, 0xc6, 0x14, 0xbd, 0x01, 0xf1,
-		0x1a, 0x16, 0xe6, 0xda, 0xf6, 0x9b, 0x6e, 0xfd, 0x9c, 0xb7, 0xf9, 0xe2, 0x88, 0x44, 0x23, 0xe7,
-		0xe4, 0x86, 0x5a, 0xe1, 0x68, 0xbc, 0xdc, 0xbe, 0x18, 0x42, 0x57, 0x60, 0x26, 0xb2, 0x46, 0xde,
-		0xe4, 0x73, 0x58, 0x5c, 0x15, 0xfe, 0x91, 0xe9, 0xda, 0x82, 0x46, 0xea, 0x6e, 0x18, 0xd7, 0xab,
-		0x3b, 0xad, 0x52, 0xfa, 0xb4, 0xc6, 0xc4, 0xbe, 0x69, 0x1d, 0xec, 0xc4, 0xcc, 0xa7, 0x76, 0xe2,
-		0x73, 0x98, 0xef, 0x1b, 0xaa, 0xf4, 0xe3, 0x5c, 0x2e, 0x1c, 0x3e, 0x50, 0x53, 0xfd, 0x03, 0x85,
-		0x61, 0xcd, 0x0f, 0xdc, 0x53, 0xd7, 0x73, 0x9a, 0xf6, 0x85, 0x24, 0xd3, 0x2d, 0x60, 0x35, 0xa6,
-		0x56, 0x93, 0xc9, 0x16, 0xfe, 0x92, 0x81, 0xab, 0xb1, 0x6d, 0x95, 0xfc, 0xba, 0xd3, 0xd4, 0xdc,
-		0xb0, 0xed, 0xd0, 0xfa, 0xd9, 0x64, 0x2e, 0xfb, 0xfd, 0x97, 0xeb, 0x97, 0x70, 0xab, 0x3f, 0x03,
-		0xdb, 0x3f, 0xb1, 0xe9, 0x99, 0x1b, 0xda, 0xc9, 0x2a, 0x8e, 0x17, 0xbc, 0xd6, 0x97, 0x51, 0xf9,
-		0xc4, 0x3a, 0x73, 0x43, 0xe1, 0x4d, 0xe8, 0x26, 0x00, 0x3f, 0x3d, 0x50, 0xff, 0x1d, 0x89, 0xba,
-		0x70, 0x1e, 0xf3, 0xe3, 0x8e, 0xc5, 0x02, 0x85, 0x57, 0x90, 0x4b, 0x9e, 0xb1, 0x9e, 0xc1, 0x8c,
-		0x38, 0xa6, 0x49, 0xeb, 0xd9, 0x8d, 0xdc, 0xc3, 0xcf, 0x52, 0x8e, 0x69, 0xfc, 0x04, 0x2b, 0x28,
-		0x85, 0x3f, 0x66, 0x60, 0xb1, 0xff, 0x16, 0xba, 0x0f, 0x4b, 0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76,
-		0xfd, 0x8c, 0xd4, 0xdf, 0x85, 0x9d, 0x96, 0xd8, 0x84, 0xc5, 0x28, 0xac, 0x8a, 0x28, 0x5a, 0x85,
-		0x99, 0xa0, 0xe3, 0xc5, 0x2f, 0xd1, 0x39, 0x3c, 0x1d, 0x74, 0xd8, 0x69, 0xe3, 0x39, 0x5c, 0x3f,
-		0x71, 0x83, 0x90, 0xbd, 0x78, 0xa2, 0x66, 0xb7, 0xeb, 0x7e, 0xab, 0xdd, 0x24, 0x7d, 0x93, 0x9c,
-		0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0xf3, 0xf5, 0x80, 0x38, 0xdd, 0xbd, 0x49, 0x2f,
-		0x65, 0x4e, 0xe0, 0x85, 0x9d, 0x2e, 0x70, 0x83, 0x75, 0xbd, 0xd3, 0x49, 0xdb, 0x74, 0x3e, 0x26,
-		0x70, 0x81, 0x5b, 0x00, 0xfc, 0xec, 0x4b, 0x9d, 0xe3, 0x66, 0xf4, 0x76, 0x9a, 0xc5, 0x89, 0x48,
-		0xf1, 0x4f, 0x12, 0x5c, 0x1e, 0xf6, 0xee, 0x45, 0x05, 0xb8, 0x55, 0xd1, 0x4d, 0xcd, 0x30, 0x5f,
-		0xda, 0x8a, 0x6a, 0x19, 0x6f, 0x0c, 0xeb, 0xc8, 0xae, 0x5a, 0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x46,
-		0x29, 0x19, 0x9a, 0xfc, 0x03, 0xf4, 0x39, 0xac, 0x8f, 0xc0, 0x54, 0xd5, 0x7d, 0x5d, 0xab, 0x95,
-		0x74, 0x4d, 0x96, 0xc6, 0x28, 0x55, 0x2d, 0x05, 0x5b, 0xba, 0x26, 0x67, 0xd0, 0x0f, 0xe1, 0xfe,
-		0x08, 0x8c, 0xaa, 0x98, 0xaa, 0x5e, 0xb2, 0xb1, 0xfe, 0xb3, 0x9a, 0x5e, 0x65, 0xe0, 0x6c, 0xf1,
-		0x57, 0xbd, 0x9c, 0xfb, 0x1c, 0x28, 0xf9, 0x24, 0x4d, 0x57, 0x8d, 0xaa, 0x51, 0x36, 0xc7, 0xe5,
-		0x7c, 0x01, 0x33, 0x22, 0xe7, 0x8b, 0xa8, 0x38, 0xe7, 0xe2, 0xaf, 0x33, 0xbd, 0x4f, 0x63, 0xa3,
-		0x81, 0x49, 0xa7, 0xeb, 0xb9, 0x9f, 0xc3, 0xfa, 0x61, 0x19, 0xbf, 0xde, 0x2b, 0x95, 0x0f, 0x6d,
-		0x43, 0xb3, 0xb1, 0x5e, 0xab, 0xea, 0x76, 0xa5, 0x5c, 0x32, 0xd4, 0xa3, 0x44, 0x26, 0x3f, 0x82,
-		0xaf, 0x46, 0xa2, 0x94, 0x12, 0x8b, 0x6a, 0xb5, 0x4a, 0xc9, 0x50, 0xd9, 0x53, 0xf7, 0x14, 0xa3,
-		0xa4, 0x6b, 0x76, 0xd9, 0x2c, 0x1d, 0xc9, 0x12, 0xfa, 0x02, 0x36, 0x26, 0x65, 0xca, 0x19, 0xb4,
-		0x09, 0x0f, 0x46, 0xa2, 0xb1, 0xfe, 0x4a, 0x57, 0xad, 0x04, 0x3c, 0x8b, 0x76, 0x60, 0x73, 0x24,
-		0xdc, 0xd2, 0xf1, 0x81, 0x61, 0xf2, 0x82, 0xee, 0xd9, 0xb8, 0x66, 0x9a, 0x86, 0xf9, 0x52, 0x9e,
-		0x2a, 0xfe, 0x5e, 0x82, 0xe5, 0x81, 0x97, 0x11, 0xba, 0x0d, 0xd7, 0x2b, 0x0a, 0xd6, 0x4d, 0xcb,
-		0x56, 0x4b, 0xe5, 0x61, 0x05, 0x18, 0x01, 0x50, 0x76, 0x15, 0x53, 0x2b, 0x9b, 0xb2, 0x84, 0xee,
-		0x41, 0x61, 0x18, 0x40, 0xf4, 0x82, 0x68, 0x0d, 0x39, 0x83, 0xee, 0xc0, 0xcd, 0x61, 0xb8, 0x6e,
-		0xb6, 0x72, 0xb6, 0xf8, 0x9f, 0x0c, 0xdc, 0x18, 0xf7, 0x05, 0xce, 0x3a, 0xb0, 0xbb, 0x6c, 0xfd,
-		0xad, 0xae, 0xd6, 0x2c, 0xb6, 0xe7, 0x91, 0x1e, 0xdb, 0xf9, 0x5a, 0x35, 0x91, 0x79, 0xb2, 0xa4,
-		0x23, 0xc0, 0x6a, 0xf9, 0xa0, 0x52, 0xd2, 0x2d, 0xde, 0x4d, 0x45, 0xb8, 0x97, 0x06, 0x8f, 0x36,
-		0x58, 0xce, 0xf4, 0xed, 0xed, 0x28, 0x69, 0xbe, 0x6e, 0x36, 0x0a, 0x68, 0x0b, 0x8a, 0x69, 0xe8,
-		0x6e, 0x15, 0x34, 0x79, 0x0a, 0x7d, 0x05, 0x5f, 0xa6, 0x27, 0x6e, 0x5a, 0x86, 0x59, 0xd3, 0x35,
-		0x5b, 0xa9, 0xda, 0xa6, 0x7e, 0x28, 0x4f, 0x4f, 0xb2, 0x5c, 0xcb, 0x38, 0x60, 0xfd, 0x59, 0xb3,
-		0xe4, 0x99, 0xe2, 0x5f, 0x25, 0xb8, 0xa2, 0xfa, 0x1e, 0x75, 0xbd, 0x0e, 0x51, 0x42, 0x93, 0x7c,
-		0x30, 0xa2, 0x73, 0x8e, 0x1f, 0xa0, 0xbb, 0x70, 0x27, 0xd6, 0x17, 0xf2, 0xb6, 0x61, 0x1a, 0x96,
-		0xa1, 0x58, 0x65, 0x9c, 0xa8, 0xef, 0x58, 0x18, 0x1b, 0x48, 0x4d, 0xc7, 0x51, 0x5d, 0x47, 0xc3,
-		0xb0, 0x6e, 0xe1, 0x23, 0xd1, 0x0a, 0x91, 0xc3, 0x8c, 0xc6, 0xaa, 0x98, 0xcd, 0xb7, 0x98, 0x7f,
-		0x39, 0x5b, 0xfc, 0x83, 0x04, 0x39, 0xf1, 0x8d, 0xca, 0x3f, 0x61, 0xf2, 0x70, 0x99, 0x2d, 0xb0,
-		0x5c, 0xb3, 0x6c, 0xeb, 0xa8, 0xa2, 0xf7, 0xf7, 0x70, 0xdf, 0x1d, 0x6e, 0x0f, 0xb6, 0x55, 0x8e,
-		0xaa, 0x13, 0x39, 0x49, 0x3f, 0x40, 0x3c, 0x85, 0x61, 0x38, 0x58, 0xce, 0x8c, 0xc5, 0x44, 0x3a,
-		0x59, 0x74, 0x0d, 0xae, 0xf4, 0x61, 0xf6, 0x75, 0x05, 0x5b, 0xbb, 0xba, 0x62, 0xc9, 0x53, 0xc5,
-		0xdf, 0x49, 0x70, 0x35, 0x76, 0x42, 0x8b, 0xbd, 0x58, 0xdd, 0x16, 0x69, 0x94, 0x3b, 0x54, 0x75,
-		0x3a, 0x21, 0x41, 0x0f, 0xe0, 0x6e, 0xd7, 0xc3, 0x2c, 0xa5, 0xfa, 0xba, 0xb7, 0x57, 0xb6, 0xaa,
-		0xb0, 0xe1, 0xee, 0xad, 0x26, 0x15, 0x2a, 0x52, 0x90, 0x25, 0x74, 0x1f, 0x3e, 0x1b, 0x0f, 0xc5,
-		0x7a, 0x55, 0xb7, 0xe4, 0x4c, 0xf1, 0x5f, 0x39, 0x58, 0x4b, 0x26, 0xc7, 0x0e, 0xfa, 0xa4, 0x11,
-		0xa5, 0x76, 0x0f, 0x0a, 0xfd, 0x22, 0xc2, 0xe7, 0x2e, 0xe6, 0xb5, 0x03, 0x9b, 0x63, 0x70, 0x35,
-		0x73, 0x5f, 0x31, 0x35, 0x76, 0x1d, 0x83, 0x64, 0x09, 0xbd, 0x80, 0x67, 0x63, 0x28, 0xbb, 0x8a,
-		0xd6, 0xab, 0x72, 0xf7, 0x8d, 0xa3, 0x58, 0x16, 0x36, 0x76, 0x6b, 0x96, 0x5e, 0x95, 0x33, 0x48,
-		0x07, 0x25, 0x45, 0xa0, 0xdf, 0x87, 0x86, 0xca, 0x64, 0xd1, 0x53, 0x78, 0x9c, 0x96, 0x47, 0xd4,
-		0x32, 0xc6, 0x81, 0x8e, 0x93, 0xd4, 0x29, 0xf4, 0x0d, 0x7c, 0x9d, 0x42, 0x15, 0x4f, 0x1e, 0xe0,
-		0x4e, 0xa3, 0x67, 0xf0, 0x24, 0x35, 0x7b, 0xb5, 0x8c, 0x35, 0xfb, 0x40, 0xc1, 0xaf, 0xfb, 0xc9,
-		0x33, 0xc8, 0x00, 0x3d, 0xed, 0xc1, 0xc2, 0xdd, 0xec, 0x21, 0xbe, 0x90, 0x90, 0xba, 0x34, 0x41,
-		0x15, 0x59, 0x20, 0x45, 0x66, 0x16, 0xbd, 0x04, 0x75, 0xb2, 0x52, 0x8c, 0x17, 0x9a, 0x43, 0x6f,
-		0xc1, 0xfa, 0xb4, 0x5d, 0xd5, 0xdf, 0x5a, 0x3a, 0x36, 0x95, 0x34, 0x65, 0x40, 0xcf, 0xe1, 0x69,
-		0x6a, 0xd1, 0xfa, 0xfd, 0x27, 0x41, 0xcf, 0xa1, 0x27, 0xf0, 0x68, 0x0c, 0x3d, 0xd9, 0x23, 0xbd,
-		0x53, 0x81, 0xa1, 0xc9, 0xf3, 0xe8, 0x31, 0xec, 0x8c, 0x21, 0xf2, 0x29, 0xb4, 0xab, 0x96, 0xa1,
-		0xbe, 0x3e, 0x8a, 0x6e, 0x97, 0x8c, 0xaa, 0x25, 0x2f, 0xa0, 0x9f, 0xc2, 0x8f, 0xc7, 0xd0, 0xba,
-		0x8b, 0x65, 0x7f, 0xe8, 0x38, 0x31, 0x62, 0x0c, 0x56, 0xc3, 0xba, 0xbc, 0x38, 0xc1, 0x9e, 0x54,
-		0x8d, 0x97, 0xe9, 0x95, 0x5b, 0x42, 0x2a, 0xbc, 0x98, 0x68, 0x44, 0xd4, 0x7d, 0xa3, 0xa4, 0x0d,
-		0x17, 0x91, 0xd1, 0x23, 0xd8, 0x1e, 0x23, 0xb2, 0x57, 0xc6, 0xaa, 0x2e, 0xde, 0x58, 0x5d, 0x93,
-		0x58, 0x46, 0x5f, 0xc3, 0xc3, 0x71, 0x24, 0xc5, 0x28, 0x95, 0xdf, 0xe8, 0xf8, 0x22, 0x0f, 0xb1,
-		0xd7, 0xe8, 0x64, 0x4b, 0x37, 0xcc, 0x4a, 0xcd, 0xb2, 0xab, 0xc6, 0xb7, 0xba, 0xbc, 0xc2, 0x5e,
-		0xa3, 0xa9, 0x3b, 0x15, 0xd7, 0x4a, 0xbe, 0x3c, 0x68, 0xc6, 0x03, 0x0f, 0xd9, 0x35, 0x4c, 0x05,
-		0x1f, 0xc9, 0xab, 0x29, 0xbd, 0x37, 0x68, 0x74, 0x7d, 0x2d, 0x74, 0x65, 0x92, 0xe5, 0xe8, 0x0a,
-		0x56, 0xf7, 0x93, 0x15, 0x5f, 0x63, 0x6f, 0x9d, 0x3b, 0xfc, 0x1f, 0x2e, 0x03, 0xe7, 0xaa, 0xa4,
-		0xc5, 0xef, 0xc0, 0x66, 0xb4, 0x6f, 0x43, 0xba, 0x60, 0x84, 0xdb, 0xef, 0xc2, 0x4f, 0x26, 0xa3,
-		0x74, 0xef, 0x2b, 0x25, 0xac, 0x2b, 0xda, 0x51, 0xf7, 0x48, 0x2a, 0x15, 0xff, 0x2e, 0x41, 0x51,
-		0x75, 0xbc, 0x3a, 0x69, 0xc6, 0xff, 0x8f, 0x1d, 0x9b, 0xe5, 0x33, 0x78, 0x32, 0xc1, 0xbc, 0x8f,
-		0xc8, 0xf7, 0x10, 0xaa, 0x9f, 0x4a, 0xae, 0x99, 0xaf, 0xcd, 0xf2, 0xa1, 0x39, 0x8e, 0x20, 0x16,
-		0x51, 0x75, 0x4f, 0xf9, 0x3f, 0x93, 0x27, 0x5b, 0x84, 0x68, 0xbb, 0xff, 0x6d, 0x11, 0x9f, 0x4a,
-		0x9e, 0x68, 0x11, 0xbb, 0xbf, 0x80, 0xb5, 0xba, 0xdf, 0x1a, 0xf6, 0x15, 0xbf, 0xbb, 0x10, 0x2f,
-		0xa7, 0xc2, 0x3e, 0x63, 0x2b, 0xd2, 0xb7, 0x3b, 0xa7, 0x2e, 0x3d, 0xeb, 0x1c, 0x6f, 0xd5, 0xfd,
-		0xd6, 0x76, 0xf2, 0xc7, 0xc9, 0x4d, 0xb7, 0xd1, 0xdc, 0x3e, 0xf5, 0xa3, 0x1f, 0x3b, 0xc5, 0x2f,
-		0x95, 0xcf, 0x9c, 0xb6, 0xfb, 0x7e, 0xe7, 0x78, 0x86, 0xc7, 0x1e, 0xfd, 0x37, 0x00, 0x00, 0xff,
-		0xff, 0x81, 0xb3, 0xae, 0xe2, 0x69, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xdb, 0xd8,
+		0xf5, 0xfd, 0x51, 0xb2, 0x1d, 0xfb, 0xca, 0x1f, 0xf4, 0x73, 0x1c, 0x2b, 0x5f, 0x8e, 0xa3, 0x99,
+		0x24, 0x8e, 0x7e, 0x63, 0x7b, 0x9c, 0x4c, 0x26, 0xcd, 0xa4, 0x69, 0x4a, 0x93, 0x74, 0xcc, 0x44,
+		0xa6, 0xd4, 0x47, 0x2a, 0x8e, 0x07, 0x45, 0x09, 0x5a, 0xa2, 0xed, 0x87, 0x48, 0xa4, 0x40, 0x3e,
+		0x25, 0xf1, 0xbe, 0x40, 0xd7, 0xdd, 0x15, 0x5d, 0xf5, 0x0f, 0x28, 0x50, 0x14, 0x5d, 0x17, 0x2d,
+		0xba, 0x28, 0xba, 0x29, 0xd0, 0x55, 0x97, 0x45, 0x91, 0x5d, 0xff, 0x8b, 0xe2, 0x3d, 0x92, 0x12,
+		0xf5, 0x49, 0xa5, 0x05, 0xa6, 0x3b, 0xf3, 0xf2, 0x9c, 0xc3, 0xfb, 0xee, 0xbb, 0xf7, 0xf0, 0xd1,
+		0x82, 0x42, 0xfb, 0xc4, 0xf1, 0x77, 0x6a, 0x76, 0xdd, 0x71, 0x6b, 0xce, 0x8e, 0xdd, 0x22, 0x3b,
+		0xef, 0x76, 0x77, 0xde, 0x7b, 0xfe, 0xdb, 0xd3, 0x86, 0xf7, 0x7e, 0xbb, 0xe5, 0x7b, 0xd4, 0x43,
+		0x2b, 0x0c, 0xb3, 0x1d, 0x61, 0xb6, 0xed, 0x16, 0xd9, 0x7e, 0xb7, 0x7b, 0x6d, 0xfd, 0xcc, 0xf3,
+		0xce, 0x1a, 0xce, 0x0e, 0x87, 0x9c, 0xb4, 0x4f, 0x77, 0xea, 0x6d, 0xdf, 0xa6, 0xc4, 0x73, 0x43,
+		0xd2, 0xb5, 0x5b, 0xfd, 0xf7, 0x29, 0x69, 0x3a, 0x01, 0xb5, 0x9b, 0xad, 0x08, 0xb0, 0x31, 0xec,
+		0xc9, 0x35, 0xaf, 0xd9, 0xec, 0x48, 0x0c, 0xcd, 0x8d, 0xda, 0xc1, 0xdb, 0x06, 0x09, 0x68, 0x88,
+		0x29, 0xfc, 0x71, 0x06, 0x56, 0x8f, 0xa2, 0x74, 0xd5, 0x0f, 0x4e, 0xad, 0xcd, 0x52, 0xd0, 0xdc,
+		0x53, 0x0f, 0x55, 0x01, 0xc5, 0xeb, 0xb0, 0x9c, 0xf8, 0x4e, 0x5e, 0xd8, 0x10, 0x36, 0x73, 0x0f,
+		0xee, 0x6e, 0x0f, 0x59, 0xd2, 0xf6, 0x80, 0x0e, 0x5e, 0x7e, 0xdf, 0x1f, 0x42, 0x8f, 0x60, 0x8a,
+		0x5e, 0xb4, 0x9c, 0x7c, 0x86, 0x0b, 0xdd, 0x1e, 0x2b, 0x64, 0x5e, 0xb4, 0x1c, 0xcc, 0xe1, 0xe8,
+		0x09, 0x40, 0x40, 0x6d, 0x9f, 0x5a, 0xac, 0x0c, 0xf9, 0x2c, 0x27, 0x5f, 0xdb, 0x0e, 0x6b, 0xb4,
+		0x1d, 0xd7, 0x68, 0xdb, 0x8c, 0x6b, 0x84, 0xe7, 0x38, 0x9a, 0x5d, 0x33, 0x6a, 0xad, 0xe1, 0x05,
+		0x4e, 0x48, 0x9d, 0x4a, 0xa7, 0x72, 0x34, 0xa7, 0x9a, 0x30, 0x1f, 0x52, 0x03, 0x6a, 0xd3, 0x76,
+		0x90, 0x9f, 0xde, 0x10, 0x36, 0x17, 0x1f, 0xec, 0x4e, 0xb6, 0x7a, 0x99, 0x31, 0x0d, 0x4e, 0xc4,
+		0xb9, 0x5a, 0xf7, 0x02, 0xdd, 0x81, 0xc5, 0x73, 0x12, 0x50, 0xcf, 0xbf, 0xb0, 0x1a, 0x8e, 0x7b,
+		0x46, 0xcf, 0xf3, 0x33, 0x1b, 0xc2, 0x66, 0x16, 0x2f, 0x44, 0xd1, 0x12, 0x0f, 0xa2, 0x1f, 0xc3,
+		0x6a, 0xcb, 0xf6, 0x1d, 0x97, 0x76, 0xcb, 0x6f, 0x11, 0xf7, 0xd4, 0xcb, 0x5f, 0xe2, 0x4b, 0xd8,
+		0x1c, 0x9a, 0x45, 0x85, 0x33, 0x7a, 0x76, 0x12, 0xaf, 0xb4, 0x06, 0x83, 0x48, 0x82, 0xc5, 0xae,
+		0x2c, 0xaf, 0xcc, 0x6c, 0x6a, 0x65, 0x16, 0x3a, 0x0c, 0x5e, 0x9d, 0x2d, 0x98, 0x6a, 0x3a, 0x4d,
+		0x2f, 0x3f, 0xc7, 0x89, 0x57, 0x87, 0xe6, 0x73, 0xe8, 0x34, 0x3d, 0xcc, 0x61, 0x08, 0xc3, 0x72,
+		0xe0, 0xd8, 0x7e, 0xed, 0xdc, 0xb2, 0x29, 0xf5, 0xc9, 0x49, 0x9b, 0x3a, 0x41, 0x1e, 0x38, 0xf7,
+		0xce, 0x50, 0xae, 0xc1, 0xd1, 0x52, 0x07, 0x8c, 0xc5, 0xa0, 0x2f, 0x82, 0x4a, 0xb0, 0x6c, 0xb7,
+		0xa9, 0x67, 0xf9, 0x4e, 0xe0, 0x50, 0xab, 0xe5, 0x11, 0x97, 0x06, 0xf9, 0x1c, 0xd7, 0xdc, 0x18,
+		0xaa, 0x89, 0x19, 0xb0, 0xc2, 0x71, 0x78, 0x89, 0x51, 0x13, 0x01, 0x74, 0x1d, 0xe6, 0xd8, 0x78,
+		0x58, 0x6c, 0x3e, 0xf2, 0xf3, 0x1b, 0xc2, 0xe6, 0x1c, 0x9e, 0x65, 0x81, 0x12, 0x09, 0x28, 0x5a,
+		0x83, 0x4b, 0x24, 0xb0, 0x6a, 0xbe, 0xe7, 0xe6, 0x17, 0x36, 0x84, 0xcd, 0x59, 0x3c, 0x43, 0x02,
+		0xd9, 0xf7, 0xdc, 0xc2, 0x2f, 0x32, 0xb0, 0x3e, 0xb8, 0xf9, 0x9e, 0x7b, 0x4a, 0xce, 0xa2, 0x91,
+		0x46, 0xdf, 0x24, 0x85, 0xc3, 0x11, 0xba, 0x39, 0x34, 0x3d, 0x33, 0x7a, 0x5a, 0xe2, 0xb9, 0x36,
+		0x6c, 0x74, 0x37, 0x2a, 0x9a, 0x01, 0xcf, 0xea, 0x76, 0xb4, 0xd7, 0xa6, 0xd1, 0x30, 0x5d, 0x1d,
+		0xd8, 0x3a, 0x25, 0x4a, 0x00, 0xdf, 0xe8, 0x48, 0x18, 0x7c, 0x2e, 0x3c, 0x39, 0xee, 0x71, 0xaf,
+		0x4d, 0xd1, 0x11, 0x5c, 0xe7, 0xe9, 0x8d, 0x50, 0xcf, 0xa6, 0xa9, 0xaf, 0x31, 0xf6, 0x10, 0xe1,
+		0xc2, 0x5f, 0x05, 0x58, 0x19, 0xd2, 0x91, 0xac, 0xd0, 0x75, 0xaf, 0x69, 0x13, 0xd7, 0x22, 0x75,
+		0x5e, 0x8f, 0x39, 0x3c, 0x1b, 0x06, 0xb4, 0x3a, 0xba, 0x05, 0xb9, 0xe8, 0xa6, 0x6b, 0x37, 0x43,
+		0xa3, 0x98, 0xc3, 0x10, 0x86, 0x74, 0xbb, 0xe9, 0x8c, 0x70, 0xa6, 0xec, 0x7f, 0xeb, 0x4c, 0xb7,
+		0x61, 0x9e, 0xb8, 0x84, 0x12, 0x9b, 0x3a, 0x75, 0x96, 0xd7, 0x14, 0x1f, 0xca, 0x5c, 0x27, 0xa6,
+		0xd5, 0x0b, 0x3f, 0x17, 0x60, 0x55, 0xfd, 0x40, 0x1d, 0xdf, 0xb5, 0x1b, 0xdf, 0x89, 0x5b, 0xf6,
+		0xe7, 0x94, 0x19, 0xcc, 0xe9, 0x1f, 0xd3, 0xb0, 0x52, 0x71, 0xdc, 0x3a, 0x71, 0xcf, 0xa4, 0x1a,
+		0x25, 0xef, 0x08, 0xbd, 0xe0, 0x19, 0xdd, 0x82, 0x9c, 0x1d, 0x5d, 0x77, 0xab, 0x0c, 0x71, 0x48,
+		0xab, 0xa3, 0x7d, 0x58, 0xe8, 0x00, 0x52, 0x2d, 0x39, 0x96, 0xe6, 0x96, 0x3c, 0x6f, 0x27, 0xae,
+		0xd0, 0x73, 0x98, 0x66, 0xf6, 0x18, 0xba, 0xf2, 0xe2, 0x83, 0xfb, 0xc3, 0x7d, 0xa9, 0x37, 0x43,
+		0xe6, 0x84, 0x0e, 0x0e, 0x79, 0x48, 0x83, 0xe5, 0x73, 0xc7, 0xf6, 0xe9, 0x89, 0x63, 0x53, 0xab,
+		0xee, 0x50, 0x9b, 0x34, 0x82, 0xc8, 0xa7, 0x6f, 0x8c, 0x30, 0xb9, 0x8b, 0x86, 0x67, 0xd7, 0xb1,
+		0xd8, 0xa1, 0x29, 0x21, 0x0b, 0xbd, 0x84, 0x95, 0x86, 0x1d, 0x50, 0xab, 0xab, 0xc7, 0xad, 0x6d,
+		0x3a, 0xd5, 0xda, 0x96, 0x19, 0xed, 0x20, 0x66, 0xb1, 0x38, 0xda, 0x07, 0x1e, 0x0c, 0xa7, 0xc2,
+		0xa9, 0x87, 0x4a, 0x33, 0xa9, 0x4a, 0x4b, 0x8c, 0x64, 0x84, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x36,
+		0xa5, 0x4e, 0xb3, 0x45, 0xb9, 0x73, 0x4f, 0xe3, 0xf8, 0x12, 0xdd, 0x07, 0xb1, 0x69, 0x7f, 0x20,
+		0xcd, 0x76, 0xd3, 0x8a, 0x42, 0x01, 0x77, 0xe1, 0x69, 0xbc, 0x14, 0xc5, 0xa5, 0x28, 0xcc, 0xec,
+		0x3a, 0xa8, 0x9d, 0x3b, 0xf5, 0x76, 0x23, 0xce, 0x64, 0x2e, 0xdd, 0xae, 0x3b, 0x0c, 0x9e, 0x87,
+		0x0c, 0x4b, 0xce, 0x87, 0x16, 0x09, 0x67, 0x36, 0xd4, 0x80, 0x54, 0x8d, 0xc5, 0x2e, 0x85, 0x8b,
+		0x3c, 0x87, 0x79, 0x5e, 0x94, 0x53, 0x9b, 0x34, 0xda, 0xbe, 0x93, 0xcf, 0x8d, 0xd9, 0xa6, 0xfd,
+		0x10, 0x83, 0x73, 0x8c, 0x11, 0x5d, 0xa0, 0x2f, 0xe1, 0x32, 0x17, 0x60, 0xbd, 0xee, 0xf8, 0x16,
+		0xa9, 0x3b, 0x2e, 0x25, 0xf4, 0x22, 0xb2, 0x5b, 0xc4, 0xee, 0x1d, 0xf1, 0x5b, 0x5a, 0x74, 0xa7,
+		0xf0, 0xbb, 0x0c, 0x5c, 0x8d, 0xda, 0x47, 0x3e, 0x27, 0x8d, 0xfa, 0x77, 0x32, 0x78, 0x5f, 0x24,
+		0x64, 0xd9, 0x70, 0x24, 0xbd, 0x48, 0x7c, 0x9f, 0x38, 0x9f, 0x70, 0x47, 0xea, 0x1f, 0xd3, 0xec,
+		0xc0, 0x98, 0xa2, 0xd7, 0x10, 0xbd, 0x86, 0x23, 0x73, 0x6d, 0x79, 0x0d, 0x52, 0xbb, 0xe0, 0x6d,
+		0xbe, 0x38, 0x22, 0xd1, 0xd0, 0x39, 0xb9, 0xa1, 0x56, 0x38, 0x1a, 0x2f, 0xb7, 0xfa, 0x43, 0xe8,
+		0x0a, 0xcc, 0x84, 0xd6, 0xc8, 0x9b, 0x7c, 0x0e, 0x47, 0x57, 0x85, 0x3f, 0x67, 0x3a, 0xb6, 0xa0,
+		0x38, 0x35, 0x12, 0xc4, 0xf5, 0xea, 0x4c, 0xab, 0x90, 0x3e, 0xad, 0x31, 0xb1, 0x67, 0x5a, 0x07,
+		0x3b, 0x31, 0xf3, 0xa9, 0x9d, 0xf8, 0x0c, 0xe6, 0x7b, 0x86, 0x2a, 0xfd, 0x38, 0x97, 0x0b, 0x86,
+		0x0f, 0xd4, 0x54, 0xef, 0x40, 0x61, 0x58, 0xf3, 0x7c, 0x72, 0x46, 0x5c, 0xbb, 0x61, 0xf5, 0x25,
+		0x99, 0x6e, 0x01, 0xab, 0x31, 0xd5, 0x48, 0x26, 0x5b, 0xf8, 0x7d, 0x06, 0xae, 0xc6, 0xb6, 0x55,
+		0xf2, 0x6a, 0x76, 0x43, 0x21, 0x41, 0xcb, 0xa6, 0xb5, 0xf3, 0xc9, 0x5c, 0xf6, 0x7f, 0x5f, 0xae,
+		0x9f, 0xc0, 0x7a, 0x6f, 0x06, 0x96, 0x77, 0x6a, 0xd1, 0x73, 0x12, 0x58, 0xc9, 0x2a, 0x8e, 0x17,
+		0xbc, 0xd6, 0x93, 0x51, 0xf9, 0xd4, 0x3c, 0x27, 0x41, 0xe4, 0x4d, 0xe8, 0x26, 0x00, 0x3f, 0x3d,
+		0x50, 0xef, 0xad, 0x13, 0x76, 0xe1, 0x3c, 0xe6, 0xc7, 0x1d, 0x93, 0x05, 0x0a, 0x2f, 0x21, 0x97,
+		0x3c, 0x63, 0x3d, 0x85, 0x99, 0xe8, 0x98, 0x26, 0x6c, 0x64, 0x37, 0x73, 0x0f, 0x3e, 0x4b, 0x39,
+		0xa6, 0xf1, 0x13, 0x6c, 0x44, 0x29, 0xfc, 0x26, 0x03, 0x8b, 0xbd, 0xb7, 0xd0, 0x3d, 0x58, 0x3a,
+		0x21, 0xae, 0xed, 0x5f, 0x58, 0xb5, 0x73, 0xa7, 0xf6, 0x36, 0x68, 0x37, 0xa3, 0x4d, 0x58, 0x0c,
+		0xc3, 0x72, 0x14, 0x45, 0xab, 0x30, 0xe3, 0xb7, 0xdd, 0xf8, 0x25, 0x3a, 0x87, 0xa7, 0xfd, 0x36,
+		0x3b, 0x6d, 0x3c, 0x83, 0xeb, 0xa7, 0xc4, 0x0f, 0xd8, 0x8b, 0x27, 0x6c, 0x76, 0xab, 0xe6, 0x35,
+		0x5b, 0x0d, 0xa7, 0x67, 0x92, 0xf3, 0x1c, 0x12, 0x8f, 0x83, 0x1c, 0x03, 0x38, 0x7d, 0xbe, 0xe6,
+		0x3b, 0x76, 0x67, 0x6f, 0xd2, 0x4b, 0x99, 0x8b, 0xf0, 0x91, 0x9d, 0x2e, 0x70, 0x83, 0x25, 0xee,
+		0xd9, 0xa4, 0x6d, 0x3a, 0x1f, 0x13, 0xb8, 0xc0, 0x3a, 0x00, 0x3f, 0xfb, 0x52, 0xfb, 0xa4, 0x11,
+		0xbe, 0x9d, 0x66, 0x71, 0x22, 0x52, 0xfc, 0xad, 0x00, 0x97, 0x87, 0xbd, 0x7b, 0x51, 0x01, 0xd6,
+		0x2b, 0xaa, 0xae, 0x68, 0xfa, 0x0b, 0x4b, 0x92, 0x4d, 0xed, 0xb5, 0x66, 0x1e, 0x5b, 0x86, 0x29,
+		0x99, 0xaa, 0xa5, 0xe9, 0xaf, 0xa5, 0x92, 0xa6, 0x88, 0xff, 0x87, 0x3e, 0x87, 0x8d, 0x11, 0x18,
+		0x43, 0x3e, 0x50, 0x95, 0x6a, 0x49, 0x55, 0x44, 0x61, 0x8c, 0x92, 0x61, 0x4a, 0xd8, 0x54, 0x15,
+		0x31, 0x83, 0xfe, 0x1f, 0xee, 0x8d, 0xc0, 0xc8, 0x92, 0x2e, 0xab, 0x25, 0x0b, 0xab, 0x3f, 0xaa,
+		0xaa, 0x06, 0x03, 0x67, 0x8b, 0x3f, 0xed, 0xe6, 0xdc, 0xe3, 0x40, 0xc9, 0x27, 0x29, 0xaa, 0xac,
+		0x19, 0x5a, 0x59, 0x1f, 0x97, 0x73, 0x1f, 0x66, 0x44, 0xce, 0xfd, 0xa8, 0x38, 0xe7, 0xe2, 0xcf,
+		0x32, 0xdd, 0x4f, 0x63, 0xad, 0x8e, 0x9d, 0x76, 0xc7, 0x73, 0x3f, 0x87, 0x8d, 0xa3, 0x32, 0x7e,
+		0xb5, 0x5f, 0x2a, 0x1f, 0x59, 0x9a, 0x62, 0x61, 0xb5, 0x6a, 0xa8, 0x56, 0xa5, 0x5c, 0xd2, 0xe4,
+		0xe3, 0x44, 0x26, 0xdf, 0x83, 0xaf, 0x46, 0xa2, 0xa4, 0x12, 0x8b, 0x2a, 0xd5, 0x4a, 0x49, 0x93,
+		0xd9, 0x53, 0xf7, 0x25, 0xad, 0xa4, 0x2a, 0x56, 0x59, 0x2f, 0x1d, 0x8b, 0x02, 0xfa, 0x02, 0x36,
+		0x27, 0x65, 0x8a, 0x19, 0xb4, 0x05, 0xf7, 0x47, 0xa2, 0xb1, 0xfa, 0x52, 0x95, 0xcd, 0x04, 0x3c,
+		0x8b, 0x76, 0x61, 0x6b, 0x24, 0xdc, 0x54, 0xf1, 0xa1, 0xa6, 0xf3, 0x82, 0xee, 0x5b, 0xb8, 0xaa,
+		0xeb, 0x9a, 0xfe, 0x42, 0x9c, 0x2a, 0xfe, 0x4a, 0x80, 0xe5, 0x81, 0x97, 0x11, 0xba, 0x05, 0xd7,
+		0x2b, 0x12, 0x56, 0x75, 0xd3, 0x92, 0x4b, 0xe5, 0x61, 0x05, 0x18, 0x01, 0x90, 0xf6, 0x24, 0x5d,
+		0x29, 0xeb, 0xa2, 0x80, 0xee, 0x42, 0x61, 0x18, 0x20, 0xea, 0x85, 0xa8, 0x35, 0xc4, 0x0c, 0xba,
+		0x0d, 0x37, 0x87, 0xe1, 0x3a, 0xd9, 0x8a, 0xd9, 0xe2, 0xbf, 0x32, 0x70, 0x63, 0xdc, 0x17, 0x38,
+		0xeb, 0xc0, 0xce, 0xb2, 0xd5, 0x37, 0xaa, 0x5c, 0x35, 0xd9, 0x9e, 0x87, 0x7a, 0x6c, 0xe7, 0xab,
+		0x46, 0x22, 0xf3, 0x64, 0x49, 0x47, 0x80, 0xe5, 0xf2, 0x61, 0xa5, 0xa4, 0x9a, 0xbc, 0x9b, 0x8a,
+		0x70, 0x37, 0x0d, 0x1e, 0x6e, 0xb0, 0x98, 0xe9, 0xd9, 0xdb, 0x51, 0xd2, 0x7c, 0xdd, 0x6c, 0x14,
+		0xd0, 0x36, 0x14, 0xd3, 0xd0, 0x9d, 0x2a, 0x28, 0xe2, 0x14, 0xfa, 0x0a, 0xbe, 0x4c, 0x4f, 0x5c,
+		0x37, 0x35, 0xbd, 0xaa, 0x2a, 0x96, 0x64, 0x58, 0xba, 0x7a, 0x24, 0x4e, 0x4f, 0xb2, 0x5c, 0x53,
+		0x3b, 0x64, 0xfd, 0x59, 0x35, 0xc5, 0x99, 0xe2, 0x1f, 0x04, 0xb8, 0x22, 0x7b, 0x2e, 0x25, 0x6e,
+		0xdb, 0x91, 0x02, 0xdd, 0x79, 0xaf, 0x85, 0xe7, 0x1c, 0xcf, 0x47, 0x77, 0xe0, 0x76, 0xac, 0x1f,
+		0xc9, 0x5b, 0x9a, 0xae, 0x99, 0x9a, 0x64, 0x96, 0x71, 0xa2, 0xbe, 0x63, 0x61, 0x6c, 0x20, 0x15,
+		0x15, 0x87, 0x75, 0x1d, 0x0d, 0xc3, 0xaa, 0x89, 0x8f, 0xa3, 0x56, 0x08, 0x1d, 0x66, 0x34, 0x56,
+		0xc6, 0x65, 0xbd, 0x33, 0xff, 0x62, 0xb6, 0xf8, 0x6b, 0x01, 0x72, 0xd1, 0x37, 0x2a, 0xff, 0x84,
+		0xc9, 0xc3, 0x65, 0xb6, 0xc0, 0x72, 0xd5, 0xb4, 0xcc, 0xe3, 0x8a, 0xda, 0xdb, 0xc3, 0x3d, 0x77,
+		0xb8, 0x3d, 0x58, 0x66, 0x39, 0xac, 0x4e, 0xe8, 0x24, 0xbd, 0x80, 0xe8, 0x29, 0x0c, 0xc3, 0xc1,
+		0x62, 0x66, 0x2c, 0x26, 0xd4, 0xc9, 0xa2, 0x6b, 0x70, 0xa5, 0x07, 0x73, 0xa0, 0x4a, 0xd8, 0xdc,
+		0x53, 0x25, 0x53, 0x9c, 0x2a, 0xfe, 0x52, 0x80, 0xab, 0xb1, 0x13, 0xb2, 0xff, 0x10, 0xb0, 0xd4,
+		0xeb, 0xe5, 0x36, 0x95, 0xed, 0x76, 0xe0, 0xa0, 0xfb, 0x70, 0xa7, 0xe3, 0x61, 0xa6, 0x64, 0xbc,
+		0xea, 0xee, 0x95, 0x25, 0x4b, 0x55, 0x23, 0xb9, 0x9a, 0x54, 0x68, 0x94, 0x82, 0x28, 0xa0, 0x7b,
+		0xf0, 0xd9, 0x78, 0x28, 0x56, 0x0d, 0xd5, 0x14, 0x33, 0xc5, 0xbf, 0xe7, 0x60, 0x2d, 0x99, 0x1c,
+		0x3b, 0xe8, 0x3b, 0xf5, 0x30, 0xb5, 0xbb, 0x50, 0xe8, 0x15, 0x89, 0x7c, 0xae, 0x3f, 0xaf, 0x5d,
+		0xd8, 0x1a, 0x83, 0xab, 0xea, 0x07, 0x92, 0xae, 0xb0, 0xeb, 0x18, 0x24, 0x0a, 0xe8, 0x39, 0x3c,
+		0x1d, 0x43, 0xd9, 0x93, 0x94, 0x6e, 0x95, 0x3b, 0x6f, 0x1c, 0xc9, 0x34, 0xb1, 0xb6, 0x57, 0x35,
+		0x55, 0x43, 0xcc, 0x20, 0x15, 0xa4, 0x14, 0x81, 0x5e, 0x1f, 0x1a, 0x2a, 0x93, 0x45, 0x4f, 0xe0,
+		0x51, 0x5a, 0x1e, 0x61, 0xcb, 0x68, 0x87, 0x2a, 0x4e, 0x52, 0xa7, 0xd0, 0x37, 0xf0, 0x75, 0x0a,
+		0x35, 0x7a, 0xf2, 0x00, 0x77, 0x1a, 0x3d, 0x85, 0xc7, 0xa9, 0xd9, 0xcb, 0x65, 0xac, 0x58, 0x87,
+		0x12, 0x7e, 0xd5, 0x4b, 0x9e, 0x41, 0x1a, 0xa8, 0x69, 0x0f, 0x8e, 0xdc, 0xcd, 0x1a, 0xe2, 0x0b,
+		0x09, 0xa9, 0x4b, 0x13, 0x54, 0x91, 0x05, 0x52, 0x64, 0x66, 0xd1, 0x0b, 0x90, 0x27, 0x2b, 0xc5,
+		0x78, 0xa1, 0x39, 0xf4, 0x06, 0xcc, 0x4f, 0xdb, 0x55, 0xf5, 0x8d, 0xa9, 0x62, 0x5d, 0x4a, 0x53,
+		0x06, 0xf4, 0x0c, 0x9e, 0xa4, 0x16, 0xad, 0xd7, 0x7f, 0x12, 0xf4, 0x1c, 0x7a, 0x0c, 0x0f, 0xc7,
+		0xd0, 0x93, 0x3d, 0xd2, 0x3d, 0x15, 0x68, 0x8a, 0x38, 0x8f, 0x1e, 0xc1, 0xee, 0x18, 0x22, 0x9f,
+		0x42, 0xcb, 0x30, 0x35, 0xf9, 0xd5, 0x71, 0x78, 0xbb, 0xa4, 0x19, 0xa6, 0xb8, 0x80, 0x7e, 0x08,
+		0xdf, 0x1f, 0x43, 0xeb, 0x2c, 0x96, 0xfd, 0xa1, 0xe2, 0xc4, 0x88, 0x31, 0x58, 0x15, 0xab, 0xe2,
+		0xe2, 0x04, 0x7b, 0x62, 0x68, 0x2f, 0xd2, 0x2b, 0xb7, 0x84, 0x64, 0x78, 0x3e, 0xd1, 0x88, 0xc8,
+		0x07, 0x5a, 0x49, 0x19, 0x2e, 0x22, 0xa2, 0x87, 0xb0, 0x33, 0x46, 0x64, 0xbf, 0x8c, 0x65, 0x35,
+		0x7a, 0x63, 0x75, 0x4c, 0x62, 0x19, 0x7d, 0x0d, 0x0f, 0xc6, 0x91, 0x24, 0xad, 0x54, 0x7e, 0xad,
+		0xe2, 0x7e, 0x1e, 0x62, 0xaf, 0xd1, 0xc9, 0x96, 0xae, 0xe9, 0x95, 0xaa, 0x69, 0x19, 0xda, 0xb7,
+		0xaa, 0xb8, 0xc2, 0x5e, 0xa3, 0xa9, 0x3b, 0x15, 0xd7, 0x4a, 0xbc, 0x3c, 0x68, 0xc6, 0x03, 0x0f,
+		0xd9, 0xd3, 0x74, 0x09, 0x1f, 0x8b, 0xab, 0x29, 0xbd, 0x37, 0x68, 0x74, 0x3d, 0x2d, 0x74, 0x65,
+		0x92, 0xe5, 0xa8, 0x12, 0x96, 0x0f, 0x92, 0x15, 0x5f, 0x63, 0x6f, 0x9d, 0xdb, 0xfc, 0x1f, 0x2e,
+		0x03, 0xe7, 0xaa, 0xa4, 0xc5, 0xef, 0xc2, 0x56, 0xb8, 0x6f, 0x43, 0xba, 0x60, 0x84, 0xdb, 0xef,
+		0xc1, 0x0f, 0x26, 0xa3, 0x74, 0xee, 0x4b, 0x25, 0xac, 0x4a, 0xca, 0x71, 0xe7, 0x48, 0x2a, 0x14,
+		0xff, 0x24, 0x40, 0x51, 0xb6, 0xdd, 0x9a, 0xd3, 0x88, 0xff, 0x1f, 0x3b, 0x36, 0xcb, 0xa7, 0xf0,
+		0x78, 0x82, 0x79, 0x1f, 0x91, 0xef, 0x11, 0x18, 0x9f, 0x4a, 0xae, 0xea, 0xaf, 0xf4, 0xf2, 0x91,
+		0x3e, 0x8e, 0x10, 0x2d, 0xc2, 0x20, 0x67, 0xae, 0x3d, 0xf1, 0x22, 0xa2, 0xb6, 0xfb, 0xcf, 0x16,
+		0xf1, 0xa9, 0xe4, 0x89, 0x16, 0xb1, 0x47, 0xfe, 0xf2, 0x71, 0x5d, 0xf8, 0xdb, 0xc7, 0x75, 0xe1,
+		0x9f, 0x1f, 0xd7, 0x05, 0x58, 0xab, 0x79, 0xcd, 0x61, 0x5f, 0xf4, 0x7b, 0x0b, 0xf1, 0xd2, 0x2a,
+		0xec, 0x93, 0xb6, 0x22, 0x7c, 0xbb, 0x7b, 0x46, 0xe8, 0x79, 0xfb, 0x64, 0xbb, 0xe6, 0x35, 0x77,
+		0x92, 0x3f, 0x54, 0x6e, 0x91, 0x7a, 0x63, 0xe7, 0xcc, 0x0b, 0x7f, 0xf8, 0x8c, 0x7e, 0xb5, 0x7c,
+		0x6a, 0xb7, 0xc8, 0xbb, 0xdd, 0x93, 0x19, 0x1e, 0x7b, 0xf8, 0xef, 0x01, 0x00, 0xb9, 0xa8, 0xe3,
+		0xa4, 0x75, 0x1d, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/query.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x6f, 0x93, 0x50,
-		0x18, 0x95, 0x9a, 0x2c, 0xd9, 0xb7, 0x55, 0xc9, 0x9d, 0xc6, 0xda, 0xec, 0x47, 0xd3, 0xed, 0x61,
-		0x69, 0x14, 0xec, 0xf4, 0x6d, 0x4f, 0x8c, 0x5e, 0x0d, 0x86, 0x01, 0x03, 0xda, 0xa5, 0x7b, 0x21,
-		0x94, 0x5e, 0x2b, 0x8e, 0x72, 0xf1, 0x5e, 0x68, 0xed, 0x3f, 0xe0, 0xbb, 0x7f, 0x8d, 0xff, 0x9e,
-		0x81, 0x52, 0x5b, 0x2d, 0x33, 0xbe, 0x7d, 0x9c, 0xef, 0x1c, 0xce, 0x39, 0xb9, 0xf9, 0xe0, 0x24,
-		0x1b, 0x11, 0x26, 0x07, 0xfe, 0x98, 0xc4, 0x01, 0x91, 0xfd, 0x24, 0x94, 0x67, 0x5d, 0xf9, 0x6b,
-		0x46, 0xd8, 0x42, 0x4a, 0x18, 0x4d, 0x29, 0x3a, 0xc8, 0x09, 0x52, 0x49, 0x90, 0xfc, 0x24, 0x94,
-		0x66, 0xdd, 0x66, 0xab, 0x4a, 0x15, 0xd0, 0xe9, 0x94, 0xc6, 0x4b, 0x59, 0xb3, 0x5d, 0xc5, 0x98,
-		0x53, 0x76, 0xff, 0x29, 0xa2, 0xf3, 0x25, 0xa7, 0x7d, 0x0f, 0xf5, 0xdb, 0x12, 0xb9, 0xc9, 0x1d,
-		0xd1, 0x11, 0x40, 0x61, 0xed, 0xa5, 0x8b, 0x84, 0x34, 0x84, 0x96, 0x70, 0xbe, 0x6b, 0xef, 0x16,
-		0x88, 0xbb, 0x48, 0x08, 0xba, 0x5c, 0xad, 0x7d, 0x36, 0xe1, 0x8d, 0x5a, 0x4b, 0x38, 0xdf, 0xbb,
-		0x38, 0x94, 0x2a, 0xf2, 0x49, 0x96, 0xbf, 0x88, 0xa8, 0x3f, 0x2e, 0xc5, 0x0a, 0x9b, 0xf0, 0xf6,
-		0x4f, 0x01, 0x0e, 0xfe, 0x70, 0xb3, 0x09, 0xcf, 0xa2, 0x14, 0x61, 0xd8, 0x63, 0xc5, 0xb4, 0x36,
-		0x7d, 0x72, 0x71, 0x56, 0xf9, 0xd7, 0x0d, 0x59, 0x9e, 0xc7, 0x06, 0xf6, 0x7b, 0x46, 0xef, 0x60,
-		0xc7, 0x8f, 0xf9, 0x9c, 0xb0, 0xff, 0xca, 0x55, 0x72, 0xd1, 0x29, 0xd4, 0x09, 0x63, 0x94, 0x79,
-		0x53, 0xc2, 0xb9, 0x3f, 0x21, 0x8d, 0xc7, 0x45, 0xe7, 0xfd, 0x02, 0xbc, 0x5e, 0x62, 0x6d, 0x02,
-		0xf5, 0xd2, 0xf9, 0x0b, 0x09, 0x52, 0x32, 0x46, 0x2e, 0xec, 0x07, 0x11, 0xe5, 0xc4, 0xe3, 0xa9,
-		0x9f, 0x66, 0xbc, 0xcc, 0xdc, 0xad, 0x74, 0x5c, 0x55, 0xc6, 0xdf, 0x48, 0x90, 0xa5, 0x21, 0x8d,
-		0xd5, 0x5c, 0xe9, 0x14, 0x42, 0x7b, 0x2f, 0x58, 0x7f, 0x74, 0x62, 0x78, 0xfa, 0x57, 0x41, 0x74,
-		0x04, 0x2f, 0x6f, 0xfa, 0xd8, 0x1e, 0x7a, 0x36, 0x76, 0xfa, 0xba, 0xeb, 0xb9, 0x43, 0x0b, 0x7b,
-		0x9a, 0x31, 0x50, 0x74, 0xad, 0x27, 0x3e, 0x42, 0xc7, 0xd0, 0xdc, 0x5e, 0x2b, 0x86, 0x73, 0x8b,
-		0x6d, 0xdc, 0x13, 0x05, 0x74, 0x08, 0x8d, 0xed, 0xfd, 0x7b, 0x45, 0xd3, 0x71, 0x4f, 0xac, 0x75,
-		0x7e, 0x08, 0xf0, 0x6c, 0xa3, 0x97, 0x4a, 0xe3, 0x71, 0x98, 0x07, 0x44, 0x6d, 0x38, 0x5e, 0xc9,
-		0x3e, 0x62, 0xd5, 0xf5, 0x54, 0xd3, 0xe8, 0x69, 0xae, 0x66, 0x1a, 0x1b, 0xd6, 0xa7, 0x70, 0xf2,
-		0x00, 0xc7, 0x30, 0x5d, 0xcf, 0xb4, 0xb0, 0x21, 0x0a, 0xe8, 0x0d, 0xbc, 0xfa, 0x07, 0x49, 0x35,
-		0xaf, 0x2d, 0x1d, 0xbb, 0xb8, 0xe7, 0xa9, 0x3a, 0x56, 0x0c, 0x7d, 0x28, 0xd6, 0x3a, 0xdf, 0x05,
-		0x78, 0x5e, 0x64, 0x52, 0x69, 0xcc, 0x43, 0x9e, 0x92, 0x38, 0x58, 0xe8, 0x64, 0x46, 0xa2, 0xb5,
-		0xa1, 0x6a, 0x1a, 0x8e, 0xe6, 0xb8, 0xd8, 0x50, 0x87, 0x9e, 0x8e, 0x07, 0x58, 0xdf, 0x48, 0x75,
-		0x06, 0xad, 0x87, 0x48, 0x78, 0x80, 0x0d, 0xb7, 0xaf, 0xe8, 0xa2, 0xb0, 0xee, 0xb7, 0xcd, 0x72,
-		0x5c, 0xdb, 0x34, 0x3e, 0x88, 0xb5, 0xab, 0x3b, 0x78, 0x11, 0xd0, 0x69, 0xd5, 0x8b, 0x5e, 0x41,
-		0x11, 0xd0, 0xca, 0x2f, 0xc8, 0x12, 0xee, 0xba, 0x93, 0x30, 0xfd, 0x9c, 0x8d, 0xa4, 0x80, 0x4e,
-		0xe5, 0xcd, 0x93, 0x7b, 0x1d, 0x8e, 0x23, 0x79, 0x42, 0xe5, 0xe2, 0xd2, 0xca, 0xfb, 0xbb, 0xf4,
-		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x69,
-		0x28, 0x5b, 0xfb, 0x03, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+		0x18, 0xfc, 0x37, 0xbf, 0x54, 0xa9, 0x5f, 0x5b, 0xb0, 0xb6, 0x20, 0x42, 0xd5, 0xa6, 0x51, 0xda,
+		0x43, 0x55, 0x81, 0x4d, 0x0a, 0xb7, 0x9e, 0x5c, 0x67, 0x41, 0x46, 0xae, 0xed, 0xda, 0x6e, 0xaa,
+		0x70, 0xb1, 0x1c, 0x67, 0x1b, 0x4c, 0x1d, 0xaf, 0x59, 0xdb, 0x09, 0x79, 0x01, 0xee, 0x3c, 0x0d,
+		0xaf, 0xc0, 0x91, 0x47, 0x40, 0x79, 0x12, 0x64, 0xc7, 0x21, 0x81, 0xb8, 0x88, 0xdb, 0xe7, 0xf9,
+		0x66, 0x3c, 0x33, 0x5a, 0x7d, 0x70, 0x98, 0xf5, 0x29, 0x97, 0x7c, 0x6f, 0x40, 0x23, 0x9f, 0x4a,
+		0x5e, 0x1c, 0x48, 0xe3, 0xb6, 0xf4, 0x31, 0xa3, 0x7c, 0x2a, 0xc6, 0x9c, 0xa5, 0x0c, 0xef, 0xe6,
+		0x04, 0xb1, 0x24, 0x88, 0x5e, 0x1c, 0x88, 0xe3, 0xf6, 0x5e, 0xb3, 0x4a, 0xe5, 0xb3, 0xd1, 0x88,
+		0x45, 0x73, 0xd9, 0x5e, 0xab, 0x8a, 0x31, 0x61, 0xfc, 0xee, 0x36, 0x64, 0x93, 0x39, 0xa7, 0x75,
+		0x07, 0x3b, 0x37, 0x25, 0x72, 0x95, 0x3b, 0xe2, 0x03, 0x80, 0xc2, 0xda, 0x4d, 0xa7, 0x31, 0xad,
+		0xa3, 0x26, 0x3a, 0xd9, 0xb4, 0x36, 0x0b, 0xc4, 0x99, 0xc6, 0x14, 0x9f, 0x2f, 0xd6, 0x1e, 0x1f,
+		0x26, 0xf5, 0x5a, 0x13, 0x9d, 0x6c, 0x9d, 0xed, 0x8b, 0x15, 0xf9, 0x44, 0xd3, 0x9b, 0x86, 0xcc,
+		0x1b, 0x94, 0x62, 0x99, 0x0f, 0x93, 0xd6, 0x57, 0x04, 0xbb, 0xbf, 0xb9, 0x59, 0x34, 0xc9, 0xc2,
+		0x14, 0x13, 0xd8, 0xe2, 0xc5, 0xb4, 0x34, 0x7d, 0x70, 0x76, 0x5c, 0xf9, 0xd7, 0x15, 0x59, 0x9e,
+		0xc7, 0x02, 0xfe, 0x6b, 0xc6, 0xaf, 0x60, 0xc3, 0x8b, 0x92, 0x09, 0xe5, 0xff, 0x94, 0xab, 0xe4,
+		0xe2, 0x23, 0xd8, 0xa1, 0x9c, 0x33, 0xee, 0x8e, 0x68, 0x92, 0x78, 0x43, 0x5a, 0xff, 0xbf, 0xe8,
+		0xbc, 0x5d, 0x80, 0x97, 0x73, 0xac, 0x45, 0x61, 0xa7, 0x74, 0xfe, 0x40, 0xfd, 0x94, 0x0e, 0xb0,
+		0x03, 0xdb, 0x7e, 0xc8, 0x12, 0xea, 0x26, 0xa9, 0x97, 0x66, 0x49, 0x99, 0xb9, 0x5d, 0xe9, 0xb8,
+		0xa8, 0x4c, 0x3e, 0x51, 0x3f, 0x4b, 0x03, 0x16, 0x29, 0xb9, 0xd2, 0x2e, 0x84, 0xd6, 0x96, 0xbf,
+		0xfc, 0x38, 0x8d, 0xe0, 0xe1, 0x1f, 0x05, 0xf1, 0x01, 0x3c, 0xbd, 0xba, 0x26, 0x56, 0xcf, 0xb5,
+		0x88, 0x7d, 0xad, 0x39, 0xae, 0xd3, 0x33, 0x89, 0xab, 0xea, 0x5d, 0x59, 0x53, 0x3b, 0xc2, 0x7f,
+		0xb8, 0x01, 0x7b, 0xeb, 0x6b, 0x59, 0xb7, 0x6f, 0x88, 0x45, 0x3a, 0x02, 0xc2, 0xfb, 0x50, 0x5f,
+		0xdf, 0xbf, 0x96, 0x55, 0x8d, 0x74, 0x84, 0xda, 0xe9, 0x17, 0x04, 0x8f, 0x56, 0x7a, 0x29, 0x2c,
+		0x1a, 0x04, 0x79, 0x40, 0xdc, 0x82, 0xc6, 0x42, 0xf6, 0x96, 0x28, 0x8e, 0xab, 0x18, 0x7a, 0x47,
+		0x75, 0x54, 0x43, 0x5f, 0xb1, 0x3e, 0x82, 0xc3, 0x7b, 0x38, 0xba, 0xe1, 0xb8, 0x86, 0x49, 0x74,
+		0x01, 0xe1, 0x17, 0xf0, 0xec, 0x2f, 0x24, 0xc5, 0xb8, 0x34, 0x35, 0xe2, 0x90, 0x8e, 0xab, 0x68,
+		0x44, 0xd6, 0xb5, 0x9e, 0x50, 0x3b, 0xfd, 0x8c, 0xe0, 0x71, 0x91, 0x49, 0x61, 0x51, 0x12, 0x24,
+		0x29, 0x8d, 0xfc, 0xa9, 0x46, 0xc7, 0x34, 0x5c, 0x1a, 0x2a, 0x86, 0x6e, 0xab, 0xb6, 0x43, 0x74,
+		0xa5, 0xe7, 0x6a, 0xa4, 0x4b, 0xb4, 0x95, 0x54, 0xc7, 0xd0, 0xbc, 0x8f, 0x44, 0xba, 0x44, 0x77,
+		0xae, 0x65, 0x4d, 0x40, 0xcb, 0x7e, 0xeb, 0x2c, 0xdb, 0xb1, 0x0c, 0xfd, 0x8d, 0x50, 0xbb, 0xb8,
+		0xfd, 0x36, 0x6b, 0xa0, 0xef, 0xb3, 0x06, 0xfa, 0x31, 0x6b, 0x20, 0x78, 0xe2, 0xb3, 0x51, 0xd5,
+		0xeb, 0x5e, 0x40, 0x11, 0xd6, 0xcc, 0xaf, 0xc9, 0x44, 0xef, 0xda, 0xc3, 0x20, 0x7d, 0x9f, 0xf5,
+		0x45, 0x9f, 0x8d, 0xa4, 0xd5, 0xf3, 0x7b, 0x1e, 0x0c, 0x42, 0x69, 0xc8, 0xa4, 0xe2, 0xea, 0xca,
+		0x5b, 0x3c, 0xf7, 0xe2, 0x60, 0xdc, 0xee, 0x6f, 0x14, 0xd8, 0xcb, 0x9f, 0x03, 0x00, 0xc7, 0xdf,
+		0xe0, 0x2c, 0x07, 0x04, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_workflow.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4f, 0x73, 0xdc, 0x48,
-		0x15, 0x2f, 0xf9, 0xbf, 0xdf, 0xd8, 0x8e, 0xd3, 0x89, 0xed, 0xc9, 0x24, 0x76, 0x1c, 0x65, 0x37,
-		0x98, 0xec, 0x66, 0x4c, 0x1c, 0x36, 0x09, 0x59, 0x60, 0xcb, 0x99, 0xc4, 0x59, 0x53, 0x9b, 0x2d,
-		0x23, 0x1b, 0x52, 0x70, 0x51, 0xb5, 0xa5, 0xe7, 0x99, 0xc6, 0x1a, 0x49, 0xee, 0x6e, 0xd9, 0x3b,
-		0xcb, 0x81, 0x82, 0xda, 0xe2, 0xc0, 0xbf, 0x82, 0x23, 0x27, 0x0e, 0x70, 0xe6, 0x63, 0x70, 0xa0,
-		0xb8, 0xf0, 0x11, 0xf8, 0x04, 0x7c, 0x83, 0x2d, 0x4a, 0xad, 0xd6, 0x8c, 0x67, 0xdc, 0xd2, 0xd8,
-		0xa1, 0xb6, 0x92, 0xda, 0xdb, 0xe8, 0xf5, 0xfb, 0xbd, 0xff, 0xdd, 0xfd, 0x5e, 0xd7, 0xc0, 0xdd,
-		0x64, 0x1f, 0xf9, 0xba, 0x47, 0x7d, 0x0c, 0x3d, 0x5c, 0xa7, 0x31, 0x5b, 0x3f, 0xbe, 0xbf, 0x2e,
-		0x90, 0x1f, 0x33, 0x0f, 0xdd, 0x93, 0x88, 0x1f, 0x1e, 0x04, 0xd1, 0x49, 0x3d, 0xe6, 0x91, 0x8c,
-		0xc8, 0x95, 0x94, 0xb7, 0xae, 0x79, 0xeb, 0x34, 0x66, 0xf5, 0xe3, 0xfb, 0xb5, 0x95, 0x66, 0x14,
-		0x35, 0x03, 0x5c, 0x57, 0x2c, 0xfb, 0xc9, 0xc1, 0xba, 0x9f, 0x70, 0x2a, 0x59, 0x14, 0x66, 0xa0,
-		0xda, 0xaa, 0x49, 0x81, 0x17, 0xb5, 0xdb, 0x5d, 0x8e, 0x5b, 0x26, 0x8e, 0x16, 0x13, 0x32, 0xe2,
-		0x1d, 0xcd, 0x72, 0xd3, 0xc4, 0x72, 0x94, 0x60, 0x97, 0xc1, 0x36, 0x31, 0x48, 0x2a, 0x0e, 0x03,
-		0x26, 0x64, 0x19, 0x4f, 0xbf, 0x8b, 0xf6, 0x3f, 0x27, 0x61, 0x79, 0x57, 0x52, 0x2e, 0x5f, 0x69,
-		0xfa, 0xf3, 0xcf, 0xd0, 0x4b, 0x52, 0x77, 0x1c, 0x3c, 0x4a, 0x50, 0x48, 0xb2, 0x08, 0x13, 0x7e,
-		0xd4, 0xa6, 0x2c, 0xac, 0x5a, 0xab, 0xd6, 0xda, 0xb4, 0xa3, 0xbf, 0xc8, 0x4d, 0xa8, 0xe4, 0xb2,
-		0x5c, 0xe6, 0x57, 0x47, 0xd4, 0x22, 0xe4, 0xa4, 0x6d, 0x9f, 0x6c, 0xc1, 0x6c, 0x97, 0x41, 0x76,
-		0x62, 0xac, 0x8e, 0xae, 0x5a, 0x6b, 0x95, 0x8d, 0x5b, 0x75, 0x43, 0x54, 0xeb, 0xb9, 0xfa, 0xbd,
-		0x4e, 0x8c, 0xce, 0xcc, 0xc9, 0xa9, 0x2f, 0xf2, 0x04, 0xa6, 0x53, 0xc7, 0xdc, 0xd4, 0xb3, 0xea,
-		0x98, 0x92, 0xb1, 0x6c, 0x94, 0xb1, 0x47, 0xc5, 0xe1, 0x27, 0x4c, 0x48, 0x67, 0x4a, 0xea, 0x5f,
-		0x64, 0x03, 0xc6, 0x59, 0x18, 0x27, 0xb2, 0x3a, 0xae, 0x70, 0x37, 0x8c, 0xb8, 0x1d, 0xda, 0x09,
-		0x22, 0xea, 0x3b, 0x19, 0x2b, 0xa1, 0xb0, 0x8a, 0x79, 0x10, 0x5c, 0x91, 0xc6, 0xc6, 0x95, 0x91,
-		0xeb, 0x05, 0x91, 0x40, 0x57, 0xb2, 0x36, 0x46, 0x89, 0xac, 0x4e, 0x28, 0x71, 0xd7, 0xea, 0x59,
-		0x2d, 0xd4, 0xf3, 0x5a, 0xa8, 0x3f, 0xd3, 0xb5, 0xe0, 0xdc, 0xe8, 0x8a, 0x50, 0xd1, 0xdd, 0x8b,
-		0x1a, 0x29, 0x7e, 0x2f, 0x83, 0x93, 0x57, 0x70, 0x5d, 0xb9, 0x54, 0x20, 0x7d, 0x72, 0x98, 0xf4,
-		0xa5, 0x14, 0x6d, 0x12, 0x5c, 0x83, 0x29, 0xe6, 0x63, 0x28, 0x99, 0xec, 0x54, 0xa7, 0x54, 0x46,
-		0xba, 0xdf, 0x64, 0x19, 0x80, 0x67, 0x39, 0x4d, 0xf3, 0x35, 0xad, 0x56, 0xa7, 0x35, 0x65, 0xdb,
-		0x27, 0x1e, 0x54, 0x4f, 0xe5, 0xd3, 0xe5, 0x98, 0x08, 0x74, 0xe3, 0x28, 0x60, 0x5e, 0xa7, 0x0a,
-		0xab, 0xd6, 0xda, 0xdc, 0xc6, 0xdd, 0xd2, 0xcc, 0x6d, 0xfb, 0x4e, 0x0a, 0xd9, 0x51, 0x08, 0x67,
-		0xe1, 0xc4, 0x44, 0x26, 0x0d, 0x98, 0xe1, 0x28, 0x79, 0x27, 0x17, 0x5c, 0x51, 0x9e, 0xae, 0x1a,
-		0x05, 0x3b, 0x29, 0xa3, 0x16, 0x57, 0xe1, 0xbd, 0x0f, 0x72, 0x1b, 0x66, 0x3d, 0x9e, 0xe6, 0xc6,
-		0x6b, 0xa1, 0x9f, 0x04, 0x58, 0x9d, 0x51, 0xbe, 0xcc, 0xa4, 0xc4, 0x5d, 0x4d, 0x23, 0xf7, 0x60,
-		0xac, 0x8d, 0xed, 0xa8, 0x3a, 0xab, 0x63, 0x69, 0xd2, 0xf0, 0x12, 0xdb, 0x91, 0xa3, 0xd8, 0x88,
-		0x03, 0x97, 0x05, 0x52, 0xee, 0xb5, 0x5c, 0x2a, 0x25, 0x67, 0xfb, 0x89, 0x44, 0x51, 0x9d, 0x53,
-		0xd8, 0x77, 0x8d, 0xd8, 0x5d, 0xc5, 0xbd, 0xd9, 0x65, 0x76, 0xe6, 0xc5, 0x00, 0x85, 0x3c, 0x80,
-		0x89, 0x16, 0x52, 0x1f, 0x79, 0xf5, 0x92, 0x12, 0x74, 0xdd, 0x28, 0xe8, 0x63, 0xc5, 0xe2, 0x68,
-		0x56, 0xf2, 0x04, 0x2a, 0x3e, 0x06, 0xb4, 0x93, 0xd5, 0x46, 0x75, 0x7e, 0x58, 0x29, 0x80, 0xe2,
-		0x56, 0xb5, 0x60, 0x3f, 0x82, 0x95, 0xa2, 0xbd, 0x2c, 0xe2, 0x28, 0x14, 0x48, 0x16, 0x60, 0x82,
-		0x27, 0x61, 0x9a, 0xff, 0x6c, 0x33, 0x8f, 0xf3, 0x24, 0xdc, 0xf6, 0xed, 0x7f, 0x8c, 0xc0, 0xca,
-		0x2e, 0x6b, 0x86, 0x34, 0xb8, 0xf0, 0x31, 0xf0, 0x23, 0x20, 0xdd, 0xb2, 0xe9, 0xd6, 0xbc, 0x3a,
-		0x0d, 0x2a, 0x1b, 0x77, 0x4a, 0x0b, 0xa6, 0xa7, 0xe2, 0xf2, 0xc9, 0x20, 0xa9, 0xaf, 0x90, 0x47,
-		0x4b, 0x0b, 0x79, 0x6c, 0xb0, 0x90, 0x6f, 0x42, 0x45, 0x28, 0x5f, 0xdc, 0x90, 0xb6, 0x51, 0xed,
-		0xfc, 0x69, 0x07, 0x32, 0xd2, 0xa7, 0xb4, 0x8d, 0xe4, 0x23, 0x98, 0xd1, 0x0c, 0xd9, 0xd9, 0x30,
-		0x71, 0x8e, 0xb3, 0x41, 0x8b, 0xdc, 0x56, 0x27, 0x44, 0x15, 0x26, 0xbd, 0x28, 0x94, 0x3c, 0x0a,
-		0xd4, 0x56, 0x9d, 0x71, 0xf2, 0x4f, 0xfb, 0x16, 0xdc, 0x2c, 0x8c, 0x63, 0x96, 0x02, 0xfb, 0x4b,
-		0x0b, 0xbe, 0xa1, 0x79, 0x98, 0x6c, 0x95, 0x9f, 0xbd, 0xaf, 0x60, 0x36, 0x3b, 0x22, 0xb4, 0x77,
-		0x2a, 0xf6, 0x95, 0x8d, 0x0d, 0x73, 0x45, 0x96, 0x89, 0x72, 0x66, 0x94, 0xa0, 0x5c, 0xf0, 0x40,
-		0x8c, 0x46, 0x86, 0xc6, 0x68, 0xf4, 0xff, 0x88, 0xd1, 0x58, 0x7f, 0x8c, 0x36, 0x61, 0x6d, 0xb8,
-		0xff, 0xe5, 0xf5, 0xfa, 0xf7, 0x11, 0x58, 0x76, 0x50, 0xa0, 0x7c, 0x5b, 0xca, 0x75, 0x11, 0x26,
-		0x38, 0x52, 0x11, 0x85, 0xba, 0x58, 0xf5, 0x17, 0x79, 0x04, 0x55, 0x1f, 0x3d, 0x26, 0xd2, 0xab,
-		0xe4, 0x80, 0x85, 0x4c, 0xb4, 0x5c, 0x3c, 0xc6, 0xb0, 0x5b, 0xb8, 0xa3, 0xce, 0x42, 0xbe, 0xbe,
-		0xa5, 0x96, 0x9f, 0xa7, 0xab, 0xdb, 0xfe, 0x40, 0x8d, 0x8f, 0x0f, 0xd6, 0x78, 0x1d, 0xae, 0x88,
-		0x43, 0x16, 0xbb, 0x3a, 0x47, 0x1c, 0x69, 0x1c, 0x07, 0x1d, 0x55, 0xc9, 0x53, 0xce, 0xe5, 0x74,
-		0x29, 0x0b, 0xb1, 0x93, 0x2d, 0xa4, 0x27, 0x43, 0x51, 0xbc, 0xca, 0x23, 0xfd, 0x6f, 0x0b, 0xde,
-		0xd5, 0x31, 0x6d, 0xd0, 0xd0, 0xc3, 0xaf, 0xc1, 0x01, 0x61, 0xaf, 0xc1, 0x9d, 0x61, 0x2e, 0xf5,
-		0xf6, 0xea, 0xad, 0x3d, 0xe4, 0x6d, 0x16, 0x52, 0x89, 0x6f, 0x7b, 0xad, 0x3d, 0x84, 0x49, 0x1f,
-		0x25, 0x65, 0x81, 0xd0, 0x5d, 0x52, 0xf9, 0x6e, 0xcd, 0x99, 0xfb, 0x22, 0x39, 0xde, 0x1f, 0x49,
-		0xfb, 0x1d, 0xb0, 0xcb, 0xfc, 0xd7, 0x61, 0xfa, 0x93, 0x05, 0xab, 0xcf, 0x50, 0x78, 0x9c, 0xed,
-		0xbf, 0x2d, 0x51, 0xb2, 0xbf, 0x1c, 0x85, 0x5b, 0x25, 0x36, 0xe9, 0xaa, 0x0f, 0x60, 0xa9, 0xd7,
-		0xeb, 0x79, 0x51, 0x78, 0xc0, 0x9a, 0xfa, 0x62, 0xd5, 0x47, 0xed, 0x83, 0xf3, 0x59, 0xd0, 0x38,
-		0x0d, 0x75, 0x16, 0xd1, 0x48, 0x27, 0xfb, 0xb0, 0x74, 0xd6, 0x55, 0x97, 0x85, 0x07, 0x91, 0xf6,
-		0xf7, 0xee, 0xf9, 0xb4, 0x6d, 0x87, 0x07, 0x51, 0xaf, 0xc3, 0xea, 0x23, 0x93, 0x57, 0x40, 0x62,
-		0x0c, 0x7d, 0x16, 0x36, 0x5d, 0xea, 0x49, 0x76, 0xcc, 0x24, 0x43, 0x51, 0x1d, 0x5d, 0x1d, 0x5d,
-		0xab, 0x6c, 0xac, 0x99, 0x0b, 0x22, 0x63, 0xdf, 0xcc, 0xb8, 0x3b, 0x4a, 0xf8, 0xe5, 0xb8, 0x8f,
-		0xc8, 0x50, 0x90, 0x9f, 0xc0, 0x7c, 0x2e, 0xd8, 0x6b, 0xb1, 0xc0, 0xe7, 0x18, 0x56, 0xc7, 0x94,
-		0xd8, 0x7a, 0x99, 0xd8, 0x46, 0xca, 0xdb, 0x6f, 0xf9, 0xa5, 0xf8, 0xd4, 0x12, 0xc7, 0x90, 0xec,
-		0xf6, 0x44, 0xe7, 0xa7, 0xa1, 0x6e, 0xd8, 0x4b, 0x2d, 0x7e, 0xa6, 0x79, 0xfb, 0x84, 0xe6, 0x44,
-		0xfb, 0x8b, 0x51, 0xb8, 0xfa, 0xc3, 0x74, 0x62, 0xca, 0xc3, 0xf7, 0x86, 0xb6, 0xeb, 0x63, 0x18,
-		0x57, 0x83, 0x9b, 0xbe, 0x42, 0xed, 0x52, 0x49, 0xca, 0x60, 0x27, 0x03, 0x10, 0x17, 0x16, 0xd5,
-		0x0f, 0x97, 0xe3, 0xcf, 0xd0, 0x93, 0x69, 0x7d, 0xfa, 0x4c, 0x19, 0x35, 0xa6, 0xfa, 0xf1, 0x6f,
-		0x1a, 0x45, 0x65, 0x22, 0x14, 0xa2, 0x91, 0x03, 0x9c, 0xab, 0x47, 0x06, 0x6a, 0x5a, 0x8f, 0x99,
-		0x02, 0x2f, 0x0a, 0x05, 0x13, 0x12, 0x43, 0xaf, 0xe3, 0x06, 0x78, 0x8c, 0x81, 0x0a, 0x7f, 0x51,
-		0xc7, 0xaf, 0x34, 0x34, 0x7a, 0x90, 0x4f, 0x52, 0x84, 0xb3, 0x70, 0x64, 0x22, 0xdb, 0x7f, 0xb5,
-		0x60, 0x61, 0x20, 0x0d, 0x7a, 0xef, 0x7d, 0x04, 0x33, 0xb9, 0x7b, 0x22, 0x09, 0xf2, 0xde, 0x66,
-		0x48, 0x8b, 0xa1, 0xfd, 0x48, 0x01, 0x64, 0x1b, 0xe6, 0x4e, 0xc7, 0x07, 0x7d, 0x9d, 0x2c, 0x7b,
-		0x58, 0x5c, 0xd0, 0x77, 0x66, 0x8f, 0x4e, 0x7f, 0xda, 0xff, 0xb5, 0x60, 0x29, 0x3f, 0x2d, 0xba,
-		0x63, 0xe4, 0x90, 0x7a, 0xe9, 0x9b, 0x4b, 0x47, 0x2e, 0x36, 0x97, 0xbe, 0x80, 0xb9, 0x2e, 0xb6,
-		0x37, 0x1c, 0xcf, 0x15, 0x0c, 0xc7, 0xb9, 0x80, 0x6c, 0x38, 0x96, 0xa7, 0xbe, 0xd2, 0x06, 0x83,
-		0x85, 0x5e, 0x90, 0xf8, 0xe8, 0xf6, 0x04, 0x0a, 0x49, 0x65, 0x92, 0xdd, 0x02, 0x53, 0xce, 0x82,
-		0x5e, 0xcf, 0x85, 0xec, 0xaa, 0x45, 0xfb, 0x6f, 0x16, 0x54, 0xcf, 0x7a, 0xac, 0x53, 0xf3, 0x1d,
-		0x98, 0x8c, 0xa3, 0x20, 0x40, 0x2e, 0xaa, 0x96, 0xda, 0xe2, 0x37, 0xcd, 0x59, 0x51, 0x3c, 0x6a,
-		0xfb, 0xe5, 0xfc, 0xe4, 0x25, 0xcc, 0x9f, 0x31, 0x24, 0x0b, 0xce, 0xed, 0x52, 0xdf, 0x32, 0xb3,
-		0x9c, 0x39, 0xd9, 0x6f, 0xe6, 0x07, 0x70, 0xfd, 0x05, 0xca, 0x9c, 0x49, 0x3c, 0xed, 0x3c, 0x53,
-		0xc1, 0x1f, 0x92, 0x1b, 0xfb, 0x0f, 0x63, 0x70, 0xc3, 0x8c, 0xd3, 0x1e, 0xfe, 0x02, 0x16, 0xbb,
-		0x8d, 0x59, 0xcf, 0xde, 0x36, 0x8d, 0xb5, 0xc3, 0x3f, 0x30, 0x1a, 0x5b, 0x26, 0xb2, 0x9e, 0x9f,
-		0x3c, 0x39, 0xc7, 0x4b, 0x1a, 0x3f, 0x0f, 0x25, 0xef, 0x38, 0x57, 0xfc, 0xb3, 0x2b, 0xa9, 0x01,
-		0xfa, 0x7c, 0xee, 0x0c, 0x18, 0x30, 0xf2, 0xba, 0x06, 0xe4, 0x27, 0xf8, 0x59, 0x03, 0xe8, 0xd9,
-		0x95, 0x5a, 0x92, 0xe6, 0xdf, 0x6c, 0x31, 0x99, 0x87, 0xd1, 0x43, 0xec, 0xe8, 0x98, 0xa6, 0x3f,
-		0x49, 0x03, 0xc6, 0x8f, 0x69, 0x90, 0xa0, 0xce, 0xe5, 0x3d, 0xa3, 0x75, 0x45, 0xf5, 0xe4, 0x64,
-		0xd8, 0x27, 0x23, 0x8f, 0xad, 0x54, 0x6d, 0x91, 0x9d, 0x5f, 0xa1, 0x5a, 0x5b, 0xc0, 0xb2, 0xda,
-		0x33, 0x9a, 0x65, 0x87, 0x72, 0xa9, 0xce, 0x40, 0xf1, 0x15, 0xee, 0x72, 0xfb, 0xd7, 0x23, 0xb0,
-		0x52, 0xa4, 0x55, 0xd7, 0xe1, 0x11, 0x2c, 0x1b, 0xca, 0x20, 0xee, 0x32, 0xea, 0x72, 0xac, 0x97,
-		0xaa, 0xec, 0xca, 0x7d, 0x89, 0x92, 0xfa, 0x54, 0x52, 0xa7, 0x36, 0x98, 0xf1, 0x9e, 0xea, 0x54,
-		0xa5, 0xa1, 0xf4, 0x4f, 0xa9, 0x1c, 0x79, 0x3d, 0x95, 0x83, 0x55, 0xde, 0x53, 0x69, 0x2f, 0xc1,
-		0xc2, 0x0b, 0x94, 0x8d, 0x20, 0x11, 0x52, 0x9f, 0x17, 0x59, 0xd4, 0xed, 0x5f, 0x59, 0xb0, 0x38,
-		0xb8, 0xa2, 0x23, 0xd3, 0x82, 0x6b, 0x22, 0x89, 0xe3, 0x88, 0x4b, 0xf4, 0x5d, 0x2f, 0x60, 0xe9,
-		0xd4, 0x74, 0x8c, 0x5c, 0xe8, 0xa8, 0xa4, 0x89, 0x78, 0xdf, 0x3c, 0x07, 0xe7, 0xa8, 0x86, 0x02,
-		0xfd, 0x58, 0x63, 0x9c, 0x25, 0x61, 0x5e, 0xb0, 0x7f, 0x3b, 0x0a, 0xf6, 0x0b, 0xc3, 0x6c, 0xf4,
-		0x71, 0xf6, 0x24, 0xfb, 0x86, 0xfa, 0x86, 0xeb, 0x30, 0x1d, 0xd3, 0x26, 0xba, 0x82, 0x7d, 0x9e,
-		0xdd, 0x0e, 0xe3, 0xce, 0x54, 0x4a, 0xd8, 0x65, 0x9f, 0x23, 0xb9, 0x03, 0x97, 0x42, 0xfc, 0x2c,
-		0xcd, 0x5a, 0x13, 0x5d, 0x19, 0x1d, 0x62, 0xa8, 0xa7, 0xec, 0xd9, 0x94, 0xbc, 0x43, 0x9b, 0xb8,
-		0x97, 0x12, 0xc9, 0x7b, 0x40, 0x4e, 0x28, 0x93, 0xee, 0x41, 0xc4, 0xdd, 0x10, 0x4f, 0xb2, 0xe1,
-		0x53, 0x5d, 0xee, 0x53, 0xce, 0xa5, 0x74, 0x65, 0x2b, 0xe2, 0x9f, 0xe2, 0x89, 0x9a, 0x3a, 0x89,
-		0x0b, 0xd7, 0xf4, 0x2b, 0xb4, 0x1e, 0x52, 0x0f, 0x58, 0x20, 0x91, 0x67, 0xf7, 0xd3, 0x84, 0xba,
-		0x9f, 0xde, 0x31, 0xfa, 0xa3, 0xe0, 0x5b, 0x8a, 0x59, 0x5d, 0x51, 0x8b, 0x5a, 0xcc, 0x00, 0x9d,
-		0xdc, 0x86, 0x59, 0x35, 0xb5, 0x52, 0xee, 0xb5, 0xd8, 0x31, 0xcd, 0x5e, 0x4f, 0xa6, 0x9c, 0x99,
-		0x94, 0xb8, 0xa9, 0x69, 0xf6, 0x7f, 0x2c, 0xb8, 0x5d, 0x9a, 0x0d, 0x5d, 0x1f, 0x0f, 0x61, 0x52,
-		0xab, 0x29, 0xed, 0x1c, 0x72, 0x58, 0xce, 0x4c, 0xbe, 0x0f, 0x15, 0x4e, 0x4f, 0xdc, 0x1c, 0x9b,
-		0x15, 0xbb, 0x79, 0x4b, 0x3f, 0xa3, 0x92, 0x3e, 0x0d, 0xa2, 0x7d, 0x07, 0x38, 0x3d, 0xd1, 0x82,
-		0x4c, 0xa1, 0x1f, 0x35, 0x85, 0xbe, 0x06, 0x53, 0x99, 0x9f, 0xe8, 0xeb, 0x9b, 0xb8, 0xfb, 0x6d,
-		0x77, 0x60, 0x66, 0x0b, 0xa9, 0x4c, 0x38, 0x6e, 0x05, 0xb4, 0x29, 0x08, 0x83, 0x0d, 0xc3, 0x60,
-		0x40, 0x03, 0x8e, 0xd4, 0x4f, 0xbb, 0xb3, 0x76, 0x1c, 0x60, 0xba, 0x0d, 0x90, 0xf3, 0x88, 0xbb,
-		0x18, 0xd2, 0xfd, 0x00, 0xb3, 0x41, 0x7d, 0xca, 0xb9, 0x77, 0xa6, 0x74, 0x36, 0x33, 0x5c, 0x23,
-		0x87, 0x3d, 0x4f, 0x51, 0xcf, 0x33, 0x90, 0xfd, 0x3b, 0x0b, 0xae, 0x3b, 0x78, 0xc0, 0x51, 0xb4,
-		0xba, 0x6f, 0xee, 0x54, 0x1c, 0x8a, 0x37, 0x34, 0xa6, 0xad, 0xc0, 0x0d, 0xb3, 0x35, 0x59, 0x96,
-		0x37, 0xfe, 0x35, 0x07, 0x95, 0x7c, 0x65, 0x73, 0x67, 0x9b, 0xfc, 0xd2, 0x82, 0x45, 0xf3, 0x9b,
-		0x11, 0x79, 0x8d, 0x57, 0xb1, 0xda, 0x83, 0x0b, 0x61, 0x74, 0xe5, 0x7d, 0x61, 0xc1, 0x52, 0xc1,
-		0x2b, 0x1f, 0x29, 0x10, 0x58, 0xfa, 0xb6, 0x5a, 0xfb, 0xf6, 0xc5, 0x40, 0xda, 0x8c, 0xbf, 0x58,
-		0xb0, 0x3a, 0xec, 0x21, 0x8d, 0x7c, 0xb7, 0x4c, 0xf4, 0xb0, 0xf7, 0xc7, 0xda, 0xf7, 0x5e, 0x13,
-		0xad, 0x2d, 0x4c, 0x93, 0x65, 0x7e, 0x76, 0x2a, 0x48, 0x56, 0xe9, 0x9b, 0x5e, 0x41, 0xb2, 0x86,
-		0xbc, 0x6b, 0xfd, 0xd9, 0x82, 0x95, 0xf2, 0xd7, 0x1e, 0xf2, 0xa4, 0x40, 0xee, 0x39, 0x5e, 0xbd,
-		0x6a, 0x1f, 0xbe, 0x16, 0x56, 0xdb, 0xf6, 0x7b, 0x0b, 0x6a, 0xc5, 0xcf, 0x2b, 0xe4, 0xa1, 0xf9,
-		0x06, 0x1e, 0xf6, 0x1e, 0x55, 0x7b, 0x74, 0x61, 0x9c, 0xb6, 0xe7, 0x37, 0x16, 0x5c, 0x2b, 0x7c,
-		0x33, 0x21, 0x1f, 0x94, 0x36, 0x5f, 0x85, 0xd6, 0x3c, 0xbc, 0x28, 0x4c, 0x1b, 0x73, 0x00, 0xb3,
-		0x7d, 0x73, 0x23, 0x29, 0x19, 0x77, 0x07, 0x46, 0xfc, 0xda, 0xdd, 0xf3, 0xb0, 0x6a, 0x3d, 0x11,
-		0xcc, 0x0f, 0x36, 0x90, 0xe4, 0xfd, 0x73, 0xf6, 0x99, 0x99, 0xb6, 0x8b, 0x75, 0xa5, 0xe4, 0xe7,
-		0x70, 0xd5, 0xd4, 0xc6, 0x93, 0x6f, 0x5d, 0xa0, 0xe3, 0xcf, 0x14, 0xdf, 0xbf, 0xf0, 0x8c, 0xa0,
-		0xb6, 0xa4, 0xb9, 0x25, 0x2d, 0xd8, 0x92, 0xa5, 0x5d, 0x73, 0xc1, 0x96, 0x1c, 0xd2, 0xf3, 0x32,
-		0x98, 0xeb, 0xef, 0xf9, 0xc8, 0xdd, 0x22, 0x47, 0xce, 0xb6, 0x8c, 0xb5, 0xf7, 0xce, 0xc5, 0xab,
-		0x55, 0xfd, 0xd1, 0x52, 0xf3, 0x63, 0x51, 0x33, 0x41, 0x1e, 0x15, 0x09, 0x1b, 0xd2, 0x0c, 0xd6,
-		0x1e, 0x5f, 0x1c, 0xd8, 0x4b, 0xbf, 0xe9, 0xc6, 0x2b, 0x48, 0x7f, 0xc9, 0x55, 0x5d, 0x90, 0xfe,
-		0xb2, 0xeb, 0xf4, 0xa9, 0x0f, 0x4b, 0x5e, 0xd4, 0x36, 0xe1, 0x9e, 0x5e, 0xcd, 0x11, 0xbb, 0xd9,
-		0x9f, 0x21, 0x76, 0x78, 0x24, 0xa3, 0x1d, 0xeb, 0xa7, 0xf7, 0x9b, 0x4c, 0xb6, 0x92, 0xfd, 0xba,
-		0x17, 0xb5, 0xd7, 0x4f, 0xff, 0xa1, 0xe0, 0x1e, 0xf3, 0x83, 0xf5, 0x66, 0x94, 0xfd, 0x0f, 0x42,
-		0xff, 0xbb, 0xe0, 0x43, 0x1a, 0xb3, 0xe3, 0xfb, 0xfb, 0x13, 0x8a, 0xf6, 0xe0, 0x7f, 0x01, 0x00,
-		0x00, 0xff, 0xff, 0x0b, 0xbf, 0x5b, 0x89, 0x6c, 0x21, 0x00, 0x00,
+		0x15, 0x2f, 0x79, 0xfc, 0xf7, 0x8d, 0xed, 0x38, 0xbd, 0xb1, 0xad, 0x4c, 0x62, 0xc7, 0xd6, 0xfe,
+		0xc1, 0x64, 0x37, 0x63, 0xec, 0xb0, 0x49, 0xc8, 0x02, 0x5b, 0xce, 0x24, 0xce, 0x9a, 0xda, 0x6c,
+		0x19, 0xd9, 0x90, 0x82, 0x8b, 0xaa, 0x2d, 0x3d, 0x8f, 0x1b, 0x6b, 0x24, 0xb9, 0xd5, 0xb2, 0x77,
+		0x96, 0x03, 0x05, 0xb5, 0xc5, 0x81, 0x7f, 0x05, 0x47, 0x4e, 0x1c, 0xe0, 0xcc, 0xc7, 0xa0, 0x28,
+		0x8a, 0x03, 0x7c, 0x03, 0xc8, 0x17, 0xe0, 0x1b, 0x6c, 0x51, 0xdd, 0x6a, 0xcd, 0x78, 0xc6, 0x92,
+		0xc6, 0x13, 0x6a, 0x2b, 0x29, 0x6e, 0xa3, 0xd7, 0xef, 0xf7, 0xfe, 0x77, 0xf7, 0x7b, 0x5d, 0x03,
+		0xb7, 0x93, 0x03, 0xe4, 0xeb, 0x2e, 0xf5, 0x30, 0x70, 0x71, 0x9d, 0x46, 0x6c, 0xfd, 0x74, 0x63,
+		0x3d, 0x46, 0x7e, 0xca, 0x5c, 0x74, 0xce, 0x42, 0x7e, 0x7c, 0xe8, 0x87, 0x67, 0xf5, 0x88, 0x87,
+		0x22, 0x24, 0x6f, 0x48, 0xde, 0xba, 0xe6, 0xad, 0xd3, 0x88, 0xd5, 0x4f, 0x37, 0x6a, 0xcb, 0xcd,
+		0x30, 0x6c, 0xfa, 0xb8, 0xae, 0x58, 0x0e, 0x92, 0xc3, 0x75, 0x2f, 0xe1, 0x54, 0xb0, 0x30, 0x48,
+		0x41, 0xb5, 0x95, 0x3c, 0x05, 0x6e, 0xd8, 0x6a, 0x75, 0x38, 0x56, 0xf3, 0x38, 0x8e, 0x58, 0x2c,
+		0x42, 0xde, 0xd6, 0x2c, 0xb7, 0xf2, 0x58, 0x4e, 0x12, 0xec, 0x30, 0x58, 0x79, 0x0c, 0x82, 0xc6,
+		0xc7, 0x3e, 0x8b, 0x45, 0x19, 0x4f, 0xaf, 0x8b, 0xd6, 0xdf, 0x26, 0x60, 0x69, 0x4f, 0x50, 0x2e,
+		0x9e, 0x6b, 0xfa, 0x93, 0x4f, 0xd1, 0x4d, 0xa4, 0x3b, 0x36, 0x9e, 0x24, 0x18, 0x0b, 0xb2, 0x00,
+		0xe3, 0x5e, 0xd8, 0xa2, 0x2c, 0x30, 0x8d, 0x15, 0x63, 0x6d, 0xca, 0xd6, 0x5f, 0xe4, 0x16, 0x54,
+		0x33, 0x59, 0x0e, 0xf3, 0xcc, 0x11, 0xb5, 0x08, 0x19, 0x69, 0xc7, 0x23, 0xdb, 0x30, 0xd3, 0x61,
+		0x10, 0xed, 0x08, 0xcd, 0xca, 0x8a, 0xb1, 0x56, 0xdd, 0x5c, 0xad, 0xe7, 0x44, 0xb5, 0x9e, 0xa9,
+		0xdf, 0x6f, 0x47, 0x68, 0x4f, 0x9f, 0x9d, 0xfb, 0x22, 0x0f, 0x61, 0x4a, 0x3a, 0xe6, 0x48, 0xcf,
+		0xcc, 0x51, 0x25, 0x63, 0x29, 0x57, 0xc6, 0x3e, 0x8d, 0x8f, 0x3f, 0x66, 0xb1, 0xb0, 0x27, 0x85,
+		0xfe, 0x45, 0x36, 0x61, 0x8c, 0x05, 0x51, 0x22, 0xcc, 0x31, 0x85, 0xbb, 0x99, 0x8b, 0xdb, 0xa5,
+		0x6d, 0x3f, 0xa4, 0x9e, 0x9d, 0xb2, 0x12, 0x0a, 0x2b, 0x98, 0x05, 0xc1, 0x89, 0x65, 0x6c, 0x1c,
+		0x11, 0x3a, 0xae, 0x1f, 0xc6, 0xe8, 0x08, 0xd6, 0xc2, 0x30, 0x11, 0xe6, 0xb8, 0x12, 0x77, 0xbd,
+		0x9e, 0xd6, 0x42, 0x3d, 0xab, 0x85, 0xfa, 0x63, 0x5d, 0x0b, 0xf6, 0xcd, 0x8e, 0x08, 0x15, 0xdd,
+		0xfd, 0xb0, 0x21, 0xf1, 0xfb, 0x29, 0x9c, 0x3c, 0x87, 0x1b, 0xca, 0xa5, 0x02, 0xe9, 0x13, 0x83,
+		0xa4, 0x2f, 0x4a, 0x74, 0x9e, 0xe0, 0x1a, 0x4c, 0x32, 0x0f, 0x03, 0xc1, 0x44, 0xdb, 0x9c, 0x54,
+		0x19, 0xe9, 0x7c, 0x93, 0x25, 0x00, 0x9e, 0xe6, 0x54, 0xe6, 0x6b, 0x4a, 0xad, 0x4e, 0x69, 0xca,
+		0x8e, 0x47, 0x5c, 0x30, 0xcf, 0xe5, 0xd3, 0xe1, 0x98, 0xc4, 0xe8, 0x44, 0xa1, 0xcf, 0xdc, 0xb6,
+		0x09, 0x2b, 0xc6, 0xda, 0xec, 0xe6, 0xed, 0xd2, 0xcc, 0xed, 0x78, 0xb6, 0x84, 0xec, 0x2a, 0x84,
+		0x3d, 0x7f, 0x96, 0x47, 0x26, 0x0d, 0x98, 0xe6, 0x28, 0x78, 0x3b, 0x13, 0x5c, 0x55, 0x9e, 0xae,
+		0xe4, 0x0a, 0xb6, 0x25, 0xa3, 0x16, 0x57, 0xe5, 0xdd, 0x0f, 0xf2, 0x26, 0xcc, 0xb8, 0x5c, 0xe6,
+		0xc6, 0x3d, 0x42, 0x2f, 0xf1, 0xd1, 0x9c, 0x56, 0xbe, 0x4c, 0x4b, 0xe2, 0x9e, 0xa6, 0x91, 0x3b,
+		0x30, 0xda, 0xc2, 0x56, 0x68, 0xce, 0xe8, 0x58, 0xe6, 0x69, 0x78, 0x86, 0xad, 0xd0, 0x56, 0x6c,
+		0xc4, 0x86, 0xab, 0x31, 0x52, 0xee, 0x1e, 0x39, 0x54, 0x08, 0xce, 0x0e, 0x12, 0x81, 0xb1, 0x39,
+		0xab, 0xb0, 0x6f, 0xe7, 0x62, 0xf7, 0x14, 0xf7, 0x56, 0x87, 0xd9, 0x9e, 0x8b, 0xfb, 0x28, 0xe4,
+		0x2e, 0x8c, 0x1f, 0x21, 0xf5, 0x90, 0x9b, 0x57, 0x94, 0xa0, 0x1b, 0xb9, 0x82, 0x3e, 0x52, 0x2c,
+		0xb6, 0x66, 0x25, 0x0f, 0xa1, 0xea, 0xa1, 0x4f, 0xdb, 0x69, 0x6d, 0x98, 0x73, 0x83, 0x4a, 0x01,
+		0x14, 0xb7, 0xaa, 0x05, 0xeb, 0x3e, 0x2c, 0x17, 0xed, 0xe5, 0x38, 0x0a, 0x83, 0x18, 0xc9, 0x3c,
+		0x8c, 0xf3, 0x24, 0x90, 0xf9, 0x4f, 0x37, 0xf3, 0x18, 0x4f, 0x82, 0x1d, 0xcf, 0xfa, 0xcb, 0x08,
+		0x2c, 0xef, 0xb1, 0x66, 0x40, 0xfd, 0xa1, 0x8f, 0x81, 0xef, 0x01, 0xe9, 0x94, 0x4d, 0xa7, 0xe6,
+		0xd5, 0x69, 0x50, 0xdd, 0x7c, 0xa7, 0xb4, 0x60, 0xba, 0x2a, 0xae, 0x9e, 0xf5, 0x93, 0x7a, 0x0a,
+		0xb9, 0x52, 0x5a, 0xc8, 0xa3, 0xfd, 0x85, 0x7c, 0x0b, 0xaa, 0xb1, 0xf2, 0xc5, 0x09, 0x68, 0x0b,
+		0xd5, 0xce, 0x9f, 0xb2, 0x21, 0x25, 0x7d, 0x42, 0x5b, 0x48, 0x3e, 0x84, 0x69, 0xcd, 0x90, 0x9e,
+		0x0d, 0xe3, 0x97, 0x38, 0x1b, 0xb4, 0xc8, 0x1d, 0x75, 0x42, 0x98, 0x30, 0xe1, 0x86, 0x81, 0xe0,
+		0xa1, 0xaf, 0xb6, 0xea, 0xb4, 0x9d, 0x7d, 0x5a, 0xab, 0x70, 0xab, 0x30, 0x8e, 0x69, 0x0a, 0xac,
+		0x2f, 0x0c, 0xf8, 0x8a, 0xe6, 0x61, 0xe2, 0xa8, 0xfc, 0xec, 0x7d, 0x0e, 0x33, 0xe9, 0x11, 0xa1,
+		0xbd, 0x53, 0xb1, 0xaf, 0x6e, 0x6e, 0xe6, 0x57, 0x64, 0x99, 0x28, 0x7b, 0x5a, 0x09, 0xca, 0x04,
+		0xf7, 0xc5, 0x68, 0x64, 0x60, 0x8c, 0x2a, 0xff, 0x43, 0x8c, 0x46, 0x7b, 0x63, 0xb4, 0x05, 0x6b,
+		0x83, 0xfd, 0x2f, 0xaf, 0xd7, 0x3f, 0x8f, 0xc0, 0x92, 0x8d, 0x31, 0x8a, 0xd7, 0xa5, 0x5c, 0x17,
+		0x60, 0x9c, 0x23, 0x8d, 0xc3, 0x40, 0x17, 0xab, 0xfe, 0x22, 0xf7, 0xc1, 0xf4, 0xd0, 0x65, 0xb1,
+		0xbc, 0x4a, 0x0e, 0x59, 0xc0, 0xe2, 0x23, 0x07, 0x4f, 0x31, 0xe8, 0x14, 0x6e, 0xc5, 0x9e, 0xcf,
+		0xd6, 0xb7, 0xd5, 0xf2, 0x13, 0xb9, 0xba, 0xe3, 0xf5, 0xd5, 0xf8, 0x58, 0x7f, 0x8d, 0xd7, 0xe1,
+		0x8d, 0xf8, 0x98, 0x45, 0x8e, 0xce, 0x11, 0x47, 0x1a, 0x45, 0x7e, 0x5b, 0x55, 0xf2, 0xa4, 0x7d,
+		0x55, 0x2e, 0xa5, 0x21, 0xb6, 0xd3, 0x05, 0x79, 0x32, 0x14, 0xc5, 0xab, 0x3c, 0xd2, 0xff, 0x34,
+		0xe0, 0x6d, 0x1d, 0xd3, 0x06, 0x0d, 0x5c, 0xfc, 0x3f, 0x38, 0x20, 0xac, 0x35, 0x78, 0x67, 0x90,
+		0x4b, 0xdd, 0xbd, 0xba, 0xba, 0x8f, 0xbc, 0xc5, 0x02, 0x2a, 0xf0, 0x75, 0xaf, 0xb5, 0x7b, 0x30,
+		0xe1, 0xa1, 0xa0, 0xcc, 0x8f, 0xcd, 0xd1, 0x4b, 0xec, 0xd6, 0x8c, 0xb9, 0x27, 0x92, 0x63, 0xbd,
+		0x91, 0xb4, 0xde, 0x02, 0xab, 0xcc, 0x7f, 0x1d, 0xa6, 0xdf, 0x19, 0xb0, 0xf2, 0x18, 0x63, 0x97,
+		0xb3, 0x83, 0xd7, 0x25, 0x4a, 0xd6, 0x17, 0x15, 0x58, 0x2d, 0xb1, 0x49, 0x57, 0xbd, 0x0f, 0x8b,
+		0xdd, 0x5e, 0xcf, 0x0d, 0x83, 0x43, 0xd6, 0xd4, 0x17, 0xab, 0x3e, 0x6a, 0xef, 0x5e, 0xce, 0x82,
+		0xc6, 0x79, 0xa8, 0xbd, 0x80, 0xb9, 0x74, 0x72, 0x00, 0x8b, 0x17, 0x5d, 0x75, 0x58, 0x70, 0x18,
+		0x6a, 0x7f, 0x6f, 0x5f, 0x4e, 0xdb, 0x4e, 0x70, 0x18, 0x76, 0x3b, 0xac, 0x1e, 0x32, 0x79, 0x0e,
+		0x24, 0xc2, 0xc0, 0x63, 0x41, 0xd3, 0xa1, 0xae, 0x60, 0xa7, 0x4c, 0x30, 0x8c, 0xcd, 0xca, 0x4a,
+		0x65, 0xad, 0xba, 0xb9, 0x96, 0x5f, 0x10, 0x29, 0xfb, 0x56, 0xca, 0xdd, 0x56, 0xc2, 0xaf, 0x46,
+		0x3d, 0x44, 0x86, 0x31, 0xf9, 0x01, 0xcc, 0x65, 0x82, 0xdd, 0x23, 0xe6, 0x7b, 0x1c, 0x03, 0x73,
+		0x54, 0x89, 0xad, 0x97, 0x89, 0x6d, 0x48, 0xde, 0x5e, 0xcb, 0xaf, 0x44, 0xe7, 0x96, 0x38, 0x06,
+		0x64, 0xaf, 0x2b, 0x3a, 0x3b, 0x0d, 0x75, 0xc3, 0x5e, 0x6a, 0xf1, 0x63, 0xcd, 0xdb, 0x23, 0x34,
+		0x23, 0x5a, 0x9f, 0x57, 0xe0, 0xda, 0x77, 0xe5, 0xc4, 0x94, 0x85, 0xef, 0x15, 0x6d, 0xd7, 0x07,
+		0x30, 0xa6, 0x06, 0x37, 0x7d, 0x85, 0x5a, 0xa5, 0x92, 0x94, 0xc1, 0x76, 0x0a, 0x20, 0x0e, 0x2c,
+		0xa8, 0x1f, 0x0e, 0xc7, 0x1f, 0xa1, 0x2b, 0x64, 0x7d, 0x7a, 0x4c, 0x19, 0x35, 0xaa, 0xfa, 0xf1,
+		0xaf, 0xe6, 0x8a, 0x4a, 0x45, 0x28, 0x44, 0x23, 0x03, 0xd8, 0xd7, 0x4e, 0x72, 0xa8, 0xb2, 0x1e,
+		0x53, 0x05, 0x6e, 0x18, 0xc4, 0x2c, 0x16, 0x18, 0xb8, 0x6d, 0xc7, 0xc7, 0x53, 0xf4, 0xcd, 0xb1,
+		0x92, 0x8e, 0x5f, 0x69, 0x68, 0x74, 0x21, 0x1f, 0x4b, 0x84, 0x3d, 0x7f, 0x92, 0x47, 0xb6, 0xfe,
+		0x68, 0xc0, 0x7c, 0x5f, 0x1a, 0xf4, 0xde, 0xfb, 0x10, 0xa6, 0x33, 0xf7, 0xe2, 0xc4, 0xcf, 0x7a,
+		0x9b, 0x01, 0x2d, 0x86, 0xf6, 0x43, 0x02, 0xc8, 0x0e, 0xcc, 0x9e, 0x8f, 0x0f, 0x7a, 0xe6, 0x48,
+		0x49, 0x88, 0xcf, 0xc5, 0x05, 0x3d, 0x7b, 0xe6, 0xe4, 0xfc, 0xa7, 0xf5, 0x1f, 0x03, 0x16, 0xb3,
+		0xd3, 0xa2, 0x33, 0x46, 0x0e, 0xa8, 0x97, 0x9e, 0xb9, 0x74, 0x64, 0xb8, 0xb9, 0xf4, 0x29, 0xcc,
+		0x76, 0xb0, 0xdd, 0xe1, 0x78, 0x76, 0x73, 0xb5, 0x54, 0x40, 0x3a, 0x1c, 0x8b, 0x73, 0x5f, 0xb2,
+		0xc1, 0x60, 0x81, 0xeb, 0x27, 0x1e, 0x3a, 0x5d, 0x81, 0xb1, 0xa0, 0x22, 0x49, 0x6f, 0x81, 0x49,
+		0x7b, 0x5e, 0xaf, 0x67, 0x42, 0xf6, 0xd4, 0xa2, 0xf5, 0x27, 0x03, 0xcc, 0x8b, 0x1e, 0xeb, 0xd4,
+		0x7c, 0x03, 0x26, 0xa2, 0xd0, 0xf7, 0x91, 0xc7, 0xa6, 0xa1, 0xb6, 0xf8, 0xad, 0xfc, 0xac, 0x28,
+		0x1e, 0xb5, 0xfd, 0x32, 0x7e, 0xf2, 0x0c, 0xe6, 0x2e, 0x18, 0x92, 0x06, 0xe7, 0xcd, 0x52, 0xdf,
+		0x52, 0xb3, 0xec, 0x59, 0xd1, 0x6b, 0xe6, 0xfb, 0x70, 0xe3, 0x29, 0x8a, 0x8c, 0x29, 0x7e, 0xd4,
+		0x7e, 0xac, 0x82, 0x3f, 0x20, 0x37, 0xd6, 0x6f, 0x46, 0xe1, 0x66, 0x3e, 0x4e, 0x7b, 0xf8, 0x13,
+		0x58, 0xe8, 0x34, 0x66, 0x5d, 0x7b, 0x5b, 0x34, 0xd2, 0x0e, 0x7f, 0x27, 0xd7, 0xd8, 0x32, 0x91,
+		0xf5, 0xec, 0xe4, 0xc9, 0x38, 0x9e, 0xd1, 0xe8, 0x49, 0x20, 0x78, 0xdb, 0x7e, 0xc3, 0xbb, 0xb8,
+		0x22, 0x0d, 0xd0, 0xe7, 0x73, 0xbb, 0xcf, 0x80, 0x91, 0x97, 0x35, 0x20, 0x3b, 0xc1, 0x2f, 0x1a,
+		0x40, 0x2f, 0xae, 0xd4, 0x12, 0x99, 0xff, 0x7c, 0x8b, 0xc9, 0x1c, 0x54, 0x8e, 0xb1, 0xad, 0x63,
+		0x2a, 0x7f, 0x92, 0x06, 0x8c, 0x9d, 0x52, 0x3f, 0x41, 0x9d, 0xcb, 0x3b, 0xb9, 0xd6, 0x15, 0xd5,
+		0x93, 0x9d, 0x62, 0x1f, 0x8e, 0x3c, 0x30, 0xa4, 0xda, 0x22, 0x3b, 0xbf, 0x44, 0xb5, 0x56, 0x0c,
+		0x4b, 0x6a, 0xcf, 0x68, 0x96, 0x5d, 0xca, 0x85, 0x3a, 0x03, 0xe3, 0x2f, 0x71, 0x97, 0x5b, 0x3f,
+		0x1f, 0x81, 0xe5, 0x22, 0xad, 0xba, 0x0e, 0x4f, 0x60, 0x29, 0xa7, 0x0c, 0xa2, 0x0e, 0xa3, 0x69,
+		0x94, 0x5c, 0xb1, 0x17, 0xe4, 0x3e, 0x43, 0x41, 0x3d, 0x2a, 0xa8, 0x5d, 0xeb, 0xcf, 0x78, 0x57,
+		0xb5, 0x54, 0x99, 0x53, 0xfa, 0xe7, 0x54, 0x8e, 0xbc, 0x9c, 0xca, 0xfe, 0x2a, 0xef, 0xaa, 0xb4,
+		0x16, 0x61, 0xfe, 0x29, 0x8a, 0x86, 0x9f, 0xc4, 0x42, 0x9f, 0x17, 0x69, 0xd4, 0xad, 0x9f, 0x19,
+		0xb0, 0xd0, 0xbf, 0xa2, 0x23, 0x73, 0x04, 0xd7, 0xe3, 0x24, 0x8a, 0x42, 0x2e, 0xd0, 0x73, 0x5c,
+		0x9f, 0xc9, 0xa9, 0xe9, 0x14, 0x79, 0xac, 0xa3, 0x22, 0x13, 0xf1, 0x5e, 0xfe, 0x1c, 0x9c, 0xa1,
+		0x1a, 0x0a, 0xf4, 0x7d, 0x8d, 0xb1, 0x17, 0xe3, 0xfc, 0x05, 0xeb, 0x97, 0x15, 0xb0, 0x9e, 0xe6,
+		0xcc, 0x46, 0x1f, 0xa5, 0x4f, 0xb2, 0xaf, 0xa8, 0x6f, 0xb8, 0x01, 0x53, 0x11, 0x6d, 0xa2, 0x13,
+		0xb3, 0xcf, 0xd2, 0xdb, 0x61, 0xcc, 0x9e, 0x94, 0x84, 0x3d, 0xf6, 0x19, 0x92, 0x77, 0xe0, 0x4a,
+		0x80, 0x9f, 0xca, 0xac, 0x35, 0xd1, 0x11, 0xe1, 0x31, 0x06, 0x7a, 0xca, 0x9e, 0x91, 0xe4, 0x5d,
+		0xda, 0xc4, 0x7d, 0x49, 0x24, 0xef, 0x02, 0x39, 0xa3, 0x4c, 0x38, 0x87, 0x21, 0x77, 0x02, 0x3c,
+		0x4b, 0x87, 0x4f, 0x75, 0xb9, 0x4f, 0xda, 0x57, 0xe4, 0xca, 0x76, 0xc8, 0x3f, 0xc1, 0x33, 0x35,
+		0x75, 0x12, 0x07, 0xae, 0xeb, 0x57, 0xe8, 0x94, 0xcf, 0x39, 0x64, 0xbe, 0x40, 0x9e, 0xde, 0x4f,
+		0xe3, 0xea, 0x7e, 0x7a, 0x2b, 0xd7, 0x1f, 0x05, 0xdf, 0x56, 0xcc, 0xea, 0x8a, 0x5a, 0xd0, 0x62,
+		0xfa, 0xe8, 0xf2, 0xe1, 0x4e, 0x4d, 0xad, 0xf2, 0x9d, 0x8c, 0x9d, 0xd2, 0xf4, 0xf5, 0x64, 0xd2,
+		0x9e, 0x96, 0xc4, 0x2d, 0x4d, 0xb3, 0xfe, 0x6d, 0xc0, 0x9b, 0xa5, 0xd9, 0xd0, 0xf5, 0x71, 0x0f,
+		0x26, 0xb4, 0x9a, 0xd2, 0xce, 0x21, 0x83, 0x65, 0xcc, 0xe4, 0xdb, 0x50, 0xe5, 0xf4, 0xcc, 0xc9,
+		0xb0, 0x69, 0xb1, 0xe7, 0x6f, 0xe9, 0xc7, 0x54, 0xd0, 0x47, 0x7e, 0x78, 0x60, 0x03, 0xa7, 0x67,
+		0x5a, 0x50, 0x5e, 0xe8, 0x2b, 0x79, 0xa1, 0xaf, 0xc1, 0x64, 0xea, 0x27, 0x7a, 0xfa, 0x26, 0xee,
+		0x7c, 0x5b, 0x6d, 0x98, 0xde, 0x46, 0x2a, 0x12, 0x8e, 0xdb, 0x3e, 0x6d, 0xc6, 0x84, 0xc1, 0x66,
+		0xce, 0x60, 0x40, 0x7d, 0x8e, 0xd4, 0x93, 0xdd, 0x59, 0x2b, 0xf2, 0x51, 0x6e, 0x03, 0xe4, 0x3c,
+		0xe4, 0x0e, 0x06, 0xf4, 0xc0, 0xc7, 0x74, 0x50, 0x9f, 0xb4, 0xef, 0x5c, 0x28, 0x9d, 0xad, 0x14,
+		0xd7, 0xc8, 0x60, 0x4f, 0x24, 0xea, 0x49, 0x0a, 0xb2, 0x7e, 0x65, 0xc0, 0x0d, 0x1b, 0x0f, 0x39,
+		0xc6, 0x47, 0x9d, 0x37, 0x77, 0x1a, 0x1f, 0xc7, 0xaf, 0x68, 0x4c, 0x5b, 0x86, 0x9b, 0xf9, 0xd6,
+		0xa4, 0x59, 0xde, 0xfc, 0xfb, 0x2c, 0x54, 0xb3, 0x95, 0xad, 0xdd, 0x1d, 0xf2, 0x53, 0x03, 0x16,
+		0xf2, 0xdf, 0x8c, 0xc8, 0x4b, 0xbc, 0x8a, 0xd5, 0xee, 0x0e, 0x85, 0xd1, 0x95, 0xf7, 0xb9, 0x01,
+		0x8b, 0x05, 0xaf, 0x7c, 0xa4, 0x40, 0x60, 0xe9, 0xdb, 0x6a, 0xed, 0xeb, 0xc3, 0x81, 0xb4, 0x19,
+		0x7f, 0x30, 0x60, 0x65, 0xd0, 0x43, 0x1a, 0xf9, 0x66, 0x99, 0xe8, 0x41, 0xef, 0x8f, 0xb5, 0x6f,
+		0xbd, 0x24, 0x5a, 0x5b, 0x28, 0x93, 0x95, 0xff, 0xec, 0x54, 0x90, 0xac, 0xd2, 0x37, 0xbd, 0xda,
+		0xdd, 0xa1, 0x30, 0xda, 0x86, 0xdf, 0x1b, 0xb0, 0xac, 0x05, 0x14, 0xbc, 0xf6, 0x90, 0x87, 0x05,
+		0x72, 0x2f, 0xf1, 0xea, 0x55, 0xfb, 0xe0, 0xa5, 0xb0, 0xda, 0xb6, 0x5f, 0x1b, 0x50, 0x2b, 0x7e,
+		0x5e, 0x21, 0xf7, 0xf2, 0x6f, 0xe0, 0x41, 0xef, 0x51, 0xb5, 0xfb, 0x43, 0xe3, 0xb4, 0x3d, 0xbf,
+		0x30, 0xe0, 0x7a, 0xe1, 0x9b, 0x09, 0x79, 0xbf, 0xb4, 0xf9, 0x2a, 0xb4, 0xe6, 0xde, 0xb0, 0x30,
+		0x6d, 0xcc, 0x21, 0xcc, 0xf4, 0xcc, 0x8d, 0xa4, 0x64, 0xdc, 0xed, 0x1b, 0xf1, 0x6b, 0xb7, 0x2f,
+		0xc3, 0xaa, 0xf5, 0x84, 0x30, 0xd7, 0xdf, 0x40, 0x92, 0xf7, 0x2e, 0xd9, 0x67, 0xa6, 0xda, 0x86,
+		0xeb, 0x4a, 0xc9, 0x8f, 0xe1, 0x5a, 0x5e, 0x1b, 0x4f, 0xbe, 0x36, 0x44, 0xc7, 0x9f, 0x2a, 0xde,
+		0x18, 0x7a, 0x46, 0x50, 0x5b, 0x32, 0xbf, 0x25, 0x2d, 0xd8, 0x92, 0xa5, 0x5d, 0x73, 0xc1, 0x96,
+		0x1c, 0xd0, 0xf3, 0x32, 0x98, 0xed, 0xed, 0xf9, 0xc8, 0xed, 0x22, 0x47, 0x2e, 0xb6, 0x8c, 0xb5,
+		0x77, 0x2f, 0xc5, 0xab, 0x55, 0xfd, 0xd6, 0x50, 0xf3, 0x63, 0x51, 0x33, 0x41, 0xee, 0x17, 0x09,
+		0x1b, 0xd0, 0x0c, 0xd6, 0x1e, 0x0c, 0x0f, 0xec, 0xa6, 0x3f, 0xef, 0xc6, 0x2b, 0x48, 0x7f, 0xc9,
+		0x55, 0x5d, 0xdb, 0x18, 0x02, 0x91, 0x2a, 0x7f, 0x14, 0xfd, 0xf5, 0xc5, 0xb2, 0xf1, 0x8f, 0x17,
+		0xcb, 0xc6, 0xbf, 0x5e, 0x2c, 0x1b, 0xb0, 0xe8, 0x86, 0xad, 0x3c, 0x19, 0x8f, 0xae, 0x65, 0xe8,
+		0xbd, 0xf4, 0x8f, 0x11, 0xbb, 0x3c, 0x14, 0xe1, 0xae, 0xf1, 0xc3, 0x8d, 0x26, 0x13, 0x47, 0xc9,
+		0x41, 0xdd, 0x0d, 0x5b, 0xeb, 0xe7, 0xff, 0x5c, 0x70, 0x87, 0x79, 0xfe, 0x7a, 0x33, 0x4c, 0xff,
+		0x13, 0xa1, 0xff, 0x69, 0xf0, 0x01, 0x8d, 0xd8, 0xe9, 0xc6, 0xc1, 0xb8, 0xa2, 0xdd, 0xfd, 0xef,
+		0x00, 0xfa, 0x10, 0xc2, 0x24, 0x78, 0x21, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_worker.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdc, 0xc6,
-		0x15, 0x06, 0x75, 0xd7, 0x59, 0x5d, 0x56, 0x63, 0xd4, 0x5d, 0xad, 0x6f, 0xf2, 0xa6, 0xb6, 0x95,
-		0x36, 0xdd, 0xad, 0x95, 0xd4, 0x71, 0xec, 0xb8, 0xa8, 0x2e, 0x16, 0xac, 0x22, 0x4d, 0x15, 0x7a,
-		0xe3, 0x00, 0x29, 0x60, 0x62, 0x96, 0x1c, 0x69, 0x07, 0xe2, 0x72, 0x68, 0x72, 0xb8, 0xf2, 0xbe,
-		0xf4, 0xa1, 0x8f, 0x49, 0x1f, 0x0a, 0x14, 0x4d, 0x5f, 0x0a, 0xf8, 0xb9, 0x3f, 0xa0, 0xbf, 0xa6,
-		0x4f, 0x7d, 0xee, 0x7f, 0x28, 0x0a, 0xce, 0x0c, 0xb9, 0xdc, 0xdd, 0x21, 0xf7, 0xd2, 0x07, 0x05,
-		0xc8, 0x9b, 0x38, 0xfc, 0xce, 0xe1, 0x99, 0xf3, 0x9d, 0x39, 0xf3, 0xcd, 0x68, 0x61, 0x37, 0x6a,
-		0x91, 0xa0, 0x61, 0x63, 0x87, 0x78, 0x36, 0x69, 0x60, 0x9f, 0x36, 0xba, 0x0f, 0x1b, 0x21, 0x09,
-		0xba, 0xd4, 0x26, 0xd6, 0x25, 0x0b, 0x2e, 0x48, 0x50, 0xf7, 0x03, 0xc6, 0x19, 0xba, 0x16, 0x23,
-		0xeb, 0x0a, 0x59, 0xc7, 0x3e, 0xad, 0x77, 0x1f, 0x56, 0x6f, 0x9f, 0x33, 0x76, 0xee, 0x92, 0x86,
-		0x80, 0xb4, 0xa2, 0xb3, 0x86, 0x13, 0x05, 0x98, 0x53, 0xe6, 0x49, 0xa3, 0xea, 0x9d, 0xe1, 0xf7,
-		0x9c, 0x76, 0x48, 0xc8, 0x71, 0xc7, 0x57, 0x80, 0x11, 0x07, 0x97, 0x01, 0xf6, 0x7d, 0x12, 0x84,
-		0xea, 0xfd, 0x8e, 0x2e, 0x3e, 0x9b, 0x75, 0x3a, 0xe9, 0x27, 0x6a, 0x3a, 0x84, 0x43, 0x6c, 0x1a,
-		0xf6, 0xc3, 0xb8, 0xab, 0xc3, 0xb4, 0x69, 0xc8, 0x59, 0xd0, 0x4b, 0x22, 0xd5, 0x41, 0xde, 0x44,
-		0x24, 0x05, 0x68, 0xbf, 0xc3, 0x71, 0x78, 0xe1, 0xd2, 0x90, 0x17, 0x61, 0xe2, 0x2c, 0x9e, 0xb9,
-		0xec, 0x52, 0x62, 0x6a, 0xff, 0x34, 0xa0, 0x7a, 0xca, 0x5c, 0xf7, 0x98, 0x05, 0x47, 0x2a, 0xca,
-		0x26, 0x0e, 0x2f, 0x4c, 0xf2, 0x26, 0x22, 0x21, 0x47, 0xd7, 0x61, 0xc9, 0x61, 0x1d, 0x4c, 0xbd,
-		0x8a, 0xb1, 0x63, 0xec, 0xae, 0x9a, 0xea, 0x09, 0x3d, 0x81, 0xd5, 0xf8, 0x63, 0x56, 0xfc, 0xb5,
-		0xca, 0xdc, 0x8e, 0xb1, 0x5b, 0xda, 0xbb, 0x55, 0xd7, 0x50, 0x52, 0x8f, 0x9d, 0x7d, 0x46, 0x43,
-		0x6e, 0xae, 0x70, 0xf5, 0x17, 0xaa, 0xc2, 0x0a, 0x75, 0x88, 0xc7, 0x29, 0xef, 0x55, 0xe6, 0x85,
-		0xd7, 0xf4, 0x19, 0x3d, 0x80, 0xcd, 0x16, 0xf5, 0x70, 0xd0, 0xb3, 0xec, 0x36, 0xb1, 0x2f, 0xc2,
-		0xa8, 0x53, 0x59, 0x10, 0x90, 0x0d, 0x39, 0x7c, 0xa8, 0x46, 0x6b, 0xff, 0x5d, 0x86, 0x1b, 0xda,
-		0xb8, 0x43, 0x9f, 0x79, 0x21, 0x41, 0xb7, 0x00, 0x44, 0x80, 0x9c, 0x5d, 0x10, 0x19, 0xfc, 0x9a,
-		0x29, 0x42, 0x6e, 0xc6, 0x03, 0xe8, 0x4b, 0x40, 0x49, 0x22, 0x2c, 0xf2, 0x96, 0xd8, 0x51, 0x5c,
-		0x25, 0x6a, 0x22, 0xf7, 0xb5, 0x13, 0xf9, 0x4a, 0xc1, 0x9f, 0x27, 0x68, 0x73, 0xeb, 0x72, 0x78,
-		0x08, 0x1d, 0xc3, 0x7a, 0xea, 0x96, 0xf7, 0x7c, 0x22, 0xe6, 0x57, 0xda, 0xbb, 0x5b, 0xe8, 0xb1,
-		0xd9, 0xf3, 0x89, 0xb9, 0x76, 0x99, 0x79, 0x42, 0xaf, 0x60, 0xdb, 0x0f, 0x48, 0x97, 0xb2, 0x28,
-		0xb4, 0x42, 0x8e, 0x03, 0x4e, 0x1c, 0x8b, 0x74, 0x89, 0xc7, 0x2d, 0xea, 0x88, 0x84, 0x94, 0xf6,
-		0x6e, 0xd4, 0x65, 0xad, 0xd6, 0x93, 0x5a, 0xad, 0x9f, 0x78, 0xfc, 0xd1, 0x47, 0xaf, 0xb0, 0x1b,
-		0x11, 0xf3, 0x7a, 0x62, 0xfd, 0x52, 0x1a, 0x3f, 0x8f, 0x6d, 0x4f, 0x1c, 0xb4, 0x0b, 0xe5, 0x11,
-		0x77, 0x8b, 0x3b, 0xc6, 0xee, 0xbc, 0xb9, 0x11, 0x0e, 0x22, 0x2b, 0xb0, 0x8c, 0x39, 0x27, 0x1d,
-		0x9f, 0x57, 0x96, 0x04, 0x20, 0x79, 0x44, 0x1f, 0x00, 0x6a, 0x61, 0xfb, 0xc2, 0x65, 0xe7, 0x96,
-		0xcd, 0x22, 0x8f, 0x5b, 0x6d, 0xea, 0xf1, 0xca, 0xb2, 0x00, 0x95, 0xd5, 0x9b, 0xc3, 0xf8, 0xc5,
-		0x0b, 0xea, 0x71, 0xf4, 0x08, 0x96, 0x55, 0x65, 0x57, 0x56, 0x44, 0xdc, 0x37, 0xb5, 0xb9, 0x78,
-		0x21, 0x31, 0x66, 0x02, 0x46, 0xf7, 0x61, 0xd3, 0x23, 0x6f, 0xb9, 0xe5, 0xe3, 0x73, 0xa2, 0x48,
-		0x5c, 0x15, 0x24, 0xae, 0xc7, 0xc3, 0xa7, 0xf8, 0x9c, 0x48, 0x22, 0x1f, 0xc3, 0xa2, 0x58, 0x16,
-		0x15, 0x10, 0xde, 0x6b, 0x85, 0x99, 0xfe, 0x22, 0x46, 0x9a, 0xd2, 0x00, 0xbd, 0x86, 0x9b, 0xa3,
-		0x25, 0x60, 0xf5, 0xab, 0xba, 0x34, 0x49, 0x55, 0x6f, 0x8f, 0xd4, 0x40, 0xf2, 0x0a, 0xed, 0xc3,
-		0x46, 0x68, 0xb7, 0x89, 0x13, 0xb9, 0xc4, 0xb1, 0xe2, 0x46, 0x53, 0x59, 0x13, 0x1e, 0xab, 0x23,
-		0xc4, 0x35, 0x93, 0x2e, 0x64, 0xae, 0xa7, 0x16, 0xf1, 0x18, 0x7a, 0x06, 0x6b, 0x09, 0x5d, 0xc2,
-		0xc1, 0xfa, 0x58, 0x07, 0x25, 0x85, 0x17, 0xe6, 0x5f, 0xc1, 0x72, 0x3c, 0x55, 0x4a, 0xc2, 0xca,
-		0xc6, 0xce, 0xfc, 0x6e, 0x69, 0xef, 0x99, 0x76, 0x32, 0x05, 0xcb, 0xa8, 0xfe, 0x85, 0xb4, 0x7f,
-		0xee, 0xf1, 0x98, 0x1c, 0xe5, 0x0d, 0xd5, 0x40, 0xb0, 0xd0, 0xaf, 0xa1, 0x4d, 0xc1, 0x7e, 0x29,
-		0x1e, 0x54, 0x05, 0x54, 0x7d, 0x0d, 0x6b, 0x59, 0x63, 0x54, 0x86, 0xf9, 0x0b, 0xd2, 0x53, 0x6d,
-		0x24, 0xfe, 0x33, 0xa6, 0xae, 0x1b, 0x57, 0xab, 0x5a, 0x76, 0x13, 0x51, 0x27, 0x0c, 0x9e, 0xcc,
-		0x3d, 0x36, 0x6a, 0xff, 0x58, 0x84, 0xf7, 0x64, 0x98, 0x4e, 0x36, 0xf2, 0x43, 0xd6, 0xf1, 0x5d,
-		0xc2, 0x89, 0x93, 0x74, 0xb0, 0x31, 0x8d, 0xe0, 0x29, 0xac, 0x26, 0xdd, 0x39, 0xac, 0xcc, 0x89,
-		0x2c, 0xe9, 0x29, 0x4f, 0x3e, 0x62, 0xf6, 0xf1, 0xe8, 0x67, 0xb0, 0xd5, 0xaf, 0x1c, 0x9b, 0x79,
-		0x9c, 0xbc, 0xe5, 0x62, 0xc9, 0xaf, 0x99, 0xe5, 0xf4, 0xc5, 0xa1, 0x1c, 0x1f, 0x68, 0x7b, 0x0b,
-		0x43, 0x6d, 0xef, 0xf7, 0xb0, 0x15, 0x72, 0x6a, 0x5f, 0xf4, 0x2c, 0xcc, 0x79, 0x40, 0x5b, 0x11,
-		0x27, 0xa1, 0x58, 0x98, 0xa5, 0xbd, 0xba, 0x36, 0x9a, 0x97, 0x02, 0x9d, 0x16, 0xdd, 0x7e, 0x6a,
-		0x65, 0x96, 0xa5, 0xa3, 0xfe, 0x08, 0xfa, 0x18, 0x2a, 0x01, 0xe1, 0x51, 0xe0, 0x59, 0x1e, 0xb9,
-		0xb4, 0x92, 0xe8, 0x45, 0xa5, 0x8b, 0xb5, 0xbd, 0x62, 0xfe, 0x48, 0xbe, 0xff, 0x9c, 0x5c, 0x66,
-		0x53, 0x89, 0x0e, 0xe0, 0xf6, 0x19, 0x0b, 0x6c, 0x62, 0xd9, 0x01, 0xc1, 0x9c, 0x68, 0xcc, 0x97,
-		0x85, 0x79, 0x55, 0xa0, 0x0e, 0x05, 0x68, 0xd8, 0x87, 0xa6, 0xa1, 0xaf, 0xe8, 0x1a, 0x3a, 0x62,
-		0xb0, 0x2e, 0xd6, 0xa5, 0x15, 0x90, 0x30, 0x72, 0x79, 0x58, 0x59, 0x15, 0x64, 0xfc, 0x46, 0x3b,
-		0xfd, 0x09, 0x88, 0xaf, 0xcb, 0x8a, 0x91, 0xce, 0x64, 0xfd, 0xae, 0xbd, 0xc9, 0x0c, 0x55, 0x29,
-		0x6c, 0x8d, 0x40, 0x34, 0x55, 0xfa, 0xab, 0xc1, 0x2a, 0xdd, 0x9d, 0xa0, 0x4a, 0x85, 0xc3, 0x6c,
-		0xad, 0xbe, 0x9b, 0x87, 0x9f, 0x14, 0x87, 0xac, 0x76, 0xad, 0x2f, 0x61, 0x7d, 0x30, 0xc1, 0x86,
-		0xf8, 0xe8, 0x2f, 0xa6, 0x5d, 0xb7, 0xe6, 0x9a, 0x93, 0x25, 0xe1, 0x9d, 0x01, 0xb7, 0xb1, 0xcd,
-		0x69, 0x97, 0x72, 0x4a, 0x42, 0x8b, 0x33, 0xcb, 0xa1, 0xa1, 0x8f, 0xb9, 0xdd, 0xb6, 0x5c, 0x66,
-		0x63, 0xd7, 0xed, 0xa9, 0xd2, 0xff, 0x7a, 0x86, 0x6c, 0xab, 0x4e, 0xb1, 0x9f, 0xfa, 0x6f, 0xb2,
-		0x23, 0xe5, 0xfd, 0x33, 0xe9, 0x5c, 0x66, 0xff, 0x06, 0xce, 0x47, 0x54, 0xff, 0x00, 0x3b, 0xe3,
-		0x1c, 0x68, 0xb8, 0x39, 0x1a, 0xe4, 0x46, 0xbf, 0x54, 0x94, 0xdf, 0x9e, 0xf0, 0x95, 0x38, 0x3e,
-		0xf1, 0xce, 0x58, 0x96, 0xa1, 0x3f, 0xce, 0xc1, 0x8e, 0x66, 0x9a, 0xc7, 0x98, 0xba, 0x13, 0xb7,
-		0x92, 0x03, 0x58, 0xb4, 0x71, 0x14, 0xca, 0x68, 0x36, 0xf6, 0x3e, 0x28, 0x6c, 0x23, 0x7d, 0xef,
-		0x87, 0xb1, 0x8d, 0x29, 0x4d, 0xe3, 0xed, 0xd2, 0x21, 0x1c, 0x53, 0x37, 0x54, 0xd2, 0x41, 0xbf,
-		0x5d, 0x9e, 0xe2, 0x9e, 0xcb, 0xb0, 0x63, 0x26, 0xe0, 0xc2, 0xe6, 0xa2, 0x59, 0x82, 0x8b, 0x5a,
-		0x4d, 0xf5, 0x1e, 0xdc, 0x2d, 0xc8, 0x81, 0xe4, 0xb9, 0xf6, 0xef, 0xbe, 0x60, 0x4c, 0x32, 0x7b,
-		0x95, 0x82, 0xf1, 0x25, 0xa0, 0xd4, 0xaf, 0xd5, 0x21, 0x1c, 0x3b, 0x98, 0x63, 0x25, 0x91, 0xee,
-		0x15, 0x7e, 0xe0, 0xb7, 0x0a, 0x6c, 0x96, 0xf9, 0xd0, 0x48, 0xed, 0xdb, 0x95, 0x54, 0x5c, 0x0e,
-		0xce, 0xf1, 0x4a, 0xc5, 0xe5, 0x1d, 0x28, 0xa9, 0x25, 0xd4, 0x8b, 0xf7, 0x5c, 0x99, 0x09, 0x48,
-		0x86, 0x4e, 0x9c, 0x58, 0x7d, 0xa6, 0x00, 0xa1, 0x3e, 0x17, 0x0a, 0xd4, 0x67, 0x3a, 0x31, 0xa1,
-		0x3e, 0x71, 0xe6, 0x09, 0xed, 0xc1, 0x22, 0xf5, 0xfc, 0x88, 0xab, 0x1d, 0xa8, 0xb8, 0x04, 0x25,
-		0x54, 0xa3, 0x76, 0x96, 0xfe, 0x5f, 0xb5, 0xb3, 0x3c, 0x9d, 0xda, 0x69, 0xc2, 0x76, 0xe2, 0x2f,
-		0xee, 0x70, 0xb6, 0xcb, 0x42, 0x22, 0x1c, 0xb1, 0x88, 0x2b, 0xed, 0xb9, 0x3d, 0xe2, 0xeb, 0x48,
-		0x1d, 0x10, 0xcd, 0xeb, 0x89, 0x6d, 0x93, 0x1d, 0xc6, 0x96, 0x4d, 0x69, 0x88, 0x3e, 0x87, 0xeb,
-		0xe2, 0x23, 0xa3, 0x2e, 0x57, 0xc7, 0xb9, 0xbc, 0x26, 0x0c, 0x87, 0xfc, 0x1d, 0xc3, 0x56, 0x9b,
-		0xe0, 0x80, 0xb7, 0x08, 0xe6, 0xa9, 0x2b, 0x18, 0xe7, 0xaa, 0x9c, 0xda, 0x24, 0x7e, 0x32, 0xfa,
-		0x3c, 0x16, 0xaa, 0x8b, 0x7d, 0x7d, 0xfe, 0x1a, 0x6e, 0x0f, 0x32, 0x61, 0xb1, 0x33, 0x8b, 0xb7,
-		0x69, 0x68, 0x25, 0x06, 0xe3, 0x75, 0x68, 0x75, 0x80, 0x99, 0xdf, 0x9d, 0x35, 0xdb, 0x34, 0xdc,
-		0x57, 0xfe, 0x4f, 0xb2, 0x33, 0x48, 0x9a, 0xd5, 0xfa, 0x04, 0x95, 0xd2, 0x9f, 0xc4, 0x91, 0xea,
-		0x5a, 0x23, 0xc7, 0xa5, 0x8d, 0xd9, 0x8e, 0x4b, 0x0f, 0x60, 0x33, 0xf5, 0xa3, 0xba, 0xcf, 0xa6,
-		0xec, 0x70, 0xc9, 0xf0, 0x91, 0xec, 0x42, 0x1f, 0xc2, 0x52, 0x9b, 0x60, 0x87, 0x04, 0x95, 0xb2,
-		0x3a, 0x44, 0x69, 0x0f, 0x23, 0x02, 0x62, 0x2a, 0x68, 0xed, 0x3b, 0x23, 0x55, 0x9a, 0xd9, 0x6e,
-		0x30, 0xad, 0xd2, 0xfc, 0x08, 0x96, 0xa4, 0xb4, 0x51, 0x9d, 0xa0, 0x38, 0x59, 0x0a, 0x5b, 0xd4,
-		0xfb, 0x6a, 0xf7, 0x53, 0x55, 0x91, 0x13, 0x97, 0x6a, 0xd9, 0xdf, 0xce, 0xc1, 0x83, 0x22, 0xe0,
-		0x41, 0xef, 0xe4, 0x68, 0x5c, 0xff, 0xbe, 0xaa, 0x9e, 0xd6, 0xcf, 0xda, 0xc2, 0x8c, 0x59, 0x5b,
-		0x1c, 0xca, 0xda, 0x4f, 0x61, 0x77, 0x7c, 0x32, 0x54, 0xe6, 0xfe, 0x6a, 0xa4, 0xb2, 0x20, 0x0b,
-		0x9e, 0x4a, 0x16, 0x3c, 0x82, 0xe5, 0x33, 0x4c, 0xdd, 0x28, 0x20, 0x85, 0xc4, 0x1f, 0x4b, 0x8c,
-		0x99, 0x80, 0x0b, 0x99, 0xef, 0xef, 0xd4, 0xba, 0xb0, 0x54, 0xf0, 0xdf, 0xcc, 0x69, 0xeb, 0x43,
-		0xa2, 0xbe, 0xcf, 0x9c, 0x67, 0x32, 0xb6, 0x30, 0x6b, 0xc6, 0x86, 0x59, 0x7f, 0x00, 0xf7, 0xc6,
-		0xe4, 0x42, 0x65, 0xed, 0x6f, 0x06, 0xd4, 0x74, 0xf5, 0x81, 0x3d, 0x9b, 0x4c, 0x45, 0x7a, 0xd2,
-		0x1a, 0xe7, 0x66, 0xd5, 0x71, 0xc3, 0xa4, 0xdf, 0xd3, 0xb7, 0xa1, 0x34, 0x30, 0x35, 0x81, 0x3f,
-		0xcd, 0xc1, 0xfd, 0x02, 0xdc, 0xf7, 0x9c, 0xf8, 0x24, 0x6b, 0x0b, 0xb3, 0x66, 0x6d, 0x98, 0xf8,
-		0xf7, 0xf5, 0xbd, 0x6f, 0x20, 0x1b, 0x03, 0xd4, 0xdb, 0x2c, 0x18, 0x80, 0xbe, 0x48, 0x76, 0xad,
-		0x2b, 0xa4, 0xfe, 0x34, 0xa6, 0xbe, 0x20, 0x30, 0xa5, 0x4b, 0xdf, 0x87, 0xb2, 0x2d, 0x26, 0x66,
-		0x05, 0x32, 0x56, 0xe2, 0x88, 0xf8, 0x56, 0xcc, 0x4d, 0x39, 0x6e, 0x26, 0xc3, 0xaa, 0x4a, 0x72,
-		0x5d, 0xfe, 0xd0, 0xaa, 0xa4, 0x19, 0x57, 0xc9, 0x98, 0x6c, 0x4c, 0x9f, 0xe4, 0x7f, 0xf5, 0xb7,
-		0x0f, 0x71, 0x33, 0x30, 0x8b, 0x6c, 0xf8, 0xf5, 0x90, 0x6c, 0x98, 0xfc, 0x02, 0x22, 0xd9, 0x0c,
-		0x5f, 0xc1, 0x35, 0xf9, 0xaf, 0x13, 0xab, 0x4b, 0x02, 0x71, 0xb5, 0x40, 0xbd, 0x33, 0xa6, 0xce,
-		0x97, 0xf9, 0x44, 0x91, 0xe0, 0x95, 0x84, 0x8b, 0xb3, 0xf2, 0xd6, 0xe5, 0xf0, 0x50, 0x66, 0x13,
-		0xd2, 0x4d, 0x2e, 0xd1, 0x1e, 0x06, 0x54, 0x4d, 0x12, 0x12, 0x2e, 0x6f, 0xac, 0xd2, 0xd3, 0xdd,
-		0x95, 0xd4, 0x56, 0xed, 0x16, 0xdc, 0xd0, 0x06, 0x23, 0x83, 0xdd, 0xfb, 0xcf, 0x26, 0xac, 0xca,
-		0xa9, 0xef, 0x9f, 0x9e, 0xa0, 0xb7, 0x70, 0x4d, 0x73, 0xc5, 0x82, 0x1a, 0x93, 0x5f, 0xc6, 0x88,
-		0x39, 0x56, 0xa7, 0xbe, 0xbd, 0x41, 0x7f, 0x31, 0xe0, 0x66, 0xd1, 0xa5, 0x0b, 0x7a, 0x3c, 0xeb,
-		0xad, 0x58, 0xf5, 0x93, 0x99, 0x6f, 0x78, 0xd0, 0x37, 0x06, 0x6c, 0xe7, 0xde, 0x0f, 0xa0, 0x5f,
-		0x4e, 0xea, 0x78, 0x40, 0x3c, 0x55, 0x1f, 0x4d, 0x6b, 0xa6, 0x82, 0xe9, 0x93, 0x93, 0x5d, 0xb1,
-		0xc5, 0xe4, 0x68, 0xee, 0x2b, 0x8a, 0xc9, 0xd1, 0x1e, 0xfe, 0x33, 0xe4, 0x68, 0x05, 0x64, 0x31,
-		0x39, 0x45, 0x27, 0x88, 0x62, 0x72, 0x0a, 0x35, 0x3e, 0x7a, 0xa7, 0x57, 0xaa, 0x03, 0xb2, 0x16,
-		0x7d, 0x3a, 0xb5, 0xff, 0xcc, 0x3e, 0x50, 0x7d, 0x36, 0xa3, 0xf5, 0x68, 0xf9, 0x8c, 0x4a, 0xb0,
-		0xe2, 0xf2, 0xc9, 0xd5, 0xde, 0xc5, 0xe5, 0x93, 0xaf, 0x8d, 0xd1, 0x77, 0x06, 0xdc, 0x2a, 0xd4,
-		0x83, 0xe8, 0x93, 0xe9, 0x3c, 0x67, 0x13, 0xf5, 0x64, 0x16, 0x53, 0x15, 0xd8, 0x9f, 0x0d, 0xd1,
-		0xa2, 0xf2, 0xf4, 0x0a, 0xfa, 0x78, 0x62, 0x12, 0x06, 0x05, 0x6b, 0xf5, 0xf1, 0xf4, 0x86, 0x2a,
-		0xa4, 0xbf, 0x1b, 0x70, 0x67, 0x8c, 0x84, 0x42, 0x4f, 0xa7, 0xf5, 0x9e, 0xcd, 0xd7, 0xa7, 0xb3,
-		0x19, 0x0f, 0x64, 0x2c, 0x77, 0xef, 0xce, 0xcd, 0xd8, 0x38, 0x9d, 0x97, 0x9b, 0xb1, 0xf1, 0x3a,
-		0x4c, 0x66, 0xac, 0x50, 0x4e, 0xe4, 0x66, 0x6c, 0x12, 0x49, 0x96, 0x9b, 0xb1, 0xc9, 0x14, 0x4c,
-		0x66, 0x25, 0x8e, 0xee, 0xdc, 0xc5, 0x2b, 0x31, 0x57, 0xc6, 0x14, 0xaf, 0xc4, 0x7c, 0x81, 0x10,
-		0x37, 0x72, 0xcd, 0x96, 0x9c, 0xd3, 0xc8, 0xf3, 0x95, 0x44, 0x4e, 0x23, 0x2f, 0xd8, 0xed, 0x0f,
-		0x5a, 0xf0, 0x63, 0x9b, 0x75, 0x74, 0x66, 0x07, 0x48, 0xaa, 0x80, 0x97, 0xf2, 0x97, 0x27, 0xa7,
-		0x01, 0xe3, 0xec, 0xd4, 0xf8, 0xfa, 0xe1, 0x39, 0xe5, 0xed, 0xa8, 0x55, 0xb7, 0x59, 0xa7, 0x91,
-		0xfd, 0x69, 0xc5, 0xcf, 0xa9, 0xe3, 0x36, 0xce, 0x99, 0xfc, 0xd5, 0x88, 0xfa, 0x9d, 0xc5, 0x53,
-		0xec, 0xd3, 0xee, 0xc3, 0xd6, 0x92, 0x18, 0xfb, 0xf0, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b,
-		0xa4, 0xf1, 0x91, 0xd9, 0x22, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
+		0x15, 0x07, 0xe5, 0xef, 0x27, 0x7f, 0xc8, 0x13, 0x34, 0xa5, 0xe9, 0xc4, 0x51, 0x94, 0x0f, 0x7b,
+		0xdb, 0xad, 0x94, 0x78, 0xb7, 0x5e, 0xe7, 0xab, 0xa8, 0x3f, 0x62, 0xc4, 0xc5, 0x76, 0xeb, 0x65,
+		0xb4, 0x59, 0x60, 0x0b, 0x84, 0x18, 0x93, 0x63, 0x8b, 0x30, 0xc5, 0x51, 0xc8, 0xa1, 0x1c, 0x5d,
+		0x7a, 0xe8, 0x71, 0xb7, 0x87, 0x02, 0x45, 0xb7, 0x97, 0x02, 0x39, 0xf7, 0x0f, 0xe8, 0xff, 0xd0,
+		0x63, 0xef, 0xbd, 0xb4, 0xb9, 0xf6, 0x7f, 0x28, 0x0a, 0xce, 0x0c, 0x25, 0x4a, 0x1a, 0x51, 0x1f,
+		0x3d, 0x38, 0x40, 0x6f, 0xe6, 0xf0, 0xf7, 0x7e, 0xf3, 0xe6, 0xbd, 0x37, 0x6f, 0x7e, 0x1c, 0x0b,
+		0xb6, 0xa2, 0x53, 0x12, 0x54, 0x6c, 0xec, 0x10, 0xdf, 0x26, 0x15, 0xdc, 0x70, 0x2b, 0xcd, 0x87,
+		0x95, 0x90, 0x04, 0x4d, 0xd7, 0x26, 0xd6, 0x25, 0x0d, 0x2e, 0x48, 0x50, 0x6e, 0x04, 0x94, 0x51,
+		0x74, 0x2d, 0x46, 0x96, 0x25, 0xb2, 0x8c, 0x1b, 0x6e, 0xb9, 0xf9, 0xd0, 0xd8, 0x38, 0xa7, 0xf4,
+		0xdc, 0x23, 0x15, 0x0e, 0x39, 0x8d, 0xce, 0x2a, 0x4e, 0x14, 0x60, 0xe6, 0x52, 0x5f, 0x18, 0x19,
+		0xb7, 0x7a, 0xdf, 0x33, 0xb7, 0x4e, 0x42, 0x86, 0xeb, 0x0d, 0x09, 0xe8, 0x23, 0xb8, 0x0c, 0x70,
+		0xa3, 0x41, 0x82, 0x50, 0xbe, 0x2f, 0xaa, 0xfc, 0xb3, 0x69, 0xbd, 0xde, 0x9e, 0xa2, 0xa4, 0x42,
+		0x38, 0xc4, 0x76, 0xc3, 0x8e, 0x1b, 0xb7, 0x55, 0x98, 0x9a, 0x1b, 0x32, 0x1a, 0xb4, 0x12, 0x4f,
+		0x55, 0x90, 0x37, 0x11, 0x09, 0x5a, 0x59, 0xf3, 0x30, 0x1c, 0x5e, 0x78, 0x6e, 0xc8, 0xb2, 0x30,
+		0x71, 0x14, 0xcf, 0x3c, 0x7a, 0x29, 0x30, 0xa5, 0xbf, 0x6a, 0x60, 0x9c, 0x50, 0xcf, 0x3b, 0xa2,
+		0xc1, 0xa1, 0xf4, 0xb2, 0x8a, 0xc3, 0x0b, 0x93, 0xbc, 0x89, 0x48, 0xc8, 0xd0, 0x75, 0x98, 0x75,
+		0x68, 0x1d, 0xbb, 0xbe, 0xae, 0x15, 0xb5, 0xad, 0x05, 0x53, 0x3e, 0xa1, 0xc7, 0xb0, 0x10, 0x4f,
+		0x66, 0xc5, 0xb3, 0xe9, 0xb9, 0xa2, 0xb6, 0x95, 0xdf, 0xbe, 0x59, 0x56, 0xa4, 0xa4, 0x1c, 0x93,
+		0x7d, 0xee, 0x86, 0xcc, 0x9c, 0x67, 0xf2, 0x2f, 0x64, 0xc0, 0xbc, 0xeb, 0x10, 0x9f, 0xb9, 0xac,
+		0xa5, 0x4f, 0x71, 0xd6, 0xf6, 0x33, 0xda, 0x84, 0x95, 0x53, 0xd7, 0xc7, 0x41, 0xcb, 0xb2, 0x6b,
+		0xc4, 0xbe, 0x08, 0xa3, 0xba, 0x3e, 0xcd, 0x21, 0xcb, 0x62, 0xf8, 0x40, 0x8e, 0x96, 0xfe, 0x33,
+		0x07, 0xeb, 0x4a, 0xbf, 0xc3, 0x06, 0xf5, 0x43, 0x82, 0x6e, 0x02, 0x70, 0x07, 0x19, 0xbd, 0x20,
+		0xc2, 0xf9, 0x45, 0x93, 0xbb, 0x5c, 0x8d, 0x07, 0xd0, 0x57, 0x80, 0x92, 0x40, 0x58, 0xe4, 0x2d,
+		0xb1, 0xa3, 0xb8, 0x4a, 0xe4, 0x42, 0xee, 0x2b, 0x17, 0xf2, 0xb5, 0x84, 0x3f, 0x4f, 0xd0, 0xe6,
+		0xea, 0x65, 0xef, 0x10, 0x3a, 0x82, 0xa5, 0x36, 0x2d, 0x6b, 0x35, 0x08, 0x5f, 0x5f, 0x7e, 0xfb,
+		0x76, 0x26, 0x63, 0xb5, 0xd5, 0x20, 0xe6, 0xe2, 0x65, 0xea, 0x09, 0xbd, 0x82, 0xb5, 0x46, 0x40,
+		0x9a, 0x2e, 0x8d, 0x42, 0x2b, 0x64, 0x38, 0x60, 0xc4, 0xb1, 0x48, 0x93, 0xf8, 0xcc, 0x72, 0x1d,
+		0x1e, 0x90, 0xfc, 0xf6, 0x7a, 0x59, 0xd4, 0x6a, 0x39, 0xa9, 0xd5, 0xf2, 0xb1, 0xcf, 0x76, 0x3e,
+		0x7d, 0x85, 0xbd, 0x88, 0x98, 0xd7, 0x13, 0xeb, 0x97, 0xc2, 0xf8, 0x79, 0x6c, 0x7b, 0xec, 0xa0,
+		0x2d, 0x28, 0xf4, 0xd1, 0xcd, 0x14, 0xb5, 0xad, 0x29, 0x73, 0x39, 0xec, 0x46, 0xea, 0x30, 0x87,
+		0x19, 0x23, 0xf5, 0x06, 0xd3, 0x67, 0x39, 0x20, 0x79, 0x44, 0x1f, 0x03, 0x3a, 0xc5, 0xf6, 0x85,
+		0x47, 0xcf, 0x2d, 0x9b, 0x46, 0x3e, 0xb3, 0x6a, 0xae, 0xcf, 0xf4, 0x39, 0x0e, 0x2a, 0xc8, 0x37,
+		0x07, 0xf1, 0x8b, 0x17, 0xae, 0xcf, 0xd0, 0x0e, 0xcc, 0xc9, 0xca, 0xd6, 0xe7, 0xb9, 0xdf, 0x37,
+		0x94, 0xb1, 0x78, 0x21, 0x30, 0x66, 0x02, 0x46, 0xf7, 0x61, 0xc5, 0x27, 0x6f, 0x99, 0xd5, 0xc0,
+		0xe7, 0x44, 0x26, 0x71, 0x81, 0x27, 0x71, 0x29, 0x1e, 0x3e, 0xc1, 0xe7, 0x44, 0x24, 0x72, 0x17,
+		0x66, 0xf8, 0xb6, 0xd0, 0x81, 0xb3, 0x97, 0x32, 0x23, 0xfd, 0x65, 0x8c, 0x34, 0x85, 0x01, 0x7a,
+		0x0d, 0x37, 0xfa, 0x4b, 0xc0, 0xea, 0x54, 0x75, 0x7e, 0x94, 0xaa, 0x5e, 0xeb, 0xab, 0x81, 0xe4,
+		0x15, 0xda, 0x83, 0xe5, 0xd0, 0xae, 0x11, 0x27, 0xf2, 0x88, 0x63, 0xc5, 0x8d, 0x46, 0x5f, 0xe4,
+		0x8c, 0x46, 0x5f, 0xe2, 0xaa, 0x49, 0x17, 0x32, 0x97, 0xda, 0x16, 0xf1, 0x18, 0x7a, 0x06, 0x8b,
+		0x49, 0xba, 0x38, 0xc1, 0xd2, 0x50, 0x82, 0xbc, 0xc4, 0x73, 0xf3, 0xaf, 0x61, 0x2e, 0x5e, 0xaa,
+		0x4b, 0x42, 0x7d, 0xb9, 0x38, 0xb5, 0x95, 0xdf, 0x7e, 0xa6, 0x5c, 0x4c, 0xc6, 0x36, 0x2a, 0x7f,
+		0x29, 0xec, 0x9f, 0xfb, 0x2c, 0x4e, 0x8e, 0x64, 0x43, 0x25, 0xe0, 0x59, 0xe8, 0xd4, 0xd0, 0x0a,
+		0xcf, 0x7e, 0x3e, 0x1e, 0x94, 0x05, 0x64, 0xbc, 0x86, 0xc5, 0xb4, 0x31, 0x2a, 0xc0, 0xd4, 0x05,
+		0x69, 0xc9, 0x36, 0x12, 0xff, 0x19, 0xa7, 0xae, 0x19, 0x57, 0xab, 0x9e, 0x1b, 0x3d, 0x75, 0xdc,
+		0xe0, 0x71, 0x6e, 0x57, 0x2b, 0xfd, 0x65, 0x06, 0xee, 0x08, 0x37, 0x9d, 0xb4, 0xe7, 0x07, 0xb4,
+		0xde, 0xf0, 0x08, 0x23, 0x4e, 0xd2, 0xc1, 0x86, 0x34, 0x82, 0x27, 0xb0, 0x90, 0x74, 0xe7, 0x50,
+		0xcf, 0x15, 0xa7, 0x06, 0xa6, 0x3c, 0x99, 0xc4, 0xec, 0xe0, 0xd1, 0x8f, 0x61, 0xb5, 0x53, 0x39,
+		0x36, 0xf5, 0x19, 0x79, 0xcb, 0xf8, 0x96, 0x5f, 0x34, 0x0b, 0xed, 0x17, 0x07, 0x62, 0xbc, 0xab,
+		0xed, 0x4d, 0xf7, 0xb4, 0xbd, 0x5f, 0xc3, 0x6a, 0xc8, 0x5c, 0xfb, 0xa2, 0x65, 0x61, 0xc6, 0x02,
+		0xf7, 0x34, 0x62, 0x24, 0xe4, 0x1b, 0x33, 0xbf, 0x5d, 0x56, 0x7a, 0xf3, 0x92, 0xa3, 0xdb, 0x45,
+		0xb7, 0xd7, 0xb6, 0x32, 0x0b, 0x82, 0xa8, 0x33, 0x82, 0x3e, 0x03, 0x3d, 0x20, 0x2c, 0x0a, 0x7c,
+		0xcb, 0x27, 0x97, 0x56, 0xe2, 0x3d, 0xaf, 0x74, 0xbe, 0xb7, 0xe7, 0xcd, 0x1f, 0x88, 0xf7, 0x5f,
+		0x90, 0xcb, 0x74, 0x28, 0xd1, 0x3e, 0x6c, 0x9c, 0xd1, 0xc0, 0x26, 0x96, 0x1d, 0x10, 0xcc, 0x88,
+		0xc2, 0x7c, 0x8e, 0x9b, 0x1b, 0x1c, 0x75, 0xc0, 0x41, 0xbd, 0x1c, 0x8a, 0x86, 0x3e, 0xaf, 0x6a,
+		0xe8, 0x88, 0xc2, 0x12, 0xdf, 0x97, 0x56, 0x40, 0xc2, 0xc8, 0x63, 0xa1, 0xbe, 0xc0, 0x93, 0xf1,
+		0x0b, 0xe5, 0xf2, 0x47, 0x48, 0x7c, 0x59, 0x54, 0x8c, 0x20, 0x13, 0xf5, 0xbb, 0xf8, 0x26, 0x35,
+		0x64, 0xb8, 0xb0, 0xda, 0x07, 0x51, 0x54, 0xe9, 0xcf, 0xba, 0xab, 0x74, 0x6b, 0x84, 0x2a, 0xe5,
+		0x84, 0xe9, 0x5a, 0x7d, 0x37, 0x05, 0x77, 0xb3, 0x5d, 0x96, 0xa7, 0xd6, 0x57, 0xb0, 0xd4, 0x1d,
+		0x60, 0x8d, 0x4f, 0xfa, 0x60, 0xdc, 0x7d, 0x6b, 0x2e, 0x3a, 0xe9, 0x24, 0xbc, 0xd3, 0x60, 0x03,
+		0xdb, 0xcc, 0x6d, 0xba, 0xcc, 0x25, 0xa1, 0xc5, 0xa8, 0xe5, 0xb8, 0x61, 0x03, 0x33, 0xbb, 0x66,
+		0x79, 0xd4, 0xc6, 0x9e, 0xd7, 0x92, 0xa5, 0xff, 0xcd, 0x04, 0xd1, 0x96, 0x9d, 0x62, 0xaf, 0xcd,
+		0x5f, 0xa5, 0x87, 0x92, 0xfd, 0x73, 0x41, 0x2e, 0xa2, 0xbf, 0x8e, 0x07, 0x23, 0x8c, 0xdf, 0x40,
+		0x71, 0x18, 0x81, 0x22, 0x37, 0x87, 0xdd, 0xb9, 0x51, 0x6f, 0x15, 0xc9, 0xdb, 0xe2, 0x5c, 0x09,
+		0xf1, 0xb1, 0x7f, 0x46, 0xd3, 0x19, 0xfa, 0x6d, 0x0e, 0x8a, 0x8a, 0x65, 0x1e, 0x61, 0xd7, 0x1b,
+		0xb9, 0x95, 0xec, 0xc3, 0x8c, 0x8d, 0xa3, 0x50, 0x78, 0xb3, 0xbc, 0xfd, 0x71, 0x66, 0x1b, 0xe9,
+		0xb0, 0x1f, 0xc4, 0x36, 0xa6, 0x30, 0x8d, 0x8f, 0x4b, 0x87, 0x30, 0xec, 0x7a, 0xa1, 0x3e, 0x95,
+		0x71, 0x5c, 0x9e, 0xe0, 0x96, 0x47, 0xb1, 0x63, 0x26, 0xe0, 0xcc, 0xe6, 0xa2, 0xd8, 0x82, 0x33,
+		0x4a, 0x4d, 0x75, 0x07, 0x6e, 0x67, 0xc4, 0x40, 0xe4, 0xb9, 0xf4, 0xaf, 0x8e, 0x60, 0x4c, 0x22,
+		0x7b, 0x95, 0x82, 0xf1, 0x25, 0xa0, 0x36, 0xaf, 0x55, 0x27, 0x0c, 0x3b, 0x98, 0x61, 0x29, 0x91,
+		0xee, 0x65, 0x4e, 0xf0, 0x4b, 0x09, 0x36, 0x0b, 0xac, 0x67, 0xa4, 0xf4, 0xdd, 0x3c, 0xac, 0x2b,
+		0xd7, 0x78, 0xa5, 0xe2, 0xf2, 0x16, 0xe4, 0xe5, 0x16, 0x6a, 0xc5, 0x67, 0xae, 0x88, 0x04, 0x24,
+		0x43, 0xc7, 0x4e, 0xac, 0x3e, 0xdb, 0x00, 0xae, 0x3e, 0xa7, 0x33, 0xd4, 0x67, 0x7b, 0x61, 0x5c,
+		0x7d, 0xe2, 0xd4, 0x13, 0xda, 0x86, 0x19, 0xd7, 0x6f, 0x44, 0x4c, 0x9f, 0x19, 0xa1, 0x04, 0x05,
+		0x54, 0xa1, 0x76, 0x66, 0xff, 0x57, 0xb5, 0x33, 0x37, 0x9e, 0xda, 0xa9, 0xc2, 0x5a, 0xc2, 0x17,
+		0x77, 0x38, 0xdb, 0xa3, 0x21, 0xe1, 0x44, 0x34, 0x62, 0x52, 0x7b, 0xae, 0xf5, 0x71, 0x1d, 0xca,
+		0x0f, 0x44, 0xf3, 0x7a, 0x62, 0x5b, 0xa5, 0x07, 0xb1, 0x65, 0x55, 0x18, 0xa2, 0x2f, 0xe0, 0x3a,
+		0x9f, 0xa4, 0x9f, 0x72, 0x61, 0x18, 0xe5, 0x35, 0x6e, 0xd8, 0xc3, 0x77, 0x04, 0xab, 0x35, 0x82,
+		0x03, 0x76, 0x4a, 0x30, 0x6b, 0x53, 0xc1, 0x30, 0xaa, 0x42, 0xdb, 0x26, 0xe1, 0x49, 0xe9, 0xf3,
+		0x58, 0xa8, 0xce, 0x74, 0xf4, 0xf9, 0x6b, 0xd8, 0xe8, 0xce, 0x84, 0x45, 0xcf, 0x2c, 0x56, 0x73,
+		0x43, 0x2b, 0x31, 0x18, 0xae, 0x43, 0x8d, 0xae, 0xcc, 0xfc, 0xea, 0xac, 0x5a, 0x73, 0xc3, 0x3d,
+		0xc9, 0x7f, 0x9c, 0x5e, 0x41, 0xd2, 0xac, 0x96, 0x46, 0xa8, 0x94, 0xce, 0x22, 0x0e, 0x65, 0xd7,
+		0xea, 0xfb, 0x5c, 0x5a, 0x9e, 0xec, 0x73, 0x69, 0x13, 0x56, 0xda, 0x3c, 0xb2, 0xfb, 0xac, 0x88,
+		0x0e, 0x97, 0x0c, 0x1f, 0xf2, 0x51, 0xf4, 0x09, 0xcc, 0xd6, 0x08, 0x76, 0x48, 0xa0, 0x17, 0xe4,
+		0x47, 0x94, 0xf2, 0x63, 0x84, 0x43, 0x4c, 0x09, 0x2d, 0x7d, 0xaf, 0xb5, 0x95, 0x66, 0xba, 0x1b,
+		0x8c, 0xab, 0x34, 0x3f, 0x85, 0x59, 0x21, 0x6d, 0xf4, 0xdc, 0x08, 0xc1, 0x92, 0xd8, 0xac, 0xde,
+		0x57, 0xba, 0x0f, 0x77, 0xb3, 0xfd, 0x92, 0x2d, 0xfb, 0xbb, 0x1c, 0x6c, 0x66, 0x01, 0xf7, 0x5b,
+		0xc7, 0x87, 0xc3, 0xfa, 0xf7, 0x55, 0xf5, 0xb4, 0x4e, 0xd4, 0xa6, 0x27, 0x8c, 0xda, 0x4c, 0x4f,
+		0xd4, 0x7e, 0x04, 0x5b, 0xc3, 0x83, 0x21, 0x23, 0xf7, 0x47, 0x0d, 0x8a, 0x0a, 0xf0, 0x58, 0xb2,
+		0x60, 0x07, 0xe6, 0xce, 0xb0, 0xeb, 0x45, 0x01, 0xc9, 0x4c, 0xfc, 0x91, 0xc0, 0x98, 0x09, 0x38,
+		0x33, 0xf3, 0x9d, 0x93, 0x5a, 0xe5, 0x96, 0x74, 0xfe, 0xdb, 0x1c, 0xdc, 0x1d, 0x88, 0xfa, 0x90,
+		0x73, 0x9e, 0x8a, 0xd8, 0xf4, 0xa4, 0x11, 0xeb, 0xcd, 0xfa, 0x26, 0xdc, 0x1b, 0x12, 0x0b, 0x19,
+		0xb5, 0x3f, 0x69, 0x50, 0x52, 0xd5, 0x07, 0xf6, 0x6d, 0x32, 0x56, 0xd2, 0x93, 0xd6, 0x98, 0x9b,
+		0x54, 0xc7, 0xf5, 0x26, 0xfd, 0x1e, 0xdc, 0xc9, 0x74, 0x4c, 0x2e, 0xe0, 0x77, 0x39, 0xb8, 0x9f,
+		0x81, 0xfb, 0xc0, 0x13, 0x9f, 0x44, 0x6d, 0x7a, 0xd2, 0xa8, 0xf5, 0x26, 0xfe, 0x23, 0xd8, 0x1c,
+		0x1a, 0x8d, 0xae, 0xd4, 0xdb, 0x34, 0xe8, 0x82, 0xbe, 0x48, 0x4e, 0xad, 0x2b, 0x4c, 0xfd, 0x09,
+		0xdc, 0xc9, 0x74, 0x4c, 0xea, 0xd2, 0x8f, 0xa0, 0x60, 0xf3, 0x85, 0x59, 0x81, 0xf0, 0x95, 0x38,
+		0xdc, 0xbf, 0x79, 0x73, 0x45, 0x8c, 0x9b, 0xc9, 0xb0, 0xac, 0x92, 0x81, 0x94, 0xff, 0x6f, 0x55,
+		0x52, 0x85, 0xcd, 0xa1, 0xd1, 0x18, 0x3f, 0xc8, 0xff, 0xe8, 0x1c, 0x1f, 0xfc, 0x66, 0x60, 0x12,
+		0xd9, 0xf0, 0xf3, 0x1e, 0xd9, 0x30, 0xfa, 0x05, 0x44, 0x72, 0x18, 0xbe, 0x82, 0x6b, 0xe2, 0x5f,
+		0x27, 0x56, 0x93, 0x04, 0xfc, 0x6a, 0xc1, 0xf5, 0xcf, 0xa8, 0x3e, 0x35, 0x24, 0x51, 0x24, 0x78,
+		0x25, 0xe0, 0xfc, 0x5b, 0x79, 0xf5, 0xb2, 0x77, 0x28, 0x75, 0x08, 0xa9, 0x16, 0x97, 0x68, 0x0f,
+		0x0d, 0x0c, 0x93, 0x84, 0x84, 0x89, 0x1b, 0xab, 0xf6, 0xd7, 0xdd, 0x95, 0xd4, 0x56, 0xe9, 0x26,
+		0xac, 0x2b, 0x9d, 0x11, 0xce, 0x6e, 0xff, 0x7b, 0x05, 0x16, 0xc4, 0xd2, 0xf7, 0x4e, 0x8e, 0xd1,
+		0x5b, 0xb8, 0xa6, 0xb8, 0x62, 0x41, 0x95, 0xd1, 0x2f, 0x63, 0xf8, 0x1a, 0x8d, 0xb1, 0x6f, 0x6f,
+		0xd0, 0x1f, 0x34, 0xb8, 0x91, 0x75, 0xe9, 0x82, 0x76, 0x27, 0xbd, 0x15, 0x33, 0x1e, 0x4d, 0x7c,
+		0xc3, 0x83, 0xbe, 0xd5, 0x60, 0x6d, 0xe0, 0xfd, 0x00, 0xfa, 0xe9, 0xa8, 0xc4, 0x5d, 0xe2, 0xc9,
+		0xd8, 0x19, 0xd7, 0x4c, 0x3a, 0xd3, 0x49, 0x4e, 0x7a, 0xc7, 0x66, 0x27, 0x47, 0x71, 0x5f, 0x61,
+		0x3c, 0x18, 0xdd, 0xa0, 0x3f, 0x39, 0x4a, 0x01, 0x99, 0x9d, 0x9c, 0xac, 0x2f, 0x08, 0xe3, 0xd1,
+		0x04, 0x96, 0xd2, 0xab, 0x77, 0x6a, 0xa5, 0xda, 0x25, 0x6b, 0xd1, 0xd3, 0xb1, 0xf9, 0x53, 0xe7,
+		0x80, 0xf1, 0x6c, 0x42, 0xeb, 0xfe, 0xf2, 0xe9, 0x97, 0x60, 0xd9, 0xe5, 0x33, 0x50, 0x7b, 0x1b,
+		0x3b, 0xe3, 0x9a, 0x49, 0x67, 0xbe, 0xd7, 0xe0, 0x66, 0xa6, 0x1e, 0x44, 0x8f, 0xc6, 0x63, 0x4e,
+		0x07, 0xea, 0xf1, 0x24, 0xa6, 0xd2, 0xb1, 0xdf, 0x6b, 0xb0, 0xae, 0x40, 0x26, 0x7a, 0x05, 0x7d,
+		0x36, 0x72, 0x12, 0xba, 0x05, 0xab, 0xb1, 0x3b, 0xbe, 0xa1, 0x74, 0xe9, 0xcf, 0x1a, 0xdc, 0x1a,
+		0x22, 0xa1, 0xd0, 0x93, 0x71, 0xd9, 0xd3, 0xf1, 0x7a, 0x3a, 0x99, 0x71, 0x57, 0xc4, 0x06, 0x9e,
+		0xdd, 0x03, 0x23, 0x36, 0x4c, 0xe7, 0x19, 0xbb, 0xe3, 0x1b, 0x76, 0x45, 0x2c, 0x53, 0x4e, 0x0c,
+		0x8c, 0xd8, 0x28, 0x92, 0xcc, 0x78, 0x3a, 0x99, 0x71, 0xff, 0x4e, 0xec, 0x3f, 0xb9, 0xb3, 0x77,
+		0xe2, 0x40, 0x19, 0x63, 0xec, 0x8c, 0x6b, 0xd6, 0x69, 0xe4, 0x8a, 0x23, 0x79, 0x40, 0x23, 0x1f,
+		0xac, 0x24, 0x8c, 0x07, 0xa3, 0x1b, 0x88, 0x99, 0xf7, 0xfd, 0xbf, 0xbd, 0xdf, 0xd0, 0xfe, 0xfe,
+		0x7e, 0x43, 0xfb, 0xe7, 0xfb, 0x0d, 0x0d, 0x7e, 0x68, 0xd3, 0xba, 0x8a, 0x62, 0x1f, 0x09, 0x45,
+		0xf0, 0x52, 0xfc, 0x0a, 0xe5, 0x24, 0xa0, 0x8c, 0x9e, 0x68, 0xdf, 0x3c, 0x3c, 0x77, 0x59, 0x2d,
+		0x3a, 0x2d, 0xdb, 0xb4, 0x5e, 0x49, 0xff, 0xcc, 0xe2, 0x27, 0xae, 0xe3, 0x55, 0xce, 0xa9, 0xf8,
+		0x05, 0x89, 0xfc, 0xcd, 0xc5, 0x13, 0xdc, 0x70, 0x9b, 0x0f, 0x4f, 0x67, 0xf9, 0xd8, 0x27, 0xff,
+		0x1d, 0x00, 0x8d, 0x86, 0x2f, 0x87, 0xe5, 0x22, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6f, 0xdc, 0x44,
-		0x14, 0xc7, 0xf9, 0xb3, 0x9b, 0x7d, 0x49, 0x4a, 0x33, 0x69, 0xd3, 0xa4, 0x4d, 0x9b, 0x74, 0x11,
-		0x29, 0x6d, 0x94, 0xdd, 0x24, 0x2d, 0x55, 0xd5, 0xa2, 0x8a, 0x26, 0x6d, 0xd4, 0x48, 0x6d, 0x1a,
-		0xb9, 0x29, 0x95, 0x90, 0x90, 0x35, 0x19, 0xcf, 0x66, 0x47, 0xf1, 0xda, 0xcb, 0x78, 0x9c, 0xed,
-		0x22, 0x21, 0x71, 0x02, 0x2e, 0x7c, 0x00, 0x24, 0x4e, 0x9c, 0xe0, 0x02, 0x57, 0x10, 0x27, 0x3e,
-		0x02, 0x07, 0xbe, 0x07, 0x12, 0x5f, 0x00, 0xcd, 0x78, 0xec, 0xdd, 0x6c, 0xbc, 0x5e, 0x3b, 0x2d,
-		0xbd, 0xc5, 0xe3, 0xf7, 0x7e, 0xf3, 0x9b, 0x79, 0x33, 0xef, 0xf7, 0x73, 0x16, 0xca, 0xc1, 0x3e,
-		0xe5, 0x55, 0x82, 0x6d, 0xea, 0x12, 0x5a, 0xc5, 0x4d, 0x56, 0x3d, 0x5a, 0xab, 0xda, 0x94, 0x30,
-		0x9f, 0x79, 0x6e, 0xa5, 0xc9, 0x3d, 0xe1, 0xa1, 0x69, 0x19, 0x53, 0xd1, 0x31, 0x15, 0xdc, 0x64,
-		0x95, 0xa3, 0xb5, 0x8b, 0x57, 0x0e, 0x3c, 0xef, 0xc0, 0xa1, 0x55, 0x15, 0xb2, 0x1f, 0xd4, 0xaa,
-		0x76, 0xc0, 0xb1, 0x88, 0x93, 0x2e, 0x2e, 0x26, 0x01, 0x13, 0xaf, 0xd1, 0x88, 0x23, 0x12, 0xa7,
-		0x16, 0xd8, 0x3f, 0x74, 0x98, 0x2f, 0xd2, 0x62, 0x5a, 0x1e, 0x3f, 0xac, 0x39, 0x5e, 0x2b, 0x8c,
-		0x29, 0x7f, 0x3b, 0x05, 0x63, 0x0f, 0x35, 0x63, 0xf4, 0xbd, 0x01, 0x37, 0x7c, 0x52, 0xa7, 0x76,
-		0xe0, 0x50, 0x0b, 0x13, 0xc1, 0x8e, 0x98, 0x68, 0x5b, 0x12, 0xd5, 0x8a, 0x56, 0x65, 0x61, 0x21,
-		0x38, 0xdb, 0x0f, 0x04, 0xf5, 0x67, 0x8d, 0x45, 0xe3, 0x83, 0xf1, 0xf5, 0x7b, 0x95, 0x84, 0x15,
-		0x56, 0x9e, 0x6b, 0x98, 0x07, 0x1a, 0x65, 0x0f, 0xfb, 0x87, 0xd1, 0x3c, 0x0f, 0x62, 0x88, 0xc7,
-		0xef, 0x98, 0x4b, 0x7e, 0xa6, 0x48, 0xf4, 0x05, 0x2c, 0xf8, 0x02, 0x73, 0x61, 0x09, 0xd6, 0xa0,
-		0x3c, 0x91, 0xcf, 0x90, 0xe2, 0xb3, 0x96, 0xcc, 0x47, 0xe6, 0xee, 0xc9, 0xd4, 0x44, 0x16, 0xf3,
-		0x7e, 0xca, 0x7b, 0xf4, 0x93, 0x01, 0x72, 0xf7, 0x9b, 0x0e, 0x15, 0xd4, 0x8a, 0x36, 0xd0, 0xa2,
-		0xaf, 0x28, 0x09, 0x64, 0xd1, 0x12, 0xc9, 0x0c, 0x2b, 0x32, 0x1f, 0x27, 0x92, 0xd9, 0xd4, 0x58,
-		0x2f, 0x35, 0xd4, 0xa3, 0x08, 0x29, 0x91, 0xdb, 0x32, 0xc9, 0x1e, 0x8e, 0x7e, 0x30, 0x60, 0xb9,
-		0x86, 0x99, 0x93, 0x95, 0xe6, 0x88, 0xa2, 0xf9, 0x51, 0x22, 0xcd, 0x2d, 0xcc, 0x9c, 0x6c, 0x14,
-		0xaf, 0xd5, 0xb2, 0x85, 0xa2, 0x9f, 0x0d, 0x58, 0xe5, 0xf4, 0xf3, 0x80, 0xfa, 0xc2, 0x22, 0xd8,
-		0x25, 0xd4, 0xc9, 0x70, 0xce, 0x46, 0x53, 0xb6, 0xd2, 0x0c, 0xc1, 0x36, 0x15, 0xd6, 0xc0, 0xc3,
-		0xb6, 0xcc, 0xb3, 0x87, 0xa3, 0x2f, 0x61, 0x51, 0x53, 0xec, 0x7f, 0xe4, 0x0a, 0x8a, 0xda, 0x7a,
-		0x72, 0x95, 0x55, 0x72, 0xff, 0x33, 0x77, 0x99, 0xa4, 0x05, 0xa0, 0x1f, 0x0d, 0x58, 0xd1, 0xf3,
-		0x67, 0xac, 0x65, 0x51, 0x91, 0xb9, 0x9f, 0x42, 0x26, 0x5b, 0x35, 0xaf, 0x93, 0xac, 0xc1, 0xe8,
-		0x2f, 0x03, 0xee, 0xf7, 0xd4, 0x93, 0xbe, 0x12, 0x94, 0xbb, 0x38, 0x33, 0xeb, 0x31, 0xc5, 0xfa,
-		0xe9, 0xe0, 0xea, 0x3e, 0xd2, 0xc0, 0xd9, 0x16, 0x71, 0x87, 0x9f, 0x32, 0x17, 0x7d, 0x65, 0xc0,
-		0x55, 0x4e, 0x89, 0xc7, 0x6d, 0xab, 0x81, 0xf9, 0x61, 0x9f, 0xca, 0x97, 0x14, 0xed, 0x9b, 0x7d,
-		0x68, 0xcb, 0xec, 0xa7, 0x2a, 0x39, 0x91, 0xdc, 0x15, 0x9e, 0x1a, 0x81, 0x7e, 0x37, 0xe0, 0x36,
-		0xf1, 0x5c, 0xc1, 0xdc, 0x80, 0x5a, 0xd8, 0xb7, 0x5c, 0xda, 0xca, 0xba, 0x9d, 0xa0, 0x78, 0x3d,
-		0xea, 0xd3, 0x77, 0x42, 0xc8, 0x07, 0xfe, 0x0e, 0x6d, 0x65, 0xdb, 0xc6, 0x55, 0x92, 0x33, 0x07,
-		0xfd, 0x6a, 0xc0, 0x7a, 0xd8, 0xa9, 0x49, 0x9d, 0x39, 0x76, 0x56, 0xde, 0xe3, 0x8a, 0xf7, 0x46,
-		0xff, 0xe6, 0xbd, 0x29, 0xd1, 0xb2, 0x91, 0x5e, 0xf1, 0xf3, 0x24, 0xa0, 0x3f, 0x0c, 0xb8, 0xed,
-		0xb3, 0x03, 0x79, 0x66, 0xf3, 0x1e, 0xde, 0x09, 0xc5, 0x7a, 0x2b, 0x99, 0xb5, 0x82, 0xcc, 0x77,
-		0x6a, 0xd7, 0xfc, 0xbc, 0x49, 0xe8, 0x37, 0x03, 0x3e, 0x0c, 0x9a, 0x3e, 0xe5, 0xa2, 0x43, 0xda,
-		0xa7, 0x98, 0x93, 0x7a, 0x17, 0xd1, 0x44, 0xf2, 0x93, 0x29, 0x47, 0xe5, 0x85, 0x42, 0x8c, 0xe6,
-		0x7f, 0xae, 0xf0, 0x3a, 0x93, 0x26, 0x1f, 0x95, 0x20, 0x67, 0xce, 0xc6, 0x04, 0x40, 0x87, 0x4e,
-		0xf9, 0xbb, 0x02, 0x2c, 0x65, 0xb3, 0x0d, 0x68, 0x01, 0xc6, 0x63, 0xd9, 0x60, 0xb6, 0x32, 0x22,
-		0x25, 0x13, 0xa2, 0xa1, 0x6d, 0x1b, 0x6d, 0xc1, 0x64, 0x47, 0x57, 0xda, 0x4d, 0xaa, 0xbd, 0xc1,
-		0xd5, 0xc4, 0xb5, 0xc6, 0x93, 0xb5, 0x9b, 0xd4, 0x9c, 0xc0, 0x5d, 0x4f, 0x68, 0x06, 0x0a, 0xb6,
-		0xd7, 0xc0, 0xcc, 0x55, 0x7a, 0x5e, 0x32, 0xf5, 0x13, 0xba, 0x0b, 0x25, 0x25, 0x57, 0xd2, 0x6d,
-		0x69, 0x0d, 0xbd, 0x9c, 0x88, 0x2d, 0x17, 0xf0, 0x84, 0xf9, 0xc2, 0x1c, 0x13, 0xfa, 0x2f, 0xb4,
-		0x0e, 0xa3, 0xcc, 0x6d, 0x06, 0x42, 0xeb, 0xda, 0x7c, 0x62, 0xde, 0x2e, 0x6e, 0x3b, 0x1e, 0xb6,
-		0xcd, 0x30, 0x14, 0xed, 0xc1, 0x5c, 0x6c, 0xcc, 0x84, 0x67, 0x11, 0xc7, 0xf3, 0xa9, 0x92, 0x25,
-		0x2f, 0x10, 0x5a, 0x84, 0xe6, 0x2a, 0xa1, 0xa9, 0xac, 0x44, 0xa6, 0xb2, 0xf2, 0x50, 0x9b, 0x4a,
-		0x73, 0x26, 0xca, 0xdd, 0xf3, 0x36, 0x65, 0xe6, 0x5e, 0x98, 0xd8, 0x8b, 0xda, 0xf1, 0x57, 0x12,
-		0xb5, 0x98, 0x03, 0x35, 0x76, 0x57, 0x12, 0x75, 0x07, 0x66, 0x34, 0x52, 0x2f, 0xd1, 0xb1, 0x41,
-		0x90, 0xd3, 0xa1, 0x0d, 0x3b, 0xce, 0x72, 0x0b, 0xa6, 0xea, 0x14, 0x73, 0xb1, 0x4f, 0x71, 0x87,
-		0x5d, 0x69, 0x10, 0xd4, 0xd9, 0x38, 0x27, 0xc2, 0xd9, 0x84, 0x09, 0x4e, 0x05, 0x6f, 0x5b, 0x4d,
-		0xcf, 0x61, 0xa4, 0xad, 0x3b, 0xce, 0x62, 0x9f, 0x0e, 0x2e, 0x78, 0x7b, 0x57, 0xc5, 0x99, 0xe3,
-		0xbc, 0xf3, 0x80, 0x6e, 0x42, 0xa1, 0x4e, 0xb1, 0x4d, 0xb9, 0xbe, 0xfa, 0x97, 0x12, 0xd3, 0x1f,
-		0xab, 0x10, 0x53, 0x87, 0xa2, 0x5b, 0x30, 0x13, 0x89, 0xa4, 0xe3, 0x11, 0xec, 0x58, 0x36, 0xf3,
-		0x9b, 0x58, 0x90, 0xba, 0xba, 0x82, 0x63, 0xe6, 0x39, 0xfd, 0xf6, 0x89, 0x7c, 0xf9, 0x50, 0xbf,
-		0x2b, 0x7f, 0x63, 0xc0, 0x7c, 0x9a, 0x6d, 0x45, 0x73, 0x30, 0x16, 0x3a, 0x93, 0xf8, 0x0a, 0x14,
-		0xd5, 0xf3, 0xb6, 0x8d, 0x9e, 0xc0, 0xf9, 0xb8, 0x06, 0x35, 0xc6, 0x3b, 0x25, 0x18, 0x1a, 0xb4,
-		0x6f, 0x48, 0x97, 0x60, 0x8b, 0xf1, 0xa8, 0x02, 0x65, 0x02, 0xcb, 0x39, 0x2c, 0x2b, 0xba, 0x05,
-		0x05, 0x4e, 0xfd, 0xc0, 0x11, 0xfa, 0x0b, 0x21, 0xfd, 0x84, 0xeb, 0xd8, 0x32, 0x86, 0x6b, 0x19,
-		0x0d, 0x27, 0xba, 0x0d, 0x45, 0x69, 0x38, 0x03, 0x4e, 0x53, 0x67, 0xd8, 0x0a, 0x63, 0xcc, 0x28,
-		0xb8, 0xbc, 0x03, 0xcb, 0x39, 0xfc, 0xe2, 0xc0, 0x2e, 0x53, 0xbe, 0x0b, 0x97, 0x53, 0x4d, 0x5e,
-		0x4a, 0x85, 0xca, 0x04, 0xae, 0x67, 0xf6, 0x64, 0x72, 0xc1, 0x36, 0x15, 0x98, 0x39, 0x7e, 0xa6,
-		0x2d, 0x8d, 0x82, 0xcb, 0xff, 0x1a, 0x70, 0xe7, 0xb4, 0x1e, 0xaa, 0xab, 0xf7, 0x19, 0xc7, 0x7a,
-		0xdf, 0x0b, 0x40, 0x27, 0xd5, 0x51, 0x1f, 0xac, 0xa5, 0x44, 0x5e, 0x27, 0x66, 0x33, 0xa7, 0x5a,
-		0xbd, 0x43, 0x68, 0x16, 0x8a, 0xd2, 0x6b, 0x70, 0xcf, 0x51, 0xbd, 0x76, 0xc2, 0x8c, 0x1e, 0x51,
-		0x05, 0xa6, 0x7b, 0xac, 0x84, 0xe7, 0x3a, 0x6d, 0xd5, 0x76, 0xc7, 0xcc, 0x29, 0xd2, 0x2d, 0xf3,
-		0xcf, 0x5c, 0xa7, 0x5d, 0xfe, 0xc5, 0x80, 0x2b, 0xe9, 0x16, 0x4c, 0x96, 0x56, 0x7b, 0x3b, 0x17,
-		0x37, 0x68, 0x54, 0xda, 0x70, 0x68, 0x07, 0x37, 0x68, 0xf7, 0x8e, 0x0f, 0xe5, 0xd8, 0xf1, 0xae,
-		0xfe, 0x30, 0x9c, 0xb9, 0x3f, 0x94, 0xff, 0x29, 0xc2, 0x6a, 0x5e, 0x6f, 0x26, 0x25, 0x2e, 0xde,
-		0x0f, 0x25, 0x71, 0x46, 0x8a, 0xc4, 0x45, 0x80, 0xa1, 0xc4, 0xb5, 0xba, 0x9e, 0x8e, 0x4b, 0xd9,
-		0xd0, 0x29, 0xa5, 0x6c, 0x38, 0xbb, 0x94, 0x61, 0x58, 0xec, 0x78, 0xaa, 0x3e, 0x42, 0x31, 0x32,
-		0xa8, 0x4b, 0xcd, 0xc7, 0x10, 0xcf, 0x13, 0x14, 0xe3, 0x25, 0x5c, 0x52, 0x4b, 0xea, 0x83, 0x3e,
-		0x3a, 0x08, 0xfd, 0x82, 0xcc, 0x4e, 0x02, 0x7e, 0x06, 0x33, 0xfb, 0x98, 0x1c, 0x7a, 0xb5, 0x9a,
-		0xc6, 0x66, 0xae, 0xa0, 0xfc, 0x08, 0x3b, 0x83, 0x35, 0xf8, 0x9c, 0x4e, 0x54, 0xb0, 0xdb, 0x3a,
-		0xed, 0x84, 0x26, 0x15, 0x4f, 0xa3, 0x49, 0xdb, 0x50, 0x62, 0x2e, 0x13, 0x0c, 0x0b, 0x8f, 0x2b,
-		0x8d, 0x3d, 0xb3, 0xbe, 0x3c, 0xd8, 0xff, 0x6f, 0x47, 0x29, 0x66, 0x27, 0xbb, 0xbb, 0xb3, 0x96,
-		0x72, 0x74, 0x56, 0x64, 0xc2, 0x8c, 0x83, 0xe5, 0x37, 0x60, 0x28, 0x13, 0xb2, 0xb4, 0x5a, 0x02,
-		0x20, 0xc3, 0xc9, 0x38, 0x27, 0x73, 0x37, 0xe3, 0x54, 0x53, 0x65, 0xa2, 0xf7, 0x60, 0x92, 0x70,
-		0x79, 0x46, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x32, 0x27, 0xe4, 0x60, 0xe4, 0x13, 0x4f, 0xa7, 0xc7,
-		0x2b, 0x30, 0xd2, 0xa0, 0x0d, 0x4f, 0x1b, 0xe0, 0xb9, 0xc4, 0x94, 0xa7, 0xb4, 0xe1, 0x99, 0x2a,
-		0x0c, 0x99, 0x30, 0x75, 0xc2, 0x50, 0xcf, 0x9e, 0x51, 0xb9, 0xef, 0x27, 0x3b, 0xff, 0x1e, 0xeb,
-		0x6b, 0x9e, 0xf5, 0x7b, 0x46, 0xca, 0x7f, 0x17, 0x61, 0x25, 0xd7, 0x67, 0x4d, 0xdf, 0x76, 0xbc,
-		0x00, 0xe3, 0x71, 0x1f, 0x60, 0xb6, 0xba, 0xc1, 0x25, 0x13, 0xa2, 0xa1, 0xd0, 0x0b, 0x1f, 0x6f,
-		0x14, 0xc3, 0x6f, 0xa0, 0x51, 0xbc, 0x05, 0xcf, 0x9b, 0xa5, 0x51, 0x14, 0xfe, 0xd7, 0x46, 0x51,
-		0x3c, 0x75, 0xa3, 0xf8, 0x04, 0xa6, 0x9b, 0x98, 0x53, 0x57, 0x68, 0x44, 0x7d, 0xbd, 0xc3, 0xcb,
-		0xb9, 0xd4, 0x67, 0xf5, 0x32, 0x5e, 0xa1, 0xe8, 0x4b, 0x3e, 0xd5, 0xec, 0x1d, 0xea, 0x16, 0xc9,
-		0xd2, 0x71, 0x91, 0x24, 0x30, 0xdb, 0x75, 0x0c, 0x2c, 0x4e, 0x83, 0xce, 0xb4, 0xa0, 0xa6, 0xbd,
-		0x91, 0x5a, 0xf0, 0x6d, 0xdb, 0x94, 0x29, 0x7a, 0xea, 0xf3, 0xad, 0xa4, 0xe1, 0x37, 0x63, 0xa1,
-		0x4f, 0xdc, 0xeb, 0x89, 0xd4, 0x7b, 0x3d, 0x99, 0xff, 0x5e, 0x9f, 0x79, 0x8d, 0x7b, 0xfd, 0xee,
-		0xeb, 0xdd, 0xeb, 0x3f, 0x87, 0x60, 0x2d, 0xf7, 0x87, 0xff, 0xdb, 0xb6, 0x5a, 0x0b, 0x30, 0xae,
-		0xff, 0xdf, 0xa1, 0xdc, 0x4f, 0xf8, 0x69, 0x0b, 0xe1, 0x90, 0x72, 0x3f, 0xf1, 0x75, 0x1d, 0xc9,
-		0x7e, 0x5d, 0xbb, 0x8e, 0xe6, 0x68, 0x26, 0xff, 0x56, 0xe8, 0xe7, 0xdf, 0xbe, 0x36, 0x60, 0x35,
-		0xef, 0xff, 0x1f, 0x92, 0x8b, 0x69, 0xbc, 0x56, 0x31, 0x37, 0x3e, 0x83, 0x0b, 0xc4, 0x6b, 0x24,
-		0x65, 0x6f, 0x4c, 0x46, 0x14, 0x76, 0x65, 0x53, 0xd8, 0x35, 0x3e, 0x5d, 0x3b, 0x60, 0xa2, 0x1e,
-		0xec, 0x57, 0x88, 0xd7, 0xa8, 0x76, 0xff, 0xee, 0xb2, 0xc2, 0x6c, 0xa7, 0x7a, 0xe0, 0x85, 0x3f,
-		0xf5, 0xe8, 0x1f, 0x61, 0xee, 0xe1, 0x26, 0x3b, 0x5a, 0xdb, 0x2f, 0xa8, 0xb1, 0x9b, 0xff, 0x05,
-		0x00, 0x00, 0xff, 0xff, 0x85, 0xa3, 0xd5, 0xe5, 0x47, 0x1a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcf, 0x4f, 0xdc, 0x46,
+		0x1b, 0xfe, 0xcc, 0x8f, 0x5d, 0xf6, 0x05, 0xf2, 0x85, 0x21, 0x21, 0x90, 0x10, 0x20, 0xfb, 0xe9,
+		0x23, 0x4d, 0x10, 0xbb, 0x40, 0xd2, 0x28, 0x4a, 0xaa, 0xa8, 0x81, 0x04, 0x05, 0x29, 0x21, 0xc8,
+		0x21, 0x8d, 0xd4, 0x8b, 0x35, 0x8c, 0x07, 0x76, 0x84, 0xd7, 0xb3, 0x1d, 0x8f, 0xd9, 0x6c, 0xa5,
+		0x4a, 0x3d, 0xb5, 0xbd, 0xf4, 0x0f, 0xa8, 0xd4, 0x53, 0x4f, 0xed, 0xa5, 0xbd, 0xb6, 0xea, 0xa9,
+		0xb7, 0x1e, 0xdb, 0x43, 0xef, 0x55, 0xfe, 0x82, 0x4a, 0xfd, 0x07, 0x2a, 0x8f, 0xc7, 0xde, 0x65,
+		0xf1, 0x7a, 0x6d, 0x92, 0xe6, 0x86, 0xc7, 0xef, 0xfb, 0xcc, 0x33, 0xf3, 0xce, 0xbc, 0xcf, 0x63,
+		0x16, 0xca, 0xfe, 0x1e, 0x15, 0x55, 0x82, 0x6d, 0xea, 0x12, 0x5a, 0xc5, 0x0d, 0x56, 0x3d, 0x5a,
+		0xad, 0xda, 0x94, 0x30, 0x8f, 0x71, 0xb7, 0xd2, 0x10, 0x5c, 0x72, 0x34, 0x19, 0xc4, 0x54, 0x74,
+		0x4c, 0x05, 0x37, 0x58, 0xe5, 0x68, 0xf5, 0xe2, 0xdc, 0x01, 0xe7, 0x07, 0x0e, 0xad, 0xaa, 0x90,
+		0x3d, 0x7f, 0xbf, 0x6a, 0xfb, 0x02, 0xcb, 0x38, 0xe9, 0xe2, 0x42, 0x12, 0x30, 0xe1, 0xf5, 0x7a,
+		0x1c, 0x91, 0x38, 0xb5, 0xc4, 0xde, 0xa1, 0xc3, 0x3c, 0x99, 0x16, 0xd3, 0xe4, 0xe2, 0x70, 0xdf,
+		0xe1, 0xcd, 0x30, 0xa6, 0xfc, 0xc5, 0x04, 0x8c, 0x3c, 0xd0, 0x8c, 0xd1, 0x57, 0x06, 0x5c, 0xf7,
+		0x48, 0x8d, 0xda, 0xbe, 0x43, 0x2d, 0x4c, 0x24, 0x3b, 0x62, 0xb2, 0x65, 0x05, 0xa8, 0x56, 0xb4,
+		0x2a, 0x0b, 0x4b, 0x29, 0xd8, 0x9e, 0x2f, 0xa9, 0x37, 0x6d, 0x2c, 0x18, 0xef, 0x8c, 0xae, 0xdd,
+		0xad, 0x24, 0xac, 0xb0, 0xf2, 0x4c, 0xc3, 0xdc, 0xd7, 0x28, 0xbb, 0xd8, 0x3b, 0x8c, 0xe6, 0xb9,
+		0x1f, 0x43, 0x3c, 0xfa, 0x8f, 0xb9, 0xe8, 0x65, 0x8a, 0x44, 0x1f, 0xc3, 0xbc, 0x27, 0xb1, 0x90,
+		0x96, 0x64, 0x75, 0x2a, 0x12, 0xf9, 0x0c, 0x28, 0x3e, 0xab, 0xc9, 0x7c, 0x82, 0xdc, 0xdd, 0x20,
+		0x35, 0x91, 0xc5, 0xac, 0x97, 0xf2, 0x1e, 0x7d, 0x6b, 0x40, 0xb0, 0xfb, 0x0d, 0x87, 0x4a, 0x6a,
+		0x45, 0x1b, 0x68, 0xd1, 0x97, 0x94, 0xf8, 0x41, 0xd1, 0x12, 0xc9, 0x0c, 0x2a, 0x32, 0xef, 0x27,
+		0x92, 0xd9, 0xd0, 0x58, 0x2f, 0x34, 0xd4, 0xc3, 0x08, 0x29, 0x91, 0xdb, 0x12, 0xc9, 0x1e, 0x8e,
+		0xbe, 0x36, 0x60, 0x69, 0x1f, 0x33, 0x27, 0x2b, 0xcd, 0x21, 0x45, 0xf3, 0xbd, 0x44, 0x9a, 0x9b,
+		0x98, 0x39, 0xd9, 0x28, 0x5e, 0xdd, 0xcf, 0x16, 0x8a, 0xbe, 0x33, 0x60, 0x45, 0xd0, 0x8f, 0x7c,
+		0xea, 0x49, 0x8b, 0x60, 0x97, 0x50, 0x27, 0xc3, 0x39, 0x1b, 0x4e, 0xd9, 0x4a, 0x33, 0x04, 0xdb,
+		0x50, 0x58, 0x7d, 0x0f, 0xdb, 0x92, 0xc8, 0x1e, 0x8e, 0x3e, 0x81, 0x05, 0x4d, 0xb1, 0xf7, 0x91,
+		0x2b, 0x28, 0x6a, 0x6b, 0xc9, 0x55, 0x56, 0xc9, 0xbd, 0xcf, 0xdc, 0x65, 0x92, 0x16, 0x80, 0xbe,
+		0x31, 0x60, 0x59, 0xcf, 0x9f, 0xb1, 0x96, 0x45, 0x45, 0xe6, 0x5e, 0x0a, 0x99, 0x6c, 0xd5, 0xbc,
+		0x46, 0xb2, 0x06, 0xa3, 0xdf, 0x0d, 0xb8, 0xd7, 0x55, 0x4f, 0xfa, 0x52, 0x52, 0xe1, 0xe2, 0xcc,
+		0xac, 0x47, 0x14, 0xeb, 0x27, 0xfd, 0xab, 0xfb, 0x50, 0x03, 0x67, 0x5b, 0xc4, 0x6d, 0x71, 0xca,
+		0x5c, 0xf4, 0xa9, 0x01, 0x57, 0x04, 0x25, 0x5c, 0xd8, 0x56, 0x1d, 0x8b, 0xc3, 0x1e, 0x95, 0x2f,
+		0x29, 0xda, 0x37, 0x7a, 0xd0, 0x0e, 0xb2, 0x9f, 0xa8, 0xe4, 0x44, 0x72, 0x73, 0x22, 0x35, 0x02,
+		0xfd, 0x64, 0xc0, 0x2d, 0xc2, 0x5d, 0xc9, 0x5c, 0x9f, 0x5a, 0xd8, 0xb3, 0x5c, 0xda, 0xcc, 0xba,
+		0x9d, 0xa0, 0x78, 0x3d, 0xec, 0xd1, 0x77, 0x42, 0xc8, 0xfb, 0xde, 0x36, 0x6d, 0x66, 0xdb, 0xc6,
+		0x15, 0x92, 0x33, 0x07, 0xfd, 0x60, 0xc0, 0x5a, 0xd8, 0xa9, 0x49, 0x8d, 0x39, 0x76, 0x56, 0xde,
+		0xa3, 0x8a, 0xf7, 0x7a, 0xef, 0xe6, 0xbd, 0x11, 0xa0, 0x65, 0x23, 0xbd, 0xec, 0xe5, 0x49, 0x40,
+		0x3f, 0x1b, 0x70, 0xcb, 0x63, 0x07, 0x2e, 0xce, 0x7f, 0x78, 0xc7, 0x14, 0xeb, 0xcd, 0x64, 0xd6,
+		0x0a, 0x32, 0xdf, 0xa9, 0x5d, 0xf5, 0xf2, 0x26, 0xa1, 0x1f, 0x0d, 0x78, 0xd7, 0x6f, 0x78, 0x54,
+		0xc8, 0x36, 0x69, 0x8f, 0x62, 0x41, 0x6a, 0x1d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0x1c, 0x95, 0xe7,
+		0x0a, 0x31, 0x9a, 0xff, 0x99, 0xc2, 0x6b, 0x4f, 0x9a, 0x7c, 0x54, 0xfc, 0x9c, 0x39, 0xeb, 0x63,
+		0x00, 0x6d, 0x3a, 0xe5, 0x2f, 0x0b, 0xb0, 0x98, 0xcd, 0x36, 0xa0, 0x79, 0x18, 0x8d, 0x65, 0x83,
+		0xd9, 0xca, 0x88, 0x94, 0x4c, 0x88, 0x86, 0xb6, 0x6c, 0xb4, 0x09, 0xe3, 0x71, 0x80, 0x6c, 0x35,
+		0xa8, 0xf6, 0x06, 0x57, 0x12, 0xd7, 0x1a, 0x4f, 0xd6, 0x6a, 0x50, 0x73, 0x0c, 0x77, 0x3c, 0xa1,
+		0x29, 0x28, 0xd8, 0xbc, 0x8e, 0x99, 0xab, 0xf4, 0xbc, 0x64, 0xea, 0x27, 0x74, 0x07, 0x4a, 0x4a,
+		0xae, 0x02, 0xb7, 0xa5, 0x35, 0xf4, 0x72, 0x22, 0x76, 0xb0, 0x80, 0xc7, 0xcc, 0x93, 0xe6, 0x88,
+		0xd4, 0x7f, 0xa1, 0x35, 0x18, 0x66, 0x6e, 0xc3, 0x97, 0x5a, 0xd7, 0x66, 0x13, 0xf3, 0x76, 0x70,
+		0xcb, 0xe1, 0xd8, 0x36, 0xc3, 0x50, 0xb4, 0x0b, 0x33, 0xb1, 0x31, 0x93, 0xdc, 0x22, 0x0e, 0xf7,
+		0xa8, 0x92, 0x25, 0xee, 0x4b, 0x2d, 0x42, 0x33, 0x95, 0xd0, 0x54, 0x56, 0x22, 0x53, 0x59, 0x79,
+		0xa0, 0x4d, 0xa5, 0x39, 0x15, 0xe5, 0xee, 0xf2, 0x8d, 0x20, 0x73, 0x37, 0x4c, 0xec, 0x46, 0x6d,
+		0xfb, 0xab, 0x00, 0xb5, 0x98, 0x03, 0x35, 0x76, 0x57, 0x01, 0xea, 0x36, 0x4c, 0x69, 0xa4, 0x6e,
+		0xa2, 0x23, 0xfd, 0x20, 0x27, 0x43, 0x1b, 0x76, 0x9c, 0xe5, 0x26, 0x4c, 0xd4, 0x28, 0x16, 0x72,
+		0x8f, 0xe2, 0x36, 0xbb, 0x52, 0x3f, 0xa8, 0xb3, 0x71, 0x4e, 0x84, 0xb3, 0x01, 0x63, 0x82, 0x4a,
+		0xd1, 0xb2, 0x1a, 0xdc, 0x61, 0xa4, 0xa5, 0x3b, 0xce, 0x42, 0x8f, 0x0e, 0x2e, 0x45, 0x6b, 0x47,
+		0xc5, 0x99, 0xa3, 0xa2, 0xfd, 0x80, 0x6e, 0x40, 0xa1, 0x46, 0xb1, 0x4d, 0x85, 0xbe, 0xfa, 0x97,
+		0x12, 0xd3, 0x1f, 0xa9, 0x10, 0x53, 0x87, 0xa2, 0x9b, 0x30, 0x15, 0x89, 0xa4, 0xc3, 0x09, 0x76,
+		0x2c, 0x9b, 0x79, 0x0d, 0x2c, 0x49, 0x4d, 0x5d, 0xc1, 0x11, 0xf3, 0x9c, 0x7e, 0xfb, 0x38, 0x78,
+		0xf9, 0x40, 0xbf, 0x2b, 0x7f, 0x6e, 0xc0, 0x6c, 0x9a, 0x6d, 0x45, 0x33, 0x30, 0x12, 0x3a, 0x93,
+		0xf8, 0x0a, 0x14, 0xd5, 0xf3, 0x96, 0x8d, 0x1e, 0xc3, 0xf9, 0xb8, 0x06, 0xfb, 0x4c, 0xb4, 0x4b,
+		0x30, 0xd0, 0x6f, 0xdf, 0x90, 0x2e, 0xc1, 0x26, 0x13, 0x51, 0x05, 0xca, 0x04, 0x96, 0x72, 0x58,
+		0x56, 0x74, 0x13, 0x0a, 0x82, 0x7a, 0xbe, 0x23, 0xa7, 0x8d, 0x0c, 0x27, 0x5c, 0xc7, 0x96, 0x31,
+		0x5c, 0xcd, 0x68, 0x38, 0xd1, 0x2d, 0x28, 0x06, 0x86, 0xd3, 0x17, 0x34, 0x75, 0x86, 0xcd, 0x30,
+		0xc6, 0x8c, 0x82, 0xcb, 0xdb, 0xb0, 0x94, 0xc3, 0x2f, 0xf6, 0xed, 0x32, 0xe5, 0x3b, 0x70, 0x39,
+		0xd5, 0xe4, 0xa5, 0x54, 0xa8, 0x4c, 0xe0, 0x5a, 0x66, 0x4f, 0x16, 0x2c, 0xd8, 0xa6, 0x12, 0x33,
+		0xc7, 0xcb, 0xb4, 0xa5, 0x51, 0x70, 0xf9, 0x6f, 0x03, 0x6e, 0x9f, 0xd6, 0x43, 0x75, 0xf4, 0x3e,
+		0xe3, 0x58, 0xef, 0x7b, 0x0e, 0xe8, 0xa4, 0x3a, 0xea, 0x83, 0xb5, 0x98, 0xc8, 0xeb, 0xc4, 0x6c,
+		0xe6, 0x44, 0xb3, 0x7b, 0x08, 0x4d, 0x43, 0x91, 0x70, 0x57, 0x0a, 0xee, 0xa8, 0x5e, 0x3b, 0x66,
+		0x46, 0x8f, 0xa8, 0x02, 0x93, 0x5d, 0x56, 0x82, 0xbb, 0x4e, 0x4b, 0xb5, 0xdd, 0x11, 0x73, 0x82,
+		0x74, 0xca, 0xfc, 0x53, 0xd7, 0x69, 0x95, 0xbf, 0x37, 0x60, 0x2e, 0xdd, 0x82, 0x05, 0xa5, 0xd5,
+		0xde, 0xce, 0xc5, 0x75, 0x1a, 0x95, 0x36, 0x1c, 0xda, 0xc6, 0x75, 0xda, 0xb9, 0xe3, 0x03, 0x39,
+		0x76, 0xbc, 0xa3, 0x3f, 0x0c, 0x66, 0xee, 0x0f, 0xe5, 0xbf, 0x8a, 0xb0, 0x92, 0xd7, 0x9b, 0x05,
+		0x12, 0x17, 0xef, 0x87, 0x92, 0x38, 0x23, 0x45, 0xe2, 0x22, 0xc0, 0x50, 0xe2, 0x9a, 0x1d, 0x4f,
+		0xc7, 0xa5, 0x6c, 0xe0, 0x94, 0x52, 0x36, 0x98, 0x5d, 0xca, 0x30, 0x2c, 0xb4, 0x3d, 0x55, 0x0f,
+		0xa1, 0x18, 0xea, 0xd7, 0xa5, 0x66, 0x63, 0x88, 0x67, 0x09, 0x8a, 0xf1, 0x02, 0x2e, 0xa9, 0x25,
+		0xf5, 0x40, 0x1f, 0xee, 0x87, 0x7e, 0x21, 0xc8, 0x4e, 0x02, 0x7e, 0x0a, 0x53, 0x7b, 0x98, 0x1c,
+		0xf2, 0xfd, 0x7d, 0x8d, 0xcd, 0x5c, 0x49, 0xc5, 0x11, 0x76, 0xfa, 0x6b, 0xf0, 0x39, 0x9d, 0xa8,
+		0x60, 0xb7, 0x74, 0xda, 0x09, 0x4d, 0x2a, 0x9e, 0x46, 0x93, 0xb6, 0xa0, 0xc4, 0x5c, 0x26, 0x19,
+		0x96, 0x5c, 0x28, 0x8d, 0x3d, 0xb3, 0xb6, 0xd4, 0xdf, 0xff, 0x6f, 0x45, 0x29, 0x66, 0x3b, 0xbb,
+		0xb3, 0xb3, 0x96, 0x72, 0x74, 0x56, 0x64, 0xc2, 0x94, 0x83, 0x83, 0x6f, 0xc0, 0x50, 0x26, 0x82,
+		0xd2, 0x6a, 0x09, 0x80, 0x0c, 0x27, 0xe3, 0x5c, 0x90, 0xbb, 0x11, 0xa7, 0x9a, 0x2a, 0x13, 0xfd,
+		0x0f, 0xc6, 0x89, 0x08, 0xce, 0x88, 0xb6, 0x19, 0x4a, 0xb0, 0x4b, 0xe6, 0x58, 0x30, 0x18, 0xf9,
+		0xc4, 0xd3, 0xe9, 0xf1, 0x32, 0x0c, 0xd5, 0x69, 0x9d, 0x6b, 0x03, 0x3c, 0x93, 0x98, 0xf2, 0x84,
+		0xd6, 0xb9, 0xa9, 0xc2, 0x90, 0x09, 0x13, 0x27, 0x0c, 0xf5, 0xf4, 0x19, 0x95, 0xfb, 0xff, 0x64,
+		0xe7, 0xdf, 0x65, 0x7d, 0xcd, 0xb3, 0x5e, 0xd7, 0x48, 0xf9, 0x8f, 0x22, 0x2c, 0xe7, 0xfa, 0xac,
+		0xe9, 0xd9, 0x8e, 0xe7, 0x61, 0x34, 0xee, 0x03, 0xcc, 0x56, 0x37, 0xb8, 0x64, 0x42, 0x34, 0x14,
+		0x7a, 0xe1, 0xe3, 0x8d, 0x62, 0xf0, 0x0d, 0x34, 0x8a, 0xb7, 0xe0, 0x79, 0xb3, 0x34, 0x8a, 0xc2,
+		0xbf, 0xda, 0x28, 0x8a, 0xa7, 0x6e, 0x14, 0x1f, 0xc0, 0x64, 0x03, 0x0b, 0xea, 0x4a, 0x8d, 0xa8,
+		0xaf, 0x77, 0x78, 0x39, 0x17, 0x7b, 0xac, 0x3e, 0x88, 0x57, 0x28, 0xfa, 0x92, 0x4f, 0x34, 0xba,
+		0x87, 0x3a, 0x45, 0xb2, 0x74, 0x5c, 0x24, 0x09, 0x4c, 0x77, 0x1c, 0x03, 0x4b, 0x50, 0xbf, 0x3d,
+		0x2d, 0xa8, 0x69, 0xaf, 0xa7, 0x16, 0x7c, 0xcb, 0x36, 0xa9, 0x1f, 0xcd, 0x63, 0x9e, 0x6f, 0x26,
+		0x0d, 0xbf, 0x19, 0x0b, 0x7d, 0xe2, 0x5e, 0x8f, 0xa5, 0xde, 0xeb, 0xf1, 0xfc, 0xf7, 0xfa, 0xcc,
+		0x6b, 0xdc, 0xeb, 0xff, 0xbe, 0xde, 0xbd, 0xfe, 0x65, 0x00, 0x56, 0x73, 0x7f, 0xf8, 0xbf, 0x6d,
+		0xab, 0x35, 0x0f, 0xa3, 0xfa, 0xff, 0x1d, 0xca, 0xfd, 0x84, 0x9f, 0xb6, 0x10, 0x0e, 0x29, 0xf7,
+		0x13, 0x5f, 0xd7, 0xa1, 0xec, 0xd7, 0xb5, 0xe3, 0x68, 0x0e, 0x67, 0xf2, 0x6f, 0x85, 0x5e, 0xfe,
+		0xed, 0x33, 0x03, 0x56, 0xf2, 0xfe, 0xff, 0x21, 0xb9, 0x98, 0xc6, 0x6b, 0x15, 0x73, 0x9d, 0xfd,
+		0xfa, 0x6a, 0xce, 0xf8, 0xed, 0xd5, 0x9c, 0xf1, 0xe7, 0xab, 0x39, 0x03, 0x2e, 0x10, 0x5e, 0x4f,
+		0x42, 0x5a, 0x1f, 0x8f, 0xe8, 0xec, 0x08, 0x2e, 0xf9, 0x8e, 0xf1, 0xe1, 0xea, 0x01, 0x93, 0x35,
+		0x7f, 0xaf, 0x42, 0x78, 0xbd, 0xda, 0xf9, 0x1b, 0xcc, 0x32, 0xb3, 0x9d, 0xea, 0x01, 0x0f, 0x7f,
+		0xf6, 0xd1, 0x3f, 0xc8, 0xdc, 0xc5, 0x0d, 0x76, 0xb4, 0xba, 0x57, 0x50, 0x63, 0x37, 0xfe, 0x19,
+		0x00, 0x16, 0xba, 0xcb, 0x89, 0x53, 0x1a, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/cluster.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x6d, 0x8b, 0xd3, 0x40,
-		0x10, 0x26, 0x17, 0x6b, 0xdb, 0xe9, 0xe1, 0x9d, 0x8b, 0x87, 0x45, 0x11, 0x6b, 0x04, 0xa9, 0x88,
-		0x09, 0x3d, 0x39, 0xc4, 0x17, 0x44, 0x7a, 0x2a, 0xf6, 0x83, 0x2f, 0xac, 0xdf, 0xfc, 0x12, 0x36,
-		0x9b, 0x69, 0xbb, 0x5c, 0x76, 0xf7, 0xd8, 0x6c, 0x0a, 0x05, 0xff, 0x82, 0xff, 0x47, 0xff, 0x9d,
-		0x64, 0x37, 0x09, 0x27, 0xf6, 0xf0, 0xbe, 0xcd, 0xcc, 0x3e, 0xcf, 0xb3, 0xcf, 0xcc, 0x30, 0xf0,
-		0xb0, 0xca, 0xd0, 0x24, 0x9c, 0xe5, 0xa8, 0x38, 0x26, 0x2c, 0x97, 0x42, 0x25, 0x9b, 0x59, 0xc2,
-		0x8b, 0xaa, 0xb4, 0x68, 0xe2, 0x73, 0xa3, 0xad, 0x26, 0x47, 0x35, 0x28, 0x6e, 0x40, 0xb1, 0x03,
-		0xc5, 0x9b, 0x59, 0xf4, 0x08, 0x06, 0x1f, 0x75, 0x69, 0x17, 0x6a, 0xa9, 0xc9, 0x1d, 0x18, 0x88,
-		0x1c, 0x95, 0x15, 0x76, 0x3b, 0x0e, 0x26, 0xc1, 0x74, 0x48, 0xbb, 0x3c, 0xfa, 0x01, 0x03, 0x2a,
-		0xd4, 0xca, 0xe1, 0x08, 0x5c, 0x33, 0xba, 0xc0, 0x06, 0xe3, 0x62, 0xf2, 0x00, 0xf6, 0x25, 0xca,
-		0x0c, 0x4d, 0xca, 0x75, 0xa5, 0xec, 0x78, 0x6f, 0x12, 0x4c, 0x7b, 0x74, 0xe4, 0x6b, 0xa7, 0x75,
-		0x89, 0xbc, 0x80, 0xbe, 0x4f, 0xcb, 0x71, 0x38, 0x09, 0xa7, 0xa3, 0xe3, 0xfb, 0xf1, 0x4e, 0x4f,
-		0x71, 0x6b, 0x88, 0xb6, 0xf8, 0xe8, 0x57, 0x00, 0x37, 0x3e, 0xf9, 0x78, 0x2d, 0xce, 0x9d, 0x89,
-		0x39, 0xec, 0xf3, 0xca, 0x18, 0x54, 0x36, 0x5d, 0xeb, 0xd2, 0x3a, 0x33, 0x57, 0x90, 0x1c, 0x35,
-		0xa4, 0xba, 0x40, 0x9e, 0xc0, 0x4d, 0x83, 0x8c, 0xaf, 0x59, 0x56, 0x60, 0xda, 0x7a, 0xdb, 0x9b,
-		0x84, 0xd3, 0x21, 0x3d, 0xec, 0x1e, 0x9a, 0x7f, 0xc9, 0x09, 0xf4, 0x8c, 0x50, 0xab, 0xff, 0x99,
-		0x6f, 0xa7, 0x44, 0x3d, 0x3a, 0xfa, 0x19, 0xc0, 0xc1, 0x3b, 0x2d, 0x99, 0x50, 0xa7, 0x8c, 0xaf,
-		0xd1, 0x79, 0x7f, 0x09, 0x77, 0x55, 0x25, 0x53, 0xbd, 0x4c, 0x85, 0x45, 0x59, 0xa6, 0x42, 0xa5,
-		0xbc, 0x7e, 0x4c, 0xb3, 0x6d, 0x2a, 0x72, 0xd7, 0x4a, 0x48, 0x8f, 0x54, 0x25, 0xbf, 0x2c, 0x17,
-		0x35, 0x60, 0xe1, 0xb9, 0xf3, 0xed, 0x22, 0x27, 0x6f, 0xe0, 0xde, 0xa5, 0x5c, 0xc5, 0x24, 0xba,
-		0xc9, 0x87, 0xf4, 0xf6, 0x0e, 0xf6, 0x67, 0x26, 0x31, 0x7a, 0x0d, 0xe4, 0x2b, 0x9a, 0x52, 0x94,
-		0xb6, 0xf6, 0xfd, 0x0d, 0xad, 0x15, 0x6a, 0x45, 0x0e, 0x21, 0x3c, 0xc3, 0x76, 0xeb, 0x75, 0x48,
-		0x6e, 0x41, 0x6f, 0xc3, 0x8a, 0xca, 0xeb, 0x0d, 0xa9, 0x4f, 0xa2, 0xb7, 0x7f, 0xb1, 0x3f, 0x20,
-		0xb3, 0x95, 0xc1, 0x1d, 0xec, 0x31, 0xf4, 0x51, 0xd5, 0xd3, 0xcb, 0x1d, 0x7f, 0x40, 0xdb, 0x34,
-		0xfa, 0x1d, 0xc0, 0xc1, 0x05, 0x09, 0x37, 0x8f, 0x31, 0xf4, 0x33, 0xc6, 0xcf, 0x50, 0xe5, 0x8d,
-		0x46, 0x9b, 0x92, 0xf7, 0x30, 0x28, 0xbd, 0x45, 0xbf, 0x98, 0xd1, 0xf1, 0xe3, 0x4b, 0xe6, 0xfe,
-		0x6f, 0x53, 0xb4, 0xa3, 0xd6, 0x32, 0x4b, 0xef, 0xb5, 0x5d, 0xdf, 0x15, 0x64, 0x9a, 0xee, 0x68,
-		0x47, 0x9d, 0x3f, 0xff, 0x7e, 0xb2, 0x12, 0x76, 0x5d, 0x65, 0x31, 0xd7, 0x32, 0xb9, 0x78, 0x75,
-		0x4f, 0x45, 0x5e, 0x24, 0x2b, 0x9d, 0xb8, 0x5b, 0xeb, 0x4e, 0xf0, 0x95, 0x0b, 0x36, 0xb3, 0xec,
-		0xba, 0xab, 0x3f, 0xfb, 0x13, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x49, 0xfd, 0x6d, 0xaa, 0x03, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x8a, 0xd3, 0x40,
+		0x14, 0x26, 0x1b, 0x6b, 0xdb, 0xd3, 0xc5, 0x5d, 0x07, 0x17, 0x8b, 0x62, 0xad, 0x11, 0xa4, 0x22,
+		0x26, 0x74, 0x65, 0x2f, 0xfc, 0x41, 0xa4, 0x55, 0xb1, 0x17, 0xfe, 0x30, 0xde, 0x79, 0x13, 0x26,
+		0xc9, 0x69, 0x3b, 0x6c, 0x67, 0x66, 0x99, 0x4c, 0x0a, 0x05, 0x5f, 0xc1, 0xf7, 0xd1, 0x37, 0xf0,
+		0xd2, 0x47, 0x90, 0x3e, 0x89, 0x64, 0x26, 0x09, 0x2b, 0x76, 0x71, 0xef, 0xce, 0x39, 0xf3, 0x7d,
+		0xdf, 0x7c, 0xe7, 0x1c, 0x0e, 0xdc, 0x2f, 0x12, 0xd4, 0x51, 0xca, 0x32, 0x94, 0x29, 0x46, 0x2c,
+		0x13, 0x5c, 0x46, 0xeb, 0x71, 0x94, 0xae, 0x8a, 0xdc, 0xa0, 0x0e, 0xcf, 0xb4, 0x32, 0x8a, 0x1c,
+		0x95, 0xa0, 0xb0, 0x02, 0x85, 0x16, 0x14, 0xae, 0xc7, 0xc1, 0x03, 0xe8, 0xbc, 0x53, 0xb9, 0x99,
+		0xc9, 0xb9, 0x22, 0xb7, 0xa0, 0xc3, 0x33, 0x94, 0x86, 0x9b, 0x4d, 0xdf, 0x1b, 0x7a, 0xa3, 0x2e,
+		0x6d, 0xf2, 0xe0, 0x2b, 0x74, 0x28, 0x97, 0x0b, 0x8b, 0x23, 0x70, 0x45, 0xab, 0x15, 0x56, 0x18,
+		0x1b, 0x93, 0x7b, 0xb0, 0x2f, 0x50, 0x24, 0xa8, 0xe3, 0x54, 0x15, 0xd2, 0xf4, 0xf7, 0x86, 0xde,
+		0xa8, 0x45, 0x7b, 0xae, 0x36, 0x2d, 0x4b, 0xe4, 0x29, 0xb4, 0x5d, 0x9a, 0xf7, 0xfd, 0xa1, 0x3f,
+		0xea, 0x1d, 0xdf, 0x0d, 0x77, 0x7a, 0x0a, 0x6b, 0x43, 0xb4, 0xc6, 0x07, 0xdf, 0x3d, 0xb8, 0xf6,
+		0xde, 0xc5, 0x4b, 0x7e, 0x66, 0x4d, 0x4c, 0x60, 0x3f, 0x2d, 0xb4, 0x46, 0x69, 0xe2, 0xa5, 0xca,
+		0x8d, 0x35, 0x73, 0x09, 0xc9, 0x5e, 0x45, 0x2a, 0x0b, 0xe4, 0x11, 0x5c, 0xd7, 0xc8, 0xd2, 0x25,
+		0x4b, 0x56, 0x18, 0xd7, 0xde, 0xf6, 0x86, 0xfe, 0xa8, 0x4b, 0x0f, 0x9b, 0x87, 0xea, 0x5f, 0x72,
+		0x02, 0x2d, 0xcd, 0xe5, 0xe2, 0x7f, 0xe6, 0xeb, 0x29, 0x51, 0x87, 0x0e, 0xbe, 0x79, 0x70, 0xf0,
+		0x5a, 0x09, 0xc6, 0xe5, 0x94, 0xa5, 0x4b, 0xb4, 0xde, 0x9f, 0xc1, 0x6d, 0x59, 0x88, 0x58, 0xcd,
+		0x63, 0x6e, 0x50, 0xe4, 0x31, 0x97, 0x71, 0x5a, 0x3e, 0xc6, 0xc9, 0x26, 0xe6, 0x99, 0x6d, 0xc5,
+		0xa7, 0x47, 0xb2, 0x10, 0x1f, 0xe7, 0xb3, 0x12, 0x30, 0x73, 0xdc, 0xc9, 0x66, 0x96, 0x91, 0x97,
+		0x70, 0xe7, 0x42, 0xae, 0x64, 0x02, 0xed, 0xe4, 0x7d, 0x7a, 0x73, 0x07, 0xfb, 0x03, 0x13, 0x18,
+		0xbc, 0x00, 0xf2, 0x09, 0x75, 0xce, 0x73, 0x53, 0xfa, 0xfe, 0x8c, 0xc6, 0x70, 0xb9, 0x20, 0x87,
+		0xe0, 0x9f, 0x62, 0xbd, 0xf5, 0x32, 0x24, 0x37, 0xa0, 0xb5, 0x66, 0xab, 0xc2, 0xe9, 0x75, 0xa9,
+		0x4b, 0x82, 0x57, 0x7f, 0xb1, 0xdf, 0x22, 0x33, 0x85, 0xc6, 0x1d, 0xec, 0x3e, 0xb4, 0x51, 0x96,
+		0xd3, 0xcb, 0x2c, 0xbf, 0x43, 0xeb, 0x34, 0xf8, 0xe1, 0xc1, 0xc1, 0x39, 0x09, 0x3b, 0x8f, 0x3e,
+		0xb4, 0x13, 0x96, 0x9e, 0xa2, 0xcc, 0x2a, 0x8d, 0x3a, 0x25, 0x6f, 0xa0, 0x93, 0x3b, 0x8b, 0x6e,
+		0x31, 0xbd, 0xe3, 0x87, 0x17, 0xcc, 0xfd, 0xdf, 0xa6, 0x68, 0x43, 0x2d, 0x65, 0xe6, 0xce, 0x6b,
+		0xbd, 0xbe, 0x4b, 0xc8, 0x54, 0xdd, 0xd1, 0x86, 0x3a, 0x99, 0xfe, 0xdc, 0x0e, 0xbc, 0x5f, 0xdb,
+		0x81, 0xf7, 0x7b, 0x3b, 0xf0, 0xbe, 0x9c, 0x2c, 0xb8, 0x59, 0x16, 0x49, 0x98, 0x2a, 0x11, 0x9d,
+		0xbf, 0xc0, 0xc7, 0x3c, 0x5b, 0x45, 0x0b, 0x15, 0xd9, 0xbb, 0x6b, 0xce, 0xf1, 0xb9, 0x0d, 0xd6,
+		0xe3, 0xe4, 0xaa, 0xad, 0x3f, 0xf9, 0x33, 0x00, 0x2c, 0xce, 0x7e, 0x67, 0xb6, 0x03, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/history.proto
 	[]byte{
@@ -3629,287 +3833,290 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x32, 0x50, 0xae, 0x52, 0x09, 0x17, 0x1f, 0xaa, 0x51, 0x42, 0x8a, 0x5c, 0x3c, 0x49, 0x45, 0x89,
 		0x79, 0xc9, 0x19, 0xf1, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0x60, 0xa3, 0x78, 0x82, 0xb8, 0x21, 0x62,
 		0x21, 0x20, 0x21, 0x21, 0x7b, 0x2e, 0xd6, 0xcc, 0x92, 0xd4, 0xdc, 0x62, 0x09, 0x26, 0x05, 0x66,
-		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
-		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
-		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
-		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x14, 0xd7, 0xd4, 0x3e,
-		0x01, 0x00, 0x00,
+		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0xf3,
+		0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x18, 0x65, 0x9a, 0x9e,
+		0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x1c, 0x2a, 0xba, 0x99, 0x29, 0x39,
+		0xfa, 0xe9, 0xf9, 0xfa, 0xe0, 0xb0, 0x80, 0x07, 0x91, 0x35, 0x98, 0x51, 0x66, 0x98, 0xc4, 0x06,
+		0x16, 0x37, 0x06, 0x0c, 0x00, 0x36, 0x1c, 0xeb, 0x51, 0x4a, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/queue.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-		0x15, 0x36, 0x25, 0xff, 0x90, 0x9f, 0x1d, 0x87, 0x9e, 0x6c, 0x1a, 0xe5, 0x87, 0x11, 0x45, 0x0b,
-		0x24, 0x82, 0x37, 0x91, 0x6a, 0x7b, 0x83, 0x14, 0xdd, 0xa2, 0x2d, 0x43, 0x31, 0x16, 0xd7, 0xb2,
-		0x24, 0x0c, 0xa9, 0x24, 0x5e, 0x14, 0x18, 0xd0, 0xe2, 0xd8, 0x21, 0x42, 0x91, 0x5a, 0x92, 0x72,
-		0xaa, 0x3f, 0xa0, 0xe9, 0xbd, 0xdb, 0x6b, 0x2f, 0xbd, 0x16, 0xe8, 0xa9, 0x3d, 0x14, 0xbd, 0x16,
-		0xe8, 0xa9, 0xd7, 0xed, 0x21, 0xff, 0x44, 0xff, 0x84, 0x82, 0x43, 0x4a, 0xa2, 0x28, 0x4a, 0xa2,
-		0x13, 0x17, 0xe8, 0x61, 0x6f, 0xd6, 0xe3, 0x37, 0x8f, 0xdf, 0xfb, 0xde, 0xcc, 0xa7, 0x37, 0x16,
-		0x3c, 0xe8, 0x9f, 0x52, 0xa7, 0xd2, 0xd1, 0x74, 0x6a, 0x75, 0x68, 0x45, 0xd3, 0xbb, 0x86, 0x55,
-		0xb9, 0xd8, 0xab, 0x7c, 0xdb, 0xa7, 0x7d, 0x5a, 0xee, 0x39, 0xb6, 0x67, 0xa3, 0x9b, 0x3e, 0xa4,
-		0x1c, 0x42, 0xca, 0x0c, 0x52, 0xbe, 0xd8, 0xbb, 0x73, 0xff, 0xdc, 0xb6, 0xcf, 0x4d, 0x5a, 0x61,
-		0xa0, 0xd3, 0xfe, 0x59, 0xc5, 0x33, 0xba, 0xd4, 0xf5, 0xb4, 0x6e, 0x2f, 0x58, 0x77, 0xa7, 0x30,
-		0x99, 0xba, 0x67, 0xf8, 0x89, 0x3b, 0x76, 0xb7, 0x6b, 0x5b, 0x21, 0xe2, 0x41, 0x12, 0xe2, 0x8d,
-		0xe1, 0x7a, 0xb6, 0x33, 0x08, 0x21, 0xc5, 0x24, 0xc8, 0x3b, 0xdb, 0x79, 0x7b, 0x66, 0xda, 0xef,
-		0x02, 0x4c, 0xf1, 0x43, 0x06, 0x3e, 0x13, 0x1d, 0xdb, 0x75, 0x45, 0xb3, 0xef, 0x7a, 0xd4, 0x51,
-		0x35, 0xf7, 0xad, 0x6c, 0x9d, 0xd9, 0xe8, 0x2e, 0xac, 0xeb, 0x76, 0x57, 0x33, 0x2c, 0x62, 0xe8,
-		0x79, 0xae, 0xc0, 0x95, 0xd6, 0x71, 0x2e, 0x08, 0xc8, 0x3a, 0x6a, 0x03, 0x1a, 0xe6, 0x21, 0xf4,
-		0xd7, 0xb4, 0xd3, 0xf7, 0x0c, 0xdb, 0xca, 0x67, 0x0a, 0x5c, 0x69, 0x63, 0xff, 0x61, 0x79, 0xb2,
-		0xe6, 0x9e, 0x51, 0xbe, 0xd8, 0x2b, 0xbf, 0x0a, 0xe1, 0xd2, 0x10, 0x8d, 0xb7, 0xdf, 0xc5, 0x43,
-		0xa8, 0x06, 0xeb, 0x9e, 0xe6, 0xbe, 0x25, 0xde, 0xa0, 0x47, 0xf3, 0xd9, 0x02, 0x57, 0xda, 0xda,
-		0xff, 0xa2, 0x9c, 0xa8, 0x60, 0x39, 0xce, 0x59, 0x1d, 0xf4, 0x28, 0xce, 0x79, 0xe1, 0x5f, 0x68,
-		0x07, 0x80, 0x65, 0x72, 0x3d, 0xcd, 0xa3, 0xf9, 0xe5, 0x02, 0x57, 0x5a, 0xc1, 0x2c, 0xb7, 0xe2,
-		0x07, 0xd0, 0x2d, 0x58, 0x63, 0x8f, 0x0d, 0x3d, 0xbf, 0x52, 0xe0, 0x4a, 0x59, 0xbc, 0xea, 0x7f,
-		0x94, 0x75, 0x74, 0x0c, 0x9f, 0x5d, 0x18, 0xae, 0x71, 0x6a, 0x98, 0x86, 0x37, 0x20, 0xa3, 0xae,
-		0xe4, 0x57, 0x59, 0x69, 0x77, 0xca, 0x41, 0xdf, 0xca, 0xc3, 0xbe, 0x95, 0xd5, 0x21, 0x02, 0xdf,
-		0x18, 0xaf, 0x1b, 0x05, 0x8b, 0xdf, 0x67, 0xe0, 0xc7, 0x51, 0xa6, 0x8a, 0xa7, 0x39, 0x9e, 0xf8,
-		0xc6, 0x30, 0xf5, 0xb1, 0x0e, 0xf4, 0xdb, 0x3e, 0x75, 0x3d, 0xc1, 0xf3, 0x1c, 0xe3, 0xb4, 0xef,
-		0x51, 0x17, 0x95, 0x80, 0xf7, 0x34, 0xe7, 0x9c, 0x7a, 0x24, 0xde, 0x80, 0xad, 0x20, 0x5e, 0x1d,
-		0xb6, 0x61, 0x07, 0xc0, 0x09, 0x96, 0xfb, 0x98, 0x0c, 0xc3, 0xac, 0x87, 0x11, 0x59, 0x47, 0x8f,
-		0x01, 0x19, 0x96, 0xe1, 0x19, 0x9a, 0x47, 0x75, 0x42, 0x2f, 0xa8, 0xc5, 0x60, 0x59, 0x56, 0x30,
-		0x3f, 0x7a, 0x22, 0xf9, 0x0f, 0x64, 0x1d, 0xbd, 0xe7, 0xe0, 0x4e, 0x1c, 0xae, 0x8d, 0x58, 0x31,
-		0x0d, 0x37, 0xf6, 0x6b, 0x89, 0xcd, 0x1d, 0x97, 0x35, 0xd5, 0x66, 0x79, 0xe2, 0x35, 0xe3, 0x2a,
-		0x71, 0xde, 0x98, 0xf1, 0x04, 0x15, 0xe1, 0x5a, 0x58, 0xbf, 0xd3, 0xb7, 0x86, 0x2d, 0x5a, 0xc7,
-		0x1b, 0x41, 0x10, 0xf7, 0x2d, 0x59, 0x2f, 0x7e, 0x0d, 0x7b, 0x0b, 0x75, 0x75, 0x7b, 0xb6, 0xe5,
-		0xd2, 0x48, 0xe2, 0x9b, 0xb0, 0x1a, 0x66, 0x0c, 0xe4, 0x5c, 0x71, 0x58, 0xae, 0xbf, 0x65, 0xe0,
-		0x71, 0x34, 0x99, 0xa8, 0x59, 0x1d, 0x6a, 0x5e, 0x49, 0x83, 0x4e, 0xe1, 0x76, 0x88, 0xfc, 0xe4,
-		0xe3, 0x72, 0x2b, 0x48, 0x34, 0xf5, 0x20, 0xb6, 0x09, 0xb2, 0xe9, 0x36, 0xc1, 0xf2, 0x8c, 0x4d,
-		0x50, 0x86, 0x1b, 0x1d, 0x5f, 0xc6, 0x31, 0x5f, 0xdb, 0x32, 0x07, 0xac, 0x03, 0x39, 0xbc, 0xdd,
-		0x89, 0xb6, 0xb8, 0x69, 0x99, 0x83, 0x62, 0x05, 0x9e, 0xcc, 0x95, 0x2e, 0xde, 0x83, 0xe2, 0x5f,
-		0xb3, 0x93, 0x62, 0x2b, 0xc6, 0xb9, 0xa5, 0xfd, 0x20, 0x76, 0x1a, 0xb1, 0xd1, 0x7d, 0xd8, 0x70,
-		0x99, 0x5c, 0xc4, 0xd2, 0xba, 0x94, 0x79, 0xd2, 0x3a, 0x86, 0x20, 0xd4, 0xd0, 0xba, 0x14, 0xfd,
-		0x02, 0x36, 0x43, 0x80, 0x61, 0xf5, 0xfa, 0x5e, 0x7e, 0x8d, 0x15, 0x7d, 0x2f, 0xb1, 0xe8, 0x96,
-		0x36, 0x30, 0x6d, 0x4d, 0xc7, 0x61, 0x4a, 0xd9, 0x5f, 0x80, 0xf2, 0xb0, 0xd6, 0xb1, 0x2d, 0xcf,
-		0xb1, 0xcd, 0x7c, 0xae, 0xc0, 0x95, 0x36, 0xf1, 0xf0, 0x63, 0xbc, 0xd1, 0x53, 0x6d, 0x9b, 0x6a,
-		0xf4, 0xbf, 0x32, 0x20, 0x44, 0x57, 0x60, 0xda, 0xb1, 0x1d, 0x3d, 0xd9, 0x24, 0x44, 0xbb, 0xdb,
-		0x33, 0xa9, 0x47, 0xff, 0xdf, 0xbb, 0x7f, 0x39, 0x43, 0xad, 0x03, 0xdf, 0x09, 0x0a, 0x33, 0x6c,
-		0x2b, 0x80, 0x87, 0x2e, 0xfa, 0x20, 0x91, 0x48, 0x2d, 0xf8, 0xf2, 0x66, 0xcb, 0xf1, 0xf5, 0xf1,
-		0x52, 0x16, 0x28, 0x56, 0xe1, 0xf9, 0xe5, 0xe5, 0x9c, 0xea, 0xca, 0x7f, 0x38, 0x28, 0x08, 0xbd,
-		0x9e, 0x39, 0x68, 0x69, 0x0e, 0xb5, 0x3c, 0xd1, 0xb4, 0x5d, 0xda, 0xb2, 0x4d, 0xa3, 0x33, 0x88,
-		0x88, 0xfe, 0x10, 0xae, 0x07, 0xfb, 0x32, 0xae, 0xf9, 0x35, 0x16, 0x1e, 0x49, 0xbe, 0x0b, 0xdb,
-		0xb1, 0xfd, 0x3b, 0xfa, 0x16, 0xba, 0x3e, 0xb1, 0x7b, 0x65, 0x1d, 0x15, 0x60, 0x33, 0xc0, 0x86,
-		0x0e, 0x1c, 0x1c, 0x1d, 0x60, 0x31, 0x66, 0xe9, 0xe8, 0x25, 0xdc, 0xe8, 0x31, 0x52, 0xa4, 0xe3,
-		0xb3, 0x22, 0x3d, 0x46, 0x8b, 0x29, 0xb6, 0x35, 0xa3, 0x75, 0x53, 0x45, 0xe0, 0xed, 0x5e, 0x3c,
-		0x54, 0xfc, 0x8e, 0x83, 0x7b, 0xc9, 0x25, 0xfb, 0xb3, 0x40, 0xdf, 0x45, 0xf7, 0x60, 0x3d, 0x14,
-		0x9b, 0x06, 0x85, 0xe6, 0xf0, 0x38, 0x80, 0xda, 0xb0, 0x79, 0xa6, 0x19, 0x26, 0xd5, 0x49, 0x47,
-		0xeb, 0xbb, 0x94, 0xd5, 0xb7, 0xb5, 0xbf, 0x9f, 0x72, 0x2c, 0x79, 0xc1, 0x96, 0x8a, 0xfe, 0x4a,
-		0xbc, 0x71, 0x36, 0xfe, 0x50, 0xfc, 0x3b, 0x07, 0x3b, 0xc9, 0xac, 0xc2, 0x43, 0x80, 0x8e, 0x61,
-		0x85, 0xa9, 0xc3, 0x28, 0x6d, 0xec, 0x3f, 0x9b, 0xf1, 0xc6, 0x45, 0xdd, 0xc4, 0x41, 0x16, 0x74,
-		0x04, 0xab, 0x2e, 0xab, 0x37, 0x3c, 0x0c, 0x07, 0x97, 0xca, 0x17, 0x48, 0x85, 0xc3, 0x14, 0xc5,
-		0xdf, 0x72, 0x70, 0x10, 0x2d, 0x75, 0x6e, 0x25, 0x91, 0x9d, 0xd5, 0x82, 0x1c, 0x63, 0xe3, 0x50,
-		0x2b, 0xcf, 0x15, 0xb2, 0xa5, 0x8d, 0xfd, 0x2f, 0x2f, 0x45, 0x23, 0xcc, 0x88, 0x47, 0x59, 0x8a,
-		0xff, 0x98, 0xd9, 0x5d, 0x4c, 0xdd, 0xbe, 0x79, 0xe5, 0x32, 0xfe, 0x8f, 0xb6, 0xc3, 0xef, 0x39,
-		0xf8, 0x32, 0x8d, 0xa0, 0x53, 0x33, 0xcd, 0xaf, 0xc2, 0xb3, 0xea, 0x50, 0x8b, 0x84, 0xfd, 0x0d,
-		0x84, 0x3d, 0xb8, 0xa4, 0xb0, 0xbe, 0x58, 0x78, 0x6b, 0x98, 0x2b, 0xe8, 0x77, 0xf1, 0x0f, 0x6b,
-		0x70, 0x2b, 0x5e, 0xc3, 0x70, 0x7f, 0x0e, 0x87, 0x75, 0xc3, 0x3a, 0xb3, 0x43, 0x71, 0xd3, 0x0e,
-		0xeb, 0xfe, 0x05, 0x23, 0x18, 0xd6, 0xd9, 0x55, 0xe3, 0x77, 0x1c, 0x14, 0x5c, 0x7f, 0x82, 0x23,
-		0x81, 0x45, 0x8c, 0x7c, 0x3b, 0x3a, 0x7f, 0x06, 0xbb, 0xf6, 0x30, 0xc5, 0x1b, 0xd2, 0x0c, 0xd9,
-		0xb5, 0x25, 0xbc, 0xe3, 0x4e, 0xe3, 0x22, 0xc2, 0xfe, 0x86, 0x83, 0xbb, 0x1d, 0x36, 0xce, 0x24,
-		0xf3, 0xc9, 0x32, 0x3e, 0x62, 0x0a, 0x3e, 0x8b, 0xe6, 0xc9, 0xda, 0x12, 0xbe, 0xdd, 0x99, 0xc4,
-		0xc4, 0x78, 0x84, 0x5f, 0xea, 0x89, 0x3c, 0x96, 0x53, 0xf3, 0x58, 0x34, 0x6a, 0xf9, 0x3c, 0xdc,
-		0x49, 0x4c, 0x84, 0xc7, 0xf7, 0x1c, 0x7c, 0xe5, 0xb0, 0x2f, 0x1d, 0x12, 0x33, 0xfd, 0x31, 0xad,
-		0xa1, 0x6b, 0x92, 0xe1, 0x8c, 0x14, 0xe1, 0xb9, 0xc2, 0x78, 0xbe, 0x4e, 0xc1, 0xf3, 0xa3, 0x26,
-		0x85, 0xda, 0x12, 0x7e, 0xea, 0x7c, 0xd4, 0x88, 0xf1, 0x27, 0x0e, 0x1e, 0x6b, 0xfe, 0xa1, 0x20,
-		0x09, 0x5f, 0x3f, 0x49, 0x95, 0x04, 0x77, 0xc1, 0xaf, 0x53, 0x54, 0x92, 0xd2, 0x16, 0x6b, 0x4b,
-		0xf8, 0x91, 0x96, 0x0e, 0xfa, 0x7c, 0x13, 0x60, 0x4c, 0xa5, 0xf8, 0x97, 0x1c, 0xe4, 0xa7, 0xcf,
-		0x67, 0x60, 0x12, 0xd1, 0x4b, 0x2e, 0x37, 0x71, 0xc9, 0x9d, 0xb8, 0x66, 0x67, 0xae, 0xee, 0x9a,
-		0x9d, 0x8d, 0x5f, 0xb3, 0xe3, 0x66, 0xb9, 0x7c, 0x25, 0x66, 0x89, 0xbe, 0x4b, 0xe3, 0x17, 0x2b,
-		0x89, 0xf7, 0xd5, 0xd4, 0x7e, 0x11, 0x37, 0xda, 0xc5, 0x86, 0xf1, 0x7e, 0x81, 0x61, 0x04, 0xdb,
-		0xa6, 0xfa, 0x31, 0x86, 0x91, 0x40, 0x66, 0x8e, 0x63, 0xbc, 0x5f, 0xe0, 0x18, 0x6b, 0xa9, 0x89,
-		0x2c, 0x9c, 0xf2, 0xe7, 0x5b, 0xc6, 0xbf, 0x3f, 0xd1, 0x32, 0x72, 0x8c, 0xe8, 0xc9, 0x95, 0x59,
-		0x46, 0x02, 0xfb, 0x8f, 0xf4, 0x8c, 0x3f, 0x73, 0xf0, 0x64, 0x9e, 0x67, 0x04, 0x6f, 0x8a, 0xd6,
-		0xb2, 0xce, 0x6a, 0x39, 0xfa, 0x04, 0xd3, 0x48, 0x60, 0x5f, 0xd2, 0x52, 0x62, 0x63, 0xb6, 0x61,
-		0x27, 0xb9, 0x06, 0xab, 0xd1, 0x45, 0x0a, 0x5c, 0x63, 0x47, 0x3a, 0xec, 0xc7, 0x70, 0x9c, 0x28,
-		0xa7, 0x3c, 0xb4, 0xc3, 0x09, 0x6d, 0xd3, 0x8b, 0x24, 0xdd, 0x7d, 0xcf, 0x41, 0x6e, 0x68, 0x1f,
-		0xe8, 0x26, 0x6c, 0xab, 0x82, 0x72, 0x44, 0xd4, 0x93, 0x96, 0x44, 0xe4, 0xc6, 0x4b, 0xa1, 0x2e,
-		0x57, 0xf9, 0x25, 0xf4, 0x23, 0x40, 0xe3, 0xb0, 0x8a, 0x85, 0x86, 0xf2, 0x42, 0xc2, 0x3c, 0x87,
-		0x6e, 0xc0, 0xf5, 0x48, 0x5c, 0x3e, 0x96, 0x30, 0x9f, 0x41, 0xb7, 0xe1, 0xe6, 0x38, 0x88, 0xa5,
-		0x56, 0x5d, 0x16, 0x05, 0x55, 0x6e, 0x36, 0xf8, 0x2c, 0xba, 0x0b, 0xb7, 0xc6, 0x8f, 0x44, 0xdc,
-		0x54, 0x14, 0x22, 0xd6, 0xdb, 0x8a, 0x2a, 0x61, 0x7e, 0x79, 0xf7, 0x9f, 0x09, 0xff, 0xee, 0x64,
-		0xa4, 0x3e, 0x87, 0xfb, 0x13, 0x58, 0x92, 0x44, 0x71, 0x0f, 0x9e, 0xcc, 0x02, 0x29, 0xaa, 0x80,
-		0x55, 0x22, 0xd6, 0xe4, 0x7a, 0x95, 0x48, 0xaf, 0x25, 0xb1, 0xcd, 0xd8, 0x70, 0xe8, 0x31, 0x94,
-		0x66, 0x2d, 0x11, 0x85, 0x86, 0x28, 0xd5, 0x23, 0xe8, 0xcc, 0x3c, 0xb4, 0x22, 0x1f, 0x36, 0x84,
-		0x28, 0x3a, 0x8b, 0xaa, 0xf0, 0xcb, 0x59, 0x68, 0x2c, 0x89, 0x4d, 0x5c, 0x0d, 0xf9, 0xbc, 0x6a,
-		0xe2, 0xa3, 0xa3, 0x7a, 0xf3, 0xd5, 0x78, 0x31, 0x11, 0x9b, 0xc7, 0xad, 0xba, 0xa4, 0x4a, 0xfc,
-		0x32, 0x7a, 0x0a, 0x7b, 0xb3, 0xb2, 0x08, 0xad, 0x56, 0xfd, 0x84, 0xb4, 0x04, 0x2c, 0x35, 0x54,
-		0x22, 0xd6, 0x9b, 0x8a, 0x44, 0x5a, 0xcd, 0xba, 0x2c, 0x9e, 0xf0, 0x2b, 0xbb, 0x7f, 0xcc, 0xc2,
-		0xdd, 0x39, 0x8e, 0x8d, 0xbe, 0x80, 0x47, 0x09, 0x69, 0x5f, 0x08, 0x72, 0x5d, 0xaa, 0x12, 0x51,
-		0x68, 0x2b, 0x51, 0x61, 0x93, 0x39, 0x4c, 0x80, 0xab, 0xcd, 0x63, 0x41, 0x6e, 0x90, 0x46, 0x53,
-		0x25, 0x82, 0xa8, 0xca, 0x2f, 0x25, 0x9e, 0xbb, 0xe4, 0x32, 0xe9, 0xb5, 0xac, 0xa8, 0x0a, 0x9f,
-		0x41, 0x3f, 0x83, 0x9f, 0x2c, 0x5a, 0xe6, 0x4b, 0xf6, 0xc2, 0x97, 0x4c, 0xa8, 0x63, 0x49, 0xa8,
-		0x9e, 0x10, 0xdc, 0x6e, 0x34, 0xe4, 0xc6, 0x21, 0x9f, 0x45, 0xcf, 0xe0, 0x20, 0xf5, 0xea, 0xc8,
-		0x6b, 0x97, 0xd1, 0xcf, 0xe1, 0xa7, 0x97, 0x7e, 0xed, 0xb0, 0x4f, 0x55, 0x7e, 0x65, 0xc6, 0xee,
-		0x9b, 0x58, 0xdf, 0x6e, 0x88, 0x82, 0x2a, 0x1d, 0x36, 0xb1, 0xfc, 0x8d, 0x54, 0xe5, 0x57, 0x77,
-		0x3f, 0x70, 0x80, 0x0e, 0xa9, 0x17, 0xef, 0xcd, 0x03, 0xd8, 0x39, 0x94, 0xd4, 0xb9, 0x1d, 0x79,
-		0x08, 0xc5, 0x64, 0x88, 0x22, 0xe1, 0x97, 0xb2, 0x28, 0x91, 0xe7, 0x6d, 0xe5, 0x84, 0xe7, 0x66,
-		0xa7, 0xf2, 0x4f, 0x6a, 0xb3, 0xad, 0xf2, 0x19, 0x54, 0x86, 0xdd, 0x19, 0xa9, 0x6a, 0x02, 0xae,
-		0x92, 0xe6, 0xab, 0x86, 0x84, 0x95, 0x9a, 0xdc, 0x22, 0xf5, 0xa6, 0xa2, 0xf2, 0x59, 0xf4, 0x08,
-		0x3e, 0x4f, 0xc6, 0x4f, 0x56, 0xb7, 0xfc, 0xfc, 0xd9, 0x37, 0x4f, 0xcf, 0x0d, 0xef, 0x4d, 0xff,
-		0xb4, 0xdc, 0xb1, 0xbb, 0x95, 0xe8, 0x8f, 0x1d, 0x4f, 0x0c, 0xdd, 0xac, 0x9c, 0xdb, 0xc1, 0xef,
-		0x2b, 0xa3, 0x5f, 0x66, 0xbe, 0x62, 0x7f, 0x5c, 0xec, 0x9d, 0xae, 0xb2, 0xf8, 0xc1, 0x7f, 0x03,
-		0x00, 0x00, 0xff, 0xff, 0xeb, 0x31, 0x53, 0x3b, 0xc1, 0x19, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6e, 0xdb, 0xd8,
+		0x15, 0x36, 0x25, 0xff, 0xc8, 0xc7, 0x1e, 0x87, 0xbe, 0x99, 0x34, 0xca, 0x8f, 0x13, 0x45, 0x03,
+		0x24, 0x82, 0x27, 0x91, 0x6a, 0x7b, 0x82, 0x29, 0x3a, 0x45, 0x5b, 0x86, 0x62, 0x2c, 0x8e, 0x65,
+		0x49, 0xb8, 0xa4, 0x92, 0x78, 0x50, 0x80, 0xa0, 0xc5, 0x6b, 0x87, 0x08, 0x45, 0x6a, 0xf8, 0xe3,
+		0x54, 0x0f, 0xd0, 0x74, 0xdf, 0xe9, 0xb6, 0x9b, 0x6e, 0x0b, 0x74, 0xd5, 0x2e, 0x8a, 0x6e, 0x0b,
+		0xb4, 0x9b, 0x02, 0x5d, 0x4d, 0x17, 0xb3, 0x29, 0xf2, 0x04, 0x7d, 0x84, 0x82, 0x97, 0xa4, 0x44,
+		0x51, 0x94, 0x45, 0x3b, 0x2e, 0xd0, 0x45, 0x77, 0xe2, 0xe1, 0x77, 0x0e, 0xbf, 0x73, 0xce, 0xbd,
+		0x1f, 0xcf, 0x15, 0xe1, 0x81, 0x77, 0x4c, 0xec, 0x5a, 0x4f, 0xd5, 0x88, 0xd9, 0x23, 0x35, 0x55,
+		0xeb, 0xeb, 0x66, 0xed, 0x6c, 0xa7, 0xf6, 0xb5, 0x47, 0x3c, 0x52, 0x1d, 0xd8, 0x96, 0x6b, 0xa1,
+		0x1b, 0x3e, 0xa4, 0x1a, 0x42, 0xaa, 0x14, 0x52, 0x3d, 0xdb, 0xb9, 0x7d, 0xff, 0xd4, 0xb2, 0x4e,
+		0x0d, 0x52, 0xa3, 0xa0, 0x63, 0xef, 0xa4, 0xe6, 0xea, 0x7d, 0xe2, 0xb8, 0x6a, 0x7f, 0x10, 0xf8,
+		0xdd, 0x2e, 0x4d, 0x86, 0x1e, 0xe8, 0x7e, 0xe0, 0x9e, 0xd5, 0xef, 0x5b, 0x66, 0x88, 0x78, 0x90,
+		0x86, 0x78, 0xad, 0x3b, 0xae, 0x65, 0x0f, 0x43, 0x48, 0x39, 0x0d, 0xf2, 0xd6, 0xb2, 0xdf, 0x9c,
+		0x18, 0xd6, 0xdb, 0x00, 0x53, 0xfe, 0x2e, 0x07, 0x1f, 0xf3, 0xb6, 0xe5, 0x38, 0xbc, 0xe1, 0x39,
+		0x2e, 0xb1, 0x65, 0xd5, 0x79, 0x23, 0x9a, 0x27, 0x16, 0xba, 0x03, 0xab, 0x9a, 0xd5, 0x57, 0x75,
+		0x53, 0xd1, 0xb5, 0x22, 0x53, 0x62, 0x2a, 0xab, 0xb8, 0x10, 0x18, 0x44, 0x0d, 0x75, 0x01, 0x45,
+		0x71, 0x14, 0xf2, 0x73, 0xd2, 0xf3, 0x5c, 0xdd, 0x32, 0x8b, 0xb9, 0x12, 0x53, 0x59, 0xdb, 0x7d,
+		0x58, 0x9d, 0xcc, 0x79, 0xa0, 0x57, 0xcf, 0x76, 0xaa, 0x2f, 0x43, 0xb8, 0x10, 0xa1, 0xf1, 0xe6,
+		0xdb, 0xa4, 0x09, 0x35, 0x60, 0xd5, 0x55, 0x9d, 0x37, 0x8a, 0x3b, 0x1c, 0x90, 0x62, 0xbe, 0xc4,
+		0x54, 0x36, 0x76, 0x3f, 0xad, 0xa6, 0x56, 0xb0, 0x9a, 0xe4, 0x2c, 0x0f, 0x07, 0x04, 0x17, 0xdc,
+		0xf0, 0x17, 0xda, 0x02, 0xa0, 0x91, 0x1c, 0x57, 0x75, 0x49, 0x71, 0xb1, 0xc4, 0x54, 0x96, 0x30,
+		0x8d, 0x2d, 0xf9, 0x06, 0x74, 0x13, 0x56, 0xe8, 0x6d, 0x5d, 0x2b, 0x2e, 0x95, 0x98, 0x4a, 0x1e,
+		0x2f, 0xfb, 0x97, 0xa2, 0x86, 0x0e, 0xe1, 0xe3, 0x33, 0xdd, 0xd1, 0x8f, 0x75, 0x43, 0x77, 0x87,
+		0xca, 0xa8, 0x2b, 0xc5, 0x65, 0x9a, 0xda, 0xed, 0x6a, 0xd0, 0xb7, 0x6a, 0xd4, 0xb7, 0xaa, 0x1c,
+		0x21, 0xf0, 0xf5, 0xb1, 0xdf, 0xc8, 0x58, 0xfe, 0x36, 0x07, 0xdf, 0x8f, 0x33, 0x95, 0x5c, 0xd5,
+		0x76, 0xf9, 0xd7, 0xba, 0xa1, 0x8d, 0xeb, 0x40, 0xbe, 0xf6, 0x88, 0xe3, 0x72, 0xae, 0x6b, 0xeb,
+		0xc7, 0x9e, 0x4b, 0x1c, 0x54, 0x01, 0xd6, 0x55, 0xed, 0x53, 0xe2, 0x2a, 0xc9, 0x06, 0x6c, 0x04,
+		0xf6, 0x7a, 0xd4, 0x86, 0x2d, 0x00, 0x3b, 0x70, 0xf7, 0x31, 0x39, 0x8a, 0x59, 0x0d, 0x2d, 0xa2,
+		0x86, 0x1e, 0x03, 0xd2, 0x4d, 0xdd, 0xd5, 0x55, 0x97, 0x68, 0x0a, 0x39, 0x23, 0x26, 0x85, 0xe5,
+		0x69, 0xc2, 0xec, 0xe8, 0x8e, 0xe0, 0xdf, 0x10, 0x35, 0xf4, 0x8e, 0x81, 0xdb, 0x49, 0xb8, 0x3a,
+		0x62, 0x45, 0x6b, 0xb8, 0xb6, 0xdb, 0x48, 0x6d, 0xee, 0x38, 0xad, 0xa9, 0x36, 0x8b, 0x13, 0x8f,
+		0x19, 0x67, 0x89, 0x8b, 0xfa, 0x8c, 0x3b, 0xa8, 0x0c, 0x1f, 0x85, 0xf9, 0xdb, 0x9e, 0x19, 0xb5,
+		0x68, 0x15, 0xaf, 0x05, 0x46, 0xec, 0x99, 0xa2, 0x56, 0xfe, 0x12, 0x76, 0xe6, 0xd6, 0xd5, 0x19,
+		0x58, 0xa6, 0x43, 0x62, 0x81, 0x6f, 0xc0, 0xb2, 0xed, 0xc5, 0xca, 0xb9, 0x64, 0xd3, 0x58, 0x7f,
+		0xca, 0xc1, 0xe3, 0x78, 0x30, 0x5e, 0x35, 0x7b, 0xc4, 0xb8, 0x92, 0x06, 0x1d, 0xc3, 0xad, 0x10,
+		0xf9, 0xc1, 0xdb, 0xe5, 0x66, 0x10, 0x68, 0xea, 0x46, 0x62, 0x11, 0xe4, 0xb3, 0x2d, 0x82, 0xc5,
+		0x19, 0x8b, 0xa0, 0x0a, 0xd7, 0x7b, 0x7e, 0x19, 0xc7, 0x7c, 0x2d, 0xd3, 0x18, 0xd2, 0x0e, 0x14,
+		0xf0, 0x66, 0x2f, 0xde, 0xe2, 0xb6, 0x69, 0x0c, 0xcb, 0x35, 0x78, 0x72, 0x6e, 0xe9, 0x92, 0x3d,
+		0x28, 0xff, 0x31, 0x3f, 0x59, 0x6c, 0x49, 0x3f, 0x35, 0xd5, 0xff, 0x17, 0x3b, 0x4b, 0xb1, 0xd1,
+		0x7d, 0x58, 0x73, 0x68, 0xb9, 0x14, 0x53, 0xed, 0x13, 0xaa, 0x49, 0xab, 0x18, 0x02, 0x53, 0x4b,
+		0xed, 0x13, 0xf4, 0x13, 0x58, 0x0f, 0x01, 0xba, 0x39, 0xf0, 0xdc, 0xe2, 0x0a, 0x4d, 0xfa, 0x6e,
+		0x6a, 0xd2, 0x1d, 0x75, 0x68, 0x58, 0xaa, 0x86, 0xc3, 0x90, 0xa2, 0xef, 0x80, 0x8a, 0xb0, 0xd2,
+		0xb3, 0x4c, 0xd7, 0xb6, 0x8c, 0x62, 0xa1, 0xc4, 0x54, 0xd6, 0x71, 0x74, 0x99, 0x6c, 0xf4, 0x54,
+		0xdb, 0xa6, 0x1a, 0xfd, 0xf7, 0x1c, 0x70, 0x71, 0x0f, 0x4c, 0x7a, 0x96, 0xad, 0xa5, 0x8b, 0x04,
+		0x6f, 0xf5, 0x07, 0x06, 0x71, 0xc9, 0xff, 0x7a, 0xf7, 0x2f, 0x26, 0xa8, 0x4d, 0x60, 0x7b, 0x41,
+		0x62, 0xba, 0x65, 0x06, 0xf0, 0x50, 0x45, 0x1f, 0xa4, 0x12, 0x69, 0x04, 0x2f, 0x6f, 0xea, 0x8e,
+		0xaf, 0x8d, 0x5d, 0xa9, 0xa1, 0x5c, 0x87, 0x67, 0x17, 0x2f, 0xe7, 0x54, 0x57, 0xfe, 0xcd, 0x40,
+		0x89, 0x1b, 0x0c, 0x8c, 0x61, 0x47, 0xb5, 0x89, 0xe9, 0xf2, 0x86, 0xe5, 0x90, 0x8e, 0x65, 0xe8,
+		0xbd, 0x61, 0xac, 0xe8, 0x0f, 0xe1, 0x5a, 0xb0, 0x2e, 0x93, 0x35, 0xff, 0x88, 0x9a, 0x47, 0x25,
+		0xdf, 0x86, 0xcd, 0xc4, 0xfa, 0x1d, 0xbd, 0x85, 0xae, 0x4d, 0xac, 0x5e, 0x51, 0x43, 0x25, 0x58,
+		0x0f, 0xb0, 0xa1, 0x02, 0x07, 0x5b, 0x07, 0xa8, 0x8d, 0x4a, 0x3a, 0x7a, 0x01, 0xd7, 0x07, 0x94,
+		0x94, 0xd2, 0xf3, 0x59, 0x29, 0x03, 0x4a, 0x8b, 0x56, 0x6c, 0x63, 0x46, 0xeb, 0xa6, 0x92, 0xc0,
+		0x9b, 0x83, 0xa4, 0xa9, 0xfc, 0x0d, 0x03, 0x77, 0xd3, 0x53, 0xf6, 0x67, 0x01, 0xcf, 0x41, 0x77,
+		0x61, 0x35, 0x2c, 0x36, 0x09, 0x12, 0x2d, 0xe0, 0xb1, 0x01, 0x75, 0x61, 0xfd, 0x44, 0xd5, 0x0d,
+		0xa2, 0x29, 0x3d, 0xd5, 0x73, 0x08, 0xcd, 0x6f, 0x63, 0x77, 0x37, 0xe3, 0x58, 0xf2, 0x9c, 0xba,
+		0xf2, 0xbe, 0x27, 0x5e, 0x3b, 0x19, 0x5f, 0x94, 0xff, 0xcc, 0xc0, 0x56, 0x3a, 0xab, 0x70, 0x13,
+		0xa0, 0x43, 0x58, 0xa2, 0xd5, 0xa1, 0x94, 0xd6, 0x76, 0x3f, 0x9f, 0xf1, 0xc4, 0x79, 0xdd, 0xc4,
+		0x41, 0x14, 0x74, 0x00, 0xcb, 0x0e, 0xcd, 0x37, 0xdc, 0x0c, 0x7b, 0x17, 0x8a, 0x17, 0x94, 0x0a,
+		0x87, 0x21, 0xca, 0xbf, 0x64, 0x60, 0x2f, 0x9e, 0xea, 0xb9, 0x99, 0xc4, 0x56, 0x56, 0x07, 0x0a,
+		0x94, 0x8d, 0x4d, 0xcc, 0x22, 0x53, 0xca, 0x57, 0xd6, 0x76, 0x3f, 0xbb, 0x10, 0x8d, 0x30, 0x22,
+		0x1e, 0x45, 0x29, 0xff, 0x65, 0x66, 0x77, 0x31, 0x71, 0x3c, 0xe3, 0xca, 0xcb, 0xf8, 0x5f, 0x5a,
+		0x0e, 0xbf, 0x66, 0xe0, 0xb3, 0x2c, 0x05, 0x9d, 0x9a, 0x69, 0x7e, 0x16, 0xee, 0x55, 0x9b, 0x98,
+		0x4a, 0xd8, 0xdf, 0xa0, 0xb0, 0x7b, 0x17, 0x2c, 0xac, 0x5f, 0x2c, 0xbc, 0x11, 0xc5, 0x0a, 0xfa,
+		0x5d, 0xfe, 0xcd, 0x0a, 0xdc, 0x4c, 0xe6, 0x10, 0xad, 0xcf, 0x68, 0x58, 0xd7, 0xcd, 0x13, 0x2b,
+		0x2c, 0x6e, 0xd6, 0x61, 0xdd, 0x3f, 0x60, 0x04, 0xc3, 0xba, 0xff, 0x0b, 0xfd, 0x8a, 0x81, 0x92,
+		0xe3, 0x4f, 0x70, 0x4a, 0x20, 0x11, 0x23, 0xdd, 0x8e, 0xcf, 0x9f, 0xc1, 0xaa, 0xdd, 0xcf, 0xf0,
+		0x84, 0x2c, 0x43, 0x76, 0x63, 0x01, 0x6f, 0x39, 0xd3, 0xb8, 0x58, 0x61, 0x7f, 0xc1, 0xc0, 0x9d,
+		0x1e, 0x1d, 0x67, 0xd2, 0xf9, 0xe4, 0x29, 0x1f, 0x3e, 0x03, 0x9f, 0x79, 0xf3, 0x64, 0x63, 0x01,
+		0xdf, 0xea, 0x4d, 0x62, 0x12, 0x3c, 0xc2, 0x97, 0x7a, 0x2a, 0x8f, 0xc5, 0xcc, 0x3c, 0xe6, 0x8d,
+		0x5a, 0x3e, 0x0f, 0x67, 0x12, 0x13, 0xe3, 0xf1, 0x2d, 0x03, 0x5f, 0xd8, 0xf4, 0xa5, 0xa3, 0x24,
+		0x44, 0x7f, 0x4c, 0x2b, 0x52, 0x4d, 0x25, 0x9a, 0x91, 0x62, 0x3c, 0x97, 0x28, 0xcf, 0x57, 0x19,
+		0x78, 0x5e, 0x6a, 0x52, 0x68, 0x2c, 0xe0, 0xa7, 0xf6, 0x65, 0x1c, 0xd1, 0xef, 0x18, 0x78, 0xac,
+		0xfa, 0x9b, 0x42, 0x49, 0x79, 0xfd, 0xa4, 0x65, 0x12, 0x9c, 0x05, 0xbf, 0xcc, 0x90, 0x49, 0x46,
+		0x59, 0x6c, 0x2c, 0xe0, 0x47, 0x6a, 0x36, 0xe8, 0xb3, 0x75, 0x80, 0x31, 0x95, 0xf2, 0x1f, 0x0a,
+		0x50, 0x9c, 0xde, 0x9f, 0x81, 0x48, 0xc4, 0x0f, 0xb9, 0xcc, 0xc4, 0x21, 0x77, 0xe2, 0x98, 0x9d,
+		0xbb, 0xba, 0x63, 0x76, 0x3e, 0x79, 0xcc, 0x4e, 0x8a, 0xe5, 0xe2, 0x95, 0x88, 0x25, 0xfa, 0x26,
+		0x8b, 0x5e, 0x2c, 0xa5, 0x9e, 0x57, 0x33, 0xeb, 0x45, 0x52, 0x68, 0xe7, 0x0b, 0xc6, 0xbb, 0x39,
+		0x82, 0x11, 0x2c, 0x9b, 0xfa, 0x65, 0x04, 0x23, 0x85, 0xcc, 0x39, 0x8a, 0xf1, 0x6e, 0x8e, 0x62,
+		0xac, 0x64, 0x26, 0x32, 0x77, 0xca, 0x3f, 0x5f, 0x32, 0xfe, 0xf9, 0x81, 0x92, 0x51, 0xa0, 0x44,
+		0x8f, 0xae, 0x4c, 0x32, 0x52, 0xd8, 0x5f, 0x52, 0x33, 0x7e, 0xcf, 0xc0, 0x93, 0xf3, 0x34, 0x23,
+		0x78, 0x52, 0x3c, 0x97, 0x55, 0x9a, 0xcb, 0xc1, 0x07, 0x88, 0x46, 0x0a, 0xfb, 0x8a, 0x9a, 0x11,
+		0x9b, 0x90, 0x0d, 0x2b, 0x4d, 0x35, 0x68, 0x8e, 0x0e, 0x92, 0xfc, 0x7f, 0x5f, 0x9c, 0x37, 0x51,
+		0x3f, 0xa2, 0x71, 0xa2, 0x9a, 0x71, 0xd3, 0x46, 0x13, 0xda, 0xba, 0x3b, 0xbe, 0x70, 0xb6, 0xdf,
+		0x31, 0x50, 0x88, 0xe4, 0x03, 0xdd, 0x80, 0x4d, 0x99, 0x93, 0x0e, 0x14, 0xf9, 0xa8, 0x23, 0x28,
+		0x62, 0xeb, 0x05, 0xd7, 0x14, 0xeb, 0xec, 0x02, 0xfa, 0x1e, 0xa0, 0xb1, 0x59, 0xc6, 0x5c, 0x4b,
+		0x7a, 0x2e, 0x60, 0x96, 0x41, 0xd7, 0xe1, 0x5a, 0xcc, 0x2e, 0x1e, 0x0a, 0x98, 0xcd, 0xa1, 0x5b,
+		0x70, 0x63, 0x6c, 0xc4, 0x42, 0xa7, 0x29, 0xf2, 0x9c, 0x2c, 0xb6, 0x5b, 0x6c, 0x1e, 0xdd, 0x81,
+		0x9b, 0xe3, 0x5b, 0x3c, 0x6e, 0x4b, 0x92, 0xc2, 0x37, 0xbb, 0x92, 0x2c, 0x60, 0x76, 0x71, 0xfb,
+		0xaf, 0x29, 0x7f, 0x77, 0x52, 0x52, 0x9f, 0xc0, 0xfd, 0x09, 0xac, 0x92, 0x46, 0x71, 0x07, 0x9e,
+		0xcc, 0x02, 0x49, 0x32, 0x87, 0x65, 0x85, 0x6f, 0x88, 0xcd, 0xba, 0x22, 0xbc, 0x12, 0xf8, 0x2e,
+		0x65, 0xc3, 0xa0, 0xc7, 0x50, 0x99, 0xe5, 0xc2, 0x73, 0x2d, 0x5e, 0x68, 0xc6, 0xd0, 0xb9, 0xf3,
+		0xd0, 0x92, 0xb8, 0xdf, 0xe2, 0xe2, 0xe8, 0x3c, 0xaa, 0xc3, 0x4f, 0x67, 0xa1, 0xb1, 0xc0, 0xb7,
+		0x71, 0x3d, 0xe4, 0xf3, 0xb2, 0x8d, 0x0f, 0x0e, 0x9a, 0xed, 0x97, 0x63, 0x67, 0x85, 0x6f, 0x1f,
+		0x76, 0x9a, 0x82, 0x2c, 0xb0, 0x8b, 0xe8, 0x29, 0xec, 0xcc, 0x8a, 0xc2, 0x75, 0x3a, 0xcd, 0x23,
+		0xa5, 0xc3, 0x61, 0xa1, 0x25, 0x2b, 0x7c, 0xb3, 0x2d, 0x09, 0x4a, 0xa7, 0xdd, 0x14, 0xf9, 0x23,
+		0x76, 0x69, 0xfb, 0xb7, 0x79, 0xb8, 0x73, 0x8e, 0x62, 0xa3, 0x4f, 0xe1, 0x51, 0x4a, 0xd8, 0xe7,
+		0x9c, 0xd8, 0x14, 0xea, 0x0a, 0xcf, 0x75, 0xa5, 0x78, 0x61, 0xd3, 0x39, 0x4c, 0x80, 0xeb, 0xed,
+		0x43, 0x4e, 0x6c, 0x29, 0xad, 0xb6, 0xac, 0x70, 0xbc, 0x2c, 0xbe, 0x10, 0x58, 0xe6, 0x82, 0x6e,
+		0xc2, 0x2b, 0x51, 0x92, 0x25, 0x36, 0x87, 0x7e, 0x04, 0x3f, 0x98, 0xe7, 0xe6, 0x97, 0xec, 0xb9,
+		0x5f, 0x32, 0xae, 0x89, 0x05, 0xae, 0x7e, 0xa4, 0xe0, 0x6e, 0xab, 0x25, 0xb6, 0xf6, 0xd9, 0x3c,
+		0xfa, 0x1c, 0xf6, 0x32, 0x7b, 0xc7, 0x1e, 0xbb, 0x88, 0x7e, 0x0c, 0x3f, 0xbc, 0xf0, 0x63, 0xa3,
+		0x3e, 0xd5, 0xd9, 0xa5, 0x19, 0xab, 0x6f, 0xc2, 0xbf, 0xdb, 0xe2, 0x39, 0x59, 0xd8, 0x6f, 0x63,
+		0xf1, 0x2b, 0xa1, 0xce, 0x2e, 0x6f, 0x7f, 0xc7, 0x00, 0xda, 0x27, 0x6e, 0xb2, 0x37, 0x0f, 0x60,
+		0x6b, 0x5f, 0x90, 0xcf, 0xed, 0xc8, 0x43, 0x28, 0xa7, 0x43, 0x24, 0x01, 0xbf, 0x10, 0x79, 0x41,
+		0x79, 0xd6, 0x95, 0x8e, 0x58, 0x66, 0x76, 0x28, 0x7f, 0xa7, 0xb6, 0xbb, 0x32, 0x9b, 0x43, 0x55,
+		0xd8, 0x9e, 0x11, 0xaa, 0xc1, 0xe1, 0xba, 0xd2, 0x7e, 0xd9, 0x12, 0xb0, 0xd4, 0x10, 0x3b, 0x4a,
+		0xb3, 0x2d, 0xc9, 0x6c, 0x1e, 0x3d, 0x82, 0x4f, 0xd2, 0xf1, 0x93, 0xd9, 0x2d, 0x3e, 0xe3, 0xff,
+		0xf6, 0xfe, 0x1e, 0xf3, 0x8f, 0xf7, 0xf7, 0x98, 0x7f, 0xbd, 0xbf, 0xc7, 0x7c, 0xf5, 0xf4, 0x54,
+		0x77, 0x5f, 0x7b, 0xc7, 0xd5, 0x9e, 0xd5, 0xaf, 0xc5, 0x3f, 0x7c, 0x3c, 0xd1, 0x35, 0xa3, 0x76,
+		0x6a, 0x05, 0xdf, 0x5a, 0x46, 0x5f, 0x69, 0xbe, 0xa0, 0x3f, 0xce, 0x76, 0x8e, 0x97, 0xa9, 0x7d,
+		0xef, 0x3f, 0x03, 0x00, 0x8b, 0xf3, 0x23, 0xa6, 0xcd, 0x19, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/replication.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x73, 0x1a, 0xc7,
-		0x16, 0xf6, 0x80, 0x78, 0xe8, 0x08, 0x01, 0x6a, 0xc9, 0x16, 0x96, 0xac, 0xba, 0x18, 0xbf, 0x64,
-		0xb9, 0x2e, 0x5c, 0xc9, 0xd7, 0xf7, 0x59, 0x29, 0xd7, 0x58, 0xa0, 0x68, 0x62, 0x3d, 0x1b, 0x2c,
-		0x47, 0xa9, 0x4a, 0x4d, 0x8d, 0x66, 0x5a, 0x62, 0x4a, 0x30, 0x43, 0x4d, 0x37, 0xc8, 0xec, 0x92,
-		0x7d, 0xaa, 0xb2, 0xc9, 0x2e, 0xcb, 0xfc, 0x8d, 0xac, 0xb3, 0x4d, 0x7e, 0x52, 0x6a, 0xba, 0x7b,
-		0x80, 0x81, 0x01, 0xcb, 0xe5, 0x45, 0x76, 0xf4, 0x39, 0xdf, 0x79, 0xf4, 0xe9, 0xd3, 0xdf, 0x69,
-		0x06, 0x9e, 0x75, 0x2f, 0x88, 0x57, 0x31, 0x0d, 0x8b, 0x38, 0x26, 0xa9, 0x18, 0x56, 0xdb, 0x76,
-		0x2a, 0xbd, 0xed, 0x8a, 0x47, 0x3a, 0x2d, 0xdb, 0x34, 0x98, 0xed, 0x3a, 0xe5, 0x8e, 0xe7, 0x32,
-		0x17, 0xdd, 0xf5, 0x81, 0x65, 0x09, 0x2c, 0x73, 0x60, 0xb9, 0xb7, 0xbd, 0xf6, 0xb7, 0x2b, 0xd7,
-		0xbd, 0x6a, 0x91, 0x0a, 0x07, 0x5d, 0x74, 0x2f, 0x2b, 0xcc, 0x6e, 0x13, 0xca, 0x8c, 0x76, 0x47,
-		0xd8, 0xad, 0x15, 0xc3, 0x01, 0x3a, 0xb6, 0xef, 0xde, 0x74, 0xdb, 0xed, 0xc0, 0x73, 0x34, 0xc2,
-		0x72, 0xdb, 0x86, 0x1d, 0x20, 0x1e, 0x45, 0x27, 0xd9, 0xb4, 0x29, 0x73, 0xbd, 0xbe, 0x00, 0x95,
-		0x7e, 0x8a, 0xc1, 0x32, 0x1e, 0xa6, 0x7d, 0x48, 0x28, 0x35, 0xae, 0x08, 0x45, 0x75, 0x58, 0x1a,
-		0xd9, 0x8d, 0xce, 0x0c, 0x7a, 0x4d, 0x0b, 0x4a, 0x31, 0xbe, 0xb9, 0xb0, 0xf3, 0xb4, 0x1c, 0xb9,
-		0xa9, 0xf2, 0x88, 0x9b, 0x86, 0x41, 0xaf, 0x71, 0xde, 0x0b, 0x0b, 0x28, 0xfa, 0x2f, 0xdc, 0x6f,
-		0x19, 0x94, 0xe9, 0x1e, 0x61, 0x9e, 0x4d, 0x7a, 0xc4, 0xd2, 0xdb, 0x22, 0x9e, 0x6e, 0x5b, 0x85,
-		0x58, 0x51, 0xd9, 0x8c, 0xe3, 0x7b, 0x3e, 0x00, 0x07, 0x7a, 0x99, 0x8e, 0x66, 0xa1, 0xfb, 0x90,
-		0x6e, 0x1a, 0x54, 0x6f, 0xbb, 0x1e, 0x29, 0xc4, 0x8b, 0xca, 0x66, 0x1a, 0xa7, 0x9a, 0x06, 0x3d,
-		0x74, 0x3d, 0x82, 0x30, 0x2c, 0xd1, 0xbe, 0x63, 0xea, 0xb4, 0x69, 0x78, 0x96, 0x4e, 0x99, 0xc1,
-		0xba, 0xb4, 0x30, 0x57, 0x54, 0x66, 0xa4, 0x5a, 0xef, 0x3b, 0x66, 0xdd, 0x87, 0xd7, 0x39, 0x1a,
-		0xe7, 0x68, 0x58, 0x50, 0xfa, 0x31, 0x09, 0xb9, 0xb1, 0xfd, 0xa0, 0x2f, 0x61, 0xde, 0x2f, 0x83,
-		0xce, 0xfa, 0x1d, 0x52, 0x50, 0x8a, 0xca, 0x66, 0x76, 0x67, 0xeb, 0x76, 0xa5, 0x68, 0xf4, 0x3b,
-		0x04, 0xa7, 0x99, 0xfc, 0x85, 0x1e, 0x43, 0x96, 0xba, 0x5d, 0xcf, 0x24, 0xbc, 0xac, 0xc3, 0xbd,
-		0x67, 0x84, 0xd4, 0xb7, 0xd0, 0x2c, 0xf4, 0x1a, 0x16, 0x4d, 0x8f, 0xc8, 0xf2, 0xdb, 0x6d, 0xb1,
-		0xed, 0x85, 0x9d, 0xb5, 0xb2, 0xe8, 0x9d, 0x72, 0xd0, 0x3b, 0xe5, 0x46, 0xd0, 0x3b, 0x38, 0x13,
-		0x18, 0xf8, 0x22, 0x64, 0xc2, 0x3d, 0xd1, 0x0f, 0x22, 0x8c, 0xc1, 0x98, 0x67, 0x5f, 0x74, 0x19,
-		0x09, 0x8a, 0xf3, 0x62, 0x4a, 0xf2, 0x55, 0x6e, 0xe4, 0x67, 0xa1, 0x0e, 0x4c, 0xf6, 0xef, 0xe0,
-		0x15, 0x2b, 0x42, 0x8e, 0xbe, 0x53, 0xe0, 0xe1, 0x44, 0xf5, 0x27, 0x02, 0x26, 0x78, 0xc0, 0x7f,
-		0xde, 0xee, 0x34, 0x26, 0x22, 0x6f, 0xd0, 0x59, 0x00, 0xd4, 0x03, 0x0e, 0xd0, 0x0d, 0x93, 0xd9,
-		0x3d, 0x9b, 0xf5, 0x27, 0xa2, 0x27, 0x79, 0xf4, 0xed, 0x19, 0xd1, 0x55, 0x69, 0x3a, 0x11, 0x7a,
-		0x8d, 0x4e, 0xd5, 0xa2, 0x36, 0xac, 0xc9, 0xbb, 0x24, 0x22, 0xf6, 0x76, 0x46, 0x83, 0xa6, 0x78,
-		0xd0, 0xf2, 0x94, 0xa0, 0xfb, 0xc2, 0xd0, 0xf7, 0x78, 0xb6, 0x13, 0x8a, 0xb8, 0xda, 0x8c, 0x56,
-		0x21, 0x17, 0xd6, 0x2e, 0x0d, 0xbb, 0xe5, 0xf6, 0x88, 0xa7, 0xb7, 0x0d, 0xef, 0x9a, 0x78, 0xa3,
-		0xe1, 0xd2, 0x3c, 0x5c, 0x65, 0x4a, 0xb8, 0x3d, 0x69, 0x78, 0xc8, 0xed, 0x42, 0xf1, 0x0a, 0x97,
-		0x53, 0x74, 0x6f, 0x32, 0x00, 0xc3, 0x00, 0xa5, 0x5f, 0x63, 0xb0, 0x12, 0xd5, 0x19, 0xe8, 0x14,
-		0xf2, 0xb2, 0xcd, 0xdc, 0x0e, 0xf1, 0x78, 0xfb, 0xc9, 0xdb, 0xf1, 0x74, 0x66, 0x83, 0x1d, 0x07,
-		0x68, 0x9c, 0xb3, 0xc2, 0x02, 0x94, 0x85, 0x98, 0xbc, 0x14, 0xf3, 0x38, 0x66, 0x5b, 0xe8, 0x25,
-		0x24, 0x05, 0x44, 0xde, 0x81, 0xf5, 0x31, 0xc7, 0x1d, 0x7b, 0xe8, 0x16, 0x4b, 0x28, 0x7a, 0x02,
-		0x59, 0xd3, 0x75, 0x2e, 0xed, 0x2b, 0xbd, 0x47, 0x3c, 0xea, 0x67, 0x35, 0xc7, 0x6f, 0xd9, 0xa2,
-		0x90, 0x9e, 0x09, 0x21, 0x7a, 0x0e, 0xf9, 0x41, 0x59, 0x03, 0x60, 0x82, 0x03, 0x73, 0x81, 0x3c,
-		0x80, 0xfe, 0x0f, 0xee, 0x77, 0x3c, 0xd2, 0xb3, 0xdd, 0x2e, 0xd5, 0x27, 0x6c, 0x92, 0xdc, 0x66,
-		0x35, 0x00, 0xec, 0x85, 0x6d, 0x4b, 0x3f, 0x2b, 0xb0, 0x31, 0xb3, 0xcf, 0xfd, 0x7c, 0x25, 0x2b,
-		0x98, 0xad, 0x2e, 0x65, 0xc4, 0xe3, 0x55, 0x9c, 0xc7, 0x8b, 0x42, 0xba, 0x2b, 0x84, 0x3e, 0x11,
-		0x8a, 0xab, 0x26, 0x2b, 0x94, 0xc0, 0x29, 0xbe, 0xd6, 0x2c, 0xf4, 0x1f, 0x98, 0x1f, 0xcc, 0x91,
-		0x5b, 0xb0, 0xc5, 0x10, 0x5c, 0xfa, 0x3d, 0x01, 0x6b, 0xd3, 0xef, 0x01, 0x5a, 0x87, 0x79, 0x79,
-		0xc4, 0xb6, 0x25, 0xb3, 0x4a, 0x0b, 0x81, 0x66, 0xa1, 0x77, 0x80, 0x6e, 0x5c, 0xef, 0xfa, 0xb2,
-		0xe5, 0xde, 0xe8, 0xe4, 0x03, 0x31, 0xbb, 0xbc, 0x03, 0x62, 0x91, 0xfc, 0x2b, 0x0e, 0xea, 0xbd,
-		0x84, 0xd7, 0x02, 0x34, 0x5e, 0xba, 0x19, 0x17, 0xa1, 0x02, 0xa4, 0x82, 0xd2, 0xc6, 0x79, 0x69,
-		0x83, 0x25, 0x7a, 0x08, 0x19, 0x6a, 0x36, 0x89, 0xd5, 0x6d, 0x11, 0x5e, 0x05, 0x71, 0xac, 0x0b,
-		0x03, 0x99, 0x66, 0x21, 0x15, 0xb2, 0x43, 0x08, 0x27, 0xcf, 0xc4, 0x47, 0xcb, 0xb1, 0x38, 0xb0,
-		0xe0, 0xec, 0xb9, 0x01, 0x40, 0x99, 0xe1, 0x31, 0x11, 0x43, 0x9c, 0xee, 0xbc, 0x94, 0x68, 0x16,
-		0xfa, 0x02, 0x32, 0x81, 0x9a, 0xfb, 0x4f, 0x7d, 0xd4, 0xff, 0x82, 0xc4, 0x73, 0xef, 0x5f, 0xc1,
-		0x32, 0x9f, 0x84, 0x4d, 0x62, 0x78, 0xec, 0x82, 0x18, 0x4c, 0x78, 0x49, 0x7f, 0xd4, 0xcb, 0x92,
-		0x6f, 0xb6, 0x1f, 0x58, 0x71, 0x5f, 0xff, 0x82, 0x94, 0x45, 0x98, 0x61, 0xb7, 0x68, 0x61, 0x9e,
-		0xdb, 0x3f, 0x88, 0xac, 0xfa, 0x89, 0xd1, 0x6f, 0xb9, 0x86, 0x85, 0x03, 0xb0, 0x5f, 0x61, 0x83,
-		0x31, 0xd2, 0xee, 0xb0, 0x02, 0x88, 0x46, 0x92, 0x4b, 0xf4, 0x1a, 0x32, 0x3c, 0x3b, 0xbf, 0xc9,
-		0xbb, 0x1e, 0x29, 0x2c, 0xcc, 0x70, 0xbb, 0x27, 0x30, 0x78, 0xc1, 0xb7, 0x90, 0x0b, 0xf4, 0x0f,
-		0x58, 0xe1, 0x0e, 0xfc, 0x63, 0x25, 0x9e, 0x6e, 0x5b, 0xc4, 0x61, 0x36, 0xeb, 0x17, 0x32, 0xbc,
-		0x77, 0x90, 0xaf, 0x7b, 0xcf, 0x55, 0x9a, 0xd4, 0xa0, 0x23, 0xc8, 0xc9, 0xf3, 0xd5, 0x25, 0x01,
-		0x16, 0x16, 0x79, 0xd4, 0x27, 0x53, 0x48, 0x44, 0x5e, 0x2c, 0x49, 0xa4, 0x38, 0xdb, 0x0b, 0xad,
-		0x4b, 0xdf, 0xc7, 0x61, 0x75, 0x0a, 0xc9, 0xa2, 0x55, 0x48, 0x05, 0x83, 0x57, 0xe1, 0xe7, 0x9a,
-		0x64, 0x62, 0xe4, 0x86, 0xfa, 0x3c, 0x76, 0xab, 0x3e, 0x8f, 0x7f, 0x6e, 0x9f, 0x7f, 0x0b, 0x77,
-		0xc7, 0x36, 0xae, 0xdb, 0x8c, 0xb4, 0xfd, 0x21, 0xed, 0x3f, 0xb6, 0x9e, 0xdf, 0x6a, 0xfb, 0x1a,
-		0x23, 0x6d, 0xbc, 0xdc, 0x9b, 0x90, 0x51, 0xf4, 0x0a, 0x92, 0xa4, 0x47, 0x1c, 0x16, 0xcc, 0xe0,
-		0x8d, 0x68, 0xea, 0x34, 0x98, 0xf1, 0xa6, 0xe5, 0x5e, 0x60, 0x09, 0x46, 0xbb, 0x90, 0x75, 0xc8,
-		0x8d, 0xee, 0x75, 0x1d, 0x5d, 0x9a, 0x27, 0x6f, 0x63, 0x9e, 0x71, 0xc8, 0x0d, 0xee, 0x3a, 0x35,
-		0x6e, 0x52, 0xfa, 0x45, 0x81, 0xc2, 0xb4, 0xc9, 0x33, 0x9b, 0x53, 0xa2, 0x48, 0x39, 0x16, 0x4d,
-		0xca, 0x9f, 0xfb, 0x4c, 0x2a, 0xfd, 0xa0, 0xc0, 0x72, 0x38, 0xcb, 0x86, 0x7b, 0x4d, 0x1c, 0x3f,
-		0xc1, 0x80, 0x68, 0xc5, 0xcb, 0x37, 0x81, 0xd3, 0x92, 0x69, 0x29, 0xfa, 0x1a, 0x72, 0x63, 0xc3,
-		0x58, 0x32, 0xde, 0xa7, 0x4e, 0x60, 0x9c, 0x0d, 0xcf, 0xdf, 0xd2, 0x6f, 0xe1, 0x07, 0x39, 0x7f,
-		0x0c, 0x3a, 0x97, 0xee, 0x5f, 0xc2, 0xc1, 0xeb, 0xa3, 0x2f, 0xde, 0x38, 0xe7, 0x88, 0xe1, 0x2b,
-		0x76, 0xe4, 0x16, 0xcd, 0x85, 0x6e, 0xd1, 0x08, 0x73, 0x27, 0xc2, 0xcc, 0xfd, 0x18, 0xb2, 0x97,
-		0xb6, 0x47, 0x99, 0xe8, 0xa9, 0x21, 0xaf, 0x66, 0xb8, 0x94, 0x77, 0x8d, 0x66, 0xa1, 0x12, 0x2c,
-		0x3a, 0xe4, 0xc3, 0x08, 0x28, 0x25, 0x08, 0xde, 0x17, 0x06, 0x98, 0xf1, 0x19, 0x90, 0x9e, 0x98,
-		0x01, 0x7e, 0xf7, 0xe5, 0x47, 0x0b, 0xc9, 0x0f, 0x75, 0x74, 0x7a, 0x2a, 0xe1, 0xe9, 0xf9, 0x19,
-		0x7f, 0x4e, 0x02, 0xd3, 0x8e, 0xe7, 0x9a, 0x84, 0xd2, 0xb0, 0x69, 0x7c, 0x68, 0x7a, 0x12, 0xe8,
-		0x07, 0xa6, 0xa5, 0xb7, 0x90, 0x1b, 0x7b, 0x16, 0x84, 0xc7, 0xb8, 0xf2, 0x29, 0x63, 0xdc, 0x81,
-		0x15, 0x79, 0xf9, 0xab, 0x07, 0xa7, 0xbb, 0x6e, 0xd7, 0x61, 0x35, 0x87, 0x79, 0x7d, 0xb4, 0x02,
-		0x09, 0xd3, 0x5f, 0x49, 0xba, 0x13, 0x8b, 0x59, 0x2f, 0x89, 0xc9, 0xb7, 0x48, 0x3c, 0xe2, 0x2d,
-		0xb2, 0xf5, 0xc7, 0x64, 0xaf, 0xf2, 0xd6, 0x78, 0x08, 0x1b, 0xb8, 0x76, 0x72, 0xa0, 0xed, 0xaa,
-		0x0d, 0xed, 0xf8, 0x48, 0x6f, 0xa8, 0xf5, 0xb7, 0x7a, 0xe3, 0xfc, 0xa4, 0xa6, 0x6b, 0x47, 0x67,
-		0xea, 0x81, 0x56, 0xcd, 0xdf, 0x41, 0x45, 0x78, 0x10, 0x0d, 0xa9, 0x1e, 0x1f, 0xaa, 0xda, 0x51,
-		0x5e, 0x99, 0xee, 0x64, 0x5f, 0xab, 0x37, 0x8e, 0xf1, 0x79, 0x3e, 0x86, 0x5e, 0xc0, 0xb3, 0x68,
-		0x48, 0xfd, 0xfc, 0x68, 0x57, 0xaf, 0xef, 0xab, 0xb8, 0xaa, 0xd7, 0x1b, 0x6a, 0xe3, 0x5d, 0x3d,
-		0x1f, 0x47, 0xcf, 0xe0, 0xd1, 0x0c, 0xb0, 0xba, 0xdb, 0xd0, 0xce, 0xb4, 0xc6, 0x79, 0x7e, 0x0e,
-		0x6d, 0xc1, 0xd3, 0x99, 0x81, 0xf5, 0xc3, 0x5a, 0x43, 0xad, 0xaa, 0x0d, 0x35, 0x9f, 0x40, 0x8f,
-		0xa1, 0x38, 0x1b, 0x7b, 0xb6, 0x93, 0x4f, 0xa2, 0xe7, 0xf0, 0x24, 0x1a, 0xb5, 0xa7, 0x6a, 0x07,
-		0xc7, 0x67, 0x35, 0xac, 0x1f, 0xaa, 0xf8, 0x6d, 0x0d, 0xe7, 0x53, 0x5b, 0x36, 0xe4, 0xc6, 0x9e,
-		0xc7, 0xe8, 0x01, 0x14, 0x44, 0x51, 0xf4, 0xe3, 0x93, 0x1a, 0x16, 0x2e, 0x86, 0x85, 0x5c, 0x87,
-		0xd5, 0x09, 0xed, 0x2e, 0xae, 0xa9, 0x8d, 0x5a, 0x5e, 0x89, 0x54, 0xbe, 0x3b, 0xa9, 0xfa, 0xca,
-		0xd8, 0xd6, 0x11, 0xa4, 0xaa, 0x07, 0xa7, 0xfc, 0xc0, 0x56, 0x20, 0x5f, 0x3d, 0x38, 0x1d, 0x3f,
-		0xa3, 0x02, 0xac, 0x0c, 0xa4, 0x23, 0xf9, 0xe7, 0x15, 0xb4, 0x0c, 0xb9, 0x81, 0x46, 0x1e, 0x58,
-		0xec, 0xcd, 0xbf, 0xbf, 0x79, 0x75, 0x65, 0xb3, 0x66, 0xf7, 0xa2, 0x6c, 0xba, 0xed, 0xca, 0xe8,
-		0xc7, 0x87, 0xbf, 0xdb, 0x56, 0xab, 0x72, 0xe5, 0x8a, 0xcf, 0x1d, 0x83, 0x2f, 0x11, 0xff, 0xe7,
-		0x3f, 0x7a, 0xdb, 0x17, 0x49, 0x2e, 0x7f, 0xf9, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0x11,
-		0xc6, 0x58, 0x56, 0x11, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0xdb, 0xce,
+		0x11, 0x0f, 0x25, 0xeb, 0xe1, 0xb1, 0x2c, 0xc9, 0x6b, 0x27, 0x66, 0xec, 0x38, 0x55, 0x94, 0x97,
+		0xe3, 0xa0, 0x52, 0xed, 0x34, 0x45, 0x1f, 0x28, 0x02, 0x46, 0x92, 0x6b, 0x36, 0x7e, 0xae, 0x14,
+		0xa7, 0x2e, 0x50, 0x10, 0x34, 0xb9, 0xb6, 0x08, 0x4b, 0xa4, 0xc0, 0x5d, 0xc9, 0xd1, 0xad, 0xbd,
+		0x17, 0xe8, 0xa5, 0xb7, 0x1e, 0xfb, 0x35, 0x7a, 0x2e, 0x7a, 0x6b, 0x3e, 0x42, 0x91, 0x4f, 0x52,
+		0x70, 0x77, 0x29, 0x89, 0x7a, 0xc5, 0x6d, 0x0e, 0xff, 0x9b, 0x76, 0xe6, 0xf7, 0x9b, 0x99, 0x9d,
+		0x9d, 0x9d, 0x59, 0x11, 0x5e, 0x76, 0x2f, 0x89, 0x5f, 0xb6, 0x4c, 0x9b, 0xb8, 0x16, 0x29, 0x9b,
+		0x76, 0xdb, 0x71, 0xcb, 0xbd, 0xdd, 0xb2, 0x4f, 0x3a, 0x2d, 0xc7, 0x32, 0x99, 0xe3, 0xb9, 0xa5,
+		0x8e, 0xef, 0x31, 0x0f, 0xdd, 0x0f, 0x80, 0x25, 0x09, 0x2c, 0x71, 0x60, 0xa9, 0xb7, 0xbb, 0xf1,
+		0xa3, 0x6b, 0xcf, 0xbb, 0x6e, 0x91, 0x32, 0x07, 0x5d, 0x76, 0xaf, 0xca, 0xcc, 0x69, 0x13, 0xca,
+		0xcc, 0x76, 0x47, 0xf0, 0x36, 0x0a, 0x51, 0x07, 0x1d, 0x27, 0x30, 0x6f, 0x79, 0xed, 0xb6, 0xe7,
+		0xce, 0x43, 0xd8, 0x5e, 0xdb, 0x74, 0x42, 0xc4, 0xd3, 0xe9, 0x41, 0x36, 0x1d, 0xca, 0x3c, 0xbf,
+		0x2f, 0x40, 0xc5, 0xbf, 0xc6, 0x60, 0x15, 0x0f, 0xc3, 0x3e, 0x22, 0x94, 0x9a, 0xd7, 0x84, 0xa2,
+		0x3a, 0xac, 0x8c, 0xec, 0xc6, 0x60, 0x26, 0xbd, 0xa1, 0xaa, 0x52, 0x88, 0x6f, 0x2f, 0xed, 0xbd,
+		0x28, 0x4d, 0xdd, 0x54, 0x69, 0xc4, 0x4c, 0xc3, 0xa4, 0x37, 0x38, 0xef, 0x47, 0x05, 0x14, 0xfd,
+		0x02, 0x1e, 0xb6, 0x4c, 0xca, 0x0c, 0x9f, 0x30, 0xdf, 0x21, 0x3d, 0x62, 0x1b, 0x6d, 0xe1, 0xcf,
+		0x70, 0x6c, 0x35, 0x56, 0x50, 0xb6, 0xe3, 0xf8, 0x41, 0x00, 0xc0, 0xa1, 0x5e, 0x86, 0xa3, 0xdb,
+		0xe8, 0x21, 0xa4, 0x9b, 0x26, 0x35, 0xda, 0x9e, 0x4f, 0xd4, 0x78, 0x41, 0xd9, 0x4e, 0xe3, 0x54,
+		0xd3, 0xa4, 0x47, 0x9e, 0x4f, 0x10, 0x86, 0x15, 0xda, 0x77, 0x2d, 0x83, 0x36, 0x4d, 0xdf, 0x36,
+		0x28, 0x33, 0x59, 0x97, 0xaa, 0x0b, 0x05, 0x65, 0x4e, 0xa8, 0xf5, 0xbe, 0x6b, 0xd5, 0x03, 0x78,
+		0x9d, 0xa3, 0x71, 0x8e, 0x46, 0x05, 0xc5, 0xbf, 0x24, 0x21, 0x37, 0xb6, 0x1f, 0xf4, 0x1b, 0x58,
+		0x0c, 0xd2, 0x60, 0xb0, 0x7e, 0x87, 0xa8, 0x4a, 0x41, 0xd9, 0xce, 0xee, 0xed, 0xdc, 0x2d, 0x15,
+		0x8d, 0x7e, 0x87, 0xe0, 0x34, 0x93, 0xbf, 0xd0, 0x33, 0xc8, 0x52, 0xaf, 0xeb, 0x5b, 0x84, 0xa7,
+		0x75, 0xb8, 0xf7, 0x8c, 0x90, 0x06, 0x0c, 0xdd, 0x46, 0xef, 0x60, 0xd9, 0xf2, 0x89, 0x4c, 0xbf,
+		0xd3, 0x16, 0xdb, 0x5e, 0xda, 0xdb, 0x28, 0x89, 0xda, 0x29, 0x85, 0xb5, 0x53, 0x6a, 0x84, 0xb5,
+		0x83, 0x33, 0x21, 0x21, 0x10, 0x21, 0x0b, 0x1e, 0x88, 0x7a, 0x10, 0x6e, 0x4c, 0xc6, 0x7c, 0xe7,
+		0xb2, 0xcb, 0x48, 0x98, 0x9c, 0xd7, 0x33, 0x82, 0xaf, 0x72, 0x52, 0x10, 0x85, 0x36, 0xa0, 0x1c,
+		0xdc, 0xc3, 0x6b, 0xf6, 0x14, 0x39, 0xfa, 0xa3, 0x02, 0x4f, 0x26, 0xb2, 0x3f, 0xe1, 0x30, 0xc1,
+		0x1d, 0xfe, 0xf4, 0x6e, 0xa7, 0x31, 0xe1, 0x79, 0x8b, 0xce, 0x03, 0xa0, 0x1e, 0x70, 0x80, 0x61,
+		0x5a, 0xcc, 0xe9, 0x39, 0xac, 0x3f, 0xe1, 0x3d, 0xc9, 0xbd, 0xef, 0xce, 0xf1, 0xae, 0x49, 0xea,
+		0x84, 0xeb, 0x0d, 0x3a, 0x53, 0x8b, 0xda, 0xb0, 0x21, 0xef, 0x92, 0xf0, 0xd8, 0xdb, 0x1b, 0x75,
+		0x9a, 0xe2, 0x4e, 0x4b, 0x33, 0x9c, 0x1e, 0x08, 0x62, 0x60, 0xf1, 0x7c, 0x2f, 0xe2, 0x71, 0xbd,
+		0x39, 0x5d, 0x85, 0x3c, 0xd8, 0xb8, 0x32, 0x9d, 0x96, 0xd7, 0x23, 0xbe, 0xd1, 0x36, 0xfd, 0x1b,
+		0xe2, 0x8f, 0xba, 0x4b, 0x73, 0x77, 0xe5, 0x19, 0xee, 0xf6, 0x25, 0xf1, 0x88, 0xf3, 0x22, 0xfe,
+		0xd4, 0xab, 0x19, 0xba, 0xf7, 0x19, 0x80, 0xa1, 0x83, 0xe2, 0x3f, 0x62, 0xb0, 0x36, 0xad, 0x32,
+		0xd0, 0x19, 0xe4, 0x65, 0x99, 0x79, 0x1d, 0xe2, 0xf3, 0xf2, 0x93, 0xb7, 0xe3, 0xc5, 0xdc, 0x02,
+		0x3b, 0x09, 0xd1, 0x38, 0x67, 0x47, 0x05, 0x28, 0x0b, 0x31, 0x79, 0x29, 0x16, 0x71, 0xcc, 0xb1,
+		0xd1, 0x1b, 0x48, 0x0a, 0x88, 0xbc, 0x03, 0x9b, 0x63, 0x86, 0x3b, 0xce, 0xd0, 0x2c, 0x96, 0x50,
+		0xf4, 0x1c, 0xb2, 0x96, 0xe7, 0x5e, 0x39, 0xd7, 0x46, 0x8f, 0xf8, 0x34, 0x88, 0x6a, 0x81, 0xdf,
+		0xb2, 0x65, 0x21, 0x3d, 0x17, 0x42, 0xf4, 0x0a, 0xf2, 0x83, 0xb4, 0x86, 0xc0, 0x04, 0x07, 0xe6,
+		0x42, 0x79, 0x08, 0xfd, 0x25, 0x3c, 0xec, 0xf8, 0xa4, 0xe7, 0x78, 0x5d, 0x6a, 0x4c, 0x70, 0x92,
+		0x9c, 0xb3, 0x1e, 0x02, 0xf6, 0xa3, 0xdc, 0xe2, 0xdf, 0x14, 0xd8, 0x9a, 0x5b, 0xe7, 0x41, 0xbc,
+		0xb2, 0x2b, 0x58, 0xad, 0x2e, 0x65, 0xc4, 0xe7, 0x59, 0x5c, 0xc4, 0xcb, 0x42, 0x5a, 0x11, 0xc2,
+		0xa0, 0x11, 0x8a, 0xab, 0x26, 0x33, 0x94, 0xc0, 0x29, 0xbe, 0xd6, 0x6d, 0xf4, 0x73, 0x58, 0x1c,
+		0xcc, 0x91, 0x3b, 0x74, 0x8b, 0x21, 0xb8, 0xf8, 0xef, 0x04, 0x6c, 0xcc, 0xbe, 0x07, 0x68, 0x13,
+		0x16, 0xe5, 0x11, 0x3b, 0xb6, 0x8c, 0x2a, 0x2d, 0x04, 0xba, 0x8d, 0x3e, 0x02, 0xba, 0xf5, 0xfc,
+		0x9b, 0xab, 0x96, 0x77, 0x6b, 0x90, 0xcf, 0xc4, 0xea, 0xf2, 0x0a, 0x88, 0x4d, 0xed, 0xbf, 0xe2,
+		0xa0, 0x3e, 0x49, 0x78, 0x2d, 0x44, 0xe3, 0x95, 0xdb, 0x71, 0x11, 0x52, 0x21, 0x15, 0xa6, 0x36,
+		0xce, 0x53, 0x1b, 0x2e, 0xd1, 0x13, 0xc8, 0x50, 0xab, 0x49, 0xec, 0x6e, 0x8b, 0xf0, 0x2c, 0x88,
+		0x63, 0x5d, 0x1a, 0xc8, 0x74, 0x1b, 0x69, 0x90, 0x1d, 0x42, 0x78, 0xf3, 0x4c, 0x7c, 0x33, 0x1d,
+		0xcb, 0x03, 0x46, 0x20, 0x43, 0x5b, 0x00, 0x94, 0x99, 0x3e, 0x13, 0x3e, 0xc4, 0xe9, 0x2e, 0x4a,
+		0x89, 0x6e, 0xa3, 0x5f, 0x43, 0x26, 0x54, 0x73, 0xfb, 0xa9, 0x6f, 0xda, 0x5f, 0x92, 0x78, 0x6e,
+		0xfd, 0xb7, 0xb0, 0xca, 0x27, 0x61, 0x93, 0x98, 0x3e, 0xbb, 0x24, 0x26, 0x13, 0x56, 0xd2, 0xdf,
+		0xb4, 0xb2, 0x12, 0xd0, 0x0e, 0x42, 0x16, 0xb7, 0xf5, 0x33, 0x48, 0xd9, 0x84, 0x99, 0x4e, 0x8b,
+		0xaa, 0x8b, 0x9c, 0xff, 0x68, 0x6a, 0xd6, 0x4f, 0xcd, 0x7e, 0xcb, 0x33, 0x6d, 0x1c, 0x82, 0x83,
+		0x0c, 0x9b, 0x8c, 0x91, 0x76, 0x87, 0xa9, 0x20, 0x0a, 0x49, 0x2e, 0xd1, 0x3b, 0xc8, 0xf0, 0xe8,
+		0x82, 0x22, 0xef, 0xfa, 0x44, 0x5d, 0x9a, 0x63, 0x76, 0x5f, 0x60, 0xf0, 0x52, 0xc0, 0x90, 0x0b,
+		0xf4, 0x13, 0x58, 0xe3, 0x06, 0x82, 0x63, 0x25, 0xbe, 0xe1, 0xd8, 0xc4, 0x65, 0x0e, 0xeb, 0xab,
+		0x19, 0x5e, 0x3b, 0x28, 0xd0, 0x7d, 0xe2, 0x2a, 0x5d, 0x6a, 0xd0, 0x31, 0xe4, 0xe4, 0xf9, 0x1a,
+		0xb2, 0x01, 0xaa, 0xcb, 0xdc, 0xeb, 0xf3, 0x19, 0x4d, 0x44, 0x5e, 0x2c, 0xd9, 0x48, 0x71, 0xb6,
+		0x17, 0x59, 0x17, 0xff, 0x14, 0x87, 0xf5, 0x19, 0x4d, 0x16, 0xad, 0x43, 0x2a, 0x1c, 0xbc, 0x0a,
+		0x3f, 0xd7, 0x24, 0x13, 0x23, 0x37, 0x52, 0xe7, 0xb1, 0x3b, 0xd5, 0x79, 0xfc, 0x7b, 0xeb, 0xfc,
+		0x0f, 0x70, 0x7f, 0x6c, 0xe3, 0x86, 0xc3, 0x48, 0x3b, 0x18, 0xd2, 0xc1, 0x63, 0xeb, 0xd5, 0x9d,
+		0xb6, 0xaf, 0x33, 0xd2, 0xc6, 0xab, 0xbd, 0x09, 0x19, 0x45, 0x6f, 0x21, 0x49, 0x7a, 0xc4, 0x65,
+		0xe1, 0x0c, 0xde, 0x9a, 0xde, 0x3a, 0x4d, 0x66, 0xbe, 0x6f, 0x79, 0x97, 0x58, 0x82, 0x51, 0x05,
+		0xb2, 0x2e, 0xb9, 0x35, 0xfc, 0xae, 0x6b, 0x48, 0x7a, 0xf2, 0x2e, 0xf4, 0x8c, 0x4b, 0x6e, 0x71,
+		0xd7, 0xad, 0x71, 0x4a, 0xf1, 0xef, 0x0a, 0xa8, 0xb3, 0x26, 0xcf, 0xfc, 0x9e, 0x32, 0xad, 0x29,
+		0xc7, 0xa6, 0x37, 0xe5, 0xef, 0x7d, 0x26, 0x15, 0xff, 0xac, 0xc0, 0x6a, 0x34, 0xca, 0x86, 0x77,
+		0x43, 0xdc, 0x20, 0xc0, 0xb0, 0xd1, 0x8a, 0x97, 0x6f, 0x02, 0xa7, 0x65, 0xa7, 0xa5, 0xe8, 0x77,
+		0x90, 0x1b, 0x1b, 0xc6, 0x6a, 0xec, 0xff, 0x9a, 0xc0, 0x38, 0x1b, 0x9d, 0xbf, 0xc5, 0x7f, 0x46,
+		0x1f, 0xe4, 0xfc, 0x31, 0xe8, 0x5e, 0x79, 0x3f, 0x48, 0x0f, 0xde, 0x1c, 0x7d, 0xf1, 0xc6, 0x79,
+		0x8f, 0x18, 0xbe, 0x62, 0x47, 0x6e, 0xd1, 0x42, 0xe4, 0x16, 0x8d, 0x74, 0xee, 0x44, 0xb4, 0x73,
+		0x3f, 0x83, 0xec, 0x95, 0xe3, 0x53, 0x26, 0x6a, 0x6a, 0xd8, 0x57, 0x33, 0x5c, 0xca, 0xab, 0x46,
+		0xb7, 0x51, 0x11, 0x96, 0x5d, 0xf2, 0x79, 0x04, 0x94, 0x12, 0x0d, 0x3e, 0x10, 0x86, 0x98, 0xf1,
+		0x19, 0x90, 0x9e, 0x98, 0x01, 0x41, 0xf5, 0xe5, 0x47, 0x13, 0xc9, 0x0f, 0x75, 0x74, 0x7a, 0x2a,
+		0xd1, 0xe9, 0xf9, 0x1d, 0x7f, 0x4e, 0x42, 0x6a, 0xc7, 0xf7, 0x2c, 0x42, 0x69, 0x94, 0x1a, 0x1f,
+		0x52, 0x4f, 0x43, 0xfd, 0x80, 0x5a, 0xfc, 0x00, 0xb9, 0xb1, 0x67, 0x41, 0x74, 0x8c, 0x2b, 0xff,
+		0xcb, 0x18, 0x77, 0x61, 0x4d, 0x5e, 0xfe, 0xea, 0xe1, 0x59, 0xc5, 0xeb, 0xba, 0xac, 0xe6, 0x32,
+		0xbf, 0x8f, 0xd6, 0x20, 0x61, 0x05, 0x2b, 0xd9, 0xee, 0xc4, 0x62, 0xde, 0x4b, 0x62, 0xf2, 0x2d,
+		0x12, 0x9f, 0xf2, 0x16, 0xd9, 0xf9, 0x32, 0x59, 0xab, 0xbc, 0x34, 0x9e, 0xc0, 0x16, 0xae, 0x9d,
+		0x1e, 0xea, 0x15, 0xad, 0xa1, 0x9f, 0x1c, 0x1b, 0x0d, 0xad, 0xfe, 0xc1, 0x68, 0x5c, 0x9c, 0xd6,
+		0x0c, 0xfd, 0xf8, 0x5c, 0x3b, 0xd4, 0xab, 0xf9, 0x7b, 0xa8, 0x00, 0x8f, 0xa6, 0x43, 0xaa, 0x27,
+		0x47, 0x9a, 0x7e, 0x9c, 0x57, 0x66, 0x1b, 0x39, 0xd0, 0xeb, 0x8d, 0x13, 0x7c, 0x91, 0x8f, 0xa1,
+		0xd7, 0xf0, 0x72, 0x3a, 0xa4, 0x7e, 0x71, 0x5c, 0x31, 0xea, 0x07, 0x1a, 0xae, 0x1a, 0xf5, 0x86,
+		0xd6, 0xf8, 0x58, 0xcf, 0xc7, 0xd1, 0x4b, 0x78, 0x3a, 0x07, 0xac, 0x55, 0x1a, 0xfa, 0xb9, 0xde,
+		0xb8, 0xc8, 0x2f, 0xa0, 0x1d, 0x78, 0x31, 0xd7, 0xb1, 0x71, 0x54, 0x6b, 0x68, 0x55, 0xad, 0xa1,
+		0xe5, 0x13, 0xe8, 0x19, 0x14, 0xe6, 0x63, 0xcf, 0xf7, 0xf2, 0x49, 0xf4, 0x0a, 0x9e, 0x4f, 0x47,
+		0xed, 0x6b, 0xfa, 0xe1, 0xc9, 0x79, 0x0d, 0x1b, 0x47, 0x1a, 0xfe, 0x50, 0xc3, 0xf9, 0xd4, 0x8e,
+		0x03, 0xb9, 0xb1, 0xe7, 0x31, 0x7a, 0x04, 0xaa, 0x48, 0x8a, 0x71, 0x72, 0x5a, 0xc3, 0xc2, 0xc4,
+		0x30, 0x91, 0x9b, 0xb0, 0x3e, 0xa1, 0xad, 0xe0, 0x9a, 0xd6, 0xa8, 0xe5, 0x95, 0xa9, 0xca, 0x8f,
+		0xa7, 0xd5, 0x40, 0x19, 0xdb, 0x39, 0x86, 0x54, 0xf5, 0xf0, 0x8c, 0x1f, 0xd8, 0x1a, 0xe4, 0xab,
+		0x87, 0x67, 0xe3, 0x67, 0xa4, 0xc2, 0xda, 0x40, 0x3a, 0x12, 0x7f, 0x5e, 0x41, 0xab, 0x90, 0x1b,
+		0x68, 0xe4, 0x81, 0xc5, 0xde, 0x57, 0xfe, 0xf5, 0xf5, 0xb1, 0xf2, 0xe5, 0xeb, 0x63, 0xe5, 0x3f,
+		0x5f, 0x1f, 0x2b, 0xbf, 0x7f, 0x7b, 0xed, 0xb0, 0x66, 0xf7, 0xb2, 0x64, 0x79, 0xed, 0xf2, 0xe8,
+		0x87, 0x88, 0x1f, 0x3b, 0x76, 0xab, 0x7c, 0xed, 0x89, 0x4f, 0x1f, 0x83, 0xaf, 0x12, 0xbf, 0xe2,
+		0x3f, 0x7a, 0xbb, 0x97, 0x49, 0x2e, 0x7f, 0xf3, 0xdf, 0x01, 0x00, 0x86, 0x92, 0xb7, 0x6b, 0x62,
+		0x11, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xdb, 0x36,
-		0x14, 0x9d, 0xec, 0x24, 0x73, 0xae, 0x1c, 0xd7, 0x65, 0x9a, 0x46, 0xf1, 0x86, 0x45, 0x4d, 0x51,
-		0xc0, 0x2b, 0x30, 0x79, 0xf1, 0x86, 0xad, 0xdd, 0xb0, 0x07, 0xc7, 0x52, 0x3b, 0x0f, 0x59, 0x16,
-		0xc8, 0x6e, 0x1e, 0xd6, 0x07, 0x81, 0x96, 0x68, 0x9b, 0xa8, 0x2c, 0x0a, 0x14, 0xed, 0x34, 0x6f,
-		0xc3, 0x3e, 0x62, 0x1f, 0xb3, 0xc7, 0x7d, 0xd9, 0x20, 0x8a, 0x52, 0x1c, 0x5b, 0x48, 0xfa, 0x46,
-		0xde, 0x7b, 0xcf, 0xe1, 0xd1, 0xd1, 0xbd, 0x94, 0xc0, 0x5c, 0x8c, 0x09, 0xef, 0xf8, 0x38, 0x20,
-		0x91, 0x4f, 0x3a, 0x38, 0xa6, 0x9d, 0xe5, 0x69, 0x27, 0x60, 0x73, 0x4c, 0x23, 0x2b, 0xe6, 0x4c,
-		0x30, 0xb4, 0x9f, 0x56, 0x58, 0xaa, 0xc2, 0xc2, 0x31, 0xb5, 0x96, 0xa7, 0xad, 0xaf, 0xa6, 0x8c,
-		0x4d, 0x43, 0xd2, 0x91, 0x25, 0xe3, 0xc5, 0xa4, 0x13, 0x2c, 0x38, 0x16, 0x94, 0x29, 0x50, 0xeb,
-		0x78, 0x3d, 0x2f, 0xe8, 0x9c, 0x24, 0x02, 0xcf, 0xe3, 0xac, 0xe0, 0xe4, 0x9f, 0x1a, 0xec, 0xd8,
-		0xf2, 0x18, 0xd4, 0x80, 0x0a, 0x0d, 0x0c, 0xcd, 0xd4, 0xda, 0xbb, 0x6e, 0x85, 0x06, 0x08, 0xc1,
-		0x56, 0x84, 0xe7, 0xc4, 0xa8, 0xc8, 0x88, 0x5c, 0xa3, 0xd7, 0xb0, 0x93, 0x08, 0x2c, 0x16, 0x89,
-		0x51, 0x35, 0xb5, 0x76, 0xa3, 0xfb, 0xcc, 0x2a, 0x51, 0x65, 0x65, 0x84, 0x43, 0x59, 0xe8, 0x2a,
-		0x00, 0x32, 0x41, 0x0f, 0x48, 0xe2, 0x73, 0x1a, 0xa7, 0xfa, 0x8c, 0x2d, 0xc9, 0xba, 0x1a, 0x42,
-		0xc7, 0xa0, 0xb3, 0xeb, 0x88, 0x70, 0x8f, 0xcc, 0x31, 0x0d, 0x8d, 0x6d, 0x59, 0x01, 0x32, 0xe4,
-		0xa4, 0x11, 0xf4, 0x1a, 0xb6, 0x02, 0x2c, 0xb0, 0xb1, 0x63, 0x56, 0xdb, 0x7a, 0xf7, 0xc5, 0x3d,
-		0x67, 0x5b, 0x36, 0x16, 0xd8, 0x89, 0x04, 0xbf, 0x71, 0x25, 0x04, 0xcd, 0xe0, 0xf9, 0x35, 0xe3,
-		0x1f, 0x26, 0x21, 0xbb, 0xf6, 0xc8, 0x47, 0xe2, 0x2f, 0xd2, 0x13, 0x3d, 0x4e, 0x04, 0x89, 0xe4,
-		0x2a, 0x26, 0x9c, 0xb2, 0xc0, 0xf8, 0xdc, 0xd4, 0xda, 0x7a, 0xf7, 0xc8, 0xca, 0x6c, 0xb3, 0x72,
-		0xdb, 0x2c, 0x5b, 0xd9, 0xea, 0x9a, 0x39, 0x8b, 0x93, 0x93, 0xb8, 0x39, 0xc7, 0xa5, 0xa4, 0x40,
-		0x7d, 0xa8, 0x8f, 0x71, 0xe0, 0x8d, 0x69, 0x84, 0x39, 0x25, 0x89, 0x51, 0x93, 0x94, 0x66, 0xa9,
-		0xd8, 0x33, 0x1c, 0x9c, 0xa9, 0x3a, 0x57, 0x1f, 0xdf, 0x6e, 0xd0, 0x7b, 0x38, 0x9c, 0xd1, 0x44,
-		0x30, 0x7e, 0xe3, 0x61, 0xee, 0xcf, 0xe8, 0x12, 0x87, 0x9e, 0x32, 0x7e, 0x57, 0x1a, 0xff, 0xbc,
-		0x94, 0xaf, 0xa7, 0x6a, 0x95, 0xf5, 0x07, 0x8a, 0xe3, 0x6e, 0x18, 0x7d, 0x0b, 0x4f, 0x36, 0xc8,
-		0x17, 0x9c, 0x1a, 0x20, 0x0d, 0x47, 0x6b, 0xa0, 0x77, 0x9c, 0x22, 0x0c, 0xad, 0x25, 0x4d, 0xe8,
-		0x98, 0x86, 0x54, 0x6c, 0x2a, 0xd2, 0x3f, 0x5d, 0x91, 0x71, 0x4b, 0xb3, 0x26, 0xea, 0x07, 0x38,
-		0x2c, 0x3b, 0x22, 0xd5, 0x55, 0x97, 0xba, 0x0e, 0x36, 0xa1, 0xa9, 0x34, 0x0b, 0xf6, 0xb1, 0x2f,
-		0xe8, 0x92, 0x78, 0x7e, 0xb8, 0x48, 0x04, 0xe1, 0x9e, 0x6c, 0xda, 0x3d, 0x89, 0x79, 0x9c, 0xa5,
-		0xfa, 0x59, 0xe6, 0x22, 0xed, 0xe0, 0x4b, 0xa8, 0xa9, 0xc2, 0xc4, 0x68, 0xc8, 0x3e, 0xfa, 0xbe,
-		0x54, 0xb8, 0xc2, 0xb8, 0x24, 0x0e, 0xa9, 0x2f, 0xdf, 0x7d, 0x9f, 0x45, 0x13, 0x3a, 0xcd, 0x1b,
-		0xa1, 0x60, 0x41, 0x5f, 0x43, 0x73, 0x82, 0x69, 0xc8, 0x96, 0x84, 0x7b, 0x4b, 0xc2, 0x93, 0xb4,
-		0xbb, 0x1f, 0x99, 0x5a, 0xbb, 0xea, 0x3e, 0xca, 0xe3, 0x57, 0x59, 0x18, 0xb5, 0xa1, 0x49, 0x13,
-		0x6f, 0x1a, 0xb2, 0x31, 0x0e, 0xbd, 0x6c, 0xba, 0x8d, 0xa6, 0xa9, 0xb5, 0x6b, 0x6e, 0x83, 0x26,
-		0x6f, 0x65, 0x58, 0x0d, 0xe3, 0x1b, 0xd8, 0x2b, 0x48, 0x69, 0x34, 0x61, 0xc6, 0x63, 0xd9, 0x46,
-		0xe5, 0xf3, 0xf6, 0x46, 0x55, 0x0e, 0xa2, 0x09, 0x73, 0xeb, 0x93, 0x95, 0x5d, 0xeb, 0x47, 0xd8,
-		0x2d, 0x46, 0x01, 0x35, 0xa1, 0xfa, 0x81, 0xdc, 0xa8, 0x11, 0x4f, 0x97, 0xe8, 0x09, 0x6c, 0x2f,
-		0x71, 0xb8, 0xc8, 0x87, 0x3c, 0xdb, 0xfc, 0x54, 0x79, 0xa5, 0x9d, 0xd8, 0x70, 0xfc, 0x80, 0x05,
-		0xe8, 0x19, 0xd4, 0xef, 0x78, 0x9e, 0xf1, 0xea, 0xfe, 0xad, 0xdb, 0x27, 0xff, 0x6a, 0xa0, 0xaf,
-		0x34, 0x39, 0xfa, 0x0d, 0x6a, 0xc5, 0x60, 0x68, 0xd2, 0x7d, 0xeb, 0xa1, 0xc1, 0xb0, 0xf2, 0x45,
-		0x36, 0xce, 0x05, 0xbe, 0xe5, 0xc1, 0xde, 0x9d, 0x54, 0xc9, 0xe3, 0xbd, 0x5a, 0x7d, 0x3c, 0xbd,
-		0x7b, 0x72, 0xef, 0x59, 0x37, 0xd2, 0xbe, 0x15, 0x0b, 0xfe, 0xd6, 0x60, 0xef, 0x4e, 0x12, 0x3d,
-		0x85, 0x1d, 0x4e, 0x70, 0xc2, 0x22, 0x75, 0x88, 0xda, 0xa1, 0x16, 0xd4, 0x58, 0x4c, 0x38, 0x16,
-		0x8c, 0x2b, 0x27, 0x8b, 0x3d, 0xfa, 0x05, 0xea, 0x3e, 0x27, 0x58, 0x90, 0xc0, 0x4b, 0x2f, 0x5f,
-		0x79, 0x71, 0xea, 0xdd, 0xd6, 0xc6, 0x15, 0x33, 0xca, 0x6f, 0x66, 0x57, 0x57, 0xf5, 0x69, 0xe4,
-		0xe4, 0xbf, 0x0a, 0xd4, 0x57, 0xdf, 0x6f, 0x69, 0xbb, 0x69, 0xe5, 0xed, 0x36, 0x02, 0xa3, 0x28,
-		0x4d, 0x04, 0xe6, 0xc2, 0x2b, 0xae, 0x7f, 0xe5, 0xc8, 0x7d, 0x32, 0x9e, 0xe6, 0xd8, 0x61, 0x0a,
-		0x2d, 0xe2, 0xe8, 0x0a, 0x8e, 0x0a, 0x56, 0xf2, 0x31, 0xa6, 0x9c, 0xac, 0xd0, 0x3e, 0xfc, 0x74,
-		0x87, 0x39, 0xd8, 0x91, 0xd8, 0x5b, 0xde, 0x2e, 0x1c, 0xf8, 0x6c, 0x1e, 0x87, 0x24, 0xb5, 0x2a,
-		0x99, 0x61, 0x1e, 0x78, 0x3e, 0x5b, 0x44, 0x42, 0x7e, 0x2a, 0xb6, 0xdd, 0xfd, 0x22, 0x39, 0x4c,
-		0x73, 0xfd, 0x34, 0x85, 0x5e, 0x40, 0x23, 0x26, 0x51, 0x40, 0xa3, 0x69, 0x86, 0x48, 0x8c, 0x6d,
-		0xb3, 0xda, 0xde, 0x76, 0xf7, 0x54, 0x54, 0x96, 0x26, 0x2f, 0xff, 0xd2, 0xa0, 0xbe, 0xfa, 0x51,
-		0x42, 0x47, 0x70, 0x60, 0xff, 0xf1, 0x7b, 0x6f, 0x70, 0xe1, 0x0d, 0x47, 0xbd, 0xd1, 0xbb, 0xa1,
-		0x37, 0xb8, 0xb8, 0xea, 0x9d, 0x0f, 0xec, 0xe6, 0x67, 0xe8, 0x4b, 0x30, 0xee, 0xa6, 0x5c, 0xe7,
-		0xed, 0x60, 0x38, 0x72, 0x5c, 0xc7, 0x6e, 0x6a, 0x9b, 0x59, 0xdb, 0xb9, 0x74, 0x9d, 0x7e, 0x6f,
-		0xe4, 0xd8, 0xcd, 0xca, 0x26, 0xad, 0xed, 0x9c, 0x3b, 0x69, 0xaa, 0xfa, 0x72, 0x06, 0x8d, 0xb5,
-		0x1b, 0xef, 0x0b, 0x38, 0xec, 0xb9, 0xfd, 0x5f, 0x07, 0x57, 0xbd, 0xf3, 0x52, 0x15, 0xeb, 0x49,
-		0x7b, 0x30, 0xec, 0x9d, 0x9d, 0x4b, 0x15, 0x25, 0x50, 0xe7, 0x22, 0x4b, 0x56, 0xce, 0xde, 0xc3,
-		0xa1, 0xcf, 0xe6, 0x65, 0xad, 0x7e, 0xa6, 0x67, 0x26, 0x5c, 0xa6, 0x6f, 0xe5, 0x52, 0xfb, 0xf3,
-		0x74, 0x4a, 0xc5, 0x6c, 0x31, 0xb6, 0x7c, 0x36, 0xef, 0xac, 0xfe, 0x7f, 0x7c, 0x43, 0x83, 0xb0,
-		0x33, 0x65, 0xd9, 0x5f, 0x83, 0xfa, 0x19, 0xf9, 0x19, 0xc7, 0x74, 0x79, 0x3a, 0xde, 0x91, 0xb1,
-		0xef, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x01, 0x9f, 0x23, 0xb0, 0x08, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
+		0x10, 0x2d, 0xa5, 0xd8, 0x95, 0x87, 0xb2, 0xa2, 0xac, 0xe3, 0x98, 0x56, 0x0b, 0x9b, 0x71, 0x10,
+		0x40, 0x0d, 0x50, 0xaa, 0x56, 0x8b, 0x36, 0x69, 0xd1, 0x83, 0x2c, 0x32, 0xa9, 0x0a, 0xd7, 0x35,
+		0x28, 0xc5, 0x87, 0xf6, 0x40, 0xac, 0xc8, 0x95, 0xb4, 0x08, 0xc5, 0x25, 0x96, 0x2b, 0x39, 0xbe,
+		0x15, 0xfd, 0x88, 0x7e, 0x4c, 0x8f, 0x3d, 0xf5, 0xd8, 0x4f, 0x28, 0xfc, 0x25, 0x05, 0x97, 0x4b,
+		0x5a, 0xb6, 0x88, 0xb8, 0xb7, 0xdd, 0x99, 0x79, 0x6f, 0x1f, 0x1f, 0x67, 0x96, 0x04, 0x73, 0x31,
+		0x26, 0xbc, 0xe3, 0xe3, 0x80, 0x44, 0x3e, 0xe9, 0xe0, 0x98, 0x76, 0x96, 0xc7, 0x9d, 0x80, 0xcd,
+		0x31, 0x8d, 0xac, 0x98, 0x33, 0xc1, 0xd0, 0x4e, 0x5a, 0x61, 0xa9, 0x0a, 0x0b, 0xc7, 0xd4, 0x5a,
+		0x1e, 0xb7, 0x0e, 0xa6, 0x8c, 0x4d, 0x43, 0xd2, 0x91, 0x25, 0xe3, 0xc5, 0xa4, 0x13, 0x2c, 0x38,
+		0x16, 0x94, 0x29, 0x50, 0xeb, 0xf0, 0x6e, 0x5e, 0xd0, 0x39, 0x49, 0x04, 0x9e, 0xc7, 0x59, 0xc1,
+		0xd1, 0x1f, 0x35, 0xd8, 0xb4, 0xe5, 0x31, 0xa8, 0x01, 0x15, 0x1a, 0x18, 0x9a, 0xa9, 0xb5, 0xb7,
+		0xdc, 0x0a, 0x0d, 0x10, 0x82, 0x07, 0x11, 0x9e, 0x13, 0xa3, 0x22, 0x23, 0x72, 0x8d, 0x5e, 0xc1,
+		0x66, 0x22, 0xb0, 0x58, 0x24, 0x46, 0xd5, 0xd4, 0xda, 0x8d, 0xee, 0x53, 0xab, 0x44, 0x95, 0x95,
+		0x11, 0x0e, 0x65, 0xa1, 0xab, 0x00, 0xc8, 0x04, 0x3d, 0x20, 0x89, 0xcf, 0x69, 0x9c, 0xea, 0x33,
+		0x1e, 0x48, 0xd6, 0xd5, 0x10, 0x3a, 0x04, 0x9d, 0x5d, 0x46, 0x84, 0x7b, 0x64, 0x8e, 0x69, 0x68,
+		0x6c, 0xc8, 0x0a, 0x90, 0x21, 0x27, 0x8d, 0xa0, 0x57, 0xf0, 0x20, 0xc0, 0x02, 0x1b, 0x9b, 0x66,
+		0xb5, 0xad, 0x77, 0x9f, 0x7f, 0xe0, 0x6c, 0xcb, 0xc6, 0x02, 0x3b, 0x91, 0xe0, 0x57, 0xae, 0x84,
+		0xa0, 0x19, 0x3c, 0xbb, 0x64, 0xfc, 0xdd, 0x24, 0x64, 0x97, 0x1e, 0x79, 0x4f, 0xfc, 0x45, 0x7a,
+		0xa2, 0xc7, 0x89, 0x20, 0x91, 0x5c, 0xc5, 0x84, 0x53, 0x16, 0x18, 0x1f, 0x9b, 0x5a, 0x5b, 0xef,
+		0xee, 0x5b, 0x99, 0x6d, 0x56, 0x6e, 0x9b, 0x65, 0x2b, 0x5b, 0x5d, 0x33, 0x67, 0x71, 0x72, 0x12,
+		0x37, 0xe7, 0x38, 0x97, 0x14, 0xa8, 0x0f, 0xf5, 0x31, 0x0e, 0xbc, 0x31, 0x8d, 0x30, 0xa7, 0x24,
+		0x31, 0x6a, 0x92, 0xd2, 0x2c, 0x15, 0x7b, 0x82, 0x83, 0x13, 0x55, 0xe7, 0xea, 0xe3, 0x9b, 0x0d,
+		0xfa, 0x15, 0xf6, 0x66, 0x34, 0x11, 0x8c, 0x5f, 0x79, 0x98, 0xfb, 0x33, 0xba, 0xc4, 0xa1, 0xa7,
+		0x8c, 0xdf, 0x92, 0xc6, 0x3f, 0x2b, 0xe5, 0xeb, 0xa9, 0x5a, 0x65, 0xfd, 0xae, 0xe2, 0xb8, 0x1d,
+		0x46, 0x5f, 0xc0, 0xe3, 0x35, 0xf2, 0x05, 0xa7, 0x06, 0x48, 0xc3, 0xd1, 0x1d, 0xd0, 0x5b, 0x4e,
+		0x11, 0x86, 0xd6, 0x92, 0x26, 0x74, 0x4c, 0x43, 0x2a, 0xd6, 0x15, 0xe9, 0xff, 0x5f, 0x91, 0x71,
+		0x43, 0x73, 0x47, 0xd4, 0xd7, 0xb0, 0x57, 0x76, 0x44, 0xaa, 0xab, 0x2e, 0x75, 0xed, 0xae, 0x43,
+		0x53, 0x69, 0x16, 0xec, 0x60, 0x5f, 0xd0, 0x25, 0xf1, 0xfc, 0x70, 0x91, 0x08, 0xc2, 0x3d, 0xd9,
+		0xb4, 0xdb, 0x12, 0xf3, 0x28, 0x4b, 0xf5, 0xb3, 0xcc, 0x59, 0xda, 0xc1, 0xe7, 0x50, 0x53, 0x85,
+		0x89, 0xd1, 0x90, 0x7d, 0xf4, 0x55, 0xa9, 0x70, 0x85, 0x71, 0x49, 0x1c, 0x52, 0x5f, 0xbe, 0xfb,
+		0x3e, 0x8b, 0x26, 0x74, 0x9a, 0x37, 0x42, 0xc1, 0x82, 0x3e, 0x83, 0xe6, 0x04, 0xd3, 0x90, 0x2d,
+		0x09, 0xf7, 0x96, 0x84, 0x27, 0x69, 0x77, 0x3f, 0x34, 0xb5, 0x76, 0xd5, 0x7d, 0x98, 0xc7, 0x2f,
+		0xb2, 0x30, 0x6a, 0x43, 0x93, 0x26, 0xde, 0x34, 0x64, 0x63, 0x1c, 0x7a, 0xd9, 0x74, 0x1b, 0x4d,
+		0x53, 0x6b, 0xd7, 0xdc, 0x06, 0x4d, 0xde, 0xc8, 0xb0, 0x1a, 0xc6, 0xd7, 0xb0, 0x5d, 0x90, 0xd2,
+		0x68, 0xc2, 0x8c, 0x47, 0xb2, 0x8d, 0xca, 0xe7, 0xed, 0xb5, 0xaa, 0x1c, 0x44, 0x13, 0xe6, 0xd6,
+		0x27, 0x2b, 0xbb, 0xd6, 0x37, 0xb0, 0x55, 0x8c, 0x02, 0x6a, 0x42, 0xf5, 0x1d, 0xb9, 0x52, 0x23,
+		0x9e, 0x2e, 0xd1, 0x63, 0xd8, 0x58, 0xe2, 0x70, 0x91, 0x0f, 0x79, 0xb6, 0xf9, 0xb6, 0xf2, 0x52,
+		0x3b, 0xb2, 0xe1, 0xf0, 0x1e, 0x0b, 0xd0, 0x53, 0xa8, 0xdf, 0xf2, 0x3c, 0xe3, 0xd5, 0xfd, 0x1b,
+		0xb7, 0x8f, 0xfe, 0xd4, 0x40, 0x5f, 0x69, 0x72, 0xf4, 0x23, 0xd4, 0x8a, 0xc1, 0xd0, 0xa4, 0xfb,
+		0xd6, 0x7d, 0x83, 0x61, 0xe5, 0x8b, 0x6c, 0x9c, 0x0b, 0x7c, 0xcb, 0x83, 0xed, 0x5b, 0xa9, 0x92,
+		0xc7, 0x7b, 0xb9, 0xfa, 0x78, 0x7a, 0xf7, 0xe8, 0x83, 0x67, 0x5d, 0x49, 0xfb, 0x56, 0x2c, 0xf8,
+		0x5d, 0x83, 0xed, 0x5b, 0x49, 0xf4, 0x04, 0x36, 0x39, 0xc1, 0x09, 0x8b, 0xd4, 0x21, 0x6a, 0x87,
+		0x5a, 0x50, 0x63, 0x31, 0xe1, 0x58, 0x30, 0xae, 0x9c, 0x2c, 0xf6, 0xe8, 0x7b, 0xa8, 0xfb, 0x9c,
+		0x60, 0x41, 0x02, 0x2f, 0xbd, 0x7c, 0xe5, 0xc5, 0xa9, 0x77, 0x5b, 0x6b, 0x57, 0xcc, 0x28, 0xbf,
+		0x99, 0x5d, 0x5d, 0xd5, 0xa7, 0x91, 0xa3, 0xbf, 0x2a, 0x50, 0x5f, 0x7d, 0xbf, 0xa5, 0xed, 0xa6,
+		0x95, 0xb7, 0xdb, 0x08, 0x8c, 0xa2, 0x34, 0x11, 0x98, 0x0b, 0xaf, 0xb8, 0xfe, 0x8d, 0xca, 0xbd,
+		0x32, 0x9e, 0xe4, 0xd8, 0x61, 0x0a, 0x2d, 0xe2, 0xe8, 0x02, 0xf6, 0x0b, 0x56, 0xf2, 0x3e, 0xa6,
+		0x9c, 0xac, 0xd0, 0xde, 0xff, 0x74, 0x7b, 0x39, 0xd8, 0x91, 0xd8, 0x1b, 0xde, 0x2e, 0xec, 0xfa,
+		0x6c, 0x1e, 0x87, 0x24, 0xb5, 0x2a, 0x99, 0x61, 0x1e, 0x78, 0x3e, 0x5b, 0x44, 0x42, 0x7e, 0x2a,
+		0x36, 0xdc, 0x9d, 0x22, 0x39, 0x4c, 0x73, 0xfd, 0x34, 0x85, 0x9e, 0x43, 0x23, 0x26, 0x51, 0x40,
+		0xa3, 0x69, 0x86, 0x48, 0x8c, 0x0d, 0xb3, 0xda, 0xde, 0x70, 0xb7, 0x55, 0x54, 0x96, 0x26, 0x2f,
+		0x7e, 0xd3, 0xa0, 0xbe, 0xfa, 0x51, 0x42, 0xfb, 0xb0, 0x6b, 0xff, 0xfc, 0x53, 0x6f, 0x70, 0xe6,
+		0x0d, 0x47, 0xbd, 0xd1, 0xdb, 0xa1, 0x37, 0x38, 0xbb, 0xe8, 0x9d, 0x0e, 0xec, 0xe6, 0x47, 0xe8,
+		0x53, 0x30, 0x6e, 0xa7, 0x5c, 0xe7, 0xcd, 0x60, 0x38, 0x72, 0x5c, 0xc7, 0x6e, 0x6a, 0xeb, 0x59,
+		0xdb, 0x39, 0x77, 0x9d, 0x7e, 0x6f, 0xe4, 0xd8, 0xcd, 0xca, 0x3a, 0xad, 0xed, 0x9c, 0x3a, 0x69,
+		0xaa, 0xfa, 0x62, 0x06, 0x8d, 0x3b, 0x37, 0xde, 0x27, 0xb0, 0xd7, 0x73, 0xfb, 0x3f, 0x0c, 0x2e,
+		0x7a, 0xa7, 0xa5, 0x2a, 0xee, 0x26, 0xed, 0xc1, 0xb0, 0x77, 0x72, 0x2a, 0x55, 0x94, 0x40, 0x9d,
+		0xb3, 0x2c, 0x59, 0x39, 0x99, 0xfe, 0x7d, 0x7d, 0xa0, 0xfd, 0x73, 0x7d, 0xa0, 0xfd, 0x7b, 0x7d,
+		0xa0, 0xc1, 0x9e, 0xcf, 0xe6, 0x65, 0x6d, 0x7f, 0xa2, 0x67, 0x86, 0x9c, 0xa7, 0x6f, 0xe8, 0x5c,
+		0xfb, 0xe5, 0x78, 0x4a, 0xc5, 0x6c, 0x31, 0xb6, 0x7c, 0x36, 0xef, 0xac, 0xfe, 0x8b, 0x7c, 0x4e,
+		0x83, 0xb0, 0x33, 0x65, 0xd9, 0x1f, 0x84, 0xfa, 0x31, 0xf9, 0x0e, 0xc7, 0x74, 0x79, 0x3c, 0xde,
+		0x94, 0xb1, 0x2f, 0xff, 0x1b, 0x00, 0x31, 0xb5, 0xbc, 0xbe, 0xbc, 0x08, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -3930,8 +4137,8 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0xb3, 0xa4, 0x83, 0x02, 0x7f, 0x39, 0xf3, 0x44, 0x7c, 0x8b, 0xb6, 0xcb, 0x9f, 0xbc, 0x41, 0x6f,
 		0xf8, 0x6f, 0xd2, 0x9f, 0x9e, 0xfd, 0xea, 0xe6, 0xcf, 0xe7, 0xdb, 0x85, 0x7a, 0xb6, 0xf6, 0x6e,
 		0x2e, 0x5f, 0x2f, 0xe6, 0xc2, 0x96, 0x75, 0x81, 0x99, 0x5a, 0x90, 0xe0, 0xf8, 0x78, 0x0e, 0x92,
-		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x07, 0x00,
-		0x00, 0xff, 0xff, 0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
+		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x0f, 0x00,
+		0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/workflow.proto
 	[]byte{
@@ -3948,8 +4155,7 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0x30, 0x7f, 0x4f, 0x17, 0x01, 0x56, 0xac, 0x26, 0x06, 0x05, 0x85, 0x06, 0x80, 0x4c, 0x64, 0x73,
 		0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x09,
 		0x21, 0xbd, 0xf4, 0xd4, 0x3c, 0x7d, 0x70, 0x98, 0x20, 0x02, 0xcb, 0x1a, 0xc2, 0x2a, 0x33, 0x4c,
-		0x62, 0x03, 0xcb, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff, 0xc3, 0x37, 0x89, 0x56, 0x01,
-		0x00, 0x00,
+		0x62, 0x03, 0xcb, 0x18, 0x03, 0x06, 0x00, 0xff, 0xc3, 0x37, 0x89, 0x56, 0x01, 0x00, 0x00,
 	},
 }
 
@@ -54,6 +54,12 @@ type MatchingAPIYARPCClient interface {
 	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest, ...yarpc.CallOption) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
+	DescribeTaskListConfig(context.Context, *DescribeTaskListConfigRequest, ...yarpc.CallOption) (*DescribeTaskListConfigResponse, error)
+	UpdateTaskListConfig(context.Context, *UpdateTaskListConfigRequest, ...yarpc.CallOption) (*UpdateTaskListConfigResponse, error)
+	RequeueDeadLetterTasks(context.Context, *RequeueDeadLetterTasksRequest, ...yarpc.CallOption) (*RequeueDeadLetterTasksResponse, error)
+	MigrateTaskList(context.Context, *MigrateTaskListRequest, ...yarpc.CallOption) (*MigrateTaskListResponse, error)
+	DeleteTaskList(context.Context, *DeleteTaskListRequest, ...yarpc.CallOption) (*DeleteTaskListResponse, error)
+	ListStickyTaskLists(context.Context, *ListStickyTaskListsRequest, ...yarpc.CallOption) (*ListStickyTaskListsResponse, error)
 }
 
 func newMatchingAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingAPIYARPCClient {
@@ -84,6 +90,12 @@ type MatchingAPIYARPCServer interface {
 	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
+	DescribeTaskListConfig(context.Context, *DescribeTaskListConfigRequest) (*DescribeTaskListConfigResponse, error)
+	UpdateTaskListConfig(context.Context, *UpdateTaskListConfigRequest) (*UpdateTaskListConfigResponse, error)
+	RequeueDeadLetterTasks(context.Context, *RequeueDeadLetterTasksRequest) (*RequeueDeadLetterTasksResponse, error)
+	MigrateTaskList(context.Context, *MigrateTaskListRequest) (*MigrateTaskListResponse, error)
+	DeleteTaskList(context.Context, *DeleteTaskListRequest) (*DeleteTaskListResponse, error)
+	ListStickyTaskLists(context.Context, *ListStickyTaskListsRequest) (*ListStickyTaskListsResponse, error)
 }
 
 type buildMatchingAPIYARPCProceduresParams struct {
@@ -197,6 +209,66 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "DescribeTaskListConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeTaskListConfig,
+							NewRequest:  newMatchingAPIServiceDescribeTaskListConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateTaskListConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateTaskListConfig,
+							NewRequest:  newMatchingAPIServiceUpdateTaskListConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RequeueDeadLetterTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RequeueDeadLetterTasks,
+							NewRequest:  newMatchingAPIServiceRequeueDeadLetterTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MigrateTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MigrateTaskList,
+							NewRequest:  newMatchingAPIServiceMigrateTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeleteTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeleteTaskList,
+							NewRequest:  newMatchingAPIServiceDeleteTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListStickyTaskLists",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListStickyTaskLists,
+							NewRequest:  newMatchingAPIServiceListStickyTaskListsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -237,10 +309,10 @@ type FxMatchingAPIYARPCClientResult struct {
 // NewFxMatchingAPIYARPCClient provides a MatchingAPIYARPCClient
 // to an Fx application using the given name for routing.
 //
-//  fx.Provide(
-//    matchingv1.NewFxMatchingAPIYARPCClient("service-name"),
-//    ...
-//  )
+//	fx.Provide(
+//	  matchingv1.NewFxMatchingAPIYARPCClient("service-name"),
+//	  ...
+//	)
 func NewFxMatchingAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
 	return func(params FxMatchingAPIYARPCClientParams) FxMatchingAPIYARPCClientResult {
 		cc := params.Provider.ClientConfig(name)
@@ -286,10 +358,10 @@ type FxMatchingAPIYARPCProceduresResult struct {
 // NewFxMatchingAPIYARPCProcedures provides MatchingAPIYARPCServer procedures to an Fx application.
 // It expects a MatchingAPIYARPCServer to be present in the container.
 //
-//  fx.Provide(
-//    matchingv1.NewFxMatchingAPIYARPCProcedures(),
-//    ...
-//  )
+//	fx.Provide(
+//	  matchingv1.NewFxMatchingAPIYARPCProcedures(),
+//	  ...
+//	)
 func NewFxMatchingAPIYARPCProcedures() interface{} {
 	return func(params FxMatchingAPIYARPCProceduresParams) FxMatchingAPIYARPCProceduresResult {
 		return FxMatchingAPIYARPCProceduresResult{
@@ -429,6 +501,78 @@ func (c *_MatchingAPIYARPCCaller) GetTaskListsByDomain(ctx context.Context, requ
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) DescribeTaskListConfig(ctx context.Context, request *DescribeTaskListConfigRequest, options ...yarpc.CallOption) (*DescribeTaskListConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskListConfig", request, newMatchingAPIServiceDescribeTaskListConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceDescribeTaskListConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) UpdateTaskListConfig(ctx context.Context, request *UpdateTaskListConfigRequest, options ...yarpc.CallOption) (*UpdateTaskListConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateTaskListConfig", request, newMatchingAPIServiceUpdateTaskListConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateTaskListConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceUpdateTaskListConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) RequeueDeadLetterTasks(ctx context.Context, request *RequeueDeadLetterTasksRequest, options ...yarpc.CallOption) (*RequeueDeadLetterTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RequeueDeadLetterTasks", request, newMatchingAPIServiceRequeueDeadLetterTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RequeueDeadLetterTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceRequeueDeadLetterTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) MigrateTaskList(ctx context.Context, request *MigrateTaskListRequest, options ...yarpc.CallOption) (*MigrateTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MigrateTaskList", request, newMatchingAPIServiceMigrateTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MigrateTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceMigrateTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest, options ...yarpc.CallOption) (*DeleteTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeleteTaskList", request, newMatchingAPIServiceDeleteTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeleteTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceDeleteTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) ListStickyTaskLists(ctx context.Context, request *ListStickyTaskListsRequest, options ...yarpc.CallOption) (*ListStickyTaskListsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListStickyTaskLists", request, newMatchingAPIServiceListStickyTaskListsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListStickyTaskListsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceListStickyTaskListsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _MatchingAPIYARPCHandler struct {
 	server MatchingAPIYARPCServer
 }
@@ -593,6 +737,102 @@ func (h *_MatchingAPIYARPCHandler) GetTaskListsByDomain(ctx context.Context, req
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) DescribeTaskListConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceDescribeTaskListConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskListConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) UpdateTaskListConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateTaskListConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateTaskListConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceUpdateTaskListConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateTaskListConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) RequeueDeadLetterTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RequeueDeadLetterTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RequeueDeadLetterTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceRequeueDeadLetterTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RequeueDeadLetterTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) MigrateTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MigrateTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MigrateTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceMigrateTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MigrateTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) DeleteTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeleteTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeleteTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceDeleteTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeleteTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) ListStickyTaskLists(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListStickyTaskListsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListStickyTaskListsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceListStickyTaskListsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListStickyTaskLists(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newMatchingAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
 	return &PollForDecisionTaskRequest{}
 }
@@ -673,6 +913,54 @@ func newMatchingAPIServiceGetTaskListsByDomainYARPCResponse() proto.Message {
 	return &GetTaskListsByDomainResponse{}
 }
 
+func newMatchingAPIServiceDescribeTaskListConfigYARPCRequest() proto.Message {
+	return &DescribeTaskListConfigRequest{}
+}
+
+func newMatchingAPIServiceDescribeTaskListConfigYARPCResponse() proto.Message {
+	return &DescribeTaskListConfigResponse{}
+}
+
+func newMatchingAPIServiceUpdateTaskListConfigYARPCRequest() proto.Message {
+	return &UpdateTaskListConfigRequest{}
+}
+
+func newMatchingAPIServiceUpdateTaskListConfigYARPCResponse() proto.Message {
+	return &UpdateTaskListConfigResponse{}
+}
+
+func newMatchingAPIServiceRequeueDeadLetterTasksYARPCRequest() proto.Message {
+	return &RequeueDeadLetterTasksRequest{}
+}
+
+func newMatchingAPIServiceRequeueDeadLetterTasksYARPCResponse() proto.Message {
+	return &RequeueDeadLetterTasksResponse{}
+}
+
+func newMatchingAPIServiceMigrateTaskListYARPCRequest() proto.Message {
+	return &MigrateTaskListRequest{}
+}
+
+func newMatchingAPIServiceMigrateTaskListYARPCResponse() proto.Message {
+	return &MigrateTaskListResponse{}
+}
+
+func newMatchingAPIServiceDeleteTaskListYARPCRequest() proto.Message {
+	return &DeleteTaskListRequest{}
+}
+
+func newMatchingAPIServiceDeleteTaskListYARPCResponse() proto.Message {
+	return &DeleteTaskListResponse{}
+}
+
+func newMatchingAPIServiceListStickyTaskListsYARPCRequest() proto.Message {
+	return &ListStickyTaskListsRequest{}
+}
+
+func newMatchingAPIServiceListStickyTaskListsYARPCResponse() proto.Message {
+	return &ListStickyTaskListsResponse{}
+}
+
 var (
 	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest        = &PollForDecisionTaskRequest{}
 	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse       = &PollForDecisionTaskResponse{}
@@ -694,136 +982,193 @@ var (
 	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse    = &ListTaskListPartitionsResponse{}
 	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest       = &GetTaskListsByDomainRequest{}
 	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse      = &GetTaskListsByDomainResponse{}
+	emptyMatchingAPIServiceDescribeTaskListConfigYARPCRequest     = &DescribeTaskListConfigRequest{}
+	emptyMatchingAPIServiceDescribeTaskListConfigYARPCResponse    = &DescribeTaskListConfigResponse{}
+	emptyMatchingAPIServiceUpdateTaskListConfigYARPCRequest       = &UpdateTaskListConfigRequest{}
+	emptyMatchingAPIServiceUpdateTaskListConfigYARPCResponse      = &UpdateTaskListConfigResponse{}
+	emptyMatchingAPIServiceRequeueDeadLetterTasksYARPCRequest     = &RequeueDeadLetterTasksRequest{}
+	emptyMatchingAPIServiceRequeueDeadLetterTasksYARPCResponse    = &RequeueDeadLetterTasksResponse{}
+	emptyMatchingAPIServiceMigrateTaskListYARPCRequest            = &MigrateTaskListRequest{}
+	emptyMatchingAPIServiceMigrateTaskListYARPCResponse           = &MigrateTaskListResponse{}
+	emptyMatchingAPIServiceDeleteTaskListYARPCRequest             = &DeleteTaskListRequest{}
+	emptyMatchingAPIServiceDeleteTaskListYARPCResponse            = &DeleteTaskListResponse{}
+	emptyMatchingAPIServiceListStickyTaskListsYARPCRequest        = &ListStickyTaskListsRequest{}
+	emptyMatchingAPIServiceListStickyTaskListsYARPCResponse       = &ListStickyTaskListsResponse{}
 )
 
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdb, 0x72, 0xdb, 0xc6,
-		0x19, 0x1e, 0xe8, 0x44, 0xf1, 0xa7, 0x44, 0xcb, 0xeb, 0x44, 0x81, 0x28, 0xcb, 0x92, 0x99, 0x26,
-		0x55, 0x3b, 0x29, 0x54, 0x29, 0xb1, 0xeb, 0xd8, 0xd3, 0xe9, 0xc8, 0x92, 0x15, 0xb3, 0x53, 0xd7,
-		0x0e, 0xac, 0xba, 0x33, 0x9d, 0x8e, 0x31, 0x4b, 0x60, 0x25, 0xa2, 0x22, 0x01, 0x18, 0xbb, 0xa4,
-		0xc2, 0x5e, 0xf4, 0xa2, 0x93, 0x76, 0x3a, 0x93, 0xdb, 0x3e, 0x41, 0x9b, 0xcb, 0x3e, 0x42, 0x1f,
-		0xa0, 0xef, 0x90, 0xe9, 0x65, 0xdf, 0xa3, 0xb3, 0x07, 0x80, 0x00, 0xb9, 0xe0, 0x41, 0x72, 0x92,
-		0x3b, 0x62, 0xf7, 0xff, 0xbf, 0xff, 0xb4, 0xff, 0x61, 0x97, 0xf0, 0x61, 0xb7, 0x49, 0xe2, 0x3d,
-		0x17, 0x7b, 0x24, 0x70, 0xc9, 0x5e, 0x07, 0x33, 0xb7, 0xe5, 0x07, 0xe7, 0x7b, 0xbd, 0xfd, 0x3d,
-		0x4a, 0xe2, 0x9e, 0xef, 0x12, 0x2b, 0x8a, 0x43, 0x16, 0x22, 0x93, 0xd3, 0x59, 0x8a, 0xce, 0x4a,
-		0xe8, 0xac, 0xde, 0x7e, 0xed, 0xce, 0x79, 0x18, 0x9e, 0xb7, 0xc9, 0x9e, 0xa0, 0x6b, 0x76, 0xcf,
-		0xf6, 0xbc, 0x6e, 0x8c, 0x99, 0x1f, 0x06, 0x92, 0xb3, 0xb6, 0x3d, 0xbc, 0xcf, 0xfc, 0x0e, 0xa1,
-		0x0c, 0x77, 0x22, 0x45, 0x30, 0x02, 0x70, 0x19, 0xe3, 0x28, 0x22, 0x31, 0x55, 0xfb, 0x3b, 0x39,
-		0x15, 0x71, 0xe4, 0x73, 0xed, 0xdc, 0xb0, 0xd3, 0x19, 0x88, 0xd0, 0x51, 0xbc, 0xe9, 0x92, 0xb8,
-		0xaf, 0x08, 0xea, 0x3a, 0x02, 0x86, 0xe9, 0x45, 0xdb, 0xa7, 0x4c, 0xd1, 0xec, 0xea, 0x68, 0x94,
-		0x13, 0x9c, 0xcb, 0x30, 0xbe, 0x20, 0xb1, 0xa2, 0xfc, 0xf1, 0x24, 0xca, 0xb3, 0x76, 0x78, 0xa9,
-		0x68, 0xef, 0xea, 0x68, 0x5b, 0x3e, 0x65, 0x61, 0xaa, 0xdc, 0x0f, 0x72, 0x24, 0xb4, 0x85, 0x63,
-		0xe2, 0x8d, 0x52, 0x7d, 0x50, 0x40, 0x95, 0xb7, 0xa2, 0xfe, 0x1f, 0x03, 0x6a, 0x2f, 0xc2, 0x76,
-		0xfb, 0x24, 0x8c, 0x8f, 0x89, 0xeb, 0x53, 0x3f, 0x0c, 0x4e, 0x31, 0xbd, 0xb0, 0xc9, 0x9b, 0x2e,
-		0xa1, 0x0c, 0x35, 0xa0, 0x14, 0xcb, 0x9f, 0xa6, 0xb1, 0x63, 0xec, 0x56, 0x0e, 0xf6, 0xac, 0x5c,
-		0x60, 0x71, 0xe4, 0x5b, 0xbd, 0x7d, 0xab, 0x18, 0xc1, 0x4e, 0xf8, 0xd1, 0x26, 0x94, 0xbd, 0xb0,
-		0x83, 0xfd, 0xc0, 0xf1, 0x3d, 0x73, 0x6e, 0xc7, 0xd8, 0x2d, 0xdb, 0xcb, 0x72, 0xa1, 0xe1, 0xf1,
-		0xcd, 0x28, 0x6c, 0xb7, 0x49, 0xcc, 0x37, 0xe7, 0xe5, 0xa6, 0x5c, 0x68, 0x78, 0xe8, 0x03, 0xa8,
-		0x9e, 0x85, 0xf1, 0x25, 0x8e, 0x3d, 0xe2, 0x39, 0x67, 0x71, 0xd8, 0x31, 0x17, 0x04, 0xc5, 0x6a,
-		0xba, 0x7a, 0x12, 0x87, 0x9d, 0xfa, 0x97, 0x65, 0xd8, 0xd4, 0x2a, 0x42, 0xa3, 0x30, 0xa0, 0x04,
-		0x6d, 0x01, 0x70, 0xe3, 0x1d, 0x16, 0x5e, 0x90, 0x40, 0x98, 0xb3, 0x62, 0x97, 0xf9, 0xca, 0x29,
-		0x5f, 0x40, 0xbf, 0x01, 0x94, 0xc4, 0xc2, 0x21, 0x5f, 0x10, 0xb7, 0xcb, 0xcf, 0xa4, 0x50, 0xb4,
-		0x72, 0xf0, 0xa1, 0xd6, 0xea, 0xdf, 0x2a, 0xf2, 0x27, 0x09, 0xb5, 0x7d, 0xf3, 0x72, 0x78, 0x09,
-		0x9d, 0xc0, 0x6a, 0x0a, 0xcb, 0xfa, 0x11, 0x11, 0xd6, 0x55, 0x0e, 0xee, 0x8e, 0x45, 0x3c, 0xed,
-		0x47, 0xc4, 0x5e, 0xb9, 0xcc, 0x7c, 0xa1, 0x57, 0xb0, 0x11, 0xc5, 0xa4, 0xe7, 0x87, 0x5d, 0xea,
-		0x50, 0x86, 0x63, 0x46, 0x3c, 0x87, 0xf4, 0x48, 0xc0, 0xb8, 0xc7, 0x16, 0x04, 0xe6, 0xa6, 0x25,
-		0x33, 0xc3, 0x4a, 0x32, 0xc3, 0x6a, 0x04, 0xec, 0xfe, 0x27, 0xaf, 0x70, 0xbb, 0x4b, 0xec, 0xf5,
-		0x84, 0xfb, 0xa5, 0x64, 0x7e, 0xc2, 0x79, 0x1b, 0x1e, 0xda, 0x85, 0xb5, 0x11, 0xb8, 0xc5, 0x1d,
-		0x63, 0x77, 0xde, 0xae, 0xd2, 0x3c, 0xa5, 0x09, 0x25, 0xcc, 0x18, 0xe9, 0x44, 0xcc, 0x5c, 0xda,
-		0x31, 0x76, 0x17, 0xed, 0xe4, 0x13, 0xd5, 0x61, 0x35, 0x20, 0x5f, 0xb0, 0x01, 0x40, 0x49, 0x00,
-		0x54, 0xf8, 0x62, 0xc2, 0xfd, 0x11, 0xa0, 0x26, 0x76, 0x2f, 0xda, 0xe1, 0xb9, 0xe3, 0x86, 0xdd,
-		0x80, 0x39, 0x2d, 0x3f, 0x60, 0xe6, 0xb2, 0x20, 0x5c, 0x53, 0x3b, 0x47, 0x7c, 0xe3, 0xa9, 0x1f,
-		0x30, 0xf4, 0x00, 0x4c, 0xca, 0x7c, 0xf7, 0xa2, 0x3f, 0x08, 0x85, 0x43, 0x02, 0xdc, 0x6c, 0x13,
-		0xcf, 0x2c, 0xef, 0x18, 0xbb, 0xcb, 0xf6, 0xba, 0xdc, 0x4f, 0x1d, 0xfd, 0x44, 0xee, 0xa2, 0x07,
-		0xb0, 0x28, 0x32, 0xd9, 0x04, 0xe1, 0x93, 0xfa, 0x58, 0x3f, 0x7f, 0xce, 0x29, 0x6d, 0xc9, 0x80,
-		0x6c, 0x58, 0xf5, 0xd4, 0xb9, 0x71, 0xfc, 0xe0, 0x2c, 0x34, 0x2b, 0x02, 0xe1, 0x27, 0x79, 0x04,
-		0x99, 0x49, 0x1c, 0xe4, 0x34, 0xc6, 0x01, 0xf5, 0x49, 0xc0, 0x92, 0xd3, 0xd6, 0x08, 0xce, 0x42,
-		0x7b, 0xc5, 0xcb, 0x7c, 0xa1, 0xd7, 0x70, 0x7b, 0xf4, 0x50, 0x39, 0xe2, 0x18, 0xf2, 0x24, 0x34,
-		0x57, 0x84, 0x88, 0x2d, 0xad, 0x92, 0xfc, 0xf0, 0xfe, 0xca, 0xa7, 0xcc, 0xde, 0x18, 0x39, 0x55,
-		0xc9, 0x16, 0xb2, 0xe0, 0x96, 0x74, 0x3a, 0x4f, 0x7d, 0xe2, 0xf4, 0x48, 0xcc, 0x45, 0x9b, 0xab,
-		0x22, 0x3e, 0x37, 0xc5, 0xd6, 0x4b, 0xbe, 0xf3, 0x4a, 0x6e, 0xa0, 0xbb, 0xb0, 0xd2, 0x8c, 0x71,
-		0xe0, 0xb6, 0x54, 0x16, 0x54, 0x45, 0x16, 0x54, 0xe4, 0x9a, 0xcc, 0x83, 0x43, 0xa8, 0x52, 0xb7,
-		0x45, 0xbc, 0x6e, 0x9b, 0x78, 0x0e, 0xaf, 0xbd, 0xe6, 0x0d, 0xa1, 0x64, 0x6d, 0xe4, 0x74, 0x9d,
-		0x26, 0x85, 0xd9, 0x5e, 0x4d, 0x39, 0xf8, 0x1a, 0xfa, 0x39, 0xac, 0x24, 0x67, 0x4a, 0x00, 0xac,
-		0x4d, 0x04, 0xa8, 0x28, 0x7a, 0xc1, 0xfe, 0x7b, 0x28, 0xf1, 0x88, 0xf8, 0x84, 0x9a, 0x37, 0x77,
-		0xe6, 0x77, 0x2b, 0x07, 0x8f, 0xad, 0xa2, 0x6e, 0x62, 0x8d, 0x49, 0x78, 0xeb, 0x73, 0x09, 0xf2,
-		0x24, 0x60, 0x71, 0xdf, 0x4e, 0x20, 0x6b, 0xaf, 0x61, 0x25, 0xbb, 0x81, 0xd6, 0x60, 0xfe, 0x82,
-		0xf4, 0x45, 0x3d, 0x28, 0xdb, 0xfc, 0x27, 0x3f, 0x42, 0x3d, 0x9e, 0x33, 0x2a, 0xf9, 0xa7, 0x3a,
-		0x42, 0x82, 0xe1, 0xe1, 0xdc, 0x03, 0x23, 0x5b, 0x51, 0x0f, 0x5d, 0xe6, 0xf7, 0x7c, 0xd6, 0xbf,
-		0x7a, 0x45, 0xd5, 0x20, 0x7c, 0x87, 0x15, 0xf5, 0xab, 0xe5, 0xb4, 0xa2, 0xe6, 0x15, 0xf9, 0x5e,
-		0x2b, 0xea, 0x36, 0x54, 0xb0, 0xd2, 0x66, 0x60, 0x1b, 0x24, 0x4b, 0x0d, 0x8f, 0x97, 0xdc, 0x94,
-		0x40, 0x94, 0xdc, 0x85, 0x31, 0x25, 0x37, 0x35, 0x4c, 0x94, 0x5c, 0x9c, 0xf9, 0x42, 0x07, 0xb0,
-		0xe8, 0x07, 0x51, 0x97, 0x89, 0x7a, 0x58, 0x39, 0xb8, 0xad, 0x0f, 0x14, 0xee, 0xb7, 0x43, 0xec,
-		0xd9, 0x92, 0x54, 0x93, 0x3d, 0x4b, 0xd7, 0xcd, 0x9e, 0xd2, 0x6c, 0xd9, 0x73, 0x0a, 0x1b, 0x09,
-		0x9e, 0xc3, 0x42, 0xc7, 0x6d, 0x87, 0x94, 0x08, 0xa0, 0xb0, 0x2b, 0xeb, 0x6d, 0xe5, 0x60, 0x63,
-		0x04, 0xeb, 0x58, 0xcd, 0x60, 0xf6, 0x7a, 0xc2, 0x7b, 0x1a, 0x1e, 0x71, 0xce, 0x53, 0xc9, 0x88,
-		0x7e, 0x0d, 0xeb, 0x42, 0xc8, 0x28, 0x64, 0x79, 0x12, 0xe4, 0x2d, 0xc1, 0x38, 0x84, 0x77, 0x02,
-		0x37, 0x5b, 0x04, 0xc7, 0xac, 0x49, 0x30, 0x4b, 0xa1, 0x60, 0x12, 0xd4, 0x5a, 0xca, 0x93, 0xe0,
-		0x64, 0x9a, 0x52, 0x25, 0xdf, 0x94, 0x5e, 0xc3, 0x9d, 0x7c, 0x24, 0x9c, 0xf0, 0xcc, 0x61, 0x2d,
-		0x9f, 0x3a, 0x09, 0xc3, 0xca, 0x44, 0xc7, 0xd6, 0x72, 0x91, 0x79, 0x7e, 0x76, 0xda, 0xf2, 0xe9,
-		0xa1, 0xc2, 0x6f, 0x64, 0x2d, 0xf0, 0x08, 0xc3, 0x7e, 0x9b, 0x8a, 0xc2, 0x3b, 0xe9, 0xa4, 0x0c,
-		0x8c, 0x38, 0x96, 0x5c, 0xa3, 0x33, 0x42, 0xf5, 0x6a, 0x33, 0xc2, 0x0f, 0xe1, 0x46, 0x8a, 0x23,
-		0x0b, 0x81, 0xa8, 0xdd, 0x65, 0xbb, 0x9a, 0x2c, 0x1f, 0x8b, 0x55, 0xf4, 0x31, 0x2c, 0xb5, 0x08,
-		0xf6, 0x48, 0xac, 0x4a, 0xf3, 0xa6, 0x56, 0xd2, 0x53, 0x41, 0x62, 0x2b, 0xd2, 0xfa, 0x3f, 0xe6,
-		0x61, 0xfd, 0xd0, 0xf3, 0x74, 0x63, 0x62, 0xae, 0x12, 0x19, 0x43, 0x95, 0xe8, 0x5b, 0x2a, 0x03,
-		0x0f, 0xa1, 0x3c, 0xe8, 0xa3, 0xf3, 0xd3, 0xf4, 0xd1, 0x65, 0x96, 0xb4, 0xcd, 0x6d, 0xa8, 0xa4,
-		0x39, 0xa2, 0xc6, 0xa7, 0x79, 0x1b, 0x92, 0xa5, 0x86, 0x37, 0x9c, 0x44, 0xea, 0xe8, 0xab, 0x63,
-		0xba, 0x38, 0x43, 0x12, 0x89, 0x69, 0x2b, 0x39, 0xac, 0x0f, 0x61, 0x89, 0x86, 0xdd, 0xd8, 0x95,
-		0x45, 0xa1, 0x3a, 0xdc, 0x59, 0x32, 0xa3, 0x05, 0xa6, 0x17, 0x2f, 0x05, 0xa5, 0xad, 0x38, 0x34,
-		0x25, 0xbb, 0xa4, 0x2b, 0xd9, 0x1b, 0xf0, 0xde, 0x48, 0x8c, 0x64, 0xb5, 0xae, 0xff, 0x7b, 0x41,
-		0xc4, 0x4f, 0xd7, 0x94, 0xbe, 0x8f, 0xf8, 0xf1, 0xc1, 0x53, 0x98, 0xe6, 0x0c, 0x44, 0xcb, 0x5a,
-		0x5e, 0x95, 0xeb, 0xc7, 0x89, 0x02, 0xb9, 0x48, 0x2f, 0x5c, 0x2b, 0xd2, 0x8b, 0xb3, 0x45, 0x7a,
-		0xe9, 0xfa, 0x91, 0x2e, 0xbd, 0x85, 0x48, 0x2f, 0x6b, 0x22, 0x8d, 0x02, 0x30, 0x71, 0x26, 0x94,
-		0xc7, 0x3e, 0x8d, 0xf8, 0x5c, 0xc4, 0xc7, 0x4e, 0x55, 0x93, 0x0f, 0x8a, 0xc7, 0xa6, 0xc3, 0x02,
-		0x4e, 0xbb, 0x10, 0xb3, 0xfe, 0xcd, 0x3c, 0x98, 0x45, 0x6c, 0xe8, 0x97, 0x70, 0x63, 0x50, 0x6c,
-		0xc5, 0xd8, 0xa9, 0xa6, 0x1b, 0x7d, 0x0d, 0x7b, 0x2a, 0xaf, 0xaa, 0xe2, 0x6e, 0x60, 0x0f, 0x1a,
-		0xa6, 0xf8, 0x1e, 0xe9, 0x7f, 0x73, 0xb3, 0xf5, 0xbf, 0x4c, 0x47, 0x98, 0x9f, 0xb5, 0x23, 0x2c,
-		0xbc, 0xfd, 0x8e, 0xb0, 0xf8, 0x76, 0x3a, 0xc2, 0xd2, 0x5b, 0xeb, 0x08, 0x25, 0x5d, 0x47, 0x50,
-		0x75, 0x43, 0x37, 0xe5, 0xd5, 0xbf, 0x31, 0xe0, 0x1d, 0x31, 0xe5, 0x26, 0x72, 0x92, 0xaa, 0x71,
-		0x34, 0x3c, 0xca, 0xfe, 0x48, 0xab, 0x9e, 0x8e, 0x77, 0xca, 0x21, 0xf6, 0x3a, 0x35, 0x7e, 0xca,
-		0x19, 0xf7, 0x9f, 0x06, 0xbc, 0x3b, 0xa4, 0xa1, 0x9a, 0x6e, 0x7f, 0x01, 0x2b, 0xe2, 0x62, 0xe8,
-		0xc4, 0x84, 0x76, 0xdb, 0x89, 0x8d, 0xe3, 0x23, 0x59, 0x11, 0x1c, 0xb6, 0x60, 0x40, 0x0d, 0xa8,
-		0x26, 0x00, 0x7f, 0x20, 0x2e, 0x23, 0xde, 0xd8, 0x0b, 0x85, 0xbc, 0x48, 0x28, 0x4a, 0x7b, 0xf5,
-		0x4d, 0xf6, 0xb3, 0xfe, 0x3f, 0x03, 0x76, 0xa4, 0x62, 0x9e, 0xa0, 0xe3, 0xf6, 0x1e, 0x85, 0x9d,
-		0xa8, 0x4d, 0x38, 0xb1, 0x72, 0xe5, 0xf3, 0xe1, 0x78, 0xdc, 0xd3, 0x0a, 0x9a, 0x84, 0xf3, 0x1d,
-		0xc4, 0xe6, 0x3d, 0x28, 0x09, 0x5e, 0xd5, 0x7b, 0xcb, 0xf6, 0x12, 0xff, 0x6c, 0x78, 0xf5, 0xf7,
-		0xe1, 0xee, 0x18, 0xf5, 0xd4, 0x81, 0xfc, 0xaf, 0x01, 0xb7, 0x8f, 0x70, 0xe0, 0x92, 0xf6, 0xf3,
-		0x2e, 0xa3, 0x0c, 0x07, 0x9e, 0x1f, 0x9c, 0xf3, 0x7b, 0xca, 0x54, 0xed, 0x2c, 0x77, 0x31, 0x9a,
-		0x1b, 0xba, 0x18, 0x7d, 0x06, 0xd5, 0xd4, 0xa8, 0xc1, 0x73, 0x4d, 0xb5, 0x20, 0xf1, 0x12, 0xcb,
-		0x64, 0xe2, 0xb1, 0xcc, 0xd7, 0x75, 0x7a, 0x56, 0x7d, 0x1b, 0xb6, 0x0a, 0xcc, 0x53, 0x0e, 0xf8,
-		0x13, 0xbc, 0x77, 0x4c, 0xa8, 0x1b, 0xfb, 0x4d, 0x92, 0xb2, 0x2b, 0xd3, 0x4f, 0x86, 0xcf, 0xc0,
-		0x47, 0x5a, 0xa9, 0x05, 0xec, 0xd3, 0x85, 0xbe, 0xfe, 0xb5, 0x01, 0xe6, 0x28, 0x82, 0x4a, 0x9b,
-		0x4f, 0xa1, 0x24, 0xdd, 0x49, 0x4d, 0x43, 0xdc, 0xde, 0xb7, 0x0b, 0x2f, 0xb8, 0x24, 0x16, 0x3d,
-		0x27, 0xa1, 0x47, 0xcf, 0x60, 0x6d, 0xe0, 0x7d, 0xca, 0x30, 0xeb, 0x52, 0x95, 0x32, 0xef, 0x8f,
-		0xf5, 0xdd, 0x4b, 0x41, 0x6a, 0x57, 0x59, 0xee, 0xbb, 0x4e, 0x61, 0x4b, 0xc4, 0x43, 0xad, 0xbe,
-		0xc0, 0x31, 0xf3, 0x79, 0xd7, 0xa6, 0x89, 0xb3, 0xd6, 0x61, 0x49, 0x15, 0x45, 0x79, 0x48, 0xd4,
-		0x57, 0x3e, 0x78, 0x73, 0xb3, 0x05, 0xef, 0xaf, 0x73, 0x70, 0xa7, 0x48, 0xaa, 0xf2, 0xd0, 0x1b,
-		0xd8, 0x1a, 0xdc, 0x4f, 0x53, 0x7b, 0xa3, 0x94, 0x50, 0xf9, 0xcd, 0x1a, 0x2b, 0x32, 0xc5, 0x7d,
-		0x46, 0x18, 0xf6, 0x30, 0xc3, 0x76, 0x2d, 0xdb, 0xba, 0xf3, 0xa2, 0xb9, 0xc8, 0xf4, 0x6d, 0x4b,
-		0x2b, 0x72, 0xee, 0x6a, 0x22, 0xbd, 0xcc, 0xa0, 0x99, 0x17, 0x59, 0xbf, 0x07, 0x9b, 0x9f, 0x91,
-		0xd4, 0x0d, 0xf4, 0x71, 0x5f, 0x76, 0x9a, 0x09, 0xbe, 0xaf, 0x7f, 0xbd, 0x00, 0xb7, 0xf5, 0x7c,
-		0xca, 0x7b, 0x5f, 0x1a, 0xb0, 0xae, 0xb1, 0xa5, 0x83, 0x23, 0xe5, 0xb7, 0xe7, 0xc5, 0x63, 0xcf,
-		0x38, 0x60, 0xeb, 0x78, 0xc8, 0x96, 0x67, 0x38, 0x92, 0x4f, 0x47, 0xb7, 0xbc, 0xd1, 0x1d, 0xa1,
-		0x86, 0x26, 0x8a, 0x5c, 0x8d, 0xb9, 0x6b, 0xa9, 0x71, 0x38, 0x14, 0xc5, 0x81, 0x1a, 0x78, 0x74,
-		0xa7, 0xf6, 0x47, 0x9e, 0x89, 0x7a, 0xbd, 0x35, 0x2f, 0x5b, 0x4f, 0xf3, 0x2f, 0x5b, 0x63, 0x06,
-		0xc4, 0xa2, 0xf4, 0xce, 0xbc, 0x74, 0x71, 0xd9, 0x45, 0xca, 0x7e, 0xdb, 0xb2, 0x0f, 0xfe, 0x05,
-		0x50, 0x79, 0xa6, 0x78, 0x0e, 0x5f, 0x34, 0xd0, 0x9f, 0x0d, 0xb8, 0xa5, 0x79, 0x0b, 0x44, 0x9f,
-		0xcc, 0xf8, 0x74, 0x28, 0x0e, 0x67, 0xed, 0xde, 0x95, 0x1e, 0x1c, 0xb3, 0x4a, 0x64, 0x1d, 0x33,
-		0x85, 0x12, 0x9a, 0x4b, 0xd9, 0x14, 0x4a, 0x68, 0x1f, 0xe5, 0x7a, 0x70, 0x63, 0xe8, 0x06, 0x88,
-		0x7e, 0x3a, 0xe6, 0x22, 0xa0, 0xbd, 0xd0, 0xd7, 0xf6, 0x67, 0xe0, 0xc8, 0xc9, 0xcd, 0xd9, 0x3d,
-		0x5e, 0xae, 0xce, 0xe6, 0xfd, 0x19, 0x38, 0x94, 0xdc, 0x08, 0x56, 0x73, 0xf3, 0x1b, 0xb2, 0x8a,
-		0x31, 0x74, 0xa3, 0x68, 0x6d, 0x6f, 0x6a, 0x7a, 0x25, 0xf1, 0xef, 0x06, 0x6c, 0x14, 0x4e, 0x29,
-		0xe8, 0x61, 0x31, 0xdc, 0xa4, 0xc9, 0xab, 0xf6, 0xe8, 0x4a, 0xbc, 0x4a, 0xad, 0xbf, 0x19, 0xf0,
-		0xae, 0x76, 0x6e, 0x40, 0xf7, 0x8b, 0x61, 0xc7, 0xcd, 0x51, 0xb5, 0x9f, 0xcd, 0xcc, 0xa7, 0x54,
-		0xe9, 0xc3, 0xda, 0x70, 0x12, 0xa3, 0xfd, 0x59, 0x12, 0x5e, 0xca, 0xbf, 0x42, 0x8d, 0x40, 0x5f,
-		0x19, 0xb0, 0xae, 0xef, 0xbf, 0x68, 0x8c, 0x39, 0x63, 0xe7, 0x84, 0xda, 0x83, 0xd9, 0x19, 0x95,
-		0x36, 0x7f, 0x31, 0xe0, 0x1d, 0x5d, 0xb5, 0x47, 0xf7, 0x66, 0xed, 0x0e, 0x52, 0x93, 0xfb, 0x57,
-		0x6b, 0x2a, 0x8f, 0x1f, 0xfd, 0xee, 0xd3, 0x73, 0x9f, 0xb5, 0xba, 0x4d, 0xcb, 0x0d, 0x3b, 0x7b,
-		0xb9, 0xbf, 0x86, 0xad, 0x73, 0x12, 0xc8, 0xff, 0xd2, 0xb3, 0x7f, 0xe7, 0x3f, 0x4a, 0x7e, 0xf7,
-		0xf6, 0x9b, 0x4b, 0x62, 0xf7, 0xe3, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xe6, 0xbb, 0x9f,
-		0xfc, 0x1f, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x5f, 0x6f, 0xdb, 0xd6,
+		0xf5, 0xa0, 0x6d, 0xd9, 0xd6, 0x91, 0xac, 0x38, 0x4c, 0xe2, 0x30, 0x72, 0x9c, 0x38, 0xec, 0xaf,
+		0xad, 0x7f, 0x43, 0x27, 0xd7, 0x6a, 0x93, 0xa6, 0x29, 0x86, 0xd6, 0xb1, 0xe3, 0x44, 0x43, 0xb3,
+		0xa4, 0xb4, 0x9b, 0x02, 0xc3, 0x50, 0xee, 0x8a, 0xbc, 0xb6, 0x38, 0x53, 0x24, 0x43, 0x5e, 0xc9,
+		0x76, 0x1e, 0x06, 0xb4, 0xe8, 0x86, 0x01, 0x7d, 0xd8, 0xcb, 0xbe, 0xc1, 0xfa, 0xb0, 0xaf, 0xb0,
+		0xc7, 0xed, 0x69, 0xdf, 0xa1, 0xd8, 0xde, 0x86, 0x7d, 0x8d, 0xe1, 0xfe, 0x21, 0x45, 0x4a, 0x97,
+		0xb4, 0x64, 0xa7, 0x2b, 0xfc, 0xa6, 0x7b, 0xee, 0xf9, 0x77, 0xcf, 0x39, 0xf7, 0xfc, 0xb9, 0x14,
+		0xbc, 0xd5, 0x6b, 0xe3, 0x70, 0xdd, 0x42, 0x36, 0xf6, 0x2c, 0xbc, 0xde, 0x45, 0xc4, 0xea, 0x38,
+		0xde, 0xc1, 0x7a, 0x7f, 0x63, 0x3d, 0xc2, 0x61, 0xdf, 0xb1, 0x70, 0x23, 0x08, 0x7d, 0xe2, 0xab,
+		0x1a, 0xc5, 0x6b, 0x08, 0xbc, 0x46, 0x8c, 0xd7, 0xe8, 0x6f, 0xd4, 0x6f, 0x1d, 0xf8, 0xfe, 0x81,
+		0x8b, 0xd7, 0x19, 0x5e, 0xbb, 0xb7, 0xbf, 0x6e, 0xf7, 0x42, 0x44, 0x1c, 0xdf, 0xe3, 0x94, 0xf5,
+		0xdb, 0xc3, 0xfb, 0xc4, 0xe9, 0xe2, 0x88, 0xa0, 0x6e, 0x20, 0x10, 0x46, 0x18, 0x1c, 0x85, 0x28,
+		0x08, 0x70, 0x18, 0x89, 0xfd, 0xd5, 0x8c, 0x8a, 0x28, 0x70, 0xa8, 0x76, 0x96, 0xdf, 0xed, 0x0e,
+		0x44, 0xc8, 0x30, 0x5e, 0xf6, 0x70, 0x78, 0x22, 0x10, 0x74, 0x19, 0x02, 0x41, 0xd1, 0xa1, 0xeb,
+		0x44, 0x44, 0xe0, 0xac, 0xc9, 0x70, 0x84, 0x11, 0xcc, 0x23, 0x3f, 0x3c, 0xc4, 0xa1, 0xc0, 0xfc,
+		0xc9, 0x69, 0x98, 0xfb, 0xae, 0x7f, 0x24, 0x70, 0xef, 0xc8, 0x70, 0x3b, 0x4e, 0x44, 0xfc, 0x44,
+		0xb9, 0xff, 0xcb, 0xa0, 0x44, 0x1d, 0x14, 0x62, 0x7b, 0x14, 0xeb, 0xcd, 0x1c, 0xac, 0xec, 0x29,
+		0xf4, 0x7f, 0x4c, 0x43, 0xfd, 0xb9, 0xef, 0xba, 0x3b, 0x7e, 0xb8, 0x8d, 0x2d, 0x27, 0x72, 0x7c,
+		0x6f, 0x0f, 0x45, 0x87, 0x06, 0x7e, 0xd9, 0xc3, 0x11, 0x51, 0x5b, 0x30, 0x17, 0xf2, 0x9f, 0x9a,
+		0xb2, 0xaa, 0xac, 0x55, 0x9a, 0xeb, 0x8d, 0x8c, 0x63, 0x51, 0xe0, 0x34, 0xfa, 0x1b, 0x8d, 0x7c,
+		0x0e, 0x46, 0x4c, 0xaf, 0x2e, 0x43, 0xd9, 0xf6, 0xbb, 0xc8, 0xf1, 0x4c, 0xc7, 0xd6, 0xa6, 0x56,
+		0x95, 0xb5, 0xb2, 0x31, 0xcf, 0x01, 0x2d, 0x9b, 0x6e, 0x06, 0xbe, 0xeb, 0xe2, 0x90, 0x6e, 0x4e,
+		0xf3, 0x4d, 0x0e, 0x68, 0xd9, 0xea, 0x9b, 0x50, 0xdb, 0xf7, 0xc3, 0x23, 0x14, 0xda, 0xd8, 0x36,
+		0xf7, 0x43, 0xbf, 0xab, 0xcd, 0x30, 0x8c, 0x85, 0x04, 0xba, 0x13, 0xfa, 0x5d, 0xf5, 0x10, 0x16,
+		0x04, 0x0f, 0x17, 0xb5, 0xb1, 0x1b, 0x69, 0xa5, 0xd5, 0xe9, 0xb5, 0x4a, 0x73, 0xa7, 0x91, 0x17,
+		0x8a, 0x05, 0x6a, 0xb3, 0x2d, 0x1c, 0x7e, 0xca, 0x18, 0x3d, 0xf2, 0x48, 0x78, 0x62, 0x54, 0x83,
+		0x14, 0x48, 0x7d, 0x1b, 0x2e, 0x09, 0x61, 0x16, 0x0a, 0x90, 0xe5, 0x90, 0x13, 0x6d, 0x76, 0x55,
+		0x59, 0x2b, 0x19, 0x35, 0x0e, 0xde, 0x12, 0x50, 0x8a, 0xe8, 0x44, 0xbe, 0xcb, 0x22, 0xdc, 0x3c,
+		0x08, 0xfd, 0x5e, 0xa0, 0xcd, 0x31, 0xed, 0x6b, 0x09, 0xf8, 0x31, 0x85, 0xd6, 0x3f, 0x86, 0xcb,
+		0x23, 0x42, 0xd5, 0x45, 0x98, 0x3e, 0xc4, 0x27, 0xcc, 0xf6, 0x65, 0x83, 0xfe, 0x54, 0xaf, 0x42,
+		0xa9, 0x8f, 0xdc, 0x1e, 0x16, 0x26, 0xe4, 0x8b, 0x07, 0x53, 0xf7, 0x15, 0xfd, 0x9b, 0x32, 0x2c,
+		0x4b, 0x4f, 0x14, 0x05, 0xbe, 0x17, 0x61, 0x75, 0x05, 0x80, 0x3a, 0xdf, 0x24, 0xfe, 0x21, 0xf6,
+		0x18, 0xcb, 0xaa, 0x51, 0xa6, 0x90, 0x3d, 0x0a, 0x50, 0x3f, 0x07, 0x35, 0x8e, 0x45, 0x13, 0x1f,
+		0x63, 0xab, 0x47, 0x55, 0x63, 0x52, 0x2a, 0xcd, 0xb7, 0xa4, 0x5e, 0xff, 0x42, 0xa0, 0x3f, 0x8a,
+		0xb1, 0x8d, 0xcb, 0x47, 0xc3, 0x20, 0x75, 0x07, 0x16, 0x12, 0xb6, 0xe4, 0x24, 0xc0, 0xcc, 0xbb,
+		0x95, 0xe6, 0x9d, 0x42, 0x8e, 0x7b, 0x27, 0x01, 0x36, 0xaa, 0x47, 0xa9, 0x95, 0xfa, 0x02, 0x6e,
+		0x04, 0x21, 0xee, 0x3b, 0x7e, 0x2f, 0x32, 0x23, 0x82, 0x42, 0x82, 0x6d, 0x13, 0xf7, 0xb1, 0x47,
+		0x68, 0xc4, 0xcc, 0x30, 0x9e, 0xcb, 0x0d, 0x9e, 0x19, 0x1a, 0x71, 0x66, 0x68, 0xb4, 0x3c, 0x72,
+		0xef, 0xfd, 0x17, 0xd4, 0x42, 0xc6, 0x52, 0x4c, 0xbd, 0xcb, 0x89, 0x1f, 0x51, 0xda, 0x96, 0xad,
+		0xae, 0xc1, 0xe2, 0x08, 0xbb, 0xd2, 0xaa, 0xb2, 0x36, 0x6d, 0xd4, 0xa2, 0x2c, 0xa6, 0x06, 0x73,
+		0x88, 0x10, 0xdc, 0x0d, 0x88, 0x70, 0x75, 0xbc, 0x54, 0x75, 0x58, 0xf0, 0xf0, 0x31, 0x19, 0x30,
+		0x98, 0x63, 0x0c, 0x2a, 0x14, 0x18, 0x53, 0xbf, 0x03, 0x6a, 0x1b, 0x59, 0x87, 0xae, 0x7f, 0x60,
+		0x5a, 0x7e, 0xcf, 0x23, 0x66, 0xc7, 0xf1, 0x88, 0x36, 0xcf, 0x10, 0x17, 0xc5, 0xce, 0x16, 0xdd,
+		0x78, 0xe2, 0x78, 0x44, 0xbd, 0x0f, 0x5a, 0x44, 0x1c, 0xeb, 0xf0, 0x64, 0xe0, 0x0a, 0x13, 0x7b,
+		0xa8, 0xed, 0x62, 0x5b, 0x2b, 0xaf, 0x2a, 0x6b, 0xf3, 0xc6, 0x12, 0xdf, 0x4f, 0x0c, 0xfd, 0x88,
+		0xef, 0xaa, 0xf7, 0xa1, 0xc4, 0x32, 0x99, 0x06, 0xcc, 0x26, 0x7a, 0xa1, 0x9d, 0x3f, 0xa3, 0x98,
+		0x06, 0x27, 0x50, 0x0d, 0x58, 0xb0, 0x45, 0xdc, 0x98, 0x8e, 0xb7, 0xef, 0x6b, 0x15, 0xc6, 0xe1,
+		0xa7, 0x59, 0x0e, 0x3c, 0x93, 0x50, 0x26, 0x7b, 0x21, 0xf2, 0x22, 0x07, 0x7b, 0x24, 0x8e, 0xb6,
+		0x96, 0xb7, 0xef, 0x1b, 0x55, 0x3b, 0xb5, 0x52, 0xbf, 0x84, 0x9b, 0xa3, 0x41, 0x65, 0xb2, 0x30,
+		0xa4, 0x49, 0x48, 0xab, 0x32, 0x11, 0x2b, 0x52, 0x25, 0x69, 0xf0, 0x7e, 0xea, 0x44, 0xc4, 0xb8,
+		0x31, 0x12, 0x55, 0xf1, 0x96, 0xda, 0x80, 0x2b, 0xdc, 0xe8, 0x34, 0xf5, 0x61, 0xb3, 0x8f, 0x43,
+		0x2a, 0x5a, 0x5b, 0x60, 0xfe, 0xb9, 0xcc, 0xb6, 0x76, 0xe9, 0xce, 0x0b, 0xbe, 0xa1, 0xde, 0x81,
+		0x6a, 0x3b, 0x44, 0x9e, 0xd5, 0x11, 0xb7, 0xa0, 0xc6, 0x6e, 0x41, 0x85, 0xc3, 0xf8, 0x3d, 0xd8,
+		0x84, 0x5a, 0x64, 0x75, 0xb0, 0xdd, 0x73, 0xb1, 0x6d, 0xd2, 0xda, 0xa3, 0x5d, 0x62, 0x4a, 0xd6,
+		0x47, 0xa2, 0x6b, 0x2f, 0x2e, 0x4c, 0xc6, 0x42, 0x42, 0x41, 0x61, 0xea, 0xcf, 0xa0, 0x1a, 0xc7,
+		0x14, 0x63, 0xb0, 0x78, 0x2a, 0x83, 0x8a, 0xc0, 0x67, 0xe4, 0xbf, 0x82, 0x39, 0xea, 0x11, 0x07,
+		0x47, 0xda, 0x65, 0x96, 0xc2, 0x1e, 0x4e, 0x98, 0xc2, 0xf8, 0x85, 0x6f, 0x7c, 0xc6, 0x99, 0xf0,
+		0xf4, 0x15, 0xb3, 0xac, 0x7f, 0x09, 0xd5, 0xf4, 0x86, 0x24, 0xc5, 0xdc, 0x4f, 0xa7, 0x98, 0x31,
+		0x43, 0x68, 0x90, 0x86, 0x52, 0x15, 0x65, 0xd3, 0x22, 0x4e, 0xdf, 0x21, 0x27, 0x67, 0xaf, 0x28,
+		0x12, 0x0e, 0x17, 0xa1, 0xa2, 0x48, 0xd4, 0xbe, 0x88, 0x15, 0xe5, 0xdb, 0x79, 0x58, 0x96, 0x9e,
+		0xe8, 0x47, 0xad, 0x28, 0xb7, 0xa1, 0x82, 0x84, 0x36, 0x03, 0xdf, 0x42, 0x0c, 0x6a, 0xd9, 0xb4,
+		0xe4, 0x24, 0x08, 0xac, 0xe4, 0xcc, 0x14, 0x94, 0x9c, 0xe4, 0x60, 0xac, 0xe4, 0xa0, 0xd4, 0x4a,
+		0x6d, 0x42, 0xc9, 0xf1, 0x82, 0x1e, 0x61, 0xf5, 0xa0, 0xd2, 0xbc, 0x29, 0x0f, 0x54, 0x74, 0xe2,
+		0xfa, 0xc8, 0x36, 0x38, 0xaa, 0x24, 0x7b, 0xcc, 0x9e, 0x37, 0x7b, 0xcc, 0x4d, 0x96, 0x3d, 0xf6,
+		0xe0, 0x46, 0xcc, 0xcf, 0x24, 0xbe, 0x69, 0xb9, 0x7e, 0x84, 0x19, 0x23, 0xbf, 0xc7, 0xeb, 0x4d,
+		0xa5, 0x79, 0x63, 0x84, 0xd7, 0xb6, 0xe8, 0xc1, 0x8d, 0xa5, 0x98, 0x76, 0xcf, 0xdf, 0xa2, 0x94,
+		0x7b, 0x9c, 0x50, 0xfd, 0x05, 0x2c, 0x31, 0x21, 0xa3, 0x2c, 0xcb, 0xa7, 0xb1, 0xbc, 0xc2, 0x08,
+		0x87, 0xf8, 0xed, 0xc0, 0xe5, 0x0e, 0x46, 0x21, 0x69, 0x63, 0x44, 0x12, 0x56, 0x70, 0x1a, 0xab,
+		0xc5, 0x84, 0x26, 0xe6, 0x93, 0x2a, 0xca, 0x95, 0x6c, 0x51, 0xfe, 0x12, 0x6e, 0x65, 0x3d, 0x61,
+		0xfa, 0xfb, 0x26, 0xe9, 0x38, 0x91, 0x19, 0x13, 0x54, 0x4f, 0x35, 0x6c, 0x3d, 0xe3, 0x99, 0x67,
+		0xfb, 0x7b, 0x1d, 0x27, 0xda, 0x14, 0xfc, 0x5b, 0xe9, 0x13, 0xd8, 0x98, 0x20, 0xc7, 0x8d, 0xb4,
+		0x85, 0x31, 0x22, 0x65, 0x70, 0x88, 0x6d, 0x4e, 0x35, 0xda, 0x23, 0xd5, 0xce, 0xd6, 0x23, 0xbd,
+		0x0d, 0x97, 0x12, 0x3e, 0x3c, 0x11, 0xb2, 0xda, 0x55, 0x36, 0x6a, 0x31, 0x78, 0x9b, 0x41, 0xd5,
+		0xf7, 0x60, 0xb6, 0x83, 0x91, 0x8d, 0x43, 0x51, 0x9a, 0x96, 0xa5, 0x92, 0x9e, 0x30, 0x14, 0x43,
+		0xa0, 0xea, 0xff, 0x99, 0x86, 0xa5, 0x4d, 0xdb, 0x96, 0x8d, 0x09, 0x99, 0x4c, 0xac, 0x0c, 0x65,
+		0xe2, 0x1f, 0x28, 0x0d, 0x3c, 0x80, 0xf2, 0xa0, 0x8f, 0x98, 0x1e, 0xa7, 0x8f, 0x98, 0x27, 0xe2,
+		0x17, 0x4d, 0x21, 0xc9, 0x1d, 0x11, 0xed, 0xe3, 0xb4, 0x01, 0x31, 0xa8, 0x65, 0x0f, 0x5f, 0x22,
+		0x11, 0xfa, 0x22, 0x4c, 0x4b, 0x13, 0x5c, 0x22, 0xd6, 0x6d, 0xc6, 0xc1, 0xfa, 0x00, 0x66, 0x23,
+		0xbf, 0x17, 0x5a, 0x3c, 0x29, 0xd4, 0x9a, 0x7a, 0x6e, 0x6b, 0x85, 0xa2, 0xc3, 0x5d, 0x86, 0x69,
+		0x08, 0x0a, 0x49, 0xc9, 0x9a, 0x93, 0x95, 0xac, 0x3a, 0xcc, 0x07, 0xa1, 0xe3, 0x87, 0xb4, 0x7c,
+		0xcc, 0xb3, 0x0b, 0x91, 0xac, 0x65, 0x85, 0xa3, 0x2c, 0x2b, 0x1c, 0xfa, 0x0d, 0xb8, 0x3e, 0xe2,
+		0x68, 0x9e, 0xf2, 0xf5, 0xbf, 0x94, 0x58, 0x10, 0xc8, 0x2a, 0xfb, 0x8f, 0x11, 0x04, 0xb4, 0x7b,
+		0x67, 0xf6, 0x31, 0x07, 0xa2, 0x79, 0x41, 0xa8, 0x71, 0xf8, 0x76, 0xac, 0x40, 0x26, 0x5c, 0x66,
+		0xce, 0x15, 0x2e, 0xa5, 0xc9, 0xc2, 0x65, 0xf6, 0xfc, 0xe1, 0x32, 0xf7, 0x1a, 0xc2, 0x65, 0x5e,
+		0x16, 0x2e, 0x1e, 0x68, 0x28, 0xe5, 0xca, 0x6d, 0x27, 0x0a, 0x68, 0x37, 0x43, 0x7b, 0x77, 0x91,
+		0xd8, 0x9b, 0xf9, 0xcd, 0xce, 0x66, 0x0e, 0xa5, 0x91, 0xcb, 0x33, 0x13, 0x9e, 0x30, 0x14, 0x9e,
+		0x6f, 0x42, 0x8d, 0xb5, 0x59, 0x66, 0x84, 0x5d, 0x6c, 0x11, 0x3f, 0x64, 0x19, 0xbd, 0x6c, 0x2c,
+		0x30, 0xe8, 0xae, 0x00, 0xca, 0xa2, 0xb8, 0x2a, 0x8d, 0xe2, 0xef, 0xa7, 0x41, 0xcb, 0x53, 0x51,
+		0xfd, 0x39, 0x5c, 0x1a, 0x54, 0x07, 0x36, 0x27, 0x68, 0x4a, 0x41, 0xd2, 0x7d, 0xc2, 0xdf, 0x56,
+		0xd8, 0x30, 0x67, 0x0c, 0x2a, 0x3c, 0x5b, 0x8f, 0x14, 0xec, 0xa9, 0xc9, 0x0a, 0x76, 0xaa, 0x84,
+		0x4d, 0x4f, 0x5a, 0xc2, 0x66, 0x5e, 0x7f, 0x09, 0x2b, 0xbd, 0x9e, 0x12, 0x36, 0xfb, 0xda, 0x4a,
+		0xd8, 0x9c, 0xac, 0x84, 0x89, 0x1c, 0x25, 0x6b, 0x4b, 0xf5, 0xef, 0x15, 0xb8, 0xca, 0xc6, 0x92,
+		0x58, 0x4e, 0x9c, 0xa1, 0xb6, 0x86, 0x67, 0x8f, 0xff, 0x97, 0xaa, 0x27, 0xa3, 0x1d, 0x73, 0xea,
+		0x38, 0x4f, 0x51, 0x1a, 0x6f, 0x28, 0xd1, 0xff, 0xac, 0xc0, 0xb5, 0x21, 0x0d, 0x45, 0x3b, 0xfe,
+		0x31, 0x54, 0xd9, 0x24, 0x6f, 0x86, 0x38, 0xea, 0xb9, 0xf1, 0x19, 0x8b, 0x3d, 0x59, 0x61, 0x14,
+		0x06, 0x23, 0x50, 0x5b, 0x50, 0x8b, 0x19, 0xfc, 0x06, 0x5b, 0x04, 0xdb, 0x85, 0x13, 0x20, 0x9f,
+		0xfc, 0x04, 0xa6, 0xb1, 0xf0, 0x32, 0xbd, 0xd4, 0xff, 0xad, 0xc0, 0x2a, 0x57, 0xcc, 0x66, 0x78,
+		0xf4, 0xbc, 0x5b, 0x7e, 0x37, 0x70, 0x31, 0x45, 0x16, 0xa6, 0x7c, 0x36, 0xec, 0x8f, 0xbb, 0x52,
+		0x41, 0xa7, 0xf1, 0xf9, 0x1f, 0xf8, 0xe6, 0x3a, 0xcc, 0x31, 0x5a, 0xd1, 0x2c, 0x94, 0x8d, 0x59,
+		0xba, 0x6c, 0xd9, 0xfa, 0x1b, 0x70, 0xa7, 0x40, 0x3d, 0x11, 0x90, 0xff, 0x54, 0xe0, 0xe6, 0x16,
+		0xf2, 0x2c, 0xec, 0x3e, 0xeb, 0x91, 0x88, 0x20, 0xcf, 0x76, 0xbc, 0x03, 0x3a, 0x58, 0x8d, 0x55,
+		0x3a, 0x33, 0x93, 0xec, 0xd4, 0xd0, 0x24, 0xfb, 0x18, 0x6a, 0xc9, 0xa1, 0x06, 0xef, 0x6b, 0xb5,
+		0x9c, 0x8b, 0x17, 0x9f, 0x8c, 0x5f, 0x3c, 0x92, 0x5a, 0x9d, 0xa7, 0x3e, 0xea, 0xb7, 0x61, 0x25,
+		0xe7, 0x78, 0xc2, 0x00, 0xbf, 0x85, 0xeb, 0xdb, 0x38, 0xb2, 0x42, 0xa7, 0x8d, 0x13, 0x72, 0x71,
+		0xf4, 0x9d, 0xe1, 0x18, 0x78, 0x47, 0x2a, 0x35, 0x87, 0x7c, 0x3c, 0xd7, 0xeb, 0xdf, 0x29, 0xa0,
+		0x8d, 0x72, 0x10, 0xd7, 0xe6, 0x43, 0x98, 0xe3, 0xe6, 0x8c, 0x34, 0x85, 0xcd, 0xf7, 0xb7, 0x73,
+		0x5f, 0x24, 0x70, 0xc8, 0xea, 0x5b, 0x8c, 0xaf, 0x3e, 0x85, 0xc5, 0x81, 0xf5, 0x23, 0x82, 0x48,
+		0x2f, 0x12, 0x57, 0xe6, 0x8d, 0x42, 0xdb, 0xed, 0x32, 0x54, 0xa3, 0x46, 0x32, 0x6b, 0x3d, 0x82,
+		0x15, 0xe6, 0x0f, 0x01, 0x7d, 0x8e, 0x42, 0xe2, 0xd0, 0x7a, 0x16, 0xc5, 0xc6, 0x5a, 0x82, 0x59,
+		0x91, 0x14, 0x79, 0x90, 0x88, 0x55, 0xd6, 0x79, 0x53, 0x93, 0x39, 0xef, 0xf7, 0x53, 0x70, 0x2b,
+		0x4f, 0xaa, 0xb0, 0xd0, 0x4b, 0x58, 0x19, 0x0c, 0xd4, 0xc9, 0x79, 0x83, 0x04, 0x51, 0xd8, 0xad,
+		0x51, 0x28, 0x32, 0xe1, 0xfb, 0x14, 0x13, 0x64, 0x23, 0x82, 0x8c, 0x7a, 0xba, 0x4d, 0xc8, 0x8a,
+		0xa6, 0x22, 0x93, 0xc7, 0x48, 0xa9, 0xc8, 0xa9, 0xb3, 0x89, 0xb4, 0x53, 0x4d, 0x6d, 0x56, 0xa4,
+		0x7e, 0x17, 0x96, 0x1f, 0xe3, 0xc4, 0x0c, 0xd1, 0xc3, 0x13, 0x5e, 0x69, 0x4e, 0xb1, 0xbd, 0xfe,
+		0xdd, 0x0c, 0xdc, 0x94, 0xd3, 0x09, 0xeb, 0x7d, 0xa3, 0xc0, 0x92, 0xe4, 0x2c, 0x5d, 0x14, 0x08,
+		0xbb, 0x3d, 0xcb, 0x6f, 0xb1, 0x8a, 0x18, 0x37, 0xb6, 0x87, 0xce, 0xf2, 0x14, 0x05, 0xfc, 0x61,
+		0xe9, 0x8a, 0x3d, 0xba, 0xc3, 0xd4, 0x90, 0x78, 0x91, 0xaa, 0x31, 0x75, 0x2e, 0x35, 0x36, 0x87,
+		0xbc, 0x38, 0x50, 0x03, 0x8d, 0xee, 0xd4, 0x5f, 0xd1, 0x9b, 0x28, 0xd7, 0x5b, 0xf2, 0x36, 0xf5,
+		0x24, 0xfb, 0x14, 0x59, 0xd0, 0x8c, 0xe6, 0x5d, 0xef, 0xd4, 0x7b, 0x16, 0x95, 0x9d, 0xa7, 0xec,
+		0x0f, 0x2d, 0x5b, 0xff, 0x97, 0x02, 0xb5, 0x78, 0x7f, 0xcb, 0xf7, 0xf6, 0x9d, 0x03, 0xf5, 0x2e,
+		0x5c, 0xef, 0xa2, 0x63, 0xd3, 0x16, 0x7d, 0xa9, 0x19, 0xe0, 0xd0, 0x8c, 0xb0, 0xe5, 0x7b, 0xbc,
+		0x06, 0x28, 0xc6, 0xd5, 0x2e, 0x3a, 0x8e, 0xbb, 0xd6, 0xe7, 0x38, 0xdc, 0x65, 0x7b, 0x74, 0xe6,
+		0xa1, 0x64, 0xf1, 0xd7, 0x84, 0xc8, 0x79, 0xc5, 0x55, 0x9c, 0x36, 0x6a, 0x5d, 0x74, 0xfc, 0x90,
+		0x83, 0x77, 0x9d, 0x57, 0x58, 0xfd, 0x00, 0x34, 0x5e, 0xc9, 0xcd, 0xa3, 0x0e, 0xf6, 0x12, 0x8a,
+		0xfd, 0x9e, 0xeb, 0xb2, 0x32, 0x31, 0x6f, 0x5c, 0xe3, 0xfb, 0x5f, 0x74, 0xb0, 0x27, 0x08, 0x77,
+		0x7a, 0xae, 0xab, 0x36, 0xe1, 0x1a, 0x15, 0xe1, 0x0f, 0xd2, 0x39, 0x8b, 0x98, 0x88, 0x15, 0x86,
+		0x92, 0x71, 0xa5, 0x8b, 0x8e, 0x53, 0xa9, 0x9e, 0x1e, 0x2b, 0xd2, 0xff, 0xa6, 0xc0, 0xca, 0xb0,
+		0x21, 0xf8, 0x41, 0xc7, 0xaa, 0x72, 0xe7, 0x48, 0x61, 0xaf, 0xad, 0x08, 0xea, 0x6d, 0xb8, 0x95,
+		0x77, 0x04, 0x71, 0x99, 0x3f, 0x81, 0x59, 0x8b, 0x41, 0x44, 0xb5, 0x5a, 0xcb, 0x8f, 0x8a, 0x21,
+		0x0e, 0x82, 0x4e, 0xff, 0x6a, 0x06, 0x96, 0x3f, 0x0f, 0x6c, 0x44, 0x2e, 0xaa, 0x95, 0xd4, 0xdd,
+		0xfc, 0xb8, 0x9d, 0x11, 0x2d, 0xe7, 0xc8, 0xac, 0xeb, 0xf7, 0xda, 0x2e, 0xe6, 0x5f, 0xe2, 0xe4,
+		0x51, 0xfd, 0x48, 0x12, 0xd5, 0xa5, 0xd3, 0x3f, 0xeb, 0x0d, 0x87, 0xfc, 0x6e, 0x41, 0xc8, 0xe7,
+		0xbd, 0xc4, 0x3e, 0xf4, 0x7d, 0x97, 0x73, 0xcb, 0xb9, 0x0e, 0xcf, 0xf2, 0xae, 0xc3, 0x5c, 0xbe,
+		0x82, 0xef, 0x35, 0x39, 0x4b, 0xe9, 0x5d, 0xf9, 0x35, 0xdc, 0x94, 0x87, 0xc0, 0x6b, 0x8b, 0xb2,
+		0x3f, 0x4e, 0xc1, 0x0a, 0x8b, 0xa8, 0x1e, 0xde, 0xc6, 0xc8, 0xfe, 0x14, 0x13, 0x82, 0x43, 0x26,
+		0xfc, 0xe2, 0xc4, 0xd9, 0x12, 0xcc, 0x86, 0x18, 0x45, 0xbe, 0x17, 0xf7, 0xdc, 0x7c, 0x95, 0x6e,
+		0xc6, 0xf9, 0x53, 0x8c, 0x68, 0xc6, 0xe9, 0x91, 0xa8, 0x9f, 0xb8, 0x6f, 0xf8, 0xc7, 0x93, 0xf9,
+		0x2e, 0x3a, 0xe6, 0x36, 0x7f, 0x01, 0xb7, 0xf2, 0x0c, 0x22, 0xac, 0x5e, 0x87, 0xf9, 0x90, 0x63,
+		0x70, 0x83, 0x94, 0x8c, 0x64, 0x4d, 0x87, 0xf4, 0xe8, 0xd0, 0x09, 0x02, 0x31, 0x13, 0x95, 0x8c,
+		0x78, 0xa9, 0x7f, 0x35, 0x05, 0x4b, 0x4f, 0x9d, 0x83, 0x30, 0xe5, 0xcd, 0x8b, 0x63, 0xe2, 0x35,
+		0xda, 0xc0, 0x86, 0x07, 0x98, 0x98, 0xd9, 0xe6, 0xbf, 0x6c, 0xd4, 0x38, 0x3c, 0xa6, 0xcd, 0xda,
+		0xb6, 0x34, 0x64, 0xdb, 0x67, 0x70, 0x7d, 0xc4, 0x04, 0x03, 0xa3, 0x76, 0xf9, 0x56, 0x62, 0xd4,
+		0x78, 0x4d, 0x8d, 0x8a, 0x8f, 0x03, 0x27, 0x1c, 0x18, 0x55, 0x2c, 0xf5, 0xbf, 0x2a, 0x70, 0x6d,
+		0x1b, 0xbb, 0xf8, 0x02, 0xda, 0x54, 0xd7, 0x60, 0x69, 0x58, 0x75, 0x31, 0x06, 0x7d, 0x06, 0x75,
+		0xde, 0xed, 0xd3, 0x2f, 0xf7, 0xf1, 0xee, 0x78, 0x17, 0x72, 0xd0, 0x7d, 0x4e, 0x65, 0xba, 0xcf,
+		0xaf, 0x15, 0xa8, 0x65, 0xf9, 0xa9, 0x2a, 0xcc, 0x78, 0xa8, 0x8b, 0x05, 0x0b, 0xf6, 0x9b, 0x7a,
+		0xc1, 0xb1, 0xb1, 0x47, 0xe8, 0xbb, 0x9b, 0x18, 0x8e, 0xe2, 0xb5, 0xfa, 0x09, 0x7d, 0x77, 0xa3,
+		0xcd, 0xb5, 0xef, 0xba, 0xfc, 0x01, 0x6b, 0xfa, 0xd4, 0x57, 0xa5, 0x2a, 0xa5, 0xa0, 0x13, 0x11,
+		0x05, 0xe9, 0xfb, 0xb0, 0x2c, 0x3d, 0x97, 0x08, 0x81, 0xc7, 0x00, 0x89, 0x65, 0xe3, 0x59, 0xa1,
+		0x20, 0xa3, 0x65, 0xd9, 0xf0, 0x0f, 0x8a, 0x8c, 0x61, 0xf3, 0xef, 0x8b, 0x50, 0x79, 0x2a, 0x30,
+		0x37, 0x9f, 0xb7, 0xd4, 0xaf, 0x15, 0xb8, 0x22, 0xf9, 0x00, 0xae, 0xbe, 0x7f, 0x96, 0xbf, 0xfc,
+		0xd4, 0xef, 0x9e, 0xe9, 0x2b, 0x7b, 0x5a, 0x89, 0x74, 0x73, 0x39, 0x86, 0x12, 0x92, 0x47, 0xf4,
+		0xfa, 0xdd, 0x09, 0xa9, 0x84, 0x12, 0x7d, 0xb8, 0x34, 0xf4, 0x62, 0xaf, 0xbe, 0x5b, 0xf0, 0x70,
+		0x2b, 0xfd, 0x8a, 0x53, 0xdf, 0x98, 0x80, 0x22, 0x23, 0x37, 0x73, 0xee, 0x62, 0xb9, 0xb2, 0x33,
+		0x6f, 0x4c, 0x40, 0x21, 0xe4, 0x06, 0xb0, 0x90, 0x79, 0x03, 0x53, 0x1b, 0xf9, 0x3c, 0x64, 0xcf,
+		0x79, 0xf5, 0xf5, 0xb1, 0xf1, 0x85, 0xc4, 0x3f, 0x29, 0x70, 0x23, 0xf7, 0xa5, 0x47, 0x7d, 0x90,
+		0xcf, 0xee, 0xb4, 0xd7, 0xab, 0xfa, 0x47, 0x67, 0xa2, 0x15, 0x6a, 0xfd, 0x41, 0x81, 0x6b, 0xd2,
+		0xb7, 0x17, 0xf5, 0x5e, 0x3e, 0xdb, 0xa2, 0xb7, 0xa8, 0xfa, 0x07, 0x13, 0xd3, 0x09, 0x55, 0x4e,
+		0x60, 0x71, 0xb8, 0x79, 0x56, 0x37, 0x26, 0x19, 0x9a, 0xb8, 0xfc, 0x33, 0xcc, 0x59, 0xea, 0xb7,
+		0x0a, 0x2c, 0xc9, 0xdf, 0x30, 0xd4, 0x82, 0xe3, 0x14, 0xbe, 0xb5, 0xd4, 0xef, 0x4f, 0x4e, 0x28,
+		0xb4, 0xf9, 0x9d, 0x02, 0x57, 0x65, 0x13, 0xb3, 0x7a, 0x77, 0xd2, 0x09, 0x9b, 0x6b, 0x72, 0xef,
+		0x6c, 0x83, 0x39, 0xb3, 0x8a, 0x7c, 0x9c, 0x29, 0xb2, 0x4a, 0xe1, 0x0c, 0x57, 0xbf, 0x3f, 0x39,
+		0x61, 0xca, 0x2a, 0xb2, 0xa6, 0xb7, 0xc8, 0x2a, 0x05, 0x73, 0x52, 0xfd, 0xde, 0xa4, 0x64, 0x29,
+		0xab, 0xc8, 0x1b, 0xc1, 0x22, 0xab, 0x14, 0xf6, 0xd2, 0x45, 0x56, 0x39, 0xa5, 0xe7, 0xec, 0xc3,
+		0xa5, 0xa1, 0xce, 0xa9, 0x28, 0x81, 0xca, 0xfb, 0xcc, 0xfa, 0xc6, 0x04, 0x14, 0x42, 0x6e, 0x04,
+		0xb5, 0x6c, 0x93, 0xa2, 0xae, 0x17, 0x79, 0x56, 0xd2, 0x89, 0xd5, 0xdf, 0x1d, 0x9f, 0x20, 0x55,
+		0x2a, 0x25, 0x8d, 0x42, 0x51, 0xa9, 0xcc, 0xef, 0x97, 0x8a, 0x4a, 0x65, 0x41, 0x37, 0xf2, 0xf0,
+		0xa3, 0x5f, 0x7e, 0x78, 0xe0, 0x90, 0x4e, 0xaf, 0xdd, 0xb0, 0xfc, 0xee, 0x7a, 0xe6, 0x5f, 0xd2,
+		0x8d, 0x03, 0xec, 0xf1, 0xbf, 0x95, 0xa7, 0xff, 0xd9, 0xfe, 0x51, 0xfc, 0xbb, 0xbf, 0xd1, 0x9e,
+		0x65, 0xbb, 0xef, 0xfd, 0x77, 0x00, 0x34, 0x7b, 0xfb, 0x85, 0x07, 0x2f, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -832,13 +1177,13 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
 		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
 		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
-		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
-		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
-		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
-		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
-		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
-		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0x54, 0xc3, 0x25, 0x9c, 0x9c,
+		0x9f, 0xab, 0x87, 0x66, 0xa4, 0x13, 0x2f, 0xcc, 0xc0, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94, 0x56,
+		0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x7a, 0x7e, 0x4e, 0x62, 0x5e,
+		0x3a, 0xc2, 0x7d, 0x05, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x70, 0x67, 0xfe, 0x60, 0x64, 0x5c, 0xc4,
+		0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0x62, 0x6e, 0x00, 0x54, 0xa9, 0x5e, 0x78,
+		0x6a, 0x4e, 0x8e, 0x77, 0x5e, 0x7e, 0x79, 0x5e, 0x08, 0x48, 0x4b, 0x12, 0x1b, 0xd8, 0x0c, 0x63,
+		0xc0, 0x00, 0xdc, 0x84, 0x30, 0xff, 0xf3, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -847,13 +1192,13 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
 		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
 		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
-		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
-		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
-		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
-		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
-		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
-		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x1d, 0x97, 0x70,
+		0x72, 0x7e, 0xae, 0x1e, 0x9a, 0x99, 0x4e, 0x7c, 0x70, 0x13, 0x03, 0x40, 0x42, 0x01, 0x8c, 0x51,
+		0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0x39, 0x89,
+		0x79, 0xe9, 0x08, 0x27, 0x16, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x23, 0x5c, 0xfa, 0x83, 0x91, 0x71,
+		0x11, 0x13, 0xb3, 0x7b, 0x80, 0xd3, 0x2a, 0x26, 0x39, 0x77, 0x88, 0xc9, 0x01, 0x50, 0xb5, 0x7a,
+		0xe1, 0xa9, 0x39, 0x39, 0xde, 0x79, 0xf9, 0xe5, 0x79, 0x21, 0x20, 0x3d, 0x49, 0x6c, 0x60, 0x43,
+		0x8c, 0x01, 0x03, 0x00, 0xbc, 0x77, 0x4a, 0x07, 0xf7, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -866,14 +1211,13 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
 		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
 		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
-		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
-		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
-		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
-		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
-		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
-		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x0d,
+		0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xe8, 0x3a, 0xf1, 0x86, 0x43, 0x83, 0x3f, 0x00, 0x24,
+		0x12, 0xc0, 0x18, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f,
+		0x9e, 0x9f, 0x93, 0x98, 0x97, 0x8e, 0x88, 0xaa, 0x82, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x78, 0x8c,
+		0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0x62, 0x6e,
+		0x00, 0x54, 0xa9, 0x5e, 0x78, 0x6a, 0x4e, 0x8e, 0x77, 0x5e, 0x7e, 0x79, 0x5e, 0x08, 0x48, 0x4b,
+		0x12, 0x1b, 0xd8, 0x0c, 0x63, 0xc0, 0x00, 0x19, 0x6c, 0xb9, 0xb8, 0xfe, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
@@ -927,8 +1271,7 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x7f, 0x81, 0xc7, 0xa1, 0x4c, 0x36, 0x0d, 0xf9, 0x69, 0xfd, 0xac, 0xf8, 0x6d, 0x86, 0xa6, 0x81,
 		0xa1, 0xf3, 0xf3, 0xf1, 0x94, 0xeb, 0xb7, 0xf9, 0xc4, 0x0d, 0x65, 0xd2, 0x5d, 0xff, 0x9b, 0xbe,
 		0xe1, 0x2c, 0xee, 0x4e, 0x65, 0xf9, 0xe3, 0xd8, 0x8f, 0xea, 0x39, 0x4d, 0xf9, 0xfc, 0x78, 0x52,
-		0x2b, 0x62, 0x4f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x13, 0xdb, 0xef, 0xb7, 0xcc, 0x06, 0x00,
-		0x00,
+		0x2b, 0x62, 0x4f, 0xff, 0x1e, 0x00, 0x13, 0xdb, 0xef, 0xb7, 0xcc, 0x06, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/query.proto
 	[]byte{
@@ -964,155 +1307,155 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x5c, 0xdb, 0x34, 0x3e, 0x88, 0xb5, 0xab, 0x3b, 0x78, 0x11, 0xd0, 0x69, 0xd5, 0x8b, 0x5e, 0x41,
 		0x11, 0xd0, 0xca, 0x2f, 0xc8, 0x12, 0xee, 0xba, 0x93, 0x30, 0xfd, 0x9c, 0x8d, 0xa4, 0x80, 0x4e,
 		0xe5, 0xcd, 0x93, 0x7b, 0x1d, 0x8e, 0x23, 0x79, 0x42, 0xe5, 0xe2, 0xd2, 0xca, 0xfb, 0xbb, 0xf4,
-		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x69,
-		0x28, 0x5b, 0xfb, 0x03, 0x00, 0x00,
+		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x03, 0x00, 0xbd, 0x69, 0x28, 0x5b, 0xfb,
+		0x03, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-		0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0xb1, 0xf2, 0xdb, 0xd1, 0x6e, 0x12,
-		0x47, 0x5d, 0xdb, 0xeb, 0x64, 0xb3, 0x69, 0x36, 0x4d, 0x53, 0x9a, 0xa4, 0x63, 0x26, 0x32, 0xa5,
-		0x8e, 0xa8, 0x38, 0x5e, 0xb4, 0x25, 0x68, 0x69, 0x6c, 0x13, 0x91, 0x48, 0x81, 0x1c, 0x25, 0xf1,
-		0xbd, 0x40, 0xcf, 0xbd, 0x15, 0x3d, 0xf5, 0x0f, 0x28, 0x50, 0x14, 0x3d, 0x17, 0x2d, 0x7a, 0xe8,
-		0xad, 0xd7, 0x1e, 0x7b, 0xef, 0x7f, 0x51, 0xcc, 0x70, 0x28, 0x51, 0xd6, 0x0f, 0x2a, 0x2d, 0xb0,
-		0x7b, 0x33, 0x1f, 0xbf, 0xef, 0xe3, 0x9b, 0x37, 0xef, 0x7d, 0x1c, 0x5a, 0x50, 0xe8, 0x1c, 0x93,
-		0x60, 0xbb, 0xee, 0x34, 0x88, 0x57, 0x27, 0xdb, 0x4e, 0xdb, 0xdd, 0x7e, 0xbf, 0xb3, 0xfd, 0xc1,
-		0x0f, 0xde, 0x9d, 0x34, 0xfd, 0x0f, 0x5b, 0xed, 0xc0, 0xa7, 0x3e, 0x5a, 0x61, 0x98, 0x2d, 0x81,
-		0xd9, 0x72, 0xda, 0xee, 0xd6, 0xfb, 0x9d, 0x6b, 0xb7, 0x4e, 0x7d, 0xff, 0xb4, 0x49, 0xb6, 0x39,
-		0xe4, 0xb8, 0x73, 0xb2, 0xdd, 0xe8, 0x04, 0x0e, 0x75, 0x7d, 0x2f, 0x22, 0x5d, 0xbb, 0x7d, 0xf1,
-		0x3e, 0x75, 0x5b, 0x24, 0xa4, 0x4e, 0xab, 0x2d, 0x00, 0xeb, 0xc3, 0x9e, 0x5c, 0xf7, 0x5b, 0xad,
-		0xae, 0xc4, 0xd0, 0xdc, 0xa8, 0x13, 0xbe, 0x6b, 0xba, 0x21, 0x8d, 0x30, 0x85, 0xbf, 0xcd, 0xc0,
-		0xea, 0xa1, 0x48, 0x57, 0xff, 0x48, 0xea, 0x1d, 0x96, 0x82, 0xe1, 0x9d, 0xf8, 0xa8, 0x06, 0x28,
-		0x5e, 0x87, 0x4d, 0xe2, 0x3b, 0x79, 0x69, 0x5d, 0xda, 0xc8, 0x3d, 0xbc, 0xb7, 0x35, 0x64, 0x49,
-		0x5b, 0x03, 0x3a, 0x78, 0xf9, 0xc3, 0xc5, 0x10, 0x7a, 0x0c, 0x53, 0xf4, 0xbc, 0x4d, 0xf2, 0x19,
-		0x2e, 0x74, 0x67, 0xac, 0x90, 0x75, 0xde, 0x26, 0x98, 0xc3, 0xd1, 0x53, 0x80, 0x90, 0x3a, 0x01,
-		0xb5, 0x59, 0x19, 0xf2, 0x59, 0x4e, 0xbe, 0xb6, 0x15, 0xd5, 0x68, 0x2b, 0xae, 0xd1, 0x96, 0x15,
-		0xd7, 0x08, 0xcf, 0x71, 0x34, 0xbb, 0x66, 0xd4, 0x7a, 0xd3, 0x0f, 0x49, 0x44, 0x9d, 0x4a, 0xa7,
-		0x72, 0x34, 0xa7, 0x5a, 0x30, 0x1f, 0x51, 0x43, 0xea, 0xd0, 0x4e, 0x98, 0x9f, 0x5e, 0x97, 0x36,
-		0x16, 0x1f, 0xee, 0x4c, 0xb6, 0x7a, 0x95, 0x31, 0xab, 0x9c, 0x88, 0x73, 0xf5, 0xde, 0x05, 0xba,
-		0x0b, 0x8b, 0x67, 0x6e, 0x48, 0xfd, 0xe0, 0xdc, 0x6e, 0x12, 0xef, 0x94, 0x9e, 0xe5, 0x67, 0xd6,
-		0xa5, 0x8d, 0x2c, 0x5e, 0x10, 0xd1, 0x12, 0x0f, 0xa2, 0x9f, 0xc3, 0x6a, 0xdb, 0x09, 0x88, 0x47,
-		0x7b, 0xe5, 0xb7, 0x5d, 0xef, 0xc4, 0xcf, 0x5f, 0xe2, 0x4b, 0xd8, 0x18, 0x9a, 0x45, 0x85, 0x33,
-		0xfa, 0x76, 0x12, 0xaf, 0xb4, 0x07, 0x83, 0x48, 0x81, 0xc5, 0x9e, 0x2c, 0xaf, 0xcc, 0x6c, 0x6a,
-		0x65, 0x16, 0xba, 0x0c, 0x5e, 0x9d, 0x4d, 0x98, 0x6a, 0x91, 0x96, 0x9f, 0x9f, 0xe3, 0xc4, 0xab,
-		0x43, 0xf3, 0x39, 0x20, 0x2d, 0x1f, 0x73, 0x18, 0xc2, 0xb0, 0x1c, 0x12, 0x27, 0xa8, 0x9f, 0xd9,
-		0x0e, 0xa5, 0x81, 0x7b, 0xdc, 0xa1, 0x24, 0xcc, 0x03, 0xe7, 0xde, 0x1d, 0xca, 0xad, 0x72, 0xb4,
-		0xd2, 0x05, 0x63, 0x39, 0xbc, 0x10, 0x41, 0x25, 0x58, 0x76, 0x3a, 0xd4, 0xb7, 0x03, 0x12, 0x12,
-		0x6a, 0xb7, 0x7d, 0xd7, 0xa3, 0x61, 0x3e, 0xc7, 0x35, 0xd7, 0x87, 0x6a, 0x62, 0x06, 0xac, 0x70,
-		0x1c, 0x5e, 0x62, 0xd4, 0x44, 0x00, 0x5d, 0x87, 0x39, 0x36, 0x1e, 0x36, 0x9b, 0x8f, 0xfc, 0xfc,
-		0xba, 0xb4, 0x31, 0x87, 0x67, 0x59, 0xa0, 0xe4, 0x86, 0x14, 0xad, 0xc1, 0x25, 0x37, 0xb4, 0xeb,
-		0x81, 0xef, 0xe5, 0x17, 0xd6, 0xa5, 0x8d, 0x59, 0x3c, 0xe3, 0x86, 0x6a, 0xe0, 0x7b, 0x85, 0xdf,
-		0x66, 0xe0, 0xd6, 0xe0, 0xe6, 0xfb, 0xde, 0x89, 0x7b, 0x2a, 0x46, 0x1a, 0x7d, 0x93, 0x14, 0x8e,
-		0x46, 0xe8, 0xe6, 0xd0, 0xf4, 0x2c, 0xf1, 0xb4, 0xc4, 0x73, 0x1d, 0x58, 0xef, 0x6d, 0x94, 0x98,
-		0x01, 0xdf, 0xee, 0x75, 0xb4, 0xdf, 0xa1, 0x62, 0x98, 0xae, 0x0e, 0x6c, 0x9d, 0x26, 0x12, 0xc0,
-		0x37, 0xba, 0x12, 0x55, 0x3e, 0x17, 0xbe, 0x1a, 0xf7, 0xb8, 0xdf, 0xa1, 0xe8, 0x10, 0xae, 0xf3,
-		0xf4, 0x46, 0xa8, 0x67, 0xd3, 0xd4, 0xd7, 0x18, 0x7b, 0x88, 0x70, 0xe1, 0x9f, 0x12, 0xac, 0x0c,
-		0xe9, 0x48, 0x56, 0xe8, 0x86, 0xdf, 0x72, 0x5c, 0xcf, 0x76, 0x1b, 0xbc, 0x1e, 0x73, 0x78, 0x36,
-		0x0a, 0x18, 0x0d, 0x74, 0x1b, 0x72, 0xe2, 0xa6, 0xe7, 0xb4, 0x22, 0xa3, 0x98, 0xc3, 0x10, 0x85,
-		0x4c, 0xa7, 0x45, 0x46, 0x38, 0x53, 0xf6, 0xff, 0x75, 0xa6, 0x3b, 0x30, 0xef, 0x7a, 0x2e, 0x75,
-		0x1d, 0x4a, 0x1a, 0x2c, 0xaf, 0x29, 0x3e, 0x94, 0xb9, 0x6e, 0xcc, 0x68, 0x14, 0x7e, 0x23, 0xc1,
-		0xaa, 0xfe, 0x91, 0x92, 0xc0, 0x73, 0x9a, 0xdf, 0x89, 0x5b, 0x5e, 0xcc, 0x29, 0x33, 0x98, 0xd3,
-		0xbf, 0xa7, 0x61, 0xa5, 0x42, 0xbc, 0x86, 0xeb, 0x9d, 0x2a, 0x75, 0xea, 0xbe, 0x77, 0xe9, 0x39,
-		0xcf, 0xe8, 0x36, 0xe4, 0x1c, 0x71, 0xdd, 0xab, 0x32, 0xc4, 0x21, 0xa3, 0x81, 0xf6, 0x60, 0xa1,
-		0x0b, 0x48, 0xb5, 0xe4, 0x58, 0x9a, 0x5b, 0xf2, 0xbc, 0x93, 0xb8, 0x42, 0x2f, 0x60, 0x9a, 0xd9,
-		0x63, 0xe4, 0xca, 0x8b, 0x0f, 0x1f, 0x0c, 0xf7, 0xa5, 0xfe, 0x0c, 0x99, 0x13, 0x12, 0x1c, 0xf1,
-		0x90, 0x01, 0xcb, 0x67, 0xc4, 0x09, 0xe8, 0x31, 0x71, 0xa8, 0xdd, 0x20, 0xd4, 0x71, 0x9b, 0xa1,
-		0xf0, 0xe9, 0x1b, 0x23, 0x4c, 0xee, 0xbc, 0xe9, 0x3b, 0x0d, 0x2c, 0x77, 0x69, 0x5a, 0xc4, 0x42,
-		0xaf, 0x60, 0xa5, 0xe9, 0x84, 0xd4, 0xee, 0xe9, 0x71, 0x6b, 0x9b, 0x4e, 0xb5, 0xb6, 0x65, 0x46,
-		0xdb, 0x8f, 0x59, 0xdc, 0xde, 0xf6, 0x80, 0x07, 0xa3, 0xa9, 0x20, 0x8d, 0x48, 0x69, 0x26, 0x55,
-		0x69, 0x89, 0x91, 0xaa, 0x11, 0x87, 0xeb, 0xe4, 0xe1, 0x92, 0x43, 0x29, 0x69, 0xb5, 0x29, 0x77,
-		0xee, 0x69, 0x1c, 0x5f, 0xa2, 0x07, 0x20, 0xb7, 0x9c, 0x8f, 0x6e, 0xab, 0xd3, 0xb2, 0x45, 0x28,
-		0xe4, 0x2e, 0x3c, 0x8d, 0x97, 0x44, 0x5c, 0x11, 0x61, 0x66, 0xd7, 0x61, 0xfd, 0x8c, 0x34, 0x3a,
-		0xcd, 0x38, 0x93, 0xb9, 0x74, 0xbb, 0xee, 0x32, 0x78, 0x1e, 0x2a, 0x2c, 0x91, 0x8f, 0x6d, 0x37,
-		0x9a, 0xd9, 0x48, 0x03, 0x52, 0x35, 0x16, 0x7b, 0x14, 0x2e, 0xf2, 0x02, 0xe6, 0x79, 0x51, 0x4e,
-		0x1c, 0xb7, 0xd9, 0x09, 0x88, 0xf0, 0xda, 0xe1, 0xdb, 0xb4, 0x17, 0x61, 0x70, 0x8e, 0x31, 0xc4,
-		0x05, 0xfa, 0x12, 0x2e, 0x73, 0x01, 0xd6, 0xeb, 0x24, 0xb0, 0xdd, 0x06, 0xf1, 0xa8, 0x4b, 0xcf,
-		0x85, 0xdd, 0x22, 0x76, 0xef, 0x90, 0xdf, 0x32, 0xc4, 0x9d, 0xc2, 0x9f, 0x33, 0x70, 0x55, 0xb4,
-		0x8f, 0x7a, 0xe6, 0x36, 0x1b, 0xdf, 0xc9, 0xe0, 0x7d, 0x91, 0x90, 0x65, 0xc3, 0x91, 0xf4, 0x22,
-		0xf9, 0x43, 0xe2, 0x7c, 0xc2, 0x1d, 0xe9, 0xe2, 0x98, 0x66, 0x07, 0xc6, 0x14, 0xbd, 0x01, 0xf1,
-		0x1a, 0x16, 0xe6, 0xda, 0xf6, 0x9b, 0x6e, 0xfd, 0x9c, 0xb7, 0xf9, 0xe2, 0x88, 0x44, 0x23, 0xe7,
-		0xe4, 0x86, 0x5a, 0xe1, 0x68, 0xbc, 0xdc, 0xbe, 0x18, 0x42, 0x57, 0x60, 0x26, 0xb2, 0x46, 0xde,
-		0xe4, 0x73, 0x58, 0x5c, 0x15, 0xfe, 0x91, 0xe9, 0xda, 0x82, 0x46, 0xea, 0x6e, 0x18, 0xd7, 0xab,
-		0x3b, 0xad, 0x52, 0xfa, 0xb4, 0xc6, 0xc4, 0xbe, 0x69, 0x1d, 0xec, 0xc4, 0xcc, 0xa7, 0x76, 0xe2,
-		0x73, 0x98, 0xef, 0x1b, 0xaa, 0xf4, 0xe3, 0x5c, 0x2e, 0x1c, 0x3e, 0x50, 0x53, 0xfd, 0x03, 0x85,
-		0x61, 0xcd, 0x0f, 0xdc, 0x53, 0xd7, 0x73, 0x9a, 0xf6, 0x85, 0x24, 0xd3, 0x2d, 0x60, 0x35, 0xa6,
-		0x56, 0x93, 0xc9, 0x16, 0xfe, 0x92, 0x81, 0xab, 0xb1, 0x6d, 0x95, 0xfc, 0xba, 0xd3, 0xd4, 0xdc,
-		0xb0, 0xed, 0xd0, 0xfa, 0xd9, 0x64, 0x2e, 0xfb, 0xfd, 0x97, 0xeb, 0x97, 0x70, 0xab, 0x3f, 0x03,
-		0xdb, 0x3f, 0xb1, 0xe9, 0x99, 0x1b, 0xda, 0xc9, 0x2a, 0x8e, 0x17, 0xbc, 0xd6, 0x97, 0x51, 0xf9,
-		0xc4, 0x3a, 0x73, 0x43, 0xe1, 0x4d, 0xe8, 0x26, 0x00, 0x3f, 0x3d, 0x50, 0xff, 0x1d, 0x89, 0xba,
-		0x70, 0x1e, 0xf3, 0xe3, 0x8e, 0xc5, 0x02, 0x85, 0x57, 0x90, 0x4b, 0x9e, 0xb1, 0x9e, 0xc1, 0x8c,
-		0x38, 0xa6, 0x49, 0xeb, 0xd9, 0x8d, 0xdc, 0xc3, 0xcf, 0x52, 0x8e, 0x69, 0xfc, 0x04, 0x2b, 0x28,
-		0x85, 0x3f, 0x66, 0x60, 0xb1, 0xff, 0x16, 0xba, 0x0f, 0x4b, 0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76,
-		0xfd, 0x8c, 0xd4, 0xdf, 0x85, 0x9d, 0x96, 0xd8, 0x84, 0xc5, 0x28, 0xac, 0x8a, 0x28, 0x5a, 0x85,
-		0x99, 0xa0, 0xe3, 0xc5, 0x2f, 0xd1, 0x39, 0x3c, 0x1d, 0x74, 0xd8, 0x69, 0xe3, 0x39, 0x5c, 0x3f,
-		0x71, 0x83, 0x90, 0xbd, 0x78, 0xa2, 0x66, 0xb7, 0xeb, 0x7e, 0xab, 0xdd, 0x24, 0x7d, 0x93, 0x9c,
-		0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0xf3, 0xf5, 0x80, 0x38, 0xdd, 0xbd, 0x49, 0x2f,
-		0x65, 0x4e, 0xe0, 0x85, 0x9d, 0x2e, 0x70, 0x83, 0x75, 0xbd, 0xd3, 0x49, 0xdb, 0x74, 0x3e, 0x26,
-		0x70, 0x81, 0x5b, 0x00, 0xfc, 0xec, 0x4b, 0x9d, 0xe3, 0x66, 0xf4, 0x76, 0x9a, 0xc5, 0x89, 0x48,
-		0xf1, 0x4f, 0x12, 0x5c, 0x1e, 0xf6, 0xee, 0x45, 0x05, 0xb8, 0x55, 0xd1, 0x4d, 0xcd, 0x30, 0x5f,
-		0xda, 0x8a, 0x6a, 0x19, 0x6f, 0x0c, 0xeb, 0xc8, 0xae, 0x5a, 0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x46,
-		0x29, 0x19, 0x9a, 0xfc, 0x03, 0xf4, 0x39, 0xac, 0x8f, 0xc0, 0x54, 0xd5, 0x7d, 0x5d, 0xab, 0x95,
-		0x74, 0x4d, 0x96, 0xc6, 0x28, 0x55, 0x2d, 0x05, 0x5b, 0xba, 0x26, 0x67, 0xd0, 0x0f, 0xe1, 0xfe,
-		0x08, 0x8c, 0xaa, 0x98, 0xaa, 0x5e, 0xb2, 0xb1, 0xfe, 0xb3, 0x9a, 0x5e, 0x65, 0xe0, 0x6c, 0xf1,
-		0x57, 0xbd, 0x9c, 0xfb, 0x1c, 0x28, 0xf9, 0x24, 0x4d, 0x57, 0x8d, 0xaa, 0x51, 0x36, 0xc7, 0xe5,
-		0x7c, 0x01, 0x33, 0x22, 0xe7, 0x8b, 0xa8, 0x38, 0xe7, 0xe2, 0xaf, 0x33, 0xbd, 0x4f, 0x63, 0xa3,
-		0x81, 0x49, 0xa7, 0xeb, 0xb9, 0x9f, 0xc3, 0xfa, 0x61, 0x19, 0xbf, 0xde, 0x2b, 0x95, 0x0f, 0x6d,
-		0x43, 0xb3, 0xb1, 0x5e, 0xab, 0xea, 0x76, 0xa5, 0x5c, 0x32, 0xd4, 0xa3, 0x44, 0x26, 0x3f, 0x82,
-		0xaf, 0x46, 0xa2, 0x94, 0x12, 0x8b, 0x6a, 0xb5, 0x4a, 0xc9, 0x50, 0xd9, 0x53, 0xf7, 0x14, 0xa3,
-		0xa4, 0x6b, 0x76, 0xd9, 0x2c, 0x1d, 0xc9, 0x12, 0xfa, 0x02, 0x36, 0x26, 0x65, 0xca, 0x19, 0xb4,
-		0x09, 0x0f, 0x46, 0xa2, 0xb1, 0xfe, 0x4a, 0x57, 0xad, 0x04, 0x3c, 0x8b, 0x76, 0x60, 0x73, 0x24,
-		0xdc, 0xd2, 0xf1, 0x81, 0x61, 0xf2, 0x82, 0xee, 0xd9, 0xb8, 0x66, 0x9a, 0x86, 0xf9, 0x52, 0x9e,
-		0x2a, 0xfe, 0x5e, 0x82, 0xe5, 0x81, 0x97, 0x11, 0xba, 0x0d, 0xd7, 0x2b, 0x0a, 0xd6, 0x4d, 0xcb,
-		0x56, 0x4b, 0xe5, 0x61, 0x05, 0x18, 0x01, 0x50, 0x76, 0x15, 0x53, 0x2b, 0x9b, 0xb2, 0x84, 0xee,
-		0x41, 0x61, 0x18, 0x40, 0xf4, 0x82, 0x68, 0x0d, 0x39, 0x83, 0xee, 0xc0, 0xcd, 0x61, 0xb8, 0x6e,
-		0xb6, 0x72, 0xb6, 0xf8, 0x9f, 0x0c, 0xdc, 0x18, 0xf7, 0x05, 0xce, 0x3a, 0xb0, 0xbb, 0x6c, 0xfd,
-		0xad, 0xae, 0xd6, 0x2c, 0xb6, 0xe7, 0x91, 0x1e, 0xdb, 0xf9, 0x5a, 0x35, 0x91, 0x79, 0xb2, 0xa4,
-		0x23, 0xc0, 0x6a, 0xf9, 0xa0, 0x52, 0xd2, 0x2d, 0xde, 0x4d, 0x45, 0xb8, 0x97, 0x06, 0x8f, 0x36,
-		0x58, 0xce, 0xf4, 0xed, 0xed, 0x28, 0x69, 0xbe, 0x6e, 0x36, 0x0a, 0x68, 0x0b, 0x8a, 0x69, 0xe8,
-		0x6e, 0x15, 0x34, 0x79, 0x0a, 0x7d, 0x05, 0x5f, 0xa6, 0x27, 0x6e, 0x5a, 0x86, 0x59, 0xd3, 0x35,
-		0x5b, 0xa9, 0xda, 0xa6, 0x7e, 0x28, 0x4f, 0x4f, 0xb2, 0x5c, 0xcb, 0x38, 0x60, 0xfd, 0x59, 0xb3,
-		0xe4, 0x99, 0xe2, 0x5f, 0x25, 0xb8, 0xa2, 0xfa, 0x1e, 0x75, 0xbd, 0x0e, 0x51, 0x42, 0x93, 0x7c,
-		0x30, 0xa2, 0x73, 0x8e, 0x1f, 0xa0, 0xbb, 0x70, 0x27, 0xd6, 0x17, 0xf2, 0xb6, 0x61, 0x1a, 0x96,
-		0xa1, 0x58, 0x65, 0x9c, 0xa8, 0xef, 0x58, 0x18, 0x1b, 0x48, 0x4d, 0xc7, 0x51, 0x5d, 0x47, 0xc3,
-		0xb0, 0x6e, 0xe1, 0x23, 0xd1, 0x0a, 0x91, 0xc3, 0x8c, 0xc6, 0xaa, 0x98, 0xcd, 0xb7, 0x98, 0x7f,
-		0x39, 0x5b, 0xfc, 0x83, 0x04, 0x39, 0xf1, 0x8d, 0xca, 0x3f, 0x61, 0xf2, 0x70, 0x99, 0x2d, 0xb0,
-		0x5c, 0xb3, 0x6c, 0xeb, 0xa8, 0xa2, 0xf7, 0xf7, 0x70, 0xdf, 0x1d, 0x6e, 0x0f, 0xb6, 0x55, 0x8e,
-		0xaa, 0x13, 0x39, 0x49, 0x3f, 0x40, 0x3c, 0x85, 0x61, 0x38, 0x58, 0xce, 0x8c, 0xc5, 0x44, 0x3a,
-		0x59, 0x74, 0x0d, 0xae, 0xf4, 0x61, 0xf6, 0x75, 0x05, 0x5b, 0xbb, 0xba, 0x62, 0xc9, 0x53, 0xc5,
-		0xdf, 0x49, 0x70, 0x35, 0x76, 0x42, 0x8b, 0xbd, 0x58, 0xdd, 0x16, 0x69, 0x94, 0x3b, 0x54, 0x75,
-		0x3a, 0x21, 0x41, 0x0f, 0xe0, 0x6e, 0xd7, 0xc3, 0x2c, 0xa5, 0xfa, 0xba, 0xb7, 0x57, 0xb6, 0xaa,
-		0xb0, 0xe1, 0xee, 0xad, 0x26, 0x15, 0x2a, 0x52, 0x90, 0x25, 0x74, 0x1f, 0x3e, 0x1b, 0x0f, 0xc5,
-		0x7a, 0x55, 0xb7, 0xe4, 0x4c, 0xf1, 0x5f, 0x39, 0x58, 0x4b, 0x26, 0xc7, 0x0e, 0xfa, 0xa4, 0x11,
-		0xa5, 0x76, 0x0f, 0x0a, 0xfd, 0x22, 0xc2, 0xe7, 0x2e, 0xe6, 0xb5, 0x03, 0x9b, 0x63, 0x70, 0x35,
-		0x73, 0x5f, 0x31, 0x35, 0x76, 0x1d, 0x83, 0x64, 0x09, 0xbd, 0x80, 0x67, 0x63, 0x28, 0xbb, 0x8a,
-		0xd6, 0xab, 0x72, 0xf7, 0x8d, 0xa3, 0x58, 0x16, 0x36, 0x76, 0x6b, 0x96, 0x5e, 0x95, 0x33, 0x48,
-		0x07, 0x25, 0x45, 0xa0, 0xdf, 0x87, 0x86, 0xca, 0x64, 0xd1, 0x53, 0x78, 0x9c, 0x96, 0x47, 0xd4,
-		0x32, 0xc6, 0x81, 0x8e, 0x93, 0xd4, 0x29, 0xf4, 0x0d, 0x7c, 0x9d, 0x42, 0x15, 0x4f, 0x1e, 0xe0,
-		0x4e, 0xa3, 0x67, 0xf0, 0x24, 0x35, 0x7b, 0xb5, 0x8c, 0x35, 0xfb, 0x40, 0xc1, 0xaf, 0xfb, 0xc9,
-		0x33, 0xc8, 0x00, 0x3d, 0xed, 0xc1, 0xc2, 0xdd, 0xec, 0x21, 0xbe, 0x90, 0x90, 0xba, 0x34, 0x41,
-		0x15, 0x59, 0x20, 0x45, 0x66, 0x16, 0xbd, 0x04, 0x75, 0xb2, 0x52, 0x8c, 0x17, 0x9a, 0x43, 0x6f,
-		0xc1, 0xfa, 0xb4, 0x5d, 0xd5, 0xdf, 0x5a, 0x3a, 0x36, 0x95, 0x34, 0x65, 0x40, 0xcf, 0xe1, 0x69,
-		0x6a, 0xd1, 0xfa, 0xfd, 0x27, 0x41, 0xcf, 0xa1, 0x27, 0xf0, 0x68, 0x0c, 0x3d, 0xd9, 0x23, 0xbd,
-		0x53, 0x81, 0xa1, 0xc9, 0xf3, 0xe8, 0x31, 0xec, 0x8c, 0x21, 0xf2, 0x29, 0xb4, 0xab, 0x96, 0xa1,
-		0xbe, 0x3e, 0x8a, 0x6e, 0x97, 0x8c, 0xaa, 0x25, 0x2f, 0xa0, 0x9f, 0xc2, 0x8f, 0xc7, 0xd0, 0xba,
-		0x8b, 0x65, 0x7f, 0xe8, 0x38, 0x31, 0x62, 0x0c, 0x56, 0xc3, 0xba, 0xbc, 0x38, 0xc1, 0x9e, 0x54,
-		0x8d, 0x97, 0xe9, 0x95, 0x5b, 0x42, 0x2a, 0xbc, 0x98, 0x68, 0x44, 0xd4, 0x7d, 0xa3, 0xa4, 0x0d,
-		0x17, 0x91, 0xd1, 0x23, 0xd8, 0x1e, 0x23, 0xb2, 0x57, 0xc6, 0xaa, 0x2e, 0xde, 0x58, 0x5d, 0x93,
-		0x58, 0x46, 0x5f, 0xc3, 0xc3, 0x71, 0x24, 0xc5, 0x28, 0x95, 0xdf, 0xe8, 0xf8, 0x22, 0x0f, 0xb1,
-		0xd7, 0xe8, 0x64, 0x4b, 0x37, 0xcc, 0x4a, 0xcd, 0xb2, 0xab, 0xc6, 0xb7, 0xba, 0xbc, 0xc2, 0x5e,
-		0xa3, 0xa9, 0x3b, 0x15, 0xd7, 0x4a, 0xbe, 0x3c, 0x68, 0xc6, 0x03, 0x0f, 0xd9, 0x35, 0x4c, 0x05,
-		0x1f, 0xc9, 0xab, 0x29, 0xbd, 0x37, 0x68, 0x74, 0x7d, 0x2d, 0x74, 0x65, 0x92, 0xe5, 0xe8, 0x0a,
-		0x56, 0xf7, 0x93, 0x15, 0x5f, 0x63, 0x6f, 0x9d, 0x3b, 0xfc, 0x1f, 0x2e, 0x03, 0xe7, 0xaa, 0xa4,
-		0xc5, 0xef, 0xc0, 0x66, 0xb4, 0x6f, 0x43, 0xba, 0x60, 0x84, 0xdb, 0xef, 0xc2, 0x4f, 0x26, 0xa3,
-		0x74, 0xef, 0x2b, 0x25, 0xac, 0x2b, 0xda, 0x51, 0xf7, 0x48, 0x2a, 0x15, 0xff, 0x2e, 0x41, 0x51,
-		0x75, 0xbc, 0x3a, 0x69, 0xc6, 0xff, 0x8f, 0x1d, 0x9b, 0xe5, 0x33, 0x78, 0x32, 0xc1, 0xbc, 0x8f,
-		0xc8, 0xf7, 0x10, 0xaa, 0x9f, 0x4a, 0xae, 0x99, 0xaf, 0xcd, 0xf2, 0xa1, 0x39, 0x8e, 0x20, 0x16,
-		0x51, 0x75, 0x4f, 0xf9, 0x3f, 0x93, 0x27, 0x5b, 0x84, 0x68, 0xbb, 0xff, 0x6d, 0x11, 0x9f, 0x4a,
-		0x9e, 0x68, 0x11, 0xbb, 0xbf, 0x80, 0xb5, 0xba, 0xdf, 0x1a, 0xf6, 0x15, 0xbf, 0xbb, 0x10, 0x2f,
-		0xa7, 0xc2, 0x3e, 0x63, 0x2b, 0xd2, 0xb7, 0x3b, 0xa7, 0x2e, 0x3d, 0xeb, 0x1c, 0x6f, 0xd5, 0xfd,
-		0xd6, 0x76, 0xf2, 0xc7, 0xc9, 0x4d, 0xb7, 0xd1, 0xdc, 0x3e, 0xf5, 0xa3, 0x1f, 0x3b, 0xc5, 0x2f,
-		0x95, 0xcf, 0x9c, 0xb6, 0xfb, 0x7e, 0xe7, 0x78, 0x86, 0xc7, 0x1e, 0xfd, 0x37, 0x00, 0x00, 0xff,
-		0xff, 0x81, 0xb3, 0xae, 0xe2, 0x69, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x6f, 0xdb, 0xd8,
+		0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x8f, 0xfc, 0xa0, 0xaf, 0xe3, 0x58, 0x79, 0x3b, 0x9a, 0x49, 0xe2,
+		0xa8, 0x63, 0x7b, 0x9c, 0x4c, 0x26, 0xcd, 0xa4, 0x69, 0x4a, 0x93, 0x74, 0xcc, 0x44, 0xa6, 0xd4,
+		0x4b, 0x2a, 0x8e, 0x07, 0x6d, 0x09, 0x5a, 0xba, 0xb6, 0x89, 0x48, 0xa4, 0x40, 0x5e, 0x25, 0xf1,
+		0xbe, 0x40, 0xd7, 0xdd, 0x15, 0x5d, 0xf5, 0x07, 0x14, 0x28, 0x8a, 0xae, 0x8b, 0x16, 0x5d, 0x74,
+		0xd7, 0x6d, 0x97, 0xdd, 0xf7, 0x5f, 0x14, 0xf7, 0xf2, 0x21, 0xca, 0x7a, 0x50, 0x69, 0x81, 0x99,
+		0x9d, 0x79, 0xf8, 0x7d, 0x1f, 0xcf, 0x3d, 0xf7, 0x9c, 0x8f, 0x97, 0x16, 0x94, 0xba, 0xc7, 0xc4,
+		0xdf, 0x6e, 0xd8, 0x4d, 0xe2, 0x36, 0xc8, 0xb6, 0xdd, 0x71, 0xb6, 0xdf, 0xef, 0x6c, 0x7f, 0xf0,
+		0xfc, 0x77, 0x27, 0x2d, 0xef, 0xc3, 0x56, 0xc7, 0xf7, 0xa8, 0x87, 0x56, 0x18, 0x66, 0x2b, 0xc2,
+		0x6c, 0xd9, 0x1d, 0x67, 0xeb, 0xfd, 0xce, 0xb5, 0x5b, 0xa7, 0x9e, 0x77, 0xda, 0x22, 0xdb, 0x1c,
+		0x72, 0xdc, 0x3d, 0xd9, 0x6e, 0x76, 0x7d, 0x9b, 0x3a, 0x9e, 0x1b, 0x92, 0xae, 0xdd, 0xbe, 0x78,
+		0x9f, 0x3a, 0x6d, 0x12, 0x50, 0xbb, 0xdd, 0x89, 0x00, 0xeb, 0xc3, 0x9e, 0xdc, 0xf0, 0xda, 0xed,
+		0x44, 0x62, 0x68, 0x6e, 0xd4, 0x0e, 0xde, 0xb5, 0x9c, 0x80, 0x86, 0x98, 0xd2, 0xdf, 0x66, 0x60,
+		0xf5, 0x30, 0x4a, 0x57, 0xfd, 0x48, 0x1a, 0x5d, 0x96, 0x82, 0xe6, 0x9e, 0x78, 0xa8, 0x0e, 0x28,
+		0x5e, 0x87, 0x45, 0xe2, 0x3b, 0x45, 0x61, 0x5d, 0xd8, 0x28, 0x3c, 0xbc, 0xb7, 0x35, 0x64, 0x49,
+		0x5b, 0x03, 0x3a, 0x78, 0xf9, 0xc3, 0xc5, 0x10, 0x7a, 0x0c, 0x53, 0xf4, 0xbc, 0x43, 0x8a, 0x39,
+		0x2e, 0x74, 0x67, 0xac, 0x90, 0x79, 0xde, 0x21, 0x98, 0xc3, 0xd1, 0x53, 0x80, 0x80, 0xda, 0x3e,
+		0xb5, 0x58, 0x19, 0x8a, 0x79, 0x4e, 0xbe, 0xb6, 0x15, 0xd6, 0x68, 0x2b, 0xae, 0xd1, 0x96, 0x19,
+		0xd7, 0x08, 0xcf, 0x71, 0x34, 0xbb, 0x66, 0xd4, 0x46, 0xcb, 0x0b, 0x48, 0x48, 0x9d, 0xca, 0xa6,
+		0x72, 0x34, 0xa7, 0x9a, 0x30, 0x1f, 0x52, 0x03, 0x6a, 0xd3, 0x6e, 0x50, 0x9c, 0x5e, 0x17, 0x36,
+		0x16, 0x1f, 0xee, 0x4c, 0xb6, 0x7a, 0x99, 0x31, 0x0d, 0x4e, 0xc4, 0x85, 0x46, 0xef, 0x02, 0xdd,
+		0x85, 0xc5, 0x33, 0x27, 0xa0, 0x9e, 0x7f, 0x6e, 0xb5, 0x88, 0x7b, 0x4a, 0xcf, 0x8a, 0x33, 0xeb,
+		0xc2, 0x46, 0x1e, 0x2f, 0x44, 0xd1, 0x0a, 0x0f, 0xa2, 0x9f, 0xc3, 0x6a, 0xc7, 0xf6, 0x89, 0x4b,
+		0x7b, 0xe5, 0xb7, 0x1c, 0xf7, 0xc4, 0x2b, 0x5e, 0xe2, 0x4b, 0xd8, 0x18, 0x9a, 0x45, 0x8d, 0x33,
+		0xfa, 0x76, 0x12, 0xaf, 0x74, 0x06, 0x83, 0x48, 0x82, 0xc5, 0x9e, 0x2c, 0xaf, 0xcc, 0x6c, 0x66,
+		0x65, 0x16, 0x12, 0x06, 0xaf, 0xce, 0x26, 0x4c, 0xb5, 0x49, 0xdb, 0x2b, 0xce, 0x71, 0xe2, 0xd5,
+		0xa1, 0xf9, 0x1c, 0x90, 0xb6, 0x87, 0x39, 0x0c, 0x61, 0x58, 0x0e, 0x88, 0xed, 0x37, 0xce, 0x2c,
+		0x9b, 0x52, 0xdf, 0x39, 0xee, 0x52, 0x12, 0x14, 0x81, 0x73, 0xef, 0x0e, 0xe5, 0x1a, 0x1c, 0x2d,
+		0x25, 0x60, 0x2c, 0x06, 0x17, 0x22, 0xa8, 0x02, 0xcb, 0x76, 0x97, 0x7a, 0x96, 0x4f, 0x02, 0x42,
+		0xad, 0x8e, 0xe7, 0xb8, 0x34, 0x28, 0x16, 0xb8, 0xe6, 0xfa, 0x50, 0x4d, 0xcc, 0x80, 0x35, 0x8e,
+		0xc3, 0x4b, 0x8c, 0x9a, 0x0a, 0xa0, 0xeb, 0x30, 0xc7, 0xc6, 0xc3, 0x62, 0xf3, 0x51, 0x9c, 0x5f,
+		0x17, 0x36, 0xe6, 0xf0, 0x2c, 0x0b, 0x54, 0x9c, 0x80, 0xa2, 0x35, 0xb8, 0xe4, 0x04, 0x56, 0xc3,
+		0xf7, 0xdc, 0xe2, 0xc2, 0xba, 0xb0, 0x31, 0x8b, 0x67, 0x9c, 0x40, 0xf6, 0x3d, 0xb7, 0xf4, 0xdb,
+		0x1c, 0xdc, 0x1a, 0xdc, 0x7c, 0xcf, 0x3d, 0x71, 0x4e, 0xa3, 0x91, 0x46, 0xdf, 0xa4, 0x85, 0xc3,
+		0x11, 0xba, 0x39, 0x34, 0x3d, 0x33, 0x7a, 0x5a, 0xea, 0xb9, 0x36, 0xac, 0xf7, 0x36, 0x2a, 0x9a,
+		0x01, 0xcf, 0xea, 0x75, 0xb4, 0xd7, 0xa5, 0xd1, 0x30, 0x5d, 0x1d, 0xd8, 0x3a, 0x25, 0x4a, 0x00,
+		0xdf, 0x48, 0x24, 0x0c, 0x3e, 0x17, 0x9e, 0x1c, 0xf7, 0xb8, 0xd7, 0xa5, 0xe8, 0x10, 0xae, 0xf3,
+		0xf4, 0x46, 0xa8, 0xe7, 0xb3, 0xd4, 0xd7, 0x18, 0x7b, 0x88, 0x70, 0xe9, 0x9f, 0x02, 0xac, 0x0c,
+		0xe9, 0x48, 0x56, 0xe8, 0xa6, 0xd7, 0xb6, 0x1d, 0xd7, 0x72, 0x9a, 0xbc, 0x1e, 0x73, 0x78, 0x36,
+		0x0c, 0x68, 0x4d, 0x74, 0x1b, 0x0a, 0xd1, 0x4d, 0xd7, 0x6e, 0x87, 0x46, 0x31, 0x87, 0x21, 0x0c,
+		0xe9, 0x76, 0x9b, 0x8c, 0x70, 0xa6, 0xfc, 0xff, 0xeb, 0x4c, 0x77, 0x60, 0xde, 0x71, 0x1d, 0xea,
+		0xd8, 0x94, 0x34, 0x59, 0x5e, 0x53, 0x7c, 0x28, 0x0b, 0x49, 0x4c, 0x6b, 0x96, 0x7e, 0x23, 0xc0,
+		0xaa, 0xfa, 0x91, 0x12, 0xdf, 0xb5, 0x5b, 0xdf, 0x89, 0x5b, 0x5e, 0xcc, 0x29, 0x37, 0x98, 0xd3,
+		0xbf, 0xa7, 0x61, 0xa5, 0x46, 0xdc, 0xa6, 0xe3, 0x9e, 0x4a, 0x0d, 0xea, 0xbc, 0x77, 0xe8, 0x39,
+		0xcf, 0xe8, 0x36, 0x14, 0xec, 0xe8, 0xba, 0x57, 0x65, 0x88, 0x43, 0x5a, 0x13, 0xed, 0xc1, 0x42,
+		0x02, 0xc8, 0xb4, 0xe4, 0x58, 0x9a, 0x5b, 0xf2, 0xbc, 0x9d, 0xba, 0x42, 0x2f, 0x60, 0x9a, 0xd9,
+		0x63, 0xe8, 0xca, 0x8b, 0x0f, 0x1f, 0x0c, 0xf7, 0xa5, 0xfe, 0x0c, 0x99, 0x13, 0x12, 0x1c, 0xf2,
+		0x90, 0x06, 0xcb, 0x67, 0xc4, 0xf6, 0xe9, 0x31, 0xb1, 0xa9, 0xd5, 0x24, 0xd4, 0x76, 0x5a, 0x41,
+		0xe4, 0xd3, 0x37, 0x46, 0x98, 0xdc, 0x79, 0xcb, 0xb3, 0x9b, 0x58, 0x4c, 0x68, 0x4a, 0xc8, 0x42,
+		0xaf, 0x60, 0xa5, 0x65, 0x07, 0xd4, 0xea, 0xe9, 0x71, 0x6b, 0x9b, 0xce, 0xb4, 0xb6, 0x65, 0x46,
+		0xdb, 0x8f, 0x59, 0x2c, 0x8e, 0xf6, 0x80, 0x07, 0xc3, 0xa9, 0x20, 0xcd, 0x50, 0x69, 0x26, 0x53,
+		0x69, 0x89, 0x91, 0x8c, 0x90, 0xc3, 0x75, 0x8a, 0x70, 0xc9, 0xa6, 0x94, 0xb4, 0x3b, 0x94, 0x3b,
+		0xf7, 0x34, 0x8e, 0x2f, 0xd1, 0x03, 0x10, 0xdb, 0xf6, 0x47, 0xa7, 0xdd, 0x6d, 0x5b, 0x51, 0x28,
+		0xe0, 0x2e, 0x3c, 0x8d, 0x97, 0xa2, 0xb8, 0x14, 0x85, 0x99, 0x5d, 0x07, 0x8d, 0x33, 0xd2, 0xec,
+		0xb6, 0xe2, 0x4c, 0xe6, 0xb2, 0xed, 0x3a, 0x61, 0xf0, 0x3c, 0x64, 0x58, 0x22, 0x1f, 0x3b, 0x4e,
+		0x38, 0xb3, 0xa1, 0x06, 0x64, 0x6a, 0x2c, 0xf6, 0x28, 0x5c, 0xe4, 0x05, 0xcc, 0xf3, 0xa2, 0x9c,
+		0xd8, 0x4e, 0xab, 0xeb, 0x93, 0x62, 0x61, 0xcc, 0x36, 0xed, 0x85, 0x18, 0x5c, 0x60, 0x8c, 0xe8,
+		0x02, 0x7d, 0x09, 0x97, 0xb9, 0x00, 0xeb, 0x75, 0xe2, 0x5b, 0x4e, 0x93, 0xb8, 0xd4, 0xa1, 0xe7,
+		0x91, 0xdd, 0x22, 0x76, 0xef, 0x90, 0xdf, 0xd2, 0xa2, 0x3b, 0xa5, 0x3f, 0xe7, 0xe0, 0x6a, 0xd4,
+		0x3e, 0xf2, 0x99, 0xd3, 0x6a, 0x7e, 0x27, 0x83, 0xf7, 0x45, 0x4a, 0x96, 0x0d, 0x47, 0xda, 0x8b,
+		0xc4, 0x0f, 0xa9, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1f, 0x18, 0x53, 0xf4, 0x06, 0xa2,
+		0xd7, 0x70, 0x64, 0xae, 0x1d, 0xaf, 0xe5, 0x34, 0xce, 0x79, 0x9b, 0x2f, 0x8e, 0x48, 0x34, 0x74,
+		0x4e, 0x6e, 0xa8, 0x35, 0x8e, 0xc6, 0xcb, 0x9d, 0x8b, 0x21, 0x74, 0x05, 0x66, 0x42, 0x6b, 0xe4,
+		0x4d, 0x3e, 0x87, 0xa3, 0xab, 0xd2, 0x3f, 0x72, 0x89, 0x2d, 0x28, 0xa4, 0xe1, 0x04, 0x71, 0xbd,
+		0x92, 0x69, 0x15, 0xb2, 0xa7, 0x35, 0x26, 0xf6, 0x4d, 0xeb, 0x60, 0x27, 0xe6, 0x3e, 0xb5, 0x13,
+		0x9f, 0xc3, 0x7c, 0xdf, 0x50, 0x65, 0x1f, 0xe7, 0x0a, 0xc1, 0xf0, 0x81, 0x9a, 0xea, 0x1f, 0x28,
+		0x0c, 0x6b, 0x9e, 0xef, 0x9c, 0x3a, 0xae, 0xdd, 0xb2, 0x2e, 0x24, 0x99, 0x6d, 0x01, 0xab, 0x31,
+		0xd5, 0x48, 0x27, 0x5b, 0xfa, 0x4b, 0x0e, 0xae, 0xc6, 0xb6, 0x55, 0xf1, 0x1a, 0x76, 0x4b, 0x71,
+		0x82, 0x8e, 0x4d, 0x1b, 0x67, 0x93, 0xb9, 0xec, 0xf7, 0x5f, 0xae, 0x5f, 0xc2, 0xad, 0xfe, 0x0c,
+		0x2c, 0xef, 0xc4, 0xa2, 0x67, 0x4e, 0x60, 0xa5, 0xab, 0x38, 0x5e, 0xf0, 0x5a, 0x5f, 0x46, 0xd5,
+		0x13, 0xf3, 0xcc, 0x09, 0x22, 0x6f, 0x42, 0x37, 0x01, 0xf8, 0xe9, 0x81, 0x7a, 0xef, 0x48, 0xd8,
+		0x85, 0xf3, 0x98, 0x1f, 0x77, 0x4c, 0x16, 0x28, 0xbd, 0x82, 0x42, 0xfa, 0x8c, 0xf5, 0x0c, 0x66,
+		0xa2, 0x63, 0x9a, 0xb0, 0x9e, 0xdf, 0x28, 0x3c, 0xfc, 0x2c, 0xe3, 0x98, 0xc6, 0x4f, 0xb0, 0x11,
+		0xa5, 0xf4, 0xc7, 0x1c, 0x2c, 0xf6, 0xdf, 0x42, 0xf7, 0x61, 0xe9, 0xd8, 0x71, 0x6d, 0xff, 0xdc,
+		0x6a, 0x9c, 0x91, 0xc6, 0xbb, 0xa0, 0xdb, 0x8e, 0x36, 0x61, 0x31, 0x0c, 0xcb, 0x51, 0x14, 0xad,
+		0xc2, 0x8c, 0xdf, 0x75, 0xe3, 0x97, 0xe8, 0x1c, 0x9e, 0xf6, 0xbb, 0xec, 0xb4, 0xf1, 0x1c, 0xae,
+		0x9f, 0x38, 0x7e, 0xc0, 0x5e, 0x3c, 0x61, 0xb3, 0x5b, 0x0d, 0xaf, 0xdd, 0x69, 0x91, 0xbe, 0x49,
+		0x2e, 0x72, 0x48, 0x3c, 0x0e, 0x72, 0x0c, 0xe0, 0xf4, 0xf9, 0x86, 0x4f, 0xec, 0x64, 0x6f, 0xb2,
+		0x4b, 0x59, 0x88, 0xf0, 0x91, 0x9d, 0x2e, 0x70, 0x83, 0x75, 0xdc, 0xd3, 0x49, 0xdb, 0x74, 0x3e,
+		0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0xec, 0x4b, 0xed, 0xe3, 0x56, 0xf8, 0x76, 0x9a, 0xc5, 0xa9,
+		0x48, 0xf9, 0x4f, 0x02, 0x5c, 0x1e, 0xf6, 0xee, 0x45, 0x25, 0xb8, 0x55, 0x53, 0x75, 0x45, 0xd3,
+		0x5f, 0x5a, 0x92, 0x6c, 0x6a, 0x6f, 0x34, 0xf3, 0xc8, 0x32, 0x4c, 0xc9, 0x54, 0x2d, 0x4d, 0x7f,
+		0x23, 0x55, 0x34, 0x45, 0xfc, 0x01, 0xfa, 0x1c, 0xd6, 0x47, 0x60, 0x0c, 0x79, 0x5f, 0x55, 0xea,
+		0x15, 0x55, 0x11, 0x85, 0x31, 0x4a, 0x86, 0x29, 0x61, 0x53, 0x55, 0xc4, 0x1c, 0xfa, 0x21, 0xdc,
+		0x1f, 0x81, 0x91, 0x25, 0x5d, 0x56, 0x2b, 0x16, 0x56, 0x7f, 0x56, 0x57, 0x0d, 0x06, 0xce, 0x97,
+		0x7f, 0xd5, 0xcb, 0xb9, 0xcf, 0x81, 0xd2, 0x4f, 0x52, 0x54, 0x59, 0x33, 0xb4, 0xaa, 0x3e, 0x2e,
+		0xe7, 0x0b, 0x98, 0x11, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x97, 0x7f, 0x9d, 0xeb, 0x7d, 0x1a, 0x6b,
+		0x4d, 0x4c, 0xba, 0x89, 0xe7, 0x7e, 0x0e, 0xeb, 0x87, 0x55, 0xfc, 0x7a, 0xaf, 0x52, 0x3d, 0xb4,
+		0x34, 0xc5, 0xc2, 0x6a, 0xdd, 0x50, 0xad, 0x5a, 0xb5, 0xa2, 0xc9, 0x47, 0xa9, 0x4c, 0x7e, 0x04,
+		0x5f, 0x8d, 0x44, 0x49, 0x15, 0x16, 0x55, 0xea, 0xb5, 0x8a, 0x26, 0xb3, 0xa7, 0xee, 0x49, 0x5a,
+		0x45, 0x55, 0xac, 0xaa, 0x5e, 0x39, 0x12, 0x05, 0xf4, 0x05, 0x6c, 0x4c, 0xca, 0x14, 0x73, 0x68,
+		0x13, 0x1e, 0x8c, 0x44, 0x63, 0xf5, 0x95, 0x2a, 0x9b, 0x29, 0x78, 0x1e, 0xed, 0xc0, 0xe6, 0x48,
+		0xb8, 0xa9, 0xe2, 0x03, 0x4d, 0xe7, 0x05, 0xdd, 0xb3, 0x70, 0x5d, 0xd7, 0x35, 0xfd, 0xa5, 0x38,
+		0x55, 0xfe, 0xbd, 0x00, 0xcb, 0x03, 0x2f, 0x23, 0x74, 0x1b, 0xae, 0xd7, 0x24, 0xac, 0xea, 0xa6,
+		0x25, 0x57, 0xaa, 0xc3, 0x0a, 0x30, 0x02, 0x20, 0xed, 0x4a, 0xba, 0x52, 0xd5, 0x45, 0x01, 0xdd,
+		0x83, 0xd2, 0x30, 0x40, 0xd4, 0x0b, 0x51, 0x6b, 0x88, 0x39, 0x74, 0x07, 0x6e, 0x0e, 0xc3, 0x25,
+		0xd9, 0x8a, 0xf9, 0xf2, 0x7f, 0x72, 0x70, 0x63, 0xdc, 0x17, 0x38, 0xeb, 0xc0, 0x64, 0xd9, 0xea,
+		0x5b, 0x55, 0xae, 0x9b, 0x6c, 0xcf, 0x43, 0x3d, 0xb6, 0xf3, 0x75, 0x23, 0x95, 0x79, 0xba, 0xa4,
+		0x23, 0xc0, 0x72, 0xf5, 0xa0, 0x56, 0x51, 0x4d, 0xde, 0x4d, 0x65, 0xb8, 0x97, 0x05, 0x0f, 0x37,
+		0x58, 0xcc, 0xf5, 0xed, 0xed, 0x28, 0x69, 0xbe, 0x6e, 0x36, 0x0a, 0x68, 0x0b, 0xca, 0x59, 0xe8,
+		0xa4, 0x0a, 0x8a, 0x38, 0x85, 0xbe, 0x82, 0x2f, 0xb3, 0x13, 0xd7, 0x4d, 0x4d, 0xaf, 0xab, 0x8a,
+		0x25, 0x19, 0x96, 0xae, 0x1e, 0x8a, 0xd3, 0x93, 0x2c, 0xd7, 0xd4, 0x0e, 0x58, 0x7f, 0xd6, 0x4d,
+		0x71, 0xa6, 0xfc, 0x57, 0x01, 0xae, 0xc8, 0x9e, 0x4b, 0x1d, 0xb7, 0x4b, 0xa4, 0x40, 0x27, 0x1f,
+		0xb4, 0xf0, 0x9c, 0xe3, 0xf9, 0xe8, 0x2e, 0xdc, 0x89, 0xf5, 0x23, 0x79, 0x4b, 0xd3, 0x35, 0x53,
+		0x93, 0xcc, 0x2a, 0x4e, 0xd5, 0x77, 0x2c, 0x8c, 0x0d, 0xa4, 0xa2, 0xe2, 0xb0, 0xae, 0xa3, 0x61,
+		0x58, 0x35, 0xf1, 0x51, 0xd4, 0x0a, 0xa1, 0xc3, 0x8c, 0xc6, 0xca, 0xb8, 0xaa, 0x27, 0xf3, 0x2f,
+		0xe6, 0xcb, 0x7f, 0x10, 0xa0, 0x10, 0x7d, 0xa3, 0xf2, 0x4f, 0x98, 0x22, 0x5c, 0x66, 0x0b, 0xac,
+		0xd6, 0x4d, 0xcb, 0x3c, 0xaa, 0xa9, 0xfd, 0x3d, 0xdc, 0x77, 0x87, 0xdb, 0x83, 0x65, 0x56, 0xc3,
+		0xea, 0x84, 0x4e, 0xd2, 0x0f, 0x88, 0x9e, 0xc2, 0x30, 0x1c, 0x2c, 0xe6, 0xc6, 0x62, 0x42, 0x9d,
+		0x3c, 0xba, 0x06, 0x57, 0xfa, 0x30, 0xfb, 0xaa, 0x84, 0xcd, 0x5d, 0x55, 0x32, 0xc5, 0xa9, 0xf2,
+		0xef, 0x04, 0xb8, 0x1a, 0x3b, 0x21, 0xfb, 0x0f, 0x01, 0x4b, 0xbd, 0x59, 0xed, 0x52, 0xd9, 0xee,
+		0x06, 0x04, 0x3d, 0x80, 0xbb, 0x89, 0x87, 0x99, 0x92, 0xf1, 0xba, 0xb7, 0x57, 0x96, 0x2c, 0xd5,
+		0x8d, 0xf4, 0x6a, 0x32, 0xa1, 0x51, 0x0a, 0xa2, 0x80, 0xee, 0xc3, 0x67, 0xe3, 0xa1, 0x58, 0x35,
+		0x54, 0x53, 0xcc, 0x95, 0xff, 0x55, 0x80, 0xb5, 0x74, 0x72, 0xec, 0xa0, 0x4f, 0x9a, 0x61, 0x6a,
+		0xf7, 0xa0, 0xd4, 0x2f, 0x12, 0xf9, 0xdc, 0xc5, 0xbc, 0x76, 0x60, 0x73, 0x0c, 0xae, 0xae, 0xef,
+		0x4b, 0xba, 0xc2, 0xae, 0x63, 0x90, 0x28, 0xa0, 0x17, 0xf0, 0x6c, 0x0c, 0x65, 0x57, 0x52, 0x7a,
+		0x55, 0x4e, 0xde, 0x38, 0x92, 0x69, 0x62, 0x6d, 0xb7, 0x6e, 0xaa, 0x86, 0x98, 0x43, 0x2a, 0x48,
+		0x19, 0x02, 0xfd, 0x3e, 0x34, 0x54, 0x26, 0x8f, 0x9e, 0xc2, 0xe3, 0xac, 0x3c, 0xc2, 0x96, 0xd1,
+		0x0e, 0x54, 0x9c, 0xa6, 0x4e, 0xa1, 0x6f, 0xe0, 0xeb, 0x0c, 0x6a, 0xf4, 0xe4, 0x01, 0xee, 0x34,
+		0x7a, 0x06, 0x4f, 0x32, 0xb3, 0x97, 0xab, 0x58, 0xb1, 0x0e, 0x24, 0xfc, 0xba, 0x9f, 0x3c, 0x83,
+		0x34, 0x50, 0xb3, 0x1e, 0x1c, 0xb9, 0x9b, 0x35, 0xc4, 0x17, 0x52, 0x52, 0x97, 0x26, 0xa8, 0x22,
+		0x0b, 0x64, 0xc8, 0xcc, 0xa2, 0x97, 0x20, 0x4f, 0x56, 0x8a, 0xf1, 0x42, 0x73, 0xe8, 0x2d, 0x98,
+		0x9f, 0xb6, 0xab, 0xea, 0x5b, 0x53, 0xc5, 0xba, 0x94, 0xa5, 0x0c, 0xe8, 0x39, 0x3c, 0xcd, 0x2c,
+		0x5a, 0xbf, 0xff, 0xa4, 0xe8, 0x05, 0xf4, 0x04, 0x1e, 0x8d, 0xa1, 0xa7, 0x7b, 0xa4, 0x77, 0x2a,
+		0xd0, 0x14, 0x71, 0x1e, 0x3d, 0x86, 0x9d, 0x31, 0x44, 0x3e, 0x85, 0x96, 0x61, 0x6a, 0xf2, 0xeb,
+		0xa3, 0xf0, 0x76, 0x45, 0x33, 0x4c, 0x71, 0x01, 0xfd, 0x14, 0x7e, 0x3c, 0x86, 0x96, 0x2c, 0x96,
+		0xfd, 0xa1, 0xe2, 0xd4, 0x88, 0x31, 0x58, 0x1d, 0xab, 0xe2, 0xe2, 0x04, 0x7b, 0x62, 0x68, 0x2f,
+		0xb3, 0x2b, 0xb7, 0x84, 0x64, 0x78, 0x31, 0xd1, 0x88, 0xc8, 0xfb, 0x5a, 0x45, 0x19, 0x2e, 0x22,
+		0xa2, 0x47, 0xb0, 0x3d, 0x46, 0x64, 0xaf, 0x8a, 0x65, 0x35, 0x7a, 0x63, 0x25, 0x26, 0xb1, 0x8c,
+		0xbe, 0x86, 0x87, 0xe3, 0x48, 0x92, 0x56, 0xa9, 0xbe, 0x51, 0xf1, 0x45, 0x1e, 0x62, 0xaf, 0xd1,
+		0xc9, 0x96, 0xae, 0xe9, 0xb5, 0xba, 0x69, 0x19, 0xda, 0xb7, 0xaa, 0xb8, 0xc2, 0x5e, 0xa3, 0x99,
+		0x3b, 0x15, 0xd7, 0x4a, 0xbc, 0x3c, 0x68, 0xc6, 0x03, 0x0f, 0xd9, 0xd5, 0x74, 0x09, 0x1f, 0x89,
+		0xab, 0x19, 0xbd, 0x37, 0x68, 0x74, 0x7d, 0x2d, 0x74, 0x65, 0x92, 0xe5, 0xa8, 0x12, 0x96, 0xf7,
+		0xd3, 0x15, 0x5f, 0x63, 0x6f, 0x9d, 0x3b, 0xfc, 0x1f, 0x2e, 0x03, 0xe7, 0xaa, 0xb4, 0xc5, 0xef,
+		0xc0, 0x66, 0xb8, 0x6f, 0x43, 0xba, 0x60, 0x84, 0xdb, 0xef, 0xc2, 0x4f, 0x26, 0xa3, 0x24, 0xf7,
+		0xa5, 0x0a, 0x56, 0x25, 0xe5, 0x28, 0x39, 0x92, 0x0a, 0xe5, 0xbf, 0x0b, 0x50, 0x96, 0x6d, 0xb7,
+		0x41, 0x5a, 0xf1, 0xff, 0x63, 0xc7, 0x66, 0xf9, 0x0c, 0x9e, 0x4c, 0x30, 0xef, 0x23, 0xf2, 0x3d,
+		0x04, 0xe3, 0x53, 0xc9, 0x75, 0xfd, 0xb5, 0x5e, 0x3d, 0xd4, 0xc7, 0x11, 0xa2, 0x45, 0x18, 0xce,
+		0xa9, 0x6b, 0x4f, 0xbc, 0x88, 0xa8, 0xed, 0xfe, 0xb7, 0x45, 0x7c, 0x2a, 0x79, 0xa2, 0x45, 0xec,
+		0xfe, 0x02, 0xd6, 0x1a, 0x5e, 0x7b, 0xd8, 0x57, 0xfc, 0xee, 0x42, 0xbc, 0x9c, 0x1a, 0xfb, 0x8c,
+		0xad, 0x09, 0xdf, 0xee, 0x9c, 0x3a, 0xf4, 0xac, 0x7b, 0xbc, 0xd5, 0xf0, 0xda, 0xdb, 0xe9, 0x1f,
+		0x27, 0x37, 0x9d, 0x66, 0x6b, 0xfb, 0xd4, 0x0b, 0x7f, 0xec, 0x8c, 0x7e, 0xa9, 0x7c, 0x66, 0x77,
+		0x9c, 0xf7, 0x3b, 0xc7, 0x33, 0x3c, 0xf6, 0xe8, 0xbf, 0x03, 0x00, 0x81, 0xb3, 0xae, 0xe2, 0x69,
+		0x1d, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
@@ -1162,601 +1505,600 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
 		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
 		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
-		0x07, 0x00, 0x00, 0xff, 0xff, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+		0x0f, 0x00, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_worker.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdc, 0xc6,
-		0x15, 0x06, 0x75, 0xd7, 0x59, 0x5d, 0x56, 0x63, 0xd4, 0x5d, 0xad, 0x6f, 0xf2, 0xa6, 0xb6, 0x95,
-		0x36, 0xdd, 0xad, 0x95, 0xd4, 0x71, 0xec, 0xb8, 0xa8, 0x2e, 0x16, 0xac, 0x22, 0x4d, 0x15, 0x7a,
-		0xe3, 0x00, 0x29, 0x60, 0x62, 0x96, 0x1c, 0x69, 0x07, 0xe2, 0x72, 0x68, 0x72, 0xb8, 0xf2, 0xbe,
-		0xf4, 0xa1, 0x8f, 0x49, 0x1f, 0x0a, 0x14, 0x4d, 0x5f, 0x0a, 0xf8, 0xb9, 0x3f, 0xa0, 0xbf, 0xa6,
-		0x4f, 0x7d, 0xee, 0x7f, 0x28, 0x0a, 0xce, 0x0c, 0xb9, 0xdc, 0xdd, 0x21, 0xf7, 0xd2, 0x07, 0x05,
-		0xc8, 0x9b, 0x38, 0xfc, 0xce, 0xe1, 0x99, 0xf3, 0x9d, 0x39, 0xf3, 0xcd, 0x68, 0x61, 0x37, 0x6a,
-		0x91, 0xa0, 0x61, 0x63, 0x87, 0x78, 0x36, 0x69, 0x60, 0x9f, 0x36, 0xba, 0x0f, 0x1b, 0x21, 0x09,
-		0xba, 0xd4, 0x26, 0xd6, 0x25, 0x0b, 0x2e, 0x48, 0x50, 0xf7, 0x03, 0xc6, 0x19, 0xba, 0x16, 0x23,
-		0xeb, 0x0a, 0x59, 0xc7, 0x3e, 0xad, 0x77, 0x1f, 0x56, 0x6f, 0x9f, 0x33, 0x76, 0xee, 0x92, 0x86,
-		0x80, 0xb4, 0xa2, 0xb3, 0x86, 0x13, 0x05, 0x98, 0x53, 0xe6, 0x49, 0xa3, 0xea, 0x9d, 0xe1, 0xf7,
-		0x9c, 0x76, 0x48, 0xc8, 0x71, 0xc7, 0x57, 0x80, 0x11, 0x07, 0x97, 0x01, 0xf6, 0x7d, 0x12, 0x84,
-		0xea, 0xfd, 0x8e, 0x2e, 0x3e, 0x9b, 0x75, 0x3a, 0xe9, 0x27, 0x6a, 0x3a, 0x84, 0x43, 0x6c, 0x1a,
-		0xf6, 0xc3, 0xb8, 0xab, 0xc3, 0xb4, 0x69, 0xc8, 0x59, 0xd0, 0x4b, 0x22, 0xd5, 0x41, 0xde, 0x44,
-		0x24, 0x05, 0x68, 0xbf, 0xc3, 0x71, 0x78, 0xe1, 0xd2, 0x90, 0x17, 0x61, 0xe2, 0x2c, 0x9e, 0xb9,
-		0xec, 0x52, 0x62, 0x6a, 0xff, 0x34, 0xa0, 0x7a, 0xca, 0x5c, 0xf7, 0x98, 0x05, 0x47, 0x2a, 0xca,
-		0x26, 0x0e, 0x2f, 0x4c, 0xf2, 0x26, 0x22, 0x21, 0x47, 0xd7, 0x61, 0xc9, 0x61, 0x1d, 0x4c, 0xbd,
-		0x8a, 0xb1, 0x63, 0xec, 0xae, 0x9a, 0xea, 0x09, 0x3d, 0x81, 0xd5, 0xf8, 0x63, 0x56, 0xfc, 0xb5,
-		0xca, 0xdc, 0x8e, 0xb1, 0x5b, 0xda, 0xbb, 0x55, 0xd7, 0x50, 0x52, 0x8f, 0x9d, 0x7d, 0x46, 0x43,
-		0x6e, 0xae, 0x70, 0xf5, 0x17, 0xaa, 0xc2, 0x0a, 0x75, 0x88, 0xc7, 0x29, 0xef, 0x55, 0xe6, 0x85,
-		0xd7, 0xf4, 0x19, 0x3d, 0x80, 0xcd, 0x16, 0xf5, 0x70, 0xd0, 0xb3, 0xec, 0x36, 0xb1, 0x2f, 0xc2,
-		0xa8, 0x53, 0x59, 0x10, 0x90, 0x0d, 0x39, 0x7c, 0xa8, 0x46, 0x6b, 0xff, 0x5d, 0x86, 0x1b, 0xda,
-		0xb8, 0x43, 0x9f, 0x79, 0x21, 0x41, 0xb7, 0x00, 0x44, 0x80, 0x9c, 0x5d, 0x10, 0x19, 0xfc, 0x9a,
-		0x29, 0x42, 0x6e, 0xc6, 0x03, 0xe8, 0x4b, 0x40, 0x49, 0x22, 0x2c, 0xf2, 0x96, 0xd8, 0x51, 0x5c,
-		0x25, 0x6a, 0x22, 0xf7, 0xb5, 0x13, 0xf9, 0x4a, 0xc1, 0x9f, 0x27, 0x68, 0x73, 0xeb, 0x72, 0x78,
-		0x08, 0x1d, 0xc3, 0x7a, 0xea, 0x96, 0xf7, 0x7c, 0x22, 0xe6, 0x57, 0xda, 0xbb, 0x5b, 0xe8, 0xb1,
-		0xd9, 0xf3, 0x89, 0xb9, 0x76, 0x99, 0x79, 0x42, 0xaf, 0x60, 0xdb, 0x0f, 0x48, 0x97, 0xb2, 0x28,
-		0xb4, 0x42, 0x8e, 0x03, 0x4e, 0x1c, 0x8b, 0x74, 0x89, 0xc7, 0x2d, 0xea, 0x88, 0x84, 0x94, 0xf6,
-		0x6e, 0xd4, 0x65, 0xad, 0xd6, 0x93, 0x5a, 0xad, 0x9f, 0x78, 0xfc, 0xd1, 0x47, 0xaf, 0xb0, 0x1b,
-		0x11, 0xf3, 0x7a, 0x62, 0xfd, 0x52, 0x1a, 0x3f, 0x8f, 0x6d, 0x4f, 0x1c, 0xb4, 0x0b, 0xe5, 0x11,
-		0x77, 0x8b, 0x3b, 0xc6, 0xee, 0xbc, 0xb9, 0x11, 0x0e, 0x22, 0x2b, 0xb0, 0x8c, 0x39, 0x27, 0x1d,
-		0x9f, 0x57, 0x96, 0x04, 0x20, 0x79, 0x44, 0x1f, 0x00, 0x6a, 0x61, 0xfb, 0xc2, 0x65, 0xe7, 0x96,
-		0xcd, 0x22, 0x8f, 0x5b, 0x6d, 0xea, 0xf1, 0xca, 0xb2, 0x00, 0x95, 0xd5, 0x9b, 0xc3, 0xf8, 0xc5,
-		0x0b, 0xea, 0x71, 0xf4, 0x08, 0x96, 0x55, 0x65, 0x57, 0x56, 0x44, 0xdc, 0x37, 0xb5, 0xb9, 0x78,
-		0x21, 0x31, 0x66, 0x02, 0x46, 0xf7, 0x61, 0xd3, 0x23, 0x6f, 0xb9, 0xe5, 0xe3, 0x73, 0xa2, 0x48,
-		0x5c, 0x15, 0x24, 0xae, 0xc7, 0xc3, 0xa7, 0xf8, 0x9c, 0x48, 0x22, 0x1f, 0xc3, 0xa2, 0x58, 0x16,
-		0x15, 0x10, 0xde, 0x6b, 0x85, 0x99, 0xfe, 0x22, 0x46, 0x9a, 0xd2, 0x00, 0xbd, 0x86, 0x9b, 0xa3,
-		0x25, 0x60, 0xf5, 0xab, 0xba, 0x34, 0x49, 0x55, 0x6f, 0x8f, 0xd4, 0x40, 0xf2, 0x0a, 0xed, 0xc3,
-		0x46, 0x68, 0xb7, 0x89, 0x13, 0xb9, 0xc4, 0xb1, 0xe2, 0x46, 0x53, 0x59, 0x13, 0x1e, 0xab, 0x23,
-		0xc4, 0x35, 0x93, 0x2e, 0x64, 0xae, 0xa7, 0x16, 0xf1, 0x18, 0x7a, 0x06, 0x6b, 0x09, 0x5d, 0xc2,
-		0xc1, 0xfa, 0x58, 0x07, 0x25, 0x85, 0x17, 0xe6, 0x5f, 0xc1, 0x72, 0x3c, 0x55, 0x4a, 0xc2, 0xca,
-		0xc6, 0xce, 0xfc, 0x6e, 0x69, 0xef, 0x99, 0x76, 0x32, 0x05, 0xcb, 0xa8, 0xfe, 0x85, 0xb4, 0x7f,
-		0xee, 0xf1, 0x98, 0x1c, 0xe5, 0x0d, 0xd5, 0x40, 0xb0, 0xd0, 0xaf, 0xa1, 0x4d, 0xc1, 0x7e, 0x29,
-		0x1e, 0x54, 0x05, 0x54, 0x7d, 0x0d, 0x6b, 0x59, 0x63, 0x54, 0x86, 0xf9, 0x0b, 0xd2, 0x53, 0x6d,
-		0x24, 0xfe, 0x33, 0xa6, 0xae, 0x1b, 0x57, 0xab, 0x5a, 0x76, 0x13, 0x51, 0x27, 0x0c, 0x9e, 0xcc,
-		0x3d, 0x36, 0x6a, 0xff, 0x58, 0x84, 0xf7, 0x64, 0x98, 0x4e, 0x36, 0xf2, 0x43, 0xd6, 0xf1, 0x5d,
-		0xc2, 0x89, 0x93, 0x74, 0xb0, 0x31, 0x8d, 0xe0, 0x29, 0xac, 0x26, 0xdd, 0x39, 0xac, 0xcc, 0x89,
-		0x2c, 0xe9, 0x29, 0x4f, 0x3e, 0x62, 0xf6, 0xf1, 0xe8, 0x67, 0xb0, 0xd5, 0xaf, 0x1c, 0x9b, 0x79,
-		0x9c, 0xbc, 0xe5, 0x62, 0xc9, 0xaf, 0x99, 0xe5, 0xf4, 0xc5, 0xa1, 0x1c, 0x1f, 0x68, 0x7b, 0x0b,
-		0x43, 0x6d, 0xef, 0xf7, 0xb0, 0x15, 0x72, 0x6a, 0x5f, 0xf4, 0x2c, 0xcc, 0x79, 0x40, 0x5b, 0x11,
-		0x27, 0xa1, 0x58, 0x98, 0xa5, 0xbd, 0xba, 0x36, 0x9a, 0x97, 0x02, 0x9d, 0x16, 0xdd, 0x7e, 0x6a,
-		0x65, 0x96, 0xa5, 0xa3, 0xfe, 0x08, 0xfa, 0x18, 0x2a, 0x01, 0xe1, 0x51, 0xe0, 0x59, 0x1e, 0xb9,
-		0xb4, 0x92, 0xe8, 0x45, 0xa5, 0x8b, 0xb5, 0xbd, 0x62, 0xfe, 0x48, 0xbe, 0xff, 0x9c, 0x5c, 0x66,
-		0x53, 0x89, 0x0e, 0xe0, 0xf6, 0x19, 0x0b, 0x6c, 0x62, 0xd9, 0x01, 0xc1, 0x9c, 0x68, 0xcc, 0x97,
-		0x85, 0x79, 0x55, 0xa0, 0x0e, 0x05, 0x68, 0xd8, 0x87, 0xa6, 0xa1, 0xaf, 0xe8, 0x1a, 0x3a, 0x62,
-		0xb0, 0x2e, 0xd6, 0xa5, 0x15, 0x90, 0x30, 0x72, 0x79, 0x58, 0x59, 0x15, 0x64, 0xfc, 0x46, 0x3b,
-		0xfd, 0x09, 0x88, 0xaf, 0xcb, 0x8a, 0x91, 0xce, 0x64, 0xfd, 0xae, 0xbd, 0xc9, 0x0c, 0x55, 0x29,
-		0x6c, 0x8d, 0x40, 0x34, 0x55, 0xfa, 0xab, 0xc1, 0x2a, 0xdd, 0x9d, 0xa0, 0x4a, 0x85, 0xc3, 0x6c,
-		0xad, 0xbe, 0x9b, 0x87, 0x9f, 0x14, 0x87, 0xac, 0x76, 0xad, 0x2f, 0x61, 0x7d, 0x30, 0xc1, 0x86,
-		0xf8, 0xe8, 0x2f, 0xa6, 0x5d, 0xb7, 0xe6, 0x9a, 0x93, 0x25, 0xe1, 0x9d, 0x01, 0xb7, 0xb1, 0xcd,
-		0x69, 0x97, 0x72, 0x4a, 0x42, 0x8b, 0x33, 0xcb, 0xa1, 0xa1, 0x8f, 0xb9, 0xdd, 0xb6, 0x5c, 0x66,
-		0x63, 0xd7, 0xed, 0xa9, 0xd2, 0xff, 0x7a, 0x86, 0x6c, 0xab, 0x4e, 0xb1, 0x9f, 0xfa, 0x6f, 0xb2,
-		0x23, 0xe5, 0xfd, 0x33, 0xe9, 0x5c, 0x66, 0xff, 0x06, 0xce, 0x47, 0x54, 0xff, 0x00, 0x3b, 0xe3,
-		0x1c, 0x68, 0xb8, 0x39, 0x1a, 0xe4, 0x46, 0xbf, 0x54, 0x94, 0xdf, 0x9e, 0xf0, 0x95, 0x38, 0x3e,
-		0xf1, 0xce, 0x58, 0x96, 0xa1, 0x3f, 0xce, 0xc1, 0x8e, 0x66, 0x9a, 0xc7, 0x98, 0xba, 0x13, 0xb7,
-		0x92, 0x03, 0x58, 0xb4, 0x71, 0x14, 0xca, 0x68, 0x36, 0xf6, 0x3e, 0x28, 0x6c, 0x23, 0x7d, 0xef,
-		0x87, 0xb1, 0x8d, 0x29, 0x4d, 0xe3, 0xed, 0xd2, 0x21, 0x1c, 0x53, 0x37, 0x54, 0xd2, 0x41, 0xbf,
-		0x5d, 0x9e, 0xe2, 0x9e, 0xcb, 0xb0, 0x63, 0x26, 0xe0, 0xc2, 0xe6, 0xa2, 0x59, 0x82, 0x8b, 0x5a,
-		0x4d, 0xf5, 0x1e, 0xdc, 0x2d, 0xc8, 0x81, 0xe4, 0xb9, 0xf6, 0xef, 0xbe, 0x60, 0x4c, 0x32, 0x7b,
-		0x95, 0x82, 0xf1, 0x25, 0xa0, 0xd4, 0xaf, 0xd5, 0x21, 0x1c, 0x3b, 0x98, 0x63, 0x25, 0x91, 0xee,
-		0x15, 0x7e, 0xe0, 0xb7, 0x0a, 0x6c, 0x96, 0xf9, 0xd0, 0x48, 0xed, 0xdb, 0x95, 0x54, 0x5c, 0x0e,
-		0xce, 0xf1, 0x4a, 0xc5, 0xe5, 0x1d, 0x28, 0xa9, 0x25, 0xd4, 0x8b, 0xf7, 0x5c, 0x99, 0x09, 0x48,
-		0x86, 0x4e, 0x9c, 0x58, 0x7d, 0xa6, 0x00, 0xa1, 0x3e, 0x17, 0x0a, 0xd4, 0x67, 0x3a, 0x31, 0xa1,
-		0x3e, 0x71, 0xe6, 0x09, 0xed, 0xc1, 0x22, 0xf5, 0xfc, 0x88, 0xab, 0x1d, 0xa8, 0xb8, 0x04, 0x25,
-		0x54, 0xa3, 0x76, 0x96, 0xfe, 0x5f, 0xb5, 0xb3, 0x3c, 0x9d, 0xda, 0x69, 0xc2, 0x76, 0xe2, 0x2f,
-		0xee, 0x70, 0xb6, 0xcb, 0x42, 0x22, 0x1c, 0xb1, 0x88, 0x2b, 0xed, 0xb9, 0x3d, 0xe2, 0xeb, 0x48,
-		0x1d, 0x10, 0xcd, 0xeb, 0x89, 0x6d, 0x93, 0x1d, 0xc6, 0x96, 0x4d, 0x69, 0x88, 0x3e, 0x87, 0xeb,
-		0xe2, 0x23, 0xa3, 0x2e, 0x57, 0xc7, 0xb9, 0xbc, 0x26, 0x0c, 0x87, 0xfc, 0x1d, 0xc3, 0x56, 0x9b,
-		0xe0, 0x80, 0xb7, 0x08, 0xe6, 0xa9, 0x2b, 0x18, 0xe7, 0xaa, 0x9c, 0xda, 0x24, 0x7e, 0x32, 0xfa,
-		0x3c, 0x16, 0xaa, 0x8b, 0x7d, 0x7d, 0xfe, 0x1a, 0x6e, 0x0f, 0x32, 0x61, 0xb1, 0x33, 0x8b, 0xb7,
-		0x69, 0x68, 0x25, 0x06, 0xe3, 0x75, 0x68, 0x75, 0x80, 0x99, 0xdf, 0x9d, 0x35, 0xdb, 0x34, 0xdc,
-		0x57, 0xfe, 0x4f, 0xb2, 0x33, 0x48, 0x9a, 0xd5, 0xfa, 0x04, 0x95, 0xd2, 0x9f, 0xc4, 0x91, 0xea,
-		0x5a, 0x23, 0xc7, 0xa5, 0x8d, 0xd9, 0x8e, 0x4b, 0x0f, 0x60, 0x33, 0xf5, 0xa3, 0xba, 0xcf, 0xa6,
-		0xec, 0x70, 0xc9, 0xf0, 0x91, 0xec, 0x42, 0x1f, 0xc2, 0x52, 0x9b, 0x60, 0x87, 0x04, 0x95, 0xb2,
-		0x3a, 0x44, 0x69, 0x0f, 0x23, 0x02, 0x62, 0x2a, 0x68, 0xed, 0x3b, 0x23, 0x55, 0x9a, 0xd9, 0x6e,
-		0x30, 0xad, 0xd2, 0xfc, 0x08, 0x96, 0xa4, 0xb4, 0x51, 0x9d, 0xa0, 0x38, 0x59, 0x0a, 0x5b, 0xd4,
-		0xfb, 0x6a, 0xf7, 0x53, 0x55, 0x91, 0x13, 0x97, 0x6a, 0xd9, 0xdf, 0xce, 0xc1, 0x83, 0x22, 0xe0,
-		0x41, 0xef, 0xe4, 0x68, 0x5c, 0xff, 0xbe, 0xaa, 0x9e, 0xd6, 0xcf, 0xda, 0xc2, 0x8c, 0x59, 0x5b,
-		0x1c, 0xca, 0xda, 0x4f, 0x61, 0x77, 0x7c, 0x32, 0x54, 0xe6, 0xfe, 0x6a, 0xa4, 0xb2, 0x20, 0x0b,
-		0x9e, 0x4a, 0x16, 0x3c, 0x82, 0xe5, 0x33, 0x4c, 0xdd, 0x28, 0x20, 0x85, 0xc4, 0x1f, 0x4b, 0x8c,
-		0x99, 0x80, 0x0b, 0x99, 0xef, 0xef, 0xd4, 0xba, 0xb0, 0x54, 0xf0, 0xdf, 0xcc, 0x69, 0xeb, 0x43,
-		0xa2, 0xbe, 0xcf, 0x9c, 0x67, 0x32, 0xb6, 0x30, 0x6b, 0xc6, 0x86, 0x59, 0x7f, 0x00, 0xf7, 0xc6,
-		0xe4, 0x42, 0x65, 0xed, 0x6f, 0x06, 0xd4, 0x74, 0xf5, 0x81, 0x3d, 0x9b, 0x4c, 0x45, 0x7a, 0xd2,
-		0x1a, 0xe7, 0x66, 0xd5, 0x71, 0xc3, 0xa4, 0xdf, 0xd3, 0xb7, 0xa1, 0x34, 0x30, 0x35, 0x81, 0x3f,
-		0xcd, 0xc1, 0xfd, 0x02, 0xdc, 0xf7, 0x9c, 0xf8, 0x24, 0x6b, 0x0b, 0xb3, 0x66, 0x6d, 0x98, 0xf8,
-		0xf7, 0xf5, 0xbd, 0x6f, 0x20, 0x1b, 0x03, 0xd4, 0xdb, 0x2c, 0x18, 0x80, 0xbe, 0x48, 0x76, 0xad,
-		0x2b, 0xa4, 0xfe, 0x34, 0xa6, 0xbe, 0x20, 0x30, 0xa5, 0x4b, 0xdf, 0x87, 0xb2, 0x2d, 0x26, 0x66,
-		0x05, 0x32, 0x56, 0xe2, 0x88, 0xf8, 0x56, 0xcc, 0x4d, 0x39, 0x6e, 0x26, 0xc3, 0xaa, 0x4a, 0x72,
-		0x5d, 0xfe, 0xd0, 0xaa, 0xa4, 0x19, 0x57, 0xc9, 0x98, 0x6c, 0x4c, 0x9f, 0xe4, 0x7f, 0xf5, 0xb7,
-		0x0f, 0x71, 0x33, 0x30, 0x8b, 0x6c, 0xf8, 0xf5, 0x90, 0x6c, 0x98, 0xfc, 0x02, 0x22, 0xd9, 0x0c,
-		0x5f, 0xc1, 0x35, 0xf9, 0xaf, 0x13, 0xab, 0x4b, 0x02, 0x71, 0xb5, 0x40, 0xbd, 0x33, 0xa6, 0xce,
-		0x97, 0xf9, 0x44, 0x91, 0xe0, 0x95, 0x84, 0x8b, 0xb3, 0xf2, 0xd6, 0xe5, 0xf0, 0x50, 0x66, 0x13,
-		0xd2, 0x4d, 0x2e, 0xd1, 0x1e, 0x06, 0x54, 0x4d, 0x12, 0x12, 0x2e, 0x6f, 0xac, 0xd2, 0xd3, 0xdd,
-		0x95, 0xd4, 0x56, 0xed, 0x16, 0xdc, 0xd0, 0x06, 0x23, 0x83, 0xdd, 0xfb, 0xcf, 0x26, 0xac, 0xca,
-		0xa9, 0xef, 0x9f, 0x9e, 0xa0, 0xb7, 0x70, 0x4d, 0x73, 0xc5, 0x82, 0x1a, 0x93, 0x5f, 0xc6, 0x88,
-		0x39, 0x56, 0xa7, 0xbe, 0xbd, 0x41, 0x7f, 0x31, 0xe0, 0x66, 0xd1, 0xa5, 0x0b, 0x7a, 0x3c, 0xeb,
-		0xad, 0x58, 0xf5, 0x93, 0x99, 0x6f, 0x78, 0xd0, 0x37, 0x06, 0x6c, 0xe7, 0xde, 0x0f, 0xa0, 0x5f,
-		0x4e, 0xea, 0x78, 0x40, 0x3c, 0x55, 0x1f, 0x4d, 0x6b, 0xa6, 0x82, 0xe9, 0x93, 0x93, 0x5d, 0xb1,
-		0xc5, 0xe4, 0x68, 0xee, 0x2b, 0x8a, 0xc9, 0xd1, 0x1e, 0xfe, 0x33, 0xe4, 0x68, 0x05, 0x64, 0x31,
-		0x39, 0x45, 0x27, 0x88, 0x62, 0x72, 0x0a, 0x35, 0x3e, 0x7a, 0xa7, 0x57, 0xaa, 0x03, 0xb2, 0x16,
-		0x7d, 0x3a, 0xb5, 0xff, 0xcc, 0x3e, 0x50, 0x7d, 0x36, 0xa3, 0xf5, 0x68, 0xf9, 0x8c, 0x4a, 0xb0,
-		0xe2, 0xf2, 0xc9, 0xd5, 0xde, 0xc5, 0xe5, 0x93, 0xaf, 0x8d, 0xd1, 0x77, 0x06, 0xdc, 0x2a, 0xd4,
-		0x83, 0xe8, 0x93, 0xe9, 0x3c, 0x67, 0x13, 0xf5, 0x64, 0x16, 0x53, 0x15, 0xd8, 0x9f, 0x0d, 0xd1,
-		0xa2, 0xf2, 0xf4, 0x0a, 0xfa, 0x78, 0x62, 0x12, 0x06, 0x05, 0x6b, 0xf5, 0xf1, 0xf4, 0x86, 0x2a,
-		0xa4, 0xbf, 0x1b, 0x70, 0x67, 0x8c, 0x84, 0x42, 0x4f, 0xa7, 0xf5, 0x9e, 0xcd, 0xd7, 0xa7, 0xb3,
-		0x19, 0x0f, 0x64, 0x2c, 0x77, 0xef, 0xce, 0xcd, 0xd8, 0x38, 0x9d, 0x97, 0x9b, 0xb1, 0xf1, 0x3a,
-		0x4c, 0x66, 0xac, 0x50, 0x4e, 0xe4, 0x66, 0x6c, 0x12, 0x49, 0x96, 0x9b, 0xb1, 0xc9, 0x14, 0x4c,
-		0x66, 0x25, 0x8e, 0xee, 0xdc, 0xc5, 0x2b, 0x31, 0x57, 0xc6, 0x14, 0xaf, 0xc4, 0x7c, 0x81, 0x10,
-		0x37, 0x72, 0xcd, 0x96, 0x9c, 0xd3, 0xc8, 0xf3, 0x95, 0x44, 0x4e, 0x23, 0x2f, 0xd8, 0xed, 0x0f,
-		0x5a, 0xf0, 0x63, 0x9b, 0x75, 0x74, 0x66, 0x07, 0x48, 0xaa, 0x80, 0x97, 0xf2, 0x97, 0x27, 0xa7,
-		0x01, 0xe3, 0xec, 0xd4, 0xf8, 0xfa, 0xe1, 0x39, 0xe5, 0xed, 0xa8, 0x55, 0xb7, 0x59, 0xa7, 0x91,
-		0xfd, 0x69, 0xc5, 0xcf, 0xa9, 0xe3, 0x36, 0xce, 0x99, 0xfc, 0xd5, 0x88, 0xfa, 0x9d, 0xc5, 0x53,
-		0xec, 0xd3, 0xee, 0xc3, 0xd6, 0x92, 0x18, 0xfb, 0xf0, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b,
-		0xa4, 0xf1, 0x91, 0xd9, 0x22, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdb, 0xc8,
+		0x15, 0x06, 0x65, 0xcb, 0x97, 0x23, 0x5f, 0xe4, 0x09, 0x9a, 0x32, 0x74, 0x2e, 0x8a, 0x72, 0xb1,
+		0xb7, 0xdd, 0x4a, 0x89, 0x77, 0xeb, 0x75, 0x6e, 0x45, 0x7d, 0x89, 0x11, 0x17, 0xdb, 0xad, 0x97,
+		0xd1, 0x66, 0x81, 0x2d, 0x10, 0x62, 0x44, 0x8e, 0x2d, 0xc2, 0x14, 0x47, 0x21, 0x87, 0x72, 0xf4,
+		0xd2, 0x87, 0x3e, 0xee, 0xf6, 0xa1, 0x40, 0xd1, 0xed, 0x4b, 0x81, 0x3c, 0xf7, 0x07, 0xf4, 0xd7,
+		0xf4, 0xa9, 0xcf, 0xfd, 0x0f, 0x45, 0xc1, 0x99, 0xa1, 0x44, 0x49, 0x23, 0xea, 0xd2, 0x07, 0x2f,
+		0xb0, 0x6f, 0xe6, 0xf0, 0x3b, 0xdf, 0x9c, 0x39, 0xe7, 0xcc, 0x99, 0x8f, 0x63, 0xc1, 0x76, 0x54,
+		0x27, 0x41, 0xd5, 0xc6, 0x0e, 0xf1, 0x6d, 0x52, 0xc5, 0x2d, 0xb7, 0xda, 0x7e, 0x5c, 0x0d, 0x49,
+		0xd0, 0x76, 0x6d, 0x62, 0x5d, 0xd2, 0xe0, 0x82, 0x04, 0x95, 0x56, 0x40, 0x19, 0x45, 0xd7, 0x62,
+		0x64, 0x45, 0x22, 0x2b, 0xb8, 0xe5, 0x56, 0xda, 0x8f, 0x8d, 0xdb, 0xe7, 0x94, 0x9e, 0x7b, 0xa4,
+		0xca, 0x21, 0xf5, 0xe8, 0xac, 0xea, 0x44, 0x01, 0x66, 0x2e, 0xf5, 0x85, 0x91, 0x71, 0x67, 0xf0,
+		0x3d, 0x73, 0x9b, 0x24, 0x64, 0xb8, 0xd9, 0x92, 0x80, 0x21, 0x82, 0xcb, 0x00, 0xb7, 0x5a, 0x24,
+		0x08, 0xe5, 0xfb, 0x92, 0xca, 0x3f, 0x9b, 0x36, 0x9b, 0xdd, 0x29, 0xca, 0x2a, 0x84, 0x43, 0x6c,
+		0x37, 0xec, 0xb9, 0x71, 0x57, 0x85, 0x69, 0xb8, 0x21, 0xa3, 0x41, 0x27, 0xf1, 0x54, 0x05, 0x79,
+		0x17, 0x91, 0xa0, 0x93, 0x35, 0x0f, 0xc3, 0xe1, 0x85, 0xe7, 0x86, 0x2c, 0x0b, 0x13, 0x47, 0xf1,
+		0xcc, 0xa3, 0x97, 0x02, 0x53, 0xfe, 0xa7, 0x06, 0xc6, 0x29, 0xf5, 0xbc, 0x63, 0x1a, 0x1c, 0x49,
+		0x2f, 0x6b, 0x38, 0xbc, 0x30, 0xc9, 0xbb, 0x88, 0x84, 0x0c, 0x5d, 0x87, 0x05, 0x87, 0x36, 0xb1,
+		0xeb, 0xeb, 0x5a, 0x49, 0xdb, 0x5e, 0x36, 0xe5, 0x13, 0x7a, 0x0a, 0xcb, 0xf1, 0x64, 0x56, 0x3c,
+		0x9b, 0x9e, 0x2b, 0x69, 0xdb, 0x85, 0x9d, 0x5b, 0x15, 0x45, 0x4a, 0x2a, 0x31, 0xd9, 0xe7, 0x6e,
+		0xc8, 0xcc, 0x25, 0x26, 0xff, 0x42, 0x06, 0x2c, 0xb9, 0x0e, 0xf1, 0x99, 0xcb, 0x3a, 0xfa, 0x1c,
+		0x67, 0xed, 0x3e, 0xa3, 0x2d, 0x58, 0xaf, 0xbb, 0x3e, 0x0e, 0x3a, 0x96, 0xdd, 0x20, 0xf6, 0x45,
+		0x18, 0x35, 0xf5, 0x79, 0x0e, 0x59, 0x13, 0xc3, 0x87, 0x72, 0xb4, 0xfc, 0xdf, 0x45, 0xd8, 0x54,
+		0xfa, 0x1d, 0xb6, 0xa8, 0x1f, 0x12, 0x74, 0x0b, 0x80, 0x3b, 0xc8, 0xe8, 0x05, 0x11, 0xce, 0xaf,
+		0x98, 0xdc, 0xe5, 0x5a, 0x3c, 0x80, 0xbe, 0x02, 0x94, 0x04, 0xc2, 0x22, 0xef, 0x89, 0x1d, 0xc5,
+		0x55, 0x22, 0x17, 0xf2, 0x50, 0xb9, 0x90, 0xaf, 0x25, 0xfc, 0x65, 0x82, 0x36, 0x37, 0x2e, 0x07,
+		0x87, 0xd0, 0x31, 0xac, 0x76, 0x69, 0x59, 0xa7, 0x45, 0xf8, 0xfa, 0x0a, 0x3b, 0x77, 0x33, 0x19,
+		0x6b, 0x9d, 0x16, 0x31, 0x57, 0x2e, 0x53, 0x4f, 0xe8, 0x0d, 0xdc, 0x68, 0x05, 0xa4, 0xed, 0xd2,
+		0x28, 0xb4, 0x42, 0x86, 0x03, 0x46, 0x1c, 0x8b, 0xb4, 0x89, 0xcf, 0x2c, 0xd7, 0xe1, 0x01, 0x29,
+		0xec, 0x6c, 0x56, 0x44, 0xad, 0x56, 0x92, 0x5a, 0xad, 0x9c, 0xf8, 0x6c, 0xf7, 0xd3, 0x37, 0xd8,
+		0x8b, 0x88, 0x79, 0x3d, 0xb1, 0x7e, 0x2d, 0x8c, 0x5f, 0xc6, 0xb6, 0x27, 0x0e, 0xda, 0x86, 0xe2,
+		0x10, 0x5d, 0xbe, 0xa4, 0x6d, 0xcf, 0x99, 0x6b, 0x61, 0x3f, 0x52, 0x87, 0x45, 0xcc, 0x18, 0x69,
+		0xb6, 0x98, 0xbe, 0xc0, 0x01, 0xc9, 0x23, 0xfa, 0x18, 0x50, 0x1d, 0xdb, 0x17, 0x1e, 0x3d, 0xb7,
+		0x6c, 0x1a, 0xf9, 0xcc, 0x6a, 0xb8, 0x3e, 0xd3, 0x17, 0x39, 0xa8, 0x28, 0xdf, 0x1c, 0xc6, 0x2f,
+		0x5e, 0xb9, 0x3e, 0x43, 0xbb, 0xb0, 0x28, 0x2b, 0x5b, 0x5f, 0xe2, 0x7e, 0xdf, 0x54, 0xc6, 0xe2,
+		0x95, 0xc0, 0x98, 0x09, 0x18, 0x3d, 0x84, 0x75, 0x9f, 0xbc, 0x67, 0x56, 0x0b, 0x9f, 0x13, 0x99,
+		0xc4, 0x65, 0x9e, 0xc4, 0xd5, 0x78, 0xf8, 0x14, 0x9f, 0x13, 0x91, 0xc8, 0x3d, 0xc8, 0xf3, 0x6d,
+		0xa1, 0x03, 0x67, 0x2f, 0x67, 0x46, 0xfa, 0xcb, 0x18, 0x69, 0x0a, 0x03, 0xf4, 0x16, 0x6e, 0x0e,
+		0x97, 0x80, 0xd5, 0xab, 0xea, 0xc2, 0x24, 0x55, 0x7d, 0x63, 0xa8, 0x06, 0x92, 0x57, 0x68, 0x1f,
+		0xd6, 0x42, 0xbb, 0x41, 0x9c, 0xc8, 0x23, 0x8e, 0x15, 0x37, 0x1a, 0x7d, 0x85, 0x33, 0x1a, 0x43,
+		0x89, 0xab, 0x25, 0x5d, 0xc8, 0x5c, 0xed, 0x5a, 0xc4, 0x63, 0xe8, 0x05, 0xac, 0x24, 0xe9, 0xe2,
+		0x04, 0xab, 0x63, 0x09, 0x0a, 0x12, 0xcf, 0xcd, 0xbf, 0x86, 0xc5, 0x78, 0xa9, 0x2e, 0x09, 0xf5,
+		0xb5, 0xd2, 0xdc, 0x76, 0x61, 0xe7, 0x85, 0x72, 0x31, 0x19, 0xdb, 0xa8, 0xf2, 0xa5, 0xb0, 0x7f,
+		0xe9, 0xb3, 0x38, 0x39, 0x92, 0x0d, 0x95, 0x81, 0x67, 0xa1, 0x57, 0x43, 0xeb, 0x3c, 0xfb, 0x85,
+		0x78, 0x50, 0x16, 0x90, 0xf1, 0x16, 0x56, 0xd2, 0xc6, 0xa8, 0x08, 0x73, 0x17, 0xa4, 0x23, 0xdb,
+		0x48, 0xfc, 0x67, 0x9c, 0xba, 0x76, 0x5c, 0xad, 0x7a, 0x6e, 0xf2, 0xd4, 0x71, 0x83, 0xa7, 0xb9,
+		0x3d, 0xad, 0xfc, 0x8f, 0x3c, 0xdc, 0x13, 0x6e, 0x3a, 0x69, 0xcf, 0x0f, 0x69, 0xb3, 0xe5, 0x11,
+		0x46, 0x9c, 0xa4, 0x83, 0x8d, 0x69, 0x04, 0xcf, 0x60, 0x39, 0xe9, 0xce, 0xa1, 0x9e, 0x2b, 0xcd,
+		0x8d, 0x4c, 0x79, 0x32, 0x89, 0xd9, 0xc3, 0xa3, 0x9f, 0xc3, 0x46, 0xaf, 0x72, 0x6c, 0xea, 0x33,
+		0xf2, 0x9e, 0xf1, 0x2d, 0xbf, 0x62, 0x16, 0xbb, 0x2f, 0x0e, 0xc5, 0x78, 0x5f, 0xdb, 0x9b, 0x1f,
+		0x68, 0x7b, 0xbf, 0x87, 0x8d, 0x90, 0xb9, 0xf6, 0x45, 0xc7, 0xc2, 0x8c, 0x05, 0x6e, 0x3d, 0x62,
+		0x24, 0xe4, 0x1b, 0xb3, 0xb0, 0x53, 0x51, 0x7a, 0xf3, 0x9a, 0xa3, 0xbb, 0x45, 0xb7, 0xdf, 0xb5,
+		0x32, 0x8b, 0x82, 0xa8, 0x37, 0x82, 0x3e, 0x03, 0x3d, 0x20, 0x2c, 0x0a, 0x7c, 0xcb, 0x27, 0x97,
+		0x56, 0xe2, 0x3d, 0xaf, 0x74, 0xbe, 0xb7, 0x97, 0xcc, 0x9f, 0x88, 0xf7, 0x5f, 0x90, 0xcb, 0x74,
+		0x28, 0xd1, 0x01, 0xdc, 0x3e, 0xa3, 0x81, 0x4d, 0x2c, 0x3b, 0x20, 0x98, 0x11, 0x85, 0xf9, 0x22,
+		0x37, 0x37, 0x38, 0xea, 0x90, 0x83, 0x06, 0x39, 0x14, 0x0d, 0x7d, 0x49, 0xd5, 0xd0, 0x11, 0x85,
+		0x55, 0xbe, 0x2f, 0xad, 0x80, 0x84, 0x91, 0xc7, 0x42, 0x7d, 0x99, 0x27, 0xe3, 0x37, 0xca, 0xe5,
+		0x4f, 0x90, 0xf8, 0x8a, 0xa8, 0x18, 0x41, 0x26, 0xea, 0x77, 0xe5, 0x5d, 0x6a, 0xc8, 0x70, 0x61,
+		0x63, 0x08, 0xa2, 0xa8, 0xd2, 0x5f, 0xf5, 0x57, 0xe9, 0xf6, 0x04, 0x55, 0xca, 0x09, 0xd3, 0xb5,
+		0xfa, 0x61, 0x0e, 0xee, 0x67, 0xbb, 0x2c, 0x4f, 0xad, 0xaf, 0x60, 0xb5, 0x3f, 0xc0, 0x1a, 0x9f,
+		0xf4, 0xd1, 0xb4, 0xfb, 0xd6, 0x5c, 0x71, 0xd2, 0x49, 0xf8, 0xa0, 0xc1, 0x6d, 0x6c, 0x33, 0xb7,
+		0xed, 0x32, 0x97, 0x84, 0x16, 0xa3, 0x96, 0xe3, 0x86, 0x2d, 0xcc, 0xec, 0x86, 0xe5, 0x51, 0x1b,
+		0x7b, 0x5e, 0x47, 0x96, 0xfe, 0x37, 0x33, 0x44, 0x5b, 0x76, 0x8a, 0xfd, 0x2e, 0x7f, 0x8d, 0x1e,
+		0x49, 0xf6, 0xcf, 0x05, 0xb9, 0x88, 0xfe, 0x26, 0x1e, 0x8d, 0x30, 0xfe, 0x00, 0xa5, 0x71, 0x04,
+		0x8a, 0xdc, 0x1c, 0xf5, 0xe7, 0x46, 0xbd, 0x55, 0x24, 0x6f, 0x87, 0x73, 0x25, 0xc4, 0x27, 0xfe,
+		0x19, 0x4d, 0x67, 0xe8, 0x8f, 0x39, 0x28, 0x29, 0x96, 0x79, 0x8c, 0x5d, 0x6f, 0xe2, 0x56, 0x72,
+		0x00, 0x79, 0x1b, 0x47, 0xa1, 0xf0, 0x66, 0x6d, 0xe7, 0xe3, 0xcc, 0x36, 0xd2, 0x63, 0x3f, 0x8c,
+		0x6d, 0x4c, 0x61, 0x1a, 0x1f, 0x97, 0x0e, 0x61, 0xd8, 0xf5, 0x42, 0x7d, 0x2e, 0xe3, 0xb8, 0x3c,
+		0xc5, 0x1d, 0x8f, 0x62, 0xc7, 0x4c, 0xc0, 0x99, 0xcd, 0x45, 0xb1, 0x05, 0xf3, 0x4a, 0x4d, 0x75,
+		0x0f, 0xee, 0x66, 0xc4, 0x40, 0xe4, 0xb9, 0xfc, 0xef, 0x9e, 0x60, 0x4c, 0x22, 0x7b, 0x95, 0x82,
+		0xf1, 0x35, 0xa0, 0x2e, 0xaf, 0xd5, 0x24, 0x0c, 0x3b, 0x98, 0x61, 0x29, 0x91, 0x1e, 0x64, 0x4e,
+		0xf0, 0x5b, 0x09, 0x36, 0x8b, 0x6c, 0x60, 0xa4, 0xfc, 0xdd, 0x12, 0x6c, 0x2a, 0xd7, 0x78, 0xa5,
+		0xe2, 0xf2, 0x0e, 0x14, 0xe4, 0x16, 0xea, 0xc4, 0x67, 0xae, 0x88, 0x04, 0x24, 0x43, 0x27, 0x4e,
+		0xac, 0x3e, 0xbb, 0x00, 0xae, 0x3e, 0xe7, 0x33, 0xd4, 0x67, 0x77, 0x61, 0x5c, 0x7d, 0xe2, 0xd4,
+		0x13, 0xda, 0x81, 0xbc, 0xeb, 0xb7, 0x22, 0xa6, 0xe7, 0x27, 0x28, 0x41, 0x01, 0x55, 0xa8, 0x9d,
+		0x85, 0xff, 0x57, 0xed, 0x2c, 0x4e, 0xa7, 0x76, 0x6a, 0x70, 0x23, 0xe1, 0x8b, 0x3b, 0x9c, 0xed,
+		0xd1, 0x90, 0x70, 0x22, 0x1a, 0x31, 0xa9, 0x3d, 0x6f, 0x0c, 0x71, 0x1d, 0xc9, 0x0f, 0x44, 0xf3,
+		0x7a, 0x62, 0x5b, 0xa3, 0x87, 0xb1, 0x65, 0x4d, 0x18, 0xa2, 0x2f, 0xe0, 0x3a, 0x9f, 0x64, 0x98,
+		0x72, 0x79, 0x1c, 0xe5, 0x35, 0x6e, 0x38, 0xc0, 0x77, 0x0c, 0x1b, 0x0d, 0x82, 0x03, 0x56, 0x27,
+		0x98, 0x75, 0xa9, 0x60, 0x1c, 0x55, 0xb1, 0x6b, 0x93, 0xf0, 0xa4, 0xf4, 0x79, 0x2c, 0x54, 0xf3,
+		0x3d, 0x7d, 0xfe, 0x16, 0x6e, 0xf7, 0x67, 0xc2, 0xa2, 0x67, 0x16, 0x6b, 0xb8, 0xa1, 0x95, 0x18,
+		0x8c, 0xd7, 0xa1, 0x46, 0x5f, 0x66, 0x7e, 0x77, 0x56, 0x6b, 0xb8, 0xe1, 0xbe, 0xe4, 0x3f, 0x49,
+		0xaf, 0x20, 0x69, 0x56, 0xab, 0x13, 0x54, 0x4a, 0x6f, 0x11, 0x47, 0xb2, 0x6b, 0x0d, 0x7d, 0x2e,
+		0xad, 0xcd, 0xf6, 0xb9, 0xb4, 0x05, 0xeb, 0x5d, 0x1e, 0xd9, 0x7d, 0xd6, 0x45, 0x87, 0x4b, 0x86,
+		0x8f, 0xf8, 0x28, 0xfa, 0x04, 0x16, 0x1a, 0x04, 0x3b, 0x24, 0xd0, 0x8b, 0xf2, 0x23, 0x4a, 0xf9,
+		0x31, 0xc2, 0x21, 0xa6, 0x84, 0x96, 0xbf, 0xd7, 0xba, 0x4a, 0x33, 0xdd, 0x0d, 0xa6, 0x55, 0x9a,
+		0x9f, 0xc2, 0x82, 0x90, 0x36, 0x7a, 0x6e, 0x82, 0x60, 0x49, 0x6c, 0x56, 0xef, 0x2b, 0x3f, 0x84,
+		0xfb, 0xd9, 0x7e, 0xc9, 0x96, 0xfd, 0x5d, 0x0e, 0xb6, 0xb2, 0x80, 0x07, 0x9d, 0x93, 0xa3, 0x71,
+		0xfd, 0xfb, 0xaa, 0x7a, 0x5a, 0x2f, 0x6a, 0xf3, 0x33, 0x46, 0x2d, 0x3f, 0x10, 0xb5, 0x9f, 0xc1,
+		0xf6, 0xf8, 0x60, 0xc8, 0xc8, 0xfd, 0x55, 0x83, 0x92, 0x02, 0x3c, 0x95, 0x2c, 0xd8, 0x85, 0xc5,
+		0x33, 0xec, 0x7a, 0x51, 0x40, 0x32, 0x13, 0x7f, 0x2c, 0x30, 0x66, 0x02, 0xce, 0xcc, 0x7c, 0xef,
+		0xa4, 0x56, 0xb9, 0x25, 0x9d, 0xff, 0x36, 0x07, 0xf7, 0x47, 0xa2, 0x7e, 0xc8, 0x39, 0x4f, 0x45,
+		0x6c, 0x7e, 0xd6, 0x88, 0x0d, 0x66, 0x7d, 0x0b, 0x1e, 0x8c, 0x89, 0x85, 0x8c, 0xda, 0xdf, 0x34,
+		0x28, 0xab, 0xea, 0x03, 0xfb, 0x36, 0x99, 0x2a, 0xe9, 0x49, 0x6b, 0xcc, 0xcd, 0xaa, 0xe3, 0x06,
+		0x93, 0xfe, 0x00, 0xee, 0x65, 0x3a, 0x26, 0x17, 0xf0, 0xa7, 0x1c, 0x3c, 0xcc, 0xc0, 0xfd, 0xc0,
+		0x13, 0x9f, 0x44, 0x6d, 0x7e, 0xd6, 0xa8, 0x0d, 0x26, 0xfe, 0x23, 0xd8, 0x1a, 0x1b, 0x8d, 0xbe,
+		0xd4, 0xdb, 0x34, 0xe8, 0x83, 0xbe, 0x4a, 0x4e, 0xad, 0x2b, 0x4c, 0xfd, 0x29, 0xdc, 0xcb, 0x74,
+		0x4c, 0xea, 0xd2, 0x8f, 0xa0, 0x68, 0xf3, 0x85, 0x59, 0x81, 0xf0, 0x95, 0x38, 0xdc, 0xbf, 0x25,
+		0x73, 0x5d, 0x8c, 0x9b, 0xc9, 0xb0, 0xac, 0x92, 0x91, 0x94, 0x3f, 0xb6, 0x2a, 0xa9, 0xc1, 0xd6,
+		0xd8, 0x68, 0x4c, 0x1f, 0xe4, 0x7f, 0xf5, 0x8e, 0x0f, 0x7e, 0x33, 0x30, 0x8b, 0x6c, 0xf8, 0xf5,
+		0x80, 0x6c, 0x98, 0xfc, 0x02, 0x22, 0x39, 0x0c, 0xdf, 0xc0, 0x35, 0xf1, 0xaf, 0x13, 0xab, 0x4d,
+		0x02, 0x7e, 0xb5, 0xe0, 0xfa, 0x67, 0x54, 0x9f, 0x1b, 0x93, 0x28, 0x12, 0xbc, 0x11, 0x70, 0xfe,
+		0xad, 0xbc, 0x71, 0x39, 0x38, 0x94, 0x3a, 0x84, 0x54, 0x8b, 0x4b, 0xb4, 0x87, 0x06, 0x86, 0x49,
+		0x42, 0xc2, 0xc4, 0x8d, 0x55, 0xf7, 0xeb, 0xee, 0x4a, 0x6a, 0xab, 0x7c, 0x0b, 0x36, 0x95, 0xce,
+		0x08, 0x67, 0x77, 0xfe, 0xb3, 0x0e, 0xcb, 0x62, 0xe9, 0xfb, 0xa7, 0x27, 0xe8, 0x3d, 0x5c, 0x53,
+		0x5c, 0xb1, 0xa0, 0xea, 0xe4, 0x97, 0x31, 0x7c, 0x8d, 0xc6, 0xd4, 0xb7, 0x37, 0xe8, 0x2f, 0x1a,
+		0xdc, 0xcc, 0xba, 0x74, 0x41, 0x7b, 0xb3, 0xde, 0x8a, 0x19, 0x4f, 0x66, 0xbe, 0xe1, 0x41, 0xdf,
+		0x6a, 0x70, 0x63, 0xe4, 0xfd, 0x00, 0xfa, 0xe5, 0xa4, 0xc4, 0x7d, 0xe2, 0xc9, 0xd8, 0x9d, 0xd6,
+		0x4c, 0x3a, 0xd3, 0x4b, 0x4e, 0x7a, 0xc7, 0x66, 0x27, 0x47, 0x71, 0x5f, 0x61, 0x3c, 0x9a, 0xdc,
+		0x60, 0x38, 0x39, 0x4a, 0x01, 0x99, 0x9d, 0x9c, 0xac, 0x2f, 0x08, 0xe3, 0xc9, 0x0c, 0x96, 0xd2,
+		0xab, 0x0f, 0x6a, 0xa5, 0xda, 0x27, 0x6b, 0xd1, 0xf3, 0xa9, 0xf9, 0x53, 0xe7, 0x80, 0xf1, 0x62,
+		0x46, 0xeb, 0xe1, 0xf2, 0x19, 0x96, 0x60, 0xd9, 0xe5, 0x33, 0x52, 0x7b, 0x1b, 0xbb, 0xd3, 0x9a,
+		0x49, 0x67, 0xbe, 0xd7, 0xe0, 0x56, 0xa6, 0x1e, 0x44, 0x4f, 0xa6, 0x63, 0x4e, 0x07, 0xea, 0xe9,
+		0x2c, 0xa6, 0xd2, 0xb1, 0x3f, 0x6b, 0xb0, 0xa9, 0x40, 0x26, 0x7a, 0x05, 0x7d, 0x36, 0x71, 0x12,
+		0xfa, 0x05, 0xab, 0xb1, 0x37, 0xbd, 0xa1, 0x74, 0xe9, 0xef, 0x1a, 0xdc, 0x19, 0x23, 0xa1, 0xd0,
+		0xb3, 0x69, 0xd9, 0xd3, 0xf1, 0x7a, 0x3e, 0x9b, 0x71, 0x5f, 0xc4, 0x46, 0x9e, 0xdd, 0x23, 0x23,
+		0x36, 0x4e, 0xe7, 0x19, 0x7b, 0xd3, 0x1b, 0xf6, 0x45, 0x2c, 0x53, 0x4e, 0x8c, 0x8c, 0xd8, 0x24,
+		0x92, 0xcc, 0x78, 0x3e, 0x9b, 0xf1, 0xf0, 0x4e, 0x1c, 0x3e, 0xb9, 0xb3, 0x77, 0xe2, 0x48, 0x19,
+		0x63, 0xec, 0x4e, 0x6b, 0xd6, 0x6b, 0xe4, 0x8a, 0x23, 0x79, 0x44, 0x23, 0x1f, 0xad, 0x24, 0x8c,
+		0x47, 0x93, 0x1b, 0x88, 0x99, 0x0f, 0xea, 0xf0, 0x53, 0x9b, 0x36, 0x55, 0x66, 0x07, 0x48, 0xa8,
+		0x80, 0xd7, 0xe2, 0x97, 0x27, 0xa7, 0x01, 0x65, 0xf4, 0x54, 0xfb, 0xe6, 0xf1, 0xb9, 0xcb, 0x1a,
+		0x51, 0xbd, 0x62, 0xd3, 0x66, 0x35, 0xfd, 0xd3, 0x8a, 0x5f, 0xb8, 0x8e, 0x57, 0x3d, 0xa7, 0xe2,
+		0x57, 0x23, 0xf2, 0x77, 0x16, 0xcf, 0x70, 0xcb, 0x6d, 0x3f, 0xae, 0x2f, 0xf0, 0xb1, 0x4f, 0xfe,
+		0x37, 0x00, 0x2b, 0xa4, 0xf1, 0x91, 0xd9, 0x22, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6f, 0xdc, 0x44,
 		0x14, 0xc7, 0xf9, 0xb3, 0x9b, 0x7d, 0x49, 0x4a, 0x33, 0x69, 0xd3, 0xa4, 0x4d, 0x9b, 0x74, 0x11,
 		0x29, 0x6d, 0x94, 0xdd, 0x24, 0x2d, 0x55, 0xd5, 0xa2, 0x8a, 0x26, 0x6d, 0xd4, 0x48, 0x6d, 0x1a,
-		0xb9, 0x29, 0x95, 0x90, 0x90, 0x35, 0x19, 0xcf, 0x66, 0x47, 0xf1, 0xda, 0xcb, 0x78, 0x9c, 0xed,
-		0x22, 0x21, 0x71, 0x02, 0x2e, 0x7c, 0x00, 0x24, 0x4e, 0x9c, 0xe0, 0x02, 0x57, 0x10, 0x27, 0x3e,
-		0x02, 0x07, 0xbe, 0x07, 0x12, 0x5f, 0x00, 0xcd, 0x78, 0xec, 0xdd, 0x6c, 0xbc, 0x5e, 0x3b, 0x2d,
-		0xbd, 0xc5, 0xe3, 0xf7, 0x7e, 0xf3, 0x9b, 0x79, 0x33, 0xef, 0xf7, 0x73, 0x16, 0xca, 0xc1, 0x3e,
-		0xe5, 0x55, 0x82, 0x6d, 0xea, 0x12, 0x5a, 0xc5, 0x4d, 0x56, 0x3d, 0x5a, 0xab, 0xda, 0x94, 0x30,
-		0x9f, 0x79, 0x6e, 0xa5, 0xc9, 0x3d, 0xe1, 0xa1, 0x69, 0x19, 0x53, 0xd1, 0x31, 0x15, 0xdc, 0x64,
-		0x95, 0xa3, 0xb5, 0x8b, 0x57, 0x0e, 0x3c, 0xef, 0xc0, 0xa1, 0x55, 0x15, 0xb2, 0x1f, 0xd4, 0xaa,
-		0x76, 0xc0, 0xb1, 0x88, 0x93, 0x2e, 0x2e, 0x26, 0x01, 0x13, 0xaf, 0xd1, 0x88, 0x23, 0x12, 0xa7,
-		0x16, 0xd8, 0x3f, 0x74, 0x98, 0x2f, 0xd2, 0x62, 0x5a, 0x1e, 0x3f, 0xac, 0x39, 0x5e, 0x2b, 0x8c,
-		0x29, 0x7f, 0x3b, 0x05, 0x63, 0x0f, 0x35, 0x63, 0xf4, 0xbd, 0x01, 0x37, 0x7c, 0x52, 0xa7, 0x76,
-		0xe0, 0x50, 0x0b, 0x13, 0xc1, 0x8e, 0x98, 0x68, 0x5b, 0x12, 0xd5, 0x8a, 0x56, 0x65, 0x61, 0x21,
-		0x38, 0xdb, 0x0f, 0x04, 0xf5, 0x67, 0x8d, 0x45, 0xe3, 0x83, 0xf1, 0xf5, 0x7b, 0x95, 0x84, 0x15,
-		0x56, 0x9e, 0x6b, 0x98, 0x07, 0x1a, 0x65, 0x0f, 0xfb, 0x87, 0xd1, 0x3c, 0x0f, 0x62, 0x88, 0xc7,
-		0xef, 0x98, 0x4b, 0x7e, 0xa6, 0x48, 0xf4, 0x05, 0x2c, 0xf8, 0x02, 0x73, 0x61, 0x09, 0xd6, 0xa0,
-		0x3c, 0x91, 0xcf, 0x90, 0xe2, 0xb3, 0x96, 0xcc, 0x47, 0xe6, 0xee, 0xc9, 0xd4, 0x44, 0x16, 0xf3,
-		0x7e, 0xca, 0x7b, 0xf4, 0x93, 0x01, 0x72, 0xf7, 0x9b, 0x0e, 0x15, 0xd4, 0x8a, 0x36, 0xd0, 0xa2,
-		0xaf, 0x28, 0x09, 0x64, 0xd1, 0x12, 0xc9, 0x0c, 0x2b, 0x32, 0x1f, 0x27, 0x92, 0xd9, 0xd4, 0x58,
-		0x2f, 0x35, 0xd4, 0xa3, 0x08, 0x29, 0x91, 0xdb, 0x32, 0xc9, 0x1e, 0x8e, 0x7e, 0x30, 0x60, 0xb9,
-		0x86, 0x99, 0x93, 0x95, 0xe6, 0x88, 0xa2, 0xf9, 0x51, 0x22, 0xcd, 0x2d, 0xcc, 0x9c, 0x6c, 0x14,
-		0xaf, 0xd5, 0xb2, 0x85, 0xa2, 0x9f, 0x0d, 0x58, 0xe5, 0xf4, 0xf3, 0x80, 0xfa, 0xc2, 0x22, 0xd8,
-		0x25, 0xd4, 0xc9, 0x70, 0xce, 0x46, 0x53, 0xb6, 0xd2, 0x0c, 0xc1, 0x36, 0x15, 0xd6, 0xc0, 0xc3,
-		0xb6, 0xcc, 0xb3, 0x87, 0xa3, 0x2f, 0x61, 0x51, 0x53, 0xec, 0x7f, 0xe4, 0x0a, 0x8a, 0xda, 0x7a,
-		0x72, 0x95, 0x55, 0x72, 0xff, 0x33, 0x77, 0x99, 0xa4, 0x05, 0xa0, 0x1f, 0x0d, 0x58, 0xd1, 0xf3,
-		0x67, 0xac, 0x65, 0x51, 0x91, 0xb9, 0x9f, 0x42, 0x26, 0x5b, 0x35, 0xaf, 0x93, 0xac, 0xc1, 0xe8,
-		0x2f, 0x03, 0xee, 0xf7, 0xd4, 0x93, 0xbe, 0x12, 0x94, 0xbb, 0x38, 0x33, 0xeb, 0x31, 0xc5, 0xfa,
-		0xe9, 0xe0, 0xea, 0x3e, 0xd2, 0xc0, 0xd9, 0x16, 0x71, 0x87, 0x9f, 0x32, 0x17, 0x7d, 0x65, 0xc0,
-		0x55, 0x4e, 0x89, 0xc7, 0x6d, 0xab, 0x81, 0xf9, 0x61, 0x9f, 0xca, 0x97, 0x14, 0xed, 0x9b, 0x7d,
-		0x68, 0xcb, 0xec, 0xa7, 0x2a, 0x39, 0x91, 0xdc, 0x15, 0x9e, 0x1a, 0x81, 0x7e, 0x37, 0xe0, 0x36,
-		0xf1, 0x5c, 0xc1, 0xdc, 0x80, 0x5a, 0xd8, 0xb7, 0x5c, 0xda, 0xca, 0xba, 0x9d, 0xa0, 0x78, 0x3d,
-		0xea, 0xd3, 0x77, 0x42, 0xc8, 0x07, 0xfe, 0x0e, 0x6d, 0x65, 0xdb, 0xc6, 0x55, 0x92, 0x33, 0x07,
-		0xfd, 0x6a, 0xc0, 0x7a, 0xd8, 0xa9, 0x49, 0x9d, 0x39, 0x76, 0x56, 0xde, 0xe3, 0x8a, 0xf7, 0x46,
-		0xff, 0xe6, 0xbd, 0x29, 0xd1, 0xb2, 0x91, 0x5e, 0xf1, 0xf3, 0x24, 0xa0, 0x3f, 0x0c, 0xb8, 0xed,
-		0xb3, 0x03, 0x79, 0x66, 0xf3, 0x1e, 0xde, 0x09, 0xc5, 0x7a, 0x2b, 0x99, 0xb5, 0x82, 0xcc, 0x77,
-		0x6a, 0xd7, 0xfc, 0xbc, 0x49, 0xe8, 0x37, 0x03, 0x3e, 0x0c, 0x9a, 0x3e, 0xe5, 0xa2, 0x43, 0xda,
-		0xa7, 0x98, 0x93, 0x7a, 0x17, 0xd1, 0x44, 0xf2, 0x93, 0x29, 0x47, 0xe5, 0x85, 0x42, 0x8c, 0xe6,
-		0x7f, 0xae, 0xf0, 0x3a, 0x93, 0x26, 0x1f, 0x95, 0x20, 0x67, 0xce, 0xc6, 0x04, 0x40, 0x87, 0x4e,
-		0xf9, 0xbb, 0x02, 0x2c, 0x65, 0xb3, 0x0d, 0x68, 0x01, 0xc6, 0x63, 0xd9, 0x60, 0xb6, 0x32, 0x22,
-		0x25, 0x13, 0xa2, 0xa1, 0x6d, 0x1b, 0x6d, 0xc1, 0x64, 0x47, 0x57, 0xda, 0x4d, 0xaa, 0xbd, 0xc1,
-		0xd5, 0xc4, 0xb5, 0xc6, 0x93, 0xb5, 0x9b, 0xd4, 0x9c, 0xc0, 0x5d, 0x4f, 0x68, 0x06, 0x0a, 0xb6,
-		0xd7, 0xc0, 0xcc, 0x55, 0x7a, 0x5e, 0x32, 0xf5, 0x13, 0xba, 0x0b, 0x25, 0x25, 0x57, 0xd2, 0x6d,
-		0x69, 0x0d, 0xbd, 0x9c, 0x88, 0x2d, 0x17, 0xf0, 0x84, 0xf9, 0xc2, 0x1c, 0x13, 0xfa, 0x2f, 0xb4,
-		0x0e, 0xa3, 0xcc, 0x6d, 0x06, 0x42, 0xeb, 0xda, 0x7c, 0x62, 0xde, 0x2e, 0x6e, 0x3b, 0x1e, 0xb6,
-		0xcd, 0x30, 0x14, 0xed, 0xc1, 0x5c, 0x6c, 0xcc, 0x84, 0x67, 0x11, 0xc7, 0xf3, 0xa9, 0x92, 0x25,
-		0x2f, 0x10, 0x5a, 0x84, 0xe6, 0x2a, 0xa1, 0xa9, 0xac, 0x44, 0xa6, 0xb2, 0xf2, 0x50, 0x9b, 0x4a,
-		0x73, 0x26, 0xca, 0xdd, 0xf3, 0x36, 0x65, 0xe6, 0x5e, 0x98, 0xd8, 0x8b, 0xda, 0xf1, 0x57, 0x12,
-		0xb5, 0x98, 0x03, 0x35, 0x76, 0x57, 0x12, 0x75, 0x07, 0x66, 0x34, 0x52, 0x2f, 0xd1, 0xb1, 0x41,
-		0x90, 0xd3, 0xa1, 0x0d, 0x3b, 0xce, 0x72, 0x0b, 0xa6, 0xea, 0x14, 0x73, 0xb1, 0x4f, 0x71, 0x87,
-		0x5d, 0x69, 0x10, 0xd4, 0xd9, 0x38, 0x27, 0xc2, 0xd9, 0x84, 0x09, 0x4e, 0x05, 0x6f, 0x5b, 0x4d,
-		0xcf, 0x61, 0xa4, 0xad, 0x3b, 0xce, 0x62, 0x9f, 0x0e, 0x2e, 0x78, 0x7b, 0x57, 0xc5, 0x99, 0xe3,
-		0xbc, 0xf3, 0x80, 0x6e, 0x42, 0xa1, 0x4e, 0xb1, 0x4d, 0xb9, 0xbe, 0xfa, 0x97, 0x12, 0xd3, 0x1f,
-		0xab, 0x10, 0x53, 0x87, 0xa2, 0x5b, 0x30, 0x13, 0x89, 0xa4, 0xe3, 0x11, 0xec, 0x58, 0x36, 0xf3,
-		0x9b, 0x58, 0x90, 0xba, 0xba, 0x82, 0x63, 0xe6, 0x39, 0xfd, 0xf6, 0x89, 0x7c, 0xf9, 0x50, 0xbf,
-		0x2b, 0x7f, 0x63, 0xc0, 0x7c, 0x9a, 0x6d, 0x45, 0x73, 0x30, 0x16, 0x3a, 0x93, 0xf8, 0x0a, 0x14,
-		0xd5, 0xf3, 0xb6, 0x8d, 0x9e, 0xc0, 0xf9, 0xb8, 0x06, 0x35, 0xc6, 0x3b, 0x25, 0x18, 0x1a, 0xb4,
-		0x6f, 0x48, 0x97, 0x60, 0x8b, 0xf1, 0xa8, 0x02, 0x65, 0x02, 0xcb, 0x39, 0x2c, 0x2b, 0xba, 0x05,
-		0x05, 0x4e, 0xfd, 0xc0, 0x11, 0xfa, 0x0b, 0x21, 0xfd, 0x84, 0xeb, 0xd8, 0x32, 0x86, 0x6b, 0x19,
-		0x0d, 0x27, 0xba, 0x0d, 0x45, 0x69, 0x38, 0x03, 0x4e, 0x53, 0x67, 0xd8, 0x0a, 0x63, 0xcc, 0x28,
-		0xb8, 0xbc, 0x03, 0xcb, 0x39, 0xfc, 0xe2, 0xc0, 0x2e, 0x53, 0xbe, 0x0b, 0x97, 0x53, 0x4d, 0x5e,
-		0x4a, 0x85, 0xca, 0x04, 0xae, 0x67, 0xf6, 0x64, 0x72, 0xc1, 0x36, 0x15, 0x98, 0x39, 0x7e, 0xa6,
-		0x2d, 0x8d, 0x82, 0xcb, 0xff, 0x1a, 0x70, 0xe7, 0xb4, 0x1e, 0xaa, 0xab, 0xf7, 0x19, 0xc7, 0x7a,
-		0xdf, 0x0b, 0x40, 0x27, 0xd5, 0x51, 0x1f, 0xac, 0xa5, 0x44, 0x5e, 0x27, 0x66, 0x33, 0xa7, 0x5a,
-		0xbd, 0x43, 0x68, 0x16, 0x8a, 0xd2, 0x6b, 0x70, 0xcf, 0x51, 0xbd, 0x76, 0xc2, 0x8c, 0x1e, 0x51,
-		0x05, 0xa6, 0x7b, 0xac, 0x84, 0xe7, 0x3a, 0x6d, 0xd5, 0x76, 0xc7, 0xcc, 0x29, 0xd2, 0x2d, 0xf3,
-		0xcf, 0x5c, 0xa7, 0x5d, 0xfe, 0xc5, 0x80, 0x2b, 0xe9, 0x16, 0x4c, 0x96, 0x56, 0x7b, 0x3b, 0x17,
-		0x37, 0x68, 0x54, 0xda, 0x70, 0x68, 0x07, 0x37, 0x68, 0xf7, 0x8e, 0x0f, 0xe5, 0xd8, 0xf1, 0xae,
-		0xfe, 0x30, 0x9c, 0xb9, 0x3f, 0x94, 0xff, 0x29, 0xc2, 0x6a, 0x5e, 0x6f, 0x26, 0x25, 0x2e, 0xde,
-		0x0f, 0x25, 0x71, 0x46, 0x8a, 0xc4, 0x45, 0x80, 0xa1, 0xc4, 0xb5, 0xba, 0x9e, 0x8e, 0x4b, 0xd9,
-		0xd0, 0x29, 0xa5, 0x6c, 0x38, 0xbb, 0x94, 0x61, 0x58, 0xec, 0x78, 0xaa, 0x3e, 0x42, 0x31, 0x32,
-		0xa8, 0x4b, 0xcd, 0xc7, 0x10, 0xcf, 0x13, 0x14, 0xe3, 0x25, 0x5c, 0x52, 0x4b, 0xea, 0x83, 0x3e,
-		0x3a, 0x08, 0xfd, 0x82, 0xcc, 0x4e, 0x02, 0x7e, 0x06, 0x33, 0xfb, 0x98, 0x1c, 0x7a, 0xb5, 0x9a,
-		0xc6, 0x66, 0xae, 0xa0, 0xfc, 0x08, 0x3b, 0x83, 0x35, 0xf8, 0x9c, 0x4e, 0x54, 0xb0, 0xdb, 0x3a,
-		0xed, 0x84, 0x26, 0x15, 0x4f, 0xa3, 0x49, 0xdb, 0x50, 0x62, 0x2e, 0x13, 0x0c, 0x0b, 0x8f, 0x2b,
-		0x8d, 0x3d, 0xb3, 0xbe, 0x3c, 0xd8, 0xff, 0x6f, 0x47, 0x29, 0x66, 0x27, 0xbb, 0xbb, 0xb3, 0x96,
-		0x72, 0x74, 0x56, 0x64, 0xc2, 0x8c, 0x83, 0xe5, 0x37, 0x60, 0x28, 0x13, 0xb2, 0xb4, 0x5a, 0x02,
-		0x20, 0xc3, 0xc9, 0x38, 0x27, 0x73, 0x37, 0xe3, 0x54, 0x53, 0x65, 0xa2, 0xf7, 0x60, 0x92, 0x70,
-		0x79, 0x46, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x32, 0x27, 0xe4, 0x60, 0xe4, 0x13, 0x4f, 0xa7, 0xc7,
-		0x2b, 0x30, 0xd2, 0xa0, 0x0d, 0x4f, 0x1b, 0xe0, 0xb9, 0xc4, 0x94, 0xa7, 0xb4, 0xe1, 0x99, 0x2a,
-		0x0c, 0x99, 0x30, 0x75, 0xc2, 0x50, 0xcf, 0x9e, 0x51, 0xb9, 0xef, 0x27, 0x3b, 0xff, 0x1e, 0xeb,
-		0x6b, 0x9e, 0xf5, 0x7b, 0x46, 0xca, 0x7f, 0x17, 0x61, 0x25, 0xd7, 0x67, 0x4d, 0xdf, 0x76, 0xbc,
-		0x00, 0xe3, 0x71, 0x1f, 0x60, 0xb6, 0xba, 0xc1, 0x25, 0x13, 0xa2, 0xa1, 0xd0, 0x0b, 0x1f, 0x6f,
-		0x14, 0xc3, 0x6f, 0xa0, 0x51, 0xbc, 0x05, 0xcf, 0x9b, 0xa5, 0x51, 0x14, 0xfe, 0xd7, 0x46, 0x51,
-		0x3c, 0x75, 0xa3, 0xf8, 0x04, 0xa6, 0x9b, 0x98, 0x53, 0x57, 0x68, 0x44, 0x7d, 0xbd, 0xc3, 0xcb,
-		0xb9, 0xd4, 0x67, 0xf5, 0x32, 0x5e, 0xa1, 0xe8, 0x4b, 0x3e, 0xd5, 0xec, 0x1d, 0xea, 0x16, 0xc9,
-		0xd2, 0x71, 0x91, 0x24, 0x30, 0xdb, 0x75, 0x0c, 0x2c, 0x4e, 0x83, 0xce, 0xb4, 0xa0, 0xa6, 0xbd,
-		0x91, 0x5a, 0xf0, 0x6d, 0xdb, 0x94, 0x29, 0x7a, 0xea, 0xf3, 0xad, 0xa4, 0xe1, 0x37, 0x63, 0xa1,
-		0x4f, 0xdc, 0xeb, 0x89, 0xd4, 0x7b, 0x3d, 0x99, 0xff, 0x5e, 0x9f, 0x79, 0x8d, 0x7b, 0xfd, 0xee,
-		0xeb, 0xdd, 0xeb, 0x3f, 0x87, 0x60, 0x2d, 0xf7, 0x87, 0xff, 0xdb, 0xb6, 0x5a, 0x0b, 0x30, 0xae,
-		0xff, 0xdf, 0xa1, 0xdc, 0x4f, 0xf8, 0x69, 0x0b, 0xe1, 0x90, 0x72, 0x3f, 0xf1, 0x75, 0x1d, 0xc9,
-		0x7e, 0x5d, 0xbb, 0x8e, 0xe6, 0x68, 0x26, 0xff, 0x56, 0xe8, 0xe7, 0xdf, 0xbe, 0x36, 0x60, 0x35,
-		0xef, 0xff, 0x1f, 0x92, 0x8b, 0x69, 0xbc, 0x56, 0x31, 0x37, 0x3e, 0x83, 0x0b, 0xc4, 0x6b, 0x24,
-		0x65, 0x6f, 0x4c, 0x46, 0x14, 0x76, 0x65, 0x53, 0xd8, 0x35, 0x3e, 0x5d, 0x3b, 0x60, 0xa2, 0x1e,
-		0xec, 0x57, 0x88, 0xd7, 0xa8, 0x76, 0xff, 0xee, 0xb2, 0xc2, 0x6c, 0xa7, 0x7a, 0xe0, 0x85, 0x3f,
-		0xf5, 0xe8, 0x1f, 0x61, 0xee, 0xe1, 0x26, 0x3b, 0x5a, 0xdb, 0x2f, 0xa8, 0xb1, 0x9b, 0xff, 0x05,
-		0x00, 0x00, 0xff, 0xff, 0x85, 0xa3, 0xd5, 0xe5, 0x47, 0x1a, 0x00, 0x00,
+		0xb9, 0x29, 0x95, 0x90, 0x90, 0x35, 0x19, 0xcf, 0x66, 0x47, 0xf1, 0x7a, 0x96, 0xf1, 0x38, 0xdb,
+		0x45, 0x42, 0xe2, 0x04, 0x5c, 0xf8, 0x00, 0x48, 0x9c, 0x38, 0xc1, 0x05, 0xae, 0x20, 0x4e, 0x7c,
+		0x04, 0x0e, 0x7c, 0x0f, 0x24, 0xbe, 0x00, 0xf2, 0x78, 0xec, 0xdd, 0x6c, 0xbc, 0x5e, 0x3b, 0x2d,
+		0xbd, 0xc5, 0xe3, 0xf7, 0x7e, 0xf3, 0x9b, 0x79, 0x33, 0xef, 0xf7, 0x73, 0x16, 0xca, 0xfe, 0x3e,
+		0x15, 0x55, 0x82, 0x6d, 0xea, 0x12, 0x5a, 0xc5, 0x4d, 0x56, 0x3d, 0x5a, 0xab, 0xda, 0x94, 0x30,
+		0x8f, 0x71, 0xb7, 0xd2, 0x14, 0x5c, 0x72, 0x34, 0x1d, 0xc4, 0x54, 0x74, 0x4c, 0x05, 0x37, 0x59,
+		0xe5, 0x68, 0xed, 0xe2, 0x95, 0x03, 0xce, 0x0f, 0x1c, 0x5a, 0x55, 0x21, 0xfb, 0x7e, 0xad, 0x6a,
+		0xfb, 0x02, 0xcb, 0x38, 0xe9, 0xe2, 0x62, 0x12, 0x30, 0xe1, 0x8d, 0x46, 0x1c, 0x91, 0x38, 0xb5,
+		0xc4, 0xde, 0xa1, 0xc3, 0x3c, 0x99, 0x16, 0xd3, 0xe2, 0xe2, 0xb0, 0xe6, 0xf0, 0x56, 0x18, 0x53,
+		0xfe, 0x76, 0x0a, 0xc6, 0x1e, 0x6a, 0xc6, 0xe8, 0x7b, 0x03, 0x6e, 0x78, 0xa4, 0x4e, 0x6d, 0xdf,
+		0xa1, 0x16, 0x26, 0x92, 0x1d, 0x31, 0xd9, 0xb6, 0x02, 0x54, 0x2b, 0x5a, 0x95, 0x85, 0xa5, 0x14,
+		0x6c, 0xdf, 0x97, 0xd4, 0x9b, 0x35, 0x16, 0x8d, 0x0f, 0xc6, 0xd7, 0xef, 0x55, 0x12, 0x56, 0x58,
+		0x79, 0xae, 0x61, 0x1e, 0x68, 0x94, 0x3d, 0xec, 0x1d, 0x46, 0xf3, 0x3c, 0x88, 0x21, 0x1e, 0xbf,
+		0x63, 0x2e, 0x79, 0x99, 0x22, 0xd1, 0x17, 0xb0, 0xe0, 0x49, 0x2c, 0xa4, 0x25, 0x59, 0x83, 0x8a,
+		0x44, 0x3e, 0x43, 0x8a, 0xcf, 0x5a, 0x32, 0x9f, 0x20, 0x77, 0x2f, 0x48, 0x4d, 0x64, 0x31, 0xef,
+		0xa5, 0xbc, 0x47, 0x3f, 0x19, 0x10, 0xec, 0x7e, 0xd3, 0xa1, 0x92, 0x5a, 0xd1, 0x06, 0x5a, 0xf4,
+		0x15, 0x25, 0x7e, 0x50, 0xb4, 0x44, 0x32, 0xc3, 0x8a, 0xcc, 0xc7, 0x89, 0x64, 0x36, 0x35, 0xd6,
+		0x4b, 0x0d, 0xf5, 0x28, 0x42, 0x4a, 0xe4, 0xb6, 0x4c, 0xb2, 0x87, 0xa3, 0x1f, 0x0c, 0x58, 0xae,
+		0x61, 0xe6, 0x64, 0xa5, 0x39, 0xa2, 0x68, 0x7e, 0x94, 0x48, 0x73, 0x0b, 0x33, 0x27, 0x1b, 0xc5,
+		0x6b, 0xb5, 0x6c, 0xa1, 0xe8, 0x67, 0x03, 0x56, 0x05, 0xfd, 0xdc, 0xa7, 0x9e, 0xb4, 0x08, 0x76,
+		0x09, 0x75, 0x32, 0x9c, 0xb3, 0xd1, 0x94, 0xad, 0x34, 0x43, 0xb0, 0x4d, 0x85, 0x35, 0xf0, 0xb0,
+		0x2d, 0x8b, 0xec, 0xe1, 0xe8, 0x4b, 0x58, 0xd4, 0x14, 0xfb, 0x1f, 0xb9, 0x82, 0xa2, 0xb6, 0x9e,
+		0x5c, 0x65, 0x95, 0xdc, 0xff, 0xcc, 0x5d, 0x26, 0x69, 0x01, 0xe8, 0x47, 0x03, 0x56, 0xf4, 0xfc,
+		0x19, 0x6b, 0x59, 0x54, 0x64, 0xee, 0xa7, 0x90, 0xc9, 0x56, 0xcd, 0xeb, 0x24, 0x6b, 0x30, 0xfa,
+		0xcb, 0x80, 0xfb, 0x3d, 0xf5, 0xa4, 0xaf, 0x24, 0x15, 0x2e, 0xce, 0xcc, 0x7a, 0x4c, 0xb1, 0x7e,
+		0x3a, 0xb8, 0xba, 0x8f, 0x34, 0x70, 0xb6, 0x45, 0xdc, 0x11, 0xa7, 0xcc, 0x45, 0x5f, 0x19, 0x70,
+		0x55, 0x50, 0xc2, 0x85, 0x6d, 0x35, 0xb0, 0x38, 0xec, 0x53, 0xf9, 0x92, 0xa2, 0x7d, 0xb3, 0x0f,
+		0xed, 0x20, 0xfb, 0xa9, 0x4a, 0x4e, 0x24, 0x77, 0x45, 0xa4, 0x46, 0xa0, 0xdf, 0x0d, 0xb8, 0x4d,
+		0xb8, 0x2b, 0x99, 0xeb, 0x53, 0x0b, 0x7b, 0x96, 0x4b, 0x5b, 0x59, 0xb7, 0x13, 0x14, 0xaf, 0x47,
+		0x7d, 0xfa, 0x4e, 0x08, 0xf9, 0xc0, 0xdb, 0xa1, 0xad, 0x6c, 0xdb, 0xb8, 0x4a, 0x72, 0xe6, 0xa0,
+		0x5f, 0x0d, 0x58, 0x0f, 0x3b, 0x35, 0xa9, 0x33, 0xc7, 0xce, 0xca, 0x7b, 0x5c, 0xf1, 0xde, 0xe8,
+		0xdf, 0xbc, 0x37, 0x03, 0xb4, 0x6c, 0xa4, 0x57, 0xbc, 0x3c, 0x09, 0xe8, 0x0f, 0x03, 0x6e, 0x7b,
+		0xec, 0xc0, 0xc5, 0xf9, 0x0f, 0xef, 0x84, 0x62, 0xbd, 0x95, 0xcc, 0x5a, 0x41, 0xe6, 0x3b, 0xb5,
+		0x6b, 0x5e, 0xde, 0x24, 0xf4, 0x9b, 0x01, 0x1f, 0xfa, 0x4d, 0x8f, 0x0a, 0xd9, 0x21, 0xed, 0x51,
+		0x2c, 0x48, 0xbd, 0x8b, 0x68, 0x22, 0xf9, 0xc9, 0x94, 0xa3, 0xf2, 0x42, 0x21, 0x46, 0xf3, 0x3f,
+		0x57, 0x78, 0x9d, 0x49, 0x93, 0x8f, 0x8a, 0x9f, 0x33, 0x67, 0x63, 0x02, 0xa0, 0x43, 0xa7, 0xfc,
+		0x5d, 0x01, 0x96, 0xb2, 0xd9, 0x06, 0xb4, 0x00, 0xe3, 0xb1, 0x6c, 0x30, 0x5b, 0x19, 0x91, 0x92,
+		0x09, 0xd1, 0xd0, 0xb6, 0x8d, 0xb6, 0x60, 0x32, 0x0e, 0x90, 0xed, 0x26, 0xd5, 0xde, 0xe0, 0x6a,
+		0xe2, 0x5a, 0xe3, 0xc9, 0xda, 0x4d, 0x6a, 0x4e, 0xe0, 0xae, 0x27, 0x34, 0x03, 0x05, 0x9b, 0x37,
+		0x30, 0x73, 0x95, 0x9e, 0x97, 0x4c, 0xfd, 0x84, 0xee, 0x42, 0x49, 0xc9, 0x55, 0xe0, 0xb6, 0xb4,
+		0x86, 0x5e, 0x4e, 0xc4, 0x0e, 0x16, 0xf0, 0x84, 0x79, 0xd2, 0x1c, 0x93, 0xfa, 0x2f, 0xb4, 0x0e,
+		0xa3, 0xcc, 0x6d, 0xfa, 0x52, 0xeb, 0xda, 0x7c, 0x62, 0xde, 0x2e, 0x6e, 0x3b, 0x1c, 0xdb, 0x66,
+		0x18, 0x8a, 0xf6, 0x60, 0x2e, 0x36, 0x66, 0x92, 0x5b, 0xc4, 0xe1, 0x1e, 0x55, 0xb2, 0xc4, 0x7d,
+		0xa9, 0x45, 0x68, 0xae, 0x12, 0x9a, 0xca, 0x4a, 0x64, 0x2a, 0x2b, 0x0f, 0xb5, 0xa9, 0x34, 0x67,
+		0xa2, 0xdc, 0x3d, 0xbe, 0x19, 0x64, 0xee, 0x85, 0x89, 0xbd, 0xa8, 0x1d, 0x7f, 0x15, 0xa0, 0x16,
+		0x73, 0xa0, 0xc6, 0xee, 0x2a, 0x40, 0xdd, 0x81, 0x19, 0x8d, 0xd4, 0x4b, 0x74, 0x6c, 0x10, 0xe4,
+		0x74, 0x68, 0xc3, 0x8e, 0xb3, 0xdc, 0x82, 0xa9, 0x3a, 0xc5, 0x42, 0xee, 0x53, 0xdc, 0x61, 0x57,
+		0x1a, 0x04, 0x75, 0x36, 0xce, 0x89, 0x70, 0x36, 0x61, 0x42, 0x50, 0x29, 0xda, 0x56, 0x93, 0x3b,
+		0x8c, 0xb4, 0x75, 0xc7, 0x59, 0xec, 0xd3, 0xc1, 0xa5, 0x68, 0xef, 0xaa, 0x38, 0x73, 0x5c, 0x74,
+		0x1e, 0xd0, 0x4d, 0x28, 0xd4, 0x29, 0xb6, 0xa9, 0xd0, 0x57, 0xff, 0x52, 0x62, 0xfa, 0x63, 0x15,
+		0x62, 0xea, 0x50, 0x74, 0x0b, 0x66, 0x22, 0x91, 0x74, 0x38, 0xc1, 0x8e, 0x65, 0x33, 0xaf, 0x89,
+		0x25, 0xa9, 0xab, 0x2b, 0x38, 0x66, 0x9e, 0xd3, 0x6f, 0x9f, 0x04, 0x2f, 0x1f, 0xea, 0x77, 0xe5,
+		0x6f, 0x0c, 0x98, 0x4f, 0xb3, 0xad, 0x68, 0x0e, 0xc6, 0x42, 0x67, 0x12, 0x5f, 0x81, 0xa2, 0x7a,
+		0xde, 0xb6, 0xd1, 0x13, 0x38, 0x1f, 0xd7, 0xa0, 0xc6, 0x44, 0xa7, 0x04, 0x43, 0x83, 0xf6, 0x0d,
+		0xe9, 0x12, 0x6c, 0x31, 0x11, 0x55, 0xa0, 0x4c, 0x60, 0x39, 0x87, 0x65, 0x45, 0xb7, 0xa0, 0x20,
+		0xa8, 0xe7, 0x3b, 0x72, 0xd6, 0xc8, 0x70, 0xc2, 0x75, 0x6c, 0x19, 0xc3, 0xb5, 0x8c, 0x86, 0x13,
+		0xdd, 0x86, 0x62, 0x60, 0x38, 0x7d, 0x41, 0x53, 0x67, 0xd8, 0x0a, 0x63, 0xcc, 0x28, 0xb8, 0xbc,
+		0x03, 0xcb, 0x39, 0xfc, 0xe2, 0xc0, 0x2e, 0x53, 0xbe, 0x0b, 0x97, 0x53, 0x4d, 0x5e, 0x4a, 0x85,
+		0xca, 0x04, 0xae, 0x67, 0xf6, 0x64, 0xc1, 0x82, 0x6d, 0x2a, 0x31, 0x73, 0xbc, 0x4c, 0x5b, 0x1a,
+		0x05, 0x97, 0xff, 0x35, 0xe0, 0xce, 0x69, 0x3d, 0x54, 0x57, 0xef, 0x33, 0x8e, 0xf5, 0xbe, 0x17,
+		0x80, 0x4e, 0xaa, 0xa3, 0x3e, 0x58, 0x4b, 0x89, 0xbc, 0x4e, 0xcc, 0x66, 0x4e, 0xb5, 0x7a, 0x87,
+		0xd0, 0x2c, 0x14, 0x09, 0x77, 0xa5, 0xe0, 0x8e, 0xea, 0xb5, 0x13, 0x66, 0xf4, 0x88, 0x2a, 0x30,
+		0xdd, 0x63, 0x25, 0xb8, 0xeb, 0xb4, 0x55, 0xdb, 0x1d, 0x33, 0xa7, 0x48, 0xb7, 0xcc, 0x3f, 0x73,
+		0x9d, 0x76, 0xf9, 0x17, 0x03, 0xae, 0xa4, 0x5b, 0xb0, 0xa0, 0xb4, 0xda, 0xdb, 0xb9, 0xb8, 0x41,
+		0xa3, 0xd2, 0x86, 0x43, 0x3b, 0xb8, 0x41, 0xbb, 0x77, 0x7c, 0x28, 0xc7, 0x8e, 0x77, 0xf5, 0x87,
+		0xe1, 0xcc, 0xfd, 0xa1, 0xfc, 0x4f, 0x11, 0x56, 0xf3, 0x7a, 0xb3, 0x40, 0xe2, 0xe2, 0xfd, 0x50,
+		0x12, 0x67, 0xa4, 0x48, 0x5c, 0x04, 0x18, 0x4a, 0x5c, 0xab, 0xeb, 0xe9, 0xb8, 0x94, 0x0d, 0x9d,
+		0x52, 0xca, 0x86, 0xb3, 0x4b, 0x19, 0x86, 0xc5, 0x8e, 0xa7, 0xea, 0x23, 0x14, 0x23, 0x83, 0xba,
+		0xd4, 0x7c, 0x0c, 0xf1, 0x3c, 0x41, 0x31, 0x5e, 0xc2, 0x25, 0xb5, 0xa4, 0x3e, 0xe8, 0xa3, 0x83,
+		0xd0, 0x2f, 0x04, 0xd9, 0x49, 0xc0, 0xcf, 0x60, 0x66, 0x1f, 0x93, 0x43, 0x5e, 0xab, 0x69, 0x6c,
+		0xe6, 0x4a, 0x2a, 0x8e, 0xb0, 0x33, 0x58, 0x83, 0xcf, 0xe9, 0x44, 0x05, 0xbb, 0xad, 0xd3, 0x4e,
+		0x68, 0x52, 0xf1, 0x34, 0x9a, 0xb4, 0x0d, 0x25, 0xe6, 0x32, 0xc9, 0xb0, 0xe4, 0x42, 0x69, 0xec,
+		0x99, 0xf5, 0xe5, 0xc1, 0xfe, 0x7f, 0x3b, 0x4a, 0x31, 0x3b, 0xd9, 0xdd, 0x9d, 0xb5, 0x94, 0xa3,
+		0xb3, 0x22, 0x13, 0x66, 0x1c, 0x1c, 0x7c, 0x03, 0x86, 0x32, 0x11, 0x94, 0x56, 0x4b, 0x00, 0x64,
+		0x38, 0x19, 0xe7, 0x82, 0xdc, 0xcd, 0x38, 0xd5, 0x54, 0x99, 0xe8, 0x3d, 0x98, 0x24, 0x22, 0x38,
+		0x23, 0xda, 0x66, 0x28, 0xc1, 0x2e, 0x99, 0x13, 0xc1, 0x60, 0xe4, 0x13, 0x4f, 0xa7, 0xc7, 0x2b,
+		0x30, 0xd2, 0xa0, 0x0d, 0xae, 0x0d, 0xf0, 0x5c, 0x62, 0xca, 0x53, 0xda, 0xe0, 0xa6, 0x0a, 0x43,
+		0x26, 0x4c, 0x9d, 0x30, 0xd4, 0xb3, 0x67, 0x54, 0xee, 0xfb, 0xc9, 0xce, 0xbf, 0xc7, 0xfa, 0x9a,
+		0x67, 0xbd, 0x9e, 0x91, 0xf2, 0xdf, 0x45, 0x58, 0xc9, 0xf5, 0x59, 0xd3, 0xb7, 0x1d, 0x2f, 0xc0,
+		0x78, 0xdc, 0x07, 0x98, 0xad, 0x6e, 0x70, 0xc9, 0x84, 0x68, 0x28, 0xf4, 0xc2, 0xc7, 0x1b, 0xc5,
+		0xf0, 0x1b, 0x68, 0x14, 0x6f, 0xc1, 0xf3, 0x66, 0x69, 0x14, 0x85, 0xff, 0xb5, 0x51, 0x14, 0x4f,
+		0xdd, 0x28, 0x3e, 0x81, 0xe9, 0x26, 0x16, 0xd4, 0x95, 0x1a, 0x51, 0x5f, 0xef, 0xf0, 0x72, 0x2e,
+		0xf5, 0x59, 0x7d, 0x10, 0xaf, 0x50, 0xf4, 0x25, 0x9f, 0x6a, 0xf6, 0x0e, 0x75, 0x8b, 0x64, 0xe9,
+		0xb8, 0x48, 0x12, 0x98, 0xed, 0x3a, 0x06, 0x96, 0xa0, 0x7e, 0x67, 0x5a, 0x50, 0xd3, 0xde, 0x48,
+		0x2d, 0xf8, 0xb6, 0x6d, 0x52, 0x3f, 0x9a, 0xc7, 0x3c, 0xdf, 0x4a, 0x1a, 0x7e, 0x33, 0x16, 0xfa,
+		0xc4, 0xbd, 0x9e, 0x48, 0xbd, 0xd7, 0x93, 0xf9, 0xef, 0xf5, 0x99, 0xd7, 0xb8, 0xd7, 0xef, 0xbe,
+		0xde, 0xbd, 0xfe, 0x73, 0x08, 0xd6, 0x72, 0x7f, 0xf8, 0xbf, 0x6d, 0xab, 0xb5, 0x00, 0xe3, 0xfa,
+		0xff, 0x1d, 0xca, 0xfd, 0x84, 0x9f, 0xb6, 0x10, 0x0e, 0x29, 0xf7, 0x13, 0x5f, 0xd7, 0x91, 0xec,
+		0xd7, 0xb5, 0xeb, 0x68, 0x8e, 0x66, 0xf2, 0x6f, 0x85, 0x7e, 0xfe, 0xed, 0x6b, 0x03, 0x56, 0xf3,
+		0xfe, 0xff, 0x21, 0xb9, 0x98, 0xc6, 0x6b, 0x15, 0x73, 0xe3, 0x33, 0xb8, 0x40, 0x78, 0x23, 0x29,
+		0x7b, 0x63, 0x32, 0xa2, 0xb0, 0x2b, 0xb8, 0xe4, 0xbb, 0xc6, 0xa7, 0x6b, 0x07, 0x4c, 0xd6, 0xfd,
+		0xfd, 0x0a, 0xe1, 0x8d, 0x6a, 0xf7, 0xef, 0x2e, 0x2b, 0xcc, 0x76, 0xaa, 0x07, 0x3c, 0xfc, 0xa9,
+		0x47, 0xff, 0x08, 0x73, 0x0f, 0x37, 0xd9, 0xd1, 0xda, 0x7e, 0x41, 0x8d, 0xdd, 0xfc, 0x6f, 0x00,
+		0x85, 0xa3, 0xd5, 0xe5, 0x47, 0x1a, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x5f, 0xe2, 0xd8, 0xea, 0x4a,
-		0xfe, 0x12, 0x6b, 0xe2, 0xa4, 0x80, 0x2a, 0x2d, 0xe3, 0x99, 0xeb, 0x78, 0xe4, 0xdd, 0x9d, 0xed,
-		0xcc, 0xac, 0x37, 0x46, 0xe2, 0x89, 0x07, 0x24, 0xd4, 0x0a, 0xaa, 0x0a, 0x89, 0x0a, 0x10, 0x08,
-		0x09, 0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x90, 0x40, 0x85, 0x27, 0x5e,
-		0x78, 0x80, 0x07, 0x1e, 0xe8, 0x1b, 0x0f, 0x94, 0x37, 0x24, 0x34, 0x77, 0xee, 0xec, 0xce, 0x9f,
-		0x7b, 0x67, 0xee, 0xac, 0x37, 0x2d, 0xa8, 0x79, 0xf3, 0xdc, 0x39, 0xf7, 0xcc, 0xef, 0x9e, 0x7b,
-		0xce, 0xb9, 0xe7, 0x9e, 0x73, 0xd6, 0x70, 0xaa, 0xbd, 0x81, 0xad, 0x05, 0x4d, 0xd5, 0x71, 0x53,
-		0xc3, 0x0b, 0x6a, 0xcb, 0x58, 0xd8, 0xb9, 0xb4, 0xb0, 0x65, 0xd8, 0x8e, 0x69, 0xed, 0x56, 0x5a,
-		0x96, 0xe9, 0x98, 0xe8, 0x80, 0x4b, 0x52, 0xa1, 0x24, 0x15, 0xb5, 0x65, 0x54, 0x76, 0x2e, 0x1d,
-		0x39, 0xf1, 0xd0, 0x34, 0x1f, 0xd6, 0xf1, 0x02, 0x21, 0xd9, 0x68, 0x6f, 0x2e, 0xe8, 0x6d, 0x4b,
-		0x75, 0x0c, 0xb3, 0xe9, 0x4d, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x31, 0x1a, 0xd8, 0x76, 0xd4, 0x46,
-		0x8b, 0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x99, 0x8d, 0x46, 0x97, 0x85, 0xcc, 0xa2, 0x70, 0x54, 0x7b,
-		0xbb, 0x6e, 0xd8, 0x4e, 0x12, 0x4d, 0xc7, 0xb4, 0xb6, 0x37, 0xeb, 0x66, 0xc7, 0xa3, 0x91, 0x6f,
-		0x43, 0xf1, 0x25, 0x6f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x6e, 0x3a, 0xf6, 0x94, 0x34, 0x93,
-		0x9f, 0x2d, 0x2f, 0x9e, 0xaa, 0x30, 0xd6, 0x56, 0xa1, 0xd4, 0xcb, 0x2e, 0xa5, 0x42, 0x27, 0xc8,
-		0xef, 0x5f, 0x83, 0xd1, 0xe0, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19,
-		0x69, 0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xbc, 0x57, 0xee, 0xa2, 0xa7, 0x72,
-		0x33, 0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x93, 0x48, 0xc5, 0x97, 0x48, 0x65, 0xdd, 0x97, 0x88,
-		0x52, 0x22, 0xd4, 0xee, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x6c, 0xc3, 0x6c, 0x4e, 0xe5, 0x3d,
-		0xa6, 0xf4, 0x11, 0x1d, 0x86, 0xa2, 0xbb, 0x78, 0xf7, 0x73, 0x43, 0xe4, 0x4d, 0xc1, 0x7d, 0xac,
-		0xea, 0xe8, 0x6b, 0x12, 0x9c, 0xf7, 0x97, 0x5c, 0xc3, 0x8f, 0xb0, 0xd6, 0x76, 0xf7, 0xa1, 0x66,
-		0x3b, 0xaa, 0xe5, 0x60, 0xbd, 0xe6, 0x21, 0x51, 0x1d, 0xc7, 0x32, 0x36, 0xda, 0x0e, 0xb6, 0xa7,
-		0x86, 0x09, 0x9e, 0xff, 0x65, 0x2e, 0xfd, 0x65, 0xca, 0x67, 0xd9, 0x67, 0x73, 0xcf, 0xe3, 0x42,
-		0x96, 0x7c, 0xb3, 0xcb, 0xe3, 0xa5, 0xa7, 0x94, 0x73, 0x1d, 0x31, 0x52, 0xf4, 0x2d, 0x09, 0x2e,
-		0x30, 0xe0, 0x69, 0x66, 0xa3, 0x55, 0xc7, 0x4c, 0x80, 0x05, 0x02, 0xf0, 0x05, 0x31, 0x80, 0x4b,
-		0x3e, 0x9f, 0x38, 0xc4, 0x67, 0x3a, 0xa2, 0xc4, 0xe8, 0x2d, 0x09, 0xe6, 0x18, 0x20, 0x37, 0x55,
-		0xa3, 0xce, 0x42, 0x58, 0x24, 0x08, 0x6f, 0x88, 0x21, 0x5c, 0x21, 0x4c, 0xe2, 0xf0, 0xce, 0x76,
-		0x84, 0x28, 0xd1, 0x37, 0xd9, 0x02, 0x74, 0x75, 0x4b, 0xaf, 0x99, 0x6d, 0x27, 0x0e, 0x6f, 0x84,
-		0xc0, 0x7b, 0x5e, 0x0c, 0x9e, 0xab, 0x76, 0xfa, 0xdd, 0xb6, 0x13, 0x07, 0x38, 0xdb, 0x11, 0xa4,
-		0x45, 0x6f, 0x4a, 0x30, 0xab, 0x63, 0xcd, 0xb0, 0x09, 0x30, 0x57, 0x4b, 0x6d, 0x6d, 0x0b, 0xeb,
-		0x6d, 0xa6, 0xf0, 0x4a, 0x04, 0xdd, 0x75, 0x26, 0xba, 0xdb, 0x94, 0xc9, 0xba, 0x6a, 0x6f, 0xdf,
-		0xf3, 0x59, 0xc4, 0x91, 0x9d, 0xd1, 0x05, 0xe8, 0xd0, 0xeb, 0x12, 0x9c, 0x8d, 0xa0, 0xe2, 0xd9,
-		0x04, 0x10, 0x4c, 0xd7, 0xd2, 0x31, 0xf1, 0xcc, 0x41, 0xd6, 0x53, 0xa9, 0x18, 0x52, 0x4a, 0x30,
-		0x82, 0xb2, 0xa0, 0x94, 0x12, 0xf4, 0x3f, 0x24, 0x25, 0xae, 0xea, 0xbf, 0x11, 0x43, 0x95, 0xa0,
-		0x59, 0xa3, 0x04, 0xd5, 0xff, 0xa4, 0xa2, 0xe2, 0x2b, 0xd5, 0x69, 0x3d, 0x9d, 0x0c, 0x7d, 0x41,
-		0x82, 0xa7, 0xc3, 0x98, 0x78, 0x96, 0xb8, 0x8f, 0x00, 0xba, 0x9a, 0x0a, 0x88, 0x67, 0x84, 0xa7,
-		0xf4, 0x34, 0x22, 0xb2, 0x6d, 0xaa, 0xe6, 0x18, 0x3b, 0x86, 0xb3, 0x9b, 0xaa, 0xdc, 0x63, 0x09,
-		0xdb, 0x76, 0x93, 0x32, 0x49, 0x53, 0x6e, 0x55, 0x80, 0x8e, 0x28, 0x77, 0x04, 0x15, 0x4f, 0xb9,
-		0xf7, 0x27, 0x28, 0x77, 0x08, 0x13, 0x57, 0xb9, 0xd5, 0x54, 0x2a, 0x86, 0x94, 0x12, 0x94, 0x7b,
-		0x5c, 0x50, 0x4a, 0x49, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0x45, 0x0a, 0xa3, 0xe2, 0x29, 0xd2, 0x44,
-		0x82, 0x22, 0x05, 0x21, 0x71, 0x15, 0x49, 0x4d, 0x23, 0x22, 0x96, 0x16, 0x06, 0x93, 0x60, 0x69,
-		0x28, 0xc1, 0xd2, 0x82, 0x78, 0x12, 0x2c, 0x4d, 0x4d, 0x27, 0x43, 0x1d, 0x38, 0xe1, 0x82, 0xb0,
-		0xf8, 0xda, 0x73, 0x80, 0x00, 0xb9, 0xc8, 0x04, 0xe2, 0x72, 0xb5, 0xb8, 0x6a, 0x73, 0xd4, 0xe1,
-		0xbf, 0x46, 0xaf, 0xc2, 0x31, 0xef, 0xc3, 0x9b, 0x86, 0xc5, 0xfa, 0xec, 0x41, 0xf2, 0xd9, 0x0a,
-		0xff, 0xb3, 0x2b, 0xee, 0xbc, 0xf8, 0x47, 0xa7, 0x1d, 0xde, 0x4b, 0xf4, 0x1d, 0x09, 0x16, 0x22,
-		0x2a, 0xaa, 0x36, 0x35, 0x5c, 0xaf, 0x59, 0xf8, 0xd5, 0x36, 0xb6, 0x99, 0xab, 0x3f, 0x44, 0x60,
-		0xbc, 0x98, 0xae, 0xa9, 0x84, 0x93, 0xe2, 0x33, 0x8a, 0xe3, 0x9a, 0x53, 0x85, 0xa9, 0xd1, 0x0f,
-		0x25, 0xb8, 0x42, 0x31, 0xf9, 0x10, 0xc5, 0x94, 0x78, 0x92, 0xa0, 0x5d, 0x62, 0xa2, 0xa5, 0x5f,
-		0xf3, 0x3e, 0x2d, 0xa2, 0xd1, 0x15, 0x2b, 0xd3, 0x0c, 0xf4, 0x25, 0x09, 0xce, 0xb1, 0xc4, 0xcb,
-		0x02, 0x7a, 0x58, 0x50, 0xbb, 0x97, 0x28, 0x87, 0x14, 0xed, 0xe6, 0x90, 0xa1, 0xcf, 0xc0, 0x49,
-		0x4f, 0xc9, 0xf8, 0x48, 0xa6, 0x08, 0x92, 0x4b, 0x7c, 0x3d, 0xe3, 0x43, 0xf0, 0x14, 0x98, 0xf7,
-		0xed, 0xcf, 0x4b, 0x70, 0x86, 0x6e, 0x1e, 0x55, 0x74, 0xce, 0xa6, 0x4d, 0x13, 0x04, 0xcf, 0x32,
-		0x11, 0x78, 0xcc, 0x3d, 0x7d, 0xe7, 0x6c, 0xd3, 0x8c, 0x96, 0x42, 0x83, 0x3e, 0x0b, 0x33, 0x0d,
-		0xd5, 0xda, 0xc6, 0x56, 0xcd, 0xc2, 0x9a, 0x69, 0xe9, 0x2c, 0x10, 0x47, 0x08, 0x88, 0x45, 0x26,
-		0x88, 0xff, 0x27, 0x93, 0x15, 0x3a, 0x37, 0x8e, 0xe0, 0x78, 0x23, 0x89, 0x00, 0x7d, 0x43, 0x82,
-		0x79, 0xd6, 0xfd, 0xc4, 0x78, 0xd8, 0x54, 0x99, 0x02, 0x39, 0x9a, 0x25, 0x7c, 0xbd, 0x47, 0xd9,
-		0x88, 0x84, 0xaf, 0x1c, 0x5a, 0xf4, 0x6d, 0x09, 0x2a, 0xac, 0x08, 0x1b, 0x5b, 0x0d, 0xa3, 0xa9,
-		0x32, 0xfd, 0xc2, 0xb1, 0x04, 0xbf, 0x10, 0x0f, 0xb1, 0xbb, 0x8c, 0x18, 0x7e, 0xa1, 0x23, 0x4c,
-		0x8d, 0x7e, 0x24, 0xc1, 0x15, 0xd6, 0x55, 0x2a, 0xd5, 0x8b, 0x1d, 0x27, 0x68, 0x6f, 0x0b, 0xde,
-		0xa8, 0xd2, 0x5c, 0xd9, 0x42, 0x27, 0xdb, 0x14, 0x9e, 0x06, 0xf0, 0x8d, 0xf2, 0x44, 0x16, 0x0d,
-		0xe0, 0x1b, 0xe8, 0x6c, 0x47, 0x90, 0x16, 0xfd, 0x55, 0x82, 0xe5, 0x88, 0xc7, 0xc5, 0x8f, 0x1c,
-		0x6c, 0x35, 0xd5, 0x7a, 0x8d, 0x81, 0xdc, 0x68, 0x1a, 0x8e, 0xc1, 0x56, 0x8c, 0x93, 0x04, 0xfa,
-		0xbd, 0x74, 0x17, 0xbc, 0x4c, 0xf9, 0xc7, 0xd6, 0x53, 0xf5, 0x99, 0xc7, 0x17, 0xf4, 0x82, 0xb5,
-		0x27, 0x0e, 0xe8, 0x4f, 0x12, 0xdc, 0xca, 0xb0, 0x4c, 0x9e, 0xc7, 0x9a, 0x21, 0x6b, 0x5c, 0xdb,
-		0xc3, 0x1a, 0x79, 0xce, 0xec, 0x86, 0xd5, 0xff, 0x74, 0xf4, 0x9e, 0x04, 0xcf, 0x27, 0x2d, 0x27,
-		0xdd, 0x4e, 0x4e, 0x91, 0x85, 0xad, 0x32, 0x17, 0xc6, 0x05, 0x93, 0x6a, 0x2f, 0xd7, 0x70, 0x7f,
-		0x53, 0x49, 0x1c, 0xc0, 0x4c, 0x9d, 0x34, 0x1d, 0xa3, 0xd9, 0xc6, 0x7a, 0x4d, 0xb5, 0x6b, 0x4d,
-		0xdc, 0x89, 0xaf, 0x43, 0x4e, 0x88, 0x03, 0x18, 0x19, 0x14, 0xca, 0xee, 0xa6, 0x7d, 0x07, 0x77,
-		0x18, 0x71, 0x40, 0x27, 0xd3, 0x0c, 0xf4, 0x4b, 0x09, 0xae, 0x93, 0x68, 0xb2, 0xa6, 0x6d, 0x19,
-		0x75, 0x3d, 0xa3, 0xfd, 0x9c, 0x26, 0xd0, 0x5f, 0x62, 0x42, 0x27, 0xa1, 0xe4, 0x92, 0xcb, 0x34,
-		0x8b, 0xd1, 0x5c, 0xb6, 0xb3, 0x4f, 0x43, 0x3f, 0x95, 0xe0, 0x6a, 0xca, 0x22, 0x78, 0xd6, 0x71,
-		0x86, 0xac, 0x60, 0x39, 0xeb, 0x0a, 0x78, 0x26, 0x71, 0xd1, 0xce, 0x38, 0x07, 0x7d, 0x4f, 0x82,
-		0x4b, 0x5c, 0xd4, 0xdc, 0x38, 0xff, 0x69, 0x02, 0xfb, 0x26, 0x3b, 0x0c, 0x61, 0x7e, 0x9d, 0x1b,
-		0xf8, 0xcf, 0x6b, 0x19, 0xe8, 0xd1, 0x0f, 0x24, 0xb8, 0xcc, 0x85, 0x9b, 0x70, 0x89, 0x3c, 0x9b,
-		0xa0, 0xe4, 0x6c, 0xc0, 0x09, 0xd7, 0xc9, 0x8a, 0x96, 0x69, 0x06, 0x7a, 0x47, 0x82, 0x8b, 0x99,
-		0x35, 0xe3, 0x1c, 0x41, 0xfc, 0x7f, 0x19, 0x10, 0xf3, 0x94, 0xe2, 0xbc, 0x96, 0x41, 0x1f, 0xde,
-		0x95, 0x60, 0x91, 0x2f, 0x60, 0xee, 0x21, 0x3c, 0x4b, 0xd0, 0xde, 0xca, 0x22, 0x5f, 0xee, 0x49,
-		0x7c, 0x41, 0xcb, 0x32, 0x01, 0x7d, 0x3f, 0x49, 0x25, 0x12, 0x2e, 0xcd, 0xcf, 0x64, 0x86, 0xcc,
-		0xbf, 0x3e, 0x73, 0x20, 0xf3, 0x2e, 0xd2, 0x6e, 0x6c, 0xc6, 0x87, 0x9c, 0x10, 0x49, 0xce, 0x25,
-		0xc4, 0x66, 0x1c, 0xcc, 0x09, 0xe1, 0xe4, 0x82, 0x96, 0x6d, 0x0a, 0x39, 0x34, 0xbd, 0x50, 0xbc,
-		0xdf, 0x88, 0xe7, 0x7c, 0xc2, 0xa1, 0xe9, 0x45, 0xdc, 0xfd, 0x84, 0x3a, 0xd7, 0xec, 0xfe, 0xa6,
-		0xa2, 0x5f, 0x49, 0xf0, 0x9c, 0xc0, 0x82, 0x78, 0x36, 0x3a, 0x4f, 0x56, 0x53, 0xed, 0x67, 0x35,
-		0x3c, 0x63, 0xbd, 0x62, 0xf7, 0x31, 0x0f, 0xfd, 0x44, 0x82, 0x67, 0x93, 0x16, 0xc0, 0xbf, 0x3f,
-		0x5d, 0x48, 0x38, 0x80, 0xb8, 0x20, 0xf8, 0xf7, 0xa8, 0x8b, 0x38, 0xe3, 0x1c, 0xe2, 0x70, 0xda,
-		0x2d, 0x1b, 0x5b, 0x4e, 0x0f, 0xb8, 0x8d, 0x55, 0x4b, 0xdb, 0x0a, 0xc0, 0x8c, 0xe3, 0xae, 0x24,
-		0x58, 0xef, 0x7d, 0xc2, 0xce, 0x47, 0x70, 0x8f, 0x30, 0xeb, 0x7d, 0x91, 0x61, 0xbd, 0xed, 0x2c,
-		0x13, 0x6e, 0x8d, 0x02, 0xf4, 0x80, 0xc8, 0x7f, 0x29, 0xc3, 0x39, 0xd1, 0xd3, 0x6b, 0x05, 0xf6,
-		0x75, 0xd7, 0xe8, 0xec, 0xb6, 0x30, 0xa9, 0x05, 0xf2, 0x2a, 0x8b, 0x3e, 0xd3, 0xf5, 0xdd, 0x16,
-		0x56, 0x46, 0x3b, 0x81, 0x27, 0xf4, 0x0a, 0x1c, 0x6a, 0xa9, 0x96, 0x2b, 0x91, 0xa0, 0xd1, 0x6d,
-		0x9a, 0xb4, 0x7c, 0x38, 0xcb, 0xe4, 0xb7, 0x46, 0x66, 0x04, 0x6c, 0x62, 0xd3, 0x54, 0x0e, 0xb4,
-		0xe2, 0x83, 0xe8, 0x39, 0x28, 0x91, 0x8c, 0x4c, 0xdd, 0xb0, 0x1d, 0x52, 0x58, 0x2c, 0x2f, 0x1e,
-		0x67, 0xa7, 0x3c, 0x54, 0x7b, 0x7b, 0xd5, 0xb0, 0x1d, 0x65, 0xc4, 0xa1, 0x7f, 0xa1, 0x45, 0x18,
-		0x36, 0x9a, 0xad, 0xb6, 0x43, 0xca, 0x8e, 0xe5, 0xc5, 0x63, 0x1c, 0x24, 0xbb, 0x75, 0x53, 0xd5,
-		0x15, 0x8f, 0x14, 0xa9, 0x30, 0x13, 0x09, 0x39, 0x6a, 0x8e, 0x59, 0xd3, 0xea, 0xa6, 0x8d, 0x89,
-		0xff, 0x36, 0xdb, 0x0e, 0xad, 0x43, 0x4e, 0xc7, 0xea, 0xa2, 0xb7, 0x69, 0x25, 0x59, 0x39, 0x86,
-		0x43, 0xb2, 0x5f, 0x37, 0x97, 0xdc, 0xf9, 0xeb, 0xde, 0x74, 0xf4, 0x32, 0x1c, 0xed, 0xa5, 0xbd,
-		0xe3, 0xdc, 0x0b, 0x69, 0xdc, 0x0f, 0x3b, 0x7e, 0x32, 0x3b, 0xc2, 0xf8, 0x06, 0x1c, 0xe9, 0x45,
-		0xd8, 0xbd, 0x55, 0x58, 0xed, 0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xee, 0x52, 0x74,
-		0xe5, 0xac, 0xb4, 0x9b, 0x55, 0x1d, 0x55, 0xa1, 0x44, 0x5d, 0xa5, 0x69, 0x91, 0x3a, 0xdc, 0xd8,
-		0xe2, 0x79, 0xb6, 0x6b, 0xa7, 0x0c, 0x48, 0x08, 0x5d, 0xf5, 0xa7, 0x28, 0xbd, 0xd9, 0xa8, 0x0a,
-		0x13, 0x3d, 0x1c, 0xae, 0xbb, 0x6a, 0x5b, 0x98, 0x16, 0xcf, 0xd8, 0x7b, 0xb0, 0xe2, 0xd1, 0x28,
-		0xe3, 0xdd, 0x69, 0x74, 0x04, 0x29, 0x30, 0x59, 0x57, 0xdd, 0x3b, 0x9f, 0x17, 0xce, 0x90, 0xe5,
-		0x60, 0xbb, 0x5d, 0x77, 0x68, 0xe1, 0x2b, 0x79, 0x4f, 0x0f, 0xba, 0x73, 0x97, 0xba, 0x53, 0x15,
-		0x32, 0x13, 0x5d, 0x87, 0x69, 0xd3, 0x32, 0x1e, 0x1a, 0x9e, 0xa3, 0x8d, 0x48, 0xa9, 0x4c, 0xa4,
-		0x34, 0xe9, 0x13, 0x44, 0x84, 0x74, 0x04, 0x46, 0x0c, 0x1d, 0x37, 0x1d, 0xc3, 0xd9, 0x25, 0x15,
-		0xa5, 0x92, 0xd2, 0x7d, 0x46, 0x97, 0x61, 0x72, 0xd3, 0xb0, 0x6c, 0x27, 0xce, 0x73, 0x1f, 0xa1,
-		0x3c, 0x40, 0xde, 0x46, 0x18, 0x2e, 0xc1, 0xa8, 0x85, 0x1d, 0x6b, 0xb7, 0xd6, 0x32, 0xeb, 0x86,
-		0xb6, 0x4b, 0xab, 0x30, 0x33, 0x9c, 0x0b, 0xaa, 0x63, 0xed, 0xae, 0x11, 0x3a, 0xa5, 0x6c, 0xf5,
-		0x1e, 0xd0, 0x14, 0x14, 0x55, 0xc7, 0xc1, 0x8d, 0x96, 0x43, 0x2a, 0x26, 0xc3, 0x8a, 0xff, 0x88,
-		0x96, 0x60, 0x3f, 0x7e, 0xd4, 0x32, 0x3c, 0xc5, 0xf1, 0x8a, 0xfa, 0xe3, 0xa9, 0x45, 0xfd, 0xb1,
-		0xde, 0x14, 0x52, 0xd9, 0x3f, 0x0d, 0xfb, 0x34, 0xcb, 0xb5, 0x06, 0x5a, 0xd1, 0x21, 0x15, 0x87,
-		0x92, 0x32, 0xea, 0x0e, 0xfa, 0x55, 0x1e, 0xf4, 0x09, 0x38, 0xea, 0xad, 0x3e, 0x5c, 0xfd, 0xda,
-		0x50, 0xb5, 0x6d, 0x73, 0x73, 0x93, 0x16, 0x05, 0x12, 0x94, 0x7a, 0x8a, 0xcc, 0x0e, 0x16, 0xbe,
-		0x6e, 0x79, 0x53, 0xd1, 0x05, 0x18, 0x6a, 0xe0, 0x86, 0x49, 0xd3, 0xf9, 0xd3, 0xec, 0x44, 0x1f,
-		0x6e, 0x98, 0x0a, 0x21, 0x43, 0x0a, 0x4c, 0xc4, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b,
-		0x23, 0x1e, 0x56, 0x19, 0xb7, 0x23, 0x23, 0xe8, 0x3e, 0x4c, 0xb6, 0x2c, 0xbc, 0x53, 0x53, 0xdb,
-		0x8e, 0xe9, 0xea, 0x1f, 0x76, 0x6a, 0x2d, 0xd3, 0x68, 0x3a, 0x7e, 0x96, 0x9d, 0xb7, 0x5f, 0x36,
-		0x76, 0xd6, 0x08, 0x9d, 0x72, 0xc0, 0x9d, 0x7f, 0xb3, 0xed, 0x98, 0x81, 0x41, 0x74, 0x19, 0x0a,
-		0x5b, 0x58, 0xd5, 0xb1, 0x45, 0xd3, 0xdf, 0x47, 0xd9, 0x4d, 0x1d, 0x84, 0x44, 0xa1, 0xa4, 0xf2,
-		0x3b, 0x12, 0x3c, 0x23, 0x1e, 0xed, 0x5f, 0x81, 0x02, 0xb5, 0x17, 0x49, 0xc0, 0x5e, 0x28, 0x2d,
-		0x5a, 0x81, 0x99, 0xe4, 0x72, 0xaf, 0xa1, 0x13, 0xef, 0x9e, 0x57, 0x8e, 0xf1, 0x2b, 0xb5, 0x55,
-		0x5d, 0x7e, 0x5b, 0x82, 0xb3, 0x82, 0x41, 0xc3, 0x55, 0x28, 0xfa, 0x9e, 0x42, 0x12, 0xf0, 0x14,
-		0x3e, 0xf1, 0xc0, 0xa0, 0x9a, 0x30, 0x2b, 0x1c, 0x31, 0x2f, 0xc1, 0x28, 0x75, 0xd6, 0xbd, 0x83,
-		0x73, 0x8c, 0xa3, 0x04, 0xd4, 0x37, 0x93, 0x73, 0xb3, 0xec, 0xf4, 0x1e, 0xe4, 0xdf, 0x49, 0x70,
-		0x46, 0xa4, 0x69, 0x20, 0x7c, 0x02, 0x4a, 0xd9, 0x4e, 0xc0, 0x3b, 0x30, 0xc9, 0x39, 0x65, 0x72,
-		0x69, 0x06, 0x79, 0xc0, 0x66, 0x9c, 0x30, 0x01, 0x4f, 0x93, 0x0f, 0x79, 0x1a, 0xf9, 0x75, 0x09,
-		0xe4, 0xf4, 0x7e, 0x03, 0x34, 0x0f, 0x28, 0x5a, 0x83, 0xee, 0x76, 0x21, 0x8d, 0xdb, 0x21, 0x11,
-		0x44, 0xdc, 0x6d, 0x2e, 0xe2, 0x6e, 0x8f, 0x03, 0xf8, 0x09, 0x41, 0x43, 0x27, 0x68, 0x4a, 0x4a,
-		0x89, 0x8e, 0x54, 0x75, 0xf9, 0xef, 0x11, 0xf1, 0x72, 0x2d, 0x24, 0x1b, 0xa2, 0x59, 0x18, 0x0f,
-		0xe7, 0x21, 0xba, 0xea, 0x35, 0x66, 0x07, 0x56, 0x1c, 0xc1, 0x9e, 0x8f, 0x60, 0x3f, 0x07, 0xfb,
-		0x37, 0x8c, 0xa6, 0x6a, 0xed, 0xd6, 0xb4, 0x2d, 0xac, 0x6d, 0xdb, 0xed, 0x06, 0x09, 0x51, 0x4a,
-		0xca, 0x98, 0x37, 0xbc, 0x44, 0x47, 0xd1, 0x79, 0x98, 0x08, 0x67, 0xcf, 0xf0, 0x23, 0x2f, 0xfc,
-		0x18, 0x55, 0xc6, 0x71, 0x30, 0xa9, 0x85, 0x1f, 0x39, 0xf2, 0x6b, 0x79, 0x38, 0x2d, 0xd0, 0xca,
-		0xf0, 0xd8, 0x56, 0x1c, 0x35, 0x8b, 0x7c, 0x1f, 0x66, 0x81, 0x4e, 0x40, 0x79, 0x43, 0xb5, 0xb1,
-		0x7f, 0x74, 0x7a, 0x62, 0x29, 0xb9, 0x43, 0xde, 0x81, 0x79, 0x0c, 0xa0, 0x89, 0x3b, 0xfe, 0xeb,
-		0x61, 0x4f, 0xb0, 0x4d, 0xdc, 0xf1, 0xde, 0xce, 0x03, 0xda, 0x34, 0xad, 0x6d, 0x8a, 0xd4, 0xef,
-		0x47, 0x2b, 0x78, 0x4b, 0x73, 0xdf, 0x10, 0xac, 0x0f, 0x68, 0x63, 0xda, 0xa4, 0xeb, 0x1c, 0x55,
-		0xdb, 0x6c, 0xd2, 0xd8, 0x88, 0x3e, 0xa1, 0xdb, 0x30, 0xac, 0xa9, 0x6d, 0x1b, 0xd3, 0x30, 0xa8,
-		0x22, 0xdc, 0x34, 0xb2, 0xe4, 0xce, 0x52, 0xbc, 0xc9, 0xf2, 0xdb, 0x79, 0x38, 0x95, 0xda, 0xc8,
-		0xf1, 0xd8, 0x36, 0xe3, 0x96, 0xbf, 0x06, 0x6f, 0x17, 0xe6, 0x05, 0xfb, 0x4c, 0x82, 0x2b, 0x08,
-		0xfa, 0xe4, 0xa1, 0x2c, 0x3e, 0x39, 0xa8, 0xfa, 0xc3, 0x11, 0xd5, 0x8f, 0xec, 0x6f, 0x21, 0x79,
-		0x7f, 0x8b, 0x42, 0xfb, 0x3b, 0xc2, 0xd9, 0x5f, 0x86, 0x99, 0x95, 0x58, 0x66, 0x26, 0x7f, 0xbd,
-		0x00, 0x67, 0x44, 0x7a, 0x5c, 0xd0, 0x49, 0x28, 0x77, 0x0b, 0xc5, 0x74, 0x9b, 0x4a, 0x0a, 0xf8,
-		0x43, 0x55, 0xdd, 0xbd, 0x54, 0xf5, 0x2a, 0xc9, 0xae, 0x11, 0xe4, 0x12, 0x2e, 0x55, 0xdd, 0x4f,
-		0x92, 0x4b, 0x95, 0x1a, 0x78, 0x72, 0x55, 0x53, 0x37, 0x1b, 0xaa, 0xd1, 0xa4, 0xbe, 0x83, 0x3e,
-		0x85, 0x0f, 0x83, 0xa1, 0x3e, 0xaf, 0x43, 0x05, 0xf1, 0xeb, 0xd0, 0x3a, 0x4c, 0xfb, 0x4a, 0x18,
-		0x3f, 0x43, 0x8a, 0x69, 0x67, 0xc8, 0xa4, 0x3f, 0x37, 0x72, 0x8c, 0x44, 0xb8, 0xd2, 0x23, 0x8a,
-		0x72, 0x1d, 0xc9, 0xc0, 0xd5, 0xbb, 0x05, 0x51, 0xae, 0xfc, 0xc3, 0xae, 0xd4, 0xd7, 0x61, 0xb7,
-		0x02, 0x13, 0x5b, 0x58, 0xb5, 0x9c, 0x0d, 0xac, 0xf6, 0xd0, 0x41, 0x1a, 0xab, 0xf1, 0xee, 0x9c,
-		0x1e, 0x9f, 0xf4, 0x10, 0xa5, 0x9c, 0x1e, 0xa2, 0xc4, 0xee, 0x0a, 0xa3, 0xfd, 0xdc, 0x15, 0x7a,
-		0x31, 0xe7, 0x3e, 0xf1, 0x98, 0xf3, 0x6f, 0x12, 0xc8, 0xe9, 0xfd, 0x56, 0x1f, 0xda, 0xe1, 0x1e,
-		0x0c, 0x43, 0x86, 0xc2, 0x17, 0x9e, 0x17, 0x61, 0x94, 0xdc, 0x17, 0x7d, 0xbf, 0x35, 0x2c, 0xe0,
-		0xb7, 0xca, 0xee, 0x0c, 0xfa, 0x20, 0xff, 0x41, 0x0a, 0xbb, 0x82, 0x01, 0x47, 0xd6, 0x6c, 0x11,
-		0xe5, 0x32, 0xb8, 0xfb, 0x7c, 0x6a, 0xb4, 0x31, 0x14, 0x16, 0xa6, 0xfc, 0x7b, 0x09, 0x4e, 0xa5,
-		0x37, 0xc1, 0xf4, 0x1b, 0x80, 0x7f, 0x14, 0x2b, 0xfa, 0x59, 0x0e, 0x4e, 0x0b, 0xb4, 0x92, 0xb9,
-		0x6b, 0xd2, 0xb1, 0xa3, 0x1a, 0x75, 0x5b, 0x68, 0x93, 0x7c, 0xe2, 0xc7, 0xb6, 0xa6, 0x68, 0x84,
-		0x34, 0xd4, 0x4f, 0x84, 0xb4, 0x67, 0x15, 0xff, 0xb2, 0x04, 0x73, 0xe2, 0x1d, 0x60, 0x22, 0x67,
-		0xde, 0x60, 0xae, 0x60, 0xef, 0x4a, 0x90, 0xb1, 0xd7, 0x2b, 0x1d, 0xdb, 0x41, 0x3f, 0x0c, 0xf2,
-		0x3c, 0x0c, 0x0d, 0x6c, 0x44, 0x10, 0xe7, 0x05, 0x10, 0xbf, 0x15, 0xd1, 0x43, 0x5e, 0x55, 0xa8,
-		0x5f, 0x3d, 0x5c, 0x81, 0x99, 0xba, 0xea, 0x04, 0x7a, 0x1e, 0xa2, 0x1d, 0x00, 0x3d, 0xc9, 0x7a,
-		0x74, 0xac, 0xad, 0xf4, 0xc2, 0x26, 0x86, 0x3e, 0xe7, 0x33, 0xe8, 0xf3, 0x50, 0xaa, 0x8d, 0x46,
-		0x02, 0x3d, 0xf9, 0x3d, 0x09, 0x8e, 0x26, 0x74, 0x59, 0xa2, 0x69, 0x18, 0xf1, 0xba, 0xcb, 0xba,
-		0xfb, 0x56, 0x24, 0xcf, 0x55, 0x1d, 0xad, 0xc2, 0xa1, 0xee, 0x41, 0xbe, 0x69, 0x58, 0x19, 0x2e,
-		0xad, 0x88, 0x9e, 0xe3, 0x2b, 0x86, 0x85, 0xb3, 0x1c, 0xbf, 0x22, 0x9b, 0xfd, 0x69, 0x98, 0xe6,
-		0xb6, 0x6f, 0x26, 0xad, 0x46, 0x38, 0x66, 0x97, 0x7f, 0x2d, 0xc1, 0xb1, 0xa4, 0xce, 0xbd, 0x81,
-		0x7c, 0x65, 0x50, 0xf2, 0x48, 0x74, 0xd0, 0x3f, 0x96, 0x60, 0x26, 0xad, 0x03, 0x30, 0x69, 0x35,
-		0x8f, 0xd5, 0x6c, 0x13, 0x91, 0xff, 0xab, 0x08, 0x19, 0x1b, 0x4d, 0xd0, 0x02, 0x1c, 0x24, 0xbd,
-		0x2c, 0xd1, 0xb4, 0xaf, 0xb7, 0xa6, 0x89, 0x26, 0xee, 0x44, 0x92, 0xbe, 0xb1, 0xca, 0x4b, 0xae,
-		0xbf, 0xca, 0xcb, 0x93, 0xda, 0x88, 0x78, 0x6d, 0x44, 0x44, 0x77, 0x8a, 0x02, 0xba, 0x73, 0x17,
-		0x26, 0x69, 0x4e, 0x9b, 0x62, 0x34, 0x9a, 0x0e, 0xb6, 0x76, 0xd4, 0x7a, 0xfa, 0xbd, 0xe5, 0x20,
-		0x9d, 0x48, 0xe0, 0x55, 0xe9, 0xb4, 0x70, 0xdd, 0xa5, 0xb4, 0xa7, 0xba, 0x4b, 0x20, 0x84, 0x83,
-		0x2c, 0x21, 0x1c, 0xbf, 0xc8, 0x52, 0xee, 0xbb, 0xc8, 0xd2, 0xbb, 0x67, 0x8c, 0x0a, 0xdf, 0x33,
-		0xba, 0xa9, 0xfe, 0x7d, 0x7b, 0x48, 0xf5, 0x8f, 0xed, 0x29, 0xd5, 0xef, 0xfa, 0xe0, 0x85, 0xac,
-		0xdd, 0x6e, 0x5d, 0x6f, 0x25, 0x05, 0xbd, 0x55, 0xd2, 0xfd, 0x66, 0x03, 0x0e, 0x77, 0x2b, 0xe4,
-		0x91, 0xaa, 0xa9, 0x67, 0xc7, 0x73, 0x89, 0x35, 0xf0, 0x70, 0xdd, 0xf4, 0x10, 0x66, 0x0d, 0xcb,
-		0xdf, 0x95, 0x18, 0x29, 0x6d, 0xde, 0xc9, 0x22, 0x62, 0x1e, 0x92, 0x80, 0x79, 0x04, 0x22, 0x9d,
-		0x5c, 0x86, 0x48, 0x47, 0xfe, 0x40, 0x82, 0xe3, 0x89, 0xdd, 0xda, 0x6e, 0xa8, 0x47, 0x7b, 0xc1,
-		0x9b, 0x6a, 0xc3, 0x17, 0x35, 0x78, 0x43, 0x77, 0xd4, 0x06, 0xee, 0xf7, 0xd3, 0x03, 0x3b, 0x55,
-		0x7a, 0x1a, 0x3f, 0x24, 0x7e, 0xb3, 0xfe, 0x2a, 0x6b, 0x93, 0x78, 0xdd, 0x09, 0x27, 0xa1, 0x4c,
-		0xfb, 0x43, 0x82, 0x22, 0xf0, 0x86, 0x88, 0x08, 0xba, 0x4e, 0x3d, 0x27, 0xee, 0xd4, 0x13, 0xf2,
-		0xd4, 0xf2, 0x57, 0x24, 0x98, 0xcb, 0xd0, 0x91, 0xd3, 0xcb, 0xa7, 0x4a, 0xa1, 0x7c, 0x6a, 0xbf,
-		0x3b, 0x93, 0x04, 0xed, 0x17, 0x39, 0x78, 0x61, 0x6f, 0x5d, 0xc9, 0x03, 0xd3, 0xf9, 0x5e, 0xae,
-		0x2e, 0x17, 0xca, 0xd5, 0xdd, 0x07, 0x14, 0xef, 0x7e, 0xa1, 0xf6, 0x7d, 0x56, 0xac, 0xc3, 0x55,
-		0x99, 0x88, 0xb5, 0xb0, 0xa2, 0x29, 0x28, 0x6a, 0x66, 0xd3, 0xb1, 0xcc, 0x3a, 0x51, 0xb4, 0x51,
-		0xc5, 0x7f, 0x44, 0x15, 0x38, 0x10, 0x69, 0xe4, 0x32, 0x9b, 0x75, 0x2f, 0x32, 0x1f, 0x51, 0x26,
-		0x42, 0xfd, 0x55, 0x77, 0x9b, 0xf5, 0x5d, 0xf9, 0xcd, 0x3c, 0xdc, 0xd8, 0x43, 0xd7, 0x33, 0xba,
-		0x1f, 0xf4, 0x7b, 0x63, 0x9c, 0xdf, 0x14, 0x08, 0x71, 0x0e, 0xa5, 0x9d, 0x07, 0x74, 0x9f, 0xe4,
-		0xe6, 0x50, 0xd9, 0xfb, 0x32, 0xb4, 0xd7, 0x7d, 0x99, 0x07, 0x14, 0xed, 0x35, 0xa3, 0x15, 0x8a,
-		0xbc, 0x32, 0x6e, 0x84, 0x94, 0xd0, 0x4b, 0x61, 0xf9, 0xbb, 0x58, 0x08, 0xed, 0xa2, 0xfc, 0x47,
-		0x09, 0xae, 0xf5, 0xd9, 0xb2, 0xcd, 0xc1, 0x20, 0x71, 0x30, 0x7c, 0xb8, 0x8a, 0x2b, 0x7f, 0x31,
-		0x0f, 0xd7, 0xfa, 0x6c, 0xab, 0xfb, 0x6f, 0xb5, 0xd5, 0x88, 0xc7, 0x1e, 0xe2, 0x7b, 0xec, 0x61,
-		0x71, 0x8f, 0xcd, 0x55, 0x1d, 0x9e, 0x03, 0x28, 0xf2, 0x1c, 0xc0, 0x6b, 0x79, 0xb8, 0xd2, 0x4f,
-		0x6b, 0xa0, 0x98, 0xe5, 0x0b, 0x71, 0x7e, 0x62, 0xf9, 0x3d, 0xcb, 0x7f, 0x5f, 0x82, 0x8b, 0x59,
-		0xdb, 0x1c, 0xff, 0xa3, 0x4d, 0x9e, 0x7f, 0x56, 0xc9, 0xbf, 0x95, 0xe0, 0x42, 0xa6, 0xd6, 0xc8,
-		0x81, 0xb9, 0x00, 0xe6, 0xad, 0x21, 0xb7, 0xb7, 0x5b, 0xc3, 0x9f, 0x47, 0xe0, 0x72, 0x1f, 0xbf,
-		0xf1, 0x08, 0x6c, 0x87, 0x14, 0xda, 0x8e, 0x93, 0x50, 0xee, 0x6e, 0x07, 0xd5, 0xf9, 0x92, 0x02,
-		0xfe, 0x10, 0x2b, 0x85, 0x90, 0x1f, 0x40, 0x0a, 0xa1, 0xdf, 0x7a, 0xe2, 0xf0, 0x60, 0x53, 0x08,
-		0x85, 0xc7, 0x9a, 0x42, 0x28, 0xf6, 0x9d, 0x42, 0x78, 0x00, 0xb4, 0x43, 0x95, 0x72, 0xa4, 0x65,
-		0x38, 0xaf, 0x49, 0xe0, 0x6c, 0x42, 0x9b, 0x2b, 0xe1, 0x42, 0x8b, 0x71, 0x13, 0xad, 0xe8, 0x50,
-		0xd0, 0x48, 0x4a, 0x61, 0x7f, 0x2e, 0xa2, 0xf2, 0x20, 0xa0, 0xf2, 0x1a, 0x4c, 0x05, 0xd4, 0xa9,
-		0x66, 0xe1, 0x76, 0x0f, 0x7e, 0x99, 0xc0, 0x9f, 0x4b, 0x54, 0x9c, 0xaa, 0xae, 0xb8, 0x53, 0xe8,
-		0x12, 0x0e, 0x75, 0x58, 0xc3, 0xb1, 0xf2, 0xe4, 0xbe, 0x7e, 0xca, 0x93, 0xb1, 0x5e, 0xc3, 0x31,
-		0x46, 0xaf, 0x61, 0xef, 0xa6, 0xb5, 0x3f, 0x7b, 0x6e, 0x61, 0x7c, 0x0f, 0xb9, 0x85, 0x89, 0xbd,
-		0xb5, 0x11, 0x3e, 0x07, 0x65, 0x1d, 0xd7, 0xd5, 0x5d, 0x4f, 0x35, 0xd3, 0x7b, 0x22, 0x81, 0x50,
-		0x13, 0x55, 0x94, 0xdf, 0xc8, 0xc3, 0xc5, 0xac, 0xbf, 0xc1, 0xfa, 0xe8, 0xdd, 0xcb, 0xaa, 0x1f,
-		0x27, 0x78, 0x95, 0xae, 0xab, 0x99, 0x7f, 0x40, 0x14, 0x0a, 0x0f, 0x02, 0x86, 0x32, 0x1c, 0x36,
-		0x14, 0xf6, 0x21, 0x58, 0xe0, 0x1c, 0x82, 0x03, 0xca, 0x05, 0xca, 0xbf, 0xc9, 0xc1, 0x7c, 0x96,
-		0x1f, 0x98, 0x71, 0xf7, 0x83, 0x7d, 0xfa, 0xe6, 0xf6, 0x7a, 0xfa, 0x0e, 0x6a, 0x17, 0xd9, 0xd2,
-		0x1d, 0xe2, 0x48, 0xb7, 0x67, 0x9d, 0xc3, 0xe2, 0x79, 0x90, 0x0f, 0x72, 0x90, 0xf1, 0xa7, 0x6f,
-		0x1f, 0x0f, 0x61, 0xb2, 0xca, 0x3a, 0xc3, 0xcc, 0xb2, 0x4e, 0xaf, 0x1f, 0xa1, 0x20, 0xde, 0x8f,
-		0x20, 0xff, 0x23, 0x07, 0xe7, 0x07, 0xe1, 0x51, 0x3e, 0xa6, 0x42, 0x0f, 0x64, 0xdc, 0x0b, 0x19,
-		0x32, 0xee, 0xf2, 0x3f, 0x73, 0x70, 0x21, 0xd3, 0x2f, 0x11, 0x9f, 0x08, 0x3e, 0x26, 0x78, 0x3f,
-		0xa5, 0x58, 0xc8, 0x92, 0x67, 0xfe, 0x5c, 0x9e, 0x27, 0x78, 0x5e, 0x0f, 0xc9, 0x13, 0xc1, 0x27,
-		0xb6, 0xb0, 0x14, 0xfa, 0xe9, 0x7d, 0xff, 0x79, 0x0e, 0x16, 0x32, 0xfe, 0x42, 0xf4, 0xc9, 0x3e,
-		0x84, 0xf6, 0x61, 0xce, 0x81, 0xfd, 0xe4, 0xcf, 0x15, 0xa3, 0xee, 0x60, 0x8b, 0x7c, 0xea, 0x38,
-		0x4c, 0x2f, 0x3f, 0x58, 0xbe, 0xb3, 0x5e, 0x5b, 0xa9, 0xae, 0xae, 0x2f, 0x2b, 0xb5, 0xf5, 0x4f,
-		0xae, 0x2d, 0xd7, 0xaa, 0x77, 0x1e, 0xdc, 0x5c, 0xad, 0xde, 0x1e, 0x7f, 0x0a, 0x9d, 0x84, 0xa3,
-		0xf1, 0xd7, 0x37, 0x57, 0x57, 0x6b, 0x64, 0x74, 0x5c, 0x42, 0xa7, 0xe0, 0x78, 0x9c, 0x60, 0x69,
-		0xf5, 0xee, 0xbd, 0x65, 0x4a, 0x92, 0xbb, 0xf5, 0x0a, 0x1c, 0xd6, 0xcc, 0x06, 0x4b, 0x06, 0xb7,
-		0xfc, 0xff, 0x31, 0xba, 0xe6, 0x86, 0xb1, 0x6b, 0xd2, 0xa7, 0x2e, 0x3d, 0x34, 0x9c, 0xad, 0xf6,
-		0x46, 0x45, 0x33, 0x1b, 0x0b, 0xc1, 0xff, 0x75, 0x7a, 0xc1, 0xd0, 0xeb, 0x0b, 0x0f, 0x4d, 0xef,
-		0xff, 0xab, 0xd2, 0x7f, 0x7c, 0x7a, 0x43, 0x6d, 0x19, 0x3b, 0x97, 0x36, 0x0a, 0x64, 0xec, 0xf2,
-		0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x75, 0x55, 0x1d, 0xdb, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x47,
+		0x19, 0xef, 0xde, 0xd9, 0x67, 0xdf, 0x77, 0x8e, 0x63, 0x4f, 0x12, 0xc7, 0x4e, 0x9c, 0xc4, 0xd9,
+		0xa4, 0x89, 0xeb, 0xd8, 0xe7, 0xc4, 0x49, 0x13, 0xd2, 0xd0, 0x96, 0xc4, 0xb1, 0x95, 0x93, 0x4c,
+		0x12, 0x6d, 0x9c, 0x14, 0x50, 0xa5, 0x63, 0xbd, 0x3b, 0x8e, 0x57, 0xbe, 0xbb, 0xbd, 0xee, 0xce,
+		0xf9, 0x62, 0x24, 0x9e, 0x78, 0x40, 0x42, 0xad, 0xa0, 0xaa, 0x90, 0xa8, 0x00, 0x81, 0x90, 0x40,
+		0x2d, 0x42, 0x2a, 0x02, 0x21, 0x40, 0xbc, 0x00, 0x12, 0x02, 0x09, 0x54, 0x78, 0xe2, 0x85, 0x07,
+		0x78, 0xe0, 0x81, 0xbe, 0xf1, 0x40, 0x79, 0x43, 0x42, 0x3b, 0x3b, 0x7b, 0x7f, 0x76, 0x67, 0x76,
+		0x67, 0xcf, 0x97, 0x16, 0xd4, 0xbc, 0x79, 0x67, 0xbf, 0xf9, 0xf6, 0xf7, 0xcd, 0x7c, 0xdf, 0x37,
+		0xdf, 0x7c, 0xdf, 0x77, 0x86, 0x93, 0x8d, 0x0d, 0xec, 0x2c, 0x1a, 0xba, 0x89, 0x6b, 0x06, 0x5e,
+		0xd4, 0xeb, 0xd6, 0xe2, 0xce, 0x85, 0xc5, 0x2d, 0xcb, 0x25, 0xb6, 0xb3, 0x5b, 0xac, 0x3b, 0x36,
+		0xb1, 0xd1, 0x01, 0x8f, 0xa4, 0xc8, 0x48, 0x8a, 0x7a, 0xdd, 0x2a, 0xee, 0x5c, 0x38, 0x72, 0xfc,
+		0xa1, 0x6d, 0x3f, 0xac, 0xe0, 0x45, 0x4a, 0xb2, 0xd1, 0xd8, 0x5c, 0x34, 0x1b, 0x8e, 0x4e, 0x2c,
+		0xbb, 0xe6, 0x4f, 0x3a, 0x72, 0x22, 0xfc, 0x9e, 0x58, 0x55, 0xec, 0x12, 0xbd, 0x5a, 0x67, 0x04,
+		0x33, 0xbc, 0x0f, 0x1b, 0x76, 0xb5, 0xda, 0x62, 0xa1, 0xf2, 0x28, 0x88, 0xee, 0x6e, 0x57, 0x2c,
+		0x97, 0xc4, 0xd1, 0x34, 0x6d, 0x67, 0x7b, 0xb3, 0x62, 0x37, 0x7d, 0x1a, 0xf5, 0x26, 0x0c, 0xdd,
+		0xf2, 0x05, 0x42, 0x57, 0x21, 0x87, 0x77, 0x70, 0x8d, 0xb8, 0x93, 0xca, 0x4c, 0x76, 0xb6, 0xb0,
+		0x74, 0xb2, 0xc8, 0x91, 0xad, 0xc8, 0xa8, 0x57, 0x3c, 0x4a, 0x8d, 0x4d, 0x50, 0xdf, 0xbb, 0x02,
+		0x23, 0x9d, 0x2f, 0xd0, 0x14, 0x0c, 0xd3, 0x57, 0x65, 0xcb, 0x9c, 0x54, 0x66, 0x94, 0xd9, 0xac,
+		0x36, 0x44, 0x9f, 0x4b, 0x26, 0xba, 0x0a, 0xe0, 0xbf, 0xf2, 0x84, 0x9e, 0xcc, 0xcc, 0x28, 0xb3,
+		0x85, 0xa5, 0x23, 0x45, 0x7f, 0x45, 0x8a, 0xc1, 0x8a, 0x14, 0xd7, 0x83, 0x15, 0xd1, 0xf2, 0x94,
+		0xda, 0x7b, 0x46, 0x93, 0x30, 0xb4, 0x83, 0x1d, 0xd7, 0xb2, 0x6b, 0x93, 0x59, 0x9f, 0x29, 0x7b,
+		0x44, 0x87, 0x61, 0xc8, 0x13, 0xde, 0xfb, 0xdc, 0x00, 0x7d, 0x93, 0xf3, 0x1e, 0x4b, 0x26, 0xfa,
+		0x86, 0x02, 0xe7, 0x02, 0x91, 0xcb, 0xf8, 0x11, 0x36, 0x1a, 0xde, 0x3e, 0x94, 0x5d, 0xa2, 0x3b,
+		0x04, 0x9b, 0x65, 0x1f, 0x89, 0x4e, 0x88, 0x63, 0x6d, 0x34, 0x08, 0x76, 0x27, 0x07, 0x29, 0x9e,
+		0x8f, 0x73, 0x45, 0x7f, 0x89, 0xf1, 0x59, 0x09, 0xd8, 0xdc, 0xf3, 0xb9, 0x50, 0x91, 0xaf, 0xb7,
+		0x78, 0xdc, 0x7a, 0x4a, 0x3b, 0xdb, 0x94, 0x23, 0x45, 0xdf, 0x51, 0x60, 0x81, 0x03, 0xcf, 0xb0,
+		0xab, 0xf5, 0x0a, 0xe6, 0x02, 0xcc, 0x51, 0x80, 0x2f, 0xc8, 0x01, 0x5c, 0x0e, 0xf8, 0x44, 0x21,
+		0x3e, 0xd3, 0x94, 0x25, 0x46, 0x6f, 0x2a, 0x30, 0xc7, 0x01, 0xb9, 0xa9, 0x5b, 0x15, 0x1e, 0xc2,
+		0x21, 0x8a, 0xf0, 0x9a, 0x1c, 0xc2, 0x55, 0xca, 0x24, 0x0a, 0xef, 0x4c, 0x53, 0x8a, 0x12, 0x7d,
+		0x9b, 0xbf, 0x80, 0x9e, 0x6e, 0x99, 0x65, 0xbb, 0x41, 0xa2, 0xf0, 0x86, 0x29, 0xbc, 0xe7, 0xe5,
+		0xe0, 0x79, 0x6a, 0x67, 0xde, 0x69, 0x90, 0x28, 0xc0, 0xd9, 0xa6, 0x24, 0x2d, 0x7a, 0x43, 0x81,
+		0x59, 0x13, 0x1b, 0x96, 0x4b, 0x81, 0x79, 0x5a, 0xea, 0x1a, 0x5b, 0xd8, 0x6c, 0x70, 0x17, 0x2f,
+		0x4f, 0xd1, 0x5d, 0xe5, 0xa2, 0xbb, 0xc9, 0x98, 0xac, 0xeb, 0xee, 0xf6, 0xbd, 0x80, 0x45, 0x14,
+		0xd9, 0x69, 0x53, 0x82, 0x0e, 0xbd, 0xa6, 0xc0, 0x99, 0x10, 0x2a, 0x91, 0x4d, 0x00, 0xc5, 0x74,
+		0x25, 0x19, 0x93, 0xc8, 0x1c, 0x54, 0x33, 0x91, 0x8a, 0xb3, 0x4a, 0x31, 0x46, 0x50, 0x90, 0x5c,
+		0xa5, 0x18, 0xfd, 0x3f, 0x6d, 0x4a, 0xd0, 0xa1, 0xd7, 0x23, 0xa8, 0x62, 0x34, 0x6b, 0x84, 0xa2,
+		0xfa, 0x58, 0x22, 0x2a, 0xb1, 0x52, 0x9d, 0x32, 0x93, 0xc9, 0xd0, 0x97, 0x14, 0x78, 0xba, 0x1b,
+		0x93, 0xc8, 0x12, 0xf7, 0x51, 0x40, 0x97, 0x13, 0x01, 0x89, 0x8c, 0xf0, 0xa4, 0x99, 0x44, 0x44,
+		0xb7, 0x4d, 0x37, 0x88, 0xb5, 0x63, 0x91, 0xdd, 0x44, 0xe5, 0x1e, 0x8d, 0xd9, 0xb6, 0xeb, 0x8c,
+		0x49, 0x92, 0x72, 0xeb, 0x12, 0x74, 0x54, 0xb9, 0x43, 0xa8, 0x44, 0xca, 0xbd, 0x3f, 0x46, 0xb9,
+		0xbb, 0x30, 0x09, 0x95, 0x5b, 0x4f, 0xa4, 0xe2, 0xac, 0x52, 0x8c, 0x72, 0x8f, 0x49, 0xae, 0x52,
+		0x9c, 0x72, 0xeb, 0x12, 0x74, 0x54, 0x91, 0xba, 0x51, 0x89, 0x14, 0x69, 0x3c, 0x46, 0x91, 0x3a,
+		0x21, 0x09, 0x15, 0x49, 0x4f, 0x22, 0xa2, 0x96, 0xd6, 0x0d, 0x26, 0xc6, 0xd2, 0x50, 0x8c, 0xa5,
+		0x75, 0xe2, 0x89, 0xb1, 0x34, 0x3d, 0x99, 0x0c, 0x35, 0xe1, 0xb8, 0x07, 0xc2, 0x11, 0x6b, 0xcf,
+		0x01, 0x0a, 0xe4, 0x3c, 0x17, 0x88, 0xc7, 0xd5, 0x11, 0xaa, 0xcd, 0x51, 0x22, 0x7e, 0x8d, 0x5e,
+		0x81, 0x69, 0xff, 0xc3, 0x9b, 0x96, 0xc3, 0xfb, 0xec, 0x41, 0xfa, 0xd9, 0xa2, 0xf8, 0xb3, 0xab,
+		0x96, 0x13, 0xe1, 0x7a, 0xeb, 0x29, 0x6d, 0x8a, 0x88, 0x5e, 0xa2, 0xef, 0x29, 0xb0, 0x18, 0x52,
+		0x51, 0xbd, 0x66, 0xe0, 0x4a, 0xd9, 0xc1, 0xaf, 0x34, 0xb0, 0xcb, 0x95, 0xfe, 0x10, 0x85, 0xf1,
+		0x62, 0xb2, 0xa6, 0x52, 0x4e, 0x5a, 0xc0, 0x28, 0x8a, 0x6b, 0x4e, 0x97, 0xa6, 0x46, 0x3f, 0x56,
+		0xe0, 0x12, 0xc3, 0x14, 0x40, 0x94, 0x53, 0xe2, 0x09, 0x8a, 0x76, 0x99, 0x8b, 0x96, 0x7d, 0xcd,
+		0xff, 0xb4, 0x8c, 0x46, 0x17, 0x9d, 0x54, 0x33, 0xd0, 0x57, 0x14, 0x38, 0xcb, 0x5b, 0x5e, 0x1e,
+		0xd0, 0xc3, 0x92, 0xda, 0xbd, 0xcc, 0x38, 0x24, 0x68, 0xb7, 0x80, 0x0c, 0x7d, 0x0e, 0x4e, 0xf8,
+		0x4a, 0x26, 0x46, 0x32, 0x49, 0x91, 0x5c, 0x10, 0xeb, 0x99, 0x18, 0xc2, 0x34, 0x89, 0x79, 0x8f,
+		0xbe, 0xa8, 0xc0, 0x69, 0xb6, 0x79, 0x4c, 0xd1, 0x05, 0x9b, 0x36, 0x45, 0x11, 0x3c, 0xcb, 0x45,
+		0xe0, 0x33, 0xf7, 0xf5, 0x5d, 0xb0, 0x4d, 0x33, 0x46, 0x02, 0x0d, 0xfa, 0x3c, 0xcc, 0x54, 0x75,
+		0x67, 0x1b, 0x3b, 0x65, 0x07, 0x1b, 0xb6, 0x63, 0xf2, 0x40, 0x1c, 0xa1, 0x20, 0x96, 0xb8, 0x20,
+		0x3e, 0x49, 0x27, 0x6b, 0x6c, 0x6e, 0x14, 0xc1, 0xb1, 0x6a, 0x1c, 0x01, 0xfa, 0x96, 0x02, 0xf3,
+		0xbc, 0xfb, 0x89, 0xf5, 0xb0, 0xa6, 0x73, 0x17, 0xe4, 0x68, 0x9a, 0xf0, 0xf5, 0x1e, 0x63, 0x23,
+		0x13, 0xbe, 0x0a, 0x68, 0xd1, 0x77, 0x15, 0x28, 0x72, 0x10, 0x12, 0xec, 0x54, 0xad, 0x9a, 0xce,
+		0xf5, 0x0b, 0xd3, 0x31, 0x7e, 0x21, 0x1a, 0x62, 0xb7, 0x18, 0x71, 0xfc, 0x42, 0x53, 0x9a, 0x1a,
+		0xfd, 0x44, 0x81, 0x4b, 0xbc, 0xab, 0x54, 0xa2, 0x17, 0x3b, 0x46, 0xd1, 0xde, 0x94, 0xbc, 0x51,
+		0x25, 0xb9, 0xb2, 0xc5, 0x66, 0xba, 0x29, 0x22, 0x0d, 0x10, 0x1b, 0xe5, 0xf1, 0x34, 0x1a, 0x20,
+		0x36, 0xd0, 0xd9, 0xa6, 0x24, 0x2d, 0xfa, 0xbb, 0x02, 0x2b, 0x21, 0x8f, 0x8b, 0x1f, 0x11, 0xec,
+		0xd4, 0xf4, 0x4a, 0x99, 0x83, 0xdc, 0xaa, 0x59, 0xc4, 0xe2, 0x2b, 0xc6, 0x09, 0x0a, 0xfd, 0x5e,
+		0xb2, 0x0b, 0x5e, 0x61, 0xfc, 0x23, 0xf2, 0x94, 0x02, 0xe6, 0x51, 0x81, 0x5e, 0x70, 0xf6, 0xc4,
+		0x01, 0xfd, 0x45, 0x81, 0x1b, 0x29, 0xc4, 0x14, 0x79, 0xac, 0x19, 0x2a, 0xe3, 0xdd, 0x3d, 0xc8,
+		0x28, 0x72, 0x66, 0xd7, 0x9c, 0xde, 0xa7, 0xa3, 0x77, 0x15, 0x78, 0x3e, 0x4e, 0x9c, 0x64, 0x3b,
+		0x39, 0x49, 0x05, 0x5b, 0xe3, 0x0a, 0x26, 0x04, 0x93, 0x68, 0x2f, 0x57, 0x70, 0x6f, 0x53, 0x69,
+		0x1c, 0xc0, 0x93, 0xc3, 0xae, 0x11, 0xab, 0xd6, 0xc0, 0x66, 0x59, 0x77, 0xcb, 0x35, 0xdc, 0x8c,
+		0xca, 0xa1, 0xc6, 0xc4, 0x01, 0x51, 0x10, 0x01, 0xbb, 0xeb, 0xee, 0x6d, 0xdc, 0x8c, 0xc2, 0x2f,
+		0x36, 0x53, 0xcd, 0x40, 0xbf, 0x56, 0xe0, 0x2a, 0x8d, 0x26, 0xcb, 0xc6, 0x96, 0x55, 0x31, 0x53,
+		0xda, 0xcf, 0x29, 0x0a, 0xfd, 0x16, 0x17, 0x3a, 0x0d, 0x25, 0x97, 0x3d, 0xa6, 0x69, 0x8c, 0xe6,
+		0xa2, 0x9b, 0x7e, 0x1a, 0xfa, 0xb9, 0x02, 0x97, 0x13, 0x84, 0x10, 0x59, 0xc7, 0x69, 0x2a, 0xc1,
+		0x4a, 0x5a, 0x09, 0x44, 0x26, 0x71, 0xde, 0x4d, 0x39, 0x07, 0xfd, 0x40, 0x81, 0x0b, 0x42, 0xd4,
+		0xc2, 0x38, 0xff, 0x69, 0x0a, 0xfb, 0x3a, 0x3f, 0x0c, 0xe1, 0x7e, 0x5d, 0x18, 0xf8, 0xcf, 0x1b,
+		0x29, 0xe8, 0xd1, 0x8f, 0x14, 0xb8, 0x28, 0x84, 0x1b, 0x73, 0x89, 0x3c, 0x13, 0xa3, 0xe4, 0x7c,
+		0xc0, 0x31, 0xd7, 0xc9, 0xa2, 0x91, 0x6a, 0x06, 0x7a, 0x5b, 0x81, 0xf3, 0xa9, 0x35, 0xe3, 0x2c,
+		0x45, 0xfc, 0x89, 0x14, 0x88, 0x45, 0x4a, 0x71, 0xce, 0x48, 0xa1, 0x0f, 0xef, 0x28, 0xb0, 0x24,
+		0x5e, 0x60, 0xe1, 0x21, 0x3c, 0x4b, 0xd1, 0xde, 0x48, 0xb3, 0xbe, 0xc2, 0x93, 0x78, 0xc1, 0x48,
+		0x33, 0x01, 0xfd, 0x30, 0x4e, 0x25, 0x62, 0x2e, 0xcd, 0xcf, 0xa4, 0x86, 0x2c, 0xbe, 0x3e, 0x2f,
+		0x18, 0x69, 0x26, 0xd0, 0xd8, 0x4c, 0x0c, 0x39, 0x26, 0x92, 0x9c, 0x8b, 0x89, 0xcd, 0x04, 0x98,
+		0x63, 0xc2, 0xc9, 0x45, 0x23, 0xdd, 0x14, 0x7a, 0x68, 0xfa, 0xa1, 0x78, 0xaf, 0x11, 0xcf, 0xb9,
+		0x98, 0x43, 0xd3, 0x8f, 0xb8, 0x7b, 0x09, 0x75, 0xae, 0xb8, 0xbd, 0x4d, 0x45, 0xbf, 0x51, 0xe0,
+		0x39, 0x09, 0x81, 0x44, 0x36, 0x3a, 0x4f, 0xa5, 0x29, 0xf5, 0x22, 0x8d, 0xc8, 0x58, 0x2f, 0xb9,
+		0x3d, 0xcc, 0x43, 0x3f, 0x53, 0xe0, 0xd9, 0x38, 0x01, 0xc4, 0xf7, 0xa7, 0x85, 0x98, 0x03, 0x48,
+		0x08, 0x42, 0x7c, 0x8f, 0x3a, 0x8f, 0x53, 0xce, 0xa1, 0x0e, 0xa7, 0x51, 0x77, 0xb1, 0x43, 0xda,
+		0xc0, 0x5d, 0xac, 0x3b, 0xc6, 0x56, 0x07, 0xcc, 0x28, 0xee, 0x62, 0x8c, 0xf5, 0xde, 0xa7, 0xec,
+		0x02, 0x04, 0xf7, 0x28, 0xb3, 0xf6, 0x17, 0x39, 0xd6, 0xdb, 0x48, 0x33, 0xe1, 0xc6, 0x08, 0x40,
+		0x1b, 0x88, 0xfa, 0xb7, 0x02, 0x9c, 0x95, 0x3d, 0xbd, 0x56, 0x61, 0x5f, 0x4b, 0x46, 0xb2, 0x5b,
+		0xc7, 0xb4, 0x16, 0x28, 0xaa, 0x2c, 0x06, 0x4c, 0xd7, 0x77, 0xeb, 0x58, 0x1b, 0x69, 0x76, 0x3c,
+		0xa1, 0x97, 0xe1, 0x50, 0x5d, 0x77, 0xbc, 0x15, 0xe9, 0x34, 0xba, 0x4d, 0x9b, 0x95, 0x0f, 0x67,
+		0xb9, 0xfc, 0xee, 0xd2, 0x19, 0x1d, 0x36, 0xb1, 0x69, 0x6b, 0x07, 0xea, 0xd1, 0x41, 0xf4, 0x1c,
+		0xe4, 0x69, 0x46, 0xa6, 0x62, 0xb9, 0x84, 0x16, 0x16, 0x0b, 0x4b, 0xc7, 0xf8, 0x29, 0x0f, 0xdd,
+		0xdd, 0x5e, 0xb3, 0x5c, 0xa2, 0x0d, 0x13, 0xf6, 0x17, 0x5a, 0x82, 0x41, 0xab, 0x56, 0x6f, 0x10,
+		0x5a, 0x76, 0x2c, 0x2c, 0x4d, 0x0b, 0x90, 0xec, 0x56, 0x6c, 0xdd, 0xd4, 0x7c, 0x52, 0xa4, 0xc3,
+		0x4c, 0x28, 0xe4, 0x28, 0x13, 0xbb, 0x6c, 0x54, 0x6c, 0x17, 0x53, 0xff, 0x6d, 0x37, 0x08, 0xab,
+		0x43, 0x4e, 0x45, 0xea, 0xa2, 0x37, 0x59, 0x25, 0x59, 0x9b, 0xc6, 0x5d, 0x6b, 0xbf, 0x6e, 0x2f,
+		0x7b, 0xf3, 0xd7, 0xfd, 0xe9, 0xe8, 0x25, 0x38, 0xda, 0x4e, 0x7b, 0x47, 0xb9, 0xe7, 0x92, 0xb8,
+		0x1f, 0x26, 0x41, 0x32, 0x3b, 0xc4, 0xf8, 0x1a, 0x1c, 0x69, 0x47, 0xd8, 0x6d, 0x29, 0x9c, 0x46,
+		0xcd, 0xab, 0xbd, 0x7a, 0xa5, 0xbf, 0xbc, 0x76, 0xb8, 0x45, 0xd1, 0x5a, 0x67, 0xad, 0x51, 0x2b,
+		0x99, 0xa8, 0x04, 0x79, 0xe6, 0x2a, 0x6d, 0x87, 0xd6, 0xe1, 0x46, 0x97, 0xce, 0xf1, 0x5d, 0x3b,
+		0x63, 0x40, 0x43, 0xe8, 0x52, 0x30, 0x45, 0x6b, 0xcf, 0x46, 0x25, 0x18, 0x6f, 0xe3, 0xf0, 0xdc,
+		0x55, 0xc3, 0xc1, 0x93, 0xf9, 0x98, 0x3d, 0x58, 0xf5, 0x69, 0xb4, 0xb1, 0xd6, 0x34, 0x36, 0x82,
+		0x34, 0x98, 0xa8, 0xe8, 0xde, 0x9d, 0xcf, 0x0f, 0x67, 0xa8, 0x38, 0xd8, 0x6d, 0x54, 0xc8, 0x24,
+		0xc4, 0xf0, 0x0b, 0xf6, 0xf4, 0xa0, 0x37, 0x77, 0xb9, 0x35, 0x55, 0xa3, 0x33, 0xd1, 0x55, 0x98,
+		0xb2, 0x1d, 0xeb, 0xa1, 0xe5, 0x3b, 0xda, 0xd0, 0x2a, 0x15, 0xe8, 0x2a, 0x4d, 0x04, 0x04, 0xa1,
+		0x45, 0x3a, 0x02, 0xc3, 0x96, 0x89, 0x6b, 0xc4, 0x22, 0xbb, 0xb4, 0xa2, 0x94, 0xd7, 0x5a, 0xcf,
+		0xe8, 0x22, 0x4c, 0x6c, 0x5a, 0x8e, 0x4b, 0xa2, 0x3c, 0xf7, 0x51, 0xca, 0x03, 0xf4, 0x6d, 0x88,
+		0xe1, 0x32, 0x8c, 0x38, 0x98, 0x38, 0xbb, 0xe5, 0xba, 0x5d, 0xb1, 0x8c, 0x5d, 0x56, 0x85, 0x99,
+		0x11, 0x5c, 0x50, 0x89, 0xb3, 0x7b, 0x97, 0xd2, 0x69, 0x05, 0xa7, 0xfd, 0xe0, 0x95, 0xde, 0x75,
+		0x42, 0x70, 0xb5, 0x4e, 0x68, 0xc5, 0x64, 0x50, 0x0b, 0x1e, 0xd1, 0x32, 0xec, 0xc7, 0x8f, 0xea,
+		0x96, 0xaf, 0x38, 0x7e, 0x51, 0x7f, 0x2c, 0xb1, 0xa8, 0x3f, 0xda, 0x9e, 0xe2, 0x0d, 0xa2, 0x53,
+		0xb0, 0xcf, 0x70, 0x3c, 0x6b, 0x60, 0x15, 0x1d, 0x5a, 0x71, 0xc8, 0x6b, 0x23, 0xde, 0x60, 0x50,
+		0xe5, 0x41, 0x9f, 0x82, 0xa3, 0xbe, 0xf4, 0xdd, 0xd5, 0xaf, 0x0d, 0xdd, 0xd8, 0xb6, 0x37, 0x37,
+		0x27, 0x51, 0x92, 0x52, 0x4f, 0xd2, 0xd9, 0x9d, 0x85, 0xaf, 0x1b, 0xfe, 0x54, 0xb4, 0x00, 0x03,
+		0x55, 0x5c, 0xb5, 0x59, 0x3a, 0x7f, 0x8a, 0x9f, 0xe8, 0xc3, 0x55, 0x5b, 0xa3, 0x64, 0x48, 0x83,
+		0xf1, 0x88, 0xc7, 0x66, 0x39, 0xf9, 0xa7, 0xf9, 0x67, 0x63, 0xc8, 0xc3, 0x6a, 0x63, 0x6e, 0x68,
+		0x04, 0xdd, 0x87, 0x89, 0xba, 0x83, 0x77, 0xca, 0x7a, 0x83, 0xd8, 0x9e, 0xfe, 0x61, 0x52, 0xae,
+		0xdb, 0x56, 0x8d, 0x04, 0x59, 0x76, 0xd1, 0x7e, 0xb9, 0x98, 0xdc, 0xa5, 0x74, 0xda, 0x01, 0x6f,
+		0xfe, 0xf5, 0x06, 0xb1, 0x3b, 0x06, 0xd1, 0x45, 0xc8, 0x6d, 0x61, 0xdd, 0xc4, 0x0e, 0x4b, 0x7f,
+		0x1f, 0xe5, 0x37, 0x75, 0x50, 0x12, 0x8d, 0x91, 0xaa, 0x6f, 0x2b, 0xf0, 0x8c, 0x7c, 0xb4, 0x7f,
+		0x09, 0x72, 0xcc, 0x5e, 0x14, 0x09, 0x7b, 0x61, 0xb4, 0x68, 0x15, 0x66, 0xe2, 0xcb, 0xbd, 0x96,
+		0x49, 0xbd, 0x7b, 0x56, 0x9b, 0x16, 0x57, 0x6a, 0x4b, 0xa6, 0xfa, 0x96, 0x02, 0x67, 0x24, 0x83,
+		0x86, 0xcb, 0x30, 0x14, 0x78, 0x0a, 0x45, 0xc2, 0x53, 0x04, 0xc4, 0x7d, 0x83, 0x6a, 0xc3, 0xac,
+		0x74, 0xc4, 0xbc, 0x0c, 0x23, 0xcc, 0x59, 0xb7, 0x0f, 0xce, 0x51, 0x81, 0x12, 0x30, 0xdf, 0x4c,
+		0xcf, 0xcd, 0x02, 0x69, 0x3f, 0xa8, 0x7f, 0x50, 0xe0, 0xb4, 0x4c, 0xd3, 0x40, 0xf7, 0x09, 0xa8,
+		0xa4, 0x3b, 0x01, 0x6f, 0xc3, 0x84, 0xe0, 0x94, 0xc9, 0x24, 0x19, 0xe4, 0x01, 0x97, 0x73, 0xc2,
+		0x74, 0x78, 0x9a, 0x6c, 0x97, 0xa7, 0x51, 0x5f, 0x53, 0x40, 0x4d, 0xee, 0x37, 0x40, 0xf3, 0x80,
+		0xc2, 0x35, 0xe8, 0x56, 0x17, 0xd2, 0x98, 0xdb, 0xb5, 0x04, 0x21, 0x77, 0x9b, 0x09, 0xb9, 0xdb,
+		0x63, 0x00, 0x41, 0x42, 0xd0, 0x32, 0x29, 0x9a, 0xbc, 0x96, 0x67, 0x23, 0x25, 0x53, 0xfd, 0x67,
+		0x68, 0x79, 0x85, 0x16, 0x92, 0x0e, 0xd1, 0x2c, 0x8c, 0x75, 0xe7, 0x21, 0x5a, 0xea, 0x35, 0xea,
+		0x76, 0x48, 0x1c, 0xc2, 0x9e, 0x0d, 0x61, 0x3f, 0x0b, 0xfb, 0x37, 0xac, 0x9a, 0xee, 0xec, 0x96,
+		0x8d, 0x2d, 0x6c, 0x6c, 0xbb, 0x8d, 0x2a, 0x0d, 0x51, 0xf2, 0xda, 0xa8, 0x3f, 0xbc, 0xcc, 0x46,
+		0xd1, 0x39, 0x18, 0xef, 0xce, 0x9e, 0xe1, 0x47, 0x7e, 0xf8, 0x31, 0xa2, 0x8d, 0xe1, 0xce, 0xa4,
+		0x16, 0x7e, 0x44, 0xd4, 0x57, 0xb3, 0x70, 0x4a, 0xa2, 0x95, 0xe1, 0xb1, 0x49, 0x1c, 0x36, 0x8b,
+		0x6c, 0x0f, 0x66, 0x81, 0x8e, 0x43, 0x61, 0x43, 0x77, 0x71, 0x70, 0x74, 0xfa, 0xcb, 0x92, 0xf7,
+		0x86, 0xfc, 0x03, 0x73, 0x1a, 0xc0, 0x4b, 0x1c, 0xb2, 0xd7, 0x83, 0xfe, 0xc2, 0xd6, 0x70, 0xd3,
+		0x7f, 0x3b, 0x0f, 0x68, 0xd3, 0x76, 0xb6, 0x19, 0xd2, 0xa0, 0x1f, 0x2d, 0xe7, 0x8b, 0xe6, 0xbd,
+		0xa1, 0x58, 0x1f, 0xf8, 0xe3, 0x68, 0xc2, 0x73, 0x8e, 0xba, 0x6b, 0xd7, 0x58, 0x6c, 0xc4, 0x9e,
+		0xd0, 0x4d, 0x18, 0x34, 0xf4, 0x86, 0x8b, 0x59, 0x18, 0x54, 0x94, 0x6e, 0x1a, 0x59, 0xf6, 0x66,
+		0x69, 0xfe, 0x64, 0xf5, 0xad, 0x2c, 0x9c, 0x4c, 0x6c, 0xe4, 0x78, 0x6c, 0x9b, 0x71, 0x23, 0x90,
+		0xc1, 0xdf, 0x85, 0x79, 0xc9, 0x3e, 0x93, 0x4e, 0x09, 0x3a, 0x7d, 0xf2, 0x40, 0x1a, 0x9f, 0xdc,
+		0xa9, 0xfa, 0x83, 0x21, 0xd5, 0x0f, 0xed, 0x6f, 0x2e, 0x7e, 0x7f, 0x87, 0xa4, 0xf6, 0x77, 0x58,
+		0xb0, 0xbf, 0x1c, 0x33, 0xcb, 0xf3, 0xcc, 0x4c, 0xfd, 0x66, 0x0e, 0x4e, 0xcb, 0xf4, 0xb8, 0xa0,
+		0x13, 0x50, 0x68, 0x15, 0x8a, 0xd9, 0x36, 0xe5, 0x35, 0x08, 0x86, 0x4a, 0xa6, 0x77, 0xa9, 0x6a,
+		0x11, 0x50, 0x23, 0xc8, 0xc4, 0x5c, 0xaa, 0x5a, 0x9f, 0xa4, 0x97, 0x2a, 0xbd, 0xe3, 0xc9, 0x53,
+		0x4d, 0xd3, 0xae, 0xea, 0x56, 0x8d, 0xf9, 0x0e, 0xf6, 0xd4, 0x7d, 0x18, 0x0c, 0xf4, 0x78, 0x1d,
+		0xca, 0xc9, 0x5f, 0x87, 0xd6, 0x61, 0x2a, 0x50, 0xc2, 0xe8, 0x19, 0x32, 0x94, 0x74, 0x86, 0x4c,
+		0x04, 0x73, 0x43, 0xc7, 0x48, 0x88, 0x2b, 0x3b, 0xa2, 0x18, 0xd7, 0xe1, 0x14, 0x5c, 0xfd, 0x5b,
+		0x10, 0xe3, 0x2a, 0x3e, 0xec, 0xf2, 0x3d, 0x1d, 0x76, 0xab, 0x30, 0xbe, 0x85, 0x75, 0x87, 0x6c,
+		0x60, 0xbd, 0x8d, 0x0e, 0x92, 0x58, 0x8d, 0xb5, 0xe6, 0xb4, 0xf9, 0x24, 0x87, 0x28, 0x85, 0xe4,
+		0x10, 0x25, 0x72, 0x57, 0x18, 0xe9, 0xe5, 0xae, 0xd0, 0x8e, 0x39, 0xf7, 0xc9, 0xc7, 0x9c, 0xff,
+		0x50, 0x40, 0x4d, 0xee, 0xb7, 0xfa, 0xc0, 0x0e, 0xf7, 0xce, 0x30, 0x64, 0xa0, 0xfb, 0xc2, 0xf3,
+		0x22, 0x8c, 0xd0, 0xfb, 0x62, 0xe0, 0xb7, 0x06, 0x25, 0xfc, 0x56, 0xc1, 0x9b, 0xc1, 0x1e, 0xd4,
+		0x3f, 0x29, 0xdd, 0xae, 0xa0, 0xcf, 0x91, 0x35, 0x7f, 0x89, 0x32, 0x29, 0xdc, 0x7d, 0x36, 0x31,
+		0xda, 0x18, 0xe8, 0x5e, 0x4c, 0xf5, 0x8f, 0x0a, 0x9c, 0x4c, 0x6e, 0x82, 0xe9, 0x35, 0x00, 0xff,
+		0x30, 0x24, 0xfa, 0x45, 0x06, 0x4e, 0x49, 0xb4, 0x92, 0x79, 0x32, 0x99, 0x98, 0xe8, 0x56, 0xc5,
+		0x95, 0xda, 0xa4, 0x80, 0xf8, 0xb1, 0xc9, 0x14, 0x8e, 0x90, 0x06, 0x7a, 0x89, 0x90, 0xf6, 0xac,
+		0xe2, 0x5f, 0x55, 0x60, 0x4e, 0xbe, 0x03, 0x4c, 0xe6, 0xcc, 0xeb, 0xcf, 0x15, 0xec, 0x1d, 0x05,
+		0x52, 0xf6, 0x7a, 0x25, 0x63, 0x3b, 0x18, 0x84, 0x41, 0xbe, 0x87, 0xf1, 0x1f, 0xa4, 0x10, 0x67,
+		0x25, 0x10, 0xbf, 0x19, 0xd2, 0x43, 0x51, 0x55, 0xa8, 0x57, 0x3d, 0x5c, 0x85, 0x99, 0x8a, 0x4e,
+		0x3a, 0x7a, 0x1e, 0xc2, 0x1d, 0x00, 0xed, 0x95, 0xf5, 0xe9, 0x78, 0x5b, 0xe9, 0x87, 0x4d, 0x1c,
+		0x7d, 0xce, 0xa6, 0xd0, 0xe7, 0x81, 0x44, 0x1b, 0x0d, 0x05, 0x7a, 0xea, 0xbb, 0x0a, 0x1c, 0x8d,
+		0xe9, 0xb2, 0xf4, 0x7e, 0x85, 0xe2, 0x77, 0x97, 0xb5, 0xf6, 0x6d, 0x88, 0x3e, 0x97, 0x4c, 0xb4,
+		0x06, 0x87, 0x5a, 0x07, 0xf9, 0xa6, 0xe5, 0xa4, 0xb8, 0xb4, 0x22, 0x76, 0x8e, 0x7b, 0x5d, 0x94,
+		0x69, 0x8e, 0x5f, 0x99, 0xcd, 0xfe, 0x2c, 0x4c, 0x09, 0xdb, 0x37, 0xe3, 0xa4, 0x91, 0x8e, 0xd9,
+		0xd5, 0xdf, 0x2a, 0x30, 0x1d, 0xd7, 0xb9, 0xd7, 0x97, 0xaf, 0xf4, 0x6b, 0x3d, 0x62, 0x1d, 0xf4,
+		0x4f, 0x15, 0x98, 0x49, 0xea, 0x00, 0x8c, 0x93, 0xe6, 0xb1, 0x9a, 0x6d, 0x2c, 0xf2, 0xff, 0x0c,
+		0x41, 0xca, 0x46, 0x13, 0xb4, 0x08, 0x07, 0x69, 0x2f, 0x4b, 0x38, 0xed, 0xeb, 0xcb, 0x34, 0x5e,
+		0xc3, 0xcd, 0x50, 0xd2, 0x37, 0x52, 0x79, 0xc9, 0xf4, 0x56, 0x79, 0x79, 0x52, 0x1b, 0x91, 0xaf,
+		0x8d, 0xc8, 0xe8, 0xce, 0x90, 0x84, 0xee, 0xdc, 0x81, 0x09, 0x96, 0xd3, 0x66, 0x18, 0xad, 0x1a,
+		0xc1, 0xce, 0x8e, 0x5e, 0x49, 0xbe, 0xb7, 0x1c, 0x64, 0x13, 0x29, 0xbc, 0x12, 0x9b, 0xd6, 0x5d,
+		0x77, 0xc9, 0xef, 0xa9, 0xee, 0xd2, 0x11, 0xc2, 0x41, 0x9a, 0x10, 0x4e, 0x5c, 0x64, 0x29, 0xf4,
+		0x5c, 0x64, 0x69, 0xdf, 0x33, 0x46, 0xa4, 0xef, 0x19, 0xad, 0x54, 0xff, 0xbe, 0x3d, 0xa4, 0xfa,
+		0x47, 0xf7, 0x94, 0xea, 0xf7, 0x7c, 0xf0, 0x62, 0xda, 0x6e, 0xb7, 0x96, 0xb7, 0x52, 0x3a, 0xbd,
+		0x55, 0xdc, 0xfd, 0x66, 0x03, 0x0e, 0xb7, 0x2a, 0xe4, 0xa1, 0xaa, 0xa9, 0x6f, 0xc7, 0x73, 0xb1,
+		0x35, 0xf0, 0xee, 0xba, 0xe9, 0x21, 0xcc, 0x1b, 0x56, 0xbf, 0xaf, 0xc0, 0xac, 0x40, 0x12, 0x5e,
+		0x31, 0x38, 0xd9, 0x3c, 0x14, 0x09, 0xf3, 0xe8, 0x88, 0x74, 0x32, 0x29, 0x22, 0x1d, 0xf5, 0x7d,
+		0x05, 0x8e, 0xc5, 0x76, 0x6b, 0x7b, 0xa1, 0x1e, 0xeb, 0x05, 0xaf, 0xe9, 0xd5, 0x60, 0xa9, 0xc1,
+		0x1f, 0xba, 0xad, 0x57, 0x71, 0xaf, 0x9f, 0xee, 0xdb, 0xa9, 0xd2, 0xd6, 0xf8, 0x01, 0xf9, 0x9b,
+		0xf5, 0xd7, 0x79, 0x9b, 0x24, 0xea, 0x4e, 0x38, 0x01, 0x05, 0xd6, 0x1f, 0xd2, 0xb9, 0x04, 0xfe,
+		0x10, 0x5d, 0x82, 0x96, 0x53, 0xcf, 0xc8, 0x3b, 0xf5, 0x98, 0x3c, 0xb5, 0xfa, 0x35, 0x05, 0xe6,
+		0x52, 0x74, 0xe4, 0xb4, 0xf3, 0xa9, 0x4a, 0x57, 0x3e, 0xb5, 0xd7, 0x9d, 0x89, 0x83, 0xf6, 0xab,
+		0x0c, 0xbc, 0xb0, 0xb7, 0xae, 0xe4, 0xbe, 0xe9, 0x7c, 0x3b, 0x57, 0x97, 0xe9, 0xca, 0xd5, 0xdd,
+		0x07, 0x14, 0xed, 0x7e, 0x61, 0xf6, 0x7d, 0x46, 0xae, 0xc3, 0x55, 0x1b, 0x8f, 0xb4, 0xb0, 0x7a,
+		0xc9, 0x0f, 0xc3, 0xae, 0x11, 0xc7, 0xae, 0x50, 0x45, 0x1b, 0xd1, 0x82, 0x47, 0x54, 0x84, 0x03,
+		0xa1, 0x46, 0x2e, 0xbb, 0x56, 0xf1, 0x23, 0xf3, 0x61, 0x6d, 0xbc, 0xab, 0xbf, 0xea, 0x4e, 0xad,
+		0xb2, 0xab, 0xbe, 0x91, 0x85, 0x6b, 0x7b, 0xe8, 0x7a, 0x46, 0xf7, 0x3b, 0xfd, 0xde, 0xa8, 0xe0,
+		0x37, 0x05, 0x52, 0x9c, 0xbb, 0xd2, 0xce, 0x7d, 0xba, 0x4f, 0x0a, 0x73, 0xa8, 0xfc, 0x7d, 0x19,
+		0xd8, 0xeb, 0xbe, 0xcc, 0x03, 0x0a, 0xf7, 0x9a, 0xb1, 0x0a, 0x45, 0x56, 0x1b, 0xb3, 0xba, 0x94,
+		0xd0, 0x4f, 0x61, 0x05, 0xbb, 0x98, 0xeb, 0xda, 0x45, 0xf5, 0xcf, 0x0a, 0x5c, 0xe9, 0xb1, 0x65,
+		0x5b, 0x80, 0x41, 0x11, 0x60, 0xf8, 0x60, 0x15, 0x57, 0xfd, 0x72, 0x16, 0xae, 0xf4, 0xd8, 0x56,
+		0xf7, 0xff, 0x6a, 0xab, 0x21, 0x8f, 0x3d, 0x20, 0xf6, 0xd8, 0x83, 0xf2, 0x1e, 0x5b, 0xa8, 0x3a,
+		0x22, 0x07, 0x30, 0x24, 0x72, 0x00, 0xaf, 0x66, 0xe1, 0x52, 0x2f, 0xad, 0x81, 0x72, 0x96, 0x2f,
+		0xc5, 0xf9, 0x89, 0xe5, 0xb7, 0x2d, 0xff, 0x3d, 0x05, 0xce, 0xa7, 0x6d, 0x73, 0xfc, 0x9f, 0x36,
+		0x79, 0xf1, 0x59, 0xa5, 0xfe, 0x5e, 0x81, 0x85, 0x54, 0xad, 0x91, 0x7d, 0x73, 0x01, 0xdc, 0x5b,
+		0x43, 0x66, 0x6f, 0xb7, 0x86, 0xbf, 0x0e, 0xc3, 0xc5, 0x1e, 0x7e, 0xe3, 0xd1, 0xb1, 0x1d, 0x4a,
+		0xd7, 0x76, 0x9c, 0x80, 0x42, 0x6b, 0x3b, 0x98, 0xce, 0xe7, 0x35, 0x08, 0x86, 0x78, 0x29, 0x84,
+		0x6c, 0x1f, 0x52, 0x08, 0xbd, 0xd6, 0x13, 0x07, 0xfb, 0x9b, 0x42, 0xc8, 0x3d, 0xd6, 0x14, 0xc2,
+		0x50, 0xcf, 0x29, 0x84, 0x07, 0xc0, 0x3a, 0x54, 0x19, 0x47, 0x56, 0x86, 0xf3, 0x9b, 0x04, 0xce,
+		0xc4, 0xb4, 0xb9, 0x52, 0x2e, 0xac, 0x18, 0x37, 0x5e, 0x0f, 0x0f, 0x75, 0x1a, 0x49, 0xbe, 0xdb,
+		0x9f, 0xcb, 0xa8, 0x3c, 0x48, 0xa8, 0xbc, 0x01, 0x93, 0x1d, 0xea, 0x54, 0x76, 0x70, 0xa3, 0x0d,
+		0xbf, 0x40, 0xe1, 0xcf, 0xc5, 0x2a, 0x4e, 0xc9, 0xd4, 0x70, 0x23, 0xc0, 0xab, 0x1d, 0x6a, 0xf2,
+		0x86, 0x23, 0xe5, 0xc9, 0x7d, 0xbd, 0x94, 0x27, 0x23, 0xbd, 0x86, 0xa3, 0x9c, 0x5e, 0xc3, 0xf6,
+		0x4d, 0x6b, 0x7f, 0xfa, 0xdc, 0xc2, 0xd8, 0x1e, 0x72, 0x0b, 0xe3, 0x7b, 0x6b, 0x23, 0x7c, 0x0e,
+		0x0a, 0x26, 0xae, 0xe8, 0xbb, 0xbe, 0x6a, 0x26, 0xf7, 0x44, 0x02, 0xa5, 0xa6, 0xaa, 0xa8, 0xbe,
+		0x9e, 0x85, 0xf3, 0x69, 0x7f, 0x83, 0xf5, 0xe1, 0xbb, 0x97, 0xb5, 0x20, 0x4e, 0xf0, 0x2b, 0x5d,
+		0x97, 0x53, 0xff, 0x80, 0xa8, 0x2b, 0x3c, 0xe8, 0x30, 0x94, 0xc1, 0x6e, 0x43, 0xe1, 0x1f, 0x82,
+		0x39, 0xc1, 0x21, 0xd8, 0xa7, 0x5c, 0xa0, 0xfa, 0xbb, 0x0c, 0xcc, 0xa7, 0xf9, 0x81, 0x99, 0x70,
+		0x3f, 0xf8, 0xa7, 0x6f, 0x66, 0xaf, 0xa7, 0x6f, 0xbf, 0x76, 0x91, 0xbf, 0xba, 0x03, 0x82, 0xd5,
+		0x6d, 0x5b, 0xe7, 0xa0, 0x7c, 0x1e, 0xe4, 0xfd, 0x0c, 0xa4, 0xfc, 0xe9, 0xdb, 0x47, 0x63, 0x31,
+		0x79, 0x65, 0x9d, 0x41, 0x6e, 0x59, 0xa7, 0xdd, 0x8f, 0x90, 0x93, 0xef, 0x47, 0x50, 0xff, 0x95,
+		0x81, 0x73, 0xfd, 0xf0, 0x28, 0x1f, 0xd1, 0x45, 0xef, 0xc8, 0xb8, 0xe7, 0x52, 0x64, 0xdc, 0xd5,
+		0x7f, 0x67, 0x60, 0x21, 0xd5, 0x2f, 0x11, 0x9f, 0x2c, 0x7c, 0x64, 0xe1, 0x83, 0x94, 0x62, 0x2e,
+		0x4d, 0x9e, 0xf9, 0x0b, 0x59, 0xd1, 0xc2, 0x8b, 0x7a, 0x48, 0x9e, 0x2c, 0x7c, 0x6c, 0x0b, 0x4b,
+		0xae, 0x97, 0xde, 0xf7, 0x5f, 0x66, 0x60, 0x31, 0xe5, 0x2f, 0x44, 0x9f, 0xec, 0x43, 0xd7, 0x3e,
+		0xcc, 0x11, 0xd8, 0x4f, 0xff, 0x5c, 0xb5, 0x2a, 0x04, 0x3b, 0xf4, 0x53, 0xc7, 0x60, 0x6a, 0xe5,
+		0xc1, 0xca, 0xed, 0xf5, 0xf2, 0x6a, 0x69, 0x6d, 0x7d, 0x45, 0x2b, 0xaf, 0x7f, 0xfa, 0xee, 0x4a,
+		0xb9, 0x74, 0xfb, 0xc1, 0xf5, 0xb5, 0xd2, 0xcd, 0xb1, 0xa7, 0xd0, 0x09, 0x38, 0x1a, 0x7d, 0x7d,
+		0x7d, 0x6d, 0xad, 0x4c, 0x47, 0xc7, 0x14, 0x74, 0x12, 0x8e, 0x45, 0x09, 0x96, 0xd7, 0xee, 0xdc,
+		0x5b, 0x61, 0x24, 0x99, 0x1b, 0x2f, 0xc3, 0x61, 0xc3, 0xae, 0xf2, 0xd6, 0xe0, 0x46, 0xf0, 0x3f,
+		0x46, 0xef, 0x3a, 0x36, 0xb1, 0xef, 0x2a, 0x9f, 0xb9, 0xf0, 0xd0, 0x22, 0x5b, 0x8d, 0x8d, 0xa2,
+		0x61, 0x57, 0x17, 0x3b, 0xff, 0xd7, 0xe9, 0x82, 0x65, 0x56, 0x16, 0x1f, 0xda, 0xfe, 0xff, 0x57,
+		0x65, 0xff, 0xf8, 0xf4, 0x9a, 0x5e, 0xb7, 0x76, 0x2e, 0x6c, 0xe4, 0xe8, 0xd8, 0xc5, 0xff, 0x0e,
+		0x00, 0xe7, 0x75, 0x55, 0x1d, 0xdb, 0x55, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_workflow.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4f, 0x73, 0xdc, 0x48,
-		0x15, 0x2f, 0xf9, 0xbf, 0xdf, 0xd8, 0x8e, 0xd3, 0x89, 0xed, 0xc9, 0x24, 0x76, 0x1c, 0x65, 0x37,
-		0x98, 0xec, 0x66, 0x4c, 0x1c, 0x36, 0x09, 0x59, 0x60, 0xcb, 0x99, 0xc4, 0x59, 0x53, 0x9b, 0x2d,
-		0x23, 0x1b, 0x52, 0x70, 0x51, 0xb5, 0xa5, 0xe7, 0x99, 0xc6, 0x1a, 0x49, 0xee, 0x6e, 0xd9, 0x3b,
-		0xcb, 0x81, 0x82, 0xda, 0xe2, 0xc0, 0xbf, 0x82, 0x23, 0x27, 0x0e, 0x70, 0xe6, 0x63, 0x70, 0xa0,
-		0xb8, 0xf0, 0x11, 0xf8, 0x04, 0x7c, 0x83, 0x2d, 0x4a, 0xad, 0xd6, 0x8c, 0x67, 0xdc, 0xd2, 0xd8,
-		0xa1, 0xb6, 0x92, 0xda, 0xdb, 0xe8, 0xf5, 0xfb, 0xbd, 0xff, 0xdd, 0xfd, 0x5e, 0xd7, 0xc0, 0xdd,
-		0x64, 0x1f, 0xf9, 0xba, 0x47, 0x7d, 0x0c, 0x3d, 0x5c, 0xa7, 0x31, 0x5b, 0x3f, 0xbe, 0xbf, 0x2e,
-		0x90, 0x1f, 0x33, 0x0f, 0xdd, 0x93, 0x88, 0x1f, 0x1e, 0x04, 0xd1, 0x49, 0x3d, 0xe6, 0x91, 0x8c,
-		0xc8, 0x95, 0x94, 0xb7, 0xae, 0x79, 0xeb, 0x34, 0x66, 0xf5, 0xe3, 0xfb, 0xb5, 0x95, 0x66, 0x14,
-		0x35, 0x03, 0x5c, 0x57, 0x2c, 0xfb, 0xc9, 0xc1, 0xba, 0x9f, 0x70, 0x2a, 0x59, 0x14, 0x66, 0xa0,
-		0xda, 0xaa, 0x49, 0x81, 0x17, 0xb5, 0xdb, 0x5d, 0x8e, 0x5b, 0x26, 0x8e, 0x16, 0x13, 0x32, 0xe2,
-		0x1d, 0xcd, 0x72, 0xd3, 0xc4, 0x72, 0x94, 0x60, 0x97, 0xc1, 0x36, 0x31, 0x48, 0x2a, 0x0e, 0x03,
-		0x26, 0x64, 0x19, 0x4f, 0xbf, 0x8b, 0xf6, 0x3f, 0x27, 0x61, 0x79, 0x57, 0x52, 0x2e, 0x5f, 0x69,
-		0xfa, 0xf3, 0xcf, 0xd0, 0x4b, 0x52, 0x77, 0x1c, 0x3c, 0x4a, 0x50, 0x48, 0xb2, 0x08, 0x13, 0x7e,
-		0xd4, 0xa6, 0x2c, 0xac, 0x5a, 0xab, 0xd6, 0xda, 0xb4, 0xa3, 0xbf, 0xc8, 0x4d, 0xa8, 0xe4, 0xb2,
-		0x5c, 0xe6, 0x57, 0x47, 0xd4, 0x22, 0xe4, 0xa4, 0x6d, 0x9f, 0x6c, 0xc1, 0x6c, 0x97, 0x41, 0x76,
-		0x62, 0xac, 0x8e, 0xae, 0x5a, 0x6b, 0x95, 0x8d, 0x5b, 0x75, 0x43, 0x54, 0xeb, 0xb9, 0xfa, 0xbd,
-		0x4e, 0x8c, 0xce, 0xcc, 0xc9, 0xa9, 0x2f, 0xf2, 0x04, 0xa6, 0x53, 0xc7, 0xdc, 0xd4, 0xb3, 0xea,
-		0x98, 0x92, 0xb1, 0x6c, 0x94, 0xb1, 0x47, 0xc5, 0xe1, 0x27, 0x4c, 0x48, 0x67, 0x4a, 0xea, 0x5f,
-		0x64, 0x03, 0xc6, 0x59, 0x18, 0x27, 0xb2, 0x3a, 0xae, 0x70, 0x37, 0x8c, 0xb8, 0x1d, 0xda, 0x09,
-		0x22, 0xea, 0x3b, 0x19, 0x2b, 0xa1, 0xb0, 0x8a, 0x79, 0x10, 0x5c, 0x91, 0xc6, 0xc6, 0x95, 0x91,
-		0xeb, 0x05, 0x91, 0x40, 0x57, 0xb2, 0x36, 0x46, 0x89, 0xac, 0x4e, 0x28, 0x71, 0xd7, 0xea, 0x59,
-		0x2d, 0xd4, 0xf3, 0x5a, 0xa8, 0x3f, 0xd3, 0xb5, 0xe0, 0xdc, 0xe8, 0x8a, 0x50, 0xd1, 0xdd, 0x8b,
-		0x1a, 0x29, 0x7e, 0x2f, 0x83, 0x93, 0x57, 0x70, 0x5d, 0xb9, 0x54, 0x20, 0x7d, 0x72, 0x98, 0xf4,
-		0xa5, 0x14, 0x6d, 0x12, 0x5c, 0x83, 0x29, 0xe6, 0x63, 0x28, 0x99, 0xec, 0x54, 0xa7, 0x54, 0x46,
-		0xba, 0xdf, 0x64, 0x19, 0x80, 0x67, 0x39, 0x4d, 0xf3, 0x35, 0xad, 0x56, 0xa7, 0x35, 0x65, 0xdb,
-		0x27, 0x1e, 0x54, 0x4f, 0xe5, 0xd3, 0xe5, 0x98, 0x08, 0x74, 0xe3, 0x28, 0x60, 0x5e, 0xa7, 0x0a,
-		0xab, 0xd6, 0xda, 0xdc, 0xc6, 0xdd, 0xd2, 0xcc, 0x6d, 0xfb, 0x4e, 0x0a, 0xd9, 0x51, 0x08, 0x67,
-		0xe1, 0xc4, 0x44, 0x26, 0x0d, 0x98, 0xe1, 0x28, 0x79, 0x27, 0x17, 0x5c, 0x51, 0x9e, 0xae, 0x1a,
-		0x05, 0x3b, 0x29, 0xa3, 0x16, 0x57, 0xe1, 0xbd, 0x0f, 0x72, 0x1b, 0x66, 0x3d, 0x9e, 0xe6, 0xc6,
-		0x6b, 0xa1, 0x9f, 0x04, 0x58, 0x9d, 0x51, 0xbe, 0xcc, 0xa4, 0xc4, 0x5d, 0x4d, 0x23, 0xf7, 0x60,
-		0xac, 0x8d, 0xed, 0xa8, 0x3a, 0xab, 0x63, 0x69, 0xd2, 0xf0, 0x12, 0xdb, 0x91, 0xa3, 0xd8, 0x88,
-		0x03, 0x97, 0x05, 0x52, 0xee, 0xb5, 0x5c, 0x2a, 0x25, 0x67, 0xfb, 0x89, 0x44, 0x51, 0x9d, 0x53,
-		0xd8, 0x77, 0x8d, 0xd8, 0x5d, 0xc5, 0xbd, 0xd9, 0x65, 0x76, 0xe6, 0xc5, 0x00, 0x85, 0x3c, 0x80,
-		0x89, 0x16, 0x52, 0x1f, 0x79, 0xf5, 0x92, 0x12, 0x74, 0xdd, 0x28, 0xe8, 0x63, 0xc5, 0xe2, 0x68,
-		0x56, 0xf2, 0x04, 0x2a, 0x3e, 0x06, 0xb4, 0x93, 0xd5, 0x46, 0x75, 0x7e, 0x58, 0x29, 0x80, 0xe2,
-		0x56, 0xb5, 0x60, 0x3f, 0x82, 0x95, 0xa2, 0xbd, 0x2c, 0xe2, 0x28, 0x14, 0x48, 0x16, 0x60, 0x82,
-		0x27, 0x61, 0x9a, 0xff, 0x6c, 0x33, 0x8f, 0xf3, 0x24, 0xdc, 0xf6, 0xed, 0x7f, 0x8c, 0xc0, 0xca,
-		0x2e, 0x6b, 0x86, 0x34, 0xb8, 0xf0, 0x31, 0xf0, 0x23, 0x20, 0xdd, 0xb2, 0xe9, 0xd6, 0xbc, 0x3a,
-		0x0d, 0x2a, 0x1b, 0x77, 0x4a, 0x0b, 0xa6, 0xa7, 0xe2, 0xf2, 0xc9, 0x20, 0xa9, 0xaf, 0x90, 0x47,
-		0x4b, 0x0b, 0x79, 0x6c, 0xb0, 0x90, 0x6f, 0x42, 0x45, 0x28, 0x5f, 0xdc, 0x90, 0xb6, 0x51, 0xed,
-		0xfc, 0x69, 0x07, 0x32, 0xd2, 0xa7, 0xb4, 0x8d, 0xe4, 0x23, 0x98, 0xd1, 0x0c, 0xd9, 0xd9, 0x30,
-		0x71, 0x8e, 0xb3, 0x41, 0x8b, 0xdc, 0x56, 0x27, 0x44, 0x15, 0x26, 0xbd, 0x28, 0x94, 0x3c, 0x0a,
-		0xd4, 0x56, 0x9d, 0x71, 0xf2, 0x4f, 0xfb, 0x16, 0xdc, 0x2c, 0x8c, 0x63, 0x96, 0x02, 0xfb, 0x4b,
-		0x0b, 0xbe, 0xa1, 0x79, 0x98, 0x6c, 0x95, 0x9f, 0xbd, 0xaf, 0x60, 0x36, 0x3b, 0x22, 0xb4, 0x77,
-		0x2a, 0xf6, 0x95, 0x8d, 0x0d, 0x73, 0x45, 0x96, 0x89, 0x72, 0x66, 0x94, 0xa0, 0x5c, 0xf0, 0x40,
-		0x8c, 0x46, 0x86, 0xc6, 0x68, 0xf4, 0xff, 0x88, 0xd1, 0x58, 0x7f, 0x8c, 0x36, 0x61, 0x6d, 0xb8,
-		0xff, 0xe5, 0xf5, 0xfa, 0xf7, 0x11, 0x58, 0x76, 0x50, 0xa0, 0x7c, 0x5b, 0xca, 0x75, 0x11, 0x26,
-		0x38, 0x52, 0x11, 0x85, 0xba, 0x58, 0xf5, 0x17, 0x79, 0x04, 0x55, 0x1f, 0x3d, 0x26, 0xd2, 0xab,
-		0xe4, 0x80, 0x85, 0x4c, 0xb4, 0x5c, 0x3c, 0xc6, 0xb0, 0x5b, 0xb8, 0xa3, 0xce, 0x42, 0xbe, 0xbe,
-		0xa5, 0x96, 0x9f, 0xa7, 0xab, 0xdb, 0xfe, 0x40, 0x8d, 0x8f, 0x0f, 0xd6, 0x78, 0x1d, 0xae, 0x88,
-		0x43, 0x16, 0xbb, 0x3a, 0x47, 0x1c, 0x69, 0x1c, 0x07, 0x1d, 0x55, 0xc9, 0x53, 0xce, 0xe5, 0x74,
-		0x29, 0x0b, 0xb1, 0x93, 0x2d, 0xa4, 0x27, 0x43, 0x51, 0xbc, 0xca, 0x23, 0xfd, 0x6f, 0x0b, 0xde,
-		0xd5, 0x31, 0x6d, 0xd0, 0xd0, 0xc3, 0xaf, 0xc1, 0x01, 0x61, 0xaf, 0xc1, 0x9d, 0x61, 0x2e, 0xf5,
-		0xf6, 0xea, 0xad, 0x3d, 0xe4, 0x6d, 0x16, 0x52, 0x89, 0x6f, 0x7b, 0xad, 0x3d, 0x84, 0x49, 0x1f,
-		0x25, 0x65, 0x81, 0xd0, 0x5d, 0x52, 0xf9, 0x6e, 0xcd, 0x99, 0xfb, 0x22, 0x39, 0xde, 0x1f, 0x49,
-		0xfb, 0x1d, 0xb0, 0xcb, 0xfc, 0xd7, 0x61, 0xfa, 0x93, 0x05, 0xab, 0xcf, 0x50, 0x78, 0x9c, 0xed,
-		0xbf, 0x2d, 0x51, 0xb2, 0xbf, 0x1c, 0x85, 0x5b, 0x25, 0x36, 0xe9, 0xaa, 0x0f, 0x60, 0xa9, 0xd7,
-		0xeb, 0x79, 0x51, 0x78, 0xc0, 0x9a, 0xfa, 0x62, 0xd5, 0x47, 0xed, 0x83, 0xf3, 0x59, 0xd0, 0x38,
-		0x0d, 0x75, 0x16, 0xd1, 0x48, 0x27, 0xfb, 0xb0, 0x74, 0xd6, 0x55, 0x97, 0x85, 0x07, 0x91, 0xf6,
-		0xf7, 0xee, 0xf9, 0xb4, 0x6d, 0x87, 0x07, 0x51, 0xaf, 0xc3, 0xea, 0x23, 0x93, 0x57, 0x40, 0x62,
-		0x0c, 0x7d, 0x16, 0x36, 0x5d, 0xea, 0x49, 0x76, 0xcc, 0x24, 0x43, 0x51, 0x1d, 0x5d, 0x1d, 0x5d,
-		0xab, 0x6c, 0xac, 0x99, 0x0b, 0x22, 0x63, 0xdf, 0xcc, 0xb8, 0x3b, 0x4a, 0xf8, 0xe5, 0xb8, 0x8f,
-		0xc8, 0x50, 0x90, 0x9f, 0xc0, 0x7c, 0x2e, 0xd8, 0x6b, 0xb1, 0xc0, 0xe7, 0x18, 0x56, 0xc7, 0x94,
-		0xd8, 0x7a, 0x99, 0xd8, 0x46, 0xca, 0xdb, 0x6f, 0xf9, 0xa5, 0xf8, 0xd4, 0x12, 0xc7, 0x90, 0xec,
-		0xf6, 0x44, 0xe7, 0xa7, 0xa1, 0x6e, 0xd8, 0x4b, 0x2d, 0x7e, 0xa6, 0x79, 0xfb, 0x84, 0xe6, 0x44,
-		0xfb, 0x8b, 0x51, 0xb8, 0xfa, 0xc3, 0x74, 0x62, 0xca, 0xc3, 0xf7, 0x86, 0xb6, 0xeb, 0x63, 0x18,
-		0x57, 0x83, 0x9b, 0xbe, 0x42, 0xed, 0x52, 0x49, 0xca, 0x60, 0x27, 0x03, 0x10, 0x17, 0x16, 0xd5,
-		0x0f, 0x97, 0xe3, 0xcf, 0xd0, 0x93, 0x69, 0x7d, 0xfa, 0x4c, 0x19, 0x35, 0xa6, 0xfa, 0xf1, 0x6f,
-		0x1a, 0x45, 0x65, 0x22, 0x14, 0xa2, 0x91, 0x03, 0x9c, 0xab, 0x47, 0x06, 0x6a, 0x5a, 0x8f, 0x99,
-		0x02, 0x2f, 0x0a, 0x05, 0x13, 0x12, 0x43, 0xaf, 0xe3, 0x06, 0x78, 0x8c, 0x81, 0x0a, 0x7f, 0x51,
-		0xc7, 0xaf, 0x34, 0x34, 0x7a, 0x90, 0x4f, 0x52, 0x84, 0xb3, 0x70, 0x64, 0x22, 0xdb, 0x7f, 0xb5,
-		0x60, 0x61, 0x20, 0x0d, 0x7a, 0xef, 0x7d, 0x04, 0x33, 0xb9, 0x7b, 0x22, 0x09, 0xf2, 0xde, 0x66,
-		0x48, 0x8b, 0xa1, 0xfd, 0x48, 0x01, 0x64, 0x1b, 0xe6, 0x4e, 0xc7, 0x07, 0x7d, 0x9d, 0x2c, 0x7b,
-		0x58, 0x5c, 0xd0, 0x77, 0x66, 0x8f, 0x4e, 0x7f, 0xda, 0xff, 0xb5, 0x60, 0x29, 0x3f, 0x2d, 0xba,
-		0x63, 0xe4, 0x90, 0x7a, 0xe9, 0x9b, 0x4b, 0x47, 0x2e, 0x36, 0x97, 0xbe, 0x80, 0xb9, 0x2e, 0xb6,
-		0x37, 0x1c, 0xcf, 0x15, 0x0c, 0xc7, 0xb9, 0x80, 0x6c, 0x38, 0x96, 0xa7, 0xbe, 0xd2, 0x06, 0x83,
-		0x85, 0x5e, 0x90, 0xf8, 0xe8, 0xf6, 0x04, 0x0a, 0x49, 0x65, 0x92, 0xdd, 0x02, 0x53, 0xce, 0x82,
-		0x5e, 0xcf, 0x85, 0xec, 0xaa, 0x45, 0xfb, 0x6f, 0x16, 0x54, 0xcf, 0x7a, 0xac, 0x53, 0xf3, 0x1d,
-		0x98, 0x8c, 0xa3, 0x20, 0x40, 0x2e, 0xaa, 0x96, 0xda, 0xe2, 0x37, 0xcd, 0x59, 0x51, 0x3c, 0x6a,
-		0xfb, 0xe5, 0xfc, 0xe4, 0x25, 0xcc, 0x9f, 0x31, 0x24, 0x0b, 0xce, 0xed, 0x52, 0xdf, 0x32, 0xb3,
-		0x9c, 0x39, 0xd9, 0x6f, 0xe6, 0x07, 0x70, 0xfd, 0x05, 0xca, 0x9c, 0x49, 0x3c, 0xed, 0x3c, 0x53,
-		0xc1, 0x1f, 0x92, 0x1b, 0xfb, 0x0f, 0x63, 0x70, 0xc3, 0x8c, 0xd3, 0x1e, 0xfe, 0x02, 0x16, 0xbb,
-		0x8d, 0x59, 0xcf, 0xde, 0x36, 0x8d, 0xb5, 0xc3, 0x3f, 0x30, 0x1a, 0x5b, 0x26, 0xb2, 0x9e, 0x9f,
-		0x3c, 0x39, 0xc7, 0x4b, 0x1a, 0x3f, 0x0f, 0x25, 0xef, 0x38, 0x57, 0xfc, 0xb3, 0x2b, 0xa9, 0x01,
-		0xfa, 0x7c, 0xee, 0x0c, 0x18, 0x30, 0xf2, 0xba, 0x06, 0xe4, 0x27, 0xf8, 0x59, 0x03, 0xe8, 0xd9,
-		0x95, 0x5a, 0x92, 0xe6, 0xdf, 0x6c, 0x31, 0x99, 0x87, 0xd1, 0x43, 0xec, 0xe8, 0x98, 0xa6, 0x3f,
-		0x49, 0x03, 0xc6, 0x8f, 0x69, 0x90, 0xa0, 0xce, 0xe5, 0x3d, 0xa3, 0x75, 0x45, 0xf5, 0xe4, 0x64,
-		0xd8, 0x27, 0x23, 0x8f, 0xad, 0x54, 0x6d, 0x91, 0x9d, 0x5f, 0xa1, 0x5a, 0x5b, 0xc0, 0xb2, 0xda,
-		0x33, 0x9a, 0x65, 0x87, 0x72, 0xa9, 0xce, 0x40, 0xf1, 0x15, 0xee, 0x72, 0xfb, 0xd7, 0x23, 0xb0,
-		0x52, 0xa4, 0x55, 0xd7, 0xe1, 0x11, 0x2c, 0x1b, 0xca, 0x20, 0xee, 0x32, 0xea, 0x72, 0xac, 0x97,
-		0xaa, 0xec, 0xca, 0x7d, 0x89, 0x92, 0xfa, 0x54, 0x52, 0xa7, 0x36, 0x98, 0xf1, 0x9e, 0xea, 0x54,
-		0xa5, 0xa1, 0xf4, 0x4f, 0xa9, 0x1c, 0x79, 0x3d, 0x95, 0x83, 0x55, 0xde, 0x53, 0x69, 0x2f, 0xc1,
-		0xc2, 0x0b, 0x94, 0x8d, 0x20, 0x11, 0x52, 0x9f, 0x17, 0x59, 0xd4, 0xed, 0x5f, 0x59, 0xb0, 0x38,
-		0xb8, 0xa2, 0x23, 0xd3, 0x82, 0x6b, 0x22, 0x89, 0xe3, 0x88, 0x4b, 0xf4, 0x5d, 0x2f, 0x60, 0xe9,
-		0xd4, 0x74, 0x8c, 0x5c, 0xe8, 0xa8, 0xa4, 0x89, 0x78, 0xdf, 0x3c, 0x07, 0xe7, 0xa8, 0x86, 0x02,
-		0xfd, 0x58, 0x63, 0x9c, 0x25, 0x61, 0x5e, 0xb0, 0x7f, 0x3b, 0x0a, 0xf6, 0x0b, 0xc3, 0x6c, 0xf4,
-		0x71, 0xf6, 0x24, 0xfb, 0x86, 0xfa, 0x86, 0xeb, 0x30, 0x1d, 0xd3, 0x26, 0xba, 0x82, 0x7d, 0x9e,
-		0xdd, 0x0e, 0xe3, 0xce, 0x54, 0x4a, 0xd8, 0x65, 0x9f, 0x23, 0xb9, 0x03, 0x97, 0x42, 0xfc, 0x2c,
-		0xcd, 0x5a, 0x13, 0x5d, 0x19, 0x1d, 0x62, 0xa8, 0xa7, 0xec, 0xd9, 0x94, 0xbc, 0x43, 0x9b, 0xb8,
-		0x97, 0x12, 0xc9, 0x7b, 0x40, 0x4e, 0x28, 0x93, 0xee, 0x41, 0xc4, 0xdd, 0x10, 0x4f, 0xb2, 0xe1,
-		0x53, 0x5d, 0xee, 0x53, 0xce, 0xa5, 0x74, 0x65, 0x2b, 0xe2, 0x9f, 0xe2, 0x89, 0x9a, 0x3a, 0x89,
-		0x0b, 0xd7, 0xf4, 0x2b, 0xb4, 0x1e, 0x52, 0x0f, 0x58, 0x20, 0x91, 0x67, 0xf7, 0xd3, 0x84, 0xba,
-		0x9f, 0xde, 0x31, 0xfa, 0xa3, 0xe0, 0x5b, 0x8a, 0x59, 0x5d, 0x51, 0x8b, 0x5a, 0xcc, 0x00, 0x9d,
-		0xdc, 0x86, 0x59, 0x35, 0xb5, 0x52, 0xee, 0xb5, 0xd8, 0x31, 0xcd, 0x5e, 0x4f, 0xa6, 0x9c, 0x99,
-		0x94, 0xb8, 0xa9, 0x69, 0xf6, 0x7f, 0x2c, 0xb8, 0x5d, 0x9a, 0x0d, 0x5d, 0x1f, 0x0f, 0x61, 0x52,
-		0xab, 0x29, 0xed, 0x1c, 0x72, 0x58, 0xce, 0x4c, 0xbe, 0x0f, 0x15, 0x4e, 0x4f, 0xdc, 0x1c, 0x9b,
-		0x15, 0xbb, 0x79, 0x4b, 0x3f, 0xa3, 0x92, 0x3e, 0x0d, 0xa2, 0x7d, 0x07, 0x38, 0x3d, 0xd1, 0x82,
-		0x4c, 0xa1, 0x1f, 0x35, 0x85, 0xbe, 0x06, 0x53, 0x99, 0x9f, 0xe8, 0xeb, 0x9b, 0xb8, 0xfb, 0x6d,
-		0x77, 0x60, 0x66, 0x0b, 0xa9, 0x4c, 0x38, 0x6e, 0x05, 0xb4, 0x29, 0x08, 0x83, 0x0d, 0xc3, 0x60,
-		0x40, 0x03, 0x8e, 0xd4, 0x4f, 0xbb, 0xb3, 0x76, 0x1c, 0x60, 0xba, 0x0d, 0x90, 0xf3, 0x88, 0xbb,
-		0x18, 0xd2, 0xfd, 0x00, 0xb3, 0x41, 0x7d, 0xca, 0xb9, 0x77, 0xa6, 0x74, 0x36, 0x33, 0x5c, 0x23,
-		0x87, 0x3d, 0x4f, 0x51, 0xcf, 0x33, 0x90, 0xfd, 0x3b, 0x0b, 0xae, 0x3b, 0x78, 0xc0, 0x51, 0xb4,
-		0xba, 0x6f, 0xee, 0x54, 0x1c, 0x8a, 0x37, 0x34, 0xa6, 0xad, 0xc0, 0x0d, 0xb3, 0x35, 0x59, 0x96,
-		0x37, 0xfe, 0x35, 0x07, 0x95, 0x7c, 0x65, 0x73, 0x67, 0x9b, 0xfc, 0xd2, 0x82, 0x45, 0xf3, 0x9b,
-		0x11, 0x79, 0x8d, 0x57, 0xb1, 0xda, 0x83, 0x0b, 0x61, 0x74, 0xe5, 0x7d, 0x61, 0xc1, 0x52, 0xc1,
-		0x2b, 0x1f, 0x29, 0x10, 0x58, 0xfa, 0xb6, 0x5a, 0xfb, 0xf6, 0xc5, 0x40, 0xda, 0x8c, 0xbf, 0x58,
-		0xb0, 0x3a, 0xec, 0x21, 0x8d, 0x7c, 0xb7, 0x4c, 0xf4, 0xb0, 0xf7, 0xc7, 0xda, 0xf7, 0x5e, 0x13,
-		0xad, 0x2d, 0x4c, 0x93, 0x65, 0x7e, 0x76, 0x2a, 0x48, 0x56, 0xe9, 0x9b, 0x5e, 0x41, 0xb2, 0x86,
-		0xbc, 0x6b, 0xfd, 0xd9, 0x82, 0x95, 0xf2, 0xd7, 0x1e, 0xf2, 0xa4, 0x40, 0xee, 0x39, 0x5e, 0xbd,
-		0x6a, 0x1f, 0xbe, 0x16, 0x56, 0xdb, 0xf6, 0x7b, 0x0b, 0x6a, 0xc5, 0xcf, 0x2b, 0xe4, 0xa1, 0xf9,
-		0x06, 0x1e, 0xf6, 0x1e, 0x55, 0x7b, 0x74, 0x61, 0x9c, 0xb6, 0xe7, 0x37, 0x16, 0x5c, 0x2b, 0x7c,
-		0x33, 0x21, 0x1f, 0x94, 0x36, 0x5f, 0x85, 0xd6, 0x3c, 0xbc, 0x28, 0x4c, 0x1b, 0x73, 0x00, 0xb3,
-		0x7d, 0x73, 0x23, 0x29, 0x19, 0x77, 0x07, 0x46, 0xfc, 0xda, 0xdd, 0xf3, 0xb0, 0x6a, 0x3d, 0x11,
-		0xcc, 0x0f, 0x36, 0x90, 0xe4, 0xfd, 0x73, 0xf6, 0x99, 0x99, 0xb6, 0x8b, 0x75, 0xa5, 0xe4, 0xe7,
-		0x70, 0xd5, 0xd4, 0xc6, 0x93, 0x6f, 0x5d, 0xa0, 0xe3, 0xcf, 0x14, 0xdf, 0xbf, 0xf0, 0x8c, 0xa0,
-		0xb6, 0xa4, 0xb9, 0x25, 0x2d, 0xd8, 0x92, 0xa5, 0x5d, 0x73, 0xc1, 0x96, 0x1c, 0xd2, 0xf3, 0x32,
-		0x98, 0xeb, 0xef, 0xf9, 0xc8, 0xdd, 0x22, 0x47, 0xce, 0xb6, 0x8c, 0xb5, 0xf7, 0xce, 0xc5, 0xab,
-		0x55, 0xfd, 0xd1, 0x52, 0xf3, 0x63, 0x51, 0x33, 0x41, 0x1e, 0x15, 0x09, 0x1b, 0xd2, 0x0c, 0xd6,
-		0x1e, 0x5f, 0x1c, 0xd8, 0x4b, 0xbf, 0xe9, 0xc6, 0x2b, 0x48, 0x7f, 0xc9, 0x55, 0x5d, 0x90, 0xfe,
-		0xb2, 0xeb, 0xf4, 0xa9, 0x0f, 0x4b, 0x5e, 0xd4, 0x36, 0xe1, 0x9e, 0x5e, 0xcd, 0x11, 0xbb, 0xd9,
-		0x9f, 0x21, 0x76, 0x78, 0x24, 0xa3, 0x1d, 0xeb, 0xa7, 0xf7, 0x9b, 0x4c, 0xb6, 0x92, 0xfd, 0xba,
-		0x17, 0xb5, 0xd7, 0x4f, 0xff, 0xa1, 0xe0, 0x1e, 0xf3, 0x83, 0xf5, 0x66, 0x94, 0xfd, 0x0f, 0x42,
-		0xff, 0xbb, 0xe0, 0x43, 0x1a, 0xb3, 0xe3, 0xfb, 0xfb, 0x13, 0x8a, 0xf6, 0xe0, 0x7f, 0x01, 0x00,
-		0x00, 0xff, 0xff, 0x0b, 0xbf, 0x5b, 0x89, 0x6c, 0x21, 0x00, 0x00,
+		0x15, 0x2f, 0x79, 0xfc, 0xf7, 0x8d, 0xed, 0x38, 0x9d, 0xd8, 0x56, 0x26, 0xb1, 0x63, 0x2b, 0xbb,
+		0xc1, 0x64, 0x37, 0x63, 0xec, 0xb0, 0x49, 0xc8, 0x02, 0x5b, 0xce, 0x24, 0xce, 0x9a, 0xda, 0x6c,
+		0x19, 0xd9, 0x90, 0x82, 0x8b, 0xaa, 0x2d, 0x3d, 0x8f, 0x1b, 0x6b, 0x24, 0xb9, 0xd5, 0xb2, 0x77,
+		0x96, 0x03, 0x05, 0xb5, 0xc5, 0x81, 0x7f, 0x05, 0x47, 0x4e, 0x1c, 0xe0, 0xcc, 0xc7, 0xe0, 0x40,
+		0x71, 0xe1, 0x23, 0xf0, 0x09, 0xf8, 0x06, 0x5b, 0x54, 0xb7, 0x5a, 0x33, 0x9e, 0xb1, 0xa4, 0xf1,
+		0x84, 0xda, 0x4a, 0x6a, 0x6f, 0xa3, 0xd7, 0xef, 0xf7, 0xfe, 0x77, 0xf7, 0x7b, 0x5d, 0x03, 0xf7,
+		0x92, 0x03, 0xe4, 0xeb, 0x2e, 0xf5, 0x30, 0x70, 0x71, 0x9d, 0x46, 0x6c, 0xfd, 0x74, 0x63, 0x3d,
+		0x46, 0x7e, 0xca, 0x5c, 0x74, 0xce, 0x42, 0x7e, 0x7c, 0xe8, 0x87, 0x67, 0xf5, 0x88, 0x87, 0x22,
+		0x24, 0xd7, 0x24, 0x6f, 0x5d, 0xf3, 0xd6, 0x69, 0xc4, 0xea, 0xa7, 0x1b, 0xb5, 0xe5, 0x66, 0x18,
+		0x36, 0x7d, 0x5c, 0x57, 0x2c, 0x07, 0xc9, 0xe1, 0xba, 0x97, 0x70, 0x2a, 0x58, 0x18, 0xa4, 0xa0,
+		0xda, 0x4a, 0x9e, 0x02, 0x37, 0x6c, 0xb5, 0x3a, 0x1c, 0xab, 0x79, 0x1c, 0x47, 0x2c, 0x16, 0x21,
+		0x6f, 0x6b, 0x96, 0xdb, 0x79, 0x2c, 0x27, 0x09, 0x76, 0x18, 0xac, 0x3c, 0x06, 0x41, 0xe3, 0x63,
+		0x9f, 0xc5, 0xa2, 0x8c, 0xa7, 0xd7, 0x45, 0xeb, 0x9f, 0x13, 0xb0, 0xb4, 0x27, 0x28, 0x17, 0xaf,
+		0x34, 0xfd, 0xf9, 0x67, 0xe8, 0x26, 0xd2, 0x1d, 0x1b, 0x4f, 0x12, 0x8c, 0x05, 0x59, 0x80, 0x71,
+		0x2f, 0x6c, 0x51, 0x16, 0x98, 0xc6, 0x8a, 0xb1, 0x36, 0x65, 0xeb, 0x2f, 0x72, 0x1b, 0xaa, 0x99,
+		0x2c, 0x87, 0x79, 0xe6, 0x88, 0x5a, 0x84, 0x8c, 0xb4, 0xe3, 0x91, 0x6d, 0x98, 0xe9, 0x30, 0x88,
+		0x76, 0x84, 0x66, 0x65, 0xc5, 0x58, 0xab, 0x6e, 0xae, 0xd6, 0x73, 0xa2, 0x5a, 0xcf, 0xd4, 0xef,
+		0xb7, 0x23, 0xb4, 0xa7, 0xcf, 0xce, 0x7d, 0x91, 0x27, 0x30, 0x25, 0x1d, 0x73, 0xa4, 0x67, 0xe6,
+		0xa8, 0x92, 0xb1, 0x94, 0x2b, 0x63, 0x9f, 0xc6, 0xc7, 0x9f, 0xb0, 0x58, 0xd8, 0x93, 0x42, 0xff,
+		0x22, 0x9b, 0x30, 0xc6, 0x82, 0x28, 0x11, 0xe6, 0x98, 0xc2, 0xdd, 0xca, 0xc5, 0xed, 0xd2, 0xb6,
+		0x1f, 0x52, 0xcf, 0x4e, 0x59, 0x09, 0x85, 0x15, 0xcc, 0x82, 0xe0, 0xc4, 0x32, 0x36, 0x8e, 0x08,
+		0x1d, 0xd7, 0x0f, 0x63, 0x74, 0x04, 0x6b, 0x61, 0x98, 0x08, 0x73, 0x5c, 0x89, 0xbb, 0x51, 0x4f,
+		0x6b, 0xa1, 0x9e, 0xd5, 0x42, 0xfd, 0x99, 0xae, 0x05, 0xfb, 0x56, 0x47, 0x84, 0x8a, 0xee, 0x7e,
+		0xd8, 0x90, 0xf8, 0xfd, 0x14, 0x4e, 0x5e, 0xc1, 0x4d, 0xe5, 0x52, 0x81, 0xf4, 0x89, 0x41, 0xd2,
+		0x17, 0x25, 0x3a, 0x4f, 0x70, 0x0d, 0x26, 0x99, 0x87, 0x81, 0x60, 0xa2, 0x6d, 0x4e, 0xaa, 0x8c,
+		0x74, 0xbe, 0xc9, 0x12, 0x00, 0x4f, 0x73, 0x2a, 0xf3, 0x35, 0xa5, 0x56, 0xa7, 0x34, 0x65, 0xc7,
+		0x23, 0x2e, 0x98, 0xe7, 0xf2, 0xe9, 0x70, 0x4c, 0x62, 0x74, 0xa2, 0xd0, 0x67, 0x6e, 0xdb, 0x84,
+		0x15, 0x63, 0x6d, 0x76, 0xf3, 0x5e, 0x69, 0xe6, 0x76, 0x3c, 0x5b, 0x42, 0x76, 0x15, 0xc2, 0x9e,
+		0x3f, 0xcb, 0x23, 0x93, 0x06, 0x4c, 0x73, 0x14, 0xbc, 0x9d, 0x09, 0xae, 0x2a, 0x4f, 0x57, 0x72,
+		0x05, 0xdb, 0x92, 0x51, 0x8b, 0xab, 0xf2, 0xee, 0x07, 0xb9, 0x03, 0x33, 0x2e, 0x97, 0xb9, 0x71,
+		0x8f, 0xd0, 0x4b, 0x7c, 0x34, 0xa7, 0x95, 0x2f, 0xd3, 0x92, 0xb8, 0xa7, 0x69, 0xe4, 0x3e, 0x8c,
+		0xb6, 0xb0, 0x15, 0x9a, 0x33, 0x3a, 0x96, 0x79, 0x1a, 0x5e, 0x62, 0x2b, 0xb4, 0x15, 0x1b, 0xb1,
+		0xe1, 0x6a, 0x8c, 0x94, 0xbb, 0x47, 0x0e, 0x15, 0x82, 0xb3, 0x83, 0x44, 0x60, 0x6c, 0xce, 0x2a,
+		0xec, 0xbb, 0xb9, 0xd8, 0x3d, 0xc5, 0xbd, 0xd5, 0x61, 0xb6, 0xe7, 0xe2, 0x3e, 0x0a, 0x79, 0x00,
+		0xe3, 0x47, 0x48, 0x3d, 0xe4, 0xe6, 0x15, 0x25, 0xe8, 0x66, 0xae, 0xa0, 0x8f, 0x15, 0x8b, 0xad,
+		0x59, 0xc9, 0x13, 0xa8, 0x7a, 0xe8, 0xd3, 0x76, 0x5a, 0x1b, 0xe6, 0xdc, 0xa0, 0x52, 0x00, 0xc5,
+		0xad, 0x6a, 0xc1, 0x7a, 0x04, 0xcb, 0x45, 0x7b, 0x39, 0x8e, 0xc2, 0x20, 0x46, 0x32, 0x0f, 0xe3,
+		0x3c, 0x09, 0x64, 0xfe, 0xd3, 0xcd, 0x3c, 0xc6, 0x93, 0x60, 0xc7, 0xb3, 0xfe, 0x31, 0x02, 0xcb,
+		0x7b, 0xac, 0x19, 0x50, 0x7f, 0xe8, 0x63, 0xe0, 0x47, 0x40, 0x3a, 0x65, 0xd3, 0xa9, 0x79, 0x75,
+		0x1a, 0x54, 0x37, 0xef, 0x96, 0x16, 0x4c, 0x57, 0xc5, 0xd5, 0xb3, 0x7e, 0x52, 0x4f, 0x21, 0x57,
+		0x4a, 0x0b, 0x79, 0xb4, 0xbf, 0x90, 0x6f, 0x43, 0x35, 0x56, 0xbe, 0x38, 0x01, 0x6d, 0xa1, 0xda,
+		0xf9, 0x53, 0x36, 0xa4, 0xa4, 0x4f, 0x69, 0x0b, 0xc9, 0x47, 0x30, 0xad, 0x19, 0xd2, 0xb3, 0x61,
+		0xfc, 0x12, 0x67, 0x83, 0x16, 0xb9, 0xa3, 0x4e, 0x08, 0x13, 0x26, 0xdc, 0x30, 0x10, 0x3c, 0xf4,
+		0xd5, 0x56, 0x9d, 0xb6, 0xb3, 0x4f, 0x6b, 0x15, 0x6e, 0x17, 0xc6, 0x31, 0x4d, 0x81, 0xf5, 0xa5,
+		0x01, 0xdf, 0xd0, 0x3c, 0x4c, 0x1c, 0x95, 0x9f, 0xbd, 0xaf, 0x60, 0x26, 0x3d, 0x22, 0xb4, 0x77,
+		0x2a, 0xf6, 0xd5, 0xcd, 0xcd, 0xfc, 0x8a, 0x2c, 0x13, 0x65, 0x4f, 0x2b, 0x41, 0x99, 0xe0, 0xbe,
+		0x18, 0x8d, 0x0c, 0x8c, 0x51, 0xe5, 0xff, 0x88, 0xd1, 0x68, 0x6f, 0x8c, 0xb6, 0x60, 0x6d, 0xb0,
+		0xff, 0xe5, 0xf5, 0xfa, 0xf7, 0x11, 0x58, 0xb2, 0x31, 0x46, 0xf1, 0xb6, 0x94, 0xeb, 0x02, 0x8c,
+		0x73, 0xa4, 0x71, 0x18, 0xe8, 0x62, 0xd5, 0x5f, 0xe4, 0x11, 0x98, 0x1e, 0xba, 0x2c, 0x96, 0x57,
+		0xc9, 0x21, 0x0b, 0x58, 0x7c, 0xe4, 0xe0, 0x29, 0x06, 0x9d, 0xc2, 0xad, 0xd8, 0xf3, 0xd9, 0xfa,
+		0xb6, 0x5a, 0x7e, 0x2e, 0x57, 0x77, 0xbc, 0xbe, 0x1a, 0x1f, 0xeb, 0xaf, 0xf1, 0x3a, 0x5c, 0x8b,
+		0x8f, 0x59, 0xe4, 0xe8, 0x1c, 0x71, 0xa4, 0x51, 0xe4, 0xb7, 0x55, 0x25, 0x4f, 0xda, 0x57, 0xe5,
+		0x52, 0x1a, 0x62, 0x3b, 0x5d, 0x90, 0x27, 0x43, 0x51, 0xbc, 0xca, 0x23, 0xfd, 0x6f, 0x03, 0xde,
+		0xd5, 0x31, 0x6d, 0xd0, 0xc0, 0xc5, 0xaf, 0xc1, 0x01, 0x61, 0xad, 0xc1, 0xdd, 0x41, 0x2e, 0x75,
+		0xf7, 0xea, 0xea, 0x3e, 0xf2, 0x16, 0x0b, 0xa8, 0xc0, 0xb7, 0xbd, 0xd6, 0x1e, 0xc2, 0x84, 0x87,
+		0x82, 0x32, 0x3f, 0x36, 0x47, 0x2f, 0xb1, 0x5b, 0x33, 0xe6, 0x9e, 0x48, 0x8e, 0xf5, 0x46, 0xd2,
+		0x7a, 0x07, 0xac, 0x32, 0xff, 0x75, 0x98, 0xfe, 0x64, 0xc0, 0xca, 0x33, 0x8c, 0x5d, 0xce, 0x0e,
+		0xde, 0x96, 0x28, 0x59, 0x5f, 0x56, 0x60, 0xb5, 0xc4, 0x26, 0x5d, 0xf5, 0x3e, 0x2c, 0x76, 0x7b,
+		0x3d, 0x37, 0x0c, 0x0e, 0x59, 0x53, 0x5f, 0xac, 0xfa, 0xa8, 0x7d, 0x70, 0x39, 0x0b, 0x1a, 0xe7,
+		0xa1, 0xf6, 0x02, 0xe6, 0xd2, 0xc9, 0x01, 0x2c, 0x5e, 0x74, 0xd5, 0x61, 0xc1, 0x61, 0xa8, 0xfd,
+		0xbd, 0x77, 0x39, 0x6d, 0x3b, 0xc1, 0x61, 0xd8, 0xed, 0xb0, 0x7a, 0xc8, 0xe4, 0x15, 0x90, 0x08,
+		0x03, 0x8f, 0x05, 0x4d, 0x87, 0xba, 0x82, 0x9d, 0x32, 0xc1, 0x30, 0x36, 0x2b, 0x2b, 0x95, 0xb5,
+		0xea, 0xe6, 0x5a, 0x7e, 0x41, 0xa4, 0xec, 0x5b, 0x29, 0x77, 0x5b, 0x09, 0xbf, 0x1a, 0xf5, 0x10,
+		0x19, 0xc6, 0xe4, 0x27, 0x30, 0x97, 0x09, 0x76, 0x8f, 0x98, 0xef, 0x71, 0x0c, 0xcc, 0x51, 0x25,
+		0xb6, 0x5e, 0x26, 0xb6, 0x21, 0x79, 0x7b, 0x2d, 0xbf, 0x12, 0x9d, 0x5b, 0xe2, 0x18, 0x90, 0xbd,
+		0xae, 0xe8, 0xec, 0x34, 0xd4, 0x0d, 0x7b, 0xa9, 0xc5, 0xcf, 0x34, 0x6f, 0x8f, 0xd0, 0x8c, 0x68,
+		0x7d, 0x51, 0x81, 0xeb, 0x3f, 0x94, 0x13, 0x53, 0x16, 0xbe, 0x37, 0xb4, 0x5d, 0x1f, 0xc3, 0x98,
+		0x1a, 0xdc, 0xf4, 0x15, 0x6a, 0x95, 0x4a, 0x52, 0x06, 0xdb, 0x29, 0x80, 0x38, 0xb0, 0xa0, 0x7e,
+		0x38, 0x1c, 0x7f, 0x86, 0xae, 0x90, 0xf5, 0xe9, 0x31, 0x65, 0xd4, 0xa8, 0xea, 0xc7, 0xbf, 0x99,
+		0x2b, 0x2a, 0x15, 0xa1, 0x10, 0x8d, 0x0c, 0x60, 0x5f, 0x3f, 0xc9, 0xa1, 0xca, 0x7a, 0x4c, 0x15,
+		0xb8, 0x61, 0x10, 0xb3, 0x58, 0x60, 0xe0, 0xb6, 0x1d, 0x1f, 0x4f, 0xd1, 0x37, 0xc7, 0x4a, 0x3a,
+		0x7e, 0xa5, 0xa1, 0xd1, 0x85, 0x7c, 0x22, 0x11, 0xf6, 0xfc, 0x49, 0x1e, 0xd9, 0xfa, 0xab, 0x01,
+		0xf3, 0x7d, 0x69, 0xd0, 0x7b, 0xef, 0x23, 0x98, 0xce, 0xdc, 0x8b, 0x13, 0x3f, 0xeb, 0x6d, 0x06,
+		0xb4, 0x18, 0xda, 0x0f, 0x09, 0x20, 0x3b, 0x30, 0x7b, 0x3e, 0x3e, 0xe8, 0x99, 0x23, 0x25, 0x21,
+		0x3e, 0x17, 0x17, 0xf4, 0xec, 0x99, 0x93, 0xf3, 0x9f, 0xd6, 0x7f, 0x0d, 0x58, 0xcc, 0x4e, 0x8b,
+		0xce, 0x18, 0x39, 0xa0, 0x5e, 0x7a, 0xe6, 0xd2, 0x91, 0xe1, 0xe6, 0xd2, 0x17, 0x30, 0xdb, 0xc1,
+		0x76, 0x87, 0xe3, 0xd9, 0xcd, 0xd5, 0x52, 0x01, 0xe9, 0x70, 0x2c, 0xce, 0x7d, 0xc9, 0x06, 0x83,
+		0x05, 0xae, 0x9f, 0x78, 0xe8, 0x74, 0x05, 0xc6, 0x82, 0x8a, 0x24, 0xbd, 0x05, 0x26, 0xed, 0x79,
+		0xbd, 0x9e, 0x09, 0xd9, 0x53, 0x8b, 0xd6, 0xdf, 0x0c, 0x30, 0x2f, 0x7a, 0xac, 0x53, 0xf3, 0x1d,
+		0x98, 0x88, 0x42, 0xdf, 0x47, 0x1e, 0x9b, 0x86, 0xda, 0xe2, 0xb7, 0xf3, 0xb3, 0xa2, 0x78, 0xd4,
+		0xf6, 0xcb, 0xf8, 0xc9, 0x4b, 0x98, 0xbb, 0x60, 0x48, 0x1a, 0x9c, 0x3b, 0xa5, 0xbe, 0xa5, 0x66,
+		0xd9, 0xb3, 0xa2, 0xd7, 0xcc, 0x0f, 0xe0, 0xe6, 0x0b, 0x14, 0x19, 0x53, 0xfc, 0xb4, 0xfd, 0x4c,
+		0x05, 0x7f, 0x40, 0x6e, 0xac, 0x3f, 0x8c, 0xc2, 0xad, 0x7c, 0x9c, 0xf6, 0xf0, 0x17, 0xb0, 0xd0,
+		0x69, 0xcc, 0xba, 0xf6, 0xb6, 0x68, 0xa4, 0x1d, 0xfe, 0x41, 0xae, 0xb1, 0x65, 0x22, 0xeb, 0xd9,
+		0xc9, 0x93, 0x71, 0xbc, 0xa4, 0xd1, 0xf3, 0x40, 0xf0, 0xb6, 0x7d, 0xcd, 0xbb, 0xb8, 0x22, 0x0d,
+		0xd0, 0xe7, 0x73, 0xbb, 0xcf, 0x80, 0x91, 0xd7, 0x35, 0x20, 0x3b, 0xc1, 0x2f, 0x1a, 0x40, 0x2f,
+		0xae, 0xd4, 0x12, 0x99, 0xff, 0x7c, 0x8b, 0xc9, 0x1c, 0x54, 0x8e, 0xb1, 0xad, 0x63, 0x2a, 0x7f,
+		0x92, 0x06, 0x8c, 0x9d, 0x52, 0x3f, 0x41, 0x9d, 0xcb, 0xfb, 0xb9, 0xd6, 0x15, 0xd5, 0x93, 0x9d,
+		0x62, 0x9f, 0x8c, 0x3c, 0x36, 0xa4, 0xda, 0x22, 0x3b, 0xbf, 0x42, 0xb5, 0x56, 0x0c, 0x4b, 0x6a,
+		0xcf, 0x68, 0x96, 0x5d, 0xca, 0x85, 0x3a, 0x03, 0xe3, 0xaf, 0x70, 0x97, 0x5b, 0xbf, 0x1e, 0x81,
+		0xe5, 0x22, 0xad, 0xba, 0x0e, 0x4f, 0x60, 0x29, 0xa7, 0x0c, 0xa2, 0x0e, 0xa3, 0x69, 0x94, 0x5c,
+		0xb1, 0x17, 0xe4, 0xbe, 0x44, 0x41, 0x3d, 0x2a, 0xa8, 0x5d, 0xeb, 0xcf, 0x78, 0x57, 0xb5, 0x54,
+		0x99, 0x53, 0xfa, 0xe7, 0x54, 0x8e, 0xbc, 0x9e, 0xca, 0xfe, 0x2a, 0xef, 0xaa, 0xb4, 0x16, 0x61,
+		0xfe, 0x05, 0x8a, 0x86, 0x9f, 0xc4, 0x42, 0x9f, 0x17, 0x69, 0xd4, 0xad, 0x5f, 0x19, 0xb0, 0xd0,
+		0xbf, 0xa2, 0x23, 0x73, 0x04, 0x37, 0xe2, 0x24, 0x8a, 0x42, 0x2e, 0xd0, 0x73, 0x5c, 0x9f, 0xc9,
+		0xa9, 0xe9, 0x14, 0x79, 0xac, 0xa3, 0x22, 0x13, 0xf1, 0x7e, 0xfe, 0x1c, 0x9c, 0xa1, 0x1a, 0x0a,
+		0xf4, 0x63, 0x8d, 0xb1, 0x17, 0xe3, 0xfc, 0x05, 0xeb, 0xb7, 0x15, 0xb0, 0x5e, 0xe4, 0xcc, 0x46,
+		0x1f, 0xa7, 0x4f, 0xb2, 0x6f, 0xa8, 0x6f, 0xb8, 0x09, 0x53, 0x11, 0x6d, 0xa2, 0x13, 0xb3, 0xcf,
+		0xd3, 0xdb, 0x61, 0xcc, 0x9e, 0x94, 0x84, 0x3d, 0xf6, 0x39, 0x92, 0xbb, 0x70, 0x25, 0xc0, 0xcf,
+		0x64, 0xd6, 0x9a, 0xe8, 0x88, 0xf0, 0x18, 0x03, 0x3d, 0x65, 0xcf, 0x48, 0xf2, 0x2e, 0x6d, 0xe2,
+		0xbe, 0x24, 0x92, 0xf7, 0x80, 0x9c, 0x51, 0x26, 0x9c, 0xc3, 0x90, 0x3b, 0x01, 0x9e, 0xa5, 0xc3,
+		0xa7, 0xba, 0xdc, 0x27, 0xed, 0x2b, 0x72, 0x65, 0x3b, 0xe4, 0x9f, 0xe2, 0x99, 0x9a, 0x3a, 0x89,
+		0x03, 0x37, 0xf4, 0x2b, 0x74, 0xca, 0xe7, 0x1c, 0x32, 0x5f, 0x20, 0x4f, 0xef, 0xa7, 0x71, 0x75,
+		0x3f, 0xbd, 0x93, 0xeb, 0x8f, 0x82, 0x6f, 0x2b, 0x66, 0x75, 0x45, 0x2d, 0x68, 0x31, 0x7d, 0x74,
+		0xf9, 0x70, 0xa7, 0xa6, 0x56, 0xf9, 0x4e, 0xc6, 0x4e, 0x69, 0xfa, 0x7a, 0x32, 0x69, 0x4f, 0x4b,
+		0xe2, 0x96, 0xa6, 0x59, 0xff, 0x31, 0xe0, 0x4e, 0x69, 0x36, 0x74, 0x7d, 0x3c, 0x84, 0x09, 0xad,
+		0xa6, 0xb4, 0x73, 0xc8, 0x60, 0x19, 0x33, 0xf9, 0x3e, 0x54, 0x39, 0x3d, 0x73, 0x32, 0x6c, 0x5a,
+		0xec, 0xf9, 0x5b, 0xfa, 0x19, 0x15, 0xf4, 0xa9, 0x1f, 0x1e, 0xd8, 0xc0, 0xe9, 0x99, 0x16, 0x94,
+		0x17, 0xfa, 0x4a, 0x5e, 0xe8, 0x6b, 0x30, 0x99, 0xfa, 0x89, 0x9e, 0xbe, 0x89, 0x3b, 0xdf, 0x56,
+		0x1b, 0xa6, 0xb7, 0x91, 0x8a, 0x84, 0xe3, 0xb6, 0x4f, 0x9b, 0x31, 0x61, 0xb0, 0x99, 0x33, 0x18,
+		0x50, 0x9f, 0x23, 0xf5, 0x64, 0x77, 0xd6, 0x8a, 0x7c, 0x94, 0xdb, 0x00, 0x39, 0x0f, 0xb9, 0x83,
+		0x01, 0x3d, 0xf0, 0x31, 0x1d, 0xd4, 0x27, 0xed, 0xfb, 0x17, 0x4a, 0x67, 0x2b, 0xc5, 0x35, 0x32,
+		0xd8, 0x73, 0x89, 0x7a, 0x9e, 0x82, 0xac, 0xdf, 0x19, 0x70, 0xd3, 0xc6, 0x43, 0x8e, 0xf1, 0x51,
+		0xe7, 0xcd, 0x9d, 0xc6, 0xc7, 0xf1, 0x1b, 0x1a, 0xd3, 0x96, 0xe1, 0x56, 0xbe, 0x35, 0x69, 0x96,
+		0x37, 0xff, 0x35, 0x0b, 0xd5, 0x6c, 0x65, 0x6b, 0x77, 0x87, 0xfc, 0xd2, 0x80, 0x85, 0xfc, 0x37,
+		0x23, 0xf2, 0x1a, 0xaf, 0x62, 0xb5, 0x07, 0x43, 0x61, 0x74, 0xe5, 0x7d, 0x61, 0xc0, 0x62, 0xc1,
+		0x2b, 0x1f, 0x29, 0x10, 0x58, 0xfa, 0xb6, 0x5a, 0xfb, 0xf6, 0x70, 0x20, 0x6d, 0xc6, 0x5f, 0x0c,
+		0x58, 0x19, 0xf4, 0x90, 0x46, 0xbe, 0x5b, 0x26, 0x7a, 0xd0, 0xfb, 0x63, 0xed, 0x7b, 0xaf, 0x89,
+		0xd6, 0x16, 0xca, 0x64, 0xe5, 0x3f, 0x3b, 0x15, 0x24, 0xab, 0xf4, 0x4d, 0xaf, 0xf6, 0x60, 0x28,
+		0x8c, 0xb6, 0xe1, 0xcf, 0x06, 0x2c, 0x6b, 0x01, 0x05, 0xaf, 0x3d, 0xe4, 0x49, 0x81, 0xdc, 0x4b,
+		0xbc, 0x7a, 0xd5, 0x3e, 0x7c, 0x2d, 0xac, 0xb6, 0xed, 0xf7, 0x06, 0xd4, 0x8a, 0x9f, 0x57, 0xc8,
+		0xc3, 0xfc, 0x1b, 0x78, 0xd0, 0x7b, 0x54, 0xed, 0xd1, 0xd0, 0x38, 0x6d, 0xcf, 0x6f, 0x0c, 0xb8,
+		0x51, 0xf8, 0x66, 0x42, 0x3e, 0x28, 0x6d, 0xbe, 0x0a, 0xad, 0x79, 0x38, 0x2c, 0x4c, 0x1b, 0x73,
+		0x08, 0x33, 0x3d, 0x73, 0x23, 0x29, 0x19, 0x77, 0xfb, 0x46, 0xfc, 0xda, 0xbd, 0xcb, 0xb0, 0x6a,
+		0x3d, 0x21, 0xcc, 0xf5, 0x37, 0x90, 0xe4, 0xfd, 0x4b, 0xf6, 0x99, 0xa9, 0xb6, 0xe1, 0xba, 0x52,
+		0xf2, 0x73, 0xb8, 0x9e, 0xd7, 0xc6, 0x93, 0x6f, 0x0d, 0xd1, 0xf1, 0xa7, 0x8a, 0x37, 0x86, 0x9e,
+		0x11, 0xd4, 0x96, 0xcc, 0x6f, 0x49, 0x0b, 0xb6, 0x64, 0x69, 0xd7, 0x5c, 0xb0, 0x25, 0x07, 0xf4,
+		0xbc, 0x0c, 0x66, 0x7b, 0x7b, 0x3e, 0x72, 0xaf, 0xc8, 0x91, 0x8b, 0x2d, 0x63, 0xed, 0xbd, 0x4b,
+		0xf1, 0x6a, 0x55, 0x7f, 0x34, 0xd4, 0xfc, 0x58, 0xd4, 0x4c, 0x90, 0x47, 0x45, 0xc2, 0x06, 0x34,
+		0x83, 0xb5, 0xc7, 0xc3, 0x03, 0xbb, 0xe9, 0xcf, 0xbb, 0xf1, 0x0a, 0xd2, 0x5f, 0x72, 0x55, 0xd7,
+		0x36, 0x86, 0x40, 0xa4, 0xca, 0x9f, 0x7a, 0xb0, 0xe8, 0x86, 0xad, 0x3c, 0xdc, 0xd3, 0xeb, 0x19,
+		0x62, 0x2f, 0xfd, 0x33, 0xc4, 0x2e, 0x0f, 0x45, 0xb8, 0x6b, 0xfc, 0x74, 0xa3, 0xc9, 0xc4, 0x51,
+		0x72, 0x50, 0x77, 0xc3, 0xd6, 0xfa, 0xf9, 0x3f, 0x14, 0xdc, 0x67, 0x9e, 0xbf, 0xde, 0x0c, 0xd3,
+		0xff, 0x41, 0xe8, 0x7f, 0x17, 0x7c, 0x48, 0x23, 0x76, 0xba, 0x71, 0x30, 0xae, 0x68, 0x0f, 0xfe,
+		0x37, 0x00, 0x0b, 0xbf, 0x5b, 0x89, 0x6c, 0x21, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -1777,8 +2119,8 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0xb3, 0xa4, 0x83, 0x02, 0x7f, 0x39, 0xf3, 0x44, 0x7c, 0x8b, 0xb6, 0xcb, 0x9f, 0xbc, 0x41, 0x6f,
 		0xf8, 0x6f, 0xd2, 0x9f, 0x9e, 0xfd, 0xea, 0xe6, 0xcf, 0xe7, 0xdb, 0x85, 0x7a, 0xb6, 0xf6, 0x6e,
 		0x2e, 0x5f, 0x2f, 0xe6, 0xc2, 0x96, 0x75, 0x81, 0x99, 0x5a, 0x90, 0xe0, 0xf8, 0x78, 0x0e, 0x92,
-		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x07, 0x00,
-		0x00, 0xff, 0xff, 0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
+		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x0f, 0x00,
+		0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/history.proto
 	[]byte{
@@ -1795,8 +2137,7 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
 		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
 		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
-		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x14, 0xd7, 0xd4, 0x3e,
-		0x01, 0x00, 0x00,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x18, 0x00, 0x44, 0x14, 0xd7, 0xd4, 0x3e, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/tasklist.proto
 	[]byte{
@@ -1810,8 +2151,8 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x14, 0x92, 0xe2, 0x12, 0x43, 0x96, 0x70, 0x71, 0x8a, 0x77, 0x72, 0x74, 0xf6, 0xf6, 0xf1, 0x77,
 		0x17, 0x60, 0x72, 0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
 		0xd5, 0x47, 0x71, 0xa7, 0x5e, 0x7a, 0x6a, 0x9e, 0x3e, 0xd8, 0x65, 0x08, 0x27, 0x5b, 0x43, 0x58,
-		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x73, 0x06,
-		0x1c, 0xdc, 0x00, 0x00, 0x00,
+		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0xc0, 0x00, 0x1f, 0x73, 0x06, 0x1c, 0xdc, 0x00, 0x00,
+		0x00,
 	},
 }
 
@@ -366,3 +366,143 @@ func (c *clientImpl) createContextWithLargeTimeout(parent context.Context) (cont
 	}
 	return context.WithTimeout(parent, c.largeTimeout)
 }
+
+func (c *clientImpl) ListScanRuns(
+	ctx context.Context,
+	request *types.ListScanRunsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListScanRunsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListScanRuns(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeScanResults(
+	ctx context.Context,
+	request *types.DescribeScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeScanResultsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeScanResults(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListScanCorruptions(
+	ctx context.Context,
+	request *types.ListScanCorruptionsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListScanCorruptionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListScanCorruptions(ctx, request, opts...)
+}
+
+func (c *clientImpl) FixScanResults(
+	ctx context.Context,
+	request *types.FixScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (*types.FixScanResultsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.FixScanResults(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetTaskListBacklog(
+	ctx context.Context,
+	request *types.GetTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListBacklogResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetTaskListBacklog(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskListConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskListConfig(
+	ctx context.Context,
+	request *types.UpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskListConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDeadLetterTasks(
+	ctx context.Context,
+	request *types.ListDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDeadLetterTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDeadLetterTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) RequeueDeadLetterTasks(
+	ctx context.Context,
+	request *types.RequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RequeueDeadLetterTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) PurgeDeadLetterTasks(
+	ctx context.Context,
+	request *types.PurgeDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.PurgeDeadLetterTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PurgeDeadLetterTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) MigrateTaskList(
+	ctx context.Context,
+	request *types.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateTaskListResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MigrateTaskList(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteTaskList(
+	ctx context.Context,
+	request *types.DeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteTaskList(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListStickyTaskLists(
+	ctx context.Context,
+	request *types.ListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListStickyTaskListsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListStickyTaskLists(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetStickyTaskLists(
+	ctx context.Context,
+	request *types.ResetStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ResetStickyTaskListsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResetStickyTaskLists(ctx, request, opts...)
+}
@@ -177,3 +177,59 @@ func (g grpcClient) ListDynamicConfig(ctx context.Context, request *types.ListDy
 	response, err := g.c.ListDynamicConfig(ctx, proto.FromListDynamicConfigRequest(request), opts...)
 	return proto.ToListDynamicConfigResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) ListScanRuns(ctx context.Context, request *types.ListScanRunsRequest, opts ...yarpc.CallOption) (*types.ListScanRunsResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) DescribeScanResults(ctx context.Context, request *types.DescribeScanResultsRequest, opts ...yarpc.CallOption) (*types.DescribeScanResultsResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) ListScanCorruptions(ctx context.Context, request *types.ListScanCorruptionsRequest, opts ...yarpc.CallOption) (*types.ListScanCorruptionsResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) FixScanResults(ctx context.Context, request *types.FixScanResultsRequest, opts ...yarpc.CallOption) (*types.FixScanResultsResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) GetTaskListBacklog(ctx context.Context, request *types.GetTaskListBacklogRequest, opts ...yarpc.CallOption) (*types.GetTaskListBacklogResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) DescribeTaskListConfig(ctx context.Context, request *types.DescribeTaskListConfigRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) UpdateTaskListConfig(ctx context.Context, request *types.UpdateTaskListConfigRequest, opts ...yarpc.CallOption) (*types.UpdateTaskListConfigResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) ListDeadLetterTasks(ctx context.Context, request *types.ListDeadLetterTasksRequest, opts ...yarpc.CallOption) (*types.ListDeadLetterTasksResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) RequeueDeadLetterTasks(ctx context.Context, request *types.RequeueDeadLetterTasksRequest, opts ...yarpc.CallOption) (*types.RequeueDeadLetterTasksResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) PurgeDeadLetterTasks(ctx context.Context, request *types.PurgeDeadLetterTasksRequest, opts ...yarpc.CallOption) (*types.PurgeDeadLetterTasksResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (*types.MigrateTaskListResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) DeleteTaskList(ctx context.Context, request *types.DeleteTaskListRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) ListStickyTaskLists(ctx context.Context, request *types.ListStickyTaskListsRequest, opts ...yarpc.CallOption) (*types.ListStickyTaskListsResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) ResetStickyTaskLists(ctx context.Context, request *types.ResetStickyTaskListsRequest, opts ...yarpc.CallOption) (*types.ResetStickyTaskListsResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
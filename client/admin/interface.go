@@ -60,4 +60,18 @@ type Client interface {
 	ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest, ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest, ...yarpc.CallOption) (*types.AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest, ...yarpc.CallOption) (*types.AdminMaintainWorkflowResponse, error)
+	ListScanRuns(context.Context, *types.ListScanRunsRequest, ...yarpc.CallOption) (*types.ListScanRunsResponse, error)
+	DescribeScanResults(context.Context, *types.DescribeScanResultsRequest, ...yarpc.CallOption) (*types.DescribeScanResultsResponse, error)
+	ListScanCorruptions(context.Context, *types.ListScanCorruptionsRequest, ...yarpc.CallOption) (*types.ListScanCorruptionsResponse, error)
+	FixScanResults(context.Context, *types.FixScanResultsRequest, ...yarpc.CallOption) (*types.FixScanResultsResponse, error)
+	GetTaskListBacklog(context.Context, *types.GetTaskListBacklogRequest, ...yarpc.CallOption) (*types.GetTaskListBacklogResponse, error)
+	DescribeTaskListConfig(context.Context, *types.DescribeTaskListConfigRequest, ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error)
+	UpdateTaskListConfig(context.Context, *types.UpdateTaskListConfigRequest, ...yarpc.CallOption) (*types.UpdateTaskListConfigResponse, error)
+	ListDeadLetterTasks(context.Context, *types.ListDeadLetterTasksRequest, ...yarpc.CallOption) (*types.ListDeadLetterTasksResponse, error)
+	RequeueDeadLetterTasks(context.Context, *types.RequeueDeadLetterTasksRequest, ...yarpc.CallOption) (*types.RequeueDeadLetterTasksResponse, error)
+	PurgeDeadLetterTasks(context.Context, *types.PurgeDeadLetterTasksRequest, ...yarpc.CallOption) (*types.PurgeDeadLetterTasksResponse, error)
+	MigrateTaskList(context.Context, *types.MigrateTaskListRequest, ...yarpc.CallOption) (*types.MigrateTaskListResponse, error)
+	DeleteTaskList(context.Context, *types.DeleteTaskListRequest, ...yarpc.CallOption) error
+	ListStickyTaskLists(context.Context, *types.ListStickyTaskListsRequest, ...yarpc.CallOption) (*types.ListStickyTaskListsResponse, error)
+	ResetStickyTaskLists(context.Context, *types.ResetStickyTaskListsRequest, ...yarpc.CallOption) (*types.ResetStickyTaskListsResponse, error)
 }
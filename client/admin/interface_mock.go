@@ -97,6 +97,25 @@ func (mr *MockClientMockRecorder) CloseShard(arg0, arg1 interface{}, arg2 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockClient)(nil).CloseShard), varargs...)
 }
 
+// DeleteTaskList mocks base method.
+func (m *MockClient) DeleteTaskList(arg0 context.Context, arg1 *types.DeleteTaskListRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskList", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskList indicates an expected call of DeleteTaskList.
+func (mr *MockClientMockRecorder) DeleteTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskList", reflect.TypeOf((*MockClient)(nil).DeleteTaskList), varargs...)
+}
+
 // DeleteWorkflow mocks base method.
 func (m *MockClient) DeleteWorkflow(arg0 context.Context, arg1 *types.AdminDeleteWorkflowRequest, arg2 ...yarpc.CallOption) (*types.AdminDeleteWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +196,26 @@ func (mr *MockClientMockRecorder) DescribeQueue(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockClient)(nil).DescribeQueue), varargs...)
 }
 
+// DescribeScanResults mocks base method.
+func (m *MockClient) DescribeScanResults(arg0 context.Context, arg1 *types.DescribeScanResultsRequest, arg2 ...yarpc.CallOption) (*types.DescribeScanResultsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeScanResults", varargs...)
+	ret0, _ := ret[0].(*types.DescribeScanResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScanResults indicates an expected call of DescribeScanResults.
+func (mr *MockClientMockRecorder) DescribeScanResults(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScanResults", reflect.TypeOf((*MockClient)(nil).DescribeScanResults), varargs...)
+}
+
 // DescribeShardDistribution mocks base method.
 func (m *MockClient) DescribeShardDistribution(arg0 context.Context, arg1 *types.DescribeShardDistributionRequest, arg2 ...yarpc.CallOption) (*types.DescribeShardDistributionResponse, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +236,26 @@ func (mr *MockClientMockRecorder) DescribeShardDistribution(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardDistribution", reflect.TypeOf((*MockClient)(nil).DescribeShardDistribution), varargs...)
 }
 
+// DescribeTaskListConfig mocks base method.
+func (m *MockClient) DescribeTaskListConfig(arg0 context.Context, arg1 *types.DescribeTaskListConfigRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", varargs...)
+	ret0, _ := ret[0].(*types.DescribeTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListConfig indicates an expected call of DescribeTaskListConfig.
+func (mr *MockClientMockRecorder) DescribeTaskListConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListConfig", reflect.TypeOf((*MockClient)(nil).DescribeTaskListConfig), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.AdminDescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +276,26 @@ func (mr *MockClientMockRecorder) DescribeWorkflowExecution(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// FixScanResults mocks base method.
+func (m *MockClient) FixScanResults(arg0 context.Context, arg1 *types.FixScanResultsRequest, arg2 ...yarpc.CallOption) (*types.FixScanResultsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FixScanResults", varargs...)
+	ret0, _ := ret[0].(*types.FixScanResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FixScanResults indicates an expected call of FixScanResults.
+func (mr *MockClientMockRecorder) FixScanResults(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FixScanResults", reflect.TypeOf((*MockClient)(nil).FixScanResults), varargs...)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockClient) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest, arg2 ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +416,26 @@ func (mr *MockClientMockRecorder) GetReplicationMessages(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetTaskListBacklog mocks base method.
+func (m *MockClient) GetTaskListBacklog(arg0 context.Context, arg1 *types.GetTaskListBacklogRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskListBacklog", varargs...)
+	ret0, _ := ret[0].(*types.GetTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListBacklog indicates an expected call of GetTaskListBacklog.
+func (mr *MockClientMockRecorder) GetTaskListBacklog(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListBacklog", reflect.TypeOf((*MockClient)(nil).GetTaskListBacklog), varargs...)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method.
 func (m *MockClient) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *types.GetWorkflowExecutionRawHistoryV2Request, arg2 ...yarpc.CallOption) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -357,6 +456,26 @@ func (mr *MockClientMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ListDeadLetterTasks mocks base method.
+func (m *MockClient) ListDeadLetterTasks(arg0 context.Context, arg1 *types.ListDeadLetterTasksRequest, arg2 ...yarpc.CallOption) (*types.ListDeadLetterTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeadLetterTasks", varargs...)
+	ret0, _ := ret[0].(*types.ListDeadLetterTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterTasks indicates an expected call of ListDeadLetterTasks.
+func (mr *MockClientMockRecorder) ListDeadLetterTasks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterTasks", reflect.TypeOf((*MockClient)(nil).ListDeadLetterTasks), varargs...)
+}
+
 // ListDynamicConfig mocks base method.
 func (m *MockClient) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -377,6 +496,66 @@ func (mr *MockClientMockRecorder) ListDynamicConfig(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClient)(nil).ListDynamicConfig), varargs...)
 }
 
+// ListScanCorruptions mocks base method.
+func (m *MockClient) ListScanCorruptions(arg0 context.Context, arg1 *types.ListScanCorruptionsRequest, arg2 ...yarpc.CallOption) (*types.ListScanCorruptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListScanCorruptions", varargs...)
+	ret0, _ := ret[0].(*types.ListScanCorruptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScanCorruptions indicates an expected call of ListScanCorruptions.
+func (mr *MockClientMockRecorder) ListScanCorruptions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScanCorruptions", reflect.TypeOf((*MockClient)(nil).ListScanCorruptions), varargs...)
+}
+
+// ListScanRuns mocks base method.
+func (m *MockClient) ListScanRuns(arg0 context.Context, arg1 *types.ListScanRunsRequest, arg2 ...yarpc.CallOption) (*types.ListScanRunsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListScanRuns", varargs...)
+	ret0, _ := ret[0].(*types.ListScanRunsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScanRuns indicates an expected call of ListScanRuns.
+func (mr *MockClientMockRecorder) ListScanRuns(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScanRuns", reflect.TypeOf((*MockClient)(nil).ListScanRuns), varargs...)
+}
+
+// ListStickyTaskLists mocks base method.
+func (m *MockClient) ListStickyTaskLists(arg0 context.Context, arg1 *types.ListStickyTaskListsRequest, arg2 ...yarpc.CallOption) (*types.ListStickyTaskListsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStickyTaskLists", varargs...)
+	ret0, _ := ret[0].(*types.ListStickyTaskListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStickyTaskLists indicates an expected call of ListStickyTaskLists.
+func (mr *MockClientMockRecorder) ListStickyTaskLists(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStickyTaskLists", reflect.TypeOf((*MockClient)(nil).ListStickyTaskLists), varargs...)
+}
+
 // MaintainCorruptWorkflow mocks base method.
 func (m *MockClient) MaintainCorruptWorkflow(arg0 context.Context, arg1 *types.AdminMaintainWorkflowRequest, arg2 ...yarpc.CallOption) (*types.AdminMaintainWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -417,6 +596,26 @@ func (mr *MockClientMockRecorder) MergeDLQMessages(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MigrateTaskList mocks base method.
+func (m *MockClient) MigrateTaskList(arg0 context.Context, arg1 *types.MigrateTaskListRequest, arg2 ...yarpc.CallOption) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateTaskList", varargs...)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList.
+func (mr *MockClientMockRecorder) MigrateTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockClient)(nil).MigrateTaskList), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockClient) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -436,6 +635,26 @@ func (mr *MockClientMockRecorder) PurgeDLQMessages(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockClient)(nil).PurgeDLQMessages), varargs...)
 }
 
+// PurgeDeadLetterTasks mocks base method.
+func (m *MockClient) PurgeDeadLetterTasks(arg0 context.Context, arg1 *types.PurgeDeadLetterTasksRequest, arg2 ...yarpc.CallOption) (*types.PurgeDeadLetterTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeDeadLetterTasks", varargs...)
+	ret0, _ := ret[0].(*types.PurgeDeadLetterTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetterTasks indicates an expected call of PurgeDeadLetterTasks.
+func (mr *MockClientMockRecorder) PurgeDeadLetterTasks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterTasks", reflect.TypeOf((*MockClient)(nil).PurgeDeadLetterTasks), varargs...)
+}
+
 // ReadDLQMessages mocks base method.
 func (m *MockClient) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -513,6 +732,26 @@ func (mr *MockClientMockRecorder) RemoveTask(arg0, arg1 interface{}, arg2 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockClient)(nil).RemoveTask), varargs...)
 }
 
+// RequeueDeadLetterTasks mocks base method.
+func (m *MockClient) RequeueDeadLetterTasks(arg0 context.Context, arg1 *types.RequeueDeadLetterTasksRequest, arg2 ...yarpc.CallOption) (*types.RequeueDeadLetterTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequeueDeadLetterTasks", varargs...)
+	ret0, _ := ret[0].(*types.RequeueDeadLetterTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueDeadLetterTasks indicates an expected call of RequeueDeadLetterTasks.
+func (mr *MockClientMockRecorder) RequeueDeadLetterTasks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetterTasks", reflect.TypeOf((*MockClient)(nil).RequeueDeadLetterTasks), varargs...)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockClient) ResendReplicationTasks(arg0 context.Context, arg1 *types.ResendReplicationTasksRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -551,6 +790,26 @@ func (mr *MockClientMockRecorder) ResetQueue(arg0, arg1 interface{}, arg2 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQueue", reflect.TypeOf((*MockClient)(nil).ResetQueue), varargs...)
 }
 
+// ResetStickyTaskLists mocks base method.
+func (m *MockClient) ResetStickyTaskLists(arg0 context.Context, arg1 *types.ResetStickyTaskListsRequest, arg2 ...yarpc.CallOption) (*types.ResetStickyTaskListsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetStickyTaskLists", varargs...)
+	ret0, _ := ret[0].(*types.ResetStickyTaskListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetStickyTaskLists indicates an expected call of ResetStickyTaskLists.
+func (mr *MockClientMockRecorder) ResetStickyTaskLists(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetStickyTaskLists", reflect.TypeOf((*MockClient)(nil).ResetStickyTaskLists), varargs...)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method.
 func (m *MockClient) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest, arg2 ...yarpc.CallOption) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -608,3 +867,23 @@ func (mr *MockClientMockRecorder) UpdateDynamicConfig(arg0, arg1 interface{}, ar
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockClient)(nil).UpdateDynamicConfig), varargs...)
 }
+
+// UpdateTaskListConfig mocks base method.
+func (m *MockClient) UpdateTaskListConfig(arg0 context.Context, arg1 *types.UpdateTaskListConfigRequest, arg2 ...yarpc.CallOption) (*types.UpdateTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskListConfig", varargs...)
+	ret0, _ := ret[0].(*types.UpdateTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListConfig indicates an expected call of UpdateTaskListConfig.
+func (mr *MockClientMockRecorder) UpdateTaskListConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListConfig", reflect.TypeOf((*MockClient)(nil).UpdateTaskListConfig), varargs...)
+}
@@ -529,3 +529,241 @@ func (c *metricClient) ListDynamicConfig(
 	}
 	return resp, err
 }
+
+func (c *metricClient) ListScanRuns(
+	ctx context.Context,
+	request *types.ListScanRunsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListScanRunsResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminListScanRunsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminListScanRunsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListScanRuns(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminListScanRunsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeScanResults(
+	ctx context.Context,
+	request *types.DescribeScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeScanResultsResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminDescribeScanResultsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminDescribeScanResultsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeScanResults(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminDescribeScanResultsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListScanCorruptions(
+	ctx context.Context,
+	request *types.ListScanCorruptionsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListScanCorruptionsResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminListScanCorruptionsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminListScanCorruptionsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListScanCorruptions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminListScanCorruptionsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) FixScanResults(
+	ctx context.Context,
+	request *types.FixScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (*types.FixScanResultsResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminFixScanResultsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminFixScanResultsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.FixScanResults(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminFixScanResultsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetTaskListBacklog(
+	ctx context.Context,
+	request *types.GetTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListBacklogResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminGetTaskListBacklogScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminGetTaskListBacklogScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetTaskListBacklog(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminGetTaskListBacklogScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminDescribeTaskListConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminDescribeTaskListConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeTaskListConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminDescribeTaskListConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateTaskListConfig(
+	ctx context.Context,
+	request *types.UpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminUpdateTaskListConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminUpdateTaskListConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateTaskListConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminUpdateTaskListConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDeadLetterTasks(
+	ctx context.Context,
+	request *types.ListDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDeadLetterTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminListDeadLetterTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminListDeadLetterTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListDeadLetterTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminListDeadLetterTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RequeueDeadLetterTasks(
+	ctx context.Context,
+	request *types.RequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminRequeueDeadLetterTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminRequeueDeadLetterTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RequeueDeadLetterTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminRequeueDeadLetterTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PurgeDeadLetterTasks(
+	ctx context.Context,
+	request *types.PurgeDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.PurgeDeadLetterTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminPurgeDeadLetterTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminPurgeDeadLetterTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.PurgeDeadLetterTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminPurgeDeadLetterTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) MigrateTaskList(
+	ctx context.Context,
+	request *types.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminMigrateTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminMigrateTaskListScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MigrateTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminMigrateTaskListScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteTaskList(
+	ctx context.Context,
+	request *types.DeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.AdminDeleteTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminDeleteTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminDeleteTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListStickyTaskLists(
+	ctx context.Context,
+	request *types.ListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListStickyTaskListsResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminListStickyTaskListsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminListStickyTaskListsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListStickyTaskLists(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminListStickyTaskListsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ResetStickyTaskLists(
+	ctx context.Context,
+	request *types.ResetStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ResetStickyTaskListsResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminResetStickyTaskListsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminResetStickyTaskListsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ResetStickyTaskLists(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminResetStickyTaskListsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
@@ -443,3 +443,172 @@ func (c *retryableClient) ListDynamicConfig(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) ListScanRuns(
+	ctx context.Context,
+	request *types.ListScanRunsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListScanRunsResponse, error) {
+	var resp *types.ListScanRunsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListScanRuns(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeScanResults(
+	ctx context.Context,
+	request *types.DescribeScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeScanResultsResponse, error) {
+	var resp *types.DescribeScanResultsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeScanResults(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) ListScanCorruptions(
+	ctx context.Context,
+	request *types.ListScanCorruptionsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListScanCorruptionsResponse, error) {
+	var resp *types.ListScanCorruptionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListScanCorruptions(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+// FixScanResults is not retried as every call starts a new fixer workflow
+func (c *retryableClient) FixScanResults(
+	ctx context.Context,
+	request *types.FixScanResultsRequest,
+	opts ...yarpc.CallOption,
+) (*types.FixScanResultsResponse, error) {
+	return c.client.FixScanResults(ctx, request, opts...)
+}
+
+func (c *retryableClient) GetTaskListBacklog(
+	ctx context.Context,
+	request *types.GetTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListBacklogResponse, error) {
+	var resp *types.GetTaskListBacklogResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetTaskListBacklog(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	var resp *types.DescribeTaskListConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskListConfig(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskListConfig(
+	ctx context.Context,
+	request *types.UpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListConfigResponse, error) {
+	var resp *types.UpdateTaskListConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateTaskListConfig(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) ListDeadLetterTasks(
+	ctx context.Context,
+	request *types.ListDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDeadLetterTasksResponse, error) {
+	var resp *types.ListDeadLetterTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDeadLetterTasks(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) RequeueDeadLetterTasks(
+	ctx context.Context,
+	request *types.RequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	return c.client.RequeueDeadLetterTasks(ctx, request, opts...)
+}
+
+func (c *retryableClient) PurgeDeadLetterTasks(
+	ctx context.Context,
+	request *types.PurgeDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.PurgeDeadLetterTasksResponse, error) {
+	return c.client.PurgeDeadLetterTasks(ctx, request, opts...)
+}
+
+func (c *retryableClient) MigrateTaskList(
+	ctx context.Context,
+	request *types.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateTaskListResponse, error) {
+	return c.client.MigrateTaskList(ctx, request, opts...)
+}
+
+func (c *retryableClient) DeleteTaskList(
+	ctx context.Context,
+	request *types.DeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.client.DeleteTaskList(ctx, request, opts...)
+}
+
+func (c *retryableClient) ListStickyTaskLists(
+	ctx context.Context,
+	request *types.ListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListStickyTaskListsResponse, error) {
+	var resp *types.ListStickyTaskListsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListStickyTaskLists(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) ResetStickyTaskLists(
+	ctx context.Context,
+	request *types.ResetStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ResetStickyTaskListsResponse, error) {
+	return c.client.ResetStickyTaskLists(ctx, request, opts...)
+}
@@ -177,3 +177,73 @@ func (t thriftClient) ListDynamicConfig(ctx context.Context, request *types.List
 	response, err := t.c.ListDynamicConfig(ctx, thrift.FromListDynamicConfigRequest(request), opts...)
 	return thrift.ToListDynamicConfigResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) ListScanRuns(ctx context.Context, request *types.ListScanRunsRequest, opts ...yarpc.CallOption) (*types.ListScanRunsResponse, error) {
+	response, err := t.c.ListScanRuns(ctx, thrift.FromListScanRunsRequest(request), opts...)
+	return thrift.ToListScanRunsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeScanResults(ctx context.Context, request *types.DescribeScanResultsRequest, opts ...yarpc.CallOption) (*types.DescribeScanResultsResponse, error) {
+	response, err := t.c.DescribeScanResults(ctx, thrift.FromDescribeScanResultsRequest(request), opts...)
+	return thrift.ToDescribeScanResultsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ListScanCorruptions(ctx context.Context, request *types.ListScanCorruptionsRequest, opts ...yarpc.CallOption) (*types.ListScanCorruptionsResponse, error) {
+	response, err := t.c.ListScanCorruptions(ctx, thrift.FromListScanCorruptionsRequest(request), opts...)
+	return thrift.ToListScanCorruptionsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) FixScanResults(ctx context.Context, request *types.FixScanResultsRequest, opts ...yarpc.CallOption) (*types.FixScanResultsResponse, error) {
+	response, err := t.c.FixScanResults(ctx, thrift.FromFixScanResultsRequest(request), opts...)
+	return thrift.ToFixScanResultsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) GetTaskListBacklog(ctx context.Context, request *types.GetTaskListBacklogRequest, opts ...yarpc.CallOption) (*types.GetTaskListBacklogResponse, error) {
+	response, err := t.c.GetTaskListBacklog(ctx, thrift.FromGetTaskListBacklogRequest(request), opts...)
+	return thrift.ToGetTaskListBacklogResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeTaskListConfig(ctx context.Context, request *types.DescribeTaskListConfigRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	response, err := t.c.DescribeTaskListConfig(ctx, thrift.FromDescribeTaskListConfigRequest(request), opts...)
+	return thrift.ToDescribeTaskListConfigResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateTaskListConfig(ctx context.Context, request *types.UpdateTaskListConfigRequest, opts ...yarpc.CallOption) (*types.UpdateTaskListConfigResponse, error) {
+	response, err := t.c.UpdateTaskListConfig(ctx, thrift.FromUpdateTaskListConfigRequest(request), opts...)
+	return thrift.ToUpdateTaskListConfigResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ListDeadLetterTasks(ctx context.Context, request *types.ListDeadLetterTasksRequest, opts ...yarpc.CallOption) (*types.ListDeadLetterTasksResponse, error) {
+	response, err := t.c.ListDeadLetterTasks(ctx, thrift.FromListDeadLetterTasksRequest(request), opts...)
+	return thrift.ToListDeadLetterTasksResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) RequeueDeadLetterTasks(ctx context.Context, request *types.RequeueDeadLetterTasksRequest, opts ...yarpc.CallOption) (*types.RequeueDeadLetterTasksResponse, error) {
+	response, err := t.c.RequeueDeadLetterTasks(ctx, thrift.FromRequeueDeadLetterTasksRequest(request), opts...)
+	return thrift.ToRequeueDeadLetterTasksResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) PurgeDeadLetterTasks(ctx context.Context, request *types.PurgeDeadLetterTasksRequest, opts ...yarpc.CallOption) (*types.PurgeDeadLetterTasksResponse, error) {
+	response, err := t.c.PurgeDeadLetterTasks(ctx, thrift.FromPurgeDeadLetterTasksRequest(request), opts...)
+	return thrift.ToPurgeDeadLetterTasksResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (*types.MigrateTaskListResponse, error) {
+	response, err := t.c.MigrateTaskList(ctx, thrift.FromMigrateTaskListRequest(request), opts...)
+	return thrift.ToMigrateTaskListResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteTaskList(ctx context.Context, request *types.DeleteTaskListRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteTaskList(ctx, thrift.FromDeleteTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ListStickyTaskLists(ctx context.Context, request *types.ListStickyTaskListsRequest, opts ...yarpc.CallOption) (*types.ListStickyTaskListsResponse, error) {
+	response, err := t.c.ListStickyTaskLists(ctx, thrift.FromListStickyTaskListsRequest(request), opts...)
+	return thrift.ToListStickyTaskListsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ResetStickyTaskLists(ctx context.Context, request *types.ResetStickyTaskListsRequest, opts ...yarpc.CallOption) (*types.ResetStickyTaskListsResponse, error) {
+	response, err := t.c.ResetStickyTaskLists(ctx, thrift.FromResetStickyTaskListsRequest(request), opts...)
+	return thrift.ToResetStickyTaskListsResponse(response), thrift.ToError(err)
+}
@@ -158,13 +158,14 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 	}
 
 	peerResolver := matching.NewPeerResolver(cf.resolver, namedPort)
+	partitionConfigProvider := matching.NewPartitionConfigProvider(rawClient, peerResolver, cf.logger)
 
 	client := matching.NewClient(
 		timeout,
 		longPollTimeout,
 		rawClient,
 		peerResolver,
-		matching.NewLoadBalancer(domainIDToName, cf.dynConfig),
+		matching.NewLoadBalancer(domainIDToName, cf.dynConfig, partitionConfigProvider),
 	)
 	if errorRate := cf.dynConfig.GetFloat64Property(dynamicconfig.MatchingErrorInjectionRate, 0)(); errorRate != 0 {
 		client = matching.NewErrorInjectionClient(client, errorRate, cf.logger)
@@ -241,6 +241,50 @@ func (g grpcClient) TerminateWorkflowExecution(ctx context.Context, request *typ
 	return proto.ToError(err)
 }
 
+func (g grpcClient) PauseWorkflowExecution(ctx context.Context, request *types.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) UnpauseWorkflowExecution(ctx context.Context, request *types.UnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) UpdateWorkflowExecution(ctx context.Context, request *types.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) DescribeSchedule(ctx context.Context, request *types.DescribeScheduleRequest, opts ...yarpc.CallOption) (*types.DescribeScheduleResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) UpdateSchedule(ctx context.Context, request *types.UpdateScheduleRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) PauseSchedule(ctx context.Context, request *types.PauseScheduleRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) UnpauseSchedule(ctx context.Context, request *types.UnpauseScheduleRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) DeleteSchedule(ctx context.Context, request *types.DeleteScheduleRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) BackfillSchedule(ctx context.Context, request *types.BackfillScheduleRequest, opts ...yarpc.CallOption) error {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g grpcClient) ListSchedules(ctx context.Context, request *types.ListSchedulesRequest, opts ...yarpc.CallOption) (*types.ListSchedulesResponse, error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g grpcClient) UpdateDomain(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	response, err := g.domain.UpdateDomain(ctx, proto.FromUpdateDomainRequest(request), opts...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
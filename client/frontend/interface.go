@@ -71,5 +71,16 @@ type Client interface {
 	SignalWorkflowExecution(context.Context, *types.SignalWorkflowExecutionRequest, ...yarpc.CallOption) error
 	StartWorkflowExecution(context.Context, *types.StartWorkflowExecutionRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UpdateWorkflowExecution(context.Context, *types.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error)
+	CreateSchedule(context.Context, *types.CreateScheduleRequest, ...yarpc.CallOption) error
+	DescribeSchedule(context.Context, *types.DescribeScheduleRequest, ...yarpc.CallOption) (*types.DescribeScheduleResponse, error)
+	UpdateSchedule(context.Context, *types.UpdateScheduleRequest, ...yarpc.CallOption) error
+	PauseSchedule(context.Context, *types.PauseScheduleRequest, ...yarpc.CallOption) error
+	UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest, ...yarpc.CallOption) error
+	DeleteSchedule(context.Context, *types.DeleteScheduleRequest, ...yarpc.CallOption) error
+	BackfillSchedule(context.Context, *types.BackfillScheduleRequest, ...yarpc.CallOption) error
+	ListSchedules(context.Context, *types.ListSchedulesRequest, ...yarpc.CallOption) (*types.ListSchedulesResponse, error)
 	UpdateDomain(context.Context, *types.UpdateDomainRequest, ...yarpc.CallOption) (*types.UpdateDomainResponse, error)
 }
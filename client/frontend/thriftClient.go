@@ -234,6 +234,61 @@ func (t thriftClient) TerminateWorkflowExecution(ctx context.Context, request *t
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) PauseWorkflowExecution(ctx context.Context, request *types.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseWorkflowExecution(ctx, thrift.FromPauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseWorkflowExecution(ctx context.Context, request *types.UnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseWorkflowExecution(ctx, thrift.FromUnpauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateWorkflowExecution(ctx context.Context, request *types.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	response, err := t.c.UpdateWorkflowExecution(ctx, thrift.FromUpdateWorkflowExecutionRequest(request), opts...)
+	return thrift.ToUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.CreateSchedule(ctx, thrift.FromCreateScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeSchedule(ctx context.Context, request *types.DescribeScheduleRequest, opts ...yarpc.CallOption) (*types.DescribeScheduleResponse, error) {
+	response, err := t.c.DescribeSchedule(ctx, thrift.FromDescribeScheduleRequest(request), opts...)
+	return thrift.ToDescribeScheduleResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateSchedule(ctx context.Context, request *types.UpdateScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UpdateSchedule(ctx, thrift.FromUpdateScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) PauseSchedule(ctx context.Context, request *types.PauseScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseSchedule(ctx, thrift.FromPauseScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseSchedule(ctx context.Context, request *types.UnpauseScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseSchedule(ctx, thrift.FromUnpauseScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteSchedule(ctx context.Context, request *types.DeleteScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteSchedule(ctx, thrift.FromDeleteScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) BackfillSchedule(ctx context.Context, request *types.BackfillScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.BackfillSchedule(ctx, thrift.FromBackfillScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ListSchedules(ctx context.Context, request *types.ListSchedulesRequest, opts ...yarpc.CallOption) (*types.ListSchedulesResponse, error) {
+	response, err := t.c.ListSchedules(ctx, thrift.FromListSchedulesRequest(request), opts...)
+	return thrift.ToListSchedulesResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) UpdateDomain(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	response, err := t.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(request), opts...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
@@ -289,3 +289,96 @@ func (c *clientImpl) createLongPollContext(
 	}
 	return context.WithTimeout(parent, c.longPollTimeout)
 }
+
+func (c *clientImpl) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.GetDescRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskListConfig(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
+func (c *clientImpl) UpdateTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListConfigResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.GetUpdateRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskListConfig(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
+func (c *clientImpl) RequeueDeadLetterTasks(
+	ctx context.Context,
+	request *types.MatchingRequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.GetRequeueRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RequeueDeadLetterTasks(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
+func (c *clientImpl) MigrateTaskList(
+	ctx context.Context,
+	request *types.MatchingMigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateTaskListResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.GetMigrateRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MigrateTaskList(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
+func (c *clientImpl) DeleteTaskList(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromTaskList(request.GetDeleteRequest().GetTaskList().GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteTaskList(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
+func (c *clientImpl) ListStickyTaskLists(
+	ctx context.Context,
+	request *types.MatchingListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingListStickyTaskListsResponse, error) {
+	peers, err := c.peerResolver.GetAllPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	var taskLists []*types.MatchingStickyTaskList
+	for _, peer := range peers {
+		resp, err := c.client.ListStickyTaskLists(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		if err != nil {
+			return nil, err
+		}
+		taskLists = append(taskLists, resp.GetTaskLists()...)
+	}
+	return &types.MatchingListStickyTaskListsResponse{
+		TaskLists: taskLists,
+	}, nil
+}
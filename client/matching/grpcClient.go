@@ -87,3 +87,33 @@ func (g grpcClient) RespondQueryTaskCompleted(ctx context.Context, request *type
 	_, err := g.c.RespondQueryTaskCompleted(ctx, proto.FromMatchingRespondQueryTaskCompletedRequest(request), opts...)
 	return proto.ToError(err)
 }
+
+func (g grpcClient) DescribeTaskListConfig(ctx context.Context, request *types.MatchingDescribeTaskListConfigRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	response, err := g.c.DescribeTaskListConfig(ctx, proto.FromMatchingDescribeTaskListConfigRequest(request), opts...)
+	return proto.ToMatchingDescribeTaskListConfigResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) UpdateTaskListConfig(ctx context.Context, request *types.MatchingUpdateTaskListConfigRequest, opts ...yarpc.CallOption) (*types.UpdateTaskListConfigResponse, error) {
+	response, err := g.c.UpdateTaskListConfig(ctx, proto.FromMatchingUpdateTaskListConfigRequest(request), opts...)
+	return proto.ToMatchingUpdateTaskListConfigResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) RequeueDeadLetterTasks(ctx context.Context, request *types.MatchingRequeueDeadLetterTasksRequest, opts ...yarpc.CallOption) (*types.RequeueDeadLetterTasksResponse, error) {
+	response, err := g.c.RequeueDeadLetterTasks(ctx, proto.FromMatchingRequeueDeadLetterTasksRequest(request), opts...)
+	return proto.ToMatchingRequeueDeadLetterTasksResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) MigrateTaskList(ctx context.Context, request *types.MatchingMigrateTaskListRequest, opts ...yarpc.CallOption) (*types.MigrateTaskListResponse, error) {
+	response, err := g.c.MigrateTaskList(ctx, proto.FromMatchingMigrateTaskListRequest(request), opts...)
+	return proto.ToMatchingMigrateTaskListResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) DeleteTaskList(ctx context.Context, request *types.MatchingDeleteTaskListRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.DeleteTaskList(ctx, proto.FromMatchingDeleteTaskListRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ListStickyTaskLists(ctx context.Context, request *types.MatchingListStickyTaskListsRequest, opts ...yarpc.CallOption) (*types.MatchingListStickyTaskListsResponse, error) {
+	response, err := g.c.ListStickyTaskLists(ctx, proto.FromMatchingListStickyTaskListsRequest(request), opts...)
+	return proto.ToMatchingListStickyTaskListsResponse(response), proto.ToError(err)
+}
@@ -42,4 +42,10 @@ type Client interface {
 	PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest, ...yarpc.CallOption) (*types.MatchingPollForDecisionTaskResponse, error)
 	QueryWorkflow(context.Context, *types.MatchingQueryWorkflowRequest, ...yarpc.CallOption) (*types.QueryWorkflowResponse, error)
 	RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest, ...yarpc.CallOption) error
+	DescribeTaskListConfig(context.Context, *types.MatchingDescribeTaskListConfigRequest, ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error)
+	UpdateTaskListConfig(context.Context, *types.MatchingUpdateTaskListConfigRequest, ...yarpc.CallOption) (*types.UpdateTaskListConfigResponse, error)
+	RequeueDeadLetterTasks(context.Context, *types.MatchingRequeueDeadLetterTasksRequest, ...yarpc.CallOption) (*types.RequeueDeadLetterTasksResponse, error)
+	MigrateTaskList(context.Context, *types.MatchingMigrateTaskListRequest, ...yarpc.CallOption) (*types.MigrateTaskListResponse, error)
+	DeleteTaskList(context.Context, *types.MatchingDeleteTaskListRequest, ...yarpc.CallOption) error
+	ListStickyTaskLists(context.Context, *types.MatchingListStickyTaskListsRequest, ...yarpc.CallOption) (*types.MatchingListStickyTaskListsResponse, error)
 }
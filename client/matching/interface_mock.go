@@ -116,6 +116,25 @@ func (mr *MockClientMockRecorder) CancelOutstandingPoll(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockClient)(nil).CancelOutstandingPoll), varargs...)
 }
 
+// DeleteTaskList mocks base method
+func (m *MockClient) DeleteTaskList(arg0 context.Context, arg1 *types.MatchingDeleteTaskListRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskList", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskList indicates an expected call of DeleteTaskList
+func (mr *MockClientMockRecorder) DeleteTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskList", reflect.TypeOf((*MockClient)(nil).DeleteTaskList), varargs...)
+}
+
 // DescribeTaskList mocks base method
 func (m *MockClient) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +155,46 @@ func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
 }
 
+// DescribeTaskListConfig mocks base method
+func (m *MockClient) DescribeTaskListConfig(arg0 context.Context, arg1 *types.MatchingDescribeTaskListConfigRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", varargs...)
+	ret0, _ := ret[0].(*types.DescribeTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListConfig indicates an expected call of DescribeTaskListConfig
+func (mr *MockClientMockRecorder) DescribeTaskListConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListConfig", reflect.TypeOf((*MockClient)(nil).DescribeTaskListConfig), varargs...)
+}
+
+// ListStickyTaskLists mocks base method
+func (m *MockClient) ListStickyTaskLists(arg0 context.Context, arg1 *types.MatchingListStickyTaskListsRequest, arg2 ...yarpc.CallOption) (*types.MatchingListStickyTaskListsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStickyTaskLists", varargs...)
+	ret0, _ := ret[0].(*types.MatchingListStickyTaskListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStickyTaskLists indicates an expected call of ListStickyTaskLists
+func (mr *MockClientMockRecorder) ListStickyTaskLists(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStickyTaskLists", reflect.TypeOf((*MockClient)(nil).ListStickyTaskLists), varargs...)
+}
+
 // ListTaskListPartitions mocks base method
 func (m *MockClient) ListTaskListPartitions(arg0 context.Context, arg1 *types.MatchingListTaskListPartitionsRequest, arg2 ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +235,26 @@ func (mr *MockClientMockRecorder) GetTaskListsByDomain(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListsByDomain", reflect.TypeOf((*MockClient)(nil).GetTaskListsByDomain), varargs...)
 }
 
+// MigrateTaskList mocks base method
+func (m *MockClient) MigrateTaskList(arg0 context.Context, arg1 *types.MatchingMigrateTaskListRequest, arg2 ...yarpc.CallOption) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateTaskList", varargs...)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList
+func (mr *MockClientMockRecorder) MigrateTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockClient)(nil).MigrateTaskList), varargs...)
+}
+
 // PollForActivityTask mocks base method
 func (m *MockClient) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest, arg2 ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +315,26 @@ func (mr *MockClientMockRecorder) QueryWorkflow(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockClient)(nil).QueryWorkflow), varargs...)
 }
 
+// RequeueDeadLetterTasks mocks base method
+func (m *MockClient) RequeueDeadLetterTasks(arg0 context.Context, arg1 *types.MatchingRequeueDeadLetterTasksRequest, arg2 ...yarpc.CallOption) (*types.RequeueDeadLetterTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequeueDeadLetterTasks", varargs...)
+	ret0, _ := ret[0].(*types.RequeueDeadLetterTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueDeadLetterTasks indicates an expected call of RequeueDeadLetterTasks
+func (mr *MockClientMockRecorder) RequeueDeadLetterTasks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetterTasks", reflect.TypeOf((*MockClient)(nil).RequeueDeadLetterTasks), varargs...)
+}
+
 // RespondQueryTaskCompleted mocks base method
 func (m *MockClient) RespondQueryTaskCompleted(arg0 context.Context, arg1 *types.MatchingRespondQueryTaskCompletedRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -254,3 +353,23 @@ func (mr *MockClientMockRecorder) RespondQueryTaskCompleted(arg0, arg1 interface
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockClient)(nil).RespondQueryTaskCompleted), varargs...)
 }
+
+// UpdateTaskListConfig mocks base method
+func (m *MockClient) UpdateTaskListConfig(arg0 context.Context, arg1 *types.MatchingUpdateTaskListConfigRequest, arg2 ...yarpc.CallOption) (*types.UpdateTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskListConfig", varargs...)
+	ret0, _ := ret[0].(*types.UpdateTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListConfig indicates an expected call of UpdateTaskListConfig
+func (mr *MockClientMockRecorder) UpdateTaskListConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListConfig", reflect.TypeOf((*MockClient)(nil).UpdateTaskListConfig), varargs...)
+}
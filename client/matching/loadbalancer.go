@@ -63,19 +63,26 @@ type (
 		nReadPartitions  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		nWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		domainIDToName   func(string) (string, error)
+		// partitionConfigProvider is optional, the dynamic config is used when
+		// the partition config of the task list is not known
+		partitionConfigProvider PartitionConfigProvider
 	}
 )
 
 // NewLoadBalancer returns an instance of matching load balancer that
-// can help distribute api calls across task list partitions
+// can help distribute api calls across task list partitions. The partition
+// counts reported by the root partition of the task list take precedence
+// over the dynamic config when a partition config provider is given.
 func NewLoadBalancer(
 	domainIDToName func(string) (string, error),
 	dc *dynamicconfig.Collection,
+	partitionConfigProvider PartitionConfigProvider,
 ) LoadBalancer {
 	return &defaultLoadBalancer{
-		domainIDToName:   domainIDToName,
-		nReadPartitions:  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
-		nWritePartitions: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
+		domainIDToName:          domainIDToName,
+		nReadPartitions:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
+		nWritePartitions:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
+		partitionConfigProvider: partitionConfigProvider,
 	}
 }
 
@@ -85,7 +92,8 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	taskListType int,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom, lb.nWritePartitions)
+	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom, lb.nWritePartitions,
+		func(config *types.TaskListPartitionConfig) int32 { return config.NumWritePartitions })
 }
 
 func (lb *defaultLoadBalancer) PickReadPartition(
@@ -94,7 +102,8 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	taskListType int,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom, lb.nReadPartitions)
+	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom, lb.nReadPartitions,
+		func(config *types.TaskListPartitionConfig) int32 { return config.NumReadPartitions })
 }
 
 func (lb *defaultLoadBalancer) pickPartition(
@@ -103,6 +112,7 @@ func (lb *defaultLoadBalancer) pickPartition(
 	taskListType int,
 	forwardedFrom string,
 	nPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+	nPartitionsFromConfig func(*types.TaskListPartitionConfig) int32,
 ) string {
 
 	if forwardedFrom != "" || taskList.GetKind() == types.TaskListKindSticky {
@@ -120,6 +130,12 @@ func (lb *defaultLoadBalancer) pickPartition(
 	}
 
 	n := nPartitions(domainName, taskList.GetName(), taskListType)
+	if lb.partitionConfigProvider != nil {
+		config := lb.partitionConfigProvider.GetPartitionConfig(domainID, taskList.GetName(), taskListType)
+		if config != nil && nPartitionsFromConfig(config) > 0 {
+			n = int(nPartitionsFromConfig(config))
+		}
+	}
 	if n <= 0 {
 		return taskList.GetName()
 	}
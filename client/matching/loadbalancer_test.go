@@ -0,0 +1,115 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+type testPartitionConfigProvider struct {
+	config *types.TaskListPartitionConfig
+}
+
+func (p *testPartitionConfigProvider) GetPartitionConfig(string, string, int) *types.TaskListPartitionConfig {
+	return p.config
+}
+
+func TestLoadBalancer_PartitionConfigOverridesDynamicConfig(t *testing.T) {
+	provider := &testPartitionConfigProvider{}
+	lb := NewLoadBalancer(
+		func(string) (string, error) { return "domain", nil },
+		dynamicconfig.NewCollection(dynamicconfig.NewInMemoryClient(), loggerimpl.NewNopLogger()),
+		provider,
+	)
+	taskList := types.TaskList{Name: "tl", Kind: types.TaskListKindNormal.Ptr()}
+
+	pickPartitions := func() map[string]struct{} {
+		partitions := make(map[string]struct{})
+		for i := 0; i != 100; i++ {
+			partitions[lb.PickWritePartition("domainID", taskList, persistence.TaskListTypeActivity, "")] = struct{}{}
+			partitions[lb.PickReadPartition("domainID", taskList, persistence.TaskListTypeActivity, "")] = struct{}{}
+		}
+		return partitions
+	}
+
+	// the dynamic config is used while the partition config is not known
+	assert.Equal(t, map[string]struct{}{"tl": {}}, pickPartitions())
+
+	provider.config = &types.TaskListPartitionConfig{NumReadPartitions: 2, NumWritePartitions: 2}
+	assert.Equal(t, map[string]struct{}{"tl": {}, "/__cadence_sys/tl/1": {}}, pickPartitions())
+
+	// forwarded requests are never load balanced
+	assert.Equal(t, "/__cadence_sys/tl/1", lb.PickWritePartition("domainID", types.TaskList{Name: "/__cadence_sys/tl/1"}, persistence.TaskListTypeActivity, "tl"))
+}
+
+func TestPartitionConfigProvider(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	client := NewMockClient(controller)
+	resolver := membership.NewMockResolver(controller)
+	host := membership.NewDetailedHostInfo("host:1234", "host_1234", membership.PortMap{membership.PortTchannel: 1234})
+	resolver.EXPECT().Lookup(service.Matching, "tl").Return(host, nil).AnyTimes()
+	resolver.EXPECT().LookupByAddress(service.Matching, "host:1234").Return(host, nil).AnyTimes()
+
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	provider := NewPartitionConfigProvider(client, NewPeerResolver(resolver, membership.PortTchannel), loggerimpl.NewNopLogger()).(*partitionConfigProviderImpl)
+	provider.timeSource = timeSource
+	key := partitionConfigKey{domainID: "domainID", taskList: "tl", taskListType: persistence.TaskListTypeDecision}
+	refreshed := func() bool {
+		entry, ok := provider.configs.Get(key).(*partitionConfigEntry)
+		return ok && entry.refreshTime.Equal(timeSource.Now())
+	}
+
+	config := &types.TaskListPartitionConfig{NumReadPartitions: 3, NumWritePartitions: 2}
+	client.EXPECT().DescribeTaskList(gomock.Any(), &types.MatchingDescribeTaskListRequest{
+		DomainUUID: "domainID",
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:              &types.TaskList{Name: "tl", Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType:          types.TaskListTypeDecision.Ptr(),
+			IncludeTaskListStatus: true,
+		},
+	}, gomock.Any()).Return(&types.DescribeTaskListResponse{PartitionConfig: config}, nil).Times(1)
+
+	// the config is loaded in the background
+	assert.Nil(t, provider.GetPartitionConfig("domainID", "tl", persistence.TaskListTypeDecision))
+	assert.Eventually(t, refreshed, time.Second, 10*time.Millisecond)
+	assert.Equal(t, config, provider.GetPartitionConfig("domainID", "tl", persistence.TaskListTypeDecision))
+
+	// the last known config is kept when the refresh fails
+	timeSource.Update(timeSource.Now().Add(partitionConfigRefreshInterval))
+	client.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(1)
+	assert.Equal(t, config, provider.GetPartitionConfig("domainID", "tl", persistence.TaskListTypeDecision))
+	assert.Eventually(t, refreshed, time.Second, 10*time.Millisecond)
+	assert.Equal(t, config, provider.GetPartitionConfig("domainID", "tl", persistence.TaskListTypeDecision))
+}
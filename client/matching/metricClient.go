@@ -278,3 +278,110 @@ func (c *metricClient) emitForwardedFromStats(
 		}
 	}
 }
+
+func (c *metricClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDescribeTaskListConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeTaskListConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListConfigScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) UpdateTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientUpdateTaskListConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateTaskListConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListConfigScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RequeueDeadLetterTasks(
+	ctx context.Context,
+	request *types.MatchingRequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientRequeueDeadLetterTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRequeueDeadLetterTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RequeueDeadLetterTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRequeueDeadLetterTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) MigrateTaskList(
+	ctx context.Context,
+	request *types.MatchingMigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientMigrateTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientMigrateTaskListScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MigrateTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientMigrateTaskListScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DeleteTaskList(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientDeleteTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDeleteTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDeleteTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListStickyTaskLists(
+	ctx context.Context,
+	request *types.MatchingListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingListStickyTaskListsResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientListStickyTaskListsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientListStickyTaskListsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListStickyTaskLists(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientListStickyTaskListsScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
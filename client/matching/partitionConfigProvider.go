@@ -0,0 +1,170 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	partitionConfigRefreshInterval = 10 * time.Second
+	partitionConfigDescribeTimeout = 5 * time.Second
+	partitionConfigCacheSize       = 10000
+)
+
+type (
+	// PartitionConfigProvider returns the partition counts of a task list as reported by
+	// its root partition, which owns the counts persisted by the partition autoscaler
+	PartitionConfigProvider interface {
+		// GetPartitionConfig returns the last known partition config of the task list, or nil
+		// if it is not known yet. Stale configs are refreshed in the background.
+		GetPartitionConfig(domainID string, taskList string, taskListType int) *types.TaskListPartitionConfig
+	}
+
+	partitionConfigProviderImpl struct {
+		client       Client
+		peerResolver PeerResolver
+		timeSource   clock.TimeSource
+		logger       log.Logger
+		configs      cache.Cache
+
+		sync.Mutex
+		refreshing map[partitionConfigKey]struct{}
+	}
+
+	partitionConfigKey struct {
+		domainID     string
+		taskList     string
+		taskListType int
+	}
+
+	partitionConfigEntry struct {
+		config      *types.TaskListPartitionConfig
+		refreshTime time.Time
+	}
+)
+
+// NewPartitionConfigProvider creates a provider which reads the partition config of task lists
+// from their root partition through the given matching client
+func NewPartitionConfigProvider(
+	client Client,
+	peerResolver PeerResolver,
+	logger log.Logger,
+) PartitionConfigProvider {
+	return &partitionConfigProviderImpl{
+		client:       client,
+		peerResolver: peerResolver,
+		timeSource:   clock.NewRealTimeSource(),
+		logger:       logger,
+		configs: cache.New(&cache.Options{
+			InitialCapacity: 32,
+			MaxCount:        partitionConfigCacheSize,
+		}),
+		refreshing: make(map[partitionConfigKey]struct{}),
+	}
+}
+
+func (p *partitionConfigProviderImpl) GetPartitionConfig(
+	domainID string,
+	taskList string,
+	taskListType int,
+) *types.TaskListPartitionConfig {
+	key := partitionConfigKey{domainID: domainID, taskList: taskList, taskListType: taskListType}
+	entry, ok := p.configs.Get(key).(*partitionConfigEntry)
+	if ok && p.timeSource.Now().Sub(entry.refreshTime) < partitionConfigRefreshInterval {
+		return entry.config
+	}
+
+	p.Lock()
+	_, inProgress := p.refreshing[key]
+	p.refreshing[key] = struct{}{}
+	p.Unlock()
+	if !inProgress {
+		go p.refresh(key)
+	}
+	if ok {
+		return entry.config
+	}
+	return nil
+}
+
+func (p *partitionConfigProviderImpl) refresh(key partitionConfigKey) {
+	defer func() {
+		p.Lock()
+		delete(p.refreshing, key)
+		p.Unlock()
+	}()
+
+	var previous *types.TaskListPartitionConfig
+	if entry, ok := p.configs.Get(key).(*partitionConfigEntry); ok {
+		previous = entry.config
+	}
+	config, err := p.describe(key)
+	if err != nil {
+		p.logger.Warn("Failed to refresh task list partition config",
+			tag.WorkflowDomainID(key.domainID),
+			tag.WorkflowTaskListName(key.taskList),
+			tag.Error(err))
+		// keep the previous config and retry after the refresh interval
+		config = previous
+	}
+	p.configs.Put(key, &partitionConfigEntry{
+		config:      config,
+		refreshTime: p.timeSource.Now(),
+	})
+}
+
+func (p *partitionConfigProviderImpl) describe(key partitionConfigKey) (*types.TaskListPartitionConfig, error) {
+	peer, err := p.peerResolver.FromTaskList(key.taskList)
+	if err != nil {
+		return nil, err
+	}
+
+	taskListType := types.TaskListTypeDecision
+	if key.taskListType == persistence.TaskListTypeActivity {
+		taskListType = types.TaskListTypeActivity
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), partitionConfigDescribeTimeout)
+	defer cancel()
+	resp, err := p.client.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: key.domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:              &types.TaskList{Name: key.taskList, Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: true,
+		},
+	}, yarpc.WithShardKey(peer))
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPartitionConfig(), nil
+}
@@ -203,3 +203,78 @@ func (c *retryableClient) GetTaskListsByDomain(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+
+	var resp *types.DescribeTaskListConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskListConfig(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListConfigResponse, error) {
+
+	var resp *types.UpdateTaskListConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateTaskListConfig(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) RequeueDeadLetterTasks(
+	ctx context.Context,
+	request *types.MatchingRequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	return c.client.RequeueDeadLetterTasks(ctx, request, opts...)
+}
+
+func (c *retryableClient) MigrateTaskList(
+	ctx context.Context,
+	request *types.MatchingMigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateTaskListResponse, error) {
+	return c.client.MigrateTaskList(ctx, request, opts...)
+}
+
+func (c *retryableClient) DeleteTaskList(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.client.DeleteTaskList(ctx, request, opts...)
+}
+
+func (c *retryableClient) ListStickyTaskLists(
+	ctx context.Context,
+	request *types.MatchingListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingListStickyTaskListsResponse, error) {
+
+	var resp *types.MatchingListStickyTaskListsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListStickyTaskLists(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
@@ -128,3 +128,57 @@ func (t thriftClient) RespondQueryTaskCompleted(
 	err := t.c.RespondQueryTaskCompleted(ctx, thrift.FromMatchingRespondQueryTaskCompletedRequest(request), opts...)
 	return thrift.ToError(err)
 }
+
+func (t thriftClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	response, err := t.c.DescribeTaskListConfig(ctx, thrift.FromMatchingDescribeTaskListConfigRequest(request), opts...)
+	return thrift.ToDescribeTaskListConfigResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListConfigResponse, error) {
+	response, err := t.c.UpdateTaskListConfig(ctx, thrift.FromMatchingUpdateTaskListConfigRequest(request), opts...)
+	return thrift.ToUpdateTaskListConfigResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) RequeueDeadLetterTasks(
+	ctx context.Context,
+	request *types.MatchingRequeueDeadLetterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	response, err := t.c.RequeueDeadLetterTasks(ctx, thrift.FromMatchingRequeueDeadLetterTasksRequest(request), opts...)
+	return thrift.ToRequeueDeadLetterTasksResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) MigrateTaskList(
+	ctx context.Context,
+	request *types.MatchingMigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateTaskListResponse, error) {
+	response, err := t.c.MigrateTaskList(ctx, thrift.FromMatchingMigrateTaskListRequest(request), opts...)
+	return thrift.ToMigrateTaskListResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteTaskList(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	err := t.c.DeleteTaskList(ctx, thrift.FromMatchingDeleteTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ListStickyTaskLists(
+	ctx context.Context,
+	request *types.MatchingListStickyTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingListStickyTaskListsResponse, error) {
+	response, err := t.c.ListStickyTaskLists(ctx, thrift.FromMatchingListStickyTaskListsRequest(request), opts...)
+	return thrift.ToMatchingListStickyTaskListsResponse(response), thrift.ToError(err)
+}
@@ -139,7 +139,7 @@ func (h *historyArchiver) Archive(
 
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator = archiver.NewHistoryIterator(ctx, request, h.container.HistoryV2Manager, targetHistoryBlobSize)
+		historyIterator = archiver.NewHistoryIterator(ctx, request, h.container.HistoryV2Manager, h.container.PayloadStore, targetHistoryBlobSize)
 	}
 
 	historyBatches := []*types.History{}
@@ -28,6 +28,7 @@ import (
 	"errors"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
 	"github.com/uber/cadence/common/types"
@@ -76,6 +77,7 @@ type (
 		ctx                   context.Context
 		request               *ArchiveHistoryRequest
 		historyV2Manager      persistence.HistoryManager
+		payloadStore          *payload.Store
 		sizeEstimator         SizeEstimator
 		historyPageSize       int
 		targetHistoryBlobSize int
@@ -86,14 +88,16 @@ var (
 	errIteratorDepleted = errors.New("iterator is depleted")
 )
 
-// NewHistoryIterator returns a new HistoryIterator
+// NewHistoryIterator returns a new HistoryIterator, payloads offloaded to the blobstore are
+// rehydrated if the payload store is not nil
 func NewHistoryIterator(
 	ctx context.Context,
 	request *ArchiveHistoryRequest,
 	historyV2Manager persistence.HistoryManager,
+	payloadStore *payload.Store,
 	targetHistoryBlobSize int,
 ) HistoryIterator {
-	return newHistoryIterator(ctx, request, historyV2Manager, targetHistoryBlobSize)
+	return newHistoryIterator(ctx, request, historyV2Manager, payloadStore, targetHistoryBlobSize)
 }
 
 // NewHistoryIteratorFromState returns a new HistoryIterator with specified state
@@ -101,10 +105,11 @@ func NewHistoryIteratorFromState(
 	ctx context.Context,
 	request *ArchiveHistoryRequest,
 	historyV2Manager persistence.HistoryManager,
+	payloadStore *payload.Store,
 	targetHistoryBlobSize int,
 	initialState []byte,
 ) (HistoryIterator, error) {
-	it := newHistoryIterator(ctx, request, historyV2Manager, targetHistoryBlobSize)
+	it := newHistoryIterator(ctx, request, historyV2Manager, payloadStore, targetHistoryBlobSize)
 	if initialState == nil {
 		return it, nil
 	}
@@ -118,6 +123,7 @@ func newHistoryIterator(
 	ctx context.Context,
 	request *ArchiveHistoryRequest,
 	historyV2Manager persistence.HistoryManager,
+	payloadStore *payload.Store,
 	targetHistoryBlobSize int,
 ) *historyIterator {
 	return &historyIterator{
@@ -128,6 +134,7 @@ func newHistoryIterator(
 		ctx:                   ctx,
 		request:               request,
 		historyV2Manager:      historyV2Manager,
+		payloadStore:          payloadStore,
 		historyPageSize:       historyPageSize,
 		targetHistoryBlobSize: targetHistoryBlobSize,
 		sizeEstimator:         NewJSONSizeEstimator(),
@@ -238,7 +245,15 @@ func (i *historyIterator) readHistory(ctx context.Context, firstEventID int64) (
 		ShardID:     common.IntPtr(i.request.ShardID),
 	}
 	historyBatches, _, _, err := persistenceutils.ReadFullPageV2EventsByBatch(ctx, i.historyV2Manager, req)
-	return historyBatches, err
+	if err != nil || i.payloadStore == nil {
+		return historyBatches, err
+	}
+	for _, batch := range historyBatches {
+		if err := i.payloadStore.RehydrateEvents(ctx, i.request.DomainID, batch.Events); err != nil {
+			return nil, err
+		}
+	}
+	return historyBatches, nil
 
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -67,6 +68,7 @@ type (
 		MetricsClient    metrics.Client
 		ClusterMetadata  cluster.Metadata
 		DomainCache      cache.DomainCache
+		PayloadStore     *payload.Store
 	}
 
 	// HistoryArchiver is used to archive history and read archived history
@@ -86,6 +86,7 @@ var (
 	// DefaultCLIFeatureFlags is the default FeatureFlags used by Cadence CLI
 	DefaultCLIFeatureFlags = shared.FeatureFlags{
 		WorkflowExecutionAlreadyCompletedErrorEnabled: common.BoolPtr(true),
+		WorkflowHistoryCompactionEnabled:              common.BoolPtr(true),
 	}
 )
 
@@ -98,6 +99,7 @@ type (
 		SupportsConsistentQuery(clientImpl string, clientFeatureVersion string) error
 		SupportsRawHistoryQuery(clientImpl string, clientFeatureVersion string) error
 		SupportsWorkflowAlreadyCompletedError(clientImpl string, clientFeatureVersion string, featureFlags shared.FeatureFlags) error
+		SupportsWorkflowHistoryCompaction(featureFlags shared.FeatureFlags) error
 	}
 
 	versionChecker struct {
@@ -228,6 +230,15 @@ func (vc *versionChecker) SupportsWorkflowAlreadyCompletedError(clientImpl strin
 	return &shared.FeatureNotEnabledError{FeatureFlag: "WorkflowExecutionAlreadyCompletedErrorEnabled"}
 }
 
+// SupportsWorkflowHistoryCompaction returns error if the client can't replay a compacted workflow history otherwise nil.
+// No released client version replays the WorkflowHistoryCompacted event, so the client has to declare it with the feature flag.
+func (vc *versionChecker) SupportsWorkflowHistoryCompaction(featureFlags shared.FeatureFlags) error {
+	if featureFlags.WorkflowHistoryCompactionEnabled != nil && *featureFlags.WorkflowHistoryCompactionEnabled {
+		return nil
+	}
+	return &shared.FeatureNotEnabledError{FeatureFlag: "WorkflowHistoryCompactionEnabled"}
+}
+
 func (vc *versionChecker) featureSupported(clientImpl string, clientFeatureVersion string, feature string) error {
 	// Some older clients may not provide clientImpl.
 	// If this is the case special handling needs to be done to maintain backwards compatibility.
@@ -128,3 +128,17 @@ func (mr *MockVersionCheckerMockRecorder) SupportsWorkflowAlreadyCompletedError(
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsWorkflowAlreadyCompletedError", reflect.TypeOf((*VersionCheckerMock)(nil).SupportsRawHistoryQuery), clientImpl, clientFeatureVersion)
 }
+
+// SupportsWorkflowHistoryCompaction mocks base method
+func (m *VersionCheckerMock) SupportsWorkflowHistoryCompaction(featureFlags shared.FeatureFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsWorkflowHistoryCompaction", featureFlags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SupportsWorkflowHistoryCompaction indicates an expected call of SupportsWorkflowHistoryCompaction
+func (mr *MockVersionCheckerMockRecorder) SupportsWorkflowHistoryCompaction(featureFlags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsWorkflowHistoryCompaction", reflect.TypeOf((*VersionCheckerMock)(nil).SupportsWorkflowHistoryCompaction), featureFlags)
+}
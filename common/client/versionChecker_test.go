@@ -427,6 +427,18 @@ func (s *VersionCheckerSuite) TestSupportsWorkflowAlreadyCompletedError() {
 	}
 }
 
+func (s *VersionCheckerSuite) TestSupportsWorkflowHistoryCompaction() {
+	vc := NewVersionChecker()
+	s.IsType(&shared.FeatureNotEnabledError{}, vc.SupportsWorkflowHistoryCompaction(shared.FeatureFlags{}))
+	s.IsType(&shared.FeatureNotEnabledError{}, vc.SupportsWorkflowHistoryCompaction(shared.FeatureFlags{
+		WorkflowHistoryCompactionEnabled: common.BoolPtr(false),
+	}))
+	s.NoError(vc.SupportsWorkflowHistoryCompaction(shared.FeatureFlags{
+		WorkflowHistoryCompactionEnabled: common.BoolPtr(true),
+	}))
+	s.NoError(vc.SupportsWorkflowHistoryCompaction(DefaultCLIFeatureFlags))
+}
+
 func (s *VersionCheckerSuite) getHigherVersion(version string) string {
 	split := strings.Split(version, ".")
 	s.Len(split, 3)
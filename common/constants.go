@@ -217,9 +217,43 @@ const StickyTaskConditionFailedErrorMsg = "StickyTaskConditionFailedError"
 // MemoKeyForOperator is the memo key for operator
 const MemoKeyForOperator = "operator"
 
+// TaskPriorityHeaderKey is the header key used to set the matching task priority of a workflow or an activity.
+// The value is a decimal integer, tasks with higher values are dispatched first.
+const TaskPriorityHeaderKey = "cadence-task-priority"
+
+// TaskLabelSelectorHeaderKey is the header key used to restrict the pollers an activity can be dispatched to.
+// The value is a comma separated list of key=value pairs, e.g. "gpu=true,zone=dca1", and the activity is only
+// dispatched to pollers advertising all of the labels.
+const TaskLabelSelectorHeaderKey = "cadence-task-label-selector"
+
+// TaskIsolationGroupHeaderKey is the header key used to set the isolation group (e.g. the zone) of a workflow or an activity.
+// Tasks are preferentially dispatched to pollers of the same isolation group and fall back to other pollers after a delay.
+const TaskIsolationGroupHeaderKey = "cadence-task-isolation-group"
+
+// HistoryCompactionMarkerName is the name of the marker a workflow records to provide its own state for history compaction.
+// When compaction is enabled for the domain and the worker declares the WorkflowHistoryCompactionEnabled feature flag,
+// history written before the decision that recorded the marker may be trimmed,
+// and the marker details are carried on the WorkflowHistoryCompacted event so the workflow can restore its state on replay.
+const HistoryCompactionMarkerName = "HistoryCompactionState"
+
 // ReservedTaskListPrefix is the required naming prefix for any task list partition other than partition 0
 const ReservedTaskListPrefix = "/__cadence_sys/"
 
+// DeadLetterTaskListPrefix is the naming prefix of the task lists holding the tasks of a task list
+// that could not be dispatched, see DeadLetterTaskListName
+const DeadLetterTaskListPrefix = ReservedTaskListPrefix + "__dlq/"
+
+// Reasons for a task to be moved to the dead-letter task list of its task list
+const (
+	// DeadLetterReasonExpired is used for tasks whose schedule to start timeout expired in the backlog
+	DeadLetterReasonExpired = "expired"
+	// DeadLetterReasonDispatchFailed is used for tasks that repeatedly failed to be started
+	DeadLetterReasonDispatchFailed = "dispatch-failed"
+)
+
+// DeadLetterReasons are all the reasons for a task to be dead-lettered
+var DeadLetterReasons = []string{DeadLetterReasonExpired, DeadLetterReasonDispatchFailed}
+
 type (
 	// VisibilityOperation is an enum that represents visibility message types
 	VisibilityOperation string
@@ -36,6 +36,8 @@ const (
 	Encoding        = "Encoding"
 	KafkaKey        = "KafkaKey"
 	BinaryChecksums = "BinaryChecksums"
+	Paused          = "Paused"
+	Inactive        = "Inactive"
 	TaskList        = "TaskList"
 	IsCron          = "IsCron"
 	NumClusters     = "NumClusters"
@@ -100,6 +102,8 @@ var systemIndexedKeys = map[string]interface{}{
 	TaskList:      shared.IndexedValueTypeKeyword,
 	IsCron:        shared.IndexedValueTypeBool,
 	NumClusters:   shared.IndexedValueTypeInt,
+	Paused:        shared.IndexedValueTypeBool,
+	Inactive:      shared.IndexedValueTypeBool,
 }
 
 // IsSystemIndexedKey return true is key is system added
@@ -557,13 +557,24 @@ func matchFilters(dcValue *types.DynamicConfigValue, filters map[dc.Filter]inter
 		}
 
 		requestValue, err := convertFromDataBlob(valueFilter.Value)
-		if err != nil || filters[filterKey] != requestValue {
+		if err != nil || !filterValueEqual(filters[filterKey], requestValue) {
 			return false
 		}
 	}
 	return true
 }
 
+// filterValueEqual compares the filter value passed by the caller with the value decoded from
+// a json blob, which is always float64 for numbers (e.g. task type)
+func filterValueEqual(filterValue interface{}, requestValue interface{}) bool {
+	if floatVal, ok := requestValue.(float64); ok {
+		if intVal, ok := filterValue.(int); ok {
+			return float64(intVal) == floatVal
+		}
+	}
+	return filterValue == requestValue
+}
+
 func validateClientConfig(config *csc.ClientConfig) error {
 	if config == nil {
 		return errors.New("no config found for config store based dynamic config client")
@@ -683,6 +683,24 @@ func (s *configStoreClientSuite) TestMatchFilters() {
 			},
 			matched: false,
 		},
+		{
+			v: &types.DynamicConfigValue{
+				Value: nil,
+				Filters: []*types.DynamicConfigFilter{
+					{
+						Name: "taskType",
+						Value: &types.DataBlob{
+							EncodingType: types.EncodingTypeJSON.Ptr(),
+							Data:         jsonMarshalHelper(1),
+						},
+					},
+				},
+			},
+			filters: map[dc.Filter]interface{}{
+				dc.TaskType: 1,
+			},
+			matched: true,
+		},
 	}
 
 	for index, tc := range testCases {
@@ -243,6 +243,20 @@ const (
 	// Default value: 51200 (50*1024)
 	// Allowed filters: DomainName
 	HistoryCountLimitWarn
+	// PayloadOffloadThreshold is the size above which workflow inputs and results are offloaded to the blobstore
+	// and replaced with a reference in history events, 0 disables offloading. Offloaded payloads are
+	// garbage-collected with the history of their workflow even after offloading is disabled
+	// KeyName: limit.payloadOffload.threshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PayloadOffloadThreshold
+	// PayloadOffloadSizeLimit is the max size of a payload offloaded to the blobstore
+	// KeyName: limit.payloadOffload.sizeLimit
+	// Value type: Int
+	// Default value: 33554432 (32*1024*1024)
+	// Allowed filters: DomainName
+	PayloadOffloadSizeLimit
 	// DomainNameMaxLength is the length limit for domain name
 	// KeyName: limit.domainNameLength
 	// Value type: Int
@@ -669,6 +683,111 @@ const (
 	// Default value: 100ms
 	// Allowed filters: DomainName
 	MatchingActivityTaskSyncMatchWaitTime
+	// MatchingEnablePartitionAutoscaler is to enable automatic scaling of task list partitions based on load,
+	// scaling decisions are persisted on the root partition of the task list and take precedence over
+	// numTasklistWritePartitions and numTasklistReadPartitions
+	// KeyName: matching.enablePartitionAutoscaler
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnablePartitionAutoscaler
+	// MatchingPartitionAutoscalerInterval is the interval at which the partition autoscaler evaluates a task list
+	// KeyName: matching.partitionAutoscalerInterval
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionAutoscalerInterval
+	// MatchingPartitionAutoscalerTargetRPSPerPartition is the add task rate each write partition is expected to handle
+	// KeyName: matching.partitionAutoscalerTargetRPSPerPartition
+	// Value type: Int
+	// Default value: 200
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionAutoscalerTargetRPSPerPartition
+	// MatchingPartitionAutoscalerBacklogThreshold is the per partition backlog above which the autoscaler adds a write partition
+	// KeyName: matching.partitionAutoscalerBacklogThreshold
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionAutoscalerBacklogThreshold
+	// MatchingPartitionAutoscalerMaxPartitions is the max number of partitions the autoscaler can scale a task list to
+	// KeyName: matching.partitionAutoscalerMaxPartitions
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionAutoscalerMaxPartitions
+	// MatchingPartitionAutoscalerDownscaleCooldown is the min amount of time between the last scaling decision and reducing write partitions
+	// KeyName: matching.partitionAutoscalerDownscaleCooldown
+	// Value type: Duration
+	// Default value: 10m
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionAutoscalerDownscaleCooldown
+	// MatchingEnableTaskPriority enables dispatching backlog tasks in priority order, preempting lower priority tasks waiting for a poller
+	// KeyName: matching.enableTaskPriority
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableTaskPriority
+	// MatchingTaskPriorityStarvationThreshold is the number of consecutive backlog dispatches that may skip the oldest buffered task
+	// in favor of higher priority tasks before the oldest task is dispatched regardless of its priority
+	// KeyName: matching.taskPriorityStarvationThreshold
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskPriorityStarvationThreshold
+	// MatchingTaskPriorityBufferSize is the max number of backlog tasks loaded in memory and dispatched in priority order
+	// when task priority is enabled, the task list keeps reading its backlog while a task waits for a poller until it is reached
+	// KeyName: matching.taskPriorityBufferSize
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskPriorityBufferSize
+	// MatchingEnableTaskDeadLetter enables moving expired tasks and tasks that repeatedly failed to be started
+	// to the dead-letter task lists of the task list instead of dropping them
+	// KeyName: matching.enableTaskDeadLetter
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableTaskDeadLetter
+	// MatchingMaxTaskDispatchAttempts is the number of times a backlog task may fail to be started before it is dead-lettered,
+	// 0 means the task is retried forever
+	// KeyName: matching.maxTaskDispatchAttempts
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxTaskDispatchAttempts
+	// MatchingDeadLetterTaskRetention is the amount of time dead-lettered tasks are kept before they are deleted
+	// KeyName: matching.deadLetterTaskRetention
+	// Value type: Duration
+	// Default value: 168h (7 days)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingDeadLetterTaskRetention
+	// MatchingEnableIsolationGroups enables dispatching tasks preferentially to pollers of the same isolation group
+	// KeyName: matching.enableIsolationGroups
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableIsolationGroups
+	// MatchingIsolationGroupFallbackDelay is the amount of time a task waits for a poller of its isolation group
+	// before it can be dispatched to any poller
+	// KeyName: matching.isolationGroupFallbackDelay
+	// Value type: Duration
+	// Default value: 5s
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingIsolationGroupFallbackDelay
+	// MatchingLabeledTaskBacklogTimeout is the amount of time a backlog task with a label selector of a child partition waits
+	// in memory for a compatible poller before it is offered to the parent partition
+	// KeyName: matching.labeledTaskBacklogTimeout
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingLabeledTaskBacklogTimeout
+	// MatchingMaxLabeledTasks is the max number of tasks with a label selector held in memory per task list partition
+	// while they wait for a compatible poller, tasks read from the backlog once the limit is reached are dispatched to any poller
+	// KeyName: matching.maxLabeledTasks
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxLabeledTasks
 
 	// key for history
 
@@ -1536,6 +1655,25 @@ const (
 	// Default value: 1
 	// Allowed filters: N/A
 	MaxBufferedQueryCount
+	// EnableWorkflowUpdateByDomain indicates if workflow update API is enabled for a domain
+	// KeyName: history.enableWorkflowUpdateByDomain
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableWorkflowUpdateByDomain
+	// MaxBufferedUpdateCount indicates the maximum number of updates which can be in-flight at a given time for a single workflow
+	// KeyName: history.maxBufferedUpdateCount
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	MaxBufferedUpdateCount
+	// MaxCompletedUpdateCount is the maximum number of completed updates of a single workflow whose results are kept
+	// to deduplicate retried update requests, the results of older updates are dropped
+	// KeyName: history.maxCompletedUpdateCount
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	MaxCompletedUpdateCount
 	// MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state
 	// KeyName: history.mutableStateChecksumGenProbability
 	// Value type: Int
@@ -1584,6 +1722,60 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	MaxActivityCountDispatchByDomain
+	// EnableTaskPriority enables reading the task priority header from workflow start and activity scheduled events
+	// so that decision and activity tasks are added to matching with their priority
+	// KeyName: history.enableTaskPriority
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableTaskPriority
+	// EnableTaskIsolationGroups enables reading the isolation group header from workflow start and activity scheduled events
+	// so that decision and activity tasks are added to matching with their isolation group
+	// KeyName: history.enableTaskIsolationGroups
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableTaskIsolationGroups
+	// NDCReapplySignals is whether signals are reapplied to the current run when resolving conflicts between clusters
+	// KeyName: history.ndcReapplySignals
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: DomainName
+	NDCReapplySignals
+	// NDCReapplyUpsertSearchAttributes is whether search attribute upserts are reapplied to the current run when resolving conflicts between clusters
+	// KeyName: history.ndcReapplyUpsertSearchAttributes
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	NDCReapplyUpsertSearchAttributes
+	// NDCReapplyCancelRequests is whether cancellation requests are reapplied to the current run when resolving conflicts between clusters
+	// KeyName: history.ndcReapplyCancelRequests
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	NDCReapplyCancelRequests
+	// EnableHistoryCompaction is whether workflows can compact their history by recording the history compaction state marker.
+	// Only workers declaring the WorkflowHistoryCompactionEnabled client feature flag compact, and only such clients can read a compacted history.
+	// Limitations: compaction is not supported for global domains and is skipped for them, as the trimmed history can't be replicated.
+	// The history archived when the workflow closes is the compacted history, events trimmed before are not archived.
+	// KeyName: history.enableHistoryCompaction
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableHistoryCompaction
+	// HistoryCompactionEventThreshold is the minimum number of events written since the previous compaction before the history of a workflow is compacted again
+	// KeyName: history.historyCompactionEventThreshold
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: DomainName
+	HistoryCompactionEventThreshold
+	// HistoryCompactionSnapshotSizeLimit is the maximum size in bytes of the mutable state snapshot carried on the history compaction event,
+	// workflows with a larger state are not compacted
+	// KeyName: history.historyCompactionSnapshotSizeLimit
+	// Value type: Int
+	// Default value: 1048576 (1MB)
+	// Allowed filters: DomainName
+	HistoryCompactionSnapshotSizeLimit
 	// HistoryErrorInjectionRate is rate for injecting random error in history client
 	// KeyName: history.errorInjectionRate
 	// Value type: Float64
@@ -1602,6 +1794,25 @@ const (
 	// Default value: 30m (30*time.Minute)
 	// Allowed filters: DomainName
 	ActivityMaxScheduleToStartTimeoutForRetry
+	// ActivityAttemptLogMaxSize is the max number of previous attempts kept in the attempt log of a retrying activity, 0 disables the attempt log
+	// KeyName: history.activityAttemptLogMaxSize
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityAttemptLogMaxSize
+	// ActivityAttemptLogDetailsSizeLimit is the max size in bytes of the failure details and of the heartbeat details kept for an attempt in the activity attempt log,
+	// larger details are dropped and the attempt is marked with FailureDetailsTruncated or HeartbeatDetailsTruncated instead
+	// KeyName: history.activityAttemptLogDetailsSizeLimit
+	// Value type: Int
+	// Default value: 1024
+	// Allowed filters: DomainName
+	ActivityAttemptLogDetailsSizeLimit
+	// ActivityAttemptLogSizeLimit is the max total size in bytes of the attempt log of an activity, the oldest attempts are dropped first to stay within it
+	// KeyName: history.activityAttemptLogSizeLimit
+	// Value type: Int
+	// Default value: 16384
+	// Allowed filters: DomainName
+	ActivityAttemptLogSizeLimit
 
 	// key for history replication
 
@@ -1891,6 +2102,12 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	ConcreteExecutionsScannerInvariantCollectionHistory
+	// ConcreteExecutionsScannerInvariantCollectionPendingInfo is indicates if pending info invariant checks should be run
+	// KeyName: worker.executionsScannerInvariantCollectionPendingInfo
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ConcreteExecutionsScannerInvariantCollectionPendingInfo
 	// CurrentExecutionsScannerEnabled is indicates if current executions scanner should be started as part of worker.Scanner
 	// KeyName: worker.currentExecutionsScannerEnabled
 	// Value type: Bool
@@ -1939,6 +2156,12 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableBatcher
+	// EnableScheduler is decides whether start scheduler in our worker
+	// KeyName: worker.enableScheduler
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableScheduler
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	// KeyName: system.enableParentClosePolicyWorker
 	// Value type: Bool
@@ -2041,6 +2264,48 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	TimersFixerDomainAllow
+	// VisibilityScannerEnabled is if visibility scanner should be started as part of worker.Scanner
+	// KeyName: worker.visibilityScannerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	VisibilityScannerEnabled
+	// VisibilityFixerEnabled is if visibility fixer should be started as part of worker.Scanner
+	// KeyName: worker.visibilityFixerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	VisibilityFixerEnabled
+	// VisibilityScannerConcurrency is the concurrency of visibility scanner
+	// KeyName: worker.visibilityScannerConcurrency
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: N/A
+	VisibilityScannerConcurrency
+	// VisibilityScannerPersistencePageSize is the page size of execution persistence fetches in visibility scanner
+	// KeyName: worker.visibilityScannerPersistencePageSize
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: N/A
+	VisibilityScannerPersistencePageSize
+	// VisibilityScannerBlobstoreFlushThreshold is threshold to flush blob store
+	// KeyName: worker.visibilityScannerBlobstoreFlushThreshold
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	VisibilityScannerBlobstoreFlushThreshold
+	// VisibilityScannerActivityBatchSize is the number of shards scanned by a single visibility scanner activity
+	// KeyName: worker.visibilityScannerActivityBatchSize
+	// Value type: Int
+	// Default value: 25
+	// Allowed filters: N/A
+	VisibilityScannerActivityBatchSize
+	// VisibilityFixerDomainAllow is which domains are allowed to be fixed by visibility fixer workflow
+	// KeyName: worker.visibilityFixerDomainAllow
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	VisibilityFixerDomainAllow
 	// ConcreteExecutionFixerEnabled is if concrete execution fixer workflow is enabled
 	// KeyName: worker.concreteExecutionFixerEnabled
 	// Value type: Bool
@@ -2210,6 +2475,7 @@ var Keys = map[Key]string{
 	MaxDecisionStartToCloseSeconds:      "system.maxDecisionStartToCloseSeconds",
 	DisallowQuery:                       "system.disallowQuery",
 	EnableBatcher:                       "worker.enableBatcher",
+	EnableScheduler:                     "worker.enableScheduler",
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
 	EnableESAnalyzer:                    "system.enableESAnalyzer",
 	EnableFailoverManager:               "system.enableFailoverManager",
@@ -2232,6 +2498,10 @@ var Keys = map[Key]string{
 	HistoryCountLimitError: "limit.historyCount.error",
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 
+	// payload offloading
+	PayloadOffloadThreshold: "limit.payloadOffload.threshold",
+	PayloadOffloadSizeLimit: "limit.payloadOffload.sizeLimit",
+
 	// id length limits
 	MaxIDLengthWarnLimit:  "limit.maxIDWarnLength",
 	DomainNameMaxLength:   "limit.domainNameLength",
@@ -2310,6 +2580,24 @@ var Keys = map[Key]string{
 	MatchingEnableTaskInfoLogByDomainID:     "matching.enableTaskInfoLogByDomainID",
 	MatchingActivityTaskSyncMatchWaitTime:   "matching.activityTaskSyncMatchWaitTime",
 
+	MatchingEnablePartitionAutoscaler:                "matching.enablePartitionAutoscaler",
+	MatchingPartitionAutoscalerInterval:              "matching.partitionAutoscalerInterval",
+	MatchingPartitionAutoscalerTargetRPSPerPartition: "matching.partitionAutoscalerTargetRPSPerPartition",
+	MatchingPartitionAutoscalerBacklogThreshold:      "matching.partitionAutoscalerBacklogThreshold",
+	MatchingPartitionAutoscalerMaxPartitions:         "matching.partitionAutoscalerMaxPartitions",
+	MatchingPartitionAutoscalerDownscaleCooldown:     "matching.partitionAutoscalerDownscaleCooldown",
+
+	MatchingEnableTaskPriority:              "matching.enableTaskPriority",
+	MatchingTaskPriorityStarvationThreshold: "matching.taskPriorityStarvationThreshold",
+	MatchingTaskPriorityBufferSize:          "matching.taskPriorityBufferSize",
+	MatchingEnableTaskDeadLetter:            "matching.enableTaskDeadLetter",
+	MatchingMaxTaskDispatchAttempts:         "matching.maxTaskDispatchAttempts",
+	MatchingDeadLetterTaskRetention:         "matching.deadLetterTaskRetention",
+	MatchingEnableIsolationGroups:           "matching.enableIsolationGroups",
+	MatchingIsolationGroupFallbackDelay:     "matching.isolationGroupFallbackDelay",
+	MatchingLabeledTaskBacklogTimeout:       "matching.labeledTaskBacklogTimeout",
+	MatchingMaxLabeledTasks:                 "matching.maxLabeledTasks",
+
 	// history settings
 	HistoryRPS:                                         "history.rps",
 	HistoryPersistenceMaxQPS:                           "history.persistenceMaxQPS",
@@ -2478,6 +2766,9 @@ var Keys = map[Key]string{
 	EnableConsistentQueryByDomain:                      "history.EnableConsistentQueryByDomain",
 	EnableCrossClusterOperations:                       "history.enableCrossClusterOperations",
 	MaxBufferedQueryCount:                              "history.MaxBufferedQueryCount",
+	EnableWorkflowUpdateByDomain:                       "history.enableWorkflowUpdateByDomain",
+	MaxBufferedUpdateCount:                             "history.maxBufferedUpdateCount",
+	MaxCompletedUpdateCount:                            "history.maxCompletedUpdateCount",
 	MutableStateChecksumGenProbability:                 "history.mutableStateChecksumGenProbability",
 	MutableStateChecksumVerifyProbability:              "history.mutableStateChecksumVerifyProbability",
 	MutableStateChecksumInvalidateBefore:               "history.mutableStateChecksumInvalidateBefore",
@@ -2487,9 +2778,20 @@ var Keys = map[Key]string{
 	EnableDropStuckTaskByDomainID:                      "history.DropStuckTaskByDomain",
 	EnableActivityLocalDispatchByDomain:                "history.enableActivityLocalDispatchByDomain",
 	MaxActivityCountDispatchByDomain:                   "history.maxActivityCountDispatchByDomain",
+	EnableTaskPriority:                                 "history.enableTaskPriority",
+	EnableTaskIsolationGroups:                          "history.enableTaskIsolationGroups",
+	NDCReapplySignals:                                  "history.ndcReapplySignals",
+	NDCReapplyUpsertSearchAttributes:                   "history.ndcReapplyUpsertSearchAttributes",
+	NDCReapplyCancelRequests:                           "history.ndcReapplyCancelRequests",
+	EnableHistoryCompaction:                            "history.enableHistoryCompaction",
+	HistoryCompactionEventThreshold:                    "history.historyCompactionEventThreshold",
+	HistoryCompactionSnapshotSizeLimit:                 "history.historyCompactionSnapshotSizeLimit",
 	HistoryErrorInjectionRate:                          "history.errorInjectionRate",
 	HistoryEnableTaskInfoLogByDomainID:                 "history.enableTaskInfoLogByDomainID",
 	ActivityMaxScheduleToStartTimeoutForRetry:          "history.activityMaxScheduleToStartTimeoutForRetry",
+	ActivityAttemptLogMaxSize:                          "history.activityAttemptLogMaxSize",
+	ActivityAttemptLogDetailsSizeLimit:                 "history.activityAttemptLogDetailsSizeLimit",
+	ActivityAttemptLogSizeLimit:                        "history.activityAttemptLogSizeLimit",
 
 	WorkerPersistenceMaxQPS:                                  "worker.persistenceMaxQPS",
 	WorkerPersistenceGlobalMaxQPS:                            "worker.persistenceGlobalMaxQPS",
@@ -2518,6 +2820,7 @@ var Keys = map[Key]string{
 	ConcreteExecutionsScannerPersistencePageSize:             "worker.executionsScannerPersistencePageSize",
 	ConcreteExecutionsScannerInvariantCollectionHistory:      "worker.executionsScannerInvariantCollectionHistory",
 	ConcreteExecutionsScannerInvariantCollectionMutableState: "worker.executionsScannerInvariantCollectionMutableState",
+	ConcreteExecutionsScannerInvariantCollectionPendingInfo:  "worker.executionsScannerInvariantCollectionPendingInfo",
 	CurrentExecutionsScannerEnabled:                          "worker.currentExecutionsScannerEnabled",
 	CurrentExecutionsScannerBlobstoreFlushThreshold:          "worker.currentExecutionsBlobstoreFlushThreshold",
 	CurrentExecutionsScannerActivityBatchSize:                "worker.currentExecutionsActivityBatchSize",
@@ -2538,6 +2841,13 @@ var Keys = map[Key]string{
 	TimersScannerPeriodStart:                                 "worker.timersScannerPeriodStart",
 	TimersScannerPeriodEnd:                                   "worker.timersScannerPeriodEnd",
 	TimersFixerDomainAllow:                                   "worker.timersFixerDomainAllow",
+	VisibilityScannerEnabled:                                 "worker.visibilityScannerEnabled",
+	VisibilityFixerEnabled:                                   "worker.visibilityFixerEnabled",
+	VisibilityScannerConcurrency:                             "worker.visibilityScannerConcurrency",
+	VisibilityScannerPersistencePageSize:                     "worker.visibilityScannerPersistencePageSize",
+	VisibilityScannerBlobstoreFlushThreshold:                 "worker.visibilityScannerBlobstoreFlushThreshold",
+	VisibilityScannerActivityBatchSize:                       "worker.visibilityScannerActivityBatchSize",
+	VisibilityFixerDomainAllow:                               "worker.visibilityFixerDomainAllow",
 
 	// used by internal repos, need to moved out of this repo
 	// TODO https://github.com/uber/cadence/issues/3861
@@ -104,6 +104,20 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, domain)
 	s.Equal(`StartTime is read-only Cadence reservered attribute`, err.Error())
 
+	fields = map[string][]byte{
+		"Paused": []byte(`true`),
+	}
+	attr.IndexedFields = fields
+	err = validator.ValidateSearchAttributes(attr, domain)
+	s.Equal(`Paused is read-only Cadence reservered attribute`, err.Error())
+
+	fields = map[string][]byte{
+		"Inactive": []byte(`true`),
+	}
+	attr.IndexedFields = fields
+	err = validator.ValidateSearchAttributes(attr, domain)
+	s.Equal(`Inactive is read-only Cadence reservered attribute`, err.Error())
+
 	fields = map[string][]byte{
 		"CustomKeywordField": []byte(`"123456"`),
 	}
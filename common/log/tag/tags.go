@@ -148,6 +148,11 @@ func QueryID(queryID string) Tag {
 	return newStringTag("query-id", queryID)
 }
 
+// UpdateID returns tag for UpdateID
+func UpdateID(updateID string) Tag {
+	return newStringTag("update-id", updateID)
+}
+
 // BlobSizeViolationOperation returns tag for BlobSizeViolationOperation
 func BlobSizeViolationOperation(operation string) Tag {
 	return newStringTag("blob-size-violation-operation", operation)
@@ -256,6 +261,11 @@ func WorkflowTaskListName(taskListName string) Tag {
 	return newStringTag("wf-task-list-name", taskListName)
 }
 
+// WorkflowTaskDeadLetterReason returns tag for the reason a task was dead-lettered
+func WorkflowTaskDeadLetterReason(reason string) Tag {
+	return newStringTag("wf-task-dead-letter-reason", reason)
+}
+
 // size limit
 
 // WorkflowSize returns tag for WorkflowSize
@@ -36,6 +36,12 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused               = workflowAction("add-workflow-unpaused-event")
+	WorkflowActionWorkflowUpdateRequested        = workflowAction("add-workflow-update-requested-event")
+	WorkflowActionWorkflowUpdateCompleted        = workflowAction("add-workflow-update-completed-event")
+	WorkflowActionWorkflowHistoryCompacted       = workflowAction("add-workflow-history-compacted-event")
+	WorkflowActionWorkflowInactive               = workflowAction("add-workflow-inactive-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -122,6 +128,7 @@ var (
 	ComponentESVisibilityManager        = component("es-visibility-manager")
 	ComponentArchiver                   = component("archiver")
 	ComponentBatcher                    = component("batcher")
+	ComponentScheduler                  = component("scheduler")
 	ComponentWorker                     = component("worker")
 	ComponentServiceResolver            = component("service-resolver")
 	ComponentFailoverCoordinator        = component("failover-coordinator")
@@ -217,6 +224,7 @@ var (
 	StoreOperationCompleteTask          = storeOperation("complete-task")
 	StoreOperationCompleteTasksLessThan = storeOperation("complete-tasks-less-than")
 	StoreOperationLeaseTaskList         = storeOperation("lease-task-list")
+	StoreOperationGetTaskList           = storeOperation("get-task-list")
 	StoreOperationUpdateTaskList        = storeOperation("update-task-list")
 	StoreOperationListTaskList          = storeOperation("list-task-list")
 	StoreOperationDeleteTaskList        = storeOperation("delete-task-list")
@@ -252,6 +260,7 @@ var (
 	StoreOperationReadRawHistoryBranch      = storeOperation("read-raw-history-branch")
 	StoreOperationForkHistoryBranch         = storeOperation("fork-history-branch")
 	StoreOperationDeleteHistoryBranch       = storeOperation("delete-history-branch")
+	StoreOperationTrimHistoryBranch         = storeOperation("trim-history-branch")
 	StoreOperationGetHistoryTree            = storeOperation("get-history-tree")
 	StoreOperationGetAllHistoryTreeBranches = storeOperation("get-all-history-tree-branches")
 
@@ -302,6 +311,20 @@ var (
 	AdminClientOperationListDynamicConfig                 = clientOperation("admin-list-dynamic-config")
 	AdminDeleteWorkflow                                   = clientOperation("admin-delete-workflow")
 	MaintainCorruptWorkflow                               = clientOperation("maintain-corrupt-workflow")
+	AdminClientOperationListScanRuns                      = clientOperation("admin-list-scan-runs")
+	AdminClientOperationDescribeScanResults               = clientOperation("admin-describe-scan-results")
+	AdminClientOperationListScanCorruptions               = clientOperation("admin-list-scan-corruptions")
+	AdminClientOperationFixScanResults                    = clientOperation("admin-fix-scan-results")
+	AdminClientOperationGetTaskListBacklog                = clientOperation("admin-get-task-list-backlog")
+	AdminClientOperationDescribeTaskListConfig            = clientOperation("admin-describe-task-list-config")
+	AdminClientOperationUpdateTaskListConfig              = clientOperation("admin-update-task-list-config")
+	AdminClientOperationListDeadLetterTasks               = clientOperation("admin-list-dead-letter-tasks")
+	AdminClientOperationRequeueDeadLetterTasks            = clientOperation("admin-requeue-dead-letter-tasks")
+	AdminClientOperationPurgeDeadLetterTasks              = clientOperation("admin-purge-dead-letter-tasks")
+	AdminClientOperationMigrateTaskList                   = clientOperation("admin-migrate-task-list")
+	AdminClientOperationDeleteTaskList                    = clientOperation("admin-delete-task-list")
+	AdminClientOperationListStickyTaskLists               = clientOperation("admin-list-sticky-task-lists")
+	AdminClientOperationResetStickyTaskLists              = clientOperation("admin-reset-sticky-task-lists")
 
 	FrontendClientOperationDeprecateDomain                  = clientOperation("frontend-deprecate-domain")
 	FrontendClientOperationDescribeDomain                   = clientOperation("frontend-describe-domain")
@@ -339,6 +362,17 @@ var (
 	FrontendClientOperationSignalWorkflowExecution          = clientOperation("frontend-signal-wf-execution")
 	FrontendClientOperationStartWorkflowExecution           = clientOperation("frontend-start-wf-execution")
 	FrontendClientOperationTerminateWorkflowExecution       = clientOperation("frontend-terminate-wf-execution")
+	FrontendClientOperationPauseWorkflowExecution           = clientOperation("frontend-pause-wf-execution")
+	FrontendClientOperationUnpauseWorkflowExecution         = clientOperation("frontend-unpause-wf-execution")
+	FrontendClientOperationUpdateWorkflowExecution          = clientOperation("frontend-update-wf-execution")
+	FrontendClientOperationCreateSchedule                   = clientOperation("frontend-create-schedule")
+	FrontendClientOperationDescribeSchedule                 = clientOperation("frontend-describe-schedule")
+	FrontendClientOperationUpdateSchedule                   = clientOperation("frontend-update-schedule")
+	FrontendClientOperationPauseSchedule                    = clientOperation("frontend-pause-schedule")
+	FrontendClientOperationUnpauseSchedule                  = clientOperation("frontend-unpause-schedule")
+	FrontendClientOperationDeleteSchedule                   = clientOperation("frontend-delete-schedule")
+	FrontendClientOperationBackfillSchedule                 = clientOperation("frontend-backfill-schedule")
+	FrontendClientOperationListSchedules                    = clientOperation("frontend-list-schedules")
 	FrontendClientOperationUpdateDomain                     = clientOperation("frontend-update-domain")
 	FrontendClientOperationGetClusterInfo                   = clientOperation("frontend-get-cluster-info")
 	FrontendClientOperationListTaskListPartitions           = clientOperation("frontend-list-task-list-partitions")
@@ -368,6 +402,9 @@ var (
 	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
 	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
 	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
+	HistoryClientOperationPauseWorkflowExecution            = clientOperation("history-pause-wf-execution")
+	HistoryClientOperationUnpauseWorkflowExecution          = clientOperation("history-unpause-wf-execution")
+	HistoryClientOperationUpdateWorkflowExecution           = clientOperation("history-update-wf-execution")
 	HistoryClientOperationResetWorkflowExecution            = clientOperation("history-reset-wf-execution")
 	HistoryClientOperationScheduleDecisionTask              = clientOperation("history-schedule-decision-task")
 	HistoryClientOperationRecordChildExecutionCompleted     = clientOperation("history-record-child-execution-completed")
@@ -398,6 +435,12 @@ var (
 	MatchingClientOperationDescribeTaskList       = clientOperation("matching-describe-task-list")
 	MatchingClientOperationListTaskListPartitions = clientOperation("matching-list-task-list-partitions")
 	MatchingClientOperationGetTaskListsByDomain   = clientOperation("get-task-list-for-domain")
+	MatchingClientOperationDescribeTaskListConfig = clientOperation("matching-describe-task-list-config")
+	MatchingClientOperationUpdateTaskListConfig   = clientOperation("matching-update-task-list-config")
+	MatchingClientOperationRequeueDeadLetterTasks = clientOperation("matching-requeue-dead-letter-tasks")
+	MatchingClientOperationMigrateTaskList        = clientOperation("matching-migrate-task-list")
+	MatchingClientOperationDeleteTaskList         = clientOperation("matching-delete-task-list")
+	MatchingClientOperationListStickyTaskLists    = clientOperation("matching-list-sticky-task-lists")
 )
 
 // Pre-defined values for TagIDType
@@ -213,6 +213,8 @@ const (
 	PersistenceGetOrphanTasksScope
 	// PersistenceLeaseTaskListScope tracks LeaseTaskList calls made by service to persistence layer
 	PersistenceLeaseTaskListScope
+	// PersistenceGetTaskListScope tracks GetTaskList calls made by service to persistence layer
+	PersistenceGetTaskListScope
 	// PersistenceUpdateTaskListScope tracks PersistenceUpdateTaskListScope calls made by service to persistence layer
 	PersistenceUpdateTaskListScope
 	// PersistenceListTaskListScope is the metric scope for persistence.TaskManager.ListTaskList API
@@ -345,6 +347,12 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -403,6 +411,18 @@ const (
 	MatchingClientListTaskListPartitionsScope
 	// MatchingClientGetTaskListsByDomainScope tracks RPC calls to matching service
 	MatchingClientGetTaskListsByDomainScope
+	// MatchingClientListStickyTaskListsScope tracks RPC calls to matching service
+	MatchingClientListStickyTaskListsScope
+	// MatchingClientDeleteTaskListScope tracks RPC calls to matching service
+	MatchingClientDeleteTaskListScope
+	// MatchingClientMigrateTaskListScope tracks RPC calls to matching service
+	MatchingClientMigrateTaskListScope
+	// MatchingClientRequeueDeadLetterTasksScope tracks RPC calls to matching service
+	MatchingClientRequeueDeadLetterTasksScope
+	// MatchingClientUpdateTaskListConfigScope tracks RPC calls to matching service
+	MatchingClientUpdateTaskListConfigScope
+	// MatchingClientDescribeTaskListConfigScope tracks RPC calls to matching service
+	MatchingClientDescribeTaskListConfigScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -471,6 +491,28 @@ const (
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientUnpauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUnpauseWorkflowExecutionScope
+	// FrontendClientUpdateWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowExecutionScope
+	// FrontendClientCreateScheduleScope tracks RPC calls to frontend service
+	FrontendClientCreateScheduleScope
+	// FrontendClientDescribeScheduleScope tracks RPC calls to frontend service
+	FrontendClientDescribeScheduleScope
+	// FrontendClientUpdateScheduleScope tracks RPC calls to frontend service
+	FrontendClientUpdateScheduleScope
+	// FrontendClientPauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientPauseScheduleScope
+	// FrontendClientUnpauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientUnpauseScheduleScope
+	// FrontendClientDeleteScheduleScope tracks RPC calls to frontend service
+	FrontendClientDeleteScheduleScope
+	// FrontendClientBackfillScheduleScope tracks RPC calls to frontend service
+	FrontendClientBackfillScheduleScope
+	// FrontendClientListSchedulesScope tracks RPC calls to frontend service
+	FrontendClientListSchedulesScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -613,6 +655,28 @@ const (
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionUnpauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseWorkflowExecutionScope
+	// DCRedirectionUpdateWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowExecutionScope
+	// DCRedirectionCreateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionCreateScheduleScope
+	// DCRedirectionDescribeScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeScheduleScope
+	// DCRedirectionUpdateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateScheduleScope
+	// DCRedirectionPauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionPauseScheduleScope
+	// DCRedirectionUnpauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseScheduleScope
+	// DCRedirectionDeleteScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteScheduleScope
+	// DCRedirectionBackfillScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionBackfillScheduleScope
+	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
+	DCRedirectionListSchedulesScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
 	// DCRedirectionListTaskListPartitionsScope tracks RPC calls for dc redirection
@@ -650,6 +714,8 @@ const (
 	PersistenceForkHistoryBranchScope
 	// PersistenceDeleteHistoryBranchScope tracks DeleteHistoryBranch calls made by service to persistence layer
 	PersistenceDeleteHistoryBranchScope
+	// PersistenceTrimHistoryBranchScope tracks TrimHistoryBranch calls made by service to persistence layer
+	PersistenceTrimHistoryBranchScope
 	// PersistenceCompleteForkBranchScope tracks CompleteForkBranch calls made by service to persistence layer
 	PersistenceCompleteForkBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
@@ -785,6 +851,34 @@ const (
 	AdminDeleteWorkflowScope
 	// MaintainCorruptWorkflowScope is the metric scope for admin.MaintainCorruptWorkflow
 	MaintainCorruptWorkflowScope
+	// AdminListScanRunsScope is the metric scope for admin.ListScanRuns
+	AdminListScanRunsScope
+	// AdminDescribeScanResultsScope is the metric scope for admin.DescribeScanResults
+	AdminDescribeScanResultsScope
+	// AdminListScanCorruptionsScope is the metric scope for admin.ListScanCorruptions
+	AdminListScanCorruptionsScope
+	// AdminFixScanResultsScope is the metric scope for admin.FixScanResults
+	AdminFixScanResultsScope
+	// AdminGetTaskListBacklogScope is the metric scope for admin.GetTaskListBacklog
+	AdminGetTaskListBacklogScope
+	// AdminDescribeTaskListConfigScope is the metric scope for admin.DescribeTaskListConfig
+	AdminDescribeTaskListConfigScope
+	// AdminUpdateTaskListConfigScope is the metric scope for admin.UpdateTaskListConfig
+	AdminUpdateTaskListConfigScope
+	// AdminListDeadLetterTasksScope is the metric scope for admin.ListDeadLetterTasks
+	AdminListDeadLetterTasksScope
+	// AdminRequeueDeadLetterTasksScope is the metric scope for admin.RequeueDeadLetterTasks
+	AdminRequeueDeadLetterTasksScope
+	// AdminPurgeDeadLetterTasksScope is the metric scope for admin.PurgeDeadLetterTasks
+	AdminPurgeDeadLetterTasksScope
+	// AdminMigrateTaskListScope is the metric scope for admin.MigrateTaskList
+	AdminMigrateTaskListScope
+	// AdminDeleteTaskListScope is the metric scope for admin.DeleteTaskList
+	AdminDeleteTaskListScope
+	// AdminListStickyTaskListsScope is the metric scope for admin.ListStickyTaskLists
+	AdminListStickyTaskListsScope
+	// AdminResetStickyTaskListsScope is the metric scope for admin.ResetStickyTaskLists
+	AdminResetStickyTaskListsScope
 
 	NumAdminScopes
 )
@@ -831,6 +925,28 @@ const (
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendUnpauseWorkflowExecutionScope is the metric scope for frontend.UnpauseWorkflowExecution
+	FrontendUnpauseWorkflowExecutionScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
+	// FrontendCreateScheduleScope is the metric scope for frontend.CreateSchedule
+	FrontendCreateScheduleScope
+	// FrontendDescribeScheduleScope is the metric scope for frontend.DescribeSchedule
+	FrontendDescribeScheduleScope
+	// FrontendUpdateScheduleScope is the metric scope for frontend.UpdateSchedule
+	FrontendUpdateScheduleScope
+	// FrontendPauseScheduleScope is the metric scope for frontend.PauseSchedule
+	FrontendPauseScheduleScope
+	// FrontendUnpauseScheduleScope is the metric scope for frontend.UnpauseSchedule
+	FrontendUnpauseScheduleScope
+	// FrontendDeleteScheduleScope is the metric scope for frontend.DeleteSchedule
+	FrontendDeleteScheduleScope
+	// FrontendBackfillScheduleScope is the metric scope for frontend.BackfillSchedule
+	FrontendBackfillScheduleScope
+	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
+	FrontendListSchedulesScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
@@ -919,6 +1035,12 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope tracks UnpauseWorkflowExecution API calls received by service
+	HistoryUnpauseWorkflowExecutionScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -1039,6 +1161,8 @@ const (
 	TimerActiveTaskWorkflowBackoffTimerScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
+	// TimerActiveTaskWorkflowInactivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing workflow inactivity timeouts
+	TimerActiveTaskWorkflowInactivityTimeoutScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
 	TimerStandbyTaskActivityTimeoutScope
 	// TimerStandbyTaskDecisionTimeoutScope is the scope used by metric emitted by timer queue processor for processing decision timeouts
@@ -1053,6 +1177,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskWorkflowInactivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing workflow inactivity timeouts
+	TimerStandbyTaskWorkflowInactivityTimeoutScope
 	// CrossClusterQueueProcessorScope is the scope used by all metric emitted by cross cluster queue processor in the source cluster
 	CrossClusterQueueProcessorScope
 	// CrossClusterTaskProcessorScope is the scope used by all metric emitted by cross cluster task processor in the target cluster
@@ -1165,6 +1291,18 @@ const (
 	MatchingListTaskListPartitionsScope
 	// MatchingGetTaskListsByDomainScope tracks GetTaskListsByDomain API calls received by service
 	MatchingGetTaskListsByDomainScope
+	// MatchingDescribeTaskListConfigScope tracks DescribeTaskListConfig API calls received by service
+	MatchingDescribeTaskListConfigScope
+	// MatchingUpdateTaskListConfigScope tracks UpdateTaskListConfig API calls received by service
+	MatchingUpdateTaskListConfigScope
+	// MatchingRequeueDeadLetterTasksScope tracks RequeueDeadLetterTasks API calls received by service
+	MatchingRequeueDeadLetterTasksScope
+	// MatchingMigrateTaskListScope tracks MigrateTaskList API calls received by service
+	MatchingMigrateTaskListScope
+	// MatchingDeleteTaskListScope tracks DeleteTaskList API calls received by service
+	MatchingDeleteTaskListScope
+	// MatchingListStickyTaskListsScope tracks ListStickyTaskLists API calls received by service
+	MatchingListStickyTaskListsScope
 
 	NumMatchingScopes
 )
@@ -1257,6 +1395,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCompleteTasksLessThanScope:                    {operation: "CompleteTasksLessThan"},
 		PersistenceGetOrphanTasksScope:                           {operation: "GetOrphanTasks"},
 		PersistenceLeaseTaskListScope:                            {operation: "LeaseTaskList"},
+		PersistenceGetTaskListScope:                              {operation: "GetTaskList"},
 		PersistenceUpdateTaskListScope:                           {operation: "UpdateTaskList"},
 		PersistenceListTaskListScope:                             {operation: "ListTaskList"},
 		PersistenceDeleteTaskListScope:                           {operation: "DeleteTaskList"},
@@ -1289,6 +1428,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceReadHistoryBranchScope:                        {operation: "ReadHistoryBranch"},
 		PersistenceForkHistoryBranchScope:                        {operation: "ForkHistoryBranch"},
 		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch"},
+		PersistenceTrimHistoryBranchScope:                        {operation: "TrimHistoryBranch"},
 		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch"},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree"},
 		PersistenceGetAllHistoryTreeBranchesScope:                {operation: "GetAllHistoryTreeBranches"},
@@ -1333,6 +1473,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:    {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:            {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:          {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:              {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:            {operation: "HistoryClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:             {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:              {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:                {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:       {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1362,6 +1505,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientGetTaskListsByDomainScope:               {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDescribeTaskListConfigScope:             {operation: "MatchingClientDescribeTaskListConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientUpdateTaskListConfigScope:               {operation: "MatchingClientUpdateTaskListConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientRequeueDeadLetterTasksScope:             {operation: "MatchingClientRequeueDeadLetterTasks", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientMigrateTaskListScope:                    {operation: "MatchingClientMigrateTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDeleteTaskListScope:                     {operation: "MatchingClientDeleteTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientListStickyTaskListsScope:                {operation: "MatchingClientListStickyTaskLists", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                    {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeDomainScope:                     {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                   {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1396,6 +1545,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientSignalWorkflowExecutionScope:            {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:             {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:         {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:             {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseWorkflowExecutionScope:           {operation: "FrontendClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateWorkflowExecutionScope:            {operation: "FrontendClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientCreateScheduleScope:                     {operation: "FrontendClientCreateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDescribeScheduleScope:                   {operation: "FrontendClientDescribeSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateScheduleScope:                     {operation: "FrontendClientUpdateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseScheduleScope:                      {operation: "FrontendClientPauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseScheduleScope:                    {operation: "FrontendClientUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDeleteScheduleScope:                     {operation: "FrontendClientDeleteSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientBackfillScheduleScope:                   {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientListSchedulesScope:                      {operation: "FrontendClientListSchedules", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUpdateDomainScope:                       {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListWorkflowExecutionsScope:             {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientScanWorkflowExecutionsScope:             {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1467,6 +1627,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionSignalWorkflowExecutionScope:             {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionScope:              {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:          {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:              {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseWorkflowExecutionScope:            {operation: "DCRedirectionUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowExecutionScope:             {operation: "DCRedirectionUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCreateScheduleScope:                      {operation: "DCRedirectionCreateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeScheduleScope:                    {operation: "DCRedirectionDescribeSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateScheduleScope:                      {operation: "DCRedirectionUpdateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseScheduleScope:                       {operation: "DCRedirectionPauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseScheduleScope:                     {operation: "DCRedirectionUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteScheduleScope:                      {operation: "DCRedirectionDeleteSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionBackfillScheduleScope:                    {operation: "DCRedirectionBackfillSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListSchedulesScope:                       {operation: "DCRedirectionListSchedules", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                        {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListTaskListPartitionsScope:              {operation: "DCRedirectionListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetTaskListsByDomainScope:                {operation: "DCRedirectionGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1546,6 +1717,20 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminListDynamicConfigScope:                 {operation: "AdminListDynamicConfig"},
 		AdminDeleteWorkflowScope:                    {operation: "AdminDeleteWorkflow"},
 		MaintainCorruptWorkflowScope:                {operation: "MaintainCorruptWorkflow"},
+		AdminListScanRunsScope:                      {operation: "AdminListScanRuns"},
+		AdminDescribeScanResultsScope:               {operation: "AdminDescribeScanResults"},
+		AdminListScanCorruptionsScope:               {operation: "AdminListScanCorruptions"},
+		AdminFixScanResultsScope:                    {operation: "AdminFixScanResults"},
+		AdminGetTaskListBacklogScope:                {operation: "AdminGetTaskListBacklog"},
+		AdminDescribeTaskListConfigScope:            {operation: "AdminDescribeTaskListConfig"},
+		AdminUpdateTaskListConfigScope:              {operation: "AdminUpdateTaskListConfig"},
+		AdminListDeadLetterTasksScope:               {operation: "AdminListDeadLetterTasks"},
+		AdminRequeueDeadLetterTasksScope:            {operation: "AdminRequeueDeadLetterTasks"},
+		AdminPurgeDeadLetterTasksScope:              {operation: "AdminPurgeDeadLetterTasks"},
+		AdminMigrateTaskListScope:                   {operation: "AdminMigrateTaskList"},
+		AdminDeleteTaskListScope:                    {operation: "AdminDeleteTaskList"},
+		AdminListStickyTaskListsScope:               {operation: "AdminListStickyTaskLists"},
+		AdminResetStickyTaskListsScope:              {operation: "AdminResetStickyTaskLists"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:                {operation: "PollForDecisionTask"},
@@ -1567,6 +1752,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWorkflowExecutionScope:            {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope:   {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:         {operation: "TerminateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:             {operation: "PauseWorkflowExecution"},
+		FrontendUnpauseWorkflowExecutionScope:           {operation: "UnpauseWorkflowExecution"},
+		FrontendUpdateWorkflowExecutionScope:            {operation: "UpdateWorkflowExecution"},
+		FrontendCreateScheduleScope:                     {operation: "CreateSchedule"},
+		FrontendDescribeScheduleScope:                   {operation: "DescribeSchedule"},
+		FrontendUpdateScheduleScope:                     {operation: "UpdateSchedule"},
+		FrontendPauseScheduleScope:                      {operation: "PauseSchedule"},
+		FrontendUnpauseScheduleScope:                    {operation: "UnpauseSchedule"},
+		FrontendDeleteScheduleScope:                     {operation: "DeleteSchedule"},
+		FrontendBackfillScheduleScope:                   {operation: "BackfillSchedule"},
+		FrontendListSchedulesScope:                      {operation: "ListSchedules"},
 		FrontendResetWorkflowExecutionScope:             {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:     {operation: "RequestCancelWorkflowExecution"},
 		FrontendListArchivedWorkflowExecutionsScope:     {operation: "ListArchivedWorkflowExecutions"},
@@ -1611,6 +1807,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope:                    {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                            {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                          {operation: "TerminateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                              {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                            {operation: "UnpauseWorkflowExecution"},
+		HistoryUpdateWorkflowExecutionScope:                             {operation: "UpdateWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:                              {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                                       {operation: "QueryWorkflow"},
 		HistoryProcessDeleteHistoryEventScope:                           {operation: "ProcessDeleteHistoryEvent"},
@@ -1674,6 +1873,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerActiveTaskActivityRetryTimerScope:                          {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:                        {operation: "TimerActiveTaskWorkflowBackoffTimer"},
 		TimerActiveTaskDeleteHistoryEventScope:                          {operation: "TimerActiveTaskDeleteHistoryEvent"},
+		TimerActiveTaskWorkflowInactivityTimeoutScope:                   {operation: "TimerActiveTaskWorkflowInactivityTimeout"},
 		TimerStandbyTaskActivityTimeoutScope:                            {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskDecisionTimeoutScope:                            {operation: "TimerStandbyTaskDecisionTimeout"},
 		TimerStandbyTaskUserTimerScope:                                  {operation: "TimerStandbyTaskUserTimer"},
@@ -1681,6 +1881,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerStandbyTaskActivityRetryTimerScope:                         {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:                       {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
 		TimerStandbyTaskDeleteHistoryEventScope:                         {operation: "TimerStandbyTaskDeleteHistoryEvent"},
+		TimerStandbyTaskWorkflowInactivityTimeoutScope:                  {operation: "TimerStandbyTaskWorkflowInactivityTimeout"},
 		CrossClusterQueueProcessorScope:                                 {operation: "CrossClusterQueueProcessor"},
 		CrossClusterTaskProcessorScope:                                  {operation: "CrossClusterTaskProcessor"},
 		CrossClusterTaskFetcherScope:                                    {operation: "CrossClusterTaskFetcher"},
@@ -1734,6 +1935,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
 		MatchingListTaskListPartitionsScope:    {operation: "ListTaskListPartitions"},
 		MatchingGetTaskListsByDomainScope:      {operation: "GetTaskListsByDomain"},
+		MatchingDescribeTaskListConfigScope:    {operation: "DescribeTaskListConfig"},
+		MatchingUpdateTaskListConfigScope:      {operation: "UpdateTaskListConfig"},
+		MatchingRequeueDeadLetterTasksScope:    {operation: "RequeueDeadLetterTasks"},
+		MatchingMigrateTaskListScope:           {operation: "MigrateTaskList"},
+		MatchingDeleteTaskListScope:            {operation: "DeleteTaskList"},
+		MatchingListStickyTaskListsScope:       {operation: "ListStickyTaskLists"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -2059,6 +2266,9 @@ const (
 	CompleteDecisionWithStickyEnabledCounter
 	CompleteDecisionWithStickyDisabledCounter
 	DecisionHeartbeatTimeoutCounter
+	HistoryCompactionCounter
+	HistoryCompactionFailedCounter
+	WorkflowInactivityTimeoutCounter
 	HistoryEventNotificationQueueingLatency
 	HistoryEventNotificationFanoutLatency
 	HistoryEventNotificationInFlightMessageGauge
@@ -2154,6 +2364,8 @@ const (
 	QueryBufferExceededCount
 	QueryRegistryInvalidStateCount
 	WorkerNotSupportsConsistentQueryCount
+	WorkflowUpdateTimeoutCount
+	WorkflowUpdateBufferExceededCount
 	DecisionStartToCloseTimeoutOverrideCount
 	ReplicationTaskCleanupCount
 	ReplicationTaskCleanupFailure
@@ -2203,6 +2415,21 @@ const (
 	TaskListManagersGauge
 	TaskLagPerTaskListGauge
 	TaskBacklogPerTaskListGauge
+	TaskListReadPartitionsGauge
+	TaskListWritePartitionsGauge
+	TaskListPartitionScaleFailuresCounter
+	StarvedTasksPerTaskListCounter
+	PreemptedTasksPerTaskListCounter
+	TasksWithoutMatchingPollerPerTaskListCounter
+	LabeledTasksFallbackPerTaskListCounter
+	BacklogFullPerTaskListCounter
+	OutstandingTasksLimitPerTaskListCounter
+	ExpiredTasksDeadLetteredPerTaskListCounter
+	DispatchFailedTasksDeadLetteredPerTaskListCounter
+	DeadLetterFailuresPerTaskListCounter
+	HandoffPerTaskListCounter
+	HandoffPollsForwardedPerTaskListCounter
+	IsolationGroupFallbackPerTaskListCounter
 
 	NumMatchingMetrics
 )
@@ -2606,6 +2833,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CompleteDecisionWithStickyEnabledCounter:            {metricName: "complete_decision_sticky_enabled_count", metricType: Counter},
 		CompleteDecisionWithStickyDisabledCounter:           {metricName: "complete_decision_sticky_disabled_count", metricType: Counter},
 		DecisionHeartbeatTimeoutCounter:                     {metricName: "decision_heartbeat_timeout_count", metricType: Counter},
+		HistoryCompactionCounter:                            {metricName: "history_compaction_count", metricType: Counter},
+		HistoryCompactionFailedCounter:                      {metricName: "history_compaction_failed_count", metricType: Counter},
+		WorkflowInactivityTimeoutCounter:                    {metricName: "workflow_inactivity_timeout_count", metricType: Counter},
 		HistoryEventNotificationQueueingLatency:             {metricName: "history_event_notification_queueing_latency", metricType: Timer},
 		HistoryEventNotificationFanoutLatency:               {metricName: "history_event_notification_fanout_latency", metricType: Timer},
 		HistoryEventNotificationInFlightMessageGauge:        {metricName: "history_event_notification_inflight_message_gauge", metricType: Gauge},
@@ -2697,6 +2927,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		QueryBufferExceededCount:                            {metricName: "query_buffer_exceeded", metricType: Counter},
 		QueryRegistryInvalidStateCount:                      {metricName: "query_registry_invalid_state", metricType: Counter},
 		WorkerNotSupportsConsistentQueryCount:               {metricName: "worker_not_supports_consistent_query", metricType: Counter},
+		WorkflowUpdateTimeoutCount:                          {metricName: "workflow_update_timeout", metricType: Counter},
+		WorkflowUpdateBufferExceededCount:                   {metricName: "workflow_update_buffer_exceeded", metricType: Counter},
 		DecisionStartToCloseTimeoutOverrideCount:            {metricName: "decision_start_to_close_timeout_overrides", metricType: Counter},
 		ReplicationTaskCleanupCount:                         {metricName: "replication_task_cleanup_count", metricType: Counter},
 		ReplicationTaskCleanupFailure:                       {metricName: "replication_task_cleanup_failed", metricType: Counter},
@@ -2746,6 +2978,23 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskListManagersGauge:                    {metricName: "tasklist_managers", metricType: Gauge},
 		TaskLagPerTaskListGauge:                  {metricName: "task_lag_per_tl", metricType: Gauge},
 		TaskBacklogPerTaskListGauge:              {metricName: "task_backlog_per_tl", metricType: Gauge},
+		TaskListReadPartitionsGauge:              {metricName: "tasklist_read_partitions", metricType: Gauge},
+		TaskListWritePartitionsGauge:             {metricName: "tasklist_write_partitions", metricType: Gauge},
+		TaskListPartitionScaleFailuresCounter:    {metricName: "tasklist_partition_scale_failures", metricType: Counter},
+		StarvedTasksPerTaskListCounter:           {metricName: "tasks_starved_per_tl", metricRollupName: "tasks_starved"},
+		PreemptedTasksPerTaskListCounter:         {metricName: "tasks_preempted_per_tl", metricRollupName: "tasks_preempted"},
+
+		TasksWithoutMatchingPollerPerTaskListCounter: {metricName: "tasks_without_matching_poller_per_tl", metricRollupName: "tasks_without_matching_poller"},
+		LabeledTasksFallbackPerTaskListCounter:       {metricName: "labeled_tasks_fallback_per_tl", metricRollupName: "labeled_tasks_fallback"},
+		BacklogFullPerTaskListCounter:                {metricName: "backlog_full_per_tl", metricRollupName: "backlog_full"},
+		OutstandingTasksLimitPerTaskListCounter:      {metricName: "outstanding_tasks_limit_per_tl", metricRollupName: "outstanding_tasks_limit"},
+
+		ExpiredTasksDeadLetteredPerTaskListCounter:        {metricName: "tasks_dead_lettered_expired_per_tl", metricRollupName: "tasks_dead_lettered_expired"},
+		DispatchFailedTasksDeadLetteredPerTaskListCounter: {metricName: "tasks_dead_lettered_dispatch_failed_per_tl", metricRollupName: "tasks_dead_lettered_dispatch_failed"},
+		DeadLetterFailuresPerTaskListCounter:              {metricName: "dead_letter_failures_per_tl", metricRollupName: "dead_letter_failures"},
+		HandoffPerTaskListCounter:                         {metricName: "handoff_per_tl", metricRollupName: "handoff"},
+		HandoffPollsForwardedPerTaskListCounter:           {metricName: "handoff_polls_forwarded_per_tl", metricRollupName: "handoff_polls_forwarded"},
+		IsolationGroupFallbackPerTaskListCounter:          {metricName: "isolation_group_fallback_per_tl", metricRollupName: "isolation_group_fallback"},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -229,3 +229,17 @@ func (_m *HistoryV2Manager) ReadRawHistoryBranch(ctx context.Context, request *p
 
 	return r0, r1
 }
+
+// TrimHistoryBranch provides a mock function with given fields: ctx, request
+func (_m *HistoryV2Manager) TrimHistoryBranch(ctx context.Context, request *persistence.TrimHistoryBranchRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.TrimHistoryBranchRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,381 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package payload offloads large workflow payloads to the blobstore. An offloaded payload is
+// replaced in history events with a reference to the blob holding it, references are rehydrated
+// when history is read by clients or archived, and deleted along with the history of the workflow.
+package payload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pborman/uuid"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// referencePrefix starts the payload replacing an offloaded payload in history events,
+	// the key of the blob holding the payload follows it
+	referencePrefix = "\x00cadence-payload-ref:"
+	keyPrefix       = "payload_"
+
+	workflowIDTag = "workflowID"
+
+	deleteHistoryPageSize = 100
+)
+
+var (
+	// ErrSizeExceedsLimit is returned when a payload is too large to be offloaded
+	ErrSizeExceedsLimit = &types.BadRequestError{Message: "Payload size exceeds offload size limit."}
+
+	errInvalidReference = &types.BadRequestError{Message: "Invalid payload reference."}
+)
+
+type (
+	// Store offloads payloads to the blobstore and rehydrates them
+	Store struct {
+		client    blobstore.Client
+		threshold dynamicconfig.IntPropertyFnWithDomainFilter
+		sizeLimit dynamicconfig.IntPropertyFnWithDomainFilter
+		encoder   codec.BinaryEncoder
+	}
+)
+
+// NewStore returns a new payload store, payloads are never offloaded if the blobstore client is nil.
+// The threshold and size limit may be nil for a store only used to rehydrate payloads
+func NewStore(
+	client blobstore.Client,
+	threshold dynamicconfig.IntPropertyFnWithDomainFilter,
+	sizeLimit dynamicconfig.IntPropertyFnWithDomainFilter,
+) *Store {
+	return &Store{
+		client:    client,
+		threshold: threshold,
+		sizeLimit: sizeLimit,
+		encoder:   codec.NewThriftRWEncoder(),
+	}
+}
+
+// IsReference returns whether the payload is a reference to an offloaded payload
+func IsReference(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(referencePrefix))
+}
+
+// Enabled returns whether payloads of the domain are offloaded
+func (s *Store) Enabled(domainName string) bool {
+	return s.client != nil && s.threshold != nil && s.threshold(domainName) > 0
+}
+
+// InlineSize returns the size of the payload once stored in history, which is the size of
+// its reference if the payload is offloaded
+func (s *Store) InlineSize(domainName string, domainID string, data []byte) (int, error) {
+	if !s.shouldOffload(domainName, data) {
+		return len(data), nil
+	}
+	if len(data) > s.sizeLimit(domainName) {
+		return 0, ErrSizeExceedsLimit
+	}
+	return len(referencePrefix) + len(s.newKey(domainID)), nil
+}
+
+// Offload stores the payload in the blobstore and returns a reference to it if the payload is larger
+// than the offload threshold of the domain, otherwise the payload is returned unchanged. Payloads
+// provided by clients must not be references, or a client could read or delete the payload of another workflow
+func (s *Store) Offload(
+	ctx context.Context,
+	domainName string,
+	domainID string,
+	workflowID string,
+	data []byte,
+) ([]byte, error) {
+	if IsReference(data) {
+		return nil, errInvalidReference
+	}
+	if !s.shouldOffload(domainName, data) {
+		return data, nil
+	}
+	if len(data) > s.sizeLimit(domainName) {
+		return nil, ErrSizeExceedsLimit
+	}
+	return s.put(ctx, domainID, workflowID, data)
+}
+
+// Copy stores a copy of an offloaded payload of the source domain in the domain and returns a reference to the copy.
+// It is used when a payload is carried over to another run, so that the payload outlives the history of the run
+// it was offloaded for
+func (s *Store) Copy(
+	ctx context.Context,
+	sourceDomainID string,
+	domainID string,
+	workflowID string,
+	data []byte,
+) ([]byte, error) {
+	if !IsReference(data) {
+		return data, nil
+	}
+	payload, err := s.Rehydrate(ctx, sourceDomainID, data)
+	if err != nil {
+		return nil, err
+	}
+	return s.put(ctx, domainID, workflowID, payload)
+}
+
+// Rehydrate returns the offloaded payload if the payload is a reference, otherwise the payload is returned unchanged
+func (s *Store) Rehydrate(
+	ctx context.Context,
+	domainID string,
+	data []byte,
+) ([]byte, error) {
+	if !IsReference(data) {
+		return data, nil
+	}
+	key, err := s.getKey(domainID, data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Get(ctx, &blobstore.GetRequest{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Blob.Body, nil
+}
+
+// RehydrateEvents replaces the references to offloaded payloads in the events with the payloads
+func (s *Store) RehydrateEvents(
+	ctx context.Context,
+	domainID string,
+	events []*types.HistoryEvent,
+) error {
+	for _, event := range events {
+		for _, data := range offloadablePayloads(event) {
+			payload, err := s.Rehydrate(ctx, domainID, *data)
+			if err != nil {
+				return err
+			}
+			*data = payload
+		}
+	}
+	return nil
+}
+
+// Delete deletes the offloaded payload if the payload is a reference
+func (s *Store) Delete(
+	ctx context.Context,
+	domainID string,
+	data []byte,
+) error {
+	if !IsReference(data) {
+		return nil
+	}
+	key, err := s.getKey(domainID, data)
+	if err != nil {
+		return err
+	}
+	// the payload may already be deleted if a previous attempt failed after deleting it
+	resp, err := s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key})
+	if err != nil || !resp.Exists {
+		return err
+	}
+	_, err = s.client.Delete(ctx, &blobstore.DeleteRequest{Key: key})
+	return err
+}
+
+// MayBePersisted returns whether a workflow update which returned the error may have been persisted, in which
+// case the payloads offloaded for the update may be referenced by its events and must not be discarded
+func MayBePersisted(updateErr error) bool {
+	return updateErr == nil ||
+		persistence.IsTimeoutError(updateErr) ||
+		updateErr == context.DeadlineExceeded ||
+		updateErr == context.Canceled
+}
+
+// Discard deletes the payloads offloaded or copied for a workflow update which was not persisted, as no event
+// references them
+func (s *Store) Discard(
+	ctx context.Context,
+	domainID string,
+	references [][]byte,
+) error {
+	for _, data := range references {
+		if err := s.Delete(ctx, domainID, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteHistory deletes the payloads offloaded for a history branch before the branch is deleted. Payloads of
+// events shared with other branches of the history tree, such as the events before the reset point of a reset
+// workflow, are kept since they are still referenced by the other branches. Payloads are deleted regardless of
+// the offload threshold, as the history may hold references created before offloading was disabled
+func (s *Store) DeleteHistory(
+	ctx context.Context,
+	historyManager persistence.HistoryManager,
+	domainID string,
+	branchToken []byte,
+	shardID int,
+) error {
+	if s.client == nil {
+		return nil
+	}
+
+	minEventID, err := s.getFirstOwnedEventID(ctx, historyManager, branchToken, shardID)
+	if err != nil {
+		return err
+	}
+
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  minEventID,
+		MaxEventID:  common.EndEventID,
+		PageSize:    deleteHistoryPageSize,
+		ShardID:     common.IntPtr(shardID),
+	}
+	for {
+		events, _, nextPageToken, err := persistenceutils.ReadFullPageV2Events(ctx, historyManager, request)
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			for _, data := range ownedPayloads(event) {
+				if err := s.Delete(ctx, domainID, *data); err != nil {
+					return err
+				}
+			}
+		}
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = nextPageToken
+	}
+}
+
+func (s *Store) shouldOffload(domainName string, data []byte) bool {
+	return s.Enabled(domainName) && len(data) > s.threshold(domainName) && !IsReference(data)
+}
+
+func (s *Store) put(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	data []byte,
+) ([]byte, error) {
+	key := s.newKey(domainID)
+	if _, err := s.client.Put(ctx, &blobstore.PutRequest{
+		Key: key,
+		Blob: blobstore.Blob{
+			Tags: map[string]string{workflowIDTag: workflowID},
+			Body: data,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return []byte(referencePrefix + key), nil
+}
+
+// newKey returns a new blob key of the domain. Keys embed the domain ID so that a reference
+// can only be rehydrated and deleted within the history of its domain
+func (s *Store) newKey(domainID string) string {
+	return fmt.Sprintf("%v%v_%v", keyPrefix, domainID, uuid.New())
+}
+
+func (s *Store) getKey(domainID string, data []byte) (string, error) {
+	key := string(data[len(referencePrefix):])
+	if s.client == nil || !strings.HasPrefix(key, keyPrefix+domainID+"_") {
+		return "", errInvalidReference
+	}
+	return key, nil
+}
+
+// getFirstOwnedEventID returns the ID of the first event of the branch which is not shared with another branch
+func (s *Store) getFirstOwnedEventID(
+	ctx context.Context,
+	historyManager persistence.HistoryManager,
+	branchToken []byte,
+	shardID int,
+) (int64, error) {
+	var branch workflow.HistoryBranch
+	if err := s.encoder.Decode(branchToken, &branch); err != nil {
+		return 0, err
+	}
+	resp, err := historyManager.GetHistoryTree(ctx, &persistence.GetHistoryTreeRequest{
+		TreeID:  branch.GetTreeID(),
+		ShardID: common.IntPtr(shardID),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	firstEventID := common.FirstEventID
+	for _, ancestor := range branch.GetAncestors() {
+		firstEventID = common.MaxInt64(firstEventID, ancestor.GetEndNodeID())
+	}
+	for _, other := range resp.Branches {
+		if other.GetBranchID() == branch.GetBranchID() {
+			continue
+		}
+		for _, ancestor := range other.GetAncestors() {
+			if ancestor.GetBranchID() == branch.GetBranchID() {
+				firstEventID = common.MaxInt64(firstEventID, ancestor.GetEndNodeID())
+			}
+		}
+	}
+	return firstEventID, nil
+}
+
+// offloadablePayloads returns pointers to the workflow input and result payloads of the event which may be offloaded
+func offloadablePayloads(event *types.HistoryEvent) []*[]byte {
+	switch {
+	case event.WorkflowExecutionStartedEventAttributes != nil:
+		attributes := event.WorkflowExecutionStartedEventAttributes
+		return []*[]byte{&attributes.Input, &attributes.LastCompletionResult}
+	case event.WorkflowExecutionCompletedEventAttributes != nil:
+		return []*[]byte{&event.WorkflowExecutionCompletedEventAttributes.Result}
+	case event.WorkflowExecutionContinuedAsNewEventAttributes != nil:
+		attributes := event.WorkflowExecutionContinuedAsNewEventAttributes
+		return []*[]byte{&attributes.Input, &attributes.LastCompletionResult}
+	case event.ChildWorkflowExecutionCompletedEventAttributes != nil:
+		return []*[]byte{&event.ChildWorkflowExecutionCompletedEventAttributes.Result}
+	}
+	return nil
+}
+
+// ownedPayloads returns pointers to the payloads of the event which are deleted along with its history.
+// The payloads of a continued as new event are owned by the new run, whose started event carries them
+func ownedPayloads(event *types.HistoryEvent) []*[]byte {
+	if event.WorkflowExecutionContinuedAsNewEventAttributes != nil {
+		return nil
+	}
+	return offloadablePayloads(event)
+}
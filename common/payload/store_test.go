@@ -0,0 +1,240 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainName = "test-domain"
+	testDomainID   = "test-domain-id"
+	testWorkflowID = "test-workflow-id"
+)
+
+type (
+	storeSuite struct {
+		suite.Suite
+
+		client *blobstore.MockClient
+		store  *Store
+	}
+)
+
+func TestStoreSuite(t *testing.T) {
+	suite.Run(t, new(storeSuite))
+}
+
+func (s *storeSuite) SetupTest() {
+	s.client = &blobstore.MockClient{}
+	s.store = NewStore(
+		s.client,
+		dynamicconfig.GetIntPropertyFilteredByDomain(4),
+		dynamicconfig.GetIntPropertyFilteredByDomain(8),
+	)
+}
+
+func (s *storeSuite) TearDownTest() {
+	s.client.AssertExpectations(s.T())
+}
+
+func (s *storeSuite) TestOffload_BelowThreshold() {
+	data, err := s.store.Offload(context.Background(), testDomainName, testDomainID, testWorkflowID, []byte("abcd"))
+	s.NoError(err)
+	s.Equal([]byte("abcd"), data)
+}
+
+func (s *storeSuite) TestOffload_Disabled() {
+	store := NewStore(s.client, dynamicconfig.GetIntPropertyFilteredByDomain(0), dynamicconfig.GetIntPropertyFilteredByDomain(8))
+	data, err := store.Offload(context.Background(), testDomainName, testDomainID, testWorkflowID, []byte("abcdef"))
+	s.NoError(err)
+	s.Equal([]byte("abcdef"), data)
+}
+
+func (s *storeSuite) TestOffload_ExceedsSizeLimit() {
+	_, err := s.store.Offload(context.Background(), testDomainName, testDomainID, testWorkflowID, []byte("abcdefghi"))
+	s.Equal(ErrSizeExceedsLimit, err)
+
+	_, err = s.store.InlineSize(testDomainName, testDomainID, []byte("abcdefghi"))
+	s.Equal(ErrSizeExceedsLimit, err)
+}
+
+func (s *storeSuite) TestOffload_RejectsReference() {
+	_, err := s.store.Offload(context.Background(), testDomainName, testDomainID, testWorkflowID, []byte(referencePrefix+"payload_other"))
+	s.Equal(errInvalidReference, err)
+}
+
+func (s *storeSuite) TestOffloadAndRehydrate() {
+	var stored *blobstore.PutRequest
+	s.client.On("Put", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*blobstore.PutRequest)
+	}).Return(&blobstore.PutResponse{}, nil).Once()
+
+	data, err := s.store.Offload(context.Background(), testDomainName, testDomainID, testWorkflowID, []byte("abcdef"))
+	s.NoError(err)
+	s.True(IsReference(data))
+	s.True(strings.HasPrefix(stored.Key, keyPrefix+testDomainID+"_"))
+	s.Equal(testWorkflowID, stored.Blob.Tags[workflowIDTag])
+
+	inlineSize, err := s.store.InlineSize(testDomainName, testDomainID, []byte("abcdef"))
+	s.NoError(err)
+	s.Equal(len(data), inlineSize)
+
+	s.client.On("Get", mock.Anything, &blobstore.GetRequest{Key: stored.Key}).
+		Return(&blobstore.GetResponse{Blob: stored.Blob}, nil).Once()
+	event := &types.HistoryEvent{
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+			Input: data,
+		},
+	}
+	s.NoError(s.store.RehydrateEvents(context.Background(), testDomainID, []*types.HistoryEvent{event}))
+	s.Equal([]byte("abcdef"), event.WorkflowExecutionStartedEventAttributes.Input)
+}
+
+func (s *storeSuite) TestRehydrate_OtherDomain() {
+	_, err := s.store.Rehydrate(context.Background(), "other-domain-id", []byte(referencePrefix+keyPrefix+testDomainID+"_key"))
+	s.Equal(errInvalidReference, err)
+}
+
+func (s *storeSuite) TestCopy() {
+	sourceKey := keyPrefix + "source-domain-id_key"
+	s.client.On("Get", mock.Anything, &blobstore.GetRequest{Key: sourceKey}).
+		Return(&blobstore.GetResponse{Blob: blobstore.Blob{Body: []byte("abcdef")}}, nil).Once()
+	s.client.On("Put", mock.Anything, mock.MatchedBy(func(request *blobstore.PutRequest) bool {
+		return strings.HasPrefix(request.Key, keyPrefix+testDomainID+"_") && string(request.Blob.Body) == "abcdef"
+	})).Return(&blobstore.PutResponse{}, nil).Once()
+
+	data, err := s.store.Copy(context.Background(), "source-domain-id", testDomainID, testWorkflowID, []byte(referencePrefix+sourceKey))
+	s.NoError(err)
+	s.True(IsReference(data))
+	s.NotEqual([]byte(referencePrefix+sourceKey), data)
+
+	data, err = s.store.Copy(context.Background(), "source-domain-id", testDomainID, testWorkflowID, []byte("abc"))
+	s.NoError(err)
+	s.Equal([]byte("abc"), data)
+}
+
+func (s *storeSuite) TestDelete() {
+	key := keyPrefix + testDomainID + "_key"
+	s.client.On("Exists", mock.Anything, &blobstore.ExistsRequest{Key: key}).
+		Return(&blobstore.ExistsResponse{Exists: true}, nil).Once()
+	s.client.On("Delete", mock.Anything, &blobstore.DeleteRequest{Key: key}).
+		Return(&blobstore.DeleteResponse{}, nil).Once()
+	s.NoError(s.store.Delete(context.Background(), testDomainID, []byte(referencePrefix+key)))
+
+	s.client.On("Exists", mock.Anything, &blobstore.ExistsRequest{Key: key}).
+		Return(&blobstore.ExistsResponse{Exists: false}, nil).Once()
+	s.NoError(s.store.Delete(context.Background(), testDomainID, []byte(referencePrefix+key)))
+}
+
+func (s *storeSuite) TestDiscard() {
+	key := keyPrefix + testDomainID + "_key"
+	references := [][]byte{[]byte("inline"), []byte(referencePrefix + key)}
+
+	s.client.On("Exists", mock.Anything, &blobstore.ExistsRequest{Key: key}).
+		Return(&blobstore.ExistsResponse{Exists: true}, nil).Once()
+	s.client.On("Delete", mock.Anything, &blobstore.DeleteRequest{Key: key}).
+		Return(&blobstore.DeleteResponse{}, nil).Once()
+	s.NoError(s.store.Discard(context.Background(), testDomainID, references))
+}
+
+func (s *storeSuite) TestMayBePersisted() {
+	s.True(MayBePersisted(nil))
+	s.True(MayBePersisted(&persistence.TimeoutError{}))
+	s.True(MayBePersisted(context.DeadlineExceeded))
+	s.False(MayBePersisted(&persistence.ConditionFailedError{}))
+	s.False(MayBePersisted(&types.InternalServiceError{}))
+}
+
+func (s *storeSuite) TestDeleteHistory() {
+	encoder := codec.NewThriftRWEncoder()
+	branchToken, err := encoder.Encode(&workflow.HistoryBranch{
+		TreeID:    common.StringPtr("tree-id"),
+		BranchID:  common.StringPtr("branch-id"),
+		Ancestors: []*workflow.HistoryBranchRange{},
+	})
+	s.NoError(err)
+
+	// events before 5 are shared with a branch forked from this branch
+	historyManager := &mocks.HistoryV2Manager{}
+	defer historyManager.AssertExpectations(s.T())
+	historyManager.On("GetHistoryTree", mock.Anything, mock.Anything).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*workflow.HistoryBranch{
+			{
+				TreeID:   common.StringPtr("tree-id"),
+				BranchID: common.StringPtr("branch-id"),
+			},
+			{
+				TreeID:   common.StringPtr("tree-id"),
+				BranchID: common.StringPtr("forked-branch-id"),
+				Ancestors: []*workflow.HistoryBranchRange{
+					{
+						BranchID:    common.StringPtr("branch-id"),
+						BeginNodeID: common.Int64Ptr(1),
+						EndNodeID:   common.Int64Ptr(5),
+					},
+				},
+			},
+		},
+	}, nil).Once()
+
+	completedKey := keyPrefix + testDomainID + "_completed"
+	continuedAsNewKey := keyPrefix + testDomainID + "_continued"
+	historyManager.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == 5
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{
+				ID: 5,
+				WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{
+					Result: []byte(referencePrefix + completedKey),
+				},
+			},
+			{
+				ID: 6,
+				WorkflowExecutionContinuedAsNewEventAttributes: &types.WorkflowExecutionContinuedAsNewEventAttributes{
+					Input: []byte(referencePrefix + continuedAsNewKey),
+				},
+			},
+		},
+	}, nil).Once()
+
+	s.client.On("Exists", mock.Anything, &blobstore.ExistsRequest{Key: completedKey}).
+		Return(&blobstore.ExistsResponse{Exists: true}, nil).Once()
+	s.client.On("Delete", mock.Anything, &blobstore.DeleteRequest{Key: completedKey}).
+		Return(&blobstore.DeleteResponse{}, nil).Once()
+
+	s.NoError(s.store.DeleteHistory(context.Background(), historyManager, testDomainID, branchToken, 1))
+}
@@ -187,6 +187,7 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeWorkflowInactivityTimeout
 )
 
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
@@ -351,6 +352,17 @@ type (
 		CronSchedule      string
 		IsCron            bool
 		ExpirationSeconds int32 // TODO: is this field useful?
+		// Pause
+		Paused bool
+		// Inactivity
+		InactivityTimeout       int32
+		InactivityTimeoutPolicy types.InactivityTimeoutPolicy
+		Inactive                bool
+		// LastDecisionCompletedTimestamp is the completion time of the last decision in unix nanos,
+		// the inactivity timer counts from it
+		LastDecisionCompletedTimestamp int64
+		// Update
+		WorkflowUpdates []*types.WorkflowUpdateInfo
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -443,6 +455,27 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		// Config is the server side configuration of the task list, nil if not configured
+		Config *TaskListConfig
+		// WorkerIdentity is the identity of the worker owning a sticky task list
+		WorkerIdentity string
+	}
+
+	// TaskListConfig contains the server side limits of a task list partition,
+	// a zero value means the corresponding limit is not enforced
+	TaskListConfig struct {
+		// MaxDispatchPerSecond overrides the dispatch rate provided by the pollers
+		MaxDispatchPerSecond float64
+		// MaxBacklogSize is the max number of tasks persisted in the backlog
+		MaxBacklogSize int64
+		// RejectWhenBacklogFull rejects new tasks when the backlog reaches MaxBacklogSize
+		RejectWhenBacklogFull bool
+		// MaxOutstandingTasks is the max number of tasks loaded from the backlog and not yet delivered
+		MaxOutstandingTasks int32
+		// NumReadPartitions is the number of read partitions chosen by the partition autoscaler, zero means unset
+		NumReadPartitions int32
+		// NumWritePartitions is the number of write partitions chosen by the partition autoscaler, zero means unset
+		NumWritePartitions int32
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -455,6 +488,9 @@ type (
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		Priority               int32
+		LabelSelector          string
+		IsolationGroup         string
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -542,6 +578,16 @@ type (
 		Version             int64
 	}
 
+	// WorkflowInactivityTimeoutTask identifies a timer task for checking workflow inactivity.
+	// EventID is the last processed event when the task is generated, and is used to detect
+	// whether any decision has completed since then.
+	WorkflowInactivityTimeoutTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		EventID             int64
+		Version             int64
+	}
+
 	// CancelExecutionTask identifies a transfer task for cancel of execution
 	CancelExecutionTask struct {
 		VisibilityTimestamp     time.Time
@@ -784,6 +830,8 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// Bounded log of previous attempts, oldest first
+		AttemptLog []*types.ActivityAttemptInfo
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -1217,6 +1265,18 @@ type (
 		TaskListInfo *TaskListInfo
 	}
 
+	// GetTaskListRequest is used to read a task list without taking its lease
+	GetTaskListRequest struct {
+		DomainID string
+		TaskList string
+		TaskType int
+	}
+
+	// GetTaskListResponse is response to GetTaskListRequest
+	GetTaskListResponse struct {
+		TaskListInfo *TaskListInfo
+	}
+
 	// UpdateTaskListRequest is used to update task list implementation information
 	UpdateTaskListRequest struct {
 		TaskListInfo *TaskListInfo
@@ -1618,6 +1678,17 @@ type (
 		ShardID *int
 	}
 
+	// TrimHistoryBranchRequest is used to remove a range of history nodes from a branch
+	TrimHistoryBranchRequest struct {
+		// branch to be trimmed
+		BranchToken []byte
+		// nodes from MinNodeID(inclusive) to MaxNodeID(exclusive) are removed
+		MinNodeID int64
+		MaxNodeID int64
+		// The shard to trim history branch data
+		ShardID *int
+	}
+
 	// GetHistoryTreeRequest is used to retrieve branch info of a history tree
 	GetHistoryTreeRequest struct {
 		// A UUID of a tree
@@ -1750,6 +1821,7 @@ type (
 		Closeable
 		GetName() string
 		LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
+		GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error)
 		UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
 		ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error)
 		DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error
@@ -1783,6 +1855,9 @@ type (
 		// DeleteHistoryBranch removes a branch
 		// If this is the last branch to delete, it will also remove the root node
 		DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error
+		// TrimHistoryBranch removes a range of nodes from a branch
+		// Nodes still referred by other branches of the tree are kept
+		TrimHistoryBranch(ctx context.Context, request *TrimHistoryBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
@@ -2292,6 +2367,41 @@ func (u *WorkflowTimeoutTask) SetVisibilityTimestamp(t time.Time) {
 	u.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the inactivity timeout task
+func (u *WorkflowInactivityTimeoutTask) GetType() int {
+	return TaskTypeWorkflowInactivityTimeout
+}
+
+// GetVersion returns the version of the inactivity timeout task
+func (u *WorkflowInactivityTimeoutTask) GetVersion() int64 {
+	return u.Version
+}
+
+// SetVersion sets the version of the inactivity timeout task
+func (u *WorkflowInactivityTimeoutTask) SetVersion(version int64) {
+	u.Version = version
+}
+
+// GetTaskID returns the sequence ID of the inactivity timeout task
+func (u *WorkflowInactivityTimeoutTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the inactivity timeout task
+func (u *WorkflowInactivityTimeoutTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (u *WorkflowInactivityTimeoutTask) GetVisibilityTimestamp() time.Time {
+	return u.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp sets the visibility time stamp
+func (u *WorkflowInactivityTimeoutTask) SetVisibilityTimestamp(t time.Time) {
+	u.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the cancel transfer task
 func (u *CancelExecutionTask) GetType() int {
 	return TransferTaskTypeCancelExecution
@@ -2929,6 +3039,33 @@ func NewHistoryBranchTokenFromAnother(branchID string, anotherToken []byte) ([]b
 	return token, nil
 }
 
+// NewHistoryBranchTokenWithCompaction returns the branch token with the compaction node ID set,
+// nodes of the branch before the compaction node (except the first one) can be trimmed
+func NewHistoryBranchTokenWithCompaction(branchToken []byte, compactionNodeID int64) ([]byte, error) {
+	var branch workflow.HistoryBranch
+	err := internalThriftEncoder.Decode(branchToken, &branch)
+	if err != nil {
+		return nil, err
+	}
+
+	branch.CompactionNodeID = &compactionNodeID
+	token, err := internalThriftEncoder.Encode(&branch)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// GetHistoryBranchCompactionNodeID returns the compaction node ID of the branch, 0 if the branch is not compacted
+func GetHistoryBranchCompactionNodeID(branchToken []byte) (int64, error) {
+	var branch workflow.HistoryBranch
+	err := internalThriftEncoder.Decode(branchToken, &branch)
+	if err != nil {
+		return 0, err
+	}
+	return branch.GetCompactionNodeID(), nil
+}
+
 // BuildHistoryGarbageCleanupInfo combine the workflow identity information into a string
 func BuildHistoryGarbageCleanupInfo(domainID, workflowID, runID string) string {
 	return fmt.Sprintf("%v:%v:%v", domainID, workflowID, runID)
@@ -864,6 +864,21 @@ func (mr *MockTaskManagerMockRecorder) LeaseTaskList(ctx, request interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaseTaskList", reflect.TypeOf((*MockTaskManager)(nil).LeaseTaskList), ctx, request)
 }
 
+// GetTaskList mocks base method
+func (m *MockTaskManager) GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskList", ctx, request)
+	ret0, _ := ret[0].(*GetTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskList indicates an expected call of GetTaskList
+func (mr *MockTaskManagerMockRecorder) GetTaskList(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskList", reflect.TypeOf((*MockTaskManager)(nil).GetTaskList), ctx, request)
+}
+
 // UpdateTaskList mocks base method
 func (m *MockTaskManager) UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -1150,6 +1165,20 @@ func (mr *MockHistoryManagerMockRecorder) GetAllHistoryTreeBranches(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllHistoryTreeBranches", reflect.TypeOf((*MockHistoryManager)(nil).GetAllHistoryTreeBranches), ctx, request)
 }
 
+// TrimHistoryBranch mocks base method
+func (m *MockHistoryManager) TrimHistoryBranch(ctx context.Context, request *TrimHistoryBranchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrimHistoryBranch", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrimHistoryBranch indicates an expected call of TrimHistoryBranch
+func (mr *MockHistoryManagerMockRecorder) TrimHistoryBranch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimHistoryBranch", reflect.TypeOf((*MockHistoryManager)(nil).TrimHistoryBranch), ctx, request)
+}
+
 // MockDomainManager is a mock of DomainManager interface
 type MockDomainManager struct {
 	ctrl     *gomock.Controller
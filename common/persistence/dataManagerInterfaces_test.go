@@ -58,3 +58,20 @@ func TestIsTransientError(t *testing.T) {
 		require.False(t, IsTransientError(err))
 	}
 }
+
+func TestHistoryBranchTokenWithCompaction(t *testing.T) {
+	token, err := NewHistoryBranchToken("test-tree-id")
+	require.NoError(t, err)
+	compactionNodeID, err := GetHistoryBranchCompactionNodeID(token)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), compactionNodeID)
+
+	compactedToken, err := NewHistoryBranchTokenWithCompaction(token, 101)
+	require.NoError(t, err)
+	compactionNodeID, err = GetHistoryBranchCompactionNodeID(compactedToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), compactionNodeID)
+
+	_, err = GetHistoryBranchCompactionNodeID([]byte("invalid token"))
+	require.Error(t, err)
+}
@@ -54,6 +54,7 @@ type (
 		Closeable
 		GetName() string
 		LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
+		GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error)
 		UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
 		ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error)
 		DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error
@@ -152,6 +153,8 @@ type (
 		ForkHistoryBranch(ctx context.Context, request *InternalForkHistoryBranchRequest) (*InternalForkHistoryBranchResponse, error)
 		// DeleteHistoryBranch removes a branch
 		DeleteHistoryBranch(ctx context.Context, request *InternalDeleteHistoryBranchRequest) error
+		// TrimHistoryBranch removes a range of nodes from a branch
+		TrimHistoryBranch(ctx context.Context, request *InternalTrimHistoryBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(ctx context.Context, request *InternalGetHistoryTreeRequest) (*InternalGetHistoryTreeResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
@@ -324,6 +327,14 @@ type (
 		ExpirationInterval time.Duration
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
+		Paused             bool
+		// Inactivity
+		InactivityTimeout              time.Duration
+		InactivityTimeoutPolicy        types.InactivityTimeoutPolicy
+		Inactive                       bool
+		LastDecisionCompletedTimestamp time.Time
+		// Update
+		WorkflowUpdates *DataBlob
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -382,6 +393,8 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// Bounded log of previous attempts, oldest first
+		AttemptLog *DataBlob
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -567,6 +580,18 @@ type (
 		ShardID int
 	}
 
+	// InternalTrimHistoryBranchRequest is used to remove a range of history nodes from a branch
+	InternalTrimHistoryBranchRequest struct {
+		// branch to be trimmed
+		BranchInfo types.HistoryBranch
+		// Remove the history nodes from MinNodeID. Inclusive.
+		MinNodeID int64
+		// Remove the history nodes upto MaxNodeID. Exclusive.
+		MaxNodeID int64
+		// Used in sharded data stores to identify which shard to use
+		ShardID int
+	}
+
 	// InternalReadHistoryBranchRequest is used to read a history branch
 	InternalReadHistoryBranchRequest struct {
 		// The tree of branch range to be read
@@ -864,6 +889,9 @@ type (
 		ScheduleToStartTimeout time.Duration
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		Priority               int32
+		LabelSelector          string
+		IsolationGroup         string
 	}
 
 	// InternalCreateTasksInfo describes a task to be created in InternalCreateTasksRequest
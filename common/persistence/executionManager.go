@@ -129,6 +129,11 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		return nil, nil, err
 	}
 
+	workflowUpdates, err := m.serializer.DeserializeWorkflowUpdates(info.WorkflowUpdates)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
 
@@ -186,6 +191,12 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
+		Paused:                             info.Paused,
+		InactivityTimeout:                  int32(info.InactivityTimeout.Seconds()),
+		InactivityTimeoutPolicy:            info.InactivityTimeoutPolicy,
+		Inactive:                           info.Inactive,
+		LastDecisionCompletedTimestamp:     info.LastDecisionCompletedTimestamp.UnixNano(),
+		WorkflowUpdates:                    workflowUpdates,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -269,6 +280,10 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		attemptLog, err := m.serializer.DeserializeActivityAttemptLog(v.AttemptLog)
+		if err != nil {
+			return nil, err
+		}
 		a := &ActivityInfo{
 			ScheduledEvent: scheduledEvent,
 			StartedEvent:   startedEvent,
@@ -304,6 +319,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			AttemptLog:                              attemptLog,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -395,6 +411,10 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		attemptLog, err := m.serializer.SerializeActivityAttemptLog(v.AttemptLog, encoding)
+		if err != nil {
+			return nil, err
+		}
 		i := &InternalActivityInfo{
 			Version:                                 v.Version,
 			ScheduleID:                              v.ScheduleID,
@@ -429,6 +449,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			AttemptLog:                              attemptLog,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -455,6 +476,11 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		return nil, err
 	}
 
+	workflowUpdates, err := m.serializer.SerializeWorkflowUpdates(info.WorkflowUpdates, encoding)
+	if err != nil {
+		return nil, err
+	}
+
 	return &InternalWorkflowExecutionInfo{
 		DomainID:                           info.DomainID,
 		WorkflowID:                         info.WorkflowID,
@@ -510,6 +536,12 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		ExpirationInterval:                 common.SecondsToDuration(int64(info.ExpirationSeconds)),
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
+		Paused:                             info.Paused,
+		InactivityTimeout:                  common.SecondsToDuration(int64(info.InactivityTimeout)),
+		InactivityTimeoutPolicy:            info.InactivityTimeoutPolicy,
+		Inactive:                           info.Inactive,
+		LastDecisionCompletedTimestamp:     time.Unix(0, info.LastDecisionCompletedTimestamp),
+		WorkflowUpdates:                    workflowUpdates,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
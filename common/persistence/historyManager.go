@@ -97,6 +97,12 @@ func (m *historyV2ManagerImpl) ForkHistoryBranch(
 	if err != nil {
 		return nil, err
 	}
+	compactionNodeID := forkBranch.GetCompactionNodeID()
+	if compactionNodeID > 0 && request.ForkNodeID <= compactionNodeID {
+		return nil, &InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("ForkNodeID must be > %v, nodes before it are compacted", compactionNodeID),
+		}
+	}
 	shardID, err := getShardID(request.ShardID)
 	if err != nil {
 		return nil, &types.InternalServiceError{
@@ -116,6 +122,8 @@ func (m *historyV2ManagerImpl) ForkHistoryBranch(
 	if err != nil {
 		return nil, err
 	}
+	// the new branch shares the compacted ancestor nodes, so it needs to know about the compaction as well
+	resp.NewBranchInfo.CompactionNodeID = compactionNodeID
 
 	token, err := m.thriftEncoder.Encode(thrift.FromHistoryBranch(&resp.NewBranchInfo))
 	if err != nil {
@@ -154,6 +162,46 @@ func (m *historyV2ManagerImpl) DeleteHistoryBranch(
 	return m.persistence.DeleteHistoryBranch(ctx, req)
 }
 
+// TrimHistoryBranch removes a range of nodes from a branch
+func (m *historyV2ManagerImpl) TrimHistoryBranch(
+	ctx context.Context,
+	request *TrimHistoryBranchRequest,
+) error {
+
+	// the first node contains the workflow started event and is never trimmed
+	if request.MinNodeID <= common.FirstEventID || request.MinNodeID >= request.MaxNodeID {
+		return &InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf(
+				"no nodes can be trimmed for minNodeID %v, maxNodeID: %v",
+				request.MinNodeID,
+				request.MaxNodeID,
+			),
+		}
+	}
+
+	var branch workflow.HistoryBranch
+	err := m.thriftEncoder.Decode(request.BranchToken, &branch)
+	if err != nil {
+		return err
+	}
+
+	shardID, err := getShardID(request.ShardID)
+	if err != nil {
+		m.logger.Error("shardID is not set in trim history operation", tag.Error(err))
+		return &types.InternalServiceError{
+			Message: err.Error(),
+		}
+	}
+	req := &InternalTrimHistoryBranchRequest{
+		BranchInfo: *thrift.ToHistoryBranch(&branch),
+		MinNodeID:  request.MinNodeID,
+		MaxNodeID:  request.MaxNodeID,
+		ShardID:    shardID,
+	}
+
+	return m.persistence.TrimHistoryBranch(ctx, req)
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (m *historyV2ManagerImpl) GetHistoryTree(
 	ctx context.Context,
@@ -497,7 +545,8 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 			logger.Info("Stale event batch with eventID", tag.WorkflowFirstEventID(firstEvent.ID), tag.TokenLastEventID(token.LastEventID))
 			continue
 		}
-		if firstEvent.ID != token.LastEventID+1 {
+		if firstEvent.ID != token.LastEventID+1 && firstEvent.GetEventType() != types.EventTypeWorkflowHistoryCompacted {
+			// Events before a history compaction event are removed, so it's expected that the history is not continuous there
 			// We assume application layer want to read from MinEventID(inclusive)
 			// However, for getting history from remote cluster, there is scenario that we have to read from middle without knowing the firstEventID.
 			// In that case we don't validate history continuousness for the first page
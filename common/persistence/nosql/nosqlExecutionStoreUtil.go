@@ -224,6 +224,9 @@ func (d *nosqlExecutionStore) prepareTimerTasksForWorkflowTxn(
 		case *p.WorkflowTimeoutTask:
 			// noop
 
+		case *p.WorkflowInactivityTimeoutTask:
+			eventID = t.EventID
+
 		case *p.DeleteHistoryEventTask:
 			// noop
 
@@ -544,6 +547,7 @@ func (d *nosqlExecutionStore) prepareActivityInfosForWorkflowTxn(
 		}
 		a.ScheduledEvent = a.ScheduledEvent.ToNilSafeDataBlob()
 		a.StartedEvent = a.StartedEvent.ToNilSafeDataBlob()
+		a.AttemptLog = a.AttemptLog.ToNilSafeDataBlob()
 		m[a.ScheduleID] = a
 	}
 	return m, nil
@@ -624,6 +628,7 @@ func (d *nosqlExecutionStore) prepareUpdateWorkflowExecutionTxn(
 
 	executionInfo.CompletionEvent = executionInfo.CompletionEvent.ToNilSafeDataBlob()
 	executionInfo.AutoResetPoints = executionInfo.AutoResetPoints.ToNilSafeDataBlob()
+	executionInfo.WorkflowUpdates = executionInfo.WorkflowUpdates.ToNilSafeDataBlob()
 	// TODO also need to set the start / current / last write version
 	versionHistories = versionHistories.ToNilSafeDataBlob()
 	return &nosqlplugin.WorkflowExecutionRequest{
@@ -665,6 +670,7 @@ func (d *nosqlExecutionStore) prepareCreateWorkflowExecutionTxn(
 	}
 	executionInfo.CompletionEvent = executionInfo.CompletionEvent.ToNilSafeDataBlob()
 	executionInfo.AutoResetPoints = executionInfo.AutoResetPoints.ToNilSafeDataBlob()
+	executionInfo.WorkflowUpdates = executionInfo.WorkflowUpdates.ToNilSafeDataBlob()
 	if versionHistories == nil {
 		return nil, &types.InternalServiceError{Message: "encounter empty version histories in createExecution"}
 	}
@@ -366,6 +366,51 @@ func (h *nosqlHistoryStore) DeleteHistoryBranch(
 	return nil
 }
 
+// TrimHistoryBranch removes a range of nodes from a branch
+func (h *nosqlHistoryStore) TrimHistoryBranch(
+	ctx context.Context,
+	request *p.InternalTrimHistoryBranchRequest,
+) error {
+
+	branch := request.BranchInfo
+	treeID := branch.TreeID
+	// nodes of the ancestors are shared with other branches, only nodes of this branch can be removed
+	minNodeID := request.MinNodeID
+	if beginNodeID := persistenceutils.GetBeginNodeID(branch); minNodeID < beginNodeID {
+		minNodeID = beginNodeID
+	}
+
+	rsp, err := h.GetHistoryTree(ctx, &p.InternalGetHistoryTreeRequest{
+		TreeID:  treeID,
+		ShardID: &request.ShardID,
+	})
+	if err != nil {
+		return err
+	}
+
+	// nodes referred by branches forked from this branch are kept
+	validBRsMaxEndNode := persistenceutils.GetBranchesMaxReferredNodeIDs(rsp.Branches)
+	if maxReferredEndNodeID, ok := validBRsMaxEndNode[branch.BranchID]; ok && minNodeID < maxReferredEndNodeID {
+		minNodeID = maxReferredEndNodeID
+	}
+	if minNodeID >= request.MaxNodeID {
+		return nil
+	}
+
+	nodeFilter := &nosqlplugin.HistoryNodeFilter{
+		ShardID:   request.ShardID,
+		TreeID:    treeID,
+		BranchID:  branch.BranchID,
+		MinNodeID: minNodeID,
+		MaxNodeID: request.MaxNodeID,
+	}
+	err = h.db.DeleteFromHistoryNode(ctx, nodeFilter)
+	if err != nil {
+		return convertCommonErrors(h.db, "TrimHistoryBranch", err)
+	}
+	return nil
+}
+
 func (h *nosqlHistoryStore) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *p.GetAllHistoryTreeBranchesRequest,
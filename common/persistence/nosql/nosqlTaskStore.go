@@ -129,6 +129,8 @@ func (t *nosqlTaskStore) LeaseTaskList(
 			TaskListKind:    currTL.TaskListKind,
 			AckLevel:        currTL.AckLevel,
 			LastUpdatedTime: now,
+			Config:          currTL.Config,
+			WorkerIdentity:  currTL.WorkerIdentity,
 		}, currTL.RangeID-1)
 	}
 	if err != nil {
@@ -142,17 +144,46 @@ func (t *nosqlTaskStore) LeaseTaskList(
 		return nil, convertCommonErrors(t.db, "LeaseTaskList", err)
 	}
 	tli := &p.TaskListInfo{
-		DomainID:    request.DomainID,
-		Name:        request.TaskList,
-		TaskType:    request.TaskType,
-		RangeID:     currTL.RangeID,
-		AckLevel:    currTL.AckLevel,
-		Kind:        request.TaskListKind,
-		LastUpdated: now,
+		DomainID:       request.DomainID,
+		Name:           request.TaskList,
+		TaskType:       request.TaskType,
+		RangeID:        currTL.RangeID,
+		AckLevel:       currTL.AckLevel,
+		Kind:           request.TaskListKind,
+		LastUpdated:    now,
+		Config:         currTL.Config,
+		WorkerIdentity: currTL.WorkerIdentity,
 	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
 
+func (t *nosqlTaskStore) GetTaskList(
+	ctx context.Context,
+	request *p.GetTaskListRequest,
+) (*p.GetTaskListResponse, error) {
+	currTL, err := t.db.SelectTaskList(ctx, &nosqlplugin.TaskListFilter{
+		DomainID:     request.DomainID,
+		TaskListName: request.TaskList,
+		TaskListType: request.TaskType,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(t.db, "GetTaskList", err)
+	}
+	return &p.GetTaskListResponse{
+		TaskListInfo: &p.TaskListInfo{
+			DomainID:       request.DomainID,
+			Name:           request.TaskList,
+			TaskType:       request.TaskType,
+			RangeID:        currTL.RangeID,
+			AckLevel:       currTL.AckLevel,
+			Kind:           currTL.TaskListKind,
+			LastUpdated:    currTL.LastUpdatedTime,
+			Config:         currTL.Config,
+			WorkerIdentity: currTL.WorkerIdentity,
+		},
+	}, nil
+}
+
 func (t *nosqlTaskStore) UpdateTaskList(
 	ctx context.Context,
 	request *p.UpdateTaskListRequest,
@@ -167,6 +198,8 @@ func (t *nosqlTaskStore) UpdateTaskList(
 		TaskListKind:    tli.Kind,
 		AckLevel:        tli.AckLevel,
 		LastUpdatedTime: time.Now(),
+		Config:          tli.Config,
+		WorkerIdentity:  tli.WorkerIdentity,
 	}
 
 	if tli.Kind == p.TaskListKindSticky { // if task_list is sticky, then update with TTL
@@ -238,6 +271,10 @@ func (t *nosqlTaskStore) CreateTasks(
 			RunID:        t.Execution.GetRunID(),
 			ScheduledID:  t.Data.ScheduleID,
 			CreatedTime:  now,
+			Priority:     t.Data.Priority,
+
+			LabelSelector:  t.Data.LabelSelector,
+			IsolationGroup: t.Data.IsolationGroup,
 		}
 		ttl := int(t.Data.ScheduleToStartTimeout.Seconds())
 		tasks = append(tasks, &nosqlplugin.TaskRowForInsert{
@@ -271,6 +308,8 @@ func toTaskListRow(info *p.TaskListInfo) *nosqlplugin.TaskListRow {
 		RangeID:         info.RangeID,
 		AckLevel:        info.AckLevel,
 		LastUpdatedTime: info.LastUpdated,
+		Config:          info.Config,
+		WorkerIdentity:  info.WorkerIdentity,
 	}
 }
 
@@ -318,6 +357,10 @@ func toTaskInfo(t *nosqlplugin.TaskRow) *p.InternalTaskInfo {
 		TaskID:      t.TaskID,
 		ScheduleID:  t.ScheduledID,
 		CreatedTime: t.CreatedTime,
+		Priority:    t.Priority,
+
+		LabelSelector:  t.LabelSelector,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
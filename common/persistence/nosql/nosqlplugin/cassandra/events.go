@@ -43,6 +43,8 @@ const (
 
 	v2templateRangeDeleteData = `DELETE FROM history_node WHERE tree_id = ? AND branch_id = ? AND node_id >= ? `
 
+	v2templateRangeDeleteDataBetween = `DELETE FROM history_node WHERE tree_id = ? AND branch_id = ? AND node_id >= ? AND node_id < ? `
+
 	// below are templates for history_tree table
 	v2templateInsertTree = `INSERT INTO history_tree (` +
 		`tree_id, branch_id, ancestors, fork_time, info) ` +
@@ -133,6 +135,16 @@ func (db *cdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nos
 	return db.session.ExecuteBatch(batch)
 }
 
+// DeleteFromHistoryNode delete a range of nodes of a branch
+func (db *cdb) DeleteFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) error {
+	query := db.session.Query(v2templateRangeDeleteDataBetween,
+		filter.TreeID,
+		filter.BranchID,
+		filter.MinNodeID,
+		filter.MaxNodeID).WithContext(ctx)
+	return query.Exec()
+}
+
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *cdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
 	query := db.session.Query(v2templateScanAllTreeBranches).WithContext(ctx)
@@ -51,7 +51,14 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`max_dispatch_rps: ?, ` +
+		`max_backlog_size: ?, ` +
+		`reject_on_full_backlog: ?, ` +
+		`max_outstanding_tasks: ?, ` +
+		`worker_identity: ?, ` +
+		`num_read_partitions: ?, ` +
+		`num_write_partitions: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -59,7 +66,10 @@ const (
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`priority: ?, ` +
+		`label_selector: ?, ` +
+		`isolation_group: ? ` +
 		`}`
 
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
@@ -163,6 +173,7 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 	ackLevel := tlDB["ack_level"].(int64)
 	taskListKind := tlDB["kind"].(int)
 	lastUpdatedTime := tlDB["last_updated"].(time.Time)
+	workerIdentity, _ := tlDB["worker_identity"].(string)
 
 	return &nosqlplugin.TaskListRow{
 		DomainID:     filter.DomainID,
@@ -173,12 +184,49 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 		LastUpdatedTime: lastUpdatedTime,
 		AckLevel:        ackLevel,
 		RangeID:         rangeID,
+		Config:          taskListConfigFromMap(tlDB),
+		WorkerIdentity:  workerIdentity,
 	}, nil
 }
 
+func taskListConfigFromMap(tlDB map[string]interface{}) *p.TaskListConfig {
+	config := &p.TaskListConfig{}
+	if v, ok := tlDB["max_dispatch_rps"].(float64); ok {
+		config.MaxDispatchPerSecond = v
+	}
+	if v, ok := tlDB["max_backlog_size"].(int64); ok {
+		config.MaxBacklogSize = v
+	}
+	if v, ok := tlDB["reject_on_full_backlog"].(bool); ok {
+		config.RejectWhenBacklogFull = v
+	}
+	if v, ok := tlDB["max_outstanding_tasks"].(int); ok {
+		config.MaxOutstandingTasks = int32(v)
+	}
+	if v, ok := tlDB["num_read_partitions"].(int); ok {
+		config.NumReadPartitions = int32(v)
+	}
+	if v, ok := tlDB["num_write_partitions"].(int); ok {
+		config.NumWritePartitions = int32(v)
+	}
+	if *config == (p.TaskListConfig{}) {
+		return nil
+	}
+	return config
+}
+
+// taskListConfigOrDefault returns the config of the task list row, with no limits if it's not configured
+func taskListConfigOrDefault(config *p.TaskListConfig) *p.TaskListConfig {
+	if config == nil {
+		return &p.TaskListConfig{}
+	}
+	return config
+}
+
 // InsertTaskList insert a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *cdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
+	config := taskListConfigOrDefault(row.Config)
 	query := db.session.Query(templateInsertTaskListQuery,
 		row.DomainID,
 		row.TaskListName,
@@ -192,6 +240,13 @@ func (db *cdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow)
 		0,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		config.MaxDispatchPerSecond,
+		config.MaxBacklogSize,
+		config.RejectWhenBacklogFull,
+		config.MaxOutstandingTasks,
+		row.WorkerIdentity,
+		config.NumReadPartitions,
+		config.NumWritePartitions,
 	).WithContext(ctx)
 
 	previous := make(map[string]interface{})
@@ -210,6 +265,7 @@ func (db *cdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
+	config := taskListConfigOrDefault(row.Config)
 	query := db.session.Query(templateUpdateTaskListQuery,
 		row.RangeID,
 		row.DomainID,
@@ -218,6 +274,13 @@ func (db *cdb) UpdateTaskList(
 		row.AckLevel,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		config.MaxDispatchPerSecond,
+		config.MaxBacklogSize,
+		config.RejectWhenBacklogFull,
+		config.MaxOutstandingTasks,
+		row.WorkerIdentity,
+		config.NumReadPartitions,
+		config.NumWritePartitions,
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -261,6 +324,7 @@ func (db *cdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
+	config := taskListConfigOrDefault(row.Config)
 	batch := db.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	// part 1 is used to set TTL on primary key as UPDATE can't set TTL for primary key
 	batch.Query(templateUpdateTaskListQueryWithTTLPart1,
@@ -281,6 +345,13 @@ func (db *cdb) UpdateTaskListWithTTL(
 		row.AckLevel,
 		row.TaskListKind,
 		time.Now(),
+		config.MaxDispatchPerSecond,
+		config.MaxBacklogSize,
+		config.RejectWhenBacklogFull,
+		config.MaxOutstandingTasks,
+		row.WorkerIdentity,
+		config.NumReadPartitions,
+		config.NumWritePartitions,
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -336,6 +407,7 @@ func (db *cdb) InsertTasks(
 	taskListType := tasklistCondition.TaskListType
 	taskListKind := tasklistCondition.TaskListKind
 	ackLevel := tasklistCondition.AckLevel
+	config := taskListConfigOrDefault(tasklistCondition.Config)
 
 	for _, task := range tasksToInsert {
 		scheduleID := task.ScheduledID
@@ -351,7 +423,10 @@ func (db *cdb) InsertTasks(
 				task.WorkflowID,
 				task.RunID,
 				scheduleID,
-				task.CreatedTime)
+				task.CreatedTime,
+				task.Priority,
+				task.LabelSelector,
+				task.IsolationGroup)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -367,6 +442,9 @@ func (db *cdb) InsertTasks(
 				task.RunID,
 				scheduleID,
 				task.CreatedTime,
+				task.Priority,
+				task.LabelSelector,
+				task.IsolationGroup,
 				ttl)
 		}
 	}
@@ -380,6 +458,13 @@ func (db *cdb) InsertTasks(
 		ackLevel,
 		taskListKind,
 		time.Now(),
+		config.MaxDispatchPerSecond,
+		config.MaxBacklogSize,
+		config.RejectWhenBacklogFull,
+		config.MaxOutstandingTasks,
+		tasklistCondition.WorkerIdentity,
+		config.NumReadPartitions,
+		config.NumWritePartitions,
 		domainID,
 		taskListName,
 		taskListType,
@@ -454,6 +539,12 @@ func createTaskInfo(
 			info.ScheduledID = v.(int64)
 		case "created_time":
 			info.CreatedTime = v.(time.Time)
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "label_selector":
+			info.LabelSelector = v.(string)
+		case "isolation_group":
+			info.IsolationGroup = v.(string)
 		}
 	}
 
@@ -80,7 +80,14 @@ const (
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
-		`memo: ? ` +
+		`memo: ?, ` +
+		`paused: ?, ` +
+		`inactivity_timeout: ?, ` +
+		`inactivity_timeout_policy: ?, ` +
+		`inactive: ?, ` +
+		`last_decision_completed_timestamp: ?, ` +
+		`workflow_updates: ?, ` +
+		`workflow_updates_encoding: ? ` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -166,7 +173,9 @@ const (
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
 		`last_failure_details: ?, ` +
-		`event_data_encoding: ?` +
+		`event_data_encoding: ?, ` +
+		`attempt_log: ?, ` +
+		`attempt_log_encoding: ?` +
 		`}`
 
 	templateTimerInfoType = `{` +
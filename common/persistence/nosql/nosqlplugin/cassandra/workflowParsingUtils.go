@@ -45,6 +45,8 @@ func parseWorkflowExecutionInfo(
 	var completionEventEncoding common.EncodingType
 	var autoResetPoints []byte
 	var autoResetPointsEncoding common.EncodingType
+	var workflowUpdates []byte
+	var workflowUpdatesEncoding common.EncodingType
 
 	for k, v := range result {
 		switch k {
@@ -168,10 +170,25 @@ func parseWorkflowExecutionInfo(
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
 			info.Memo = v.(map[string][]byte)
+		case "paused":
+			info.Paused = v.(bool)
+		case "inactivity_timeout":
+			info.InactivityTimeout = common.SecondsToDuration(int64(v.(int)))
+		case "inactivity_timeout_policy":
+			info.InactivityTimeoutPolicy = types.InactivityTimeoutPolicy(v.(int))
+		case "inactive":
+			info.Inactive = v.(bool)
+		case "last_decision_completed_timestamp":
+			info.LastDecisionCompletedTimestamp = time.Unix(0, v.(int64))
+		case "workflow_updates":
+			workflowUpdates = v.([]byte)
+		case "workflow_updates_encoding":
+			workflowUpdatesEncoding = common.EncodingType(v.(string))
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
 	info.AutoResetPoints = persistence.NewDataBlob(autoResetPoints, autoResetPointsEncoding)
+	info.WorkflowUpdates = persistence.NewDataBlob(workflowUpdates, workflowUpdatesEncoding)
 	return info
 }
 
@@ -231,7 +248,8 @@ func parseActivityInfo(
 
 	info := &persistence.InternalActivityInfo{}
 	var sharedEncoding common.EncodingType
-	var scheduledEventData, startedEventData []byte
+	var scheduledEventData, startedEventData, attemptLogData []byte
+	var attemptLogEncoding common.EncodingType
 	for k, v := range result {
 		switch k {
 		case "version":
@@ -300,11 +318,16 @@ func parseActivityInfo(
 			info.LastFailureDetails = v.([]byte)
 		case "event_data_encoding":
 			sharedEncoding = common.EncodingType(v.(string))
+		case "attempt_log":
+			attemptLogData = v.([]byte)
+		case "attempt_log_encoding":
+			attemptLogEncoding = common.EncodingType(v.(string))
 		}
 	}
 	info.DomainID = domainID
 	info.ScheduledEvent = persistence.NewDataBlob(scheduledEventData, sharedEncoding)
 	info.StartedEvent = persistence.NewDataBlob(startedEventData, sharedEncoding)
+	info.AttemptLog = persistence.NewDataBlob(attemptLogData, attemptLogEncoding)
 
 	return info
 }
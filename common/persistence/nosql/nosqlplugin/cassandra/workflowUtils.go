@@ -922,6 +922,8 @@ func resetActivityInfoMap(
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
 		aInfo["last_failure_details"] = a.LastFailureDetails
+		aInfo["attempt_log"] = a.AttemptLog.Data
+		aInfo["attempt_log_encoding"] = a.AttemptLog.GetEncodingString()
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -974,6 +976,8 @@ func (db *cdb) updateActivityInfos(
 			a.LastWorkerIdentity,
 			a.LastFailureDetails,
 			a.ScheduledEvent.GetEncodingString(),
+			a.AttemptLog.Data,
+			a.AttemptLog.GetEncodingString(),
 			shardID,
 			rowTypeExecution,
 			domainID,
@@ -1265,6 +1269,13 @@ func (db *cdb) updateWorkflowExecution(
 		int32(execution.ExpirationInterval.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		execution.Paused,
+		int32(execution.InactivityTimeout.Seconds()),
+		int32(execution.InactivityTimeoutPolicy),
+		execution.Inactive,
+		execution.LastDecisionCompletedTimestamp.UnixNano(),
+		execution.WorkflowUpdates.Data,
+		execution.WorkflowUpdates.GetEncodingString(),
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1359,6 +1370,13 @@ func (db *cdb) createWorkflowExecution(
 		int32(execution.ExpirationInterval.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		execution.Paused,
+		int32(execution.InactivityTimeout.Seconds()),
+		int32(execution.InactivityTimeoutPolicy),
+		execution.Inactive,
+		execution.LastDecisionCompletedTimestamp.UnixNano(),
+		execution.WorkflowUpdates.Data,
+		execution.WorkflowUpdates.GetEncodingString(),
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
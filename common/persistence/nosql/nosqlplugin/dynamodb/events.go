@@ -41,6 +41,11 @@ func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nos
 	panic("TODO")
 }
 
+// DeleteFromHistoryNode delete a range of nodes of a branch
+func (db *ddb) DeleteFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) error {
+	panic("TODO")
+}
+
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
 	panic("TODO")
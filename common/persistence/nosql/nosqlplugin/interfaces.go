@@ -98,6 +98,9 @@ type (
 		// for each range, it will delete all nodes starting from MinNodeID(inclusive)
 		DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *HistoryTreeFilter, nodeFilters []*HistoryNodeFilter) error
 
+		// DeleteFromHistoryNode delete a range of nodes of a branch, from MinNodeID(inclusive) to MaxNodeID(exclusive)
+		DeleteFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) error
+
 		// SelectAllHistoryTrees will return all tree branches with pagination
 		SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*HistoryTreeRow, []byte, error)
 
@@ -217,6 +217,20 @@ func (mr *MockDBMockRecorder) DeleteDomain(ctx, domainID, domainName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockDB)(nil).DeleteDomain), ctx, domainID, domainName)
 }
 
+// DeleteFromHistoryNode mocks base method.
+func (m *MockDB) DeleteFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromHistoryNode", ctx, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFromHistoryNode indicates an expected call of DeleteFromHistoryNode.
+func (mr *MockDBMockRecorder) DeleteFromHistoryNode(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryNode", reflect.TypeOf((*MockDB)(nil).DeleteFromHistoryNode), ctx, filter)
+}
+
 // DeleteFromHistoryTreeAndNode mocks base method.
 func (m *MockDB) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *HistoryTreeFilter, nodeFilters []*HistoryNodeFilter) error {
 	m.ctrl.T.Helper()
@@ -1303,6 +1317,20 @@ func (mr *MocktableCRUDMockRecorder) DeleteDomain(ctx, domainID, domainName inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MocktableCRUD)(nil).DeleteDomain), ctx, domainID, domainName)
 }
 
+// DeleteFromHistoryNode mocks base method.
+func (m *MocktableCRUD) DeleteFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromHistoryNode", ctx, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFromHistoryNode indicates an expected call of DeleteFromHistoryNode.
+func (mr *MocktableCRUDMockRecorder) DeleteFromHistoryNode(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryNode", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromHistoryNode), ctx, filter)
+}
+
 // DeleteFromHistoryTreeAndNode mocks base method.
 func (m *MocktableCRUD) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *HistoryTreeFilter, nodeFilters []*HistoryNodeFilter) error {
 	m.ctrl.T.Helper()
@@ -2356,6 +2384,20 @@ func (m *MockHistoryEventsCRUD) EXPECT() *MockHistoryEventsCRUDMockRecorder {
 	return m.recorder
 }
 
+// DeleteFromHistoryNode mocks base method.
+func (m *MockHistoryEventsCRUD) DeleteFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromHistoryNode", ctx, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFromHistoryNode indicates an expected call of DeleteFromHistoryNode.
+func (mr *MockHistoryEventsCRUDMockRecorder) DeleteFromHistoryNode(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryNode", reflect.TypeOf((*MockHistoryEventsCRUD)(nil).DeleteFromHistoryNode), ctx, filter)
+}
+
 // DeleteFromHistoryTreeAndNode mocks base method.
 func (m *MockHistoryEventsCRUD) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *HistoryTreeFilter, nodeFilters []*HistoryNodeFilter) error {
 	m.ctrl.T.Helper()
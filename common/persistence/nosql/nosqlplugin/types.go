@@ -151,6 +151,10 @@ type (
 		RunID       string
 		ScheduledID int64
 		CreatedTime time.Time
+		Priority    int32
+
+		LabelSelector  string
+		IsolationGroup string
 	}
 
 	// TaskListFilter is for filtering tasklist
@@ -170,6 +174,8 @@ type (
 		TaskListKind    int
 		AckLevel        int64
 		LastUpdatedTime time.Time
+		Config          *persistence.TaskListConfig
+		WorkerIdentity  string
 	}
 
 	// ListTaskListResult is the result of list tasklists
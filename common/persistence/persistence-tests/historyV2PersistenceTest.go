@@ -372,6 +372,69 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 }
 
 // TestConcurrentlyCreateAndAppendBranches test
+// TestTrimBranch test
+func (s *HistoryV2PersistenceSuite) TestTrimBranch() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	treeID := uuid.New()
+	bi, err := s.newHistoryBranch(treeID)
+	s.Nil(err)
+
+	events := s.genRandomEvents([]int64{1, 2}, 1)
+	err = s.appendNewBranchAndFirstNode(ctx, bi, events, 1, "branchInfo")
+	s.Nil(err)
+	err = s.appendNewNode(ctx, bi, s.genRandomEvents([]int64{3}, 1), 2)
+	s.Nil(err)
+	err = s.appendNewNode(ctx, bi, s.genRandomEvents([]int64{4, 5}, 1), 3)
+	s.Nil(err)
+	err = s.appendNewNode(ctx, bi, s.genRandomEvents([]int64{6, 7}, 1), 4)
+	s.Nil(err)
+	compactedEvents := s.genRandomEvents([]int64{8}, 1)
+	compactedEvents[0].EventType = types.EventTypeWorkflowHistoryCompacted.Ptr()
+	err = s.appendNewNode(ctx, bi, compactedEvents, 5)
+	s.Nil(err)
+	err = s.appendNewNode(ctx, bi, s.genRandomEvents([]int64{9}, 1), 6)
+	s.Nil(err)
+
+	// fork from node 4, so nodes before node 4 are still referred by the new branch
+	forked, err := s.fork(ctx, bi, 4)
+	s.Nil(err)
+
+	err = s.HistoryV2Mgr.TrimHistoryBranch(ctx, &p.TrimHistoryBranchRequest{
+		BranchToken: bi,
+		MinNodeID:   common.FirstEventID + 1,
+		MaxNodeID:   8,
+		ShardID:     common.IntPtr(s.ShardInfo.ShardID),
+	})
+	s.Nil(err)
+
+	var eventIDs []int64
+	for _, e := range s.read(ctx, bi, 1, 10) {
+		eventIDs = append(eventIDs, e.ID)
+	}
+	s.Equal([]int64{1, 2, 3, 8, 9}, eventIDs)
+
+	eventIDs = nil
+	for _, e := range s.read(ctx, forked, 1, 4) {
+		eventIDs = append(eventIDs, e.ID)
+	}
+	s.Equal([]int64{1, 2, 3}, eventIDs)
+
+	err = s.HistoryV2Mgr.TrimHistoryBranch(ctx, &p.TrimHistoryBranchRequest{
+		BranchToken: bi,
+		MinNodeID:   common.FirstEventID,
+		MaxNodeID:   8,
+		ShardID:     common.IntPtr(s.ShardInfo.ShardID),
+	})
+	s.IsType(&p.InvalidPersistenceRequestError{}, err)
+
+	err = s.deleteHistoryBranch(ctx, forked)
+	s.Nil(err)
+	err = s.deleteHistoryBranch(ctx, bi)
+	s.Nil(err)
+}
+
 func (s *HistoryV2PersistenceSuite) TestConcurrentlyCreateAndAppendBranches() {
 	ctx, cancel := context.WithTimeout(context.Background(), largeTestContextTimeout)
 	defer cancel()
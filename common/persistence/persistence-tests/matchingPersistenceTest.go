@@ -116,6 +116,136 @@ func (s *MatchingPersistenceSuite) TestCreateTask() {
 	}
 }
 
+// TestCreateTaskWithPriority test
+func (s *MatchingPersistenceSuite) TestCreateTaskWithPriority() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := "8b1a2f5c-3d0e-4f6a-9c7b-1e2d3c4b5a69"
+	workflowExecution := types.WorkflowExecution{WorkflowID: "create-task-with-priority-test",
+		RunID: "2f6d1c3a-7b8e-4a5d-9e0f-1a2b3c4d5e6f"}
+	taskList := "1a2b3c4d5e6f"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:               domainID,
+					WorkflowID:             workflowExecution.WorkflowID,
+					RunID:                  workflowExecution.RunID,
+					TaskID:                 taskID,
+					ScheduleID:             10,
+					ScheduleToStartTimeout: defaultScheduleToStartTimeout,
+					Priority:               7,
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(ctx, domainID, taskList, p.TaskListTypeActivity, 1)
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal(int64(10), resp.Tasks[0].ScheduleID)
+	s.Equal(int32(7), resp.Tasks[0].Priority)
+}
+
+// TestCreateTaskWithLabelSelector test
+func (s *MatchingPersistenceSuite) TestCreateTaskWithLabelSelector() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := "5c9e2a1b-8d3f-4e7a-b6c0-2f1e0d9c8b7a"
+	workflowExecution := types.WorkflowExecution{WorkflowID: "create-task-with-label-selector-test",
+		RunID: "7e1f2a3b-4c5d-4e6f-8a9b-0c1d2e3f4a5b"}
+	taskList := "7e1f2a3b4c5d"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:               domainID,
+					WorkflowID:             workflowExecution.WorkflowID,
+					RunID:                  workflowExecution.RunID,
+					TaskID:                 taskID,
+					ScheduleID:             10,
+					ScheduleToStartTimeout: defaultScheduleToStartTimeout,
+					LabelSelector:          "gpu=true",
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(ctx, domainID, taskList, p.TaskListTypeActivity, 1)
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal("gpu=true", resp.Tasks[0].LabelSelector)
+}
+
+// TestCreateTaskWithIsolationGroup test
+func (s *MatchingPersistenceSuite) TestCreateTaskWithIsolationGroup() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := "1d4f6a8c-2b3e-4f5a-9c7d-8e0f1a2b3c4d"
+	workflowExecution := types.WorkflowExecution{WorkflowID: "create-task-with-isolation-group-test",
+		RunID: "3a5c7e9f-1b2d-4c6e-8f0a-2b4d6f8a0c1e"}
+	taskList := "3a5c7e9f1b2d"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:               domainID,
+					WorkflowID:             workflowExecution.WorkflowID,
+					RunID:                  workflowExecution.RunID,
+					TaskID:                 taskID,
+					ScheduleID:             10,
+					ScheduleToStartTimeout: defaultScheduleToStartTimeout,
+					IsolationGroup:         "zone1",
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(ctx, domainID, taskList, p.TaskListTypeActivity, 1)
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal("zone1", resp.Tasks[0].IsolationGroup)
+}
+
 // TestGetDecisionTasks test
 func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
@@ -362,6 +492,63 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 }
 
 // TestLeaseAndUpdateTaskListSticky test
+// TestGetTaskListWithConfig test
+func (s *MatchingPersistenceSuite) TestGetTaskListWithConfig() {
+	domainID := "d1d5e5e5-3ae3-4b1c-9a5c-1d1b7e2a4c11"
+	taskList := "get-task-list-with-config"
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	_, err := s.TaskMgr.GetTaskList(ctx, &p.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	response, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Nil(response.TaskListInfo.Config)
+
+	config := &p.TaskListConfig{
+		MaxDispatchPerSecond:  12.5,
+		MaxBacklogSize:        1000,
+		RejectWhenBacklogFull: true,
+		MaxOutstandingTasks:   20,
+	}
+	taskListInfo := response.TaskListInfo
+	taskListInfo.Config = config
+	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
+		TaskListInfo: taskListInfo,
+	})
+	s.NoError(err)
+
+	getResponse, err := s.TaskMgr.GetTaskList(ctx, &p.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Equal(taskListInfo.RangeID, getResponse.TaskListInfo.RangeID)
+	s.Equal(config, getResponse.TaskListInfo.Config)
+
+	// the config is preserved when the lease is renewed
+	response, err = s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+		RangeID:  taskListInfo.RangeID,
+	})
+	s.NoError(err)
+	s.Equal(taskListInfo.RangeID+1, response.TaskListInfo.RangeID)
+	s.Equal(config, response.TaskListInfo.Config)
+}
+
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
 	taskList := "aaaaaaa"
@@ -1132,6 +1132,31 @@ func (p *taskErrorInjectionPersistenceClient) LeaseTaskList(
 	return response, persistenceErr
 }
 
+func (p *taskErrorInjectionPersistenceClient) GetTaskList(
+	ctx context.Context,
+	request *GetTaskListRequest,
+) (*GetTaskListResponse, error) {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var response *GetTaskListResponse
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		response, persistenceErr = p.persistence.GetTaskList(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationGetTaskList,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return nil, fakeErr
+	}
+	return response, persistenceErr
+}
+
 func (p *taskErrorInjectionPersistenceClient) UpdateTaskList(
 	ctx context.Context,
 	request *UpdateTaskListRequest,
@@ -1927,6 +1952,31 @@ func (p *historyErrorInjectionPersistenceClient) DeleteHistoryBranch(
 	return persistenceErr
 }
 
+// TrimHistoryBranch removes a range of nodes from a branch
+func (p *historyErrorInjectionPersistenceClient) TrimHistoryBranch(
+	ctx context.Context,
+	request *TrimHistoryBranchRequest,
+) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.TrimHistoryBranch(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationTrimHistoryBranch,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (p *historyErrorInjectionPersistenceClient) GetHistoryTree(
 	ctx context.Context,
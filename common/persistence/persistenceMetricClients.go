@@ -857,6 +857,23 @@ func (p *taskPersistenceClient) LeaseTaskList(
 	return resp, nil
 }
 
+func (p *taskPersistenceClient) GetTaskList(
+	ctx context.Context,
+	request *GetTaskListRequest,
+) (*GetTaskListResponse, error) {
+	var resp *GetTaskListResponse
+	op := func() error {
+		var err error
+		resp, err = p.persistence.GetTaskList(ctx, request)
+		return err
+	}
+	err := p.call(metrics.PersistenceGetTaskListScope, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (p *taskPersistenceClient) ListTaskList(
 	ctx context.Context,
 	request *ListTaskListRequest,
@@ -1360,6 +1377,17 @@ func (p *historyPersistenceClient) DeleteHistoryBranch(
 	return p.call(metrics.PersistenceDeleteHistoryBranchScope, op)
 }
 
+// TrimHistoryBranch removes a range of nodes from a branch
+func (p *historyPersistenceClient) TrimHistoryBranch(
+	ctx context.Context,
+	request *TrimHistoryBranchRequest,
+) error {
+	op := func() error {
+		return p.persistence.TrimHistoryBranch(ctx, request)
+	}
+	return p.call(metrics.PersistenceTrimHistoryBranchScope, op)
+}
+
 func (p *historyPersistenceClient) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *GetAllHistoryTreeBranchesRequest,
@@ -648,6 +648,18 @@ func (p *taskRateLimitedPersistenceClient) LeaseTaskList(
 	return response, err
 }
 
+func (p *taskRateLimitedPersistenceClient) GetTaskList(
+	ctx context.Context,
+	request *GetTaskListRequest,
+) (*GetTaskListResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetTaskList(ctx, request)
+	return response, err
+}
+
 func (p *taskRateLimitedPersistenceClient) UpdateTaskList(
 	ctx context.Context,
 	request *UpdateTaskListRequest,
@@ -1034,6 +1046,18 @@ func (p *historyRateLimitedPersistenceClient) DeleteHistoryBranch(
 	return err
 }
 
+// TrimHistoryBranch removes a range of nodes from a branch
+func (p *historyRateLimitedPersistenceClient) TrimHistoryBranch(
+	ctx context.Context,
+	request *TrimHistoryBranchRequest,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	err := p.persistence.TrimHistoryBranch(ctx, request)
+	return err
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (p *historyRateLimitedPersistenceClient) GetHistoryTree(
 	ctx context.Context,
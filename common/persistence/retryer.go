@@ -38,6 +38,8 @@ type Retryer interface {
 	ReadHistoryBranch(context.Context, *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error)
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) error
 	DeleteCurrentWorkflowExecution(context.Context, *DeleteCurrentWorkflowExecutionRequest) error
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	GetShardID() int
 	GetTimerIndexTasks(context.Context, *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
 	CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error
@@ -47,6 +49,7 @@ type (
 	persistenceRetryer struct {
 		execManager    ExecutionManager
 		historyManager HistoryManager
+		shardManager   ShardManager
 		throttleRetry  *backoff.ThrottleRetry
 	}
 )
@@ -55,11 +58,13 @@ type (
 func NewPersistenceRetryer(
 	execManager ExecutionManager,
 	historyManager HistoryManager,
+	shardManager ShardManager,
 	policy backoff.RetryPolicy,
 ) Retryer {
 	return &persistenceRetryer{
 		execManager:    execManager,
 		historyManager: historyManager,
+		shardManager:   shardManager,
 		throttleRetry: backoff.NewThrottleRetry(
 			backoff.WithRetryPolicy(policy),
 			backoff.WithRetryableError(IsTransientError),
@@ -197,6 +202,42 @@ func (pr *persistenceRetryer) DeleteCurrentWorkflowExecution(
 	return pr.throttleRetry.Do(ctx, op)
 }
 
+// UpdateWorkflowExecution retries UpdateWorkflowExecution
+func (pr *persistenceRetryer) UpdateWorkflowExecution(
+	ctx context.Context,
+	req *UpdateWorkflowExecutionRequest,
+) (*UpdateWorkflowExecutionResponse, error) {
+	var resp *UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = pr.execManager.UpdateWorkflowExecution(ctx, req)
+		return err
+	}
+	err := pr.throttleRetry.Do(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetShard retries GetShard
+func (pr *persistenceRetryer) GetShard(
+	ctx context.Context,
+	req *GetShardRequest,
+) (*GetShardResponse, error) {
+	var resp *GetShardResponse
+	op := func() error {
+		var err error
+		resp, err = pr.shardManager.GetShard(ctx, req)
+		return err
+	}
+	err := pr.throttleRetry.Do(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // GetShardID return shard id
 func (pr *persistenceRetryer) GetShardID() int {
 	return pr.execManager.GetShardID()
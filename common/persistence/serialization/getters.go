@@ -540,6 +540,54 @@ func (w *WorkflowExecutionInfo) GetVersionHistoriesEncoding() (o string) {
 	return
 }
 
+// GetPaused internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if w != nil {
+		return w.Paused
+	}
+	return
+}
+
+// GetInactivityTimeout internal sql blob getter
+func (w *WorkflowExecutionInfo) GetInactivityTimeout() time.Duration {
+	if w != nil {
+		return w.InactivityTimeout
+	}
+	return time.Duration(0)
+}
+
+// GetInactivityTimeoutPolicy internal sql blob getter
+func (w *WorkflowExecutionInfo) GetInactivityTimeoutPolicy() (o int32) {
+	if w != nil {
+		return w.InactivityTimeoutPolicy
+	}
+	return
+}
+
+// GetInactive internal sql blob getter
+func (w *WorkflowExecutionInfo) GetInactive() (o bool) {
+	if w != nil {
+		return w.Inactive
+	}
+	return
+}
+
+// GetLastDecisionCompletedTimestamp internal sql blob getter
+func (w *WorkflowExecutionInfo) GetLastDecisionCompletedTimestamp() time.Time {
+	if w != nil {
+		return w.LastDecisionCompletedTimestamp
+	}
+	return time.Unix(0, 0)
+}
+
+// GetWorkflowUpdatesEncoding internal sql blob getter
+func (w *WorkflowExecutionInfo) GetWorkflowUpdatesEncoding() (o string) {
+	if w != nil {
+		return w.WorkflowUpdatesEncoding
+	}
+	return
+}
+
 // GetInitiatedID internal sql blob getter
 func (w *WorkflowExecutionInfo) GetInitiatedID() (o int64) {
 	if w != nil {
@@ -820,6 +868,14 @@ func (w *WorkflowExecutionInfo) GetAutoResetPoints() (o []byte) {
 	return
 }
 
+// GetWorkflowUpdates internal sql blob getter
+func (w *WorkflowExecutionInfo) GetWorkflowUpdates() (o []byte) {
+	if w != nil {
+		return w.WorkflowUpdates
+	}
+	return
+}
+
 // GetVersionHistories internal sql blob getter
 func (w *WorkflowExecutionInfo) GetVersionHistories() (o []byte) {
 	if w != nil {
@@ -1084,6 +1140,22 @@ func (a *ActivityInfo) GetRetryLastFailureDetails() (o []byte) {
 	return
 }
 
+// GetAttemptLog internal sql blob getter
+func (a *ActivityInfo) GetAttemptLog() (o []byte) {
+	if a != nil {
+		return a.AttemptLog
+	}
+	return
+}
+
+// GetAttemptLogEncoding internal sql blob getter
+func (a *ActivityInfo) GetAttemptLogEncoding() (o string) {
+	if a != nil {
+		return a.AttemptLogEncoding
+	}
+	return
+}
+
 // GetCancelRequested internal sql blob getter
 func (a *ActivityInfo) GetCancelRequested() (o bool) {
 	if a != nil {
@@ -1372,6 +1444,30 @@ func (t *TaskInfo) GetCreatedTimestamp() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetPriority internal sql blob getter
+func (t *TaskInfo) GetPriority() (o int32) {
+	if t != nil {
+		return t.Priority
+	}
+	return
+}
+
+// GetLabelSelector internal sql blob getter
+func (t *TaskInfo) GetLabelSelector() (o string) {
+	if t != nil {
+		return t.LabelSelector
+	}
+	return
+}
+
+// GetIsolationGroup internal sql blob getter
+func (t *TaskInfo) GetIsolationGroup() (o string) {
+	if t != nil {
+		return t.IsolationGroup
+	}
+	return
+}
+
 // GetKind internal sql blob getter
 func (t *TaskListInfo) GetKind() (o int16) {
 	if t != nil {
@@ -1404,6 +1500,62 @@ func (t *TaskListInfo) GetLastUpdated() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetMaxDispatchPerSecond internal sql blob getter
+func (t *TaskListInfo) GetMaxDispatchPerSecond() (o float64) {
+	if t != nil {
+		return t.MaxDispatchPerSecond
+	}
+	return
+}
+
+// GetMaxBacklogSize internal sql blob getter
+func (t *TaskListInfo) GetMaxBacklogSize() (o int64) {
+	if t != nil {
+		return t.MaxBacklogSize
+	}
+	return
+}
+
+// GetRejectWhenBacklogFull internal sql blob getter
+func (t *TaskListInfo) GetRejectWhenBacklogFull() (o bool) {
+	if t != nil {
+		return t.RejectWhenBacklogFull
+	}
+	return
+}
+
+// GetMaxOutstandingTasks internal sql blob getter
+func (t *TaskListInfo) GetMaxOutstandingTasks() (o int32) {
+	if t != nil {
+		return t.MaxOutstandingTasks
+	}
+	return
+}
+
+// GetWorkerIdentity internal sql blob getter
+func (t *TaskListInfo) GetWorkerIdentity() (o string) {
+	if t != nil {
+		return t.WorkerIdentity
+	}
+	return
+}
+
+// GetNumReadPartitions internal sql blob getter
+func (t *TaskListInfo) GetNumReadPartitions() (o int32) {
+	if t != nil {
+		return t.NumReadPartitions
+	}
+	return
+}
+
+// GetNumWritePartitions internal sql blob getter
+func (t *TaskListInfo) GetNumWritePartitions() (o int32) {
+	if t != nil {
+		return t.NumWritePartitions
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *TransferTaskInfo) GetDomainID() (o []byte) {
 	if t != nil {
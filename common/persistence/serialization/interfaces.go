@@ -161,6 +161,13 @@ type (
 		Memo                               map[string][]byte
 		VersionHistories                   []byte
 		VersionHistoriesEncoding           string
+		Paused                             bool
+		InactivityTimeout                  time.Duration
+		InactivityTimeoutPolicy            int32
+		Inactive                           bool
+		LastDecisionCompletedTimestamp     time.Time
+		WorkflowUpdates                    []byte
+		WorkflowUpdatesEncoding            string
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -196,6 +203,8 @@ type (
 		RetryLastFailureReason   string
 		RetryLastWorkerIdentity  string
 		RetryLastFailureDetails  []byte
+		AttemptLog               []byte
+		AttemptLogEncoding       string
 	}
 
 	// ChildExecutionInfo blob in a serialization agnostic format
@@ -248,6 +257,9 @@ type (
 		ScheduleID       int64
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
+		Priority         int32
+		LabelSelector    string
+		IsolationGroup   string
 	}
 
 	// TaskListInfo blob in a serialization agnostic format
@@ -256,6 +268,14 @@ type (
 		AckLevel        int64
 		ExpiryTimestamp time.Time
 		LastUpdated     time.Time
+
+		MaxDispatchPerSecond  float64
+		MaxBacklogSize        int64
+		RejectWhenBacklogFull bool
+		MaxOutstandingTasks   int32
+		WorkerIdentity        string
+		NumReadPartitions     int32
+		NumWritePartitions    int32
 	}
 
 	// TransferTaskInfo blob in a serialization agnostic format
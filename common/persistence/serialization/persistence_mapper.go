@@ -25,6 +25,7 @@ package serialization
 import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.InternalWorkflowExecutionInfo {
@@ -72,6 +73,11 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		Memo:                               info.GetMemo(),
 		SearchAttributes:                   info.GetSearchAttributes(),
 		HistorySize:                        info.GetHistorySize(),
+		Paused:                             info.GetPaused(),
+		InactivityTimeout:                  info.GetInactivityTimeout(),
+		InactivityTimeoutPolicy:            types.InactivityTimeoutPolicy(info.GetInactivityTimeoutPolicy()),
+		Inactive:                           info.GetInactive(),
+		LastDecisionCompletedTimestamp:     info.GetLastDecisionCompletedTimestamp(),
 	}
 	if info.ParentDomainID != nil {
 		result.ParentDomainID = info.ParentDomainID.String()
@@ -98,6 +104,11 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		result.AutoResetPoints = persistence.NewDataBlob(info.AutoResetPoints,
 			common.EncodingType(info.GetAutoResetPointsEncoding()))
 	}
+
+	if info.WorkflowUpdates != nil {
+		result.WorkflowUpdates = persistence.NewDataBlob(info.WorkflowUpdates,
+			common.EncodingType(info.GetWorkflowUpdatesEncoding()))
+	}
 	return result
 }
 
@@ -149,6 +160,11 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		AutoResetPointsEncoding:            string(executionInfo.AutoResetPoints.GetEncoding()),
 		SearchAttributes:                   executionInfo.SearchAttributes,
 		Memo:                               executionInfo.Memo,
+		Paused:                             executionInfo.Paused,
+		InactivityTimeout:                  executionInfo.InactivityTimeout,
+		InactivityTimeoutPolicy:            int32(executionInfo.InactivityTimeoutPolicy),
+		Inactive:                           executionInfo.Inactive,
+		LastDecisionCompletedTimestamp:     executionInfo.LastDecisionCompletedTimestamp,
 		CompletionEventEncoding:            string(common.EncodingTypeEmpty),
 		VersionHistoriesEncoding:           string(common.EncodingTypeEmpty),
 		InitiatedID:                        common.EmptyEventID,
@@ -159,6 +175,11 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		info.CompletionEventEncoding = string(executionInfo.CompletionEvent.Encoding)
 	}
 
+	if executionInfo.WorkflowUpdates != nil {
+		info.WorkflowUpdates = executionInfo.WorkflowUpdates.Data
+		info.WorkflowUpdatesEncoding = string(executionInfo.WorkflowUpdates.Encoding)
+	}
+
 	if executionInfo.ParentDomainID != "" {
 		info.ParentDomainID = MustParseUUID(executionInfo.ParentDomainID)
 		info.ParentWorkflowID = executionInfo.ParentWorkflowID
@@ -32,6 +32,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 func TestInternalWorkflowExecutionInfo(t *testing.T) {
@@ -87,6 +88,12 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 		Memo:                               map[string][]byte{"key_1": []byte("Memo")},
 		SearchAttributes:                   map[string][]byte{"key_1": []byte("SearchAttributes")},
 		HistorySize:                        int64(rand.Intn(1000)),
+		Paused:                             true,
+		InactivityTimeout:                  time.Minute * time.Duration(rand.Intn(10)),
+		InactivityTimeoutPolicy:            types.InactivityTimeoutPolicyNotify,
+		Inactive:                           true,
+		LastDecisionCompletedTimestamp:     time.Now(),
+		WorkflowUpdates:                    persistence.NewDataBlob([]byte("WorkflowUpdates"), common.EncodingTypeJSON),
 	}
 	actual := ToInternalWorkflowExecutionInfo(FromInternalWorkflowExecutionInfo(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -140,4 +147,10 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.Memo, actual.Memo)
 	assert.Equal(t, expected.SearchAttributes, actual.SearchAttributes)
 	assert.Equal(t, expected.HistorySize, actual.HistorySize)
+	assert.Equal(t, expected.Paused, actual.Paused)
+	assert.Equal(t, expected.InactivityTimeout, actual.InactivityTimeout)
+	assert.Equal(t, expected.InactivityTimeoutPolicy, actual.InactivityTimeoutPolicy)
+	assert.Equal(t, expected.Inactive, actual.Inactive)
+	assert.Equal(t, expected.LastDecisionCompletedTimestamp.Sub(actual.LastDecisionCompletedTimestamp), time.Duration(0))
+	assert.Equal(t, expected.WorkflowUpdates, actual.WorkflowUpdates)
 }
@@ -246,6 +246,13 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		Memo:                                    info.Memo,
 		VersionHistories:                        info.VersionHistories,
 		VersionHistoriesEncoding:                &info.VersionHistoriesEncoding,
+		Paused:                                  &info.Paused,
+		InactivityTimeoutSeconds:                durationToSecondsInt32Ptr(info.InactivityTimeout),
+		InactivityTimeoutPolicy:                 &info.InactivityTimeoutPolicy,
+		Inactive:                                &info.Inactive,
+		LastDecisionCompletedTimestampNanos:     timeToUnixNanoPtr(info.LastDecisionCompletedTimestamp),
+		WorkflowUpdates:                         info.WorkflowUpdates,
+		WorkflowUpdatesEncoding:                 &info.WorkflowUpdatesEncoding,
 	}
 }
 
@@ -312,6 +319,13 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		Memo:                               info.Memo,
 		VersionHistories:                   info.VersionHistories,
 		VersionHistoriesEncoding:           info.GetVersionHistoriesEncoding(),
+		Paused:                             info.GetPaused(),
+		InactivityTimeout:                  common.SecondsToDuration(int64(info.GetInactivityTimeoutSeconds())),
+		InactivityTimeoutPolicy:            info.GetInactivityTimeoutPolicy(),
+		Inactive:                           info.GetInactive(),
+		LastDecisionCompletedTimestamp:     timeFromUnixNano(info.GetLastDecisionCompletedTimestampNanos()),
+		WorkflowUpdates:                    info.WorkflowUpdates,
+		WorkflowUpdatesEncoding:            info.GetWorkflowUpdatesEncoding(),
 	}
 }
 
@@ -351,6 +365,8 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		RetryLastFailureReason:        &info.RetryLastFailureReason,
 		RetryLastWorkerIdentity:       &info.RetryLastWorkerIdentity,
 		RetryLastFailureDetails:       info.RetryLastFailureDetails,
+		AttemptLog:                    info.AttemptLog,
+		AttemptLogEncoding:            &info.AttemptLogEncoding,
 	}
 }
 
@@ -390,6 +406,8 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		RetryLastFailureReason:   info.GetRetryLastFailureReason(),
 		RetryLastWorkerIdentity:  info.GetRetryLastWorkerIdentity(),
 		RetryLastFailureDetails:  info.RetryLastFailureDetails,
+		AttemptLog:               info.AttemptLog,
+		AttemptLogEncoding:       info.GetAttemptLogEncoding(),
 	}
 }
 
@@ -521,6 +539,9 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ScheduleID:       &info.ScheduleID,
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
+		Priority:         &info.Priority,
+		LabelSelector:    &info.LabelSelector,
+		IsolationGroup:   &info.IsolationGroup,
 	}
 }
 
@@ -534,6 +555,9 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ScheduleID:       info.GetScheduleID(),
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
+		Priority:         info.GetPriority(),
+		LabelSelector:    info.GetLabelSelector(),
+		IsolationGroup:   info.GetIsolationGroup(),
 	}
 }
 
@@ -546,6 +570,14 @@ func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 		AckLevel:         &info.AckLevel,
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		LastUpdatedNanos: timeToUnixNanoPtr(info.LastUpdated),
+
+		MaxDispatchPerSecond:  &info.MaxDispatchPerSecond,
+		MaxBacklogSize:        &info.MaxBacklogSize,
+		RejectWhenBacklogFull: &info.RejectWhenBacklogFull,
+		MaxOutstandingTasks:   &info.MaxOutstandingTasks,
+		WorkerIdentity:        &info.WorkerIdentity,
+		NumReadPartitions:     &info.NumReadPartitions,
+		NumWritePartitions:    &info.NumWritePartitions,
 	}
 }
 
@@ -558,6 +590,14 @@ func taskListInfoFromThrift(info *sqlblobs.TaskListInfo) *TaskListInfo {
 		AckLevel:        info.GetAckLevel(),
 		ExpiryTimestamp: timeFromUnixNano(info.GetExpiryTimeNanos()),
 		LastUpdated:     timeFromUnixNano(info.GetLastUpdatedNanos()),
+
+		MaxDispatchPerSecond:  info.GetMaxDispatchPerSecond(),
+		MaxBacklogSize:        info.GetMaxBacklogSize(),
+		RejectWhenBacklogFull: info.GetRejectWhenBacklogFull(),
+		MaxOutstandingTasks:   info.GetMaxOutstandingTasks(),
+		WorkerIdentity:        info.GetWorkerIdentity(),
+		NumReadPartitions:     info.GetNumReadPartitions(),
+		NumWritePartitions:    info.GetNumWritePartitions(),
 	}
 }
 
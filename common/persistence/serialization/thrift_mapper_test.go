@@ -214,6 +214,13 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		Memo:                               map[string][]byte{"key_1": []byte("Memo")},
 		VersionHistories:                   []byte("VersionHistories"),
 		VersionHistoriesEncoding:           "VersionHistoriesEncoding",
+		Paused:                             true,
+		InactivityTimeout:                  time.Minute * time.Duration(rand.Intn(10)),
+		InactivityTimeoutPolicy:            int32(rand.Intn(2)),
+		Inactive:                           true,
+		LastDecisionCompletedTimestamp:     time.Now(),
+		WorkflowUpdates:                    []byte("WorkflowUpdates"),
+		WorkflowUpdatesEncoding:            "WorkflowUpdatesEncoding",
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -268,6 +275,13 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.Memo, actual.Memo)
 	assert.Equal(t, expected.VersionHistories, actual.VersionHistories)
 	assert.Equal(t, expected.VersionHistoriesEncoding, actual.VersionHistoriesEncoding)
+	assert.Equal(t, expected.Paused, actual.Paused)
+	assert.Equal(t, expected.InactivityTimeout, actual.InactivityTimeout)
+	assert.Equal(t, expected.InactivityTimeoutPolicy, actual.InactivityTimeoutPolicy)
+	assert.Equal(t, expected.Inactive, actual.Inactive)
+	assert.Equal(t, expected.LastDecisionCompletedTimestamp.Sub(actual.LastDecisionCompletedTimestamp), time.Duration(0))
+	assert.Equal(t, expected.WorkflowUpdates, actual.WorkflowUpdates)
+	assert.Equal(t, expected.WorkflowUpdatesEncoding, actual.WorkflowUpdatesEncoding)
 	assert.Equal(t, expected.RetryExpirationTimestamp.Sub(actual.RetryExpirationTimestamp), time.Duration(0))
 	assert.True(t, (expected.StickyScheduleToStartTimeout-actual.StickyScheduleToStartTimeout) < time.Second)
 	assert.True(t, (expected.RetryInitialInterval-actual.RetryInitialInterval) < time.Second)
@@ -308,6 +322,8 @@ func TestActivityInfo(t *testing.T) {
 		RetryLastFailureReason:   "RetryLastFailureReason",
 		RetryLastWorkerIdentity:  "RetryLastWorkerIdentity",
 		RetryLastFailureDetails:  []byte("RetryLastFailureDetails"),
+		AttemptLog:               []byte("AttemptLog"),
+		AttemptLogEncoding:       "AttemptLogEncoding",
 	}
 	actual := activityInfoFromThrift(activityInfoToThrift(expected))
 	assert.Equal(t, expected.Version, actual.Version)
@@ -332,6 +348,8 @@ func TestActivityInfo(t *testing.T) {
 	assert.Equal(t, expected.RetryLastFailureReason, actual.RetryLastFailureReason)
 	assert.Equal(t, expected.RetryLastWorkerIdentity, actual.RetryLastWorkerIdentity)
 	assert.Equal(t, expected.RetryLastFailureDetails, actual.RetryLastFailureDetails)
+	assert.Equal(t, expected.AttemptLog, actual.AttemptLog)
+	assert.Equal(t, expected.AttemptLogEncoding, actual.AttemptLogEncoding)
 	assert.True(t, (expected.ScheduleToStartTimeout-actual.ScheduleToStartTimeout) < time.Second)
 	assert.True(t, (expected.ScheduleToCloseTimeout-actual.ScheduleToCloseTimeout) < time.Second)
 	assert.True(t, (expected.StartToCloseTimeout-actual.StartToCloseTimeout) < time.Second)
@@ -408,11 +426,17 @@ func TestTaskInfo(t *testing.T) {
 		ScheduleID:       int64(rand.Intn(1000)),
 		ExpiryTimestamp:  time.Now(),
 		CreatedTimestamp: time.Now(),
+		Priority:         int32(rand.Intn(10)),
+		LabelSelector:    "gpu=true",
+		IsolationGroup:   "zone1",
 	}
 	actual := taskInfoFromThrift(taskInfoToThrift(expected))
 	assert.Equal(t, expected.WorkflowID, actual.WorkflowID)
 	assert.Equal(t, expected.RunID, actual.RunID)
 	assert.Equal(t, expected.ScheduleID, actual.ScheduleID)
+	assert.Equal(t, expected.Priority, actual.Priority)
+	assert.Equal(t, expected.LabelSelector, actual.LabelSelector)
+	assert.Equal(t, expected.IsolationGroup, actual.IsolationGroup)
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 	assert.Equal(t, expected.CreatedTimestamp.Sub(actual.CreatedTimestamp), time.Duration(0))
 }
@@ -423,10 +447,25 @@ func TestTaskListInfo(t *testing.T) {
 		AckLevel:        int64(rand.Intn(1000)),
 		ExpiryTimestamp: time.Now(),
 		LastUpdated:     time.Now(),
+
+		MaxDispatchPerSecond:  rand.Float64(),
+		MaxBacklogSize:        int64(rand.Intn(1000)),
+		RejectWhenBacklogFull: true,
+		MaxOutstandingTasks:   int32(rand.Intn(1000)),
+		WorkerIdentity:        "worker-identity",
+		NumReadPartitions:     int32(rand.Intn(10)),
+		NumWritePartitions:    int32(rand.Intn(10)),
 	}
 	actual := taskListInfoFromThrift(taskListInfoToThrift(expected))
 	assert.Equal(t, expected.Kind, actual.Kind)
 	assert.Equal(t, expected.AckLevel, actual.AckLevel)
+	assert.Equal(t, expected.MaxDispatchPerSecond, actual.MaxDispatchPerSecond)
+	assert.Equal(t, expected.MaxBacklogSize, actual.MaxBacklogSize)
+	assert.Equal(t, expected.RejectWhenBacklogFull, actual.RejectWhenBacklogFull)
+	assert.Equal(t, expected.MaxOutstandingTasks, actual.MaxOutstandingTasks)
+	assert.Equal(t, expected.WorkerIdentity, actual.WorkerIdentity)
+	assert.Equal(t, expected.NumReadPartitions, actual.NumReadPartitions)
+	assert.Equal(t, expected.NumWritePartitions, actual.NumWritePartitions)
 	assert.Equal(t, expected.LastUpdated.Sub(actual.LastUpdated), time.Duration(0))
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 }
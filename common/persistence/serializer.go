@@ -66,6 +66,14 @@ type (
 		SerializePendingFailoverMarkers(markers []*types.FailoverMarkerAttributes, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializePendingFailoverMarkers(data *DataBlob) ([]*types.FailoverMarkerAttributes, error)
 
+		// serialize/deserialize activity attempt log
+		SerializeActivityAttemptLog(attempts []*types.ActivityAttemptInfo, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeActivityAttemptLog(data *DataBlob) ([]*types.ActivityAttemptInfo, error)
+
+		// serialize/deserialize workflow updates
+		SerializeWorkflowUpdates(updates []*types.WorkflowUpdateInfo, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeWorkflowUpdates(data *DataBlob) ([]*types.WorkflowUpdateInfo, error)
+
 		// serialize/deserialize processing queue statesss
 		SerializeProcessingQueueStates(states *types.ProcessingQueueStates, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeProcessingQueueStates(data *DataBlob) (*types.ProcessingQueueStates, error)
@@ -214,6 +222,58 @@ func (t *serializerImpl) DeserializePendingFailoverMarkers(
 	return markers, err
 }
 
+func (t *serializerImpl) SerializeActivityAttemptLog(
+	attempts []*types.ActivityAttemptInfo,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+
+	if attempts == nil {
+		return nil, nil
+	}
+	return t.serialize(attempts, encodingType)
+}
+
+func (t *serializerImpl) DeserializeActivityAttemptLog(
+	data *DataBlob,
+) ([]*types.ActivityAttemptInfo, error) {
+
+	if data == nil {
+		return nil, nil
+	}
+	var attempts []*types.ActivityAttemptInfo
+	if len(data.Data) == 0 {
+		return attempts, nil
+	}
+	err := t.deserialize(data, &attempts)
+	return attempts, err
+}
+
+func (t *serializerImpl) SerializeWorkflowUpdates(
+	updates []*types.WorkflowUpdateInfo,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+
+	if updates == nil {
+		return nil, nil
+	}
+	return t.serialize(updates, encodingType)
+}
+
+func (t *serializerImpl) DeserializeWorkflowUpdates(
+	data *DataBlob,
+) ([]*types.WorkflowUpdateInfo, error) {
+
+	if data == nil {
+		return nil, nil
+	}
+	var updates []*types.WorkflowUpdateInfo
+	if len(data.Data) == 0 {
+		return updates, nil
+	}
+	err := t.deserialize(data, &updates)
+	return updates, err
+}
+
 func (t *serializerImpl) SerializeProcessingQueueStates(
 	states *types.ProcessingQueueStates,
 	encodingType common.EncodingType,
@@ -300,6 +360,10 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(thrift.FromVersionHistories(input))
 	case []*types.FailoverMarkerAttributes:
 		return t.thriftrwEncoder.Encode(&replicator.FailoverMarkers{FailoverMarkers: thrift.FromFailoverMarkerAttributesArray(input)})
+	case []*types.ActivityAttemptInfo:
+		return t.thriftrwEncoder.Encode(&workflow.ActivityAttemptLog{Attempts: thrift.FromActivityAttemptInfoArray(input)})
+	case []*types.WorkflowUpdateInfo:
+		return t.thriftrwEncoder.Encode(&workflow.WorkflowUpdates{Updates: thrift.FromWorkflowUpdateInfoArray(input)})
 	case *types.ProcessingQueueStates:
 		return t.thriftrwEncoder.Encode(thrift.FromProcessingQueueStates(input))
 	case *types.DynamicConfigBlob:
@@ -384,6 +448,20 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = thrift.ToFailoverMarkerAttributesArray(thriftTarget.GetFailoverMarkers())
 		return nil
+	case *[]*types.ActivityAttemptInfo:
+		thriftTarget := workflow.ActivityAttemptLog{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thrift.ToActivityAttemptInfoArray(thriftTarget.GetAttempts())
+		return nil
+	case *[]*types.WorkflowUpdateInfo:
+		thriftTarget := workflow.WorkflowUpdates{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thrift.ToWorkflowUpdateInfoArray(thriftTarget.GetUpdates())
+		return nil
 	case *types.ProcessingQueueStates:
 		thriftTarget := history.ProcessingQueueStates{}
 		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
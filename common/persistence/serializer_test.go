@@ -112,6 +112,27 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 		},
 	}
 
+	attemptLog := []*types.ActivityAttemptInfo{
+		{
+			Attempt:           0,
+			StartedTimestamp:  1,
+			FinishedTimestamp: 2,
+			Identity:          "test-identity",
+			FailureReason:     "test-reason",
+			FailureDetails:    []byte("test-details"),
+			HeartbeatDetails:  []byte("test-heartbeat"),
+		},
+	}
+
+	workflowUpdates := []*types.WorkflowUpdateInfo{
+		{
+			UpdateID:                     "test-update-id",
+			AfterEventID:                 3,
+			DecisionTaskCompletedEventID: 6,
+			CompletedEventID:             7,
+		},
+	}
+
 	histories := &types.VersionHistories{
 		Histories: []*types.VersionHistory{
 			{
@@ -448,6 +469,62 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.Equal(dHistoriesEmpty, histories)
 
+			// serialize activity attempt log
+
+			nilAttemptLog, err := serializer.SerializeActivityAttemptLog(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilAttemptLog)
+
+			attemptLogJSON, err := serializer.SerializeActivityAttemptLog(attemptLog, common.EncodingTypeJSON)
+			s.Nil(err)
+			s.NotNil(attemptLogJSON)
+
+			attemptLogThrift, err := serializer.SerializeActivityAttemptLog(attemptLog, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(attemptLogThrift)
+
+			// deserialize activity attempt log
+
+			dNilAttemptLog, err := serializer.DeserializeActivityAttemptLog(nil)
+			s.Nil(err)
+			s.Nil(dNilAttemptLog)
+
+			dAttemptLogJSON, err := serializer.DeserializeActivityAttemptLog(attemptLogJSON)
+			s.Nil(err)
+			s.Equal(attemptLog, dAttemptLogJSON)
+
+			dAttemptLogThrift, err := serializer.DeserializeActivityAttemptLog(attemptLogThrift)
+			s.Nil(err)
+			s.Equal(attemptLog, dAttemptLogThrift)
+
+			// serialize workflow updates
+
+			nilWorkflowUpdates, err := serializer.SerializeWorkflowUpdates(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilWorkflowUpdates)
+
+			workflowUpdatesJSON, err := serializer.SerializeWorkflowUpdates(workflowUpdates, common.EncodingTypeJSON)
+			s.Nil(err)
+			s.NotNil(workflowUpdatesJSON)
+
+			workflowUpdatesThrift, err := serializer.SerializeWorkflowUpdates(workflowUpdates, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(workflowUpdatesThrift)
+
+			// deserialize workflow updates
+
+			dNilWorkflowUpdates, err := serializer.DeserializeWorkflowUpdates(nil)
+			s.Nil(err)
+			s.Nil(dNilWorkflowUpdates)
+
+			dWorkflowUpdatesJSON, err := serializer.DeserializeWorkflowUpdates(workflowUpdatesJSON)
+			s.Nil(err)
+			s.Equal(workflowUpdates, dWorkflowUpdatesJSON)
+
+			dWorkflowUpdatesThrift, err := serializer.DeserializeWorkflowUpdates(workflowUpdatesThrift)
+			s.Nil(err)
+			s.Equal(workflowUpdates, dWorkflowUpdatesThrift)
+
 			// serialize processing queue states
 
 			nilProcessingQueueStates, err := serializer.SerializeProcessingQueueStates(nil, common.EncodingTypeThriftRW)
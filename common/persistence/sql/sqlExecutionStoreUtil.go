@@ -1542,6 +1542,9 @@ func createTimerTasks(
 		case *p.WorkflowTimeoutTask:
 			// noop
 
+		case *p.WorkflowInactivityTimeoutTask:
+			info.EventID = t.EventID
+
 		case *p.DeleteHistoryEventTask:
 			// noop
 
@@ -456,6 +456,67 @@ func (m *sqlHistoryStore) DeleteHistoryBranch(
 	})
 }
 
+// TrimHistoryBranch removes a range of nodes from a branch
+func (m *sqlHistoryStore) TrimHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalTrimHistoryBranchRequest,
+) error {
+
+	branch := request.BranchInfo
+	treeID := branch.TreeID
+	// nodes of the ancestors are shared with other branches, only nodes of this branch can be removed
+	minNodeID := request.MinNodeID
+	if beginNodeID := persistenceutils.GetBeginNodeID(branch); minNodeID < beginNodeID {
+		minNodeID = beginNodeID
+	}
+
+	rsp, err := m.GetHistoryTree(ctx, &persistence.InternalGetHistoryTreeRequest{
+		TreeID:  treeID,
+		ShardID: common.IntPtr(request.ShardID),
+	})
+	if err != nil {
+		return err
+	}
+
+	// nodes referred by branches forked from this branch are kept
+	validBRsMaxEndNode := persistenceutils.GetBranchesMaxReferredNodeIDs(rsp.Branches)
+	if maxReferredEndNodeID, ok := validBRsMaxEndNode[branch.BranchID]; ok && minNodeID < maxReferredEndNodeID {
+		minNodeID = maxReferredEndNodeID
+	}
+	if minNodeID >= request.MaxNodeID {
+		return nil
+	}
+
+	treeUUID := serialization.MustParseUUID(treeID)
+	dbShardID := sqlplugin.GetDBShardIDFromTreeID(treeUUID, m.db.GetTotalNumDBShards())
+	return m.txExecute(ctx, dbShardID, "TrimHistoryBranch", func(tx sqlplugin.Tx) error {
+		nodeFilter := &sqlplugin.HistoryNodeFilter{
+			TreeID:    treeUUID,
+			BranchID:  serialization.MustParseUUID(branch.BranchID),
+			ShardID:   request.ShardID,
+			MinNodeID: &minNodeID,
+			MaxNodeID: &request.MaxNodeID,
+			PageSize:  _defaultHistoryNodeDeleteBatch,
+		}
+		for {
+			result, err := tx.DeleteFromHistoryNode(ctx, nodeFilter)
+			if err != nil {
+				return err
+			}
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if rowsAffected < _defaultHistoryNodeDeleteBatch ||
+				rowsAffected == persistence.UnknownNumRowsAffected ||
+				rowsAffected > _defaultHistoryNodeDeleteBatch {
+				break
+			}
+		}
+		return nil
+	})
+}
+
 // TODO: Limit the underlying query to a specific shard at a time. See https://github.com/uber/cadence/issues/4064
 func (m *sqlHistoryStore) GetAllHistoryTreeBranches(
 	ctx context.Context,
@@ -174,19 +174,60 @@ func (m *sqlTaskStore) LeaseTaskList(
 			return fmt.Errorf("%v rows affected instead of 1", rowsAffected)
 		}
 		resp = &persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
-			DomainID:    request.DomainID,
-			Name:        request.TaskList,
-			TaskType:    request.TaskType,
-			RangeID:     rangeID + 1,
-			AckLevel:    ackLevel,
-			Kind:        request.TaskListKind,
-			LastUpdated: now,
+			DomainID:       request.DomainID,
+			Name:           request.TaskList,
+			TaskType:       request.TaskType,
+			RangeID:        rangeID + 1,
+			AckLevel:       ackLevel,
+			Kind:           request.TaskListKind,
+			LastUpdated:    now,
+			Config:         taskListConfigFromBlob(tlInfo),
+			WorkerIdentity: tlInfo.GetWorkerIdentity(),
 		}}
 		return nil
 	})
 	return resp, err
 }
 
+func (m *sqlTaskStore) GetTaskList(
+	ctx context.Context,
+	request *persistence.GetTaskListRequest,
+) (*persistence.GetTaskListResponse, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainIDAndTasklist(request.DomainID, request.TaskList, m.db.GetTotalNumDBShards())
+	domainID := serialization.MustParseUUID(request.DomainID)
+	rows, err := m.db.SelectFromTaskLists(ctx, &sqlplugin.TaskListsFilter{
+		ShardID:  dbShardID,
+		DomainID: &domainID,
+		Name:     &request.TaskList,
+		TaskType: common.Int64Ptr(int64(request.TaskType))})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &types.EntityNotExistsError{
+				Message: fmt.Sprintf("Task list %v of type %v does not exist.", request.TaskList, request.TaskType),
+			}
+		}
+		return nil, convertCommonErrors(m.db, "GetTaskList", "Failed to get task list.", err)
+	}
+
+	row := rows[0]
+	tlInfo, err := m.parser.TaskListInfoFromBlob(row.Data, row.DataEncoding)
+	if err != nil {
+		return nil, err
+	}
+	return &persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
+		DomainID:       request.DomainID,
+		Name:           request.TaskList,
+		TaskType:       request.TaskType,
+		RangeID:        row.RangeID,
+		AckLevel:       tlInfo.GetAckLevel(),
+		Kind:           int(tlInfo.GetKind()),
+		Expiry:         tlInfo.GetExpiryTimestamp(),
+		LastUpdated:    tlInfo.GetLastUpdated(),
+		Config:         taskListConfigFromBlob(tlInfo),
+		WorkerIdentity: tlInfo.GetWorkerIdentity(),
+	}}, nil
+}
+
 func (m *sqlTaskStore) UpdateTaskList(
 	ctx context.Context,
 	request *persistence.UpdateTaskListRequest,
@@ -198,7 +239,9 @@ func (m *sqlTaskStore) UpdateTaskList(
 		Kind:            int16(request.TaskListInfo.Kind),
 		ExpiryTimestamp: time.Unix(0, 0),
 		LastUpdated:     time.Now(),
+		WorkerIdentity:  request.TaskListInfo.WorkerIdentity,
 	}
+	setTaskListConfig(tlInfo, request.TaskListInfo.Config)
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		tlInfo.ExpiryTimestamp = stickyTaskListExpiry()
 	}
@@ -325,6 +368,8 @@ func (m *sqlTaskStore) ListTaskList(
 		resp.Items[i].AckLevel = info.GetAckLevel()
 		resp.Items[i].Expiry = info.GetExpiryTimestamp()
 		resp.Items[i].LastUpdated = info.GetLastUpdated()
+		resp.Items[i].Config = taskListConfigFromBlob(info)
+		resp.Items[i].WorkerIdentity = info.GetWorkerIdentity()
 	}
 
 	return resp, nil
@@ -392,6 +437,9 @@ func (m *sqlTaskStore) CreateTasks(
 			ScheduleID:       v.Data.ScheduleID,
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: time.Now(),
+			Priority:         v.Data.Priority,
+			LabelSelector:    v.Data.LabelSelector,
+			IsolationGroup:   v.Data.IsolationGroup,
 		})
 		if err != nil {
 			return nil, err
@@ -478,6 +526,10 @@ func (m *sqlTaskStore) GetTasks(
 			ScheduleID:  info.GetScheduleID(),
 			Expiry:      info.GetExpiryTimestamp(),
 			CreatedTime: info.GetCreatedTimestamp(),
+			Priority:    info.GetPriority(),
+
+			LabelSelector:  info.GetLabelSelector(),
+			IsolationGroup: info.GetIsolationGroup(),
 		}
 	}
 
@@ -576,3 +628,30 @@ func lockTaskList(ctx context.Context, tx sqlplugin.Tx, shardID int, domainID se
 func stickyTaskListExpiry() time.Time {
 	return time.Now().Add(stickyTasksListsTTL)
 }
+
+func taskListConfigFromBlob(info *serialization.TaskListInfo) *persistence.TaskListConfig {
+	config := &persistence.TaskListConfig{
+		MaxDispatchPerSecond:  info.GetMaxDispatchPerSecond(),
+		MaxBacklogSize:        info.GetMaxBacklogSize(),
+		RejectWhenBacklogFull: info.GetRejectWhenBacklogFull(),
+		MaxOutstandingTasks:   info.GetMaxOutstandingTasks(),
+		NumReadPartitions:     info.GetNumReadPartitions(),
+		NumWritePartitions:    info.GetNumWritePartitions(),
+	}
+	if *config == (persistence.TaskListConfig{}) {
+		return nil
+	}
+	return config
+}
+
+func setTaskListConfig(info *serialization.TaskListInfo, config *persistence.TaskListConfig) {
+	if config == nil {
+		return
+	}
+	info.MaxDispatchPerSecond = config.MaxDispatchPerSecond
+	info.MaxBacklogSize = config.MaxBacklogSize
+	info.RejectWhenBacklogFull = config.RejectWhenBacklogFull
+	info.MaxOutstandingTasks = config.MaxOutstandingTasks
+	info.NumReadPartitions = config.NumReadPartitions
+	info.NumWritePartitions = config.NumWritePartitions
+}
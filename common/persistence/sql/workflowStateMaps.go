@@ -49,6 +49,7 @@ func updateActivityInfos(
 		for i, activityInfo := range activityInfos {
 			scheduledEvent, scheduledEncoding := persistence.FromDataBlob(activityInfo.ScheduledEvent)
 			startEvent, startEncoding := persistence.FromDataBlob(activityInfo.StartedEvent)
+			attemptLog, attemptLogEncoding := persistence.FromDataBlob(activityInfo.AttemptLog)
 
 			info := &serialization.ActivityInfo{
 				Version:                  activityInfo.Version,
@@ -82,6 +83,8 @@ func updateActivityInfos(
 				RetryLastFailureReason:   activityInfo.LastFailureReason,
 				RetryLastWorkerIdentity:  activityInfo.LastWorkerIdentity,
 				RetryLastFailureDetails:  activityInfo.LastFailureDetails,
+				AttemptLog:               attemptLog,
+				AttemptLogEncoding:       attemptLogEncoding,
 			}
 			blob, err := parser.ActivityInfoToBlob(info)
 			if err != nil {
@@ -183,6 +186,9 @@ func getActivityInfoMap(
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, common.EncodingType(decoded.GetStartedEventEncoding()))
 		}
+		if decoded.AttemptLog != nil {
+			info.AttemptLog = persistence.NewDataBlob(decoded.AttemptLog, common.EncodingType(decoded.GetAttemptLogEncoding()))
+		}
 		ret[row.ScheduleID] = info
 	}
 
@@ -57,6 +57,10 @@ func (t *taskManager) LeaseTaskList(ctx context.Context, request *LeaseTaskListR
 	return t.persistence.LeaseTaskList(ctx, request)
 }
 
+func (t *taskManager) GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	return t.persistence.GetTaskList(ctx, request)
+}
+
 func (t *taskManager) UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
 	return t.persistence.UpdateTaskList(ctx, request)
 }
@@ -133,6 +137,9 @@ func (t *taskManager) toInternalTaskInfo(taskInfo *TaskInfo) *InternalTaskInfo {
 		ScheduleToStartTimeout: common.SecondsToDuration(int64(taskInfo.ScheduleToStartTimeout)),
 		Expiry:                 taskInfo.Expiry,
 		CreatedTime:            taskInfo.CreatedTime,
+		Priority:               taskInfo.Priority,
+		LabelSelector:          taskInfo.LabelSelector,
+		IsolationGroup:         taskInfo.IsolationGroup,
 	}
 }
 func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *TaskInfo {
@@ -148,5 +155,8 @@ func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *
 		ScheduleToStartTimeout: int32(internalTaskInfo.ScheduleToStartTimeout.Seconds()),
 		Expiry:                 internalTaskInfo.Expiry,
 		CreatedTime:            internalTaskInfo.CreatedTime,
+		Priority:               internalTaskInfo.Priority,
+		LabelSelector:          internalTaskInfo.LabelSelector,
+		IsolationGroup:         internalTaskInfo.IsolationGroup,
 	}
 }
@@ -156,7 +156,7 @@ func (s *ConcreteExecutionExistsSuite) TestCheck() {
 		execManager := &mocks.ExecutionManager{}
 		execManager.On("IsWorkflowExecutionExists", mock.Anything, mock.Anything).Return(tc.getConcreteResp, tc.getConcreteErr)
 		execManager.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(tc.getCurrentResp, tc.getCurrentErr)
-		o := NewConcreteExecutionExists(persistence.NewPersistenceRetryer(execManager, nil, c.CreatePersistenceRetryPolicy()))
+		o := NewConcreteExecutionExists(persistence.NewPersistenceRetryer(execManager, nil, nil, c.CreatePersistenceRetryPolicy()))
 		s.Equal(tc.expectedResult, o.Check(context.Background(), tc.execution))
 	}
 }
@@ -134,7 +134,7 @@ func (s *HistoryExistsSuite) TestCheck() {
 		historyManager := &mocks.HistoryV2Manager{}
 		execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(tc.getExecResp, tc.getExecErr)
 		historyManager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(tc.getHistoryResp, tc.getHistoryErr)
-		i := NewHistoryExists(persistence.NewPersistenceRetryer(execManager, historyManager, c2.CreatePersistenceRetryPolicy()))
+		i := NewHistoryExists(persistence.NewPersistenceRetryer(execManager, historyManager, nil, c2.CreatePersistenceRetryPolicy()))
 		result := i.Check(context.Background(), getOpenConcreteExecution())
 		s.Equal(tc.expectedResult, result)
 	}
@@ -174,7 +174,7 @@ func (s *OpenCurrentExecutionSuite) TestCheck() {
 		execManager := &mocks.ExecutionManager{}
 		execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(tc.getConcreteResp, tc.getConcreteErr)
 		execManager.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(tc.getCurrentResp, tc.getCurrentErr)
-		o := NewOpenCurrentExecution(persistence.NewPersistenceRetryer(execManager, nil, c2.CreatePersistenceRetryPolicy()))
+		o := NewOpenCurrentExecution(persistence.NewPersistenceRetryer(execManager, nil, nil, c2.CreatePersistenceRetryPolicy()))
 		s.Equal(tc.expectedResult, o.Check(context.Background(), tc.execution))
 	}
 }
@@ -0,0 +1,488 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	c "github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	pendingInfoHistoryPageSize = 100
+)
+
+type (
+	// pendingInfoValid asserts that the pending activities, child executions, external cancellations
+	// and external signals kept in mutable state are backed by their initiating events in history
+	// and have not been resolved yet. Mutable state and history are loaded once for all kinds.
+	pendingInfoValid struct {
+		pr    persistence.Retryer
+		specs []pendingInfoSpec
+	}
+
+	pendingInfoSpec struct {
+		// description is the human readable name of the pending info, used in check results
+		description string
+		// getExpectedEvents returns the history events which must exist for the pending infos in mutable state
+		getExpectedEvents func(*persistence.WorkflowMutableState) []expectedEvent
+		// getResolvedInitiatedID returns the initiated event ID resolved by the given history event, if any
+		getResolvedInitiatedID func(*types.HistoryEvent) (int64, bool)
+		// remove deletes the pending info with the given initiated event ID as part of the mutation
+		remove func(*persistence.WorkflowMutation, int64)
+	}
+
+	expectedEvent struct {
+		initiatedID int64
+		eventID     int64
+		eventType   types.EventType
+	}
+
+	invalidPendingInfo struct {
+		spec        *pendingInfoSpec
+		initiatedID int64
+		checkResult CheckResult
+	}
+)
+
+// NewPendingInfoValid returns an invariant which asserts that every pending activity, child execution,
+// external cancellation and external signal references existing initiating events and has not been
+// resolved in history
+func NewPendingInfoValid(
+	pr persistence.Retryer,
+) Invariant {
+	return &pendingInfoValid{
+		pr: pr,
+		specs: []pendingInfoSpec{
+			pendingActivitySpec,
+			pendingChildExecutionSpec,
+			pendingRequestCancelSpec,
+			pendingSignalSpec,
+		},
+	}
+}
+
+var pendingActivitySpec = pendingInfoSpec{
+	description: "pending activity",
+	getExpectedEvents: func(state *persistence.WorkflowMutableState) []expectedEvent {
+		var events []expectedEvent
+		for _, ai := range state.ActivityInfos {
+			events = append(events, expectedEvent{
+				initiatedID: ai.ScheduleID,
+				eventID:     ai.ScheduleID,
+				eventType:   types.EventTypeActivityTaskScheduled,
+			})
+		}
+		return events
+	},
+	getResolvedInitiatedID: func(event *types.HistoryEvent) (int64, bool) {
+		switch event.GetEventType() {
+		case types.EventTypeActivityTaskCompleted:
+			return event.ActivityTaskCompletedEventAttributes.GetScheduledEventID(), true
+		case types.EventTypeActivityTaskFailed:
+			return event.ActivityTaskFailedEventAttributes.GetScheduledEventID(), true
+		case types.EventTypeActivityTaskTimedOut:
+			return event.ActivityTaskTimedOutEventAttributes.GetScheduledEventID(), true
+		case types.EventTypeActivityTaskCanceled:
+			return event.ActivityTaskCanceledEventAttributes.GetScheduledEventID(), true
+		}
+		return 0, false
+	},
+	remove: func(mutation *persistence.WorkflowMutation, initiatedID int64) {
+		mutation.DeleteActivityInfos = append(mutation.DeleteActivityInfos, initiatedID)
+	},
+}
+
+var pendingChildExecutionSpec = pendingInfoSpec{
+	description: "pending child execution",
+	getExpectedEvents: func(state *persistence.WorkflowMutableState) []expectedEvent {
+		var events []expectedEvent
+		for _, ci := range state.ChildExecutionInfos {
+			events = append(events, expectedEvent{
+				initiatedID: ci.InitiatedID,
+				eventID:     ci.InitiatedID,
+				eventType:   types.EventTypeStartChildWorkflowExecutionInitiated,
+			})
+			if ci.StartedID != c.EmptyEventID {
+				events = append(events, expectedEvent{
+					initiatedID: ci.InitiatedID,
+					eventID:     ci.StartedID,
+					eventType:   types.EventTypeChildWorkflowExecutionStarted,
+				})
+			}
+		}
+		return events
+	},
+	getResolvedInitiatedID: func(event *types.HistoryEvent) (int64, bool) {
+		switch event.GetEventType() {
+		case types.EventTypeStartChildWorkflowExecutionFailed:
+			return event.StartChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventID(), true
+		case types.EventTypeChildWorkflowExecutionCompleted:
+			return event.ChildWorkflowExecutionCompletedEventAttributes.GetInitiatedEventID(), true
+		case types.EventTypeChildWorkflowExecutionFailed:
+			return event.ChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventID(), true
+		case types.EventTypeChildWorkflowExecutionCanceled:
+			return event.ChildWorkflowExecutionCanceledEventAttributes.GetInitiatedEventID(), true
+		case types.EventTypeChildWorkflowExecutionTimedOut:
+			return event.ChildWorkflowExecutionTimedOutEventAttributes.GetInitiatedEventID(), true
+		case types.EventTypeChildWorkflowExecutionTerminated:
+			return event.ChildWorkflowExecutionTerminatedEventAttributes.GetInitiatedEventID(), true
+		}
+		return 0, false
+	},
+	remove: func(mutation *persistence.WorkflowMutation, initiatedID int64) {
+		mutation.DeleteChildExecutionInfos = append(mutation.DeleteChildExecutionInfos, initiatedID)
+	},
+}
+
+var pendingRequestCancelSpec = pendingInfoSpec{
+	description: "pending request cancel",
+	getExpectedEvents: func(state *persistence.WorkflowMutableState) []expectedEvent {
+		var events []expectedEvent
+		for _, rci := range state.RequestCancelInfos {
+			events = append(events, expectedEvent{
+				initiatedID: rci.InitiatedID,
+				eventID:     rci.InitiatedID,
+				eventType:   types.EventTypeRequestCancelExternalWorkflowExecutionInitiated,
+			})
+		}
+		return events
+	},
+	getResolvedInitiatedID: func(event *types.HistoryEvent) (int64, bool) {
+		switch event.GetEventType() {
+		case types.EventTypeExternalWorkflowExecutionCancelRequested:
+			return event.ExternalWorkflowExecutionCancelRequestedEventAttributes.GetInitiatedEventID(), true
+		case types.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+			return event.RequestCancelExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventID(), true
+		}
+		return 0, false
+	},
+	remove: func(mutation *persistence.WorkflowMutation, initiatedID int64) {
+		mutation.DeleteRequestCancelInfos = append(mutation.DeleteRequestCancelInfos, initiatedID)
+	},
+}
+
+var pendingSignalSpec = pendingInfoSpec{
+	description: "pending signal",
+	getExpectedEvents: func(state *persistence.WorkflowMutableState) []expectedEvent {
+		var events []expectedEvent
+		for _, si := range state.SignalInfos {
+			events = append(events, expectedEvent{
+				initiatedID: si.InitiatedID,
+				eventID:     si.InitiatedID,
+				eventType:   types.EventTypeSignalExternalWorkflowExecutionInitiated,
+			})
+		}
+		return events
+	},
+	getResolvedInitiatedID: func(event *types.HistoryEvent) (int64, bool) {
+		switch event.GetEventType() {
+		case types.EventTypeExternalWorkflowExecutionSignaled:
+			return event.ExternalWorkflowExecutionSignaledEventAttributes.GetInitiatedEventID(), true
+		case types.EventTypeSignalExternalWorkflowExecutionFailed:
+			return event.SignalExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventID(), true
+		}
+		return 0, false
+	},
+	remove: func(mutation *persistence.WorkflowMutation, initiatedID int64) {
+		mutation.DeleteSignalInfos = append(mutation.DeleteSignalInfos, initiatedID)
+	},
+}
+
+func (p *pendingInfoValid) Check(
+	ctx context.Context,
+	execution interface{},
+) CheckResult {
+	if checkResult := validateCheckContext(ctx, p.Name()); checkResult != nil {
+		return *checkResult
+	}
+
+	_, invalidInfos, checkResult := p.check(ctx, execution)
+	if checkResult != nil {
+		return *checkResult
+	}
+	if len(invalidInfos) != 0 {
+		return invalidInfos[0].checkResult
+	}
+	return CheckResult{
+		CheckResultType: CheckResultTypeHealthy,
+		InvariantName:   p.Name(),
+	}
+}
+
+func (p *pendingInfoValid) Fix(
+	ctx context.Context,
+	execution interface{},
+) FixResult {
+	if fixResult := validateFixContext(ctx, p.Name()); fixResult != nil {
+		return *fixResult
+	}
+
+	state, invalidInfos, checkResult := p.check(ctx, execution)
+	if checkResult != nil {
+		fixResult := FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: p.Name(),
+			CheckResult:   *checkResult,
+			Info:          "failed fix because check failed",
+		}
+		if checkResult.CheckResultType == CheckResultTypeHealthy {
+			fixResult.FixResultType = FixResultTypeSkipped
+			fixResult.Info = "skipped fix because execution was healthy"
+		}
+		return fixResult
+	}
+	if len(invalidInfos) == 0 {
+		return FixResult{
+			FixResultType: FixResultTypeSkipped,
+			InvariantName: p.Name(),
+			CheckResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   p.Name(),
+			},
+			Info: "skipped fix because execution was healthy",
+		}
+	}
+
+	// Open executions are owned by their history shard, which may hold a cached copy of the mutable
+	// state and would overwrite or fail on a concurrent update. Only closed executions are repaired.
+	if state.ExecutionInfo.State != persistence.WorkflowStateCompleted {
+		return FixResult{
+			FixResultType: FixResultTypeSkipped,
+			InvariantName: p.Name(),
+			CheckResult:   invalidInfos[0].checkResult,
+			Info:          "skipped fix because execution is still open",
+		}
+	}
+
+	fixResult := FixResult{
+		FixResultType: FixResultTypeFixed,
+		InvariantName: p.Name(),
+		CheckResult:   invalidInfos[0].checkResult,
+	}
+	if err := p.removePendingInfos(ctx, execution.(*entity.ConcreteExecution), state, invalidInfos); err != nil {
+		fixResult.FixResultType = FixResultTypeFailed
+		fixResult.Info = "failed to remove invalid pending infos from mutable state"
+		fixResult.InfoDetails = err.Error()
+	}
+	return fixResult
+}
+
+func (p *pendingInfoValid) Name() Name {
+	return PendingInfoValid
+}
+
+// check loads the mutable state and history of the execution once and returns every pending info
+// violating the invariant. A non-nil check result is returned if the check could not be completed
+// or the execution no longer exists.
+func (p *pendingInfoValid) check(
+	ctx context.Context,
+	execution interface{},
+) (*persistence.WorkflowMutableState, []invalidPendingInfo, *CheckResult) {
+	concreteExecution, ok := execution.(*entity.ConcreteExecution)
+	if !ok {
+		return nil, nil, &CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   p.Name(),
+			Info:            "failed to check: expected concrete execution",
+		}
+	}
+
+	resp, err := p.pr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: concreteExecution.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: concreteExecution.WorkflowID,
+			RunID:      concreteExecution.RunID,
+		},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *types.EntityNotExistsError:
+			return nil, nil, &CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   p.Name(),
+				Info:            "determined execution was healthy because concrete execution no longer exists",
+			}
+		default:
+			return nil, nil, &CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   p.Name(),
+				Info:            "failed to get concrete execution",
+				InfoDetails:     err.Error(),
+			}
+		}
+	}
+
+	expectedEvents := make([][]expectedEvent, len(p.specs))
+	hasExpectedEvents := false
+	for i := range p.specs {
+		expectedEvents[i] = p.specs[i].getExpectedEvents(resp.State)
+		sort.Slice(expectedEvents[i], func(x, y int) bool {
+			return expectedEvents[i][x].eventID < expectedEvents[i][y].eventID
+		})
+		hasExpectedEvents = hasExpectedEvents || len(expectedEvents[i]) != 0
+	}
+	if !hasExpectedEvents {
+		return resp.State, nil, nil
+	}
+
+	eventTypes, resolvedIDs, err := p.readHistory(ctx, concreteExecution, resp.State.ExecutionInfo.NextEventID)
+	if err != nil {
+		return nil, nil, &CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   p.Name(),
+			Info:            "failed to read history",
+			InfoDetails:     err.Error(),
+		}
+	}
+
+	var invalidInfos []invalidPendingInfo
+	for i := range p.specs {
+		spec := &p.specs[i]
+		invalidIDs := make(map[int64]bool)
+		for _, expected := range expectedEvents[i] {
+			if invalidIDs[expected.initiatedID] {
+				continue
+			}
+			if result := p.checkExpectedEvent(spec, expected, eventTypes, resolvedIDs); result != nil {
+				invalidIDs[expected.initiatedID] = true
+				invalidInfos = append(invalidInfos, invalidPendingInfo{
+					spec:        spec,
+					initiatedID: expected.initiatedID,
+					checkResult: *result,
+				})
+			}
+		}
+	}
+	return resp.State, invalidInfos, nil
+}
+
+func (p *pendingInfoValid) checkExpectedEvent(
+	spec *pendingInfoSpec,
+	expected expectedEvent,
+	eventTypes map[int64]types.EventType,
+	resolvedIDs map[int64]bool,
+) *CheckResult {
+	eventType, ok := eventTypes[expected.eventID]
+	if !ok {
+		return &CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   p.Name(),
+			Info:            fmt.Sprintf("%v references an event which does not exist in history", spec.description),
+			InfoDetails:     fmt.Sprintf("EventID: %v, expected EventType: %v", expected.eventID, expected.eventType),
+		}
+	}
+	if eventType != expected.eventType {
+		return &CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   p.Name(),
+			Info:            fmt.Sprintf("%v references an event of unexpected type in history", spec.description),
+			InfoDetails:     fmt.Sprintf("EventID: %v, expected EventType: %v, actual EventType: %v", expected.eventID, expected.eventType, eventType),
+		}
+	}
+	if resolvedIDs[expected.initiatedID] {
+		return &CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   p.Name(),
+			Info:            fmt.Sprintf("%v is already resolved in history", spec.description),
+			InfoDetails:     fmt.Sprintf("InitiatedEventID: %v", expected.initiatedID),
+		}
+	}
+	return nil
+}
+
+// readHistory reads the whole history branch of the execution and returns the type of every event
+// along with the initiated event IDs which are resolved by a later event of any pending info kind
+func (p *pendingInfoValid) readHistory(
+	ctx context.Context,
+	concreteExecution *entity.ConcreteExecution,
+	nextEventID int64,
+) (map[int64]types.EventType, map[int64]bool, error) {
+	eventTypes := make(map[int64]types.EventType)
+	resolvedIDs := make(map[int64]bool)
+
+	req := &persistence.ReadHistoryBranchRequest{
+		BranchToken: concreteExecution.BranchToken,
+		MinEventID:  c.FirstEventID,
+		MaxEventID:  nextEventID,
+		PageSize:    pendingInfoHistoryPageSize,
+		ShardID:     c.IntPtr(concreteExecution.ShardID),
+	}
+	for {
+		resp, err := p.pr.ReadHistoryBranch(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, event := range resp.HistoryEvents {
+			eventTypes[event.ID] = event.GetEventType()
+			for i := range p.specs {
+				if initiatedID, ok := p.specs[i].getResolvedInitiatedID(event); ok {
+					resolvedIDs[initiatedID] = true
+					break
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return eventTypes, resolvedIDs, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+// removePendingInfos deletes the invalid pending infos from the mutable state of a closed execution,
+// leaving the rest of the execution untouched. The update is conditioned on the next event ID
+// the check was based on, so it fails if the execution has been modified in the meantime.
+func (p *pendingInfoValid) removePendingInfos(
+	ctx context.Context,
+	concreteExecution *entity.ConcreteExecution,
+	state *persistence.WorkflowMutableState,
+	invalidInfos []invalidPendingInfo,
+) error {
+	shard, err := p.pr.GetShard(ctx, &persistence.GetShardRequest{ShardID: concreteExecution.ShardID})
+	if err != nil {
+		return err
+	}
+
+	mutation := persistence.WorkflowMutation{
+		ExecutionInfo:    state.ExecutionInfo,
+		ExecutionStats:   state.ExecutionStats,
+		VersionHistories: state.VersionHistories,
+		Condition:        state.ExecutionInfo.NextEventID,
+	}
+	for _, info := range invalidInfos {
+		info.spec.remove(&mutation, info.initiatedID)
+	}
+	_, err = p.pr.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		RangeID: shard.ShardInfo.RangeID,
+		// only pending infos are removed, the current record is left untouched
+		Mode:                   persistence.UpdateWorkflowModeIgnoreCurrent,
+		UpdateWorkflowMutation: mutation,
+		Encoding:               c.EncodingTypeThriftRW,
+	})
+	return err
+}
@@ -0,0 +1,414 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	c2 "github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type PendingInfoValidSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestPendingInfoValidSuite(t *testing.T) {
+	suite.Run(t, new(PendingInfoValidSuite))
+}
+
+func (s *PendingInfoValidSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *PendingInfoValidSuite) TestCheckPendingActivity() {
+	testCases := []struct {
+		getExecErr     error
+		getExecResp    *persistence.GetWorkflowExecutionResponse
+		getHistoryErr  error
+		getHistoryResp *persistence.ReadHistoryBranchResponse
+		expectedResult CheckResult
+	}{
+		{
+			getExecErr: &types.EntityNotExistsError{},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   PendingInfoValid,
+				Info:            "determined execution was healthy because concrete execution no longer exists",
+			},
+		},
+		{
+			getExecErr: errors.New("got error getting workflow"),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   PendingInfoValid,
+				Info:            "failed to get concrete execution",
+				InfoDetails:     "got error getting workflow",
+			},
+		},
+		{
+			getExecResp: getMutableStateResponse(&persistence.WorkflowMutableState{}),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   PendingInfoValid,
+			},
+		},
+		{
+			getExecResp: getMutableStateResponse(&persistence.WorkflowMutableState{
+				ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}},
+			}),
+			getHistoryErr: errors.New("error fetching history"),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   PendingInfoValid,
+				Info:            "failed to read history",
+				InfoDetails:     "error fetching history",
+			},
+		},
+		{
+			getExecResp: getMutableStateResponse(&persistence.WorkflowMutableState{
+				ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}},
+			}),
+			getHistoryResp: &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: []*types.HistoryEvent{
+					getHistoryEvent(4, types.EventTypeDecisionTaskCompleted),
+				},
+			},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   PendingInfoValid,
+				Info:            "pending activity references an event which does not exist in history",
+				InfoDetails:     "EventID: 5, expected EventType: ActivityTaskScheduled",
+			},
+		},
+		{
+			getExecResp: getMutableStateResponse(&persistence.WorkflowMutableState{
+				ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}},
+			}),
+			getHistoryResp: &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: []*types.HistoryEvent{
+					getHistoryEvent(5, types.EventTypeTimerStarted),
+				},
+			},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   PendingInfoValid,
+				Info:            "pending activity references an event of unexpected type in history",
+				InfoDetails:     "EventID: 5, expected EventType: ActivityTaskScheduled, actual EventType: TimerStarted",
+			},
+		},
+		{
+			getExecResp: getMutableStateResponse(&persistence.WorkflowMutableState{
+				ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}},
+			}),
+			getHistoryResp: &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: []*types.HistoryEvent{
+					getHistoryEvent(5, types.EventTypeActivityTaskScheduled),
+					{
+						ID:        6,
+						EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+						ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+							ScheduledEventID: 5,
+						},
+					},
+				},
+			},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   PendingInfoValid,
+				Info:            "pending activity is already resolved in history",
+				InfoDetails:     "InitiatedEventID: 5",
+			},
+		},
+		{
+			getExecResp: getMutableStateResponse(&persistence.WorkflowMutableState{
+				ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}},
+			}),
+			getHistoryResp: &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: []*types.HistoryEvent{
+					getHistoryEvent(5, types.EventTypeActivityTaskScheduled),
+				},
+			},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   PendingInfoValid,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		execManager := &mocks.ExecutionManager{}
+		historyManager := &mocks.HistoryV2Manager{}
+		execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(tc.getExecResp, tc.getExecErr)
+		historyManager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(tc.getHistoryResp, tc.getHistoryErr)
+		i := NewPendingInfoValid(persistence.NewPersistenceRetryer(execManager, historyManager, nil, c2.CreatePersistenceRetryPolicy()))
+		result := i.Check(context.Background(), getOpenConcreteExecution())
+		s.Equal(tc.expectedResult, result)
+	}
+}
+
+func (s *PendingInfoValidSuite) TestCheckPendingChildExecution() {
+	testCases := []struct {
+		state          *persistence.WorkflowMutableState
+		historyEvents  []*types.HistoryEvent
+		expectedResult CheckResult
+	}{
+		{
+			state: &persistence.WorkflowMutableState{
+				ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{5: {InitiatedID: 5, StartedID: c2.EmptyEventID}},
+			},
+			historyEvents: []*types.HistoryEvent{
+				getHistoryEvent(5, types.EventTypeStartChildWorkflowExecutionInitiated),
+			},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   PendingInfoValid,
+			},
+		},
+		{
+			state: &persistence.WorkflowMutableState{
+				ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{5: {InitiatedID: 5, StartedID: 7}},
+			},
+			historyEvents: []*types.HistoryEvent{
+				getHistoryEvent(5, types.EventTypeStartChildWorkflowExecutionInitiated),
+			},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   PendingInfoValid,
+				Info:            "pending child execution references an event which does not exist in history",
+				InfoDetails:     "EventID: 7, expected EventType: ChildWorkflowExecutionStarted",
+			},
+		},
+		{
+			state: &persistence.WorkflowMutableState{
+				ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{5: {InitiatedID: 5, StartedID: 7}},
+			},
+			historyEvents: []*types.HistoryEvent{
+				getHistoryEvent(5, types.EventTypeStartChildWorkflowExecutionInitiated),
+				getHistoryEvent(7, types.EventTypeChildWorkflowExecutionStarted),
+				{
+					ID:        9,
+					EventType: types.EventTypeChildWorkflowExecutionCompleted.Ptr(),
+					ChildWorkflowExecutionCompletedEventAttributes: &types.ChildWorkflowExecutionCompletedEventAttributes{
+						InitiatedEventID: 5,
+					},
+				},
+			},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   PendingInfoValid,
+				Info:            "pending child execution is already resolved in history",
+				InfoDetails:     "InitiatedEventID: 5",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		execManager := &mocks.ExecutionManager{}
+		historyManager := &mocks.HistoryV2Manager{}
+		execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(getMutableStateResponse(tc.state), nil)
+		historyManager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+			HistoryEvents: tc.historyEvents,
+		}, nil)
+		i := NewPendingInfoValid(persistence.NewPersistenceRetryer(execManager, historyManager, nil, c2.CreatePersistenceRetryPolicy()))
+		result := i.Check(context.Background(), getOpenConcreteExecution())
+		s.Equal(tc.expectedResult, result)
+	}
+}
+
+func (s *PendingInfoValidSuite) TestCheckPendingRequestCancelAndSignal() {
+	state := &persistence.WorkflowMutableState{
+		ActivityInfos:      map[int64]*persistence.ActivityInfo{4: {ScheduleID: 4}},
+		RequestCancelInfos: map[int64]*persistence.RequestCancelInfo{5: {InitiatedID: 5}},
+		SignalInfos:        map[int64]*persistence.SignalInfo{6: {InitiatedID: 6}},
+	}
+	historyEvents := []*types.HistoryEvent{
+		getHistoryEvent(4, types.EventTypeActivityTaskScheduled),
+		getHistoryEvent(5, types.EventTypeRequestCancelExternalWorkflowExecutionInitiated),
+		getHistoryEvent(6, types.EventTypeSignalExternalWorkflowExecutionInitiated),
+		{
+			ID:        7,
+			EventType: types.EventTypeExternalWorkflowExecutionSignaled.Ptr(),
+			ExternalWorkflowExecutionSignaledEventAttributes: &types.ExternalWorkflowExecutionSignaledEventAttributes{
+				InitiatedEventID: 6,
+			},
+		},
+	}
+
+	execManager := &mocks.ExecutionManager{}
+	historyManager := &mocks.HistoryV2Manager{}
+	execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(getMutableStateResponse(state), nil).Once()
+	historyManager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: historyEvents,
+	}, nil).Once()
+	pr := persistence.NewPersistenceRetryer(execManager, historyManager, nil, c2.CreatePersistenceRetryPolicy())
+
+	result := NewPendingInfoValid(pr).Check(context.Background(), getOpenConcreteExecution())
+	s.Equal(CheckResult{
+		CheckResultType: CheckResultTypeCorrupted,
+		InvariantName:   PendingInfoValid,
+		Info:            "pending signal is already resolved in history",
+		InfoDetails:     "InitiatedEventID: 6",
+	}, result)
+	execManager.AssertExpectations(s.T())
+	historyManager.AssertExpectations(s.T())
+}
+
+func (s *PendingInfoValidSuite) TestCheckReadsAllHistoryPages() {
+	state := &persistence.WorkflowMutableState{
+		ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}},
+	}
+	execManager := &mocks.ExecutionManager{}
+	historyManager := &mocks.HistoryV2Manager{}
+	execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(getMutableStateResponse(state), nil)
+	historyManager.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return len(req.NextPageToken) == 0
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{getHistoryEvent(1, types.EventTypeWorkflowExecutionStarted)},
+		NextPageToken: []byte{1},
+	}, nil).Once()
+	historyManager.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return len(req.NextPageToken) != 0
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{getHistoryEvent(5, types.EventTypeActivityTaskScheduled)},
+	}, nil).Once()
+
+	i := NewPendingInfoValid(persistence.NewPersistenceRetryer(execManager, historyManager, nil, c2.CreatePersistenceRetryPolicy()))
+	result := i.Check(context.Background(), getOpenConcreteExecution())
+	s.Equal(CheckResult{
+		CheckResultType: CheckResultTypeHealthy,
+		InvariantName:   PendingInfoValid,
+	}, result)
+	historyManager.AssertExpectations(s.T())
+}
+
+func (s *PendingInfoValidSuite) TestFix() {
+	state := &persistence.WorkflowMutableState{
+		ActivityInfos:       map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}, 6: {ScheduleID: 6}},
+		ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{7: {InitiatedID: 7, StartedID: 8}},
+		SignalInfos:         map[int64]*persistence.SignalInfo{9: {InitiatedID: 9}},
+	}
+	execManager := &mocks.ExecutionManager{}
+	historyManager := &mocks.HistoryV2Manager{}
+	shardManager := &mocks.ShardManager{}
+	response := getMutableStateResponse(state)
+	response.State.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(response, nil).Once()
+	historyManager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			getHistoryEvent(6, types.EventTypeActivityTaskScheduled),
+			getHistoryEvent(7, types.EventTypeStartChildWorkflowExecutionInitiated),
+			getHistoryEvent(9, types.EventTypeSignalExternalWorkflowExecutionInitiated),
+		},
+	}, nil).Once()
+	shardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(&persistence.GetShardResponse{
+		ShardInfo: &persistence.ShardInfo{ShardID: shardID, RangeID: 3},
+	}, nil).Once()
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	execManager.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	i := NewPendingInfoValid(persistence.NewPersistenceRetryer(execManager, historyManager, shardManager, c2.CreatePersistenceRetryPolicy()))
+	result := i.Fix(context.Background(), getClosedConcreteExecution())
+	s.Equal(FixResultTypeFixed, result.FixResultType)
+	s.Equal(PendingInfoValid, result.InvariantName)
+	s.Equal(CheckResultTypeCorrupted, result.CheckResult.CheckResultType)
+	execManager.AssertExpectations(s.T())
+	historyManager.AssertExpectations(s.T())
+	shardManager.AssertExpectations(s.T())
+
+	mutation := updateRequest.UpdateWorkflowMutation
+	s.Equal(int64(3), updateRequest.RangeID)
+	s.Equal(persistence.UpdateWorkflowModeIgnoreCurrent, updateRequest.Mode)
+	s.Equal(int64(10), mutation.Condition)
+	s.Equal(state.ExecutionInfo, mutation.ExecutionInfo)
+	s.Equal([]int64{5}, mutation.DeleteActivityInfos)
+	s.Equal([]int64{7}, mutation.DeleteChildExecutionInfos)
+	s.Empty(mutation.DeleteRequestCancelInfos)
+	s.Empty(mutation.DeleteSignalInfos)
+	s.Empty(mutation.UpsertActivityInfos)
+}
+
+func (s *PendingInfoValidSuite) TestFix_OpenExecution() {
+	state := &persistence.WorkflowMutableState{
+		ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}},
+	}
+	execManager := &mocks.ExecutionManager{}
+	historyManager := &mocks.HistoryV2Manager{}
+	shardManager := &mocks.ShardManager{}
+	execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(getMutableStateResponse(state), nil).Once()
+	historyManager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{}, nil).Once()
+
+	i := NewPendingInfoValid(persistence.NewPersistenceRetryer(execManager, historyManager, shardManager, c2.CreatePersistenceRetryPolicy()))
+	result := i.Fix(context.Background(), getOpenConcreteExecution())
+	s.Equal(FixResultTypeSkipped, result.FixResultType)
+	s.Equal(CheckResultTypeCorrupted, result.CheckResult.CheckResultType)
+	s.Equal("skipped fix because execution is still open", result.Info)
+	execManager.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything, mock.Anything)
+	shardManager.AssertNotCalled(s.T(), "GetShard", mock.Anything, mock.Anything)
+}
+
+func (s *PendingInfoValidSuite) TestFix_Healthy() {
+	state := &persistence.WorkflowMutableState{
+		ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5}},
+	}
+	execManager := &mocks.ExecutionManager{}
+	historyManager := &mocks.HistoryV2Manager{}
+	execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(getMutableStateResponse(state), nil)
+	historyManager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{getHistoryEvent(5, types.EventTypeActivityTaskScheduled)},
+	}, nil)
+
+	i := NewPendingInfoValid(persistence.NewPersistenceRetryer(execManager, historyManager, nil, c2.CreatePersistenceRetryPolicy()))
+	result := i.Fix(context.Background(), getOpenConcreteExecution())
+	s.Equal(FixResultTypeSkipped, result.FixResultType)
+	s.Equal(CheckResultTypeHealthy, result.CheckResult.CheckResultType)
+	execManager.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything, mock.Anything)
+}
+
+func getMutableStateResponse(state *persistence.WorkflowMutableState) *persistence.GetWorkflowExecutionResponse {
+	state.ExecutionInfo = &persistence.WorkflowExecutionInfo{
+		DomainID:    domainID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+		NextEventID: 10,
+	}
+	return &persistence.GetWorkflowExecutionResponse{State: state}
+}
+
+func getHistoryEvent(eventID int64, eventType types.EventType) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID:        eventID,
+		EventType: eventType.Ptr(),
+	}
+}
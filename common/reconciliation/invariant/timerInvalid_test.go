@@ -145,6 +145,7 @@ func (ts *TimerInvalidTest) TestCheck() {
 				persistence.NewPersistenceRetryer(
 					execManager,
 					nil,
+					nil,
 					common.CreatePersistenceRetryPolicy(),
 				),
 			)
@@ -270,6 +271,7 @@ func (ts *TimerInvalidTest) TestFix() {
 				persistence.NewPersistenceRetryer(
 					execManager,
 					nil,
+					nil,
 					common.CreatePersistenceRetryPolicy(),
 				),
 			)
@@ -48,11 +48,18 @@ const (
 	OpenCurrentExecution Name = "open_current_execution"
 	// ConcreteExecutionExists asserts that an open current execution must have a valid concrete execution
 	ConcreteExecutionExists Name = "concrete_execution_exists"
+	// PendingInfoValid asserts that pending activities, child executions, external cancellations and signals
+	// are initiated and not yet resolved in history
+	PendingInfoValid Name = "pending_info_valid"
+	// VisibilityRecordConsistent asserts that the visibility record of an execution exists and matches its status
+	VisibilityRecordConsistent Name = "visibility_record_consistent"
 
 	// CollectionMutableState is the collection of invariants relating to mutable state
 	CollectionMutableState Collection = 0
 	// CollectionHistory is the collection  of invariants relating to history
 	CollectionHistory Collection = 1
+	// CollectionPendingInfo is the collection of invariants relating to pending infos in mutable state
+	CollectionPendingInfo Collection = 2
 )
 
 type (
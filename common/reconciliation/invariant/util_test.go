@@ -86,7 +86,7 @@ func (s *UtilSuite) TestDeleteExecution() {
 		if tc.deleteConcreteErr == nil {
 			execManager.On("DeleteCurrentWorkflowExecution", mock.Anything, mock.Anything).Return(tc.deleteCurrentErr).Once()
 		}
-		pr := persistence.NewPersistenceRetryer(execManager, nil, common.CreatePersistenceRetryPolicy())
+		pr := persistence.NewPersistenceRetryer(execManager, nil, nil, common.CreatePersistenceRetryPolicy())
 		result := DeleteExecution(context.Background(), &entity.ConcreteExecution{}, pr)
 		s.Equal(tc.expectedFixResult, result)
 	}
@@ -140,7 +140,7 @@ func (s *UtilSuite) TestExecutionStillOpen() {
 	for _, tc := range testCases {
 		execManager := &mocks.ExecutionManager{}
 		execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(tc.getExecResp, tc.getExecErr)
-		pr := persistence.NewPersistenceRetryer(execManager, nil, common.CreatePersistenceRetryPolicy())
+		pr := persistence.NewPersistenceRetryer(execManager, nil, nil, common.CreatePersistenceRetryPolicy())
 		open, err := ExecutionStillOpen(context.Background(), &entity.Execution{}, pr)
 		if tc.expectError {
 			s.Error(err)
@@ -185,7 +185,7 @@ func (s *UtilSuite) TestExecutionStillExists() {
 	for _, tc := range testCases {
 		execManager := &mocks.ExecutionManager{}
 		execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(tc.getExecResp, tc.getExecErr)
-		pr := persistence.NewPersistenceRetryer(execManager, nil, common.CreatePersistenceRetryPolicy())
+		pr := persistence.NewPersistenceRetryer(execManager, nil, nil, common.CreatePersistenceRetryPolicy())
 		exists, err := ExecutionStillExists(context.Background(), &entity.Execution{}, pr)
 		if tc.expectError {
 			s.Error(err)
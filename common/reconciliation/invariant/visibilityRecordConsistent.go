@@ -0,0 +1,485 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	c "github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// visibilityRecordLagTolerance is how long an execution must stay untouched before its
+	// visibility record is expected to reflect it, visibility is updated asynchronously
+	visibilityRecordLagTolerance = time.Hour
+	visibilityRecordPageSize     = 100
+	// visibilityCloseTimePrecision is the coarsest close time precision among visibility stores,
+	// cassandra keeps timestamps in milliseconds
+	visibilityCloseTimePrecision = time.Millisecond
+	secondsInDay                 = int64(24 * time.Hour / time.Second)
+)
+
+type (
+	// visibilityRecordConsistent asserts that the visibility record of a concrete execution
+	// exists and matches the open or closed status of the execution
+	visibilityRecordConsistent struct {
+		pr                persistence.Retryer
+		visibilityManager persistence.VisibilityManager
+		domainCache       cache.DomainCache
+	}
+
+	visibilityRecords struct {
+		open   *types.WorkflowExecutionInfo
+		closed *types.WorkflowExecutionInfo
+	}
+)
+
+// NewVisibilityRecordConsistent returns a new visibility record consistent invariant
+func NewVisibilityRecordConsistent(
+	pr persistence.Retryer,
+	visibilityManager persistence.VisibilityManager,
+	domainCache cache.DomainCache,
+) Invariant {
+	return &visibilityRecordConsistent{
+		pr:                pr,
+		visibilityManager: visibilityManager,
+		domainCache:       domainCache,
+	}
+}
+
+func (v *visibilityRecordConsistent) Check(
+	ctx context.Context,
+	execution interface{},
+) CheckResult {
+	if checkResult := validateCheckContext(ctx, v.Name()); checkResult != nil {
+		return *checkResult
+	}
+
+	concreteExecution, ok := execution.(*entity.ConcreteExecution)
+	if !ok {
+		return CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   v.Name(),
+			Info:            "failed to check: expected concrete execution",
+		}
+	}
+
+	resp, err := v.pr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: concreteExecution.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: concreteExecution.WorkflowID,
+			RunID:      concreteExecution.RunID,
+		},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *types.EntityNotExistsError:
+			return CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   v.Name(),
+				Info:            "determined execution was healthy because concrete execution no longer exists",
+			}
+		default:
+			return CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   v.Name(),
+				Info:            "failed to get concrete execution",
+				InfoDetails:     err.Error(),
+			}
+		}
+	}
+	executionInfo := resp.State.ExecutionInfo
+
+	if executionInfo.State == persistence.WorkflowStateZombie {
+		return CheckResult{
+			CheckResultType: CheckResultTypeHealthy,
+			InvariantName:   v.Name(),
+			Info:            "determined execution was healthy because zombie executions are not recorded in visibility",
+		}
+	}
+	if time.Since(executionInfo.LastUpdatedTimestamp) < visibilityRecordLagTolerance {
+		return CheckResult{
+			CheckResultType: CheckResultTypeHealthy,
+			InvariantName:   v.Name(),
+			Info:            "determined execution was healthy because it was updated too recently",
+		}
+	}
+
+	domainEntry, err := v.domainCache.GetDomainByID(concreteExecution.DomainID)
+	if err != nil {
+		switch err.(type) {
+		case *types.EntityNotExistsError:
+			return CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   v.Name(),
+				Info:            "determined execution was healthy because domain no longer exists",
+			}
+		default:
+			return CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   v.Name(),
+				Info:            "failed to get domain",
+				InfoDetails:     err.Error(),
+			}
+		}
+	}
+	if domainEntry.IsSampledForLongerRetentionEnabled(concreteExecution.WorkflowID) &&
+		!domainEntry.IsSampledForLongerRetention(concreteExecution.WorkflowID) {
+		return CheckResult{
+			CheckResultType: CheckResultTypeHealthy,
+			InvariantName:   v.Name(),
+			Info:            "determined execution was healthy because it is not sampled for visibility",
+		}
+	}
+
+	open := Open(executionInfo.State)
+	var closeTime int64
+	if !open {
+		completionEvent, err := v.getCompletionEvent(ctx, concreteExecution, executionInfo)
+		if err != nil {
+			return CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   v.Name(),
+				Info:            "failed to read completion event",
+				InfoDetails:     err.Error(),
+			}
+		}
+		closeTime = completionEvent.GetTimestamp()
+
+		retention := time.Duration(int64(domainEntry.GetRetentionDays(concreteExecution.WorkflowID))*secondsInDay) * time.Second
+		if time.Unix(0, closeTime).Add(retention).Before(time.Now()) {
+			return CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   v.Name(),
+				Info:            "determined execution was healthy because its visibility record is past retention",
+			}
+		}
+	}
+
+	records, err := v.getVisibilityRecords(ctx, domainEntry.GetInfo().Name, concreteExecution, executionInfo.StartTimestamp)
+	if err != nil {
+		return CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   v.Name(),
+			Info:            "failed to get visibility record",
+			InfoDetails:     err.Error(),
+		}
+	}
+
+	if open {
+		switch {
+		case records.open != nil:
+			return CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   v.Name(),
+			}
+		case records.closed != nil:
+			return CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   v.Name(),
+				Info:            "visibility record is closed for open execution",
+				InfoDetails:     fmt.Sprintf("visibility CloseStatus: %v", records.closed.GetCloseStatus()),
+			}
+		default:
+			return CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   v.Name(),
+				Info:            "visibility record does not exist for open execution",
+			}
+		}
+	}
+
+	if records.closed == nil {
+		if records.open != nil {
+			return CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   v.Name(),
+				Info:            "visibility record is open for closed execution",
+			}
+		}
+		return CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   v.Name(),
+			Info:            "visibility record does not exist for closed execution",
+		}
+	}
+	closeStatus := persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
+	if records.closed.CloseStatus == nil || *records.closed.CloseStatus != *closeStatus {
+		return CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   v.Name(),
+			Info:            "visibility record close status does not match execution",
+			InfoDetails:     fmt.Sprintf("visibility CloseStatus: %v, execution CloseStatus: %v", records.closed.GetCloseStatus(), *closeStatus),
+		}
+	}
+	if !closeTimeEqual(records.closed.GetCloseTime(), closeTime) {
+		return CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   v.Name(),
+			Info:            "visibility record close time does not match execution",
+			InfoDetails:     fmt.Sprintf("visibility CloseTime: %v, execution CloseTime: %v", records.closed.GetCloseTime(), closeTime),
+		}
+	}
+	return CheckResult{
+		CheckResultType: CheckResultTypeHealthy,
+		InvariantName:   v.Name(),
+	}
+}
+
+// Fix re-emits the visibility record of the execution the same way history records it
+func (v *visibilityRecordConsistent) Fix(
+	ctx context.Context,
+	execution interface{},
+) FixResult {
+	if fixResult := validateFixContext(ctx, v.Name()); fixResult != nil {
+		return *fixResult
+	}
+
+	fixResult, checkResult := checkBeforeFix(ctx, v, execution)
+	if fixResult != nil {
+		return *fixResult
+	}
+
+	if err := v.recordVisibility(ctx, execution.(*entity.ConcreteExecution)); err != nil {
+		return FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: v.Name(),
+			CheckResult:   *checkResult,
+			Info:          "failed to record visibility",
+			InfoDetails:   err.Error(),
+		}
+	}
+	return FixResult{
+		FixResultType: FixResultTypeFixed,
+		InvariantName: v.Name(),
+		CheckResult:   *checkResult,
+	}
+}
+
+func (v *visibilityRecordConsistent) Name() Name {
+	return VisibilityRecordConsistent
+}
+
+// closeTimeEqual compares close times at the precision every visibility store is able to keep
+func closeTimeEqual(recordCloseTime int64, closeTime int64) bool {
+	return time.Unix(0, recordCloseTime).Truncate(visibilityCloseTimePrecision).
+		Equal(time.Unix(0, closeTime).Truncate(visibilityCloseTimePrecision))
+}
+
+// getVisibilityRecords returns the open and closed visibility records of the execution, if any
+func (v *visibilityRecordConsistent) getVisibilityRecords(
+	ctx context.Context,
+	domainName string,
+	concreteExecution *entity.ConcreteExecution,
+	startTime time.Time,
+) (*visibilityRecords, error) {
+	// closed records are indexed by close time in some stores, so search from start time until now
+	req := &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:   concreteExecution.DomainID,
+			Domain:       domainName,
+			EarliestTime: startTime.UnixNano(),
+			LatestTime:   time.Now().UnixNano(),
+			PageSize:     visibilityRecordPageSize,
+		},
+		WorkflowID: concreteExecution.WorkflowID,
+	}
+
+	open, err := v.findRecord(ctx, v.visibilityManager.ListOpenWorkflowExecutionsByWorkflowID, req, concreteExecution.RunID)
+	if err != nil {
+		return nil, err
+	}
+	closed, err := v.findRecord(ctx, v.visibilityManager.ListClosedWorkflowExecutionsByWorkflowID, req, concreteExecution.RunID)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityRecords{open: open, closed: closed}, nil
+}
+
+func (v *visibilityRecordConsistent) findRecord(
+	ctx context.Context,
+	list func(context.Context, *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error),
+	request *persistence.ListWorkflowExecutionsByWorkflowIDRequest,
+	runID string,
+) (*types.WorkflowExecutionInfo, error) {
+	req := *request
+	for {
+		resp, err := list(ctx, &req)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range resp.Executions {
+			if record.GetExecution().GetRunID() == runID {
+				return record, nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+func (v *visibilityRecordConsistent) recordVisibility(
+	ctx context.Context,
+	concreteExecution *entity.ConcreteExecution,
+) error {
+	resp, err := v.pr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: concreteExecution.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: concreteExecution.WorkflowID,
+			RunID:      concreteExecution.RunID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	executionInfo := resp.State.ExecutionInfo
+
+	domainEntry, err := v.domainCache.GetDomainByID(concreteExecution.DomainID)
+	if err != nil {
+		return err
+	}
+
+	startEvent, err := v.readStartEvent(ctx, concreteExecution, executionInfo.NextEventID)
+	if err != nil {
+		return err
+	}
+	// value 0 represents workflows which do not need backoff, same as history
+	executionTimestamp := time.Unix(0, 0)
+	if backoffSeconds := startEvent.WorkflowExecutionStartedEventAttributes.GetFirstDecisionTaskBackoffSeconds(); backoffSeconds != 0 {
+		executionTimestamp = time.Unix(0, startEvent.GetTimestamp()).Add(time.Duration(backoffSeconds) * time.Second)
+	}
+
+	var memo *types.Memo
+	if executionInfo.Memo != nil {
+		memo = &types.Memo{Fields: executionInfo.Memo}
+	}
+	execution := types.WorkflowExecution{
+		WorkflowID: concreteExecution.WorkflowID,
+		RunID:      concreteExecution.RunID,
+	}
+	isCron := len(executionInfo.CronSchedule) > 0
+	numClusters := int16(len(domainEntry.GetReplicationConfig().Clusters))
+
+	if Open(executionInfo.State) {
+		return v.visibilityManager.RecordWorkflowExecutionStarted(ctx, &persistence.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:         concreteExecution.DomainID,
+			Domain:             domainEntry.GetInfo().Name,
+			Execution:          execution,
+			WorkflowTypeName:   executionInfo.WorkflowTypeName,
+			StartTimestamp:     executionInfo.StartTimestamp.UnixNano(),
+			ExecutionTimestamp: executionTimestamp.UnixNano(),
+			WorkflowTimeout:    int64(executionInfo.WorkflowTimeout),
+			TaskID:             executionInfo.LastEventTaskID,
+			Memo:               memo,
+			TaskList:           executionInfo.TaskList,
+			IsCron:             isCron,
+			NumClusters:        numClusters,
+			SearchAttributes:   executionInfo.SearchAttributes,
+		})
+	}
+
+	completionEvent, err := v.getCompletionEvent(ctx, concreteExecution, executionInfo)
+	if err != nil {
+		return err
+	}
+	return v.visibilityManager.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:         concreteExecution.DomainID,
+		Domain:             domainEntry.GetInfo().Name,
+		Execution:          execution,
+		WorkflowTypeName:   executionInfo.WorkflowTypeName,
+		StartTimestamp:     executionInfo.StartTimestamp.UnixNano(),
+		ExecutionTimestamp: executionTimestamp.UnixNano(),
+		CloseTimestamp:     completionEvent.GetTimestamp(),
+		Status:             *persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus),
+		HistoryLength:      executionInfo.NextEventID - 1,
+		RetentionSeconds:   int64(domainEntry.GetRetentionDays(concreteExecution.WorkflowID)) * secondsInDay,
+		TaskID:             executionInfo.LastEventTaskID,
+		Memo:               memo,
+		TaskList:           executionInfo.TaskList,
+		IsCron:             isCron,
+		NumClusters:        numClusters,
+		SearchAttributes:   executionInfo.SearchAttributes,
+	})
+}
+
+// getCompletionEvent reads the last event of the completion event batch of a closed execution
+func (v *visibilityRecordConsistent) getCompletionEvent(
+	ctx context.Context,
+	concreteExecution *entity.ConcreteExecution,
+	executionInfo *persistence.WorkflowExecutionInfo,
+) (*types.HistoryEvent, error) {
+	req := &persistence.ReadHistoryBranchRequest{
+		BranchToken: concreteExecution.BranchToken,
+		MinEventID:  executionInfo.CompletionEventBatchID,
+		MaxEventID:  executionInfo.NextEventID,
+		PageSize:    visibilityRecordPageSize,
+		ShardID:     c.IntPtr(concreteExecution.ShardID),
+	}
+	var lastEvent *types.HistoryEvent
+	for {
+		resp, err := v.pr.ReadHistoryBranch(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.HistoryEvents) > 0 {
+			lastEvent = resp.HistoryEvents[len(resp.HistoryEvents)-1]
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	if lastEvent == nil || lastEvent.ID != executionInfo.NextEventID-1 {
+		return nil, fmt.Errorf("completion event %v not found in history", executionInfo.NextEventID-1)
+	}
+	return lastEvent, nil
+}
+
+func (v *visibilityRecordConsistent) readStartEvent(
+	ctx context.Context,
+	concreteExecution *entity.ConcreteExecution,
+	nextEventID int64,
+) (*types.HistoryEvent, error) {
+	resp, err := v.pr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: concreteExecution.BranchToken,
+		MinEventID:  c.FirstEventID,
+		MaxEventID:  nextEventID,
+		PageSize:    1,
+		ShardID:     c.IntPtr(concreteExecution.ShardID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.HistoryEvents) == 0 || resp.HistoryEvents[0].GetEventType() != types.EventTypeWorkflowExecutionStarted {
+		return nil, fmt.Errorf("workflow execution started event not found in history")
+	}
+	return resp.HistoryEvents[0], nil
+}
@@ -0,0 +1,334 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	c2 "github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type VisibilityRecordConsistentSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller        *gomock.Controller
+	execManager       *mocks.ExecutionManager
+	historyManager    *mocks.HistoryV2Manager
+	visibilityManager *mocks.VisibilityManager
+	domainCache       *cache.MockDomainCache
+	invariant         Invariant
+	startTime         time.Time
+	closeTime         time.Time
+}
+
+func TestVisibilityRecordConsistentSuite(t *testing.T) {
+	suite.Run(t, new(VisibilityRecordConsistentSuite))
+}
+
+func (s *VisibilityRecordConsistentSuite) SetupSuite() {
+	s.startTime = time.Now().Add(-3 * time.Hour)
+	s.closeTime = time.Now().Add(-2 * time.Hour)
+}
+
+func (s *VisibilityRecordConsistentSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.execManager = &mocks.ExecutionManager{}
+	s.historyManager = &mocks.HistoryV2Manager{}
+	s.visibilityManager = &mocks.VisibilityManager{}
+	s.domainCache = cache.NewMockDomainCache(s.controller)
+	s.invariant = NewVisibilityRecordConsistent(
+		persistence.NewPersistenceRetryer(s.execManager, s.historyManager, nil, c2.CreatePersistenceRetryPolicy()),
+		s.visibilityManager,
+		s.domainCache,
+	)
+	s.domainCache.EXPECT().GetDomainByID(domainID).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: "test-domain"},
+		&persistence.DomainConfig{Retention: 1},
+		"",
+	), nil).AnyTimes()
+}
+
+func (s *VisibilityRecordConsistentSuite) TearDownTest() {
+	s.controller.Finish()
+	s.execManager.AssertExpectations(s.T())
+	s.visibilityManager.AssertExpectations(s.T())
+}
+
+func (s *VisibilityRecordConsistentSuite) TestCheck_ExecutionNotExists() {
+	s.execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{})
+
+	result := s.invariant.Check(context.Background(), getOpenConcreteExecution())
+	s.Equal(CheckResult{
+		CheckResultType: CheckResultTypeHealthy,
+		InvariantName:   VisibilityRecordConsistent,
+		Info:            "determined execution was healthy because concrete execution no longer exists",
+	}, result)
+}
+
+func (s *VisibilityRecordConsistentSuite) TestCheck_RecentlyUpdated() {
+	resp := s.getExecutionResponse(persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone)
+	resp.State.ExecutionInfo.LastUpdatedTimestamp = time.Now()
+	s.execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(resp, nil)
+
+	result := s.invariant.Check(context.Background(), getOpenConcreteExecution())
+	s.Equal(CheckResultTypeHealthy, result.CheckResultType)
+	s.Equal("determined execution was healthy because it was updated too recently", result.Info)
+}
+
+func (s *VisibilityRecordConsistentSuite) TestCheck_OpenExecution() {
+	testCases := []struct {
+		openRecords   []*types.WorkflowExecutionInfo
+		closedRecords []*types.WorkflowExecutionInfo
+		expectedType  CheckResultType
+		expectedInfo  string
+	}{
+		{
+			openRecords:  []*types.WorkflowExecutionInfo{s.getRecord(runID, nil, 0)},
+			expectedType: CheckResultTypeHealthy,
+		},
+		{
+			openRecords:  []*types.WorkflowExecutionInfo{s.getRecord("other-run-id", nil, 0)},
+			expectedType: CheckResultTypeCorrupted,
+			expectedInfo: "visibility record does not exist for open execution",
+		},
+		{
+			closedRecords: []*types.WorkflowExecutionInfo{
+				s.getRecord(runID, types.WorkflowExecutionCloseStatusCompleted.Ptr(), s.closeTime.UnixNano()),
+			},
+			expectedType: CheckResultTypeCorrupted,
+			expectedInfo: "visibility record is closed for open execution",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+			Return(s.getExecutionResponse(persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone), nil)
+		s.mockVisibilityRecords(tc.openRecords, tc.closedRecords)
+
+		result := s.invariant.Check(context.Background(), getOpenConcreteExecution())
+		s.Equal(tc.expectedType, result.CheckResultType)
+		s.Equal(tc.expectedInfo, result.Info)
+	}
+}
+
+func (s *VisibilityRecordConsistentSuite) TestCheck_ClosedExecution() {
+	testCases := []struct {
+		openRecords   []*types.WorkflowExecutionInfo
+		closedRecords []*types.WorkflowExecutionInfo
+		expectedType  CheckResultType
+		expectedInfo  string
+	}{
+		{
+			closedRecords: []*types.WorkflowExecutionInfo{
+				s.getRecord(runID, types.WorkflowExecutionCloseStatusCompleted.Ptr(), s.closeTime.UnixNano()),
+			},
+			expectedType: CheckResultTypeHealthy,
+		},
+		{
+			closedRecords: []*types.WorkflowExecutionInfo{
+				s.getRecord(runID, types.WorkflowExecutionCloseStatusCompleted.Ptr(), s.closeTime.Truncate(time.Millisecond).UnixNano()),
+			},
+			expectedType: CheckResultTypeHealthy,
+		},
+		{
+			closedRecords: []*types.WorkflowExecutionInfo{
+				s.getRecord(runID, types.WorkflowExecutionCloseStatusCompleted.Ptr(), s.closeTime.Truncate(time.Microsecond).UnixNano()),
+			},
+			expectedType: CheckResultTypeHealthy,
+		},
+		{
+			openRecords:  []*types.WorkflowExecutionInfo{s.getRecord(runID, nil, 0)},
+			expectedType: CheckResultTypeCorrupted,
+			expectedInfo: "visibility record is open for closed execution",
+		},
+		{
+			expectedType: CheckResultTypeCorrupted,
+			expectedInfo: "visibility record does not exist for closed execution",
+		},
+		{
+			closedRecords: []*types.WorkflowExecutionInfo{
+				s.getRecord(runID, types.WorkflowExecutionCloseStatusFailed.Ptr(), s.closeTime.UnixNano()),
+			},
+			expectedType: CheckResultTypeCorrupted,
+			expectedInfo: "visibility record close status does not match execution",
+		},
+		{
+			closedRecords: []*types.WorkflowExecutionInfo{
+				s.getRecord(runID, types.WorkflowExecutionCloseStatusCompleted.Ptr(), s.startTime.UnixNano()),
+			},
+			expectedType: CheckResultTypeCorrupted,
+			expectedInfo: "visibility record close time does not match execution",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+			Return(s.getExecutionResponse(persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted), nil)
+		s.mockCompletionEvent()
+		s.mockVisibilityRecords(tc.openRecords, tc.closedRecords)
+
+		result := s.invariant.Check(context.Background(), getClosedConcreteExecution())
+		s.Equal(tc.expectedType, result.CheckResultType)
+		s.Equal(tc.expectedInfo, result.Info)
+	}
+}
+
+func (s *VisibilityRecordConsistentSuite) TestCheck_ClosedExecutionPastRetention() {
+	closeTime := s.closeTime
+	defer func() { s.closeTime = closeTime }()
+	s.closeTime = time.Now().Add(-48 * time.Hour)
+	s.execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+		Return(s.getExecutionResponse(persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted), nil)
+	s.mockCompletionEvent()
+
+	result := s.invariant.Check(context.Background(), getClosedConcreteExecution())
+	s.Equal(CheckResultTypeHealthy, result.CheckResultType)
+	s.Equal("determined execution was healthy because its visibility record is past retention", result.Info)
+}
+
+func (s *VisibilityRecordConsistentSuite) TestFix_OpenExecution() {
+	s.execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+		Return(s.getExecutionResponse(persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone), nil)
+	s.mockVisibilityRecords(nil, nil)
+	s.historyManager.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return req.MinEventID == c2.FirstEventID
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{
+			ID:                                      c2.FirstEventID,
+			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+		}},
+	}, nil).Once()
+	s.visibilityManager.On("RecordWorkflowExecutionStarted", mock.Anything, mock.MatchedBy(func(req *persistence.RecordWorkflowExecutionStartedRequest) bool {
+		return req.DomainUUID == domainID &&
+			req.Execution.GetRunID() == runID &&
+			req.StartTimestamp == s.startTime.UnixNano() &&
+			req.WorkflowTypeName == "test-workflow-type"
+	})).Return(nil).Once()
+
+	result := s.invariant.Fix(context.Background(), getOpenConcreteExecution())
+	s.Equal(FixResultTypeFixed, result.FixResultType)
+	s.Equal(VisibilityRecordConsistent, result.InvariantName)
+	s.Equal(CheckResultTypeCorrupted, result.CheckResult.CheckResultType)
+}
+
+func (s *VisibilityRecordConsistentSuite) TestFix_ClosedExecution() {
+	s.execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+		Return(s.getExecutionResponse(persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted), nil)
+	s.mockCompletionEvent()
+	s.mockVisibilityRecords([]*types.WorkflowExecutionInfo{s.getRecord(runID, nil, 0)}, nil)
+	s.historyManager.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return req.MinEventID == c2.FirstEventID
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{
+			ID:                                      c2.FirstEventID,
+			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+		}},
+	}, nil).Once()
+	s.visibilityManager.On("RecordWorkflowExecutionClosed", mock.Anything, mock.MatchedBy(func(req *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return req.Execution.GetRunID() == runID &&
+			req.CloseTimestamp == s.closeTime.UnixNano() &&
+			req.Status == types.WorkflowExecutionCloseStatusCompleted &&
+			req.HistoryLength == 9 &&
+			req.RetentionSeconds == secondsInDay
+	})).Return(nil).Once()
+
+	result := s.invariant.Fix(context.Background(), getClosedConcreteExecution())
+	s.Equal(FixResultTypeFixed, result.FixResultType)
+	s.Equal(CheckResultTypeCorrupted, result.CheckResult.CheckResultType)
+}
+
+func (s *VisibilityRecordConsistentSuite) getExecutionResponse(state int, closeStatus int) *persistence.GetWorkflowExecutionResponse {
+	return &persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:               domainID,
+				WorkflowID:             workflowID,
+				RunID:                  runID,
+				WorkflowTypeName:       "test-workflow-type",
+				State:                  state,
+				CloseStatus:            closeStatus,
+				StartTimestamp:         s.startTime,
+				LastUpdatedTimestamp:   s.closeTime,
+				CompletionEventBatchID: 8,
+				NextEventID:            10,
+			},
+		},
+	}
+}
+
+func (s *VisibilityRecordConsistentSuite) getRecord(
+	runID string,
+	closeStatus *types.WorkflowExecutionCloseStatus,
+	closeTime int64,
+) *types.WorkflowExecutionInfo {
+	record := &types.WorkflowExecutionInfo{
+		Execution:   &types.WorkflowExecution{WorkflowID: workflowID, RunID: runID},
+		StartTime:   c2.Int64Ptr(s.startTime.UnixNano()),
+		CloseStatus: closeStatus,
+	}
+	if closeStatus != nil {
+		record.CloseTime = c2.Int64Ptr(closeTime)
+	}
+	return record
+}
+
+func (s *VisibilityRecordConsistentSuite) mockCompletionEvent() {
+	s.historyManager.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return req.MinEventID == 8
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			getHistoryEvent(8, types.EventTypeDecisionTaskCompleted),
+			{
+				ID:        9,
+				EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+				Timestamp: c2.Int64Ptr(s.closeTime.UnixNano()),
+			},
+		},
+	}, nil)
+}
+
+func (s *VisibilityRecordConsistentSuite) mockVisibilityRecords(open, closed []*types.WorkflowExecutionInfo) {
+	s.visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.MatchedBy(func(req *persistence.ListWorkflowExecutionsByWorkflowIDRequest) bool {
+		return req.DomainUUID == domainID && req.WorkflowID == workflowID && req.EarliestTime == s.startTime.UnixNano()
+	})).Return(&persistence.ListWorkflowExecutionsResponse{Executions: open}, nil)
+	s.visibilityManager.On("ListClosedWorkflowExecutionsByWorkflowID", mock.Anything, mock.MatchedBy(func(req *persistence.ListWorkflowExecutionsByWorkflowIDRequest) bool {
+		return req.DomainUUID == domainID && req.WorkflowID == workflowID && req.EarliestTime == s.startTime.UnixNano()
+	})).Return(&persistence.ListWorkflowExecutionsResponse{Executions: closed}, nil)
+}
@@ -0,0 +1,343 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination mocks.go -self_package github.com/uber/cadence/common/reconciliation/invariant
+//go:generate enumer -type=Collection
+
+package scanresults
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/reconciliation/store"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	scanResultsDefaultPageSize = 10
+	scanResultsMaxPageSize     = 1000
+	fixerDecisionTimeout       = 10 * time.Second
+)
+
+type (
+	// ScanResults reads the results of the shard scanner workflows run by the worker service
+	// and starts fixer workflows for them
+	ScanResults struct {
+		frontendClient  frontend.Client
+		blobstoreClient blobstore.Client
+	}
+
+	scanCorruptionsToken struct {
+		ShardID int
+		Offset  int
+	}
+)
+
+// NewScanResults creates a reader of shard scanner results, blobstoreClient must point to the
+// blobstore the worker service writes the scan results to
+func NewScanResults(frontendClient frontend.Client, blobstoreClient blobstore.Client) *ScanResults {
+	return &ScanResults{
+		frontendClient:  frontendClient,
+		blobstoreClient: blobstoreClient,
+	}
+}
+
+// ListRuns lists the open or closed runs of a shard scanner workflow
+func (s *ScanResults) ListRuns(ctx context.Context, request *types.ListScanRunsRequest) (*types.ListScanRunsResponse, error) {
+	scanType, err := getScanType(request.GetScanType())
+	if err != nil {
+		return nil, err
+	}
+	executionFilter := &types.WorkflowExecutionFilter{WorkflowID: scanType.ScannerWorkflowID}
+	startTimeFilter := &types.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(0),
+		LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+	}
+	pageSize := getScanResultsPageSize(request.PageSize)
+
+	if request.Open {
+		resp, err := s.frontendClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.SystemLocalDomainName,
+			MaximumPageSize: pageSize,
+			NextPageToken:   request.NextPageToken,
+			StartTimeFilter: startTimeFilter,
+			ExecutionFilter: executionFilter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.ListScanRunsResponse{Executions: resp.Executions, NextPageToken: resp.NextPageToken}, nil
+	}
+	resp, err := s.frontendClient.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+		Domain:          common.SystemLocalDomainName,
+		MaximumPageSize: pageSize,
+		NextPageToken:   request.NextPageToken,
+		StartTimeFilter: startTimeFilter,
+		ExecutionFilter: executionFilter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.ListScanRunsResponse{Executions: resp.Executions, NextPageToken: resp.NextPageToken}, nil
+}
+
+// Describe returns the entity and per invariant corruption counts of a shard scanner run,
+// the latest run is described if no RunID is given
+func (s *ScanResults) Describe(ctx context.Context, request *types.DescribeScanResultsRequest) (*types.DescribeScanResultsResponse, error) {
+	scanType, err := getScanType(request.GetScanType())
+	if err != nil {
+		return nil, err
+	}
+	workflowID := scanType.ScannerWorkflowID
+
+	var aggregate AggregateScanReportResult
+	if err := s.query(ctx, workflowID, request.RunID, AggregateReportQuery, nil, &aggregate); err != nil {
+		return nil, err
+	}
+	var statusSummary ShardStatusSummaryResult
+	if err := s.query(ctx, workflowID, request.RunID, ShardStatusSummaryQuery, nil, &statusSummary); err != nil {
+		return nil, err
+	}
+	corruptKeys, err := s.getCorruptKeys(ctx, workflowID, request.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.DescribeScanResultsResponse{
+		EntitiesCount:    aggregate.EntitiesCount,
+		CorruptedCount:   aggregate.CorruptedCount,
+		CheckFailedCount: aggregate.CheckFailedCount,
+		CorruptionByType: make(map[string]int64, len(aggregate.CorruptionByType)),
+		ShardStatus:      make(map[string]int64, len(statusSummary)),
+	}
+	for name, count := range aggregate.CorruptionByType {
+		resp.CorruptionByType[string(name)] = count
+	}
+	for status, count := range statusSummary {
+		resp.ShardStatus[string(status)] = int64(count)
+	}
+	for _, shardID := range sortedShardIDs(corruptKeys) {
+		resp.CorruptedShardIDs = append(resp.CorruptedShardIDs, int32(shardID))
+	}
+	return resp, nil
+}
+
+// ListCorruptions pages through the corrupted entities found by a shard scanner run, ordered by shard
+func (s *ScanResults) ListCorruptions(ctx context.Context, request *types.ListScanCorruptionsRequest) (*types.ListScanCorruptionsResponse, error) {
+	scanType, err := getScanType(request.GetScanType())
+	if err != nil {
+		return nil, err
+	}
+	token := scanCorruptionsToken{}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, &types.BadRequestError{Message: "Invalid NextPageToken."}
+		}
+	}
+	corruptKeys, err := s.getCorruptKeys(ctx, scanType.ScannerWorkflowID, request.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	var shardIDs []int
+	for _, shardID := range sortedShardIDs(corruptKeys) {
+		if request.ShardID != nil && shardID != int(*request.ShardID) {
+			continue
+		}
+		if shardID >= token.ShardID {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+
+	pageSize := int(getScanResultsPageSize(request.PageSize))
+	resp := &types.ListScanCorruptionsResponse{}
+	for _, shardID := range shardIDs {
+		offset := 0
+		if shardID == token.ShardID {
+			offset = token.Offset
+		}
+		iter := store.NewBlobstoreIterator(ctx, s.blobstoreClient, corruptKeys[shardID], scanType.NewEntity())
+		for position := 0; iter.HasNext(); position++ {
+			scanOutput, err := iter.Next()
+			if err != nil {
+				return nil, err
+			}
+			if position < offset {
+				continue
+			}
+			if len(resp.Entities) == pageSize {
+				resp.NextPageToken, err = json.Marshal(scanCorruptionsToken{ShardID: shardID, Offset: position})
+				if err != nil {
+					return nil, err
+				}
+				return resp, nil
+			}
+			data, err := json.Marshal(scanOutput)
+			if err != nil {
+				return nil, err
+			}
+			resp.Entities = append(resp.Entities, &types.ScanCorruptedEntity{
+				ShardID: int32(shardID),
+				Entity:  data,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// Fix starts a fixer workflow for the corruptions found by a closed shard scanner run,
+// all shards with corruptions are fixed if no ShardIDs are given
+func (s *ScanResults) Fix(ctx context.Context, request *types.FixScanResultsRequest) (*types.FixScanResultsResponse, error) {
+	scanType, err := getScanType(request.GetScanType())
+	if err != nil {
+		return nil, err
+	}
+	if request.RunID == "" {
+		return nil, &types.BadRequestError{Message: "RunID of the closed scanner workflow is not set on request."}
+	}
+	params := FixerWorkflowParams{
+		ScannerWorkflowWorkflowID: scanType.ScannerWorkflowID,
+		ScannerWorkflowRunID:      request.RunID,
+	}
+	for _, shardID := range request.ShardIDs {
+		params.ShardIDs = append(params.ShardIDs, int(shardID))
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowID := fmt.Sprintf("%v-%v", scanType.FixerWorkflowID, uuid.New())
+	resp, err := s.frontendClient.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		RequestID:                           uuid.New(),
+		WorkflowID:                          workflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: scanType.FixerTaskList},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(scanType.FixerExecutionStartToClose.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(fixerDecisionTimeout.Seconds())),
+		WorkflowType:                        &types.WorkflowType{Name: scanType.FixerWorkflowType},
+		Input:                               input,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.FixScanResultsResponse{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      resp.GetRunID(),
+		},
+	}, nil
+}
+
+func (s *ScanResults) getCorruptKeys(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+) (ShardCorruptKeysResult, error) {
+	corruptKeys := make(ShardCorruptKeysResult)
+	request := PaginatedShardQueryRequest{}
+	for {
+		var result ShardCorruptKeysQueryResult
+		if err := s.query(ctx, workflowID, runID, ShardCorruptKeysQuery, request, &result); err != nil {
+			return nil, err
+		}
+		for shardID, keys := range result.Result {
+			corruptKeys[shardID] = keys
+		}
+		if result.ShardQueryPaginationToken.IsDone {
+			return corruptKeys, nil
+		}
+		request.StartingShardID = result.ShardQueryPaginationToken.NextShardID
+	}
+}
+
+func (s *ScanResults) query(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	queryType string,
+	queryArgs interface{},
+	result interface{},
+) error {
+	var args []byte
+	if queryArgs != nil {
+		var err error
+		if args, err = json.Marshal(queryArgs); err != nil {
+			return err
+		}
+	}
+	resp, err := s.frontendClient.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: common.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: queryType,
+			QueryArgs: args,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetQueryResult() == nil {
+		return &types.InternalServiceError{Message: fmt.Sprintf("Query %v of scanner workflow returned no result", queryType)}
+	}
+	return json.Unmarshal(resp.GetQueryResult(), result)
+}
+
+func getScanType(name string) (ScanType, error) {
+	scanType, ok := scanTypes[name]
+	if !ok {
+		return ScanType{}, &types.BadRequestError{Message: fmt.Sprintf("Unknown scan type %q.", name)}
+	}
+	return scanType, nil
+}
+
+func getScanResultsPageSize(pageSize int32) int32 {
+	if pageSize <= 0 {
+		return scanResultsDefaultPageSize
+	}
+	if pageSize > scanResultsMaxPageSize {
+		return scanResultsMaxPageSize
+	}
+	return pageSize
+}
+
+func sortedShardIDs(corruptKeys ShardCorruptKeysResult) []int {
+	shardIDs := make([]int, 0, len(corruptKeys))
+	for shardID := range corruptKeys {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Ints(shardIDs)
+	return shardIDs
+}
@@ -0,0 +1,196 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination mocks.go -self_package github.com/uber/cadence/common/reconciliation/invariant
+//go:generate enumer -type=Collection
+
+package scanresults
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/store"
+	"github.com/uber/cadence/common/types"
+)
+
+type scanResultsTestSuite struct {
+	suite.Suite
+	mockCtrl       *gomock.Controller
+	frontendClient *frontend.MockClient
+	blobstore      blobstore.Client
+	outputDir      string
+	scanResults    *ScanResults
+	queryResults   map[string]interface{}
+}
+
+func TestScanResultsSuite(t *testing.T) {
+	suite.Run(t, new(scanResultsTestSuite))
+}
+
+func (s *scanResultsTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.frontendClient = frontend.NewMockClient(s.mockCtrl)
+
+	var err error
+	s.outputDir, err = ioutil.TempDir("", "TestScanResults")
+	s.NoError(err)
+	s.blobstore, err = filestore.NewFilestoreClient(&config.FileBlobstore{OutputDirectory: s.outputDir})
+	s.NoError(err)
+	s.scanResults = NewScanResults(s.frontendClient, s.blobstore)
+
+	s.queryResults = map[string]interface{}{
+		AggregateReportQuery: AggregateScanReportResult{
+			EntitiesCount:  10,
+			CorruptedCount: 5,
+		},
+		ShardStatusSummaryQuery: ShardStatusSummaryResult{
+			ShardStatusSuccess: 4,
+		},
+		ShardCorruptKeysQuery: ShardCorruptKeysQueryResult{
+			Result: ShardCorruptKeysResult{
+				4: s.writeCorrupted("shard-4", 2),
+				1: s.writeCorrupted("shard-1", 3),
+			},
+			ShardQueryPaginationToken: ShardQueryPaginationToken{IsDone: true},
+		},
+	}
+	s.frontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.QueryWorkflowRequest, _ ...interface{}) (*types.QueryWorkflowResponse, error) {
+			s.Equal(common.SystemLocalDomainName, request.Domain)
+			s.Equal("cadence-sys-timers-scanner", request.Execution.WorkflowID)
+			result, err := json.Marshal(s.queryResults[request.Query.QueryType])
+			s.NoError(err)
+			return &types.QueryWorkflowResponse{QueryResult: result}, nil
+		}).AnyTimes()
+}
+
+func (s *scanResultsTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+	os.RemoveAll(s.outputDir)
+}
+
+func (s *scanResultsTestSuite) TestDescribe() {
+	resp, err := s.scanResults.Describe(context.Background(), &types.DescribeScanResultsRequest{ScanType: "timers"})
+	s.NoError(err)
+	s.Equal(&types.DescribeScanResultsResponse{
+		EntitiesCount:     10,
+		CorruptedCount:    5,
+		CorruptionByType:  map[string]int64{},
+		ShardStatus:       map[string]int64{string(ShardStatusSuccess): 4},
+		CorruptedShardIDs: []int32{1, 4},
+	}, resp)
+}
+
+func (s *scanResultsTestSuite) TestDescribe_UnknownScanType() {
+	_, err := s.scanResults.Describe(context.Background(), &types.DescribeScanResultsRequest{ScanType: "unknown"})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *scanResultsTestSuite) TestListCorruptions_Paginated() {
+	request := &types.ListScanCorruptionsRequest{ScanType: "timers", PageSize: 2}
+	var shardIDs []int32
+	var timerIDs []string
+	for {
+		resp, err := s.scanResults.ListCorruptions(context.Background(), request)
+		s.NoError(err)
+		s.True(len(resp.Entities) <= 2)
+		for _, corrupted := range resp.Entities {
+			var timer entity.Timer
+			s.NoError(json.Unmarshal(corrupted.Entity, &store.ScanOutputEntity{Execution: &timer}))
+			shardIDs = append(shardIDs, corrupted.ShardID)
+			timerIDs = append(timerIDs, timer.WorkflowID)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	s.Equal([]int32{1, 1, 1, 4, 4}, shardIDs)
+	s.Equal([]string{"shard-1-0", "shard-1-1", "shard-1-2", "shard-4-0", "shard-4-1"}, timerIDs)
+}
+
+func (s *scanResultsTestSuite) TestListCorruptions_SingleShard() {
+	resp, err := s.scanResults.ListCorruptions(context.Background(), &types.ListScanCorruptionsRequest{
+		ScanType: "timers",
+		ShardID:  common.Int32Ptr(4),
+	})
+	s.NoError(err)
+	s.Len(resp.Entities, 2)
+	s.Empty(resp.NextPageToken)
+}
+
+func (s *scanResultsTestSuite) TestFix() {
+	s.frontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+			s.Equal("cadence-sys-timers-fixer-workflow", request.WorkflowType.Name)
+			s.Equal("cadence-sys-timers-fixer-tasklist-0", request.TaskList.Name)
+			var params FixerWorkflowParams
+			s.NoError(json.Unmarshal(request.Input, &params))
+			s.Equal(FixerWorkflowParams{
+				ScannerWorkflowWorkflowID: "cadence-sys-timers-scanner",
+				ScannerWorkflowRunID:      "test-run-id",
+				ShardIDs:                  []int{1, 3},
+			}, params)
+			return &types.StartWorkflowExecutionResponse{RunID: "fixer-run-id"}, nil
+		})
+	resp, err := s.scanResults.Fix(context.Background(), &types.FixScanResultsRequest{
+		ScanType: "timers",
+		RunID:    "test-run-id",
+		ShardIDs: []int32{1, 3},
+	})
+	s.NoError(err)
+	s.Equal("fixer-run-id", resp.GetExecution().GetRunID())
+}
+
+func (s *scanResultsTestSuite) TestFix_RunIDNotSet() {
+	_, err := s.scanResults.Fix(context.Background(), &types.FixScanResultsRequest{ScanType: "timers"})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *scanResultsTestSuite) writeCorrupted(uuid string, count int) store.Keys {
+	writer := store.NewBlobstoreWriter(uuid, store.CorruptedExtension, s.blobstore, 2)
+	for i := 0; i < count; i++ {
+		s.NoError(writer.Add(store.ScanOutputEntity{
+			Execution: &entity.Timer{
+				DomainID:   "test-domain-id",
+				WorkflowID: fmt.Sprintf("%v-%v", uuid, i),
+				RunID:      "test-run-id",
+			},
+		}))
+	}
+	s.NoError(writer.Flush())
+	return *writer.FlushedKeys()
+}
@@ -0,0 +1,186 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination mocks.go -self_package github.com/uber/cadence/common/reconciliation/invariant
+//go:generate enumer -type=Collection
+
+package scanresults
+
+import (
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/store"
+)
+
+// The queries and types below are the contract between the shard scanner workflows run by the
+// worker service and the readers of their results. They live outside of the worker service so
+// that readers do not depend on, and register, the scanner workflows.
+
+const (
+	// ShardStatusSummaryQuery is the query name for the query used to get the shard status -> counts map
+	ShardStatusSummaryQuery = "shard_status_summary"
+	// AggregateReportQuery is the query name for the query used to get the aggregate result of all finished shards
+	AggregateReportQuery = "aggregate_report"
+	// ShardCorruptKeysQuery is the query name for the query used to get all completed shards with at least one corruption
+	ShardCorruptKeysQuery = "shard_corrupt_keys"
+
+	// ShardStatusSuccess indicates the scan on the shard ran successfully
+	ShardStatusSuccess ShardStatus = "success"
+	// ShardStatusControlFlowFailure indicates the scan on the shard failed
+	ShardStatusControlFlowFailure ShardStatus = "control_flow_failure"
+	// ShardStatusRunning indicates the shard has not completed yet
+	ShardStatusRunning ShardStatus = "running"
+)
+
+type (
+	// ShardStatus is the type which indicates the status of a shard scan.
+	ShardStatus string
+
+	// ShardStatusSummaryResult indicates the counts of shards in each status
+	ShardStatusSummaryResult map[ShardStatus]int
+
+	// ScanStats indicates the stats of entities which were handled by shard Scan.
+	ScanStats struct {
+		EntitiesCount    int64
+		CorruptedCount   int64
+		CheckFailedCount int64
+		CorruptionByType map[invariant.Name]int64
+	}
+
+	// AggregateScanReportResult indicates the result of summing together all
+	// shard reports which have finished scan.
+	AggregateScanReportResult ScanStats
+
+	// ShardCorruptKeysResult is a map of all shards which have finished scan successfully and have at least one corruption
+	ShardCorruptKeysResult map[int]store.Keys
+
+	// PaginatedShardQueryRequest is the request used for queries which return results over all shards
+	PaginatedShardQueryRequest struct {
+		// StartingShardID is the first shard to start iteration from.
+		// Setting to nil will start iteration from the beginning of the shards.
+		StartingShardID *int
+		// LimitShards indicates the maximum number of results that can be returned.
+		// If nil or larger than allowed maximum, will default to maximum allowed.
+		LimitShards *int
+	}
+
+	// ShardQueryPaginationToken is used to return information used to make the next query
+	ShardQueryPaginationToken struct {
+		// NextShardID is one greater than the highest shard returned in the current query.
+		// NextShardID is nil if IsDone is true.
+		// It is possible to get NextShardID != nil and on the next call to get an empty result with IsDone = true.
+		NextShardID *int
+		IsDone      bool
+	}
+
+	// ShardCorruptKeysQueryResult is the query result for ShardCorruptKeysQuery
+	ShardCorruptKeysQueryResult struct {
+		Result                    ShardCorruptKeysResult
+		ShardQueryPaginationToken ShardQueryPaginationToken
+	}
+
+	// FixerWorkflowParams are the parameters to the fix workflow
+	FixerWorkflowParams struct {
+		ScannerWorkflowWorkflowID     string
+		ScannerWorkflowRunID          string
+		FixerWorkflowConfigOverwrites FixerWorkflowConfigOverwrites
+		// ShardIDs limits the fix to the corruptions found on the given shards.
+		// Leaving it empty fixes the corruptions found on all shards.
+		ShardIDs []int
+	}
+
+	// FixerWorkflowConfigOverwrites enables overwriting the default values.
+	// If provided workflow will favor overwrites over defaults.
+	// Any overwrites that are left as nil will fall back to defaults.
+	FixerWorkflowConfigOverwrites struct {
+		Concurrency             *int
+		BlobstoreFlushThreshold *int
+		ActivityBatchSize       *int
+	}
+
+	// ScanType describes where to find the scanner workflow of a scan type, how to start its fixer
+	// workflow and how to decode the corrupted entities it found
+	ScanType struct {
+		ScannerWorkflowID          string
+		FixerWorkflowID            string
+		FixerWorkflowType          string
+		FixerTaskList              string
+		FixerExecutionStartToClose time.Duration
+		NewEntity                  func() entity.Entity
+	}
+)
+
+const fixerExecutionStartToClose = 20 * 365 * 24 * time.Hour
+
+var scanTypes = map[string]ScanType{
+	"concrete_execution": {
+		ScannerWorkflowID:          "cadence-sys-executions-scanner",
+		FixerWorkflowID:            "cadence-sys-executions-fixer",
+		FixerWorkflowType:          "cadence-sys-executions-fixer-workflow",
+		FixerTaskList:              "cadence-sys-executions-fixer-tasklist-0",
+		FixerExecutionStartToClose: fixerExecutionStartToClose,
+		NewEntity:                  func() entity.Entity { return &entity.ConcreteExecution{} },
+	},
+	"current_execution": {
+		ScannerWorkflowID:          "cadence-sys-current-executions-scanner",
+		FixerWorkflowID:            "cadence-sys-current-executions-fixer",
+		FixerWorkflowType:          "cadence-sys-current-executions-fixer-workflow",
+		FixerTaskList:              "cadence-sys-current-executions-fixer-tasklist-0",
+		FixerExecutionStartToClose: fixerExecutionStartToClose,
+		NewEntity:                  func() entity.Entity { return &entity.CurrentExecution{} },
+	},
+	"timers": {
+		ScannerWorkflowID:          "cadence-sys-timers-scanner",
+		FixerWorkflowID:            "cadence-sys-timers-fixer",
+		FixerWorkflowType:          "cadence-sys-timers-fixer-workflow",
+		FixerTaskList:              "cadence-sys-timers-fixer-tasklist-0",
+		FixerExecutionStartToClose: fixerExecutionStartToClose,
+		NewEntity:                  func() entity.Entity { return &entity.Timer{} },
+	},
+	"visibility": {
+		ScannerWorkflowID:          "cadence-sys-visibility-scanner",
+		FixerWorkflowID:            "cadence-sys-visibility-fixer",
+		FixerWorkflowType:          "cadence-sys-visibility-fixer-workflow",
+		FixerTaskList:              "cadence-sys-visibility-fixer-tasklist-0",
+		FixerExecutionStartToClose: fixerExecutionStartToClose,
+		NewEntity:                  func() entity.Entity { return &entity.ConcreteExecution{} },
+	},
+}
+
+// ScanTypeNames returns the names of the scanners whose results can be inspected
+func ScanTypeNames() []string {
+	var names []string
+	for name := range scanTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetScanType returns the scan type with the given name
+func GetScanType(name string) (ScanType, bool) {
+	scanType, ok := scanTypes[name]
+	return scanType, ok
+}
@@ -64,7 +64,7 @@ func (s *WriterIteratorSuite) SetupTest() {
 }
 
 func (s *WriterIteratorSuite) TestWriterIterator() {
-	pr := persistence.NewPersistenceRetryer(getMockExecutionManager(10, 10), nil, common.CreatePersistenceRetryPolicy())
+	pr := persistence.NewPersistenceRetryer(getMockExecutionManager(10, 10), nil, nil, common.CreatePersistenceRetryPolicy())
 	pItr := fetcher.ConcreteExecutionIterator(context.Background(), pr, executionPageSize)
 
 	uuid := "uuid"
@@ -47,6 +47,16 @@ const (
 	ClientImplHeaderName = "cadence-client-name"
 	// AuthorizationTokenHeaderName refers to the jwt token in the request
 	AuthorizationTokenHeaderName = "cadence-authorization"
+
+	// PollerLabelsHeaderName refers to the name of the header that contains the
+	// labels advertised by a poller, as a comma separated list of key=value pairs
+	PollerLabelsHeaderName = "cadence-poller-labels"
+	// PollerCapacityHeaderName refers to the name of the header that contains the
+	// maximum number of tasks a poller is able to process concurrently
+	PollerCapacityHeaderName = "cadence-poller-capacity"
+	// IsolationGroupHeaderName refers to the name of the header that contains the
+	// isolation group (e.g. the zone) the caller is running in
+	IsolationGroupHeaderName = "cadence-client-isolation-group"
 )
 
 type (
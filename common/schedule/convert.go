@@ -0,0 +1,171 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schedule
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// ToSpec converts the API ScheduleSpec to the spec of the schedule workflow
+func ToSpec(spec *types.ScheduleSpec) Spec {
+	return Spec{
+		CronExpression: spec.GetCronExpression(),
+		TimeZone:       spec.GetTimeZone(),
+		Jitter:         time.Duration(spec.GetJitterInSeconds()) * time.Second,
+		StartTime:      toTime(spec.GetStartTimeNano()),
+		EndTime:        toTime(spec.GetEndTimeNano()),
+	}
+}
+
+// FromSpec converts the spec of the schedule workflow to the API ScheduleSpec
+func FromSpec(spec Spec) *types.ScheduleSpec {
+	return &types.ScheduleSpec{
+		CronExpression:  spec.CronExpression,
+		TimeZone:        spec.TimeZone,
+		JitterInSeconds: int32(spec.Jitter / time.Second),
+		StartTimeNano:   fromTime(spec.StartTime),
+		EndTimeNano:     fromTime(spec.EndTime),
+	}
+}
+
+// ToAction converts the API ScheduleAction to the action of the schedule workflow
+func ToAction(action *types.ScheduleAction) Action {
+	return Action{
+		WorkflowType:                        action.GetWorkflowType().GetName(),
+		TaskList:                            action.GetTaskList().GetName(),
+		Input:                               action.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: action.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      action.GetTaskStartToCloseTimeoutSeconds(),
+		WorkflowIDPrefix:                    action.GetWorkflowIDPrefix(),
+	}
+}
+
+// FromAction converts the action of the schedule workflow to the API ScheduleAction
+func FromAction(action Action) *types.ScheduleAction {
+	return &types.ScheduleAction{
+		WorkflowType:                        &types.WorkflowType{Name: action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: action.TaskList},
+		Input:                               action.Input,
+		ExecutionStartToCloseTimeoutSeconds: action.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      action.TaskStartToCloseTimeoutSeconds,
+		WorkflowIDPrefix:                    action.WorkflowIDPrefix,
+	}
+}
+
+// ToPolicies converts the API SchedulePolicies to the policies of the schedule workflow
+func ToPolicies(policies *types.SchedulePolicies) Policies {
+	if policies == nil {
+		return Policies{}
+	}
+	return Policies{
+		OverlapPolicy: ToOverlapPolicy(policies.OverlapPolicy),
+	}
+}
+
+// FromPolicies converts the policies of the schedule workflow to the API SchedulePolicies
+func FromPolicies(policies Policies) *types.SchedulePolicies {
+	return &types.SchedulePolicies{
+		OverlapPolicy: FromOverlapPolicy(policies.OverlapPolicy),
+	}
+}
+
+// ToOverlapPolicy converts the API ScheduleOverlapPolicy to the overlap policy of the schedule workflow,
+// empty string is returned if the overlap policy is not set
+func ToOverlapPolicy(overlapPolicy *types.ScheduleOverlapPolicy) string {
+	if overlapPolicy == nil {
+		return ""
+	}
+	switch *overlapPolicy {
+	case types.ScheduleOverlapPolicySkip:
+		return OverlapPolicySkip
+	case types.ScheduleOverlapPolicyBuffer:
+		return OverlapPolicyBuffer
+	case types.ScheduleOverlapPolicyCancelPrevious:
+		return OverlapPolicyCancelPrevious
+	}
+	return overlapPolicy.String()
+}
+
+// FromOverlapPolicy converts the overlap policy of the schedule workflow to the API ScheduleOverlapPolicy
+func FromOverlapPolicy(overlapPolicy string) *types.ScheduleOverlapPolicy {
+	switch overlapPolicy {
+	case OverlapPolicySkip:
+		return types.ScheduleOverlapPolicySkip.Ptr()
+	case OverlapPolicyBuffer:
+		return types.ScheduleOverlapPolicyBuffer.Ptr()
+	case OverlapPolicyCancelPrevious:
+		return types.ScheduleOverlapPolicyCancelPrevious.Ptr()
+	}
+	return nil
+}
+
+// FromDescription converts the query result of the schedule workflow to the API DescribeScheduleResponse
+func FromDescription(desc *Description) *types.DescribeScheduleResponse {
+	resp := &types.DescribeScheduleResponse{
+		Spec:         FromSpec(desc.Spec),
+		Action:       FromAction(desc.Action),
+		Policies:     FromPolicies(desc.Policies),
+		Paused:       desc.Paused,
+		PauseReason:  desc.PauseReason,
+		BufferedRuns: int32(desc.BufferedRuns),
+		TotalRuns:    desc.TotalRuns,
+		SkippedRuns:  desc.SkippedRuns,
+		FailedRuns:   desc.FailedRuns,
+	}
+	if desc.LastRun != nil {
+		resp.LastRun = fromRunInfo(*desc.LastRun)
+	}
+	for _, run := range desc.RecentRuns {
+		resp.RecentRuns = append(resp.RecentRuns, fromRunInfo(run))
+	}
+	for _, t := range desc.UpcomingRuns {
+		resp.UpcomingRunTimesNano = append(resp.UpcomingRunTimesNano, t.UnixNano())
+	}
+	return resp
+}
+
+func fromRunInfo(run RunInfo) *types.ScheduleRunInfo {
+	return &types.ScheduleRunInfo{
+		ScheduledTimeNano: fromTime(run.ScheduledTime),
+		StartTimeNano:     fromTime(run.StartTime),
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: run.WorkflowID,
+			RunID:      run.RunID,
+		},
+	}
+}
+
+func toTime(unixNano int64) time.Time {
+	if unixNano == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, unixNano).UTC()
+}
+
+func fromTime(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	unixNano := t.UnixNano()
+	return &unixNano
+}
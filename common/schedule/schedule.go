@@ -0,0 +1,296 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package schedule contains the definitions shared by the frontend schedule APIs and the schedule workflows
+// hosted by the worker service.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+const (
+	// TaskListName is the tasklist of schedule workflows
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName is the workflow type of schedule workflows
+	WorkflowTypeName = "cadence-sys-schedule-workflow"
+
+	workflowIDPrefix = "cadence-sys-schedule"
+
+	// WorkflowStartToCloseTimeout is the execution timeout of schedule workflows, they continue as new long before it
+	WorkflowStartToCloseTimeout = 20 * 365 * 24 * time.Hour
+	// DecisionStartToCloseTimeout is the decision task timeout of schedule workflows
+	DecisionStartToCloseTimeout = 10 * time.Second
+
+	// QueryType is the query type to describe a schedule
+	QueryType = "describe"
+	// UpdateSignal is the signal name to update a schedule
+	UpdateSignal = "update"
+	// PauseSignal is the signal name to pause a schedule
+	PauseSignal = "pause"
+	// UnpauseSignal is the signal name to unpause a schedule
+	UnpauseSignal = "unpause"
+	// BackfillSignal is the signal name to backfill a schedule
+	BackfillSignal = "backfill"
+
+	// OverlapPolicySkip skips a scheduled run if the previous run is still running
+	OverlapPolicySkip = "skip"
+	// OverlapPolicyBuffer starts a scheduled run once the previous run completes
+	OverlapPolicyBuffer = "buffer"
+	// OverlapPolicyCancelPrevious requests cancellation of the previous run and starts the scheduled run right away
+	OverlapPolicyCancelPrevious = "cancel-previous"
+
+	// MaxBufferedRuns is the maximum number of runs buffered by OverlapPolicyBuffer, further runs are skipped
+	MaxBufferedRuns = 100
+	// MaxBackfillRuns is the maximum number of runs a single backfill can trigger
+	MaxBackfillRuns = 1000
+
+	errMsgParamsIsNil          = "params is nil"
+	errMsgDomainIsEmpty        = "domain is empty"
+	errMsgScheduleIDIsEmpty    = "scheduleID is empty"
+	errMsgWorkflowTypeIsEmpty  = "workflowType is empty"
+	errMsgTaskListIsEmpty      = "taskList is empty"
+	errMsgInvalidCron          = "invalid cron expression"
+	errMsgInvalidTimeZone      = "invalid time zone"
+	errMsgNegativeJitter       = "jitter is negative"
+	errMsgInvalidTimeRange     = "end time is before start time"
+	errMsgInvalidOverlapPolicy = "invalid overlap policy"
+)
+
+// AllOverlapPolicies is the overlap policies we supported
+var AllOverlapPolicies = []string{OverlapPolicySkip, OverlapPolicyBuffer, OverlapPolicyCancelPrevious}
+
+type (
+	// Spec defines when a schedule fires
+	Spec struct {
+		// CronExpression is a standard cron expression
+		CronExpression string
+		// TimeZone is the IANA time zone name the cron expression is evaluated in. Default to UTC
+		TimeZone string
+		// Jitter is the upper bound of a random delay added to each scheduled run
+		Jitter time.Duration
+		// StartTime is optional, no run is scheduled before it
+		StartTime time.Time
+		// EndTime is optional, no run is scheduled after it
+		EndTime time.Time
+	}
+
+	// Action defines the workflow started by each run of a schedule
+	Action struct {
+		WorkflowType                        string
+		TaskList                            string
+		Input                               []byte
+		ExecutionStartToCloseTimeoutSeconds int32
+		TaskStartToCloseTimeoutSeconds      int32
+		// WorkflowIDPrefix is suffixed with the scheduled time to build the workflowID of each run. Default to the scheduleID
+		WorkflowIDPrefix string
+	}
+
+	// Policies defines how a schedule behaves when runs overlap
+	Policies struct {
+		// OverlapPolicy is one of AllOverlapPolicies. Default to OverlapPolicySkip
+		OverlapPolicy string
+	}
+
+	// Params is the arg of the schedule workflow
+	Params struct {
+		// Domain is the domain the scheduled workflows are started in
+		Domain      string
+		ScheduleID  string
+		Spec        Spec
+		Action      Action
+		Policies    Policies
+		Paused      bool
+		PauseReason string
+		// State is the progress of the schedule, carried over continue as new
+		State State
+	}
+
+	// State is the progress of a schedule
+	State struct {
+		// LastProcessedTime is the last scheduled time that has been acted on
+		LastProcessedTime time.Time
+		LastRun           *RunInfo
+		RecentRuns        []RunInfo
+		BufferedRuns      []time.Time
+		TotalRuns         int64
+		SkippedRuns       int64
+		FailedRuns        int64
+	}
+
+	// RunInfo describes a workflow started by a schedule
+	RunInfo struct {
+		ScheduledTime time.Time
+		StartTime     time.Time
+		WorkflowID    string
+		RunID         string
+	}
+
+	// UpdateParams is the payload of UpdateSignal, it replaces the spec, action and policies of a schedule
+	UpdateParams struct {
+		Spec     Spec
+		Action   Action
+		Policies Policies
+	}
+
+	// PauseParams is the payload of PauseSignal
+	PauseParams struct {
+		Reason string
+	}
+
+	// BackfillParams is the payload of BackfillSignal, it triggers all runs scheduled in [StartTime, EndTime)
+	BackfillParams struct {
+		StartTime time.Time
+		EndTime   time.Time
+		// OverlapPolicy is optional and overrides the overlap policy of the schedule for the backfill
+		OverlapPolicy string
+	}
+
+	// Description is the query result of QueryType
+	Description struct {
+		Domain       string
+		ScheduleID   string
+		Spec         Spec
+		Action       Action
+		Policies     Policies
+		Paused       bool
+		PauseReason  string
+		LastRun      *RunInfo
+		RecentRuns   []RunInfo
+		UpcomingRuns []time.Time
+		BufferedRuns int
+		TotalRuns    int64
+		SkippedRuns  int64
+		FailedRuns   int64
+	}
+)
+
+// WorkflowID returns the workflowID of the schedule workflow for the given domain and scheduleID
+func WorkflowID(domain, scheduleID string) string {
+	return fmt.Sprintf("%v:%v:%v", workflowIDPrefix, domain, scheduleID)
+}
+
+// ScheduleIDFromWorkflowID returns the scheduleID of a schedule workflow of the given domain,
+// false is returned if the workflow does not belong to a schedule of the domain
+func ScheduleIDFromWorkflowID(domain, workflowID string) (string, bool) {
+	prefix := WorkflowID(domain, "")
+	if !strings.HasPrefix(workflowID, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(workflowID, prefix), true
+}
+
+// GetRunTimes returns at most maxCount times scheduled by spec after the given time and before the end time if not zero.
+// The time zone of the spec is loaded from the tzdata of the host, so workflow code must only call it in a side effect
+func GetRunTimes(spec Spec, after time.Time, before time.Time, maxCount int) ([]time.Time, error) {
+	schedule, err := cron.ParseStandard(spec.CronExpression)
+	if err != nil {
+		return nil, err
+	}
+	location, err := time.LoadLocation(spec.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	if !spec.StartTime.IsZero() && after.Before(spec.StartTime) {
+		after = spec.StartTime.Add(-time.Nanosecond)
+	}
+
+	var result []time.Time
+	for len(result) < maxCount {
+		next := schedule.Next(after.In(location))
+		if next.IsZero() ||
+			(!spec.EndTime.IsZero() && next.After(spec.EndTime)) ||
+			(!before.IsZero() && !next.Before(before)) {
+			break
+		}
+		result = append(result, next.UTC())
+		after = next
+	}
+	return result, nil
+}
+
+// ValidateParams validates the params of a schedule. The time zone of the spec is loaded from the tzdata of the host,
+// so workflow code must only call it in a side effect
+func ValidateParams(params *Params) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if params.Domain == "" {
+		return errors.New(errMsgDomainIsEmpty)
+	}
+	if params.ScheduleID == "" {
+		return errors.New(errMsgScheduleIDIsEmpty)
+	}
+	if params.Action.WorkflowType == "" {
+		return errors.New(errMsgWorkflowTypeIsEmpty)
+	}
+	if params.Action.TaskList == "" {
+		return errors.New(errMsgTaskListIsEmpty)
+	}
+	if err := ValidateSpec(params.Spec); err != nil {
+		return err
+	}
+	if params.Policies.OverlapPolicy == "" {
+		return nil
+	}
+	return ValidateOverlapPolicy(params.Policies.OverlapPolicy)
+}
+
+// ValidateSpec validates the spec of a schedule
+func ValidateSpec(spec Spec) error {
+	if _, err := cron.ParseStandard(spec.CronExpression); err != nil {
+		return fmt.Errorf("%v: %v", errMsgInvalidCron, err)
+	}
+	if _, err := time.LoadLocation(spec.TimeZone); err != nil {
+		return fmt.Errorf("%v: %v", errMsgInvalidTimeZone, err)
+	}
+	if spec.Jitter < 0 {
+		return errors.New(errMsgNegativeJitter)
+	}
+	if !spec.StartTime.IsZero() && !spec.EndTime.IsZero() && spec.EndTime.Before(spec.StartTime) {
+		return errors.New(errMsgInvalidTimeRange)
+	}
+	return nil
+}
+
+// ValidateOverlapPolicy validates the overlap policy of a schedule
+func ValidateOverlapPolicy(overlapPolicy string) error {
+	for _, p := range AllOverlapPolicies {
+		if p == overlapPolicy {
+			return nil
+		}
+	}
+	return fmt.Errorf("%v: %v", errMsgInvalidOverlapPolicy, overlapPolicy)
+}
+
+// SetDefaultParams sets the default values of the optional params of a schedule
+func SetDefaultParams(params *Params) {
+	if params.Spec.TimeZone == "" {
+		params.Spec.TimeZone = time.UTC.String()
+	}
+	if params.Policies.OverlapPolicy == "" {
+		params.Policies.OverlapPolicy = OverlapPolicySkip
+	}
+}
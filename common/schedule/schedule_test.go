@@ -0,0 +1,130 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+var testStartTime = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func TestValidateParams(t *testing.T) {
+	require.Error(t, ValidateParams(nil))
+	params := &Params{}
+	require.Error(t, ValidateParams(params))
+	params.Domain = "d"
+	require.Error(t, ValidateParams(params))
+	params.ScheduleID = "s"
+	require.Error(t, ValidateParams(params))
+	params.Action.WorkflowType = "wt"
+	require.Error(t, ValidateParams(params))
+	params.Action.TaskList = "tl"
+	require.Error(t, ValidateParams(params))
+	params.Spec.CronExpression = "* * * * *"
+	require.NoError(t, ValidateParams(params))
+	params.Spec.TimeZone = "Not/AZone"
+	require.Error(t, ValidateParams(params))
+	params.Spec.TimeZone = "America/New_York"
+	require.NoError(t, ValidateParams(params))
+	params.Spec.Jitter = -time.Second
+	require.Error(t, ValidateParams(params))
+	params.Spec.Jitter = time.Second
+	params.Spec.StartTime = testStartTime
+	params.Spec.EndTime = testStartTime.Add(-time.Hour)
+	require.Error(t, ValidateParams(params))
+	params.Spec.EndTime = testStartTime.Add(time.Hour)
+	require.NoError(t, ValidateParams(params))
+	params.Policies.OverlapPolicy = "invalid"
+	require.Error(t, ValidateParams(params))
+	params.Policies.OverlapPolicy = OverlapPolicyCancelPrevious
+	require.NoError(t, ValidateParams(params))
+}
+
+func TestGetRunTimes(t *testing.T) {
+	spec := Spec{CronExpression: "0 9 * * *", TimeZone: "America/New_York"}
+	times, err := GetRunTimes(spec, testStartTime, time.Time{}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{
+		time.Date(2021, 6, 1, 13, 0, 0, 0, time.UTC),
+		time.Date(2021, 6, 2, 13, 0, 0, 0, time.UTC),
+	}, times)
+
+	spec.StartTime = time.Date(2021, 6, 2, 13, 0, 0, 0, time.UTC)
+	spec.EndTime = time.Date(2021, 6, 4, 0, 0, 0, 0, time.UTC)
+	times, err = GetRunTimes(spec, testStartTime, time.Time{}, 5)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{
+		time.Date(2021, 6, 2, 13, 0, 0, 0, time.UTC),
+		time.Date(2021, 6, 3, 13, 0, 0, 0, time.UTC),
+	}, times)
+
+	times, err = GetRunTimes(spec, testStartTime, time.Date(2021, 6, 3, 13, 0, 0, 0, time.UTC), 5)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{time.Date(2021, 6, 2, 13, 0, 0, 0, time.UTC)}, times)
+}
+
+func TestScheduleIDFromWorkflowID(t *testing.T) {
+	scheduleID, ok := ScheduleIDFromWorkflowID("d", WorkflowID("d", "s"))
+	require.True(t, ok)
+	require.Equal(t, "s", scheduleID)
+
+	_, ok = ScheduleIDFromWorkflowID("d", WorkflowID("d2", "s"))
+	require.False(t, ok)
+	_, ok = ScheduleIDFromWorkflowID("d", "s")
+	require.False(t, ok)
+}
+
+func TestConvert(t *testing.T) {
+	spec := &types.ScheduleSpec{
+		CronExpression:  "0 9 * * *",
+		TimeZone:        "America/New_York",
+		JitterInSeconds: 10,
+		StartTimeNano:   common.Int64Ptr(testStartTime.UnixNano()),
+	}
+	require.Equal(t, spec, FromSpec(ToSpec(spec)))
+
+	action := &types.ScheduleAction{
+		WorkflowType:                        &types.WorkflowType{Name: "wt"},
+		TaskList:                            &types.TaskList{Name: "tl"},
+		Input:                               []byte("input"),
+		ExecutionStartToCloseTimeoutSeconds: 10,
+		TaskStartToCloseTimeoutSeconds:      5,
+		WorkflowIDPrefix:                    "prefix",
+	}
+	require.Equal(t, action, FromAction(ToAction(action)))
+
+	for _, policy := range []types.ScheduleOverlapPolicy{
+		types.ScheduleOverlapPolicySkip,
+		types.ScheduleOverlapPolicyBuffer,
+		types.ScheduleOverlapPolicyCancelPrevious,
+	} {
+		policies := &types.SchedulePolicies{OverlapPolicy: policy.Ptr()}
+		require.Equal(t, policies, FromPolicies(ToPolicies(policies)))
+	}
+	require.Equal(t, "", ToOverlapPolicy(nil))
+	require.Nil(t, FromOverlapPolicy(""))
+}
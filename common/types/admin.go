@@ -301,3 +301,524 @@ type ListDynamicConfigRequest struct {
 type ListDynamicConfigResponse struct {
 	Entries []*DynamicConfigEntry `json:"entries,omitempty"`
 }
+
+type ListScanRunsRequest struct {
+	ScanType      string `json:"scanType,omitempty"`
+	Open          bool   `json:"open,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListScanRunsRequest) GetScanType() (o string) {
+	if v != nil {
+		return v.ScanType
+	}
+	return
+}
+
+type ListScanRunsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+}
+
+type DescribeScanResultsRequest struct {
+	ScanType string `json:"scanType,omitempty"`
+	RunID    string `json:"runID,omitempty"`
+}
+
+func (v *DescribeScanResultsRequest) GetScanType() (o string) {
+	if v != nil {
+		return v.ScanType
+	}
+	return
+}
+
+type DescribeScanResultsResponse struct {
+	EntitiesCount     int64            `json:"entitiesCount,omitempty"`
+	CorruptedCount    int64            `json:"corruptedCount,omitempty"`
+	CheckFailedCount  int64            `json:"checkFailedCount,omitempty"`
+	CorruptionByType  map[string]int64 `json:"corruptionByType,omitempty"`
+	ShardStatus       map[string]int64 `json:"shardStatus,omitempty"`
+	CorruptedShardIDs []int32          `json:"corruptedShardIDs,omitempty"`
+}
+
+type ListScanCorruptionsRequest struct {
+	ScanType      string `json:"scanType,omitempty"`
+	RunID         string `json:"runID,omitempty"`
+	ShardID       *int32 `json:"shardID,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListScanCorruptionsRequest) GetScanType() (o string) {
+	if v != nil {
+		return v.ScanType
+	}
+	return
+}
+
+// ScanCorruptedEntity is a corrupted entity found by a shard scanner run, Entity holds its JSON encoded scan output
+type ScanCorruptedEntity struct {
+	ShardID int32  `json:"shardID,omitempty"`
+	Entity  []byte `json:"entity,omitempty"`
+}
+
+type ListScanCorruptionsResponse struct {
+	Entities      []*ScanCorruptedEntity `json:"entities,omitempty"`
+	NextPageToken []byte                 `json:"nextPageToken,omitempty"`
+}
+
+type FixScanResultsRequest struct {
+	ScanType string  `json:"scanType,omitempty"`
+	RunID    string  `json:"runID,omitempty"`
+	ShardIDs []int32 `json:"shardIDs,omitempty"`
+}
+
+func (v *FixScanResultsRequest) GetScanType() (o string) {
+	if v != nil {
+		return v.ScanType
+	}
+	return
+}
+
+type FixScanResultsResponse struct {
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+}
+
+func (v *FixScanResultsResponse) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+type GetTaskListBacklogRequest struct {
+	Domain        string        `json:"domain,omitempty"`
+	TaskList      *TaskList     `json:"taskList,omitempty"`
+	TaskListType  *TaskListType `json:"taskListType,omitempty"`
+	PageSize      int32         `json:"pageSize,omitempty"`
+	NextPageToken []byte        `json:"nextPageToken,omitempty"`
+}
+
+func (v *GetTaskListBacklogRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskListBacklogResponse is the aggregated view of a page of the persisted tasks of a task list partition
+type GetTaskListBacklogResponse struct {
+	AckLevel       int64            `json:"ackLevel,omitempty"`
+	Scanned        int32            `json:"scanned,omitempty"`
+	Stale          int32            `json:"stale,omitempty"`
+	LastTaskID     int64            `json:"lastTaskID,omitempty"`
+	ByDomain       map[string]int32 `json:"byDomain,omitempty"`
+	ByWorkflowType map[string]int32 `json:"byWorkflowType,omitempty"`
+	ByActivityType map[string]int32 `json:"byActivityType,omitempty"`
+	NextPageToken  []byte           `json:"nextPageToken,omitempty"`
+}
+
+// TaskListConfig holds the server side limits of a task list, a limit set to 0 is not enforced. The limits are
+// configured on the root partition and apply to every partition: the dispatch rate is shared by the read
+// partitions while the backlog limits apply to the backlog of each partition
+type TaskListConfig struct {
+	MaxDispatchPerSecond  float64 `json:"maxDispatchPerSecond,omitempty"`
+	MaxBacklogSize        int64   `json:"maxBacklogSize,omitempty"`
+	RejectWhenBacklogFull bool    `json:"rejectWhenBacklogFull,omitempty"`
+	MaxOutstandingTasks   int32   `json:"maxOutstandingTasks,omitempty"`
+}
+
+type DescribeTaskListConfigRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+func (v *DescribeTaskListConfigRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *DescribeTaskListConfigRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *DescribeTaskListConfigRequest) GetTaskListType() (o *TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return v.TaskListType
+	}
+	return
+}
+
+// DescribeTaskListConfigResponse holds the config of the task list partition, nil if no limit is configured
+type DescribeTaskListConfigResponse struct {
+	Config *TaskListConfig `json:"config,omitempty"`
+}
+
+// UpdateTaskListConfigRequest updates the limits which are set and leaves the others as is
+type UpdateTaskListConfigRequest struct {
+	Domain                string        `json:"domain,omitempty"`
+	TaskList              *TaskList     `json:"taskList,omitempty"`
+	TaskListType          *TaskListType `json:"taskListType,omitempty"`
+	MaxDispatchPerSecond  *float64      `json:"maxDispatchPerSecond,omitempty"`
+	MaxBacklogSize        *int64        `json:"maxBacklogSize,omitempty"`
+	RejectWhenBacklogFull *bool         `json:"rejectWhenBacklogFull,omitempty"`
+	MaxOutstandingTasks   *int32        `json:"maxOutstandingTasks,omitempty"`
+}
+
+func (v *UpdateTaskListConfigRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *UpdateTaskListConfigRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *UpdateTaskListConfigRequest) GetTaskListType() (o *TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return v.TaskListType
+	}
+	return
+}
+
+type UpdateTaskListConfigResponse struct {
+	Config *TaskListConfig `json:"config,omitempty"`
+}
+
+// DeadLetterTask is a task moved to the dead-letter task list of a task list partition
+type DeadLetterTask struct {
+	TaskID                int64  `json:"taskID,omitempty"`
+	WorkflowID            string `json:"workflowID,omitempty"`
+	RunID                 string `json:"runID,omitempty"`
+	ScheduleID            int64  `json:"scheduleID,omitempty"`
+	DeadLetteredTimestamp int64  `json:"deadLetteredTimestamp,omitempty"`
+}
+
+type ListDeadLetterTasksRequest struct {
+	Domain        string        `json:"domain,omitempty"`
+	TaskList      *TaskList     `json:"taskList,omitempty"`
+	TaskListType  *TaskListType `json:"taskListType,omitempty"`
+	Reason        string        `json:"reason,omitempty"`
+	PageSize      int32         `json:"pageSize,omitempty"`
+	NextPageToken []byte        `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListDeadLetterTasksRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *ListDeadLetterTasksRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *ListDeadLetterTasksRequest) GetTaskListType() (o *TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return v.TaskListType
+	}
+	return
+}
+
+func (v *ListDeadLetterTasksRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+type ListDeadLetterTasksResponse struct {
+	Tasks         []*DeadLetterTask `json:"tasks,omitempty"`
+	NextPageToken []byte            `json:"nextPageToken,omitempty"`
+}
+
+// RequeueDeadLetterTasksRequest operates on the task of the given ID if set, else on up to MaxTasks tasks
+type RequeueDeadLetterTasksRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	Reason       string        `json:"reason,omitempty"`
+	TaskID       int64         `json:"taskID,omitempty"`
+	MaxTasks     int32         `json:"maxTasks,omitempty"`
+}
+
+func (v *RequeueDeadLetterTasksRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *RequeueDeadLetterTasksRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *RequeueDeadLetterTasksRequest) GetTaskListType() (o *TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return v.TaskListType
+	}
+	return
+}
+
+func (v *RequeueDeadLetterTasksRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *RequeueDeadLetterTasksRequest) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+func (v *RequeueDeadLetterTasksRequest) GetMaxTasks() (o int32) {
+	if v != nil {
+		return v.MaxTasks
+	}
+	return
+}
+
+// RequeueDeadLetterTasksResponse holds the number of requeued tasks and of tasks skipped and left in the
+// dead-letter task list
+type RequeueDeadLetterTasksResponse struct {
+	Requeued int32 `json:"requeued,omitempty"`
+	Skipped  int32 `json:"skipped,omitempty"`
+}
+
+// PurgeDeadLetterTasksRequest operates on the task of the given ID if set, else on up to MaxTasks tasks
+type PurgeDeadLetterTasksRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	Reason       string        `json:"reason,omitempty"`
+	TaskID       int64         `json:"taskID,omitempty"`
+	MaxTasks     int32         `json:"maxTasks,omitempty"`
+}
+
+func (v *PurgeDeadLetterTasksRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *PurgeDeadLetterTasksRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *PurgeDeadLetterTasksRequest) GetTaskListType() (o *TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return v.TaskListType
+	}
+	return
+}
+
+func (v *PurgeDeadLetterTasksRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *PurgeDeadLetterTasksRequest) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+func (v *PurgeDeadLetterTasksRequest) GetMaxTasks() (o int32) {
+	if v != nil {
+		return v.MaxTasks
+	}
+	return
+}
+
+type PurgeDeadLetterTasksResponse struct {
+	Purged int32 `json:"purged,omitempty"`
+}
+
+// MigrateTaskListRequest moves up to MaxTasks tasks to the root partition of TargetTaskList
+type MigrateTaskListRequest struct {
+	Domain         string        `json:"domain,omitempty"`
+	TaskList       *TaskList     `json:"taskList,omitempty"`
+	TaskListType   *TaskListType `json:"taskListType,omitempty"`
+	TargetTaskList string        `json:"targetTaskList,omitempty"`
+	MaxTasks       int32         `json:"maxTasks,omitempty"`
+}
+
+func (v *MigrateTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *MigrateTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *MigrateTaskListRequest) GetTaskListType() (o *TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return v.TaskListType
+	}
+	return
+}
+
+func (v *MigrateTaskListRequest) GetTargetTaskList() (o string) {
+	if v != nil {
+		return v.TargetTaskList
+	}
+	return
+}
+
+func (v *MigrateTaskListRequest) GetMaxTasks() (o int32) {
+	if v != nil {
+		return v.MaxTasks
+	}
+	return
+}
+
+type MigrateTaskListResponse struct {
+	Migrated int32 `json:"migrated,omitempty"`
+	// Expired tasks are deleted instead of migrated
+	Expired int32 `json:"expired,omitempty"`
+}
+
+type DeleteTaskListRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+func (v *DeleteTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *DeleteTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *DeleteTaskListRequest) GetTaskListType() (o *TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return v.TaskListType
+	}
+	return
+}
+
+type ListStickyTaskListsRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+func (v *ListStickyTaskListsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+type StickyTaskListInfo struct {
+	Name string `json:"name,omitempty"`
+	// Identity of the worker owning the sticky task list
+	Identity          string `json:"identity,omitempty"`
+	LastPollTimestamp *int64 `json:"lastPollTimestamp,omitempty"`
+	PinnedWorkflows   int32  `json:"pinnedWorkflows,omitempty"`
+}
+
+func (v *StickyTaskListInfo) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+func (v *StickyTaskListInfo) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *StickyTaskListInfo) GetLastPollTimestamp() (o int64) {
+	if v != nil && v.LastPollTimestamp != nil {
+		return *v.LastPollTimestamp
+	}
+	return
+}
+
+type ListStickyTaskListsResponse struct {
+	TaskLists []*StickyTaskListInfo `json:"taskLists,omitempty"`
+}
+
+func (v *ListStickyTaskListsResponse) GetTaskLists() (o []*StickyTaskListInfo) {
+	if v != nil && v.TaskLists != nil {
+		return v.TaskLists
+	}
+	return
+}
+
+// ResetStickyTaskListsRequest selects the sticky task lists by worker identity or by name
+type ResetStickyTaskListsRequest struct {
+	Domain   string `json:"domain,omitempty"`
+	Identity string `json:"identity,omitempty"`
+	TaskList string `json:"taskList,omitempty"`
+}
+
+func (v *ResetStickyTaskListsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *ResetStickyTaskListsRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *ResetStickyTaskListsRequest) GetTaskList() (o string) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+type ResetStickyTaskListsResponse struct {
+	ResetWorkflows int32 `json:"resetWorkflows,omitempty"`
+}
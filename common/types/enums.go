@@ -65,6 +65,13 @@ func EventTypeValues() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeWorkflowExecutionPaused,
+		EventTypeWorkflowExecutionUnpaused,
+		EventTypeWorkflowExecutionUpdateRequested,
+		EventTypeWorkflowHistoryCompacted,
+		EventTypeWorkflowExecutionInactive,
+		EventTypeWorkflowSearchAttributesReapplied,
+		EventTypeWorkflowExecutionUpdateCompleted,
 	}
 }
 
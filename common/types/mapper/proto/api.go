@@ -1921,6 +1921,8 @@ func ToParentClosePolicy(t apiv1.ParentClosePolicy) *types.ParentClosePolicy {
 	panic("unexpected enum value")
 }
 
+// FromPendingActivityInfo drops AttemptLog, as the public proto API has no field for it yet. Between frontend
+// and history it is carried by the history service proto
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *apiv1.PendingActivityInfo {
 	if t == nil {
 		return nil
@@ -2731,6 +2733,8 @@ func ToResetStickyTaskListRequest(t *apiv1.ResetStickyTaskListRequest) *types.Re
 	}
 }
 
+// FromResetWorkflowExecutionRequest drops ResetChildPolicy and ReapplyPolicy, as the public proto API has no fields for
+// them yet. They are carried by the thrift API and, between frontend and history, by the history service proto
 func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *apiv1.ResetWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -3316,6 +3320,8 @@ func ToSignalExternalWorkflowExecutionInitiatedEventAttributes(t *apiv1.SignalEx
 	}
 }
 
+// FromSignalWithStartWorkflowExecutionRequest drops InactivityTimeoutSeconds and InactivityTimeoutPolicy, as the public proto API has no fields
+// for them yet. Between frontend and history they are carried by the history service proto
 func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflowExecutionRequest) *apiv1.SignalWithStartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -3586,6 +3592,8 @@ func ToStartTimerDecisionAttributes(t *apiv1.StartTimerDecisionAttributes) *type
 	}
 }
 
+// FromStartWorkflowExecutionRequest drops InactivityTimeoutSeconds and InactivityTimeoutPolicy, as the public proto API has no fields
+// for them yet. Between frontend and history they are carried by the history service proto
 func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *apiv1.StartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -4684,6 +4692,8 @@ func ToWorkflowExecutionSignaledEventAttributes(t *apiv1.WorkflowExecutionSignal
 	}
 }
 
+// FromWorkflowExecutionStartedEventAttributes drops InactivityTimeoutSeconds and InactivityTimeoutPolicy, as the
+// public proto API has no fields for them yet
 func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStartedEventAttributes) *apiv1.WorkflowExecutionStartedEventAttributes {
 	if t == nil {
 		return nil
@@ -5320,6 +5330,11 @@ func ToFailureDetails(failure *apiv1.Failure) []byte {
 	return failure.Details
 }
 
+// FromHistoryEvent converts a history event to the public proto API. The public proto
+// (cadence-idl) has no attributes for WorkflowSearchAttributesReapplied and
+// WorkflowExecutionUpdateCompleted events yet, so such events are sent without attributes.
+// A WorkflowHistoryCompacted event is sent as the history compaction marker it carries,
+// which is what the workflow restores its state from on replay.
 func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 	if e == nil {
 		return nil
@@ -5499,6 +5514,14 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
 			UpsertWorkflowSearchAttributesEventAttributes: FromUpsertWorkflowSearchAttributesEventAttributes(e.UpsertWorkflowSearchAttributesEventAttributes),
 		}
+	case types.EventTypeWorkflowHistoryCompacted:
+		attributes := e.WorkflowHistoryCompactedEventAttributes
+		event.Attributes = &apiv1.HistoryEvent_MarkerRecordedEventAttributes{
+			MarkerRecordedEventAttributes: FromMarkerRecordedEventAttributes(&types.MarkerRecordedEventAttributes{
+				MarkerName: attributes.GetMarkerName(),
+				Details:    attributes.GetMarkerDetails(),
+			}),
+		}
 	}
 	return &event
 }
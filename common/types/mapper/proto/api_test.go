@@ -988,6 +988,16 @@ func TestHistoryEvent(t *testing.T) {
 	}
 	assert.Panics(t, func() { FromHistoryEvent(&types.HistoryEvent{}) })
 }
+
+func TestHistoryEvent_WorkflowHistoryCompacted(t *testing.T) {
+	event := ToHistoryEvent(FromHistoryEvent(&testdata.HistoryEvent_WorkflowHistoryCompacted))
+	assert.Equal(t, types.EventTypeMarkerRecorded, event.GetEventType())
+	assert.Equal(t, testdata.HistoryEvent_WorkflowHistoryCompacted.ID, event.ID)
+	assert.Equal(t, &types.MarkerRecordedEventAttributes{
+		MarkerName: testdata.WorkflowHistoryCompactedEventAttributes.MarkerName,
+		Details:    testdata.WorkflowHistoryCompactedEventAttributes.MarkerDetails,
+	}, event.MarkerRecordedEventAttributes)
+}
 func TestDecision(t *testing.T) {
 	for _, item := range []*types.Decision{
 		nil,
@@ -27,6 +27,7 @@ import (
 
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	sharedv1 "github.com/uber/cadence/.gen/proto/shared/v1"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
@@ -133,6 +134,18 @@ func TestContinueAsNewInitiator(t *testing.T) {
 	assert.Panics(t, func() { ToContinueAsNewInitiator(apiv1.ContinueAsNewInitiator(UnknownValue)) })
 	assert.Panics(t, func() { FromContinueAsNewInitiator(types.ContinueAsNewInitiator(UnknownValue).Ptr()) })
 }
+func TestInactivityTimeoutPolicy(t *testing.T) {
+	for _, item := range []*types.InactivityTimeoutPolicy{
+		nil,
+		types.InactivityTimeoutPolicyFail.Ptr(),
+		types.InactivityTimeoutPolicyNotify.Ptr(),
+	} {
+		assert.Equal(t, item, ToInactivityTimeoutPolicy(FromInactivityTimeoutPolicy(item)))
+	}
+	assert.Panics(t, func() { ToInactivityTimeoutPolicy(historyv1.InactivityTimeoutPolicy(UnknownValue)) })
+	assert.Panics(t, func() { FromInactivityTimeoutPolicy(types.InactivityTimeoutPolicy(UnknownValue).Ptr()) })
+}
+
 func TestDecisionTaskFailedCause(t *testing.T) {
 	for _, item := range []*types.DecisionTaskFailedCause{
 		nil,
@@ -192,11 +192,13 @@ func FromHistoryDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecu
 		return nil
 	}
 	return &historyv1.DescribeWorkflowExecutionResponse{
-		ExecutionConfiguration: FromWorkflowExecutionConfiguration(t.ExecutionConfiguration),
-		WorkflowExecutionInfo:  FromWorkflowExecutionInfo(t.WorkflowExecutionInfo),
-		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
-		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
-		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		ExecutionConfiguration:     FromWorkflowExecutionConfiguration(t.ExecutionConfiguration),
+		WorkflowExecutionInfo:      FromWorkflowExecutionInfo(t.WorkflowExecutionInfo),
+		PendingActivities:          FromPendingActivityInfoArray(t.PendingActivities),
+		PendingChildren:            FromPendingChildExecutionInfoArray(t.PendingChildren),
+		PendingDecision:            FromPendingDecisionInfo(t.PendingDecision),
+		IsPaused:                   t.WorkflowExecutionInfo.GetIsPaused(),
+		PendingActivityAttemptLogs: FromPendingActivityAttemptLogArray(t.PendingActivities),
 	}
 }
 
@@ -204,15 +206,104 @@ func ToHistoryDescribeWorkflowExecutionResponse(t *historyv1.DescribeWorkflowExe
 	if t == nil {
 		return nil
 	}
+	workflowExecutionInfo := ToWorkflowExecutionInfo(t.WorkflowExecutionInfo)
+	if workflowExecutionInfo != nil {
+		workflowExecutionInfo.IsPaused = t.IsPaused
+	}
+	pendingActivities := ToPendingActivityInfoArray(t.PendingActivities)
+	toPendingActivityAttemptLogArray(t.PendingActivityAttemptLogs, pendingActivities)
 	return &types.DescribeWorkflowExecutionResponse{
 		ExecutionConfiguration: ToWorkflowExecutionConfiguration(t.ExecutionConfiguration),
-		WorkflowExecutionInfo:  ToWorkflowExecutionInfo(t.WorkflowExecutionInfo),
-		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
+		WorkflowExecutionInfo:  workflowExecutionInfo,
+		PendingActivities:      pendingActivities,
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
 	}
 }
 
+func FromPendingActivityAttemptLogArray(t []*types.PendingActivityInfo) []*historyv1.PendingActivityAttemptLog {
+	var v []*historyv1.PendingActivityAttemptLog
+	for _, activity := range t {
+		if len(activity.GetAttemptLog()) == 0 {
+			continue
+		}
+		v = append(v, &historyv1.PendingActivityAttemptLog{
+			ActivityId: activity.ActivityID,
+			Attempts:   FromActivityAttemptInfoArray(activity.AttemptLog),
+		})
+	}
+	return v
+}
+
+// toPendingActivityAttemptLogArray sets the attempt logs on the pending activities they belong to
+func toPendingActivityAttemptLogArray(t []*historyv1.PendingActivityAttemptLog, pendingActivities []*types.PendingActivityInfo) {
+	if len(t) == 0 {
+		return
+	}
+	attemptLogs := make(map[string][]*types.ActivityAttemptInfo, len(t))
+	for _, attemptLog := range t {
+		attemptLogs[attemptLog.ActivityId] = ToActivityAttemptInfoArray(attemptLog.Attempts)
+	}
+	for _, activity := range pendingActivities {
+		activity.AttemptLog = attemptLogs[activity.ActivityID]
+	}
+}
+
+func FromActivityAttemptInfo(t *types.ActivityAttemptInfo) *historyv1.ActivityAttemptInfo {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivityAttemptInfo{
+		Attempt:                   t.Attempt,
+		StartedTime:               unixNanoToTime(&t.StartedTimestamp),
+		FinishedTime:              unixNanoToTime(&t.FinishedTimestamp),
+		Identity:                  t.Identity,
+		Failure:                   FromFailure(common.StringPtr(t.FailureReason), t.FailureDetails),
+		HeartbeatDetails:          FromPayload(t.HeartbeatDetails),
+		FailureDetailsTruncated:   t.FailureDetailsTruncated,
+		HeartbeatDetailsTruncated: t.HeartbeatDetailsTruncated,
+	}
+}
+
+func ToActivityAttemptInfo(t *historyv1.ActivityAttemptInfo) *types.ActivityAttemptInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityAttemptInfo{
+		Attempt:                   t.Attempt,
+		StartedTimestamp:          common.Int64Default(timeToUnixNano(t.StartedTime)),
+		FinishedTimestamp:         common.Int64Default(timeToUnixNano(t.FinishedTime)),
+		Identity:                  t.Identity,
+		FailureReason:             common.StringDefault(ToFailureReason(t.Failure)),
+		FailureDetails:            ToFailureDetails(t.Failure),
+		HeartbeatDetails:          ToPayload(t.HeartbeatDetails),
+		FailureDetailsTruncated:   t.FailureDetailsTruncated,
+		HeartbeatDetailsTruncated: t.HeartbeatDetailsTruncated,
+	}
+}
+
+func FromActivityAttemptInfoArray(t []*types.ActivityAttemptInfo) []*historyv1.ActivityAttemptInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.ActivityAttemptInfo, len(t))
+	for i := range t {
+		v[i] = FromActivityAttemptInfo(t[i])
+	}
+	return v
+}
+
+func ToActivityAttemptInfoArray(t []*historyv1.ActivityAttemptInfo) []*types.ActivityAttemptInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityAttemptInfo, len(t))
+	for i := range t {
+		v[i] = ToActivityAttemptInfo(t[i])
+	}
+	return v
+}
+
 func FromHistoryGetDLQReplicationMessagesRequest(t *types.GetDLQReplicationMessagesRequest) *historyv1.GetDLQReplicationMessagesRequest {
 	if t == nil {
 		return nil
@@ -1090,9 +1181,17 @@ func FromHistoryResetWorkflowExecutionRequest(t *types.HistoryResetWorkflowExecu
 	if t == nil {
 		return nil
 	}
+	var resetChildPolicy *types.ResetChildPolicy
+	var reapplyPolicy *types.ResetReapplyPolicy
+	if t.ResetRequest != nil {
+		resetChildPolicy = t.ResetRequest.ResetChildPolicy
+		reapplyPolicy = t.ResetRequest.ReapplyPolicy
+	}
 	return &historyv1.ResetWorkflowExecutionRequest{
-		Request:  FromResetWorkflowExecutionRequest(t.ResetRequest),
-		DomainId: t.DomainUUID,
+		Request:            FromResetWorkflowExecutionRequest(t.ResetRequest),
+		DomainId:           t.DomainUUID,
+		ResetChildPolicy:   FromResetChildPolicy(resetChildPolicy),
+		ResetReapplyPolicy: FromResetReapplyPolicy(reapplyPolicy),
 	}
 }
 
@@ -1100,12 +1199,94 @@ func ToHistoryResetWorkflowExecutionRequest(t *historyv1.ResetWorkflowExecutionR
 	if t == nil {
 		return nil
 	}
+	resetRequest := ToResetWorkflowExecutionRequest(t.Request)
+	if resetRequest != nil {
+		// the child and reapply policies are not part of the public API request, they are carried next to it
+		resetRequest.ResetChildPolicy = ToResetChildPolicy(t.ResetChildPolicy)
+		resetRequest.ReapplyPolicy = ToResetReapplyPolicy(t.ResetReapplyPolicy)
+	}
 	return &types.HistoryResetWorkflowExecutionRequest{
-		ResetRequest: ToResetWorkflowExecutionRequest(t.Request),
+		ResetRequest: resetRequest,
 		DomainUUID:   t.DomainId,
 	}
 }
 
+func FromResetChildPolicy(t *types.ResetChildPolicy) historyv1.ResetChildPolicy {
+	if t == nil {
+		return historyv1.ResetChildPolicy_RESET_CHILD_POLICY_INVALID
+	}
+	switch *t {
+	case types.ResetChildPolicyDefault:
+		return historyv1.ResetChildPolicy_RESET_CHILD_POLICY_DEFAULT
+	case types.ResetChildPolicyReattach:
+		return historyv1.ResetChildPolicy_RESET_CHILD_POLICY_REATTACH
+	case types.ResetChildPolicyReattachAndTerminate:
+		return historyv1.ResetChildPolicy_RESET_CHILD_POLICY_REATTACH_AND_TERMINATE
+	}
+	panic("unexpected enum value")
+}
+
+func ToResetChildPolicy(t historyv1.ResetChildPolicy) *types.ResetChildPolicy {
+	switch t {
+	case historyv1.ResetChildPolicy_RESET_CHILD_POLICY_INVALID:
+		return nil
+	case historyv1.ResetChildPolicy_RESET_CHILD_POLICY_DEFAULT:
+		return types.ResetChildPolicyDefault.Ptr()
+	case historyv1.ResetChildPolicy_RESET_CHILD_POLICY_REATTACH:
+		return types.ResetChildPolicyReattach.Ptr()
+	case historyv1.ResetChildPolicy_RESET_CHILD_POLICY_REATTACH_AND_TERMINATE:
+		return types.ResetChildPolicyReattachAndTerminate.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromInactivityTimeoutPolicy(t *types.InactivityTimeoutPolicy) historyv1.InactivityTimeoutPolicy {
+	if t == nil {
+		return historyv1.InactivityTimeoutPolicy_INACTIVITY_TIMEOUT_POLICY_INVALID
+	}
+	switch *t {
+	case types.InactivityTimeoutPolicyFail:
+		return historyv1.InactivityTimeoutPolicy_INACTIVITY_TIMEOUT_POLICY_FAIL
+	case types.InactivityTimeoutPolicyNotify:
+		return historyv1.InactivityTimeoutPolicy_INACTIVITY_TIMEOUT_POLICY_NOTIFY
+	}
+	panic("unexpected enum value")
+}
+
+func ToInactivityTimeoutPolicy(t historyv1.InactivityTimeoutPolicy) *types.InactivityTimeoutPolicy {
+	switch t {
+	case historyv1.InactivityTimeoutPolicy_INACTIVITY_TIMEOUT_POLICY_INVALID:
+		return nil
+	case historyv1.InactivityTimeoutPolicy_INACTIVITY_TIMEOUT_POLICY_FAIL:
+		return types.InactivityTimeoutPolicyFail.Ptr()
+	case historyv1.InactivityTimeoutPolicy_INACTIVITY_TIMEOUT_POLICY_NOTIFY:
+		return types.InactivityTimeoutPolicyNotify.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromResetReapplyPolicy(t *types.ResetReapplyPolicy) *historyv1.ResetReapplyPolicy {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ResetReapplyPolicy{
+		ReapplySignals:                t.ReapplySignals,
+		ReapplyUpsertSearchAttributes: t.ReapplyUpsertSearchAttributes,
+		ReapplyCancelRequests:         t.ReapplyCancelRequests,
+	}
+}
+
+func ToResetReapplyPolicy(t *historyv1.ResetReapplyPolicy) *types.ResetReapplyPolicy {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetReapplyPolicy{
+		ReapplySignals:                t.ReapplySignals,
+		ReapplyUpsertSearchAttributes: t.ReapplyUpsertSearchAttributes,
+		ReapplyCancelRequests:         t.ReapplyCancelRequests,
+	}
+}
+
 func FromHistoryResetWorkflowExecutionResponse(t *types.ResetWorkflowExecutionResponse) *historyv1.ResetWorkflowExecutionResponse {
 	if t == nil {
 		return nil
@@ -1189,8 +1370,9 @@ func FromHistoryRespondDecisionTaskCompletedRequest(t *types.HistoryRespondDecis
 		return nil
 	}
 	return &historyv1.RespondDecisionTaskCompletedRequest{
-		DomainId: t.DomainUUID,
-		Request:  FromRespondDecisionTaskCompletedRequest(t.CompleteRequest),
+		DomainId:      t.DomainUUID,
+		Request:       FromRespondDecisionTaskCompletedRequest(t.CompleteRequest),
+		UpdateResults: FromWorkflowUpdateResultMap(t.CompleteRequest.GetUpdateResults()),
 	}
 }
 
@@ -1198,9 +1380,13 @@ func ToHistoryRespondDecisionTaskCompletedRequest(t *historyv1.RespondDecisionTa
 	if t == nil {
 		return nil
 	}
+	completeRequest := ToRespondDecisionTaskCompletedRequest(t.Request)
+	if completeRequest != nil {
+		completeRequest.UpdateResults = ToWorkflowUpdateResultMap(t.UpdateResults)
+	}
 	return &types.HistoryRespondDecisionTaskCompletedRequest{
 		DomainUUID:      t.DomainId,
-		CompleteRequest: ToRespondDecisionTaskCompletedRequest(t.Request),
+		CompleteRequest: completeRequest,
 	}
 }
 
@@ -1270,18 +1456,28 @@ func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWi
 	if t == nil {
 		return nil
 	}
-	return &historyv1.SignalWithStartWorkflowExecutionRequest{
+	request := &historyv1.SignalWithStartWorkflowExecutionRequest{
 		Request:  FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
 		DomainId: t.DomainUUID,
 	}
+	if t.SignalWithStartRequest != nil {
+		request.InactivityTimeout = secondsToDuration(t.SignalWithStartRequest.InactivityTimeoutSeconds)
+		request.InactivityTimeoutPolicy = FromInactivityTimeoutPolicy(t.SignalWithStartRequest.InactivityTimeoutPolicy)
+	}
+	return request
 }
 
 func ToHistorySignalWithStartWorkflowExecutionRequest(t *historyv1.SignalWithStartWorkflowExecutionRequest) *types.HistorySignalWithStartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
 	}
+	signalWithStartRequest := ToSignalWithStartWorkflowExecutionRequest(t.Request)
+	if signalWithStartRequest != nil {
+		signalWithStartRequest.InactivityTimeoutSeconds = durationToSeconds(t.InactivityTimeout)
+		signalWithStartRequest.InactivityTimeoutPolicy = ToInactivityTimeoutPolicy(t.InactivityTimeoutPolicy)
+	}
 	return &types.HistorySignalWithStartWorkflowExecutionRequest{
-		SignalWithStartRequest: ToSignalWithStartWorkflowExecutionRequest(t.Request),
+		SignalWithStartRequest: signalWithStartRequest,
 		DomainUUID:             t.DomainId,
 	}
 }
@@ -1332,7 +1528,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 	if t == nil {
 		return nil
 	}
-	return &historyv1.StartWorkflowExecutionRequest{
+	request := &historyv1.StartWorkflowExecutionRequest{
 		Request:                  FromStartWorkflowExecutionRequest(t.StartRequest),
 		DomainId:                 t.DomainUUID,
 		ParentExecutionInfo:      FromParentExecutionInfo(t.ParentExecutionInfo),
@@ -1343,14 +1539,24 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		LastCompletionResult:     FromPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoff: secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
 	}
+	if t.StartRequest != nil {
+		request.InactivityTimeout = secondsToDuration(t.StartRequest.InactivityTimeoutSeconds)
+		request.InactivityTimeoutPolicy = FromInactivityTimeoutPolicy(t.StartRequest.InactivityTimeoutPolicy)
+	}
+	return request
 }
 
 func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionRequest) *types.HistoryStartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
 	}
+	startRequest := ToStartWorkflowExecutionRequest(t.Request)
+	if startRequest != nil {
+		startRequest.InactivityTimeoutSeconds = durationToSeconds(t.InactivityTimeout)
+		startRequest.InactivityTimeoutPolicy = ToInactivityTimeoutPolicy(t.InactivityTimeoutPolicy)
+	}
 	return &types.HistoryStartWorkflowExecutionRequest{
-		StartRequest:                    ToStartWorkflowExecutionRequest(t.Request),
+		StartRequest:                    startRequest,
 		DomainUUID:                      t.DomainId,
 		ParentExecutionInfo:             ToParentExecutionInfo(t.ParentExecutionInfo),
 		Attempt:                         t.Attempt,
@@ -1471,6 +1677,158 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *historyv1.TerminateWorkflowEx
 	}
 }
 
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *historyv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.PauseRequest.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.PauseRequest.GetWorkflowExecution()),
+		Reason:            t.PauseRequest.GetReason(),
+		Identity:          t.PauseRequest.GetIdentity(),
+	}
+}
+
+func ToHistoryPauseWorkflowExecutionRequest(t *historyv1.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *historyv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.UnpauseRequest.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.UnpauseRequest.GetWorkflowExecution()),
+		Reason:            t.UnpauseRequest.GetReason(),
+		Identity:          t.UnpauseRequest.GetIdentity(),
+	}
+}
+
+func ToHistoryUnpauseWorkflowExecutionRequest(t *historyv1.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *historyv1.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.UpdateRequest.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.UpdateRequest.GetWorkflowExecution()),
+		UpdateName:        t.UpdateRequest.GetUpdateName(),
+		Input:             FromPayload(t.UpdateRequest.GetInput()),
+		Identity:          t.UpdateRequest.GetIdentity(),
+		RequestId:         t.UpdateRequest.GetRequestID(),
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionRequest(t *historyv1.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			UpdateName:        t.UpdateName,
+			Input:             ToPayload(t.Input),
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		},
+	}
+}
+
+func FromHistoryUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *historyv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionResponse{
+		Result:       FromPayload(t.Result),
+		ErrorMessage: t.ErrorMessage,
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionResponse(t *historyv1.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result:       ToPayload(t.Result),
+		ErrorMessage: t.ErrorMessage,
+	}
+}
+
+func FromWorkflowUpdateResult(t *types.WorkflowUpdateResult) *historyv1.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.WorkflowUpdateResult{
+		ResultType:   FromQueryResultType(t.ResultType),
+		Result:       FromPayload(t.Result),
+		ErrorMessage: t.ErrorMessage,
+	}
+}
+
+func ToWorkflowUpdateResult(t *historyv1.WorkflowUpdateResult) *types.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdateResult{
+		ResultType:   ToQueryResultType(t.ResultType),
+		Result:       ToPayload(t.Result),
+		ErrorMessage: t.ErrorMessage,
+	}
+}
+
+func FromWorkflowUpdateResultMap(t map[string]*types.WorkflowUpdateResult) map[string]*historyv1.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*historyv1.WorkflowUpdateResult, len(t))
+	for key := range t {
+		v[key] = FromWorkflowUpdateResult(t[key])
+	}
+	return v
+}
+
+func ToWorkflowUpdateResultMap(t map[string]*historyv1.WorkflowUpdateResult) map[string]*types.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.WorkflowUpdateResult, len(t))
+	for key := range t {
+		v[key] = ToWorkflowUpdateResult(t[key])
+	}
+	return v
+}
+
 // FromHistoryGetCrossClusterTasksRequest converts internal GetCrossClusterTasksRequest type to proto
 func FromHistoryGetCrossClusterTasksRequest(t *types.GetCrossClusterTasksRequest) *historyv1.GetCrossClusterTasksRequest {
 	if t == nil {
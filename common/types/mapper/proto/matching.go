@@ -40,6 +40,9 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		Source:                   FromTaskSource(t.Source),
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
+		Priority:                 t.Priority,
+		LabelSelector:            t.LabelSelector,
+		IsolationGroup:           t.IsolationGroup,
 	}
 }
 
@@ -57,6 +60,9 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
+		Priority:                      t.Priority,
+		LabelSelector:                 t.LabelSelector,
+		IsolationGroup:                t.IsolationGroup,
 	}
 }
 
@@ -72,6 +78,8 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
+		Priority:               t.Priority,
+		IsolationGroup:         t.IsolationGroup,
 	}
 }
 
@@ -87,6 +95,8 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
+		Priority:                      t.Priority,
+		IsolationGroup:                t.IsolationGroup,
 	}
 }
 
@@ -192,6 +202,330 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 	}
 }
 
+func FromMatchingDescribeTaskListConfigRequest(t *types.MatchingDescribeTaskListConfigRequest) *matchingv1.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribeTaskListConfigRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.GetDescRequest().GetTaskList()),
+		TaskListType: FromTaskListType(t.GetDescRequest().GetTaskListType()),
+	}
+}
+
+func ToMatchingDescribeTaskListConfigRequest(t *matchingv1.DescribeTaskListConfigRequest) *types.MatchingDescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID: t.DomainId,
+		DescRequest: &types.DescribeTaskListConfigRequest{
+			TaskList:     ToTaskList(t.TaskList),
+			TaskListType: ToTaskListType(t.TaskListType),
+		},
+	}
+}
+
+func FromMatchingDescribeTaskListConfigResponse(t *types.DescribeTaskListConfigResponse) *matchingv1.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribeTaskListConfigResponse{
+		Config: FromMatchingTaskListConfig(t.Config),
+	}
+}
+
+func ToMatchingDescribeTaskListConfigResponse(t *matchingv1.DescribeTaskListConfigResponse) *types.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigResponse{
+		Config: ToMatchingTaskListConfig(t.Config),
+	}
+}
+
+func FromMatchingUpdateTaskListConfigRequest(t *types.MatchingUpdateTaskListConfigRequest) *matchingv1.UpdateTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	update := t.GetUpdateRequest()
+	if update == nil {
+		return &matchingv1.UpdateTaskListConfigRequest{DomainId: t.DomainUUID}
+	}
+	return &matchingv1.UpdateTaskListConfigRequest{
+		DomainId:              t.DomainUUID,
+		TaskList:              FromTaskList(update.TaskList),
+		TaskListType:          FromTaskListType(update.TaskListType),
+		MaxDispatchPerSecond:  fromDoubleValue(update.MaxDispatchPerSecond),
+		MaxBacklogSize:        fromInt64Value(update.MaxBacklogSize),
+		RejectWhenBacklogFull: fromBoolValue(update.RejectWhenBacklogFull),
+		MaxOutstandingTasks:   fromInt32Value(update.MaxOutstandingTasks),
+	}
+}
+
+func ToMatchingUpdateTaskListConfigRequest(t *matchingv1.UpdateTaskListConfigRequest) *types.MatchingUpdateTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateTaskListConfigRequest{
+		DomainUUID: t.DomainId,
+		UpdateRequest: &types.UpdateTaskListConfigRequest{
+			TaskList:              ToTaskList(t.TaskList),
+			TaskListType:          ToTaskListType(t.TaskListType),
+			MaxDispatchPerSecond:  toDoubleValue(t.MaxDispatchPerSecond),
+			MaxBacklogSize:        toInt64Value(t.MaxBacklogSize),
+			RejectWhenBacklogFull: toBoolValue(t.RejectWhenBacklogFull),
+			MaxOutstandingTasks:   toInt32Value(t.MaxOutstandingTasks),
+		},
+	}
+}
+
+func FromMatchingUpdateTaskListConfigResponse(t *types.UpdateTaskListConfigResponse) *matchingv1.UpdateTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.UpdateTaskListConfigResponse{
+		Config: FromMatchingTaskListConfig(t.Config),
+	}
+}
+
+func ToMatchingUpdateTaskListConfigResponse(t *matchingv1.UpdateTaskListConfigResponse) *types.UpdateTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListConfigResponse{
+		Config: ToMatchingTaskListConfig(t.Config),
+	}
+}
+
+func FromMatchingTaskListConfig(t *types.TaskListConfig) *matchingv1.TaskListConfig {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.TaskListConfig{
+		MaxDispatchPerSecond:  t.MaxDispatchPerSecond,
+		MaxBacklogSize:        t.MaxBacklogSize,
+		RejectWhenBacklogFull: t.RejectWhenBacklogFull,
+		MaxOutstandingTasks:   t.MaxOutstandingTasks,
+	}
+}
+
+func ToMatchingTaskListConfig(t *matchingv1.TaskListConfig) *types.TaskListConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListConfig{
+		MaxDispatchPerSecond:  t.MaxDispatchPerSecond,
+		MaxBacklogSize:        t.MaxBacklogSize,
+		RejectWhenBacklogFull: t.RejectWhenBacklogFull,
+		MaxOutstandingTasks:   t.MaxOutstandingTasks,
+	}
+}
+
+func FromMatchingRequeueDeadLetterTasksRequest(t *types.MatchingRequeueDeadLetterTasksRequest) *matchingv1.RequeueDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	requeueRequest := t.GetRequeueRequest()
+	if requeueRequest == nil {
+		return &matchingv1.RequeueDeadLetterTasksRequest{DomainId: t.DomainUUID}
+	}
+	return &matchingv1.RequeueDeadLetterTasksRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(requeueRequest.TaskList),
+		TaskListType: FromTaskListType(requeueRequest.TaskListType),
+		Reason:       requeueRequest.Reason,
+		TaskId:       requeueRequest.TaskID,
+		MaxTasks:     requeueRequest.MaxTasks,
+	}
+}
+
+func ToMatchingRequeueDeadLetterTasksRequest(t *matchingv1.RequeueDeadLetterTasksRequest) *types.MatchingRequeueDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingRequeueDeadLetterTasksRequest{
+		DomainUUID: t.DomainId,
+		RequeueRequest: &types.RequeueDeadLetterTasksRequest{
+			TaskList:     ToTaskList(t.TaskList),
+			TaskListType: ToTaskListType(t.TaskListType),
+			Reason:       t.Reason,
+			TaskID:       t.TaskId,
+			MaxTasks:     t.MaxTasks,
+		},
+	}
+}
+
+func FromMatchingRequeueDeadLetterTasksResponse(t *types.RequeueDeadLetterTasksResponse) *matchingv1.RequeueDeadLetterTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.RequeueDeadLetterTasksResponse{
+		Requeued: t.Requeued,
+		Skipped:  t.Skipped,
+	}
+}
+
+func ToMatchingRequeueDeadLetterTasksResponse(t *matchingv1.RequeueDeadLetterTasksResponse) *types.RequeueDeadLetterTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RequeueDeadLetterTasksResponse{
+		Requeued: t.Requeued,
+		Skipped:  t.Skipped,
+	}
+}
+
+func FromMatchingMigrateTaskListRequest(t *types.MatchingMigrateTaskListRequest) *matchingv1.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	migrateRequest := t.GetMigrateRequest()
+	if migrateRequest == nil {
+		return &matchingv1.MigrateTaskListRequest{DomainId: t.DomainUUID}
+	}
+	return &matchingv1.MigrateTaskListRequest{
+		DomainId:       t.DomainUUID,
+		TaskList:       FromTaskList(migrateRequest.TaskList),
+		TaskListType:   FromTaskListType(migrateRequest.TaskListType),
+		TargetTaskList: migrateRequest.TargetTaskList,
+		MaxTasks:       migrateRequest.MaxTasks,
+	}
+}
+
+func ToMatchingMigrateTaskListRequest(t *matchingv1.MigrateTaskListRequest) *types.MatchingMigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingMigrateTaskListRequest{
+		DomainUUID: t.DomainId,
+		MigrateRequest: &types.MigrateTaskListRequest{
+			TaskList:       ToTaskList(t.TaskList),
+			TaskListType:   ToTaskListType(t.TaskListType),
+			TargetTaskList: t.TargetTaskList,
+			MaxTasks:       t.MaxTasks,
+		},
+	}
+}
+
+func FromMatchingMigrateTaskListResponse(t *types.MigrateTaskListResponse) *matchingv1.MigrateTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.MigrateTaskListResponse{
+		Migrated: t.Migrated,
+		Expired:  t.Expired,
+	}
+}
+
+func ToMatchingMigrateTaskListResponse(t *matchingv1.MigrateTaskListResponse) *types.MigrateTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateTaskListResponse{
+		Migrated: t.Migrated,
+		Expired:  t.Expired,
+	}
+}
+
+func FromMatchingDeleteTaskListRequest(t *types.MatchingDeleteTaskListRequest) *matchingv1.DeleteTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	deleteRequest := t.GetDeleteRequest()
+	if deleteRequest == nil {
+		return &matchingv1.DeleteTaskListRequest{DomainId: t.DomainUUID}
+	}
+	return &matchingv1.DeleteTaskListRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(deleteRequest.TaskList),
+		TaskListType: FromTaskListType(deleteRequest.TaskListType),
+	}
+}
+
+func ToMatchingDeleteTaskListRequest(t *matchingv1.DeleteTaskListRequest) *types.MatchingDeleteTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDeleteTaskListRequest{
+		DomainUUID: t.DomainId,
+		DeleteRequest: &types.DeleteTaskListRequest{
+			TaskList:     ToTaskList(t.TaskList),
+			TaskListType: ToTaskListType(t.TaskListType),
+		},
+	}
+}
+
+func FromMatchingListStickyTaskListsRequest(t *types.MatchingListStickyTaskListsRequest) *matchingv1.ListStickyTaskListsRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.ListStickyTaskListsRequest{
+		DomainId: t.DomainUUID,
+		Domain:   t.GetListRequest().GetDomain(),
+	}
+}
+
+func ToMatchingListStickyTaskListsRequest(t *matchingv1.ListStickyTaskListsRequest) *types.MatchingListStickyTaskListsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingListStickyTaskListsRequest{
+		DomainUUID: t.DomainId,
+		ListRequest: &types.ListStickyTaskListsRequest{
+			Domain: t.Domain,
+		},
+	}
+}
+
+func FromMatchingStickyTaskList(t *types.MatchingStickyTaskList) *matchingv1.StickyTaskList {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.StickyTaskList{
+		Name:         t.Name,
+		Identity:     t.Identity,
+		LastPollTime: unixNanoToTime(t.LastPollTimestamp),
+	}
+}
+
+func ToMatchingStickyTaskList(t *matchingv1.StickyTaskList) *types.MatchingStickyTaskList {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingStickyTaskList{
+		Name:              t.Name,
+		Identity:          t.Identity,
+		LastPollTimestamp: timeToUnixNano(t.LastPollTime),
+	}
+}
+
+func FromMatchingListStickyTaskListsResponse(t *types.MatchingListStickyTaskListsResponse) *matchingv1.ListStickyTaskListsResponse {
+	if t == nil {
+		return nil
+	}
+	var taskLists []*matchingv1.StickyTaskList
+	for _, taskList := range t.TaskLists {
+		taskLists = append(taskLists, FromMatchingStickyTaskList(taskList))
+	}
+	return &matchingv1.ListStickyTaskListsResponse{
+		TaskLists: taskLists,
+	}
+}
+
+func ToMatchingListStickyTaskListsResponse(t *matchingv1.ListStickyTaskListsResponse) *types.MatchingListStickyTaskListsResponse {
+	if t == nil {
+		return nil
+	}
+	var taskLists []*types.MatchingStickyTaskList
+	for _, taskList := range t.TaskLists {
+		taskLists = append(taskLists, ToMatchingStickyTaskList(taskList))
+	}
+	return &types.MatchingListStickyTaskListsResponse{
+		TaskLists: taskLists,
+	}
+}
+
 func FromMatchingListTaskListPartitionsRequest(t *types.MatchingListTaskListPartitionsRequest) *matchingv1.ListTaskListPartitionsRequest {
 	if t == nil {
 		return nil
@@ -299,10 +633,13 @@ func FromMatchingPollForActivityTaskRequest(t *types.MatchingPollForActivityTask
 		return nil
 	}
 	return &matchingv1.PollForActivityTaskRequest{
-		Request:       FromPollForActivityTaskRequest(t.PollRequest),
-		DomainId:      t.DomainUUID,
-		PollerId:      t.PollerID,
-		ForwardedFrom: t.ForwardedFrom,
+		Request:        FromPollForActivityTaskRequest(t.PollRequest),
+		DomainId:       t.DomainUUID,
+		PollerId:       t.PollerID,
+		ForwardedFrom:  t.ForwardedFrom,
+		PollerLabels:   t.PollerLabels,
+		PollerCapacity: t.PollerCapacity,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -311,10 +648,13 @@ func ToMatchingPollForActivityTaskRequest(t *matchingv1.PollForActivityTaskReque
 		return nil
 	}
 	return &types.MatchingPollForActivityTaskRequest{
-		PollRequest:   ToPollForActivityTaskRequest(t.Request),
-		DomainUUID:    t.DomainId,
-		PollerID:      t.PollerId,
-		ForwardedFrom: t.ForwardedFrom,
+		PollRequest:    ToPollForActivityTaskRequest(t.Request),
+		DomainUUID:     t.DomainId,
+		PollerID:       t.PollerId,
+		ForwardedFrom:  t.ForwardedFrom,
+		PollerLabels:   t.PollerLabels,
+		PollerCapacity: t.PollerCapacity,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -371,10 +711,13 @@ func FromMatchingPollForDecisionTaskRequest(t *types.MatchingPollForDecisionTask
 		return nil
 	}
 	return &matchingv1.PollForDecisionTaskRequest{
-		Request:       FromPollForDecisionTaskRequest(t.PollRequest),
-		DomainId:      t.DomainUUID,
-		PollerId:      t.PollerID,
-		ForwardedFrom: t.ForwardedFrom,
+		Request:        FromPollForDecisionTaskRequest(t.PollRequest),
+		DomainId:       t.DomainUUID,
+		PollerId:       t.PollerID,
+		ForwardedFrom:  t.ForwardedFrom,
+		PollerLabels:   t.PollerLabels,
+		PollerCapacity: t.PollerCapacity,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -383,10 +726,13 @@ func ToMatchingPollForDecisionTaskRequest(t *matchingv1.PollForDecisionTaskReque
 		return nil
 	}
 	return &types.MatchingPollForDecisionTaskRequest{
-		PollRequest:   ToPollForDecisionTaskRequest(t.Request),
-		DomainUUID:    t.DomainId,
-		PollerID:      t.PollerId,
-		ForwardedFrom: t.ForwardedFrom,
+		PollRequest:    ToPollForDecisionTaskRequest(t.Request),
+		DomainUUID:     t.DomainId,
+		PollerID:       t.PollerId,
+		ForwardedFrom:  t.ForwardedFrom,
+		PollerLabels:   t.PollerLabels,
+		PollerCapacity: t.PollerCapacity,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -65,6 +65,66 @@ func TestMatchingDescribeTaskListResponseMap(t *testing.T) {
 	}
 }
 
+func TestMatchingDescribeTaskListConfigRequest(t *testing.T) {
+	for _, item := range []*types.MatchingDescribeTaskListConfigRequest{nil, &testdata.MatchingDescribeTaskListConfigRequest} {
+		assert.Equal(t, item, ToMatchingDescribeTaskListConfigRequest(FromMatchingDescribeTaskListConfigRequest(item)))
+	}
+}
+
+func TestMatchingUpdateTaskListConfigRequest(t *testing.T) {
+	for _, item := range []*types.MatchingUpdateTaskListConfigRequest{nil, &testdata.MatchingUpdateTaskListConfigRequest} {
+		assert.Equal(t, item, ToMatchingUpdateTaskListConfigRequest(FromMatchingUpdateTaskListConfigRequest(item)))
+	}
+}
+
+func TestMatchingUpdateTaskListConfigResponse(t *testing.T) {
+	for _, item := range []*types.UpdateTaskListConfigResponse{nil, {}, {Config: &testdata.MatchingTaskListConfig}} {
+		assert.Equal(t, item, ToMatchingUpdateTaskListConfigResponse(FromMatchingUpdateTaskListConfigResponse(item)))
+	}
+}
+
+func TestMatchingRequeueDeadLetterTasksRequest(t *testing.T) {
+	for _, item := range []*types.MatchingRequeueDeadLetterTasksRequest{nil, &testdata.MatchingRequeueDeadLetterTasksRequest} {
+		assert.Equal(t, item, ToMatchingRequeueDeadLetterTasksRequest(FromMatchingRequeueDeadLetterTasksRequest(item)))
+	}
+}
+
+func TestMatchingRequeueDeadLetterTasksResponse(t *testing.T) {
+	for _, item := range []*types.RequeueDeadLetterTasksResponse{nil, {}, &testdata.MatchingRequeueDeadLetterTasksResponse} {
+		assert.Equal(t, item, ToMatchingRequeueDeadLetterTasksResponse(FromMatchingRequeueDeadLetterTasksResponse(item)))
+	}
+}
+
+func TestMatchingMigrateTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MatchingMigrateTaskListRequest{nil, &testdata.MatchingMigrateTaskListRequest} {
+		assert.Equal(t, item, ToMatchingMigrateTaskListRequest(FromMatchingMigrateTaskListRequest(item)))
+	}
+}
+
+func TestMatchingMigrateTaskListResponse(t *testing.T) {
+	for _, item := range []*types.MigrateTaskListResponse{nil, {}, &testdata.MatchingMigrateTaskListResponse} {
+		assert.Equal(t, item, ToMatchingMigrateTaskListResponse(FromMatchingMigrateTaskListResponse(item)))
+	}
+}
+
+func TestMatchingDeleteTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MatchingDeleteTaskListRequest{nil, &testdata.MatchingDeleteTaskListRequest} {
+		assert.Equal(t, item, ToMatchingDeleteTaskListRequest(FromMatchingDeleteTaskListRequest(item)))
+	}
+}
+
+func TestMatchingListStickyTaskListsRequest(t *testing.T) {
+	for _, item := range []*types.MatchingListStickyTaskListsRequest{nil, &testdata.MatchingListStickyTaskListsRequest} {
+		assert.Equal(t, item, ToMatchingListStickyTaskListsRequest(FromMatchingListStickyTaskListsRequest(item)))
+	}
+}
+
+func TestMatchingListStickyTaskListsResponse(t *testing.T) {
+	for _, item := range []*types.MatchingListStickyTaskListsResponse{nil, {}, &testdata.MatchingListStickyTaskListsResponse} {
+		assert.Equal(t, item, ToMatchingListStickyTaskListsResponse(FromMatchingListStickyTaskListsResponse(item)))
+	}
+}
+
 func TestMatchingListTaskListPartitionsRequest(t *testing.T) {
 	for _, item := range []*types.MatchingListTaskListPartitionsRequest{nil, {}, &testdata.MatchingListTaskListPartitionsRequest} {
 		assert.Equal(t, item, ToMatchingListTaskListPartitionsRequest(FromMatchingListTaskListPartitionsRequest(item)))
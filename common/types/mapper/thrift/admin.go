@@ -685,3 +685,815 @@ func ToListDynamicConfigResponse(t *admin.ListDynamicConfigResponse) *types.List
 		Entries: ToDynamicConfigEntryArray(t.Entries),
 	}
 }
+
+// FromListScanRunsRequest converts internal ListScanRunsRequest type to thrift
+func FromListScanRunsRequest(t *types.ListScanRunsRequest) *admin.ListScanRunsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListScanRunsRequest{
+		ScanType:      &t.ScanType,
+		Open:          &t.Open,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListScanRunsRequest converts thrift ListScanRunsRequest type to internal
+func ToListScanRunsRequest(t *admin.ListScanRunsRequest) *types.ListScanRunsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListScanRunsRequest{
+		ScanType:      t.GetScanType(),
+		Open:          t.GetOpen(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListScanRunsResponse converts internal ListScanRunsResponse type to thrift
+func FromListScanRunsResponse(t *types.ListScanRunsResponse) *admin.ListScanRunsResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListScanRunsResponse{
+		Executions:    FromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListScanRunsResponse converts thrift ListScanRunsResponse type to internal
+func ToListScanRunsResponse(t *admin.ListScanRunsResponse) *types.ListScanRunsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListScanRunsResponse{
+		Executions:    ToWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromDescribeScanResultsRequest converts internal DescribeScanResultsRequest type to thrift
+func FromDescribeScanResultsRequest(t *types.DescribeScanResultsRequest) *admin.DescribeScanResultsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeScanResultsRequest{
+		ScanType: &t.ScanType,
+		RunID:    &t.RunID,
+	}
+}
+
+// ToDescribeScanResultsRequest converts thrift DescribeScanResultsRequest type to internal
+func ToDescribeScanResultsRequest(t *admin.DescribeScanResultsRequest) *types.DescribeScanResultsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScanResultsRequest{
+		ScanType: t.GetScanType(),
+		RunID:    t.GetRunID(),
+	}
+}
+
+// FromDescribeScanResultsResponse converts internal DescribeScanResultsResponse type to thrift
+func FromDescribeScanResultsResponse(t *types.DescribeScanResultsResponse) *admin.DescribeScanResultsResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeScanResultsResponse{
+		EntitiesCount:     &t.EntitiesCount,
+		CorruptedCount:    &t.CorruptedCount,
+		CheckFailedCount:  &t.CheckFailedCount,
+		CorruptionByType:  t.CorruptionByType,
+		ShardStatus:       t.ShardStatus,
+		CorruptedShardIDs: t.CorruptedShardIDs,
+	}
+}
+
+// ToDescribeScanResultsResponse converts thrift DescribeScanResultsResponse type to internal
+func ToDescribeScanResultsResponse(t *admin.DescribeScanResultsResponse) *types.DescribeScanResultsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScanResultsResponse{
+		EntitiesCount:     t.GetEntitiesCount(),
+		CorruptedCount:    t.GetCorruptedCount(),
+		CheckFailedCount:  t.GetCheckFailedCount(),
+		CorruptionByType:  t.CorruptionByType,
+		ShardStatus:       t.ShardStatus,
+		CorruptedShardIDs: t.CorruptedShardIDs,
+	}
+}
+
+// FromListScanCorruptionsRequest converts internal ListScanCorruptionsRequest type to thrift
+func FromListScanCorruptionsRequest(t *types.ListScanCorruptionsRequest) *admin.ListScanCorruptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListScanCorruptionsRequest{
+		ScanType:      &t.ScanType,
+		RunID:         &t.RunID,
+		ShardID:       t.ShardID,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListScanCorruptionsRequest converts thrift ListScanCorruptionsRequest type to internal
+func ToListScanCorruptionsRequest(t *admin.ListScanCorruptionsRequest) *types.ListScanCorruptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListScanCorruptionsRequest{
+		ScanType:      t.GetScanType(),
+		RunID:         t.GetRunID(),
+		ShardID:       t.ShardID,
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListScanCorruptionsResponse converts internal ListScanCorruptionsResponse type to thrift
+func FromListScanCorruptionsResponse(t *types.ListScanCorruptionsResponse) *admin.ListScanCorruptionsResponse {
+	if t == nil {
+		return nil
+	}
+	var entities []*admin.ScanCorruptedEntity
+	for _, entity := range t.Entities {
+		if entity == nil {
+			continue
+		}
+		entities = append(entities, &admin.ScanCorruptedEntity{
+			ShardID: &entity.ShardID,
+			Entity:  entity.Entity,
+		})
+	}
+	return &admin.ListScanCorruptionsResponse{
+		Entities:      entities,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListScanCorruptionsResponse converts thrift ListScanCorruptionsResponse type to internal
+func ToListScanCorruptionsResponse(t *admin.ListScanCorruptionsResponse) *types.ListScanCorruptionsResponse {
+	if t == nil {
+		return nil
+	}
+	var entities []*types.ScanCorruptedEntity
+	for _, entity := range t.Entities {
+		if entity == nil {
+			continue
+		}
+		entities = append(entities, &types.ScanCorruptedEntity{
+			ShardID: entity.GetShardID(),
+			Entity:  entity.Entity,
+		})
+	}
+	return &types.ListScanCorruptionsResponse{
+		Entities:      entities,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromFixScanResultsRequest converts internal FixScanResultsRequest type to thrift
+func FromFixScanResultsRequest(t *types.FixScanResultsRequest) *admin.FixScanResultsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.FixScanResultsRequest{
+		ScanType: &t.ScanType,
+		RunID:    &t.RunID,
+		ShardIDs: t.ShardIDs,
+	}
+}
+
+// ToFixScanResultsRequest converts thrift FixScanResultsRequest type to internal
+func ToFixScanResultsRequest(t *admin.FixScanResultsRequest) *types.FixScanResultsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.FixScanResultsRequest{
+		ScanType: t.GetScanType(),
+		RunID:    t.GetRunID(),
+		ShardIDs: t.ShardIDs,
+	}
+}
+
+// FromFixScanResultsResponse converts internal FixScanResultsResponse type to thrift
+func FromFixScanResultsResponse(t *types.FixScanResultsResponse) *admin.FixScanResultsResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.FixScanResultsResponse{
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToFixScanResultsResponse converts thrift FixScanResultsResponse type to internal
+func ToFixScanResultsResponse(t *admin.FixScanResultsResponse) *types.FixScanResultsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.FixScanResultsResponse{
+		Execution: ToWorkflowExecution(t.Execution),
+	}
+}
+
+// FromGetTaskListBacklogRequest converts internal GetTaskListBacklogRequest type to thrift
+func FromGetTaskListBacklogRequest(t *types.GetTaskListBacklogRequest) *admin.GetTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetTaskListBacklogRequest{
+		Domain:        &t.Domain,
+		TaskList:      FromTaskList(t.TaskList),
+		TaskListType:  FromTaskListType(t.TaskListType),
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToGetTaskListBacklogRequest converts thrift GetTaskListBacklogRequest type to internal
+func ToGetTaskListBacklogRequest(t *admin.GetTaskListBacklogRequest) *types.GetTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListBacklogRequest{
+		Domain:        t.GetDomain(),
+		TaskList:      ToTaskList(t.TaskList),
+		TaskListType:  ToTaskListType(t.TaskListType),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromGetTaskListBacklogResponse converts internal GetTaskListBacklogResponse type to thrift
+func FromGetTaskListBacklogResponse(t *types.GetTaskListBacklogResponse) *admin.GetTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetTaskListBacklogResponse{
+		AckLevel:       &t.AckLevel,
+		Scanned:        &t.Scanned,
+		Stale:          &t.Stale,
+		LastTaskID:     &t.LastTaskID,
+		ByDomain:       t.ByDomain,
+		ByWorkflowType: t.ByWorkflowType,
+		ByActivityType: t.ByActivityType,
+		NextPageToken:  t.NextPageToken,
+	}
+}
+
+// ToGetTaskListBacklogResponse converts thrift GetTaskListBacklogResponse type to internal
+func ToGetTaskListBacklogResponse(t *admin.GetTaskListBacklogResponse) *types.GetTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListBacklogResponse{
+		AckLevel:       t.GetAckLevel(),
+		Scanned:        t.GetScanned(),
+		Stale:          t.GetStale(),
+		LastTaskID:     t.GetLastTaskID(),
+		ByDomain:       t.ByDomain,
+		ByWorkflowType: t.ByWorkflowType,
+		ByActivityType: t.ByActivityType,
+		NextPageToken:  t.NextPageToken,
+	}
+}
+
+// FromTaskListConfig converts internal TaskListConfig type to thrift
+func FromTaskListConfig(t *types.TaskListConfig) *admin.TaskListConfig {
+	if t == nil {
+		return nil
+	}
+	return &admin.TaskListConfig{
+		MaxDispatchPerSecond:  &t.MaxDispatchPerSecond,
+		MaxBacklogSize:        &t.MaxBacklogSize,
+		RejectWhenBacklogFull: &t.RejectWhenBacklogFull,
+		MaxOutstandingTasks:   &t.MaxOutstandingTasks,
+	}
+}
+
+// ToTaskListConfig converts thrift TaskListConfig type to internal
+func ToTaskListConfig(t *admin.TaskListConfig) *types.TaskListConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListConfig{
+		MaxDispatchPerSecond:  t.GetMaxDispatchPerSecond(),
+		MaxBacklogSize:        t.GetMaxBacklogSize(),
+		RejectWhenBacklogFull: t.GetRejectWhenBacklogFull(),
+		MaxOutstandingTasks:   t.GetMaxOutstandingTasks(),
+	}
+}
+
+// FromDescribeTaskListConfigRequest converts internal DescribeTaskListConfigRequest type to thrift
+func FromDescribeTaskListConfigRequest(t *types.DescribeTaskListConfigRequest) *admin.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeTaskListConfigRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToDescribeTaskListConfigRequest converts thrift DescribeTaskListConfigRequest type to internal
+func ToDescribeTaskListConfigRequest(t *admin.DescribeTaskListConfigRequest) *types.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromDescribeTaskListConfigResponse converts internal DescribeTaskListConfigResponse type to thrift
+func FromDescribeTaskListConfigResponse(t *types.DescribeTaskListConfigResponse) *admin.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeTaskListConfigResponse{
+		Config: FromTaskListConfig(t.Config),
+	}
+}
+
+// ToDescribeTaskListConfigResponse converts thrift DescribeTaskListConfigResponse type to internal
+func ToDescribeTaskListConfigResponse(t *admin.DescribeTaskListConfigResponse) *types.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigResponse{
+		Config: ToTaskListConfig(t.Config),
+	}
+}
+
+// FromUpdateTaskListConfigRequest converts internal UpdateTaskListConfigRequest type to thrift
+func FromUpdateTaskListConfigRequest(t *types.UpdateTaskListConfigRequest) *admin.UpdateTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateTaskListConfigRequest{
+		Domain:                &t.Domain,
+		TaskList:              FromTaskList(t.TaskList),
+		TaskListType:          FromTaskListType(t.TaskListType),
+		MaxDispatchPerSecond:  t.MaxDispatchPerSecond,
+		MaxBacklogSize:        t.MaxBacklogSize,
+		RejectWhenBacklogFull: t.RejectWhenBacklogFull,
+		MaxOutstandingTasks:   t.MaxOutstandingTasks,
+	}
+}
+
+// ToUpdateTaskListConfigRequest converts thrift UpdateTaskListConfigRequest type to internal
+func ToUpdateTaskListConfigRequest(t *admin.UpdateTaskListConfigRequest) *types.UpdateTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListConfigRequest{
+		Domain:                t.GetDomain(),
+		TaskList:              ToTaskList(t.TaskList),
+		TaskListType:          ToTaskListType(t.TaskListType),
+		MaxDispatchPerSecond:  t.MaxDispatchPerSecond,
+		MaxBacklogSize:        t.MaxBacklogSize,
+		RejectWhenBacklogFull: t.RejectWhenBacklogFull,
+		MaxOutstandingTasks:   t.MaxOutstandingTasks,
+	}
+}
+
+// FromUpdateTaskListConfigResponse converts internal UpdateTaskListConfigResponse type to thrift
+func FromUpdateTaskListConfigResponse(t *types.UpdateTaskListConfigResponse) *admin.UpdateTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateTaskListConfigResponse{
+		Config: FromTaskListConfig(t.Config),
+	}
+}
+
+// ToUpdateTaskListConfigResponse converts thrift UpdateTaskListConfigResponse type to internal
+func ToUpdateTaskListConfigResponse(t *admin.UpdateTaskListConfigResponse) *types.UpdateTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListConfigResponse{
+		Config: ToTaskListConfig(t.Config),
+	}
+}
+
+// FromDeadLetterTask converts internal DeadLetterTask type to thrift
+func FromDeadLetterTask(t *types.DeadLetterTask) *admin.DeadLetterTask {
+	if t == nil {
+		return nil
+	}
+	return &admin.DeadLetterTask{
+		TaskID:                &t.TaskID,
+		WorkflowID:            &t.WorkflowID,
+		RunID:                 &t.RunID,
+		ScheduleID:            &t.ScheduleID,
+		DeadLetteredTimestamp: &t.DeadLetteredTimestamp,
+	}
+}
+
+// ToDeadLetterTask converts thrift DeadLetterTask type to internal
+func ToDeadLetterTask(t *admin.DeadLetterTask) *types.DeadLetterTask {
+	if t == nil {
+		return nil
+	}
+	return &types.DeadLetterTask{
+		TaskID:                t.GetTaskID(),
+		WorkflowID:            t.GetWorkflowID(),
+		RunID:                 t.GetRunID(),
+		ScheduleID:            t.GetScheduleID(),
+		DeadLetteredTimestamp: t.GetDeadLetteredTimestamp(),
+	}
+}
+
+// FromDeadLetterTaskArray converts internal DeadLetterTask type array to thrift
+func FromDeadLetterTaskArray(t []*types.DeadLetterTask) []*admin.DeadLetterTask {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.DeadLetterTask, len(t))
+	for i := range t {
+		v[i] = FromDeadLetterTask(t[i])
+	}
+	return v
+}
+
+// ToDeadLetterTaskArray converts thrift DeadLetterTask type array to internal
+func ToDeadLetterTaskArray(t []*admin.DeadLetterTask) []*types.DeadLetterTask {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DeadLetterTask, len(t))
+	for i := range t {
+		v[i] = ToDeadLetterTask(t[i])
+	}
+	return v
+}
+
+// FromListDeadLetterTasksRequest converts internal ListDeadLetterTasksRequest type to thrift
+func FromListDeadLetterTasksRequest(t *types.ListDeadLetterTasksRequest) *admin.ListDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListDeadLetterTasksRequest{
+		Domain:        &t.Domain,
+		TaskList:      FromTaskList(t.TaskList),
+		TaskListType:  FromTaskListType(t.TaskListType),
+		Reason:        &t.Reason,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDeadLetterTasksRequest converts thrift ListDeadLetterTasksRequest type to internal
+func ToListDeadLetterTasksRequest(t *admin.ListDeadLetterTasksRequest) *types.ListDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDeadLetterTasksRequest{
+		Domain:        t.GetDomain(),
+		TaskList:      ToTaskList(t.TaskList),
+		TaskListType:  ToTaskListType(t.TaskListType),
+		Reason:        t.GetReason(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListDeadLetterTasksResponse converts internal ListDeadLetterTasksResponse type to thrift
+func FromListDeadLetterTasksResponse(t *types.ListDeadLetterTasksResponse) *admin.ListDeadLetterTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListDeadLetterTasksResponse{
+		Tasks:         FromDeadLetterTaskArray(t.Tasks),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDeadLetterTasksResponse converts thrift ListDeadLetterTasksResponse type to internal
+func ToListDeadLetterTasksResponse(t *admin.ListDeadLetterTasksResponse) *types.ListDeadLetterTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDeadLetterTasksResponse{
+		Tasks:         ToDeadLetterTaskArray(t.Tasks),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromRequeueDeadLetterTasksRequest converts internal RequeueDeadLetterTasksRequest type to thrift
+func FromRequeueDeadLetterTasksRequest(t *types.RequeueDeadLetterTasksRequest) *admin.RequeueDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RequeueDeadLetterTasksRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Reason:       &t.Reason,
+		TaskID:       &t.TaskID,
+		MaxTasks:     &t.MaxTasks,
+	}
+}
+
+// ToRequeueDeadLetterTasksRequest converts thrift RequeueDeadLetterTasksRequest type to internal
+func ToRequeueDeadLetterTasksRequest(t *admin.RequeueDeadLetterTasksRequest) *types.RequeueDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RequeueDeadLetterTasksRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Reason:       t.GetReason(),
+		TaskID:       t.GetTaskID(),
+		MaxTasks:     t.GetMaxTasks(),
+	}
+}
+
+// FromRequeueDeadLetterTasksResponse converts internal RequeueDeadLetterTasksResponse type to thrift
+func FromRequeueDeadLetterTasksResponse(t *types.RequeueDeadLetterTasksResponse) *admin.RequeueDeadLetterTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.RequeueDeadLetterTasksResponse{
+		Requeued: &t.Requeued,
+		Skipped:  &t.Skipped,
+	}
+}
+
+// ToRequeueDeadLetterTasksResponse converts thrift RequeueDeadLetterTasksResponse type to internal
+func ToRequeueDeadLetterTasksResponse(t *admin.RequeueDeadLetterTasksResponse) *types.RequeueDeadLetterTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RequeueDeadLetterTasksResponse{
+		Requeued: t.GetRequeued(),
+		Skipped:  t.GetSkipped(),
+	}
+}
+
+// FromPurgeDeadLetterTasksRequest converts internal PurgeDeadLetterTasksRequest type to thrift
+func FromPurgeDeadLetterTasksRequest(t *types.PurgeDeadLetterTasksRequest) *admin.PurgeDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.PurgeDeadLetterTasksRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Reason:       &t.Reason,
+		TaskID:       &t.TaskID,
+		MaxTasks:     &t.MaxTasks,
+	}
+}
+
+// ToPurgeDeadLetterTasksRequest converts thrift PurgeDeadLetterTasksRequest type to internal
+func ToPurgeDeadLetterTasksRequest(t *admin.PurgeDeadLetterTasksRequest) *types.PurgeDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PurgeDeadLetterTasksRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Reason:       t.GetReason(),
+		TaskID:       t.GetTaskID(),
+		MaxTasks:     t.GetMaxTasks(),
+	}
+}
+
+// FromPurgeDeadLetterTasksResponse converts internal PurgeDeadLetterTasksResponse type to thrift
+func FromPurgeDeadLetterTasksResponse(t *types.PurgeDeadLetterTasksResponse) *admin.PurgeDeadLetterTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.PurgeDeadLetterTasksResponse{
+		Purged: &t.Purged,
+	}
+}
+
+// ToPurgeDeadLetterTasksResponse converts thrift PurgeDeadLetterTasksResponse type to internal
+func ToPurgeDeadLetterTasksResponse(t *admin.PurgeDeadLetterTasksResponse) *types.PurgeDeadLetterTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.PurgeDeadLetterTasksResponse{
+		Purged: t.GetPurged(),
+	}
+}
+
+// FromMigrateTaskListRequest converts internal MigrateTaskListRequest type to thrift
+func FromMigrateTaskListRequest(t *types.MigrateTaskListRequest) *admin.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.MigrateTaskListRequest{
+		Domain:         &t.Domain,
+		TaskList:       FromTaskList(t.TaskList),
+		TaskListType:   FromTaskListType(t.TaskListType),
+		TargetTaskList: &t.TargetTaskList,
+		MaxTasks:       &t.MaxTasks,
+	}
+}
+
+// ToMigrateTaskListRequest converts thrift MigrateTaskListRequest type to internal
+func ToMigrateTaskListRequest(t *admin.MigrateTaskListRequest) *types.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateTaskListRequest{
+		Domain:         t.GetDomain(),
+		TaskList:       ToTaskList(t.TaskList),
+		TaskListType:   ToTaskListType(t.TaskListType),
+		TargetTaskList: t.GetTargetTaskList(),
+		MaxTasks:       t.GetMaxTasks(),
+	}
+}
+
+// FromMigrateTaskListResponse converts internal MigrateTaskListResponse type to thrift
+func FromMigrateTaskListResponse(t *types.MigrateTaskListResponse) *admin.MigrateTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.MigrateTaskListResponse{
+		Migrated: &t.Migrated,
+		Expired:  &t.Expired,
+	}
+}
+
+// ToMigrateTaskListResponse converts thrift MigrateTaskListResponse type to internal
+func ToMigrateTaskListResponse(t *admin.MigrateTaskListResponse) *types.MigrateTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateTaskListResponse{
+		Migrated: t.GetMigrated(),
+		Expired:  t.GetExpired(),
+	}
+}
+
+// FromDeleteTaskListRequest converts internal DeleteTaskListRequest type to thrift
+func FromDeleteTaskListRequest(t *types.DeleteTaskListRequest) *admin.DeleteTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DeleteTaskListRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToDeleteTaskListRequest converts thrift DeleteTaskListRequest type to internal
+func ToDeleteTaskListRequest(t *admin.DeleteTaskListRequest) *types.DeleteTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteTaskListRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromListStickyTaskListsRequest converts internal ListStickyTaskListsRequest type to thrift
+func FromListStickyTaskListsRequest(t *types.ListStickyTaskListsRequest) *admin.ListStickyTaskListsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListStickyTaskListsRequest{
+		Domain: &t.Domain,
+	}
+}
+
+// ToListStickyTaskListsRequest converts thrift ListStickyTaskListsRequest type to internal
+func ToListStickyTaskListsRequest(t *admin.ListStickyTaskListsRequest) *types.ListStickyTaskListsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListStickyTaskListsRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// FromStickyTaskListInfo converts internal StickyTaskListInfo type to thrift
+func FromStickyTaskListInfo(t *types.StickyTaskListInfo) *admin.StickyTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	return &admin.StickyTaskListInfo{
+		Name:              &t.Name,
+		Identity:          &t.Identity,
+		LastPollTimestamp: t.LastPollTimestamp,
+		PinnedWorkflows:   &t.PinnedWorkflows,
+	}
+}
+
+// ToStickyTaskListInfo converts thrift StickyTaskListInfo type to internal
+func ToStickyTaskListInfo(t *admin.StickyTaskListInfo) *types.StickyTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.StickyTaskListInfo{
+		Name:              t.GetName(),
+		Identity:          t.GetIdentity(),
+		LastPollTimestamp: t.LastPollTimestamp,
+		PinnedWorkflows:   t.GetPinnedWorkflows(),
+	}
+}
+
+// FromStickyTaskListInfoArray converts internal StickyTaskListInfo type array to thrift
+func FromStickyTaskListInfoArray(t []*types.StickyTaskListInfo) []*admin.StickyTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.StickyTaskListInfo, len(t))
+	for i := range t {
+		v[i] = FromStickyTaskListInfo(t[i])
+	}
+	return v
+}
+
+// ToStickyTaskListInfoArray converts thrift StickyTaskListInfo type array to internal
+func ToStickyTaskListInfoArray(t []*admin.StickyTaskListInfo) []*types.StickyTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.StickyTaskListInfo, len(t))
+	for i := range t {
+		v[i] = ToStickyTaskListInfo(t[i])
+	}
+	return v
+}
+
+// FromListStickyTaskListsResponse converts internal ListStickyTaskListsResponse type to thrift
+func FromListStickyTaskListsResponse(t *types.ListStickyTaskListsResponse) *admin.ListStickyTaskListsResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListStickyTaskListsResponse{
+		TaskLists: FromStickyTaskListInfoArray(t.TaskLists),
+	}
+}
+
+// ToListStickyTaskListsResponse converts thrift ListStickyTaskListsResponse type to internal
+func ToListStickyTaskListsResponse(t *admin.ListStickyTaskListsResponse) *types.ListStickyTaskListsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListStickyTaskListsResponse{
+		TaskLists: ToStickyTaskListInfoArray(t.TaskLists),
+	}
+}
+
+// FromResetStickyTaskListsRequest converts internal ResetStickyTaskListsRequest type to thrift
+func FromResetStickyTaskListsRequest(t *types.ResetStickyTaskListsRequest) *admin.ResetStickyTaskListsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ResetStickyTaskListsRequest{
+		Domain:   &t.Domain,
+		Identity: &t.Identity,
+		TaskList: &t.TaskList,
+	}
+}
+
+// ToResetStickyTaskListsRequest converts thrift ResetStickyTaskListsRequest type to internal
+func ToResetStickyTaskListsRequest(t *admin.ResetStickyTaskListsRequest) *types.ResetStickyTaskListsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetStickyTaskListsRequest{
+		Domain:   t.GetDomain(),
+		Identity: t.GetIdentity(),
+		TaskList: t.GetTaskList(),
+	}
+}
+
+// FromResetStickyTaskListsResponse converts internal ResetStickyTaskListsResponse type to thrift
+func FromResetStickyTaskListsResponse(t *types.ResetStickyTaskListsResponse) *admin.ResetStickyTaskListsResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ResetStickyTaskListsResponse{
+		ResetWorkflows: &t.ResetWorkflows,
+	}
+}
+
+// ToResetStickyTaskListsResponse converts thrift ResetStickyTaskListsResponse type to internal
+func ToResetStickyTaskListsResponse(t *admin.ResetStickyTaskListsResponse) *types.ResetStickyTaskListsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetStickyTaskListsResponse{
+		ResetWorkflows: t.GetResetWorkflows(),
+	}
+}
@@ -42,6 +42,9 @@ func FromAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matching.AddAc
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
+		Priority:                      &t.Priority,
+		LabelSelector:                 &t.LabelSelector,
+		IsolationGroup:                &t.IsolationGroup,
 	}
 }
 
@@ -60,6 +63,9 @@ func ToAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types.AddActi
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
+		Priority:                      t.GetPriority(),
+		LabelSelector:                 t.GetLabelSelector(),
+		IsolationGroup:                t.GetIsolationGroup(),
 	}
 }
 
@@ -107,6 +113,8 @@ func FromAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matching.AddDe
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
+		Priority:                      &t.Priority,
+		IsolationGroup:                &t.IsolationGroup,
 	}
 }
 
@@ -123,6 +131,8 @@ func ToAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types.AddDeci
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
+		Priority:                      t.GetPriority(),
+		IsolationGroup:                t.GetIsolationGroup(),
 	}
 }
 
@@ -174,6 +184,190 @@ func ToMatchingDescribeTaskListRequest(t *matching.DescribeTaskListRequest) *typ
 	}
 }
 
+// FromMatchingDescribeTaskListConfigRequest converts internal DescribeTaskListConfigRequest type to thrift
+func FromMatchingDescribeTaskListConfigRequest(t *types.MatchingDescribeTaskListConfigRequest) *matching.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.DescribeTaskListConfigRequest{
+		DomainUUID:  &t.DomainUUID,
+		DescRequest: FromDescribeTaskListConfigRequest(t.DescRequest),
+	}
+}
+
+// ToMatchingDescribeTaskListConfigRequest converts thrift DescribeTaskListConfigRequest type to internal
+func ToMatchingDescribeTaskListConfigRequest(t *matching.DescribeTaskListConfigRequest) *types.MatchingDescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID:  t.GetDomainUUID(),
+		DescRequest: ToDescribeTaskListConfigRequest(t.DescRequest),
+	}
+}
+
+// FromMatchingUpdateTaskListConfigRequest converts internal UpdateTaskListConfigRequest type to thrift
+func FromMatchingUpdateTaskListConfigRequest(t *types.MatchingUpdateTaskListConfigRequest) *matching.UpdateTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.UpdateTaskListConfigRequest{
+		DomainUUID:    &t.DomainUUID,
+		UpdateRequest: FromUpdateTaskListConfigRequest(t.UpdateRequest),
+	}
+}
+
+// ToMatchingUpdateTaskListConfigRequest converts thrift UpdateTaskListConfigRequest type to internal
+func ToMatchingUpdateTaskListConfigRequest(t *matching.UpdateTaskListConfigRequest) *types.MatchingUpdateTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateTaskListConfigRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		UpdateRequest: ToUpdateTaskListConfigRequest(t.UpdateRequest),
+	}
+}
+
+// FromMatchingRequeueDeadLetterTasksRequest converts internal RequeueDeadLetterTasksRequest type to thrift
+func FromMatchingRequeueDeadLetterTasksRequest(t *types.MatchingRequeueDeadLetterTasksRequest) *matching.RequeueDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.RequeueDeadLetterTasksRequest{
+		DomainUUID:     &t.DomainUUID,
+		RequeueRequest: FromRequeueDeadLetterTasksRequest(t.RequeueRequest),
+	}
+}
+
+// ToMatchingRequeueDeadLetterTasksRequest converts thrift RequeueDeadLetterTasksRequest type to internal
+func ToMatchingRequeueDeadLetterTasksRequest(t *matching.RequeueDeadLetterTasksRequest) *types.MatchingRequeueDeadLetterTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingRequeueDeadLetterTasksRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		RequeueRequest: ToRequeueDeadLetterTasksRequest(t.RequeueRequest),
+	}
+}
+
+// FromMatchingMigrateTaskListRequest converts internal MigrateTaskListRequest type to thrift
+func FromMatchingMigrateTaskListRequest(t *types.MatchingMigrateTaskListRequest) *matching.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.MigrateTaskListRequest{
+		DomainUUID:     &t.DomainUUID,
+		MigrateRequest: FromMigrateTaskListRequest(t.MigrateRequest),
+	}
+}
+
+// ToMatchingMigrateTaskListRequest converts thrift MigrateTaskListRequest type to internal
+func ToMatchingMigrateTaskListRequest(t *matching.MigrateTaskListRequest) *types.MatchingMigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingMigrateTaskListRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		MigrateRequest: ToMigrateTaskListRequest(t.MigrateRequest),
+	}
+}
+
+// FromMatchingDeleteTaskListRequest converts internal DeleteTaskListRequest type to thrift
+func FromMatchingDeleteTaskListRequest(t *types.MatchingDeleteTaskListRequest) *matching.DeleteTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.DeleteTaskListRequest{
+		DomainUUID:    &t.DomainUUID,
+		DeleteRequest: FromDeleteTaskListRequest(t.DeleteRequest),
+	}
+}
+
+// ToMatchingDeleteTaskListRequest converts thrift DeleteTaskListRequest type to internal
+func ToMatchingDeleteTaskListRequest(t *matching.DeleteTaskListRequest) *types.MatchingDeleteTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDeleteTaskListRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		DeleteRequest: ToDeleteTaskListRequest(t.DeleteRequest),
+	}
+}
+
+// FromMatchingListStickyTaskListsRequest converts internal ListStickyTaskListsRequest type to thrift
+func FromMatchingListStickyTaskListsRequest(t *types.MatchingListStickyTaskListsRequest) *matching.ListStickyTaskListsRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.ListStickyTaskListsRequest{
+		DomainUUID:  &t.DomainUUID,
+		ListRequest: FromListStickyTaskListsRequest(t.ListRequest),
+	}
+}
+
+// ToMatchingListStickyTaskListsRequest converts thrift ListStickyTaskListsRequest type to internal
+func ToMatchingListStickyTaskListsRequest(t *matching.ListStickyTaskListsRequest) *types.MatchingListStickyTaskListsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingListStickyTaskListsRequest{
+		DomainUUID:  t.GetDomainUUID(),
+		ListRequest: ToListStickyTaskListsRequest(t.ListRequest),
+	}
+}
+
+// FromMatchingStickyTaskList converts internal StickyTaskList type to thrift
+func FromMatchingStickyTaskList(t *types.MatchingStickyTaskList) *matching.StickyTaskList {
+	if t == nil {
+		return nil
+	}
+	return &matching.StickyTaskList{
+		Name:              &t.Name,
+		Identity:          &t.Identity,
+		LastPollTimestamp: t.LastPollTimestamp,
+	}
+}
+
+// ToMatchingStickyTaskList converts thrift StickyTaskList type to internal
+func ToMatchingStickyTaskList(t *matching.StickyTaskList) *types.MatchingStickyTaskList {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingStickyTaskList{
+		Name:              t.GetName(),
+		Identity:          t.GetIdentity(),
+		LastPollTimestamp: t.LastPollTimestamp,
+	}
+}
+
+// FromMatchingListStickyTaskListsResponse converts internal ListStickyTaskListsResponse type to thrift
+func FromMatchingListStickyTaskListsResponse(t *types.MatchingListStickyTaskListsResponse) *matching.ListStickyTaskListsResponse {
+	if t == nil {
+		return nil
+	}
+	var taskLists []*matching.StickyTaskList
+	for _, taskList := range t.TaskLists {
+		taskLists = append(taskLists, FromMatchingStickyTaskList(taskList))
+	}
+	return &matching.ListStickyTaskListsResponse{
+		TaskLists: taskLists,
+	}
+}
+
+// ToMatchingListStickyTaskListsResponse converts thrift ListStickyTaskListsResponse type to internal
+func ToMatchingListStickyTaskListsResponse(t *matching.ListStickyTaskListsResponse) *types.MatchingListStickyTaskListsResponse {
+	if t == nil {
+		return nil
+	}
+	var taskLists []*types.MatchingStickyTaskList
+	for _, taskList := range t.TaskLists {
+		taskLists = append(taskLists, ToMatchingStickyTaskList(taskList))
+	}
+	return &types.MatchingListStickyTaskListsResponse{
+		TaskLists: taskLists,
+	}
+}
+
 // FromMatchingListTaskListPartitionsRequest converts internal ListTaskListPartitionsRequest type to thrift
 func FromMatchingListTaskListPartitionsRequest(t *types.MatchingListTaskListPartitionsRequest) *matching.ListTaskListPartitionsRequest {
 	if t == nil {
@@ -202,10 +396,13 @@ func FromMatchingPollForActivityTaskRequest(t *types.MatchingPollForActivityTask
 		return nil
 	}
 	return &matching.PollForActivityTaskRequest{
-		DomainUUID:    &t.DomainUUID,
-		PollerID:      &t.PollerID,
-		PollRequest:   FromPollForActivityTaskRequest(t.PollRequest),
-		ForwardedFrom: &t.ForwardedFrom,
+		DomainUUID:     &t.DomainUUID,
+		PollerID:       &t.PollerID,
+		PollRequest:    FromPollForActivityTaskRequest(t.PollRequest),
+		ForwardedFrom:  &t.ForwardedFrom,
+		PollerLabels:   t.PollerLabels,
+		PollerCapacity: &t.PollerCapacity,
+		IsolationGroup: &t.IsolationGroup,
 	}
 }
 
@@ -215,10 +412,13 @@ func ToMatchingPollForActivityTaskRequest(t *matching.PollForActivityTaskRequest
 		return nil
 	}
 	return &types.MatchingPollForActivityTaskRequest{
-		DomainUUID:    t.GetDomainUUID(),
-		PollerID:      t.GetPollerID(),
-		PollRequest:   ToPollForActivityTaskRequest(t.PollRequest),
-		ForwardedFrom: t.GetForwardedFrom(),
+		DomainUUID:     t.GetDomainUUID(),
+		PollerID:       t.GetPollerID(),
+		PollRequest:    ToPollForActivityTaskRequest(t.PollRequest),
+		ForwardedFrom:  t.GetForwardedFrom(),
+		PollerLabels:   t.PollerLabels,
+		PollerCapacity: t.GetPollerCapacity(),
+		IsolationGroup: t.GetIsolationGroup(),
 	}
 }
 
@@ -228,10 +428,13 @@ func FromMatchingPollForDecisionTaskRequest(t *types.MatchingPollForDecisionTask
 		return nil
 	}
 	return &matching.PollForDecisionTaskRequest{
-		DomainUUID:    &t.DomainUUID,
-		PollerID:      &t.PollerID,
-		PollRequest:   FromPollForDecisionTaskRequest(t.PollRequest),
-		ForwardedFrom: &t.ForwardedFrom,
+		DomainUUID:     &t.DomainUUID,
+		PollerID:       &t.PollerID,
+		PollRequest:    FromPollForDecisionTaskRequest(t.PollRequest),
+		ForwardedFrom:  &t.ForwardedFrom,
+		PollerLabels:   t.PollerLabels,
+		PollerCapacity: &t.PollerCapacity,
+		IsolationGroup: &t.IsolationGroup,
 	}
 }
 
@@ -241,10 +444,13 @@ func ToMatchingPollForDecisionTaskRequest(t *matching.PollForDecisionTaskRequest
 		return nil
 	}
 	return &types.MatchingPollForDecisionTaskRequest{
-		DomainUUID:    t.GetDomainUUID(),
-		PollerID:      t.GetPollerID(),
-		PollRequest:   ToPollForDecisionTaskRequest(t.PollRequest),
-		ForwardedFrom: t.GetForwardedFrom(),
+		DomainUUID:     t.GetDomainUUID(),
+		PollerID:       t.GetPollerID(),
+		PollRequest:    ToPollForDecisionTaskRequest(t.PollRequest),
+		ForwardedFrom:  t.GetForwardedFrom(),
+		PollerLabels:   t.PollerLabels,
+		PollerCapacity: t.GetPollerCapacity(),
+		IsolationGroup: t.GetIsolationGroup(),
 	}
 }
 
@@ -332,6 +332,36 @@ func ToArchivalStatus(t *shared.ArchivalStatus) *types.ArchivalStatus {
 	panic("unexpected enum value")
 }
 
+// FromBackfillScheduleRequest converts internal BackfillScheduleRequest type to thrift
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *shared.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.BackfillScheduleRequest{
+		Domain:        &t.Domain,
+		ScheduleId:    &t.ScheduleID,
+		StartTimeNano: t.StartTimeNano,
+		EndTimeNano:   t.EndTimeNano,
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      &t.Identity,
+	}
+}
+
+// ToBackfillScheduleRequest converts thrift BackfillScheduleRequest type to internal
+func ToBackfillScheduleRequest(t *shared.BackfillScheduleRequest) *types.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillScheduleRequest{
+		Domain:        t.GetDomain(),
+		ScheduleID:    t.GetScheduleId(),
+		StartTimeNano: t.StartTimeNano,
+		EndTimeNano:   t.EndTimeNano,
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.GetIdentity(),
+	}
+}
+
 // FromBadBinaries converts internal BadBinaries type to thrift
 func FromBadBinaries(t *types.BadBinaries) *shared.BadBinaries {
 	if t == nil {
@@ -964,6 +994,38 @@ func ToCountWorkflowExecutionsResponse(t *shared.CountWorkflowExecutionsResponse
 	}
 }
 
+// FromCreateScheduleRequest converts internal CreateScheduleRequest type to thrift
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *shared.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CreateScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToCreateScheduleRequest converts thrift CreateScheduleRequest type to internal
+func ToCreateScheduleRequest(t *shared.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
 // FromCurrentBranchChangedError converts internal CurrentBranchChangedError type to thrift
 func FromCurrentBranchChangedError(t *types.CurrentBranchChangedError) *shared.CurrentBranchChangedError {
 	if t == nil {
@@ -1490,6 +1552,30 @@ func ToDecisionType(t *shared.DecisionType) *types.DecisionType {
 	panic("unexpected enum value")
 }
 
+// FromDeleteScheduleRequest converts internal DeleteScheduleRequest type to thrift
+func FromDeleteScheduleRequest(t *types.DeleteScheduleRequest) *shared.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToDeleteScheduleRequest converts thrift DeleteScheduleRequest type to internal
+func ToDeleteScheduleRequest(t *shared.DeleteScheduleRequest) *types.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
 // FromDeprecateDomainRequest converts internal DeprecateDomainRequest type to thrift
 func FromDeprecateDomainRequest(t *types.DeprecateDomainRequest) *shared.DeprecateDomainRequest {
 	if t == nil {
@@ -1576,6 +1662,70 @@ func FromDescribeHistoryHostRequest(t *types.DescribeHistoryHostRequest) *shared
 	}
 }
 
+// FromDescribeScheduleRequest converts internal DescribeScheduleRequest type to thrift
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *shared.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+	}
+}
+
+// ToDescribeScheduleRequest converts thrift DescribeScheduleRequest type to internal
+func ToDescribeScheduleRequest(t *shared.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+	}
+}
+
+// FromDescribeScheduleResponse converts internal DescribeScheduleResponse type to thrift
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *shared.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleResponse{
+		Spec:                 FromScheduleSpec(t.Spec),
+		Action:               FromScheduleAction(t.Action),
+		Policies:             FromSchedulePolicies(t.Policies),
+		Paused:               &t.Paused,
+		PauseReason:          &t.PauseReason,
+		LastRun:              FromScheduleRunInfo(t.LastRun),
+		RecentRuns:           FromScheduleRunInfoArray(t.RecentRuns),
+		UpcomingRunTimesNano: t.UpcomingRunTimesNano,
+		BufferedRuns:         &t.BufferedRuns,
+		TotalRuns:            &t.TotalRuns,
+		SkippedRuns:          &t.SkippedRuns,
+		FailedRuns:           &t.FailedRuns,
+	}
+}
+
+// ToDescribeScheduleResponse converts thrift DescribeScheduleResponse type to internal
+func ToDescribeScheduleResponse(t *shared.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:                 ToScheduleSpec(t.Spec),
+		Action:               ToScheduleAction(t.Action),
+		Policies:             ToSchedulePolicies(t.Policies),
+		Paused:               t.GetPaused(),
+		PauseReason:          t.GetPauseReason(),
+		LastRun:              ToScheduleRunInfo(t.LastRun),
+		RecentRuns:           ToScheduleRunInfoArray(t.RecentRuns),
+		UpcomingRunTimesNano: t.UpcomingRunTimesNano,
+		BufferedRuns:         t.GetBufferedRuns(),
+		TotalRuns:            t.GetTotalRuns(),
+		SkippedRuns:          t.GetSkippedRuns(),
+		FailedRuns:           t.GetFailedRuns(),
+	}
+}
+
 // FromDescribeShardDistributionRequest converts internal DescribeHistoryHostRequest type to thrift
 func FromDescribeShardDistributionRequest(t *types.DescribeShardDistributionRequest) *shared.DescribeShardDistributionRequest {
 	if t == nil {
@@ -1736,8 +1886,9 @@ func FromDescribeTaskListResponse(t *types.DescribeTaskListResponse) *shared.Des
 		return nil
 	}
 	return &shared.DescribeTaskListResponse{
-		Pollers:        FromPollerInfoArray(t.Pollers),
-		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+		Pollers:         FromPollerInfoArray(t.Pollers),
+		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig: FromTaskListPartitionConfig(t.PartitionConfig),
 	}
 }
 
@@ -1747,8 +1898,9 @@ func ToDescribeTaskListResponse(t *shared.DescribeTaskListResponse) *types.Descr
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:        ToPollerInfoArray(t.Pollers),
-		TaskListStatus: ToTaskListStatus(t.TaskListStatus),
+		Pollers:         ToPollerInfoArray(t.Pollers),
+		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
+		PartitionConfig: ToTaskListPartitionConfig(t.PartitionConfig),
 	}
 }
 
@@ -2228,6 +2380,27 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeWorkflowExecutionPaused:
+		v := shared.EventTypeWorkflowExecutionPaused
+		return &v
+	case types.EventTypeWorkflowExecutionUnpaused:
+		v := shared.EventTypeWorkflowExecutionUnpaused
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateRequested:
+		v := shared.EventTypeWorkflowExecutionUpdateRequested
+		return &v
+	case types.EventTypeWorkflowHistoryCompacted:
+		v := shared.EventTypeWorkflowHistoryCompacted
+		return &v
+	case types.EventTypeWorkflowExecutionInactive:
+		v := shared.EventTypeWorkflowExecutionInactive
+		return &v
+	case types.EventTypeWorkflowSearchAttributesReapplied:
+		v := shared.EventTypeWorkflowSearchAttributesReapplied
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		v := shared.EventTypeWorkflowExecutionUpdateCompleted
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2364,6 +2537,27 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeWorkflowExecutionPaused:
+		v := types.EventTypeWorkflowExecutionPaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUnpaused:
+		v := types.EventTypeWorkflowExecutionUnpaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateRequested:
+		v := types.EventTypeWorkflowExecutionUpdateRequested
+		return &v
+	case shared.EventTypeWorkflowHistoryCompacted:
+		v := types.EventTypeWorkflowHistoryCompacted
+		return &v
+	case shared.EventTypeWorkflowExecutionInactive:
+		v := types.EventTypeWorkflowExecutionInactive
+		return &v
+	case shared.EventTypeWorkflowSearchAttributesReapplied:
+		v := types.EventTypeWorkflowSearchAttributesReapplied
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateCompleted:
+		v := types.EventTypeWorkflowExecutionUpdateCompleted
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2563,10 +2757,16 @@ func FromHistoryBranch(t *types.HistoryBranch) *shared.HistoryBranch {
 	if t == nil {
 		return nil
 	}
+	var compactionNodeID *int64
+	// only set for compacted branches, so branch tokens of other branches stay unchanged
+	if t.CompactionNodeID != 0 {
+		compactionNodeID = &t.CompactionNodeID
+	}
 	return &shared.HistoryBranch{
-		TreeID:    &t.TreeID,
-		BranchID:  &t.BranchID,
-		Ancestors: FromHistoryBranchRangeArray(t.Ancestors),
+		TreeID:           &t.TreeID,
+		BranchID:         &t.BranchID,
+		Ancestors:        FromHistoryBranchRangeArray(t.Ancestors),
+		CompactionNodeID: compactionNodeID,
 	}
 }
 
@@ -2576,9 +2776,10 @@ func ToHistoryBranch(t *shared.HistoryBranch) *types.HistoryBranch {
 		return nil
 	}
 	return &types.HistoryBranch{
-		TreeID:    *t.TreeID,
-		BranchID:  *t.BranchID,
-		Ancestors: ToHistoryBranchRangeArray(t.Ancestors),
+		TreeID:           *t.TreeID,
+		BranchID:         *t.BranchID,
+		Ancestors:        ToHistoryBranchRangeArray(t.Ancestors),
+		CompactionNodeID: t.GetCompactionNodeID(),
 	}
 }
 
@@ -2659,6 +2860,13 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         FromWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       FromWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
+		WorkflowExecutionUpdateRequestedEventAttributes:                FromWorkflowExecutionUpdateRequestedEventAttributes(t.WorkflowExecutionUpdateRequestedEventAttributes),
+		WorkflowHistoryCompactedEventAttributes:                        FromWorkflowHistoryCompactedEventAttributes(t.WorkflowHistoryCompactedEventAttributes),
+		WorkflowExecutionInactiveEventAttributes:                       FromWorkflowExecutionInactiveEventAttributes(t.WorkflowExecutionInactiveEventAttributes),
+		WorkflowSearchAttributesReappliedEventAttributes:               FromWorkflowSearchAttributesReappliedEventAttributes(t.WorkflowSearchAttributesReappliedEventAttributes),
+		WorkflowExecutionUpdateCompletedEventAttributes:                FromWorkflowExecutionUpdateCompletedEventAttributes(t.WorkflowExecutionUpdateCompletedEventAttributes),
 	}
 }
 
@@ -2715,6 +2923,13 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         ToWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       ToWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
+		WorkflowExecutionUpdateRequestedEventAttributes:                ToWorkflowExecutionUpdateRequestedEventAttributes(t.WorkflowExecutionUpdateRequestedEventAttributes),
+		WorkflowHistoryCompactedEventAttributes:                        ToWorkflowHistoryCompactedEventAttributes(t.WorkflowHistoryCompactedEventAttributes),
+		WorkflowExecutionInactiveEventAttributes:                       ToWorkflowExecutionInactiveEventAttributes(t.WorkflowExecutionInactiveEventAttributes),
+		WorkflowSearchAttributesReappliedEventAttributes:               ToWorkflowSearchAttributesReappliedEventAttributes(t.WorkflowSearchAttributesReappliedEventAttributes),
+		WorkflowExecutionUpdateCompletedEventAttributes:                ToWorkflowExecutionUpdateCompletedEventAttributes(t.WorkflowExecutionUpdateCompletedEventAttributes),
 	}
 }
 
@@ -2750,6 +2965,38 @@ func ToHistoryEventFilterType(t *shared.HistoryEventFilterType) *types.HistoryEv
 	panic("unexpected enum value")
 }
 
+// FromInactivityTimeoutPolicy converts internal InactivityTimeoutPolicy type to thrift
+func FromInactivityTimeoutPolicy(t *types.InactivityTimeoutPolicy) *shared.InactivityTimeoutPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.InactivityTimeoutPolicyFail:
+		v := shared.InactivityTimeoutPolicyFail
+		return &v
+	case types.InactivityTimeoutPolicyNotify:
+		v := shared.InactivityTimeoutPolicyNotify
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToInactivityTimeoutPolicy converts thrift InactivityTimeoutPolicy type to internal
+func ToInactivityTimeoutPolicy(t *shared.InactivityTimeoutPolicy) *types.InactivityTimeoutPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.InactivityTimeoutPolicyFail:
+		v := types.InactivityTimeoutPolicyFail
+		return &v
+	case shared.InactivityTimeoutPolicyNotify:
+		v := types.InactivityTimeoutPolicyNotify
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromIndexedValueType converts internal IndexedValueType type to thrift
 func FromIndexedValueType(t types.IndexedValueType) shared.IndexedValueType {
 
@@ -3048,6 +3295,52 @@ func ToListOpenWorkflowExecutionsResponse(t *shared.ListOpenWorkflowExecutionsRe
 	}
 }
 
+// FromListSchedulesRequest converts internal ListSchedulesRequest type to thrift
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *shared.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesRequest{
+		Domain:        &t.Domain,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesRequest converts thrift ListSchedulesRequest type to internal
+func ToListSchedulesRequest(t *shared.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListSchedulesResponse converts internal ListSchedulesResponse type to thrift
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *shared.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesResponse{
+		ScheduleIds:   t.ScheduleIDs,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesResponse converts thrift ListSchedulesResponse type to internal
+func ToListSchedulesResponse(t *shared.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		ScheduleIDs:   t.ScheduleIds,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
 // FromListTaskListPartitionsRequest converts internal ListTaskListPartitionsRequest type to thrift
 func FromListTaskListPartitionsRequest(t *types.ListTaskListPartitionsRequest) *shared.ListTaskListPartitionsRequest {
 	if t == nil {
@@ -3292,6 +3585,58 @@ func ToParentClosePolicy(t *shared.ParentClosePolicy) *types.ParentClosePolicy {
 	panic("unexpected enum value")
 }
 
+// FromPauseScheduleRequest converts internal PauseScheduleRequest type to thrift
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *shared.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Reason:     &t.Reason,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToPauseScheduleRequest converts thrift PauseScheduleRequest type to internal
+func ToPauseScheduleRequest(t *shared.PauseScheduleRequest) *types.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Reason:     t.GetReason(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *shared.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToPauseWorkflowExecutionRequest(t *shared.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromPendingActivityInfo converts internal PendingActivityInfo type to thrift
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivityInfo {
 	if t == nil {
@@ -3311,6 +3656,7 @@ func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivi
 		LastFailureReason:      t.LastFailureReason,
 		LastWorkerIdentity:     &t.LastWorkerIdentity,
 		LastFailureDetails:     t.LastFailureDetails,
+		AttemptLog:             FromActivityAttemptInfoArray(t.AttemptLog),
 	}
 }
 
@@ -3333,6 +3679,43 @@ func ToPendingActivityInfo(t *shared.PendingActivityInfo) *types.PendingActivity
 		LastFailureReason:      t.LastFailureReason,
 		LastWorkerIdentity:     t.GetLastWorkerIdentity(),
 		LastFailureDetails:     t.LastFailureDetails,
+		AttemptLog:             ToActivityAttemptInfoArray(t.AttemptLog),
+	}
+}
+
+// FromActivityAttemptInfo converts internal ActivityAttemptInfo type to thrift
+func FromActivityAttemptInfo(t *types.ActivityAttemptInfo) *shared.ActivityAttemptInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityAttemptInfo{
+		Attempt:                   &t.Attempt,
+		StartedTimestamp:          &t.StartedTimestamp,
+		FinishedTimestamp:         &t.FinishedTimestamp,
+		Identity:                  &t.Identity,
+		FailureReason:             &t.FailureReason,
+		FailureDetails:            t.FailureDetails,
+		HeartbeatDetails:          t.HeartbeatDetails,
+		FailureDetailsTruncated:   &t.FailureDetailsTruncated,
+		HeartbeatDetailsTruncated: &t.HeartbeatDetailsTruncated,
+	}
+}
+
+// ToActivityAttemptInfo converts thrift ActivityAttemptInfo type to internal
+func ToActivityAttemptInfo(t *shared.ActivityAttemptInfo) *types.ActivityAttemptInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityAttemptInfo{
+		Attempt:                   t.GetAttempt(),
+		StartedTimestamp:          t.GetStartedTimestamp(),
+		FinishedTimestamp:         t.GetFinishedTimestamp(),
+		Identity:                  t.GetIdentity(),
+		FailureReason:             t.GetFailureReason(),
+		FailureDetails:            t.FailureDetails,
+		HeartbeatDetails:          t.HeartbeatDetails,
+		FailureDetailsTruncated:   t.GetFailureDetailsTruncated(),
+		HeartbeatDetailsTruncated: t.GetHeartbeatDetailsTruncated(),
 	}
 }
 
@@ -4362,6 +4745,68 @@ func ToResetStickyTaskListResponse(t *shared.ResetStickyTaskListResponse) *types
 	return &types.ResetStickyTaskListResponse{}
 }
 
+// FromResetChildPolicy converts internal ResetChildPolicy type to thrift
+func FromResetChildPolicy(t *types.ResetChildPolicy) *shared.ResetChildPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.ResetChildPolicyDefault:
+		v := shared.ResetChildPolicyDefault
+		return &v
+	case types.ResetChildPolicyReattach:
+		v := shared.ResetChildPolicyReattach
+		return &v
+	case types.ResetChildPolicyReattachAndTerminate:
+		v := shared.ResetChildPolicyReattachAndTerminate
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToResetChildPolicy converts thrift ResetChildPolicy type to internal
+func ToResetChildPolicy(t *shared.ResetChildPolicy) *types.ResetChildPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.ResetChildPolicyDefault:
+		v := types.ResetChildPolicyDefault
+		return &v
+	case shared.ResetChildPolicyReattach:
+		v := types.ResetChildPolicyReattach
+		return &v
+	case shared.ResetChildPolicyReattachAndTerminate:
+		v := types.ResetChildPolicyReattachAndTerminate
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromResetReapplyPolicy converts internal ResetReapplyPolicy type to thrift
+func FromResetReapplyPolicy(t *types.ResetReapplyPolicy) *shared.ResetReapplyPolicy {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetReapplyPolicy{
+		ReapplySignals:                &t.ReapplySignals,
+		ReapplyUpsertSearchAttributes: &t.ReapplyUpsertSearchAttributes,
+		ReapplyCancelRequests:         &t.ReapplyCancelRequests,
+	}
+}
+
+// ToResetReapplyPolicy converts thrift ResetReapplyPolicy type to internal
+func ToResetReapplyPolicy(t *shared.ResetReapplyPolicy) *types.ResetReapplyPolicy {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetReapplyPolicy{
+		ReapplySignals:                t.GetReapplySignals(),
+		ReapplyUpsertSearchAttributes: t.GetReapplyUpsertSearchAttributes(),
+		ReapplyCancelRequests:         t.GetReapplyCancelRequests(),
+	}
+}
+
 // FromResetWorkflowExecutionRequest converts internal ResetWorkflowExecutionRequest type to thrift
 func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionRequest {
 	if t == nil {
@@ -4374,6 +4819,8 @@ func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *
 		DecisionFinishEventId: &t.DecisionFinishEventID,
 		RequestId:             &t.RequestID,
 		SkipSignalReapply:     &t.SkipSignalReapply,
+		ResetChildPolicy:      FromResetChildPolicy(t.ResetChildPolicy),
+		ReapplyPolicy:         FromResetReapplyPolicy(t.ReapplyPolicy),
 	}
 }
 
@@ -4389,6 +4836,8 @@ func ToResetWorkflowExecutionRequest(t *shared.ResetWorkflowExecutionRequest) *t
 		DecisionFinishEventID: t.GetDecisionFinishEventId(),
 		RequestID:             t.GetRequestId(),
 		SkipSignalReapply:     t.GetSkipSignalReapply(),
+		ResetChildPolicy:      ToResetChildPolicy(t.ResetChildPolicy),
+		ReapplyPolicy:         ToResetReapplyPolicy(t.ReapplyPolicy),
 	}
 }
 
@@ -4593,6 +5042,7 @@ func FromRespondDecisionTaskCompletedRequest(t *types.RespondDecisionTaskComplet
 		ForceCreateNewDecisionTask: &t.ForceCreateNewDecisionTask,
 		BinaryChecksum:             &t.BinaryChecksum,
 		QueryResults:               FromWorkflowQueryResultMap(t.QueryResults),
+		UpdateResults:              FromWorkflowUpdateResultMap(t.UpdateResults),
 	}
 }
 
@@ -4611,6 +5061,7 @@ func ToRespondDecisionTaskCompletedRequest(t *shared.RespondDecisionTaskComplete
 		ForceCreateNewDecisionTask: t.GetForceCreateNewDecisionTask(),
 		BinaryChecksum:             t.GetBinaryChecksum(),
 		QueryResults:               ToWorkflowQueryResultMap(t.QueryResults),
+		UpdateResults:              ToWorkflowUpdateResultMap(t.UpdateResults),
 	}
 }
 
@@ -4756,6 +5207,36 @@ func ToRetryTaskV2Error(t *shared.RetryTaskV2Error) *types.RetryTaskV2Error {
 	}
 }
 
+// FromScheduleAction converts internal ScheduleAction type to thrift
+func FromScheduleAction(t *types.ScheduleAction) *shared.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleAction{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: &t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      &t.TaskStartToCloseTimeoutSeconds,
+		WorkflowIdPrefix:                    &t.WorkflowIDPrefix,
+	}
+}
+
+// ToScheduleAction converts thrift ScheduleAction type to internal
+func ToScheduleAction(t *shared.ScheduleAction) *types.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		WorkflowIDPrefix:                    t.GetWorkflowIdPrefix(),
+	}
+}
+
 // FromScheduleActivityTaskDecisionAttributes converts internal ScheduleActivityTaskDecisionAttributes type to thrift
 func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDecisionAttributes) *shared.ScheduleActivityTaskDecisionAttributes {
 	if t == nil {
@@ -4798,23 +5279,133 @@ func ToScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDeci
 	}
 }
 
-// FromSearchAttributes converts internal SearchAttributes type to thrift
-func FromSearchAttributes(t *types.SearchAttributes) *shared.SearchAttributes {
+// FromScheduleOverlapPolicy converts internal ScheduleOverlapPolicy type to thrift
+func FromScheduleOverlapPolicy(t *types.ScheduleOverlapPolicy) *shared.ScheduleOverlapPolicy {
 	if t == nil {
 		return nil
 	}
-	return &shared.SearchAttributes{
-		IndexedFields: t.IndexedFields,
+	switch *t {
+	case types.ScheduleOverlapPolicySkip:
+		v := shared.ScheduleOverlapPolicySkip
+		return &v
+	case types.ScheduleOverlapPolicyBuffer:
+		v := shared.ScheduleOverlapPolicyBuffer
+		return &v
+	case types.ScheduleOverlapPolicyCancelPrevious:
+		v := shared.ScheduleOverlapPolicyCancelPrevious
+		return &v
 	}
+	panic("unexpected enum value")
 }
 
-// ToSearchAttributes converts thrift SearchAttributes type to internal
-func ToSearchAttributes(t *shared.SearchAttributes) *types.SearchAttributes {
+// ToScheduleOverlapPolicy converts thrift ScheduleOverlapPolicy type to internal
+func ToScheduleOverlapPolicy(t *shared.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
 	if t == nil {
 		return nil
 	}
-	return &types.SearchAttributes{
-		IndexedFields: t.IndexedFields,
+	switch *t {
+	case shared.ScheduleOverlapPolicySkip:
+		v := types.ScheduleOverlapPolicySkip
+		return &v
+	case shared.ScheduleOverlapPolicyBuffer:
+		v := types.ScheduleOverlapPolicyBuffer
+		return &v
+	case shared.ScheduleOverlapPolicyCancelPrevious:
+		v := types.ScheduleOverlapPolicyCancelPrevious
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromSchedulePolicies converts internal SchedulePolicies type to thrift
+func FromSchedulePolicies(t *types.SchedulePolicies) *shared.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &shared.SchedulePolicies{
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+// ToSchedulePolicies converts thrift SchedulePolicies type to internal
+func ToSchedulePolicies(t *shared.SchedulePolicies) *types.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &types.SchedulePolicies{
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+// FromScheduleRunInfo converts internal ScheduleRunInfo type to thrift
+func FromScheduleRunInfo(t *types.ScheduleRunInfo) *shared.ScheduleRunInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleRunInfo{
+		ScheduledTimeNano: t.ScheduledTimeNano,
+		StartTimeNano:     t.StartTimeNano,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToScheduleRunInfo converts thrift ScheduleRunInfo type to internal
+func ToScheduleRunInfo(t *shared.ScheduleRunInfo) *types.ScheduleRunInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleRunInfo{
+		ScheduledTimeNano: t.ScheduledTimeNano,
+		StartTimeNano:     t.StartTimeNano,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// FromScheduleSpec converts internal ScheduleSpec type to thrift
+func FromScheduleSpec(t *types.ScheduleSpec) *shared.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleSpec{
+		CronExpression:  &t.CronExpression,
+		TimeZone:        &t.TimeZone,
+		JitterInSeconds: &t.JitterInSeconds,
+		StartTimeNano:   t.StartTimeNano,
+		EndTimeNano:     t.EndTimeNano,
+	}
+}
+
+// ToScheduleSpec converts thrift ScheduleSpec type to internal
+func ToScheduleSpec(t *shared.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronExpression:  t.GetCronExpression(),
+		TimeZone:        t.GetTimeZone(),
+		JitterInSeconds: t.GetJitterInSeconds(),
+		StartTimeNano:   t.StartTimeNano,
+		EndTimeNano:     t.EndTimeNano,
+	}
+}
+
+// FromSearchAttributes converts internal SearchAttributes type to thrift
+func FromSearchAttributes(t *types.SearchAttributes) *shared.SearchAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.SearchAttributes{
+		IndexedFields: t.IndexedFields,
+	}
+}
+
+// ToSearchAttributes converts thrift SearchAttributes type to internal
+func ToSearchAttributes(t *shared.SearchAttributes) *types.SearchAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.SearchAttributes{
+		IndexedFields: t.IndexedFields,
 	}
 }
 
@@ -4980,6 +5571,9 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
+		DelayStartSeconds:                   t.DelayStartSeconds,
+		InactivityTimeoutSeconds:            t.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:             FromInactivityTimeoutPolicy(t.InactivityTimeoutPolicy),
 	}
 }
 
@@ -5007,6 +5601,9 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
+		DelayStartSeconds:                   t.DelayStartSeconds,
+		InactivityTimeoutSeconds:            t.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:             ToInactivityTimeoutPolicy(t.InactivityTimeoutPolicy),
 	}
 }
 
@@ -5238,6 +5835,8 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		InactivityTimeoutSeconds:            t.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:             FromInactivityTimeoutPolicy(t.InactivityTimeoutPolicy),
 	}
 }
 
@@ -5263,6 +5862,8 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		InactivityTimeoutSeconds:            t.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:             ToInactivityTimeoutPolicy(t.InactivityTimeoutPolicy),
 	}
 }
 
@@ -5448,6 +6049,54 @@ func ToTaskListPartitionMetadata(t *shared.TaskListPartitionMetadata) *types.Tas
 	}
 }
 
+// FromTaskListPartitionConfig converts internal TaskListPartitionConfig type to thrift
+func FromTaskListPartitionConfig(t *types.TaskListPartitionConfig) *shared.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	var decisions []*shared.TaskListPartitionScalingDecision
+	for _, decision := range t.ScalingDecisions {
+		if decision == nil {
+			continue
+		}
+		decisions = append(decisions, &shared.TaskListPartitionScalingDecision{
+			Timestamp:          &decision.Timestamp,
+			NumReadPartitions:  &decision.NumReadPartitions,
+			NumWritePartitions: &decision.NumWritePartitions,
+			Reason:             &decision.Reason,
+		})
+	}
+	return &shared.TaskListPartitionConfig{
+		NumReadPartitions:  &t.NumReadPartitions,
+		NumWritePartitions: &t.NumWritePartitions,
+		ScalingDecisions:   decisions,
+	}
+}
+
+// ToTaskListPartitionConfig converts thrift TaskListPartitionConfig type to internal
+func ToTaskListPartitionConfig(t *shared.TaskListPartitionConfig) *types.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	var decisions []*types.TaskListPartitionScalingDecision
+	for _, decision := range t.ScalingDecisions {
+		if decision == nil {
+			continue
+		}
+		decisions = append(decisions, &types.TaskListPartitionScalingDecision{
+			Timestamp:          decision.GetTimestamp(),
+			NumReadPartitions:  decision.GetNumReadPartitions(),
+			NumWritePartitions: decision.GetNumWritePartitions(),
+			Reason:             decision.GetReason(),
+		})
+	}
+	return &types.TaskListPartitionConfig{
+		NumReadPartitions:  t.GetNumReadPartitions(),
+		NumWritePartitions: t.GetNumWritePartitions(),
+		ScalingDecisions:   decisions,
+	}
+}
+
 // FromTaskListStatus converts internal TaskListStatus type to thrift
 func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 	if t == nil {
@@ -5674,6 +6323,56 @@ func ToTransientDecisionInfo(t *shared.TransientDecisionInfo) *types.TransientDe
 	}
 }
 
+// FromUnpauseScheduleRequest converts internal UnpauseScheduleRequest type to thrift
+func FromUnpauseScheduleRequest(t *types.UnpauseScheduleRequest) *shared.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToUnpauseScheduleRequest converts thrift UnpauseScheduleRequest type to internal
+func ToUnpauseScheduleRequest(t *shared.UnpauseScheduleRequest) *types.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *shared.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToUnpauseWorkflowExecutionRequest(t *shared.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromUpdateDomainRequest converts internal UpdateDomainRequest type to thrift
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainRequest {
 	if t == nil {
@@ -5778,6 +6477,88 @@ func ToUpdateDomainResponse(t *shared.UpdateDomainResponse) *types.UpdateDomainR
 	}
 }
 
+// FromUpdateScheduleRequest converts internal UpdateScheduleRequest type to thrift
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *shared.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   &t.Identity,
+	}
+}
+
+// ToUpdateScheduleRequest converts thrift UpdateScheduleRequest type to internal
+func ToUpdateScheduleRequest(t *shared.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromUpdateWorkflowExecutionRequest converts internal UpdateWorkflowExecutionRequest type to thrift
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateName:        &t.UpdateName,
+		Input:             t.Input,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToUpdateWorkflowExecutionRequest(t *shared.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		UpdateName:        t.GetUpdateName(),
+		Input:             t.Input,
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromUpdateWorkflowExecutionResponse converts internal UpdateWorkflowExecutionResponse type to thrift
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *shared.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionResponse{
+		Result:       t.Result,
+		ErrorMessage: &t.ErrorMessage,
+	}
+}
+
+// ToUpdateWorkflowExecutionResponse converts thrift UpdateWorkflowExecutionResponse type to internal
+func ToUpdateWorkflowExecutionResponse(t *shared.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result:       t.Result,
+		ErrorMessage: t.GetErrorMessage(),
+	}
+}
+
 // FromUpsertWorkflowSearchAttributesDecisionAttributes converts internal UpsertWorkflowSearchAttributesDecisionAttributes type to thrift
 func FromUpsertWorkflowSearchAttributesDecisionAttributes(t *types.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.UpsertWorkflowSearchAttributesDecisionAttributes {
 	if t == nil {
@@ -6198,6 +6979,30 @@ func ToWorkflowExecutionFilter(t *shared.WorkflowExecutionFilter) *types.Workflo
 	}
 }
 
+// FromWorkflowExecutionInactiveEventAttributes converts internal WorkflowExecutionInactiveEventAttributes type to thrift
+func FromWorkflowExecutionInactiveEventAttributes(t *types.WorkflowExecutionInactiveEventAttributes) *shared.WorkflowExecutionInactiveEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionInactiveEventAttributes{
+		InactivityTimeoutSeconds:         &t.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:          FromInactivityTimeoutPolicy(t.InactivityTimeoutPolicy),
+		LastDecisionTaskCompletedEventId: &t.LastDecisionTaskCompletedEventID,
+	}
+}
+
+// ToWorkflowExecutionInactiveEventAttributes converts thrift WorkflowExecutionInactiveEventAttributes type to internal
+func ToWorkflowExecutionInactiveEventAttributes(t *shared.WorkflowExecutionInactiveEventAttributes) *types.WorkflowExecutionInactiveEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionInactiveEventAttributes{
+		InactivityTimeoutSeconds:         t.GetInactivityTimeoutSeconds(),
+		InactivityTimeoutPolicy:          ToInactivityTimeoutPolicy(t.InactivityTimeoutPolicy),
+		LastDecisionTaskCompletedEventID: t.GetLastDecisionTaskCompletedEventId(),
+	}
+}
+
 // FromWorkflowExecutionInfo converts internal WorkflowExecutionInfo type to thrift
 func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *shared.WorkflowExecutionInfo {
 	if t == nil {
@@ -6218,6 +7023,7 @@ func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *shared.WorkflowE
 		AutoResetPoints:  FromResetPoints(t.AutoResetPoints),
 		TaskList:         &t.TaskList,
 		IsCron:           &t.IsCron,
+		IsPaused:         &t.IsPaused,
 	}
 }
 
@@ -6241,6 +7047,29 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 		AutoResetPoints:  ToResetPoints(t.AutoResetPoints),
 		TaskList:         t.GetTaskList(),
 		IsCron:           t.GetIsCron(),
+		IsPaused:         t.GetIsPaused(),
+	}
+}
+
+// FromWorkflowExecutionPausedEventAttributes converts internal WorkflowExecutionPausedEventAttributes type to thrift
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *shared.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionPausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionPausedEventAttributes converts thrift WorkflowExecutionPausedEventAttributes type to internal
+func ToWorkflowExecutionPausedEventAttributes(t *shared.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
 	}
 }
 
@@ -6299,6 +7128,8 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 FromResetPoints(t.PrevAutoResetPoints),
 		Header:                              FromHeader(t.Header),
+		InactivityTimeoutSeconds:            t.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:             FromInactivityTimeoutPolicy(t.InactivityTimeoutPolicy),
 	}
 }
 
@@ -6333,6 +7164,8 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		InactivityTimeoutSeconds:            t.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:             ToInactivityTimeoutPolicy(t.InactivityTimeoutPolicy),
 	}
 }
 
@@ -6360,6 +7193,108 @@ func ToWorkflowExecutionTerminatedEventAttributes(t *shared.WorkflowExecutionTer
 	}
 }
 
+// FromWorkflowExecutionUnpausedEventAttributes converts internal WorkflowExecutionUnpausedEventAttributes type to thrift
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *shared.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionUnpausedEventAttributes converts thrift WorkflowExecutionUnpausedEventAttributes type to internal
+func ToWorkflowExecutionUnpausedEventAttributes(t *shared.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// FromWorkflowExecutionUpdateRequestedEventAttributes converts internal WorkflowExecutionUpdateRequestedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateRequestedEventAttributes(t *types.WorkflowExecutionUpdateRequestedEventAttributes) *shared.WorkflowExecutionUpdateRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateId:   &t.UpdateID,
+		UpdateName: &t.UpdateName,
+		Input:      t.Input,
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToWorkflowExecutionUpdateRequestedEventAttributes converts thrift WorkflowExecutionUpdateRequestedEventAttributes type to internal
+func ToWorkflowExecutionUpdateRequestedEventAttributes(t *shared.WorkflowExecutionUpdateRequestedEventAttributes) *types.WorkflowExecutionUpdateRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   t.GetUpdateId(),
+		UpdateName: t.GetUpdateName(),
+		Input:      t.Input,
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
+// FromWorkflowExecutionUpdateCompletedEventAttributes converts internal WorkflowExecutionUpdateCompletedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateCompletedEventAttributes(t *types.WorkflowExecutionUpdateCompletedEventAttributes) *shared.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateId:                     &t.UpdateID,
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		Result:                       t.Result,
+		ErrorMessage:                 &t.ErrorMessage,
+	}
+}
+
+// ToWorkflowExecutionUpdateCompletedEventAttributes converts thrift WorkflowExecutionUpdateCompletedEventAttributes type to internal
+func ToWorkflowExecutionUpdateCompletedEventAttributes(t *shared.WorkflowExecutionUpdateCompletedEventAttributes) *types.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     t.GetUpdateId(),
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		Result:                       t.Result,
+		ErrorMessage:                 t.GetErrorMessage(),
+	}
+}
+
+// FromWorkflowHistoryCompactedEventAttributes converts internal WorkflowHistoryCompactedEventAttributes type to thrift
+func FromWorkflowHistoryCompactedEventAttributes(t *types.WorkflowHistoryCompactedEventAttributes) *shared.WorkflowHistoryCompactedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowHistoryCompactedEventAttributes{
+		MarkerName:           &t.MarkerName,
+		MarkerDetails:        t.MarkerDetails,
+		MarkerEventId:        &t.MarkerEventID,
+		MutableStateSnapshot: FromDataBlob(t.MutableStateSnapshot),
+	}
+}
+
+// ToWorkflowHistoryCompactedEventAttributes converts thrift WorkflowHistoryCompactedEventAttributes type to internal
+func ToWorkflowHistoryCompactedEventAttributes(t *shared.WorkflowHistoryCompactedEventAttributes) *types.WorkflowHistoryCompactedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowHistoryCompactedEventAttributes{
+		MarkerName:           t.GetMarkerName(),
+		MarkerDetails:        t.MarkerDetails,
+		MarkerEventID:        t.GetMarkerEventId(),
+		MutableStateSnapshot: ToDataBlob(t.MutableStateSnapshot),
+	}
+}
+
 // FromWorkflowExecutionTimedOutEventAttributes converts internal WorkflowExecutionTimedOutEventAttributes type to thrift
 func FromWorkflowExecutionTimedOutEventAttributes(t *types.WorkflowExecutionTimedOutEventAttributes) *shared.WorkflowExecutionTimedOutEventAttributes {
 	if t == nil {
@@ -6470,6 +7405,76 @@ func ToWorkflowQueryResult(t *shared.WorkflowQueryResult) *types.WorkflowQueryRe
 	}
 }
 
+// FromWorkflowSearchAttributesReappliedEventAttributes converts internal WorkflowSearchAttributesReappliedEventAttributes type to thrift
+func FromWorkflowSearchAttributesReappliedEventAttributes(t *types.WorkflowSearchAttributesReappliedEventAttributes) *shared.WorkflowSearchAttributesReappliedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowSearchAttributesReappliedEventAttributes{
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// ToWorkflowSearchAttributesReappliedEventAttributes converts thrift WorkflowSearchAttributesReappliedEventAttributes type to internal
+func ToWorkflowSearchAttributesReappliedEventAttributes(t *shared.WorkflowSearchAttributesReappliedEventAttributes) *types.WorkflowSearchAttributesReappliedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowSearchAttributesReappliedEventAttributes{
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// FromWorkflowUpdateInfo converts internal WorkflowUpdateInfo type to thrift
+func FromWorkflowUpdateInfo(t *types.WorkflowUpdateInfo) *shared.WorkflowUpdateInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowUpdateInfo{
+		UpdateId:                     &t.UpdateID,
+		AfterEventId:                 &t.AfterEventID,
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		CompletedEventId:             &t.CompletedEventID,
+	}
+}
+
+// ToWorkflowUpdateInfo converts thrift WorkflowUpdateInfo type to internal
+func ToWorkflowUpdateInfo(t *shared.WorkflowUpdateInfo) *types.WorkflowUpdateInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdateInfo{
+		UpdateID:                     t.GetUpdateId(),
+		AfterEventID:                 t.GetAfterEventId(),
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		CompletedEventID:             t.GetCompletedEventId(),
+	}
+}
+
+// FromWorkflowUpdateResult converts internal WorkflowUpdateResult type to thrift
+func FromWorkflowUpdateResult(t *types.WorkflowUpdateResult) *shared.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowUpdateResult{
+		ResultType:   FromQueryResultType(t.ResultType),
+		Result:       t.Result,
+		ErrorMessage: &t.ErrorMessage,
+	}
+}
+
+// ToWorkflowUpdateResult converts thrift WorkflowUpdateResult type to internal
+func ToWorkflowUpdateResult(t *shared.WorkflowUpdateResult) *types.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdateResult{
+		ResultType:   ToQueryResultType(t.ResultType),
+		Result:       t.Result,
+		ErrorMessage: t.GetErrorMessage(),
+	}
+}
+
 // FromWorkflowType converts internal WorkflowType type to thrift
 func FromWorkflowType(t *types.WorkflowType) *shared.WorkflowType {
 	if t == nil {
@@ -6534,6 +7539,78 @@ func ToPendingActivityInfoArray(t []*shared.PendingActivityInfo) []*types.Pendin
 	return v
 }
 
+// FromActivityAttemptInfoArray converts internal ActivityAttemptInfo type array to thrift
+func FromActivityAttemptInfoArray(t []*types.ActivityAttemptInfo) []*shared.ActivityAttemptInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ActivityAttemptInfo, len(t))
+	for i := range t {
+		v[i] = FromActivityAttemptInfo(t[i])
+	}
+	return v
+}
+
+// ToActivityAttemptInfoArray converts thrift ActivityAttemptInfo type array to internal
+func ToActivityAttemptInfoArray(t []*shared.ActivityAttemptInfo) []*types.ActivityAttemptInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ActivityAttemptInfo, len(t))
+	for i := range t {
+		v[i] = ToActivityAttemptInfo(t[i])
+	}
+	return v
+}
+
+// FromWorkflowUpdateInfoArray converts internal WorkflowUpdateInfo type array to thrift
+func FromWorkflowUpdateInfoArray(t []*types.WorkflowUpdateInfo) []*shared.WorkflowUpdateInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowUpdateInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkflowUpdateInfo(t[i])
+	}
+	return v
+}
+
+// ToWorkflowUpdateInfoArray converts thrift WorkflowUpdateInfo type array to internal
+func ToWorkflowUpdateInfoArray(t []*shared.WorkflowUpdateInfo) []*types.WorkflowUpdateInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowUpdateInfo, len(t))
+	for i := range t {
+		v[i] = ToWorkflowUpdateInfo(t[i])
+	}
+	return v
+}
+
+// FromScheduleRunInfoArray converts internal ScheduleRunInfo type array to thrift
+func FromScheduleRunInfoArray(t []*types.ScheduleRunInfo) []*shared.ScheduleRunInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ScheduleRunInfo, len(t))
+	for i := range t {
+		v[i] = FromScheduleRunInfo(t[i])
+	}
+	return v
+}
+
+// ToScheduleRunInfoArray converts thrift ScheduleRunInfo type array to internal
+func ToScheduleRunInfoArray(t []*shared.ScheduleRunInfo) []*types.ScheduleRunInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleRunInfo, len(t))
+	for i := range t {
+		v[i] = ToScheduleRunInfo(t[i])
+	}
+	return v
+}
+
 // FromHistoryEventArray converts internal HistoryEvent type array to thrift
 func FromHistoryEventArray(t []*types.HistoryEvent) []*shared.HistoryEvent {
 	if t == nil {
@@ -6918,6 +7995,30 @@ func ToWorkflowQueryResultMap(t map[string]*shared.WorkflowQueryResult) map[stri
 	return v
 }
 
+// FromWorkflowUpdateResultMap converts internal WorkflowUpdateResult type map to thrift
+func FromWorkflowUpdateResultMap(t map[string]*types.WorkflowUpdateResult) map[string]*shared.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*shared.WorkflowUpdateResult, len(t))
+	for key := range t {
+		v[key] = FromWorkflowUpdateResult(t[key])
+	}
+	return v
+}
+
+// ToWorkflowUpdateResultMap converts thrift WorkflowUpdateResult type map to internal
+func ToWorkflowUpdateResultMap(t map[string]*shared.WorkflowUpdateResult) map[string]*types.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.WorkflowUpdateResult, len(t))
+	for key := range t {
+		v[key] = ToWorkflowUpdateResult(t[key])
+	}
+	return v
+}
+
 // FromActivityLocalDispatchInfoMap converts internal ActivityLocalDispatchInfo type map to thrift
 func FromActivityLocalDispatchInfoMap(t map[string]*types.ActivityLocalDispatchInfo) map[string]*shared.ActivityLocalDispatchInfo {
 	if t == nil {
@@ -35,3 +35,21 @@ func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, thrift.ToHistoryTerminateWorkflowExecutionRequest(thrift.FromHistoryTerminateWorkflowExecutionRequest(item)))
 	}
 }
+
+func TestHistoryPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToHistoryPauseWorkflowExecutionRequest(thrift.FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToHistoryUnpauseWorkflowExecutionRequest(thrift.FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowExecutionRequest{nil, {}, &testdata.HistoryUpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToHistoryUpdateWorkflowExecutionRequest(thrift.FromHistoryUpdateWorkflowExecutionRequest(item)))
+	}
+}
@@ -207,3 +207,186 @@ func TestCrossClusterApplyParentClosePolicyResponse(t *testing.T) {
 		),
 	)
 }
+
+func TestWorkflowExecutionPausedHistoryEvents(t *testing.T) {
+	for _, item := range []*types.HistoryEvent{&testdata.HistoryEvent_WorkflowExecutionPaused, &testdata.HistoryEvent_WorkflowExecutionUnpaused} {
+		assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+	}
+}
+
+func TestWorkflowExecutionUpdateRequestedHistoryEvent(t *testing.T) {
+	item := &testdata.HistoryEvent_WorkflowExecutionUpdateRequested
+	assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+}
+
+func TestWorkflowHistoryCompactedHistoryEvent(t *testing.T) {
+	item := &testdata.HistoryEvent_WorkflowHistoryCompacted
+	assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+}
+
+func TestWorkflowExecutionInactiveHistoryEvent(t *testing.T) {
+	item := &testdata.HistoryEvent_WorkflowExecutionInactive
+	assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+}
+
+func TestWorkflowSearchAttributesReappliedHistoryEvent(t *testing.T) {
+	item := &testdata.HistoryEvent_WorkflowSearchAttributesReapplied
+	assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+}
+
+func TestWorkflowExecutionUpdateCompletedHistoryEvent(t *testing.T) {
+	item := &testdata.HistoryEvent_WorkflowExecutionUpdateCompleted
+	assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+}
+
+func TestWorkflowUpdateInfoArray(t *testing.T) {
+	for _, item := range [][]*types.WorkflowUpdateInfo{nil, {}, testdata.WorkflowUpdateInfoArray} {
+		assert.Equal(t, item, thrift.ToWorkflowUpdateInfoArray(thrift.FromWorkflowUpdateInfoArray(item)))
+	}
+}
+
+func TestInactivityTimeoutPolicy(t *testing.T) {
+	for _, item := range []*types.InactivityTimeoutPolicy{nil, types.InactivityTimeoutPolicyFail.Ptr(), types.InactivityTimeoutPolicyNotify.Ptr()} {
+		assert.Equal(t, item, thrift.ToInactivityTimeoutPolicy(thrift.FromInactivityTimeoutPolicy(item)))
+	}
+	assert.Panics(t, func() { thrift.FromInactivityTimeoutPolicy(types.InactivityTimeoutPolicy(999).Ptr()) })
+}
+
+func TestHistoryBranch_CompactionNodeID(t *testing.T) {
+	branch := &types.HistoryBranch{
+		TreeID:   testdata.RunID,
+		BranchID: testdata.RunID,
+	}
+	assert.Nil(t, thrift.FromHistoryBranch(branch).CompactionNodeID)
+	assert.Equal(t, branch, thrift.ToHistoryBranch(thrift.FromHistoryBranch(branch)))
+
+	branch.CompactionNodeID = testdata.EventID1
+	assert.Equal(t, branch, thrift.ToHistoryBranch(thrift.FromHistoryBranch(branch)))
+}
+
+func TestUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.UpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, thrift.ToUpdateWorkflowExecutionResponse(thrift.FromUpdateWorkflowExecutionResponse(item)))
+	}
+}
+
+func TestRespondDecisionTaskCompletedRequest_UpdateResults(t *testing.T) {
+	item := &testdata.RespondDecisionTaskCompletedRequestWithUpdateResults
+	assert.Equal(t, item, thrift.ToRespondDecisionTaskCompletedRequest(thrift.FromRespondDecisionTaskCompletedRequest(item)))
+}
+
+func TestWorkflowExecutionInfo_IsPaused(t *testing.T) {
+	assert.True(t, thrift.ToWorkflowExecutionInfo(thrift.FromWorkflowExecutionInfo(&testdata.PausedWorkflowExecutionInfo)).IsPaused)
+	assert.False(t, thrift.ToWorkflowExecutionInfo(thrift.FromWorkflowExecutionInfo(&testdata.WorkflowExecutionInfo)).IsPaused)
+}
+
+func TestDescribeTaskListResponse_PartitionConfig(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListResponse{nil, &testdata.DescribeTaskListResponse, &testdata.DescribeTaskListResponseWithPartitionConfig} {
+		assert.Equal(t, item, thrift.ToDescribeTaskListResponse(thrift.FromDescribeTaskListResponse(item)))
+	}
+}
+
+func TestPendingActivityInfo_AttemptLog(t *testing.T) {
+	item := &testdata.PendingActivityInfoWithAttemptLog
+	assert.Equal(t, item, thrift.ToPendingActivityInfo(thrift.FromPendingActivityInfo(item)))
+	for _, item := range [][]*types.ActivityAttemptInfo{nil, {}, testdata.ActivityAttemptInfoArray} {
+		assert.Equal(t, item, thrift.ToActivityAttemptInfoArray(thrift.FromActivityAttemptInfoArray(item)))
+	}
+}
+
+func TestStartWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.StartWorkflowExecutionRequest{nil, &testdata.StartWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToStartWorkflowExecutionRequest(thrift.FromStartWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestSignalWithStartWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.SignalWithStartWorkflowExecutionRequest{nil, &testdata.SignalWithStartWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToSignalWithStartWorkflowExecutionRequest(thrift.FromSignalWithStartWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestStartWorkflowExecutionRequest_InactivityTimeout(t *testing.T) {
+	startRequest := testdata.StartWorkflowExecutionRequest
+	startRequest.InactivityTimeoutSeconds = &testdata.Duration2
+	startRequest.InactivityTimeoutPolicy = types.InactivityTimeoutPolicyFail.Ptr()
+	assert.Equal(t, &startRequest, thrift.ToStartWorkflowExecutionRequest(thrift.FromStartWorkflowExecutionRequest(&startRequest)))
+
+	signalWithStartRequest := testdata.SignalWithStartWorkflowExecutionRequest
+	signalWithStartRequest.InactivityTimeoutSeconds = &testdata.Duration2
+	signalWithStartRequest.InactivityTimeoutPolicy = types.InactivityTimeoutPolicyNotify.Ptr()
+	assert.Equal(t, &signalWithStartRequest, thrift.ToSignalWithStartWorkflowExecutionRequest(thrift.FromSignalWithStartWorkflowExecutionRequest(&signalWithStartRequest)))
+
+	startedAttributes := testdata.WorkflowExecutionStartedEventAttributes
+	startedAttributes.ParentWorkflowDomainID = nil // not part of the thrift definition
+	startedAttributes.InactivityTimeoutSeconds = &testdata.Duration2
+	startedAttributes.InactivityTimeoutPolicy = types.InactivityTimeoutPolicyNotify.Ptr()
+	assert.Equal(t, &startedAttributes, thrift.ToWorkflowExecutionStartedEventAttributes(thrift.FromWorkflowExecutionStartedEventAttributes(&startedAttributes)))
+}
+
+func TestCreateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.CreateScheduleRequest{nil, {}, &testdata.CreateScheduleRequest} {
+		assert.Equal(t, item, thrift.ToCreateScheduleRequest(thrift.FromCreateScheduleRequest(item)))
+	}
+}
+
+func TestDescribeScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleRequest{nil, {}, &testdata.DescribeScheduleRequest} {
+		assert.Equal(t, item, thrift.ToDescribeScheduleRequest(thrift.FromDescribeScheduleRequest(item)))
+	}
+}
+
+func TestDescribeScheduleResponse(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleResponse{nil, {}, &testdata.DescribeScheduleResponse} {
+		assert.Equal(t, item, thrift.ToDescribeScheduleResponse(thrift.FromDescribeScheduleResponse(item)))
+	}
+}
+
+func TestUpdateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UpdateScheduleRequest{nil, {}, &testdata.UpdateScheduleRequest} {
+		assert.Equal(t, item, thrift.ToUpdateScheduleRequest(thrift.FromUpdateScheduleRequest(item)))
+	}
+}
+
+func TestPauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.PauseScheduleRequest{nil, {}, &testdata.PauseScheduleRequest} {
+		assert.Equal(t, item, thrift.ToPauseScheduleRequest(thrift.FromPauseScheduleRequest(item)))
+	}
+}
+
+func TestUnpauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseScheduleRequest{nil, {}, &testdata.UnpauseScheduleRequest} {
+		assert.Equal(t, item, thrift.ToUnpauseScheduleRequest(thrift.FromUnpauseScheduleRequest(item)))
+	}
+}
+
+func TestDeleteScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DeleteScheduleRequest{nil, {}, &testdata.DeleteScheduleRequest} {
+		assert.Equal(t, item, thrift.ToDeleteScheduleRequest(thrift.FromDeleteScheduleRequest(item)))
+	}
+}
+
+func TestBackfillScheduleRequest(t *testing.T) {
+	for _, item := range []*types.BackfillScheduleRequest{nil, {}, &testdata.BackfillScheduleRequest} {
+		assert.Equal(t, item, thrift.ToBackfillScheduleRequest(thrift.FromBackfillScheduleRequest(item)))
+	}
+}
+
+func TestListSchedulesRequest(t *testing.T) {
+	for _, item := range []*types.ListSchedulesRequest{nil, {}, &testdata.ListSchedulesRequest} {
+		assert.Equal(t, item, thrift.ToListSchedulesRequest(thrift.FromListSchedulesRequest(item)))
+	}
+}
+
+func TestListSchedulesResponse(t *testing.T) {
+	for _, item := range []*types.ListSchedulesResponse{nil, {}, &testdata.ListSchedulesResponse} {
+		assert.Equal(t, item, thrift.ToListSchedulesResponse(thrift.FromListSchedulesResponse(item)))
+	}
+}
+
+func TestScheduleOverlapPolicy(t *testing.T) {
+	for _, item := range []*types.ScheduleOverlapPolicy{nil, types.ScheduleOverlapPolicySkip.Ptr(), types.ScheduleOverlapPolicyBuffer.Ptr(), types.ScheduleOverlapPolicyCancelPrevious.Ptr()} {
+		assert.Equal(t, item, thrift.ToScheduleOverlapPolicy(thrift.FromScheduleOverlapPolicy(item)))
+	}
+	assert.Panics(t, func() { thrift.FromScheduleOverlapPolicy(types.ScheduleOverlapPolicy(999).Ptr()) })
+}
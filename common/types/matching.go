@@ -37,6 +37,9 @@ type AddActivityTaskRequest struct {
 	Source                        *TaskSource               `json:"source,omitempty"`
 	ForwardedFrom                 string                    `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
+	Priority                      int32                     `json:"priority,omitempty"`
+	LabelSelector                 string                    `json:"labelSelector,omitempty"`
+	IsolationGroup                string                    `json:"isolationGroup,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -103,6 +106,30 @@ func (v *AddActivityTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetLabelSelector is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetLabelSelector() (o string) {
+	if v != nil {
+		return v.LabelSelector
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // ActivityTaskDispatchInfo is an internal type (TBD...)
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent                  *HistoryEvent `json:"scheduledEvent,omitempty"`
@@ -123,6 +150,8 @@ type AddDecisionTaskRequest struct {
 	ScheduleToStartTimeoutSeconds *int32             `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
+	Priority                      int32              `json:"priority,omitempty"`
+	IsolationGroup                string             `json:"isolationGroup,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -181,6 +210,22 @@ func (v *AddDecisionTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // CancelOutstandingPollRequest is an internal type (TBD...)
 type CancelOutstandingPollRequest struct {
 	DomainUUID   string    `json:"domainUUID,omitempty"`
@@ -243,6 +288,174 @@ func (v *MatchingDescribeTaskListRequest) GetDescRequest() (o *DescribeTaskListR
 	return
 }
 
+// MatchingDescribeTaskListConfigRequest is an internal type (TBD...)
+type MatchingDescribeTaskListConfigRequest struct {
+	DomainUUID  string                         `json:"domainUUID,omitempty"`
+	DescRequest *DescribeTaskListConfigRequest `json:"descRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingDescribeTaskListConfigRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetDescRequest is an internal getter (TBD...)
+func (v *MatchingDescribeTaskListConfigRequest) GetDescRequest() (o *DescribeTaskListConfigRequest) {
+	if v != nil && v.DescRequest != nil {
+		return v.DescRequest
+	}
+	return
+}
+
+// MatchingUpdateTaskListConfigRequest is an internal type (TBD...)
+type MatchingUpdateTaskListConfigRequest struct {
+	DomainUUID    string                       `json:"domainUUID,omitempty"`
+	UpdateRequest *UpdateTaskListConfigRequest `json:"updateRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListConfigRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUpdateRequest is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListConfigRequest) GetUpdateRequest() (o *UpdateTaskListConfigRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+	return
+}
+
+// MatchingRequeueDeadLetterTasksRequest is an internal type (TBD...)
+type MatchingRequeueDeadLetterTasksRequest struct {
+	DomainUUID     string                         `json:"domainUUID,omitempty"`
+	RequeueRequest *RequeueDeadLetterTasksRequest `json:"requeueRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingRequeueDeadLetterTasksRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequeueRequest is an internal getter (TBD...)
+func (v *MatchingRequeueDeadLetterTasksRequest) GetRequeueRequest() (o *RequeueDeadLetterTasksRequest) {
+	if v != nil && v.RequeueRequest != nil {
+		return v.RequeueRequest
+	}
+	return
+}
+
+// MatchingMigrateTaskListRequest is an internal type (TBD...)
+type MatchingMigrateTaskListRequest struct {
+	DomainUUID     string                  `json:"domainUUID,omitempty"`
+	MigrateRequest *MigrateTaskListRequest `json:"migrateRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingMigrateTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetMigrateRequest is an internal getter (TBD...)
+func (v *MatchingMigrateTaskListRequest) GetMigrateRequest() (o *MigrateTaskListRequest) {
+	if v != nil && v.MigrateRequest != nil {
+		return v.MigrateRequest
+	}
+	return
+}
+
+// MatchingDeleteTaskListRequest is an internal type (TBD...)
+type MatchingDeleteTaskListRequest struct {
+	DomainUUID    string                 `json:"domainUUID,omitempty"`
+	DeleteRequest *DeleteTaskListRequest `json:"deleteRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetDeleteRequest is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListRequest) GetDeleteRequest() (o *DeleteTaskListRequest) {
+	if v != nil && v.DeleteRequest != nil {
+		return v.DeleteRequest
+	}
+	return
+}
+
+// MatchingListStickyTaskListsRequest is an internal type (TBD...)
+type MatchingListStickyTaskListsRequest struct {
+	DomainUUID  string                      `json:"domainUUID,omitempty"`
+	ListRequest *ListStickyTaskListsRequest `json:"listRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingListStickyTaskListsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetListRequest is an internal getter (TBD...)
+func (v *MatchingListStickyTaskListsRequest) GetListRequest() (o *ListStickyTaskListsRequest) {
+	if v != nil && v.ListRequest != nil {
+		return v.ListRequest
+	}
+	return
+}
+
+// MatchingStickyTaskList is an internal type (TBD...)
+type MatchingStickyTaskList struct {
+	Name              string `json:"name,omitempty"`
+	Identity          string `json:"identity,omitempty"`
+	LastPollTimestamp *int64 `json:"lastPollTimestamp,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *MatchingStickyTaskList) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *MatchingStickyTaskList) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// MatchingListStickyTaskListsResponse is an internal type (TBD...)
+type MatchingListStickyTaskListsResponse struct {
+	TaskLists []*MatchingStickyTaskList `json:"taskLists,omitempty"`
+}
+
+// GetTaskLists is an internal getter (TBD...)
+func (v *MatchingListStickyTaskListsResponse) GetTaskLists() (o []*MatchingStickyTaskList) {
+	if v != nil && v.TaskLists != nil {
+		return v.TaskLists
+	}
+	return
+}
+
 // MatchingListTaskListPartitionsRequest is an internal type (TBD...)
 type MatchingListTaskListPartitionsRequest struct {
 	Domain   string    `json:"domain,omitempty"`
@@ -280,10 +493,13 @@ func (v *MatchingGetTaskListsByDomainRequest) GetDomain() (o string) {
 
 // MatchingPollForActivityTaskRequest is an internal type (TBD...)
 type MatchingPollForActivityTaskRequest struct {
-	DomainUUID    string                      `json:"domainUUID,omitempty"`
-	PollerID      string                      `json:"pollerID,omitempty"`
-	PollRequest   *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
-	ForwardedFrom string                      `json:"forwardedFrom,omitempty"`
+	DomainUUID     string                      `json:"domainUUID,omitempty"`
+	PollerID       string                      `json:"pollerID,omitempty"`
+	PollRequest    *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom  string                      `json:"forwardedFrom,omitempty"`
+	PollerLabels   map[string]string           `json:"pollerLabels,omitempty"`
+	PollerCapacity int32                       `json:"pollerCapacity,omitempty"`
+	IsolationGroup string                      `json:"isolationGroup,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -318,12 +534,39 @@ func (v *MatchingPollForActivityTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetPollerLabels is an internal getter (TBD...)
+func (v *MatchingPollForActivityTaskRequest) GetPollerLabels() (o map[string]string) {
+	if v != nil {
+		return v.PollerLabels
+	}
+	return
+}
+
+// GetPollerCapacity is an internal getter (TBD...)
+func (v *MatchingPollForActivityTaskRequest) GetPollerCapacity() (o int32) {
+	if v != nil {
+		return v.PollerCapacity
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *MatchingPollForActivityTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // MatchingPollForDecisionTaskRequest is an internal type (TBD...)
 type MatchingPollForDecisionTaskRequest struct {
-	DomainUUID    string                      `json:"domainUUID,omitempty"`
-	PollerID      string                      `json:"pollerID,omitempty"`
-	PollRequest   *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
-	ForwardedFrom string                      `json:"forwardedFrom,omitempty"`
+	DomainUUID     string                      `json:"domainUUID,omitempty"`
+	PollerID       string                      `json:"pollerID,omitempty"`
+	PollRequest    *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom  string                      `json:"forwardedFrom,omitempty"`
+	PollerLabels   map[string]string           `json:"pollerLabels,omitempty"`
+	PollerCapacity int32                       `json:"pollerCapacity,omitempty"`
+	IsolationGroup string                      `json:"isolationGroup,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -358,6 +601,30 @@ func (v *MatchingPollForDecisionTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetPollerLabels is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskRequest) GetPollerLabels() (o map[string]string) {
+	if v != nil {
+		return v.PollerLabels
+	}
+	return
+}
+
+// GetPollerCapacity is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskRequest) GetPollerCapacity() (o int32) {
+	if v != nil {
+		return v.PollerCapacity
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // MatchingPollForDecisionTaskResponse is an internal type (TBD...)
 type MatchingPollForDecisionTaskResponse struct {
 	TaskToken                 []byte                    `json:"taskToken,omitempty"`
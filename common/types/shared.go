@@ -324,6 +324,64 @@ const (
 	ArchivalStatusEnabled
 )
 
+// BackfillScheduleRequest is an internal type (TBD...)
+type BackfillScheduleRequest struct {
+	Domain        string                 `json:"domain,omitempty"`
+	ScheduleID    string                 `json:"scheduleId,omitempty"`
+	StartTimeNano *int64                 `json:"startTimeNano,omitempty"`
+	EndTimeNano   *int64                 `json:"endTimeNano,omitempty"`
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Identity      string                 `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetStartTimeNano is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetStartTimeNano() (o int64) {
+	if v != nil && v.StartTimeNano != nil {
+		return *v.StartTimeNano
+	}
+	return
+}
+
+// GetEndTimeNano is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetEndTimeNano() (o int64) {
+	if v != nil && v.EndTimeNano != nil {
+		return *v.EndTimeNano
+	}
+	return
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // BadBinaries is an internal type (TBD...)
 type BadBinaries struct {
 	Binaries map[string]*BadBinaryInfo `json:"binaries,omitempty"`
@@ -807,6 +865,73 @@ func (v *CountWorkflowExecutionsResponse) GetCount() (o int64) {
 	return
 }
 
+// CreateScheduleRequest is an internal type (TBD...)
+type CreateScheduleRequest struct {
+	Domain     string            `json:"domain,omitempty"`
+	ScheduleID string            `json:"scheduleId,omitempty"`
+	Spec       *ScheduleSpec     `json:"spec,omitempty"`
+	Action     *ScheduleAction   `json:"action,omitempty"`
+	Policies   *SchedulePolicies `json:"policies,omitempty"`
+	Identity   string            `json:"identity,omitempty"`
+	RequestID  string            `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // CurrentBranchChangedError is an internal type (TBD...)
 type CurrentBranchChangedError struct {
 	Message            string `json:"message,required"`
@@ -1431,6 +1556,37 @@ const (
 	DecisionTypeUpsertWorkflowSearchAttributes
 )
 
+// DeleteScheduleRequest is an internal type (TBD...)
+type DeleteScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // DeprecateDomainRequest is an internal type (TBD...)
 type DeprecateDomainRequest struct {
 	Name          string `json:"name,omitempty"`
@@ -1516,6 +1672,140 @@ type DescribeHistoryHostRequest struct {
 	ExecutionForHost *WorkflowExecution `json:"executionForHost,omitempty"`
 }
 
+// DescribeScheduleRequest is an internal type (TBD...)
+type DescribeScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// DescribeScheduleResponse is an internal type (TBD...)
+type DescribeScheduleResponse struct {
+	Spec                 *ScheduleSpec      `json:"spec,omitempty"`
+	Action               *ScheduleAction    `json:"action,omitempty"`
+	Policies             *SchedulePolicies  `json:"policies,omitempty"`
+	Paused               bool               `json:"paused,omitempty"`
+	PauseReason          string             `json:"pauseReason,omitempty"`
+	LastRun              *ScheduleRunInfo   `json:"lastRun,omitempty"`
+	RecentRuns           []*ScheduleRunInfo `json:"recentRuns,omitempty"`
+	UpcomingRunTimesNano []int64            `json:"upcomingRunTimesNano,omitempty"`
+	BufferedRuns         int32              `json:"bufferedRuns,omitempty"`
+	TotalRuns            int64              `json:"totalRuns,omitempty"`
+	SkippedRuns          int64              `json:"skippedRuns,omitempty"`
+	FailedRuns           int64              `json:"failedRuns,omitempty"`
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetPaused is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
+// GetPauseReason is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetPauseReason() (o string) {
+	if v != nil {
+		return v.PauseReason
+	}
+	return
+}
+
+// GetLastRun is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetLastRun() (o *ScheduleRunInfo) {
+	if v != nil && v.LastRun != nil {
+		return v.LastRun
+	}
+	return
+}
+
+// GetRecentRuns is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetRecentRuns() (o []*ScheduleRunInfo) {
+	if v != nil && v.RecentRuns != nil {
+		return v.RecentRuns
+	}
+	return
+}
+
+// GetUpcomingRunTimesNano is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetUpcomingRunTimesNano() (o []int64) {
+	if v != nil && v.UpcomingRunTimesNano != nil {
+		return v.UpcomingRunTimesNano
+	}
+	return
+}
+
+// GetBufferedRuns is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetBufferedRuns() (o int32) {
+	if v != nil {
+		return v.BufferedRuns
+	}
+	return
+}
+
+// GetTotalRuns is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetTotalRuns() (o int64) {
+	if v != nil {
+		return v.TotalRuns
+	}
+	return
+}
+
+// GetSkippedRuns is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetSkippedRuns() (o int64) {
+	if v != nil {
+		return v.SkippedRuns
+	}
+	return
+}
+
+// GetFailedRuns is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetFailedRuns() (o int64) {
+	if v != nil {
+		return v.FailedRuns
+	}
+	return
+}
+
 // DescribeShardDistributionRequest is an internal type (TBD...)
 type DescribeShardDistributionRequest struct {
 	PageSize int32 `json:"pageSize,omitempty"`
@@ -1631,8 +1921,17 @@ func (v *DescribeTaskListRequest) GetIncludeTaskListStatus() (o bool) {
 
 // DescribeTaskListResponse is an internal type (TBD...)
 type DescribeTaskListResponse struct {
-	Pollers        []*PollerInfo   `json:"pollers,omitempty"`
-	TaskListStatus *TaskListStatus `json:"taskListStatus,omitempty"`
+	Pollers         []*PollerInfo            `json:"pollers,omitempty"`
+	TaskListStatus  *TaskListStatus          `json:"taskListStatus,omitempty"`
+	PartitionConfig *TaskListPartitionConfig `json:"partitionConfig,omitempty"`
+}
+
+// GetPartitionConfig is an internal getter (TBD...)
+func (v *DescribeTaskListResponse) GetPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
+	}
+	return
 }
 
 // GetPollers is an internal getter (TBD...)
@@ -2097,6 +2396,20 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionPaused"
+	case 43:
+		return "WorkflowExecutionUnpaused"
+	case 44:
+		return "WorkflowExecutionUpdateRequested"
+	case 45:
+		return "WorkflowHistoryCompacted"
+	case 46:
+		return "WorkflowExecutionInactive"
+	case 47:
+		return "WorkflowSearchAttributesReapplied"
+	case 48:
+		return "WorkflowExecutionUpdateCompleted"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -2230,6 +2543,27 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WORKFLOWEXECUTIONPAUSED":
+		*e = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WORKFLOWEXECUTIONUNPAUSED":
+		*e = EventTypeWorkflowExecutionUnpaused
+		return nil
+	case "WORKFLOWEXECUTIONUPDATEREQUESTED":
+		*e = EventTypeWorkflowExecutionUpdateRequested
+		return nil
+	case "WORKFLOWHISTORYCOMPACTED":
+		*e = EventTypeWorkflowHistoryCompacted
+		return nil
+	case "WORKFLOWEXECUTIONINACTIVE":
+		*e = EventTypeWorkflowExecutionInactive
+		return nil
+	case "WORKFLOWSEARCHATTRIBUTESREAPPLIED":
+		*e = EventTypeWorkflowSearchAttributesReapplied
+		return nil
+	case "WORKFLOWEXECUTIONUPDATECOMPLETED":
+		*e = EventTypeWorkflowExecutionUpdateCompleted
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -2330,6 +2664,20 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeWorkflowExecutionPaused is an option for EventType
+	EventTypeWorkflowExecutionPaused
+	// EventTypeWorkflowExecutionUnpaused is an option for EventType
+	EventTypeWorkflowExecutionUnpaused
+	// EventTypeWorkflowExecutionUpdateRequested is an option for EventType
+	EventTypeWorkflowExecutionUpdateRequested
+	// EventTypeWorkflowHistoryCompacted is an option for EventType
+	EventTypeWorkflowHistoryCompacted
+	// EventTypeWorkflowExecutionInactive is an option for EventType
+	EventTypeWorkflowExecutionInactive
+	// EventTypeWorkflowSearchAttributesReapplied is an option for EventType
+	EventTypeWorkflowSearchAttributesReapplied
+	// EventTypeWorkflowExecutionUpdateCompleted is an option for EventType
+	EventTypeWorkflowExecutionUpdateCompleted
 )
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
@@ -2566,9 +2914,10 @@ func (v *History) GetEvents() (o []*HistoryEvent) {
 
 // HistoryBranch is an internal type (TBD...)
 type HistoryBranch struct {
-	TreeID    string
-	BranchID  string
-	Ancestors []*HistoryBranchRange
+	TreeID           string
+	BranchID         string
+	Ancestors        []*HistoryBranchRange
+	CompactionNodeID int64
 }
 
 // HistoryBranchRange is an internal type (TBD...)
@@ -2627,6 +2976,13 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionUnpausedEventAttributes                       *WorkflowExecutionUnpausedEventAttributes                       `json:"workflowExecutionUnpausedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateRequestedEventAttributes                *WorkflowExecutionUpdateRequestedEventAttributes                `json:"workflowExecutionUpdateRequestedEventAttributes,omitempty"`
+	WorkflowHistoryCompactedEventAttributes                        *WorkflowHistoryCompactedEventAttributes                        `json:"workflowHistoryCompactedEventAttributes,omitempty"`
+	WorkflowExecutionInactiveEventAttributes                       *WorkflowExecutionInactiveEventAttributes                       `json:"workflowExecutionInactiveEventAttributes,omitempty"`
+	WorkflowSearchAttributesReappliedEventAttributes               *WorkflowSearchAttributesReappliedEventAttributes               `json:"workflowSearchAttributesReappliedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateCompletedEventAttributes                *WorkflowExecutionUpdateCompletedEventAttributes                `json:"workflowExecutionUpdateCompletedEventAttributes,omitempty"`
 }
 
 // GetTimestamp is an internal getter (TBD...)
@@ -2981,6 +3337,62 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetWorkflowExecutionPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() (o *WorkflowExecutionUnpausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil {
+		return v.WorkflowExecutionUnpausedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUpdateRequestedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateRequestedEventAttributes() (o *WorkflowExecutionUpdateRequestedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateRequestedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateRequestedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowHistoryCompactedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowHistoryCompactedEventAttributes() (o *WorkflowHistoryCompactedEventAttributes) {
+	if v != nil && v.WorkflowHistoryCompactedEventAttributes != nil {
+		return v.WorkflowHistoryCompactedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionInactiveEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionInactiveEventAttributes() (o *WorkflowExecutionInactiveEventAttributes) {
+	if v != nil && v.WorkflowExecutionInactiveEventAttributes != nil {
+		return v.WorkflowExecutionInactiveEventAttributes
+	}
+	return
+}
+
+// GetWorkflowSearchAttributesReappliedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowSearchAttributesReappliedEventAttributes() (o *WorkflowSearchAttributesReappliedEventAttributes) {
+	if v != nil && v.WorkflowSearchAttributesReappliedEventAttributes != nil {
+		return v.WorkflowSearchAttributesReappliedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUpdateCompletedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateCompletedEventAttributes() (o *WorkflowExecutionUpdateCompletedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateCompletedEventAttributes
+	}
+	return
+}
+
 // HistoryEventFilterType is an internal type (TBD...)
 type HistoryEventFilterType int32
 
@@ -3032,6 +3444,57 @@ const (
 	HistoryEventFilterTypeCloseEvent
 )
 
+// InactivityTimeoutPolicy is an internal type (TBD...)
+type InactivityTimeoutPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e InactivityTimeoutPolicy) Ptr() *InactivityTimeoutPolicy {
+	return &e
+}
+
+// String returns a readable string representation of InactivityTimeoutPolicy.
+func (e InactivityTimeoutPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "FAIL"
+	case 1:
+		return "NOTIFY"
+	}
+	return fmt.Sprintf("InactivityTimeoutPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *InactivityTimeoutPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "FAIL":
+		*e = InactivityTimeoutPolicyFail
+		return nil
+	case "NOTIFY":
+		*e = InactivityTimeoutPolicyNotify
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "InactivityTimeoutPolicy", err)
+		}
+		*e = InactivityTimeoutPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes InactivityTimeoutPolicy to text.
+func (e InactivityTimeoutPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// InactivityTimeoutPolicyFail is an option for InactivityTimeoutPolicy
+	InactivityTimeoutPolicyFail InactivityTimeoutPolicy = iota
+	// InactivityTimeoutPolicyNotify is an option for InactivityTimeoutPolicy
+	InactivityTimeoutPolicyNotify
+)
+
 // IndexedValueType is an internal type (TBD...)
 type IndexedValueType int32
 
@@ -3305,29 +3768,82 @@ func (v *ListOpenWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecu
 	return
 }
 
-// ListTaskListPartitionsRequest is an internal type (TBD...)
-type ListTaskListPartitionsRequest struct {
-	Domain   string    `json:"domain,omitempty"`
-	TaskList *TaskList `json:"taskList,omitempty"`
+// ListSchedulesRequest is an internal type (TBD...)
+type ListSchedulesRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *ListTaskListPartitionsRequest) GetDomain() (o string) {
+func (v *ListSchedulesRequest) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// ListTaskListPartitionsResponse is an internal type (TBD...)
-type ListTaskListPartitionsResponse struct {
-	ActivityTaskListPartitions []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
-	DecisionTaskListPartitions []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
+// GetPageSize is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
 }
 
-// GetTaskListsByDomainRequest is an internal type (TBD...)
-type GetTaskListsByDomainRequest struct {
-	Domain string `json:"domain,omitempty"`
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetNextPageToken() (o []byte) {
+	if v != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListSchedulesResponse is an internal type (TBD...)
+type ListSchedulesResponse struct {
+	ScheduleIDs   []string `json:"scheduleIds,omitempty"`
+	NextPageToken []byte   `json:"nextPageToken,omitempty"`
+}
+
+// GetScheduleIDs is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetScheduleIDs() (o []string) {
+	if v != nil && v.ScheduleIDs != nil {
+		return v.ScheduleIDs
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetNextPageToken() (o []byte) {
+	if v != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListTaskListPartitionsRequest is an internal type (TBD...)
+type ListTaskListPartitionsRequest struct {
+	Domain   string    `json:"domain,omitempty"`
+	TaskList *TaskList `json:"taskList,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListTaskListPartitionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// ListTaskListPartitionsResponse is an internal type (TBD...)
+type ListTaskListPartitionsResponse struct {
+	ActivityTaskListPartitions []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
+	DecisionTaskListPartitions []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
+}
+
+// GetTaskListsByDomainRequest is an internal type (TBD...)
+type GetTaskListsByDomainRequest struct {
+	Domain string `json:"domain,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -3501,21 +4017,102 @@ const (
 	ParentClosePolicyTerminate
 )
 
+// PauseScheduleRequest is an internal type (TBD...)
+type PauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// PauseWorkflowExecutionRequest is an internal type (TBD...)
+type PauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // PendingActivityInfo is an internal type (TBD...)
 type PendingActivityInfo struct {
-	ActivityID             string                `json:"activityID,omitempty"`
-	ActivityType           *ActivityType         `json:"activityType,omitempty"`
-	State                  *PendingActivityState `json:"state,omitempty"`
-	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
-	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
-	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
-	Attempt                int32                 `json:"attempt,omitempty"`
-	MaximumAttempts        int32                 `json:"maximumAttempts,omitempty"`
-	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
-	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
-	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
-	LastWorkerIdentity     string                `json:"lastWorkerIdentity,omitempty"`
-	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
+	ActivityID             string                 `json:"activityID,omitempty"`
+	ActivityType           *ActivityType          `json:"activityType,omitempty"`
+	State                  *PendingActivityState  `json:"state,omitempty"`
+	HeartbeatDetails       []byte                 `json:"heartbeatDetails,omitempty"`
+	LastHeartbeatTimestamp *int64                 `json:"lastHeartbeatTimestamp,omitempty"`
+	LastStartedTimestamp   *int64                 `json:"lastStartedTimestamp,omitempty"`
+	Attempt                int32                  `json:"attempt,omitempty"`
+	MaximumAttempts        int32                  `json:"maximumAttempts,omitempty"`
+	ScheduledTimestamp     *int64                 `json:"scheduledTimestamp,omitempty"`
+	ExpirationTimestamp    *int64                 `json:"expirationTimestamp,omitempty"`
+	LastFailureReason      *string                `json:"lastFailureReason,omitempty"`
+	LastWorkerIdentity     string                 `json:"lastWorkerIdentity,omitempty"`
+	LastFailureDetails     []byte                 `json:"lastFailureDetails,omitempty"`
+	AttemptLog             []*ActivityAttemptInfo `json:"attemptLog,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -3590,6 +4187,101 @@ func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
 	return
 }
 
+// GetAttemptLog is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetAttemptLog() (o []*ActivityAttemptInfo) {
+	if v != nil && v.AttemptLog != nil {
+		return v.AttemptLog
+	}
+	return
+}
+
+// ActivityAttemptInfo is an internal type (TBD...)
+type ActivityAttemptInfo struct {
+	Attempt           int32  `json:"attempt,omitempty"`
+	StartedTimestamp  int64  `json:"startedTimestamp,omitempty"`
+	FinishedTimestamp int64  `json:"finishedTimestamp,omitempty"`
+	Identity          string `json:"identity,omitempty"`
+	FailureReason     string `json:"failureReason,omitempty"`
+	FailureDetails    []byte `json:"failureDetails,omitempty"`
+	HeartbeatDetails  []byte `json:"heartbeatDetails,omitempty"`
+	// FailureDetailsTruncated and HeartbeatDetailsTruncated are set when the
+	// corresponding details exceeded the size limit and were dropped
+	FailureDetailsTruncated   bool `json:"failureDetailsTruncated,omitempty"`
+	HeartbeatDetailsTruncated bool `json:"heartbeatDetailsTruncated,omitempty"`
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetStartedTimestamp is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetStartedTimestamp() (o int64) {
+	if v != nil {
+		return v.StartedTimestamp
+	}
+	return
+}
+
+// GetFinishedTimestamp is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetFinishedTimestamp() (o int64) {
+	if v != nil {
+		return v.FinishedTimestamp
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetFailureReason is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetFailureReason() (o string) {
+	if v != nil {
+		return v.FailureReason
+	}
+	return
+}
+
+// GetFailureDetails is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetFailureDetails() (o []byte) {
+	if v != nil && v.FailureDetails != nil {
+		return v.FailureDetails
+	}
+	return
+}
+
+// GetHeartbeatDetails is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetHeartbeatDetails() (o []byte) {
+	if v != nil && v.HeartbeatDetails != nil {
+		return v.HeartbeatDetails
+	}
+	return
+}
+
+// GetFailureDetailsTruncated is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetFailureDetailsTruncated() (o bool) {
+	if v != nil {
+		return v.FailureDetailsTruncated
+	}
+	return
+}
+
+// GetHeartbeatDetailsTruncated is an internal getter (TBD...)
+func (v *ActivityAttemptInfo) GetHeartbeatDetailsTruncated() (o bool) {
+	if v != nil {
+		return v.HeartbeatDetailsTruncated
+	}
+	return
+}
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -4660,6 +5352,64 @@ func (v *RequestCancelWorkflowExecutionRequest) GetRequestID() (o string) {
 	return
 }
 
+// ResetChildPolicy is an internal type (TBD...)
+type ResetChildPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e ResetChildPolicy) Ptr() *ResetChildPolicy {
+	return &e
+}
+
+// String returns a readable string representation of ResetChildPolicy.
+func (e ResetChildPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "DEFAULT"
+	case 1:
+		return "REATTACH"
+	case 2:
+		return "REATTACH_AND_TERMINATE"
+	}
+	return fmt.Sprintf("ResetChildPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ResetChildPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "DEFAULT":
+		*e = ResetChildPolicyDefault
+		return nil
+	case "REATTACH":
+		*e = ResetChildPolicyReattach
+		return nil
+	case "REATTACH_AND_TERMINATE":
+		*e = ResetChildPolicyReattachAndTerminate
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ResetChildPolicy", err)
+		}
+		*e = ResetChildPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ResetChildPolicy to text.
+func (e ResetChildPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ResetChildPolicyDefault is an option for ResetChildPolicy, it rejects the reset if child workflows are pending at the reset point
+	ResetChildPolicyDefault ResetChildPolicy = iota
+	// ResetChildPolicyReattach is an option for ResetChildPolicy
+	ResetChildPolicyReattach
+	// ResetChildPolicyReattachAndTerminate is an option for ResetChildPolicy
+	ResetChildPolicyReattachAndTerminate
+)
+
 // ResetPointInfo is an internal type (TBD...)
 type ResetPointInfo struct {
 	BinaryChecksum           string `json:"binaryChecksum,omitempty"`
@@ -4754,6 +5504,37 @@ func (v *ResetQueueRequest) GetType() (o int32) {
 	return
 }
 
+// ResetReapplyPolicy is an internal type (TBD...)
+type ResetReapplyPolicy struct {
+	ReapplySignals                bool `json:"reapplySignals,omitempty"`
+	ReapplyUpsertSearchAttributes bool `json:"reapplyUpsertSearchAttributes,omitempty"`
+	ReapplyCancelRequests         bool `json:"reapplyCancelRequests,omitempty"`
+}
+
+// GetReapplySignals is an internal getter (TBD...)
+func (v *ResetReapplyPolicy) GetReapplySignals() (o bool) {
+	if v != nil {
+		return v.ReapplySignals
+	}
+	return
+}
+
+// GetReapplyUpsertSearchAttributes is an internal getter (TBD...)
+func (v *ResetReapplyPolicy) GetReapplyUpsertSearchAttributes() (o bool) {
+	if v != nil {
+		return v.ReapplyUpsertSearchAttributes
+	}
+	return
+}
+
+// GetReapplyCancelRequests is an internal getter (TBD...)
+func (v *ResetReapplyPolicy) GetReapplyCancelRequests() (o bool) {
+	if v != nil {
+		return v.ReapplyCancelRequests
+	}
+	return
+}
+
 // ResetStickyTaskListRequest is an internal type (TBD...)
 type ResetStickyTaskListRequest struct {
 	Domain    string             `json:"domain,omitempty"`
@@ -4782,12 +5563,14 @@ type ResetStickyTaskListResponse struct {
 
 // ResetWorkflowExecutionRequest is an internal type (TBD...)
 type ResetWorkflowExecutionRequest struct {
-	Domain                string             `json:"domain,omitempty"`
-	WorkflowExecution     *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Reason                string             `json:"reason,omitempty"`
-	DecisionFinishEventID int64              `json:"decisionFinishEventId,omitempty"`
-	RequestID             string             `json:"requestId,omitempty"`
-	SkipSignalReapply     bool               `json:"skipSignalReapply,omitempty"`
+	Domain                string              `json:"domain,omitempty"`
+	WorkflowExecution     *WorkflowExecution  `json:"workflowExecution,omitempty"`
+	Reason                string              `json:"reason,omitempty"`
+	DecisionFinishEventID int64               `json:"decisionFinishEventId,omitempty"`
+	RequestID             string              `json:"requestId,omitempty"`
+	SkipSignalReapply     bool                `json:"skipSignalReapply,omitempty"`
+	ResetChildPolicy      *ResetChildPolicy   `json:"resetChildPolicy,omitempty"`
+	ReapplyPolicy         *ResetReapplyPolicy `json:"reapplyPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -4838,6 +5621,22 @@ func (v *ResetWorkflowExecutionRequest) GetSkipSignalReapply() (o bool) {
 	return
 }
 
+// GetResetChildPolicy is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetResetChildPolicy() (o ResetChildPolicy) {
+	if v != nil && v.ResetChildPolicy != nil {
+		return *v.ResetChildPolicy
+	}
+	return
+}
+
+// GetReapplyPolicy is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetReapplyPolicy() (o *ResetReapplyPolicy) {
+	if v != nil && v.ReapplyPolicy != nil {
+		return v.ReapplyPolicy
+	}
+	return
+}
+
 // ResetWorkflowExecutionResponse is an internal type (TBD...)
 type ResetWorkflowExecutionResponse struct {
 	RunID string `json:"runId,omitempty"`
@@ -5066,15 +5865,16 @@ func (v *RespondActivityTaskFailedRequest) GetIdentity() (o string) {
 
 // RespondDecisionTaskCompletedRequest is an internal type (TBD...)
 type RespondDecisionTaskCompletedRequest struct {
-	TaskToken                  []byte                          `json:"taskToken,omitempty"`
-	Decisions                  []*Decision                     `json:"decisions,omitempty"`
-	ExecutionContext           []byte                          `json:"executionContext,omitempty"`
-	Identity                   string                          `json:"identity,omitempty"`
-	StickyAttributes           *StickyExecutionAttributes      `json:"stickyAttributes,omitempty"`
-	ReturnNewDecisionTask      bool                            `json:"returnNewDecisionTask,omitempty"`
-	ForceCreateNewDecisionTask bool                            `json:"forceCreateNewDecisionTask,omitempty"`
-	BinaryChecksum             string                          `json:"binaryChecksum,omitempty"`
-	QueryResults               map[string]*WorkflowQueryResult `json:"queryResults,omitempty"`
+	TaskToken                  []byte                           `json:"taskToken,omitempty"`
+	Decisions                  []*Decision                      `json:"decisions,omitempty"`
+	ExecutionContext           []byte                           `json:"executionContext,omitempty"`
+	Identity                   string                           `json:"identity,omitempty"`
+	StickyAttributes           *StickyExecutionAttributes       `json:"stickyAttributes,omitempty"`
+	ReturnNewDecisionTask      bool                             `json:"returnNewDecisionTask,omitempty"`
+	ForceCreateNewDecisionTask bool                             `json:"forceCreateNewDecisionTask,omitempty"`
+	BinaryChecksum             string                           `json:"binaryChecksum,omitempty"`
+	QueryResults               map[string]*WorkflowQueryResult  `json:"queryResults,omitempty"`
+	UpdateResults              map[string]*WorkflowUpdateResult `json:"updateResults,omitempty"`
 }
 
 // GetIdentity is an internal getter (TBD...)
@@ -5117,6 +5917,14 @@ func (v *RespondDecisionTaskCompletedRequest) GetQueryResults() (o map[string]*W
 	return
 }
 
+// GetUpdateResults is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedRequest) GetUpdateResults() (o map[string]*WorkflowUpdateResult) {
+	if v != nil && v.UpdateResults != nil {
+		return v.UpdateResults
+	}
+	return
+}
+
 // RespondDecisionTaskCompletedResponse is an internal type (TBD...)
 type RespondDecisionTaskCompletedResponse struct {
 	DecisionTask                *PollForDecisionTaskResponse          `json:"decisionTask,omitempty"`
@@ -5299,40 +6107,98 @@ func (v *RetryTaskV2Error) GetRunID() (o string) {
 	return
 }
 
-// ScheduleActivityTaskDecisionAttributes is an internal type (TBD...)
-type ScheduleActivityTaskDecisionAttributes struct {
-	ActivityID                    string        `json:"activityId,omitempty"`
-	ActivityType                  *ActivityType `json:"activityType,omitempty"`
-	Domain                        string        `json:"domain,omitempty"`
-	TaskList                      *TaskList     `json:"taskList,omitempty"`
-	Input                         []byte        `json:"input,omitempty"`
-	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
-	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
-	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
-	Header                        *Header       `json:"header,omitempty"`
-	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+// ScheduleAction is an internal type (TBD...)
+type ScheduleAction struct {
+	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
+	TaskList                            *TaskList     `json:"taskList,omitempty"`
+	Input                               []byte        `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds int32         `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      int32         `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	WorkflowIDPrefix                    string        `json:"workflowIdPrefix,omitempty"`
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetActivityID() (o string) {
-	if v != nil {
-		return v.ActivityID
+// GetWorkflowType is an internal getter (TBD...)
+func (v *ScheduleAction) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
 	}
 	return
 }
 
-// GetActivityType is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetActivityType() (o *ActivityType) {
-	if v != nil && v.ActivityType != nil {
-		return v.ActivityType
+// GetTaskList is an internal getter (TBD...)
+func (v *ScheduleAction) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
 	}
 	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetDomain() (o string) {
+// GetInput is an internal getter (TBD...)
+func (v *ScheduleAction) GetInput() (o []byte) {
+	if v != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleAction) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil {
+		return v.ExecutionStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil {
+		return v.TaskStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetWorkflowIDPrefix is an internal getter (TBD...)
+func (v *ScheduleAction) GetWorkflowIDPrefix() (o string) {
+	if v != nil {
+		return v.WorkflowIDPrefix
+	}
+	return
+}
+
+// ScheduleActivityTaskDecisionAttributes is an internal type (TBD...)
+type ScheduleActivityTaskDecisionAttributes struct {
+	ActivityID                    string        `json:"activityId,omitempty"`
+	ActivityType                  *ActivityType `json:"activityType,omitempty"`
+	Domain                        string        `json:"domain,omitempty"`
+	TaskList                      *TaskList     `json:"taskList,omitempty"`
+	Input                         []byte        `json:"input,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
+	Header                        *Header       `json:"header,omitempty"`
+	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetActivityType is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetActivityType() (o *ActivityType) {
+	if v != nil && v.ActivityType != nil {
+		return v.ActivityType
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
@@ -5371,6 +6237,157 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetHeartbeatTimeoutSeconds() (o
 	return
 }
 
+// ScheduleOverlapPolicy is an internal type (TBD...)
+type ScheduleOverlapPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e ScheduleOverlapPolicy) Ptr() *ScheduleOverlapPolicy {
+	return &e
+}
+
+// String returns a readable string representation of ScheduleOverlapPolicy.
+func (e ScheduleOverlapPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "SKIP"
+	case 1:
+		return "BUFFER"
+	case 2:
+		return "CANCEL_PREVIOUS"
+	}
+	return fmt.Sprintf("ScheduleOverlapPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ScheduleOverlapPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "SKIP":
+		*e = ScheduleOverlapPolicySkip
+		return nil
+	case "BUFFER":
+		*e = ScheduleOverlapPolicyBuffer
+		return nil
+	case "CANCEL_PREVIOUS":
+		*e = ScheduleOverlapPolicyCancelPrevious
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ScheduleOverlapPolicy", err)
+		}
+		*e = ScheduleOverlapPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ScheduleOverlapPolicy to text.
+func (e ScheduleOverlapPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ScheduleOverlapPolicySkip is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicySkip ScheduleOverlapPolicy = iota
+	// ScheduleOverlapPolicyBuffer is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyBuffer
+	// ScheduleOverlapPolicyCancelPrevious is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyCancelPrevious
+)
+
+// SchedulePolicies is an internal type (TBD...)
+type SchedulePolicies struct {
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *SchedulePolicies) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// ScheduleRunInfo is an internal type (TBD...)
+type ScheduleRunInfo struct {
+	ScheduledTimeNano *int64             `json:"scheduledTimeNano,omitempty"`
+	StartTimeNano     *int64             `json:"startTimeNano,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetScheduledTimeNano is an internal getter (TBD...)
+func (v *ScheduleRunInfo) GetScheduledTimeNano() (o int64) {
+	if v != nil && v.ScheduledTimeNano != nil {
+		return *v.ScheduledTimeNano
+	}
+	return
+}
+
+// GetStartTimeNano is an internal getter (TBD...)
+func (v *ScheduleRunInfo) GetStartTimeNano() (o int64) {
+	if v != nil && v.StartTimeNano != nil {
+		return *v.StartTimeNano
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ScheduleRunInfo) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// ScheduleSpec is an internal type (TBD...)
+type ScheduleSpec struct {
+	CronExpression  string `json:"cronExpression,omitempty"`
+	TimeZone        string `json:"timeZone,omitempty"`
+	JitterInSeconds int32  `json:"jitterInSeconds,omitempty"`
+	StartTimeNano   *int64 `json:"startTimeNano,omitempty"`
+	EndTimeNano     *int64 `json:"endTimeNano,omitempty"`
+}
+
+// GetCronExpression is an internal getter (TBD...)
+func (v *ScheduleSpec) GetCronExpression() (o string) {
+	if v != nil {
+		return v.CronExpression
+	}
+	return
+}
+
+// GetTimeZone is an internal getter (TBD...)
+func (v *ScheduleSpec) GetTimeZone() (o string) {
+	if v != nil {
+		return v.TimeZone
+	}
+	return
+}
+
+// GetJitterInSeconds is an internal getter (TBD...)
+func (v *ScheduleSpec) GetJitterInSeconds() (o int32) {
+	if v != nil {
+		return v.JitterInSeconds
+	}
+	return
+}
+
+// GetStartTimeNano is an internal getter (TBD...)
+func (v *ScheduleSpec) GetStartTimeNano() (o int64) {
+	if v != nil && v.StartTimeNano != nil {
+		return *v.StartTimeNano
+	}
+	return
+}
+
+// GetEndTimeNano is an internal getter (TBD...)
+func (v *ScheduleSpec) GetEndTimeNano() (o int64) {
+	if v != nil && v.EndTimeNano != nil {
+		return *v.EndTimeNano
+	}
+	return
+}
+
 // SearchAttributes is an internal type (TBD...)
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
@@ -5530,25 +6547,27 @@ func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkfl
 
 // SignalWithStartWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionRequest struct {
-	Domain                              string                 `json:"domain,omitempty"`
-	WorkflowID                          string                 `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                 `json:"identity,omitempty"`
-	RequestID                           string                 `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	SignalName                          string                 `json:"signalName,omitempty"`
-	SignalInput                         []byte                 `json:"signalInput,omitempty"`
-	Control                             []byte                 `json:"control,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                 `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Domain                              string                   `json:"domain,omitempty"`
+	WorkflowID                          string                   `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType            `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                `json:"taskList,omitempty"`
+	Input                               []byte                   `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                   `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                   `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                   `json:"identity,omitempty"`
+	RequestID                           string                   `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy   `json:"workflowIdReusePolicy,omitempty"`
+	SignalName                          string                   `json:"signalName,omitempty"`
+	SignalInput                         []byte                   `json:"signalInput,omitempty"`
+	Control                             []byte                   `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy             `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                   `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                    `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes        `json:"searchAttributes,omitempty"`
+	Header                              *Header                  `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                   `json:"delayStartSeconds,omitempty"`
+	InactivityTimeoutSeconds            *int32                   `json:"inactivityTimeoutSeconds,omitempty"`
+	InactivityTimeoutPolicy             *InactivityTimeoutPolicy `json:"inactivityTimeoutPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5647,6 +6666,30 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetDelayStartSeconds is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetDelayStartSeconds() (o int32) {
+	if v != nil && v.DelayStartSeconds != nil {
+		return *v.DelayStartSeconds
+	}
+	return
+}
+
+// GetInactivityTimeoutSeconds is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetInactivityTimeoutSeconds() (o int32) {
+	if v != nil && v.InactivityTimeoutSeconds != nil {
+		return *v.InactivityTimeoutSeconds
+	}
+	return
+}
+
+// GetInactivityTimeoutPolicy is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetInactivityTimeoutPolicy() (o InactivityTimeoutPolicy) {
+	if v != nil && v.InactivityTimeoutPolicy != nil {
+		return *v.InactivityTimeoutPolicy
+	}
+	return
+}
+
 // SignalWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWorkflowExecutionRequest struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -5899,22 +6942,24 @@ func (v *StartTimerDecisionAttributes) GetStartToFireTimeoutSeconds() (o int64)
 
 // StartWorkflowExecutionRequest is an internal type (TBD...)
 type StartWorkflowExecutionRequest struct {
-	Domain                              string                 `json:"domain,omitempty"`
-	WorkflowID                          string                 `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                 `json:"identity,omitempty"`
-	RequestID                           string                 `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                 `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Domain                              string                   `json:"domain,omitempty"`
+	WorkflowID                          string                   `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType            `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                `json:"taskList,omitempty"`
+	Input                               []byte                   `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                   `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                   `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                   `json:"identity,omitempty"`
+	RequestID                           string                   `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy   `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy             `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                   `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                    `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes        `json:"searchAttributes,omitempty"`
+	Header                              *Header                  `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                   `json:"delayStartSeconds,omitempty"`
+	InactivityTimeoutSeconds            *int32                   `json:"inactivityTimeoutSeconds,omitempty"`
+	InactivityTimeoutPolicy             *InactivityTimeoutPolicy `json:"inactivityTimeoutPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5957,6 +7002,22 @@ func (v *StartWorkflowExecutionRequest) GetDelayStartSeconds() (o int32) {
 	return
 }
 
+// GetInactivityTimeoutSeconds is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetInactivityTimeoutSeconds() (o int32) {
+	if v != nil && v.InactivityTimeoutSeconds != nil {
+		return *v.InactivityTimeoutSeconds
+	}
+	return
+}
+
+// GetInactivityTimeoutPolicy is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetInactivityTimeoutPolicy() (o InactivityTimeoutPolicy) {
+	if v != nil && v.InactivityTimeoutPolicy != nil {
+		return *v.InactivityTimeoutPolicy
+	}
+	return
+}
+
 // GetRequestID is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetRequestID() (o string) {
 	if v != nil {
@@ -6136,6 +7197,29 @@ func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
 	return
 }
 
+// TaskListPartitionConfig is an internal type (TBD...)
+type TaskListPartitionConfig struct {
+	NumReadPartitions  int32                               `json:"numReadPartitions,omitempty"`
+	NumWritePartitions int32                               `json:"numWritePartitions,omitempty"`
+	ScalingDecisions   []*TaskListPartitionScalingDecision `json:"scalingDecisions,omitempty"`
+}
+
+// GetScalingDecisions is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetScalingDecisions() (o []*TaskListPartitionScalingDecision) {
+	if v != nil && v.ScalingDecisions != nil {
+		return v.ScalingDecisions
+	}
+	return
+}
+
+// TaskListPartitionScalingDecision is an internal type (TBD...)
+type TaskListPartitionScalingDecision struct {
+	Timestamp          int64  `json:"timestamp,omitempty"`
+	NumReadPartitions  int32  `json:"numReadPartitions,omitempty"`
+	NumWritePartitions int32  `json:"numWritePartitions,omitempty"`
+	Reason             string `json:"reason,omitempty"`
+}
+
 // TaskListStatus is an internal type (TBD...)
 type TaskListStatus struct {
 	BacklogCountHint int64        `json:"backlogCountHint,omitempty"`
@@ -6417,6 +7501,77 @@ type TransientDecisionInfo struct {
 	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
 }
 
+// UnpauseScheduleRequest is an internal type (TBD...)
+type UnpauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type UnpauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // UpdateDomainRequest is an internal type (TBD...)
 type UpdateDomainRequest struct {
 	Name                                   string                             `json:"name,omitempty"`
@@ -6502,7 +7657,145 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
-// UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
+// UpdateScheduleRequest is an internal type (TBD...)
+type UpdateScheduleRequest struct {
+	Domain     string            `json:"domain,omitempty"`
+	ScheduleID string            `json:"scheduleId,omitempty"`
+	Spec       *ScheduleSpec     `json:"spec,omitempty"`
+	Action     *ScheduleAction   `json:"action,omitempty"`
+	Policies   *SchedulePolicies `json:"policies,omitempty"`
+	Identity   string            `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UpdateWorkflowExecutionRequest is an internal type (TBD...)
+type UpdateWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	UpdateName        string             `json:"updateName,omitempty"`
+	Input             []byte             `json:"input,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UpdateWorkflowExecutionResponse is an internal type (TBD...)
+type UpdateWorkflowExecutionResponse struct {
+	Result       []byte `json:"result,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetErrorMessage() (o string) {
+	if v != nil {
+		return v.ErrorMessage
+	}
+	return
+}
+
+// UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
 }
@@ -6816,6 +8109,37 @@ func (v *WorkflowExecutionFilter) GetWorkflowID() (o string) {
 	return
 }
 
+// WorkflowExecutionInactiveEventAttributes is an internal type (TBD...)
+type WorkflowExecutionInactiveEventAttributes struct {
+	InactivityTimeoutSeconds         int32                    `json:"inactivityTimeoutSeconds,omitempty"`
+	InactivityTimeoutPolicy          *InactivityTimeoutPolicy `json:"inactivityTimeoutPolicy,omitempty"`
+	LastDecisionTaskCompletedEventID int64                    `json:"lastDecisionTaskCompletedEventId,omitempty"`
+}
+
+// GetInactivityTimeoutSeconds is an internal getter (TBD...)
+func (v *WorkflowExecutionInactiveEventAttributes) GetInactivityTimeoutSeconds() (o int32) {
+	if v != nil {
+		return v.InactivityTimeoutSeconds
+	}
+	return
+}
+
+// GetInactivityTimeoutPolicy is an internal getter (TBD...)
+func (v *WorkflowExecutionInactiveEventAttributes) GetInactivityTimeoutPolicy() (o InactivityTimeoutPolicy) {
+	if v != nil && v.InactivityTimeoutPolicy != nil {
+		return *v.InactivityTimeoutPolicy
+	}
+	return
+}
+
+// GetLastDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionInactiveEventAttributes) GetLastDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.LastDecisionTaskCompletedEventID
+	}
+	return
+}
+
 // WorkflowExecutionInfo is an internal type (TBD...)
 type WorkflowExecutionInfo struct {
 	Execution         *WorkflowExecution            `json:"execution,omitempty"`
@@ -6834,6 +8158,7 @@ type WorkflowExecutionInfo struct {
 	AutoResetPoints   *ResetPoints                  `json:"autoResetPoints,omitempty"`
 	TaskList          string                        `json:"taskList,omitempty"`
 	IsCron            bool                          `json:"isCron,omitempty"`
+	IsPaused          bool                          `json:"isPaused,omitempty"`
 }
 
 // GetExecution is an internal getter (TBD...)
@@ -6892,6 +8217,36 @@ func (v *WorkflowExecutionInfo) GetSearchAttributes() (o *SearchAttributes) {
 	return
 }
 
+// GetIsPaused is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetIsPaused() (o bool) {
+	if v != nil {
+		return v.IsPaused
+	}
+	return
+}
+
+// WorkflowExecutionPausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
@@ -6925,32 +8280,34 @@ func (v *WorkflowExecutionSignaledEventAttributes) GetIdentity() (o string) {
 
 // WorkflowExecutionStartedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionStartedEventAttributes struct {
-	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
-	ParentWorkflowDomainID              *string                 `json:"parentWorkflowDomainID,omitempty"`
-	ParentWorkflowDomain                *string                 `json:"parentWorkflowDomain,omitempty"`
-	ParentWorkflowExecution             *WorkflowExecution      `json:"parentWorkflowExecution,omitempty"`
-	ParentInitiatedEventID              *int64                  `json:"parentInitiatedEventId,omitempty"`
-	TaskList                            *TaskList               `json:"taskList,omitempty"`
-	Input                               []byte                  `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	ContinuedExecutionRunID             string                  `json:"continuedExecutionRunId,omitempty"`
-	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
-	ContinuedFailureReason              *string                 `json:"continuedFailureReason,omitempty"`
-	ContinuedFailureDetails             []byte                  `json:"continuedFailureDetails,omitempty"`
-	LastCompletionResult                []byte                  `json:"lastCompletionResult,omitempty"`
-	OriginalExecutionRunID              string                  `json:"originalExecutionRunId,omitempty"`
-	Identity                            string                  `json:"identity,omitempty"`
-	FirstExecutionRunID                 string                  `json:"firstExecutionRunId,omitempty"`
-	RetryPolicy                         *RetryPolicy            `json:"retryPolicy,omitempty"`
-	Attempt                             int32                   `json:"attempt,omitempty"`
-	ExpirationTimestamp                 *int64                  `json:"expirationTimestamp,omitempty"`
-	CronSchedule                        string                  `json:"cronSchedule,omitempty"`
-	FirstDecisionTaskBackoffSeconds     *int32                  `json:"firstDecisionTaskBackoffSeconds,omitempty"`
-	Memo                                *Memo                   `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
-	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
-	Header                              *Header                 `json:"header,omitempty"`
+	WorkflowType                        *WorkflowType            `json:"workflowType,omitempty"`
+	ParentWorkflowDomainID              *string                  `json:"parentWorkflowDomainID,omitempty"`
+	ParentWorkflowDomain                *string                  `json:"parentWorkflowDomain,omitempty"`
+	ParentWorkflowExecution             *WorkflowExecution       `json:"parentWorkflowExecution,omitempty"`
+	ParentInitiatedEventID              *int64                   `json:"parentInitiatedEventId,omitempty"`
+	TaskList                            *TaskList                `json:"taskList,omitempty"`
+	Input                               []byte                   `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                   `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                   `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	ContinuedExecutionRunID             string                   `json:"continuedExecutionRunId,omitempty"`
+	Initiator                           *ContinueAsNewInitiator  `json:"initiator,omitempty"`
+	ContinuedFailureReason              *string                  `json:"continuedFailureReason,omitempty"`
+	ContinuedFailureDetails             []byte                   `json:"continuedFailureDetails,omitempty"`
+	LastCompletionResult                []byte                   `json:"lastCompletionResult,omitempty"`
+	OriginalExecutionRunID              string                   `json:"originalExecutionRunId,omitempty"`
+	Identity                            string                   `json:"identity,omitempty"`
+	FirstExecutionRunID                 string                   `json:"firstExecutionRunId,omitempty"`
+	RetryPolicy                         *RetryPolicy             `json:"retryPolicy,omitempty"`
+	Attempt                             int32                    `json:"attempt,omitempty"`
+	ExpirationTimestamp                 *int64                   `json:"expirationTimestamp,omitempty"`
+	CronSchedule                        string                   `json:"cronSchedule,omitempty"`
+	FirstDecisionTaskBackoffSeconds     *int32                   `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	Memo                                *Memo                    `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes        `json:"searchAttributes,omitempty"`
+	PrevAutoResetPoints                 *ResetPoints             `json:"prevAutoResetPoints,omitempty"`
+	Header                              *Header                  `json:"header,omitempty"`
+	InactivityTimeoutSeconds            *int32                   `json:"inactivityTimeoutSeconds,omitempty"`
+	InactivityTimeoutPolicy             *InactivityTimeoutPolicy `json:"inactivityTimeoutPolicy,omitempty"`
 }
 
 // GetParentWorkflowDomain is an internal getter (TBD...)
@@ -7065,6 +8422,22 @@ func (v *WorkflowExecutionStartedEventAttributes) GetPrevAutoResetPoints() (o *R
 	return
 }
 
+// GetInactivityTimeoutSeconds is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetInactivityTimeoutSeconds() (o int32) {
+	if v != nil && v.InactivityTimeoutSeconds != nil {
+		return *v.InactivityTimeoutSeconds
+	}
+	return
+}
+
+// GetInactivityTimeoutPolicy is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetInactivityTimeoutPolicy() (o InactivityTimeoutPolicy) {
+	if v != nil && v.InactivityTimeoutPolicy != nil {
+		return *v.InactivityTimeoutPolicy
+	}
+	return
+}
+
 // WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   string `json:"reason,omitempty"`
@@ -7088,6 +8461,157 @@ func (v *WorkflowExecutionTerminatedEventAttributes) GetIdentity() (o string) {
 	return
 }
 
+// WorkflowExecutionUnpausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// WorkflowExecutionUpdateRequestedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateRequestedEventAttributes struct {
+	UpdateID   string `json:"updateId,omitempty"`
+	UpdateName string `json:"updateName,omitempty"`
+	Input      []byte `json:"input,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRequestedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// WorkflowExecutionUpdateCompletedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateCompletedEventAttributes struct {
+	UpdateID                     string `json:"updateId,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	Result                       []byte `json:"result,omitempty"`
+	ErrorMessage                 string `json:"errorMessage,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetErrorMessage() (o string) {
+	if v != nil {
+		return v.ErrorMessage
+	}
+	return
+}
+
+// WorkflowHistoryCompactedEventAttributes is an internal type (TBD...)
+type WorkflowHistoryCompactedEventAttributes struct {
+	MarkerName           string    `json:"markerName,omitempty"`
+	MarkerDetails        []byte    `json:"markerDetails,omitempty"`
+	MarkerEventID        int64     `json:"markerEventId,omitempty"`
+	MutableStateSnapshot *DataBlob `json:"mutableStateSnapshot,omitempty"`
+}
+
+// GetMarkerName is an internal getter (TBD...)
+func (v *WorkflowHistoryCompactedEventAttributes) GetMarkerName() (o string) {
+	if v != nil {
+		return v.MarkerName
+	}
+	return
+}
+
+// GetMarkerDetails is an internal getter (TBD...)
+func (v *WorkflowHistoryCompactedEventAttributes) GetMarkerDetails() (o []byte) {
+	if v != nil && v.MarkerDetails != nil {
+		return v.MarkerDetails
+	}
+	return
+}
+
+// GetMarkerEventID is an internal getter (TBD...)
+func (v *WorkflowHistoryCompactedEventAttributes) GetMarkerEventID() (o int64) {
+	if v != nil {
+		return v.MarkerEventID
+	}
+	return
+}
+
+// GetMutableStateSnapshot is an internal getter (TBD...)
+func (v *WorkflowHistoryCompactedEventAttributes) GetMutableStateSnapshot() (o *DataBlob) {
+	if v != nil && v.MutableStateSnapshot != nil {
+		return v.MutableStateSnapshot
+	}
+	return
+}
+
 // WorkflowExecutionTimedOutEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTimedOutEventAttributes struct {
 	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
@@ -7219,6 +8743,90 @@ func (v *WorkflowQueryResult) GetErrorMessage() (o string) {
 	return
 }
 
+// WorkflowSearchAttributesReappliedEventAttributes is an internal type (TBD...)
+type WorkflowSearchAttributesReappliedEventAttributes struct {
+	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
+}
+
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *WorkflowSearchAttributesReappliedEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
+}
+
+// WorkflowUpdateInfo is an internal type (TBD...)
+type WorkflowUpdateInfo struct {
+	UpdateID                     string `json:"updateId,omitempty"`
+	AfterEventID                 int64  `json:"afterEventId,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	CompletedEventID             int64  `json:"completedEventId,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowUpdateInfo) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetAfterEventID is an internal getter (TBD...)
+func (v *WorkflowUpdateInfo) GetAfterEventID() (o int64) {
+	if v != nil {
+		return v.AfterEventID
+	}
+	return
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowUpdateInfo) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowUpdateInfo) GetCompletedEventID() (o int64) {
+	if v != nil {
+		return v.CompletedEventID
+	}
+	return
+}
+
+// WorkflowUpdateResult is an internal type (TBD...)
+type WorkflowUpdateResult struct {
+	ResultType   *QueryResultType `json:"resultType,omitempty"`
+	Result       []byte           `json:"result,omitempty"`
+	ErrorMessage string           `json:"errorMessage,omitempty"`
+}
+
+// GetResultType is an internal getter (TBD...)
+func (v *WorkflowUpdateResult) GetResultType() (o QueryResultType) {
+	if v != nil && v.ResultType != nil {
+		return *v.ResultType
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *WorkflowUpdateResult) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *WorkflowUpdateResult) GetErrorMessage() (o string) {
+	if v != nil {
+		return v.ErrorMessage
+	}
+	return
+}
+
 // WorkflowType is an internal type (TBD...)
 type WorkflowType struct {
 	Name string `json:"name,omitempty"`
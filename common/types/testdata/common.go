@@ -37,16 +37,20 @@ const (
 	ActivityID = "ActivityID"
 	RequestID  = "RequestID"
 	TimerID    = "TimerID"
+	UpdateID   = "UpdateID"
+	ScheduleID = "ScheduleID"
 
 	WorkflowTypeName     = "WorkflowTypeName"
 	ActivityTypeName     = "ActivityTypeName"
 	TaskListName         = "TaskListName"
 	MarkerName           = "MarkerName"
 	SignalName           = "SignalName"
+	UpdateName           = "UpdateName"
 	QueryType            = "QueryType"
 	HostName             = "HostName"
 	Identity             = "Identity"
 	CronSchedule         = "CronSchedule"
+	TimeZone             = "TimeZone"
 	Checksum             = "Checksum"
 	Reason               = "Reason"
 	Cause                = "Cause"
@@ -335,7 +339,23 @@ var (
 		AutoResetPoints:   &ResetPoints,
 		TaskList:          TaskListName,
 	}
-	WorkflowExecutionInfoArray = []*types.WorkflowExecutionInfo{&WorkflowExecutionInfo}
+	WorkflowExecutionInfoArray  = []*types.WorkflowExecutionInfo{&WorkflowExecutionInfo}
+	PausedWorkflowExecutionInfo = types.WorkflowExecutionInfo{
+		Execution:         &WorkflowExecution,
+		Type:              &WorkflowType,
+		StartTime:         &Timestamp1,
+		HistoryLength:     HistoryLength,
+		ParentDomainID:    common.StringPtr(DomainID),
+		ParentDomain:      common.StringPtr(DomainName),
+		ParentExecution:   &WorkflowExecution,
+		ParentInitiatedID: common.Int64Ptr(EventID1),
+		ExecutionTime:     &Timestamp3,
+		Memo:              &Memo,
+		SearchAttributes:  &SearchAttributes,
+		AutoResetPoints:   &ResetPoints,
+		TaskList:          TaskListName,
+		IsPaused:          true,
+	}
 
 	WorkflowQuery = types.WorkflowQuery{
 		QueryType: QueryType,
@@ -352,6 +372,14 @@ var (
 	WorkflowQueryResultMap = map[string]*types.WorkflowQueryResult{
 		"WorkflowQuery1": &WorkflowQueryResult,
 	}
+	WorkflowUpdateResult = types.WorkflowUpdateResult{
+		ResultType:   &QueryResultType,
+		Result:       Payload1,
+		ErrorMessage: ErrorMessage,
+	}
+	WorkflowUpdateResultMap = map[string]*types.WorkflowUpdateResult{
+		"WorkflowUpdate1": &WorkflowUpdateResult,
+	}
 	QueryRejected = types.QueryRejected{
 		CloseStatus: &WorkflowExecutionCloseStatus,
 	}
@@ -375,9 +403,40 @@ var (
 		LastWorkerIdentity:     Identity,
 		LastFailureDetails:     FailureDetails,
 	}
+	PendingActivityInfoWithAttemptLog = types.PendingActivityInfo{
+		ActivityID:         ActivityID,
+		ActivityType:       &ActivityType,
+		State:              &PendingActivityState,
+		Attempt:            Attempt,
+		LastFailureReason:  &FailureReason,
+		LastWorkerIdentity: Identity,
+		LastFailureDetails: FailureDetails,
+		AttemptLog:         ActivityAttemptInfoArray,
+	}
 	PendingActivityInfoArray = []*types.PendingActivityInfo{
 		&PendingActivityInfo,
 	}
+	ActivityAttemptInfo = types.ActivityAttemptInfo{
+		Attempt:                   Attempt - 1,
+		StartedTimestamp:          Timestamp1,
+		FinishedTimestamp:         Timestamp2,
+		Identity:                  Identity,
+		FailureReason:             FailureReason,
+		FailureDetails:            FailureDetails,
+		HeartbeatDetailsTruncated: true,
+	}
+	ActivityAttemptInfoArray = []*types.ActivityAttemptInfo{
+		&ActivityAttemptInfo,
+	}
+	WorkflowUpdateInfo = types.WorkflowUpdateInfo{
+		UpdateID:                     UpdateID,
+		AfterEventID:                 EventID1,
+		DecisionTaskCompletedEventID: EventID2,
+		CompletedEventID:             EventID3,
+	}
+	WorkflowUpdateInfoArray = []*types.WorkflowUpdateInfo{
+		&WorkflowUpdateInfo,
+	}
 	PendingChildExecutionInfo = types.PendingChildExecutionInfo{
 		Domain:            DomainName,
 		WorkflowID:        WorkflowID,
@@ -396,4 +455,31 @@ var (
 		Attempt:                    Attempt,
 		OriginalScheduledTimestamp: &Timestamp3,
 	}
+	ScheduleOverlapPolicy = types.ScheduleOverlapPolicyBuffer
+	ScheduleSpec          = types.ScheduleSpec{
+		CronExpression:  CronSchedule,
+		TimeZone:        TimeZone,
+		JitterInSeconds: Duration1,
+		StartTimeNano:   &Timestamp1,
+		EndTimeNano:     &Timestamp2,
+	}
+	ScheduleAction = types.ScheduleAction{
+		WorkflowType:                        &WorkflowType,
+		TaskList:                            &TaskList,
+		Input:                               Payload1,
+		ExecutionStartToCloseTimeoutSeconds: Duration1,
+		TaskStartToCloseTimeoutSeconds:      Duration2,
+		WorkflowIDPrefix:                    WorkflowID,
+	}
+	SchedulePolicies = types.SchedulePolicies{
+		OverlapPolicy: &ScheduleOverlapPolicy,
+	}
+	ScheduleRunInfo = types.ScheduleRunInfo{
+		ScheduledTimeNano: &Timestamp3,
+		StartTimeNano:     &Timestamp4,
+		WorkflowExecution: &WorkflowExecution,
+	}
+	ScheduleRunInfoArray = []*types.ScheduleRunInfo{
+		&ScheduleRunInfo,
+	}
 )
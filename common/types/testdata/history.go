@@ -245,6 +245,34 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_WorkflowExecutionPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		e.WorkflowExecutionPausedEventAttributes = &WorkflowExecutionPausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		e.WorkflowExecutionUnpausedEventAttributes = &WorkflowExecutionUnpausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateRequested = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateRequested.Ptr()
+		e.WorkflowExecutionUpdateRequestedEventAttributes = &WorkflowExecutionUpdateRequestedEventAttributes
+	})
+	HistoryEvent_WorkflowHistoryCompacted = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowHistoryCompacted.Ptr()
+		e.WorkflowHistoryCompactedEventAttributes = &WorkflowHistoryCompactedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionInactive = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionInactive.Ptr()
+		e.WorkflowExecutionInactiveEventAttributes = &WorkflowExecutionInactiveEventAttributes
+	})
+	HistoryEvent_WorkflowSearchAttributesReapplied = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowSearchAttributesReapplied.Ptr()
+		e.WorkflowSearchAttributesReappliedEventAttributes = &WorkflowSearchAttributesReappliedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateCompleted = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateCompleted.Ptr()
+		e.WorkflowExecutionUpdateCompletedEventAttributes = &WorkflowExecutionUpdateCompletedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -569,6 +597,41 @@ var (
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
 	}
+	WorkflowSearchAttributesReappliedEventAttributes = types.WorkflowSearchAttributesReappliedEventAttributes{
+		SearchAttributes: &SearchAttributes,
+	}
+	WorkflowExecutionPausedEventAttributes = types.WorkflowExecutionPausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
+	WorkflowExecutionUnpausedEventAttributes = types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
+	WorkflowExecutionUpdateRequestedEventAttributes = types.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   UpdateID,
+		UpdateName: UpdateName,
+		Input:      Payload1,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	WorkflowExecutionUpdateCompletedEventAttributes = types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     UpdateID,
+		DecisionTaskCompletedEventID: EventID1,
+		Result:                       Payload1,
+		ErrorMessage:                 ErrorMessage,
+	}
+	WorkflowHistoryCompactedEventAttributes = types.WorkflowHistoryCompactedEventAttributes{
+		MarkerName:           MarkerName,
+		MarkerDetails:        Payload1,
+		MarkerEventID:        EventID1,
+		MutableStateSnapshot: &DataBlob,
+	}
+	WorkflowExecutionInactiveEventAttributes = types.WorkflowExecutionInactiveEventAttributes{
+		InactivityTimeoutSeconds:         Duration1,
+		InactivityTimeoutPolicy:          types.InactivityTimeoutPolicyNotify.Ptr(),
+		LastDecisionTaskCompletedEventID: EventID1,
+	}
 	GetFailoverInfoRequest = types.GetFailoverInfoRequest{
 		DomainID: uuid.NewUUID().String(),
 	}
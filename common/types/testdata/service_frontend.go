@@ -198,6 +198,18 @@ var (
 		BinaryChecksum:             Checksum,
 		QueryResults:               WorkflowQueryResultMap,
 	}
+	RespondDecisionTaskCompletedRequestWithUpdateResults = types.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  TaskToken,
+		Decisions:                  DecisionArray,
+		ExecutionContext:           ExecutionContext,
+		Identity:                   Identity,
+		StickyAttributes:           &StickyExecutionAttributes,
+		ReturnNewDecisionTask:      true,
+		ForceCreateNewDecisionTask: true,
+		BinaryChecksum:             Checksum,
+		QueryResults:               WorkflowQueryResultMap,
+		UpdateResults:              WorkflowUpdateResultMap,
+	}
 	RespondDecisionTaskCompletedResponse = types.RespondDecisionTaskCompletedResponse{
 		DecisionTask:                &PollForDecisionTaskResponse,
 		ActivitiesToDispatchLocally: ActivityLocalDispatchInfoMap,
@@ -319,6 +331,27 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		DelayStartSeconds:                   &Duration1,
+	}
+	StartWorkflowExecutionRequestWithInactivityTimeout = types.StartWorkflowExecutionRequest{
+		Domain:                              DomainName,
+		WorkflowID:                          WorkflowID,
+		WorkflowType:                        &WorkflowType,
+		TaskList:                            &TaskList,
+		Input:                               Payload1,
+		ExecutionStartToCloseTimeoutSeconds: &Duration1,
+		TaskStartToCloseTimeoutSeconds:      &Duration2,
+		Identity:                            Identity,
+		RequestID:                           RequestID,
+		WorkflowIDReusePolicy:               &WorkflowIDReusePolicy,
+		RetryPolicy:                         &RetryPolicy,
+		CronSchedule:                        CronSchedule,
+		Memo:                                &Memo,
+		SearchAttributes:                    &SearchAttributes,
+		Header:                              &Header,
+		DelayStartSeconds:                   &Duration1,
+		InactivityTimeoutSeconds:            &Duration2,
+		InactivityTimeoutPolicy:             types.InactivityTimeoutPolicyNotify.Ptr(),
 	}
 	StartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -351,6 +384,30 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		DelayStartSeconds:                   &Duration1,
+	}
+	SignalWithStartWorkflowExecutionRequestWithInactivityTimeout = types.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              DomainName,
+		WorkflowID:                          WorkflowID,
+		WorkflowType:                        &WorkflowType,
+		TaskList:                            &TaskList,
+		Input:                               Payload1,
+		ExecutionStartToCloseTimeoutSeconds: &Duration1,
+		TaskStartToCloseTimeoutSeconds:      &Duration2,
+		Identity:                            Identity,
+		RequestID:                           RequestID,
+		WorkflowIDReusePolicy:               &WorkflowIDReusePolicy,
+		SignalName:                          SignalName,
+		SignalInput:                         Payload2,
+		Control:                             Control,
+		RetryPolicy:                         &RetryPolicy,
+		CronSchedule:                        CronSchedule,
+		Memo:                                &Memo,
+		SearchAttributes:                    &SearchAttributes,
+		Header:                              &Header,
+		DelayStartSeconds:                   &Duration1,
+		InactivityTimeoutSeconds:            &Duration2,
+		InactivityTimeoutPolicy:             types.InactivityTimeoutPolicyFail.Ptr(),
 	}
 	ResetWorkflowExecutionRequest = types.ResetWorkflowExecutionRequest{
 		Domain:                DomainName,
@@ -370,6 +427,98 @@ var (
 		Details:           Payload1,
 		Identity:          Identity,
 	}
+	PauseWorkflowExecutionRequest = types.PauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
+	UnpauseWorkflowExecutionRequest = types.UnpauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
+	UpdateWorkflowExecutionRequest = types.UpdateWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		UpdateName:        UpdateName,
+		Input:             Payload1,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	UpdateWorkflowExecutionResponse = types.UpdateWorkflowExecutionResponse{
+		Result:       Payload2,
+		ErrorMessage: ErrorMessage,
+	}
+	CreateScheduleRequest = types.CreateScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Spec:       &ScheduleSpec,
+		Action:     &ScheduleAction,
+		Policies:   &SchedulePolicies,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	DescribeScheduleRequest = types.DescribeScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+	}
+	DescribeScheduleResponse = types.DescribeScheduleResponse{
+		Spec:                 &ScheduleSpec,
+		Action:               &ScheduleAction,
+		Policies:             &SchedulePolicies,
+		Paused:               true,
+		PauseReason:          Reason,
+		LastRun:              &ScheduleRunInfo,
+		RecentRuns:           ScheduleRunInfoArray,
+		UpcomingRunTimesNano: []int64{Timestamp5},
+		BufferedRuns:         Duration1,
+		TotalRuns:            EventID3,
+		SkippedRuns:          EventID2,
+		FailedRuns:           EventID1,
+	}
+	UpdateScheduleRequest = types.UpdateScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Spec:       &ScheduleSpec,
+		Action:     &ScheduleAction,
+		Policies:   &SchedulePolicies,
+		Identity:   Identity,
+	}
+	PauseScheduleRequest = types.PauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Reason:     Reason,
+		Identity:   Identity,
+	}
+	UnpauseScheduleRequest = types.UnpauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Identity:   Identity,
+	}
+	DeleteScheduleRequest = types.DeleteScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Identity:   Identity,
+	}
+	BackfillScheduleRequest = types.BackfillScheduleRequest{
+		Domain:        DomainName,
+		ScheduleID:    ScheduleID,
+		StartTimeNano: &Timestamp1,
+		EndTimeNano:   &Timestamp2,
+		OverlapPolicy: &ScheduleOverlapPolicy,
+		Identity:      Identity,
+	}
+	ListSchedulesRequest = types.ListSchedulesRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	ListSchedulesResponse = types.ListSchedulesResponse{
+		ScheduleIDs:   []string{ScheduleID},
+		NextPageToken: NextPageToken,
+	}
 	DescribeWorkflowExecutionRequest = types.DescribeWorkflowExecutionRequest{
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
@@ -402,6 +551,18 @@ var (
 		Pollers:        PollerInfoArray,
 		TaskListStatus: &TaskListStatus,
 	}
+	DescribeTaskListResponseWithPartitionConfig = types.DescribeTaskListResponse{
+		Pollers:        PollerInfoArray,
+		TaskListStatus: &TaskListStatus,
+		PartitionConfig: &types.TaskListPartitionConfig{
+			NumReadPartitions:  3,
+			NumWritePartitions: 2,
+			ScalingDecisions: []*types.TaskListPartitionScalingDecision{
+				{Timestamp: Timestamp1, NumReadPartitions: 3, NumWritePartitions: 3, Reason: "add rate above target"},
+				{Timestamp: Timestamp2, NumReadPartitions: 3, NumWritePartitions: 2, Reason: "add rate below target"},
+			},
+		},
+	}
 	ListTaskListPartitionsRequest = types.ListTaskListPartitionsRequest{
 		Domain:   DomainName,
 		TaskList: &TaskList,
@@ -44,7 +44,13 @@ var (
 		DomainUUID: DomainID,
 		Request:    &DescribeWorkflowExecutionRequest,
 	}
-	HistoryDescribeWorkflowExecutionResponse = DescribeWorkflowExecutionResponse
+	HistoryDescribeWorkflowExecutionResponse = types.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: &WorkflowExecutionConfiguration,
+		WorkflowExecutionInfo:  &PausedWorkflowExecutionInfo,
+		PendingActivities:      []*types.PendingActivityInfo{&PendingActivityInfoWithAttemptLog},
+		PendingChildren:        PendingChildExecutionInfoArray,
+		PendingDecision:        &PendingDecisionInfo,
+	}
 	HistoryGetDLQReplicationMessagesRequest  = AdminGetDLQReplicationMessagesRequest
 	HistoryGetDLQReplicationMessagesResponse = AdminGetDLQReplicationMessagesResponse
 	HistoryGetMutableStateRequest            = types.GetMutableStateRequest{
@@ -202,8 +208,20 @@ var (
 		Execution:  &WorkflowExecution,
 	}
 	HistoryResetWorkflowExecutionRequest = types.HistoryResetWorkflowExecutionRequest{
-		DomainUUID:   DomainID,
-		ResetRequest: &ResetWorkflowExecutionRequest,
+		DomainUUID: DomainID,
+		ResetRequest: &types.ResetWorkflowExecutionRequest{
+			Domain:                DomainName,
+			WorkflowExecution:     &WorkflowExecution,
+			Reason:                Reason,
+			DecisionFinishEventID: EventID1,
+			RequestID:             RequestID,
+			SkipSignalReapply:     true,
+			ResetChildPolicy:      ResetChildPolicy.Ptr(),
+			ReapplyPolicy: &types.ResetReapplyPolicy{
+				ReapplySignals:        true,
+				ReapplyCancelRequests: true,
+			},
+		},
 	}
 	HistoryResetWorkflowExecutionResponse = types.ResetWorkflowExecutionResponse{
 		RunID: RunID,
@@ -224,6 +242,10 @@ var (
 		DomainUUID:      DomainID,
 		CompleteRequest: &RespondDecisionTaskCompletedRequest,
 	}
+	HistoryRespondDecisionTaskCompletedRequestWithUpdateResults = types.HistoryRespondDecisionTaskCompletedRequest{
+		DomainUUID:      DomainID,
+		CompleteRequest: &RespondDecisionTaskCompletedRequestWithUpdateResults,
+	}
 	HistoryRespondDecisionTaskCompletedResponse = types.HistoryRespondDecisionTaskCompletedResponse{
 		StartedResponse:             &HistoryRecordDecisionTaskStartedResponse,
 		ActivitiesToDispatchLocally: ActivityLocalDispatchInfoMap,
@@ -239,7 +261,7 @@ var (
 	}
 	HistorySignalWithStartWorkflowExecutionRequest = types.HistorySignalWithStartWorkflowExecutionRequest{
 		DomainUUID:             DomainID,
-		SignalWithStartRequest: &SignalWithStartWorkflowExecutionRequest,
+		SignalWithStartRequest: &SignalWithStartWorkflowExecutionRequestWithInactivityTimeout,
 	}
 	HistorySignalWithStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -252,7 +274,7 @@ var (
 	}
 	HistoryStartWorkflowExecutionRequest = types.HistoryStartWorkflowExecutionRequest{
 		DomainUUID:                      DomainID,
-		StartRequest:                    &StartWorkflowExecutionRequest,
+		StartRequest:                    &StartWorkflowExecutionRequestWithInactivityTimeout,
 		ParentExecutionInfo:             &ParentExecutionInfo,
 		Attempt:                         Attempt,
 		ExpirationTimestamp:             &Timestamp1,
@@ -293,6 +315,19 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryPauseWorkflowExecutionRequest = types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseWorkflowExecutionRequest,
+	}
+	HistoryUnpauseWorkflowExecutionRequest = types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseWorkflowExecutionRequest,
+	}
+	HistoryUpdateWorkflowExecutionRequest = types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		UpdateRequest: &UpdateWorkflowExecutionRequest,
+	}
+	HistoryUpdateWorkflowExecutionResponse           = UpdateWorkflowExecutionResponse
 	HistoryGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
@@ -26,8 +26,16 @@ import (
 )
 
 const (
-	ForwardedFrom = "ForwardedFrom"
-	PollerID      = "PollerID"
+	ForwardedFrom     = "ForwardedFrom"
+	PollerID          = "PollerID"
+	TaskPriority      = 5
+	TaskLabelSelector = "gpu=true"
+	PollerCapacity    = 10
+	IsolationGroup    = "zone1"
+)
+
+var (
+	PollerLabels = map[string]string{"gpu": "true", "zone": "zone1"}
 )
 
 var (
@@ -40,6 +48,9 @@ var (
 		ScheduleToStartTimeoutSeconds: &Duration1,
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
+		Priority:                      TaskPriority,
+		LabelSelector:                 TaskLabelSelector,
+		IsolationGroup:                IsolationGroup,
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -49,6 +60,8 @@ var (
 		ScheduleToStartTimeoutSeconds: &Duration1,
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
+		Priority:                      TaskPriority,
+		IsolationGroup:                IsolationGroup,
 	}
 	MatchingCancelOutstandingPollRequest = types.CancelOutstandingPollRequest{
 		DomainUUID:   DomainID,
@@ -64,6 +77,79 @@ var (
 		Pollers:        PollerInfoArray,
 		TaskListStatus: &TaskListStatus,
 	}
+	MatchingDescribeTaskListConfigRequest = types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID: DomainID,
+		DescRequest: &types.DescribeTaskListConfigRequest{
+			TaskList:     &TaskList,
+			TaskListType: types.TaskListTypeActivity.Ptr(),
+		},
+	}
+	MatchingUpdateTaskListConfigRequest = types.MatchingUpdateTaskListConfigRequest{
+		DomainUUID: DomainID,
+		UpdateRequest: &types.UpdateTaskListConfigRequest{
+			TaskList:              &TaskList,
+			TaskListType:          types.TaskListTypeActivity.Ptr(),
+			MaxDispatchPerSecond:  common.Float64Ptr(10),
+			MaxBacklogSize:        common.Int64Ptr(1000),
+			RejectWhenBacklogFull: common.BoolPtr(true),
+			MaxOutstandingTasks:   common.Int32Ptr(100),
+		},
+	}
+	MatchingTaskListConfig = types.TaskListConfig{
+		MaxDispatchPerSecond:  10,
+		MaxBacklogSize:        1000,
+		RejectWhenBacklogFull: true,
+		MaxOutstandingTasks:   100,
+	}
+	MatchingRequeueDeadLetterTasksRequest = types.MatchingRequeueDeadLetterTasksRequest{
+		DomainUUID: DomainID,
+		RequeueRequest: &types.RequeueDeadLetterTasksRequest{
+			TaskList:     &TaskList,
+			TaskListType: types.TaskListTypeActivity.Ptr(),
+			Reason:       common.DeadLetterReasonDispatchFailed,
+			TaskID:       TaskID,
+			MaxTasks:     100,
+		},
+	}
+	MatchingRequeueDeadLetterTasksResponse = types.RequeueDeadLetterTasksResponse{
+		Requeued: 10,
+		Skipped:  2,
+	}
+	MatchingMigrateTaskListRequest = types.MatchingMigrateTaskListRequest{
+		DomainUUID: DomainID,
+		MigrateRequest: &types.MigrateTaskListRequest{
+			TaskList:       &TaskList,
+			TaskListType:   types.TaskListTypeActivity.Ptr(),
+			TargetTaskList: TaskListName,
+			MaxTasks:       100,
+		},
+	}
+	MatchingMigrateTaskListResponse = types.MigrateTaskListResponse{
+		Migrated: 10,
+		Expired:  2,
+	}
+	MatchingDeleteTaskListRequest = types.MatchingDeleteTaskListRequest{
+		DomainUUID: DomainID,
+		DeleteRequest: &types.DeleteTaskListRequest{
+			TaskList:     &TaskList,
+			TaskListType: types.TaskListTypeDecision.Ptr(),
+		},
+	}
+	MatchingListStickyTaskListsRequest = types.MatchingListStickyTaskListsRequest{
+		DomainUUID: DomainID,
+		ListRequest: &types.ListStickyTaskListsRequest{
+			Domain: DomainName,
+		},
+	}
+	MatchingListStickyTaskListsResponse = types.MatchingListStickyTaskListsResponse{
+		TaskLists: []*types.MatchingStickyTaskList{
+			{
+				Name:              TaskListName,
+				Identity:          Identity,
+				LastPollTimestamp: &Timestamp1,
+			},
+		},
+	}
 	MatchingListTaskListPartitionsRequest = types.MatchingListTaskListPartitionsRequest{
 		Domain:   DomainName,
 		TaskList: &TaskList,
@@ -73,10 +159,13 @@ var (
 		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
 	}
 	MatchingPollForActivityTaskRequest = types.MatchingPollForActivityTaskRequest{
-		DomainUUID:    DomainID,
-		PollerID:      PollerID,
-		PollRequest:   &PollForActivityTaskRequest,
-		ForwardedFrom: ForwardedFrom,
+		DomainUUID:     DomainID,
+		PollerID:       PollerID,
+		PollRequest:    &PollForActivityTaskRequest,
+		ForwardedFrom:  ForwardedFrom,
+		PollerLabels:   PollerLabels,
+		PollerCapacity: PollerCapacity,
+		IsolationGroup: IsolationGroup,
 	}
 	MatchingPollForActivityTaskResponse = types.PollForActivityTaskResponse{
 		TaskToken:                       TaskToken,
@@ -97,10 +186,13 @@ var (
 		Header:                          &Header,
 	}
 	MatchingPollForDecisionTaskRequest = types.MatchingPollForDecisionTaskRequest{
-		DomainUUID:    DomainID,
-		PollerID:      PollerID,
-		PollRequest:   &PollForDecisionTaskRequest,
-		ForwardedFrom: ForwardedFrom,
+		DomainUUID:     DomainID,
+		PollerID:       PollerID,
+		PollRequest:    &PollForDecisionTaskRequest,
+		ForwardedFrom:  ForwardedFrom,
+		PollerLabels:   PollerLabels,
+		PollerCapacity: PollerCapacity,
+		IsolationGroup: IsolationGroup,
 	}
 	MatchingPollForDecisionTaskResponse = types.MatchingPollForDecisionTaskResponse{
 		TaskToken:                 TaskToken,
@@ -632,6 +632,91 @@ func ValidateDomainUUID(
 	return nil
 }
 
+// GetTaskPriorityFromHeader returns the task priority set in the header and whether
+// the header contains a valid task priority
+func GetTaskPriorityFromHeader(header *types.Header) (int32, bool) {
+	if header == nil {
+		return 0, false
+	}
+	value, ok := header.Fields[TaskPriorityHeaderKey]
+	if !ok {
+		return 0, false
+	}
+	// clients may json encode header values, so tolerate surrounding quotes
+	priority, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(string(value)), `"`), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(priority), true
+}
+
+// ParseLabels parses a comma separated list of key=value pairs, such as the labels
+// advertised by a poller or the label selector of a task, e.g. "gpu=true,zone=dca1"
+func ParseLabels(value string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || key == "" {
+			return nil, fmt.Errorf("invalid label %q, expected key=value", pair)
+		}
+		labels[key] = strings.TrimSpace(parts[1])
+	}
+	return labels, nil
+}
+
+// FormatLabels formats the labels as a comma separated list of key=value pairs sorted by key
+func FormatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// GetTaskLabelSelectorFromHeader returns the label selector set in the header in its canonical
+// form, or an empty string if the header does not contain a valid label selector
+func GetTaskLabelSelectorFromHeader(header *types.Header) string {
+	if header == nil {
+		return ""
+	}
+	value, ok := header.Fields[TaskLabelSelectorHeaderKey]
+	if !ok {
+		return ""
+	}
+	// clients may json encode header values, so tolerate surrounding quotes
+	selector, err := ParseLabels(strings.Trim(strings.TrimSpace(string(value)), `"`))
+	if err != nil {
+		return ""
+	}
+	return FormatLabels(selector)
+}
+
+// GetTaskIsolationGroupFromHeader returns the isolation group set in the header,
+// or an empty string if the header does not contain an isolation group
+func GetTaskIsolationGroupFromHeader(header *types.Header) string {
+	if header == nil {
+		return ""
+	}
+	value, ok := header.Fields[TaskIsolationGroupHeaderKey]
+	if !ok {
+		return ""
+	}
+	// clients may json encode header values, so tolerate surrounding quotes
+	return strings.TrimSpace(strings.Trim(strings.TrimSpace(string(value)), `"`))
+}
+
+// DeadLetterTaskListName returns the name of the task list holding the tasks of the given task list
+// that were dead-lettered for the given reason
+func DeadLetterTaskListName(taskList string, reason string) string {
+	return DeadLetterTaskListPrefix + reason + "/" + taskList
+}
+
 // GetSizeOfMapStringToByteArray get size of map[string][]byte
 func GetSizeOfMapStringToByteArray(input map[string][]byte) int {
 	if input == nil {
@@ -712,6 +797,13 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		res += len(event.MarkerRecordedEventAttributes.Details)
 	case types.EventTypeWorkflowExecutionSignaled:
 		res += len(event.WorkflowExecutionSignaledEventAttributes.Input)
+	case types.EventTypeWorkflowExecutionUpdateRequested:
+		res += len(event.WorkflowExecutionUpdateRequestedEventAttributes.Input)
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		res += len(event.WorkflowExecutionUpdateCompletedEventAttributes.Result)
+	case types.EventTypeWorkflowHistoryCompacted:
+		res += len(event.WorkflowHistoryCompactedEventAttributes.MarkerDetails)
+		res += len(event.WorkflowHistoryCompactedEventAttributes.MutableStateSnapshot.GetData())
 	case types.EventTypeWorkflowExecutionTerminated:
 		res += len(event.WorkflowExecutionTerminatedEventAttributes.Details)
 	case types.EventTypeWorkflowExecutionContinuedAsNew:
@@ -765,6 +857,10 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		if event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes != nil {
 			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
 		}
+	case types.EventTypeWorkflowSearchAttributesReapplied:
+		if event.WorkflowSearchAttributesReappliedEventAttributes.SearchAttributes != nil {
+			res += GetSizeOfMapStringToByteArray(event.WorkflowSearchAttributesReappliedEventAttributes.SearchAttributes.IndexedFields)
+		}
 	}
 	return uint64(res)
 }
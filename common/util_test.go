@@ -245,3 +245,119 @@ func TestConvertErrToGetTaskFailedCause(t *testing.T) {
 		require.Equal(t, tc.expectedFailedCause, ConvertErrToGetTaskFailedCause(tc.err))
 	}
 }
+
+func TestGetTaskPriorityFromHeader(t *testing.T) {
+	testCases := []struct {
+		header           *types.Header
+		expectedPriority int32
+		expectedOK       bool
+	}{
+		{
+			header:           nil,
+			expectedPriority: 0,
+			expectedOK:       false,
+		},
+		{
+			header:           &types.Header{Fields: map[string][]byte{"other": []byte("5")}},
+			expectedPriority: 0,
+			expectedOK:       false,
+		},
+		{
+			header:           &types.Header{Fields: map[string][]byte{TaskPriorityHeaderKey: []byte("5")}},
+			expectedPriority: 5,
+			expectedOK:       true,
+		},
+		{
+			header:           &types.Header{Fields: map[string][]byte{TaskPriorityHeaderKey: []byte(`"-3"`)}},
+			expectedPriority: -3,
+			expectedOK:       true,
+		},
+		{
+			header:           &types.Header{Fields: map[string][]byte{TaskPriorityHeaderKey: []byte("high")}},
+			expectedPriority: 0,
+			expectedOK:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		priority, ok := GetTaskPriorityFromHeader(tc.header)
+		require.Equal(t, tc.expectedPriority, priority)
+		require.Equal(t, tc.expectedOK, ok)
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels(" gpu = true ,zone=dca1,, empty=")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"gpu": "true", "zone": "dca1", "empty": ""}, labels)
+	require.Equal(t, "empty=,gpu=true,zone=dca1", FormatLabels(labels))
+
+	labels, err = ParseLabels("")
+	require.NoError(t, err)
+	require.Empty(t, labels)
+
+	_, err = ParseLabels("gpu")
+	require.Error(t, err)
+	_, err = ParseLabels("=true")
+	require.Error(t, err)
+}
+
+func TestGetTaskLabelSelectorFromHeader(t *testing.T) {
+	testCases := []struct {
+		header           *types.Header
+		expectedSelector string
+	}{
+		{
+			header:           nil,
+			expectedSelector: "",
+		},
+		{
+			header:           &types.Header{Fields: map[string][]byte{"other": []byte("gpu=true")}},
+			expectedSelector: "",
+		},
+		{
+			header:           &types.Header{Fields: map[string][]byte{TaskLabelSelectorHeaderKey: []byte("zone=dca1,gpu=true")}},
+			expectedSelector: "gpu=true,zone=dca1",
+		},
+		{
+			header:           &types.Header{Fields: map[string][]byte{TaskLabelSelectorHeaderKey: []byte(`"gpu=true"`)}},
+			expectedSelector: "gpu=true",
+		},
+		{
+			header:           &types.Header{Fields: map[string][]byte{TaskLabelSelectorHeaderKey: []byte("gpu")}},
+			expectedSelector: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expectedSelector, GetTaskLabelSelectorFromHeader(tc.header))
+	}
+}
+
+func TestGetTaskIsolationGroupFromHeader(t *testing.T) {
+	testCases := []struct {
+		header        *types.Header
+		expectedGroup string
+	}{
+		{
+			header:        nil,
+			expectedGroup: "",
+		},
+		{
+			header:        &types.Header{Fields: map[string][]byte{"other": []byte("zone1")}},
+			expectedGroup: "",
+		},
+		{
+			header:        &types.Header{Fields: map[string][]byte{TaskIsolationGroupHeaderKey: []byte(" zone1 ")}},
+			expectedGroup: "zone1",
+		},
+		{
+			header:        &types.Header{Fields: map[string][]byte{TaskIsolationGroupHeaderKey: []byte(`"zone2"`)}},
+			expectedGroup: "zone2",
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expectedGroup, GetTaskIsolationGroupFromHeader(tc.header))
+	}
+}
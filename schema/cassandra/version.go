@@ -23,7 +23,7 @@ package cassandra
 // NOTE: whenever there is a new data base schema update, plz update the following versions
 
 // Version is the Cassandra database release version
-const Version = "0.33"
+const Version = "0.44"
 
 // VisibilityVersion is the Cassandra visibility database release version
 const VisibilityVersion = "0.7"
@@ -481,3 +481,227 @@ func (a *AccessControlledWorkflowAdminHandler) isAuthorized(
 	isAuth := result.Decision == authorization.DecisionAllow
 	return isAuth, nil
 }
+
+func (a *AccessControlledWorkflowAdminHandler) ListScanRuns(ctx context.Context, request *types.ListScanRunsRequest) (*types.ListScanRunsResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ListScanRuns",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ListScanRuns(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) DescribeScanResults(ctx context.Context, request *types.DescribeScanResultsRequest) (*types.DescribeScanResultsResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "DescribeScanResults",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.DescribeScanResults(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ListScanCorruptions(ctx context.Context, request *types.ListScanCorruptionsRequest) (*types.ListScanCorruptionsResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ListScanCorruptions",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ListScanCorruptions(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) FixScanResults(ctx context.Context, request *types.FixScanResultsRequest) (*types.FixScanResultsResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "FixScanResults",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.FixScanResults(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) GetTaskListBacklog(ctx context.Context, request *types.GetTaskListBacklogRequest) (*types.GetTaskListBacklogResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "GetTaskListBacklog",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.GetTaskListBacklog(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) DescribeTaskListConfig(ctx context.Context, request *types.DescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "DescribeTaskListConfig",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.DescribeTaskListConfig(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) UpdateTaskListConfig(ctx context.Context, request *types.UpdateTaskListConfigRequest) (*types.UpdateTaskListConfigResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "UpdateTaskListConfig",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.UpdateTaskListConfig(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ListDeadLetterTasks(ctx context.Context, request *types.ListDeadLetterTasksRequest) (*types.ListDeadLetterTasksResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ListDeadLetterTasks",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ListDeadLetterTasks(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) RequeueDeadLetterTasks(ctx context.Context, request *types.RequeueDeadLetterTasksRequest) (*types.RequeueDeadLetterTasksResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "RequeueDeadLetterTasks",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.RequeueDeadLetterTasks(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) PurgeDeadLetterTasks(ctx context.Context, request *types.PurgeDeadLetterTasksRequest) (*types.PurgeDeadLetterTasksResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "PurgeDeadLetterTasks",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.PurgeDeadLetterTasks(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest) (*types.MigrateTaskListResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "MigrateTaskList",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.MigrateTaskList(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) DeleteTaskList(ctx context.Context, request *types.DeleteTaskListRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "DeleteTaskList",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.DeleteTaskList(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ListStickyTaskLists(ctx context.Context, request *types.ListStickyTaskListsRequest) (*types.ListStickyTaskListsResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ListStickyTaskLists",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ListStickyTaskLists(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ResetStickyTaskLists(ctx context.Context, request *types.ResetStickyTaskListsRequest) (*types.ResetStickyTaskListsResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ResetStickyTaskLists",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ResetStickyTaskLists(ctx, request)
+}
@@ -721,6 +721,270 @@ func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 	return a.frontendHandler.TerminateWorkflowExecution(ctx, request)
 }
 
+// PauseWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "PauseWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.PauseWorkflowExecution(ctx, request)
+}
+
+// UnpauseWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UnpauseWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+}
+
+// UpdateWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UpdateWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateWorkflowExecution(ctx, request)
+}
+
+// CreateSchedule API call
+func (a *AccessControlledWorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendCreateScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "CreateSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.CreateSchedule(ctx, request)
+}
+
+// DescribeSchedule API call
+func (a *AccessControlledWorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+) (*types.DescribeScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "DescribeSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionRead,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.DescribeSchedule(ctx, request)
+}
+
+// UpdateSchedule API call
+func (a *AccessControlledWorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UpdateSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateSchedule(ctx, request)
+}
+
+// PauseSchedule API call
+func (a *AccessControlledWorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "PauseSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.PauseSchedule(ctx, request)
+}
+
+// UnpauseSchedule API call
+func (a *AccessControlledWorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UnpauseSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseSchedule(ctx, request)
+}
+
+// DeleteSchedule API call
+func (a *AccessControlledWorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	request *types.DeleteScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDeleteScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "DeleteSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.DeleteSchedule(ctx, request)
+}
+
+// BackfillSchedule API call
+func (a *AccessControlledWorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendBackfillScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "BackfillSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.BackfillSchedule(ctx, request)
+}
+
+// ListSchedules API call
+func (a *AccessControlledWorkflowHandler) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+) (*types.ListSchedulesResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListSchedulesScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "ListSchedules",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionRead,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.ListSchedules(ctx, request)
+}
+
 // ListTaskListPartitions API call
 func (a *AccessControlledWorkflowHandler) ListTaskListPartitions(
 	ctx context.Context,
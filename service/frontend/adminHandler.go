@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -47,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/scanresults"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
@@ -57,6 +59,8 @@ var _ AdminHandler = (*adminHandlerImpl)(nil)
 
 const (
 	endMessageID int64 = 1<<63 - 1
+	// maxMigrateTaskListTasks is the max number of tasks moved by a single MigrateTaskList request
+	maxMigrateTaskListTasks = 1000
 )
 
 var (
@@ -96,6 +100,20 @@ type (
 		ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
 		DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error)
 		MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error)
+		ListScanRuns(context.Context, *types.ListScanRunsRequest) (*types.ListScanRunsResponse, error)
+		DescribeScanResults(context.Context, *types.DescribeScanResultsRequest) (*types.DescribeScanResultsResponse, error)
+		ListScanCorruptions(context.Context, *types.ListScanCorruptionsRequest) (*types.ListScanCorruptionsResponse, error)
+		FixScanResults(context.Context, *types.FixScanResultsRequest) (*types.FixScanResultsResponse, error)
+		GetTaskListBacklog(context.Context, *types.GetTaskListBacklogRequest) (*types.GetTaskListBacklogResponse, error)
+		DescribeTaskListConfig(context.Context, *types.DescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error)
+		UpdateTaskListConfig(context.Context, *types.UpdateTaskListConfigRequest) (*types.UpdateTaskListConfigResponse, error)
+		ListDeadLetterTasks(context.Context, *types.ListDeadLetterTasksRequest) (*types.ListDeadLetterTasksResponse, error)
+		RequeueDeadLetterTasks(context.Context, *types.RequeueDeadLetterTasksRequest) (*types.RequeueDeadLetterTasksResponse, error)
+		PurgeDeadLetterTasks(context.Context, *types.PurgeDeadLetterTasksRequest) (*types.PurgeDeadLetterTasksResponse, error)
+		MigrateTaskList(context.Context, *types.MigrateTaskListRequest) (*types.MigrateTaskListResponse, error)
+		DeleteTaskList(context.Context, *types.DeleteTaskListRequest) error
+		ListStickyTaskLists(context.Context, *types.ListStickyTaskListsRequest) (*types.ListStickyTaskListsResponse, error)
+		ResetStickyTaskLists(context.Context, *types.ResetStickyTaskListsRequest) (*types.ResetStickyTaskListsResponse, error)
 	}
 
 	// adminHandlerImpl is an implementation for admin service independent of wire protocol
@@ -110,6 +128,7 @@ type (
 		eventSerializer       persistence.PayloadSerializer
 		esClient              elasticsearch.GenericClient
 		throttleRetry         *backoff.ThrottleRetry
+		scanResults           *scanresults.ScanResults
 	}
 
 	workflowQueryTemplate struct {
@@ -178,6 +197,7 @@ func NewAdminHandler(
 			backoff.WithRetryPolicy(adminServiceRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		scanResults: scanresults.NewScanResults(resource.GetFrontendClient(), resource.GetBlobstoreClient()),
 	}
 }
 
@@ -1718,6 +1738,564 @@ func (adh *adminHandlerImpl) ListDynamicConfig(ctx context.Context, request *typ
 	}, nil
 }
 
+func (adh *adminHandlerImpl) ListScanRuns(ctx context.Context, request *types.ListScanRunsRequest) (_ *types.ListScanRunsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListScanRunsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.scanResults.ListRuns(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) DescribeScanResults(ctx context.Context, request *types.DescribeScanResultsRequest) (_ *types.DescribeScanResultsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeScanResultsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.scanResults.Describe(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) ListScanCorruptions(ctx context.Context, request *types.ListScanCorruptionsRequest) (_ *types.ListScanCorruptionsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListScanCorruptionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.scanResults.ListCorruptions(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) FixScanResults(ctx context.Context, request *types.FixScanResultsRequest) (_ *types.FixScanResultsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminFixScanResultsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.scanResults.Fix(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) GetTaskListBacklog(ctx context.Context, request *types.GetTaskListBacklogRequest) (_ *types.GetTaskListBacklogResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetTaskListBacklogScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.TaskList == nil || request.TaskList.GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultTaskListBacklogPageSize
+	}
+	if pageSize > maxTaskListBacklogPageSize {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("PageSize must not exceed %v.", maxTaskListBacklogPageSize),
+		}, scope)
+	}
+	taskListType := types.TaskListTypeDecision
+	if request.TaskListType != nil {
+		taskListType = *request.TaskListType
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	var token taskListBacklogPageToken
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, adh.error(errInvalidNextPageToken, scope)
+		}
+	} else {
+		token.AckLevel, err = adh.getTaskListAckLevel(ctx, domainID, request.TaskList.GetName(), taskListType)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		token.ReadLevel = token.AckLevel
+	}
+
+	resolver := newTaskListBacklogResolver(
+		taskListType,
+		adh.numberOfHistoryShards,
+		adh.GetDomainCache(),
+		adh.GetHistoryManager(),
+		adh.GetExecutionManager,
+	)
+	resp, hasMore, err := scanTaskListBacklogPage(ctx, adh.GetTaskManager(), resolver, &persistence.GetTasksRequest{
+		DomainID:  domainID,
+		TaskList:  request.TaskList.GetName(),
+		TaskType:  int(taskListType),
+		ReadLevel: token.ReadLevel,
+		BatchSize: pageSize,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp.AckLevel = token.AckLevel
+	if hasMore {
+		token.ReadLevel = resp.LastTaskID
+		if resp.NextPageToken, err = json.Marshal(token); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) DescribeTaskListConfig(ctx context.Context, request *types.DescribeTaskListConfigRequest) (_ *types.DescribeTaskListConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeTaskListConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().DescribeTaskListConfig(ctx, &types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID:  domainID,
+		DescRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// UpdateTaskListConfig updates the server side limits of a task list partition. The update is forwarded to the
+// matching host owning the partition which persists it under its lease and applies it right away
+func (adh *adminHandlerImpl) UpdateTaskListConfig(ctx context.Context, request *types.UpdateTaskListConfigRequest) (_ *types.UpdateTaskListConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUpdateTaskListConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if err := validateTaskListConfigUpdate(request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().UpdateTaskListConfig(ctx, &types.MatchingUpdateTaskListConfigRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func validateTaskListConfigUpdate(request *types.UpdateTaskListConfigRequest) error {
+	if request.MaxDispatchPerSecond != nil && *request.MaxDispatchPerSecond < 0 {
+		return &types.BadRequestError{Message: "MaxDispatchPerSecond must not be negative."}
+	}
+	if request.MaxBacklogSize != nil && *request.MaxBacklogSize < 0 {
+		return &types.BadRequestError{Message: "MaxBacklogSize must not be negative."}
+	}
+	if request.MaxOutstandingTasks != nil && *request.MaxOutstandingTasks < 0 {
+		return &types.BadRequestError{Message: "MaxOutstandingTasks must not be negative."}
+	}
+	return nil
+}
+
+func (adh *adminHandlerImpl) ListDeadLetterTasks(ctx context.Context, request *types.ListDeadLetterTasksRequest) (_ *types.ListDeadLetterTasksResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListDeadLetterTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if err := validateDeadLetterReason(request.GetReason()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultDeadLetterTasksPageSize
+	}
+	if pageSize > maxDeadLetterTasks {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("PageSize must not exceed %v.", maxDeadLetterTasks),
+		}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	var token deadLetterTasksPageToken
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, adh.error(errInvalidNextPageToken, scope)
+		}
+	}
+	resp, hasMore, err := listDeadLetterTasksPage(ctx, adh.GetTaskManager(), &persistence.GetTasksRequest{
+		DomainID:  domainID,
+		TaskList:  common.DeadLetterTaskListName(request.GetTaskList().GetName(), request.GetReason()),
+		TaskType:  int(getTaskListTypeOrDefault(request.GetTaskListType())),
+		ReadLevel: token.ReadLevel,
+		BatchSize: pageSize,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if hasMore {
+		token.ReadLevel = resp.Tasks[len(resp.Tasks)-1].TaskID
+		if resp.NextPageToken, err = json.Marshal(token); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) RequeueDeadLetterTasks(ctx context.Context, request *types.RequeueDeadLetterTasksRequest) (_ *types.RequeueDeadLetterTasksResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRequeueDeadLetterTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if err := validateDeadLetterReason(request.GetReason()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	maxTasks, err := getDeadLetterMaxTasks(request.GetTaskID(), request.GetMaxTasks())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// the tasks are written to the backlog by the matching host owning the task list, so that
+	// they are allocated task IDs under its lease and dispatched right away
+	requeueRequest := *request
+	requeueRequest.MaxTasks = maxTasks
+	resp, err := adh.GetMatchingClient().RequeueDeadLetterTasks(ctx, &types.MatchingRequeueDeadLetterTasksRequest{
+		DomainUUID:     domainID,
+		RequeueRequest: &requeueRequest,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) PurgeDeadLetterTasks(ctx context.Context, request *types.PurgeDeadLetterTasksRequest) (_ *types.PurgeDeadLetterTasksResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminPurgeDeadLetterTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if err := validateDeadLetterReason(request.GetReason()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	maxTasks, err := getDeadLetterMaxTasks(request.GetTaskID(), request.GetMaxTasks())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	purged, err := purgeDeadLetterTasks(ctx, adh.GetTaskManager(), &persistence.TaskListInfo{
+		DomainID: domainID,
+		Name:     common.DeadLetterTaskListName(request.GetTaskList().GetName(), request.GetReason()),
+		TaskType: int(getTaskListTypeOrDefault(request.GetTaskListType())),
+	}, request.GetTaskID(), int(maxTasks))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.PurgeDeadLetterTasksResponse{Purged: int32(purged)}, nil
+}
+
+func (adh *adminHandlerImpl) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest) (_ *types.MigrateTaskListResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminMigrateTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.GetTargetTaskList() == "" || strings.HasPrefix(request.GetTargetTaskList(), common.ReservedTaskListPrefix) {
+		return nil, adh.error(&types.BadRequestError{Message: "Target task list is not set or is a partition."}, scope)
+	}
+	if request.GetTargetTaskList() == request.GetTaskList().GetName() {
+		return nil, adh.error(&types.BadRequestError{Message: "Target task list must be different from the source task list."}, scope)
+	}
+	if request.GetMaxTasks() <= 0 || request.GetMaxTasks() > maxMigrateTaskListTasks {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("MaxTasks must be between 1 and %v.", maxMigrateTaskListTasks),
+		}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// the tasks are moved by the matching host owning the task list, so that they are not
+	// dispatched by it at the same time
+	resp, err := adh.GetMatchingClient().MigrateTaskList(ctx, &types.MatchingMigrateTaskListRequest{
+		DomainUUID:     domainID,
+		MigrateRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) DeleteTaskList(ctx context.Context, request *types.DeleteTaskListRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDeleteTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return adh.error(errTaskListNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	// the task list is deleted by the matching host owning it, after it stopped dispatching it
+	err = adh.GetMatchingClient().DeleteTaskList(ctx, &types.MatchingDeleteTaskListRequest{
+		DomainUUID:    domainID,
+		DeleteRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+func (adh *adminHandlerImpl) ListStickyTaskLists(ctx context.Context, request *types.ListStickyTaskListsRequest) (_ *types.ListStickyTaskListsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListStickyTaskListsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	taskLists, err := adh.listStickyTaskLists(ctx, domainID, request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	result := make([]*types.StickyTaskListInfo, 0, len(taskLists))
+	for _, taskList := range taskLists {
+		result = append(result, &types.StickyTaskListInfo{
+			Name:              taskList.Name,
+			Identity:          taskList.Identity,
+			LastPollTimestamp: taskList.LastPollTimestamp,
+			PinnedWorkflows:   int32(len(taskList.Workflows)),
+		})
+	}
+	return &types.ListStickyTaskListsResponse{TaskLists: result}, nil
+}
+
+func (adh *adminHandlerImpl) ResetStickyTaskLists(ctx context.Context, request *types.ResetStickyTaskListsRequest) (_ *types.ResetStickyTaskListsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminResetStickyTaskListsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetIdentity() == "" && request.GetTaskList() == "" {
+		return nil, adh.error(&types.BadRequestError{Message: "Either Identity or TaskList must be set."}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	taskLists, err := adh.listStickyTaskLists(ctx, domainID, request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// a workflow may have been pinned to several sticky task lists of the worker
+	workflows := make(map[types.WorkflowExecution]struct{})
+	for _, taskList := range taskLists {
+		if taskList.Name == request.GetTaskList() || (request.GetIdentity() != "" && taskList.Identity == request.GetIdentity()) {
+			for _, execution := range taskList.Workflows {
+				workflows[execution] = struct{}{}
+			}
+		}
+	}
+	var reset int32
+	for execution := range workflows {
+		execution := execution
+		_, err := adh.GetHistoryClient().ResetStickyTaskList(ctx, &types.HistoryResetStickyTaskListRequest{
+			DomainUUID: domainID,
+			Execution:  &execution,
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				// the workflow completed or was deleted since it was scanned
+				continue
+			}
+			return nil, adh.error(err, scope)
+		}
+		reset++
+	}
+	return &types.ResetStickyTaskListsResponse{ResetWorkflows: reset}, nil
+}
+
+// listStickyTaskLists returns the sticky task lists the open workflows of the domain are pinned to, as
+// recorded in their mutable state, along with the worker owning them
+func (adh *adminHandlerImpl) listStickyTaskLists(ctx context.Context, domainID string, domain string) ([]*stickyTaskList, error) {
+	taskLists, err := scanStickyTaskLists(
+		ctx,
+		adh.GetExecutionManager,
+		adh.numberOfHistoryShards,
+		domainID,
+		stickyTaskListScanPageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.GetMatchingClient().ListStickyTaskLists(ctx, &types.MatchingListStickyTaskListsRequest{
+		DomainUUID:  domainID,
+		ListRequest: &types.ListStickyTaskListsRequest{Domain: domain},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := describeStickyTaskLists(ctx, adh.GetTaskManager(), resp.GetTaskLists(), domainID, taskLists); err != nil {
+		return nil, err
+	}
+	return taskLists, nil
+}
+
+// getTaskListAckLevel returns the ack level of the task list partition, tasks at or below the ack level are
+// already processed and waiting to be deleted. The live ack level of the owning matching host is preferred
+// over the persisted one which is only updated periodically
+func (adh *adminHandlerImpl) getTaskListAckLevel(
+	ctx context.Context,
+	domainID string,
+	taskList string,
+	taskListType types.TaskListType,
+) (int64, error) {
+	describeResp, err := adh.GetMatchingClient().DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:              &types.TaskList{Name: taskList},
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: true,
+		},
+	})
+	if err == nil && describeResp.GetTaskListStatus() != nil {
+		return describeResp.GetTaskListStatus().GetAckLevel(), nil
+	}
+
+	resp, err := adh.GetTaskManager().GetTaskList(ctx, &persistence.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: int(taskListType),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.TaskListInfo.AckLevel, nil
+}
+
 func checkValidKey(keyName string) (dc.Key, error) {
 	keyVal, ok := dc.KeyNames[keyName]
 	if !ok || keyVal == dc.UnknownKey {
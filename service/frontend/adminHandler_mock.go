@@ -101,6 +101,20 @@ func (mr *MockAdminHandlerMockRecorder) CountDLQMessages(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockAdminHandler)(nil).CountDLQMessages), arg0, arg1)
 }
 
+// DeleteTaskList mocks base method.
+func (m *MockAdminHandler) DeleteTaskList(arg0 context.Context, arg1 *types.DeleteTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskList indicates an expected call of DeleteTaskList.
+func (mr *MockAdminHandlerMockRecorder) DeleteTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskList", reflect.TypeOf((*MockAdminHandler)(nil).DeleteTaskList), arg0, arg1)
+}
+
 // DeleteWorkflow mocks base method.
 func (m *MockAdminHandler) DeleteWorkflow(arg0 context.Context, arg1 *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -161,6 +175,21 @@ func (mr *MockAdminHandlerMockRecorder) DescribeQueue(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockAdminHandler)(nil).DescribeQueue), arg0, arg1)
 }
 
+// DescribeScanResults mocks base method.
+func (m *MockAdminHandler) DescribeScanResults(arg0 context.Context, arg1 *types.DescribeScanResultsRequest) (*types.DescribeScanResultsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScanResults", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeScanResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScanResults indicates an expected call of DescribeScanResults.
+func (mr *MockAdminHandlerMockRecorder) DescribeScanResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScanResults", reflect.TypeOf((*MockAdminHandler)(nil).DescribeScanResults), arg0, arg1)
+}
+
 // DescribeShardDistribution mocks base method.
 func (m *MockAdminHandler) DescribeShardDistribution(arg0 context.Context, arg1 *types.DescribeShardDistributionRequest) (*types.DescribeShardDistributionResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +205,21 @@ func (mr *MockAdminHandlerMockRecorder) DescribeShardDistribution(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardDistribution", reflect.TypeOf((*MockAdminHandler)(nil).DescribeShardDistribution), arg0, arg1)
 }
 
+// DescribeTaskListConfig mocks base method.
+func (m *MockAdminHandler) DescribeTaskListConfig(arg0 context.Context, arg1 *types.DescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListConfig indicates an expected call of DescribeTaskListConfig.
+func (mr *MockAdminHandlerMockRecorder) DescribeTaskListConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListConfig", reflect.TypeOf((*MockAdminHandler)(nil).DescribeTaskListConfig), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockAdminHandler) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +235,21 @@ func (mr *MockAdminHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockAdminHandler)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// FixScanResults mocks base method.
+func (m *MockAdminHandler) FixScanResults(arg0 context.Context, arg1 *types.FixScanResultsRequest) (*types.FixScanResultsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FixScanResults", arg0, arg1)
+	ret0, _ := ret[0].(*types.FixScanResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FixScanResults indicates an expected call of FixScanResults.
+func (mr *MockAdminHandlerMockRecorder) FixScanResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FixScanResults", reflect.TypeOf((*MockAdminHandler)(nil).FixScanResults), arg0, arg1)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockAdminHandler) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -266,6 +325,21 @@ func (mr *MockAdminHandlerMockRecorder) GetReplicationMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminHandler)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetTaskListBacklog mocks base method.
+func (m *MockAdminHandler) GetTaskListBacklog(arg0 context.Context, arg1 *types.GetTaskListBacklogRequest) (*types.GetTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListBacklog indicates an expected call of GetTaskListBacklog.
+func (mr *MockAdminHandlerMockRecorder) GetTaskListBacklog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListBacklog", reflect.TypeOf((*MockAdminHandler)(nil).GetTaskListBacklog), arg0, arg1)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method.
 func (m *MockAdminHandler) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +355,21 @@ func (mr *MockAdminHandlerMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminHandler)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ListDeadLetterTasks mocks base method.
+func (m *MockAdminHandler) ListDeadLetterTasks(arg0 context.Context, arg1 *types.ListDeadLetterTasksRequest) (*types.ListDeadLetterTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListDeadLetterTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterTasks indicates an expected call of ListDeadLetterTasks.
+func (mr *MockAdminHandlerMockRecorder) ListDeadLetterTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterTasks", reflect.TypeOf((*MockAdminHandler)(nil).ListDeadLetterTasks), arg0, arg1)
+}
+
 // ListDynamicConfig mocks base method.
 func (m *MockAdminHandler) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +385,51 @@ func (mr *MockAdminHandlerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).ListDynamicConfig), arg0, arg1)
 }
 
+// ListScanCorruptions mocks base method.
+func (m *MockAdminHandler) ListScanCorruptions(arg0 context.Context, arg1 *types.ListScanCorruptionsRequest) (*types.ListScanCorruptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScanCorruptions", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListScanCorruptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScanCorruptions indicates an expected call of ListScanCorruptions.
+func (mr *MockAdminHandlerMockRecorder) ListScanCorruptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScanCorruptions", reflect.TypeOf((*MockAdminHandler)(nil).ListScanCorruptions), arg0, arg1)
+}
+
+// ListScanRuns mocks base method.
+func (m *MockAdminHandler) ListScanRuns(arg0 context.Context, arg1 *types.ListScanRunsRequest) (*types.ListScanRunsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScanRuns", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListScanRunsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScanRuns indicates an expected call of ListScanRuns.
+func (mr *MockAdminHandlerMockRecorder) ListScanRuns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScanRuns", reflect.TypeOf((*MockAdminHandler)(nil).ListScanRuns), arg0, arg1)
+}
+
+// ListStickyTaskLists mocks base method.
+func (m *MockAdminHandler) ListStickyTaskLists(arg0 context.Context, arg1 *types.ListStickyTaskListsRequest) (*types.ListStickyTaskListsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStickyTaskLists", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListStickyTaskListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStickyTaskLists indicates an expected call of ListStickyTaskLists.
+func (mr *MockAdminHandlerMockRecorder) ListStickyTaskLists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStickyTaskLists", reflect.TypeOf((*MockAdminHandler)(nil).ListStickyTaskLists), arg0, arg1)
+}
+
 // MaintainCorruptWorkflow mocks base method.
 func (m *MockAdminHandler) MaintainCorruptWorkflow(arg0 context.Context, arg1 *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -326,6 +460,21 @@ func (mr *MockAdminHandlerMockRecorder) MergeDLQMessages(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminHandler)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MigrateTaskList mocks base method.
+func (m *MockAdminHandler) MigrateTaskList(arg0 context.Context, arg1 *types.MigrateTaskListRequest) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList.
+func (mr *MockAdminHandlerMockRecorder) MigrateTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockAdminHandler)(nil).MigrateTaskList), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminHandler) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest) error {
 	m.ctrl.T.Helper()
@@ -340,6 +489,21 @@ func (mr *MockAdminHandlerMockRecorder) PurgeDLQMessages(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminHandler)(nil).PurgeDLQMessages), arg0, arg1)
 }
 
+// PurgeDeadLetterTasks mocks base method.
+func (m *MockAdminHandler) PurgeDeadLetterTasks(arg0 context.Context, arg1 *types.PurgeDeadLetterTasksRequest) (*types.PurgeDeadLetterTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetterTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.PurgeDeadLetterTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetterTasks indicates an expected call of PurgeDeadLetterTasks.
+func (mr *MockAdminHandlerMockRecorder) PurgeDeadLetterTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterTasks", reflect.TypeOf((*MockAdminHandler)(nil).PurgeDeadLetterTasks), arg0, arg1)
+}
+
 // ReadDLQMessages mocks base method.
 func (m *MockAdminHandler) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +561,21 @@ func (mr *MockAdminHandlerMockRecorder) RemoveTask(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminHandler)(nil).RemoveTask), arg0, arg1)
 }
 
+// RequeueDeadLetterTasks mocks base method.
+func (m *MockAdminHandler) RequeueDeadLetterTasks(arg0 context.Context, arg1 *types.RequeueDeadLetterTasksRequest) (*types.RequeueDeadLetterTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLetterTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.RequeueDeadLetterTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueDeadLetterTasks indicates an expected call of RequeueDeadLetterTasks.
+func (mr *MockAdminHandlerMockRecorder) RequeueDeadLetterTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetterTasks", reflect.TypeOf((*MockAdminHandler)(nil).RequeueDeadLetterTasks), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminHandler) ResendReplicationTasks(arg0 context.Context, arg1 *types.ResendReplicationTasksRequest) error {
 	m.ctrl.T.Helper()
@@ -425,6 +604,21 @@ func (mr *MockAdminHandlerMockRecorder) ResetQueue(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQueue", reflect.TypeOf((*MockAdminHandler)(nil).ResetQueue), arg0, arg1)
 }
 
+// ResetStickyTaskLists mocks base method.
+func (m *MockAdminHandler) ResetStickyTaskLists(arg0 context.Context, arg1 *types.ResetStickyTaskListsRequest) (*types.ResetStickyTaskListsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetStickyTaskLists", arg0, arg1)
+	ret0, _ := ret[0].(*types.ResetStickyTaskListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetStickyTaskLists indicates an expected call of ResetStickyTaskLists.
+func (mr *MockAdminHandlerMockRecorder) ResetStickyTaskLists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetStickyTaskLists", reflect.TypeOf((*MockAdminHandler)(nil).ResetStickyTaskLists), arg0, arg1)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method.
 func (m *MockAdminHandler) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -491,3 +685,18 @@ func (mr *MockAdminHandlerMockRecorder) UpdateDynamicConfig(arg0, arg1 interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).UpdateDynamicConfig), arg0, arg1)
 }
+
+// UpdateTaskListConfig mocks base method.
+func (m *MockAdminHandler) UpdateTaskListConfig(arg0 context.Context, arg1 *types.UpdateTaskListConfigRequest) (*types.UpdateTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListConfig indicates an expected call of UpdateTaskListConfig.
+func (mr *MockAdminHandlerMockRecorder) UpdateTaskListConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListConfig", reflect.TypeOf((*MockAdminHandler)(nil).UpdateTaskListConfig), arg0, arg1)
+}
@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -308,6 +309,282 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_FailedOnDomain
 	s.Error(err)
 }
 
+func (s *adminHandlerSuite) Test_UpdateTaskListConfig_Validate() {
+	ctx := context.Background()
+	_, err := s.handler.UpdateTaskListConfig(ctx, &types.UpdateTaskListConfigRequest{
+		TaskList: &types.TaskList{Name: "tl"},
+	})
+	s.Equal(errDomainNotSet, err)
+
+	_, err = s.handler.UpdateTaskListConfig(ctx, &types.UpdateTaskListConfigRequest{
+		Domain: s.domainName,
+	})
+	s.Equal(errTaskListNotSet, err)
+
+	_, err = s.handler.UpdateTaskListConfig(ctx, &types.UpdateTaskListConfigRequest{
+		Domain:         s.domainName,
+		TaskList:       &types.TaskList{Name: "tl"},
+		MaxBacklogSize: common.Int64Ptr(-1),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateTaskListConfig() {
+	ctx := context.Background()
+	request := &types.UpdateTaskListConfigRequest{
+		Domain:               s.domainName,
+		TaskList:             &types.TaskList{Name: "tl"},
+		TaskListType:         types.TaskListTypeActivity.Ptr(),
+		MaxDispatchPerSecond: common.Float64Ptr(10),
+	}
+	response := &types.UpdateTaskListConfigResponse{Config: &types.TaskListConfig{MaxDispatchPerSecond: 10}}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().UpdateTaskListConfig(ctx, &types.MatchingUpdateTaskListConfigRequest{
+		DomainUUID:    s.domainID,
+		UpdateRequest: request,
+	}).Return(response, nil).Times(1)
+
+	resp, err := s.handler.UpdateTaskListConfig(ctx, request)
+	s.NoError(err)
+	s.Equal(response, resp)
+}
+
+func (s *adminHandlerSuite) Test_ListDeadLetterTasks() {
+	ctx := context.Background()
+	_, err := s.handler.ListDeadLetterTasks(ctx, &types.ListDeadLetterTasksRequest{
+		Domain:   s.domainName,
+		TaskList: &types.TaskList{Name: "tl"},
+		Reason:   "unknown",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	createdTime := time.Now()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.TaskMgr.On("GetTasks", mock.Anything, &persistence.GetTasksRequest{
+		DomainID:  s.domainID,
+		TaskList:  common.DeadLetterTaskListName("tl", common.DeadLetterReasonExpired),
+		TaskType:  persistence.TaskListTypeActivity,
+		ReadLevel: 0,
+		BatchSize: 2,
+	}).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistence.TaskInfo{
+			{TaskID: 3, WorkflowID: "wid", RunID: "rid", ScheduleID: 5, CreatedTime: createdTime},
+			{TaskID: 7, WorkflowID: "wid", RunID: "rid", ScheduleID: 9, CreatedTime: createdTime},
+		},
+	}, nil).Once()
+
+	resp, err := s.handler.ListDeadLetterTasks(ctx, &types.ListDeadLetterTasksRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		Reason:       common.DeadLetterReasonExpired,
+		PageSize:     2,
+	})
+	s.NoError(err)
+	s.Equal([]*types.DeadLetterTask{
+		{TaskID: 3, WorkflowID: "wid", RunID: "rid", ScheduleID: 5, DeadLetteredTimestamp: createdTime.UnixNano()},
+		{TaskID: 7, WorkflowID: "wid", RunID: "rid", ScheduleID: 9, DeadLetteredTimestamp: createdTime.UnixNano()},
+	}, resp.Tasks)
+	var token deadLetterTasksPageToken
+	s.NoError(json.Unmarshal(resp.NextPageToken, &token))
+	s.Equal(int64(7), token.ReadLevel)
+}
+
+func (s *adminHandlerSuite) Test_RequeueDeadLetterTasks() {
+	ctx := context.Background()
+	_, err := s.handler.RequeueDeadLetterTasks(ctx, &types.RequeueDeadLetterTasksRequest{
+		Domain:   s.domainName,
+		TaskList: &types.TaskList{Name: "tl"},
+		Reason:   common.DeadLetterReasonDispatchFailed,
+		MaxTasks: maxDeadLetterTasks + 1,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	// a single task is requeued when the request targets a task by ID
+	request := &types.RequeueDeadLetterTasksRequest{
+		Domain:   s.domainName,
+		TaskList: &types.TaskList{Name: "tl"},
+		Reason:   common.DeadLetterReasonDispatchFailed,
+		TaskID:   11,
+	}
+	response := &types.RequeueDeadLetterTasksResponse{Requeued: 1}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().RequeueDeadLetterTasks(ctx, &types.MatchingRequeueDeadLetterTasksRequest{
+		DomainUUID: s.domainID,
+		RequeueRequest: &types.RequeueDeadLetterTasksRequest{
+			Domain:   s.domainName,
+			TaskList: &types.TaskList{Name: "tl"},
+			Reason:   common.DeadLetterReasonDispatchFailed,
+			TaskID:   11,
+			MaxTasks: 1,
+		},
+	}).Return(response, nil).Times(1)
+
+	resp, err := s.handler.RequeueDeadLetterTasks(ctx, request)
+	s.NoError(err)
+	s.Equal(response, resp)
+	s.Equal(int32(0), request.MaxTasks)
+}
+
+func (s *adminHandlerSuite) Test_MigrateTaskList() {
+	ctx := context.Background()
+	for _, request := range []*types.MigrateTaskListRequest{
+		{Domain: s.domainName, TaskList: &types.TaskList{Name: "tl"}, TargetTaskList: "tl", MaxTasks: 10},
+		{Domain: s.domainName, TaskList: &types.TaskList{Name: "tl"}, TargetTaskList: common.ReservedTaskListPrefix + "target/1", MaxTasks: 10},
+		{Domain: s.domainName, TaskList: &types.TaskList{Name: "tl"}, TargetTaskList: "target", MaxTasks: maxMigrateTaskListTasks + 1},
+	} {
+		_, err := s.handler.MigrateTaskList(ctx, request)
+		s.IsType(&types.BadRequestError{}, err)
+	}
+
+	request := &types.MigrateTaskListRequest{
+		Domain:         s.domainName,
+		TaskList:       &types.TaskList{Name: "tl"},
+		TargetTaskList: "target",
+		MaxTasks:       10,
+	}
+	response := &types.MigrateTaskListResponse{Migrated: 8, Expired: 2}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().MigrateTaskList(ctx, &types.MatchingMigrateTaskListRequest{
+		DomainUUID:     s.domainID,
+		MigrateRequest: request,
+	}).Return(response, nil).Times(1)
+
+	resp, err := s.handler.MigrateTaskList(ctx, request)
+	s.NoError(err)
+	s.Equal(response, resp)
+}
+
+func (s *adminHandlerSuite) Test_DeleteTaskList() {
+	ctx := context.Background()
+	err := s.handler.DeleteTaskList(ctx, &types.DeleteTaskListRequest{Domain: s.domainName})
+	s.Equal(errTaskListNotSet, err)
+
+	request := &types.DeleteTaskListRequest{
+		Domain:   s.domainName,
+		TaskList: &types.TaskList{Name: "tl"},
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().DeleteTaskList(ctx, &types.MatchingDeleteTaskListRequest{
+		DomainUUID:    s.domainID,
+		DeleteRequest: request,
+	}).Return(nil).Times(1)
+
+	s.NoError(s.handler.DeleteTaskList(ctx, request))
+}
+
+func (s *adminHandlerSuite) Test_ListStickyTaskLists() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.ExecutionMgr.On("ListConcreteExecutions", ctx, &persistence.ListConcreteExecutionsRequest{PageSize: stickyTaskListScanPageSize}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{
+				newStickyExecution(s.domainID, "wid1", "host1:sticky", persistence.WorkflowStateRunning),
+				newStickyExecution(s.domainID, "wid2", "host2:sticky", persistence.WorkflowStateRunning),
+				newStickyExecution(s.domainID, "wid3", "host2:sticky", persistence.WorkflowStateRunning),
+			},
+		}, nil).Once()
+	s.mockResource.MatchingClient.EXPECT().ListStickyTaskLists(ctx, &types.MatchingListStickyTaskListsRequest{
+		DomainUUID:  s.domainID,
+		ListRequest: &types.ListStickyTaskListsRequest{Domain: s.domainName},
+	}).Return(&types.MatchingListStickyTaskListsResponse{
+		TaskLists: []*types.MatchingStickyTaskList{
+			{Name: "host2:sticky", Identity: "worker@host2", LastPollTimestamp: common.Int64Ptr(1)},
+		},
+	}, nil).Times(1)
+	// the sticky task list of an idle worker is no longer loaded, its worker is persisted with it
+	s.mockResource.TaskMgr.On("GetTaskList", ctx, &persistence.GetTaskListRequest{
+		DomainID: s.domainID,
+		TaskList: "host1:sticky",
+		TaskType: persistence.TaskListTypeDecision,
+	}).Return(&persistence.GetTaskListResponse{
+		TaskListInfo: &persistence.TaskListInfo{WorkerIdentity: "worker@host1"},
+	}, nil).Once()
+
+	resp, err := s.handler.ListStickyTaskLists(ctx, &types.ListStickyTaskListsRequest{Domain: s.domainName})
+	s.NoError(err)
+	s.Equal(&types.ListStickyTaskListsResponse{
+		TaskLists: []*types.StickyTaskListInfo{
+			{Name: "host2:sticky", Identity: "worker@host2", LastPollTimestamp: common.Int64Ptr(1), PinnedWorkflows: 2},
+			{Name: "host1:sticky", Identity: "worker@host1", PinnedWorkflows: 1},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_ResetStickyTaskLists() {
+	ctx := context.Background()
+	_, err := s.handler.ResetStickyTaskLists(ctx, &types.ResetStickyTaskListsRequest{Domain: s.domainName})
+	s.IsType(&types.BadRequestError{}, err)
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.ExecutionMgr.On("ListConcreteExecutions", ctx, mock.Anything).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{
+				newStickyExecution(s.domainID, "wid1", "host1:sticky", persistence.WorkflowStateRunning),
+				newStickyExecution(s.domainID, "wid2", "host1:sticky", persistence.WorkflowStateRunning),
+				// the worker was restarted with the same identity
+				newStickyExecution(s.domainID, "wid1", "host1:sticky2", persistence.WorkflowStateRunning),
+				newStickyExecution(s.domainID, "wid3", "host2:sticky", persistence.WorkflowStateRunning),
+			},
+		}, nil).Once()
+	s.mockResource.MatchingClient.EXPECT().ListStickyTaskLists(ctx, gomock.Any()).Return(&types.MatchingListStickyTaskListsResponse{
+		TaskLists: []*types.MatchingStickyTaskList{
+			{Name: "host1:sticky", Identity: "worker@host1"},
+			{Name: "host1:sticky2", Identity: "worker@host1"},
+			{Name: "host2:sticky", Identity: "worker@host2"},
+		},
+	}, nil).Times(1)
+	s.mockHistoryClient.EXPECT().ResetStickyTaskList(ctx, &types.HistoryResetStickyTaskListRequest{
+		DomainUUID: s.domainID,
+		Execution:  &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid"},
+	}).Return(&types.HistoryResetStickyTaskListResponse{}, nil).Times(1)
+	// workflows which no longer exist are skipped
+	s.mockHistoryClient.EXPECT().ResetStickyTaskList(ctx, &types.HistoryResetStickyTaskListRequest{
+		DomainUUID: s.domainID,
+		Execution:  &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid"},
+	}).Return(nil, &types.EntityNotExistsError{}).Times(1)
+
+	resp, err := s.handler.ResetStickyTaskLists(ctx, &types.ResetStickyTaskListsRequest{
+		Domain:   s.domainName,
+		Identity: "worker@host1",
+	})
+	s.NoError(err)
+	s.Equal(&types.ResetStickyTaskListsResponse{ResetWorkflows: 1}, resp)
+}
+
+func (s *adminHandlerSuite) Test_PurgeDeadLetterTasks() {
+	ctx := context.Background()
+	taskList := &persistence.TaskListInfo{
+		DomainID: s.domainID,
+		Name:     common.DeadLetterTaskListName("tl", common.DeadLetterReasonExpired),
+		TaskType: persistence.TaskListTypeDecision,
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.TaskMgr.On("GetTasks", mock.Anything, &persistence.GetTasksRequest{
+		DomainID:  s.domainID,
+		TaskList:  taskList.Name,
+		TaskType:  persistence.TaskListTypeDecision,
+		BatchSize: 10,
+	}).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistence.TaskInfo{{TaskID: 3}, {TaskID: 7}},
+	}, nil).Once()
+	for _, taskID := range []int64{3, 7} {
+		s.mockResource.TaskMgr.On("CompleteTask", mock.Anything, &persistence.CompleteTaskRequest{
+			TaskList: taskList,
+			TaskID:   taskID,
+		}).Return(nil).Once()
+	}
+
+	resp, err := s.handler.PurgeDeadLetterTasks(ctx, &types.PurgeDeadLetterTasksRequest{
+		Domain:   s.domainName,
+		TaskList: &types.TaskList{Name: "tl"},
+		Reason:   common.DeadLetterReasonExpired,
+		MaxTasks: 10,
+	})
+	s.NoError(err)
+	s.Equal(&types.PurgeDeadLetterTasksResponse{Purged: 2}, resp)
+}
+
 func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2() {
 	ctx := context.Background()
 	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
@@ -207,3 +207,87 @@ func (t AdminThriftHandler) ListDynamicConfig(ctx context.Context, request *admi
 	response, err := t.h.ListDynamicConfig(ctx, thrift.ToListDynamicConfigRequest(request))
 	return thrift.FromListDynamicConfigResponse(response), thrift.FromError(err)
 }
+
+// ListScanRuns lists the runs of a shard scanner workflow
+func (t AdminThriftHandler) ListScanRuns(ctx context.Context, request *admin.ListScanRunsRequest) (*admin.ListScanRunsResponse, error) {
+	response, err := t.h.ListScanRuns(ctx, thrift.ToListScanRunsRequest(request))
+	return thrift.FromListScanRunsResponse(response), thrift.FromError(err)
+}
+
+// DescribeScanResults returns the entity and per invariant corruption counts of a shard scanner run
+func (t AdminThriftHandler) DescribeScanResults(ctx context.Context, request *admin.DescribeScanResultsRequest) (*admin.DescribeScanResultsResponse, error) {
+	response, err := t.h.DescribeScanResults(ctx, thrift.ToDescribeScanResultsRequest(request))
+	return thrift.FromDescribeScanResultsResponse(response), thrift.FromError(err)
+}
+
+// ListScanCorruptions pages through the corrupted entities found by a shard scanner run
+func (t AdminThriftHandler) ListScanCorruptions(ctx context.Context, request *admin.ListScanCorruptionsRequest) (*admin.ListScanCorruptionsResponse, error) {
+	response, err := t.h.ListScanCorruptions(ctx, thrift.ToListScanCorruptionsRequest(request))
+	return thrift.FromListScanCorruptionsResponse(response), thrift.FromError(err)
+}
+
+// FixScanResults starts a fixer workflow for the corruptions found by a closed shard scanner run
+func (t AdminThriftHandler) FixScanResults(ctx context.Context, request *admin.FixScanResultsRequest) (*admin.FixScanResultsResponse, error) {
+	response, err := t.h.FixScanResults(ctx, thrift.ToFixScanResultsRequest(request))
+	return thrift.FromFixScanResultsResponse(response), thrift.FromError(err)
+}
+
+// GetTaskListBacklog aggregates a page of the persisted tasks of a task list partition
+func (t AdminThriftHandler) GetTaskListBacklog(ctx context.Context, request *admin.GetTaskListBacklogRequest) (*admin.GetTaskListBacklogResponse, error) {
+	response, err := t.h.GetTaskListBacklog(ctx, thrift.ToGetTaskListBacklogRequest(request))
+	return thrift.FromGetTaskListBacklogResponse(response), thrift.FromError(err)
+}
+
+// DescribeTaskListConfig returns the server side limits configured on a task list partition
+func (t AdminThriftHandler) DescribeTaskListConfig(ctx context.Context, request *admin.DescribeTaskListConfigRequest) (*admin.DescribeTaskListConfigResponse, error) {
+	response, err := t.h.DescribeTaskListConfig(ctx, thrift.ToDescribeTaskListConfigRequest(request))
+	return thrift.FromDescribeTaskListConfigResponse(response), thrift.FromError(err)
+}
+
+// UpdateTaskListConfig updates the server side limits of a task list partition
+func (t AdminThriftHandler) UpdateTaskListConfig(ctx context.Context, request *admin.UpdateTaskListConfigRequest) (*admin.UpdateTaskListConfigResponse, error) {
+	response, err := t.h.UpdateTaskListConfig(ctx, thrift.ToUpdateTaskListConfigRequest(request))
+	return thrift.FromUpdateTaskListConfigResponse(response), thrift.FromError(err)
+}
+
+// ListDeadLetterTasks lists the tasks dead-lettered for a task list
+func (t AdminThriftHandler) ListDeadLetterTasks(ctx context.Context, request *admin.ListDeadLetterTasksRequest) (*admin.ListDeadLetterTasksResponse, error) {
+	response, err := t.h.ListDeadLetterTasks(ctx, thrift.ToListDeadLetterTasksRequest(request))
+	return thrift.FromListDeadLetterTasksResponse(response), thrift.FromError(err)
+}
+
+// RequeueDeadLetterTasks moves dead-lettered tasks back to the task list backlog
+func (t AdminThriftHandler) RequeueDeadLetterTasks(ctx context.Context, request *admin.RequeueDeadLetterTasksRequest) (*admin.RequeueDeadLetterTasksResponse, error) {
+	response, err := t.h.RequeueDeadLetterTasks(ctx, thrift.ToRequeueDeadLetterTasksRequest(request))
+	return thrift.FromRequeueDeadLetterTasksResponse(response), thrift.FromError(err)
+}
+
+// PurgeDeadLetterTasks deletes dead-lettered tasks of a task list
+func (t AdminThriftHandler) PurgeDeadLetterTasks(ctx context.Context, request *admin.PurgeDeadLetterTasksRequest) (*admin.PurgeDeadLetterTasksResponse, error) {
+	response, err := t.h.PurgeDeadLetterTasks(ctx, thrift.ToPurgeDeadLetterTasksRequest(request))
+	return thrift.FromPurgeDeadLetterTasksResponse(response), thrift.FromError(err)
+}
+
+// MigrateTaskList moves the backlog of a task list partition to another task list
+func (t AdminThriftHandler) MigrateTaskList(ctx context.Context, request *admin.MigrateTaskListRequest) (*admin.MigrateTaskListResponse, error) {
+	response, err := t.h.MigrateTaskList(ctx, thrift.ToMigrateTaskListRequest(request))
+	return thrift.FromMigrateTaskListResponse(response), thrift.FromError(err)
+}
+
+// DeleteTaskList deletes a task list partition and all its tasks
+func (t AdminThriftHandler) DeleteTaskList(ctx context.Context, request *admin.DeleteTaskListRequest) error {
+	err := t.h.DeleteTaskList(ctx, thrift.ToDeleteTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// ListStickyTaskLists returns the sticky task lists of a domain with the identity of their worker
+func (t AdminThriftHandler) ListStickyTaskLists(ctx context.Context, request *admin.ListStickyTaskListsRequest) (*admin.ListStickyTaskListsResponse, error) {
+	response, err := t.h.ListStickyTaskLists(ctx, thrift.ToListStickyTaskListsRequest(request))
+	return thrift.FromListStickyTaskListsResponse(response), thrift.FromError(err)
+}
+
+// ResetStickyTaskLists resets the stickiness of the workflows pinned to the sticky task lists of a worker
+func (t AdminThriftHandler) ResetStickyTaskLists(ctx context.Context, request *admin.ResetStickyTaskListsRequest) (*admin.ResetStickyTaskListsResponse, error) {
+	response, err := t.h.ResetStickyTaskLists(ctx, thrift.ToResetStickyTaskListsRequest(request))
+	return thrift.FromResetStickyTaskListsResponse(response), thrift.FromError(err)
+}
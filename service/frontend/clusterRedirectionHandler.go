@@ -1112,6 +1112,224 @@ func (handler *ClusterRedirectionHandlerImpl) TerminateWorkflowExecution(
 	return err
 }
 
+// PauseWorkflowExecution API call
+func (handler *ClusterRedirectionHandlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "PauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UnpauseWorkflowExecution API call
+func (handler *ClusterRedirectionHandlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "UnpauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UpdateWorkflowExecution API call
+func (handler *ClusterRedirectionHandlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+
+	var apiName = "UpdateWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.UpdateWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// CreateSchedule API call
+func (handler *ClusterRedirectionHandlerImpl) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+) (retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.CreateSchedule(ctx, request)
+}
+
+// DescribeSchedule API call
+func (handler *ClusterRedirectionHandlerImpl) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+) (resp *types.DescribeScheduleResponse, retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.DescribeSchedule(ctx, request)
+}
+
+// UpdateSchedule API call
+func (handler *ClusterRedirectionHandlerImpl) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+) (retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.UpdateSchedule(ctx, request)
+}
+
+// PauseSchedule API call
+func (handler *ClusterRedirectionHandlerImpl) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+) (retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.PauseSchedule(ctx, request)
+}
+
+// UnpauseSchedule API call
+func (handler *ClusterRedirectionHandlerImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+) (retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.UnpauseSchedule(ctx, request)
+}
+
+// DeleteSchedule API call
+func (handler *ClusterRedirectionHandlerImpl) DeleteSchedule(
+	ctx context.Context,
+	request *types.DeleteScheduleRequest,
+) (retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.DeleteSchedule(ctx, request)
+}
+
+// BackfillSchedule API call
+func (handler *ClusterRedirectionHandlerImpl) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+) (retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionBackfillScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.BackfillSchedule(ctx, request)
+}
+
+// ListSchedules API call
+func (handler *ClusterRedirectionHandlerImpl) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+) (resp *types.ListSchedulesResponse, retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListSchedulesScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.ListSchedules(ctx, request)
+}
+
 // ListTaskListPartitions API call
 func (handler *ClusterRedirectionHandlerImpl) ListTaskListPartitions(
 	ctx context.Context,
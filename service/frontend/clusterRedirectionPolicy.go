@@ -43,6 +43,9 @@ const (
 	// 5. TerminateWorkflowExecution
 	// 6. QueryWorkflowStrongConsistency
 	// 7. ResetWorkflow
+	// 8. PauseWorkflowExecution
+	// 9. UnpauseWorkflowExecution
+	// 10. UpdateWorkflowExecution
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlist and DCRedirectionPolicySelectedAPIsForwardingV2
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 	// DCRedirectionPolicySelectedAPIsForwardingV2 forwards everything in DCRedirectionPolicySelectedAPIsForwarding,
@@ -52,15 +55,15 @@ const (
 	//
 	// This will likely replace DCRedirectionPolicySelectedAPIsForwarding soon.
 	//
-	// 1-7. from DCRedirectionPolicySelectedAPIsForwarding
-	// 8. RecordActivityTaskHeartbeat
-	// 9. RecordActivityTaskHeartbeatByID
-	// 10. RespondActivityTaskCanceled
-	// 11. RespondActivityTaskCanceledByID
-	// 12. RespondActivityTaskCompleted
-	// 13. RespondActivityTaskCompletedByID
-	// 14. RespondActivityTaskFailed
-	// 15. RespondActivityTaskFailedByID
+	// 1-10. from DCRedirectionPolicySelectedAPIsForwarding
+	// 11. RecordActivityTaskHeartbeat
+	// 12. RecordActivityTaskHeartbeatByID
+	// 13. RespondActivityTaskCanceled
+	// 14. RespondActivityTaskCanceledByID
+	// 15. RespondActivityTaskCompleted
+	// 16. RespondActivityTaskCompletedByID
+	// 17. RespondActivityTaskFailed
+	// 18. RespondActivityTaskFailedByID
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2
 	DCRedirectionPolicySelectedAPIsForwardingV2 = "selected-apis-forwarding-v2"
 	// DCRedirectionPolicyAllDomainAPIsForwarding means forwarding all the worker and non-worker APIs based domain,
@@ -107,6 +110,9 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"TerminateWorkflowExecution":       {},
 	"QueryWorkflowStrongConsistency":   {},
 	"ResetWorkflowExecution":           {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"UpdateWorkflowExecution":          {},
 }
 
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 contains a list of non-worker APIs which can be redirected.
@@ -120,6 +126,9 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"TerminateWorkflowExecution":       {},
 	"QueryWorkflowStrongConsistency":   {},
 	"ResetWorkflowExecution":           {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"UpdateWorkflowExecution":          {},
 	// additional endpoints
 	"RecordActivityTaskHeartbeat":      {},
 	"RecordActivityTaskHeartbeatByID":  {},
@@ -71,6 +71,17 @@ type (
 		SignalWorkflowExecution(context.Context, *types.SignalWorkflowExecutionRequest) error
 		StartWorkflowExecution(context.Context, *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest) error
+		UpdateWorkflowExecution(context.Context, *types.UpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
+		CreateSchedule(context.Context, *types.CreateScheduleRequest) error
+		DescribeSchedule(context.Context, *types.DescribeScheduleRequest) (*types.DescribeScheduleResponse, error)
+		UpdateSchedule(context.Context, *types.UpdateScheduleRequest) error
+		PauseSchedule(context.Context, *types.PauseScheduleRequest) error
+		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) error
+		DeleteSchedule(context.Context, *types.DeleteScheduleRequest) error
+		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) error
+		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
 		UpdateDomain(context.Context, *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error)
 	}
 )
@@ -83,6 +83,10 @@ type Config struct {
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// payload offloading
+	PayloadOffloadThreshold dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadOffloadSizeLimit dynamicconfig.IntPropertyFnWithDomainFilter
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 	// Domain specific config
@@ -146,6 +150,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		DisableListVisibilityByFilter:               dc.GetBoolPropertyFilteredByDomain(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                          dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		PayloadOffloadThreshold:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 0),
+		PayloadOffloadSizeLimit:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadSizeLimit, 32*1024*1024),
 		ThrottledLogRPS:                             dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                       dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0),
 		EnableDomainNotActiveAutoForwarding:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableDomainNotActiveAutoForwarding, true),
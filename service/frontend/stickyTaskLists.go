@@ -0,0 +1,137 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sort"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// stickyTaskList is a sticky task list with the open workflows of a domain pinned to it
+type stickyTaskList struct {
+	Name              string
+	Identity          string
+	LastPollTimestamp *int64
+	Workflows         []types.WorkflowExecution
+}
+
+// stickyTaskListScanPageSize is the number of workflows read per page when scanning the shards
+const stickyTaskListScanPageSize = 1000
+
+// scanStickyTaskLists scans the mutable state of the workflows of all shards and returns the sticky task
+// lists the open workflows of the domain are pinned to, sorted by decreasing number of pinned workflows.
+// Mutable state is the source of truth of stickiness, so idle workflows and sticky task lists not loaded
+// by any matching host are included
+func scanStickyTaskLists(
+	ctx context.Context,
+	getExecutionManager func(shardID int) (persistence.ExecutionManager, error),
+	numberOfShards int,
+	domainID string,
+	pageSize int,
+) ([]*stickyTaskList, error) {
+	taskLists := make(map[string]*stickyTaskList)
+	for shardID := 0; shardID < numberOfShards; shardID++ {
+		executionManager, err := getExecutionManager(shardID)
+		if err != nil {
+			return nil, err
+		}
+		request := &persistence.ListConcreteExecutionsRequest{PageSize: pageSize}
+		for {
+			resp, err := executionManager.ListConcreteExecutions(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			for _, execution := range resp.Executions {
+				info := execution.ExecutionInfo
+				if info == nil ||
+					info.DomainID != domainID ||
+					info.StickyTaskList == "" ||
+					info.State == persistence.WorkflowStateCompleted {
+					continue
+				}
+				taskList, ok := taskLists[info.StickyTaskList]
+				if !ok {
+					taskList = &stickyTaskList{Name: info.StickyTaskList}
+					taskLists[info.StickyTaskList] = taskList
+				}
+				taskList.Workflows = append(taskList.Workflows, types.WorkflowExecution{
+					WorkflowID: info.WorkflowID,
+					RunID:      info.RunID,
+				})
+			}
+			if len(resp.PageToken) == 0 {
+				break
+			}
+			request.PageToken = resp.PageToken
+		}
+	}
+
+	result := make([]*stickyTaskList, 0, len(taskLists))
+	for _, taskList := range taskLists {
+		result = append(result, taskList)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i].Workflows) != len(result[j].Workflows) {
+			return len(result[i].Workflows) > len(result[j].Workflows)
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// describeStickyTaskLists sets the worker owning the sticky task lists. Task lists loaded by a matching
+// host report their last poll time, the worker identity of the others is read from their persisted row.
+// The row of a task list whose worker stopped polling long ago may have expired, its worker is unknown
+func describeStickyTaskLists(
+	ctx context.Context,
+	taskManager persistence.TaskManager,
+	loadedTaskLists []*types.MatchingStickyTaskList,
+	domainID string,
+	taskLists []*stickyTaskList,
+) error {
+	loaded := make(map[string]*types.MatchingStickyTaskList, len(loadedTaskLists))
+	for _, taskList := range loadedTaskLists {
+		loaded[taskList.GetName()] = taskList
+	}
+	for _, taskList := range taskLists {
+		if loadedTaskList, ok := loaded[taskList.Name]; ok {
+			taskList.Identity = loadedTaskList.GetIdentity()
+			taskList.LastPollTimestamp = loadedTaskList.LastPollTimestamp
+			continue
+		}
+		resp, err := taskManager.GetTaskList(ctx, &persistence.GetTaskListRequest{
+			DomainID: domainID,
+			TaskList: taskList.Name,
+			TaskType: persistence.TaskListTypeDecision,
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				continue
+			}
+			return err
+		}
+		taskList.Identity = resp.TaskListInfo.WorkerIdentity
+	}
+	return nil
+}
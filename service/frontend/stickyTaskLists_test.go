@@ -0,0 +1,123 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func newStickyExecution(domainID, workflowID, stickyTaskList string, state int) *persistence.ListConcreteExecutionsEntity {
+	return &persistence.ListConcreteExecutionsEntity{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:       domainID,
+			WorkflowID:     workflowID,
+			RunID:          "rid",
+			StickyTaskList: stickyTaskList,
+			State:          state,
+		},
+	}
+}
+
+func TestScanStickyTaskLists(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	executionManagers := []*persistence.MockExecutionManager{
+		persistence.NewMockExecutionManager(mockCtrl),
+		persistence.NewMockExecutionManager(mockCtrl),
+	}
+	getExecutionManager := func(shardID int) (persistence.ExecutionManager, error) { return executionManagers[shardID], nil }
+
+	gomock.InOrder(
+		executionManagers[0].EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{PageSize: 2}).
+			Return(&persistence.ListConcreteExecutionsResponse{
+				Executions: []*persistence.ListConcreteExecutionsEntity{
+					newStickyExecution("domainID", "wid1", "host1:sticky", persistence.WorkflowStateRunning),
+					newStickyExecution("domainID", "wid2", "", persistence.WorkflowStateRunning),
+				},
+				PageToken: []byte("token"),
+			}, nil),
+		executionManagers[0].EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{PageSize: 2, PageToken: []byte("token")}).
+			Return(&persistence.ListConcreteExecutionsResponse{
+				Executions: []*persistence.ListConcreteExecutionsEntity{
+					newStickyExecution("domainID", "wid3", "host2:sticky", persistence.WorkflowStateRunning),
+					newStickyExecution("domainID", "wid4", "host2:sticky", persistence.WorkflowStateCompleted),
+				},
+			}, nil),
+	)
+	executionManagers[1].EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{PageSize: 2}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{
+				newStickyExecution("domainID", "wid5", "host2:sticky", persistence.WorkflowStateRunning),
+				newStickyExecution("otherDomainID", "wid6", "host1:sticky", persistence.WorkflowStateRunning),
+			},
+		}, nil)
+
+	taskLists, err := scanStickyTaskLists(context.Background(), getExecutionManager, 2, "domainID", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []*stickyTaskList{
+		{
+			Name: "host2:sticky",
+			Workflows: []types.WorkflowExecution{
+				{WorkflowID: "wid3", RunID: "rid"},
+				{WorkflowID: "wid5", RunID: "rid"},
+			},
+		},
+		{
+			Name:      "host1:sticky",
+			Workflows: []types.WorkflowExecution{{WorkflowID: "wid1", RunID: "rid"}},
+		},
+	}, taskLists)
+}
+
+func TestDescribeStickyTaskLists(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	taskManager := persistence.NewMockTaskManager(mockCtrl)
+	taskLists := []*stickyTaskList{{Name: "host1:sticky"}, {Name: "host2:sticky"}, {Name: "host3:sticky"}}
+	loadedTaskLists := []*types.MatchingStickyTaskList{
+		{Name: "host1:sticky", Identity: "worker@host1", LastPollTimestamp: common.Int64Ptr(1)},
+	}
+
+	taskManager.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+		DomainID: "domainID",
+		TaskList: "host2:sticky",
+		TaskType: persistence.TaskListTypeDecision,
+	}).Return(&persistence.GetTaskListResponse{
+		TaskListInfo: &persistence.TaskListInfo{WorkerIdentity: "worker@host2"},
+	}, nil)
+	// the row of the task list expired
+	taskManager.EXPECT().GetTaskList(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+
+	assert.NoError(t, describeStickyTaskLists(context.Background(), taskManager, loadedTaskLists, "domainID", taskLists))
+	assert.Equal(t, []*stickyTaskList{
+		{Name: "host1:sticky", Identity: "worker@host1", LastPollTimestamp: common.Int64Ptr(1)},
+		{Name: "host2:sticky", Identity: "worker@host2"},
+		{Name: "host3:sticky"},
+	}, taskLists)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// deadLetterTasksPageToken is the next page token of ListDeadLetterTasks
+	deadLetterTasksPageToken struct {
+		ReadLevel int64
+	}
+)
+
+const (
+	defaultDeadLetterTasksPageSize = 100
+	// maxDeadLetterTasks is the max number of dead-lettered tasks listed, requeued or purged by a single request
+	maxDeadLetterTasks = 1000
+)
+
+// validateDeadLetterReason returns an error unless the reason is one tasks are dead-lettered for
+func validateDeadLetterReason(reason string) error {
+	for _, r := range common.DeadLetterReasons {
+		if r == reason {
+			return nil
+		}
+	}
+	return &types.BadRequestError{
+		Message: fmt.Sprintf("Invalid dead-letter reason %v, must be one of %v.", reason, common.DeadLetterReasons),
+	}
+}
+
+// getDeadLetterMaxTasks returns the number of tasks to requeue or purge, a single one if
+// the request targets a task by ID
+func getDeadLetterMaxTasks(taskID int64, maxTasks int32) (int32, error) {
+	if taskID < 0 {
+		return 0, &types.BadRequestError{Message: "TaskID must not be negative."}
+	}
+	if taskID > 0 {
+		return 1, nil
+	}
+	if maxTasks <= 0 || maxTasks > maxDeadLetterTasks {
+		return 0, &types.BadRequestError{
+			Message: fmt.Sprintf("MaxTasks must be between 1 and %v.", maxDeadLetterTasks),
+		}
+	}
+	return maxTasks, nil
+}
+
+// getTaskListTypeOrDefault returns the given task list type, decision if it is not set
+func getTaskListTypeOrDefault(taskListType *types.TaskListType) types.TaskListType {
+	if taskListType == nil {
+		return types.TaskListTypeDecision
+	}
+	return *taskListType
+}
+
+// listDeadLetterTasksPage reads a page of the dead-letter task list of the request
+func listDeadLetterTasksPage(
+	ctx context.Context,
+	taskManager persistence.TaskManager,
+	request *persistence.GetTasksRequest,
+) (*types.ListDeadLetterTasksResponse, bool, error) {
+	resp, err := taskManager.GetTasks(ctx, request)
+	if err != nil {
+		return nil, false, err
+	}
+	result := &types.ListDeadLetterTasksResponse{
+		Tasks: make([]*types.DeadLetterTask, 0, len(resp.Tasks)),
+	}
+	for _, task := range resp.Tasks {
+		result.Tasks = append(result.Tasks, &types.DeadLetterTask{
+			TaskID:                task.TaskID,
+			WorkflowID:            task.WorkflowID,
+			RunID:                 task.RunID,
+			ScheduleID:            task.ScheduleID,
+			DeadLetteredTimestamp: task.CreatedTime.UnixNano(),
+		})
+	}
+	return result, len(resp.Tasks) == request.BatchSize, nil
+}
+
+// purgeDeadLetterTasks deletes up to maxTasks tasks of the dead-letter task list, or only the
+// task with the given ID if it is not 0. Dead-letter task lists are only appended to by the
+// owner of the task list, so their tasks are deleted without taking the lease
+func purgeDeadLetterTasks(
+	ctx context.Context,
+	taskManager persistence.TaskManager,
+	taskList *persistence.TaskListInfo,
+	taskID int64,
+	maxTasks int,
+) (int, error) {
+	request := &persistence.GetTasksRequest{
+		DomainID:  taskList.DomainID,
+		TaskList:  taskList.Name,
+		TaskType:  taskList.TaskType,
+		BatchSize: maxTasks,
+	}
+	if taskID > 0 {
+		request.ReadLevel = taskID - 1
+		request.MaxReadLevel = common.Int64Ptr(taskID)
+	}
+	resp, err := taskManager.GetTasks(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, task := range resp.Tasks {
+		if err := taskManager.CompleteTask(ctx, &persistence.CompleteTaskRequest{
+			TaskList: taskList,
+			TaskID:   task.TaskID,
+		}); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
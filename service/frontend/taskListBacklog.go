@@ -0,0 +1,263 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// taskListBacklogTask contains the attributes a backlog task is aggregated by
+	taskListBacklogTask struct {
+		DomainName   string
+		WorkflowType string
+		ActivityType string
+		// Stale is set when the workflow or activity the task belongs to no longer exists,
+		// matching drops such tasks when they are dispatched
+		Stale bool
+	}
+
+	// taskListBacklogResolver resolves the attributes of a persisted task list task
+	taskListBacklogResolver interface {
+		Resolve(ctx context.Context, task *persistence.TaskInfo) (*taskListBacklogTask, error)
+	}
+
+	// taskListBacklogResolverImpl looks up the workflows of the tasks in persistence. Its caches
+	// live for a single page of the backlog, so their size is bounded by the page size
+	taskListBacklogResolverImpl struct {
+		taskListType        types.TaskListType
+		numberOfShards      int
+		domainCache         cache.DomainCache
+		historyManager      persistence.HistoryManager
+		getExecutionManager func(shardID int) (persistence.ExecutionManager, error)
+
+		workflows     map[types.WorkflowExecution]*persistence.WorkflowMutableState
+		activityTypes map[string]string
+	}
+
+	// taskListBacklogPageToken is the next page token of GetTaskListBacklog
+	taskListBacklogPageToken struct {
+		AckLevel  int64
+		ReadLevel int64
+	}
+)
+
+const (
+	unknownBacklogAttribute = "<unknown>"
+
+	defaultTaskListBacklogPageSize = 100
+	maxTaskListBacklogPageSize     = 1000
+)
+
+func newTaskListBacklogResolver(
+	taskListType types.TaskListType,
+	numberOfShards int,
+	domainCache cache.DomainCache,
+	historyManager persistence.HistoryManager,
+	getExecutionManager func(shardID int) (persistence.ExecutionManager, error),
+) *taskListBacklogResolverImpl {
+	return &taskListBacklogResolverImpl{
+		taskListType:        taskListType,
+		numberOfShards:      numberOfShards,
+		domainCache:         domainCache,
+		historyManager:      historyManager,
+		getExecutionManager: getExecutionManager,
+		workflows:           make(map[types.WorkflowExecution]*persistence.WorkflowMutableState),
+		activityTypes:       make(map[string]string),
+	}
+}
+
+// scanTaskListBacklogPage reads a page of the persisted tasks above the read level of the
+// request and aggregates them, the returned bool tells whether there are more tasks to read
+func scanTaskListBacklogPage(
+	ctx context.Context,
+	taskManager persistence.TaskManager,
+	resolver taskListBacklogResolver,
+	request *persistence.GetTasksRequest,
+) (*types.GetTaskListBacklogResponse, bool, error) {
+	// read one more task than requested to tell whether this is the last page
+	req := *request
+	req.BatchSize = request.BatchSize + 1
+	resp, err := taskManager.GetTasks(ctx, &req)
+	if err != nil {
+		return nil, false, err
+	}
+	tasks := resp.Tasks
+	hasMore := len(tasks) > request.BatchSize
+	if hasMore {
+		tasks = tasks[:request.BatchSize]
+	}
+
+	backlog := &types.GetTaskListBacklogResponse{
+		LastTaskID:     request.ReadLevel,
+		ByDomain:       make(map[string]int32),
+		ByWorkflowType: make(map[string]int32),
+		ByActivityType: make(map[string]int32),
+	}
+	for _, task := range tasks {
+		attributes, err := resolver.Resolve(ctx, task)
+		if err != nil {
+			return nil, false, err
+		}
+		backlog.Scanned++
+		if task.TaskID > backlog.LastTaskID {
+			backlog.LastTaskID = task.TaskID
+		}
+		if attributes.Stale {
+			backlog.Stale++
+		}
+		backlog.ByDomain[attributes.DomainName]++
+		backlog.ByWorkflowType[attributes.WorkflowType]++
+		if attributes.ActivityType != "" {
+			backlog.ByActivityType[attributes.ActivityType]++
+		}
+	}
+	return backlog, hasMore, nil
+}
+
+func (r *taskListBacklogResolverImpl) Resolve(
+	ctx context.Context,
+	task *persistence.TaskInfo,
+) (*taskListBacklogTask, error) {
+	domainName, err := r.domainCache.GetDomainName(task.DomainID)
+	if err != nil {
+		return nil, err
+	}
+	result := &taskListBacklogTask{
+		DomainName:   domainName,
+		WorkflowType: unknownBacklogAttribute,
+	}
+	if r.taskListType == types.TaskListTypeActivity {
+		result.ActivityType = unknownBacklogAttribute
+	}
+
+	state, err := r.getWorkflow(ctx, task.DomainID, types.WorkflowExecution{
+		WorkflowID: task.WorkflowID,
+		RunID:      task.RunID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		result.Stale = true
+		return result, nil
+	}
+	result.WorkflowType = state.ExecutionInfo.WorkflowTypeName
+	if state.ExecutionInfo.State == persistence.WorkflowStateCompleted {
+		result.Stale = true
+		return result, nil
+	}
+	if r.taskListType != types.TaskListTypeActivity {
+		return result, nil
+	}
+
+	activityInfo, ok := state.ActivityInfos[task.ScheduleID]
+	if !ok {
+		result.Stale = true
+		return result, nil
+	}
+	activityType, err := r.getActivityType(ctx, task, state, activityInfo)
+	if err != nil {
+		return nil, err
+	}
+	result.ActivityType = activityType
+	return result, nil
+}
+
+// getWorkflow returns the mutable state of the workflow, or nil if the workflow no longer exists
+func (r *taskListBacklogResolverImpl) getWorkflow(
+	ctx context.Context,
+	domainID string,
+	execution types.WorkflowExecution,
+) (*persistence.WorkflowMutableState, error) {
+	if state, ok := r.workflows[execution]; ok {
+		return state, nil
+	}
+	shardID := common.WorkflowIDToHistoryShard(execution.WorkflowID, r.numberOfShards)
+	executionManager, err := r.getExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID:  domainID,
+		Execution: execution,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			return nil, err
+		}
+		r.workflows[execution] = nil
+		return nil, nil
+	}
+	r.workflows[execution] = resp.State
+	return resp.State, nil
+}
+
+func (r *taskListBacklogResolverImpl) getActivityType(
+	ctx context.Context,
+	task *persistence.TaskInfo,
+	state *persistence.WorkflowMutableState,
+	activityInfo *persistence.ActivityInfo,
+) (string, error) {
+	if activityInfo.ScheduledEvent != nil {
+		return activityInfo.ScheduledEvent.GetActivityTaskScheduledEventAttributes().GetActivityType().GetName(), nil
+	}
+
+	key := fmt.Sprintf("%v/%v/%v/%v", task.DomainID, task.WorkflowID, task.RunID, task.ScheduleID)
+	if activityType, ok := r.activityTypes[key]; ok {
+		return activityType, nil
+	}
+
+	branchToken := state.ExecutionInfo.BranchToken
+	if state.VersionHistories != nil {
+		currentVersionHistory, err := state.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return "", err
+		}
+		branchToken = currentVersionHistory.GetBranchToken()
+	}
+	shardID := common.WorkflowIDToHistoryShard(task.WorkflowID, r.numberOfShards)
+	resp, err := r.historyManager.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  activityInfo.ScheduledEventBatchID,
+		MaxEventID:  activityInfo.ScheduleID + 1,
+		PageSize:    1,
+		ShardID:     common.IntPtr(shardID),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	activityType := unknownBacklogAttribute
+	for _, event := range resp.HistoryEvents {
+		if event.ID == activityInfo.ScheduleID {
+			activityType = event.GetActivityTaskScheduledEventAttributes().GetActivityType().GetName()
+		}
+	}
+	r.activityTypes[key] = activityType
+	return activityType, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type fakeTaskListBacklogResolver map[string]*taskListBacklogTask
+
+func (r fakeTaskListBacklogResolver) Resolve(
+	_ context.Context,
+	task *persistence.TaskInfo,
+) (*taskListBacklogTask, error) {
+	attributes, ok := r[task.WorkflowID]
+	if !ok {
+		return nil, errors.New("unknown workflow")
+	}
+	return attributes, nil
+}
+
+func TestScanTaskListBacklogPage(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	taskManager := persistence.NewMockTaskManager(mockCtrl)
+	resolver := fakeTaskListBacklogResolver{
+		"wf1": {DomainName: "domain", WorkflowType: "typeA", ActivityType: "activityA"},
+		"wf2": {DomainName: "domain", WorkflowType: "typeB", ActivityType: "activityA"},
+		"wf3": {DomainName: "other-domain", WorkflowType: "<unknown>", ActivityType: "<unknown>", Stale: true},
+	}
+	request := &persistence.GetTasksRequest{
+		DomainID:  "domainID",
+		TaskList:  "tl",
+		TaskType:  persistence.TaskListTypeActivity,
+		ReadLevel: 10,
+		BatchSize: 3,
+	}
+	expected := *request
+	expected.BatchSize = 4
+	taskManager.EXPECT().GetTasks(gomock.Any(), &expected).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistence.TaskInfo{
+			{TaskID: 11, WorkflowID: "wf1"},
+			{TaskID: 12, WorkflowID: "wf2"},
+			{TaskID: 15, WorkflowID: "wf3"},
+			{TaskID: 16, WorkflowID: "wf1"},
+		},
+	}, nil).Times(1)
+
+	backlog, hasMore, err := scanTaskListBacklogPage(context.Background(), taskManager, resolver, request)
+	assert.NoError(t, err)
+	assert.True(t, hasMore)
+	assert.Equal(t, &types.GetTaskListBacklogResponse{
+		Scanned:        3,
+		Stale:          1,
+		LastTaskID:     15,
+		ByDomain:       map[string]int32{"domain": 2, "other-domain": 1},
+		ByWorkflowType: map[string]int32{"typeA": 1, "typeB": 1, "<unknown>": 1},
+		ByActivityType: map[string]int32{"activityA": 2, "<unknown>": 1},
+	}, backlog)
+}
+
+func TestScanTaskListBacklogPage_LastPage(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	taskManager := persistence.NewMockTaskManager(mockCtrl)
+	resolver := fakeTaskListBacklogResolver{
+		"wf1": {DomainName: "domain", WorkflowType: "typeA"},
+	}
+	taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistence.TaskInfo{{TaskID: 3, WorkflowID: "wf1"}},
+	}, nil).Times(1)
+
+	backlog, hasMore, err := scanTaskListBacklogPage(
+		context.Background(),
+		taskManager,
+		resolver,
+		&persistence.GetTasksRequest{TaskList: "tl", BatchSize: 10},
+	)
+	assert.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Equal(t, int32(1), backlog.Scanned)
+	assert.Empty(t, backlog.ByActivityType)
+}
+
+func TestTaskListBacklogResolver(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	domainCache := cache.NewMockDomainCache(mockCtrl)
+	executionManager := persistence.NewMockExecutionManager(mockCtrl)
+	resolver := newTaskListBacklogResolver(
+		types.TaskListTypeActivity,
+		4,
+		domainCache,
+		nil,
+		func(int) (persistence.ExecutionManager, error) { return executionManager, nil },
+	)
+
+	domainCache.EXPECT().GetDomainName("domainID").Return("domain", nil).AnyTimes()
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+		DomainID:  "domainID",
+		Execution: types.WorkflowExecution{WorkflowID: "wf1", RunID: "run1"},
+	}).Return(&persistence.GetWorkflowExecutionResponse{State: &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{WorkflowTypeName: "typeA"},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduledEvent: &types.HistoryEvent{
+				ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					ActivityType: &types.ActivityType{Name: "activityA"},
+				},
+			}},
+		},
+	}}, nil).Times(1)
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+		DomainID:  "domainID",
+		Execution: types.WorkflowExecution{WorkflowID: "wf2", RunID: "run2"},
+	}).Return(nil, &types.EntityNotExistsError{}).Times(1)
+
+	result, err := resolver.Resolve(context.Background(), &persistence.TaskInfo{
+		DomainID: "domainID", WorkflowID: "wf1", RunID: "run1", ScheduleID: 5,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &taskListBacklogTask{DomainName: "domain", WorkflowType: "typeA", ActivityType: "activityA"}, result)
+
+	// the activity of the second task is already completed, the workflow is read from the cache
+	result, err = resolver.Resolve(context.Background(), &persistence.TaskInfo{
+		DomainID: "domainID", WorkflowID: "wf1", RunID: "run1", ScheduleID: 7,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &taskListBacklogTask{DomainName: "domain", WorkflowType: "typeA", ActivityType: "<unknown>", Stale: true}, result)
+
+	result, err = resolver.Resolve(context.Background(), &persistence.TaskInfo{
+		DomainID: "domainID", WorkflowID: "wf2", RunID: "run2", ScheduleID: 5,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &taskListBacklogTask{DomainName: "domain", WorkflowType: "<unknown>", ActivityType: "<unknown>", Stale: true}, result)
+}
@@ -289,6 +289,72 @@ func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *
 	return thrift.FromError(err)
 }
 
+// PauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) PauseWorkflowExecution(ctx context.Context, request *shared.PauseWorkflowExecutionRequest) error {
+	err := t.h.PauseWorkflowExecution(ctx, thrift.ToPauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, request *shared.UnpauseWorkflowExecutionRequest) error {
+	err := t.h.UnpauseWorkflowExecution(ctx, thrift.ToUnpauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// UpdateWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UpdateWorkflowExecution(ctx context.Context, request *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	response, err := t.h.UpdateWorkflowExecution(ctx, thrift.ToUpdateWorkflowExecutionRequest(request))
+	return thrift.FromUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
+// CreateSchedule forwards request to the underlying handler
+func (t ThriftHandler) CreateSchedule(ctx context.Context, request *shared.CreateScheduleRequest) error {
+	err := t.h.CreateSchedule(ctx, thrift.ToCreateScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// DescribeSchedule forwards request to the underlying handler
+func (t ThriftHandler) DescribeSchedule(ctx context.Context, request *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse, error) {
+	response, err := t.h.DescribeSchedule(ctx, thrift.ToDescribeScheduleRequest(request))
+	return thrift.FromDescribeScheduleResponse(response), thrift.FromError(err)
+}
+
+// UpdateSchedule forwards request to the underlying handler
+func (t ThriftHandler) UpdateSchedule(ctx context.Context, request *shared.UpdateScheduleRequest) error {
+	err := t.h.UpdateSchedule(ctx, thrift.ToUpdateScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// PauseSchedule forwards request to the underlying handler
+func (t ThriftHandler) PauseSchedule(ctx context.Context, request *shared.PauseScheduleRequest) error {
+	err := t.h.PauseSchedule(ctx, thrift.ToPauseScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseSchedule forwards request to the underlying handler
+func (t ThriftHandler) UnpauseSchedule(ctx context.Context, request *shared.UnpauseScheduleRequest) error {
+	err := t.h.UnpauseSchedule(ctx, thrift.ToUnpauseScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// DeleteSchedule forwards request to the underlying handler
+func (t ThriftHandler) DeleteSchedule(ctx context.Context, request *shared.DeleteScheduleRequest) error {
+	err := t.h.DeleteSchedule(ctx, thrift.ToDeleteScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// BackfillSchedule forwards request to the underlying handler
+func (t ThriftHandler) BackfillSchedule(ctx context.Context, request *shared.BackfillScheduleRequest) error {
+	err := t.h.BackfillSchedule(ctx, thrift.ToBackfillScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// ListSchedules forwards request to the underlying handler
+func (t ThriftHandler) ListSchedules(ctx context.Context, request *shared.ListSchedulesRequest) (*shared.ListSchedulesResponse, error) {
+	response, err := t.h.ListSchedules(ctx, thrift.ToListSchedulesRequest(request))
+	return thrift.FromListSchedulesResponse(response), thrift.FromError(err)
+}
+
 // UpdateDomain forwards request to the underlying handler
 func (t ThriftHandler) UpdateDomain(ctx context.Context, request *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error) {
 	response, err := t.h.UpdateDomain(ctx, thrift.ToUpdateDomainRequest(request))
@@ -25,6 +25,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -33,6 +35,7 @@ import (
 	"go.uber.org/yarpc/yarpcerrors"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/backoff"
@@ -44,10 +47,12 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/schedule"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
@@ -56,6 +61,7 @@ import (
 const (
 	getDomainReplicationMessageBatchSize = 100
 	defaultLastMessageID                 = int64(-1)
+	scheduleDeletedReason                = "Schedule deleted"
 )
 
 const (
@@ -85,6 +91,7 @@ type (
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
 		throttleRetry             *backoff.ThrottleRetry
+		payloadStore              *payload.Store
 	}
 
 	getHistoryContinuationToken struct {
@@ -120,6 +127,10 @@ var (
 	errNextPageTokenRunIDMismatch                 = &types.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
 	errQueryNotSet                                = &types.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	errQueryTypeNotSet                            = &types.BadRequestError{Message: "QueryType is not set on request."}
+	errUpdateNameNotSet                           = &types.BadRequestError{Message: "UpdateName is not set on request."}
+	errScheduleIDNotSet                           = &types.BadRequestError{Message: "ScheduleID is not set on request."}
+	errInvalidBackfillTimeRange                   = &types.BadRequestError{Message: "A valid time range with StartTimeNano before EndTimeNano is not set on request."}
+	errScheduleNotExists                          = &types.EntityNotExistsError{Message: "Schedule does not exist."}
 	errRequestNotSet                              = &types.BadRequestError{Message: "Request is nil."}
 	errNoPermission                               = &types.BadRequestError{Message: "No permission to do this operation."}
 	errRequestIDNotSet                            = &types.BadRequestError{Message: "RequestId is not set on request."}
@@ -128,6 +139,7 @@ var (
 	errInvalidExecutionStartToCloseTimeoutSeconds = &types.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &types.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidDelayStartSeconds                   = &types.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
+	errInvalidInactivityTimeoutSeconds            = &types.BadRequestError{Message: "A valid InactivityTimeoutSeconds is not set on request."}
 	errQueryDisallowedForDomain                   = &types.BadRequestError{Message: "Domain is not allowed to query, please contact cadence team to re-enable queries."}
 	errClusterNameNotSet                          = &types.BadRequestError{Message: "Cluster name is not set."}
 	errEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
@@ -135,9 +147,16 @@ var (
 	errDomainInLockdown                           = &types.BadRequestError{Message: "Domain is not accepting fail overs at this time due to lockdown."}
 	errShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
 
+	// err for poller attributes
+	errInvalidPollerLabels   = &types.BadRequestError{Message: "Poller labels header is invalid, expected comma separated key=value pairs."}
+	errInvalidPollerCapacity = &types.BadRequestError{Message: "Poller capacity header is invalid, expected a positive integer."}
+
 	// err for archival
 	errHistoryNotFound = &types.BadRequestError{Message: "Requested workflow history not found, may have passed retention period."}
 
+	// err for history compaction
+	errHistoryCompactionNotSupported = &types.FeatureNotEnabledError{FeatureFlag: "WorkflowHistoryCompactionEnabled"}
+
 	// err for string too long
 	errDomainTooLong       = &types.BadRequestError{Message: "Domain length exceeds limit."}
 	errWorkflowTypeTooLong = &types.BadRequestError{Message: "WorkflowType length exceeds limit."}
@@ -207,6 +226,11 @@ func NewWorkflowHandler(
 			backoff.WithRetryPolicy(frontendServiceRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		payloadStore: payload.NewStore(
+			resource.GetBlobstoreClient(),
+			config.PayloadOffloadThreshold,
+			config.PayloadOffloadSizeLimit,
+		),
 	}
 }
 
@@ -585,12 +609,20 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	pollerLabels, pollerCapacity, err := getPollerAttributes(ctx)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	pollerID := uuid.New()
 	op := func() error {
 		resp, err = wh.GetMatchingClient().PollForActivityTask(ctx, &types.MatchingPollForActivityTaskRequest{
-			DomainUUID:  domainID,
-			PollerID:    pollerID,
-			PollRequest: pollRequest,
+			DomainUUID:     domainID,
+			PollerID:       pollerID,
+			PollRequest:    pollRequest,
+			PollerLabels:   pollerLabels,
+			PollerCapacity: pollerCapacity,
+			IsolationGroup: getCallerIsolationGroup(ctx),
 		})
 		return err
 	}
@@ -700,13 +732,21 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
+	pollerLabels, pollerCapacity, err := getPollerAttributes(ctx)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	pollerID := uuid.New()
 	var matchingResp *types.MatchingPollForDecisionTaskResponse
 	op := func() error {
 		matchingResp, err = wh.GetMatchingClient().PollForDecisionTask(ctx, &types.MatchingPollForDecisionTaskRequest{
-			DomainUUID:  domainID,
-			PollerID:    pollerID,
-			PollRequest: pollRequest,
+			DomainUUID:     domainID,
+			PollerID:       pollerID,
+			PollRequest:    pollRequest,
+			PollerLabels:   pollerLabels,
+			PollerCapacity: pollerCapacity,
+			IsolationGroup: getCallerIsolationGroup(ctx),
 		})
 		return err
 	}
@@ -2084,6 +2124,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errInvalidDelayStartSeconds, scope, tags...)
 	}
 
+	if startRequest.GetInactivityTimeoutSeconds() < 0 {
+		return nil, wh.error(errInvalidInactivityTimeoutSeconds, scope, tags...)
+	}
+
 	if startRequest.GetRequestID() == "" {
 		return nil, wh.error(errRequestIDNotSet, scope, tags...)
 	}
@@ -2112,7 +2156,11 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
-	actualSize := len(startRequest.Input)
+	// inputs above the offload threshold are stored in the blobstore and only their reference counts towards the limit
+	actualSize, err := wh.payloadStore.InlineSize(domainName, domainID, startRequest.Input)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
 	if startRequest.Memo != nil {
 		actualSize += common.GetSizeOfMapStringToByteArray(startRequest.Memo.GetFields())
 	}
@@ -2130,6 +2178,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	startRequest.Header = withCallerIsolationGroup(ctx, startRequest.Header)
 	wh.GetLogger().Debug("Start workflow execution request domainID", tag.WorkflowDomainID(domainID))
 	historyRequest := common.CreateHistoryStartWorkflowRequest(
 		domainID, startRequest, time.Now())
@@ -2650,6 +2699,14 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope, tags...)
 	}
 
+	if signalWithStartRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope, tags...)
+	}
+
+	if signalWithStartRequest.GetInactivityTimeoutSeconds() < 0 {
+		return nil, wh.error(errInvalidInactivityTimeoutSeconds, scope, tags...)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
@@ -2682,7 +2739,11 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 	); err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
-	actualSize := len(signalWithStartRequest.Input) + common.GetSizeOfMapStringToByteArray(signalWithStartRequest.Memo.GetFields())
+	inputSize, err := wh.payloadStore.InlineSize(domainName, domainID, signalWithStartRequest.Input)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	actualSize := inputSize + common.GetSizeOfMapStringToByteArray(signalWithStartRequest.Memo.GetFields())
 	if err := common.CheckEventBlobSizeLimit(
 		actualSize,
 		sizeLimitWarn,
@@ -2697,6 +2758,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	signalWithStartRequest.Header = withCallerIsolationGroup(ctx, signalWithStartRequest.Header)
 	resp, err = wh.GetHistoryClient().SignalWithStartWorkflowExecution(ctx, &types.HistorySignalWithStartWorkflowExecutionRequest{
 		DomainUUID:             domainID,
 		SignalWithStartRequest: signalWithStartRequest,
@@ -2763,69 +2825,72 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
-// ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
-// in the history and immediately terminating the current execution instance.
-func (wh *WorkflowHandler) ResetWorkflowExecution(
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history. No decision or activity tasks are dispatched while the workflow is paused, and the
+// schedule-to-start timeouts of activities waiting to be dispatched restart once it is unpaused.
+// The workflow timeout and the timeouts of already started activities still apply while paused.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
 	ctx context.Context,
-	resetRequest *types.ResetWorkflowExecutionRequest,
-) (resp *types.ResetWorkflowExecutionResponse, retError error) {
+	pauseRequest *types.PauseWorkflowExecutionRequest,
+) (retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendResetWorkflowExecutionScope, resetRequest)
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendPauseWorkflowExecutionScope, pauseRequest)
 	defer sw.Stop()
 
 	if wh.isShuttingDown() {
-		return nil, errShuttingDown
+		return errShuttingDown
 	}
 
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
-		return nil, wh.error(err, scope)
+		return wh.error(err, scope)
 	}
 
-	if resetRequest == nil {
-		return nil, wh.error(errRequestNotSet, scope)
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
 	}
 
-	domainName := resetRequest.GetDomain()
-	wfExecution := resetRequest.GetWorkflowExecution()
+	domainName := pauseRequest.GetDomain()
+	wfExecution := pauseRequest.GetWorkflowExecution()
 	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
 
-	if domainName == "" {
-		return nil, wh.error(errDomainNotSet, scope, tags...)
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(true, resetRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	if ok := wh.allow(true, pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return wh.error(err, scope, tags...)
 	}
 
-	domainID, err := wh.GetDomainCache().GetDomainID(resetRequest.GetDomain())
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return wh.error(err, scope, tags...)
 	}
 
-	resp, err = wh.GetHistoryClient().ResetWorkflowExecution(ctx, &types.HistoryResetWorkflowExecutionRequest{
+	err = wh.GetHistoryClient().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{
 		DomainUUID:   domainID,
-		ResetRequest: resetRequest,
+		PauseRequest: pauseRequest,
 	})
 	if err != nil {
-		return nil, wh.error(err, scope, tags...)
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
 	}
 
-	return resp, nil
+	return nil
 }
 
-// RequestCancelWorkflowExecution - requests to cancel a workflow execution
-func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
+// UnpauseWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionUnpaused event
+// in the history and dispatching the tasks held back while the workflow was paused.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
 	ctx context.Context,
-	cancelRequest *types.RequestCancelWorkflowExecutionRequest,
+	unpauseRequest *types.UnpauseWorkflowExecutionRequest,
 ) (retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendRequestCancelWorkflowExecutionScope, cancelRequest)
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUnpauseWorkflowExecutionScope, unpauseRequest)
 	defer sw.Stop()
 
 	if wh.isShuttingDown() {
@@ -2836,19 +2901,19 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(err, scope)
 	}
 
-	if cancelRequest == nil {
+	if unpauseRequest == nil {
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	domainName := cancelRequest.GetDomain()
-	wfExecution := cancelRequest.GetWorkflowExecution()
+	domainName := unpauseRequest.GetDomain()
+	wfExecution := unpauseRequest.GetWorkflowExecution()
 	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
 
-	if domainName == "" {
+	if unpauseRequest.GetDomain() == "" {
 		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(true, cancelRequest); !ok {
+	if ok := wh.allow(true, unpauseRequest); !ok {
 		return wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -2856,14 +2921,14 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(err, scope, tags...)
 	}
 
-	domainID, err := wh.GetDomainCache().GetDomainID(cancelRequest.GetDomain())
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
 		return wh.error(err, scope, tags...)
 	}
 
-	err = wh.GetHistoryClient().RequestCancelWorkflowExecution(ctx, &types.HistoryRequestCancelWorkflowExecutionRequest{
-		DomainUUID:    domainID,
-		CancelRequest: cancelRequest,
+	err = wh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
 	})
 	if err != nil {
 		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
@@ -2872,14 +2937,16 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	return nil
 }
 
-// ListOpenWorkflowExecutions - retrieves info for open workflow executions in a domain
-func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
+// UpdateWorkflowExecution sends an update to an existing workflow execution and waits for the workflow to respond.
+// The update is recorded as WorkflowExecutionUpdateRequested event in the history and delivered to the workflow
+// with the next decision task, the result the workflow responds with on that decision task is returned to the caller.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
 	ctx context.Context,
-	listRequest *types.ListOpenWorkflowExecutionsRequest,
-) (resp *types.ListOpenWorkflowExecutionsResponse, retError error) {
+	updateRequest *types.UpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListOpenWorkflowExecutionsScope, listRequest)
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUpdateWorkflowExecutionScope, updateRequest)
 	defer sw.Stop()
 
 	if wh.isShuttingDown() {
@@ -2890,205 +2957,879 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
 		return nil, wh.error(err, scope)
 	}
 
-	if listRequest == nil {
+	if updateRequest == nil {
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if listRequest.GetDomain() == "" {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
-
-	if ok := wh.allow(true, listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
-	}
-
-	if listRequest.StartTimeFilter == nil {
-		return nil, wh.error(&types.BadRequestError{Message: "StartTimeFilter is required"}, scope)
-	}
-
-	if listRequest.StartTimeFilter.EarliestTime == nil {
-		return nil, wh.error(&types.BadRequestError{Message: "EarliestTime in StartTimeFilter is required"}, scope)
-	}
+	domainName := updateRequest.GetDomain()
+	wfExecution := updateRequest.GetWorkflowExecution()
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
 
-	if listRequest.StartTimeFilter.LatestTime == nil {
-		return nil, wh.error(&types.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}, scope)
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if listRequest.StartTimeFilter.GetEarliestTime() > listRequest.StartTimeFilter.GetLatestTime() {
-		return nil, wh.error(&types.BadRequestError{Message: "EarliestTime in StartTimeFilter should not be larger than LatestTime"}, scope)
+	if ok := wh.allow(true, updateRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
-	if listRequest.ExecutionFilter != nil && listRequest.TypeFilter != nil {
-		return nil, wh.error(&types.BadRequestError{
-			Message: "Only one of ExecutionFilter or TypeFilter is allowed"}, scope)
+	if err := validateExecution(wfExecution); err != nil {
+		return nil, wh.error(err, scope, tags...)
 	}
 
-	if listRequest.GetMaximumPageSize() <= 0 {
-		listRequest.MaximumPageSize = int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain()))
+	if updateRequest.GetUpdateName() == "" {
+		return nil, wh.error(errUpdateNameNotSet, scope, tags...)
 	}
 
-	if wh.isListRequestPageSizeTooLarge(listRequest.GetMaximumPageSize(), listRequest.GetDomain()) {
-		return nil, wh.error(&types.BadRequestError{
-			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
+	if !common.ValidIDLength(
+		updateRequest.GetRequestID(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return nil, wh.error(errRequestIDTooLong, scope, tags...)
 	}
 
-	domain := listRequest.GetDomain()
-	domainID, err := wh.GetDomainCache().GetDomainID(domain)
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
-		return nil, wh.error(err, scope)
-	}
-
-	baseReq := persistence.ListWorkflowExecutionsRequest{
-		DomainUUID:    domainID,
-		Domain:        domain,
-		PageSize:      int(listRequest.GetMaximumPageSize()),
-		NextPageToken: listRequest.NextPageToken,
-		EarliestTime:  listRequest.StartTimeFilter.GetEarliestTime(),
-		LatestTime:    listRequest.StartTimeFilter.GetLatestTime(),
+		return nil, wh.error(err, scope, tags...)
 	}
 
-	var persistenceResp *persistence.ListWorkflowExecutionsResponse
-	if listRequest.ExecutionFilter != nil {
-		if wh.config.DisableListVisibilityByFilter(domain) {
-			err = errNoPermission
-		} else {
-			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByWorkflowID(
-				ctx,
-				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
-					ListWorkflowExecutionsRequest: baseReq,
-					WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowID(),
-				})
-		}
-		wh.GetLogger().Debug("List open workflow with filter",
-			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByID)
-	} else if listRequest.TypeFilter != nil {
-		if wh.config.DisableListVisibilityByFilter(domain) {
-			err = errNoPermission
-		} else {
-			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByType(
-				ctx,
-				&persistence.ListWorkflowExecutionsByTypeRequest{
-					ListWorkflowExecutionsRequest: baseReq,
-					WorkflowTypeName:              listRequest.TypeFilter.GetName(),
-				},
-			)
-		}
-		wh.GetLogger().Debug("List open workflow with filter",
-			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByType)
-	} else {
-		persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutions(ctx, &baseReq)
+	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		wfExecution.GetWorkflowID(),
+		wfExecution.GetRunID(),
+		scope,
+		wh.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation("UpdateWorkflowExecution"),
+	); err != nil {
+		return nil, wh.error(err, scope, tags...)
 	}
 
+	resp, err = wh.GetHistoryClient().UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: updateRequest,
+	})
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return nil, wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
 	}
 
-	resp = &types.ListOpenWorkflowExecutionsResponse{}
-	resp.Executions = persistenceResp.Executions
-	resp.NextPageToken = persistenceResp.NextPageToken
 	return resp, nil
 }
 
-// ListArchivedWorkflowExecutions - retrieves archived info for closed workflow executions in a domain
-func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
+// CreateSchedule creates a schedule that starts workflows in the domain periodically.
+// The schedule is hosted by a system workflow in every cluster of the domain, so that it survives domain failovers,
+// but only the copy in the active cluster of the domain starts runs.
+func (wh *WorkflowHandler) CreateSchedule(
 	ctx context.Context,
-	listRequest *types.ListArchivedWorkflowExecutionsRequest,
-) (resp *types.ListArchivedWorkflowExecutionsResponse, retError error) {
+	createRequest *types.CreateScheduleRequest,
+) (retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListArchivedWorkflowExecutionsScope, listRequest)
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendCreateScheduleScope, createRequest)
 	defer sw.Stop()
 
 	if wh.isShuttingDown() {
-		return nil, errShuttingDown
+		return errShuttingDown
 	}
 
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
-		return nil, wh.error(err, scope)
-	}
-
-	if listRequest == nil {
-		return nil, wh.error(errRequestNotSet, scope)
-	}
-
-	if listRequest.GetDomain() == "" {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
-
-	if ok := wh.allow(true, listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return wh.error(err, scope)
 	}
 
-	if listRequest.GetPageSize() <= 0 {
-		listRequest.PageSize = int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain()))
+	if createRequest == nil {
+		return wh.error(errRequestNotSet, scope)
 	}
 
-	maxPageSize := wh.config.VisibilityArchivalQueryMaxPageSize()
-	if int(listRequest.GetPageSize()) > maxPageSize {
-		return nil, wh.error(&types.BadRequestError{
-			Message: fmt.Sprintf("Pagesize is larger than allowed %d", maxPageSize)}, scope)
-	}
+	domainName := createRequest.GetDomain()
+	scheduleID := createRequest.GetScheduleID()
+	tags := getDomainScheduleIDTags(domainName, scheduleID)
 
-	if !wh.GetArchivalMetadata().GetVisibilityConfig().ClusterConfiguredForArchival() {
-		return nil, wh.error(&types.BadRequestError{Message: "Cluster is not configured for visibility archival"}, scope)
+	if err := validateScheduleRequest(domainName, scheduleID); err != nil {
+		return wh.error(err, scope, tags...)
 	}
 
-	if !wh.GetArchivalMetadata().GetVisibilityConfig().ReadEnabled() {
-		return nil, wh.error(&types.BadRequestError{Message: "Cluster is not configured for reading archived visibility records"}, scope)
+	if ok := wh.allow(true, createRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
 	}
 
-	entry, err := wh.GetDomainCache().GetDomain(listRequest.GetDomain())
-	if err != nil {
-		return nil, wh.error(err, scope)
+	params := &schedule.Params{
+		Domain:     domainName,
+		ScheduleID: scheduleID,
+		Spec:       schedule.ToSpec(createRequest.Spec),
+		Action:     schedule.ToAction(createRequest.Action),
+		Policies:   schedule.ToPolicies(createRequest.Policies),
 	}
-
-	if entry.GetConfig().VisibilityArchivalStatus != types.ArchivalStatusEnabled {
-		return nil, wh.error(&types.BadRequestError{Message: "Domain is not configured for visibility archival"}, scope)
+	if err := validateScheduleParams(params); err != nil {
+		return wh.error(err, scope, tags...)
 	}
+	schedule.SetDefaultParams(params)
 
-	URI, err := archiver.NewURI(entry.GetConfig().VisibilityArchivalURI)
+	input, err := json.Marshal(params)
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return wh.error(err, scope, tags...)
 	}
 
-	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), service.Frontend)
-	if err != nil {
-		return nil, wh.error(err, scope)
+	requestID := createRequest.GetRequestID()
+	if requestID == "" {
+		requestID = uuid.New()
 	}
-
-	archiverRequest := &archiver.QueryVisibilityRequest{
-		DomainID:      entry.GetInfo().ID,
-		PageSize:      int(listRequest.GetPageSize()),
-		NextPageToken: listRequest.NextPageToken,
-		Query:         listRequest.GetQuery(),
+	startRequest := &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		WorkflowID:                          schedule.WorkflowID(domainName, scheduleID),
+		WorkflowType:                        &types.WorkflowType{Name: schedule.WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: schedule.TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(schedule.WorkflowStartToCloseTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(schedule.DecisionStartToCloseTimeout.Seconds())),
+		Identity:                            createRequest.GetIdentity(),
+		// the same requestID in every cluster makes the retry of a partially failed request succeed
+		RequestID:             requestID,
+		WorkflowIDReusePolicy: types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
 	}
 
-	archiverResponse, err := visibilityArchiver.Query(ctx, URI, archiverRequest)
+	clusters, err := wh.getScheduleClusters(domainName)
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return wh.error(err, scope, tags...)
 	}
-
-	// special handling of ExecutionTime for cron or retry
-	for _, execution := range archiverResponse.Executions {
-		if execution.GetExecutionTime() == 0 {
-			execution.ExecutionTime = common.Int64Ptr(execution.GetStartTime())
+	for _, clusterName := range clusters {
+		if _, err := wh.getScheduleClient(clusterName).StartWorkflowExecution(ctx, startRequest); err != nil {
+			return wh.error(err, scope, append(tags, tag.ClusterName(clusterName))...)
 		}
 	}
 
-	return &types.ListArchivedWorkflowExecutionsResponse{
-		Executions:    archiverResponse.Executions,
-		NextPageToken: archiverResponse.NextPageToken,
-	}, nil
+	return nil
 }
 
-// ListClosedWorkflowExecutions - retrieves info for closed workflow executions in a domain
-func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
+// DescribeSchedule returns the spec, action, policies and the recent and upcoming runs of a schedule.
+// The runs are read from the copy of the schedule in the active cluster of the domain.
+func (wh *WorkflowHandler) DescribeSchedule(
 	ctx context.Context,
-	listRequest *types.ListClosedWorkflowExecutionsRequest,
-) (resp *types.ListClosedWorkflowExecutionsResponse, retError error) {
+	describeRequest *types.DescribeScheduleRequest,
+) (resp *types.DescribeScheduleResponse, retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListClosedWorkflowExecutionsScope, listRequest)
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendDescribeScheduleScope, describeRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if describeRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := describeRequest.GetDomain()
+	scheduleID := describeRequest.GetScheduleID()
+	tags := getDomainScheduleIDTags(domainName, scheduleID)
+
+	if err := validateScheduleRequest(domainName, scheduleID); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	if ok := wh.allow(true, describeRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	domainEntry, err := wh.GetDomainCache().GetDomain(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	clusterName := wh.GetClusterMetadata().GetCurrentClusterName()
+	if domainEntry.IsGlobalDomain() {
+		clusterName = domainEntry.GetReplicationConfig().ActiveClusterName
+	}
+
+	queryResp, err := wh.getScheduleClient(clusterName).QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: common.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: schedule.WorkflowID(domainName, scheduleID),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: schedule.QueryType,
+		},
+	})
+	if err != nil {
+		if isScheduleNotExistsError(err) {
+			err = errScheduleNotExists
+		}
+		return nil, wh.error(err, scope, append(tags, tag.ClusterName(clusterName))...)
+	}
+
+	var desc schedule.Description
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &desc); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	return schedule.FromDescription(&desc), nil
+}
+
+// UpdateSchedule replaces the spec, action and policies of a schedule
+func (wh *WorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	updateRequest *types.UpdateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUpdateScheduleScope, updateRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := updateRequest.GetDomain()
+	scheduleID := updateRequest.GetScheduleID()
+	tags := getDomainScheduleIDTags(domainName, scheduleID)
+
+	if err := validateScheduleRequest(domainName, scheduleID); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	if ok := wh.allow(true, updateRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	params := &schedule.Params{
+		Domain:     domainName,
+		ScheduleID: scheduleID,
+		Spec:       schedule.ToSpec(updateRequest.Spec),
+		Action:     schedule.ToAction(updateRequest.Action),
+		Policies:   schedule.ToPolicies(updateRequest.Policies),
+	}
+	if err := validateScheduleParams(params); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+	schedule.SetDefaultParams(params)
+
+	err := wh.signalSchedule(ctx, domainName, scheduleID, schedule.UpdateSignal, schedule.UpdateParams{
+		Spec:     params.Spec,
+		Action:   params.Action,
+		Policies: params.Policies,
+	}, updateRequest.GetIdentity())
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	return nil
+}
+
+// PauseSchedule pauses a schedule, runs scheduled while the schedule is paused are not started
+func (wh *WorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	pauseRequest *types.PauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendPauseScheduleScope, pauseRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := pauseRequest.GetDomain()
+	scheduleID := pauseRequest.GetScheduleID()
+	tags := getDomainScheduleIDTags(domainName, scheduleID)
+
+	if err := validateScheduleRequest(domainName, scheduleID); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	if ok := wh.allow(true, pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	err := wh.signalSchedule(ctx, domainName, scheduleID, schedule.PauseSignal, schedule.PauseParams{
+		Reason: pauseRequest.GetReason(),
+	}, pauseRequest.GetIdentity())
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	return nil
+}
+
+// UnpauseSchedule unpauses a paused schedule
+func (wh *WorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUnpauseScheduleScope, unpauseRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if unpauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := unpauseRequest.GetDomain()
+	scheduleID := unpauseRequest.GetScheduleID()
+	tags := getDomainScheduleIDTags(domainName, scheduleID)
+
+	if err := validateScheduleRequest(domainName, scheduleID); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	if ok := wh.allow(true, unpauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	err := wh.signalSchedule(ctx, domainName, scheduleID, schedule.UnpauseSignal, nil, unpauseRequest.GetIdentity())
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	return nil
+}
+
+// DeleteSchedule deletes a schedule, workflows already started by the schedule are not affected
+func (wh *WorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	deleteRequest *types.DeleteScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendDeleteScheduleScope, deleteRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if deleteRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := deleteRequest.GetDomain()
+	scheduleID := deleteRequest.GetScheduleID()
+	tags := getDomainScheduleIDTags(domainName, scheduleID)
+
+	if err := validateScheduleRequest(domainName, scheduleID); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	if ok := wh.allow(true, deleteRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	terminateRequest := &types.TerminateWorkflowExecutionRequest{
+		Domain: common.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: schedule.WorkflowID(domainName, scheduleID),
+		},
+		Reason:   scheduleDeletedReason,
+		Identity: deleteRequest.GetIdentity(),
+	}
+	err := wh.forEachScheduleCluster(domainName, tags, func(client frontend.Client) error {
+		return client.TerminateWorkflowExecution(ctx, terminateRequest)
+	})
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	return nil
+}
+
+// BackfillSchedule starts the runs a schedule would have started in [StartTimeNano, EndTimeNano)
+func (wh *WorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	backfillRequest *types.BackfillScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendBackfillScheduleScope, backfillRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if backfillRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := backfillRequest.GetDomain()
+	scheduleID := backfillRequest.GetScheduleID()
+	tags := getDomainScheduleIDTags(domainName, scheduleID)
+
+	if err := validateScheduleRequest(domainName, scheduleID); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	if ok := wh.allow(true, backfillRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	if backfillRequest.StartTimeNano == nil || backfillRequest.EndTimeNano == nil ||
+		backfillRequest.GetStartTimeNano() >= backfillRequest.GetEndTimeNano() {
+		return wh.error(errInvalidBackfillTimeRange, scope, tags...)
+	}
+
+	err := wh.signalSchedule(ctx, domainName, scheduleID, schedule.BackfillSignal, schedule.BackfillParams{
+		StartTime:     time.Unix(0, backfillRequest.GetStartTimeNano()).UTC(),
+		EndTime:       time.Unix(0, backfillRequest.GetEndTimeNano()).UTC(),
+		OverlapPolicy: schedule.ToOverlapPolicy(backfillRequest.OverlapPolicy),
+	}, backfillRequest.GetIdentity())
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	return nil
+}
+
+// ListSchedules returns the scheduleIDs of the schedules of a domain.
+// Schedules of other domains are filtered out of each page, so a page may hold less than PageSize schedules
+// while more pages are available.
+func (wh *WorkflowHandler) ListSchedules(
+	ctx context.Context,
+	listRequest *types.ListSchedulesRequest,
+) (resp *types.ListSchedulesResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListSchedulesScope, listRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := listRequest.GetDomain()
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if ok := wh.allow(true, listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	pageSize := listRequest.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = int32(wh.config.VisibilityMaxPageSize(domainName))
+	}
+	if wh.isListRequestPageSizeTooLarge(pageSize, domainName) {
+		return nil, wh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+	}
+
+	// the domain is not used by the query, but a schedule can only be listed by a domain that exists
+	if _, err := wh.GetDomainCache().GetDomainID(domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	systemDomainID, err := wh.GetDomainCache().GetDomainID(common.SystemLocalDomainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	persistenceResp, err := wh.GetVisibilityManager().ListOpenWorkflowExecutionsByType(
+		ctx,
+		&persistence.ListWorkflowExecutionsByTypeRequest{
+			ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+				DomainUUID:    systemDomainID,
+				Domain:        common.SystemLocalDomainName,
+				PageSize:      int(pageSize),
+				NextPageToken: listRequest.NextPageToken,
+				EarliestTime:  0,
+				LatestTime:    wh.GetTimeSource().Now().UnixNano(),
+			},
+			WorkflowTypeName: schedule.WorkflowTypeName,
+		},
+	)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp = &types.ListSchedulesResponse{
+		NextPageToken: persistenceResp.NextPageToken,
+	}
+	for _, execution := range persistenceResp.Executions {
+		if scheduleID, ok := schedule.ScheduleIDFromWorkflowID(domainName, execution.GetExecution().GetWorkflowID()); ok {
+			resp.ScheduleIDs = append(resp.ScheduleIDs, scheduleID)
+		}
+	}
+	return resp, nil
+}
+
+// ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
+// in the history and immediately terminating the current execution instance.
+func (wh *WorkflowHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	resetRequest *types.ResetWorkflowExecutionRequest,
+) (resp *types.ResetWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendResetWorkflowExecutionScope, resetRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if resetRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := resetRequest.GetDomain()
+	wfExecution := resetRequest.GetWorkflowExecution()
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if ok := wh.allow(true, resetRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	if err := validateExecution(wfExecution); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(resetRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	resp, err = wh.GetHistoryClient().ResetWorkflowExecution(ctx, &types.HistoryResetWorkflowExecutionRequest{
+		DomainUUID:   domainID,
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
+	return resp, nil
+}
+
+// RequestCancelWorkflowExecution - requests to cancel a workflow execution
+func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	cancelRequest *types.RequestCancelWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendRequestCancelWorkflowExecutionScope, cancelRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if cancelRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := cancelRequest.GetDomain()
+	wfExecution := cancelRequest.GetWorkflowExecution()
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if domainName == "" {
+		return wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if ok := wh.allow(true, cancelRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	if err := validateExecution(wfExecution); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(cancelRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	err = wh.GetHistoryClient().RequestCancelWorkflowExecution(ctx, &types.HistoryRequestCancelWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		CancelRequest: cancelRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
+	}
+
+	return nil
+}
+
+// ListOpenWorkflowExecutions - retrieves info for open workflow executions in a domain
+func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	listRequest *types.ListOpenWorkflowExecutionsRequest,
+) (resp *types.ListOpenWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListOpenWorkflowExecutionsScope, listRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if listRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if ok := wh.allow(true, listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if listRequest.StartTimeFilter == nil {
+		return nil, wh.error(&types.BadRequestError{Message: "StartTimeFilter is required"}, scope)
+	}
+
+	if listRequest.StartTimeFilter.EarliestTime == nil {
+		return nil, wh.error(&types.BadRequestError{Message: "EarliestTime in StartTimeFilter is required"}, scope)
+	}
+
+	if listRequest.StartTimeFilter.LatestTime == nil {
+		return nil, wh.error(&types.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}, scope)
+	}
+
+	if listRequest.StartTimeFilter.GetEarliestTime() > listRequest.StartTimeFilter.GetLatestTime() {
+		return nil, wh.error(&types.BadRequestError{Message: "EarliestTime in StartTimeFilter should not be larger than LatestTime"}, scope)
+	}
+
+	if listRequest.ExecutionFilter != nil && listRequest.TypeFilter != nil {
+		return nil, wh.error(&types.BadRequestError{
+			Message: "Only one of ExecutionFilter or TypeFilter is allowed"}, scope)
+	}
+
+	if listRequest.GetMaximumPageSize() <= 0 {
+		listRequest.MaximumPageSize = int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain()))
+	}
+
+	if wh.isListRequestPageSizeTooLarge(listRequest.GetMaximumPageSize(), listRequest.GetDomain()) {
+		return nil, wh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+	}
+
+	domain := listRequest.GetDomain()
+	domainID, err := wh.GetDomainCache().GetDomainID(domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	baseReq := persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:    domainID,
+		Domain:        domain,
+		PageSize:      int(listRequest.GetMaximumPageSize()),
+		NextPageToken: listRequest.NextPageToken,
+		EarliestTime:  listRequest.StartTimeFilter.GetEarliestTime(),
+		LatestTime:    listRequest.StartTimeFilter.GetLatestTime(),
+	}
+
+	var persistenceResp *persistence.ListWorkflowExecutionsResponse
+	if listRequest.ExecutionFilter != nil {
+		if wh.config.DisableListVisibilityByFilter(domain) {
+			err = errNoPermission
+		} else {
+			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByWorkflowID(
+				ctx,
+				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+					ListWorkflowExecutionsRequest: baseReq,
+					WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowID(),
+				})
+		}
+		wh.GetLogger().Debug("List open workflow with filter",
+			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByID)
+	} else if listRequest.TypeFilter != nil {
+		if wh.config.DisableListVisibilityByFilter(domain) {
+			err = errNoPermission
+		} else {
+			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByType(
+				ctx,
+				&persistence.ListWorkflowExecutionsByTypeRequest{
+					ListWorkflowExecutionsRequest: baseReq,
+					WorkflowTypeName:              listRequest.TypeFilter.GetName(),
+				},
+			)
+		}
+		wh.GetLogger().Debug("List open workflow with filter",
+			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByType)
+	} else {
+		persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutions(ctx, &baseReq)
+	}
+
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp = &types.ListOpenWorkflowExecutionsResponse{}
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
+// ListArchivedWorkflowExecutions - retrieves archived info for closed workflow executions in a domain
+func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	listRequest *types.ListArchivedWorkflowExecutionsRequest,
+) (resp *types.ListArchivedWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListArchivedWorkflowExecutionsScope, listRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if listRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if ok := wh.allow(true, listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if listRequest.GetPageSize() <= 0 {
+		listRequest.PageSize = int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain()))
+	}
+
+	maxPageSize := wh.config.VisibilityArchivalQueryMaxPageSize()
+	if int(listRequest.GetPageSize()) > maxPageSize {
+		return nil, wh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Pagesize is larger than allowed %d", maxPageSize)}, scope)
+	}
+
+	if !wh.GetArchivalMetadata().GetVisibilityConfig().ClusterConfiguredForArchival() {
+		return nil, wh.error(&types.BadRequestError{Message: "Cluster is not configured for visibility archival"}, scope)
+	}
+
+	if !wh.GetArchivalMetadata().GetVisibilityConfig().ReadEnabled() {
+		return nil, wh.error(&types.BadRequestError{Message: "Cluster is not configured for reading archived visibility records"}, scope)
+	}
+
+	entry, err := wh.GetDomainCache().GetDomain(listRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if entry.GetConfig().VisibilityArchivalStatus != types.ArchivalStatusEnabled {
+		return nil, wh.error(&types.BadRequestError{Message: "Domain is not configured for visibility archival"}, scope)
+	}
+
+	URI, err := archiver.NewURI(entry.GetConfig().VisibilityArchivalURI)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), service.Frontend)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	archiverRequest := &archiver.QueryVisibilityRequest{
+		DomainID:      entry.GetInfo().ID,
+		PageSize:      int(listRequest.GetPageSize()),
+		NextPageToken: listRequest.NextPageToken,
+		Query:         listRequest.GetQuery(),
+	}
+
+	archiverResponse, err := visibilityArchiver.Query(ctx, URI, archiverRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// special handling of ExecutionTime for cron or retry
+	for _, execution := range archiverResponse.Executions {
+		if execution.GetExecutionTime() == 0 {
+			execution.ExecutionTime = common.Int64Ptr(execution.GetStartTime())
+		}
+	}
+
+	return &types.ListArchivedWorkflowExecutionsResponse{
+		Executions:    archiverResponse.Executions,
+		NextPageToken: archiverResponse.NextPageToken,
+	}, nil
+}
+
+// ListClosedWorkflowExecutions - retrieves info for closed workflow executions in a domain
+func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	listRequest *types.ListClosedWorkflowExecutionsRequest,
+) (resp *types.ListClosedWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListClosedWorkflowExecutionsScope, listRequest)
 	defer sw.Stop()
 
 	if wh.isShuttingDown() {
@@ -3808,6 +4549,17 @@ func (wh *WorkflowHandler) getRawHistory(
 		return nil, nil, err
 	}
 
+	// a compacted history is the first batch followed by the batch of the history compaction event
+	if firstEventID == common.FirstEventID && len(nextPageToken) == 0 && len(resp.HistoryEventBlobs) > 1 {
+		events, err := wh.GetPayloadSerializer().DeserializeBatchEvents(resp.HistoryEventBlobs[1])
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := wh.checkHistoryCompactionSupported(ctx, events); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	var encoding *types.EncodingType
 	for _, data := range resp.HistoryEventBlobs {
 		switch data.Encoding {
@@ -3879,6 +4631,10 @@ func (wh *WorkflowHandler) getHistory(
 
 	scope.RecordTimer(metrics.HistorySize, time.Duration(size))
 
+	if err := wh.checkHistoryCompactionSupported(ctx, historyEvents); err != nil {
+		return nil, nil, err
+	}
+
 	isLastPage := len(nextPageToken) == 0
 	if err := verifyHistoryIsComplete(
 		historyEvents,
@@ -3909,11 +4665,40 @@ func (wh *WorkflowHandler) getHistory(
 		historyEvents = append(historyEvents, transientDecision.ScheduledEvent, transientDecision.StartedEvent)
 	}
 
+	if err := wh.payloadStore.RehydrateEvents(ctx, domainID, historyEvents); err != nil {
+		wh.GetLogger().Error("getHistory: failed to rehydrate offloaded payloads",
+			tag.WorkflowDomainID(domainID),
+			tag.WorkflowID(execution.GetWorkflowID()),
+			tag.WorkflowRunID(execution.GetRunID()),
+			tag.Error(err))
+		return nil, nil, err
+	}
+
 	executionHistory := &types.History{}
 	executionHistory.Events = historyEvents
 	return executionHistory, nextPageToken, nil
 }
 
+// checkHistoryCompactionSupported returns an error if the history events contain a WorkflowHistoryCompacted event
+// and the client did not declare it can replay it, as the events trimmed by the compaction are not returned
+func (wh *WorkflowHandler) checkHistoryCompactionSupported(
+	ctx context.Context,
+	events []*types.HistoryEvent,
+) error {
+
+	for _, event := range events {
+		if event.GetEventType() != types.EventTypeWorkflowHistoryCompacted {
+			continue
+		}
+		featureFlags := client.GetFeatureFlagsFromHeader(yarpc.CallFromContext(ctx))
+		if err := wh.versionChecker.SupportsWorkflowHistoryCompaction(featureFlags); err != nil {
+			return errHistoryCompactionNotSupported
+		}
+		return nil
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateTransientDecisionEvents(
 	expectedNextEventID int64,
 	decision *types.TransientDecisionInfo,
@@ -4185,6 +4970,12 @@ func verifyHistoryIsComplete(
 	}
 
 	nExpectedEvents := expectedLastEventID - expectedFirstEventID + 1
+	// events trimmed by history compaction precede the history compaction event
+	for i := 1; i < nEvents; i++ {
+		if events[i].GetEventType() == types.EventTypeWorkflowHistoryCompacted {
+			nExpectedEvents -= events[i].ID - events[i-1].ID - 1
+		}
+	}
 
 	if firstEventID == expectedFirstEventID &&
 		((isLastPage && lastEventID == expectedLastEventID && int64(nEvents) == nExpectedEvents) ||
@@ -4455,6 +5246,128 @@ func getDomainWfIDRunIDTags(
 	)
 }
 
+// getScheduleClusters returns the clusters hosting the schedules of a domain, starting with the current cluster
+func (wh *WorkflowHandler) getScheduleClusters(domainName string) ([]string, error) {
+	domainEntry, err := wh.GetDomainCache().GetDomain(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	currentClusterName := wh.GetClusterMetadata().GetCurrentClusterName()
+	clusters := []string{currentClusterName}
+	if !domainEntry.IsGlobalDomain() {
+		return clusters, nil
+	}
+	for _, replicationConfig := range domainEntry.GetReplicationConfig().Clusters {
+		if replicationConfig.ClusterName != currentClusterName {
+			clusters = append(clusters, replicationConfig.ClusterName)
+		}
+	}
+	return clusters, nil
+}
+
+func (wh *WorkflowHandler) getScheduleClient(clusterName string) frontend.Client {
+	if clusterName == wh.GetClusterMetadata().GetCurrentClusterName() {
+		return wh.GetFrontendClient()
+	}
+	return wh.GetRemoteFrontendClient(clusterName)
+}
+
+// forEachScheduleCluster applies op to the copy of a schedule in every cluster of the domain.
+// A copy missing from a remote cluster is skipped, as the cluster may have been added to the domain after the
+// schedule was created.
+func (wh *WorkflowHandler) forEachScheduleCluster(
+	domainName string,
+	tags []tag.Tag,
+	op func(client frontend.Client) error,
+) error {
+	clusters, err := wh.getScheduleClusters(domainName)
+	if err != nil {
+		return err
+	}
+
+	for i, clusterName := range clusters {
+		err := op(wh.getScheduleClient(clusterName))
+		if err == nil {
+			continue
+		}
+		if !isScheduleNotExistsError(err) {
+			return err
+		}
+		if i == 0 {
+			return errScheduleNotExists
+		}
+		wh.GetLogger().WithTags(tags...).Warn("Schedule does not exist in remote cluster", tag.ClusterName(clusterName))
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) signalSchedule(
+	ctx context.Context,
+	domainName string,
+	scheduleID string,
+	signalName string,
+	payload interface{},
+	identity string,
+) error {
+	input, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	signalRequest := &types.SignalWorkflowExecutionRequest{
+		Domain: common.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: schedule.WorkflowID(domainName, scheduleID),
+		},
+		SignalName: signalName,
+		Input:      input,
+		Identity:   identity,
+		RequestID:  uuid.New(),
+	}
+	return wh.forEachScheduleCluster(domainName, getDomainScheduleIDTags(domainName, scheduleID), func(client frontend.Client) error {
+		return client.SignalWorkflowExecution(ctx, signalRequest)
+	})
+}
+
+func validateScheduleRequest(domainName string, scheduleID string) error {
+	if domainName == "" {
+		return errDomainNotSet
+	}
+	if scheduleID == "" {
+		return errScheduleIDNotSet
+	}
+	return nil
+}
+
+func validateScheduleParams(params *schedule.Params) error {
+	if err := schedule.ValidateParams(params); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid schedule: %v", err)}
+	}
+	if params.Action.ExecutionStartToCloseTimeoutSeconds <= 0 {
+		return errInvalidExecutionStartToCloseTimeoutSeconds
+	}
+	if params.Action.TaskStartToCloseTimeoutSeconds <= 0 {
+		return errInvalidTaskStartToCloseTimeoutSeconds
+	}
+	return nil
+}
+
+func isScheduleNotExistsError(err error) bool {
+	switch err.(type) {
+	case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError:
+		return true
+	}
+	return false
+}
+
+func getDomainScheduleIDTags(domainName string, scheduleID string) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowID(schedule.WorkflowID(domainName, scheduleID)),
+	}
+}
+
 func checkRequiredDomainDataKVs(requiredDomainDataKeys map[string]interface{}, domainData map[string]string) error {
 	// check requiredDomainDataKeys
 	for k := range requiredDomainDataKeys {
@@ -4466,6 +5379,51 @@ func checkRequiredDomainDataKVs(requiredDomainDataKeys map[string]interface{}, d
 	return nil
 }
 
+// getPollerAttributes returns the labels and the capacity a poller advertises through the request headers
+func getPollerAttributes(ctx context.Context) (map[string]string, int32, error) {
+	call := yarpc.CallFromContext(ctx)
+	labels, err := common.ParseLabels(call.Header(common.PollerLabelsHeaderName))
+	if err != nil {
+		return nil, 0, errInvalidPollerLabels
+	}
+	if len(labels) == 0 {
+		labels = nil
+	}
+
+	var capacity int32
+	if value := call.Header(common.PollerCapacityHeaderName); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || parsed <= 0 {
+			return nil, 0, errInvalidPollerCapacity
+		}
+		capacity = int32(parsed)
+	}
+	return labels, capacity, nil
+}
+
+// getCallerIsolationGroup returns the isolation group the caller is running in, as advertised through the request headers
+func getCallerIsolationGroup(ctx context.Context) string {
+	return strings.TrimSpace(yarpc.CallFromContext(ctx).Header(common.IsolationGroupHeaderName))
+}
+
+// withCallerIsolationGroup returns the workflow header with the isolation group of the caller, so that the tasks
+// of a workflow started from an isolation group are preferentially dispatched to pollers of the same group.
+// An isolation group explicitly set in the workflow header takes precedence.
+func withCallerIsolationGroup(ctx context.Context, header *types.Header) *types.Header {
+	group := getCallerIsolationGroup(ctx)
+	if group == "" || common.GetTaskIsolationGroupFromHeader(header) != "" {
+		return header
+	}
+	fields := make(map[string][]byte)
+	if header != nil {
+		for key, value := range header.Fields {
+			fields[key] = value
+		}
+	}
+	fields[common.TaskIsolationGroupHeaderKey] = []byte(group)
+	return &types.Header{Fields: fields}
+}
+
 // Some error types are introduced later that some clients might not support
 // To make them backward compatible, we continue returning the legacy error types
 // for older clients
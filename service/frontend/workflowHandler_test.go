@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/yarpctest"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
@@ -48,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/schedule"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 )
@@ -295,6 +297,266 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadDelayStartSe
 	s.Equal(errInvalidDelayStartSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution_Failed_BadDelayStartSeconds() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	signalWithStartRequest := &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		SignalName:                          "signal-name",
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		DelayStartSeconds:                   common.Int32Ptr(-1),
+	}
+	_, err := wh.SignalWithStartWorkflowExecution(context.Background(), signalWithStartRequest)
+	s.Error(err)
+	s.Equal(errInvalidDelayStartSeconds, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadInactivityTimeoutSeconds() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		InactivityTimeoutSeconds:            common.Int32Ptr(-1),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(errInvalidInactivityTimeoutSeconds, err)
+}
+
+func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution_Failed_BadInactivityTimeoutSeconds() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	signalWithStartRequest := &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		SignalName:                          "signal-name",
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		InactivityTimeoutSeconds:            common.Int32Ptr(-1),
+	}
+	_, err := wh.SignalWithStartWorkflowExecution(context.Background(), signalWithStartRequest)
+	s.Error(err)
+	s.Equal(errInvalidInactivityTimeoutSeconds, err)
+}
+
+func (s *workflowHandlerSuite) TestUpdateWorkflowExecution_Failed_UpdateNameNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	updateRequest := &types.UpdateWorkflowExecutionRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "workflow-id",
+		},
+		RequestID: uuid.New(),
+	}
+	_, err := wh.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.Error(err)
+	s.Equal(errUpdateNameNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_GlobalDomain() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(s.newGlobalDomainCacheEntry(cluster.TestCurrentClusterName), nil)
+	var startRequests []*types.StartWorkflowExecutionRequest
+	recordStart := func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+		startRequests = append(startRequests, request)
+		return &types.StartWorkflowExecutionResponse{RunID: testRunID}, nil
+	}
+	s.mockResource.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(recordStart)
+	s.mockResource.RemoteFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(recordStart)
+
+	err := wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain:     s.testDomain,
+		ScheduleID: "schedule-id",
+		Spec:       &types.ScheduleSpec{CronExpression: "* * * * *"},
+		Action: &types.ScheduleAction{
+			WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+			TaskList:                            &types.TaskList{Name: "task-list"},
+			ExecutionStartToCloseTimeoutSeconds: 10,
+			TaskStartToCloseTimeoutSeconds:      5,
+		},
+	})
+	s.NoError(err)
+	s.Len(startRequests, 2)
+	s.Equal(startRequests[0], startRequests[1])
+	s.Equal(common.SystemLocalDomainName, startRequests[0].Domain)
+	s.Equal(schedule.WorkflowID(s.testDomain, "schedule-id"), startRequests[0].WorkflowID)
+	s.NotEmpty(startRequests[0].RequestID)
+
+	var params schedule.Params
+	s.NoError(json.Unmarshal(startRequests[0].Input, &params))
+	s.Equal(s.testDomain, params.Domain)
+	s.Equal("UTC", params.Spec.TimeZone)
+	s.Equal(schedule.OverlapPolicySkip, params.Policies.OverlapPolicy)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_InvalidSchedule() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain: s.testDomain,
+	})
+	s.Equal(errScheduleIDNotSet, err)
+
+	err = wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain:     s.testDomain,
+		ScheduleID: "schedule-id",
+		Spec:       &types.ScheduleSpec{CronExpression: "* * * * *", TimeZone: "Not/AZone"},
+		Action: &types.ScheduleAction{
+			WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+			TaskList:                            &types.TaskList{Name: "task-list"},
+			ExecutionStartToCloseTimeoutSeconds: 10,
+			TaskStartToCloseTimeoutSeconds:      5,
+		},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestDescribeSchedule() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	// only the copy in the active cluster knows about the runs
+	s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(s.newGlobalDomainCacheEntry(cluster.TestAlternativeClusterName), nil)
+	desc := schedule.Description{
+		Domain:     s.testDomain,
+		ScheduleID: "schedule-id",
+		Spec:       schedule.Spec{CronExpression: "* * * * *", TimeZone: "UTC"},
+		Policies:   schedule.Policies{OverlapPolicy: schedule.OverlapPolicyBuffer},
+		TotalRuns:  3,
+	}
+	queryResult, err := json.Marshal(desc)
+	s.NoError(err)
+	s.mockResource.RemoteFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.QueryWorkflowRequest, _ ...interface{}) (*types.QueryWorkflowResponse, error) {
+			s.Equal(common.SystemLocalDomainName, request.Domain)
+			s.Equal(schedule.WorkflowID(s.testDomain, "schedule-id"), request.Execution.WorkflowID)
+			s.Equal(schedule.QueryType, request.Query.QueryType)
+			return &types.QueryWorkflowResponse{QueryResult: queryResult}, nil
+		})
+
+	resp, err := wh.DescribeSchedule(context.Background(), &types.DescribeScheduleRequest{
+		Domain:     s.testDomain,
+		ScheduleID: "schedule-id",
+	})
+	s.NoError(err)
+	s.Equal("* * * * *", resp.Spec.CronExpression)
+	s.Equal(types.ScheduleOverlapPolicyBuffer, resp.Policies.GetOverlapPolicy())
+	s.Equal(int64(3), resp.TotalRuns)
+}
+
+func (s *workflowHandlerSuite) TestPauseSchedule() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(s.newGlobalDomainCacheEntry(cluster.TestCurrentClusterName), nil).Times(2)
+	s.mockResource.FrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...interface{}) error {
+			s.Equal(schedule.PauseSignal, request.SignalName)
+			s.Equal(schedule.WorkflowID(s.testDomain, "schedule-id"), request.WorkflowExecution.WorkflowID)
+			return nil
+		})
+	// the copy is missing from the remote cluster when the cluster was added to the domain after the schedule was created
+	s.mockResource.RemoteFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.EntityNotExistsError{})
+
+	pauseRequest := &types.PauseScheduleRequest{
+		Domain:     s.testDomain,
+		ScheduleID: "schedule-id",
+		Reason:     "reason",
+	}
+	s.NoError(wh.PauseSchedule(context.Background(), pauseRequest))
+
+	s.mockResource.FrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.EntityNotExistsError{})
+	s.Equal(errScheduleNotExists, wh.PauseSchedule(context.Background(), pauseRequest))
+}
+
+func (s *workflowHandlerSuite) TestBackfillSchedule_Failed_InvalidTimeRange() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.BackfillSchedule(context.Background(), &types.BackfillScheduleRequest{
+		Domain:        s.testDomain,
+		ScheduleID:    "schedule-id",
+		StartTimeNano: common.Int64Ptr(2),
+		EndTimeNano:   common.Int64Ptr(1),
+	})
+	s.Equal(errInvalidBackfillTimeRange, err)
+}
+
+func (s *workflowHandlerSuite) TestListSchedules() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockDomainCache.EXPECT().GetDomainID(common.SystemLocalDomainName).Return("system-domain-id", nil)
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.Anything, mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+			return request.DomainUUID == "system-domain-id" &&
+				request.WorkflowTypeName == schedule.WorkflowTypeName &&
+				request.PageSize == 10
+		})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: schedule.WorkflowID(s.testDomain, "schedule-1")}},
+			{Execution: &types.WorkflowExecution{WorkflowID: schedule.WorkflowID("another-domain", "schedule-2")}},
+			{Execution: &types.WorkflowExecution{WorkflowID: schedule.WorkflowID(s.testDomain, "schedule-3")}},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+
+	resp, err := wh.ListSchedules(context.Background(), &types.ListSchedulesRequest{
+		Domain:   s.testDomain,
+		PageSize: 10,
+	})
+	s.NoError(err)
+	s.Equal([]string{"schedule-1", "schedule-3"}, resp.ScheduleIDs)
+	s.Equal([]byte("token"), resp.NextPageToken)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dc.GetIntPropertyFn(10)
@@ -1441,6 +1703,44 @@ func (s *workflowHandlerSuite) TestVerifyHistoryIsComplete() {
 	}
 }
 
+func (s *workflowHandlerSuite) TestVerifyHistoryIsComplete_Compacted() {
+	// the first batch is followed by the history compaction event, events 3 to 99 were trimmed
+	events := []*types.HistoryEvent{
+		{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+		{ID: 100, EventType: types.EventTypeWorkflowHistoryCompacted.Ptr()},
+		{ID: 101, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+	}
+	s.NoError(verifyHistoryIsComplete(events, 1, 101, true, true, 1000))
+	s.Error(verifyHistoryIsComplete(events, 1, 102, true, true, 1000))
+
+	// a gap not preceding a history compaction event is still rejected
+	events[2].EventType = types.EventTypeMarkerRecorded.Ptr()
+	s.Error(verifyHistoryIsComplete(events, 1, 101, true, true, 1000))
+}
+
+func (s *workflowHandlerSuite) TestCheckHistoryCompactionSupported() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	events := []*types.HistoryEvent{
+		{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		{ID: 100, EventType: types.EventTypeWorkflowHistoryCompacted.Ptr()},
+	}
+
+	// no version check is needed for a history which was not compacted
+	s.NoError(wh.checkHistoryCompactionSupported(context.Background(), events[:1]))
+
+	s.mockVersionChecker.EXPECT().SupportsWorkflowHistoryCompaction(shared.FeatureFlags{}).
+		Return(&shared.FeatureNotEnabledError{FeatureFlag: "WorkflowHistoryCompactionEnabled"})
+	s.Equal(errHistoryCompactionNotSupported, wh.checkHistoryCompactionSupported(context.Background(), events))
+
+	featureFlags := shared.FeatureFlags{WorkflowHistoryCompactionEnabled: common.BoolPtr(true)}
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{
+		Headers: map[string]string{common.ClientFeatureFlagsHeaderName: client.FeatureFlagsHeader(featureFlags)},
+	})
+	s.mockVersionChecker.EXPECT().SupportsWorkflowHistoryCompaction(featureFlags).Return(nil)
+	s.NoError(wh.checkHistoryCompactionSupported(ctx, events))
+}
+
 func (s *workflowHandlerSuite) TestContextMetricsTags() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -1498,6 +1798,21 @@ func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
 	return config
 }
 
+func (s *workflowHandlerSuite) newGlobalDomainCacheEntry(activeClusterName string) *cache.DomainCacheEntry {
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.testDomainID, Name: s.testDomain},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: activeClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		common.EmptyVersion,
+	)
+}
+
 func updateRequest(
 	historyArchivalURI *string,
 	historyArchivalStatus *types.ArchivalStatus,
@@ -1638,6 +1953,27 @@ func getHistoryRequest(nextPageToken []byte) *types.GetWorkflowExecutionHistoryR
 	}
 }
 
+func (s *workflowHandlerSuite) TestWithCallerIsolationGroup() {
+	header := &types.Header{Fields: map[string][]byte{"key": []byte("value")}}
+	s.Equal(header, withCallerIsolationGroup(context.Background(), header))
+
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{
+		Headers: map[string]string{common.IsolationGroupHeaderName: "zone1"},
+	})
+	s.Equal(&types.Header{Fields: map[string][]byte{
+		"key":                              []byte("value"),
+		common.TaskIsolationGroupHeaderKey: []byte("zone1"),
+	}}, withCallerIsolationGroup(ctx, header))
+	s.Equal(map[string][]byte{"key": []byte("value")}, header.Fields)
+	s.Equal(&types.Header{Fields: map[string][]byte{
+		common.TaskIsolationGroupHeaderKey: []byte("zone1"),
+	}}, withCallerIsolationGroup(ctx, nil))
+
+	// an isolation group set by the workflow takes precedence over the one of the caller
+	header = &types.Header{Fields: map[string][]byte{common.TaskIsolationGroupHeaderKey: []byte("zone2")}}
+	s.Equal(header, withCallerIsolationGroup(ctx, header))
+}
+
 func listArchivedWorkflowExecutionsTestRequest() *types.ListArchivedWorkflowExecutionsRequest {
 	return &types.ListArchivedWorkflowExecutionsRequest{
 		Domain:   "some random domain name",
@@ -239,6 +239,10 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// Payload offloading related settings
+	PayloadOffloadThreshold dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadOffloadSizeLimit dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
@@ -286,6 +290,11 @@ type Config struct {
 	EnableConsistentQueryByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 	MaxBufferedQueryCount         dynamicconfig.IntPropertyFn
 
+	// The following are used by workflow update
+	EnableWorkflowUpdateByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
+	MaxBufferedUpdateCount       dynamicconfig.IntPropertyFn
+	MaxCompletedUpdateCount      dynamicconfig.IntPropertyFn
+
 	EnableCrossClusterOperations dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	// Data integrity check related config knobs
@@ -305,8 +314,25 @@ type Config struct {
 	EnableActivityLocalDispatchByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 	// Max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.
 	MaxActivityCountDispatchByDomain dynamicconfig.IntPropertyFnWithDomainFilter
+	// Read the task priority header from history events when adding decision and activity tasks to matching
+	EnableTaskPriority dynamicconfig.BoolPropertyFnWithDomainFilter
+	// Read the isolation group header from history events when adding decision and activity tasks to matching
+	EnableTaskIsolationGroups dynamicconfig.BoolPropertyFnWithDomainFilter
+
+	// Event categories reapplied to the current run when resolving conflicts between clusters
+	NDCReapplySignals                dynamicconfig.BoolPropertyFnWithDomainFilter
+	NDCReapplyUpsertSearchAttributes dynamicconfig.BoolPropertyFnWithDomainFilter
+	NDCReapplyCancelRequests         dynamicconfig.BoolPropertyFnWithDomainFilter
+
+	// The following are used by history compaction
+	EnableHistoryCompaction            dynamicconfig.BoolPropertyFnWithDomainFilter
+	HistoryCompactionEventThreshold    dynamicconfig.IntPropertyFnWithDomainFilter
+	HistoryCompactionSnapshotSizeLimit dynamicconfig.IntPropertyFnWithDomainFilter
 
 	ActivityMaxScheduleToStartTimeoutForRetry dynamicconfig.DurationPropertyFnWithDomainFilter
+	ActivityAttemptLogMaxSize                 dynamicconfig.IntPropertyFnWithDomainFilter
+	ActivityAttemptLogDetailsSizeLimit        dynamicconfig.IntPropertyFnWithDomainFilter
+	ActivityAttemptLogSizeLimit               dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// Debugging configurations
 	EnableDebugMode             bool // note that this value is initialized once on service start
@@ -513,6 +539,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
 
+		PayloadOffloadThreshold: dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 0),
+		PayloadOffloadSizeLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadSizeLimit, 32*1024*1024),
+
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 		EnableStickyQuery: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableStickyQuery, true),
 
@@ -552,6 +581,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		EnableConsistentQueryByDomain:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableConsistentQueryByDomain, false),
 		EnableCrossClusterOperations:          dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableCrossClusterOperations, false),
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount, 1),
+		EnableWorkflowUpdateByDomain:          dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableWorkflowUpdateByDomain, false),
+		MaxBufferedUpdateCount:                dc.GetIntProperty(dynamicconfig.MaxBufferedUpdateCount, 10),
+		MaxCompletedUpdateCount:               dc.GetIntProperty(dynamicconfig.MaxCompletedUpdateCount, 100),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumGenProbability, 0),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumVerifyProbability, 0),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore, 0),
@@ -564,8 +596,21 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 
 		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityLocalDispatchByDomain, true),
 		MaxActivityCountDispatchByDomain:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxActivityCountDispatchByDomain, 0),
+		EnableTaskPriority:                  dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTaskPriority, false),
+		EnableTaskIsolationGroups:           dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTaskIsolationGroups, false),
+
+		NDCReapplySignals:                dc.GetBoolPropertyFilteredByDomain(dynamicconfig.NDCReapplySignals, true),
+		NDCReapplyUpsertSearchAttributes: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.NDCReapplyUpsertSearchAttributes, false),
+		NDCReapplyCancelRequests:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.NDCReapplyCancelRequests, false),
+
+		EnableHistoryCompaction:            dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableHistoryCompaction, false),
+		HistoryCompactionEventThreshold:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCompactionEventThreshold, 10000),
+		HistoryCompactionSnapshotSizeLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCompactionSnapshotSizeLimit, 1024*1024),
 
 		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry, 30*time.Minute),
+		ActivityAttemptLogMaxSize:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityAttemptLogMaxSize, 0),
+		ActivityAttemptLogDetailsSizeLimit:        dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityAttemptLogDetailsSizeLimit, 1024),
+		ActivityAttemptLogSizeLimit:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityAttemptLogSizeLimit, 16*1024),
 
 		EnableDebugMode:             dc.GetBoolProperty(dynamicconfig.EnableDebugMode, false)(),
 		EnableTaskInfoLogByDomainID: dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.HistoryEnableTaskInfoLogByDomainID, false),
@@ -586,6 +631,7 @@ func NewForTestByShardNumber(shardNumber int) *Config {
 	// reduce the duration of long poll to increase test speed
 	config.LongPollExpirationInterval = dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval, 10*time.Second)
 	config.EnableConsistentQueryByDomain = dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableConsistentQueryByDomain, true)
+	config.EnableWorkflowUpdateByDomain = dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableWorkflowUpdateByDomain, true)
 	config.ReplicationTaskProcessorHostQPS = dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorHostQPS, 10000)
 	config.ReplicationTaskProcessorShardQPS = dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorShardQPS, 10000)
 	config.ReplicationTaskProcessorStartWait = dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorStartWait, time.Nanosecond)
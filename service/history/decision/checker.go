@@ -151,6 +151,11 @@ func (c *workflowSizeChecker) failWorkflowIfBlobSizeExceedsLimit(
 
 func (c *workflowSizeChecker) failWorkflowSizeExceedsLimit() (bool, error) {
 	historyCount := int(c.mutableState.GetNextEventID()) - 1
+	if compactionNodeID, err := c.mutableState.GetHistoryCompactionNodeID(); err == nil && compactionNodeID > 0 {
+		// events between the first batch and the last compaction event are trimmed,
+		// approximate the first batch with the workflow started event only
+		historyCount = int(c.mutableState.GetNextEventID()-compactionNodeID) + 1
+	}
 	historySize := int(c.executionStats.HistorySize)
 
 	if historySize > c.historySizeLimitError || historyCount > c.historyCountLimitError {
@@ -34,12 +34,14 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -68,6 +70,7 @@ type (
 		throttledLogger log.Logger
 		attrValidator   *attrValidator
 		versionChecker  client.VersionChecker
+		payloadStore    *payload.Store
 	}
 )
 
@@ -96,6 +99,11 @@ func NewHandler(
 			logger,
 		),
 		versionChecker: client.NewVersionChecker(),
+		payloadStore: payload.NewStore(
+			shard.GetService().GetBlobstoreClient(),
+			config.PayloadOffloadThreshold,
+			config.PayloadOffloadSizeLimit,
+		),
 	}
 }
 
@@ -204,6 +212,12 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, &types.EntityNotExistsError{Message: "Decision task not found."}
 			}
 
+			// Decision tasks are not handed out while the workflow is paused, the pending decision
+			// will be rescheduled when the workflow gets unpaused.
+			if mutableState.IsWorkflowExecutionPaused() && decision.StartedID == common.EmptyEventID {
+				return nil, workflow.ErrWorkflowPaused
+			}
+
 			updateAction := &workflow.UpdateAction{}
 
 			if decision.StartedID != common.EmptyEventID {
@@ -315,6 +329,14 @@ func (handler *handlerImpl) HandleDecisionTaskCompleted(
 	}
 	defer func() { release(retError) }()
 
+	// the payloads offloaded for decisions which are not persisted are not referenced by any event
+	var offloadedPayloads [][]byte
+	defer func() {
+		if !payload.MayBePersisted(retError) {
+			handler.discardPayloads(ctx, domainID, offloadedPayloads)
+		}
+	}()
+
 Update_History_Loop:
 	for attempt := 0; attempt < workflow.ConditionalRetryCount; attempt++ {
 		msBuilder, err := wfContext.LoadWorkflowExecution(ctx)
@@ -397,6 +419,8 @@ Update_History_Loop:
 			continueAsNewBuilder        execution.MutableState
 			hasUnhandledEvents          bool
 			decisionResults             []*decisionResult
+			historyCompactionMarker     *types.HistoryEvent
+			updateTerminationStates     map[string]*update.TerminationState
 		)
 		hasUnhandledEvents = msBuilder.HasBufferedEvents()
 
@@ -420,6 +444,21 @@ Update_History_Loop:
 			failMessage = fmt.Sprintf("binary %v is already marked as bad deployment", binChecksum)
 		} else {
 
+			// the results of updates are recorded ahead of the decisions, as a decision may close the workflow
+			if !decisionHeartbeatTimeout {
+				updateTerminationStates, err = handler.handleUpdateResults(
+					msBuilder,
+					completedEvent.ID,
+					startedID,
+					req.GetCompleteRequest().GetUpdateResults(),
+					domainEntry,
+					decisionHeartbeating,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			domainName := domainEntry.GetInfo().Name
 			workflowSizeChecker := newWorkflowSizeChecker(
 				handler.config.BlobSizeLimitWarn(domainName),
@@ -443,17 +482,20 @@ Update_History_Loop:
 				handler.attrValidator,
 				workflowSizeChecker,
 				handler.tokenSerializer,
+				handler.payloadStore,
 				handler.logger,
 				handler.domainCache,
 				handler.metricsClient,
 				handler.config,
 			)
 
-			if decisionResults, err = decisionTaskHandler.handleDecisions(
+			decisionResults, err = decisionTaskHandler.handleDecisions(
 				ctx,
 				request.ExecutionContext,
 				request.Decisions,
-			); err != nil {
+			)
+			offloadedPayloads = decisionTaskHandler.offloadedPayloads
+			if err != nil {
 				return nil, err
 			}
 
@@ -472,6 +514,8 @@ Update_History_Loop:
 			continueAsNewBuilder = decisionTaskHandler.continueAsNewBuilder
 
 			hasUnhandledEvents = decisionTaskHandler.hasUnhandledEventsBeforeDecisions
+
+			historyCompactionMarker = decisionTaskHandler.historyCompactionMarker
 		}
 
 		if failDecision {
@@ -480,6 +524,8 @@ Update_History_Loop:
 				tag.WorkflowID(token.WorkflowID),
 				tag.WorkflowRunID(token.RunID),
 				tag.WorkflowDomainID(domainID))
+			handler.discardPayloads(ctx, domainID, offloadedPayloads)
+			offloadedPayloads = nil
 			msBuilder, err = handler.failDecisionHelper(
 				ctx, wfContext, scheduleID, startedID, failCause, []byte(failMessage), request, domainEntry)
 			if err != nil {
@@ -487,21 +533,27 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			historyCompactionMarker = nil
+			// pending updates will be delivered again with the next decision task
+			updateTerminationStates = nil
 		}
 
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+		// new decision task can not be returned inline while the workflow is paused,
+		// it will be dispatched through matching once the workflow gets unpaused
+		returnNewDecisionTask := request.GetReturnNewDecisionTask() && !msBuilder.IsWorkflowExecutionPaused()
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			var newDecision *execution.DecisionInfo
 			var err error
 			if decisionHeartbeating && !decisionHeartbeatTimeout {
 				newDecision, err = msBuilder.AddDecisionTaskScheduledEventAsHeartbeat(
-					request.GetReturnNewDecisionTask(),
+					returnNewDecisionTask,
 					currentDecision.OriginalScheduledTimestamp,
 				)
 			} else {
 				newDecision, err = msBuilder.AddDecisionTaskScheduledEvent(
-					request.GetReturnNewDecisionTask(),
+					returnNewDecisionTask,
 				)
 			}
 			if err != nil {
@@ -510,7 +562,7 @@ Update_History_Loop:
 
 			newDecisionTaskScheduledID = newDecision.ScheduleID
 			// skip transfer task for decision if request asking to return new decision task
-			if request.GetReturnNewDecisionTask() {
+			if returnNewDecisionTask {
 				// start the new decision task if request asked to do so
 				// TODO: replace the poll request
 				_, _, err := msBuilder.AddDecisionTaskStartedEvent(newDecision.ScheduleID, "request-from-RespondDecisionTaskCompleted", &types.PollForDecisionTaskRequest{
@@ -550,6 +602,8 @@ Update_History_Loop:
 		if updateErr != nil {
 			if execution.IsConflictError(updateErr) {
 				handler.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.ConcurrencyUpdateFailureCounter)
+				handler.discardPayloads(ctx, domainID, offloadedPayloads)
+				offloadedPayloads = nil
 				continue Update_History_Loop
 			}
 
@@ -583,6 +637,8 @@ Update_History_Loop:
 
 			return nil, updateErr
 		}
+		// the offloaded payloads are now referenced by the persisted events
+		offloadedPayloads = nil
 
 		handler.handleBufferedQueries(
 			msBuilder,
@@ -593,6 +649,15 @@ Update_History_Loop:
 			domainEntry,
 			decisionHeartbeating)
 
+		handler.handleBufferedUpdates(
+			wfContext.GetUpdateRegistry(),
+			msBuilder,
+			updateTerminationStates)
+
+		if historyCompactionMarker != nil {
+			handler.compactHistory(ctx, wfContext, domainEntry, historyCompactionMarker)
+		}
+
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 			return nil, &types.EntityNotExistsError{
@@ -614,7 +679,7 @@ Update_History_Loop:
 		}
 		resp.ActivitiesToDispatchLocally = activitiesToDispatchLocally
 
-		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
+		if returnNewDecisionTask && createNewDecisionTask {
 			decision, _ := msBuilder.GetDecisionInfo(newDecisionTaskScheduledID)
 			resp.StartedResponse, err = handler.createRecordDecisionTaskStartedResponse(domainID, msBuilder, decision, request.GetIdentity())
 			if err != nil {
@@ -630,6 +695,112 @@ Update_History_Loop:
 	return nil, workflow.ErrMaxAttemptsExceeded
 }
 
+// discardPayloads deletes the payloads offloaded for decisions which were not persisted,
+// failing to delete them only leaks them in the blobstore
+func (handler *handlerImpl) discardPayloads(
+	ctx context.Context,
+	domainID string,
+	references [][]byte,
+) {
+
+	if err := handler.payloadStore.Discard(ctx, domainID, references); err != nil {
+		handler.logger.Warn("Failed to discard offloaded payloads.", tag.WorkflowDomainID(domainID), tag.Error(err))
+	}
+}
+
+// compactHistory writes the history compaction event in its own transaction right after the decision
+// which recorded the history compaction marker, and trims the history written before it.
+// Compaction is best effort, failing to compact does not fail the decision task completion.
+func (handler *handlerImpl) compactHistory(
+	ctx context.Context,
+	wfContext execution.Context,
+	domainEntry *cache.DomainCacheEntry,
+	markerEvent *types.HistoryEvent,
+) {
+
+	domainName := domainEntry.GetInfo().Name
+	if !handler.config.EnableHistoryCompaction(domainName) {
+		return
+	}
+	// history of global domains is replicated to other clusters, where it can't be compacted consistently,
+	// so compaction enabled for a global domain is a misconfiguration and is rejected
+	if domainEntry.IsGlobalDomain() {
+		handler.metricsClient.Scope(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.DomainTag(domainName)).
+			IncCounter(metrics.HistoryCompactionFailedCounter)
+		handler.logger.Warn("History compaction is not supported for global domains, ignoring the history compaction config.",
+			tag.WorkflowDomainName(domainName))
+		return
+	}
+
+	// workers replay the compacted history, so only a worker declaring it can replay the
+	// WorkflowHistoryCompacted event is allowed to compact the history of its workflow
+	featureFlags := client.GetFeatureFlagsFromHeader(yarpc.CallFromContext(ctx))
+	if err := handler.versionChecker.SupportsWorkflowHistoryCompaction(featureFlags); err != nil {
+		handler.metricsClient.Scope(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.DomainTag(domainName)).
+			IncCounter(metrics.HistoryCompactionFailedCounter)
+		handler.throttledLogger.Warn("History compaction is not supported by the worker, ignoring the history compaction marker.",
+			tag.WorkflowDomainName(domainName),
+			tag.Error(err))
+		return
+	}
+
+	msBuilder, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return
+	}
+	if !msBuilder.IsWorkflowExecutionRunning() || msBuilder.HasPendingDecision() || msBuilder.HasBufferedEvents() {
+		return
+	}
+	compactionNodeID, err := msBuilder.GetHistoryCompactionNodeID()
+	if err != nil {
+		return
+	}
+	// the first batch contains the workflow started event and is always kept
+	minNodeID := common.MaxInt64(compactionNodeID, common.FirstEventID+1)
+	if msBuilder.GetNextEventID()-minNodeID < int64(handler.config.HistoryCompactionEventThreshold(domainName)) {
+		return
+	}
+
+	scope := handler.metricsClient.Scope(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.DomainTag(domainName))
+	executionInfo := msBuilder.GetExecutionInfo()
+	logger := handler.logger.WithTags(
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowID(executionInfo.WorkflowID),
+		tag.WorkflowRunID(executionInfo.RunID),
+	)
+
+	compactedEvent, err := msBuilder.AddWorkflowHistoryCompactedEvent(ctx, markerEvent)
+	if err != nil {
+		scope.IncCounter(metrics.HistoryCompactionFailedCounter)
+		logger.Warn("Failed to compact workflow history.", tag.Error(err))
+		// mutable state may be partially updated, it needs to be reloaded
+		wfContext.Clear()
+		return
+	}
+	// events before the compaction event no longer count towards the history size
+	wfContext.SetHistorySize(0)
+	if err := wfContext.UpdateWorkflowExecutionAsActive(ctx, handler.shard.GetTimeSource().Now()); err != nil {
+		scope.IncCounter(metrics.HistoryCompactionFailedCounter)
+		logger.Warn("Failed to compact workflow history.", tag.Error(err))
+		return
+	}
+	scope.IncCounter(metrics.HistoryCompactionCounter)
+
+	branchToken, err := msBuilder.GetCurrentBranchToken()
+	if err == nil {
+		err = handler.shard.GetHistoryManager().TrimHistoryBranch(ctx, &persistence.TrimHistoryBranchRequest{
+			BranchToken: branchToken,
+			MinNodeID:   minNodeID,
+			MaxNodeID:   compactedEvent.ID,
+			ShardID:     common.IntPtr(handler.shard.GetShardID()),
+		})
+	}
+	if err != nil {
+		// the compaction event is persisted, the untrimmed events are still readable and only waste storage
+		logger.Warn("Failed to trim compacted workflow history.", tag.Error(err))
+	}
+}
+
 func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 	domainID string,
 	msBuilder execution.MutableState,
@@ -831,6 +1002,136 @@ func (handler *handlerImpl) handleBufferedQueries(
 	}
 }
 
+// handleUpdateResults records the results of the pending updates the workflow responded to on the decision task
+// and drops the updates which failed, as part of the decision task completion. It returns the termination states
+// of the updates, to be set on the update registry once the decision task completion is persisted
+func (handler *handlerImpl) handleUpdateResults(
+	msBuilder execution.MutableState,
+	decisionCompletedEventID int64,
+	decisionStartedID int64,
+	updateResults map[string]*types.WorkflowUpdateResult,
+	domainEntry *cache.DomainCacheEntry,
+	decisionHeartbeating bool,
+) (map[string]*update.TerminationState, error) {
+
+	pendingUpdates := msBuilder.GetPendingWorkflowUpdates()
+	if len(pendingUpdates) == 0 {
+		return nil, nil
+	}
+
+	domainID := domainEntry.GetInfo().ID
+	domain := domainEntry.GetInfo().Name
+	workflowID := msBuilder.GetExecutionInfo().WorkflowID
+	runID := msBuilder.GetExecutionInfo().RunID
+
+	scope := handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DomainTag(domain),
+		metrics.DecisionTypeTag("WorkflowUpdate"))
+	sizeLimitError := handler.config.BlobSizeLimitError(domain)
+	sizeLimitWarn := handler.config.BlobSizeLimitWarn(domain)
+
+	terminationStates := make(map[string]*update.TerminationState)
+	for _, updateInfo := range pendingUpdates {
+		id := updateInfo.UpdateID
+		result, ok := updateResults[id]
+		if !ok {
+			// Updates recorded before this decision task started were part of its history,
+			// the workflow completed the decision task without responding to them.
+			// If its a heartbeat decision the workflow may still respond to the update
+			// once local activities running on the worker complete
+			if !decisionHeartbeating && updateInfo.AfterEventID < decisionStartedID {
+				msBuilder.DeleteWorkflowUpdate(id)
+				terminationStates[id] = &update.TerminationState{
+					TerminationType: update.TerminationTypeFailed,
+					Failure:         workflow.ErrWorkflowUpdateNotHandled,
+				}
+			}
+			continue
+		}
+
+		err := update.ValidateUpdateResult(result)
+		if err == nil {
+			err = common.CheckEventBlobSizeLimit(
+				len(result.GetResult()),
+				sizeLimitWarn,
+				sizeLimitError,
+				domainID,
+				workflowID,
+				runID,
+				scope,
+				handler.throttledLogger,
+				tag.BlobSizeViolationOperation("WorkflowUpdate"),
+			)
+		}
+		if err != nil {
+			msBuilder.DeleteWorkflowUpdate(id)
+			terminationStates[id] = &update.TerminationState{
+				TerminationType: update.TerminationTypeFailed,
+				Failure:         err,
+			}
+			continue
+		}
+
+		if _, err := msBuilder.AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, id, result); err != nil {
+			return nil, &types.InternalServiceError{Message: "Unable to add WorkflowExecutionUpdateCompleted event to history."}
+		}
+		terminationStates[id] = &update.TerminationState{
+			TerminationType: update.TerminationTypeCompleted,
+			UpdateResult:    result,
+		}
+	}
+	return terminationStates, nil
+}
+
+// handleBufferedUpdates notifies the callers waiting for updates terminated by the persisted decision task completion
+func (handler *handlerImpl) handleBufferedUpdates(
+	updateRegistry update.Registry,
+	msBuilder execution.MutableState,
+	terminationStates map[string]*update.TerminationState,
+) {
+	if !updateRegistry.HasPendingUpdate() {
+		return
+	}
+
+	domainID := msBuilder.GetExecutionInfo().DomainID
+	workflowID := msBuilder.GetExecutionInfo().WorkflowID
+	runID := msBuilder.GetExecutionInfo().RunID
+
+	setTerminationState := func(id string, terminationState *update.TerminationState) {
+		if err := updateRegistry.SetTerminationState(id, terminationState); err != nil {
+			handler.logger.Error(
+				"failed to set update termination state",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(runID),
+				tag.UpdateID(id),
+				tag.Error(err))
+		}
+	}
+
+	pending := make(map[string]struct{})
+	for _, id := range updateRegistry.GetPendingIDs() {
+		pending[id] = struct{}{}
+	}
+	for id, terminationState := range terminationStates {
+		// the caller of the update may have timed out already
+		if _, ok := pending[id]; ok {
+			setTerminationState(id, terminationState)
+		}
+	}
+
+	// The workflow closed on this decision task, remaining updates will never be delivered
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		for _, id := range updateRegistry.GetPendingIDs() {
+			setTerminationState(id, &update.TerminationState{
+				TerminationType: update.TerminationTypeFailed,
+				Failure:         workflow.ErrAlreadyCompleted,
+			})
+		}
+	}
+}
+
 func (handler *handlerImpl) failDecisionHelper(
 	ctx context.Context,
 	wfContext execution.Context,
@@ -21,13 +21,19 @@
 package decision
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	"go.uber.org/yarpc/yarpctest"
 
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
@@ -37,6 +43,9 @@ import (
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/query"
+	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
+	"github.com/uber/cadence/service/history/workflow"
 )
 
 type (
@@ -61,14 +70,14 @@ func (s *DecisionHandlerSuite) SetupTest() {
 	s.controller = gomock.NewController(s.T())
 
 	s.decisionHandler = &handlerImpl{
-		versionChecker: client.NewVersionChecker(),
-		metricsClient:  metrics.NewClient(tally.NoopScope, metrics.History),
-		config:         config.NewForTest(),
-		logger:         loggerimpl.NewNopLogger(),
+		versionChecker:  client.NewVersionChecker(),
+		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.History),
+		config:          config.NewForTest(),
+		logger:          loggerimpl.NewNopLogger(),
+		throttledLogger: loggerimpl.NewNopLogger(),
 	}
 	s.queryRegistry = s.constructQueryRegistry(10)
 	s.mockMutableState = execution.NewMockMutableState(s.controller)
-	s.mockMutableState.EXPECT().GetQueryRegistry().Return(s.queryRegistry)
 	workflowInfo := &persistence.WorkflowExecutionInfo{
 		WorkflowID: constants.TestWorkflowID,
 		RunID:      constants.TestRunID,
@@ -81,12 +90,14 @@ func (s *DecisionHandlerSuite) TearDownTest() {
 }
 
 func (s *DecisionHandlerSuite) TestHandleBufferedQueries_ClientNotSupports() {
+	s.mockMutableState.EXPECT().GetQueryRegistry().Return(s.queryRegistry)
 	s.assertQueryCounts(s.queryRegistry, 10, 0, 0, 0)
 	s.decisionHandler.handleBufferedQueries(s.mockMutableState, client.GoSDK, "0.0.0", nil, false, constants.TestGlobalDomainEntry, false)
 	s.assertQueryCounts(s.queryRegistry, 0, 0, 0, 10)
 }
 
 func (s *DecisionHandlerSuite) TestHandleBufferedQueries_HeartbeatDecision() {
+	s.mockMutableState.EXPECT().GetQueryRegistry().Return(s.queryRegistry)
 	s.assertQueryCounts(s.queryRegistry, 10, 0, 0, 0)
 	queryResults := s.constructQueryResults(s.queryRegistry.GetBufferedIDs()[0:5], 10)
 	s.decisionHandler.handleBufferedQueries(s.mockMutableState, client.GoSDK, client.GoWorkerConsistentQueryVersion, queryResults, false, constants.TestGlobalDomainEntry, true)
@@ -94,6 +105,7 @@ func (s *DecisionHandlerSuite) TestHandleBufferedQueries_HeartbeatDecision() {
 }
 
 func (s *DecisionHandlerSuite) TestHandleBufferedQueries_NewDecisionTask() {
+	s.mockMutableState.EXPECT().GetQueryRegistry().Return(s.queryRegistry)
 	s.assertQueryCounts(s.queryRegistry, 10, 0, 0, 0)
 	queryResults := s.constructQueryResults(s.queryRegistry.GetBufferedIDs()[0:5], 10)
 	s.decisionHandler.handleBufferedQueries(s.mockMutableState, client.GoSDK, client.GoWorkerConsistentQueryVersion, queryResults, true, constants.TestGlobalDomainEntry, false)
@@ -101,6 +113,7 @@ func (s *DecisionHandlerSuite) TestHandleBufferedQueries_NewDecisionTask() {
 }
 
 func (s *DecisionHandlerSuite) TestHandleBufferedQueries_NoNewDecisionTask() {
+	s.mockMutableState.EXPECT().GetQueryRegistry().Return(s.queryRegistry)
 	s.assertQueryCounts(s.queryRegistry, 10, 0, 0, 0)
 	queryResults := s.constructQueryResults(s.queryRegistry.GetBufferedIDs()[0:5], 10)
 	s.decisionHandler.handleBufferedQueries(s.mockMutableState, client.GoSDK, client.GoWorkerConsistentQueryVersion, queryResults, false, constants.TestGlobalDomainEntry, false)
@@ -108,6 +121,7 @@ func (s *DecisionHandlerSuite) TestHandleBufferedQueries_NoNewDecisionTask() {
 }
 
 func (s *DecisionHandlerSuite) TestHandleBufferedQueries_QueryTooLarge() {
+	s.mockMutableState.EXPECT().GetQueryRegistry().Return(s.queryRegistry)
 	s.assertQueryCounts(s.queryRegistry, 10, 0, 0, 0)
 	bufferedIDs := s.queryRegistry.GetBufferedIDs()
 	queryResults := s.constructQueryResults(bufferedIDs[0:5], 10)
@@ -119,6 +133,257 @@ func (s *DecisionHandlerSuite) TestHandleBufferedQueries_QueryTooLarge() {
 	s.assertQueryCounts(s.queryRegistry, 0, 5, 0, 5)
 }
 
+func (s *DecisionHandlerSuite) TestHandleUpdateResults_NoPendingUpdate() {
+	s.mockMutableState.EXPECT().GetPendingWorkflowUpdates().Return(nil)
+	states, err := s.decisionHandler.handleUpdateResults(s.mockMutableState, 20, 10, nil, constants.TestGlobalDomainEntry, false)
+	s.NoError(err)
+	s.Empty(states)
+}
+
+func (s *DecisionHandlerSuite) TestHandleUpdateResults_HeartbeatDecision() {
+	updateInfos, ids := s.constructWorkflowUpdates(10)
+	s.mockMutableState.EXPECT().GetPendingWorkflowUpdates().Return(updateInfos)
+	updateResults := s.constructUpdateResults(ids[0:5], 10)
+	for _, id := range ids[0:5] {
+		s.mockMutableState.EXPECT().AddWorkflowExecutionUpdateCompletedEvent(int64(100), id, updateResults[id]).Return(&types.HistoryEvent{}, nil)
+	}
+	states, err := s.decisionHandler.handleUpdateResults(s.mockMutableState, 100, 99, updateResults, constants.TestGlobalDomainEntry, true)
+	s.NoError(err)
+	s.assertTerminationStates(states, 5, 0)
+}
+
+func (s *DecisionHandlerSuite) TestHandleUpdateResults_NotHandled() {
+	updateInfos, ids := s.constructWorkflowUpdates(10)
+	s.mockMutableState.EXPECT().GetPendingWorkflowUpdates().Return(updateInfos)
+	updateResults := s.constructUpdateResults(ids[0:2], 10)
+	for _, id := range ids[0:2] {
+		s.mockMutableState.EXPECT().AddWorkflowExecutionUpdateCompletedEvent(int64(20), id, updateResults[id]).Return(&types.HistoryEvent{}, nil)
+	}
+	// updates 0 to 4 were recorded before the decision task started
+	for _, id := range ids[2:5] {
+		s.mockMutableState.EXPECT().DeleteWorkflowUpdate(id)
+	}
+	states, err := s.decisionHandler.handleUpdateResults(s.mockMutableState, 20, 5, updateResults, constants.TestGlobalDomainEntry, false)
+	s.NoError(err)
+	s.assertTerminationStates(states, 2, 3)
+	for _, id := range ids[2:5] {
+		s.Equal(workflow.ErrWorkflowUpdateNotHandled, states[id].Failure)
+	}
+}
+
+func (s *DecisionHandlerSuite) TestHandleUpdateResults_ResultInvalid() {
+	updateInfos, ids := s.constructWorkflowUpdates(2)
+	s.mockMutableState.EXPECT().GetPendingWorkflowUpdates().Return(updateInfos)
+	updateResults := s.constructUpdateResults(ids, 10)
+	updateResults[ids[1]].ErrorMessage = "some random error"
+	s.mockMutableState.EXPECT().AddWorkflowExecutionUpdateCompletedEvent(int64(20), ids[0], updateResults[ids[0]]).Return(&types.HistoryEvent{}, nil)
+	s.mockMutableState.EXPECT().DeleteWorkflowUpdate(ids[1])
+	states, err := s.decisionHandler.handleUpdateResults(s.mockMutableState, 20, 10, updateResults, constants.TestGlobalDomainEntry, false)
+	s.NoError(err)
+	s.assertTerminationStates(states, 1, 1)
+}
+
+func (s *DecisionHandlerSuite) TestHandleUpdateResults_ResultTooLarge() {
+	updateInfos, ids := s.constructWorkflowUpdates(10)
+	s.mockMutableState.EXPECT().GetPendingWorkflowUpdates().Return(updateInfos)
+	updateResults := s.constructUpdateResults(ids[0:5], 10)
+	for k, v := range s.constructUpdateResults(ids[5:10], 10*1024*1024) {
+		updateResults[k] = v
+	}
+	for _, id := range ids[0:5] {
+		s.mockMutableState.EXPECT().AddWorkflowExecutionUpdateCompletedEvent(int64(20), id, updateResults[id]).Return(&types.HistoryEvent{}, nil)
+	}
+	for _, id := range ids[5:10] {
+		s.mockMutableState.EXPECT().DeleteWorkflowUpdate(id)
+	}
+	states, err := s.decisionHandler.handleUpdateResults(s.mockMutableState, 20, 0, updateResults, constants.TestGlobalDomainEntry, false)
+	s.NoError(err)
+	s.assertTerminationStates(states, 5, 5)
+}
+
+func (s *DecisionHandlerSuite) TestHandleUpdateResults_AddEventFailed() {
+	updateInfos, ids := s.constructWorkflowUpdates(1)
+	s.mockMutableState.EXPECT().GetPendingWorkflowUpdates().Return(updateInfos)
+	updateResults := s.constructUpdateResults(ids, 10)
+	s.mockMutableState.EXPECT().AddWorkflowExecutionUpdateCompletedEvent(int64(20), ids[0], updateResults[ids[0]]).Return(nil, execution.ErrMissingWorkflowUpdateInfo)
+	_, err := s.decisionHandler.handleUpdateResults(s.mockMutableState, 20, 10, updateResults, constants.TestGlobalDomainEntry, false)
+	s.Error(err)
+}
+
+func (s *DecisionHandlerSuite) TestHandleBufferedUpdates_NoPendingUpdate() {
+	updateRegistry := update.NewRegistry()
+	s.decisionHandler.handleBufferedUpdates(updateRegistry, s.mockMutableState, nil)
+	s.assertUpdateCounts(updateRegistry, 0, 0, 0)
+}
+
+func (s *DecisionHandlerSuite) TestHandleBufferedUpdates_DecisionFailed() {
+	updateRegistry, _ := s.constructUpdateRegistry(10)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.decisionHandler.handleBufferedUpdates(updateRegistry, s.mockMutableState, nil)
+	s.assertUpdateCounts(updateRegistry, 10, 0, 0)
+}
+
+func (s *DecisionHandlerSuite) TestHandleBufferedUpdates_TerminationStates() {
+	updateRegistry, ids := s.constructUpdateRegistry(10)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	states := s.constructTerminationStates(ids[0:3], ids[3:5])
+	// the caller of an update not in the registry has timed out already
+	states["update-timed-out"] = &update.TerminationState{
+		TerminationType: update.TerminationTypeFailed,
+		Failure:         workflow.ErrWorkflowUpdateNotHandled,
+	}
+	s.decisionHandler.handleBufferedUpdates(updateRegistry, s.mockMutableState, states)
+	s.assertUpdateCounts(updateRegistry, 5, 3, 2)
+}
+
+func (s *DecisionHandlerSuite) TestHandleBufferedUpdates_WorkflowClosed() {
+	updateRegistry, ids := s.constructUpdateRegistry(10)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(false).AnyTimes()
+	states := s.constructTerminationStates(ids[0:5], nil)
+	s.decisionHandler.handleBufferedUpdates(updateRegistry, s.mockMutableState, states)
+	s.assertUpdateCounts(updateRegistry, 0, 5, 5)
+	for _, id := range ids[5:10] {
+		state, err := updateRegistry.GetTerminationState(id)
+		s.NoError(err)
+		s.Equal(workflow.ErrAlreadyCompleted, state.Failure)
+	}
+}
+
+func (s *DecisionHandlerSuite) TestCompactHistory_GlobalDomain() {
+	testScope := tally.NewTestScope("test", nil)
+	s.decisionHandler.metricsClient = metrics.NewClient(testScope, metrics.History)
+	s.decisionHandler.config.EnableHistoryCompaction = func(string) bool { return true }
+	mockContext := execution.NewMockContext(s.controller)
+	s.decisionHandler.compactHistory(context.Background(), mockContext, constants.TestGlobalDomainEntry, s.newHistoryCompactionMarker())
+
+	// the workflow is not loaded, and the unsupported config is reported
+	var failedCount int64
+	for _, counter := range testScope.Snapshot().Counters() {
+		if counter.Name() == "test.history_compaction_failed_count" {
+			failedCount += counter.Value()
+		}
+	}
+	s.Equal(int64(1), failedCount)
+}
+
+func (s *DecisionHandlerSuite) TestCompactHistory_NotSupportedByWorker() {
+	testScope := tally.NewTestScope("test", nil)
+	s.decisionHandler.metricsClient = metrics.NewClient(testScope, metrics.History)
+	s.decisionHandler.config.EnableHistoryCompaction = func(string) bool { return true }
+	mockContext := execution.NewMockContext(s.controller)
+	s.decisionHandler.compactHistory(context.Background(), mockContext, constants.TestLocalDomainEntry, s.newHistoryCompactionMarker())
+
+	// the workflow is not loaded, as the worker did not declare it can replay the compacted history
+	var failedCount int64
+	for _, counter := range testScope.Snapshot().Counters() {
+		if counter.Name() == "test.history_compaction_failed_count" {
+			failedCount += counter.Value()
+		}
+	}
+	s.Equal(int64(1), failedCount)
+}
+
+func (s *DecisionHandlerSuite) TestCompactHistory_BelowThreshold() {
+	s.decisionHandler.config.EnableHistoryCompaction = func(string) bool { return true }
+	s.decisionHandler.config.HistoryCompactionEventThreshold = func(string) int { return 100 }
+	mockContext := execution.NewMockContext(s.controller)
+	mockContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	s.mockMutableState.EXPECT().HasPendingDecision().Return(false)
+	s.mockMutableState.EXPECT().HasBufferedEvents().Return(false)
+	s.mockMutableState.EXPECT().GetHistoryCompactionNodeID().Return(int64(150), nil)
+	s.mockMutableState.EXPECT().GetNextEventID().Return(int64(200))
+	s.decisionHandler.compactHistory(s.newCompactionSupportedContext(), mockContext, constants.TestLocalDomainEntry, s.newHistoryCompactionMarker())
+}
+
+func (s *DecisionHandlerSuite) TestCompactHistory_Success() {
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID: 10,
+			RangeID: 1,
+		},
+		config.NewForTest(),
+	)
+	defer mockShard.Finish(s.T())
+	s.decisionHandler.shard = mockShard
+	s.decisionHandler.config.EnableHistoryCompaction = func(string) bool { return true }
+	s.decisionHandler.config.HistoryCompactionEventThreshold = func(string) int { return 100 }
+	markerEvent := s.newHistoryCompactionMarker()
+	branchToken := []byte("some branch token")
+
+	mockContext := execution.NewMockContext(s.controller)
+	mockContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	s.mockMutableState.EXPECT().HasPendingDecision().Return(false)
+	s.mockMutableState.EXPECT().HasBufferedEvents().Return(false)
+	s.mockMutableState.EXPECT().GetHistoryCompactionNodeID().Return(int64(0), nil)
+	s.mockMutableState.EXPECT().GetNextEventID().Return(int64(200))
+	s.mockMutableState.EXPECT().AddWorkflowHistoryCompactedEvent(gomock.Any(), markerEvent).Return(&types.HistoryEvent{ID: 200}, nil)
+	mockContext.EXPECT().SetHistorySize(int64(0))
+	mockContext.EXPECT().UpdateWorkflowExecutionAsActive(gomock.Any(), gomock.Any()).Return(nil)
+	s.mockMutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil)
+	mockShard.Resource.HistoryMgr.On("TrimHistoryBranch", mock.Anything, &persistence.TrimHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinNodeID:   2,
+		MaxNodeID:   200,
+		ShardID:     common.IntPtr(10),
+	}).Return(nil).Once()
+
+	s.decisionHandler.compactHistory(s.newCompactionSupportedContext(), mockContext, constants.TestLocalDomainEntry, markerEvent)
+}
+
+func (s *DecisionHandlerSuite) TestCompactHistory_UpdateFailed() {
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID: 10,
+			RangeID: 1,
+		},
+		config.NewForTest(),
+	)
+	defer mockShard.Finish(s.T())
+	s.decisionHandler.shard = mockShard
+	s.decisionHandler.config.EnableHistoryCompaction = func(string) bool { return true }
+	s.decisionHandler.config.HistoryCompactionEventThreshold = func(string) int { return 100 }
+	markerEvent := s.newHistoryCompactionMarker()
+
+	mockContext := execution.NewMockContext(s.controller)
+	mockContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	s.mockMutableState.EXPECT().HasPendingDecision().Return(false)
+	s.mockMutableState.EXPECT().HasBufferedEvents().Return(false)
+	s.mockMutableState.EXPECT().GetHistoryCompactionNodeID().Return(int64(0), nil)
+	s.mockMutableState.EXPECT().GetNextEventID().Return(int64(200))
+	s.mockMutableState.EXPECT().AddWorkflowHistoryCompactedEvent(gomock.Any(), markerEvent).Return(&types.HistoryEvent{ID: 200}, nil)
+	mockContext.EXPECT().SetHistorySize(int64(0))
+	mockContext.EXPECT().UpdateWorkflowExecutionAsActive(gomock.Any(), gomock.Any()).Return(&types.InternalServiceError{Message: "update failed"})
+
+	// no trimming after the compaction event failed to persist
+	s.decisionHandler.compactHistory(s.newCompactionSupportedContext(), mockContext, constants.TestLocalDomainEntry, markerEvent)
+}
+
+func (s *DecisionHandlerSuite) newCompactionSupportedContext() context.Context {
+	return yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{
+		Headers: map[string]string{
+			common.ClientFeatureFlagsHeaderName: client.FeatureFlagsHeader(shared.FeatureFlags{
+				WorkflowHistoryCompactionEnabled: common.BoolPtr(true),
+			}),
+		},
+	})
+}
+
+func (s *DecisionHandlerSuite) newHistoryCompactionMarker() *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID:        198,
+		EventType: types.EventTypeMarkerRecorded.Ptr(),
+		MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+			MarkerName: common.HistoryCompactionMarkerName,
+			Details:    []byte("some workflow state"),
+		},
+	}
+}
+
 func (s *DecisionHandlerSuite) constructQueryResults(ids []string, resultSize int) map[string]*types.WorkflowQueryResult {
 	results := make(map[string]*types.WorkflowQueryResult)
 	for _, id := range ids {
@@ -138,6 +403,82 @@ func (s *DecisionHandlerSuite) constructQueryRegistry(numQueries int) query.Regi
 	return queryRegistry
 }
 
+func (s *DecisionHandlerSuite) constructUpdateResults(ids []string, resultSize int) map[string]*types.WorkflowUpdateResult {
+	results := make(map[string]*types.WorkflowUpdateResult)
+	for _, id := range ids {
+		results[id] = &types.WorkflowUpdateResult{
+			ResultType: types.QueryResultTypeAnswered.Ptr(),
+			Result:     make([]byte, resultSize),
+		}
+	}
+	return results
+}
+
+func (s *DecisionHandlerSuite) constructUpdateRegistry(numUpdates int) (update.Registry, []string) {
+	updateRegistry := update.NewRegistry()
+	ids := make([]string, numUpdates)
+	for i := 0; i < numUpdates; i++ {
+		ids[i] = fmt.Sprintf("update-%v", i)
+		updateRegistry.BufferUpdate(ids[i], &types.UpdateWorkflowExecutionRequest{})
+	}
+	return updateRegistry, ids
+}
+
+func (s *DecisionHandlerSuite) constructWorkflowUpdates(numUpdates int) ([]*types.WorkflowUpdateInfo, []string) {
+	updateInfos := make([]*types.WorkflowUpdateInfo, numUpdates)
+	ids := make([]string, numUpdates)
+	for i := 0; i < numUpdates; i++ {
+		ids[i] = fmt.Sprintf("update-%v", i)
+		updateInfos[i] = &types.WorkflowUpdateInfo{
+			UpdateID:                     ids[i],
+			AfterEventID:                 int64(i),
+			DecisionTaskCompletedEventID: common.EmptyEventID,
+			CompletedEventID:             common.EmptyEventID,
+		}
+	}
+	return updateInfos, ids
+}
+
+func (s *DecisionHandlerSuite) constructTerminationStates(completedIDs []string, failedIDs []string) map[string]*update.TerminationState {
+	states := make(map[string]*update.TerminationState)
+	for _, id := range completedIDs {
+		states[id] = &update.TerminationState{
+			TerminationType: update.TerminationTypeCompleted,
+			UpdateResult: &types.WorkflowUpdateResult{
+				ResultType: types.QueryResultTypeAnswered.Ptr(),
+				Result:     []byte{1, 2, 3},
+			},
+		}
+	}
+	for _, id := range failedIDs {
+		states[id] = &update.TerminationState{
+			TerminationType: update.TerminationTypeFailed,
+			Failure:         workflow.ErrWorkflowUpdateNotHandled,
+		}
+	}
+	return states
+}
+
+func (s *DecisionHandlerSuite) assertTerminationStates(states map[string]*update.TerminationState, completed, failed int) {
+	completedCount, failedCount := 0, 0
+	for _, state := range states {
+		switch state.TerminationType {
+		case update.TerminationTypeCompleted:
+			completedCount++
+		case update.TerminationTypeFailed:
+			failedCount++
+		}
+	}
+	s.Equal(completed, completedCount)
+	s.Equal(failed, failedCount)
+}
+
+func (s *DecisionHandlerSuite) assertUpdateCounts(updateRegistry update.Registry, pending, completed, failed int) {
+	s.Len(updateRegistry.GetPendingIDs(), pending)
+	s.Len(updateRegistry.GetCompletedIDs(), completed)
+	s.Len(updateRegistry.GetFailedIDs(), failed)
+}
+
 func (s *DecisionHandlerSuite) assertQueryCounts(queryRegistry query.Registry, buffered, completed, unblocked, failed int) {
 	s.Len(queryRegistry.GetBufferedIDs(), buffered)
 	s.Len(queryRegistry.GetCompletedIDs(), completed)
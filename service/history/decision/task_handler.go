@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -58,14 +59,19 @@ type (
 		failMessage                       *string
 		activityNotStartedCancelled       bool
 		continueAsNewBuilder              execution.MutableState
+		historyCompactionMarker           *types.HistoryEvent
 		stopProcessing                    bool // should stop processing any more decisions
 		mutableState                      execution.MutableState
+		// offloadedPayloads are the references to the payloads offloaded or copied while handling the
+		// decisions, they must be discarded if the decisions are not persisted
+		offloadedPayloads [][]byte
 
 		// validation
 		attrValidator    *attrValidator
 		sizeLimitChecker *workflowSizeChecker
 
 		tokenSerializer common.TaskTokenSerializer
+		payloadStore    *payload.Store
 
 		logger        log.Logger
 		domainCache   cache.DomainCache
@@ -88,6 +94,7 @@ func newDecisionTaskHandler(
 	attrValidator *attrValidator,
 	sizeLimitChecker *workflowSizeChecker,
 	tokenSerializer common.TaskTokenSerializer,
+	payloadStore *payload.Store,
 	logger log.Logger,
 	domainCache cache.DomainCache,
 	metricsClient metrics.Client,
@@ -114,6 +121,7 @@ func newDecisionTaskHandler(
 		sizeLimitChecker: sizeLimitChecker,
 
 		tokenSerializer: tokenSerializer,
+		payloadStore:    payloadStore,
 
 		logger:        logger,
 		domainCache:   domainCache,
@@ -420,6 +428,19 @@ func (handler *taskHandlerImpl) handleDecisionCompleteWorkflow(
 		return err
 	}
 
+	// the payload is offloaded on a copy of the attributes, so that the original payload
+	// is offloaded again if the update is retried
+	attrCopy := *attr
+	attr = &attrCopy
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.offloadPayload(ctx, &attr.Result)
+		},
+		types.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeCompleteWorkflowExecution.String()),
 		attr.Result,
@@ -553,6 +574,10 @@ func (handler *taskHandlerImpl) handleDecisionFailWorkflow(
 		return err
 	}
 	startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
+	lastCompletionResult, err := handler.copyPayload(ctx, startAttributes.LastCompletionResult)
+	if err != nil {
+		return err
+	}
 	return handler.retryCronContinueAsNew(
 		ctx,
 		startAttributes,
@@ -560,7 +585,7 @@ func (handler *taskHandlerImpl) handleDecisionFailWorkflow(
 		continueAsNewInitiator.Ptr(),
 		attr.Reason,
 		attr.Details,
-		startAttributes.LastCompletionResult,
+		lastCompletionResult,
 	)
 }
 
@@ -728,8 +753,14 @@ func (handler *taskHandlerImpl) handleDecisionRecordMarker(
 		return err
 	}
 
-	_, err = handler.mutableState.AddRecordMarkerEvent(handler.decisionTaskCompletedID, attr)
-	return err
+	event, err := handler.mutableState.AddRecordMarkerEvent(handler.decisionTaskCompletedID, attr)
+	if err != nil {
+		return err
+	}
+	if attr.GetMarkerName() == common.HistoryCompactionMarkerName {
+		handler.historyCompactionMarker = event
+	}
+	return nil
 }
 
 func (handler *taskHandlerImpl) handleDecisionContinueAsNewWorkflow(
@@ -765,6 +796,19 @@ func (handler *taskHandlerImpl) handleDecisionContinueAsNewWorkflow(
 		return err
 	}
 
+	// the payload is offloaded on a copy of the attributes, so that the original payload
+	// is offloaded again if the update is retried
+	attrCopy := *attr
+	attr = &attrCopy
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.offloadPayload(ctx, &attr.Input)
+		},
+		types.DecisionTaskFailedCauseBadContinueAsNewAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeContinueAsNewWorkflowExecution.String()),
 		attr.Input,
@@ -1007,11 +1051,17 @@ func (handler *taskHandlerImpl) retryCronContinueAsNew(
 	failureDetails []byte,
 	lastCompletionResult []byte,
 ) error {
+	// the input offloaded for the current run is deleted along with its history
+	input, err := handler.copyPayload(ctx, attr.Input)
+	if err != nil {
+		return err
+	}
+
 	continueAsNewAttributes := &types.ContinueAsNewWorkflowExecutionDecisionAttributes{
 		WorkflowType:                        attr.WorkflowType,
 		TaskList:                            attr.TaskList,
 		RetryPolicy:                         attr.RetryPolicy,
-		Input:                               attr.Input,
+		Input:                               input,
 		ExecutionStartToCloseTimeoutSeconds: attr.ExecutionStartToCloseTimeoutSeconds,
 		TaskStartToCloseTimeoutSeconds:      attr.TaskStartToCloseTimeoutSeconds,
 		CronSchedule:                        attr.CronSchedule,
@@ -1040,6 +1090,57 @@ func (handler *taskHandlerImpl) retryCronContinueAsNew(
 	return nil
 }
 
+// offloadPayload replaces the payload with a reference to it if the payload is offloaded. A payload
+// exceeding the offload size limit is kept inline so that it fails the blob size limit check
+func (handler *taskHandlerImpl) offloadPayload(
+	ctx context.Context,
+	data *[]byte,
+) error {
+
+	executionInfo := handler.mutableState.GetExecutionInfo()
+	offloaded, err := handler.payloadStore.Offload(
+		ctx,
+		handler.domainEntry.GetInfo().Name,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		*data,
+	)
+	if err == payload.ErrSizeExceedsLimit {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if payload.IsReference(offloaded) {
+		handler.offloadedPayloads = append(handler.offloadedPayloads, offloaded)
+	}
+	*data = offloaded
+	return nil
+}
+
+// copyPayload copies a payload offloaded for the current run, so that it can be carried over to the new run
+func (handler *taskHandlerImpl) copyPayload(
+	ctx context.Context,
+	data []byte,
+) ([]byte, error) {
+
+	executionInfo := handler.mutableState.GetExecutionInfo()
+	copied, err := handler.payloadStore.Copy(
+		ctx,
+		executionInfo.DomainID,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		data,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if payload.IsReference(copied) {
+		handler.offloadedPayloads = append(handler.offloadedPayloads, copied)
+	}
+	return copied, nil
+}
+
 func (handler *taskHandlerImpl) validateDecisionAttr(
 	validationFn attrValidationFn,
 	failedCause types.DecisionTaskFailedCause,
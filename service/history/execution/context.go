@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 )
 
 const (
@@ -76,6 +77,7 @@ type (
 		GetDomainName() string
 		GetDomainID() string
 		GetExecution() *types.WorkflowExecution
+		GetUpdateRegistry() update.Registry
 
 		GetWorkflowExecution() MutableState
 		SetWorkflowExecution(mutableState MutableState)
@@ -171,6 +173,9 @@ type (
 		mutableState    MutableState
 		stats           *persistence.ExecutionStats
 		updateCondition int64
+		// updateRegistry is kept on the context rather than the mutable state
+		// so that in-flight updates survive mutable state being cleared and reloaded
+		updateRegistry update.Registry
 	}
 )
 
@@ -195,6 +200,7 @@ func NewContext(
 		stats: &persistence.ExecutionStats{
 			HistorySize: 0,
 		},
+		updateRegistry: update.NewRegistry(),
 	}
 }
 
@@ -222,6 +228,10 @@ func (c *contextImpl) GetExecution() *types.WorkflowExecution {
 	return &c.workflowExecution
 }
 
+func (c *contextImpl) GetUpdateRegistry() update.Registry {
+	return c.updateRegistry
+}
+
 func (c *contextImpl) GetDomainName() string {
 	domainName, err := c.shard.GetDomainCache().GetDomainName(c.domainID)
 	if err != nil {
@@ -1205,6 +1215,7 @@ func (c *contextImpl) ReapplyEvents(
 	if domainEntry.IsDomainPendingActive() {
 		return nil
 	}
+	reapplyPolicy := GetNDCReapplyPolicy(c.shard.GetConfig(), domainEntry.GetInfo().Name)
 	var reapplyEvents []*types.HistoryEvent
 	for _, events := range eventBatches {
 		if events.DomainID != domainID ||
@@ -1215,8 +1226,7 @@ func (c *contextImpl) ReapplyEvents(
 		}
 
 		for _, event := range events.Events {
-			switch event.GetEventType() {
-			case types.EventTypeWorkflowExecutionSignaled:
+			if IsReapplyEligibleEvent(reapplyPolicy, event) {
 				reapplyEvents = append(reapplyEvents, event)
 			}
 		}
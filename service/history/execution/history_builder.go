@@ -88,6 +88,8 @@ func (b *HistoryBuilder) AddWorkflowExecutionStartedEvent(startRequest *types.Hi
 		OriginalExecutionRunID:              originalRunID,
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
+		InactivityTimeoutSeconds:            request.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:             request.InactivityTimeoutPolicy,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -340,6 +342,100 @@ func (b *HistoryBuilder) AddWorkflowExecutionTerminatedEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionPausedEvent adds WorkflowExecutionPaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionPaused)
+	event.WorkflowExecutionPausedEventAttributes = &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   reason,
+		Identity: identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUnpausedEvent adds WorkflowExecutionUnpaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUnpaused)
+	event.WorkflowExecutionUnpausedEventAttributes = &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   reason,
+		Identity: identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionInactiveEvent adds WorkflowExecutionInactive event to history
+func (b *HistoryBuilder) AddWorkflowExecutionInactiveEvent(
+	inactivityTimeoutSeconds int32,
+	inactivityTimeoutPolicy types.InactivityTimeoutPolicy,
+	lastDecisionTaskCompletedEventID int64,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionInactive)
+	event.WorkflowExecutionInactiveEventAttributes = &types.WorkflowExecutionInactiveEventAttributes{
+		InactivityTimeoutSeconds:         inactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:          inactivityTimeoutPolicy.Ptr(),
+		LastDecisionTaskCompletedEventID: lastDecisionTaskCompletedEventID,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowHistoryCompactedEvent adds WorkflowHistoryCompacted event to history
+func (b *HistoryBuilder) AddWorkflowHistoryCompactedEvent(
+	markerEvent *types.HistoryEvent,
+	mutableStateSnapshot *types.DataBlob,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowHistoryCompacted)
+	event.WorkflowHistoryCompactedEventAttributes = &types.WorkflowHistoryCompactedEventAttributes{
+		MarkerName:           markerEvent.MarkerRecordedEventAttributes.GetMarkerName(),
+		MarkerDetails:        markerEvent.MarkerRecordedEventAttributes.Details,
+		MarkerEventID:        markerEvent.ID,
+		MutableStateSnapshot: mutableStateSnapshot,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateRequestedEvent adds WorkflowExecutionUpdateRequested event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateRequestedEvent(
+	updateID string,
+	request *types.UpdateWorkflowExecutionRequest,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateRequested)
+	event.WorkflowExecutionUpdateRequestedEventAttributes = &types.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   updateID,
+		UpdateName: request.GetUpdateName(),
+		Input:      request.Input,
+		Identity:   request.GetIdentity(),
+		RequestID:  request.GetRequestID(),
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent adds WorkflowExecutionUpdateCompleted event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	updateID string,
+	result *types.WorkflowUpdateResult,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateCompleted)
+	event.WorkflowExecutionUpdateCompletedEventAttributes = &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     updateID,
+		DecisionTaskCompletedEventID: decisionCompletedEventID,
+		Result:                       result.Result,
+		ErrorMessage:                 result.GetErrorMessage(),
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddContinuedAsNewEvent adds WorkflowExecutionContinuedAsNew event to history
 func (b *HistoryBuilder) AddContinuedAsNewEvent(decisionCompletedEventID int64, newRunID string,
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes) *types.HistoryEvent {
@@ -584,6 +680,18 @@ func (b *HistoryBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowSearchAttributesReappliedEvent adds WorkflowSearchAttributesReapplied event to history
+func (b *HistoryBuilder) AddWorkflowSearchAttributesReappliedEvent(
+	searchAttributes *types.SearchAttributes,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowSearchAttributesReapplied)
+	event.WorkflowSearchAttributesReappliedEventAttributes = &types.WorkflowSearchAttributesReappliedEventAttributes{
+		SearchAttributes: searchAttributes,
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddSignalExternalWorkflowExecutionFailedEvent adds SignalExternalWorkflowExecutionFailed event to history
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
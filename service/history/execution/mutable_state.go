@@ -104,6 +104,13 @@ type (
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(types.WorkflowExecution, *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(reason string, identity string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent(reason string, identity string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionInactiveEvent() (*types.HistoryEvent, error)
+		AddWorkflowExecutionUpdateRequestedEvent(updateID string, request *types.UpdateWorkflowExecutionRequest) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64, updateID string, result *types.WorkflowUpdateResult) (*types.HistoryEvent, error)
+		AddWorkflowHistoryCompactedEvent(ctx context.Context, markerEvent *types.HistoryEvent) (*types.HistoryEvent, error)
+		AddWorkflowSearchAttributesReappliedEvent(*types.SearchAttributes) (*types.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
@@ -115,6 +122,7 @@ type (
 		DeleteUserTimer(timerID string) error
 		DeleteActivity(scheduleEventID int64) error
 		DeleteSignalRequested(requestID string)
+		DeleteWorkflowUpdate(updateID string)
 		FailDecision(bool)
 		FlushBufferedEvents() error
 		GetActivityByActivityID(string) (*persistence.ActivityInfo, bool)
@@ -128,6 +136,7 @@ type (
 		GetDomainEntry() *cache.DomainCacheEntry
 		GetStartEvent(context.Context) (*types.HistoryEvent, error)
 		GetCurrentBranchToken() ([]byte, error)
+		GetHistoryCompactionNodeID() (int64, error)
 		GetVersionHistories() *persistence.VersionHistories
 		GetCurrentVersion() int64
 		GetExecutionInfo() *persistence.WorkflowExecutionInfo
@@ -152,6 +161,9 @@ type (
 		GetUserTimerInfo(string) (*persistence.TimerInfo, bool)
 		GetWorkflowType() *types.WorkflowType
 		GetWorkflowStateCloseStatus() (int, int)
+		GetWorkflowUpdateInfo(updateID string) (*types.WorkflowUpdateInfo, bool)
+		GetPendingWorkflowUpdates() []*types.WorkflowUpdateInfo
+		GetWorkflowUpdateCompletedEvent(ctx context.Context, updateID string) (*types.HistoryEvent, error)
 		GetQueryRegistry() query.Registry
 		SetQueryRegistry(query.Registry)
 		HasBufferedEvents() bool
@@ -160,6 +172,7 @@ type (
 		HasPendingDecision() bool
 		HasProcessedOrPendingDecision() bool
 		IsCancelRequested() (bool, string)
+		IsWorkflowExecutionPaused() bool
 		IsCurrentWorkflowGuaranteed() bool
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
@@ -208,6 +221,13 @@ type (
 		ReplicateWorkflowExecutionSignaled(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*string, types.WorkflowExecution, string, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUnpausedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionInactiveEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateRequestedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateCompletedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowHistoryCompactedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowSearchAttributesReappliedEvent(*types.HistoryEvent)
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *types.HistoryEvent) error
 		SetCurrentBranchToken(branchToken []byte) error
 		SetHistoryBuilder(hBuilder *HistoryBuilder)
@@ -215,6 +235,7 @@ type (
 		SetVersionHistories(*persistence.VersionHistories) error
 		UpdateActivity(*persistence.ActivityInfo) error
 		UpdateActivityProgress(ai *persistence.ActivityInfo, request *types.RecordActivityTaskHeartbeatRequest)
+		UpdateChildExecutionInfo(*persistence.ChildExecutionInfo) error
 		UpdateDecision(*DecisionInfo)
 		UpdateUserTimer(*persistence.TimerInfo) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
@@ -71,6 +71,10 @@ var (
 	ErrMissingWorkflowCompletionEvent = &types.InternalServiceError{Message: "unable to get workflow completion event"}
 	// ErrMissingActivityScheduledEvent indicates missing workflow activity scheduled event
 	ErrMissingActivityScheduledEvent = &types.InternalServiceError{Message: "unable to get activity scheduled event"}
+	// ErrMissingWorkflowUpdateInfo indicates missing workflow update info
+	ErrMissingWorkflowUpdateInfo = &types.InternalServiceError{Message: "unable to get workflow update info"}
+	// ErrMissingWorkflowUpdateCompletedEvent indicates missing workflow update completed event
+	ErrMissingWorkflowUpdateCompletedEvent = &types.InternalServiceError{Message: "unable to get workflow update completed event"}
 	// ErrMissingChildWorkflowInitiatedEvent indicates missing child workflow initiated event
 	ErrMissingChildWorkflowInitiatedEvent = &types.InternalServiceError{Message: "unable to get child workflow initiated event"}
 	// ErrEventsAfterWorkflowFinish is the error indicating server error trying to write events after workflow finish event
@@ -873,6 +877,9 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeWorkflowExecutionContinuedAsNew,
 		types.EventTypeWorkflowExecutionCanceled:
 		return false
+	case // compaction event is always written in its own batch
+		types.EventTypeWorkflowHistoryCompacted:
+		return false
 	case // decision event should not be buffered
 		types.EventTypeDecisionTaskScheduled,
 		types.EventTypeDecisionTaskStarted,
@@ -899,7 +906,9 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeMarkerRecorded,
 		types.EventTypeStartChildWorkflowExecutionInitiated,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated,
-		types.EventTypeUpsertWorkflowSearchAttributes:
+		types.EventTypeUpsertWorkflowSearchAttributes,
+		// update results are responded to on the decision completion
+		types.EventTypeWorkflowExecutionUpdateCompleted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -1323,6 +1332,24 @@ func (e *mutableStateBuilder) UpdateActivity(
 	return nil
 }
 
+// UpdateChildExecutionInfo updates a child execution info.
+func (e *mutableStateBuilder) UpdateChildExecutionInfo(
+	ci *persistence.ChildExecutionInfo,
+) error {
+
+	if _, ok := e.pendingChildExecutionInfoIDs[ci.InitiatedID]; !ok {
+		e.logError(
+			fmt.Sprintf("unable to find child workflow event ID: %v in mutable state", ci.InitiatedID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingChildWorkflowInfo
+	}
+
+	e.pendingChildExecutionInfoIDs[ci.InitiatedID] = ci
+	e.updateChildExecutionInfos[ci.InitiatedID] = ci
+	return nil
+}
+
 // DeleteActivity deletes details about an activity.
 func (e *mutableStateBuilder) DeleteActivity(
 	scheduleEventID int64,
@@ -1571,6 +1598,10 @@ func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	return false, ""
 }
 
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.Paused
+}
+
 func (e *mutableStateBuilder) IsSignalRequested(
 	requestID string,
 ) bool {
@@ -1647,6 +1678,11 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
 	}
+	// inactivity timeout settings are carried over to the new run
+	if previousExecutionInfo.InactivityTimeout > 0 {
+		createRequest.InactivityTimeoutSeconds = common.Int32Ptr(previousExecutionInfo.InactivityTimeout)
+		createRequest.InactivityTimeoutPolicy = previousExecutionInfo.InactivityTimeoutPolicy.Ptr()
+	}
 
 	req := &types.HistoryStartWorkflowExecutionRequest{
 		DomainUUID:                      e.domainEntry.GetInfo().ID,
@@ -1799,6 +1835,8 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	e.executionInfo.DecisionTimeout = 0
 
 	e.executionInfo.CronSchedule = event.GetCronSchedule()
+	e.executionInfo.InactivityTimeout = event.GetInactivityTimeoutSeconds()
+	e.executionInfo.InactivityTimeoutPolicy = event.GetInactivityTimeoutPolicy()
 
 	if parentDomainID != nil {
 		e.executionInfo.ParentDomainID = *parentDomainID
@@ -2020,7 +2058,24 @@ func (e *mutableStateBuilder) AddDecisionTaskCompletedEvent(
 	if err := e.checkMutability(opTag); err != nil {
 		return nil, err
 	}
-	return e.decisionTaskManager.AddDecisionTaskCompletedEvent(scheduleEventID, startedEventID, request, maxResetPoints)
+	wasInactive := e.executionInfo.Inactive
+	event, err := e.decisionTaskManager.AddDecisionTaskCompletedEvent(scheduleEventID, startedEventID, request, maxResetPoints)
+	if err != nil {
+		return nil, err
+	}
+	// TODO merge active & passive task generation
+	if wasInactive {
+		if err := e.taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+			return nil, err
+		}
+	}
+	if err := e.taskGenerator.GenerateWorkflowInactivityTimeoutTasks(
+		e.unixNanoToTime(event.GetTimestamp()),
+		event.Version,
+	); err != nil {
+		return nil, err
+	}
+	return event, nil
 }
 
 func (e *mutableStateBuilder) ReplicateDecisionTaskCompletedEvent(
@@ -2175,6 +2230,20 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 		metrics.WorkflowTypeTag(e.GetWorkflowType().Name),
 		metrics.TaskListTag(ai.TaskList))
 	taggedScope.IncCounter(metrics.DecisionTypeScheduleActivityDispatchCounter)
+	priority := int32(0)
+	if e.config.EnableTaskPriority(e.domainEntry.GetInfo().Name) {
+		var err error
+		if priority, err = GetActivityTaskPriority(ctx, e, scheduledEvent); err != nil {
+			return false
+		}
+	}
+	isolationGroup := ""
+	if e.config.EnableTaskIsolationGroups(e.domainEntry.GetInfo().Name) {
+		var err error
+		if isolationGroup, err = GetActivityTaskIsolationGroup(ctx, e, scheduledEvent); err != nil {
+			return false
+		}
+	}
 	err := e.shard.GetService().GetMatchingClient().AddActivityTask(ctx, &types.AddActivityTaskRequest{
 		DomainUUID:       e.executionInfo.DomainID,
 		SourceDomainUUID: e.domainEntry.GetInfo().ID,
@@ -2192,6 +2261,9 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 			WorkflowDomain:                  e.GetDomainEntry().GetInfo().Name,
 			ScheduledTimestampOfThisAttempt: common.Int64Ptr(ai.ScheduledTime.UnixNano()),
 		},
+		Priority:       priority,
+		LabelSelector:  GetActivityTaskLabelSelector(scheduledEvent),
+		IsolationGroup: isolationGroup,
 	})
 	if err == nil {
 		taggedScope.IncCounter(metrics.DecisionTypeScheduleActivityDispatchSucceedCounter)
@@ -2647,7 +2719,17 @@ func (e *mutableStateBuilder) AddFailWorkflowEvent(
 	}
 
 	event := e.hBuilder.AddFailWorkflowEvent(decisionCompletedEventID, attributes)
-	if err := e.ReplicateWorkflowExecutionFailedEvent(decisionCompletedEventID, event); err != nil {
+	firstEventID := decisionCompletedEventID
+	if firstEventID == common.EmptyEventID {
+		// the workflow is not failed by a decision, the event is written in the batch of the current transaction
+		for _, historyEvent := range e.hBuilder.history {
+			if historyEvent.ID != common.BufferedEventID {
+				firstEventID = historyEvent.ID
+				break
+			}
+		}
+	}
+	if err := e.ReplicateWorkflowExecutionFailedEvent(firstEventID, event); err != nil {
 		return nil, err
 	}
 	// TODO merge active & passive task generation
@@ -2994,6 +3076,36 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	e.executionInfo.SearchAttributes = mergeMapOfByteArray(currentSearchAttr, upsertSearchAttr)
 }
 
+// AddWorkflowSearchAttributesReappliedEvent merges search attributes upserted on another branch of the workflow.
+// Unlike an upsert, the event is not the result of a decision, so that it is not replayed as one by workers
+func (e *mutableStateBuilder) AddWorkflowSearchAttributesReappliedEvent(
+	searchAttributes *types.SearchAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionUpsertWorkflowSearchAttributes
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowSearchAttributesReappliedEvent(searchAttributes)
+	e.ReplicateWorkflowSearchAttributesReappliedEvent(event)
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowSearchAttributesReappliedEvent(
+	event *types.HistoryEvent,
+) {
+
+	reappliedSearchAttr := event.WorkflowSearchAttributesReappliedEventAttributes.GetSearchAttributes().GetIndexedFields()
+	currentSearchAttr := e.GetExecutionInfo().SearchAttributes
+
+	e.executionInfo.SearchAttributes = mergeMapOfByteArray(currentSearchAttr, reappliedSearchAttr)
+}
+
 func mergeMapOfByteArray(
 	current map[string][]byte,
 	upsert map[string][]byte,
@@ -3300,6 +3412,175 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTerminatedEvent(
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if e.executionInfo.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowState(e.executionInfo.State),
+			tag.Bool(e.executionInfo.Paused),
+		)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = true
+	return e.updatePausedSearchAttribute()
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if !e.executionInfo.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowState(e.executionInfo.State),
+			tag.Bool(e.executionInfo.Paused),
+		)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+		return nil, err
+	}
+	if err := e.taskGenerator.GenerateWorkflowUnpausedTasks(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = false
+
+	// activities are not dispatched while the workflow is paused, so the schedule of the
+	// ones which are still waiting to be started is restarted, along with their timeout timers
+	unpausedTime := time.Unix(0, event.GetTimestamp())
+	for _, activityInfo := range e.pendingActivityInfoIDs {
+		if activityInfo.StartedID != common.EmptyEventID || activityInfo.ScheduledTime.After(unpausedTime) {
+			continue
+		}
+		activityInfo.ScheduledTime = unpausedTime
+		activityInfo.TimerTaskStatus &^= TimerTaskStatusCreatedScheduleToStart | TimerTaskStatusCreatedScheduleToClose
+		if err := e.UpdateActivity(activityInfo); err != nil {
+			return err
+		}
+	}
+	return e.updatePausedSearchAttribute()
+}
+
+// updatePausedSearchAttribute records the pause state of the workflow as a search attribute,
+// so that paused workflows can be listed through visibility
+func (e *mutableStateBuilder) updatePausedSearchAttribute() error {
+	bytes, err := json.Marshal(e.executionInfo.Paused)
+	if err != nil {
+		return err
+	}
+	e.executionInfo.SearchAttributes = mergeMapOfByteArray(
+		e.executionInfo.SearchAttributes,
+		map[string][]byte{definition.Paused: bytes},
+	)
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionInactiveEvent() (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowInactive
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if e.executionInfo.InactivityTimeout <= 0 || e.executionInfo.Inactive {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowState(e.executionInfo.State),
+			tag.Bool(e.executionInfo.Inactive),
+		)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	// the completed event is always written right after the started event,
+	// see AddDecisionTaskCompletedEvent
+	lastDecisionTaskCompletedEventID := common.EmptyEventID
+	if e.executionInfo.LastProcessedEvent != common.EmptyEventID {
+		lastDecisionTaskCompletedEventID = e.executionInfo.LastProcessedEvent + 1
+	}
+	event := e.hBuilder.AddWorkflowExecutionInactiveEvent(
+		e.executionInfo.InactivityTimeout,
+		e.executionInfo.InactivityTimeoutPolicy,
+		lastDecisionTaskCompletedEventID,
+	)
+	if err := e.ReplicateWorkflowExecutionInactiveEvent(event); err != nil {
+		return nil, err
+	}
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionInactiveEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Inactive = true
+	return e.updateInactiveSearchAttribute()
+}
+
+// updateInactiveSearchAttribute records whether the workflow has exceeded its inactivity timeout
+// as a search attribute, so that inactive workflows can be listed through visibility
+func (e *mutableStateBuilder) updateInactiveSearchAttribute() error {
+	bytes, err := json.Marshal(e.executionInfo.Inactive)
+	if err != nil {
+		return err
+	}
+	e.executionInfo.SearchAttributes = mergeMapOfByteArray(
+		e.executionInfo.SearchAttributes,
+		map[string][]byte{definition.Inactive: bytes},
+	)
+	return nil
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 	signalName string,
 	input []byte,
@@ -3327,6 +3608,402 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateRequestedEvent(
+	updateID string,
+	request *types.UpdateWorkflowExecutionRequest,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateRequested
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateRequestedEvent(updateID, request)
+	if err := e.ReplicateWorkflowExecutionUpdateRequestedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateRequestedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	// the update is delivered with the history of the first decision task started after the event,
+	// if the event got buffered behind an in-flight decision, that is the decision after the in-flight one
+	afterEventID := event.ID
+	if afterEventID == common.BufferedEventID {
+		if decisionInfo, ok := e.GetInFlightDecision(); ok {
+			afterEventID = decisionInfo.StartedID
+		}
+	}
+
+	// an update which failed is requested again under the same ID
+	updateID := event.WorkflowExecutionUpdateRequestedEventAttributes.GetUpdateID()
+	updates := e.copyWorkflowUpdatesExcept(updateID)
+	e.executionInfo.WorkflowUpdates = append(updates, &types.WorkflowUpdateInfo{
+		UpdateID:                     updateID,
+		AfterEventID:                 afterEventID,
+		DecisionTaskCompletedEventID: common.EmptyEventID,
+		CompletedEventID:             common.EmptyEventID,
+	})
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	updateID string,
+	result *types.WorkflowUpdateResult,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateCompleted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if updateInfo, ok := e.GetWorkflowUpdateInfo(updateID); !ok || updateInfo.CompletedEventID != common.EmptyEventID {
+		e.logWarn(
+			mutableStateInvalidHistoryActionMsg,
+			opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(ok),
+			tag.UpdateID(updateID))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, updateID, result)
+	// Write the event to cache only on active cluster for answering retried update requests
+	e.eventsCache.PutEvent(
+		e.executionInfo.DomainID,
+		e.executionInfo.WorkflowID,
+		e.executionInfo.RunID,
+		event.ID,
+		event,
+	)
+	if err := e.ReplicateWorkflowExecutionUpdateCompletedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateCompletedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.WorkflowExecutionUpdateCompletedEventAttributes
+	afterEventID := common.EmptyEventID
+	if updateInfo, ok := e.GetWorkflowUpdateInfo(attributes.GetUpdateID()); ok {
+		afterEventID = updateInfo.AfterEventID
+	}
+	updates := append(e.copyWorkflowUpdatesExcept(attributes.GetUpdateID()), &types.WorkflowUpdateInfo{
+		UpdateID:                     attributes.GetUpdateID(),
+		AfterEventID:                 afterEventID,
+		DecisionTaskCompletedEventID: attributes.GetDecisionTaskCompletedEventID(),
+		CompletedEventID:             event.ID,
+	})
+
+	// only the results of the most recent updates are kept for deduplication
+	completed := 0
+	for _, updateInfo := range updates {
+		if updateInfo.CompletedEventID != common.EmptyEventID {
+			completed++
+		}
+	}
+	result := updates[:0]
+	for _, updateInfo := range updates {
+		if updateInfo.CompletedEventID != common.EmptyEventID && completed > e.config.MaxCompletedUpdateCount() {
+			completed--
+			continue
+		}
+		result = append(result, updateInfo)
+	}
+	e.executionInfo.WorkflowUpdates = result
+	return nil
+}
+
+// GetWorkflowUpdateInfo returns the update with the given ID, if it is pending or among the recently completed updates
+func (e *mutableStateBuilder) GetWorkflowUpdateInfo(
+	updateID string,
+) (*types.WorkflowUpdateInfo, bool) {
+
+	for _, updateInfo := range e.executionInfo.WorkflowUpdates {
+		if updateInfo.UpdateID == updateID {
+			return updateInfo, true
+		}
+	}
+	return nil, false
+}
+
+// GetPendingWorkflowUpdates returns the updates the workflow has not responded to yet
+func (e *mutableStateBuilder) GetPendingWorkflowUpdates() []*types.WorkflowUpdateInfo {
+
+	var result []*types.WorkflowUpdateInfo
+	for _, updateInfo := range e.executionInfo.WorkflowUpdates {
+		if updateInfo.CompletedEventID == common.EmptyEventID {
+			result = append(result, updateInfo)
+		}
+	}
+	return result
+}
+
+// GetWorkflowUpdateCompletedEvent returns the event recording the result of a completed update
+func (e *mutableStateBuilder) GetWorkflowUpdateCompletedEvent(
+	ctx context.Context,
+	updateID string,
+) (*types.HistoryEvent, error) {
+
+	updateInfo, ok := e.GetWorkflowUpdateInfo(updateID)
+	if !ok || updateInfo.CompletedEventID == common.EmptyEventID {
+		return nil, ErrMissingWorkflowUpdateInfo
+	}
+
+	currentBranchToken, err := e.GetCurrentBranchToken()
+	if err != nil {
+		return nil, err
+	}
+	completedEvent, err := e.eventsCache.GetEvent(
+		ctx,
+		e.shard.GetShardID(),
+		e.executionInfo.DomainID,
+		e.executionInfo.WorkflowID,
+		e.executionInfo.RunID,
+		updateInfo.DecisionTaskCompletedEventID,
+		updateInfo.CompletedEventID,
+		currentBranchToken,
+	)
+	if err != nil {
+		// do not return the original error
+		// since original error can be of type entity not exists
+		// which can be mistaken for the workflow not existing
+		return nil, ErrMissingWorkflowUpdateCompletedEvent
+	}
+	return completedEvent, nil
+}
+
+// DeleteWorkflowUpdate removes an update which failed without the workflow responding to it,
+// the failure is not recorded in history, so a retried request for the update requests it again
+func (e *mutableStateBuilder) DeleteWorkflowUpdate(
+	updateID string,
+) {
+
+	e.executionInfo.WorkflowUpdates = e.copyWorkflowUpdatesExcept(updateID)
+}
+
+// copyWorkflowUpdatesExcept copies the update list instead of modifying it in place,
+// as the list is shared with copies of the execution info
+func (e *mutableStateBuilder) copyWorkflowUpdatesExcept(
+	updateID string,
+) []*types.WorkflowUpdateInfo {
+
+	updates := make([]*types.WorkflowUpdateInfo, 0, len(e.executionInfo.WorkflowUpdates)+1)
+	for _, updateInfo := range e.executionInfo.WorkflowUpdates {
+		if updateInfo.UpdateID != updateID {
+			updates = append(updates, updateInfo)
+		}
+	}
+	return updates
+}
+
+func (e *mutableStateBuilder) AddWorkflowHistoryCompactedEvent(
+	ctx context.Context,
+	markerEvent *types.HistoryEvent,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowHistoryCompacted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	// the compaction event must directly follow a completed decision, so that the state
+	// provided by the workflow through the marker matches the snapshot of the mutable state
+	if e.HasPendingDecision() || e.HasBufferedEvents() || markerEvent.MarkerRecordedEventAttributes == nil {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowState(e.executionInfo.State),
+		)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	// events referenced by pending activities and child workflows are going to be trimmed,
+	// so they are kept in mutable state instead
+	for _, ai := range e.pendingActivityInfoIDs {
+		if ai.ScheduledEvent != nil {
+			continue
+		}
+		scheduledEvent, err := e.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		ai.ScheduledEvent = scheduledEvent
+		e.updateActivityInfos[ai.ScheduleID] = ai
+	}
+	for _, ci := range e.pendingChildExecutionInfoIDs {
+		if ci.InitiatedEvent != nil {
+			continue
+		}
+		initiatedEvent, err := e.GetChildExecutionInitiatedEvent(ctx, ci.InitiatedID)
+		if err != nil {
+			return nil, err
+		}
+		ci.InitiatedEvent = initiatedEvent
+		e.updateChildExecutionInfos[ci.InitiatedID] = ci
+	}
+
+	snapshot, err := encodeHistoryCompactionSnapshot(
+		e.CopyToPersistence(),
+		e.config.HistoryCompactionSnapshotSizeLimit(e.GetDomainEntry().GetInfo().Name),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowHistoryCompactedEvent(markerEvent, snapshot)
+	if err := e.updateHistoryCompaction(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowHistoryCompactedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	snapshot, err := decodeHistoryCompactionSnapshot(event.WorkflowHistoryCompactedEventAttributes.MutableStateSnapshot)
+	if err != nil {
+		return err
+	}
+
+	// the workflow being rebuilt can be a different run (e.g. after reset) on a different branch,
+	// so its identity and history branch are kept, everything else comes from the snapshot
+	executionInfo := snapshot.ExecutionInfo
+	executionInfo.DomainID = e.executionInfo.DomainID
+	executionInfo.WorkflowID = e.executionInfo.WorkflowID
+	executionInfo.RunID = e.executionInfo.RunID
+	executionInfo.CreateRequestID = e.executionInfo.CreateRequestID
+	executionInfo.BranchToken = e.executionInfo.BranchToken
+	executionInfo.LastEventTaskID = event.TaskID
+	e.executionInfo = executionInfo
+
+	for scheduleID := range e.pendingActivityInfoIDs {
+		if _, ok := snapshot.ActivityInfos[scheduleID]; !ok {
+			e.deleteActivityInfos[scheduleID] = struct{}{}
+			delete(e.updateActivityInfos, scheduleID)
+		}
+	}
+	e.pendingActivityInfoIDs = make(map[int64]*persistence.ActivityInfo)
+	e.pendingActivityIDToEventID = make(map[string]int64)
+	for scheduleID, ai := range snapshot.ActivityInfos {
+		e.pendingActivityInfoIDs[scheduleID] = ai
+		e.pendingActivityIDToEventID[ai.ActivityID] = scheduleID
+		e.updateActivityInfos[scheduleID] = ai
+	}
+
+	for timerID := range e.pendingTimerInfoIDs {
+		if _, ok := snapshot.TimerInfos[timerID]; !ok {
+			e.deleteTimerInfos[timerID] = struct{}{}
+			delete(e.updateTimerInfos, timerID)
+		}
+	}
+	e.pendingTimerInfoIDs = make(map[string]*persistence.TimerInfo)
+	e.pendingTimerEventIDToID = make(map[int64]string)
+	for timerID, ti := range snapshot.TimerInfos {
+		e.pendingTimerInfoIDs[timerID] = ti
+		e.pendingTimerEventIDToID[ti.StartedID] = timerID
+		e.updateTimerInfos[timerID] = ti
+	}
+
+	for initiatedID := range e.pendingChildExecutionInfoIDs {
+		if _, ok := snapshot.ChildExecutionInfos[initiatedID]; !ok {
+			e.deleteChildExecutionInfos[initiatedID] = struct{}{}
+			delete(e.updateChildExecutionInfos, initiatedID)
+		}
+	}
+	e.pendingChildExecutionInfoIDs = make(map[int64]*persistence.ChildExecutionInfo)
+	for initiatedID, ci := range snapshot.ChildExecutionInfos {
+		e.pendingChildExecutionInfoIDs[initiatedID] = ci
+		e.updateChildExecutionInfos[initiatedID] = ci
+	}
+
+	for initiatedID := range e.pendingRequestCancelInfoIDs {
+		if _, ok := snapshot.RequestCancelInfos[initiatedID]; !ok {
+			e.deleteRequestCancelInfos[initiatedID] = struct{}{}
+			delete(e.updateRequestCancelInfos, initiatedID)
+		}
+	}
+	e.pendingRequestCancelInfoIDs = make(map[int64]*persistence.RequestCancelInfo)
+	for initiatedID, rci := range snapshot.RequestCancelInfos {
+		e.pendingRequestCancelInfoIDs[initiatedID] = rci
+		e.updateRequestCancelInfos[initiatedID] = rci
+	}
+
+	for initiatedID := range e.pendingSignalInfoIDs {
+		if _, ok := snapshot.SignalInfos[initiatedID]; !ok {
+			e.deleteSignalInfos[initiatedID] = struct{}{}
+			delete(e.updateSignalInfos, initiatedID)
+		}
+	}
+	e.pendingSignalInfoIDs = make(map[int64]*persistence.SignalInfo)
+	for initiatedID, si := range snapshot.SignalInfos {
+		e.pendingSignalInfoIDs[initiatedID] = si
+		e.updateSignalInfos[initiatedID] = si
+	}
+
+	for requestID := range e.pendingSignalRequestedIDs {
+		if _, ok := snapshot.SignalRequestedIDs[requestID]; !ok {
+			e.deleteSignalRequestedIDs[requestID] = struct{}{}
+			delete(e.updateSignalRequestedIDs, requestID)
+		}
+	}
+	e.pendingSignalRequestedIDs = make(map[string]struct{})
+	for requestID := range snapshot.SignalRequestedIDs {
+		e.pendingSignalRequestedIDs[requestID] = struct{}{}
+		e.updateSignalRequestedIDs[requestID] = struct{}{}
+	}
+
+	return e.updateHistoryCompaction(event)
+}
+
+func (e *mutableStateBuilder) GetHistoryCompactionNodeID() (int64, error) {
+	currentBranchToken, err := e.GetCurrentBranchToken()
+	if err != nil {
+		return 0, err
+	}
+	return persistence.GetHistoryBranchCompactionNodeID(currentBranchToken)
+}
+
+// updateHistoryCompaction records the compaction on the current branch
+// and drops the state which refers to the compacted events
+func (e *mutableStateBuilder) updateHistoryCompaction(
+	event *types.HistoryEvent,
+) error {
+
+	currentBranchToken, err := e.GetCurrentBranchToken()
+	if err != nil {
+		return err
+	}
+	compactedBranchToken, err := persistence.NewHistoryBranchTokenWithCompaction(currentBranchToken, event.ID)
+	if err != nil {
+		return err
+	}
+	if err := e.SetCurrentBranchToken(compactedBranchToken); err != nil {
+		return err
+	}
+
+	// workflow can not be reset to a point before the compaction
+	if e.executionInfo.AutoResetPoints != nil {
+		var points []*types.ResetPointInfo
+		for _, point := range e.executionInfo.AutoResetPoints.Points {
+			if point.GetFirstDecisionCompletedID() > event.ID {
+				points = append(points, point)
+			}
+		}
+		e.executionInfo.AutoResetPoints.Points = points
+	}
+	return nil
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	ctx context.Context,
 	firstEventID int64,
@@ -3902,7 +4579,8 @@ func (e *mutableStateBuilder) RetryActivity(
 		return false, nil
 	}
 
-	// a retry is needed, update activity info for next retry
+	// a retry is needed, record the finished attempt and update activity info for next retry
+	e.appendActivityAttemptLog(ai, now, failureReason, failureDetails)
 	ai.Version = e.GetCurrentVersion()
 	ai.Attempt++
 	ai.ScheduledTime = now.Add(backoffInterval) // update to next schedule time
@@ -3925,6 +4603,55 @@ func (e *mutableStateBuilder) RetryActivity(
 	return true, nil
 }
 
+func (e *mutableStateBuilder) appendActivityAttemptLog(
+	ai *persistence.ActivityInfo,
+	finishedTime time.Time,
+	failureReason string,
+	failureDetails []byte,
+) {
+
+	domainName := e.GetDomainEntry().GetInfo().Name
+	maxSize := e.config.ActivityAttemptLogMaxSize(domainName)
+	if maxSize <= 0 {
+		return
+	}
+	detailsSizeLimit := e.config.ActivityAttemptLogDetailsSizeLimit(domainName)
+	sizeLimit := e.config.ActivityAttemptLogSizeLimit(domainName)
+
+	attempt := &types.ActivityAttemptInfo{
+		Attempt:           ai.Attempt,
+		FinishedTimestamp: finishedTime.UnixNano(),
+		Identity:          ai.StartedIdentity,
+		FailureReason:     failureReason,
+	}
+	attempt.FailureDetails, attempt.FailureDetailsTruncated = limitAttemptDetails(failureDetails, detailsSizeLimit)
+	attempt.HeartbeatDetails, attempt.HeartbeatDetailsTruncated = limitAttemptDetails(ai.Details, detailsSizeLimit)
+	if !ai.StartedTime.IsZero() {
+		attempt.StartedTimestamp = ai.StartedTime.UnixNano()
+	}
+
+	// always build a new slice so copies of the activity info sharing the old log are not affected
+	attemptLog := make([]*types.ActivityAttemptInfo, 0, len(ai.AttemptLog)+1)
+	attemptLog = append(attemptLog, ai.AttemptLog...)
+	attemptLog = append(attemptLog, attempt)
+	if len(attemptLog) > maxSize {
+		attemptLog = attemptLog[len(attemptLog)-maxSize:]
+	}
+	// drop the oldest attempts until the log fits into its size limit
+	size := 0
+	for i := len(attemptLog) - 1; i >= 0; i-- {
+		size += attemptInfoSize(attemptLog[i])
+		if size > sizeLimit {
+			attemptLog = attemptLog[i+1:]
+			break
+		}
+	}
+	if len(attemptLog) == 0 {
+		attemptLog = nil
+	}
+	ai.AttemptLog = attemptLog
+}
+
 // TODO mutable state should generate corresponding transfer / timer tasks according to
 //  updates accumulated, while currently all transfer / timer tasks are managed manually
 
@@ -21,6 +21,8 @@
 package execution
 
 import (
+	"fmt"
+	"context"
 	"testing"
 	"time"
 
@@ -188,6 +190,7 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		types.EventTypeWorkflowExecutionTerminated:     true,
 		types.EventTypeWorkflowExecutionContinuedAsNew: true,
 		types.EventTypeWorkflowExecutionCanceled:       true,
+		types.EventTypeWorkflowHistoryCompacted:        true,
 	}
 
 	// decision events will be assign event ID immediately
@@ -197,6 +200,8 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		types.EventTypeDecisionTaskCompleted: true,
 		types.EventTypeDecisionTaskFailed:    true,
 		types.EventTypeDecisionTaskTimedOut:  true,
+		// update results are part of the decision task completion
+		types.EventTypeWorkflowExecutionUpdateCompleted: true,
 	}
 
 	// events corresponding to decisions from client will be assign event ID immediately
@@ -789,6 +794,466 @@ func (s *mutableStateSuite) prepareTransientDecisionCompletionFirstBatchReplicat
 	return newDecisionScheduleEvent, newDecisionStartedEvent
 }
 
+func (s *mutableStateSuite) TestPauseAndUnpauseWorkflowExecution() {
+	version := int64(2000)
+	runID := uuid.New()
+	s.msBuilder = NewMutableStateBuilderWithVersionHistoriesWithEventV2(
+		s.mockShard,
+		s.logger,
+		version,
+		runID,
+		constants.TestGlobalDomainEntry,
+	).(*mutableStateBuilder)
+	_, _ = s.prepareTransientDecisionCompletionFirstBatchReplicated(version, runID)
+	err := s.msBuilder.UpdateCurrentVersion(version, true)
+	s.NoError(err)
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+
+	_, err = s.msBuilder.AddWorkflowExecutionUnpausedEvent("some reason", "some identity")
+	s.Error(err)
+
+	event, err := s.msBuilder.AddWorkflowExecutionPausedEvent("some reason", "some identity")
+	s.NoError(err)
+	s.Equal(types.EventTypeWorkflowExecutionPaused, event.GetEventType())
+	s.Equal("some reason", event.WorkflowExecutionPausedEventAttributes.GetReason())
+	s.Equal("some identity", event.WorkflowExecutionPausedEventAttributes.GetIdentity())
+	s.True(s.msBuilder.IsWorkflowExecutionPaused())
+	s.Equal([]byte("true"), s.msBuilder.GetExecutionInfo().SearchAttributes[definition.Paused])
+
+	_, err = s.msBuilder.AddWorkflowExecutionPausedEvent("some reason", "some identity")
+	s.Error(err)
+
+	event, err = s.msBuilder.AddWorkflowExecutionUnpausedEvent("other reason", "other identity")
+	s.NoError(err)
+	s.Equal(types.EventTypeWorkflowExecutionUnpaused, event.GetEventType())
+	s.Equal("other reason", event.WorkflowExecutionUnpausedEventAttributes.GetReason())
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+	s.Equal([]byte("false"), s.msBuilder.GetExecutionInfo().SearchAttributes[definition.Paused])
+}
+
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionUnpausedEvent_RestartsActivitySchedule() {
+	version := int64(2000)
+	runID := uuid.New()
+	s.msBuilder = NewMutableStateBuilderWithVersionHistoriesWithEventV2(
+		s.mockShard,
+		s.logger,
+		version,
+		runID,
+		constants.TestGlobalDomainEntry,
+	).(*mutableStateBuilder)
+	s.msBuilder.executionInfo.Paused = true
+
+	unpausedTime := time.Now()
+	scheduledTime := unpausedTime.Add(-time.Hour)
+	backoffTime := unpausedTime.Add(time.Hour)
+	s.msBuilder.pendingActivityInfoIDs = map[int64]*persistence.ActivityInfo{
+		5: {
+			ScheduleID:      5,
+			ScheduledTime:   scheduledTime,
+			StartedID:       common.EmptyEventID,
+			TimerTaskStatus: TimerTaskStatusCreatedScheduleToStart | TimerTaskStatusCreatedScheduleToClose,
+		},
+		6: {
+			ScheduleID:      6,
+			ScheduledTime:   scheduledTime,
+			StartedID:       7,
+			TimerTaskStatus: TimerTaskStatusCreatedStartToClose,
+		},
+		8: {
+			ScheduleID:      8,
+			ScheduledTime:   backoffTime,
+			StartedID:       common.EmptyEventID,
+			TimerTaskStatus: TimerTaskStatusNone,
+		},
+	}
+
+	err := s.msBuilder.ReplicateWorkflowExecutionUnpausedEvent(&types.HistoryEvent{
+		ID:        10,
+		Timestamp: common.Int64Ptr(unpausedTime.UnixNano()),
+		EventType: types.EventTypeWorkflowExecutionUnpaused.Ptr(),
+	})
+	s.NoError(err)
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+
+	s.Equal(unpausedTime.UnixNano(), s.msBuilder.pendingActivityInfoIDs[5].ScheduledTime.UnixNano())
+	s.Equal(int32(TimerTaskStatusNone), s.msBuilder.pendingActivityInfoIDs[5].TimerTaskStatus)
+	s.Contains(s.msBuilder.updateActivityInfos, int64(5))
+
+	s.Equal(scheduledTime, s.msBuilder.pendingActivityInfoIDs[6].ScheduledTime)
+	s.Equal(int32(TimerTaskStatusCreatedStartToClose), s.msBuilder.pendingActivityInfoIDs[6].TimerTaskStatus)
+	s.Equal(backoffTime, s.msBuilder.pendingActivityInfoIDs[8].ScheduledTime)
+	s.NotContains(s.msBuilder.updateActivityInfos, int64(6))
+	s.NotContains(s.msBuilder.updateActivityInfos, int64(8))
+}
+
+func (s *mutableStateSuite) TestWorkflowExecutionInactive() {
+	version := int64(2000)
+	runID := uuid.New()
+	s.msBuilder = NewMutableStateBuilderWithVersionHistoriesWithEventV2(
+		s.mockShard,
+		s.logger,
+		version,
+		runID,
+		constants.TestGlobalDomainEntry,
+	).(*mutableStateBuilder)
+	_, _ = s.prepareTransientDecisionCompletionFirstBatchReplicated(version, runID)
+	err := s.msBuilder.UpdateCurrentVersion(version, true)
+	s.NoError(err)
+
+	// inactivity timeout is not enabled for the workflow
+	_, err = s.msBuilder.AddWorkflowExecutionInactiveEvent()
+	s.Error(err)
+
+	executionInfo := s.msBuilder.GetExecutionInfo()
+	executionInfo.InactivityTimeout = 10
+	executionInfo.InactivityTimeoutPolicy = types.InactivityTimeoutPolicyNotify
+	s.Equal(common.EmptyEventID, executionInfo.LastProcessedEvent)
+
+	event, err := s.msBuilder.AddWorkflowExecutionInactiveEvent()
+	s.NoError(err)
+	s.Equal(types.EventTypeWorkflowExecutionInactive, event.GetEventType())
+	s.Equal(int32(10), event.WorkflowExecutionInactiveEventAttributes.GetInactivityTimeoutSeconds())
+	s.Equal(types.InactivityTimeoutPolicyNotify, event.WorkflowExecutionInactiveEventAttributes.GetInactivityTimeoutPolicy())
+	s.Equal(common.EmptyEventID, event.WorkflowExecutionInactiveEventAttributes.GetLastDecisionTaskCompletedEventID())
+	s.True(executionInfo.Inactive)
+	s.Equal([]byte("true"), executionInfo.SearchAttributes[definition.Inactive])
+
+	_, err = s.msBuilder.AddWorkflowExecutionInactiveEvent()
+	s.Error(err)
+
+	// a completed decision clears the inactive flag and restarts the inactivity window
+	err = s.msBuilder.ReplicateDecisionTaskCompletedEvent(&types.HistoryEvent{
+		ID:        event.ID + 2,
+		Timestamp: common.Int64Ptr(1234),
+		Version:   version,
+		EventType: types.EventTypeDecisionTaskCompleted.Ptr(),
+		DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{
+			StartedEventID: event.ID + 1,
+		},
+	})
+	s.NoError(err)
+	s.False(executionInfo.Inactive)
+	s.Equal(event.ID+1, executionInfo.LastProcessedEvent)
+	s.Equal(int64(1234), executionInfo.LastDecisionCompletedTimestamp)
+	s.Equal([]byte("false"), executionInfo.SearchAttributes[definition.Inactive])
+}
+
+func (s *mutableStateSuite) TestAddWorkflowExecutionUpdateRequestedEvent() {
+	version := int64(2000)
+	runID := uuid.New()
+	s.msBuilder = NewMutableStateBuilderWithVersionHistoriesWithEventV2(
+		s.mockShard,
+		s.logger,
+		version,
+		runID,
+		constants.TestGlobalDomainEntry,
+	).(*mutableStateBuilder)
+	_, _ = s.prepareTransientDecisionCompletionFirstBatchReplicated(version, runID)
+	err := s.msBuilder.UpdateCurrentVersion(version, true)
+	s.NoError(err)
+
+	event, err := s.msBuilder.AddWorkflowExecutionUpdateRequestedEvent("some update ID", &types.UpdateWorkflowExecutionRequest{
+		UpdateName: "some update name",
+		Input:      []byte("some input"),
+		Identity:   "some identity",
+		RequestID:  "some request ID",
+	})
+	s.NoError(err)
+	s.Equal(types.EventTypeWorkflowExecutionUpdateRequested, event.GetEventType())
+	s.Equal(&types.WorkflowExecutionUpdateRequestedEventAttributes{
+		UpdateID:   "some update ID",
+		UpdateName: "some update name",
+		Input:      []byte("some input"),
+		Identity:   "some identity",
+		RequestID:  "some request ID",
+	}, event.WorkflowExecutionUpdateRequestedEventAttributes)
+}
+
+func (s *mutableStateSuite) TestAddWorkflowExecutionUpdateCompletedEvent() {
+	version := int64(2000)
+	runID := uuid.New()
+	s.msBuilder = NewMutableStateBuilderWithVersionHistoriesWithEventV2(
+		s.mockShard,
+		s.logger,
+		version,
+		runID,
+		constants.TestGlobalDomainEntry,
+	).(*mutableStateBuilder)
+	_, _ = s.prepareTransientDecisionCompletionFirstBatchReplicated(version, runID)
+	err := s.msBuilder.UpdateCurrentVersion(version, true)
+	s.NoError(err)
+
+	result := &types.WorkflowUpdateResult{
+		ResultType: types.QueryResultTypeAnswered.Ptr(),
+		Result:     []byte("some result"),
+	}
+	// the update was not requested
+	_, err = s.msBuilder.AddWorkflowExecutionUpdateCompletedEvent(123, "some update ID", result)
+	s.Error(err)
+
+	// the update requested while the decision is in flight is delivered with the next decision task
+	_, err = s.msBuilder.AddWorkflowExecutionUpdateRequestedEvent("some update ID", &types.UpdateWorkflowExecutionRequest{})
+	s.NoError(err)
+	decisionInfo, ok := s.msBuilder.GetInFlightDecision()
+	s.True(ok)
+	s.Equal([]*types.WorkflowUpdateInfo{{
+		UpdateID:                     "some update ID",
+		AfterEventID:                 decisionInfo.StartedID,
+		DecisionTaskCompletedEventID: common.EmptyEventID,
+		CompletedEventID:             common.EmptyEventID,
+	}}, s.msBuilder.GetPendingWorkflowUpdates())
+
+	completedEvent, err := s.msBuilder.AddDecisionTaskCompletedEvent(decisionInfo.ScheduleID, decisionInfo.StartedID, &types.RespondDecisionTaskCompletedRequest{}, 0)
+	s.NoError(err)
+	s.mockEventsCache.EXPECT().PutEvent(
+		constants.TestDomainID, gomock.Any(), runID, completedEvent.ID+1, gomock.Any(),
+	).Times(1)
+	event, err := s.msBuilder.AddWorkflowExecutionUpdateCompletedEvent(completedEvent.ID, "some update ID", result)
+	s.NoError(err)
+	s.Equal(completedEvent.ID+1, event.ID)
+	s.Equal(types.EventTypeWorkflowExecutionUpdateCompleted, event.GetEventType())
+	s.Equal(&types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     "some update ID",
+		DecisionTaskCompletedEventID: completedEvent.ID,
+		Result:                       []byte("some result"),
+	}, event.WorkflowExecutionUpdateCompletedEventAttributes)
+	s.Empty(s.msBuilder.GetPendingWorkflowUpdates())
+	updateInfo, ok := s.msBuilder.GetWorkflowUpdateInfo("some update ID")
+	s.True(ok)
+	s.Equal(&types.WorkflowUpdateInfo{
+		UpdateID:                     "some update ID",
+		AfterEventID:                 decisionInfo.StartedID,
+		DecisionTaskCompletedEventID: completedEvent.ID,
+		CompletedEventID:             event.ID,
+	}, updateInfo)
+
+	// an update is only completed once
+	_, err = s.msBuilder.AddWorkflowExecutionUpdateCompletedEvent(completedEvent.ID, "some update ID", result)
+	s.Error(err)
+}
+
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionUpdateCompletedEvent_PrunesCompletedUpdates() {
+	s.msBuilder.config.MaxCompletedUpdateCount = dynamicconfig.GetIntPropertyFn(2)
+	s.msBuilder.executionInfo.WorkflowUpdates = []*types.WorkflowUpdateInfo{
+		{UpdateID: "completed-1", AfterEventID: 1, DecisionTaskCompletedEventID: 4, CompletedEventID: 5},
+		{UpdateID: "pending", AfterEventID: 6, DecisionTaskCompletedEventID: common.EmptyEventID, CompletedEventID: common.EmptyEventID},
+		{UpdateID: "completed-2", AfterEventID: 2, DecisionTaskCompletedEventID: 8, CompletedEventID: 9},
+		{UpdateID: "completing", AfterEventID: 7, DecisionTaskCompletedEventID: common.EmptyEventID, CompletedEventID: common.EmptyEventID},
+	}
+	updates := s.msBuilder.executionInfo.WorkflowUpdates
+
+	err := s.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(&types.HistoryEvent{
+		ID:        13,
+		EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+		WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+			UpdateID:                     "completing",
+			DecisionTaskCompletedEventID: 12,
+		},
+	})
+	s.NoError(err)
+	s.Equal([]*types.WorkflowUpdateInfo{
+		{UpdateID: "pending", AfterEventID: 6, DecisionTaskCompletedEventID: common.EmptyEventID, CompletedEventID: common.EmptyEventID},
+		{UpdateID: "completed-2", AfterEventID: 2, DecisionTaskCompletedEventID: 8, CompletedEventID: 9},
+		{UpdateID: "completing", AfterEventID: 7, DecisionTaskCompletedEventID: 12, CompletedEventID: 13},
+	}, s.msBuilder.executionInfo.WorkflowUpdates)
+	// the list is shared with copies of the execution info and is not modified in place
+	s.Equal("completed-1", updates[0].UpdateID)
+	s.Equal(common.EmptyEventID, updates[3].CompletedEventID)
+
+	s.msBuilder.DeleteWorkflowUpdate("pending")
+	s.Empty(s.msBuilder.GetPendingWorkflowUpdates())
+	s.Len(s.msBuilder.executionInfo.WorkflowUpdates, 2)
+}
+
+func (s *mutableStateSuite) TestWorkflowHistoryCompaction() {
+	branchToken, err := persistence.NewHistoryBranchToken(constants.TestRunID)
+	s.NoError(err)
+	mutableState := s.buildWorkflowMutableState()
+	mutableState.BufferedEvents = nil
+	mutableState.VersionHistories.Histories[0].BranchToken = branchToken
+	mutableState.ActivityInfos[5].ScheduledEvent = &types.HistoryEvent{ID: 90}
+	mutableState.ExecutionInfo.AutoResetPoints = &types.ResetPoints{
+		Points: []*types.ResetPointInfo{
+			{BinaryChecksum: "checksum-1", FirstDecisionCompletedID: 4},
+			{BinaryChecksum: "checksum-2", FirstDecisionCompletedID: 104},
+		},
+	}
+	s.msBuilder.Load(mutableState)
+	s.NoError(s.msBuilder.UpdateCurrentVersion(int64(300), true))
+
+	markerEvent := &types.HistoryEvent{
+		ID:        99,
+		EventType: types.EventTypeMarkerRecorded.Ptr(),
+		MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+			MarkerName: common.HistoryCompactionMarkerName,
+			Details:    []byte("some workflow state"),
+		},
+	}
+	event, err := s.msBuilder.AddWorkflowHistoryCompactedEvent(context.Background(), markerEvent)
+	s.NoError(err)
+	s.Equal(types.EventTypeWorkflowHistoryCompacted, event.GetEventType())
+	s.Equal(int64(101), event.ID)
+	attributes := event.WorkflowHistoryCompactedEventAttributes
+	s.Equal(common.HistoryCompactionMarkerName, attributes.MarkerName)
+	s.Equal([]byte("some workflow state"), attributes.MarkerDetails)
+	s.Equal(int64(99), attributes.MarkerEventID)
+	s.Equal(types.EncodingTypeJSON, attributes.MutableStateSnapshot.GetEncodingType())
+	snapshot, err := decodeHistoryCompactionSnapshot(attributes.MutableStateSnapshot)
+	s.NoError(err)
+	s.Equal(historyCompactionSnapshotVersion, snapshot.Version)
+	s.Empty(snapshot.ExecutionInfo.DomainID)
+	s.Empty(snapshot.ExecutionInfo.RunID)
+	s.Empty(snapshot.ExecutionInfo.CreateRequestID)
+	s.Nil(snapshot.ExecutionInfo.BranchToken)
+
+	compactionNodeID, err := s.msBuilder.GetHistoryCompactionNodeID()
+	s.NoError(err)
+	s.Equal(int64(101), compactionNodeID)
+	s.Equal(1, len(s.msBuilder.GetExecutionInfo().AutoResetPoints.Points))
+	s.Equal("checksum-2", s.msBuilder.GetExecutionInfo().AutoResetPoints.Points[0].BinaryChecksum)
+
+	// rebuild another run from the compaction event
+	rebuiltState := s.buildWorkflowMutableState()
+	rebuiltState.BufferedEvents = nil
+	rebuiltState.ExecutionInfo.RunID = uuid.New()
+	rebuiltState.VersionHistories.Histories[0].BranchToken = branchToken
+	rebuiltState.ActivityInfos[6] = &persistence.ActivityInfo{ScheduleID: 6, ActivityID: "activityID_6"}
+	rebuilt := newMutableStateBuilder(s.mockShard, s.logger, constants.TestLocalDomainEntry)
+	rebuilt.Load(rebuiltState)
+
+	err = rebuilt.ReplicateWorkflowHistoryCompactedEvent(event)
+	s.NoError(err)
+	s.Equal(rebuiltState.ExecutionInfo.RunID, rebuilt.GetExecutionInfo().RunID)
+	s.Equal(int64(101), rebuilt.GetExecutionInfo().NextEventID)
+	s.Equal(1, len(rebuilt.GetExecutionInfo().AutoResetPoints.Points))
+	s.Equal(1, len(rebuilt.pendingActivityInfoIDs))
+	s.Equal(int64(90), rebuilt.pendingActivityInfoIDs[5].ScheduledEvent.ID)
+	s.Contains(rebuilt.deleteActivityInfos, int64(6))
+	s.Equal(len(mutableState.TimerInfos), len(rebuilt.pendingTimerInfoIDs))
+	s.Equal(len(mutableState.ChildExecutionInfos), len(rebuilt.pendingChildExecutionInfoIDs))
+	s.Equal(len(mutableState.SignalInfos), len(rebuilt.pendingSignalInfoIDs))
+	s.Equal(mutableState.SignalRequestedIDs, rebuilt.pendingSignalRequestedIDs)
+	compactionNodeID, err = rebuilt.GetHistoryCompactionNodeID()
+	s.NoError(err)
+	s.Equal(int64(101), compactionNodeID)
+}
+
+func (s *mutableStateSuite) TestWorkflowHistoryCompaction_SnapshotTooLarge() {
+	s.mockShard.GetConfig().HistoryCompactionSnapshotSizeLimit = func(domain string) int { return 10 }
+	branchToken, err := persistence.NewHistoryBranchToken(constants.TestRunID)
+	s.NoError(err)
+	mutableState := s.buildWorkflowMutableState()
+	mutableState.BufferedEvents = nil
+	mutableState.VersionHistories.Histories[0].BranchToken = branchToken
+	mutableState.ActivityInfos[5].ScheduledEvent = &types.HistoryEvent{ID: 90}
+	s.msBuilder.Load(mutableState)
+	s.NoError(s.msBuilder.UpdateCurrentVersion(int64(300), true))
+
+	_, err = s.msBuilder.AddWorkflowHistoryCompactedEvent(context.Background(), &types.HistoryEvent{
+		ID:        99,
+		EventType: types.EventTypeMarkerRecorded.Ptr(),
+		MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+			MarkerName: common.HistoryCompactionMarkerName,
+			Details:    []byte("some workflow state"),
+		},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+	compactionNodeID, err := s.msBuilder.GetHistoryCompactionNodeID()
+	s.NoError(err)
+	s.Zero(compactionNodeID)
+}
+
+func (s *mutableStateSuite) TestWorkflowHistoryCompaction_UnknownSnapshotVersion() {
+	_, err := decodeHistoryCompactionSnapshot(&types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         []byte(`{"version":2,"executionInfo":{}}`),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *mutableStateSuite) TestRetryActivity_AttemptLog() {
+	s.mockShard.GetConfig().ActivityAttemptLogMaxSize = func(domain string) int { return 2 }
+	mutableState := s.buildWorkflowMutableState()
+	s.msBuilder.Load(mutableState)
+	s.NoError(s.msBuilder.UpdateCurrentVersion(int64(300), true))
+
+	ai := s.msBuilder.pendingActivityInfoIDs[5]
+	ai.ScheduleID = 5
+	ai.HasRetryPolicy = true
+	ai.InitialInterval = 1
+	ai.BackoffCoefficient = 2
+	ai.MaximumInterval = 10
+	ai.ExpirationTime = time.Now().Add(time.Hour)
+	ai.Details = []byte("some heartbeat details")
+
+	for attempt := int32(0); attempt < 3; attempt++ {
+		ai.StartedTime = time.Now()
+		ai.StartedIdentity = fmt.Sprintf("worker-%v", attempt)
+		retried, err := s.msBuilder.RetryActivity(ai, "some reason", []byte("some details"))
+		s.NoError(err)
+		s.True(retried)
+	}
+
+	s.Equal(int32(3), ai.Attempt)
+	s.Equal(2, len(ai.AttemptLog))
+	for i, attempt := range ai.AttemptLog {
+		s.Equal(int32(i+1), attempt.Attempt)
+		s.Equal(fmt.Sprintf("worker-%v", i+1), attempt.Identity)
+		s.Equal("some reason", attempt.FailureReason)
+		s.Equal([]byte("some details"), attempt.FailureDetails)
+		s.Equal([]byte("some heartbeat details"), attempt.HeartbeatDetails)
+		s.False(attempt.FailureDetailsTruncated)
+		s.False(attempt.HeartbeatDetailsTruncated)
+		s.NotZero(attempt.StartedTimestamp)
+		s.NotZero(attempt.FinishedTimestamp)
+	}
+
+	// disabling the attempt log keeps the existing entries but stops recording new attempts
+	s.mockShard.GetConfig().ActivityAttemptLogMaxSize = func(domain string) int { return 0 }
+	retried, err := s.msBuilder.RetryActivity(ai, "some reason", nil)
+	s.NoError(err)
+	s.True(retried)
+	s.Equal(2, len(ai.AttemptLog))
+	s.Equal(int32(2), ai.AttemptLog[1].Attempt)
+}
+
+func (s *mutableStateSuite) TestRetryActivity_AttemptLogSizeLimit() {
+	s.mockShard.GetConfig().ActivityAttemptLogMaxSize = func(domain string) int { return 10 }
+	s.mockShard.GetConfig().ActivityAttemptLogDetailsSizeLimit = func(domain string) int { return 12 }
+	s.mockShard.GetConfig().ActivityAttemptLogSizeLimit = func(domain string) int { return 120 }
+	mutableState := s.buildWorkflowMutableState()
+	s.msBuilder.Load(mutableState)
+	s.NoError(s.msBuilder.UpdateCurrentVersion(int64(300), true))
+
+	ai := s.msBuilder.pendingActivityInfoIDs[5]
+	ai.ScheduleID = 5
+	ai.HasRetryPolicy = true
+	ai.InitialInterval = 1
+	ai.BackoffCoefficient = 2
+	ai.MaximumInterval = 10
+	ai.ExpirationTime = time.Now().Add(time.Hour)
+	ai.Details = []byte("some heartbeat details")
+
+	for attempt := int32(0); attempt < 3; attempt++ {
+		ai.StartedTime = time.Now()
+		ai.StartedIdentity = fmt.Sprintf("worker-%v", attempt)
+		retried, err := s.msBuilder.RetryActivity(ai, "some reason", []byte("some details"))
+		s.NoError(err)
+		s.True(retried)
+	}
+
+	// oversized details are dropped and only the latest attempts fitting into the size limit are kept
+	s.Equal(2, len(ai.AttemptLog))
+	for i, attempt := range ai.AttemptLog {
+		s.Equal(int32(i+1), attempt.Attempt)
+		s.Equal([]byte("some details"), attempt.FailureDetails)
+		s.False(attempt.FailureDetailsTruncated)
+		s.Nil(attempt.HeartbeatDetails)
+		s.True(attempt.HeartbeatDetailsTruncated)
+	}
+	s.Equal([]byte("some heartbeat details"), ai.Details)
+}
+
 func (s *mutableStateSuite) TestLoad_BackwardsCompatibility() {
 	mutableState := s.buildWorkflowMutableState()
 
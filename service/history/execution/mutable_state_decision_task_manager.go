@@ -834,6 +834,14 @@ func (m *mutableStateDecisionTaskManagerImpl) afterAddDecisionTaskCompletedEvent
 	maxResetPoints int,
 ) error {
 	m.msb.executionInfo.LastProcessedEvent = event.GetDecisionTaskCompletedEventAttributes().GetStartedEventID()
+	m.msb.executionInfo.LastDecisionCompletedTimestamp = event.GetTimestamp()
+	if m.msb.executionInfo.Inactive {
+		// a completed decision means the workflow is making progress again
+		m.msb.executionInfo.Inactive = false
+		if err := m.msb.updateInactiveSearchAttribute(); err != nil {
+			return err
+		}
+	}
 	return m.msb.addBinaryCheckSumIfNotExists(event, maxResetPoints)
 }
 
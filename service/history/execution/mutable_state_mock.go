@@ -776,6 +776,36 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCanceledEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCanceledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCanceledEvent), arg0, arg1)
 }
 
+// AddWorkflowExecutionInactiveEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionInactiveEvent() (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionInactiveEvent")
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionInactiveEvent indicates an expected call of AddWorkflowExecutionInactiveEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionInactiveEvent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionInactiveEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionInactiveEvent))
+}
+
+// AddWorkflowExecutionPausedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionPausedEvent(reason, identity string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionPausedEvent", reason, identity)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionPausedEvent indicates an expected call of AddWorkflowExecutionPausedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionPausedEvent(reason, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionPausedEvent), reason, identity)
+}
+
 // AddWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -821,6 +851,81 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionTerminatedEvent(firs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionTerminatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionTerminatedEvent), firstEventID, reason, details, identity)
 }
 
+// AddWorkflowExecutionUnpausedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUnpausedEvent(reason, identity string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUnpausedEvent", reason, identity)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUnpausedEvent indicates an expected call of AddWorkflowExecutionUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUnpausedEvent(reason, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUnpausedEvent), reason, identity)
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64, updateID string, result *types.WorkflowUpdateResult) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateCompletedEvent", decisionCompletedEventID, updateID, result)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent indicates an expected call of AddWorkflowExecutionUpdateCompletedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, updateID, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUpdateCompletedEvent), decisionCompletedEventID, updateID, result)
+}
+
+// AddWorkflowExecutionUpdateRequestedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUpdateRequestedEvent(updateID string, request *types.UpdateWorkflowExecutionRequest) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateRequestedEvent", updateID, request)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateRequestedEvent indicates an expected call of AddWorkflowExecutionUpdateRequestedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUpdateRequestedEvent(updateID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateRequestedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUpdateRequestedEvent), updateID, request)
+}
+
+// AddWorkflowHistoryCompactedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowHistoryCompactedEvent(ctx context.Context, markerEvent *types.HistoryEvent) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowHistoryCompactedEvent", ctx, markerEvent)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowHistoryCompactedEvent indicates an expected call of AddWorkflowHistoryCompactedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowHistoryCompactedEvent(ctx, markerEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowHistoryCompactedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowHistoryCompactedEvent), ctx, markerEvent)
+}
+
+// AddWorkflowSearchAttributesReappliedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowSearchAttributesReappliedEvent(arg0 *types.SearchAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowSearchAttributesReappliedEvent", arg0)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowSearchAttributesReappliedEvent indicates an expected call of AddWorkflowSearchAttributesReappliedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowSearchAttributesReappliedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowSearchAttributesReappliedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowSearchAttributesReappliedEvent), arg0)
+}
+
 // CheckResettable mocks base method.
 func (m *MockMutableState) CheckResettable() error {
 	m.ctrl.T.Helper()
@@ -1024,6 +1129,18 @@ func (mr *MockMutableStateMockRecorder) DeleteUserTimer(timerID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTimer", reflect.TypeOf((*MockMutableState)(nil).DeleteUserTimer), timerID)
 }
 
+// DeleteWorkflowUpdate mocks base method.
+func (m *MockMutableState) DeleteWorkflowUpdate(updateID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteWorkflowUpdate", updateID)
+}
+
+// DeleteWorkflowUpdate indicates an expected call of DeleteWorkflowUpdate.
+func (mr *MockMutableStateMockRecorder) DeleteWorkflowUpdate(updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowUpdate", reflect.TypeOf((*MockMutableState)(nil).DeleteWorkflowUpdate), updateID)
+}
+
 // FailDecision mocks base method.
 func (m *MockMutableState) FailDecision(arg0 bool) {
 	m.ctrl.T.Helper()
@@ -1269,6 +1386,21 @@ func (mr *MockMutableStateMockRecorder) GetHistoryBuilder() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryBuilder", reflect.TypeOf((*MockMutableState)(nil).GetHistoryBuilder))
 }
 
+// GetHistoryCompactionNodeID mocks base method.
+func (m *MockMutableState) GetHistoryCompactionNodeID() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryCompactionNodeID")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryCompactionNodeID indicates an expected call of GetHistoryCompactionNodeID.
+func (mr *MockMutableStateMockRecorder) GetHistoryCompactionNodeID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryCompactionNodeID", reflect.TypeOf((*MockMutableState)(nil).GetHistoryCompactionNodeID))
+}
+
 // GetInFlightDecision mocks base method.
 func (m *MockMutableState) GetInFlightDecision() (*DecisionInfo, bool) {
 	m.ctrl.T.Helper()
@@ -1412,6 +1544,20 @@ func (mr *MockMutableStateMockRecorder) GetPendingTimerInfos() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTimerInfos", reflect.TypeOf((*MockMutableState)(nil).GetPendingTimerInfos))
 }
 
+// GetPendingWorkflowUpdates mocks base method.
+func (m *MockMutableState) GetPendingWorkflowUpdates() []*types.WorkflowUpdateInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingWorkflowUpdates")
+	ret0, _ := ret[0].([]*types.WorkflowUpdateInfo)
+	return ret0
+}
+
+// GetPendingWorkflowUpdates indicates an expected call of GetPendingWorkflowUpdates.
+func (mr *MockMutableStateMockRecorder) GetPendingWorkflowUpdates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingWorkflowUpdates", reflect.TypeOf((*MockMutableState)(nil).GetPendingWorkflowUpdates))
+}
+
 // GetPreviousStartedEventID mocks base method.
 func (m *MockMutableState) GetPreviousStartedEventID() int64 {
 	m.ctrl.T.Helper()
@@ -1629,6 +1775,36 @@ func (mr *MockMutableStateMockRecorder) GetWorkflowType() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowType", reflect.TypeOf((*MockMutableState)(nil).GetWorkflowType))
 }
 
+// GetWorkflowUpdateCompletedEvent mocks base method.
+func (m *MockMutableState) GetWorkflowUpdateCompletedEvent(ctx context.Context, updateID string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowUpdateCompletedEvent", ctx, updateID)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowUpdateCompletedEvent indicates an expected call of GetWorkflowUpdateCompletedEvent.
+func (mr *MockMutableStateMockRecorder) GetWorkflowUpdateCompletedEvent(ctx, updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowUpdateCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).GetWorkflowUpdateCompletedEvent), ctx, updateID)
+}
+
+// GetWorkflowUpdateInfo mocks base method.
+func (m *MockMutableState) GetWorkflowUpdateInfo(updateID string) (*types.WorkflowUpdateInfo, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowUpdateInfo", updateID)
+	ret0, _ := ret[0].(*types.WorkflowUpdateInfo)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetWorkflowUpdateInfo indicates an expected call of GetWorkflowUpdateInfo.
+func (mr *MockMutableStateMockRecorder) GetWorkflowUpdateInfo(updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowUpdateInfo", reflect.TypeOf((*MockMutableState)(nil).GetWorkflowUpdateInfo), updateID)
+}
+
 // HasBufferedEvents mocks base method.
 func (m *MockMutableState) HasBufferedEvents() bool {
 	m.ctrl.T.Helper()
@@ -1784,6 +1960,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowCompleted() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowCompleted", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowCompleted))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockMutableState) IsWorkflowExecutionPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionPaused))
+}
+
 // IsWorkflowExecutionRunning mocks base method.
 func (m *MockMutableState) IsWorkflowExecutionRunning() bool {
 	m.ctrl.T.Helper()
@@ -2334,6 +2524,34 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionFailedEvent(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionFailedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionFailedEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionInactiveEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionInactiveEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionInactiveEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionInactiveEvent indicates an expected call of ReplicateWorkflowExecutionInactiveEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionInactiveEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionInactiveEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionInactiveEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionPausedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionPausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionPausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionPausedEvent indicates an expected call of ReplicateWorkflowExecutionPausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionPausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionPausedEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) ReplicateWorkflowExecutionSignaled(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2390,6 +2608,74 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionTimedoutEvent(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionTimedoutEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionTimedoutEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionUnpausedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionUnpausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUnpausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUnpausedEvent indicates an expected call of ReplicateWorkflowExecutionUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUnpausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUnpausedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionUpdateCompletedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUpdateCompletedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent indicates an expected call of ReplicateWorkflowExecutionUpdateCompletedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUpdateCompletedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUpdateCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUpdateCompletedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionUpdateRequestedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionUpdateRequestedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUpdateRequestedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUpdateRequestedEvent indicates an expected call of ReplicateWorkflowExecutionUpdateRequestedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUpdateRequestedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUpdateRequestedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUpdateRequestedEvent), arg0)
+}
+
+// ReplicateWorkflowHistoryCompactedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowHistoryCompactedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowHistoryCompactedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowHistoryCompactedEvent indicates an expected call of ReplicateWorkflowHistoryCompactedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowHistoryCompactedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowHistoryCompactedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowHistoryCompactedEvent), arg0)
+}
+
+// ReplicateWorkflowSearchAttributesReappliedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowSearchAttributesReappliedEvent(arg0 *types.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReplicateWorkflowSearchAttributesReappliedEvent", arg0)
+}
+
+// ReplicateWorkflowSearchAttributesReappliedEvent indicates an expected call of ReplicateWorkflowSearchAttributesReappliedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowSearchAttributesReappliedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowSearchAttributesReappliedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowSearchAttributesReappliedEvent), arg0)
+}
+
 // RetryActivity mocks base method.
 func (m *MockMutableState) RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2524,6 +2810,20 @@ func (mr *MockMutableStateMockRecorder) UpdateActivityProgress(ai, request inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityProgress", reflect.TypeOf((*MockMutableState)(nil).UpdateActivityProgress), ai, request)
 }
 
+// UpdateChildExecutionInfo mocks base method.
+func (m *MockMutableState) UpdateChildExecutionInfo(arg0 *persistence.ChildExecutionInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChildExecutionInfo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChildExecutionInfo indicates an expected call of UpdateChildExecutionInfo.
+func (mr *MockMutableStateMockRecorder) UpdateChildExecutionInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChildExecutionInfo", reflect.TypeOf((*MockMutableState)(nil).UpdateChildExecutionInfo), arg0)
+}
+
 // UpdateCurrentVersion mocks base method.
 func (m *MockMutableState) UpdateCurrentVersion(version int64, forceUpdate bool) error {
 	m.ctrl.T.Helper()
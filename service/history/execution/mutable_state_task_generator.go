@@ -79,6 +79,13 @@ type (
 		) error
 		GenerateWorkflowSearchAttrTasks() error
 		GenerateWorkflowResetTasks() error
+		GenerateWorkflowUnpausedTasks(
+			unpausedEvent *types.HistoryEvent,
+		) error
+		GenerateWorkflowInactivityTimeoutTasks(
+			referenceTime time.Time,
+			version int64,
+		) error
 		GenerateFromTransferTask(
 			transferTask *persistence.TransferTaskInfo,
 			targetCluster string,
@@ -159,6 +166,14 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowStartTasks(
 		Version:             startVersion,
 	})
 
+	// once a decision has completed, the inactivity window is tracked from
+	// the last decision completion instead of the workflow start
+	if executionInfo.LastProcessedEvent == common.EmptyEventID {
+		return r.GenerateWorkflowInactivityTimeoutTasks(
+			startTime.Add(firstDecisionDelayDuration),
+			startVersion,
+		)
+	}
 	return nil
 }
 
@@ -621,6 +636,47 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowResetTasks() error {
 	return nil
 }
 
+func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowUnpausedTasks(
+	unpausedEvent *types.HistoryEvent,
+) error {
+
+	// decision and activity tasks are not dispatched while the workflow is paused,
+	// so dispatch the ones which are still waiting to be started
+	if decision, ok := r.mutableState.GetPendingDecision(); ok && decision.StartedID == common.EmptyEventID {
+		if err := r.GenerateDecisionScheduleTasks(
+			decision.ScheduleID,
+		); err != nil {
+			return err
+		}
+	}
+
+	executionInfo := r.mutableState.GetExecutionInfo()
+	unpausedTime := time.Unix(0, unpausedEvent.GetTimestamp())
+	for _, activityInfo := range r.mutableState.GetPendingActivityInfos() {
+		if activityInfo.StartedID != common.EmptyEventID {
+			continue
+		}
+		// activity waiting for its retry backoff will be dispatched by the retry timer task
+		if activityInfo.ScheduledTime.After(unpausedTime) {
+			continue
+		}
+
+		targetDomainID := activityInfo.DomainID
+		if targetDomainID == "" {
+			targetDomainID = executionInfo.DomainID
+		}
+		r.mutableState.AddTransferTasks(&persistence.ActivityTask{
+			// TaskID and VisibilityTimestamp are set by shard context
+			DomainID:   targetDomainID,
+			TaskList:   activityInfo.TaskList,
+			ScheduleID: activityInfo.ScheduleID,
+			Version:    activityInfo.Version,
+		})
+	}
+
+	return nil
+}
+
 func (r *mutableStateTaskGeneratorImpl) GenerateCrossClusterRecordChildCompletedTask(
 	task *persistence.TransferTaskInfo,
 	targetCluster string,
@@ -674,6 +730,26 @@ func (r *mutableStateTaskGeneratorImpl) GenerateCrossClusterApplyParentClosePoli
 	return nil
 }
 
+func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowInactivityTimeoutTasks(
+	referenceTime time.Time,
+	version int64,
+) error {
+
+	executionInfo := r.mutableState.GetExecutionInfo()
+	if executionInfo.InactivityTimeout <= 0 {
+		return nil
+	}
+
+	inactivityTimeoutDuration := time.Duration(executionInfo.InactivityTimeout) * time.Second
+	r.mutableState.AddTimerTasks(&persistence.WorkflowInactivityTimeoutTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: referenceTime.Add(inactivityTimeoutDuration),
+		EventID:             executionInfo.LastProcessedEvent,
+		Version:             version,
+	})
+	return nil
+}
+
 func (r *mutableStateTaskGeneratorImpl) GenerateFromTransferTask(
 	task *persistence.TransferTaskInfo,
 	targetCluster string,
@@ -281,6 +281,20 @@ func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowCloseTasks(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowCloseTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowCloseTasks), closeEvent, workflowDeletionTaskJitterRange)
 }
 
+// GenerateWorkflowInactivityTimeoutTasks mocks base method.
+func (m *MockMutableStateTaskGenerator) GenerateWorkflowInactivityTimeoutTasks(referenceTime time.Time, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateWorkflowInactivityTimeoutTasks", referenceTime, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateWorkflowInactivityTimeoutTasks indicates an expected call of GenerateWorkflowInactivityTimeoutTasks.
+func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowInactivityTimeoutTasks(referenceTime, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowInactivityTimeoutTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowInactivityTimeoutTasks), referenceTime, version)
+}
+
 // GenerateWorkflowResetTasks mocks base method.
 func (m *MockMutableStateTaskGenerator) GenerateWorkflowResetTasks() error {
 	m.ctrl.T.Helper()
@@ -322,3 +336,17 @@ func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowStartTasks(
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowStartTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowStartTasks), startTime, startEvent)
 }
+
+// GenerateWorkflowUnpausedTasks mocks base method.
+func (m *MockMutableStateTaskGenerator) GenerateWorkflowUnpausedTasks(unpausedEvent *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateWorkflowUnpausedTasks", unpausedEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateWorkflowUnpausedTasks indicates an expected call of GenerateWorkflowUnpausedTasks.
+func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowUnpausedTasks(unpausedEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowUnpausedTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowUnpausedTasks), unpausedEvent)
+}
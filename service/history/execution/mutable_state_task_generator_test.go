@@ -239,6 +239,101 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks() {
 	}
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowInactivityTimeoutTasks() {
+	now := time.Now()
+	version := int64(123)
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:           constants.TestDomainID,
+		LastProcessedEvent: 15,
+	}
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+
+	// inactivity timeout is not enabled, no task is generated
+	err := s.taskGenerator.GenerateWorkflowInactivityTimeoutTasks(now, version)
+	s.NoError(err)
+
+	executionInfo.InactivityTimeout = 30
+	s.mockMutableState.EXPECT().AddTimerTasks(&persistence.WorkflowInactivityTimeoutTask{
+		VisibilityTimestamp: now.Add(30 * time.Second),
+		EventID:             15,
+		Version:             version,
+	}).Times(1)
+	err = s.taskGenerator.GenerateWorkflowInactivityTimeoutTasks(now, version)
+	s.NoError(err)
+}
+
+func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowUnpausedTasks() {
+	now := time.Now()
+	version := int64(123)
+	unpausedEvent := &types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionUnpaused.Ptr(),
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		Version:   version,
+	}
+	decision := &DecisionInfo{
+		Version:    version,
+		ScheduleID: 5,
+		StartedID:  common.EmptyEventID,
+		TaskList:   "some random tasklist",
+	}
+	activityInfos := map[int64]*persistence.ActivityInfo{
+		// pending activity, should be dispatched
+		6: {
+			Version:       version,
+			ScheduleID:    6,
+			StartedID:     common.EmptyEventID,
+			ScheduledTime: now.Add(-time.Minute),
+			TaskList:      "some random tasklist",
+		},
+		// started activity, should be skipped
+		7: {
+			Version:       version,
+			ScheduleID:    7,
+			StartedID:     9,
+			ScheduledTime: now.Add(-time.Minute),
+			TaskList:      "some random tasklist",
+		},
+		// activity waiting for retry, should be skipped
+		8: {
+			Version:       version,
+			ScheduleID:    8,
+			StartedID:     common.EmptyEventID,
+			ScheduledTime: now.Add(time.Minute),
+			DomainID:      constants.TestTargetDomainID,
+			TaskList:      "some random tasklist",
+		},
+	}
+
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		DomainID: constants.TestDomainID,
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetPendingDecision().Return(decision, true).Times(1)
+	s.mockMutableState.EXPECT().GetDecisionInfo(decision.ScheduleID).Return(decision, true).Times(1)
+	s.mockMutableState.EXPECT().GetDecisionScheduleToStartTimeout().Return(time.Duration(0)).Times(1)
+	s.mockMutableState.EXPECT().GetPendingActivityInfos().Return(activityInfos).Times(1)
+	var transferTasks []persistence.Task
+	s.mockMutableState.EXPECT().AddTransferTasks(gomock.Any()).Do(func(tasks ...persistence.Task) {
+		transferTasks = append(transferTasks, tasks...)
+	}).Times(2)
+
+	err := s.taskGenerator.GenerateWorkflowUnpausedTasks(unpausedEvent)
+	s.NoError(err)
+	s.Equal([]persistence.Task{
+		&persistence.DecisionTask{
+			DomainID:   constants.TestDomainID,
+			TaskList:   decision.TaskList,
+			ScheduleID: decision.ScheduleID,
+			Version:    version,
+		},
+		&persistence.ActivityTask{
+			DomainID:   constants.TestDomainID,
+			TaskList:   "some random tasklist",
+			ScheduleID: 6,
+			Version:    version,
+		},
+	}, transferTasks)
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateFromTransferTask() {
 	targetCluster := cluster.TestAlternativeClusterName
 	now := time.Now()
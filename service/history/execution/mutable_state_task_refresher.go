@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -48,6 +49,7 @@ type (
 		clusterMetadata cluster.Metadata
 		domainCache     cache.DomainCache
 		eventsCache     events.Cache
+		timeSource      clock.TimeSource
 		shardID         int
 	}
 )
@@ -58,6 +60,7 @@ func NewMutableStateTaskRefresher(
 	clusterMetadata cluster.Metadata,
 	domainCache cache.DomainCache,
 	eventsCache events.Cache,
+	timeSource clock.TimeSource,
 	shardID int,
 ) MutableStateTaskRefresher {
 
@@ -66,6 +69,7 @@ func NewMutableStateTaskRefresher(
 		clusterMetadata: clusterMetadata,
 		domainCache:     domainCache,
 		eventsCache:     eventsCache,
+		timeSource:      timeSource,
 		shardID:         shardID,
 	}
 }
@@ -115,6 +119,14 @@ func (r *mutableStateTaskRefresherImpl) RefreshTasks(
 		return err
 	}
 
+	if err := r.refreshTasksForWorkflowInactivity(
+		ctx,
+		mutableState,
+		taskGenerator,
+	); err != nil {
+		return err
+	}
+
 	if err := r.refreshTasksForActivity(
 		ctx,
 		mutableState,
@@ -271,6 +283,35 @@ func (r *mutableStateTaskRefresherImpl) refreshTasksForDecision(
 	)
 }
 
+func (r *mutableStateTaskRefresherImpl) refreshTasksForWorkflowInactivity(
+	ctx context.Context,
+	mutableState MutableState,
+	taskGenerator MutableStateTaskGenerator,
+) error {
+
+	// before any decision completes, the inactivity timer is refreshed along with workflow start tasks
+	if !mutableState.IsWorkflowExecutionRunning() ||
+		mutableState.GetExecutionInfo().LastProcessedEvent == common.EmptyEventID {
+		return nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+
+	// executions persisted before the completion time of the last decision was
+	// recorded have no reference time, so their inactivity window restarts from now
+	referenceTime := r.timeSource.Now()
+	if lastDecisionCompletedTimestamp := mutableState.GetExecutionInfo().LastDecisionCompletedTimestamp; lastDecisionCompletedTimestamp > 0 {
+		referenceTime = time.Unix(0, lastDecisionCompletedTimestamp)
+	}
+	return taskGenerator.GenerateWorkflowInactivityTimeoutTasks(
+		referenceTime,
+		lastWriteVersion,
+	)
+}
+
 func (r *mutableStateTaskRefresherImpl) refreshTasksForActivity(
 	ctx context.Context,
 	mutableState MutableState,
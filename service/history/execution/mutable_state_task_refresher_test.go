@@ -0,0 +1,108 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	mutableStateTaskRefresherSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller        *gomock.Controller
+		mockMutableState  *MockMutableState
+		mockTaskGenerator *MockMutableStateTaskGenerator
+		timeSource        *clock.EventTimeSource
+
+		taskRefresher *mutableStateTaskRefresherImpl
+	}
+)
+
+func TestMutableStateTaskRefresherSuite(t *testing.T) {
+	s := new(mutableStateTaskRefresherSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateTaskRefresherSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockMutableState = NewMockMutableState(s.controller)
+	s.mockTaskGenerator = NewMockMutableStateTaskGenerator(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(0, 1000))
+
+	s.taskRefresher = &mutableStateTaskRefresherImpl{
+		timeSource: s.timeSource,
+	}
+}
+
+func (s *mutableStateTaskRefresherSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *mutableStateTaskRefresherSuite) TestRefreshTasksForWorkflowInactivity_NoDecisionCompleted() {
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		LastProcessedEvent: common.EmptyEventID,
+	}).AnyTimes()
+
+	err := s.taskRefresher.refreshTasksForWorkflowInactivity(context.Background(), s.mockMutableState, s.mockTaskGenerator)
+	s.NoError(err)
+}
+
+func (s *mutableStateTaskRefresherSuite) TestRefreshTasksForWorkflowInactivity_LastDecisionCompleted() {
+	version := int64(100)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		LastProcessedEvent:             10,
+		LastDecisionCompletedTimestamp: 500,
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetLastWriteVersion().Return(version, nil).Times(1)
+	s.mockTaskGenerator.EXPECT().GenerateWorkflowInactivityTimeoutTasks(time.Unix(0, 500), version).Return(nil).Times(1)
+
+	err := s.taskRefresher.refreshTasksForWorkflowInactivity(context.Background(), s.mockMutableState, s.mockTaskGenerator)
+	s.NoError(err)
+}
+
+func (s *mutableStateTaskRefresherSuite) TestRefreshTasksForWorkflowInactivity_CompletionTimeNotRecorded() {
+	version := int64(100)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		LastProcessedEvent: 10,
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetLastWriteVersion().Return(version, nil).Times(1)
+	s.mockTaskGenerator.EXPECT().GenerateWorkflowInactivityTimeoutTasks(s.timeSource.Now(), version).Return(nil).Times(1)
+
+	err := s.taskRefresher.refreshTasksForWorkflowInactivity(context.Background(), s.mockMutableState, s.mockTaskGenerator)
+	s.NoError(err)
+}
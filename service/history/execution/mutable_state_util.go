@@ -22,12 +22,16 @@
 package execution
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
 )
 
 type (
@@ -374,6 +378,12 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		NonRetriableErrors:                 sourceInfo.NonRetriableErrors,
 		BranchToken:                        sourceInfo.BranchToken,
 		ExpirationSeconds:                  sourceInfo.ExpirationSeconds,
+		Paused:                             sourceInfo.Paused,
+		InactivityTimeout:                  sourceInfo.InactivityTimeout,
+		InactivityTimeoutPolicy:            sourceInfo.InactivityTimeoutPolicy,
+		Inactive:                           sourceInfo.Inactive,
+		LastDecisionCompletedTimestamp:     sourceInfo.LastDecisionCompletedTimestamp,
+		WorkflowUpdates:                    sourceInfo.WorkflowUpdates,
 	}
 }
 
@@ -416,6 +426,7 @@ func CopyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		LastFailureReason:        sourceInfo.LastFailureReason,
 		LastWorkerIdentity:       sourceInfo.LastWorkerIdentity,
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
+		AttemptLog:               sourceInfo.AttemptLog,
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds: sourceInfo.LastHeartbeatTimeoutVisibilityInSeconds,
 	}
@@ -550,3 +561,271 @@ func GetChildExecutionDomainEntry(
 
 	return parentDomainEntry, nil
 }
+
+// GetDecisionTaskPriority gets the matching task priority for the decision tasks of the workflow
+// from the task priority header of the workflow execution started event
+func GetDecisionTaskPriority(
+	ctx context.Context,
+	mutableState MutableState,
+) (int32, error) {
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return 0, err
+	}
+	attributes := startEvent.WorkflowExecutionStartedEventAttributes
+	if attributes == nil {
+		return 0, nil
+	}
+	priority, _ := common.GetTaskPriorityFromHeader(attributes.Header)
+	return priority, nil
+}
+
+// GetActivityTaskPriority gets the matching task priority for an activity task
+// from the task priority header of the activity scheduled event
+// if the header is not set, the priority of the workflow is used
+func GetActivityTaskPriority(
+	ctx context.Context,
+	mutableState MutableState,
+	scheduledEvent *types.HistoryEvent,
+) (int32, error) {
+	if attributes := scheduledEvent.ActivityTaskScheduledEventAttributes; attributes != nil {
+		if priority, ok := common.GetTaskPriorityFromHeader(attributes.Header); ok {
+			return priority, nil
+		}
+	}
+	return GetDecisionTaskPriority(ctx, mutableState)
+}
+
+// GetDecisionTaskIsolationGroup gets the isolation group for the decision tasks of the workflow
+// from the isolation group header of the workflow execution started event
+func GetDecisionTaskIsolationGroup(
+	ctx context.Context,
+	mutableState MutableState,
+) (string, error) {
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return "", err
+	}
+	attributes := startEvent.WorkflowExecutionStartedEventAttributes
+	if attributes == nil {
+		return "", nil
+	}
+	return common.GetTaskIsolationGroupFromHeader(attributes.Header), nil
+}
+
+// GetActivityTaskIsolationGroup gets the isolation group for an activity task
+// from the isolation group header of the activity scheduled event
+// if the header is not set, the isolation group of the workflow is used
+func GetActivityTaskIsolationGroup(
+	ctx context.Context,
+	mutableState MutableState,
+	scheduledEvent *types.HistoryEvent,
+) (string, error) {
+	if attributes := scheduledEvent.ActivityTaskScheduledEventAttributes; attributes != nil {
+		if group := common.GetTaskIsolationGroupFromHeader(attributes.Header); group != "" {
+			return group, nil
+		}
+	}
+	return GetDecisionTaskIsolationGroup(ctx, mutableState)
+}
+
+// GetActivityTaskLabelSelector gets the label selector restricting the pollers an activity
+// task can be dispatched to from the task label selector header of the activity scheduled event
+func GetActivityTaskLabelSelector(scheduledEvent *types.HistoryEvent) string {
+	if attributes := scheduledEvent.ActivityTaskScheduledEventAttributes; attributes != nil {
+		return common.GetTaskLabelSelectorFromHeader(attributes.Header)
+	}
+	return ""
+}
+
+// NewDefaultReapplyPolicy creates the reapply policy used when a reset request does not specify one,
+// which carries forward signals unless signal reapply is skipped
+func NewDefaultReapplyPolicy(skipSignalReapply bool) *types.ResetReapplyPolicy {
+	return &types.ResetReapplyPolicy{
+		ReapplySignals: !skipSignalReapply,
+	}
+}
+
+// GetNDCReapplyPolicy gets the reapply policy used when resolving conflicts between clusters
+func GetNDCReapplyPolicy(
+	config *config.Config,
+	domainName string,
+) *types.ResetReapplyPolicy {
+	return &types.ResetReapplyPolicy{
+		ReapplySignals:                config.NDCReapplySignals(domainName),
+		ReapplyUpsertSearchAttributes: config.NDCReapplyUpsertSearchAttributes(domainName),
+		ReapplyCancelRequests:         config.NDCReapplyCancelRequests(domainName),
+	}
+}
+
+// IsReapplyPolicyEmpty returns true if the reapply policy does not carry forward any event
+func IsReapplyPolicyEmpty(policy *types.ResetReapplyPolicy) bool {
+	return !policy.GetReapplySignals() &&
+		!policy.GetReapplyUpsertSearchAttributes() &&
+		!policy.GetReapplyCancelRequests()
+}
+
+// IsReapplyEligibleEvent returns true if the event belongs to an event category carried forward by the reapply policy
+func IsReapplyEligibleEvent(
+	policy *types.ResetReapplyPolicy,
+	event *types.HistoryEvent,
+) bool {
+	switch event.GetEventType() {
+	case types.EventTypeWorkflowExecutionSignaled:
+		return policy.GetReapplySignals()
+	case types.EventTypeUpsertWorkflowSearchAttributes, types.EventTypeWorkflowSearchAttributesReapplied:
+		return policy.GetReapplyUpsertSearchAttributes()
+	case types.EventTypeWorkflowExecutionCancelRequested:
+		return policy.GetReapplyCancelRequests()
+	default:
+		return false
+	}
+}
+
+// ReapplyEvent reapplies an event eligible for reapplication to the mutable state by writing a new event.
+// A search attribute upsert is reapplied as a WorkflowSearchAttributesReapplied event, as it is not the result
+// of a decision of the new branch
+func ReapplyEvent(
+	mutableState MutableState,
+	event *types.HistoryEvent,
+) error {
+	switch event.GetEventType() {
+	case types.EventTypeWorkflowExecutionSignaled:
+		attr := event.WorkflowExecutionSignaledEventAttributes
+		_, err := mutableState.AddWorkflowExecutionSignaled(
+			attr.GetSignalName(),
+			attr.GetInput(),
+			attr.GetIdentity(),
+		)
+		return err
+	case types.EventTypeUpsertWorkflowSearchAttributes:
+		_, err := mutableState.AddWorkflowSearchAttributesReappliedEvent(
+			event.UpsertWorkflowSearchAttributesEventAttributes.GetSearchAttributes(),
+		)
+		return err
+	case types.EventTypeWorkflowSearchAttributesReapplied:
+		_, err := mutableState.AddWorkflowSearchAttributesReappliedEvent(
+			event.WorkflowSearchAttributesReappliedEventAttributes.GetSearchAttributes(),
+		)
+		return err
+	case types.EventTypeWorkflowExecutionCancelRequested:
+		if cancelRequested, _ := mutableState.IsCancelRequested(); cancelRequested {
+			// the workflow can only be requested to cancel once
+			return nil
+		}
+		attr := event.WorkflowExecutionCancelRequestedEventAttributes
+		_, err := mutableState.AddWorkflowExecutionCancelRequestedEvent(
+			attr.Cause,
+			&types.HistoryRequestCancelWorkflowExecutionRequest{
+				CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+					Identity: attr.Identity,
+				},
+				ExternalInitiatedEventID:  attr.ExternalInitiatedEventID,
+				ExternalWorkflowExecution: attr.ExternalWorkflowExecution,
+			},
+		)
+		return err
+	default:
+		return &types.InternalServiceError{
+			Message: fmt.Sprintf("unable to reapply event of type %v", event.GetEventType()),
+		}
+	}
+}
+
+func limitAttemptDetails(
+	details []byte,
+	sizeLimit int,
+) ([]byte, bool) {
+
+	// partial payloads can't be decoded by the client, so oversized details are dropped altogether
+	if len(details) <= sizeLimit {
+		return details, false
+	}
+	return nil, true
+}
+
+func attemptInfoSize(
+	attempt *types.ActivityAttemptInfo,
+) int {
+
+	// the fixed size fields are approximated by the size of their values
+	const fixedSize = 4 + 8 + 8 + 1 + 1
+	return fixedSize + len(attempt.Identity) + len(attempt.FailureReason) + len(attempt.FailureDetails) + len(attempt.HeartbeatDetails)
+}
+
+// historyCompactionSnapshotVersion is the version of the mutable state snapshot format written
+// to the history compaction event, it has to be bumped on any incompatible change of the format
+const historyCompactionSnapshotVersion = 1
+
+// historyCompactionSnapshot is the mutable state carried on the history compaction event.
+// It is an internal format of the history service, the identity and the history branch of
+// the workflow are not part of it since they are taken from the workflow being rebuilt.
+type historyCompactionSnapshot struct {
+	Version             int                                       `json:"version"`
+	ExecutionInfo       *persistence.WorkflowExecutionInfo        `json:"executionInfo"`
+	ActivityInfos       map[int64]*persistence.ActivityInfo       `json:"activityInfos,omitempty"`
+	TimerInfos          map[string]*persistence.TimerInfo         `json:"timerInfos,omitempty"`
+	ChildExecutionInfos map[int64]*persistence.ChildExecutionInfo `json:"childExecutionInfos,omitempty"`
+	RequestCancelInfos  map[int64]*persistence.RequestCancelInfo  `json:"requestCancelInfos,omitempty"`
+	SignalInfos         map[int64]*persistence.SignalInfo         `json:"signalInfos,omitempty"`
+	SignalRequestedIDs  map[string]struct{}                       `json:"signalRequestedIDs,omitempty"`
+}
+
+func encodeHistoryCompactionSnapshot(
+	mutableState *persistence.WorkflowMutableState,
+	sizeLimit int,
+) (*types.DataBlob, error) {
+
+	executionInfo := *mutableState.ExecutionInfo
+	executionInfo.DomainID = ""
+	executionInfo.WorkflowID = ""
+	executionInfo.RunID = ""
+	executionInfo.CreateRequestID = ""
+	executionInfo.BranchToken = nil
+	executionInfo.LastEventTaskID = common.EmptyEventTaskID
+
+	data, err := json.Marshal(&historyCompactionSnapshot{
+		Version:             historyCompactionSnapshotVersion,
+		ExecutionInfo:       &executionInfo,
+		ActivityInfos:       mutableState.ActivityInfos,
+		TimerInfos:          mutableState.TimerInfos,
+		ChildExecutionInfos: mutableState.ChildExecutionInfos,
+		RequestCancelInfos:  mutableState.RequestCancelInfos,
+		SignalInfos:         mutableState.SignalInfos,
+		SignalRequestedIDs:  mutableState.SignalRequestedIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > sizeLimit {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("Mutable state snapshot size %v exceeds the limit %v", len(data), sizeLimit),
+		}
+	}
+	return &types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         data,
+	}, nil
+}
+
+func decodeHistoryCompactionSnapshot(
+	blob *types.DataBlob,
+) (*historyCompactionSnapshot, error) {
+
+	if blob.GetEncodingType() != types.EncodingTypeJSON {
+		return nil, &types.BadRequestError{Message: "Unknown encoding of the mutable state snapshot"}
+	}
+	snapshot := &historyCompactionSnapshot{}
+	if err := json.Unmarshal(blob.GetData(), snapshot); err != nil {
+		return nil, err
+	}
+	if snapshot.Version != historyCompactionSnapshotVersion {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("Unknown version %v of the mutable state snapshot", snapshot.Version),
+		}
+	}
+	if snapshot.ExecutionInfo == nil {
+		return nil, &types.BadRequestError{Message: "Mutable state snapshot is missing execution info"}
+	}
+	return snapshot, nil
+}
@@ -148,3 +148,33 @@ func TestFindAutoResetPoint(t *testing.T) {
 	})
 	assert.Equal(t, pt, pt5)
 }
+
+func TestIsReapplyEligibleEvent(t *testing.T) {
+	signalEvent := &types.HistoryEvent{EventType: types.EventTypeWorkflowExecutionSignaled.Ptr()}
+	upsertEvent := &types.HistoryEvent{EventType: types.EventTypeUpsertWorkflowSearchAttributes.Ptr()}
+	reappliedUpsertEvent := &types.HistoryEvent{EventType: types.EventTypeWorkflowSearchAttributesReapplied.Ptr()}
+	cancelEvent := &types.HistoryEvent{EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr()}
+	timerEvent := &types.HistoryEvent{EventType: types.EventTypeTimerFired.Ptr()}
+
+	policy := NewDefaultReapplyPolicy(false)
+	assert.False(t, IsReapplyPolicyEmpty(policy))
+	assert.True(t, IsReapplyEligibleEvent(policy, signalEvent))
+	assert.False(t, IsReapplyEligibleEvent(policy, upsertEvent))
+	assert.False(t, IsReapplyEligibleEvent(policy, cancelEvent))
+	assert.False(t, IsReapplyEligibleEvent(policy, timerEvent))
+
+	policy = NewDefaultReapplyPolicy(true)
+	assert.True(t, IsReapplyPolicyEmpty(policy))
+	assert.False(t, IsReapplyEligibleEvent(policy, signalEvent))
+
+	policy = &types.ResetReapplyPolicy{
+		ReapplyUpsertSearchAttributes: true,
+		ReapplyCancelRequests:         true,
+	}
+	assert.False(t, IsReapplyPolicyEmpty(policy))
+	assert.False(t, IsReapplyEligibleEvent(policy, signalEvent))
+	assert.True(t, IsReapplyEligibleEvent(policy, upsertEvent))
+	assert.True(t, IsReapplyEligibleEvent(policy, reappliedUpsertEvent))
+	assert.True(t, IsReapplyEligibleEvent(policy, cancelEvent))
+	assert.False(t, IsReapplyEligibleEvent(policy, timerEvent))
+}
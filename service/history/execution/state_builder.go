@@ -228,12 +228,26 @@ func (b *stateBuilderImpl) ApplyEvents(
 			}
 
 		case types.EventTypeDecisionTaskCompleted:
+			wasInactive := b.mutableState.GetExecutionInfo().Inactive
 			if err := b.mutableState.ReplicateDecisionTaskCompletedEvent(
 				event,
 			); err != nil {
 				return nil, err
 			}
 
+			if wasInactive {
+				if err := taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+					return nil, err
+				}
+			}
+
+			if err := taskGenerator.GenerateWorkflowInactivityTimeoutTasks(
+				time.Unix(0, event.GetTimestamp()),
+				event.Version,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeDecisionTaskTimedOut:
 			if err := b.mutableState.ReplicateDecisionTaskTimedOutEvent(
 				event.DecisionTaskTimedOutEventAttributes.GetTimeoutType(),
@@ -501,6 +515,27 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowExecutionUpdateRequested:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateRequestedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUpdateCompleted:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateCompletedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowHistoryCompacted:
+			if err := b.mutableState.ReplicateWorkflowHistoryCompactedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCancelRequested:
 			if err := b.mutableState.ReplicateWorkflowExecutionCancelRequestedEvent(
 				event,
@@ -514,6 +549,51 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowSearchAttributesReapplied:
+			b.mutableState.ReplicateWorkflowSearchAttributesReappliedEvent(event)
+			if err := taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionPaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionPausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+			if err := taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUnpaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionUnpausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+			if err := taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+				return nil, err
+			}
+
+			if err := taskGenerator.GenerateWorkflowUnpausedTasks(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionInactive:
+			if err := b.mutableState.ReplicateWorkflowExecutionInactiveEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+			if err := taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCompleted:
 			if err := b.mutableState.ReplicateWorkflowExecutionCompletedEvent(
 				firstEvent.ID,
@@ -91,6 +91,7 @@ func NewStateRebuilder(
 			shard.GetClusterMetadata(),
 			shard.GetDomainCache(),
 			shard.GetEventsCache(),
+			shard.GetTimeSource(),
 			shard.GetShardID(),
 		),
 		rebuiltHistorySize: 0,
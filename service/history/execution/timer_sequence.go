@@ -280,6 +280,12 @@ func (t *timerSequenceImpl) getActivityScheduleToStartTimeout(
 		return nil
 	}
 
+	// activity is not dispatched while the workflow is paused,
+	// the schedule-to-start window restarts when the workflow gets unpaused
+	if t.mutableState.IsWorkflowExecutionPaused() {
+		return nil
+	}
+
 	startTimeout := activityInfo.ScheduledTime.Add(
 		time.Duration(activityInfo.ScheduleToStartTimeout) * time.Second,
 	)
@@ -63,6 +63,7 @@ func (s *timerSequenceSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 	s.mockMutableState = NewMockMutableState(s.controller)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(false).AnyTimes()
 
 	s.timerSequence = NewTimerSequence(s.mockMutableState).(*timerSequenceImpl)
 }
@@ -692,6 +693,28 @@ func (s *timerSequenceSuite) TestGetActivityScheduleToStartTimeout_NotScheduled(
 	s.Empty(timerSequence)
 }
 
+func (s *timerSequenceSuite) TestGetActivityScheduleToStartTimeout_Scheduled_NotStarted_Paused() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                123,
+		ScheduleID:             234,
+		ScheduledTime:          now,
+		StartedID:              common.EmptyEventID,
+		StartedTime:            time.Time{},
+		ActivityID:             "some random activity ID",
+		ScheduleToStartTimeout: 10,
+		ScheduleToCloseTimeout: 1000,
+		StartToCloseTimeout:    100,
+		TimerTaskStatus:        TimerTaskStatusNone,
+		Attempt:                12,
+	}
+
+	mockMutableState := NewMockMutableState(s.controller)
+	mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(true).Times(1)
+	timerSequence := NewTimerSequence(mockMutableState).(*timerSequenceImpl).getActivityScheduleToStartTimeout(activityInfo)
+	s.Empty(timerSequence)
+}
+
 func (s *timerSequenceSuite) TestGetActivityScheduleToStartTimeout_Scheduled_NotStarted() {
 	now := time.Now()
 	activityInfo := &persistence.ActivityInfo{
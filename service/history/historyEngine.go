@@ -46,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	cndc "github.com/uber/cadence/common/ndc"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/reconciliation/invariant"
@@ -64,6 +65,7 @@ import (
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 	warchiver "github.com/uber/cadence/service/worker/archiver"
 )
@@ -119,6 +121,7 @@ type (
 		clientChecker              client.VersionChecker
 		replicationDLQHandler      replication.DLQHandler
 		failoverMarkerNotifier     failover.MarkerNotifier
+		payloadStore               *payload.Store
 	}
 )
 
@@ -207,6 +210,11 @@ func NewEngineWithShardContext(
 			shard,
 			executionCache,
 		),
+		payloadStore: payload.NewStore(
+			shard.GetService().GetBlobstoreClient(),
+			config.PayloadOffloadThreshold,
+			config.PayloadOffloadSizeLimit,
+		),
 	}
 	historyEngImpl.decisionHandler = decision.NewHandler(
 		shard,
@@ -216,6 +224,7 @@ func NewEngineWithShardContext(
 	pRetry := persistence.NewPersistenceRetryer(
 		shard.GetExecutionManager(),
 		shard.GetHistoryManager(),
+		shard.GetService().GetShardManager(),
 		common.CreatePersistenceRetryPolicy(),
 	)
 	openExecutionCheck := invariant.NewConcreteExecutionExists(pRetry)
@@ -600,6 +609,22 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	workflowID := request.GetWorkflowID()
 	domainID := domainEntry.GetInfo().ID
 
+	request.Input, err = e.payloadStore.Offload(ctx, domainEntry.GetInfo().Name, domainID, workflowID, request.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: workflowID,
+		RunID:      uuid.New(),
+	}
+	defer func() {
+		// the offloaded input is only referenced by the run started by this request
+		if resp == nil || resp.GetRunID() != workflowExecution.GetRunID() {
+			e.deleteUnusedStartInput(ctx, domainID, workflowExecution, request.Input, retError)
+		}
+	}()
+
 	// grab the current context as a lock, nothing more
 	// use a smaller context timeout to get the lock
 	childCtx, childCancel := e.newChildContext(ctx)
@@ -618,10 +643,6 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	}
 	defer func() { currentRelease(retError) }()
 
-	workflowExecution := types.WorkflowExecution{
-		WorkflowID: workflowID,
-		RunID:      uuid.New(),
-	}
 	curMutableState, err := e.createMutableState(domainEntry, workflowExecution.GetRunID())
 	if err != nil {
 		return nil, err
@@ -783,6 +804,32 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	}, nil
 }
 
+// deleteUnusedStartInput deletes the input offloaded for a start request which did not start a new run.
+// The input is kept if the run may have been created, as when persisting it timed out
+func (e *historyEngineImpl) deleteUnusedStartInput(
+	ctx context.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+	input []byte,
+	err error,
+) {
+	if !payload.IsReference(input) || ctx.Err() != nil {
+		return
+	}
+	switch err.(type) {
+	case *persistence.TimeoutError, *types.InternalServiceError:
+		return
+	}
+	if err := e.payloadStore.Delete(ctx, domainID, input); err != nil {
+		e.logger.Warn("Failed to delete offloaded workflow input",
+			tag.WorkflowDomainID(domainID),
+			tag.WorkflowID(workflowExecution.GetWorkflowID()),
+			tag.WorkflowRunID(workflowExecution.GetRunID()),
+			tag.Error(err),
+		)
+	}
+}
+
 func shouldTerminateAndStart(
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
 	state int,
@@ -1543,6 +1590,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			Memo:             &types.Memo{Fields: executionInfo.Memo},
 			IsCron:           len(executionInfo.CronSchedule) > 0,
 			SearchAttributes: &types.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
+			IsPaused:         mutableState.IsWorkflowExecutionPaused(),
 		},
 	}
 
@@ -1620,6 +1668,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 				if ai.LastWorkerIdentity != "" {
 					p.LastWorkerIdentity = ai.LastWorkerIdentity
 				}
+				p.AttemptLog = ai.AttemptLog
 			}
 			result.PendingActivities = append(result.PendingActivities, p)
 		}
@@ -1701,6 +1750,12 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			requestID := request.GetRequestID()
 			ai, isRunning := mutableState.GetActivityInfo(scheduleID)
 
+			// Activity tasks are not handed out while the workflow is paused, they will be
+			// regenerated when the workflow gets unpaused.
+			if mutableState.IsWorkflowExecutionPaused() && isRunning && ai.StartedID == common.EmptyEventID {
+				return workflow.ErrWorkflowPaused
+			}
+
 			// RecordActivityTaskStarted is already past scheduleToClose timeout.
 			// If at this point pending activity is still in mutable state it may be resurrected.
 			// Otherwise it would be completed or timed out already.
@@ -2315,11 +2370,13 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			}
 
 			executionInfo := mutableState.GetExecutionInfo()
-			createDecisionTask := true
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
-				createDecisionTask = false
+			// Do not create decision task when the cron has not been started yet
+			// or the workflow is still waiting out its start delay
+			startDelayed, err := isWaitingForStartDelay(ctx, mutableState)
+			if err != nil {
+				return nil, err
 			}
+			createDecisionTask := !startDelayed
 
 			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
 			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
@@ -2442,8 +2499,13 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
 
-			// Create a transfer task to schedule a decision task
-			if !mutableState.HasPendingDecision() {
+			// Create a transfer task to schedule a decision task, unless the workflow is still
+			// waiting out its start delay and the decision will be scheduled by the backoff timer
+			startDelayed, err := isWaitingForStartDelay(ctx, mutableState)
+			if err != nil {
+				return nil, err
+			}
+			if !startDelayed && !mutableState.HasPendingDecision() {
 				_, err := mutableState.AddDecisionTaskScheduledEvent(false)
 				if err != nil {
 					return nil, &types.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -2596,11 +2658,232 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// PauseWorkflowExecution pauses a running workflow execution by recording WorkflowExecutionPaused event
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.GetWorkflowID(),
+		RunID:      request.WorkflowExecution.GetRunID(),
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			if mutableState.IsWorkflowExecutionPaused() {
+				// already paused, nothing to do
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionUnpaused event
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(unpauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := unpauseRequest.UnpauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.GetWorkflowID(),
+		RunID:      request.WorkflowExecution.GetRunID(),
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			if !mutableState.IsWorkflowExecutionPaused() {
+				// not paused, nothing to do
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionUnpausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to unpause workflow execution."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// UpdateWorkflowExecution records WorkflowExecutionUpdateRequested event and blocks until the workflow
+// responds to the update on a decision task, the update fails or the request context is done.
+// The update ID is the request ID, a retried request waits for the pending update or gets the recorded result
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *types.HistoryUpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.getActiveDomainByID(updateRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return nil, errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+	if !e.config.EnableWorkflowUpdateByDomain(domainName) {
+		return nil, workflow.ErrWorkflowUpdateNotEnabled
+	}
+
+	scope := e.metricsClient.Scope(metrics.HistoryUpdateWorkflowExecutionScope).Tagged(metrics.DomainTag(domainName))
+	request := updateRequest.UpdateRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.GetWorkflowID(),
+		RunID:      request.WorkflowExecution.GetRunID(),
+	}
+
+	updateID := request.GetRequestID()
+	if updateID == "" {
+		updateID = uuid.New()
+	}
+	var updateReg update.Registry
+	var termCh <-chan struct{}
+	var completedEvent *types.HistoryEvent
+	err = workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			updateInfo, recorded := mutableState.GetWorkflowUpdateInfo(updateID)
+			if recorded && updateInfo.CompletedEventID != common.EmptyEventID {
+				// the update was completed by a previous request, the result is recorded in history
+				event, err := mutableState.GetWorkflowUpdateCompletedEvent(ctx, updateID)
+				if err != nil {
+					return nil, err
+				}
+				completedEvent = event
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			// the action is retried on conflicts, the update is only registered once
+			updateReg = wfContext.GetUpdateRegistry()
+			if termCh == nil {
+				if ch, ok := updateReg.AttachUpdate(updateID); ok {
+					termCh = ch
+				}
+			}
+
+			if recorded {
+				// the update was requested by a previous request, the registry
+				// is not persisted so the update may not be registered yet
+				if termCh == nil {
+					termCh = updateReg.BufferUpdate(updateID, request)
+				}
+				if mutableState.HasPendingDecision() || !mutableState.HasProcessedOrPendingDecision() {
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+				return workflow.UpdateWithNewDecision, nil
+			}
+
+			if len(mutableState.GetPendingWorkflowUpdates()) >= e.config.MaxBufferedUpdateCount() {
+				scope.IncCounter(metrics.WorkflowUpdateBufferExceededCount)
+				return nil, workflow.ErrWorkflowUpdateBufferExceeded
+			}
+			if _, err := mutableState.AddWorkflowExecutionUpdateRequestedEvent(updateID, request); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to update workflow execution."}
+			}
+			if termCh == nil {
+				termCh = updateReg.BufferUpdate(updateID, request)
+			}
+
+			return &workflow.UpdateAction{
+				Noop: false,
+				// Do not create decision task when the first decision is still backed off, i.e. the cron
+				// has not been started yet or the workflow start is delayed
+				CreateDecision: mutableState.HasProcessedOrPendingDecision(),
+			}, nil
+		})
+	if termCh != nil {
+		defer updateReg.RemoveUpdate(updateID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if completedEvent != nil {
+		attributes := completedEvent.GetWorkflowExecutionUpdateCompletedEventAttributes()
+		if attributes.GetErrorMessage() != "" {
+			return &types.UpdateWorkflowExecutionResponse{ErrorMessage: attributes.GetErrorMessage()}, nil
+		}
+		return &types.UpdateWorkflowExecutionResponse{Result: attributes.GetResult()}, nil
+	}
+
+	select {
+	case <-termCh:
+		state, err := updateReg.GetTerminationState(updateID)
+		if err != nil {
+			return nil, err
+		}
+		switch state.TerminationType {
+		case update.TerminationTypeCompleted:
+			result := state.UpdateResult
+			if result.GetResultType() == types.QueryResultTypeFailed {
+				return &types.UpdateWorkflowExecutionResponse{ErrorMessage: result.GetErrorMessage()}, nil
+			}
+			return &types.UpdateWorkflowExecutionResponse{Result: result.GetResult()}, nil
+		default:
+			return nil, state.Failure
+		}
+	case <-ctx.Done():
+		scope.IncCounter(metrics.WorkflowUpdateTimeoutCount)
+		return nil, ctx.Err()
+	}
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx context.Context,
 	completionRequest *types.RecordChildExecutionCompletedRequest,
-) error {
+) (retError error) {
 
 	domainEntry, err := e.getActiveDomainByID(completionRequest.DomainUUID)
 	if err != nil {
@@ -2608,50 +2891,107 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	}
 	domainID := domainEntry.GetInfo().ID
 
+	// the result of the child is copied by every attempt to record its completion, only the copy
+	// made by the last attempt is referenced by the history of the parent if the attempt is persisted
+	var copiedResults [][]byte
+	defer func() {
+		if payload.MayBePersisted(retError) && len(copiedResults) > 0 {
+			copiedResults = copiedResults[:len(copiedResults)-1]
+		}
+		if err := e.payloadStore.Discard(ctx, domainID, copiedResults); err != nil {
+			e.logger.Warn("Failed to discard offloaded payloads.", tag.WorkflowDomainID(domainID), tag.Error(err))
+		}
+	}()
+
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: completionRequest.WorkflowExecution.WorkflowID,
 		RunID:      completionRequest.WorkflowExecution.RunID,
 	}
 
-	return workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
+	var closedParentChildInfo *persistence.ChildExecutionInfo
+	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
+				closedParentChildInfo, _ = mutableState.GetChildExecutionInfo(completionRequest.InitiatedID)
 				return workflow.ErrNotExists
 			}
 
-			initiatedID := completionRequest.InitiatedID
-			completedExecution := completionRequest.CompletedExecution
-			completionEvent := completionRequest.CompletionEvent
+			return e.recordChildExecutionCompleted(ctx, domainID, workflowExecution.WorkflowID, mutableState, completionRequest, &copiedResults)
+		})
+	if err != workflow.ErrNotExists || closedParentChildInfo == nil || closedParentChildInfo.StartedID == common.EmptyEventID {
+		return err
+	}
 
-			// Check mutable state to make sure child execution is in pending child executions
-			ci, isRunning := mutableState.GetChildExecutionInfo(initiatedID)
-			if !isRunning || ci.StartedID == common.EmptyEventID {
-				return &types.EntityNotExistsError{Message: "Pending child execution not found."}
-			}
-			if ci.StartedWorkflowID != completedExecution.GetWorkflowID() {
-				return &types.EntityNotExistsError{Message: "Pending child execution not found."}
+	// the parent may have been reset with the child reattached to the reset run,
+	// in which case the current run of the parent is waiting for the same child
+	return workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID,
+		types.WorkflowExecution{WorkflowID: workflowExecution.WorkflowID}, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrNotExists
 			}
 
-			switch *completionEvent.EventType {
-			case types.EventTypeWorkflowExecutionCompleted:
-				attributes := completionEvent.WorkflowExecutionCompletedEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionCompletedEvent(initiatedID, completedExecution, attributes)
-			case types.EventTypeWorkflowExecutionFailed:
-				attributes := completionEvent.WorkflowExecutionFailedEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionFailedEvent(initiatedID, completedExecution, attributes)
-			case types.EventTypeWorkflowExecutionCanceled:
-				attributes := completionEvent.WorkflowExecutionCanceledEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionCanceledEvent(initiatedID, completedExecution, attributes)
-			case types.EventTypeWorkflowExecutionTerminated:
-				attributes := completionEvent.WorkflowExecutionTerminatedEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionTerminatedEvent(initiatedID, completedExecution, attributes)
-			case types.EventTypeWorkflowExecutionTimedOut:
-				attributes := completionEvent.WorkflowExecutionTimedOutEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionTimedOutEvent(initiatedID, completedExecution, attributes)
+			ci, isRunning := mutableState.GetChildExecutionInfo(completionRequest.InitiatedID)
+			if !isRunning || ci.StartedRunID != closedParentChildInfo.StartedRunID {
+				return nil, &types.EntityNotExistsError{Message: "Pending child execution not found."}
 			}
+			if err := e.recordChildExecutionCompleted(ctx, domainID, workflowExecution.WorkflowID, mutableState, completionRequest, &copiedResults); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithNewDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) recordChildExecutionCompleted(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	mutableState execution.MutableState,
+	completionRequest *types.RecordChildExecutionCompletedRequest,
+	copiedResults *[][]byte,
+) error {
 
+	initiatedID := completionRequest.InitiatedID
+	completedExecution := completionRequest.CompletedExecution
+	completionEvent := completionRequest.CompletionEvent
+
+	// Check mutable state to make sure child execution is in pending child executions
+	ci, isRunning := mutableState.GetChildExecutionInfo(initiatedID)
+	if !isRunning || ci.StartedID == common.EmptyEventID {
+		return &types.EntityNotExistsError{Message: "Pending child execution not found."}
+	}
+	if ci.StartedWorkflowID != completedExecution.GetWorkflowID() {
+		return &types.EntityNotExistsError{Message: "Pending child execution not found."}
+	}
+
+	var err error
+	switch *completionEvent.EventType {
+	case types.EventTypeWorkflowExecutionCompleted:
+		// the result offloaded by the child is deleted along with the history of the child
+		attributes := *completionEvent.WorkflowExecutionCompletedEventAttributes
+		attributes.Result, err = e.payloadStore.Copy(ctx, ci.DomainID, domainID, workflowID, attributes.Result)
+		if err != nil {
 			return err
-		})
+		}
+		if payload.IsReference(attributes.Result) {
+			*copiedResults = append(*copiedResults, attributes.Result)
+		}
+		_, err = mutableState.AddChildWorkflowExecutionCompletedEvent(initiatedID, completedExecution, &attributes)
+	case types.EventTypeWorkflowExecutionFailed:
+		attributes := completionEvent.WorkflowExecutionFailedEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionFailedEvent(initiatedID, completedExecution, attributes)
+	case types.EventTypeWorkflowExecutionCanceled:
+		attributes := completionEvent.WorkflowExecutionCanceledEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionCanceledEvent(initiatedID, completedExecution, attributes)
+	case types.EventTypeWorkflowExecutionTerminated:
+		attributes := completionEvent.WorkflowExecutionTerminatedEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionTerminatedEvent(initiatedID, completedExecution, attributes)
+	case types.EventTypeWorkflowExecutionTimedOut:
+		attributes := completionEvent.WorkflowExecutionTimedOutEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionTimedOutEvent(initiatedID, completedExecution, attributes)
+	}
+
+	return err
 }
 
 func (e *historyEngineImpl) ReplicateEventsV2(
@@ -2728,6 +3068,15 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 			Message: "Decision finish ID must be > 1 && <= workflow next event ID.",
 		}
 	}
+	compactionNodeID, err := baseMutableState.GetHistoryCompactionNodeID()
+	if err != nil {
+		return nil, err
+	}
+	if request.GetDecisionFinishEventID() <= compactionNodeID {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("Decision finish ID must be > %v, history before it is compacted.", compactionNodeID),
+		}
+	}
 
 	// also load the current run of the workflow, it can be different from the base runID
 	resp, err := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
@@ -2798,6 +3147,11 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		baseCurrentBranchToken = baseCurrentVersionHistory.GetBranchToken()
 	}
 
+	reapplyPolicy := request.GetReapplyPolicy()
+	if reapplyPolicy == nil {
+		reapplyPolicy = execution.NewDefaultReapplyPolicy(request.GetSkipSignalReapply())
+	}
+
 	if err := e.workflowResetter.ResetWorkflow(
 		ctx,
 		domainID,
@@ -2818,7 +3172,8 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		),
 		request.GetReason(),
 		nil,
-		request.GetSkipSignalReapply(),
+		reapplyPolicy,
+		request.GetResetChildPolicy(),
 	); err != nil {
 		return nil, err
 	}
@@ -3051,6 +3406,29 @@ func (e *historyEngineImpl) overrideStartWorkflowExecutionRequest(
 	}
 }
 
+// isWaitingForStartDelay returns true if the first decision of the workflow is backed off because
+// the cron has not been started yet or the start is delayed. Runs backed off by the retry policy
+// are not considered as delayed, so that signals still trigger a decision for them.
+func isWaitingForStartDelay(
+	ctx context.Context,
+	mutableState execution.MutableState,
+) (bool, error) {
+
+	if mutableState.HasProcessedOrPendingDecision() {
+		return false, nil
+	}
+	if mutableState.GetExecutionInfo().CronSchedule != "" {
+		return true, nil
+	}
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return false, err
+	}
+	startAttributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+	return startAttributes.GetFirstDecisionTaskBackoffSeconds() > 0 &&
+		startAttributes.GetInitiator() != types.ContinueAsNewInitiatorRetryPolicy, nil
+}
+
 func getScheduleID(
 	activityID string,
 	mutableState execution.MutableState,
@@ -3088,6 +3466,8 @@ func getStartRequest(
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
 		DelayStartSeconds:                   request.DelayStartSeconds,
+		InactivityTimeoutSeconds:            request.InactivityTimeoutSeconds,
+		InactivityTimeoutPolicy:             request.InactivityTimeoutPolicy,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now())
@@ -3239,6 +3619,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 		}
 	}
 	domainID := domainEntry.GetInfo().ID
+	reapplyPolicy := execution.GetNDCReapplyPolicy(e.config, domainEntry.GetInfo().Name)
 	// remove run id from the execution so that reapply events to the current run
 	currentExecution := types.WorkflowExecution{
 		WorkflowID: workflowID,
@@ -3328,7 +3709,8 @@ func (e *historyEngineImpl) ReapplyEvents(
 					),
 					ndc.EventsReapplicationResetWorkflowReason,
 					toReapplyEvents,
-					false,
+					reapplyPolicy,
+					types.ResetChildPolicyDefault,
 				); err != nil {
 					return nil, err
 				}
@@ -3337,18 +3719,21 @@ func (e *historyEngineImpl) ReapplyEvents(
 				}, nil
 			}
 
-			postActions := &workflow.UpdateAction{
-				CreateDecision: true,
+			// Do not create decision task when the cron has not been started yet
+			// or the workflow is still waiting out its start delay
+			startDelayed, err := isWaitingForStartDelay(ctx, mutableState)
+			if err != nil {
+				return nil, err
 			}
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
-				postActions.CreateDecision = false
+			postActions := &workflow.UpdateAction{
+				CreateDecision: !startDelayed,
 			}
 			reappliedEvents, err := e.eventsReapplier.ReapplyEvents(
 				ctx,
 				mutableState,
 				toReapplyEvents,
 				runID,
+				reapplyPolicy,
 			)
 			if err != nil {
 				e.logger.Error("failed to re-apply stale events", tag.Error(err))
@@ -3450,6 +3835,7 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 		e.shard.GetClusterMetadata(),
 		e.shard.GetDomainCache(),
 		e.shard.GetEventsCache(),
+		e.shard.GetTimeSource(),
 		e.shard.GetShardID(),
 	)
 
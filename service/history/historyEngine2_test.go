@@ -35,13 +35,16 @@ import (
 	"github.com/uber-go/tally"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
@@ -142,6 +145,7 @@ func (s *engine2Suite) SetupTest() {
 		historyEventNotifier: events.NewNotifier(clock.NewRealTimeSource(), metrics.NewClient(tally.NoopScope, metrics.History), func(string) int { return 0 }),
 		txProcessor:          s.mockTxProcessor,
 		timerProcessor:       s.mockTimerProcessor,
+		payloadStore:         payload.NewStore(nil, nil, nil),
 	}
 	s.mockShard.SetEngine(h)
 	h.decisionHandler = decision.NewHandler(s.mockShard, h.executionCache, h.tokenSerializer)
@@ -1055,6 +1059,57 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
 	s.Nil(resp)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup_DeletesOffloadedInput() {
+	domainID := constants.TestDomainID
+	workflowID := "workflowID"
+	lastWriteVersion := common.EmptyVersion
+
+	blobstoreClient := &blobstore.MockClient{}
+	defer blobstoreClient.AssertExpectations(s.T())
+	s.historyEngine.payloadStore = payload.NewStore(
+		blobstoreClient,
+		dynamicconfig.GetIntPropertyFilteredByDomain(4),
+		dynamicconfig.GetIntPropertyFilteredByDomain(1024),
+	)
+	var stored *blobstore.PutRequest
+	blobstoreClient.On("Put", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*blobstore.PutRequest)
+	}).Return(&blobstore.PutResponse{}, nil).Once()
+	blobstoreClient.On("Exists", mock.Anything, mock.MatchedBy(func(request *blobstore.ExistsRequest) bool {
+		return request.Key == stored.Key
+	})).Return(&blobstore.ExistsResponse{Exists: true}, nil).Once()
+	blobstoreClient.On("Delete", mock.Anything, mock.MatchedBy(func(request *blobstore.DeleteRequest) bool {
+		return request.Key == stored.Key
+	})).Return(&blobstore.DeleteResponse{}, nil).Once()
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            "runID",
+		State:            p.WorkflowStateRunning,
+		CloseStatus:      p.WorkflowCloseStatusNone,
+		LastWriteVersion: lastWriteVersion,
+	}).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &types.HistoryStartWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:                              domainID,
+			WorkflowID:                          workflowID,
+			WorkflowType:                        &types.WorkflowType{Name: "workflowType"},
+			TaskList:                            &types.TaskList{Name: "testTaskList"},
+			Input:                               []byte("large input"),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            "testIdentity",
+			RequestID:                           "newRequestID",
+		},
+	})
+	s.IsType(&types.WorkflowExecutionAlreadyStartedError{}, err)
+	s.Nil(resp)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 	domainID := constants.TestDomainID
 	workflowID := "workflowID"
@@ -1243,6 +1298,7 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 		runID,
 		constants.TestLocalDomainEntry,
 	)
+	startEvent := test.AddWorkflowExecutionStartedEvent(msBuilder, types.WorkflowExecution{WorkflowID: workflowID, RunID: runID}, "wType", "testTaskList", []byte("input"), 100, 200, identity)
 	ms := execution.CreatePersistenceMutableState(msBuilder)
 	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
@@ -1254,6 +1310,11 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
 	}, nil).Once()
 
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, workflowID, runID,
+		common.FirstEventID, common.FirstEventID, gomock.Any(),
+	).Return(startEvent, nil).Times(1)
+
 	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.Nil(err)
 	s.Equal(runID, resp.GetRunID())
@@ -40,6 +40,7 @@ import (
 	hclient "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -47,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
@@ -61,6 +63,7 @@ import (
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
 	test "github.com/uber/cadence/service/history/testing"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -166,6 +169,7 @@ func (s *engineSuite) SetupTest() {
 		clientChecker:        cc.NewVersionChecker(),
 		eventsReapplier:      s.mockEventsReapplier,
 		workflowResetter:     s.mockWorkflowResetter,
+		payloadStore:         payload.NewStore(nil, nil, nil),
 	}
 	s.mockShard.SetEngine(h)
 	h.decisionHandler = decision.NewHandler(s.mockShard, h.executionCache, h.tokenSerializer)
@@ -1226,6 +1230,76 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedMaxAttemptsExceeded() {
 	s.Equal(workflow.ErrMaxAttemptsExceeded, err)
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedMaxAttemptsExceeded_DiscardsOffloadedPayloads() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	s.config.PayloadOffloadThreshold = dynamicconfig.GetIntPropertyFilteredByDomain(4)
+	s.config.PayloadOffloadSizeLimit = dynamicconfig.GetIntPropertyFilteredByDomain(1024)
+	s.mockHistoryEngine.decisionHandler = decision.NewHandler(s.mockShard, s.mockHistoryEngine.executionCache, s.mockHistoryEngine.tokenSerializer)
+	blobstoreClient := s.mockShard.Resource.BlobstoreClient
+	defer blobstoreClient.AssertExpectations(s.T())
+	// the result is offloaded by every attempt, and discarded when the attempt is not persisted
+	storedKeys := make(map[string]bool)
+	blobstoreClient.On("Put", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		storedKeys[args.Get(1).(*blobstore.PutRequest).Key] = true
+	}).Return(&blobstore.PutResponse{}, nil).Times(workflow.ConditionalRetryCount)
+	blobstoreClient.On("Exists", mock.Anything, mock.Anything).Return(&blobstore.ExistsResponse{Exists: true}, nil).Times(workflow.ConditionalRetryCount)
+	blobstoreClient.On("Delete", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		key := args.Get(1).(*blobstore.DeleteRequest).Key
+		s.True(storedKeys[key])
+		delete(storedKeys, key)
+	}).Return(&blobstore.DeleteResponse{}, nil).Times(workflow.ConditionalRetryCount)
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	decisions := []*types.Decision{{
+		DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+		CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{
+			Result: []byte("large result"),
+		},
+	}}
+
+	for i := 0; i < workflow.ConditionalRetryCount; i++ {
+		ms := execution.CreatePersistenceMutableState(msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+		s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}},
+			&persistence.ConditionFailedError{}).Once()
+	}
+
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &types.HistoryRespondDecisionTaskCompletedRequest{
+		DomainUUID: constants.TestDomainID,
+		CompleteRequest: &types.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Decisions: decisions,
+			Identity:  identity,
+		},
+	})
+	s.Equal(workflow.ErrMaxAttemptsExceeded, err)
+	s.Empty(storedKeys)
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowFailed() {
 
 	we := types.WorkflowExecution{
@@ -4897,6 +4971,313 @@ func (s *engineSuite) TestCancelTimer_RespondDecisionTaskCompleted_TimerFired()
 	s.False(executionBuilder.HasBufferedEvents())
 }
 
+func (s *engineSuite) TestPauseWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	identity := "testIdentity"
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Reason:            "maintenance",
+			Identity:          identity,
+		},
+	}
+
+	ms := s.createPauseTestMutableState(we, identity, false)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updatedExecutionInfo *persistence.WorkflowExecutionInfo
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updatedExecutionInfo = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest).UpdateWorkflowMutation.ExecutionInfo
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Nil(err)
+	s.NotNil(updatedExecutionInfo)
+	s.True(updatedExecutionInfo.Paused)
+}
+
+func (s *engineSuite) TestPauseWorkflowExecution_AlreadyPaused() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	identity := "testIdentity"
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	}
+
+	ms := s.createPauseTestMutableState(we, identity, true)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	identity := "testIdentity"
+	unpauseRequest := &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	}
+
+	ms := s.createPauseTestMutableState(we, identity, true)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.Nil(err)
+	s.NotNil(updateRequest)
+	s.False(updateRequest.UpdateWorkflowMutation.ExecutionInfo.Paused)
+	// the pending decision held back while paused is dispatched again
+	hasDecisionTask := false
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		if task.GetType() == persistence.TransferTaskTypeDecisionTask {
+			hasDecisionTask = true
+		}
+	}
+	s.True(hasDecisionTask)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution_NotPaused() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	identity := "testIdentity"
+	unpauseRequest := &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	}
+
+	ms := s.createPauseTestMutableState(we, identity, false)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) createPauseTestMutableState(
+	we types.WorkflowExecution,
+	identity string,
+	paused bool,
+) *persistence.WorkflowMutableState {
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.Paused = paused
+	return ms
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_NotEnabled() {
+	s.mockHistoryEngine.config.EnableWorkflowUpdateByDomain = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), s.createUpdateRequest())
+	s.Nil(resp)
+	s.Equal(workflow.ErrWorkflowUpdateNotEnabled, err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_BufferExceeded() {
+	s.mockHistoryEngine.config.MaxBufferedUpdateCount = dynamicconfig.GetIntPropertyFn(0)
+	request := s.createUpdateRequest()
+	ms := s.createPauseTestMutableState(*request.UpdateRequest.WorkflowExecution, "testIdentity", false)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), request)
+	s.Nil(resp)
+	s.Equal(workflow.ErrWorkflowUpdateBufferExceeded, err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_Completed() {
+	request := s.createUpdateRequest()
+	we := *request.UpdateRequest.WorkflowExecution
+	ms := s.createPauseTestMutableState(we, "testIdentity", false)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	waitGroup := &sync.WaitGroup{}
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		updateReg := s.getUpdateRegistry(constants.TestDomainID, we)
+		var pending []string
+		for len(pending) == 0 {
+			<-time.After(10 * time.Millisecond)
+			pending = updateReg.GetPendingIDs()
+		}
+		s.Len(pending, 1)
+		err := updateReg.SetTerminationState(pending[0], &update.TerminationState{
+			TerminationType: update.TerminationTypeCompleted,
+			UpdateResult: &types.WorkflowUpdateResult{
+				ResultType: types.QueryResultTypeAnswered.Ptr(),
+				Result:     []byte{1, 2, 3},
+			},
+		})
+		s.NoError(err)
+	}()
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), request)
+	waitGroup.Wait()
+	s.NoError(err)
+	s.Equal(&types.UpdateWorkflowExecutionResponse{Result: []byte{1, 2, 3}}, resp)
+	s.NotNil(updateRequest)
+	s.Equal(int64(4), updateRequest.UpdateWorkflowMutation.ExecutionInfo.NextEventID)
+	updateReg := s.getUpdateRegistry(constants.TestDomainID, we)
+	s.False(updateReg.HasPendingUpdate())
+	s.False(updateReg.HasCompletedUpdate())
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_Timeout() {
+	request := s.createUpdateRequest()
+	we := *request.UpdateRequest.WorkflowExecution
+	ms := s.createPauseTestMutableState(we, "testIdentity", false)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(ctx, request)
+	s.Nil(resp)
+	s.Equal(context.DeadlineExceeded, err)
+	s.False(s.getUpdateRegistry(constants.TestDomainID, we).HasPendingUpdate())
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_RecordedResult() {
+	request := s.createUpdateRequest()
+	request.UpdateRequest.RequestID = "testUpdateID"
+	we := *request.UpdateRequest.WorkflowExecution
+	ms := s.createPauseTestMutableState(we, "testIdentity", false)
+	// the result is returned even though the workflow has closed since the update completed
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = persistence.WorkflowCloseStatusCompleted
+	ms.ExecutionInfo.WorkflowUpdates = []*types.WorkflowUpdateInfo{{
+		UpdateID:                     "testUpdateID",
+		AfterEventID:                 3,
+		DecisionTaskCompletedEventID: 6,
+		CompletedEventID:             7,
+	}}
+	s.eventsCache.PutEvent(constants.TestDomainID, we.GetWorkflowID(), we.GetRunID(), 7, &types.HistoryEvent{
+		ID:        7,
+		EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+		WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+			UpdateID:                     "testUpdateID",
+			DecisionTaskCompletedEventID: 6,
+			Result:                       []byte{1, 2, 3},
+		},
+	})
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal(&types.UpdateWorkflowExecutionResponse{Result: []byte{1, 2, 3}}, resp)
+	s.False(s.getUpdateRegistry(constants.TestDomainID, we).HasPendingUpdate())
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_PendingUpdateRetried() {
+	request := s.createUpdateRequest()
+	request.UpdateRequest.RequestID = "testUpdateID"
+	we := *request.UpdateRequest.WorkflowExecution
+	ms := s.createPauseTestMutableState(we, "testIdentity", false)
+	ms.ExecutionInfo.WorkflowUpdates = []*types.WorkflowUpdateInfo{{
+		UpdateID:                     "testUpdateID",
+		AfterEventID:                 3,
+		DecisionTaskCompletedEventID: common.EmptyEventID,
+		CompletedEventID:             common.EmptyEventID,
+	}}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	// the update is not requested again, nothing is persisted
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	waitGroup := &sync.WaitGroup{}
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		updateReg := s.getUpdateRegistry(constants.TestDomainID, we)
+		for !updateReg.HasPendingUpdate() {
+			<-time.After(10 * time.Millisecond)
+		}
+		err := updateReg.SetTerminationState("testUpdateID", &update.TerminationState{
+			TerminationType: update.TerminationTypeCompleted,
+			UpdateResult: &types.WorkflowUpdateResult{
+				ResultType:   types.QueryResultTypeFailed.Ptr(),
+				ErrorMessage: "update rejected",
+			},
+		})
+		s.NoError(err)
+	}()
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), request)
+	waitGroup.Wait()
+	s.NoError(err)
+	s.Equal(&types.UpdateWorkflowExecutionResponse{ErrorMessage: "update rejected"}, resp)
+}
+
+func (s *engineSuite) createUpdateRequest() *types.HistoryUpdateWorkflowExecutionRequest {
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+			Domain: constants.TestDomainName,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      constants.TestRunID,
+			},
+			UpdateName: "testUpdate",
+			Input:      []byte("input"),
+			Identity:   "testIdentity",
+		},
+	}
+}
+
 func (s *engineSuite) TestSignalWorkflowExecution_InvalidRequest() {
 	signalRequest := &types.HistorySignalWorkflowExecutionRequest{}
 	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
@@ -4943,6 +5324,89 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_DelayedStart() {
+	// first decision is backed off by the start delay, so there is no decision yet
+	updateRequest := s.signalWorkflowWithFirstDecisionBackoff(nil)
+	s.Equal(common.EmptyEventID, updateRequest.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		s.NotEqual(persistence.TransferTaskTypeDecisionTask, task.GetType())
+	}
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_RetryBackoff() {
+	// first decision is backed off by the retry policy, which is cut short by the signal
+	updateRequest := s.signalWorkflowWithFirstDecisionBackoff(types.ContinueAsNewInitiatorRetryPolicy.Ptr())
+	s.NotEqual(common.EmptyEventID, updateRequest.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+	decisionTaskCreated := false
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		if task.GetType() == persistence.TransferTaskTypeDecisionTask {
+			decisionTaskCreated = true
+		}
+	}
+	s.True(decisionTaskCreated)
+}
+
+func (s *engineSuite) signalWorkflowWithFirstDecisionBackoff(
+	initiator *types.ContinueAsNewInitiator,
+) *persistence.UpdateWorkflowExecutionRequest {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        "my signal name",
+			Input:             []byte("test input"),
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	_, err := msBuilder.AddWorkflowExecutionStartedEvent(
+		we,
+		&types.HistoryStartWorkflowExecutionRequest{
+			DomainUUID: constants.TestDomainID,
+			StartRequest: &types.StartWorkflowExecutionRequest{
+				WorkflowID:                          we.WorkflowID,
+				WorkflowType:                        &types.WorkflowType{Name: "wType"},
+				TaskList:                            &types.TaskList{Name: tasklist},
+				Input:                               []byte("input"),
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+				Identity:                            identity,
+			},
+			ContinueAsNewInitiator:          initiator,
+			FirstDecisionTaskBackoffSeconds: common.Int32Ptr(60),
+		},
+	)
+	s.NoError(err)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.NotNil(updateRequest)
+	return updateRequest
+}
+
 // Test signal decision by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowOpen() {
 	we := types.WorkflowExecution{
@@ -5124,12 +5588,13 @@ func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 		workflowExecution.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
 	ms := execution.CreatePersistenceMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
 	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 
 	err := s.mockHistoryEngine.ReapplyEvents(
 		context.Background(),
@@ -5165,7 +5630,7 @@ func (s *engineSuite) TestReapplyEvents_IgnoreSameVersionEvents() {
 	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
 	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	err := s.mockHistoryEngine.ReapplyEvents(
 		context.Background(),
@@ -5207,10 +5672,10 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
 	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 	s.mockWorkflowResetter.EXPECT().ResetWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-		gomock.Any(),
+		gomock.Any(), types.ResetChildPolicyDefault,
 	).Return(nil).Times(1)
 	err = s.mockHistoryEngine.ReapplyEvents(
 		context.Background(),
@@ -5232,6 +5697,16 @@ func (s *engineSuite) getBuilder(testDomainID string, we types.WorkflowExecution
 	return context.GetWorkflowExecution()
 }
 
+func (s *engineSuite) getUpdateRegistry(testDomainID string, we types.WorkflowExecution) update.Registry {
+	context, release, err := s.mockHistoryEngine.executionCache.GetOrCreateWorkflowExecutionForBackground(testDomainID, we)
+	if err != nil {
+		return nil
+	}
+	defer release(nil)
+
+	return context.GetUpdateRegistry()
+}
+
 func (s *engineSuite) getActivityScheduledEvent(
 	msBuilder execution.MutableState,
 	scheduleID int64,
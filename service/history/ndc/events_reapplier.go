@@ -40,6 +40,7 @@ type (
 			msBuilder execution.MutableState,
 			historyEvents []*types.HistoryEvent,
 			runID string,
+			reapplyPolicy *types.ResetReapplyPolicy,
 		) ([]*types.HistoryEvent, error)
 	}
 
@@ -68,19 +69,20 @@ func (r *eventsReapplierImpl) ReapplyEvents(
 	msBuilder execution.MutableState,
 	historyEvents []*types.HistoryEvent,
 	runID string,
+	reapplyPolicy *types.ResetReapplyPolicy,
 ) ([]*types.HistoryEvent, error) {
 
 	var reappliedEvents []*types.HistoryEvent
 	for _, event := range historyEvents {
-		switch event.GetEventType() {
-		case types.EventTypeWorkflowExecutionSignaled:
-			dedupResource := definition.NewEventReappliedID(runID, event.ID, event.Version)
-			if msBuilder.IsResourceDuplicated(dedupResource) {
-				// skip already applied event
-				continue
-			}
-			reappliedEvents = append(reappliedEvents, event)
+		if !execution.IsReapplyEligibleEvent(reapplyPolicy, event) {
+			continue
 		}
+		dedupResource := definition.NewEventReappliedID(runID, event.ID, event.Version)
+		if msBuilder.IsResourceDuplicated(dedupResource) {
+			// skip already applied event
+			continue
+		}
+		reappliedEvents = append(reappliedEvents, event)
 	}
 
 	if len(reappliedEvents) == 0 {
@@ -95,12 +97,7 @@ func (r *eventsReapplierImpl) ReapplyEvents(
 	}
 
 	for _, event := range reappliedEvents {
-		signal := event.GetWorkflowExecutionSignaledEventAttributes()
-		if _, err := msBuilder.AddWorkflowExecutionSignaled(
-			signal.GetSignalName(),
-			signal.GetInput(),
-			signal.GetIdentity(),
-		); err != nil {
+		if err := execution.ReapplyEvent(msBuilder, event); err != nil {
 			return nil, err
 		}
 		deDupResource := definition.NewEventReappliedID(runID, event.ID, event.Version)
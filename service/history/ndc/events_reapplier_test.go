@@ -104,7 +104,7 @@ func (s *eventReapplicationSuite) TestReapplyEvents_AppliedEvent() {
 		{EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
 		event,
 	}
-	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID, execution.NewDefaultReapplyPolicy(false))
 	s.NoError(err)
 	s.Equal(1, len(appliedEvent))
 }
@@ -128,7 +128,7 @@ func (s *eventReapplicationSuite) TestReapplyEvents_Noop() {
 		{EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
 		event,
 	}
-	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID, execution.NewDefaultReapplyPolicy(false))
 	s.NoError(err)
 	s.Equal(0, len(appliedEvent))
 }
@@ -177,7 +177,7 @@ func (s *eventReapplicationSuite) TestReapplyEvents_PartialAppliedEvent() {
 		event1,
 		event2,
 	}
-	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID, execution.NewDefaultReapplyPolicy(false))
 	s.NoError(err)
 	s.Equal(1, len(appliedEvent))
 }
@@ -213,7 +213,70 @@ func (s *eventReapplicationSuite) TestReapplyEvents_Error() {
 		{EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
 		event,
 	}
-	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID, execution.NewDefaultReapplyPolicy(false))
 	s.Error(err)
 	s.Equal(0, len(appliedEvent))
 }
+
+func (s *eventReapplicationSuite) TestReapplyEvents_ReapplyPolicy() {
+	runID := uuid.New()
+	signalEvent := &types.HistoryEvent{
+		ID:        1,
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			Identity:   "test",
+			SignalName: "signal",
+			Input:      []byte{},
+		},
+	}
+	upsertEvent := &types.HistoryEvent{
+		ID:        2,
+		EventType: types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+		UpsertWorkflowSearchAttributesEventAttributes: &types.UpsertWorkflowSearchAttributesEventAttributes{
+			SearchAttributes: &types.SearchAttributes{
+				IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+			},
+		},
+	}
+	cancelEvent := &types.HistoryEvent{
+		ID:        3,
+		EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+		WorkflowExecutionCancelRequestedEventAttributes: &types.WorkflowExecutionCancelRequestedEventAttributes{
+			Cause:    "cause",
+			Identity: "test",
+		},
+	}
+
+	msBuilderCurrent := execution.NewMockMutableState(s.controller)
+	msBuilderCurrent.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	msBuilderCurrent.EXPECT().AddWorkflowSearchAttributesReappliedEvent(
+		upsertEvent.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes,
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	msBuilderCurrent.EXPECT().IsCancelRequested().Return(false, "").Times(1)
+	msBuilderCurrent.EXPECT().AddWorkflowExecutionCancelRequestedEvent(
+		"cause",
+		&types.HistoryRequestCancelWorkflowExecutionRequest{
+			CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+				Identity: "test",
+			},
+		},
+	).Return(cancelEvent, nil).Times(1)
+	for _, event := range []*types.HistoryEvent{upsertEvent, cancelEvent} {
+		dedupResource := definition.NewEventReappliedID(runID, event.ID, event.Version)
+		msBuilderCurrent.EXPECT().IsResourceDuplicated(dedupResource).Return(false).Times(1)
+		msBuilderCurrent.EXPECT().UpdateDuplicatedResource(dedupResource).Times(1)
+	}
+	events := []*types.HistoryEvent{
+		{EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		signalEvent,
+		upsertEvent,
+		cancelEvent,
+	}
+	policy := &types.ResetReapplyPolicy{
+		ReapplyUpsertSearchAttributes: true,
+		ReapplyCancelRequests:         true,
+	}
+	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID, policy)
+	s.NoError(err)
+	s.Equal([]*types.HistoryEvent{upsertEvent, cancelEvent}, appliedEvent)
+}
@@ -280,6 +280,10 @@ func (r *transactionManagerImpl) backfillWorkflowEventsReapply(
 	)
 	currentCluster := r.clusterMetadata.GetCurrentClusterName()
 	isActiveCluster := targetWorkflowActiveCluster == currentCluster
+	reapplyPolicy := execution.GetNDCReapplyPolicy(
+		r.shard.GetConfig(),
+		targetWorkflow.GetMutableState().GetDomainEntry().GetInfo().Name,
+	)
 
 	// workflow events reapplication
 	// we need to handle 3 cases
@@ -297,6 +301,7 @@ func (r *transactionManagerImpl) backfillWorkflowEventsReapply(
 				targetWorkflow.GetMutableState(),
 				targetWorkflowEvents.Events,
 				targetWorkflow.GetMutableState().GetExecutionInfo().RunID,
+				reapplyPolicy,
 			); err != nil {
 				return 0, execution.TransactionPolicyActive, err
 			}
@@ -353,7 +358,8 @@ func (r *transactionManagerImpl) backfillWorkflowEventsReapply(
 			targetWorkflow,
 			EventsReapplicationResetWorkflowReason,
 			targetWorkflowEvents.Events,
-			false,
+			reapplyPolicy,
+			types.ResetChildPolicyDefault,
 		); err != nil {
 			return 0, execution.TransactionPolicyActive, err
 		}
@@ -24,13 +24,17 @@ package reset
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/collection"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
 	"github.com/uber/cadence/common/types"
@@ -38,6 +42,12 @@ import (
 	"github.com/uber/cadence/service/history/shard"
 )
 
+const (
+	// childWorkflowDeletedReason is the reason of the terminated event resolving a reattached
+	// child workflow which closed and was deleted by retention before the reset
+	childWorkflowDeletedReason = "child workflow closed and was deleted by retention"
+)
+
 type (
 	// WorkflowResetter is the new NDC compatible workflow reset component
 	WorkflowResetter interface {
@@ -55,7 +65,8 @@ type (
 			currentWorkflow execution.Workflow,
 			resetReason string,
 			additionalReapplyEvents []*types.HistoryEvent,
-			skipSignalReapply bool,
+			reapplyPolicy *types.ResetReapplyPolicy,
+			resetChildPolicy types.ResetChildPolicy,
 		) error
 	}
 
@@ -64,6 +75,8 @@ type (
 		domainCache       cache.DomainCache
 		clusterMetadata   cluster.Metadata
 		historyV2Mgr      persistence.HistoryManager
+		historyClient     history.Client
+		payloadStore      *payload.Store
 		executionCache    *execution.Cache
 		newStateRebuilder nDCStateRebuilderProvider
 		logger            log.Logger
@@ -85,7 +98,13 @@ func NewWorkflowResetter(
 		domainCache:     shard.GetDomainCache(),
 		clusterMetadata: shard.GetClusterMetadata(),
 		historyV2Mgr:    shard.GetHistoryManager(),
-		executionCache:  executionCache,
+		historyClient:   shard.GetService().GetHistoryClient(),
+		payloadStore: payload.NewStore(
+			shard.GetService().GetBlobstoreClient(),
+			shard.GetConfig().PayloadOffloadThreshold,
+			shard.GetConfig().PayloadOffloadSizeLimit,
+		),
+		executionCache: executionCache,
 		newStateRebuilder: func() execution.StateRebuilder {
 			return execution.NewStateRebuilder(shard, logger)
 		},
@@ -107,7 +126,8 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	currentWorkflow execution.Workflow,
 	resetReason string,
 	additionalReapplyEvents []*types.HistoryEvent,
-	skipSignalReapply bool,
+	reapplyPolicy *types.ResetReapplyPolicy,
+	resetChildPolicy types.ResetChildPolicy,
 ) (retError error) {
 
 	domainEntry, err := r.domainCache.GetDomainByID(domainID)
@@ -129,7 +149,18 @@ func (r *workflowResetterImpl) ResetWorkflow(
 		currentWorkflowTerminated = true
 	}
 
-	resetWorkflow, err := r.prepareResetWorkflow(
+	// the results of closed children copied for the reset run are only referenced by its history
+	var copiedResults [][]byte
+	defer func() {
+		if payload.MayBePersisted(retError) {
+			return
+		}
+		if err := r.payloadStore.Discard(ctx, domainID, copiedResults); err != nil {
+			r.logger.Warn("Failed to discard offloaded payloads.", tag.WorkflowDomainID(domainID), tag.Error(err))
+		}
+	}()
+
+	resetWorkflow, childrenStartedAfterReset, err := r.prepareResetWorkflow(
 		ctx,
 		domainID,
 		workflowID,
@@ -143,19 +174,39 @@ func (r *workflowResetterImpl) ResetWorkflow(
 		resetWorkflowVersion,
 		resetReason,
 		additionalReapplyEvents,
-		skipSignalReapply,
+		reapplyPolicy,
+		resetChildPolicy,
+		&copiedResults,
 	)
 	if err != nil {
 		return err
 	}
 	defer resetWorkflow.GetReleaseFn()(retError)
 
-	return r.persistToDB(
+	if currentWorkflowTerminated && resetChildPolicy != types.ResetChildPolicyDefault &&
+		currentMutableState.GetExecutionInfo().RunID == baseRunID {
+		// children reattached to the reset run must survive the termination of the base run
+		if err := r.abandonReattachedChildWorkflows(
+			currentMutableState,
+			resetWorkflow.GetMutableState(),
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := r.persistToDB(
 		ctx,
 		currentWorkflowTerminated,
 		currentWorkflow,
 		resetWorkflow,
-	)
+	); err != nil {
+		return err
+	}
+
+	if resetChildPolicy == types.ResetChildPolicyReattachAndTerminate {
+		r.terminateChildWorkflows(ctx, workflowID, baseRunID, resetReason, childrenStartedAfterReset)
+	}
+	return nil
 }
 
 func (r *workflowResetterImpl) prepareResetWorkflow(
@@ -172,8 +223,10 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	resetWorkflowVersion int64,
 	resetReason string,
 	additionalReapplyEvents []*types.HistoryEvent,
-	skipSignalReapply bool,
-) (execution.Workflow, error) {
+	reapplyPolicy *types.ResetReapplyPolicy,
+	resetChildPolicy types.ResetChildPolicy,
+	copiedResults *[][]byte,
+) (execution.Workflow, []*types.ChildWorkflowExecutionStartedEventAttributes, error) {
 
 	resetWorkflow, err := r.replayResetWorkflow(
 		ctx,
@@ -187,14 +240,14 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		resetRequestID,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	resetMutableState := resetWorkflow.GetMutableState()
 
 	baseLastEventVersion := resetMutableState.GetCurrentVersion()
 	if baseLastEventVersion > resetWorkflowVersion {
-		return nil, &types.InternalServiceError{
+		return nil, nil, &types.InternalServiceError{
 			Message: "workflowResetter encounter version mismatch.",
 		}
 	}
@@ -202,7 +255,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		resetWorkflowVersion,
 		false,
 	); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	resetMutableState, err = r.closePendingDecisionTask(
@@ -211,19 +264,35 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		resetRunID,
 		baseLastEventVersion,
 		resetReason,
+		resetChildPolicy,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err := r.failInflightActivity(resetMutableState, resetReason); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	var childrenStartedAfterReset []*types.ChildWorkflowExecutionStartedEventAttributes
+	if resetChildPolicy != types.ResetChildPolicyDefault {
+		if childrenStartedAfterReset, err = r.reconcileChildWorkflows(
+			ctx,
+			resetMutableState,
+			domainID,
+			workflowID,
+			baseBranchToken,
+			baseRebuildLastEventID+1,
+			baseNextEventID,
+			copiedResults,
+		); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	// TODO right now only signals are eligible for reapply, so we can directly skip the whole reapply process
-	// for the sake of performance. In the future, if there are other events that need to be reapplied, remove this check
-	// For example, we may want to re-apply activity/timer results for https://github.com/uber/cadence/issues/2934
-	if !skipSignalReapply {
+	// when the policy carries forward no event category, skip the whole reapply process for the sake of performance
+	// TODO we may want to re-apply activity/timer results for https://github.com/uber/cadence/issues/2934
+	if !execution.IsReapplyPolicyEmpty(reapplyPolicy) {
 		if err := r.reapplyResetAndContinueAsNewWorkflowEvents(
 			ctx,
 			resetMutableState,
@@ -233,22 +302,28 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 			baseBranchToken,
 			baseRebuildLastEventID+1,
 			baseNextEventID,
+			reapplyPolicy,
 		); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 	}
 
-	// NOTE: this is reapplying events that are passing into the API that we shouldn't skip
-	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
-		return nil, err
+	// NOTE: this is reapplying events that are passing into the API, signals among them are never skipped
+	additionalReapplyPolicy := &types.ResetReapplyPolicy{
+		ReapplySignals:                true,
+		ReapplyUpsertSearchAttributes: reapplyPolicy.GetReapplyUpsertSearchAttributes(),
+		ReapplyCancelRequests:         reapplyPolicy.GetReapplyCancelRequests(),
+	}
+	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents, additionalReapplyPolicy); err != nil {
+		return nil, nil, err
 	}
 
 	if err := execution.ScheduleDecision(resetMutableState); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resetWorkflow, nil
+	return resetWorkflow, childrenStartedAfterReset, nil
 }
 
 func (r *workflowResetterImpl) persistToDB(
@@ -405,6 +480,406 @@ func (r *workflowResetterImpl) failInflightActivity(
 	return nil
 }
 
+// reconcileChildWorkflows brings the pending child workflows of the reset run up to date with the base run:
+// child workflow events recorded by the base run after the reset point are reapplied and children that closed
+// in the meantime are resolved. It returns the children initiated by the base run after the reset point
+// which have not closed yet. The offloaded results of closed children are copied for the reset run and
+// appended to copiedResults.
+func (r *workflowResetterImpl) reconcileChildWorkflows(
+	ctx context.Context,
+	resetMutableState execution.MutableState,
+	domainID string,
+	workflowID string,
+	baseBranchToken []byte,
+	baseRebuildNextEventID int64,
+	baseNextEventID int64,
+	copiedResults *[][]byte,
+) ([]*types.ChildWorkflowExecutionStartedEventAttributes, error) {
+
+	startedAfterReset := make(map[int64]*types.ChildWorkflowExecutionStartedEventAttributes)
+	if baseRebuildNextEventID < baseNextEventID {
+		iter := collection.NewPagingIterator(r.getPaginationFn(
+			ctx,
+			baseRebuildNextEventID,
+			baseNextEventID,
+			baseBranchToken,
+		))
+		for iter.HasNext() {
+			batch, err := iter.Next()
+			if err != nil {
+				return nil, err
+			}
+			for _, event := range batch.(*types.History).Events {
+				if err := r.reapplyChildWorkflowEvent(
+					ctx,
+					resetMutableState,
+					domainID,
+					event,
+					startedAfterReset,
+					copiedResults,
+				); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	for _, ci := range resetMutableState.GetPendingChildExecutionInfos() {
+		if ci.StartedID == common.EmptyEventID {
+			// the start child workflow task is regenerated for the reset run
+			continue
+		}
+		if err := r.reattachChildWorkflow(ctx, resetMutableState, ci, copiedResults); err != nil {
+			return nil, err
+		}
+	}
+
+	children := make([]*types.ChildWorkflowExecutionStartedEventAttributes, 0, len(startedAfterReset))
+	for _, attr := range startedAfterReset {
+		children = append(children, attr)
+	}
+	return children, nil
+}
+
+func (r *workflowResetterImpl) reapplyChildWorkflowEvent(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	domainID string,
+	event *types.HistoryEvent,
+	startedAfterReset map[int64]*types.ChildWorkflowExecutionStartedEventAttributes,
+	copiedResults *[][]byte,
+) error {
+
+	switch event.GetEventType() {
+	case types.EventTypeChildWorkflowExecutionStarted:
+		attr := event.ChildWorkflowExecutionStartedEventAttributes
+		ci, ok := mutableState.GetChildExecutionInfo(attr.InitiatedEventID)
+		if !ok {
+			// child is initiated after the reset point
+			startedAfterReset[attr.InitiatedEventID] = attr
+			return nil
+		}
+		if ci.StartedID != common.EmptyEventID {
+			return nil
+		}
+		_, err := mutableState.AddChildWorkflowExecutionStartedEvent(
+			attr.Domain,
+			attr.WorkflowExecution,
+			attr.WorkflowType,
+			attr.InitiatedEventID,
+			attr.Header,
+		)
+		return err
+
+	case types.EventTypeStartChildWorkflowExecutionFailed:
+		attr := event.StartChildWorkflowExecutionFailedEventAttributes
+		ci, ok := mutableState.GetChildExecutionInfo(attr.InitiatedEventID)
+		if !ok || ci.StartedID != common.EmptyEventID {
+			return nil
+		}
+		initiatedEvent, err := mutableState.GetChildExecutionInitiatedEvent(ctx, attr.InitiatedEventID)
+		if err != nil {
+			return err
+		}
+		_, err = mutableState.AddStartChildWorkflowExecutionFailedEvent(
+			attr.InitiatedEventID,
+			*attr.Cause,
+			initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes,
+		)
+		return err
+
+	default:
+		initiatedID, childExecution, closeEvent := toChildWorkflowCloseEvent(event)
+		if closeEvent == nil {
+			// events other than child workflow events will be ignored
+			return nil
+		}
+		if _, ok := startedAfterReset[initiatedID]; ok {
+			delete(startedAfterReset, initiatedID)
+			return nil
+		}
+		ci, ok := mutableState.GetChildExecutionInfo(initiatedID)
+		if !ok || ci.StartedID == common.EmptyEventID {
+			return nil
+		}
+		return r.addChildWorkflowCloseEvent(ctx, mutableState, initiatedID, domainID, childExecution, closeEvent, copiedResults)
+	}
+}
+
+func (r *workflowResetterImpl) reattachChildWorkflow(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	ci *persistence.ChildExecutionInfo,
+	copiedResults *[][]byte,
+) error {
+
+	domainEntry, err := r.domainCache.GetDomainByID(mutableState.GetExecutionInfo().DomainID)
+	if err != nil {
+		return err
+	}
+	childDomainID, err := execution.GetChildExecutionDomainID(ci, r.domainCache, domainEntry)
+	if err != nil {
+		return err
+	}
+	childShardID := common.WorkflowIDToHistoryShard(ci.StartedWorkflowID, r.shard.GetConfig().NumberOfShards)
+
+	childExecution := &types.WorkflowExecution{
+		WorkflowID: ci.StartedWorkflowID,
+		RunID:      ci.StartedRunID,
+	}
+	for {
+		response, err := r.historyClient.GetMutableState(ctx, &types.GetMutableStateRequest{
+			DomainUUID: childDomainID,
+			Execution:  childExecution,
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				// the close event of the child is gone along with its history
+				r.logger.Warn("Child workflow no longer exists, resolving it as terminated",
+					tag.WorkflowDomainID(childDomainID),
+					tag.WorkflowID(childExecution.GetWorkflowID()),
+					tag.WorkflowRunID(childExecution.GetRunID()),
+				)
+				_, err = mutableState.AddChildWorkflowExecutionTerminatedEvent(
+					ci.InitiatedID,
+					childExecution,
+					&types.WorkflowExecutionTerminatedEventAttributes{
+						Reason:   childWorkflowDeletedReason,
+						Identity: execution.IdentityHistoryService,
+					},
+				)
+			}
+			return err
+		}
+		if response.GetIsWorkflowRunning() {
+			return nil
+		}
+
+		closeEvent, err := r.getLastEvent(
+			ctx,
+			response.CurrentBranchToken,
+			response.LastFirstEventID,
+			response.GetNextEventID(),
+			childShardID,
+		)
+		if err != nil {
+			return err
+		}
+		if closeEvent.GetEventType() != types.EventTypeWorkflowExecutionContinuedAsNew {
+			return r.addChildWorkflowCloseEvent(ctx, mutableState, ci.InitiatedID, childDomainID, childExecution, closeEvent, copiedResults)
+		}
+		// parent is only notified once the last run of the child closes
+		childExecution = &types.WorkflowExecution{
+			WorkflowID: ci.StartedWorkflowID,
+			RunID:      closeEvent.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunID(),
+		}
+	}
+}
+
+func (r *workflowResetterImpl) getLastEvent(
+	ctx context.Context,
+	branchToken []byte,
+	firstEventID int64,
+	nextEventID int64,
+	shardID int,
+) (*types.HistoryEvent, error) {
+
+	var lastEvent *types.HistoryEvent
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  firstEventID,
+		MaxEventID:  nextEventID,
+		PageSize:    execution.NDCDefaultPageSize,
+		ShardID:     common.IntPtr(shardID),
+	}
+	for {
+		response, err := r.historyV2Mgr.ReadHistoryBranch(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.HistoryEvents) > 0 {
+			lastEvent = response.HistoryEvents[len(response.HistoryEvents)-1]
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	if lastEvent == nil {
+		return nil, &types.InternalServiceError{
+			Message: "workflowResetter unable to load close event of child workflow",
+		}
+	}
+	return lastEvent, nil
+}
+
+func (r *workflowResetterImpl) addChildWorkflowCloseEvent(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	initiatedID int64,
+	sourceDomainID string,
+	childExecution *types.WorkflowExecution,
+	closeEvent *types.HistoryEvent,
+	copiedResults *[][]byte,
+) error {
+
+	var err error
+	switch closeEvent.GetEventType() {
+	case types.EventTypeWorkflowExecutionCompleted:
+		// the result may be offloaded by a workflow whose history is deleted independently of the reset run
+		executionInfo := mutableState.GetExecutionInfo()
+		attributes := *closeEvent.WorkflowExecutionCompletedEventAttributes
+		attributes.Result, err = r.payloadStore.Copy(
+			ctx,
+			sourceDomainID,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			attributes.Result,
+		)
+		if err != nil {
+			return err
+		}
+		if payload.IsReference(attributes.Result) {
+			*copiedResults = append(*copiedResults, attributes.Result)
+		}
+		_, err = mutableState.AddChildWorkflowExecutionCompletedEvent(initiatedID, childExecution, &attributes)
+	case types.EventTypeWorkflowExecutionFailed:
+		attributes := closeEvent.WorkflowExecutionFailedEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionFailedEvent(initiatedID, childExecution, attributes)
+	case types.EventTypeWorkflowExecutionCanceled:
+		attributes := closeEvent.WorkflowExecutionCanceledEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionCanceledEvent(initiatedID, childExecution, attributes)
+	case types.EventTypeWorkflowExecutionTerminated:
+		attributes := closeEvent.WorkflowExecutionTerminatedEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionTerminatedEvent(initiatedID, childExecution, attributes)
+	case types.EventTypeWorkflowExecutionTimedOut:
+		attributes := closeEvent.WorkflowExecutionTimedOutEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionTimedOutEvent(initiatedID, childExecution, attributes)
+	default:
+		return &types.InternalServiceError{
+			Message: fmt.Sprintf("workflowResetter encounter unexpected child workflow close event: %v", closeEvent.GetEventType()),
+		}
+	}
+	return err
+}
+
+// abandonReattachedChildWorkflows prevents the parent close policy of the terminated base run
+// from being applied to the children reattached to the reset run
+func (r *workflowResetterImpl) abandonReattachedChildWorkflows(
+	baseMutableState execution.MutableState,
+	resetMutableState execution.MutableState,
+) error {
+
+	for initiatedID, ci := range baseMutableState.GetPendingChildExecutionInfos() {
+		if ci.ParentClosePolicy == types.ParentClosePolicyAbandon {
+			continue
+		}
+		resetChildInfo, ok := resetMutableState.GetChildExecutionInfo(initiatedID)
+		if !ok || resetChildInfo.StartedRunID != ci.StartedRunID {
+			continue
+		}
+		childInfo := *ci
+		childInfo.ParentClosePolicy = types.ParentClosePolicyAbandon
+		if err := baseMutableState.UpdateChildExecutionInfo(&childInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *workflowResetterImpl) terminateChildWorkflows(
+	ctx context.Context,
+	workflowID string,
+	baseRunID string,
+	resetReason string,
+	children []*types.ChildWorkflowExecutionStartedEventAttributes,
+) {
+
+	for _, child := range children {
+		childDomainID, err := r.domainCache.GetDomainID(child.GetDomain())
+		if err == nil {
+			err = r.historyClient.TerminateWorkflowExecution(ctx, &types.HistoryTerminateWorkflowExecutionRequest{
+				DomainUUID: childDomainID,
+				TerminateRequest: &types.TerminateWorkflowExecutionRequest{
+					Domain: child.GetDomain(),
+					WorkflowExecution: &types.WorkflowExecution{
+						WorkflowID: child.WorkflowExecution.GetWorkflowID(),
+					},
+					Reason:   resetReason,
+					Identity: execution.IdentityHistoryService,
+				},
+				ExternalWorkflowExecution: &types.WorkflowExecution{
+					WorkflowID: workflowID,
+					RunID:      baseRunID,
+				},
+				ChildWorkflowOnly: true,
+			})
+		}
+
+		switch err.(type) {
+		case nil, *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError:
+		default:
+			// the reset is already persisted, remaining children are left for the operator
+			r.logger.Warn("Failed to terminate child workflow started after reset point.",
+				tag.WorkflowID(child.WorkflowExecution.GetWorkflowID()),
+				tag.Error(err),
+			)
+		}
+	}
+}
+
+// toChildWorkflowCloseEvent converts the child workflow close event recorded by the parent
+// back into the close event of the child workflow
+func toChildWorkflowCloseEvent(
+	event *types.HistoryEvent,
+) (int64, *types.WorkflowExecution, *types.HistoryEvent) {
+
+	switch event.GetEventType() {
+	case types.EventTypeChildWorkflowExecutionCompleted:
+		attr := event.ChildWorkflowExecutionCompletedEventAttributes
+		return attr.InitiatedEventID, attr.WorkflowExecution, &types.HistoryEvent{
+			EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+			WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{
+				Result: attr.Result,
+			},
+		}
+	case types.EventTypeChildWorkflowExecutionFailed:
+		attr := event.ChildWorkflowExecutionFailedEventAttributes
+		return attr.InitiatedEventID, attr.WorkflowExecution, &types.HistoryEvent{
+			EventType: types.EventTypeWorkflowExecutionFailed.Ptr(),
+			WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{
+				Reason:  attr.Reason,
+				Details: attr.Details,
+			},
+		}
+	case types.EventTypeChildWorkflowExecutionCanceled:
+		attr := event.ChildWorkflowExecutionCanceledEventAttributes
+		return attr.InitiatedEventID, attr.WorkflowExecution, &types.HistoryEvent{
+			EventType: types.EventTypeWorkflowExecutionCanceled.Ptr(),
+			WorkflowExecutionCanceledEventAttributes: &types.WorkflowExecutionCanceledEventAttributes{
+				Details: attr.Details,
+			},
+		}
+	case types.EventTypeChildWorkflowExecutionTerminated:
+		attr := event.ChildWorkflowExecutionTerminatedEventAttributes
+		return attr.InitiatedEventID, attr.WorkflowExecution, &types.HistoryEvent{
+			EventType: types.EventTypeWorkflowExecutionTerminated.Ptr(),
+			WorkflowExecutionTerminatedEventAttributes: &types.WorkflowExecutionTerminatedEventAttributes{},
+		}
+	case types.EventTypeChildWorkflowExecutionTimedOut:
+		attr := event.ChildWorkflowExecutionTimedOutEventAttributes
+		return attr.InitiatedEventID, attr.WorkflowExecution, &types.HistoryEvent{
+			EventType: types.EventTypeWorkflowExecutionTimedOut.Ptr(),
+			WorkflowExecutionTimedOutEventAttributes: &types.WorkflowExecutionTimedOutEventAttributes{
+				TimeoutType: attr.TimeoutType,
+			},
+		}
+	default:
+		return 0, nil, nil
+	}
+}
+
 func (r *workflowResetterImpl) forkAndGenerateBranchToken(
 	ctx context.Context,
 	domainID string,
@@ -452,6 +927,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 	baseBranchToken []byte,
 	baseRebuildNextEventID int64,
 	baseNextEventID int64,
+	reapplyPolicy *types.ResetReapplyPolicy,
 ) error {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -467,6 +943,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 		baseRebuildNextEventID,
 		baseNextEventID,
 		baseBranchToken,
+		reapplyPolicy,
 	); err != nil {
 		return err
 	}
@@ -512,6 +989,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 			common.FirstEventID,
 			nextWorkflowNextEventID,
 			nextWorkflowBranchToken,
+			reapplyPolicy,
 		); err != nil {
 			return err
 		}
@@ -525,6 +1003,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 	firstEventID int64,
 	nextEventID int64,
 	branchToken []byte,
+	reapplyPolicy *types.ResetReapplyPolicy,
 ) (string, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -553,7 +1032,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 			return "", err
 		}
 		lastEvents = batch.(*types.History).Events
-		if err := r.reapplyEvents(mutableState, lastEvents); err != nil {
+		if err := r.reapplyEvents(mutableState, lastEvents, reapplyPolicy); err != nil {
 			return "", err
 		}
 	}
@@ -570,21 +1049,16 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 func (r *workflowResetterImpl) reapplyEvents(
 	mutableState execution.MutableState,
 	events []*types.HistoryEvent,
+	reapplyPolicy *types.ResetReapplyPolicy,
 ) error {
 
 	for _, event := range events {
-		switch event.GetEventType() {
-		case types.EventTypeWorkflowExecutionSignaled:
-			attr := event.GetWorkflowExecutionSignaledEventAttributes()
-			if _, err := mutableState.AddWorkflowExecutionSignaled(
-				attr.GetSignalName(),
-				attr.GetInput(),
-				attr.GetIdentity(),
-			); err != nil {
-				return err
-			}
-		default:
-			// events other than signal will be ignored
+		if !execution.IsReapplyEligibleEvent(reapplyPolicy, event) {
+			// events not carried forward by the reapply policy will be ignored
+			continue
+		}
+		if err := execution.ReapplyEvent(mutableState, event); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -628,9 +1102,10 @@ func (r *workflowResetterImpl) closePendingDecisionTask(
 	resetRunID string,
 	baseLastEventVersion int64,
 	resetReason string,
+	resetChildPolicy types.ResetChildPolicy,
 ) (execution.MutableState, error) {
 
-	if len(resetMutableState.GetPendingChildExecutionInfos()) > 0 {
+	if resetChildPolicy == types.ResetChildPolicyDefault && len(resetMutableState.GetPendingChildExecutionInfos()) > 0 {
 		return nil, &types.BadRequestError{
 			Message: "Can not reset workflow with pending child workflows",
 		}
@@ -465,6 +465,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 		baseBranchToken,
 		baseFirstEventID,
 		baseNextEventID,
+		execution.NewDefaultReapplyPolicy(false),
 	)
 	s.NoError(err)
 }
@@ -523,6 +524,7 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 		firstEventID,
 		nextEventID,
 		branchToken,
+		execution.NewDefaultReapplyPolicy(false),
 	)
 	s.NoError(err)
 	s.Equal(newRunID, nextRunID)
@@ -562,6 +564,7 @@ func (s *workflowResetterSuite) TestClosePendingDecisionTask() {
 		newRunID,
 		baseForkEventVerison,
 		reason,
+		types.ResetChildPolicyDefault,
 	)
 	s.NoError(err)
 
@@ -582,10 +585,275 @@ func (s *workflowResetterSuite) TestClosePendingDecisionTask() {
 		newRunID,
 		baseForkEventVerison,
 		reason,
+		types.ResetChildPolicyDefault,
 	)
 	s.NoError(err)
 }
 
+func (s *workflowResetterSuite) TestClosePendingDecisionTask_PendingChildWorkflows() {
+	sourceMutableState := execution.NewMockMutableState(s.controller)
+	baseRunID := uuid.New()
+	newRunID := uuid.New()
+	baseForkEventVerison := int64(10)
+	reason := "some random reason"
+
+	sourceMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{
+		5: {InitiatedID: 5},
+	}).Times(1)
+	_, err := s.workflowResetter.closePendingDecisionTask(
+		sourceMutableState,
+		baseRunID,
+		newRunID,
+		baseForkEventVerison,
+		reason,
+		types.ResetChildPolicyDefault,
+	)
+	s.IsType(&types.BadRequestError{}, err)
+
+	sourceMutableState.EXPECT().GetInFlightDecision().Return(nil, false).Times(1)
+	sourceMutableState.EXPECT().GetPendingDecision().Return(nil, false).Times(1)
+	_, err = s.workflowResetter.closePendingDecisionTask(
+		sourceMutableState,
+		baseRunID,
+		newRunID,
+		baseForkEventVerison,
+		reason,
+		types.ResetChildPolicyReattach,
+	)
+	s.NoError(err)
+}
+
+func (s *workflowResetterSuite) TestReapplyChildWorkflowEvent() {
+	mutableState := execution.NewMockMutableState(s.controller)
+	startedAfterReset := make(map[int64]*types.ChildWorkflowExecutionStartedEventAttributes)
+	var copiedResults [][]byte
+	childExecution := &types.WorkflowExecution{WorkflowID: "child workflow ID", RunID: uuid.New()}
+
+	// child initiated before the reset point and started after it
+	startedAttr := &types.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            constants.TestDomainName,
+		InitiatedEventID:  5,
+		WorkflowExecution: childExecution,
+		WorkflowType:      &types.WorkflowType{Name: "some random workflow type"},
+	}
+	mutableState.EXPECT().GetChildExecutionInfo(int64(5)).Return(&persistence.ChildExecutionInfo{
+		InitiatedID: 5,
+		StartedID:   common.EmptyEventID,
+	}, true).Times(1)
+	mutableState.EXPECT().AddChildWorkflowExecutionStartedEvent(
+		startedAttr.Domain,
+		startedAttr.WorkflowExecution,
+		startedAttr.WorkflowType,
+		startedAttr.InitiatedEventID,
+		startedAttr.Header,
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	s.NoError(s.workflowResetter.reapplyChildWorkflowEvent(context.Background(), mutableState, s.domainID, &types.HistoryEvent{
+		ID:        20,
+		EventType: types.EventTypeChildWorkflowExecutionStarted.Ptr(),
+		ChildWorkflowExecutionStartedEventAttributes: startedAttr,
+	}, startedAfterReset, &copiedResults))
+	s.Empty(startedAfterReset)
+
+	// child initiated after the reset point
+	mutableState.EXPECT().GetChildExecutionInfo(int64(21)).Return(nil, false).Times(1)
+	s.NoError(s.workflowResetter.reapplyChildWorkflowEvent(context.Background(), mutableState, s.domainID, &types.HistoryEvent{
+		ID:        22,
+		EventType: types.EventTypeChildWorkflowExecutionStarted.Ptr(),
+		ChildWorkflowExecutionStartedEventAttributes: &types.ChildWorkflowExecutionStartedEventAttributes{
+			Domain:            constants.TestDomainName,
+			InitiatedEventID:  21,
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: "another child workflow ID", RunID: uuid.New()},
+		},
+	}, startedAfterReset, &copiedResults))
+	s.Len(startedAfterReset, 1)
+
+	// child initiated after the reset point closes
+	s.NoError(s.workflowResetter.reapplyChildWorkflowEvent(context.Background(), mutableState, s.domainID, &types.HistoryEvent{
+		ID:        23,
+		EventType: types.EventTypeChildWorkflowExecutionTerminated.Ptr(),
+		ChildWorkflowExecutionTerminatedEventAttributes: &types.ChildWorkflowExecutionTerminatedEventAttributes{
+			InitiatedEventID: 21,
+		},
+	}, startedAfterReset, &copiedResults))
+	s.Empty(startedAfterReset)
+
+	// reattached child closes
+	mutableState.EXPECT().GetChildExecutionInfo(int64(5)).Return(&persistence.ChildExecutionInfo{
+		InitiatedID: 5,
+		StartedID:   20,
+	}, true).Times(1)
+	mutableState.EXPECT().AddChildWorkflowExecutionFailedEvent(
+		int64(5),
+		childExecution,
+		&types.WorkflowExecutionFailedEventAttributes{
+			Reason:  common.StringPtr("some random failure reason"),
+			Details: []byte("some random failure details"),
+		},
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	s.NoError(s.workflowResetter.reapplyChildWorkflowEvent(context.Background(), mutableState, s.domainID, &types.HistoryEvent{
+		ID:        24,
+		EventType: types.EventTypeChildWorkflowExecutionFailed.Ptr(),
+		ChildWorkflowExecutionFailedEventAttributes: &types.ChildWorkflowExecutionFailedEventAttributes{
+			Reason:            common.StringPtr("some random failure reason"),
+			Details:           []byte("some random failure details"),
+			InitiatedEventID:  5,
+			WorkflowExecution: childExecution,
+		},
+	}, startedAfterReset, &copiedResults))
+}
+
+func (s *workflowResetterSuite) TestReattachChildWorkflow() {
+	mutableState := execution.NewMockMutableState(s.controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
+		RunID:      s.resetRunID,
+	}).AnyTimes()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(s.domainID).Return(constants.TestLocalDomainEntry, nil).AnyTimes()
+
+	ci := &persistence.ChildExecutionInfo{
+		InitiatedID:       5,
+		StartedID:         6,
+		StartedWorkflowID: "child workflow ID",
+		StartedRunID:      uuid.New(),
+		DomainID:          s.domainID,
+	}
+	childExecution := &types.WorkflowExecution{
+		WorkflowID: ci.StartedWorkflowID,
+		RunID:      ci.StartedRunID,
+	}
+	var copiedResults [][]byte
+
+	// child is still running
+	s.mockShard.Resource.HistoryClient.EXPECT().GetMutableState(gomock.Any(), &types.GetMutableStateRequest{
+		DomainUUID: s.domainID,
+		Execution:  childExecution,
+	}).Return(&types.GetMutableStateResponse{IsWorkflowRunning: true}, nil).Times(1)
+	s.NoError(s.workflowResetter.reattachChildWorkflow(context.Background(), mutableState, ci, &copiedResults))
+
+	// child closed after the base run
+	branchToken := []byte("some random branch token")
+	s.mockShard.Resource.HistoryClient.EXPECT().GetMutableState(gomock.Any(), &types.GetMutableStateRequest{
+		DomainUUID: s.domainID,
+		Execution:  childExecution,
+	}).Return(&types.GetMutableStateResponse{
+		IsWorkflowRunning:  false,
+		CurrentBranchToken: branchToken,
+		LastFirstEventID:   10,
+		NextEventID:        12,
+	}, nil).Times(1)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  10,
+		MaxEventID:  12,
+		PageSize:    execution.NDCDefaultPageSize,
+		ShardID:     common.IntPtr(common.WorkflowIDToHistoryShard(ci.StartedWorkflowID, s.mockShard.GetConfig().NumberOfShards)),
+	}).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{
+				ID:                                   10,
+				EventType:                            types.EventTypeDecisionTaskCompleted.Ptr(),
+				DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{},
+			},
+			{
+				ID:        11,
+				EventType: types.EventTypeWorkflowExecutionCanceled.Ptr(),
+				WorkflowExecutionCanceledEventAttributes: &types.WorkflowExecutionCanceledEventAttributes{
+					DecisionTaskCompletedEventID: 10,
+				},
+			},
+		},
+	}, nil).Once()
+	mutableState.EXPECT().AddChildWorkflowExecutionCanceledEvent(
+		ci.InitiatedID,
+		childExecution,
+		&types.WorkflowExecutionCanceledEventAttributes{DecisionTaskCompletedEventID: 10},
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	s.NoError(s.workflowResetter.reattachChildWorkflow(context.Background(), mutableState, ci, &copiedResults))
+
+	// child was deleted by retention
+	s.mockShard.Resource.HistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).
+		Return(nil, &types.EntityNotExistsError{}).Times(1)
+	mutableState.EXPECT().AddChildWorkflowExecutionTerminatedEvent(
+		ci.InitiatedID,
+		childExecution,
+		&types.WorkflowExecutionTerminatedEventAttributes{
+			Reason:   childWorkflowDeletedReason,
+			Identity: execution.IdentityHistoryService,
+		},
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	s.NoError(s.workflowResetter.reattachChildWorkflow(context.Background(), mutableState, ci, &copiedResults))
+}
+
+func (s *workflowResetterSuite) TestAbandonReattachedChildWorkflows() {
+	baseMutableState := execution.NewMockMutableState(s.controller)
+	resetMutableState := execution.NewMockMutableState(s.controller)
+
+	reattachedChild := &persistence.ChildExecutionInfo{
+		InitiatedID:       5,
+		StartedRunID:      uuid.New(),
+		ParentClosePolicy: types.ParentClosePolicyTerminate,
+	}
+	childStartedAfterReset := &persistence.ChildExecutionInfo{
+		InitiatedID:       21,
+		StartedRunID:      uuid.New(),
+		ParentClosePolicy: types.ParentClosePolicyTerminate,
+	}
+	baseMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{
+		reattachedChild.InitiatedID:        reattachedChild,
+		childStartedAfterReset.InitiatedID: childStartedAfterReset,
+	}).Times(1)
+	resetMutableState.EXPECT().GetChildExecutionInfo(reattachedChild.InitiatedID).Return(&persistence.ChildExecutionInfo{
+		InitiatedID:  reattachedChild.InitiatedID,
+		StartedRunID: reattachedChild.StartedRunID,
+	}, true).Times(1)
+	resetMutableState.EXPECT().GetChildExecutionInfo(childStartedAfterReset.InitiatedID).Return(nil, false).Times(1)
+
+	abandonedChild := *reattachedChild
+	abandonedChild.ParentClosePolicy = types.ParentClosePolicyAbandon
+	baseMutableState.EXPECT().UpdateChildExecutionInfo(&abandonedChild).Return(nil).Times(1)
+
+	s.NoError(s.workflowResetter.abandonReattachedChildWorkflows(baseMutableState, resetMutableState))
+}
+
+func (s *workflowResetterSuite) TestTerminateChildWorkflows() {
+	reason := "some random reason"
+	children := []*types.ChildWorkflowExecutionStartedEventAttributes{
+		{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: "child workflow ID", RunID: uuid.New()},
+		},
+		{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: "another child workflow ID", RunID: uuid.New()},
+		},
+	}
+
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainID(constants.TestDomainName).Return(s.domainID, nil).Times(2)
+	for i, child := range children {
+		var err error
+		if i == 0 {
+			err = &types.WorkflowExecutionAlreadyCompletedError{}
+		}
+		s.mockShard.Resource.HistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), &types.HistoryTerminateWorkflowExecutionRequest{
+			DomainUUID: s.domainID,
+			TerminateRequest: &types.TerminateWorkflowExecutionRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: child.WorkflowExecution.WorkflowID},
+				Reason:            reason,
+				Identity:          execution.IdentityHistoryService,
+			},
+			ExternalWorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: s.workflowID,
+				RunID:      s.baseRunID,
+			},
+			ChildWorkflowOnly: true,
+		}).Return(err).Times(1)
+	}
+
+	s.workflowResetter.terminateChildWorkflows(context.Background(), s.workflowID, s.baseRunID, reason, children)
+}
+
 func (s *workflowResetterSuite) TestReapplyEvents() {
 
 	event1 := &types.HistoryEvent{
@@ -626,7 +894,72 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 		}
 	}
 
-	err := s.workflowResetter.reapplyEvents(mutableState, events)
+	err := s.workflowResetter.reapplyEvents(mutableState, events, execution.NewDefaultReapplyPolicy(false))
+	s.NoError(err)
+}
+
+func (s *workflowResetterSuite) TestReapplyEvents_ReapplyPolicy() {
+
+	event1 := &types.HistoryEvent{
+		ID:        101,
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some random signal name",
+			Input:      []byte("some random signal input"),
+			Identity:   "some random signal identity",
+		},
+	}
+	event2 := &types.HistoryEvent{
+		ID:        102,
+		EventType: types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+		UpsertWorkflowSearchAttributesEventAttributes: &types.UpsertWorkflowSearchAttributesEventAttributes{
+			SearchAttributes: &types.SearchAttributes{
+				IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"some random value"`)},
+			},
+		},
+	}
+	event3 := &types.HistoryEvent{
+		ID:        103,
+		EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+		WorkflowExecutionCancelRequestedEventAttributes: &types.WorkflowExecutionCancelRequestedEventAttributes{
+			Cause:    "some random cause",
+			Identity: "some random identity",
+		},
+	}
+	event4 := &types.HistoryEvent{
+		ID:        104,
+		EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+		WorkflowExecutionCancelRequestedEventAttributes: &types.WorkflowExecutionCancelRequestedEventAttributes{
+			Cause:    "another random cause",
+			Identity: "another random identity",
+		},
+	}
+	events := []*types.HistoryEvent{event1, event2, event3, event4}
+
+	mutableState := execution.NewMockMutableState(s.controller)
+	mutableState.EXPECT().AddWorkflowSearchAttributesReappliedEvent(
+		event2.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes,
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	mutableState.EXPECT().IsCancelRequested().Return(false, "").Times(1)
+	mutableState.EXPECT().AddWorkflowExecutionCancelRequestedEvent(
+		"some random cause",
+		&types.HistoryRequestCancelWorkflowExecutionRequest{
+			CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+				Identity: "some random identity",
+			},
+		},
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	// the workflow can only be requested to cancel once
+	mutableState.EXPECT().IsCancelRequested().Return(true, "").Times(1)
+
+	err := s.workflowResetter.reapplyEvents(mutableState, events, &types.ResetReapplyPolicy{
+		ReapplyUpsertSearchAttributes: true,
+		ReapplyCancelRequests:         true,
+	})
+	s.NoError(err)
+
+	// nothing is reapplied when the policy carries forward no event category
+	err = s.workflowResetter.reapplyEvents(mutableState, events, &types.ResetReapplyPolicy{})
 	s.NoError(err)
 }
 
@@ -114,31 +114,46 @@ type (
 
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
+		priority                       int32
+		labelSelector                  string
+		isolationGroup                 string
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
+		priority                       int32
+		isolationGroup                 string
 	}
 )
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
+	priority int32,
+	labelSelector string,
+	isolationGroup string,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priority:                       priority,
+		labelSelector:                  labelSelector,
+		isolationGroup:                 isolationGroup,
 	}
 }
 
 func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
+	priority int32,
+	isolationGroup string,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
+		priority:                       priority,
+		isolationGroup:                 isolationGroup,
 	}
 }
 
@@ -23,15 +23,18 @@ package task
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
 )
@@ -191,6 +194,11 @@ func GetTimerTaskMetricScope(
 			return metrics.TimerActiveTaskWorkflowBackoffTimerScope
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope
+	case persistence.TaskTypeWorkflowInactivityTimeout:
+		if isActive {
+			return metrics.TimerActiveTaskWorkflowInactivityTimeoutScope
+		}
+		return metrics.TimerStandbyTaskWorkflowInactivityTimeoutScope
 	default:
 		if isActive {
 			return metrics.TimerActiveQueueProcessorScope
@@ -262,6 +270,87 @@ func verifyTaskVersion(
 	return true, nil
 }
 
+// getActivityTaskPriority returns the matching task priority of the activity,
+// or 0 if task priority is not enabled for the domain
+func getActivityTaskPriority(
+	ctx context.Context,
+	config *config.Config,
+	mutableState execution.MutableState,
+	scheduleID int64,
+) (int32, error) {
+
+	if !config.EnableTaskPriority(mutableState.GetDomainEntry().GetInfo().Name) {
+		return 0, nil
+	}
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
+	if err != nil {
+		return 0, err
+	}
+	return execution.GetActivityTaskPriority(ctx, mutableState, scheduledEvent)
+}
+
+// getActivityTaskLabelSelector returns the label selector restricting the pollers the activity
+// can be dispatched to, or an empty string if the activity can be dispatched to any poller
+func getActivityTaskLabelSelector(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	scheduleID int64,
+) (string, error) {
+
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
+	if err != nil {
+		return "", err
+	}
+	return execution.GetActivityTaskLabelSelector(scheduledEvent), nil
+}
+
+// getDecisionTaskPriority returns the matching task priority of the workflow decisions,
+// or 0 if task priority is not enabled for the domain
+func getDecisionTaskPriority(
+	ctx context.Context,
+	config *config.Config,
+	mutableState execution.MutableState,
+) (int32, error) {
+
+	if !config.EnableTaskPriority(mutableState.GetDomainEntry().GetInfo().Name) {
+		return 0, nil
+	}
+	return execution.GetDecisionTaskPriority(ctx, mutableState)
+}
+
+// getActivityTaskIsolationGroup returns the isolation group of the activity,
+// or an empty string if isolation groups are not enabled for the domain
+func getActivityTaskIsolationGroup(
+	ctx context.Context,
+	config *config.Config,
+	mutableState execution.MutableState,
+	scheduleID int64,
+) (string, error) {
+
+	if !config.EnableTaskIsolationGroups(mutableState.GetDomainEntry().GetInfo().Name) {
+		return "", nil
+	}
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
+	if err != nil {
+		return "", err
+	}
+	return execution.GetActivityTaskIsolationGroup(ctx, mutableState, scheduledEvent)
+}
+
+// getDecisionTaskIsolationGroup returns the isolation group of the workflow decisions,
+// or an empty string if isolation groups are not enabled for the domain
+func getDecisionTaskIsolationGroup(
+	ctx context.Context,
+	config *config.Config,
+	mutableState execution.MutableState,
+) (string, error) {
+
+	if !config.EnableTaskIsolationGroups(mutableState.GetDomainEntry().GetInfo().Name) {
+		return "", nil
+	}
+	return execution.GetDecisionTaskIsolationGroup(ctx, mutableState)
+}
+
 // load mutable state, if mutable state's next event ID <= task ID, will attempt to refresh
 // if still mutable state's next event ID <= task ID, will return nil, nil
 func loadMutableStateForTimerTask(
@@ -440,6 +529,67 @@ func timeoutWorkflow(
 	return err
 }
 
+func markWorkflowInactive(
+	mutableState execution.MutableState,
+	failWorkflow bool,
+) error {
+
+	// fail the in flight decision first, so the following events are not buffered
+	if decision, ok := mutableState.GetInFlightDecision(); ok && failWorkflow {
+		if err := execution.FailDecision(
+			mutableState,
+			decision,
+			types.DecisionTaskFailedCauseForceCloseDecision,
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err := mutableState.AddWorkflowExecutionInactiveEvent(); err != nil {
+		return err
+	}
+	if !failWorkflow {
+		return nil
+	}
+	// the workflow is going to be closed in the same batch, and events
+	// still buffered when the workflow is closed are dropped
+	return mutableState.FlushBufferedEvents()
+}
+
+func failInactiveWorkflow(
+	mutableState execution.MutableState,
+) error {
+
+	// the workflow is not failed by a decision
+	_, err := mutableState.AddFailWorkflowEvent(
+		common.EmptyEventID,
+		&types.FailWorkflowExecutionDecisionAttributes{
+			Reason: common.StringPtr(inactivityTimeoutFailureReason),
+		},
+	)
+	return err
+}
+
+// getWorkflowBackoff returns the backoff before the next run of the workflow closed with the given failure reason
+// and the initiator of the next run, backoff.NoBackoff means the workflow is not retried nor continued by cron
+func getWorkflowBackoff(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	failureReason string,
+) (time.Duration, types.ContinueAsNewInitiator, error) {
+
+	backoffInterval := mutableState.GetRetryBackoffDuration(failureReason)
+	if backoffInterval != backoff.NoBackoff {
+		return backoffInterval, types.ContinueAsNewInitiatorRetryPolicy, nil
+	}
+	// check if a cron backoff is needed
+	backoffInterval, err := mutableState.GetCronBackoffDuration(ctx)
+	if err != nil {
+		return 0, types.ContinueAsNewInitiatorCronSchedule, err
+	}
+	return backoffInterval, types.ContinueAsNewInitiatorCronSchedule, nil
+}
+
 func retryWorkflow(
 	ctx context.Context,
 	mutableState execution.MutableState,
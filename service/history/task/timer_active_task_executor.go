@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
@@ -105,6 +106,8 @@ func (t *timerActiveTaskExecutor) Execute(
 		return t.executeWorkflowBackoffTimerTask(ctx, timerTask)
 	case persistence.TaskTypeDeleteHistoryEvent:
 		return t.executeDeleteHistoryEventTask(ctx, timerTask)
+	case persistence.TaskTypeWorkflowInactivityTimeout:
+		return t.executeWorkflowInactivityTimeoutTask(ctx, timerTask)
 	default:
 		return errUnknownTimerTask
 	}
@@ -558,6 +561,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// activity task will be regenerated when the workflow gets unpaused
+		return nil
+	}
 
 	domainID := task.DomainID
 	targetDomainID := domainID
@@ -588,6 +595,18 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	priority, err := getActivityTaskPriority(ctx, t.config, mutableState, scheduledID)
+	if err != nil {
+		return err
+	}
+	labelSelector, err := getActivityTaskLabelSelector(ctx, mutableState, scheduledID)
+	if err != nil {
+		return err
+	}
+	isolationGroup, err := getActivityTaskIsolationGroup(ctx, t.config, mutableState, scheduledID)
+	if err != nil {
+		return err
+	}
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -598,6 +617,9 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		TaskList:                      taskList,
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+		Priority:                      priority,
+		LabelSelector:                 labelSelector,
+		IsolationGroup:                isolationGroup,
 	})
 }
 
@@ -636,18 +658,13 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 		return err
 	}
 
+	// the workflow timeout bounds the whole run, so it still applies while the workflow is paused
 	eventBatchFirstEventID := mutableState.GetNextEventID()
 
 	timeoutReason := execution.TimerTypeToReason(execution.TimerTypeStartToClose)
-	backoffInterval := mutableState.GetRetryBackoffDuration(timeoutReason)
-	continueAsNewInitiator := types.ContinueAsNewInitiatorRetryPolicy
-	if backoffInterval == backoff.NoBackoff {
-		// check if a cron backoff is needed
-		backoffInterval, err = mutableState.GetCronBackoffDuration(ctx)
-		if err != nil {
-			return err
-		}
-		continueAsNewInitiator = types.ContinueAsNewInitiatorCronSchedule
+	backoffInterval, continueAsNewInitiator, err := getWorkflowBackoff(ctx, mutableState, timeoutReason)
+	if err != nil {
+		return err
 	}
 	if backoffInterval == backoff.NoBackoff {
 		if err := timeoutWorkflow(mutableState, eventBatchFirstEventID); err != nil {
@@ -660,22 +677,61 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 	}
 
 	// workflow timeout, but a retry or cron is needed, so we do continue as new to retry or cron
+	return t.continueAsNewWorkflow(
+		ctx,
+		wfContext,
+		mutableState,
+		task,
+		eventBatchFirstEventID,
+		timeoutReason,
+		backoffInterval,
+		continueAsNewInitiator,
+	)
+}
+
+// continueAsNewWorkflow closes the workflow which failed or timed out and starts its next run
+// according to the retry policy or the cron schedule of the workflow
+func (t *timerActiveTaskExecutor) continueAsNewWorkflow(
+	ctx context.Context,
+	wfContext execution.Context,
+	mutableState execution.MutableState,
+	task *persistence.TimerTaskInfo,
+	eventBatchFirstEventID int64,
+	failureReason string,
+	backoffInterval time.Duration,
+	continueAsNewInitiator types.ContinueAsNewInitiator,
+) (retError error) {
+
 	startEvent, err := mutableState.GetStartEvent(ctx)
 	if err != nil {
 		return err
 	}
 
 	startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
+	// the input offloaded for the current run is deleted along with its history
+	input, err := t.payloadStore.Copy(ctx, task.DomainID, task.DomainID, task.WorkflowID, startAttributes.Input)
+	if err != nil {
+		return err
+	}
+	// the copy is only referenced by the new run, and the task is retried if the new run is not persisted
+	defer func() {
+		if payload.MayBePersisted(retError) {
+			return
+		}
+		if err := t.payloadStore.Discard(ctx, task.DomainID, [][]byte{input}); err != nil {
+			t.logger.Warn("Failed to discard offloaded payload.", tag.WorkflowDomainID(task.DomainID), tag.Error(err))
+		}
+	}()
 	continueAsNewAttributes := &types.ContinueAsNewWorkflowExecutionDecisionAttributes{
 		WorkflowType:                        startAttributes.WorkflowType,
 		TaskList:                            startAttributes.TaskList,
-		Input:                               startAttributes.Input,
+		Input:                               input,
 		ExecutionStartToCloseTimeoutSeconds: startAttributes.ExecutionStartToCloseTimeoutSeconds,
 		TaskStartToCloseTimeoutSeconds:      startAttributes.TaskStartToCloseTimeoutSeconds,
 		BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(backoffInterval.Seconds())),
 		RetryPolicy:                         startAttributes.RetryPolicy,
 		Initiator:                           continueAsNewInitiator.Ptr(),
-		FailureReason:                       common.StringPtr(timeoutReason),
+		FailureReason:                       common.StringPtr(failureReason),
 		CronSchedule:                        mutableState.GetExecutionInfo().CronSchedule,
 		Header:                              startAttributes.Header,
 		Memo:                                startAttributes.Memo,
@@ -710,6 +766,97 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 	)
 }
 
+func (t *timerActiveTaskExecutor) executeWorkflowInactivityTimeoutTask(
+	ctx context.Context,
+	task *persistence.TimerTaskInfo,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTimerTask(ctx, wfContext, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	// a decision completed after this task was generated and a new inactivity
+	// timer task was generated along with it, so this task is stale
+	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.LastProcessedEvent != task.EventID || executionInfo.Inactive {
+		return nil
+	}
+
+	now := t.shard.GetTimeSource().Now()
+	if mutableState.IsWorkflowExecutionPaused() {
+		// decisions are not dispatched while the workflow is paused,
+		// so the inactivity window is restarted instead
+		taskGenerator := execution.NewMutableStateTaskGenerator(
+			t.shard.GetClusterMetadata(),
+			t.shard.GetDomainCache(),
+			mutableState,
+		)
+		if err := taskGenerator.GenerateWorkflowInactivityTimeoutTasks(
+			now,
+			task.Version,
+		); err != nil {
+			return err
+		}
+		return wfContext.UpdateWorkflowExecutionTasks(ctx, now)
+	}
+
+	eventBatchFirstEventID := mutableState.GetNextEventID()
+	failWorkflow := executionInfo.InactivityTimeoutPolicy == types.InactivityTimeoutPolicyFail
+	if err := markWorkflowInactive(mutableState, failWorkflow); err != nil {
+		return err
+	}
+
+	if domainTag, err := getDomainTagByID(t.shard.GetDomainCache(), task.DomainID); err == nil {
+		t.metricsClient.Scope(
+			metrics.TimerActiveTaskWorkflowInactivityTimeoutScope,
+			domainTag,
+		).IncCounter(metrics.WorkflowInactivityTimeoutCounter)
+	}
+
+	if !failWorkflow {
+		return t.updateWorkflowExecution(ctx, wfContext, mutableState, false)
+	}
+
+	// the workflow failed by the inactivity timeout is retried or continued by cron the same way as a failed workflow
+	backoffInterval, continueAsNewInitiator, err := getWorkflowBackoff(ctx, mutableState, inactivityTimeoutFailureReason)
+	if err != nil {
+		return err
+	}
+	if backoffInterval == backoff.NoBackoff {
+		if err := failInactiveWorkflow(mutableState); err != nil {
+			return err
+		}
+		return t.updateWorkflowExecution(ctx, wfContext, mutableState, false)
+	}
+	return t.continueAsNewWorkflow(
+		ctx,
+		wfContext,
+		mutableState,
+		task,
+		eventBatchFirstEventID,
+		inactivityTimeoutFailureReason,
+		backoffInterval,
+		continueAsNewInitiator,
+	)
+}
+
 func (t *timerActiveTaskExecutor) updateWorkflowExecution(
 	ctx context.Context,
 	wfContext execution.Context,
@@ -1149,6 +1149,154 @@ func (s *timerActiveTaskExecutorSuite) TestWorkflowTimeout_Fire() {
 	s.False(running)
 }
 
+func (s *timerActiveTaskExecutorSuite) TestWorkflowInactivityTimeout_Notify() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	executionInfo := mutableState.GetExecutionInfo()
+	executionInfo.InactivityTimeout = 10
+	executionInfo.InactivityTimeoutPolicy = types.InactivityTimeoutPolicyNotify
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.TimerTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeWorkflowInactivityTimeout,
+		EventID:             executionInfo.LastProcessedEvent,
+		VisibilityTimestamp: s.now,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.timerActiveTaskExecutor.Execute(timerTask, true)
+	s.NoError(err)
+
+	mutableState = s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	s.True(mutableState.IsWorkflowExecutionRunning())
+	s.True(mutableState.GetExecutionInfo().Inactive)
+}
+
+func (s *timerActiveTaskExecutorSuite) TestWorkflowInactivityTimeout_Fail() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	executionInfo := mutableState.GetExecutionInfo()
+	executionInfo.InactivityTimeout = 10
+	executionInfo.InactivityTimeoutPolicy = types.InactivityTimeoutPolicyFail
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.TimerTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeWorkflowInactivityTimeout,
+		EventID:             executionInfo.LastProcessedEvent,
+		VisibilityTimestamp: s.now,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	eventBatchFirstEventID := mutableState.GetNextEventID()
+	err = s.timerActiveTaskExecutor.Execute(timerTask, true)
+	s.NoError(err)
+
+	mutableState = s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	state, closeStatus := mutableState.GetWorkflowStateCloseStatus()
+	s.Equal(persistence.WorkflowStateCompleted, state)
+	s.Equal(persistence.WorkflowCloseStatusFailed, closeStatus)
+	s.True(mutableState.GetExecutionInfo().Inactive)
+	s.Equal(eventBatchFirstEventID, mutableState.GetExecutionInfo().CompletionEventBatchID)
+	completionEvent, err := mutableState.GetCompletionEvent(context.Background())
+	s.NoError(err)
+	s.Equal(common.EmptyEventID, completionEvent.WorkflowExecutionFailedEventAttributes.DecisionTaskCompletedEventID)
+	s.Equal(inactivityTimeoutFailureReason, completionEvent.WorkflowExecutionFailedEventAttributes.GetReason())
+}
+
+func (s *timerActiveTaskExecutorSuite) TestWorkflowInactivityTimeout_Fail_ContinueAsNew_Retry() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	executionInfo := mutableState.GetExecutionInfo()
+	executionInfo.InactivityTimeout = 10
+	executionInfo.InactivityTimeoutPolicy = types.InactivityTimeoutPolicyFail
+	executionInfo.HasRetryPolicy = true
+	executionInfo.ExpirationTime = s.now.Add(1000 * time.Second)
+	executionInfo.MaximumAttempts = 10
+	executionInfo.InitialInterval = 1
+	executionInfo.MaximumInterval = 1
+	executionInfo.BackoffCoefficient = 1
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.TimerTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeWorkflowInactivityTimeout,
+		EventID:             executionInfo.LastProcessedEvent,
+		VisibilityTimestamp: s.now,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	// one for current workflow, one for new
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Times(2)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.timerActiveTaskExecutor.Execute(timerTask, true)
+	s.NoError(err)
+
+	state, closeStatus := s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID()).GetWorkflowStateCloseStatus()
+	s.Equal(persistence.WorkflowStateCompleted, state)
+	s.Equal(persistence.WorkflowCloseStatusContinuedAsNew, closeStatus)
+}
+
+func (s *timerActiveTaskExecutorSuite) TestWorkflowInactivityTimeout_Noop() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	executionInfo := mutableState.GetExecutionInfo()
+	executionInfo.InactivityTimeout = 10
+	executionInfo.InactivityTimeoutPolicy = types.InactivityTimeoutPolicyFail
+
+	// the task is generated before the last decision completed
+	timerTask := s.newTimerTaskFromInfo(&persistence.TimerTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeWorkflowInactivityTimeout,
+		EventID:             common.EmptyEventID,
+		VisibilityTimestamp: s.now,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	err = s.timerActiveTaskExecutor.Execute(timerTask, true)
+	s.NoError(err)
+
+	s.True(s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID()).IsWorkflowExecutionRunning())
+}
+
 func (s *timerActiveTaskExecutorSuite) TestWorkflowTimeout_ContinueAsNew_Retry() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
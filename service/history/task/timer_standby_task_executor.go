@@ -109,6 +109,8 @@ func (t *timerStandbyTaskExecutor) Execute(
 		return nil
 	case persistence.TaskTypeWorkflowBackoffTimer:
 		return t.executeWorkflowBackoffTimerTask(ctx, timerTask)
+	case persistence.TaskTypeWorkflowInactivityTimeout:
+		return t.executeWorkflowInactivityTimeoutTask(ctx, timerTask)
 	case persistence.TaskTypeDeleteHistoryEvent:
 		return t.executeDeleteHistoryEventTask(ctx, timerTask)
 	default:
@@ -393,6 +395,41 @@ func (t *timerStandbyTaskExecutor) executeWorkflowTimeoutTask(
 	)
 }
 
+func (t *timerStandbyTaskExecutor) executeWorkflowInactivityTimeoutTask(
+	ctx context.Context,
+	timerTask *persistence.TimerTaskInfo,
+) error {
+
+	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
+
+		// the task is stale if a decision completed after it was generated,
+		// and there is nothing to wait for if the workflow is already flagged as inactive
+		// or the inactivity window is restarted by the active side for a paused workflow
+		executionInfo := mutableState.GetExecutionInfo()
+		if executionInfo.LastProcessedEvent != timerTask.EventID ||
+			executionInfo.Inactive ||
+			mutableState.IsWorkflowExecutionPaused() {
+			return nil, nil
+		}
+
+		return getHistoryResendInfo(mutableState)
+	}
+
+	return t.processTimer(
+		ctx,
+		timerTask,
+		actionFn,
+		getStandbyPostActionFn(
+			timerTask,
+			t.getCurrentTime,
+			t.config.StandbyTaskMissingEventsResendDelay(),
+			t.config.StandbyTaskMissingEventsDiscardDelay(),
+			t.fetchHistoryFromRemote,
+			standbyTimerTaskPostActionTaskDiscarded,
+		),
+	)
+}
+
 func (t *timerStandbyTaskExecutor) getStandbyClusterTime() time.Time {
 	// time of remote cluster in the shard is delayed by "StandbyClusterDelay"
 	// so to get the current accurate remote cluster time, need to add it back
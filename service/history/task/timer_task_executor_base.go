@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
@@ -41,6 +42,11 @@ var (
 	taskRetryPolicy = common.CreateTaskProcessingRetryPolicy()
 )
 
+const (
+	// inactivityTimeoutFailureReason is the failure reason of workflows failed by the inactivity timeout
+	inactivityTimeoutFailureReason = "cadenceInternal:InactivityTimeout"
+)
+
 type (
 	timerTaskExecutorBase struct {
 		shard          shard.Context
@@ -50,6 +56,7 @@ type (
 		metricsClient  metrics.Client
 		config         *config.Config
 		throttleRetry  *backoff.ThrottleRetry
+		payloadStore   *payload.Store
 	}
 )
 
@@ -72,6 +79,11 @@ func newTimerTaskExecutorBase(
 			backoff.WithRetryPolicy(taskRetryPolicy),
 			backoff.WithRetryableError(persistence.IsTransientError),
 		),
+		payloadStore: payload.NewStore(
+			shard.GetService().GetBlobstoreClient(),
+			config.PayloadOffloadThreshold,
+			config.PayloadOffloadSizeLimit,
+		),
 	}
 }
 
@@ -132,6 +144,11 @@ func (t *timerTaskExecutorBase) deleteWorkflow(
 	msBuilder execution.MutableState,
 ) error {
 
+	// payloads are deleted first as the task is not retried once the execution is deleted
+	if err := t.deleteWorkflowPayloads(ctx, task, msBuilder); err != nil {
+		return err
+	}
+
 	if err := t.deleteCurrentWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
@@ -197,6 +214,12 @@ func (t *timerTaskExecutorBase) archiveWorkflow(
 		return err
 	}
 
+	if resp.HistoryArchivedInline {
+		if err := t.deleteWorkflowPayloads(ctx, task, msBuilder); err != nil {
+			return err
+		}
+	}
+
 	if err := t.deleteCurrentWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
@@ -271,6 +294,28 @@ func (t *timerTaskExecutorBase) deleteWorkflowHistory(
 	return t.throttleRetry.Do(ctx, op)
 }
 
+func (t *timerTaskExecutorBase) deleteWorkflowPayloads(
+	ctx context.Context,
+	task *persistence.TimerTaskInfo,
+	msBuilder execution.MutableState,
+) error {
+
+	op := func() error {
+		branchToken, err := msBuilder.GetCurrentBranchToken()
+		if err != nil {
+			return err
+		}
+		return t.payloadStore.DeleteHistory(
+			ctx,
+			t.shard.GetHistoryManager(),
+			task.DomainID,
+			branchToken,
+			t.shard.GetShardID(),
+		)
+	}
+	return t.throttleRetry.Do(ctx, op)
+}
+
 func (t *timerTaskExecutorBase) deleteWorkflowVisibility(
 	ctx context.Context,
 	task *persistence.TimerTaskInfo,
@@ -187,12 +187,28 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// activity task will be regenerated when the workflow gets unpaused
+		return nil
+	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	priority, err := getActivityTaskPriority(ctx, t.config, mutableState, task.ScheduleID)
+	if err != nil {
+		return err
+	}
+	labelSelector, err := getActivityTaskLabelSelector(ctx, mutableState, task.ScheduleID)
+	if err != nil {
+		return err
+	}
+	isolationGroup, err := getActivityTaskIsolationGroup(ctx, t.config, mutableState, task.ScheduleID)
+	if err != nil {
+		return err
+	}
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(ctx, task, timeout)
+	return t.pushActivity(ctx, task, timeout, priority, labelSelector, isolationGroup)
 }
 
 func (t *transferActiveTaskExecutor) processDecisionTask(
@@ -230,6 +246,10 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// decision task will be regenerated when the workflow gets unpaused
+		return nil
+	}
 
 	executionInfo := mutableState.GetExecutionInfo()
 	workflowTimeout := executionInfo.WorkflowTimeout
@@ -258,10 +278,19 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// for the decision. Using MaxTaskTimeout here for now so at least no
 	// decision will be lost.
 
+	priority, err := getDecisionTaskPriority(ctx, t.config, mutableState)
+	if err != nil {
+		return err
+	}
+	isolationGroup, err := getDecisionTaskIsolationGroup(ctx, t.config, mutableState)
+	if err != nil {
+		return err
+	}
+
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(ctx, task, taskList, decisionTimeout)
+	return t.pushDecision(ctx, task, taskList, decisionTimeout, priority, isolationGroup)
 }
 
 func (t *transferActiveTaskExecutor) processCloseExecution(
@@ -1773,7 +1802,8 @@ func (t *transferActiveTaskExecutor) resetWorkflow(
 		),
 		reason,
 		nil,
-		false,
+		execution.NewDefaultReapplyPolicy(false),
+		types.ResetChildPolicyDefault,
 	)
 
 	switch err.(type) {
@@ -136,9 +136,30 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 			return nil, err
 		}
 
+		if mutableState.IsWorkflowExecutionPaused() {
+			// activity task is not dispatched by the active cluster while the workflow is paused,
+			// it will be regenerated when the workflow gets unpaused
+			return nil, nil
+		}
+
 		if activityInfo.StartedID == common.EmptyEventID {
+			priority, err := getActivityTaskPriority(ctx, t.config, mutableState, transferTask.ScheduleID)
+			if err != nil {
+				return nil, err
+			}
+			labelSelector, err := getActivityTaskLabelSelector(ctx, mutableState, transferTask.ScheduleID)
+			if err != nil {
+				return nil, err
+			}
+			isolationGroup, err := getActivityTaskIsolationGroup(ctx, t.config, mutableState, transferTask.ScheduleID)
+			if err != nil {
+				return nil, err
+			}
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
+				priority,
+				labelSelector,
+				isolationGroup,
 			), nil
 		}
 
@@ -183,10 +204,26 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 			return nil, err
 		}
 
+		if mutableState.IsWorkflowExecutionPaused() {
+			// decision task is not dispatched by the active cluster while the workflow is paused,
+			// it will be regenerated when the workflow gets unpaused
+			return nil, nil
+		}
+
 		if decisionInfo.StartedID == common.EmptyEventID {
+			priority, err := getDecisionTaskPriority(ctx, t.config, mutableState)
+			if err != nil {
+				return nil, err
+			}
+			isolationGroup, err := getDecisionTaskIsolationGroup(ctx, t.config, mutableState)
+			if err != nil {
+				return nil, err
+			}
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
 				types.TaskList{Name: transferTask.TaskList},
+				priority,
+				isolationGroup,
 			), nil
 		}
 
@@ -578,6 +615,9 @@ func (t *transferStandbyTaskExecutor) pushActivity(
 		ctx,
 		task.(*persistence.TransferTaskInfo),
 		timeout,
+		pushActivityInfo.priority,
+		pushActivityInfo.labelSelector,
+		pushActivityInfo.isolationGroup,
 	)
 }
 
@@ -599,6 +639,8 @@ func (t *transferStandbyTaskExecutor) pushDecision(
 		task.(*persistence.TransferTaskInfo),
 		&pushDecisionInfo.tasklist,
 		timeout,
+		pushDecisionInfo.priority,
+		pushDecisionInfo.isolationGroup,
 	)
 }
 
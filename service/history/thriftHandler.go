@@ -121,6 +121,12 @@ func (t ThriftHandler) NotifyFailoverMarkers(ctx context.Context, request *h.Not
 	return thrift.FromError(err)
 }
 
+// PauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error {
+	err := t.h.PauseWorkflowExecution(ctx, thrift.ToHistoryPauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
 // PollMutableState forwards request to the underlying handler
 func (t ThriftHandler) PollMutableState(ctx context.Context, request *h.PollMutableStateRequest) (*h.PollMutableStateResponse, error) {
 	response, err := t.h.PollMutableState(ctx, thrift.ToPollMutableStateRequest(request))
@@ -301,6 +307,18 @@ func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *
 	return thrift.FromError(err)
 }
 
+// UnpauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, request *h.UnpauseWorkflowExecutionRequest) error {
+	err := t.h.UnpauseWorkflowExecution(ctx, thrift.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// UpdateWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UpdateWorkflowExecution(ctx context.Context, request *h.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	response, err := t.h.UpdateWorkflowExecution(ctx, thrift.ToHistoryUpdateWorkflowExecutionRequest(request))
+	return thrift.FromUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
 // GetFailoverInfo forwards request to the underlying handler
 func (t ThriftHandler) GetFailoverInfo(ctx context.Context, request *h.GetFailoverInfoRequest) (*h.GetFailoverInfoResponse, error) {
 	response, err := t.h.GetFailoverInfo(ctx, thrift.ToGetFailoverInfoRequest(request))
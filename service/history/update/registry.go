@@ -0,0 +1,223 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package update
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/types"
+)
+
+var (
+	errUpdateNotExists = &types.InternalServiceError{Message: "update does not exist"}
+)
+
+type (
+	// Registry manages the callers waiting for the in-flight updates of a workflow.
+	// An update is pending until the workflow responds to it on a decision task
+	// or it gets failed, after which it is either completed or failed.
+	// The updates themselves are tracked by the mutable state, the registry is not persisted.
+	Registry interface {
+		HasPendingUpdate() bool
+		GetPendingIDs() []string
+		HasCompletedUpdate() bool
+		GetCompletedIDs() []string
+		HasFailedUpdate() bool
+		GetFailedIDs() []string
+
+		GetUpdateTermCh(string) (<-chan struct{}, error)
+		GetUpdateRequest(string) (*types.UpdateWorkflowExecutionRequest, error)
+		GetTerminationState(string) (*TerminationState, error)
+
+		// BufferUpdate replaces a terminated update with the same ID, as the update is requested again
+		BufferUpdate(id string, request *types.UpdateWorkflowExecutionRequest) <-chan struct{}
+		// AttachUpdate waits for an update which is pending in the registry, the update
+		// is only removed once each call to BufferUpdate and AttachUpdate removed it
+		AttachUpdate(id string) (<-chan struct{}, bool)
+		SetTerminationState(string, *TerminationState) error
+		RemoveUpdate(id string)
+	}
+
+	registryImpl struct {
+		sync.RWMutex
+
+		pending   map[string]update
+		completed map[string]update
+		failed    map[string]update
+		waiters   map[string]int
+	}
+)
+
+// NewRegistry creates a new update registry
+func NewRegistry() Registry {
+	return &registryImpl{
+		pending:   make(map[string]update),
+		completed: make(map[string]update),
+		failed:    make(map[string]update),
+		waiters:   make(map[string]int),
+	}
+}
+
+func (r *registryImpl) HasPendingUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.pending) > 0
+}
+
+func (r *registryImpl) GetPendingIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.getIDs(r.pending)
+}
+
+func (r *registryImpl) HasCompletedUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.completed) > 0
+}
+
+func (r *registryImpl) GetCompletedIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.getIDs(r.completed)
+}
+
+func (r *registryImpl) HasFailedUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.failed) > 0
+}
+
+func (r *registryImpl) GetFailedIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.getIDs(r.failed)
+}
+
+func (r *registryImpl) GetUpdateTermCh(id string) (<-chan struct{}, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getUpdateTermCh(), nil
+}
+
+func (r *registryImpl) GetUpdateRequest(id string) (*types.UpdateWorkflowExecutionRequest, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getUpdateRequest(), nil
+}
+
+func (r *registryImpl) GetTerminationState(id string) (*TerminationState, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getTerminationState()
+}
+
+func (r *registryImpl) BufferUpdate(
+	id string,
+	request *types.UpdateWorkflowExecutionRequest,
+) <-chan struct{} {
+	r.Lock()
+	defer r.Unlock()
+	u := newUpdate(id, request)
+	delete(r.completed, id)
+	delete(r.failed, id)
+	r.pending[id] = u
+	r.waiters[id]++
+	return u.getUpdateTermCh()
+}
+
+func (r *registryImpl) AttachUpdate(id string) (<-chan struct{}, bool) {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.pending[id]
+	if !ok {
+		return nil, false
+	}
+	r.waiters[id]++
+	return u.getUpdateTermCh(), true
+}
+
+func (r *registryImpl) SetTerminationState(id string, terminationState *TerminationState) error {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.pending[id]
+	if !ok {
+		return errUpdateNotExists
+	}
+	if err := u.setTerminationState(terminationState); err != nil {
+		return err
+	}
+	delete(r.pending, id)
+	switch terminationState.TerminationType {
+	case TerminationTypeCompleted:
+		r.completed[id] = u
+	case TerminationTypeFailed:
+		r.failed[id] = u
+	}
+	return nil
+}
+
+func (r *registryImpl) RemoveUpdate(id string) {
+	r.Lock()
+	defer r.Unlock()
+	if r.waiters[id]--; r.waiters[id] > 0 {
+		return
+	}
+	delete(r.waiters, id)
+	delete(r.pending, id)
+	delete(r.completed, id)
+	delete(r.failed, id)
+}
+
+func (r *registryImpl) getUpdateNoLock(id string) (update, error) {
+	if u, ok := r.pending[id]; ok {
+		return u, nil
+	}
+	if u, ok := r.completed[id]; ok {
+		return u, nil
+	}
+	if u, ok := r.failed[id]; ok {
+		return u, nil
+	}
+	return nil, errUpdateNotExists
+}
+
+func (r *registryImpl) getIDs(m map[string]update) []string {
+	result := make([]string, len(m))
+	index := 0
+	for id := range m {
+		result[index] = id
+		index++
+	}
+	return result
+}
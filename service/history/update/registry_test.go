@@ -0,0 +1,233 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package update
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type UpdateRegistrySuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UpdateRegistrySuite))
+}
+
+func (s *UpdateRegistrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateRegistrySuite) TestUpdateRegistry() {
+	ur := NewRegistry()
+	ids := make([]string, 90)
+	termChans := make([]<-chan struct{}, 90)
+	for i := 0; i < 90; i++ {
+		ids[i] = fmt.Sprintf("update-%v", i)
+		termChans[i] = ur.BufferUpdate(ids[i], &types.UpdateWorkflowExecutionRequest{UpdateName: "name"})
+	}
+	s.assertPendingState(ur, ids...)
+	s.assertHasUpdates(ur, true, false, false)
+	s.assertUpdateSizes(ur, 90, 0, 0)
+	s.assertChanState(false, termChans...)
+
+	for i := 0; i < 30; i++ {
+		err := ur.SetTerminationState(ids[i], &TerminationState{
+			TerminationType: TerminationTypeCompleted,
+			UpdateResult: &types.WorkflowUpdateResult{
+				ResultType: types.QueryResultTypeAnswered.Ptr(),
+				Result:     []byte{1, 2, 3},
+			},
+		})
+		s.NoError(err)
+	}
+	s.assertCompletedState(ur, ids[0:30]...)
+	s.assertPendingState(ur, ids[30:]...)
+	s.assertHasUpdates(ur, true, true, false)
+	s.assertUpdateSizes(ur, 60, 30, 0)
+	s.assertChanState(true, termChans[0:30]...)
+	s.assertChanState(false, termChans[30:]...)
+
+	for i := 30; i < 60; i++ {
+		err := ur.SetTerminationState(ids[i], &TerminationState{
+			TerminationType: TerminationTypeFailed,
+			Failure:         errors.New("err"),
+		})
+		s.NoError(err)
+	}
+	s.assertCompletedState(ur, ids[0:30]...)
+	s.assertFailedState(ur, ids[30:60]...)
+	s.assertPendingState(ur, ids[60:]...)
+	s.assertHasUpdates(ur, true, true, true)
+	s.assertUpdateSizes(ur, 30, 30, 30)
+	s.assertChanState(true, termChans[0:60]...)
+	s.assertChanState(false, termChans[60:]...)
+
+	for i := 0; i < 60; i++ {
+		s.Equal(errUpdateNotExists, ur.SetTerminationState(ids[i], &TerminationState{
+			TerminationType: TerminationTypeFailed,
+			Failure:         errors.New("err"),
+		}))
+	}
+	s.assertUpdateSizes(ur, 30, 30, 30)
+
+	for i := 0; i < 30; i++ {
+		ur.RemoveUpdate(ids[i])
+		s.assertHasUpdates(ur, true, i < 29, true)
+		s.assertUpdateSizes(ur, 30, 30-i-1, 30)
+	}
+	for i := 30; i < 60; i++ {
+		ur.RemoveUpdate(ids[i])
+		s.assertHasUpdates(ur, true, false, i < 59)
+		s.assertUpdateSizes(ur, 30, 0, 60-i-1)
+	}
+	for i := 60; i < 90; i++ {
+		ur.RemoveUpdate(ids[i])
+		s.assertHasUpdates(ur, i < 89, false, false)
+		s.assertUpdateSizes(ur, 90-i-1, 0, 0)
+	}
+	s.assertChanState(true, termChans[0:60]...)
+	s.assertChanState(false, termChans[60:]...)
+
+	_, err := ur.GetUpdateTermCh(ids[0])
+	s.Equal(errUpdateNotExists, err)
+	_, err = ur.GetUpdateRequest(ids[0])
+	s.Equal(errUpdateNotExists, err)
+	_, err = ur.GetTerminationState(ids[0])
+	s.Equal(errUpdateNotExists, err)
+}
+
+func (s *UpdateRegistrySuite) TestAttachUpdate() {
+	ur := NewRegistry()
+	_, ok := ur.AttachUpdate("update-id")
+	s.False(ok)
+
+	termCh := ur.BufferUpdate("update-id", &types.UpdateWorkflowExecutionRequest{UpdateName: "name"})
+	attachedTermCh, ok := ur.AttachUpdate("update-id")
+	s.True(ok)
+	s.Equal(termCh, attachedTermCh)
+	s.assertUpdateSizes(ur, 1, 0, 0)
+
+	s.NoError(ur.SetTerminationState("update-id", &TerminationState{
+		TerminationType: TerminationTypeFailed,
+		Failure:         errors.New("some random error"),
+	}))
+	s.assertChanState(true, attachedTermCh)
+	// terminated updates are not attached to
+	_, ok = ur.AttachUpdate("update-id")
+	s.False(ok)
+
+	// the update is kept until both waiters removed it
+	ur.RemoveUpdate("update-id")
+	s.assertFailedState(ur, "update-id")
+	ur.RemoveUpdate("update-id")
+	s.assertUpdateSizes(ur, 0, 0, 0)
+	_, ok = ur.AttachUpdate("update-id")
+	s.False(ok)
+}
+
+func (s *UpdateRegistrySuite) TestBufferUpdate_ReplacesTerminatedUpdate() {
+	ur := NewRegistry()
+	termCh := ur.BufferUpdate("update-id", &types.UpdateWorkflowExecutionRequest{UpdateName: "name"})
+	s.NoError(ur.SetTerminationState("update-id", &TerminationState{
+		TerminationType: TerminationTypeFailed,
+		Failure:         errors.New("some random error"),
+	}))
+	s.assertChanState(true, termCh)
+
+	retriedTermCh := ur.BufferUpdate("update-id", &types.UpdateWorkflowExecutionRequest{UpdateName: "name"})
+	s.assertChanState(false, retriedTermCh)
+	s.assertUpdateSizes(ur, 1, 0, 0)
+
+	// the retried update is kept until both waiters removed it
+	ur.RemoveUpdate("update-id")
+	s.assertPendingState(ur, "update-id")
+	ur.RemoveUpdate("update-id")
+	s.assertUpdateSizes(ur, 0, 0, 0)
+}
+
+func (s *UpdateRegistrySuite) assertPendingState(ur Registry, ids ...string) {
+	for _, id := range ids {
+		termCh, err := ur.GetUpdateTermCh(id)
+		s.NoError(err)
+		s.False(closed(termCh))
+		request, err := ur.GetUpdateRequest(id)
+		s.NoError(err)
+		s.NotNil(request)
+		ts, err := ur.GetTerminationState(id)
+		s.Equal(errUpdateNotInTerminalState, err)
+		s.Nil(ts)
+	}
+}
+
+func (s *UpdateRegistrySuite) assertCompletedState(ur Registry, ids ...string) {
+	for _, id := range ids {
+		termCh, err := ur.GetUpdateTermCh(id)
+		s.NoError(err)
+		s.True(closed(termCh))
+		ts, err := ur.GetTerminationState(id)
+		s.NoError(err)
+		s.NotNil(ts)
+		s.Equal(TerminationTypeCompleted, ts.TerminationType)
+		s.NotNil(ts.UpdateResult)
+		s.Nil(ts.Failure)
+	}
+}
+
+func (s *UpdateRegistrySuite) assertFailedState(ur Registry, ids ...string) {
+	for _, id := range ids {
+		termCh, err := ur.GetUpdateTermCh(id)
+		s.NoError(err)
+		s.True(closed(termCh))
+		ts, err := ur.GetTerminationState(id)
+		s.NoError(err)
+		s.NotNil(ts)
+		s.Equal(TerminationTypeFailed, ts.TerminationType)
+		s.Nil(ts.UpdateResult)
+		s.NotNil(ts.Failure)
+	}
+}
+
+func (s *UpdateRegistrySuite) assertHasUpdates(ur Registry, pending, completed, failed bool) {
+	s.Equal(pending, ur.HasPendingUpdate())
+	s.Equal(completed, ur.HasCompletedUpdate())
+	s.Equal(failed, ur.HasFailedUpdate())
+}
+
+func (s *UpdateRegistrySuite) assertUpdateSizes(ur Registry, pending, completed, failed int) {
+	s.Len(ur.GetPendingIDs(), pending)
+	s.Len(ur.GetCompletedIDs(), completed)
+	s.Len(ur.GetFailedIDs(), failed)
+}
+
+func (s *UpdateRegistrySuite) assertChanState(expectedClosed bool, chans ...<-chan struct{}) {
+	for _, ch := range chans {
+		s.Equal(expectedClosed, closed(ch))
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package update
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type UpdateSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestUpdateSuite(t *testing.T) {
+	suite.Run(t, new(UpdateSuite))
+}
+
+func (s *UpdateSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateSuite) TestValidateTerminationState() {
+	testCases := []struct {
+		ts        *TerminationState
+		expectErr bool
+	}{
+		{
+			ts:        nil,
+			expectErr: true,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+			},
+			expectErr: true,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult:    &types.WorkflowUpdateResult{},
+				Failure:         errors.New("err"),
+			},
+			expectErr: true,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult: &types.WorkflowUpdateResult{
+					ResultType:   types.QueryResultTypeAnswered.Ptr(),
+					ErrorMessage: "err",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult: &types.WorkflowUpdateResult{
+					ResultType: types.QueryResultTypeFailed.Ptr(),
+					Result:     []byte{1, 2, 3},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult: &types.WorkflowUpdateResult{
+					ResultType: types.QueryResultTypeAnswered.Ptr(),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult: &types.WorkflowUpdateResult{
+					ResultType: types.QueryResultTypeAnswered.Ptr(),
+					Result:     []byte{1, 2, 3},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult: &types.WorkflowUpdateResult{
+					ResultType:   types.QueryResultTypeFailed.Ptr(),
+					ErrorMessage: "err",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeFailed,
+			},
+			expectErr: true,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeFailed,
+				UpdateResult:    &types.WorkflowUpdateResult{},
+				Failure:         errors.New("err"),
+			},
+			expectErr: true,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationTypeFailed,
+				Failure:         errors.New("err"),
+			},
+			expectErr: false,
+		},
+		{
+			ts: &TerminationState{
+				TerminationType: TerminationType(100),
+			},
+			expectErr: true,
+		},
+	}
+
+	updateImpl := &updateImpl{}
+	for _, tc := range testCases {
+		if tc.expectErr {
+			s.Error(updateImpl.validateTerminationState(tc.ts))
+		} else {
+			s.NoError(updateImpl.validateTerminationState(tc.ts))
+		}
+	}
+}
+
+func (s *UpdateSuite) TestTerminationState_Failed() {
+	failedTerminationState := &TerminationState{
+		TerminationType: TerminationTypeFailed,
+		Failure:         errors.New("err"),
+	}
+	s.testSetTerminationState(failedTerminationState)
+}
+
+func (s *UpdateSuite) TestTerminationState_Completed() {
+	answeredTerminationState := &TerminationState{
+		TerminationType: TerminationTypeCompleted,
+		UpdateResult: &types.WorkflowUpdateResult{
+			ResultType: types.QueryResultTypeAnswered.Ptr(),
+			Result:     []byte{1, 2, 3},
+		},
+	}
+	s.testSetTerminationState(answeredTerminationState)
+}
+
+func (s *UpdateSuite) testSetTerminationState(terminationState *TerminationState) {
+	update := newUpdate("some random update id", nil)
+	s.Equal("some random update id", update.getUpdateID())
+	ts, err := update.getTerminationState()
+	s.Equal(errUpdateNotInTerminalState, err)
+	s.Nil(ts)
+	s.False(closed(update.getUpdateTermCh()))
+	s.Equal(errTerminationStateInvalid, update.setTerminationState(nil))
+	s.NoError(update.setTerminationState(terminationState))
+	s.True(closed(update.getUpdateTermCh()))
+	s.Equal(errAlreadyInTerminalState, update.setTerminationState(terminationState))
+	actualTerminationState, err := update.getTerminationState()
+	s.NoError(err)
+	s.Equal(terminationState, actualTerminationState)
+}
+
+func closed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
@@ -37,6 +37,8 @@ var (
 	ErrNotExists = &types.EntityNotExistsError{Message: "workflow execution already completed"}
 	// ErrAlreadyCompleted is the error to indicate workflow execution already completed
 	ErrAlreadyCompleted = &types.WorkflowExecutionAlreadyCompletedError{Message: "workflow execution already completed"}
+	// ErrWorkflowPaused is the error to indicate task can not be started because workflow execution is paused
+	ErrWorkflowPaused = &types.EntityNotExistsError{Message: "workflow execution is paused"}
 	// ErrParentMismatch is the error to parent execution is given and mismatch
 	ErrParentMismatch = &types.EntityNotExistsError{Message: "workflow parent does not match"}
 	// ErrDeserializingToken is the error to indicate task token is invalid
@@ -57,6 +59,12 @@ var (
 	ErrConsistentQueryNotEnabled = &types.BadRequestError{Message: "cluster or domain does not enable strongly consistent query but strongly consistent query was requested"}
 	// ErrConsistentQueryBufferExceeded is error indicating that too many consistent queries have been buffered and until buffered queries are finished new consistent queries cannot be buffered
 	ErrConsistentQueryBufferExceeded = &types.InternalServiceError{Message: "consistent query buffer is full, cannot accept new consistent queries"}
+	// ErrWorkflowUpdateNotEnabled is error indicating that workflow update was requested but the domain does not enable it
+	ErrWorkflowUpdateNotEnabled = &types.BadRequestError{Message: "domain does not enable workflow update but workflow update was requested"}
+	// ErrWorkflowUpdateBufferExceeded is error indicating that too many updates are in-flight for the workflow and until they are finished new updates cannot be accepted
+	ErrWorkflowUpdateBufferExceeded = &types.LimitExceededError{Message: "workflow update buffer is full, cannot accept new updates"}
+	// ErrWorkflowUpdateNotHandled is error indicating that the workflow completed a decision task for the update without responding to it
+	ErrWorkflowUpdateNotHandled = &types.BadRequestError{Message: "workflow completed decision task without responding to the update"}
 	// ErrConcurrentStartRequest is error indicating there is an outstanding start workflow request. The incoming request fails to acquires the lock before the outstanding request finishes.
 	ErrConcurrentStartRequest = &types.ServiceBusyError{Message: "an outstanding start workflow request is in-progress. Failed to acquire the resource."}
 )
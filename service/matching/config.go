@@ -53,6 +53,32 @@ type (
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
+		// partition autoscaler configuration
+		EnablePartitionAutoscaler                dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		PartitionAutoscalerInterval              dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionAutoscalerTargetRPSPerPartition dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionAutoscalerBacklogThreshold      dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionAutoscalerMaxPartitions         dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionAutoscalerDownscaleCooldown     dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// task priority configuration
+		EnableTaskPriority              dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		TaskPriorityStarvationThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		TaskPriorityBufferSize          dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+		// task dead-letter configuration
+		EnableTaskDeadLetter    dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		MaxTaskDispatchAttempts dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		DeadLetterTaskRetention dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// isolation group configuration
+		EnableIsolationGroups       dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		IsolationGroupFallbackDelay dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// label selector configuration
+		LabeledTaskBacklogTimeout dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		MaxLabeledTasks           dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
@@ -78,8 +104,18 @@ type (
 		ForwarderMaxChildrenPerNode  func() int
 	}
 
+	partitionAutoscalerConfig struct {
+		EnablePartitionAutoscaler                func() bool
+		PartitionAutoscalerInterval              func() time.Duration
+		PartitionAutoscalerTargetRPSPerPartition func() int
+		PartitionAutoscalerBacklogThreshold      func() int
+		PartitionAutoscalerMaxPartitions         func() int
+		PartitionAutoscalerDownscaleCooldown     func() time.Duration
+	}
+
 	taskListConfig struct {
 		forwarderConfig
+		partitionAutoscalerConfig
 		EnableSyncMatch func() bool
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval func() time.Duration
@@ -95,6 +131,20 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// taskReader configuration
+		EnableTaskPriority              func() bool
+		TaskPriorityStarvationThreshold func() int
+		TaskPriorityBufferSize          func() int
+		// task dead-letter configuration
+		EnableTaskDeadLetter    func() bool
+		MaxTaskDispatchAttempts func() int
+		DeadLetterTaskRetention func() time.Duration
+		// isolation group configuration
+		EnableIsolationGroups       func() bool
+		IsolationGroupFallbackDelay func() time.Duration
+		// label selector configuration
+		LabeledTaskBacklogTimeout func() time.Duration
+		MaxLabeledTasks           func() int
 	}
 )
 
@@ -126,9 +176,26 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
 		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
-		EnableDebugMode:                 dc.GetBoolProperty(dynamicconfig.EnableDebugMode, false)(),
-		EnableTaskInfoLogByDomainID:     dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID, false),
-		ActivityTaskSyncMatchWaitTime:   dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingActivityTaskSyncMatchWaitTime, 100*time.Millisecond),
+
+		EnablePartitionAutoscaler:                dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnablePartitionAutoscaler, false),
+		PartitionAutoscalerInterval:              dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionAutoscalerInterval, time.Minute),
+		PartitionAutoscalerTargetRPSPerPartition: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionAutoscalerTargetRPSPerPartition, 200),
+		PartitionAutoscalerBacklogThreshold:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionAutoscalerBacklogThreshold, 1000),
+		PartitionAutoscalerMaxPartitions:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionAutoscalerMaxPartitions, 10),
+		PartitionAutoscalerDownscaleCooldown:     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionAutoscalerDownscaleCooldown, 10*time.Minute),
+		EnableTaskPriority:                       dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableTaskPriority, false),
+		TaskPriorityStarvationThreshold:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskPriorityStarvationThreshold, 10),
+		TaskPriorityBufferSize:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskPriorityBufferSize, 1000),
+		EnableTaskDeadLetter:                     dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableTaskDeadLetter, false),
+		MaxTaskDispatchAttempts:                  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDispatchAttempts, 0),
+		DeadLetterTaskRetention:                  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingDeadLetterTaskRetention, 7*24*time.Hour),
+		EnableIsolationGroups:                    dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableIsolationGroups, false),
+		IsolationGroupFallbackDelay:              dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIsolationGroupFallbackDelay, 5*time.Second),
+		LabeledTaskBacklogTimeout:                dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLabeledTaskBacklogTimeout, time.Minute),
+		MaxLabeledTasks:                          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxLabeledTasks, 1000),
+		EnableDebugMode:                          dc.GetBoolProperty(dynamicconfig.EnableDebugMode, false)(),
+		EnableTaskInfoLogByDomainID:              dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID, false),
+		ActivityTaskSyncMatchWaitTime:            dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingActivityTaskSyncMatchWaitTime, 100*time.Millisecond),
 	}
 }
 
@@ -178,6 +245,36 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTasklistReadPartitions(domainName, taskListName, taskType))
 		},
+		EnableTaskPriority: func() bool {
+			return config.EnableTaskPriority(domainName, taskListName, taskType)
+		},
+		TaskPriorityStarvationThreshold: func() int {
+			return common.MaxInt(1, config.TaskPriorityStarvationThreshold(domainName, taskListName, taskType))
+		},
+		TaskPriorityBufferSize: func() int {
+			return common.MaxInt(1, config.TaskPriorityBufferSize(domainName, taskListName, taskType))
+		},
+		EnableTaskDeadLetter: func() bool {
+			return config.EnableTaskDeadLetter(domainName, taskListName, taskType)
+		},
+		MaxTaskDispatchAttempts: func() int {
+			return config.MaxTaskDispatchAttempts(domainName, taskListName, taskType)
+		},
+		DeadLetterTaskRetention: func() time.Duration {
+			return config.DeadLetterTaskRetention(domainName, taskListName, taskType)
+		},
+		EnableIsolationGroups: func() bool {
+			return config.EnableIsolationGroups(domainName, taskListName, taskType)
+		},
+		IsolationGroupFallbackDelay: func() time.Duration {
+			return config.IsolationGroupFallbackDelay(domainName, taskListName, taskType)
+		},
+		LabeledTaskBacklogTimeout: func() time.Duration {
+			return config.LabeledTaskBacklogTimeout(domainName, taskListName, taskType)
+		},
+		MaxLabeledTasks: func() int {
+			return config.MaxLabeledTasks(domainName, taskListName, taskType)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(domainName, taskListName, taskType)
@@ -192,5 +289,25 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 				return common.MaxInt(1, config.ForwarderMaxChildrenPerNode(domainName, taskListName, taskType))
 			},
 		},
+		partitionAutoscalerConfig: partitionAutoscalerConfig{
+			EnablePartitionAutoscaler: func() bool {
+				return config.EnablePartitionAutoscaler(domainName, taskListName, taskType)
+			},
+			PartitionAutoscalerInterval: func() time.Duration {
+				return config.PartitionAutoscalerInterval(domainName, taskListName, taskType)
+			},
+			PartitionAutoscalerTargetRPSPerPartition: func() int {
+				return common.MaxInt(1, config.PartitionAutoscalerTargetRPSPerPartition(domainName, taskListName, taskType))
+			},
+			PartitionAutoscalerBacklogThreshold: func() int {
+				return config.PartitionAutoscalerBacklogThreshold(domainName, taskListName, taskType)
+			},
+			PartitionAutoscalerMaxPartitions: func() int {
+				return common.MaxInt(1, config.PartitionAutoscalerMaxPartitions(domainName, taskListName, taskType))
+			},
+			PartitionAutoscalerDownscaleCooldown: func() time.Duration {
+				return config.PartitionAutoscalerDownscaleCooldown(domainName, taskListName, taskType)
+			},
+		},
 	}, nil
 }
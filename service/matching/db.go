@@ -33,14 +33,16 @@ import (
 type (
 	taskListDB struct {
 		sync.Mutex
-		domainID     string
-		taskListName string
-		taskListKind int
-		taskType     int
-		rangeID      int64
-		ackLevel     int64
-		store        persistence.TaskManager
-		logger       log.Logger
+		domainID       string
+		taskListName   string
+		taskListKind   int
+		taskType       int
+		rangeID        int64
+		ackLevel       int64
+		config         *persistence.TaskListConfig
+		workerIdentity string
+		store          persistence.TaskManager
+		logger         log.Logger
 	}
 	taskListState struct {
 		rangeID  int64
@@ -76,6 +78,15 @@ func (db *taskListDB) RangeID() int64 {
 	return db.rangeID
 }
 
+// Config returns the server side configuration of the taskList, nil if the taskList is
+// not configured. The config is read when the lease is taken and written back as is on
+// every update, it is only changed through UpdateConfig by the owner of the lease.
+func (db *taskListDB) Config() *persistence.TaskListConfig {
+	db.Lock()
+	defer db.Unlock()
+	return db.config
+}
+
 // RenewLease renews the lease on a tasklist. If there is no previous lease,
 // this method will attempt to steal tasklist from current owner
 func (db *taskListDB) RenewLease() (taskListState, error) {
@@ -93,6 +104,8 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
+	db.config = resp.TaskListInfo.Config
+	db.workerIdentity = resp.TaskListInfo.WorkerIdentity
 	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
 }
 
@@ -102,12 +115,14 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:       db.domainID,
+			Name:           db.taskListName,
+			TaskType:       db.taskType,
+			AckLevel:       ackLevel,
+			RangeID:        db.rangeID,
+			Kind:           db.taskListKind,
+			Config:         db.config,
+			WorkerIdentity: db.workerIdentity,
 		},
 	})
 	if err == nil {
@@ -116,18 +131,99 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// UpdateConfig persists the server side configuration of the taskList returned by update
+// under the current lease and returns it
+func (db *taskListDB) UpdateConfig(
+	update func(*persistence.TaskListConfig) *persistence.TaskListConfig,
+) (*persistence.TaskListConfig, error) {
+	db.Lock()
+	defer db.Unlock()
+	config := update(db.config)
+	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:       db.domainID,
+			Name:           db.taskListName,
+			TaskType:       db.taskType,
+			AckLevel:       db.ackLevel,
+			RangeID:        db.rangeID,
+			Kind:           db.taskListKind,
+			Config:         config,
+			WorkerIdentity: db.workerIdentity,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	db.config = config
+	return config, nil
+}
+
+// WorkerIdentity returns the identity of the worker owning the sticky taskList, empty if
+// it never polled the taskList
+func (db *taskListDB) WorkerIdentity() string {
+	db.Lock()
+	defer db.Unlock()
+	return db.workerIdentity
+}
+
+// UpdateWorkerIdentity persists the identity of the worker owning the sticky taskList
+// under the current lease
+func (db *taskListDB) UpdateWorkerIdentity(identity string) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:       db.domainID,
+			Name:           db.taskListName,
+			TaskType:       db.taskType,
+			AckLevel:       db.ackLevel,
+			RangeID:        db.rangeID,
+			Kind:           db.taskListKind,
+			Config:         db.config,
+			WorkerIdentity: identity,
+		},
+	})
+	if err == nil {
+		db.workerIdentity = identity
+	}
+	return err
+}
+
+// DeleteTaskList deletes the taskList under the current lease, the tasks of the taskList
+// must be deleted first
+func (db *taskListDB) DeleteTaskList() error {
+	db.Lock()
+	defer db.Unlock()
+	err := db.store.DeleteTaskList(context.Background(), &persistence.DeleteTaskListRequest{
+		DomainID:     db.domainID,
+		TaskListName: db.taskListName,
+		TaskListType: db.taskType,
+		RangeID:      db.rangeID,
+	})
+	if err != nil {
+		db.logger.Error("Persistent store operation failure",
+			tag.StoreOperationDeleteTaskList,
+			tag.Error(err),
+			tag.TaskType(db.taskType),
+			tag.WorkflowTaskListName(db.taskListName))
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
 	defer db.Unlock()
 	return db.store.CreateTasks(context.Background(), &persistence.CreateTasksRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: db.ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:       db.domainID,
+			Name:           db.taskListName,
+			TaskType:       db.taskType,
+			AckLevel:       db.ackLevel,
+			RangeID:        db.rangeID,
+			Kind:           db.taskListKind,
+			Config:         db.config,
+			WorkerIdentity: db.workerIdentity,
 		},
 		Tasks: tasks,
 	})
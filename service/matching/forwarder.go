@@ -137,6 +137,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
+			Priority:                      task.event.Priority,
+			IsolationGroup:                task.isolationGroup(),
 		})
 	case persistence.TaskListTypeActivity:
 		err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -151,6 +153,9 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
+			Priority:                      task.event.Priority,
+			LabelSelector:                 task.event.LabelSelector,
+			IsolationGroup:                task.isolationGroup(),
 		})
 	default:
 		return errInvalidTaskListType
@@ -200,6 +205,9 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	pollerLabels, _ := ctx.Value(pollerLabelsKey).(map[string]string)
+	pollerCapacity, _ := ctx.Value(pollerCapacityKey).(int32)
+	isolationGroup, _ := ctx.Value(isolationGroupKey).(string)
 
 	switch fwdr.taskListID.taskType {
 	case persistence.TaskListTypeDecision:
@@ -213,7 +221,10 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 				},
 				Identity: identity,
 			},
-			ForwardedFrom: fwdr.taskListID.name,
+			ForwardedFrom:  fwdr.taskListID.name,
+			PollerLabels:   pollerLabels,
+			PollerCapacity: pollerCapacity,
+			IsolationGroup: isolationGroup,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -230,7 +241,10 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 				},
 				Identity: identity,
 			},
-			ForwardedFrom: fwdr.taskListID.name,
+			ForwardedFrom:  fwdr.taskListID.name,
+			PollerLabels:   pollerLabels,
+			PollerCapacity: pollerCapacity,
+			IsolationGroup: isolationGroup,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -97,3 +97,33 @@ func (g grpcHandler) RespondQueryTaskCompleted(ctx context.Context, request *mat
 	err := g.h.RespondQueryTaskCompleted(ctx, proto.ToMatchingRespondQueryTaskCompletedRequest(request))
 	return &matchingv1.RespondQueryTaskCompletedResponse{}, proto.FromError(err)
 }
+
+func (g grpcHandler) DescribeTaskListConfig(ctx context.Context, request *matchingv1.DescribeTaskListConfigRequest) (*matchingv1.DescribeTaskListConfigResponse, error) {
+	response, err := g.h.DescribeTaskListConfig(ctx, proto.ToMatchingDescribeTaskListConfigRequest(request))
+	return proto.FromMatchingDescribeTaskListConfigResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) UpdateTaskListConfig(ctx context.Context, request *matchingv1.UpdateTaskListConfigRequest) (*matchingv1.UpdateTaskListConfigResponse, error) {
+	response, err := g.h.UpdateTaskListConfig(ctx, proto.ToMatchingUpdateTaskListConfigRequest(request))
+	return proto.FromMatchingUpdateTaskListConfigResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) RequeueDeadLetterTasks(ctx context.Context, request *matchingv1.RequeueDeadLetterTasksRequest) (*matchingv1.RequeueDeadLetterTasksResponse, error) {
+	response, err := g.h.RequeueDeadLetterTasks(ctx, proto.ToMatchingRequeueDeadLetterTasksRequest(request))
+	return proto.FromMatchingRequeueDeadLetterTasksResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) MigrateTaskList(ctx context.Context, request *matchingv1.MigrateTaskListRequest) (*matchingv1.MigrateTaskListResponse, error) {
+	response, err := g.h.MigrateTaskList(ctx, proto.ToMatchingMigrateTaskListRequest(request))
+	return proto.FromMatchingMigrateTaskListResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) DeleteTaskList(ctx context.Context, request *matchingv1.DeleteTaskListRequest) (*matchingv1.DeleteTaskListResponse, error) {
+	err := g.h.DeleteTaskList(ctx, proto.ToMatchingDeleteTaskListRequest(request))
+	return &matchingv1.DeleteTaskListResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) ListStickyTaskLists(ctx context.Context, request *matchingv1.ListStickyTaskListsRequest) (*matchingv1.ListStickyTaskListsResponse, error) {
+	response, err := g.h.ListStickyTaskLists(ctx, proto.ToMatchingListStickyTaskListsRequest(request))
+	return proto.FromMatchingListStickyTaskListsResponse(response), proto.FromError(err)
+}
@@ -53,6 +53,12 @@ type (
 		PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest) (*types.MatchingPollForDecisionTaskResponse, error)
 		QueryWorkflow(context.Context, *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
 		RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest) error
+		DescribeTaskListConfig(context.Context, *types.MatchingDescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error)
+		UpdateTaskListConfig(context.Context, *types.MatchingUpdateTaskListConfigRequest) (*types.UpdateTaskListConfigResponse, error)
+		RequeueDeadLetterTasks(context.Context, *types.MatchingRequeueDeadLetterTasksRequest) (*types.RequeueDeadLetterTasksResponse, error)
+		MigrateTaskList(context.Context, *types.MatchingMigrateTaskListRequest) (*types.MigrateTaskListResponse, error)
+		DeleteTaskList(context.Context, *types.MatchingDeleteTaskListRequest) error
+		ListStickyTaskLists(context.Context, *types.MatchingListStickyTaskListsRequest) (*types.MatchingListStickyTaskListsResponse, error)
 	}
 
 	// handlerImpl is an implementation for matching service independent of wire protocol
@@ -119,6 +125,7 @@ func NewHandler(
 
 // Start starts the handler
 func (h *handlerImpl) Start() {
+	h.engine.Start()
 	h.startWG.Done()
 }
 
@@ -455,6 +462,163 @@ func (h *handlerImpl) GetTaskListsByDomain(
 	return response, hCtx.handleErr(err)
 }
 
+// DescribeTaskListConfig returns the server side limits configured on a task list partition
+func (h *handlerImpl) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+) (resp *types.DescribeTaskListConfigResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetDescRequest().GetTaskList(),
+		metrics.MatchingDescribeTaskListConfigScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.DescribeTaskListConfig(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+// UpdateTaskListConfig updates the server side limits of a task list partition owned by this host
+// and applies them right away
+func (h *handlerImpl) UpdateTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListConfigRequest,
+) (resp *types.UpdateTaskListConfigResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetUpdateRequest().GetTaskList(),
+		metrics.MatchingUpdateTaskListConfigScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.UpdateTaskListConfig(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+// RequeueDeadLetterTasks moves dead-lettered tasks back to the backlog of the task list owned by this host
+func (h *handlerImpl) RequeueDeadLetterTasks(
+	ctx context.Context,
+	request *types.MatchingRequeueDeadLetterTasksRequest,
+) (resp *types.RequeueDeadLetterTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetRequeueRequest().GetTaskList(),
+		metrics.MatchingRequeueDeadLetterTasksScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.RequeueDeadLetterTasks(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+// MigrateTaskList moves the backlog of the task list owned by this host to another task list
+func (h *handlerImpl) MigrateTaskList(
+	ctx context.Context,
+	request *types.MatchingMigrateTaskListRequest,
+) (resp *types.MigrateTaskListResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetMigrateRequest().GetTaskList(),
+		metrics.MatchingMigrateTaskListScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.MigrateTaskList(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+// DeleteTaskList unloads the task list owned by this host and deletes it with all its tasks
+func (h *handlerImpl) DeleteTaskList(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListRequest,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetDeleteRequest().GetTaskList(),
+		metrics.MatchingDeleteTaskListScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	err := h.engine.DeleteTaskList(hCtx, request)
+	return hCtx.handleErr(err)
+}
+
+// ListStickyTaskLists returns the sticky task lists of a domain loaded by this host
+func (h *handlerImpl) ListStickyTaskLists(
+	ctx context.Context,
+	request *types.MatchingListStickyTaskListsRequest,
+) (resp *types.MatchingListStickyTaskListsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		nil,
+		metrics.MatchingListStickyTaskListsScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.ListStickyTaskLists(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 func (h *handlerImpl) domainName(id string) string {
 	domainName, err := h.domainCache.GetDomainName(id)
 	if err != nil {
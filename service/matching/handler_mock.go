@@ -58,6 +58,80 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// DeleteTaskList mocks base method
+func (m *MockHandler) DeleteTaskList(arg0 context.Context, arg1 *types.MatchingDeleteTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskList indicates an expected call of DeleteTaskList
+func (mr *MockHandlerMockRecorder) DeleteTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskList", reflect.TypeOf((*MockHandler)(nil).DeleteTaskList), arg0, arg1)
+}
+
+// DescribeTaskListConfig mocks base method
+func (m *MockHandler) DescribeTaskListConfig(arg0 context.Context, arg1 *types.MatchingDescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListConfig indicates an expected call of DescribeTaskListConfig
+func (mr *MockHandlerMockRecorder) DescribeTaskListConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListConfig", reflect.TypeOf((*MockHandler)(nil).DescribeTaskListConfig), arg0, arg1)
+}
+
+// ListStickyTaskLists mocks base method
+func (m *MockHandler) ListStickyTaskLists(arg0 context.Context, arg1 *types.MatchingListStickyTaskListsRequest) (*types.MatchingListStickyTaskListsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStickyTaskLists", arg0, arg1)
+	ret0, _ := ret[0].(*types.MatchingListStickyTaskListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStickyTaskLists indicates an expected call of ListStickyTaskLists
+func (mr *MockHandlerMockRecorder) ListStickyTaskLists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStickyTaskLists", reflect.TypeOf((*MockHandler)(nil).ListStickyTaskLists), arg0, arg1)
+}
+
+// MigrateTaskList mocks base method
+func (m *MockHandler) MigrateTaskList(arg0 context.Context, arg1 *types.MatchingMigrateTaskListRequest) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList
+func (mr *MockHandlerMockRecorder) MigrateTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockHandler)(nil).MigrateTaskList), arg0, arg1)
+}
+
+// RequeueDeadLetterTasks mocks base method
+func (m *MockHandler) RequeueDeadLetterTasks(arg0 context.Context, arg1 *types.MatchingRequeueDeadLetterTasksRequest) (*types.RequeueDeadLetterTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLetterTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.RequeueDeadLetterTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueDeadLetterTasks indicates an expected call of RequeueDeadLetterTasks
+func (mr *MockHandlerMockRecorder) RequeueDeadLetterTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetterTasks", reflect.TypeOf((*MockHandler)(nil).RequeueDeadLetterTasks), arg0, arg1)
+}
+
 // Start mocks base method
 func (m *MockHandler) Start() {
 	m.ctrl.T.Helper()
@@ -242,3 +316,18 @@ func (mr *MockHandlerMockRecorder) RespondQueryTaskCompleted(arg0, arg1 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockHandler)(nil).RespondQueryTaskCompleted), arg0, arg1)
 }
+
+// UpdateTaskListConfig mocks base method
+func (m *MockHandler) UpdateTaskListConfig(arg0 context.Context, arg1 *types.MatchingUpdateTaskListConfigRequest) (*types.UpdateTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListConfig indicates an expected call of UpdateTaskListConfig
+func (mr *MockHandlerMockRecorder) UpdateTaskListConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListConfig", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListConfig), arg0, arg1)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	// an isolation group without any poller for this amount of time is considered
+	// gone, its tasks are dispatched to any poller right away
+	_isolationGroupInactivityTimeout = time.Minute
+)
+
+type (
+	// isolationGroup holds the synchronous task channel and the poller activity of an isolation group
+	isolationGroup struct {
+		taskC chan *InternalTask
+		// number of pollers of the group currently waiting for a task
+		numPollers   int
+		lastPollTime time.Time
+	}
+
+	// isolatedTaskQueue holds the backlog tasks pending dispatch to the pollers of an isolation
+	// group in the order they were read. It never blocks its producer, push fails instead once
+	// the queue holds maxSize tasks
+	isolatedTaskQueue struct {
+		sync.Mutex
+		tasks   *list.List
+		maxSize int
+		// notifyC is signalled when a task is pushed to the queue
+		notifyC chan struct{}
+	}
+
+	// isolationGroupSet holds the isolation groups of the pollers of a task list. A task sent on
+	// the task channel of an isolation group is only picked up by a poller of that group
+	isolationGroupSet struct {
+		sync.Mutex
+		groups map[string]*isolationGroup
+	}
+)
+
+func newIsolationGroupSet() *isolationGroupSet {
+	return &isolationGroupSet{
+		groups: make(map[string]*isolationGroup),
+	}
+}
+
+// taskC returns the synchronous task channel of the isolation group
+func (s *isolationGroupSet) taskC(group string) chan *InternalTask {
+	s.Lock()
+	defer s.Unlock()
+	return s.getLocked(group).taskC
+}
+
+// registerPoller records a poller of the isolation group waiting for a task and returns the task
+// channel of the group, the returned function must be called once the poller stops waiting
+func (s *isolationGroupSet) registerPoller(group string) (<-chan *InternalTask, func()) {
+	s.Lock()
+	defer s.Unlock()
+	g := s.getLocked(group)
+	g.numPollers++
+	g.lastPollTime = time.Now()
+	return g.taskC, func() {
+		s.Lock()
+		defer s.Unlock()
+		g.numPollers--
+		g.lastPollTime = time.Now()
+	}
+}
+
+// isActive returns whether a poller of the isolation group is waiting for a task or was recently
+func (s *isolationGroupSet) isActive(group string) bool {
+	s.Lock()
+	defer s.Unlock()
+	g, ok := s.groups[group]
+	return ok && (g.numPollers > 0 || time.Since(g.lastPollTime) < _isolationGroupInactivityTimeout)
+}
+
+func (s *isolationGroupSet) getLocked(group string) *isolationGroup {
+	g, ok := s.groups[group]
+	if !ok {
+		g = &isolationGroup{taskC: make(chan *InternalTask)}
+		s.groups[group] = g
+	}
+	return g
+}
+
+// newIsolatedTaskQueue returns an empty queue of the given max size, the queue is unbounded if maxSize is 0
+func newIsolatedTaskQueue(maxSize int) *isolatedTaskQueue {
+	return &isolatedTaskQueue{
+		tasks:   list.New(),
+		maxSize: maxSize,
+		notifyC: make(chan struct{}, 1),
+	}
+}
+
+// push appends the task to the queue, returns false if the queue is full
+func (q *isolatedTaskQueue) push(task *InternalTask) bool {
+	q.Lock()
+	defer q.Unlock()
+	if q.maxSize > 0 && q.tasks.Len() >= q.maxSize {
+		return false
+	}
+	q.tasks.PushBack(task)
+	select {
+	case q.notifyC <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// peek returns the oldest task of the queue without removing it, nil if the queue is empty
+func (q *isolatedTaskQueue) peek() *InternalTask {
+	q.Lock()
+	defer q.Unlock()
+	if front := q.tasks.Front(); front != nil {
+		return front.Value.(*InternalTask)
+	}
+	return nil
+}
+
+// pop removes and returns the oldest task of the queue, nil if the queue is empty
+func (q *isolatedTaskQueue) pop() *InternalTask {
+	q.Lock()
+	defer q.Unlock()
+	if front := q.tasks.Front(); front != nil {
+		return q.tasks.Remove(front).(*InternalTask)
+	}
+	return nil
+}
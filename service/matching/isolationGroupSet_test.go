@@ -0,0 +1,83 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsolationGroupSet(t *testing.T) {
+	s := newIsolationGroupSet()
+	assert.False(t, s.isActive("zone1"))
+	assert.Equal(t, s.taskC("zone1"), s.taskC("zone1"))
+	assert.NotEqual(t, s.taskC("zone1"), s.taskC("zone2"))
+	// creating the channel of a group does not make it active
+	assert.False(t, s.isActive("zone1"))
+
+	taskC, unregister := s.registerPoller("zone1")
+	assert.True(t, s.isActive("zone1"))
+	assert.False(t, s.isActive("zone2"))
+
+	task := &InternalTask{}
+	go func() { s.taskC("zone1") <- task }()
+	select {
+	case received := <-taskC:
+		assert.Equal(t, task, received)
+	case <-time.After(time.Second):
+		t.Fatal("task not received on the isolation group channel")
+	}
+
+	unregister()
+	// the group stays active for a while after its last poll
+	assert.True(t, s.isActive("zone1"))
+	s.groups["zone1"].lastPollTime = time.Now().Add(-_isolationGroupInactivityTimeout)
+	assert.False(t, s.isActive("zone1"))
+}
+
+func TestIsolatedTaskQueue(t *testing.T) {
+	q := newIsolatedTaskQueue(2)
+	assert.Nil(t, q.peek())
+	assert.Nil(t, q.pop())
+
+	task1, task2 := &InternalTask{}, &InternalTask{}
+	assert.True(t, q.push(task1))
+	assert.True(t, q.push(task2))
+	// the producer is never blocked by a full queue
+	assert.False(t, q.push(&InternalTask{}))
+	select {
+	case <-q.notifyC:
+	default:
+		t.Fatal("push did not notify the queue consumer")
+	}
+
+	assert.Equal(t, task1, q.peek())
+	assert.Equal(t, task1, q.pop())
+	assert.Equal(t, task2, q.pop())
+	assert.Nil(t, q.pop())
+
+	unbounded := newIsolatedTaskQueue(0)
+	for i := 0; i < 10; i++ {
+		assert.True(t, unbounded.push(&InternalTask{}))
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// labeledTask is a task with a label selector waiting for a compatible poller
+	labeledTask struct {
+		task     *InternalTask
+		selector map[string]string
+		// key of the group of tasks with the same selector
+		key string
+		// order in which the task was added
+		seq int64
+		// set for backlog tasks, which are offered to the parent partition
+		// when no compatible poller took them by then
+		expiry time.Time
+		// closed when a poller takes the task
+		takenC chan struct{}
+	}
+
+	// labeledTaskGroup holds the tasks with the same label selector, oldest first
+	labeledTaskGroup struct {
+		selector map[string]string
+		tasks    []*labeledTask
+	}
+
+	// labeledPoller is a poller advertising labels waiting for a task
+	labeledPoller struct {
+		labels   map[string]string
+		capacity int32
+	}
+
+	// labeledTaskSet holds the tasks with a label selector that are waiting to be dispatched.
+	// Such tasks cannot be offered through the task channel since any poller could receive
+	// them, instead pollers advertising labels pull the first compatible task from this set.
+	// A task is left to the waiting compatible poller advertising the highest capacity.
+	//
+	// Tasks are grouped by label selector so that pollers only match their labels against
+	// the distinct selectors. The set holds at most maxSize tasks, tasks which don't fit
+	// are dispatched to any poller.
+	labeledTaskSet struct {
+		sync.Mutex
+		maxSize func() int
+		groups  map[string]*labeledTaskGroup
+		size    int
+		nextSeq int64
+		// closed and replaced every time a task is added or a poller stops waiting, to wake up waiting pollers
+		changedC chan struct{}
+		// the pollers currently waiting for a task
+		pollers map[int64]*labeledPoller
+		nextID  int64
+	}
+)
+
+func newLabeledTaskSet(maxSize func() int) *labeledTaskSet {
+	return &labeledTaskSet{
+		maxSize:  maxSize,
+		groups:   make(map[string]*labeledTaskGroup),
+		changedC: make(chan struct{}),
+		pollers:  make(map[int64]*labeledPoller),
+	}
+}
+
+// add makes the task available to compatible pollers, a zero expiry means the task never expires.
+// Returns nil if the set is full
+func (s *labeledTaskSet) add(task *InternalTask, selector map[string]string, expiry time.Time) *labeledTask {
+	lt := &labeledTask{
+		task:     task,
+		selector: selector,
+		key:      labelSelectorKey(selector),
+		expiry:   expiry,
+		takenC:   make(chan struct{}),
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.size >= s.maxSize() {
+		return nil
+	}
+	group, ok := s.groups[lt.key]
+	if !ok {
+		group = &labeledTaskGroup{selector: selector}
+		s.groups[lt.key] = group
+	}
+	lt.seq = s.nextSeq
+	s.nextSeq++
+	group.tasks = append(group.tasks, lt)
+	s.size++
+	s.notifyLocked()
+	return lt
+}
+
+// remove removes the task from the set, returns false if the task was already taken by a poller
+func (s *labeledTaskSet) remove(lt *labeledTask) bool {
+	s.Lock()
+	defer s.Unlock()
+	group, ok := s.groups[lt.key]
+	if !ok {
+		return false
+	}
+	for i, t := range group.tasks {
+		if t == lt {
+			s.removeLocked(lt.key, group, i)
+			return true
+		}
+	}
+	return false
+}
+
+// removeExpired removes and returns the tasks whose expiry passed
+func (s *labeledTaskSet) removeExpired(now time.Time) []*InternalTask {
+	s.Lock()
+	defer s.Unlock()
+	var expired []*InternalTask
+	for key, group := range s.groups {
+		remaining := group.tasks[:0]
+		for _, t := range group.tasks {
+			if !t.expiry.IsZero() && now.After(t.expiry) {
+				expired = append(expired, t.task)
+				continue
+			}
+			remaining = append(remaining, t)
+		}
+		for i := len(remaining); i < len(group.tasks); i++ {
+			group.tasks[i] = nil
+		}
+		s.size -= len(group.tasks) - len(remaining)
+		group.tasks = remaining
+		if len(group.tasks) == 0 {
+			delete(s.groups, key)
+		}
+	}
+	return expired
+}
+
+// take removes and returns the oldest task compatible with the labels of the registered poller,
+// skipping the selectors another waiting poller with a higher capacity can take. When there is
+// no such task, the returned channel is closed the next time the set changes
+func (s *labeledTaskSet) take(pollerID int64) (*InternalTask, <-chan struct{}) {
+	s.Lock()
+	defer s.Unlock()
+	poller, ok := s.pollers[pollerID]
+	if !ok {
+		return nil, s.changedC
+	}
+	var oldest *labeledTaskGroup
+	for _, group := range s.groups {
+		if oldest != nil && oldest.tasks[0].seq < group.tasks[0].seq {
+			continue
+		}
+		if matchLabels(group.selector, poller.labels) && !s.hasBetterPollerLocked(pollerID, poller, group.selector) {
+			oldest = group
+		}
+	}
+	if oldest == nil {
+		return nil, s.changedC
+	}
+	t := oldest.tasks[0]
+	s.removeLocked(t.key, oldest, 0)
+	close(t.takenC)
+	return t.task, nil
+}
+
+// registerPoller records the labels and capacity of a waiting poller, the returned function
+// must be called once the poller stops waiting
+func (s *labeledTaskSet) registerPoller(labels map[string]string, capacity int32) (int64, func()) {
+	s.Lock()
+	defer s.Unlock()
+	id := s.nextID
+	s.nextID++
+	s.pollers[id] = &labeledPoller{labels: labels, capacity: capacity}
+	return id, func() {
+		s.Lock()
+		defer s.Unlock()
+		delete(s.pollers, id)
+		// the tasks left to this poller can be taken by the others now
+		s.notifyLocked()
+	}
+}
+
+// hasPoller returns whether a poller compatible with the selector is waiting for a task
+func (s *labeledTaskSet) hasPoller(selector map[string]string) bool {
+	s.Lock()
+	defer s.Unlock()
+	for _, poller := range s.pollers {
+		if matchLabels(selector, poller.labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *labeledTaskSet) hasBetterPollerLocked(pollerID int64, poller *labeledPoller, selector map[string]string) bool {
+	for id, other := range s.pollers {
+		if id != pollerID && other.capacity > poller.capacity && matchLabels(selector, other.labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *labeledTaskSet) removeLocked(key string, group *labeledTaskGroup, index int) {
+	copy(group.tasks[index:], group.tasks[index+1:])
+	group.tasks[len(group.tasks)-1] = nil
+	group.tasks = group.tasks[:len(group.tasks)-1]
+	if len(group.tasks) == 0 {
+		delete(s.groups, key)
+	}
+	s.size--
+}
+
+func (s *labeledTaskSet) notifyLocked() {
+	close(s.changedC)
+	s.changedC = make(chan struct{})
+}
+
+// labelSelectorKey returns the canonical form of the selector, which is
+// the same for selectors with the same labels in a different order
+func labelSelectorKey(selector map[string]string) string {
+	keys := make([]string, 0, len(selector))
+	for key := range selector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(key)
+		b.WriteByte('=')
+		b.WriteString(selector[key])
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// matchLabels returns whether the labels satisfy the selector, that is every key
+// of the selector is present in the labels with the same value
+func matchLabels(selector map[string]string, labels map[string]string) bool {
+	for key, value := range selector {
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLabelSelector returns the label selector of a persisted task, an invalid
+// selector is ignored so that the task can still be dispatched to any poller
+func parseLabelSelector(selector string) map[string]string {
+	if selector == "" {
+		return nil
+	}
+	labels, err := common.ParseLabels(selector)
+	if err != nil || len(labels) == 0 {
+		return nil
+	}
+	return labels
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchLabels(t *testing.T) {
+	labels := map[string]string{"gpu": "true", "zone": "a"}
+	assert.True(t, matchLabels(nil, labels))
+	assert.True(t, matchLabels(map[string]string{"gpu": "true"}, labels))
+	assert.True(t, matchLabels(map[string]string{"gpu": "true", "zone": "a"}, labels))
+	assert.False(t, matchLabels(map[string]string{"gpu": "false"}, labels))
+	assert.False(t, matchLabels(map[string]string{"region": "us"}, labels))
+	assert.False(t, matchLabels(map[string]string{"gpu": "true"}, nil))
+}
+
+func TestLabeledTaskSet(t *testing.T) {
+	s := newLabeledTaskSet(func() int { return 10 })
+	task := &InternalTask{}
+	lt := s.add(task, map[string]string{"gpu": "true"}, time.Time{})
+
+	cpuPoller, unregisterCPU := s.registerPoller(map[string]string{"gpu": "false"}, 0)
+	taken, changedC := s.take(cpuPoller)
+	assert.Nil(t, taken)
+	assert.NotNil(t, changedC)
+	unregisterCPU()
+	assertClosed(t, changedC)
+
+	gpuPoller, unregisterGPU := s.registerPoller(map[string]string{"gpu": "true"}, 0)
+	assert.True(t, s.hasPoller(map[string]string{"gpu": "true"}))
+	taken, _ = s.take(gpuPoller)
+	assert.Equal(t, task, taken)
+	assertClosed(t, lt.takenC)
+	assert.False(t, s.remove(lt))
+	unregisterGPU()
+	assert.False(t, s.hasPoller(map[string]string{"gpu": "true"}))
+
+	_, changedC = s.take(gpuPoller)
+	lt = s.add(task, map[string]string{"gpu": "true"}, time.Time{})
+	assertClosed(t, changedC)
+	assert.True(t, s.remove(lt))
+}
+
+func TestLabeledTaskSet_PrefersHigherCapacity(t *testing.T) {
+	s := newLabeledTaskSet(func() int { return 10 })
+	task := &InternalTask{}
+	s.add(task, map[string]string{"gpu": "true"}, time.Time{})
+
+	smallPoller, unregisterSmall := s.registerPoller(map[string]string{"gpu": "true"}, 2)
+	defer unregisterSmall()
+	largePoller, unregisterLarge := s.registerPoller(map[string]string{"gpu": "true", "zone": "a"}, 8)
+	// an incompatible poller with a higher capacity doesn't hold back the task
+	_, unregisterOther := s.registerPoller(map[string]string{"gpu": "false"}, 16)
+	defer unregisterOther()
+
+	taken, changedC := s.take(smallPoller)
+	assert.Nil(t, taken)
+	unregisterLarge()
+	assertClosed(t, changedC)
+	taken, _ = s.take(smallPoller)
+	assert.Equal(t, task, taken)
+
+	s.add(task, map[string]string{"gpu": "true"}, time.Time{})
+	largePoller, unregisterLarge = s.registerPoller(map[string]string{"gpu": "true"}, 8)
+	defer unregisterLarge()
+	taken, _ = s.take(largePoller)
+	assert.Equal(t, task, taken)
+}
+
+func TestLabeledTaskSet_RemoveExpired(t *testing.T) {
+	s := newLabeledTaskSet(func() int { return 10 })
+	now := time.Now()
+	expired := &InternalTask{}
+	pending := &InternalTask{}
+	synced := &InternalTask{}
+	s.add(expired, map[string]string{"gpu": "true"}, now.Add(-time.Second))
+	s.add(pending, map[string]string{"gpu": "true"}, now.Add(time.Minute))
+	s.add(synced, map[string]string{"gpu": "true"}, time.Time{})
+
+	assert.Equal(t, []*InternalTask{expired}, s.removeExpired(now))
+	assert.Empty(t, s.removeExpired(now))
+
+	poller, unregister := s.registerPoller(map[string]string{"gpu": "true"}, 0)
+	defer unregister()
+	taken, _ := s.take(poller)
+	assert.Equal(t, pending, taken)
+	taken, _ = s.take(poller)
+	assert.Equal(t, synced, taken)
+}
+
+func TestLabeledTaskSet_Full(t *testing.T) {
+	s := newLabeledTaskSet(func() int { return 1 })
+	lt := s.add(&InternalTask{}, map[string]string{"gpu": "true"}, time.Time{})
+	assert.NotNil(t, lt)
+	assert.Nil(t, s.add(&InternalTask{}, map[string]string{"gpu": "false"}, time.Time{}))
+
+	assert.True(t, s.remove(lt))
+	assert.NotNil(t, s.add(&InternalTask{}, map[string]string{"gpu": "false"}, time.Time{}))
+}
+
+func TestLabeledTaskSet_TakesOldestCompatibleTask(t *testing.T) {
+	s := newLabeledTaskSet(func() int { return 10 })
+	first := &InternalTask{}
+	second := &InternalTask{}
+	third := &InternalTask{}
+	s.add(first, map[string]string{"zone": "a", "gpu": "true"}, time.Time{})
+	s.add(&InternalTask{}, map[string]string{"gpu": "false"}, time.Time{})
+	s.add(second, map[string]string{"gpu": "true"}, time.Time{})
+	// same selector as the first task with the labels in a different order
+	s.add(third, map[string]string{"gpu": "true", "zone": "a"}, time.Time{})
+	assert.Len(t, s.groups, 3)
+
+	poller, unregister := s.registerPoller(map[string]string{"gpu": "true", "zone": "a"}, 0)
+	defer unregister()
+	for _, expected := range []*InternalTask{first, second, third} {
+		taken, _ := s.take(poller)
+		assert.Equal(t, expected, taken)
+	}
+	taken, _ := s.take(poller)
+	assert.Nil(t, taken)
+	assert.Equal(t, 1, s.size)
+}
+
+func assertClosed(t *testing.T, c <-chan struct{}) {
+	select {
+	case <-c:
+	default:
+		t.Fatal("channel is not closed")
+	}
+}
@@ -43,6 +43,15 @@ type TaskMatcher struct {
 	// are interested in queryTasks but not others. Example is when domain is
 	// not active in a cluster
 	queryTaskC chan *InternalTask
+	// tasks with a label selector, which are only dispatched to pollers
+	// advertising compatible labels
+	labeledTasks *labeledTaskSet
+	// synchronous task channels of the isolation groups of the pollers, tasks
+	// are preferentially matched with pollers of their isolation group
+	isolationGroups             *isolationGroupSet
+	enableIsolationGroups       func() bool
+	isolationGroupFallbackDelay func() time.Duration
+	labeledTaskBacklogTimeout   func() time.Duration
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter *quotas.RateLimiter
 
@@ -54,6 +63,10 @@ type TaskMatcher struct {
 const (
 	_defaultTaskDispatchRPS    = 100000.0
 	_defaultTaskDispatchRPSTTL = 60 * time.Second
+
+	// max time a task with a label selector waits for a compatible
+	// poller before falling back to the non sync match path
+	_labeledSyncMatchTimeout = 100 * time.Millisecond
 )
 
 var errTasklistThrottled = errors.New("cannot add to tasklist, limit exceeded")
@@ -65,12 +78,17 @@ func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() me
 	dPtr := _defaultTaskDispatchRPS
 	limiter := quotas.NewRateLimiter(&dPtr, _defaultTaskDispatchRPSTTL, config.MinTaskThrottlingBurstSize())
 	return &TaskMatcher{
-		limiter:       limiter,
-		scope:         scopeFunc,
-		fwdr:          fwdr,
-		taskC:         make(chan *InternalTask),
-		queryTaskC:    make(chan *InternalTask),
-		numPartitions: config.NumReadPartitions,
+		limiter:                     limiter,
+		scope:                       scopeFunc,
+		fwdr:                        fwdr,
+		taskC:                       make(chan *InternalTask),
+		queryTaskC:                  make(chan *InternalTask),
+		labeledTasks:                newLabeledTaskSet(config.MaxLabeledTasks),
+		isolationGroups:             newIsolationGroupSet(),
+		enableIsolationGroups:       config.EnableIsolationGroups,
+		isolationGroupFallbackDelay: config.IsolationGroupFallbackDelay,
+		labeledTaskBacklogTimeout:   config.LabeledTaskBacklogTimeout,
+		numPartitions:               config.NumReadPartitions,
 	}
 }
 
@@ -114,6 +132,14 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 		}
 	}
 
+	if selector := task.labelSelector(); len(selector) > 0 {
+		return tm.offerLabeled(ctx, task, selector, rsv)
+	}
+
+	if group := tm.taskIsolationGroup(task); group != "" {
+		return tm.offerIsolated(ctx, task, group, rsv)
+	}
+
 	select {
 	case tm.taskC <- task: // poller picked up the task
 		if task.responseC != nil {
@@ -154,8 +180,14 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 }
 
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *InternalTask) (bool, error) {
+	taskC := tm.taskC
+	if group := tm.taskIsolationGroup(task); group != "" {
+		// only wait for a poller of the isolation group, the task
+		// falls back to other pollers once it is in the backlog
+		taskC = tm.isolationGroups.taskC(group)
+	}
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case taskC <- task: // poller picked up the task
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
@@ -170,6 +202,111 @@ func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *InternalTask) (
 	}
 }
 
+// offerLabeled is the sync match path of a task with a label selector, the task
+// is only handed to a waiting poller advertising compatible labels
+func (tm *TaskMatcher) offerLabeled(
+	ctx context.Context,
+	task *InternalTask,
+	selector map[string]string,
+	rsv *rate.Reservation,
+) (bool, error) {
+	if !tm.isForwardingAllowed() && task.source == types.TaskSourceDbBacklog && task.isForwarded() {
+		// a forwarded backlog task from a child partition, block trying
+		// to match with a compatible poller until ctx timeout
+		return tm.offerLabeledOrTimeout(ctx, task, selector)
+	}
+
+	if tm.labeledTasks.hasPoller(selector) {
+		childCtx, cancel := context.WithTimeout(ctx, _labeledSyncMatchTimeout)
+		matched, err := tm.offerLabeledOrTimeout(childCtx, task, selector)
+		cancel()
+		if matched || err != nil {
+			return matched, err
+		}
+	}
+
+	select {
+	case token := <-tm.fwdrAddReqTokenC():
+		err := tm.fwdr.ForwardTask(ctx, task)
+		token.release()
+		if err == nil {
+			// task was remotely sync matched on the parent partition
+			return true, nil
+		}
+	default:
+	}
+
+	if rsv != nil {
+		rsv.Cancel()
+	}
+	return false, nil
+}
+
+func (tm *TaskMatcher) offerLabeledOrTimeout(
+	ctx context.Context,
+	task *InternalTask,
+	selector map[string]string,
+) (bool, error) {
+	lt := tm.labeledTasks.add(task, selector, time.Time{})
+	if lt == nil {
+		// too many tasks are waiting for a compatible poller
+		return false, nil
+	}
+	select {
+	case <-lt.takenC:
+	case <-ctx.Done():
+		if tm.labeledTasks.remove(lt) {
+			return false, nil
+		}
+		// a poller took the task right before it was removed
+	}
+	if task.responseC != nil {
+		return true, <-task.responseC
+	}
+	return task.activityTaskDispatchInfo != nil, nil
+}
+
+// offerIsolated is the sync match path of a task with an isolation group, the task is only
+// handed to a waiting poller of the group. When there is no such poller, the task goes to
+// the backlog where it waits for a poller of the group until the fallback delay expires
+func (tm *TaskMatcher) offerIsolated(
+	ctx context.Context,
+	task *InternalTask,
+	group string,
+	rsv *rate.Reservation,
+) (bool, error) {
+	select {
+	case tm.isolationGroups.taskC(group) <- task: // poller of the isolation group picked up the task
+		if task.responseC != nil {
+			return true, <-task.responseC
+		}
+		return false, nil
+	default:
+	}
+
+	if !tm.isForwardingAllowed() && task.source == types.TaskSourceDbBacklog && task.isForwarded() {
+		// a forwarded backlog task from a child partition, block trying
+		// to match with a poller of the isolation group until ctx timeout
+		return tm.offerOrTimeout(ctx, task)
+	}
+
+	select {
+	case token := <-tm.fwdrAddReqTokenC():
+		err := tm.fwdr.ForwardTask(ctx, task)
+		token.release()
+		if err == nil {
+			// task was remotely sync matched on the parent partition
+			return true, nil
+		}
+	default:
+	}
+
+	if rsv != nil {
+		rsv.Cancel()
+	}
+	return false, nil
+}
+
 // OfferQuery will either match task to local poller or will forward query task.
 // Local match is always attempted before forwarding is attempted. If local match occurs
 // response and error are both nil, if forwarding occurs then response or error is returned.
@@ -207,7 +344,10 @@ func (tm *TaskMatcher) OfferQuery(ctx context.Context, task *InternalTask) (*typ
 	}
 }
 
-// MustOffer blocks until a consumer is found to handle this task
+// MustOffer blocks until a consumer is found to handle this task, except for a task with a label
+// selector which is added to the labeled tasks waiting for a compatible poller, unless there are
+// too many of them already in which case the task is dispatched to any poller. Backlog tasks of an
+// isolation group are only passed to MustOffer once OfferIsolated gave up on the pollers of the group
 // Returns error only when context is canceled or the ratelimit is set to zero (allow nothing)
 // The passed in context MUST NOT have a deadline associated with it
 func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error {
@@ -215,6 +355,15 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error
 		return err
 	}
 
+	if selector := task.labelSelector(); len(selector) > 0 {
+		// don't block the backlog on a task only some pollers can take
+		if tm.AddLabeledTask(task) {
+			return nil
+		}
+		task.labelSelectorDropped = true
+		tm.scope().IncCounter(metrics.LabeledTasksFallbackPerTaskListCounter)
+	}
+
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
@@ -263,31 +412,155 @@ forLoop:
 	}
 }
 
+// AddLabeledTask makes a backlog task with a label selector available to compatible pollers,
+// returns false if there are too many tasks waiting for a compatible poller already. On a child
+// partition, the task is handed back by RemoveExpiredLabeledTasks if no compatible poller took it
+// before the labeled task backlog timeout so that it can be offered to the parent partition
+func (tm *TaskMatcher) AddLabeledTask(task *InternalTask) bool {
+	var expiry time.Time
+	if tm.isForwardingAllowed() {
+		expiry = time.Now().Add(tm.labeledTaskBacklogTimeout())
+	}
+	return tm.labeledTasks.add(task, task.labelSelector(), expiry) != nil
+}
+
+// RemoveExpiredLabeledTasks removes and returns the backlog tasks with a label selector
+// that no compatible poller took before the labeled task backlog timeout
+func (tm *TaskMatcher) RemoveExpiredLabeledTasks(now time.Time) []*InternalTask {
+	return tm.labeledTasks.removeExpired(now)
+}
+
+// TryForward forwards the task to the parent partition if forwarding is possible right away,
+// returns whether the task was dispatched to a poller of the parent partition
+func (tm *TaskMatcher) TryForward(ctx context.Context, task *InternalTask) bool {
+	select {
+	case token := <-tm.fwdrAddReqTokenC():
+		childCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+		err := tm.fwdr.ForwardTask(childCtx, task)
+		cancel()
+		token.release()
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// OfferIsolated blocks until a poller of the isolation group of the backlog task picks it up, or the
+// task is forwarded to the parent partition. Returns false once the task waited for longer than the
+// isolation group fallback delay, or right away if the task has no active isolation group, in which
+// case the task must be dispatched to any poller with MustOffer
+// The passed in context MUST NOT have a deadline associated with it
+func (tm *TaskMatcher) OfferIsolated(ctx context.Context, task *InternalTask) (bool, error) {
+	group := tm.taskIsolationGroup(task)
+	if group == "" {
+		return false, nil
+	}
+	fallbackDelay := time.Until(task.event.CreatedTime.Add(tm.isolationGroupFallbackDelay()))
+	if fallbackDelay <= 0 {
+		return false, nil
+	}
+	fallbackTimer := time.NewTimer(fallbackDelay)
+	defer fallbackTimer.Stop()
+
+	// the reservation is cancelled when the task falls back, as MustOffer then takes another one
+	rsv := tm.limiter.Reserve()
+	if !rsv.OK() {
+		return false, nil
+	}
+	matched, err := tm.offerIsolatedUntil(ctx, task, group, rsv.Delay(), fallbackTimer.C)
+	if !matched {
+		rsv.Cancel()
+	}
+	return matched, err
+}
+
+func (tm *TaskMatcher) offerIsolatedUntil(
+	ctx context.Context,
+	task *InternalTask,
+	group string,
+	rateLimitDelay time.Duration,
+	fallbackC <-chan time.Time,
+) (bool, error) {
+	rateLimitTimer := time.NewTimer(rateLimitDelay)
+	defer rateLimitTimer.Stop()
+	select {
+	case <-rateLimitTimer.C:
+	case <-fallbackC:
+		return false, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+
+	taskC := tm.isolationGroups.taskC(group)
+	for {
+		select {
+		case taskC <- task:
+			return true, nil
+		case token := <-tm.fwdrAddReqTokenC():
+			childCtx, cancel := context.WithDeadline(ctx, time.Now().Add(time.Second*2))
+			err := tm.fwdr.ForwardTask(childCtx, task)
+			token.release()
+			if err == nil {
+				cancel()
+				task.finish(nil)
+				return true, nil
+			}
+			// same as MustOffer, only attempt the next forwarded call
+			// after childCtx expires and hope for a local match till then
+			select {
+			case taskC <- task:
+				cancel()
+				return true, nil
+			case <-fallbackC:
+				cancel()
+				return false, nil
+			case <-childCtx.Done():
+			}
+			cancel()
+		case <-fallbackC:
+			return false, nil
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) Poll(ctx context.Context) (*InternalTask, error) {
+	var isolatedTaskC <-chan *InternalTask
+	if group := tm.pollerIsolationGroup(ctx); group != "" {
+		// pollers of an isolation group can also receive the tasks of the group
+		var unregister func()
+		isolatedTaskC, unregister = tm.isolationGroups.registerPoller(group)
+		defer unregister()
+	}
+	if labels, _ := ctx.Value(pollerLabelsKey).(map[string]string); len(labels) > 0 {
+		// pollers advertising labels can also receive tasks with a compatible label selector
+		return tm.pollLabeled(ctx, labels, isolatedTaskC)
+	}
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC, isolatedTaskC); err == nil {
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC)
+	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC, isolatedTaskC)
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*InternalTask, error) {
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC, nil); err == nil {
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctx, nil, tm.queryTaskC, nil)
 }
 
 // UpdateRatelimit updates the task dispatch rate
@@ -309,10 +582,69 @@ func (tm *TaskMatcher) Rate() float64 {
 	return tm.limiter.Limit()
 }
 
+func (tm *TaskMatcher) pollLabeled(
+	ctx context.Context,
+	labels map[string]string,
+	isolatedTaskC <-chan *InternalTask,
+) (*InternalTask, error) {
+	capacity, _ := ctx.Value(pollerCapacityKey).(int32)
+	pollerID, unregister := tm.labeledTasks.registerPoller(labels, capacity)
+	defer func() { unregister() }()
+
+	fwdrTokenC := tm.fwdrPollReqTokenC()
+	for {
+		task, changedC := tm.labeledTasks.take(pollerID)
+		if task != nil {
+			if task.responseC != nil {
+				tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+			}
+			tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+			return task, nil
+		}
+
+		select {
+		case task := <-tm.taskC:
+			if task.responseC != nil {
+				tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+			}
+			tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+			return task, nil
+		case task := <-isolatedTaskC:
+			if task.responseC != nil {
+				tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+			}
+			tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+			return task, nil
+		case task := <-tm.queryTaskC:
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+			tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+			return task, nil
+		case <-changedC:
+			// a task was added, check whether it is compatible with this poller
+		case <-ctx.Done():
+			tm.scope().IncCounter(metrics.PollTimeoutPerTaskListCounter)
+			return nil, ErrNoTasks
+		case token := <-fwdrTokenC:
+			// the poller can't take local tasks while the poll is forwarded
+			unregister()
+			task, err := tm.fwdr.ForwardPoll(ctx)
+			token.release()
+			if err == nil {
+				unregister = func() {}
+				return task, nil
+			}
+			pollerID, unregister = tm.labeledTasks.registerPoller(labels, capacity)
+			// same as pollOrForward, forward the poll at most once
+			fwdrTokenC = noopForwarderTokenC
+		}
+	}
+}
+
 func (tm *TaskMatcher) pollOrForward(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
 	case task := <-taskC:
@@ -321,6 +653,12 @@ func (tm *TaskMatcher) pollOrForward(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-isolatedTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -334,7 +672,7 @@ func (tm *TaskMatcher) pollOrForward(
 			return task, nil
 		}
 		token.release()
-		return tm.poll(ctx, taskC, queryTaskC)
+		return tm.poll(ctx, taskC, queryTaskC, isolatedTaskC)
 	}
 }
 
@@ -342,6 +680,7 @@ func (tm *TaskMatcher) poll(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
 	case task := <-taskC:
@@ -350,6 +689,12 @@ func (tm *TaskMatcher) poll(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-isolatedTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -364,6 +709,7 @@ func (tm *TaskMatcher) pollNonBlocking(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
 	case task := <-taskC:
@@ -372,6 +718,12 @@ func (tm *TaskMatcher) pollNonBlocking(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-isolatedTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -426,3 +778,26 @@ func (tm *TaskMatcher) ratelimit(ctx context.Context) (*rate.Reservation, error)
 func (tm *TaskMatcher) isForwardingAllowed() bool {
 	return tm.fwdr != nil
 }
+
+// taskIsolationGroup returns the isolation group of the pollers the task should be matched with,
+// an empty string if the task can be matched with any poller right away
+func (tm *TaskMatcher) taskIsolationGroup(task *InternalTask) string {
+	if !tm.enableIsolationGroups() {
+		return ""
+	}
+	group := task.isolationGroup()
+	if group == "" || !tm.isolationGroups.isActive(group) {
+		// no need to wait for the pollers of an isolation group that is gone
+		return ""
+	}
+	return group
+}
+
+// pollerIsolationGroup returns the isolation group of the poller, if any
+func (tm *TaskMatcher) pollerIsolationGroup(ctx context.Context) string {
+	if !tm.enableIsolationGroups() {
+		return ""
+	}
+	group, _ := ctx.Value(isolationGroupKey).(string)
+	return group
+}
@@ -317,6 +317,217 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestLabeledLocalSyncMatch() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		ctx = context.WithValue(ctx, pollerLabelsKey, map[string]string{"gpu": "true", "zone": "a"})
+		task, err := t.matcher.Poll(ctx)
+		if err == nil {
+			task.finish(nil)
+		}
+	})
+
+	info := t.newTaskInfo()
+	info.LabelSelector = "gpu=true"
+	task := newInternalTask(info, nil, types.TaskSourceHistory, "", true, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.True(syncMatch)
+}
+
+func (t *MatcherTestSuite) TestLabeledMustOfferSkipsIncompatiblePollers() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	info := t.newTaskInfo()
+	info.LabelSelector = "gpu=true"
+	task := newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil)
+	// the task waits for a compatible poller without blocking the backlog
+	t.NoError(t.matcher.MustOffer(context.Background(), task))
+
+	for _, labels := range []map[string]string{nil, {"gpu": "false"}} {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		ctx = context.WithValue(ctx, pollerLabelsKey, labels)
+		_, err := t.matcher.Poll(ctx)
+		cancel()
+		t.Equal(ErrNoTasks, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	ctx = context.WithValue(ctx, pollerLabelsKey, map[string]string{"gpu": "true"})
+	polledTask, err := t.matcher.Poll(ctx)
+	cancel()
+	t.NoError(err)
+	t.Equal(task, polledTask)
+}
+
+func (t *MatcherTestSuite) TestLabeledMustOfferExpires() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	info := t.newTaskInfo()
+	info.LabelSelector = "gpu=true"
+	task := newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil)
+	t.NoError(t.matcher.MustOffer(context.Background(), task))
+
+	t.Empty(t.matcher.RemoveExpiredLabeledTasks(time.Now()))
+	t.Equal([]*InternalTask{task}, t.matcher.RemoveExpiredLabeledTasks(time.Now().Add(time.Hour)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	ctx = context.WithValue(ctx, pollerLabelsKey, map[string]string{"gpu": "true"})
+	_, err := t.matcher.Poll(ctx)
+	cancel()
+	t.Equal(ErrNoTasks, err)
+}
+
+func (t *MatcherTestSuite) TestLabeledMustOfferNeverExpiresOnRootPartition() {
+	info := t.newTaskInfo()
+	info.LabelSelector = "gpu=true"
+	task := newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil)
+	t.NoError(t.rootMatcher.MustOffer(context.Background(), task))
+
+	// there is no parent partition to offer the task to, it waits for a compatible poller
+	t.Empty(t.rootMatcher.RemoveExpiredLabeledTasks(time.Now().Add(time.Hour)))
+}
+
+func (t *MatcherTestSuite) TestLabeledMustOfferFallsBackWhenFull() {
+	rootTaskList := newTestTaskListID(t.taskList.domainID, t.taskList.Parent(20), persistence.TaskListTypeDecision)
+	cfg, err := newTaskListConfig(rootTaskList, NewConfig(dynamicconfig.NewNopCollection()), t.newDomainCache())
+	t.NoError(err)
+	cfg.MaxLabeledTasks = func() int { return 1 }
+	matcher := newTaskMatcher(cfg, nil, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
+
+	info := t.newTaskInfo()
+	info.LabelSelector = "gpu=true"
+	held := newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil)
+	t.NoError(matcher.MustOffer(context.Background(), held))
+
+	var polledTask *InternalTask
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		polledTask, err = matcher.Poll(ctx)
+	})
+	info = t.newTaskInfo()
+	info.LabelSelector = "gpu=true"
+	task := newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil)
+	// the labeled tasks are full, the task is dispatched to any poller
+	t.NoError(matcher.MustOffer(context.Background(), task))
+	wait()
+	t.NoError(err)
+	t.Equal(task, polledTask)
+	t.Nil(polledTask.labelSelector())
+}
+
+func (t *MatcherTestSuite) TestOfferIsolatedSkipsOtherGroups() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.enableIsolationGroups(time.Minute)
+
+	info := t.newTaskInfo()
+	info.IsolationGroup = "zone1"
+	info.CreatedTime = time.Now()
+	task := newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil)
+	offerCtx, offerCancel := context.WithCancel(context.Background())
+	defer offerCancel()
+	type offerResult struct {
+		matched bool
+		err     error
+	}
+	resultC := make(chan offerResult, 1)
+	go func() {
+		matched, err := t.matcher.OfferIsolated(offerCtx, task)
+		resultC <- offerResult{matched, err}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	ctx = context.WithValue(ctx, isolationGroupKey, "zone2")
+	_, err := t.matcher.Poll(ctx)
+	cancel()
+	t.Equal(ErrNoTasks, err)
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	ctx = context.WithValue(ctx, isolationGroupKey, "zone1")
+	polledTask, err := t.matcher.Poll(ctx)
+	cancel()
+	t.NoError(err)
+	t.Equal(task, polledTask)
+	t.Equal("zone1", polledTask.isolationGroup())
+	t.Equal(offerResult{matched: true}, <-resultC)
+}
+
+func (t *MatcherTestSuite) TestOfferIsolatedFallback() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.enableIsolationGroups(50 * time.Millisecond)
+
+	info := t.newTaskInfo()
+	info.IsolationGroup = "zone1"
+	info.CreatedTime = time.Now()
+	task := newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil)
+	// no poller of zone1 took the task before the fallback delay
+	matched, err := t.matcher.OfferIsolated(context.Background(), task)
+	t.NoError(err)
+	t.False(matched)
+
+	// a task past the fallback delay is not offered to the pollers of its group at all
+	matched, err = t.matcher.OfferIsolated(context.Background(), task)
+	t.NoError(err)
+	t.False(matched)
+
+	task.isolationGroupExpired = true
+	offerCtx, offerCancel := context.WithTimeout(context.Background(), time.Second)
+	defer offerCancel()
+	errC := make(chan error, 1)
+	go func() {
+		errC <- t.matcher.MustOffer(offerCtx, task)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	ctx = context.WithValue(ctx, isolationGroupKey, "zone2")
+	polledTask, err := t.matcher.Poll(ctx)
+	cancel()
+	t.NoError(err)
+	t.Equal(task, polledTask)
+	t.Empty(polledTask.isolationGroup())
+	t.NoError(<-errC)
+}
+
+func (t *MatcherTestSuite) TestIsolatedInactiveGroupNoWait() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.matcher.enableIsolationGroups = func() bool { return true }
+	t.matcher.isolationGroupFallbackDelay = func() time.Duration { return time.Minute }
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ctx)
+		if err == nil {
+			task.finish(nil)
+		}
+	})
+
+	// no poller of zone1 was seen, the task is matched with any poller
+	info := t.newTaskInfo()
+	info.IsolationGroup = "zone1"
+	info.CreatedTime = time.Now()
+	task := newInternalTask(info, nil, types.TaskSourceHistory, "", true, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.True(syncMatch)
+}
+
 func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	pollSigC := make(chan struct{})
 
@@ -427,6 +638,14 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 	t.True(task.isStarted())
 }
 
+// enableIsolationGroups turns on isolation groups on the matcher and marks zone1 as active
+func (t *MatcherTestSuite) enableIsolationGroups(fallbackDelay time.Duration) {
+	t.matcher.enableIsolationGroups = func() bool { return true }
+	t.matcher.isolationGroupFallbackDelay = func() time.Duration { return fallbackDelay }
+	_, unregister := t.matcher.isolationGroups.registerPoller("zone1")
+	unregister()
+}
+
 func (t *MatcherTestSuite) newDomainCache() cache.DomainCache {
 	domainName := "test-domain"
 	dc := cache.NewMockDomainCache(t.controller)
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/cluster"
@@ -40,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
@@ -52,8 +54,11 @@ import (
 // TODO: Switch implementation from lock/channel based to a partitioned agent
 // to simplify code and reduce possibility of synchronization errors.
 type (
-	pollerIDCtxKey string
-	identityCtxKey string
+	pollerIDCtxKey       string
+	identityCtxKey       string
+	pollerLabelsCtxKey   string
+	pollerCapacityCtxKey string
+	isolationGroupCtxKey string
 
 	queryResult struct {
 		workerResponse *types.MatchingRespondQueryTaskCompletedRequest
@@ -84,9 +89,16 @@ type (
 		domainCache          cache.DomainCache
 		versionChecker       client.VersionChecker
 		membershipResolver   membership.Resolver
+		membershipUpdateCh   chan *membership.ChangedEvent
+		shutdownCh           chan struct{}
+		stopped              int32
 	}
 )
 
+const (
+	matchingEngineMembershipUpdateListenerName = "MatchingEngine"
+)
+
 var (
 	// EmptyPollForDecisionTaskResponse is the response when there are no decision tasks to hand out
 	emptyPollForDecisionTaskResponse = &types.MatchingPollForDecisionTaskResponse{}
@@ -99,8 +111,11 @@ var (
 	ErrNoTasks    = errors.New("no tasks")
 	errPumpClosed = errors.New("task list pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey       pollerIDCtxKey       = "pollerID"
+	identityKey       identityCtxKey       = "identity"
+	pollerLabelsKey   pollerLabelsCtxKey   = "pollerLabels"
+	pollerCapacityKey pollerCapacityCtxKey = "pollerCapacity"
+	isolationGroupKey isolationGroupCtxKey = "isolationGroup"
 )
 
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
@@ -115,6 +130,7 @@ func NewEngine(taskManager persistence.TaskManager,
 	metricsClient metrics.Client,
 	domainCache cache.DomainCache,
 	resolver membership.Resolver,
+	dynamicConfig dynamicconfig.Client,
 ) Engine {
 	return &matchingEngineImpl{
 		taskManager:          taskManager,
@@ -130,18 +146,88 @@ func NewEngine(taskManager persistence.TaskManager,
 		domainCache:          domainCache,
 		versionChecker:       client.NewVersionChecker(),
 		membershipResolver:   resolver,
+		membershipUpdateCh:   make(chan *membership.ChangedEvent, 10),
+		shutdownCh:           make(chan struct{}),
 	}
 }
 
 func (e *matchingEngineImpl) Start() {
-	// As task lists are initialized lazily nothing is done on startup at this point.
+	// As task lists are initialized lazily we only need to watch for ownership changes
+	// of the loaded task lists at this point.
+	if e.membershipResolver == nil {
+		return
+	}
+	err := e.membershipResolver.Subscribe(service.Matching, matchingEngineMembershipUpdateListenerName, e.membershipUpdateCh)
+	if err != nil {
+		e.logger.Error("Failed to subscribe to membership updates", tag.Error(err))
+		return
+	}
+	go e.membershipUpdatePump()
 }
 
 func (e *matchingEngineImpl) Stop() {
-	// Executes Stop() on each task list outside of lock
+	if !atomic.CompareAndSwapInt32(&e.stopped, 0, 1) {
+		return
+	}
+	if e.membershipResolver != nil {
+		if err := e.membershipResolver.Unsubscribe(service.Matching, matchingEngineMembershipUpdateListenerName); err != nil {
+			e.logger.Error("Failed to unsubscribe from membership updates", tag.Error(err))
+		}
+		close(e.shutdownCh)
+	}
+	// Hands off each task list outside of lock, by now the other hosts discovered that
+	// this host left the ring so waiting pollers are forwarded to the new owners
+	var wg sync.WaitGroup
 	for _, l := range e.getTaskLists(math.MaxInt32) {
-		l.Stop()
+		wg.Add(1)
+		go func(l taskListManager) {
+			defer wg.Done()
+			l.Handoff()
+		}(l)
+	}
+	wg.Wait()
+}
+
+func (e *matchingEngineImpl) membershipUpdatePump() {
+	for {
+		select {
+		case <-e.membershipUpdateCh:
+			e.handoffTaskLists()
+		case <-e.shutdownCh:
+			return
+		}
+	}
+}
+
+// handoffTaskLists hands off the loaded task lists that are now owned by another host
+func (e *matchingEngineImpl) handoffTaskLists() {
+	var handoff []taskListManager
+	e.taskListsLock.RLock()
+	for id, tlMgr := range e.taskLists {
+		if e.isOwnedByOtherHost(id.name) {
+			handoff = append(handoff, tlMgr)
+		}
+	}
+	e.taskListsLock.RUnlock()
+	for _, tlMgr := range handoff {
+		go tlMgr.Handoff()
+	}
+}
+
+// isOwnedByOtherHost returns whether the membership ring assigns the task list to another host
+func (e *matchingEngineImpl) isOwnedByOtherHost(taskListName string) bool {
+	if e.membershipResolver == nil {
+		return false
+	}
+	self, err := e.membershipResolver.WhoAmI()
+	if err != nil {
+		return false
 	}
+	owner, err := e.membershipResolver.Lookup(service.Matching, taskListName)
+	if err != nil {
+		return false
+	}
+	return owner.Identity() != self.Identity()
 }
 
 func (e *matchingEngineImpl) getTaskLists(maxCount int) (lists []taskListManager) {
@@ -297,6 +383,8 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleID:             request.GetScheduleID(),
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               request.GetPriority(),
+		IsolationGroup:         isolationGroupOf(request.GetIsolationGroup(), taskListKind),
 	}
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
 		execution:     request.Execution,
@@ -345,6 +433,9 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleID:             request.GetScheduleID(),
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               request.GetPriority(),
+		LabelSelector:          request.GetLabelSelector(),
+		IsolationGroup:         request.GetIsolationGroup(),
 	}
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
 		execution:                request.Execution,
@@ -384,12 +475,18 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, pollerLabelsKey, req.GetPollerLabels())
+		pollerCtx = context.WithValue(pollerCtx, pollerCapacityKey, req.GetPollerCapacity())
+		pollerCtx = context.WithValue(pollerCtx, isolationGroupKey, isolationGroupOf(req.GetIsolationGroup(), taskListKind))
 		task, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
 				return emptyPollForDecisionTaskResponse, nil
 			}
+			if err == errTaskListHandedOff {
+				return e.forwardDecisionTaskPollOnHandoff(hCtx, req)
+			}
 			return nil, err
 		}
 
@@ -493,6 +590,9 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, pollerLabelsKey, req.GetPollerLabels())
+		pollerCtx = context.WithValue(pollerCtx, pollerCapacityKey, req.GetPollerCapacity())
+		pollerCtx = context.WithValue(pollerCtx, isolationGroupKey, req.GetIsolationGroup())
 		taskListKind := request.TaskList.Kind
 		task, err := e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
 		if err != nil {
@@ -500,6 +600,9 @@ pollLoop:
 			if err == ErrNoTasks || err == errPumpClosed {
 				return emptyPollForActivityTaskResponse, nil
 			}
+			if err == errTaskListHandedOff {
+				return e.forwardActivityTaskPollOnHandoff(hCtx, req)
+			}
 			return nil, err
 		}
 
@@ -707,6 +810,108 @@ func (e *matchingEngineImpl) DescribeTaskList(
 	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
 }
 
+func (e *matchingEngineImpl) DescribeTaskListConfig(
+	hCtx *handlerContext,
+	request *types.MatchingDescribeTaskListConfigRequest,
+) (*types.DescribeTaskListConfigResponse, error) {
+	descRequest := request.GetDescRequest()
+	tlMgr, err := e.getTaskListManagerByType(request.GetDomainUUID(), descRequest.GetTaskList(), descRequest.GetTaskListType())
+	if err != nil {
+		return nil, err
+	}
+	return &types.DescribeTaskListConfigResponse{Config: tlMgr.DescribeConfig()}, nil
+}
+
+func (e *matchingEngineImpl) UpdateTaskListConfig(
+	hCtx *handlerContext,
+	request *types.MatchingUpdateTaskListConfigRequest,
+) (*types.UpdateTaskListConfigResponse, error) {
+	updateRequest := request.GetUpdateRequest()
+	tlMgr, err := e.getTaskListManagerByType(request.GetDomainUUID(), updateRequest.GetTaskList(), updateRequest.GetTaskListType())
+	if err != nil {
+		return nil, err
+	}
+	config, err := tlMgr.UpdateConfig(updateRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &types.UpdateTaskListConfigResponse{Config: config}, nil
+}
+
+func (e *matchingEngineImpl) RequeueDeadLetterTasks(
+	hCtx *handlerContext,
+	request *types.MatchingRequeueDeadLetterTasksRequest,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	requeueRequest := request.GetRequeueRequest()
+	tlMgr, err := e.getTaskListManagerByType(request.GetDomainUUID(), requeueRequest.GetTaskList(), requeueRequest.GetTaskListType())
+	if err != nil {
+		return nil, err
+	}
+	return tlMgr.RequeueDeadLetterTasks(requeueRequest.GetReason(), requeueRequest.GetTaskID(), int(requeueRequest.GetMaxTasks()))
+}
+
+func (e *matchingEngineImpl) MigrateTaskList(
+	hCtx *handlerContext,
+	request *types.MatchingMigrateTaskListRequest,
+) (*types.MigrateTaskListResponse, error) {
+	migrateRequest := request.GetMigrateRequest()
+	tlMgr, err := e.getTaskListManagerByType(request.GetDomainUUID(), migrateRequest.GetTaskList(), migrateRequest.GetTaskListType())
+	if err != nil {
+		return nil, err
+	}
+	return tlMgr.MigrateTasks(hCtx.Context, migrateRequest.GetTargetTaskList(), int(migrateRequest.GetMaxTasks()))
+}
+
+func (e *matchingEngineImpl) DeleteTaskList(
+	hCtx *handlerContext,
+	request *types.MatchingDeleteTaskListRequest,
+) error {
+	deleteRequest := request.GetDeleteRequest()
+	tlMgr, err := e.getTaskListManagerByType(request.GetDomainUUID(), deleteRequest.GetTaskList(), deleteRequest.GetTaskListType())
+	if err != nil {
+		return err
+	}
+	return tlMgr.Delete()
+}
+
+func (e *matchingEngineImpl) ListStickyTaskLists(
+	hCtx *handlerContext,
+	request *types.MatchingListStickyTaskListsRequest,
+) (*types.MatchingListStickyTaskListsResponse, error) {
+	var taskListMgrs []taskListManager
+	e.taskListsLock.RLock()
+	for tl, tlm := range e.taskLists {
+		if tl.domainID == request.GetDomainUUID() && tlm.GetTaskListKind() == types.TaskListKindSticky {
+			taskListMgrs = append(taskListMgrs, tlm)
+		}
+	}
+	e.taskListsLock.RUnlock()
+
+	taskLists := make([]*types.MatchingStickyTaskList, 0, len(taskListMgrs))
+	for _, tlm := range taskListMgrs {
+		taskLists = append(taskLists, tlm.DescribeStickyTaskList())
+	}
+	return &types.MatchingListStickyTaskListsResponse{TaskLists: taskLists}, nil
+}
+
+// getTaskListManagerByType returns the manager of the task list partition, loading it
+// if this host does not own it yet
+func (e *matchingEngineImpl) getTaskListManagerByType(
+	domainID string,
+	taskList *types.TaskList,
+	taskListType *types.TaskListType,
+) (taskListManager, error) {
+	persistenceType := persistence.TaskListTypeDecision
+	if taskListType != nil && *taskListType == types.TaskListTypeActivity {
+		persistenceType = persistence.TaskListTypeActivity
+	}
+	taskListID, err := newTaskListID(domainID, taskList.GetName(), persistenceType)
+	if err != nil {
+		return nil, err
+	}
+	return e.getTaskListManager(taskListID, taskList.GetKind().Ptr())
+}
+
 func (e *matchingEngineImpl) ListTaskListPartitions(
 	hCtx *handlerContext,
 	request *types.MatchingListTaskListPartitionsRequest,
@@ -815,6 +1020,30 @@ func (e *matchingEngineImpl) getTask(
 	return tlMgr.GetTask(ctx, maxDispatchPerSecond)
 }
 
+// forwardDecisionTaskPollOnHandoff forwards a poll released by a task list handoff to the new owner
+func (e *matchingEngineImpl) forwardDecisionTaskPollOnHandoff(
+	hCtx *handlerContext,
+	req *types.MatchingPollForDecisionTaskRequest,
+) (*types.MatchingPollForDecisionTaskResponse, error) {
+	if !e.isOwnedByOtherHost(req.PollRequest.GetTaskList().GetName()) {
+		return emptyPollForDecisionTaskResponse, nil
+	}
+	hCtx.scope.IncCounter(metrics.HandoffPollsForwardedPerTaskListCounter)
+	return e.matchingClient.PollForDecisionTask(hCtx.Context, req)
+}
+
+// forwardActivityTaskPollOnHandoff forwards a poll released by a task list handoff to the new owner
+func (e *matchingEngineImpl) forwardActivityTaskPollOnHandoff(
+	hCtx *handlerContext,
+	req *types.MatchingPollForActivityTaskRequest,
+) (*types.PollForActivityTaskResponse, error) {
+	if !e.isOwnedByOtherHost(req.PollRequest.GetTaskList().GetName()) {
+		return emptyPollForActivityTaskResponse, nil
+	}
+	hCtx.scope.IncCounter(metrics.HandoffPollsForwardedPerTaskListCounter)
+	return e.matchingClient.PollForActivityTask(hCtx.Context, req)
+}
+
 func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
 	e.taskListsLock.Lock()
 	tlMgr, ok := e.taskLists[*id]
@@ -1027,3 +1256,12 @@ func isMatchingRetryableError(err error) bool {
 	}
 	return true
 }
+
+// isolationGroupOf returns the isolation group of a task or a poller of the task list. Sticky
+// task lists are bound to a single worker, so isolation groups do not apply to them
+func isolationGroupOf(group string, taskListKind *types.TaskListKind) string {
+	if taskListKind != nil && *taskListKind == types.TaskListKindSticky {
+		return ""
+	}
+	return group
+}
@@ -25,6 +25,7 @@ import "github.com/uber/cadence/common/types"
 type (
 	// Engine exposes interfaces for clients to poll for activity and decision tasks.
 	Engine interface {
+		Start()
 		Stop()
 		AddDecisionTask(hCtx *handlerContext, request *types.AddDecisionTaskRequest) (syncMatch bool, err error)
 		AddActivityTask(hCtx *handlerContext, request *types.AddActivityTaskRequest) (syncMatch bool, err error)
@@ -36,5 +37,11 @@ type (
 		DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(hCtx *handlerContext, request *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		DescribeTaskListConfig(hCtx *handlerContext, request *types.MatchingDescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error)
+		UpdateTaskListConfig(hCtx *handlerContext, request *types.MatchingUpdateTaskListConfigRequest) (*types.UpdateTaskListConfigResponse, error)
+		RequeueDeadLetterTasks(hCtx *handlerContext, request *types.MatchingRequeueDeadLetterTasksRequest) (*types.RequeueDeadLetterTasksResponse, error)
+		MigrateTaskList(hCtx *handlerContext, request *types.MatchingMigrateTaskListRequest) (*types.MigrateTaskListResponse, error)
+		DeleteTaskList(hCtx *handlerContext, request *types.MatchingDeleteTaskListRequest) error
+		ListStickyTaskLists(hCtx *handlerContext, request *types.MatchingListStickyTaskListsRequest) (*types.MatchingListStickyTaskListsResponse, error)
 	}
 )
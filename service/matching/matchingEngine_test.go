@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -1701,6 +1702,8 @@ type testTaskListManager struct {
 	ackLevel        int64
 	createTaskCount int
 	tasks           *treemap.Map
+	config          *persistence.TaskListConfig
+	workerIdentity  string
 }
 
 func Int64Comparator(a, b interface{}) int {
@@ -1721,6 +1724,15 @@ func newTestTaskListManager() *testTaskListManager {
 }
 
 func newTestTaskListID(domainID string, name string, taskType int) *taskListID {
+	if strings.HasPrefix(name, common.DeadLetterTaskListPrefix) {
+		// dead-letter task lists are never loaded by matching, so their names do not follow
+		// the task list naming rules
+		return &taskListID{
+			qualifiedTaskListName: qualifiedTaskListName{name: name, baseName: name},
+			domainID:              domainID,
+			taskType:              taskType,
+		}
+	}
 	result, err := newTaskListID(domainID, name, taskType)
 	if err != nil {
 		panic(fmt.Sprintf("newTaskListID failed with error %v", err))
@@ -1740,13 +1752,34 @@ func (m *testTaskManager) LeaseTaskList(
 	m.logger.Debug(fmt.Sprintf("LeaseTaskList rangeID=%v", tlm.rangeID))
 
 	return &persistence.LeaseTaskListResponse{
+		TaskListInfo: &persistence.TaskListInfo{
+			AckLevel:       tlm.ackLevel,
+			DomainID:       request.DomainID,
+			Name:           request.TaskList,
+			TaskType:       request.TaskType,
+			RangeID:        tlm.rangeID,
+			Kind:           request.TaskListKind,
+			Config:         tlm.config,
+			WorkerIdentity: tlm.workerIdentity,
+		},
+	}, nil
+}
+
+func (m *testTaskManager) GetTaskList(
+	_ context.Context,
+	request *persistence.GetTaskListRequest,
+) (*persistence.GetTaskListResponse, error) {
+	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+	return &persistence.GetTaskListResponse{
 		TaskListInfo: &persistence.TaskListInfo{
 			AckLevel: tlm.ackLevel,
 			DomainID: request.DomainID,
 			Name:     request.TaskList,
 			TaskType: request.TaskType,
 			RangeID:  tlm.rangeID,
-			Kind:     request.TaskListKind,
+			Config:   tlm.config,
 		},
 	}, nil
 }
@@ -1769,6 +1802,8 @@ func (m *testTaskManager) UpdateTaskList(
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.config = tli.Config
+	tlm.workerIdentity = tli.WorkerIdentity
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -0,0 +1,286 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	partitionAutoscalerDescribeTimeout = 5 * time.Second
+	// maxRecordedScalingDecisions is the number of recent scaling decisions returned by DescribeTaskList
+	maxRecordedScalingDecisions = 10
+)
+
+type (
+	// partitionConfigUpdater persists the partition counts of a task list on its root partition.
+	// Both counts are written in a single conditional update of the task list record, so only the
+	// owner of the root partition can change them and concurrent updates are never lost.
+	partitionConfigUpdater interface {
+		UpdatePartitions(numReadPartitions int, numWritePartitions int) error
+	}
+
+	// partitionScalingDecision records a change made by the partition autoscaler
+	partitionScalingDecision struct {
+		Timestamp          time.Time
+		NumReadPartitions  int
+		NumWritePartitions int
+		Reason             string
+	}
+
+	// partitionAutoscaler runs on the root partition of a task list. Load balancers pick
+	// partitions uniformly at random, so the root partition observes an even share of the
+	// load and is used as a sample for the whole task list.
+	//
+	// Scaling up increases read and write partitions together. Scaling down only reduces
+	// write partitions first, read partitions are reduced once the partitions which no
+	// longer receive new tasks have drained their backlog.
+	partitionAutoscaler struct {
+		taskListID     *taskListID
+		config         *taskListConfig
+		updater        partitionConfigUpdater
+		matchingClient matching.Client
+		backlogCount   func() int64
+		metricScope    func() metrics.Scope
+		logger         log.Logger
+
+		addCount      int64
+		dispatchCount int64
+
+		sync.Mutex
+		lastSampleTime time.Time
+		lastScaleTime  time.Time
+		decisions      []partitionScalingDecision
+
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+		stopped    int32
+	}
+)
+
+func newPartitionAutoscaler(
+	tlMgr *taskListManagerImpl,
+	updater partitionConfigUpdater,
+) *partitionAutoscaler {
+	now := time.Now()
+	return &partitionAutoscaler{
+		taskListID:     tlMgr.taskListID,
+		config:         tlMgr.config,
+		updater:        updater,
+		matchingClient: tlMgr.engine.matchingClient,
+		backlogCount:   tlMgr.taskAckManager.GetBacklogCount,
+		metricScope:    tlMgr.metricScope,
+		logger:         tlMgr.logger,
+		lastSampleTime: now,
+		lastScaleTime:  now,
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+func (a *partitionAutoscaler) Start() {
+	a.shutdownWG.Add(1)
+	go a.run()
+}
+
+func (a *partitionAutoscaler) Stop() {
+	if !atomic.CompareAndSwapInt32(&a.stopped, 0, 1) {
+		return
+	}
+	close(a.shutdownCh)
+	a.shutdownWG.Wait()
+}
+
+// RecordAdd records a task added to the task list by a client, tasks forwarded
+// from child partitions are already accounted for by the child partition
+func (a *partitionAutoscaler) RecordAdd() {
+	atomic.AddInt64(&a.addCount, 1)
+}
+
+// RecordDispatch records a task dispatched to a poller
+func (a *partitionAutoscaler) RecordDispatch() {
+	atomic.AddInt64(&a.dispatchCount, 1)
+}
+
+// LastDecision returns the last scaling decision, or nil if the partitions have not been changed
+func (a *partitionAutoscaler) LastDecision() *partitionScalingDecision {
+	a.Lock()
+	defer a.Unlock()
+	if len(a.decisions) == 0 {
+		return nil
+	}
+	decision := a.decisions[len(a.decisions)-1]
+	return &decision
+}
+
+// Decisions returns the most recent scaling decisions, oldest first
+func (a *partitionAutoscaler) Decisions() []partitionScalingDecision {
+	a.Lock()
+	defer a.Unlock()
+	return append([]partitionScalingDecision(nil), a.decisions...)
+}
+
+func (a *partitionAutoscaler) run() {
+	defer a.shutdownWG.Done()
+
+	timer := time.NewTimer(a.config.PartitionAutoscalerInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-a.shutdownCh:
+			return
+		case <-timer.C:
+			if a.config.EnablePartitionAutoscaler() {
+				a.evaluate(time.Now())
+			} else {
+				a.resetSample(time.Now())
+			}
+			timer.Reset(a.config.PartitionAutoscalerInterval())
+		}
+	}
+}
+
+func (a *partitionAutoscaler) resetSample(now time.Time) {
+	a.Lock()
+	defer a.Unlock()
+	atomic.StoreInt64(&a.addCount, 0)
+	atomic.StoreInt64(&a.dispatchCount, 0)
+	a.lastSampleTime = now
+}
+
+func (a *partitionAutoscaler) evaluate(now time.Time) {
+	a.Lock()
+	defer a.Unlock()
+
+	elapsed := now.Sub(a.lastSampleTime).Seconds()
+	adds := atomic.SwapInt64(&a.addCount, 0)
+	dispatches := atomic.SwapInt64(&a.dispatchCount, 0)
+	a.lastSampleTime = now
+	if elapsed <= 0 {
+		return
+	}
+
+	numRead := a.config.NumReadPartitions()
+	numWrite := a.config.NumWritePartitions()
+	scope := a.metricScope().Tagged(getTaskListTypeTag(a.taskListID.taskType))
+	scope.UpdateGauge(metrics.TaskListReadPartitionsGauge, float64(numRead))
+	scope.UpdateGauge(metrics.TaskListWritePartitionsGauge, float64(numWrite))
+
+	addRate := float64(adds) / elapsed * float64(numWrite)
+	dispatchRate := float64(dispatches) / elapsed * float64(numRead)
+	backlog := a.backlogCount()
+
+	desired := int(math.Ceil(math.Max(addRate, dispatchRate) / float64(a.config.PartitionAutoscalerTargetRPSPerPartition())))
+	if threshold := a.config.PartitionAutoscalerBacklogThreshold(); threshold > 0 && backlog > int64(threshold) {
+		desired = common.MaxInt(desired, numWrite+1)
+	}
+	desired = common.MaxInt(1, common.MinInt(desired, a.config.PartitionAutoscalerMaxPartitions()))
+
+	decision := partitionScalingDecision{
+		Timestamp:          now,
+		NumReadPartitions:  numRead,
+		NumWritePartitions: numWrite,
+	}
+	switch {
+	case desired > numWrite:
+		// read partitions must never be less than write partitions,
+		// otherwise tasks written to the new partitions are not picked up by pollers
+		decision.NumReadPartitions = common.MaxInt(numRead, desired)
+		decision.NumWritePartitions = desired
+		decision.Reason = fmt.Sprintf(
+			"scaled up: add rate %.1f/s, dispatch rate %.1f/s, backlog %v",
+			addRate, dispatchRate, backlog,
+		)
+	case desired < numWrite && now.Sub(a.lastScaleTime) >= a.config.PartitionAutoscalerDownscaleCooldown():
+		decision.NumWritePartitions = desired
+		decision.Reason = fmt.Sprintf(
+			"scaled down: add rate %.1f/s, dispatch rate %.1f/s, draining partitions %v to %v",
+			addRate, dispatchRate, desired, numRead-1,
+		)
+	case numRead > numWrite && a.partitionsDrained(numWrite, numRead):
+		decision.NumReadPartitions = numWrite
+		decision.Reason = fmt.Sprintf("drained partitions %v to %v", numWrite, numRead-1)
+	default:
+		return
+	}
+
+	if err := a.updater.UpdatePartitions(decision.NumReadPartitions, decision.NumWritePartitions); err != nil {
+		scope.IncCounter(metrics.TaskListPartitionScaleFailuresCounter)
+		a.logger.Error("Failed to update task list partitions", tag.Error(err))
+		return
+	}
+
+	a.lastScaleTime = now
+	a.decisions = append(a.decisions, decision)
+	if len(a.decisions) > maxRecordedScalingDecisions {
+		a.decisions = a.decisions[len(a.decisions)-maxRecordedScalingDecisions:]
+	}
+	scope.UpdateGauge(metrics.TaskListReadPartitionsGauge, float64(decision.NumReadPartitions))
+	scope.UpdateGauge(metrics.TaskListWritePartitionsGauge, float64(decision.NumWritePartitions))
+	a.logger.Info(fmt.Sprintf(
+		"Task list partitions updated, read partitions: %v -> %v, write partitions: %v -> %v, %v",
+		numRead, decision.NumReadPartitions, numWrite, decision.NumWritePartitions, decision.Reason,
+	))
+}
+
+// partitionsDrained returns true if partitions in [from, to) have no backlog
+func (a *partitionAutoscaler) partitionsDrained(
+	from int,
+	to int,
+) bool {
+	taskListType := types.TaskListTypeDecision
+	if a.taskListID.taskType == persistence.TaskListTypeActivity {
+		taskListType = types.TaskListTypeActivity
+	}
+
+	for partition := from; partition < to; partition++ {
+		ctx, cancel := context.WithTimeout(context.Background(), partitionAutoscalerDescribeTimeout)
+		resp, err := a.matchingClient.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+			DomainUUID: a.taskListID.domainID,
+			DescRequest: &types.DescribeTaskListRequest{
+				TaskList:              &types.TaskList{Name: a.taskListID.mkName(partition)},
+				TaskListType:          &taskListType,
+				IncludeTaskListStatus: true,
+			},
+		})
+		cancel()
+		if err != nil {
+			a.logger.Warn("Failed to describe task list partition", tag.Error(err))
+			return false
+		}
+		if resp.GetTaskListStatus().GetBacklogCountHint() > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	partitionAutoscalerSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+		client     *matching.MockClient
+		updater    *testPartitionUpdater
+
+		numReadPartitions  int
+		numWritePartitions int
+		backlog            int64
+		startTime          time.Time
+		autoscaler         *partitionAutoscaler
+	}
+
+	testPartitionUpdater struct {
+		updates []testPartitionUpdate
+	}
+
+	testPartitionUpdate struct {
+		numReadPartitions  int
+		numWritePartitions int
+	}
+)
+
+func TestPartitionAutoscalerSuite(t *testing.T) {
+	suite.Run(t, new(partitionAutoscalerSuite))
+}
+
+func (s *partitionAutoscalerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.client = matching.NewMockClient(s.controller)
+	s.updater = &testPartitionUpdater{}
+	s.numReadPartitions = 1
+	s.numWritePartitions = 1
+	s.backlog = 0
+	s.startTime = time.Now()
+
+	config := &taskListConfig{
+		NumReadPartitions:  func() int { return s.numReadPartitions },
+		NumWritePartitions: func() int { return s.numWritePartitions },
+		partitionAutoscalerConfig: partitionAutoscalerConfig{
+			EnablePartitionAutoscaler:                func() bool { return true },
+			PartitionAutoscalerInterval:              func() time.Duration { return time.Minute },
+			PartitionAutoscalerTargetRPSPerPartition: func() int { return 10 },
+			PartitionAutoscalerBacklogThreshold:      func() int { return 100 },
+			PartitionAutoscalerMaxPartitions:         func() int { return 4 },
+			PartitionAutoscalerDownscaleCooldown:     func() time.Duration { return 5 * time.Minute },
+		},
+	}
+	s.autoscaler = &partitionAutoscaler{
+		taskListID:     newTestTaskListID(uuid.New(), "tl0", persistence.TaskListTypeActivity),
+		config:         config,
+		updater:        s.updater,
+		matchingClient: s.client,
+		backlogCount:   func() int64 { return s.backlog },
+		metricScope:    func() metrics.Scope { return metrics.NoopScope(metrics.Matching) },
+		logger:         loggerimpl.NewNopLogger(),
+		lastSampleTime: s.startTime,
+		lastScaleTime:  s.startTime,
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+func (s *partitionAutoscalerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *partitionAutoscalerSuite) TestEvaluate_ScaleUpOnAddRate() {
+	s.recordAdds(25 * 60)
+	s.autoscaler.evaluate(s.startTime.Add(time.Minute))
+
+	s.Equal([]testPartitionUpdate{{numReadPartitions: 3, numWritePartitions: 3}}, s.updater.updates)
+	decision := s.autoscaler.LastDecision()
+	s.NotNil(decision)
+	s.Equal(3, decision.NumReadPartitions)
+	s.Equal(3, decision.NumWritePartitions)
+	s.Equal([]partitionScalingDecision{*decision}, s.autoscaler.Decisions())
+}
+
+func (s *partitionAutoscalerSuite) TestEvaluate_ScaleUpOnBacklog() {
+	s.numReadPartitions = 2
+	s.numWritePartitions = 2
+	s.backlog = 1000
+	s.autoscaler.evaluate(s.startTime.Add(time.Minute))
+
+	s.Equal([]testPartitionUpdate{{numReadPartitions: 3, numWritePartitions: 3}}, s.updater.updates)
+}
+
+func (s *partitionAutoscalerSuite) TestEvaluate_ScaleUpCappedByMaxPartitions() {
+	s.recordAdds(1000 * 60)
+	s.autoscaler.evaluate(s.startTime.Add(time.Minute))
+
+	s.Equal([]testPartitionUpdate{{numReadPartitions: 4, numWritePartitions: 4}}, s.updater.updates)
+}
+
+func (s *partitionAutoscalerSuite) TestEvaluate_ScaleDown_Cooldown() {
+	s.numReadPartitions = 4
+	s.numWritePartitions = 4
+	s.autoscaler.evaluate(s.startTime.Add(time.Minute))
+
+	s.Empty(s.updater.updates)
+	s.Nil(s.autoscaler.LastDecision())
+}
+
+func (s *partitionAutoscalerSuite) TestEvaluate_ScaleDown_KeepReadPartitions() {
+	s.numReadPartitions = 4
+	s.numWritePartitions = 4
+	s.autoscaler.evaluate(s.startTime.Add(10 * time.Minute))
+
+	s.Equal([]testPartitionUpdate{{numReadPartitions: 4, numWritePartitions: 1}}, s.updater.updates)
+	decision := s.autoscaler.LastDecision()
+	s.NotNil(decision)
+	s.Equal(4, decision.NumReadPartitions)
+	s.Equal(1, decision.NumWritePartitions)
+}
+
+func (s *partitionAutoscalerSuite) TestEvaluate_DrainReadPartitions() {
+	s.numReadPartitions = 3
+	s.numWritePartitions = 1
+	for _, partition := range []int{1, 2} {
+		name := s.autoscaler.taskListID.mkName(partition)
+		s.client.EXPECT().DescribeTaskList(gomock.Any(), &types.MatchingDescribeTaskListRequest{
+			DomainUUID: s.autoscaler.taskListID.domainID,
+			DescRequest: &types.DescribeTaskListRequest{
+				TaskList:              &types.TaskList{Name: name},
+				TaskListType:          types.TaskListTypeActivity.Ptr(),
+				IncludeTaskListStatus: true,
+			},
+		}).Return(&types.DescribeTaskListResponse{
+			TaskListStatus: &types.TaskListStatus{BacklogCountHint: 0},
+		}, nil).Times(1)
+	}
+	s.autoscaler.evaluate(s.startTime.Add(time.Minute))
+
+	s.Equal([]testPartitionUpdate{{numReadPartitions: 1, numWritePartitions: 1}}, s.updater.updates)
+}
+
+func (s *partitionAutoscalerSuite) TestEvaluate_PartitionNotDrained() {
+	s.numReadPartitions = 3
+	s.numWritePartitions = 1
+	s.client.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(&types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{BacklogCountHint: 10},
+	}, nil).Times(1)
+	s.autoscaler.evaluate(s.startTime.Add(time.Minute))
+
+	s.Empty(s.updater.updates)
+}
+
+func (s *partitionAutoscalerSuite) recordAdds(count int) {
+	for i := 0; i != count; i++ {
+		s.autoscaler.RecordAdd()
+	}
+}
+
+func (u *testPartitionUpdater) UpdatePartitions(numReadPartitions int, numWritePartitions int) error {
+	u.updates = append(u.updates, testPartitionUpdate{
+		numReadPartitions:  numReadPartitions,
+		numWritePartitions: numWritePartitions,
+	})
+	return nil
+}
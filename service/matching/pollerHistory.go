@@ -39,6 +39,9 @@ type (
 
 	pollerInfo struct {
 		ratePerSecond float64
+		// labels and capacity advertised by the poller
+		labels   map[string]string
+		capacity int32
 	}
 )
 
@@ -68,12 +71,21 @@ func newPollerHistory(historyUpdatedFunc HistoryUpdatedFunc) *pollerHistory {
 	}
 }
 
-func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64) {
+func (pollers *pollerHistory) updatePollerInfo(
+	id pollerIdentity,
+	ratePerSecond *float64,
+	labels map[string]string,
+	capacity int32,
+) {
 	rps := _defaultTaskDispatchRPS
 	if ratePerSecond != nil {
 		rps = *ratePerSecond
 	}
-	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps})
+	pollers.history.Put(id, &pollerInfo{
+		ratePerSecond: rps,
+		labels:        labels,
+		capacity:      capacity,
+	})
 	if pollers.onHistoryUpdatedFunc != nil {
 		pollers.onHistoryUpdatedFunc()
 	}
@@ -99,3 +111,16 @@ func (pollers *pollerHistory) getAllPollerInfo() []*types.PollerInfo {
 
 	return result
 }
+
+// hasMatchingPoller returns whether any poller seen in the last few minutes
+// advertised labels satisfying the label selector
+func (pollers *pollerHistory) hasMatchingPoller(selector map[string]string) bool {
+	ite := pollers.history.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		if matchLabels(selector, ite.Next().Value().(*pollerInfo).labels) {
+			return true
+		}
+	}
+	return false
+}
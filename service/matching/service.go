@@ -34,10 +34,11 @@ import (
 type Service struct {
 	resource.Resource
 
-	status  int32
-	handler Handler
-	stopC   chan struct{}
-	config  *Config
+	status        int32
+	handler       Handler
+	stopC         chan struct{}
+	config        *Config
+	dynamicConfig dynamicconfig.Client
 }
 
 // NewService builds a new cadence-matching service
@@ -68,10 +69,11 @@ func NewService(
 	}
 
 	return &Service{
-		Resource: serviceResource,
-		status:   common.DaemonStatusInitialized,
-		config:   serviceConfig,
-		stopC:    make(chan struct{}),
+		Resource:      serviceResource,
+		status:        common.DaemonStatusInitialized,
+		config:        serviceConfig,
+		dynamicConfig: params.DynamicConfig,
+		stopC:         make(chan struct{}),
 	}, nil
 }
 
@@ -94,6 +96,7 @@ func (s *Service) Start() {
 		s.GetMetricsClient(),
 		s.GetDomainCache(),
 		s.GetMembershipResolver(),
+		s.dynamicConfig,
 	)
 
 	s.handler = NewHandler(engine, s.config, s.GetDomainCache(), s.GetMetricsClient(), s.GetLogger(), s.GetThrottledLogger())
@@ -55,6 +55,11 @@ type (
 		responseC                chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint         int64
 		activityTaskDispatchInfo *types.ActivityTaskDispatchInfo
+		// set once the task waited for a poller of its isolation group for longer
+		// than the fallback delay, from then on it can be dispatched to any poller
+		isolationGroupExpired bool
+		// set when the task didn't fit in the labeled tasks, the task is then dispatched to any poller
+		labelSelectorDropped bool
 	}
 )
 
@@ -129,6 +134,24 @@ func (task *InternalTask) workflowExecution() *types.WorkflowExecution {
 	return &types.WorkflowExecution{}
 }
 
+// labelSelector returns the labels a poller must advertise to receive this task,
+// nil if the task can be dispatched to any poller
+func (task *InternalTask) labelSelector() map[string]string {
+	if task.event == nil || task.event.TaskInfo == nil || task.labelSelectorDropped {
+		return nil
+	}
+	return parseLabelSelector(task.event.LabelSelector)
+}
+
+// isolationGroup returns the isolation group of the pollers this task is preferentially
+// dispatched to, an empty string if the task can be dispatched to any poller
+func (task *InternalTask) isolationGroup() string {
+	if task.event == nil || task.event.TaskInfo == nil || task.isolationGroupExpired {
+		return ""
+	}
+	return task.event.IsolationGroup
+}
+
 // pollForDecisionResponse returns the poll response for a decision task that is
 // already marked as started. This method should only be called when isStarted() is true
 func (task *InternalTask) pollForDecisionResponse() *types.MatchingPollForDecisionTaskResponse {
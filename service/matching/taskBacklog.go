@@ -0,0 +1,120 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// taskBacklog holds the tasks loaded from persistence when task priority is enabled. The
+	// getTasks pump keeps loading tasks while a task waits for a poller, and the offer of the
+	// waiting task is preempted as soon as a task of higher priority is loaded, so that pollers
+	// are always offered the highest priority task read so far rather than the head of a batch
+	taskBacklog struct {
+		sync.Mutex
+		queue *taskPriorityQueue
+		// closed and replaced every time a task is added or removed
+		changedC chan struct{}
+		// the task currently offered to pollers and the function to preempt its offer
+		offered *persistence.TaskInfo
+		preempt func()
+	}
+)
+
+func newTaskBacklog() *taskBacklog {
+	return &taskBacklog{
+		queue:    newTaskPriorityQueue(),
+		changedC: make(chan struct{}),
+	}
+}
+
+// push adds the task to the backlog unless the backlog already holds maxSize tasks, in which
+// case the returned channel is closed once a task is removed. Preempts the offered task if the
+// pushed task has a higher priority
+func (b *taskBacklog) push(task *persistence.TaskInfo, maxSize int) (bool, <-chan struct{}) {
+	b.Lock()
+	defer b.Unlock()
+	if b.queue.Len() >= maxSize {
+		return false, b.changedC
+	}
+	b.queue.Push(task)
+	b.notifyLocked()
+	if b.preempt != nil && task.Priority > b.offered.Priority {
+		b.preempt()
+		b.preempt = nil
+	}
+	return true, nil
+}
+
+// pushBack returns a task whose offer was preempted to the backlog, regardless of its size
+func (b *taskBacklog) pushBack(task *persistence.TaskInfo) {
+	b.Lock()
+	defer b.Unlock()
+	b.queue.Push(task)
+	b.notifyLocked()
+}
+
+// pop removes and returns the next task to dispatch along with whether it was selected by
+// the starvation protection. When the backlog is empty, the returned channel is closed the
+// next time a task is added
+func (b *taskBacklog) pop(starvationThreshold int) (*persistence.TaskInfo, bool, <-chan struct{}) {
+	b.Lock()
+	defer b.Unlock()
+	if b.queue.Len() == 0 {
+		return nil, false, b.changedC
+	}
+	task, starved := b.queue.Pop(starvationThreshold)
+	b.notifyLocked()
+	return task, starved, nil
+}
+
+// startOffer records the task being offered to pollers, preempt is called if a task
+// of higher priority is pushed before endOffer is called
+func (b *taskBacklog) startOffer(task *persistence.TaskInfo, preempt func()) {
+	b.Lock()
+	defer b.Unlock()
+	b.offered = task
+	b.preempt = preempt
+}
+
+// endOffer clears the offered task
+func (b *taskBacklog) endOffer() {
+	b.Lock()
+	defer b.Unlock()
+	b.offered = nil
+	b.preempt = nil
+}
+
+// hasHigherPriority returns whether the backlog holds a task with a priority higher than the given one
+func (b *taskBacklog) hasHigherPriority(priority int32) bool {
+	b.Lock()
+	defer b.Unlock()
+	head := b.queue.Peek()
+	return head != nil && head.Priority > priority
+}
+
+func (b *taskBacklog) notifyLocked() {
+	close(b.changedC)
+	b.changedC = make(chan struct{})
+}
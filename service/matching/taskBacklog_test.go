@@ -0,0 +1,114 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type taskBacklogSuite struct {
+	suite.Suite
+	backlog *taskBacklog
+}
+
+func TestTaskBacklogSuite(t *testing.T) {
+	suite.Run(t, new(taskBacklogSuite))
+}
+
+func (s *taskBacklogSuite) SetupTest() {
+	s.backlog = newTaskBacklog()
+}
+
+func (s *taskBacklogSuite) TestPush_Full() {
+	added, _ := s.backlog.push(&persistence.TaskInfo{TaskID: 1}, 1)
+	s.True(added)
+
+	added, changedC := s.backlog.push(&persistence.TaskInfo{TaskID: 2}, 1)
+	s.False(added)
+	s.assertOpen(changedC)
+
+	task, _, _ := s.backlog.pop(10)
+	s.Equal(int64(1), task.TaskID)
+	s.assertClosed(changedC)
+}
+
+func (s *taskBacklogSuite) TestPop_Empty() {
+	task, _, changedC := s.backlog.pop(10)
+	s.Nil(task)
+	s.assertOpen(changedC)
+
+	s.backlog.push(&persistence.TaskInfo{TaskID: 1}, 10)
+	s.assertClosed(changedC)
+}
+
+func (s *taskBacklogSuite) TestPush_PreemptsOffer() {
+	preempted := 0
+	s.backlog.startOffer(&persistence.TaskInfo{TaskID: 1, Priority: 1}, func() { preempted++ })
+
+	s.backlog.push(&persistence.TaskInfo{TaskID: 2, Priority: 1}, 10)
+	s.Equal(0, preempted)
+	s.backlog.push(&persistence.TaskInfo{TaskID: 3, Priority: 2}, 10)
+	s.Equal(1, preempted)
+	s.backlog.push(&persistence.TaskInfo{TaskID: 4, Priority: 3}, 10)
+	s.Equal(1, preempted)
+
+	s.backlog.endOffer()
+	s.backlog.push(&persistence.TaskInfo{TaskID: 5, Priority: 5}, 10)
+	s.Equal(1, preempted)
+}
+
+func (s *taskBacklogSuite) TestPushBack_IgnoresSize() {
+	s.backlog.push(&persistence.TaskInfo{TaskID: 2}, 1)
+	s.backlog.pushBack(&persistence.TaskInfo{TaskID: 1})
+
+	task, _, _ := s.backlog.pop(10)
+	s.Equal(int64(1), task.TaskID)
+	task, _, _ = s.backlog.pop(10)
+	s.Equal(int64(2), task.TaskID)
+}
+
+func (s *taskBacklogSuite) TestHasHigherPriority() {
+	s.False(s.backlog.hasHigherPriority(0))
+
+	s.backlog.push(&persistence.TaskInfo{TaskID: 1, Priority: 3}, 10)
+	s.True(s.backlog.hasHigherPriority(2))
+	s.False(s.backlog.hasHigherPriority(3))
+}
+
+func (s *taskBacklogSuite) assertOpen(c <-chan struct{}) {
+	select {
+	case <-c:
+		s.Fail("channel is closed")
+	default:
+	}
+}
+
+func (s *taskBacklogSuite) assertClosed(c <-chan struct{}) {
+	select {
+	case <-c:
+	default:
+		s.Fail("channel is open")
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	dispatchAttemptsCacheInitSize = 64
+	dispatchAttemptsCacheMaxSize  = 10000
+	dispatchAttemptsCacheTTL      = time.Hour
+)
+
+type (
+	// taskDeadLetter moves the backlog tasks of a task list that can no longer be dispatched
+	// to the dead-letter task list of the reason they were given up on, instead of dropping
+	// them, so that they can later be inspected, requeued or purged by operators
+	taskDeadLetter struct {
+		sync.Mutex
+		tlMgr  *taskListManagerImpl
+		queues map[string]*deadLetterQueue
+		// dispatchAttempts tracks the number of times a backlog task failed to be started.
+		// Failed tasks are written back to the backlog with a new task ID, so they are keyed
+		// by workflow execution and schedule ID instead
+		dispatchAttempts cache.Cache
+	}
+
+	// deadLetterQueue is the dead-letter task list of a single reason. It is leased by the
+	// owner of the task list the first time a task is dead-lettered for that reason
+	deadLetterQueue struct {
+		db          *taskListDB
+		taskIDBlock taskIDBlock
+	}
+
+	dispatchAttemptsKey struct {
+		workflowID string
+		runID      string
+		scheduleID int64
+	}
+)
+
+var deadLetteredTasksCounters = map[string]int{
+	common.DeadLetterReasonExpired:        metrics.ExpiredTasksDeadLetteredPerTaskListCounter,
+	common.DeadLetterReasonDispatchFailed: metrics.DispatchFailedTasksDeadLetteredPerTaskListCounter,
+}
+
+func newTaskDeadLetter(tlMgr *taskListManagerImpl) *taskDeadLetter {
+	return &taskDeadLetter{
+		tlMgr:  tlMgr,
+		queues: make(map[string]*deadLetterQueue),
+		dispatchAttempts: cache.New(&cache.Options{
+			InitialCapacity: dispatchAttemptsCacheInitSize,
+			TTL:             dispatchAttemptsCacheTTL,
+			Pin:             false,
+			MaxCount:        dispatchAttemptsCacheMaxSize,
+		}),
+	}
+}
+
+// isEnabled returns whether tasks of this task list are dead-lettered. Sticky task lists
+// are never dead-lettered as their tasks are expected to expire
+func (d *taskDeadLetter) isEnabled() bool {
+	return d.tlMgr.taskListKind != types.TaskListKindSticky && d.tlMgr.config.EnableTaskDeadLetter()
+}
+
+// recordDispatchFailure records a failed attempt to start the given backlog task and moves
+// it to the dead-letter task list once it reached the max number of dispatch attempts.
+// Returns true if the task was dead-lettered and must not be written back to the backlog
+func (d *taskDeadLetter) recordDispatchFailure(task *persistence.TaskInfo) bool {
+	maxAttempts := d.tlMgr.config.MaxTaskDispatchAttempts()
+	if maxAttempts <= 0 || !d.isEnabled() {
+		return false
+	}
+	key := newDispatchAttemptsKey(task)
+	attempts := 1
+	if value, ok := d.dispatchAttempts.Get(key).(int); ok {
+		attempts = value + 1
+	}
+	if attempts < maxAttempts {
+		d.dispatchAttempts.Put(key, attempts)
+		return false
+	}
+	if err := d.add(common.DeadLetterReasonDispatchFailed, []*persistence.TaskInfo{task}); err != nil {
+		// keep the task in the backlog, it will be dead-lettered on its next failure
+		d.dispatchAttempts.Put(key, attempts)
+		return false
+	}
+	d.dispatchAttempts.Delete(key)
+	return true
+}
+
+// resetDispatchAttempts forgets the failed attempts to start the given backlog task
+func (d *taskDeadLetter) resetDispatchAttempts(task *persistence.TaskInfo) {
+	if d.tlMgr.config.MaxTaskDispatchAttempts() <= 0 {
+		return
+	}
+	d.dispatchAttempts.Delete(newDispatchAttemptsKey(task))
+}
+
+// add writes the given tasks to the dead-letter task list of the given reason. The
+// dead-lettered tasks are deleted after the dead-letter retention of the task list
+func (d *taskDeadLetter) add(reason string, tasks []*persistence.TaskInfo) error {
+	err := d.write(reason, tasks)
+	if err != nil {
+		d.tlMgr.metricScope().IncCounter(metrics.DeadLetterFailuresPerTaskListCounter)
+		d.tlMgr.logger.Error("Failed to dead-letter tasks",
+			tag.Error(err),
+			tag.WorkflowTaskDeadLetterReason(reason),
+			tag.Counter(len(tasks)))
+		return err
+	}
+	d.tlMgr.metricScope().AddCounter(deadLetteredTasksCounters[reason], int64(len(tasks)))
+	return nil
+}
+
+func (d *taskDeadLetter) write(reason string, tasks []*persistence.TaskInfo) error {
+	d.Lock()
+	defer d.Unlock()
+
+	queue := d.getQueue(reason)
+	retention := int32(d.tlMgr.config.DeadLetterTaskRetention().Seconds())
+	var err error
+	// a condition failure means the dead-letter task list was leased by another host
+	// since our last write, in which case we take it back and try once more
+	for attempt := 0; attempt < 2; attempt++ {
+		var taskIDs []int64
+		taskIDs, err = d.allocTaskIDs(queue, len(tasks))
+		if err != nil {
+			return err
+		}
+		createTasks := make([]*persistence.CreateTaskInfo, 0, len(tasks))
+		for i, task := range tasks {
+			data := *task
+			data.TaskID = taskIDs[i]
+			data.ScheduleToStartTimeout = retention
+			data.Expiry = time.Time{}
+			createTasks = append(createTasks, &persistence.CreateTaskInfo{
+				TaskID:    taskIDs[i],
+				Execution: types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID},
+				Data:      &data,
+			})
+		}
+		_, err = queue.db.CreateTasks(createTasks)
+		if _, ok := err.(*persistence.ConditionFailedError); !ok {
+			return err
+		}
+		queue.taskIDBlock = taskIDBlock{}
+	}
+	return err
+}
+
+// requeue moves up to maxTasks dead-lettered tasks of the given reason back to the backlog of
+// the task list, or only the task with the given ID if it is not 0. Activity tasks dead-lettered
+// on expiry were already timed out by history, so they are left in the dead-letter task list and
+// counted as skipped instead, skipped tasks count towards maxTasks
+func (d *taskDeadLetter) requeue(reason string, taskID int64, maxTasks int) (int, int, error) {
+	d.Lock()
+	queue := d.getQueue(reason)
+	d.Unlock()
+
+	readLevel, maxReadLevel := int64(0), int64(math.MaxInt64)
+	if taskID > 0 {
+		readLevel, maxReadLevel = taskID-1, taskID
+	}
+	requeued, skipped := 0, 0
+	batchSize := d.tlMgr.config.GetTasksBatchSize()
+	for requeued+skipped < maxTasks {
+		resp, err := queue.db.GetTasks(readLevel, maxReadLevel, batchSize)
+		if err != nil {
+			return requeued, skipped, err
+		}
+		for _, task := range resp.Tasks {
+			readLevel = task.TaskID
+			if requeued+skipped >= maxTasks {
+				break
+			}
+			if reason == common.DeadLetterReasonExpired && d.tlMgr.taskListID.taskType == persistence.TaskListTypeActivity {
+				skipped++
+				continue
+			}
+			data := *task
+			// the schedule to start timeout of the task is not kept when it is dead-lettered
+			data.ScheduleToStartTimeout = 0
+			data.Expiry = time.Time{}
+			_, err := d.tlMgr.executeWithRetry(func() (interface{}, error) {
+				return d.tlMgr.taskWriter.appendTask(&types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}, &data)
+			})
+			if err != nil {
+				return requeued, skipped, err
+			}
+			if err := queue.db.CompleteTask(task.TaskID); err != nil {
+				return requeued, skipped, err
+			}
+			requeued++
+		}
+		if len(resp.Tasks) < batchSize {
+			break
+		}
+	}
+	if requeued > 0 {
+		d.tlMgr.taskReader.Signal()
+	}
+	return requeued, skipped, nil
+}
+
+// getQueue returns the dead-letter task list of the given reason, the caller must hold the lock
+func (d *taskDeadLetter) getQueue(reason string) *deadLetterQueue {
+	queue, ok := d.queues[reason]
+	if !ok {
+		queue = &deadLetterQueue{
+			db: newTaskListDB(
+				d.tlMgr.engine.taskManager,
+				d.tlMgr.taskListID.domainID,
+				common.DeadLetterTaskListName(d.tlMgr.taskListID.name, reason),
+				d.tlMgr.taskListID.taskType,
+				persistence.TaskListKindNormal,
+				d.tlMgr.logger,
+			),
+		}
+		d.queues[reason] = queue
+	}
+	return queue
+}
+
+func (d *taskDeadLetter) allocTaskIDs(queue *deadLetterQueue, count int) ([]int64, error) {
+	result := make([]int64, count)
+	for i := 0; i < count; i++ {
+		if queue.taskIDBlock.start == 0 || queue.taskIDBlock.start > queue.taskIDBlock.end {
+			state, err := queue.db.RenewLease()
+			if err != nil {
+				return nil, err
+			}
+			queue.taskIDBlock = d.tlMgr.rangeIDToTaskIDBlock(state.rangeID)
+		}
+		result[i] = queue.taskIDBlock.start
+		queue.taskIDBlock.start++
+	}
+	return result, nil
+}
+
+func newDispatchAttemptsKey(task *persistence.TaskInfo) dispatchAttemptsKey {
+	return dispatchAttemptsKey{
+		workflowID: task.WorkflowID,
+		runID:      task.RunID,
+		scheduleID: task.ScheduleID,
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func createTestDeadLetterTaskListManager(controller *gomock.Controller, maxDispatchAttempts int) *taskListManagerImpl {
+	tlm := createTestTaskListManager(controller)
+	tlm.config.EnableTaskDeadLetter = func() bool { return true }
+	tlm.config.MaxTaskDispatchAttempts = func() int { return maxDispatchAttempts }
+	return tlm
+}
+
+func deadLetterTaskCount(tlm *taskListManagerImpl, reason string) int {
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	return tm.getTaskCount(newTestTaskListID(
+		tlm.taskListID.domainID,
+		common.DeadLetterTaskListName(tlm.taskListID.name, reason),
+		tlm.taskListID.taskType,
+	))
+}
+
+func TestDeadLetterExpiredTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestDeadLetterTaskListManager(controller, 0)
+	now := time.Now()
+	tasks := []*persistence.TaskInfo{
+		{WorkflowID: "wid", RunID: "rid", TaskID: 1, ScheduleID: 5, Expiry: now.Add(-time.Minute)},
+		{WorkflowID: "wid", RunID: "rid", TaskID: 2, ScheduleID: 6, Expiry: now.Add(time.Minute)},
+		{WorkflowID: "wid", RunID: "rid", TaskID: 3, ScheduleID: 7},
+	}
+	tlm.taskReader.deadLetterExpiredTasks(tasks, now)
+	require.Equal(t, 1, deadLetterTaskCount(tlm, common.DeadLetterReasonExpired))
+
+	// the dead-letter task list was leased by another host in the meantime
+	_, err := tlm.engine.taskManager.LeaseTaskList(context.Background(), &persistence.LeaseTaskListRequest{
+		DomainID: tlm.taskListID.domainID,
+		TaskList: common.DeadLetterTaskListName(tlm.taskListID.name, common.DeadLetterReasonExpired),
+		TaskType: tlm.taskListID.taskType,
+	})
+	require.NoError(t, err)
+	tlm.taskReader.deadLetterExpiredTasks(tasks, now)
+	require.Equal(t, 2, deadLetterTaskCount(tlm, common.DeadLetterReasonExpired))
+
+	tlm.config.EnableTaskDeadLetter = func() bool { return false }
+	tlm.taskReader.deadLetterExpiredTasks(tasks, now)
+	require.Equal(t, 2, deadLetterTaskCount(tlm, common.DeadLetterReasonExpired))
+}
+
+func TestDeadLetterDispatchFailures(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestDeadLetterTaskListManager(controller, 3)
+	task := &persistence.TaskInfo{WorkflowID: "wid", RunID: "rid", TaskID: 1, ScheduleID: 5}
+	require.False(t, tlm.deadLetter.recordDispatchFailure(task))
+	require.False(t, tlm.deadLetter.recordDispatchFailure(task))
+
+	// a successful dispatch of the task resets its attempts
+	tlm.deadLetter.resetDispatchAttempts(task)
+	require.False(t, tlm.deadLetter.recordDispatchFailure(task))
+	require.False(t, tlm.deadLetter.recordDispatchFailure(task))
+
+	// failed tasks are written back with a new task ID
+	retried := *task
+	retried.TaskID = 10
+	require.True(t, tlm.deadLetter.recordDispatchFailure(&retried))
+	require.Equal(t, 1, deadLetterTaskCount(tlm, common.DeadLetterReasonDispatchFailed))
+	require.Equal(t, 0, deadLetterTaskCount(tlm, common.DeadLetterReasonExpired))
+
+	tlm.config.MaxTaskDispatchAttempts = func() int { return 0 }
+	for i := 0; i < 5; i++ {
+		require.False(t, tlm.deadLetter.recordDispatchFailure(task))
+	}
+}
+
+func TestRequeueDeadLetterTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestDeadLetterTaskListManager(controller, 0)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	var tasks []*persistence.TaskInfo
+	for i := int64(0); i < 3; i++ {
+		tasks = append(tasks, &persistence.TaskInfo{WorkflowID: "wid", RunID: "rid", TaskID: i + 1, ScheduleID: 5 + i})
+	}
+	require.NoError(t, tlm.deadLetter.add(common.DeadLetterReasonDispatchFailed, tasks))
+	require.NoError(t, tlm.deadLetter.add(common.DeadLetterReasonExpired, tasks[:2]))
+
+	resp, err := tlm.RequeueDeadLetterTasks(common.DeadLetterReasonDispatchFailed, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.Requeued)
+	require.Equal(t, int32(0), resp.Skipped)
+	require.Equal(t, 1, deadLetterTaskCount(tlm, common.DeadLetterReasonDispatchFailed))
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, 2, tm.getTaskCount(tlm.taskListID))
+
+	// expired activity tasks were already timed out by history and are left dead-lettered
+	resp, err = tlm.RequeueDeadLetterTasks(common.DeadLetterReasonExpired, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), resp.Requeued)
+	require.Equal(t, int32(2), resp.Skipped)
+	require.Equal(t, 2, deadLetterTaskCount(tlm, common.DeadLetterReasonExpired))
+
+	remaining, err := tm.GetTasks(context.Background(), &persistence.GetTasksRequest{
+		DomainID:     tlm.taskListID.domainID,
+		TaskList:     common.DeadLetterTaskListName(tlm.taskListID.name, common.DeadLetterReasonDispatchFailed),
+		TaskType:     tlm.taskListID.taskType,
+		MaxReadLevel: common.Int64Ptr(math.MaxInt64),
+	})
+	require.NoError(t, err)
+	require.Len(t, remaining.Tasks, 1)
+	resp, err = tlm.RequeueDeadLetterTasks(common.DeadLetterReasonDispatchFailed, remaining.Tasks[0].TaskID, 1)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.Requeued)
+	require.Equal(t, 0, deadLetterTaskCount(tlm, common.DeadLetterReasonDispatchFailed))
+	require.Equal(t, 3, tm.getTaskCount(tlm.taskListID))
+}
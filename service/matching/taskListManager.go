@@ -23,6 +23,7 @@ package matching
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -43,6 +44,8 @@ const (
 	// Time budget for empty task to propagate through the function stack and be returned to
 	// pollForActivityTask or pollForDecisionTask handler.
 	returnEmptyTaskTimeBudget time.Duration = time.Second
+	// Max amount of time to wait for outstanding task appends to be written when handing off a task list
+	handoffDrainTimeout = time.Second
 )
 
 var (
@@ -80,8 +83,25 @@ type (
 		GetAllPollerInfo() []*types.PollerInfo
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse
+		// DescribeConfig returns the server side limits of the task list, nil if none is configured
+		DescribeConfig() *types.TaskListConfig
+		// UpdateConfig persists the server side limits of the task list under its lease and
+		// applies them right away
+		UpdateConfig(update *types.UpdateTaskListConfigRequest) (*types.TaskListConfig, error)
+		// RequeueDeadLetterTasks moves dead-lettered tasks of the given reason back to the backlog
+		RequeueDeadLetterTasks(reason string, taskID int64, maxTasks int) (*types.RequeueDeadLetterTasksResponse, error)
+		// MigrateTasks moves backlog tasks which are not loaded yet to another task list
+		MigrateTasks(ctx context.Context, target string, maxTasks int) (*types.MigrateTaskListResponse, error)
+		// Delete stops the task list and deletes it with all its tasks
+		Delete() error
+		// DescribeStickyTaskList returns the worker owning a sticky task list and the workflows pinned to it
+		DescribeStickyTaskList() *types.MatchingStickyTaskList
 		String() string
 		GetTaskListKind() types.TaskListKind
+		// Handoff gracefully stops the task list once it is owned by another host. Outstanding
+		// task appends are written and the ack level is persisted before the task list is
+		// stopped, and waiting pollers are released with errTaskListHandedOff
+		Handoff()
 	}
 
 	// Single task list in memory state
@@ -94,6 +114,7 @@ type (
 		taskWriter       *taskWriter
 		taskReader       *taskReader // reads tasks from db and async matches it with poller
 		taskGC           *taskGC
+		deadLetter       *taskDeadLetter      // moves tasks that can no longer be dispatched out of the backlog
 		taskAckManager   messaging.AckManager // tracks ackLevel for delivered messages
 		matcher          *TaskMatcher         // for matching a task producer with a poller
 		domainCache      cache.DomainCache
@@ -114,18 +135,39 @@ type (
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
 		stopped    int32
+		handedOff  int32
+
+		// partitionAutoscaler is only set on root partitions of normal task lists
+		partitionAutoscaler *partitionAutoscaler
+		// rootConfig is the server side configuration of the root partition, only loaded by the
+		// child partitions of normal task lists as the configuration applies to the whole task list
+		rootConfig atomic.Value
+		// lastPollTime and lastIdentityUpdateTime are only tracked on sticky task lists
+		lastPollTime           int64
+		lastIdentityUpdateTime int64
 	}
 )
 
 const (
 	// maxSyncMatchWaitTime is the max amount of time that we are willing to wait for a sync match to happen
 	maxSyncMatchWaitTime = 200 * time.Millisecond
+	// stickyIdentityUpdateInterval is the min interval between two writes of the identity of the
+	// worker owning a sticky task list, so that a failing write is not retried by every poll
+	stickyIdentityUpdateInterval = 10 * time.Second
+	// rootConfigRefreshInterval is the interval at which child partitions reload the server side
+	// configuration of the task list from the root partition
+	rootConfigRefreshInterval = time.Minute
 )
 
 var _ taskListManager = (*taskListManagerImpl)(nil)
 
 var errRemoteSyncMatchFailed = &types.RemoteSyncMatchedError{Message: "remote sync match failed"}
 
+var errTaskListBacklogFull = &types.LimitExceededError{Message: "task list backlog is full"}
+
+// errTaskListHandedOff is returned to the pollers waiting on a task list handed off to another host
+var errTaskListHandedOff = errors.New("task list handed off to another host")
+
 func newTaskListManager(
 	e *matchingEngineImpl,
 	taskList *taskListID,
@@ -161,6 +203,10 @@ func newTaskListManager(
 		outstandingPollsMap: make(map[string]context.CancelFunc),
 	}
 
+	tlMgr.rootConfig.Store((*persistence.TaskListConfig)(nil))
+	if *taskListKind != types.TaskListKindSticky {
+		overridePartitionsWithPersistedConfig(taskListConfig, tlMgr.limits)
+	}
 	tlMgr.domainNameValue.Store("")
 	if tlMgr.metricScope() == nil { // domain name lookup failed
 		// metric scope to use when domainName lookup fails
@@ -181,11 +227,15 @@ func newTaskListManager(
 	})
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.taskReader = newTaskReader(tlMgr)
+	tlMgr.deadLetter = newTaskDeadLetter(tlMgr)
 	var fwdr *Forwarder
 	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
 		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient)
 	}
 	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.metricScope)
+	if taskList.IsRoot() && *taskListKind != types.TaskListKindSticky {
+		tlMgr.partitionAutoscaler = newPartitionAutoscaler(tlMgr, tlMgr)
+	}
 	tlMgr.startWG.Add(1)
 	return tlMgr, nil
 }
@@ -203,8 +253,19 @@ func (c *taskListManagerImpl) Start() error {
 	}
 
 	c.taskAckManager.SetAckLevel(state.ackLevel)
+	if c.loadsRootConfig() {
+		c.refreshRootConfig()
+		go c.refreshRootConfigPump()
+	}
+	if config := c.limits(); config != nil && config.MaxDispatchPerSecond > 0 {
+		rps := config.MaxDispatchPerSecond
+		c.matcher.UpdateRatelimit(&rps)
+	}
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.taskReader.Start()
+	if c.partitionAutoscaler != nil {
+		c.partitionAutoscaler.Start()
+	}
 
 	return nil
 }
@@ -215,12 +276,42 @@ func (c *taskListManagerImpl) Stop() {
 		return
 	}
 	close(c.shutdownCh)
+	if c.partitionAutoscaler != nil {
+		c.partitionAutoscaler.Stop()
+	}
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
 	c.engine.removeTaskListManager(c.taskListID)
 	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
 }
 
+// Handoff gracefully stops the task list once it is owned by another host
+func (c *taskListManagerImpl) Handoff() {
+	if !atomic.CompareAndSwapInt32(&c.handedOff, 0, 1) {
+		return
+	}
+	c.startWG.Wait()
+	if atomic.LoadInt32(&c.stopped) == 1 {
+		return
+	}
+	c.logger.Info("Handing off task list to its new owner")
+	c.metricScope().IncCounter(metrics.HandoffPerTaskListCounter)
+
+	// new tasks are rejected from now on and are retried by history on the new owner
+	c.taskWriter.Drain(handoffDrainTimeout)
+	// persist the ack level so that the new owner does not dispatch delivered tasks again
+	if err := c.taskReader.persistAckLevel(); err != nil {
+		c.logger.Warn("Failed to persist ack level on task list handoff", tag.Error(err))
+	}
+	// release the waiting pollers so that they are forwarded to the new owner
+	c.outstandingPollsLock.Lock()
+	for _, cancel := range c.outstandingPollsMap {
+		cancel()
+	}
+	c.outstandingPollsLock.Unlock()
+	c.Stop()
+}
+
 // AddTask adds a task to the task list. This method will first attempt a synchronous
 // match with a poller. When there are no pollers or if rate limit is exceeded, task will
 // be written to database and later asynchronously matched with a poller
@@ -245,6 +336,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			if isForwarded {
 				return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 			}
+			if err := c.checkBacklogLimit(); err != nil {
+				return nil, err
+			}
 
 			r, err := c.taskWriter.appendTask(params.execution, params.taskInfo)
 			return r, err
@@ -264,6 +358,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			// child partition will persist the task when sync match fails
 			return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 		}
+		if err := c.checkBacklogLimit(); err != nil {
+			return nil, err
+		}
 
 		return c.taskWriter.appendTask(params.execution, params.taskInfo)
 	})
@@ -276,6 +373,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 		)
 	} else {
 		c.taskReader.Signal()
+		if params.forwardedFrom == "" && c.partitionAutoscaler != nil {
+			c.partitionAutoscaler.RecordAdd()
+		}
 	}
 
 	return syncMatch, err
@@ -285,6 +385,10 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 // up the task or if rate limit is exceeded, this method will return error. Task
 // *will not* be persisted to db
 func (c *taskListManagerImpl) DispatchTask(ctx context.Context, task *InternalTask) error {
+	if selector := task.labelSelector(); len(selector) > 0 && !c.pollerHistory.hasMatchingPoller(selector) {
+		// the task waits for a poller with compatible labels until it is moved to the end of the backlog
+		c.metricScope().IncCounter(metrics.TasksWithoutMatchingPollerPerTaskListCounter)
+	}
 	return c.matcher.MustOffer(ctx, task)
 }
 
@@ -308,12 +412,21 @@ func (c *taskListManagerImpl) GetTask(
 	ctx context.Context,
 	maxDispatchPerSecond *float64,
 ) (*InternalTask, error) {
+	if atomic.LoadInt32(&c.handedOff) == 1 {
+		return nil, errTaskListHandedOff
+	}
 	task, err := c.getTask(ctx, maxDispatchPerSecond)
 	if err != nil {
+		if err == ErrNoTasks && atomic.LoadInt32(&c.handedOff) == 1 && ctx.Err() == nil {
+			return nil, errTaskListHandedOff
+		}
 		return nil, err
 	}
 	task.domainName = c.domainName()
 	task.backlogCountHint = c.taskAckManager.GetBacklogCount()
+	if c.partitionAutoscaler != nil {
+		c.partitionAutoscaler.RecordDispatch()
+	}
 	return task, nil
 }
 
@@ -341,7 +454,12 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
-		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+		labels, _ := ctx.Value(pollerLabelsKey).(map[string]string)
+		capacity, _ := ctx.Value(pollerCapacityKey).(int32)
+		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond, labels, capacity)
+		if c.taskListKind == types.TaskListKindSticky {
+			c.recordStickyPoll(identity)
+		}
 	}
 
 	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
@@ -353,7 +471,12 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// poller, which lives inside the client side worker. There is
 	// one rateLimiter for this entire task list and as we get polls,
 	// we update the ratelimiter rps if it has changed from the last
-	// value. Last poller wins if different pollers provide different values
+	// value. Last poller wins if different pollers provide different values,
+	// unless the rate is configured on the task list itself
+	if config := c.limits(); config != nil && config.MaxDispatchPerSecond > 0 {
+		rps := config.MaxDispatchPerSecond
+		maxDispatchPerSecond = &rps
+	}
 	c.matcher.UpdateRatelimit(maxDispatchPerSecond)
 
 	if _, err := domainEntry.IsActiveIn(c.engine.clusterMetadata.GetCurrentClusterName()); err != nil {
@@ -399,10 +522,119 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			EndID:   taskIDBlock.end,
 		},
 	}
+	response.PartitionConfig = &types.TaskListPartitionConfig{
+		NumReadPartitions:  int32(c.config.NumReadPartitions()),
+		NumWritePartitions: int32(c.config.NumWritePartitions()),
+	}
+	if c.partitionAutoscaler != nil {
+		for _, decision := range c.partitionAutoscaler.Decisions() {
+			response.PartitionConfig.ScalingDecisions = append(response.PartitionConfig.ScalingDecisions, &types.TaskListPartitionScalingDecision{
+				Timestamp:          decision.Timestamp.UnixNano(),
+				NumReadPartitions:  int32(decision.NumReadPartitions),
+				NumWritePartitions: int32(decision.NumWritePartitions),
+				Reason:             decision.Reason,
+			})
+		}
+	}
 
 	return response
 }
 
+// DescribeConfig returns the server side limits of the task list, nil if none is configured
+func (c *taskListManagerImpl) DescribeConfig() *types.TaskListConfig {
+	return toTaskListConfig(c.limits())
+}
+
+// UpdateConfig persists the server side limits of the task list under its lease and applies
+// them right away. Limits which are not set in the update are left as is. Only the root partition
+// can be configured, child partitions load the limits within rootConfigRefreshInterval
+func (c *taskListManagerImpl) UpdateConfig(update *types.UpdateTaskListConfigRequest) (*types.TaskListConfig, error) {
+	if c.taskListKind == types.TaskListKindSticky {
+		return nil, &types.BadRequestError{Message: "Limits cannot be configured on a sticky task list."}
+	}
+	if !c.taskListID.IsRoot() {
+		return nil, &types.BadRequestError{Message: "Limits can only be configured on the root partition of a task list."}
+	}
+	result, err := c.executeWithRetry(func() (interface{}, error) {
+		return c.db.UpdateConfig(func(config *persistence.TaskListConfig) *persistence.TaskListConfig {
+			return applyTaskListConfigUpdate(config, update)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	config := result.(*persistence.TaskListConfig)
+	if config != nil && config.MaxDispatchPerSecond > 0 {
+		rps := config.MaxDispatchPerSecond
+		c.matcher.UpdateRatelimit(&rps)
+	}
+	// the reader may be waiting on the outstanding tasks limit which was raised
+	c.taskReader.Signal()
+	return toTaskListConfig(config), nil
+}
+
+// UpdatePartitions persists the partition counts chosen by the partition autoscaler on the root
+// partition. The counts take precedence over the dynamic config once they are set.
+func (c *taskListManagerImpl) UpdatePartitions(numReadPartitions int, numWritePartitions int) error {
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return c.db.UpdateConfig(func(config *persistence.TaskListConfig) *persistence.TaskListConfig {
+			result := persistence.TaskListConfig{}
+			if config != nil {
+				result = *config
+			}
+			result.NumReadPartitions = int32(numReadPartitions)
+			result.NumWritePartitions = int32(numWritePartitions)
+			return &result
+		})
+	})
+	return err
+}
+
+// RequeueDeadLetterTasks moves up to maxTasks dead-lettered tasks of the given reason back to
+// the backlog, or only the task with the given ID if it is not 0
+func (c *taskListManagerImpl) RequeueDeadLetterTasks(reason string, taskID int64, maxTasks int) (*types.RequeueDeadLetterTasksResponse, error) {
+	if c.taskListKind == types.TaskListKindSticky {
+		return nil, &types.BadRequestError{Message: "Sticky task lists have no dead-lettered tasks."}
+	}
+	requeued, skipped, err := c.deadLetter.requeue(reason, taskID, maxTasks)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RequeueDeadLetterTasksResponse{Requeued: int32(requeued), Skipped: int32(skipped)}, nil
+}
+
+// MigrateTasks moves up to maxTasks backlog tasks to the root partition of the target task list
+func (c *taskListManagerImpl) MigrateTasks(ctx context.Context, target string, maxTasks int) (*types.MigrateTaskListResponse, error) {
+	if c.taskListKind == types.TaskListKindSticky {
+		return nil, &types.BadRequestError{Message: "Sticky task lists cannot be migrated."}
+	}
+	if target == c.taskListID.name {
+		return nil, &types.BadRequestError{Message: "Target task list must be different from the source task list."}
+	}
+	return c.migrateTasks(ctx, target, maxTasks)
+}
+
+// Delete stops the task list and deletes it with all its tasks
+func (c *taskListManagerImpl) Delete() error {
+	if c.taskListKind == types.TaskListKindSticky {
+		return &types.BadRequestError{Message: "Sticky task lists cannot be deleted."}
+	}
+	return c.deleteTaskList()
+}
+
+// DescribeStickyTaskList returns the identity of the worker owning the sticky task list, as persisted
+// with the task list, and the last time it polled the task list since it was loaded
+func (c *taskListManagerImpl) DescribeStickyTaskList() *types.MatchingStickyTaskList {
+	result := &types.MatchingStickyTaskList{
+		Name:     c.taskListID.name,
+		Identity: c.db.WorkerIdentity(),
+	}
+	if lastPollTime := atomic.LoadInt64(&c.lastPollTime); lastPollTime > 0 {
+		result.LastPollTimestamp = &lastPollTime
+	}
+	return result
+}
+
 func (c *taskListManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskListID.taskType == persistence.TaskListTypeActivity {
@@ -416,6 +648,13 @@ func (c *taskListManagerImpl) String() string {
 	fmt.Fprintf(buf, "TaskIDBlock=%+v\n", c.rangeIDToTaskIDBlock(rangeID))
 	fmt.Fprintf(buf, "AckLevel=%v\n", c.taskAckManager.GetAckLevel())
 	fmt.Fprintf(buf, "MaxReadLevel=%v\n", c.taskAckManager.GetReadLevel())
+	fmt.Fprintf(buf, "NumReadPartitions=%v\n", c.config.NumReadPartitions())
+	fmt.Fprintf(buf, "NumWritePartitions=%v\n", c.config.NumWritePartitions())
+	if c.partitionAutoscaler != nil {
+		if decision := c.partitionAutoscaler.LastDecision(); decision != nil {
+			fmt.Fprintf(buf, "LastPartitionScalingDecision=%+v\n", *decision)
+		}
+	}
 
 	return buf.String()
 }
@@ -429,7 +668,9 @@ func (c *taskListManagerImpl) GetTaskListKind() types.TaskListKind {
 //   - task is deleted from the database when err is nil
 //   - new task is created and current task is deleted when err is not nil
 func (c *taskListManagerImpl) completeTask(task *persistence.TaskInfo, err error) {
-	if err != nil {
+	if err == nil {
+		c.deadLetter.resetDispatchAttempts(task)
+	} else if !c.deadLetter.recordDispatchFailure(task) {
 		// failed to start the task.
 		// We cannot just remove it from persistence because then it will be lost.
 		// We handle this by writing the task back to persistence with a higher taskID.
@@ -437,28 +678,37 @@ func (c *taskListManagerImpl) completeTask(task *persistence.TaskInfo, err error
 		// again the underlying reason for failing to start will be resolved.
 		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
 		// re-written to persistence frequently.
-		_, err = c.executeWithRetry(func() (interface{}, error) {
-			wf := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
-			return c.taskWriter.appendTask(wf, task)
-		})
-
-		if err != nil {
-			// OK, we also failed to write to persistence.
-			// This should only happen in very extreme cases where persistence is completely down.
-			// We still can't lose the old task so we just unload the entire task list
-			c.logger.Error("Failed to complete task",
-				tag.Error(err),
-				tag.WorkflowTaskListName(c.taskListID.name),
-				tag.WorkflowTaskListType(c.taskListID.taskType))
-			c.Stop()
+		if !c.writeTaskBack(task) {
 			return
 		}
-		c.taskReader.Signal()
 	}
 	ackLevel := c.taskAckManager.AckItem(task.TaskID)
 	c.taskGC.Run(ackLevel)
 }
 
+// writeTaskBack writes the task back to persistence with a higher taskID, the task list
+// is unloaded if that fails so that the task is not lost. Returns whether the write succeeded
+func (c *taskListManagerImpl) writeTaskBack(task *persistence.TaskInfo) bool {
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		wf := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
+		return c.taskWriter.appendTask(wf, task)
+	})
+
+	if err != nil {
+		// OK, we also failed to write to persistence.
+		// This should only happen in very extreme cases where persistence is completely down.
+		// We still can't lose the old task so we just unload the entire task list
+		c.logger.Error("Failed to complete task",
+			tag.Error(err),
+			tag.WorkflowTaskListName(c.taskListID.name),
+			tag.WorkflowTaskListType(c.taskListID.taskType))
+		c.Stop()
+		return false
+	}
+	c.taskReader.Signal()
+	return true
+}
+
 func (c *taskListManagerImpl) renewLeaseWithRetry() (taskListState, error) {
 	var newState taskListState
 	op := func() (err error) {
@@ -479,6 +729,31 @@ func (c *taskListManagerImpl) renewLeaseWithRetry() (taskListState, error) {
 	return newState, nil
 }
 
+// recordStickyPoll records the poll of the worker owning the sticky task list. Its identity is
+// persisted so that the task list is still attributed to the worker once it stopped polling. The
+// write happens in the background and at most once per stickyIdentityUpdateInterval so that it
+// never delays the poll
+func (c *taskListManagerImpl) recordStickyPoll(identity string) {
+	now := time.Now().UnixNano()
+	atomic.StoreInt64(&c.lastPollTime, now)
+	if c.db.WorkerIdentity() == identity {
+		return
+	}
+	lastUpdateTime := atomic.LoadInt64(&c.lastIdentityUpdateTime)
+	if now-lastUpdateTime < int64(stickyIdentityUpdateInterval) ||
+		!atomic.CompareAndSwapInt64(&c.lastIdentityUpdateTime, lastUpdateTime, now) {
+		return
+	}
+	go func() {
+		_, err := c.executeWithRetry(func() (interface{}, error) {
+			return nil, c.db.UpdateWorkerIdentity(identity)
+		})
+		if err != nil {
+			c.logger.Warn("Failed to persist the worker identity of sticky task list", tag.Error(err))
+		}
+	}()
+}
+
 func (c *taskListManagerImpl) rangeIDToTaskIDBlock(rangeID int64) taskIDBlock {
 	return taskIDBlock{
 		start: (rangeID-1)*c.config.RangeSize + 1,
@@ -499,6 +774,92 @@ func (c *taskListManagerImpl) allocTaskIDBlock(prevBlockEnd int64) (taskIDBlock,
 	return c.rangeIDToTaskIDBlock(state.rangeID), nil
 }
 
+// checkBacklogLimit returns an error when the backlog reached the max size configured
+// on the task list and the task list is configured to reject new tasks in that case
+func (c *taskListManagerImpl) checkBacklogLimit() error {
+	config := c.limits()
+	if config == nil || config.MaxBacklogSize <= 0 || c.approximateBacklogSize() < config.MaxBacklogSize {
+		return nil
+	}
+	c.metricScope().IncCounter(metrics.BacklogFullPerTaskListCounter)
+	if config.RejectWhenBacklogFull {
+		return errTaskListBacklogFull
+	}
+	return nil
+}
+
+// approximateBacklogSize returns the number of tasks loaded from the backlog and not yet
+// acked plus the number of tasks not read yet. Task IDs are not always contiguous, so the
+// latter is only an estimation
+func (c *taskListManagerImpl) approximateBacklogSize() int64 {
+	unread := c.taskWriter.GetMaxReadLevel() - c.taskAckManager.GetReadLevel()
+	if unread < 0 {
+		unread = 0
+	}
+	return c.taskAckManager.GetBacklogCount() + unread
+}
+
+// isOutstandingTasksLimitReached returns whether the number of tasks loaded from the
+// backlog and not yet delivered to a poller reached the limit configured on the task list
+func (c *taskListManagerImpl) isOutstandingTasksLimitReached() bool {
+	config := c.limits()
+	return config != nil && config.MaxOutstandingTasks > 0 &&
+		c.taskAckManager.GetBacklogCount() >= int64(config.MaxOutstandingTasks)
+}
+
+// limits returns the server side configuration applying to this partition. The configuration is
+// set on the root partition, child partitions apply the configuration they loaded from it
+func (c *taskListManagerImpl) limits() *persistence.TaskListConfig {
+	if c.loadsRootConfig() {
+		return c.rootConfig.Load().(*persistence.TaskListConfig)
+	}
+	return c.db.Config()
+}
+
+func (c *taskListManagerImpl) loadsRootConfig() bool {
+	return !c.taskListID.IsRoot() && c.taskListKind != types.TaskListKindSticky
+}
+
+func (c *taskListManagerImpl) refreshRootConfigPump() {
+	ticker := time.NewTicker(rootConfigRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-ticker.C:
+			c.refreshRootConfig()
+		}
+	}
+}
+
+// refreshRootConfig loads the server side configuration of the task list from the record of the
+// root partition and applies it, the last loaded configuration is kept if that fails
+func (c *taskListManagerImpl) refreshRootConfig() {
+	resp, err := c.engine.taskManager.GetTaskList(context.Background(), &persistence.GetTaskListRequest{
+		DomainID: c.taskListID.domainID,
+		TaskList: c.taskListID.GetRoot(),
+		TaskType: c.taskListID.taskType,
+	})
+	var config *persistence.TaskListConfig
+	switch err.(type) {
+	case nil:
+		config = resp.TaskListInfo.Config
+	case *types.EntityNotExistsError:
+		// the root partition was never loaded, so nothing is configured
+	default:
+		c.logger.Warn("Failed to load the configuration of the root partition", tag.Error(err))
+		return
+	}
+	c.rootConfig.Store(config)
+	if config != nil && config.MaxDispatchPerSecond > 0 {
+		rps := config.MaxDispatchPerSecond
+		c.matcher.UpdateRatelimit(&rps)
+	}
+	// the reader may be waiting on the outstanding tasks limit which was raised
+	c.taskReader.Signal()
+}
+
 // Retry operation on transient error. On rangeID update by another process calls c.Stop().
 func (c *taskListManagerImpl) executeWithRetry(
 	operation func() (interface{}, error),
@@ -532,6 +893,11 @@ func (c *taskListManagerImpl) executeWithRetry(
 }
 
 func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams) (bool, error) {
+	if params.activityTaskDispatchInfo == nil && params.forwardedFrom == "" &&
+		c.taskReader.backlog.hasHigherPriority(params.taskInfo.Priority) {
+		// let the pending backlog tasks of higher priority go first
+		return false, nil
+	}
 	task := newInternalTask(params.taskInfo, c.completeTask, params.source, params.forwardedFrom, true, params.activityTaskDispatchInfo)
 	childCtx := ctx
 	cancel := func() {}
@@ -638,3 +1004,66 @@ func getTaskListTypeTag(taskListType int) metrics.Tag {
 func createServiceBusyError(msg string) *types.ServiceBusyError {
 	return &types.ServiceBusyError{Message: msg}
 }
+
+// applyTaskListConfigUpdate returns the config with the update applied, nil if no limit is left
+func applyTaskListConfigUpdate(
+	config *persistence.TaskListConfig,
+	update *types.UpdateTaskListConfigRequest,
+) *persistence.TaskListConfig {
+	result := persistence.TaskListConfig{}
+	if config != nil {
+		result = *config
+	}
+	if update.MaxDispatchPerSecond != nil {
+		result.MaxDispatchPerSecond = *update.MaxDispatchPerSecond
+	}
+	if update.MaxBacklogSize != nil {
+		result.MaxBacklogSize = *update.MaxBacklogSize
+	}
+	if update.RejectWhenBacklogFull != nil {
+		result.RejectWhenBacklogFull = *update.RejectWhenBacklogFull
+	}
+	if update.MaxOutstandingTasks != nil {
+		result.MaxOutstandingTasks = *update.MaxOutstandingTasks
+	}
+	if result == (persistence.TaskListConfig{}) {
+		return nil
+	}
+	return &result
+}
+
+// overridePartitionsWithPersistedConfig makes the partition counts of a task list prefer the
+// counts persisted by the partition autoscaler on the root partition over the dynamic config
+func overridePartitionsWithPersistedConfig(
+	config *taskListConfig,
+	persistedConfig func() *persistence.TaskListConfig,
+) {
+	numReadPartitions := config.NumReadPartitions
+	numWritePartitions := config.NumWritePartitions
+	config.NumReadPartitions = func() int {
+		if persisted := persistedConfig(); persisted != nil && persisted.NumReadPartitions > 0 {
+			return int(persisted.NumReadPartitions)
+		}
+		return numReadPartitions()
+	}
+	config.NumWritePartitions = func() int {
+		if persisted := persistedConfig(); persisted != nil && persisted.NumWritePartitions > 0 {
+			return int(persisted.NumWritePartitions)
+		}
+		return numWritePartitions()
+	}
+}
+
+func toTaskListConfig(config *persistence.TaskListConfig) *types.TaskListConfig {
+	// the partition counts are reported through the partition config of the task list
+	if config == nil || (config.MaxDispatchPerSecond == 0 && config.MaxBacklogSize == 0 &&
+		!config.RejectWhenBacklogFull && config.MaxOutstandingTasks == 0) {
+		return nil
+	}
+	return &types.TaskListConfig{
+		MaxDispatchPerSecond:  config.MaxDispatchPerSecond,
+		MaxBacklogSize:        config.MaxBacklogSize,
+		RejectWhenBacklogFull: config.RejectWhenBacklogFull,
+		MaxOutstandingTasks:   config.MaxOutstandingTasks,
+	}
+}
@@ -32,12 +32,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -88,6 +91,39 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	wg.Wait()
 }
 
+func TestDeliverBacklogTasks_PreemptedByHigherPriority(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	added, _ := tlm.taskReader.backlog.push(&persistence.TaskInfo{TaskID: 1, Priority: 0}, 10)
+	require.True(t, added)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tlm.taskReader.dispatchBufferedTasks()
+	}()
+	time.Sleep(100 * time.Millisecond) // let the low priority task block waiting for a poller
+	added, _ = tlm.taskReader.backlog.push(&persistence.TaskInfo{TaskID: 2, Priority: 5}, 10)
+	require.True(t, added)
+	time.Sleep(100 * time.Millisecond) // let the offer of the low priority task be preempted
+
+	var taskIDs []int64
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		task, err := tlm.matcher.Poll(ctx)
+		cancel()
+		require.NoError(t, err)
+		taskIDs = append(taskIDs, task.event.TaskID)
+	}
+	assert.Equal(t, []int64{2, 1}, taskIDs)
+
+	tlm.taskReader.cancelFunc()
+	close(tlm.taskReader.dispatcherShutdownC)
+	wg.Wait()
+}
+
 func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -196,9 +232,12 @@ func TestDescribeTaskList(t *testing.T) {
 	descResp := tlm.DescribeTaskList(includeTaskStatus)
 	require.Equal(t, 0, len(descResp.GetPollers()))
 	require.Nil(t, descResp.GetTaskListStatus())
+	require.Nil(t, descResp.GetPartitionConfig())
 
 	includeTaskStatus = true
-	taskListStatus := tlm.DescribeTaskList(includeTaskStatus).GetTaskListStatus()
+	descResp = tlm.DescribeTaskList(includeTaskStatus)
+	require.Equal(t, &types.TaskListPartitionConfig{NumReadPartitions: 1, NumWritePartitions: 1}, descResp.GetPartitionConfig())
+	taskListStatus := descResp.GetTaskListStatus()
 	require.NotNil(t, taskListStatus)
 	require.Zero(t, taskListStatus.GetAckLevel())
 	require.Equal(t, taskCount, taskListStatus.GetReadLevel())
@@ -210,7 +249,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Equal(t, tlm.config.RangeSize, taskIDBlock.GetEndID())
 
 	// Add a poller and complete all tasks
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil, nil, 0)
 	for i := int64(0); i < taskCount; i++ {
 		tlm.taskAckManager.AckItem(startTaskID + i)
 	}
@@ -222,7 +261,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.True(t, descResp.Pollers[0].GetRatePerSecond() > (_defaultTaskDispatchRPS-1))
 
 	rps := 5.0
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps, nil, 0)
 	descResp = tlm.DescribeTaskList(includeTaskStatus)
 	require.Equal(t, 1, len(descResp.GetPollers()))
 	require.Equal(t, PollerIdentity, descResp.Pollers[0].GetIdentity())
@@ -256,7 +295,7 @@ func TestCheckIdleTaskList(t *testing.T) {
 
 	// Active poll-er
 	tlm = createTestTaskListManagerWithConfig(controller, cfg)
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil, nil, 0)
 	require.Equal(t, 1, len(tlm.GetAllPollerInfo()))
 	tlMgrStartWithoutNotifyEvent(tlm)
 	time.Sleep(20 * time.Millisecond)
@@ -331,3 +370,330 @@ func TestAddTaskStandby(t *testing.T) {
 	require.Error(t, err) // should not persist the task
 	require.False(t, syncMatch)
 }
+
+func TestTaskListConfigLimits(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.NoError(t, tlm.checkBacklogLimit())
+	require.False(t, tlm.isOutstandingTasksLimitReached())
+
+	tlm.db.config = &persistence.TaskListConfig{
+		MaxBacklogSize:      2,
+		MaxOutstandingTasks: 3,
+	}
+	for taskID := int64(1); taskID <= 2; taskID++ {
+		require.NoError(t, tlm.taskAckManager.ReadItem(taskID))
+	}
+	// the backlog is full but the task list is not configured to reject new tasks
+	require.NoError(t, tlm.checkBacklogLimit())
+	require.False(t, tlm.isOutstandingTasksLimitReached())
+
+	tlm.db.config.RejectWhenBacklogFull = true
+	require.Equal(t, errTaskListBacklogFull, tlm.checkBacklogLimit())
+
+	require.NoError(t, tlm.taskAckManager.ReadItem(3))
+	require.True(t, tlm.isOutstandingTasksLimitReached())
+
+	tlm.taskAckManager.AckItem(1)
+	tlm.taskAckManager.AckItem(2)
+	require.NoError(t, tlm.checkBacklogLimit())
+	require.False(t, tlm.isOutstandingTasksLimitReached())
+}
+
+func TestTaskListConfigOverridesDispatchRate(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	tlm.db.config = &persistence.TaskListConfig{MaxDispatchPerSecond: 0.5}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	rps := 100.0
+	_, err := tlm.getTask(ctx, &rps)
+	require.Equal(t, ErrNoTasks, err)
+	require.Equal(t, 0.5, tlm.matcher.Rate())
+}
+
+func TestTaskListManagerUpdateConfig(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	require.Nil(t, tlm.DescribeConfig())
+
+	config, err := tlm.UpdateConfig(&types.UpdateTaskListConfigRequest{
+		MaxDispatchPerSecond: common.Float64Ptr(2),
+		MaxBacklogSize:       common.Int64Ptr(10),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.TaskListConfig{MaxDispatchPerSecond: 2, MaxBacklogSize: 10}, config)
+	require.Equal(t, config, tlm.DescribeConfig())
+	// the rate limit is applied without waiting for the next poll
+	require.Equal(t, 2.0, tlm.matcher.Rate())
+
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	persisted := tm.getTaskListManager(tlm.taskListID)
+	require.Equal(t, &persistence.TaskListConfig{MaxDispatchPerSecond: 2, MaxBacklogSize: 10}, persisted.config)
+
+	// limits not set in the update are left as is, no config is left once all limits are unset
+	config, err = tlm.UpdateConfig(&types.UpdateTaskListConfigRequest{MaxDispatchPerSecond: common.Float64Ptr(0)})
+	require.NoError(t, err)
+	require.Equal(t, &types.TaskListConfig{MaxBacklogSize: 10}, config)
+	config, err = tlm.UpdateConfig(&types.UpdateTaskListConfigRequest{MaxBacklogSize: common.Int64Ptr(0)})
+	require.NoError(t, err)
+	require.Nil(t, config)
+	require.Nil(t, persisted.config)
+}
+
+func TestTaskListManagerUpdateConfig_LeaseLost(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	// another host took the lease of the task list
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	persisted := tm.getTaskListManager(tlm.taskListID)
+	persisted.Lock()
+	persisted.rangeID++
+	persisted.Unlock()
+
+	_, err := tlm.UpdateConfig(&types.UpdateTaskListConfigRequest{MaxBacklogSize: common.Int64Ptr(10)})
+	require.Error(t, err)
+	require.Nil(t, tlm.DescribeConfig())
+	require.Nil(t, persisted.config)
+}
+
+func TestTaskListManagerUpdatePartitions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	require.NotNil(t, tlm.partitionAutoscaler)
+	require.Equal(t, 1, tlm.config.NumReadPartitions())
+	require.Equal(t, 1, tlm.config.NumWritePartitions())
+
+	_, err := tlm.UpdateConfig(&types.UpdateTaskListConfigRequest{MaxBacklogSize: common.Int64Ptr(10)})
+	require.NoError(t, err)
+	require.NoError(t, tlm.UpdatePartitions(3, 2))
+
+	// the persisted counts take precedence over the dynamic config and limits are kept
+	require.Equal(t, 3, tlm.config.NumReadPartitions())
+	require.Equal(t, 2, tlm.config.NumWritePartitions())
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	persisted := tm.getTaskListManager(tlm.taskListID)
+	require.Equal(t, &persistence.TaskListConfig{
+		MaxBacklogSize:     10,
+		NumReadPartitions:  3,
+		NumWritePartitions: 2,
+	}, persisted.config)
+	desc := tlm.DescribeTaskList(true)
+	require.Equal(t, int32(3), desc.PartitionConfig.NumReadPartitions)
+	require.Equal(t, int32(2), desc.PartitionConfig.NumWritePartitions)
+
+	// unsetting the limits does not reset the partition counts
+	config, err := tlm.UpdateConfig(&types.UpdateTaskListConfigRequest{MaxBacklogSize: common.Int64Ptr(0)})
+	require.NoError(t, err)
+	require.Nil(t, config)
+	require.Equal(t, &persistence.TaskListConfig{NumReadPartitions: 3, NumWritePartitions: 2}, persisted.config)
+}
+
+func TestTaskListManagerChildPartitionLoadsRootConfig(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	root := createTestTaskListManager(controller)
+	require.NoError(t, root.Start())
+	defer root.Stop()
+	_, err := root.UpdateConfig(&types.UpdateTaskListConfigRequest{
+		MaxDispatchPerSecond: common.Float64Ptr(10),
+		MaxBacklogSize:       common.Int64Ptr(100),
+	})
+	require.NoError(t, err)
+	require.NoError(t, root.UpdatePartitions(2, 2))
+
+	childID := newTestTaskListID(root.taskListID.domainID, root.taskListID.mkName(1), root.taskListID.taskType)
+	tlKind := types.TaskListKindNormal
+	mgr, err := newTaskListManager(root.engine, childID, &tlKind, root.engine.config)
+	require.NoError(t, err)
+	child := mgr.(*taskListManagerImpl)
+	require.NoError(t, child.Start())
+	defer child.Stop()
+
+	// the child partition applies the limits and partition counts of the root partition
+	require.Nil(t, child.partitionAutoscaler)
+	require.Equal(t, &types.TaskListConfig{MaxDispatchPerSecond: 10, MaxBacklogSize: 100}, child.DescribeConfig())
+	require.Equal(t, 2, child.config.NumReadPartitions())
+	require.Equal(t, 5.0, child.matcher.Rate())
+
+	_, err = child.UpdateConfig(&types.UpdateTaskListConfigRequest{MaxBacklogSize: common.Int64Ptr(10)})
+	require.IsType(t, &types.BadRequestError{}, err)
+
+	_, err = root.UpdateConfig(&types.UpdateTaskListConfigRequest{MaxDispatchPerSecond: common.Float64Ptr(0)})
+	require.NoError(t, err)
+	child.refreshRootConfig()
+	require.Equal(t, &types.TaskListConfig{MaxBacklogSize: 100}, child.DescribeConfig())
+}
+
+func TestStickyTaskListWorkerIdentity(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	engine := createTestTaskListManager(controller).engine
+	tlID := newTestTaskListID("domain", "host1:sticky", persistence.TaskListTypeDecision)
+	tlKind := types.TaskListKindSticky
+	newStickyTaskListManager := func() *taskListManagerImpl {
+		tlMgr, err := newTaskListManager(engine, tlID, &tlKind, engine.config)
+		require.NoError(t, err)
+		require.NoError(t, tlMgr.Start())
+		return tlMgr.(*taskListManagerImpl)
+	}
+	tlm := newStickyTaskListManager()
+	require.Equal(t, &types.MatchingStickyTaskList{Name: "host1:sticky"}, tlm.DescribeStickyTaskList())
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), identityKey, "worker@host1"), 10*time.Millisecond)
+	defer cancel()
+	_, err := tlm.getTask(ctx, nil)
+	require.Equal(t, ErrNoTasks, err)
+
+	// the worker identity is persisted in the background
+	require.Eventually(t, func() bool {
+		return tlm.DescribeStickyTaskList().Identity == "worker@host1"
+	}, time.Second, 10*time.Millisecond)
+	require.NotNil(t, tlm.DescribeStickyTaskList().LastPollTimestamp)
+
+	// the worker identity is not written again until stickyIdentityUpdateInterval elapsed
+	tlm.recordStickyPoll("worker@host2")
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, "worker@host1", tlm.DescribeStickyTaskList().Identity)
+
+	// the worker identity is persisted with the task list and still known once it is reloaded
+	tlm.Stop()
+	tlm = newStickyTaskListManager()
+	defer tlm.Stop()
+	require.Equal(t, &types.MatchingStickyTaskList{Name: "host1:sticky", Identity: "worker@host1"}, tlm.DescribeStickyTaskList())
+}
+
+func TestTaskListManagerHandoff(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+
+	pollErrC := make(chan error, 1)
+	go func() {
+		ctx := context.WithValue(context.Background(), pollerIDKey, "poller")
+		_, err := tlm.GetTask(ctx, nil)
+		pollErrC <- err
+	}()
+	require.Eventually(t, func() bool {
+		tlm.outstandingPollsLock.Lock()
+		defer tlm.outstandingPollsLock.Unlock()
+		return len(tlm.outstandingPollsMap) == 1
+	}, time.Second, time.Millisecond)
+
+	tlm.taskAckManager.SetAckLevel(7)
+	tlm.Handoff()
+
+	select {
+	case err := <-pollErrC:
+		require.Equal(t, errTaskListHandedOff, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "waiting poller was not released on handoff")
+	}
+	_, err := tlm.GetTask(context.Background(), nil)
+	require.Equal(t, errTaskListHandedOff, err)
+	_, err = tlm.taskWriter.appendTask(&types.WorkflowExecution{}, &persistence.TaskInfo{})
+	require.Equal(t, errShutdown, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&tlm.stopped))
+
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, int64(7), tm.getTaskListManager(tlm.taskListID).ackLevel)
+}
+
+func TestEngineHandoffTaskListsOnMembershipChange(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	engine := tlm.engine
+	resolver := membership.NewMockResolver(controller)
+	resolver.EXPECT().WhoAmI().Return(membership.NewHostInfo("self"), nil).AnyTimes()
+	engine.membershipResolver = resolver
+	require.NoError(t, tlm.Start())
+	engine.updateTaskList(tlm.taskListID, tlm)
+
+	// the task list is still owned by this host
+	resolver.EXPECT().Lookup(service.Matching, tlm.taskListID.name).Return(membership.NewHostInfo("self"), nil)
+	engine.handoffTaskLists()
+	require.Len(t, engine.getTaskLists(10), 1)
+
+	resolver.EXPECT().Lookup(service.Matching, tlm.taskListID.name).Return(membership.NewHostInfo("other"), nil)
+	engine.handoffTaskLists()
+	require.Eventually(t, func() bool {
+		return len(engine.getTaskLists(10)) == 0
+	}, time.Second, time.Millisecond)
+	require.Equal(t, int32(1), atomic.LoadInt32(&tlm.handedOff))
+}
+
+func TestEngineStopIsIdempotent(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	engine := tlm.engine
+	resolver := membership.NewMockResolver(controller)
+	resolver.EXPECT().Subscribe(service.Matching, matchingEngineMembershipUpdateListenerName, gomock.Any()).Return(nil)
+	resolver.EXPECT().Unsubscribe(service.Matching, matchingEngineMembershipUpdateListenerName).Return(nil).Times(1)
+	engine.membershipResolver = resolver
+	engine.membershipUpdateCh = make(chan *membership.ChangedEvent, 1)
+	engine.shutdownCh = make(chan struct{})
+	engine.Start()
+
+	engine.Stop()
+	require.NotPanics(t, engine.Stop)
+}
+
+func TestTaskReaderIsolatedTasksDoNotBlock(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	tlm.config.GetTasksBatchSize = func() int { return 1 }
+	tlm.matcher.enableIsolationGroups = func() bool { return true }
+	tlm.matcher.isolationGroupFallbackDelay = func() time.Duration { return time.Minute }
+	_, unregister := tlm.matcher.isolationGroups.registerPoller("zone1")
+	unregister()
+	tr := tlm.taskReader
+	defer tr.Stop()
+
+	newTask := func() *InternalTask {
+		info := &persistence.TaskInfo{WorkflowID: "wid", RunID: "rid", IsolationGroup: "zone1", CreatedTime: time.Now()}
+		return newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil)
+	}
+	pending, spilled := newTask(), newTask()
+	// zone1 has no poller waiting, its pending tasks are full once the first task is added
+	tr.dispatchIsolatedTask("zone1", pending)
+	tr.dispatchIsolatedTask("zone1", spilled)
+	require.Equal(t, spilled, tr.fallbackTasks.pop())
+	require.Empty(t, spilled.isolationGroup())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	polled, err := tlm.matcher.Poll(context.WithValue(ctx, isolationGroupKey, "zone1"))
+	require.NoError(t, err)
+	require.Equal(t, pending, polled)
+	require.Nil(t, tr.fallbackTasks.pop())
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// migrateTasks moves up to maxTasks backlog tasks to the root partition of the target task list
+// through the matching host owning it, keeping their remaining schedule to start timeout. Only
+// tasks the task reader did not load yet are moved, and the task reader does not load tasks until
+// the migration is done, so that no task is dispatched by both task lists. Expired tasks are
+// dead-lettered if enabled and deleted instead of moved
+func (c *taskListManagerImpl) migrateTasks(ctx context.Context, target string, maxTasks int) (*types.MigrateTaskListResponse, error) {
+	tr := c.taskReader
+	tr.fetchLock.Lock()
+	defer tr.fetchLock.Unlock()
+
+	readLevel := tr.fetchedLevel
+	if ackReadLevel := c.taskAckManager.GetReadLevel(); ackReadLevel > readLevel {
+		readLevel = ackReadLevel
+	}
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+
+	result := &types.MigrateTaskListResponse{}
+	for int(result.Migrated+result.Expired) < maxTasks && readLevel < maxReadLevel {
+		batchSize := c.config.GetTasksBatchSize()
+		response, err := c.executeWithRetry(func() (interface{}, error) {
+			return c.db.GetTasks(readLevel, maxReadLevel, batchSize)
+		})
+		if err != nil {
+			return nil, err
+		}
+		tasks := response.(*persistence.GetTasksResponse).Tasks
+		if len(tasks) == 0 {
+			break
+		}
+
+		now := time.Now()
+		tr.deadLetterExpiredTasks(tasks, now)
+		for _, task := range tasks {
+			if int(result.Migrated+result.Expired) == maxTasks {
+				break
+			}
+			expired := tr.isTaskExpired(task, now)
+			if !expired {
+				if err := c.migrateTask(ctx, task, target, now); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := c.executeWithRetry(func() (interface{}, error) {
+				return nil, c.db.CompleteTask(task.TaskID)
+			}); err != nil {
+				return nil, err
+			}
+			if expired {
+				result.Expired++
+			} else {
+				result.Migrated++
+			}
+			readLevel = task.TaskID
+		}
+		if len(tasks) < batchSize {
+			break
+		}
+	}
+	return result, nil
+}
+
+// migrateTask adds the backlog task to the root partition of the target task list
+func (c *taskListManagerImpl) migrateTask(ctx context.Context, task *persistence.TaskInfo, target string, now time.Time) error {
+	scheduleToStartTimeout := task.ScheduleToStartTimeout
+	if task.Expiry.After(epochStartTime) {
+		scheduleToStartTimeout = int32(math.Ceil(task.Expiry.Sub(now).Seconds()))
+	}
+	kind := types.TaskListKindNormal
+	source := types.TaskSourceDbBacklog
+	execution := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
+	switch c.taskListID.taskType {
+	case persistence.TaskListTypeDecision:
+		return c.engine.matchingClient.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+			DomainUUID:                    task.DomainID,
+			Execution:                     execution,
+			TaskList:                      &types.TaskList{Name: target, Kind: &kind},
+			ScheduleID:                    task.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &source,
+			Priority:                      task.Priority,
+			IsolationGroup:                task.IsolationGroup,
+		})
+	case persistence.TaskListTypeActivity:
+		return c.engine.matchingClient.AddActivityTask(ctx, &types.AddActivityTaskRequest{
+			DomainUUID:                    c.taskListID.domainID,
+			SourceDomainUUID:              task.DomainID,
+			Execution:                     execution,
+			TaskList:                      &types.TaskList{Name: target, Kind: &kind},
+			ScheduleID:                    task.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &source,
+			Priority:                      task.Priority,
+			LabelSelector:                 task.LabelSelector,
+			IsolationGroup:                task.IsolationGroup,
+		})
+	default:
+		return errInvalidTaskListType
+	}
+}
+
+// deleteTaskList unloads the task list and deletes all its tasks and then the task list itself
+// under the lease it holds, so that no other host takes over the task list meanwhile
+func (c *taskListManagerImpl) deleteTaskList() error {
+	// new tasks are rejected from now on
+	c.taskWriter.Drain(handoffDrainTimeout)
+	c.outstandingPollsLock.Lock()
+	for _, cancel := range c.outstandingPollsMap {
+		cancel()
+	}
+	c.outstandingPollsLock.Unlock()
+	c.Stop()
+
+	batchSize := c.config.GetTasksBatchSize()
+	for {
+		deleted, err := c.db.CompleteTasksLessThan(math.MaxInt64, batchSize)
+		if err != nil {
+			return err
+		}
+		if !persistence.HasMoreRowsToDelete(deleted, batchSize) {
+			break
+		}
+	}
+	return c.db.DeleteTaskList()
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestMigrateTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	matchingClient := matching.NewMockClient(controller)
+	tlm.engine.matchingClient = matchingClient
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	// the first task is loaded by the task reader and stays with the task list
+	tlm.taskReader.fetchLock.Lock()
+	var taskIDs []int64
+	for i := int64(0); i < 4; i++ {
+		_, err := tlm.taskWriter.appendTask(
+			&types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+			&persistence.TaskInfo{DomainID: "domain", WorkflowID: "wid", RunID: "rid", ScheduleID: 5 + i},
+		)
+		require.NoError(t, err)
+		taskIDs = append(taskIDs, tlm.taskWriter.GetMaxReadLevel())
+	}
+	tlm.taskReader.fetchedLevel = taskIDs[0]
+	tlm.taskReader.fetchLock.Unlock()
+
+	for _, scheduleID := range []int64{6, 7} {
+		scheduleID := scheduleID
+		matchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, request *types.AddActivityTaskRequest, _ ...interface{}) error {
+				require.Equal(t, "target", request.TaskList.GetName())
+				require.Equal(t, types.TaskListKindNormal, request.TaskList.GetKind())
+				require.Equal(t, "domain", request.GetDomainUUID())
+				require.Equal(t, "wid", request.Execution.GetWorkflowID())
+				require.Empty(t, request.GetForwardedFrom())
+				require.Equal(t, scheduleID, request.GetScheduleID())
+				return nil
+			}).Times(1)
+	}
+	resp, err := tlm.MigrateTasks(context.Background(), "target", 2)
+	require.NoError(t, err)
+	require.Equal(t, &types.MigrateTaskListResponse{Migrated: 2}, resp)
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, 2, tm.getTaskCount(tlm.taskListID))
+
+	_, err = tlm.MigrateTasks(context.Background(), tlm.taskListID.name, 2)
+	require.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestDeleteTaskList(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.NoError(t, tlm.Start())
+	for i := int64(0); i < 3; i++ {
+		_, err := tlm.taskWriter.appendTask(&types.WorkflowExecution{}, &persistence.TaskInfo{ScheduleID: i})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tlm.Delete())
+	require.Equal(t, int32(1), tlm.stopped)
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	tm.Lock()
+	_, ok := tm.taskLists[*tlm.taskListID]
+	tm.Unlock()
+	require.False(t, ok)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// taskPriorityQueue orders buffered backlog tasks by priority (highest first)
+	// and then by task ID. To prevent starvation of low priority tasks, the oldest
+	// task is dispatched once the number of consecutive dispatches which skipped
+	// over it reaches the starvation threshold.
+	taskPriorityQueue struct {
+		tasks   taskHeap
+		skipped int
+	}
+
+	taskHeap []*persistence.TaskInfo
+)
+
+func newTaskPriorityQueue() *taskPriorityQueue {
+	return &taskPriorityQueue{}
+}
+
+// Len returns the number of buffered tasks
+func (q *taskPriorityQueue) Len() int {
+	return len(q.tasks)
+}
+
+// Push adds a task to the queue
+func (q *taskPriorityQueue) Push(task *persistence.TaskInfo) {
+	heap.Push(&q.tasks, task)
+}
+
+// Peek returns the task with the highest priority without removing it
+func (q *taskPriorityQueue) Peek() *persistence.TaskInfo {
+	if len(q.tasks) == 0 {
+		return nil
+	}
+	return q.tasks[0]
+}
+
+// Pop removes and returns the next task to dispatch along with whether the task
+// was selected because the starvation threshold was reached. A threshold of 0
+// disables priority ordering and the oldest task is always returned.
+func (q *taskPriorityQueue) Pop(starvationThreshold int) (*persistence.TaskInfo, bool) {
+	if len(q.tasks) == 0 {
+		return nil, false
+	}
+
+	oldest := q.oldestIndex()
+	if starvationThreshold <= 0 || q.skipped >= starvationThreshold {
+		starved := starvationThreshold > 0 && oldest != 0
+		q.skipped = 0
+		return heap.Remove(&q.tasks, oldest).(*persistence.TaskInfo), starved
+	}
+
+	if oldest == 0 {
+		q.skipped = 0
+	} else {
+		q.skipped++
+	}
+	return heap.Pop(&q.tasks).(*persistence.TaskInfo), false
+}
+
+func (q *taskPriorityQueue) oldestIndex() int {
+	oldest := 0
+	for i, task := range q.tasks {
+		if task.TaskID < q.tasks[oldest].TaskID {
+			oldest = i
+		}
+	}
+	return oldest
+}
+
+func (h taskHeap) Len() int {
+	return len(h)
+}
+
+func (h taskHeap) Less(i, j int) bool {
+	if h[i].Priority != h[j].Priority {
+		return h[i].Priority > h[j].Priority
+	}
+	return h[i].TaskID < h[j].TaskID
+}
+
+func (h taskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *taskHeap) Push(x interface{}) {
+	*h = append(*h, x.(*persistence.TaskInfo))
+}
+
+func (h *taskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return task
+}
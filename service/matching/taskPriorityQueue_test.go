@@ -0,0 +1,108 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type taskPriorityQueueSuite struct {
+	suite.Suite
+	queue *taskPriorityQueue
+}
+
+func TestTaskPriorityQueueSuite(t *testing.T) {
+	suite.Run(t, new(taskPriorityQueueSuite))
+}
+
+func (s *taskPriorityQueueSuite) SetupTest() {
+	s.queue = newTaskPriorityQueue()
+}
+
+func (s *taskPriorityQueueSuite) TestPop_Empty() {
+	task, starved := s.queue.Pop(10)
+	s.Nil(task)
+	s.False(starved)
+}
+
+func (s *taskPriorityQueueSuite) TestPeek() {
+	s.Nil(s.queue.Peek())
+
+	s.pushTasks(map[int64]int32{1: 0, 2: 5, 3: 1})
+	s.Equal(int64(2), s.queue.Peek().TaskID)
+	s.Equal(3, s.queue.Len())
+}
+
+func (s *taskPriorityQueueSuite) TestPop_PriorityOrder() {
+	s.pushTasks(map[int64]int32{1: 0, 2: 5, 3: 1, 4: 5, 5: 0})
+
+	s.Equal([]int64{2, 4, 3, 1, 5}, s.popTaskIDs(100))
+}
+
+func (s *taskPriorityQueueSuite) TestPop_Disabled() {
+	s.pushTasks(map[int64]int32{1: 0, 2: 5, 3: 1, 4: 5, 5: 0})
+
+	s.Equal([]int64{1, 2, 3, 4, 5}, s.popTaskIDs(0))
+}
+
+func (s *taskPriorityQueueSuite) TestPop_StarvationProtection() {
+	s.pushTasks(map[int64]int32{1: 0, 2: 0, 3: 5, 4: 5, 5: 5, 6: 5, 7: 5})
+
+	s.Equal([]int64{3, 4, 1, 5, 6, 2, 7}, s.popTaskIDs(2))
+}
+
+func (s *taskPriorityQueueSuite) TestPop_StarvedFlag() {
+	s.pushTasks(map[int64]int32{1: 0, 2: 5})
+
+	task, starved := s.queue.Pop(1)
+	s.Equal(int64(2), task.TaskID)
+	s.False(starved)
+	task, starved = s.queue.Pop(1)
+	s.Equal(int64(1), task.TaskID)
+	s.False(starved)
+
+	s.pushTasks(map[int64]int32{3: 0, 4: 5, 5: 5})
+	task, starved = s.queue.Pop(1)
+	s.Equal(int64(4), task.TaskID)
+	s.False(starved)
+	task, starved = s.queue.Pop(1)
+	s.Equal(int64(3), task.TaskID)
+	s.True(starved)
+}
+
+func (s *taskPriorityQueueSuite) pushTasks(priorities map[int64]int32) {
+	for taskID, priority := range priorities {
+		s.queue.Push(&persistence.TaskInfo{TaskID: taskID, Priority: priority})
+	}
+}
+
+func (s *taskPriorityQueueSuite) popTaskIDs(starvationThreshold int) []int64 {
+	var taskIDs []int64
+	for s.queue.Len() > 0 {
+		task, _ := s.queue.Pop(starvationThreshold)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	return taskIDs
+}
@@ -23,8 +23,10 @@ package matching
 import (
 	"context"
 	"runtime"
+	"sync"
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -34,6 +36,15 @@ import (
 
 var epochStartTime = time.Unix(0, 0)
 
+const (
+	// outstandingTasksCheckInterval is the interval to check whether more tasks can be loaded
+	// from the backlog once the max outstanding tasks configured on the task list is reached
+	outstandingTasksCheckInterval = 100 * time.Millisecond
+	// labeledTasksCheckInterval is the interval to check for backlog tasks with a label
+	// selector which waited for a compatible poller for too long
+	labeledTasksCheckInterval = time.Second
+)
+
 type (
 	taskReader struct {
 		taskBuffer chan *persistence.TaskInfo // tasks loaded from persistence
@@ -49,6 +60,23 @@ type (
 		// separate shutdownC needed for dispatchTasks go routine to allow
 		// getTasksPump to be stopped without stopping dispatchTasks in unit tests
 		dispatcherShutdownC chan struct{}
+		// isolatedTasks holds the pending backlog tasks of each isolation group, dispatched to the
+		// pollers of the group by a separate go routine so that neither the reader nor the other
+		// groups wait on a group without available pollers.
+		// Only accessed from the dispatchBufferedTasks go routine.
+		isolatedTasks map[string]*isolatedTaskQueue
+		// fallbackTasks holds the isolated tasks which no poller of their group took in time and
+		// the labeled tasks which didn't fit in memory, they are dispatched to any poller by the
+		// dispatchBufferedTasks go routine
+		fallbackTasks *isolatedTaskQueue
+		// backlog holds the loaded tasks in priority order when task priority is enabled,
+		// in which case they bypass the task buffer
+		backlog *taskBacklog
+		// fetchLock is held while a batch of tasks is read from persistence, so that the
+		// backlog above fetchedLevel can be moved elsewhere without being loaded meanwhile
+		fetchLock sync.Mutex
+		// fetchedLevel is the ID of the last task read from persistence
+		fetchedLevel int64
 	}
 )
 
@@ -60,6 +88,9 @@ func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 		cancelFunc:          cancel,
 		notifyC:             make(chan struct{}, 1),
 		dispatcherShutdownC: make(chan struct{}),
+		isolatedTasks:       make(map[string]*isolatedTaskQueue),
+		fallbackTasks:       newIsolatedTaskQueue(0),
+		backlog:             newTaskBacklog(),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffer: make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1),
@@ -70,6 +101,7 @@ func (tr *taskReader) Start() {
 	tr.Signal()
 	go tr.dispatchBufferedTasks()
 	go tr.getTasksPump()
+	go tr.forwardExpiredLabeledTasks()
 }
 
 func (tr *taskReader) Stop() {
@@ -88,28 +120,171 @@ func (tr *taskReader) Signal() {
 func (tr *taskReader) dispatchBufferedTasks() {
 dispatchLoop:
 	for {
+		if task := tr.fallbackTasks.pop(); task != nil {
+			if !tr.dispatchTask(task) {
+				break dispatchLoop
+			}
+			continue dispatchLoop
+		}
+		taskInfo, starved, backlogChangedC := tr.backlog.pop(tr.tlMgr.config.TaskPriorityStarvationThreshold())
+		if taskInfo != nil {
+			if starved {
+				tr.scope().IncCounter(metrics.StarvedTasksPerTaskListCounter)
+			}
+			if !tr.dispatchPrioritizedTask(taskInfo, !starved) {
+				break dispatchLoop
+			}
+			continue dispatchLoop
+		}
 		select {
 		case taskInfo, ok := <-tr.taskBuffer:
 			if !ok { // Task list getTasks pump is shutdown
 				break dispatchLoop
 			}
 			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, types.TaskSourceDbBacklog, "", false, nil)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
+			if taskInfo.IsolationGroup != "" && tr.tlMgr.config.EnableIsolationGroups() {
+				tr.dispatchIsolatedTask(taskInfo.IsolationGroup, task)
+				continue dispatchLoop
+			}
+			if !tr.dispatchTask(task) {
+				break dispatchLoop
+			}
+		case <-tr.fallbackTasks.notifyC:
+		case <-backlogChangedC:
+		case <-tr.dispatcherShutdownC:
+			break dispatchLoop
+		}
+	}
+}
+
+// dispatchPrioritizedTask dispatches a task of the priority backlog. Unless preemptible is false, the
+// offer is cancelled when a task of higher priority is loaded and the task goes back to the backlog.
+// Returns false on shutdown.
+func (tr *taskReader) dispatchPrioritizedTask(taskInfo *persistence.TaskInfo, preemptible bool) bool {
+	task := newInternalTask(taskInfo, tr.tlMgr.completeTask, types.TaskSourceDbBacklog, "", false, nil)
+	if taskInfo.IsolationGroup != "" && tr.tlMgr.config.EnableIsolationGroups() {
+		tr.dispatchIsolatedTask(taskInfo.IsolationGroup, task)
+		return true
+	}
+	if !preemptible {
+		return tr.dispatchTask(task)
+	}
+
+	ctx, cancel := context.WithCancel(tr.cancelCtx)
+	defer cancel()
+	tr.backlog.startOffer(taskInfo, cancel)
+	defer tr.backlog.endOffer()
+	for {
+		err := tr.tlMgr.DispatchTask(ctx, task)
+		if err == nil {
+			return true
+		}
+		if err == context.Canceled {
+			if tr.cancelCtx.Err() != nil {
+				tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+				return false
+			}
+			tr.scope().IncCounter(metrics.PreemptedTasksPerTaskListCounter)
+			tr.backlog.pushBack(taskInfo)
+			return true
+		}
+		// this should never happen unless there is a bug - don't drop the task
+		tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
+		tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		runtime.Gosched()
+	}
+}
+
+// dispatchTask blocks until the task is dispatched to a poller, or the task list
+// manager is shutting down. Returns false on shutdown.
+func (tr *taskReader) dispatchTask(task *InternalTask) bool {
+	for {
+		err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+		if err == nil {
+			return true
+		}
+		if err == context.Canceled {
+			tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+			return false
+		}
+		// this should never happen unless there is a bug - don't drop the task
+		tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
+		tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		runtime.Gosched()
+	}
+}
+
+// dispatchIsolatedTask adds the task to the pending tasks of its isolation group, starting their
+// dispatcher if needed. The task falls back to any poller right away when the pending tasks of
+// the group are full, so the reader never waits on the pollers of a single group
+func (tr *taskReader) dispatchIsolatedTask(group string, task *InternalTask) {
+	queue, ok := tr.isolatedTasks[group]
+	if !ok {
+		queue = newIsolatedTaskQueue(tr.tlMgr.config.GetTasksBatchSize())
+		tr.isolatedTasks[group] = queue
+		go tr.dispatchIsolatedTasks(queue)
+	}
+	if !queue.push(task) {
+		tr.fallbackIsolatedTask(task)
+	}
+}
+
+// dispatchIsolatedTasks offers the pending tasks of an isolation group to the pollers of the group
+// in order, a task which is not picked up before the isolation group fallback delay is handed to
+// the dispatchBufferedTasks go routine to be dispatched to any poller
+func (tr *taskReader) dispatchIsolatedTasks(queue *isolatedTaskQueue) {
+	for {
+		task := queue.peek()
+		if task == nil {
+			select {
+			case <-queue.notifyC:
+				continue
+			case <-tr.dispatcherShutdownC:
+				return
+			}
+		}
+		matched, err := tr.tlMgr.matcher.OfferIsolated(tr.cancelCtx, task)
+		if err != nil {
+			// the task list manager is shutting down
+			return
+		}
+		queue.pop()
+		if !matched {
+			tr.fallbackIsolatedTask(task)
+		}
+	}
+}
+
+func (tr *taskReader) fallbackIsolatedTask(task *InternalTask) {
+	task.isolationGroupExpired = true
+	tr.scope().IncCounter(metrics.IsolationGroupFallbackPerTaskListCounter)
+	tr.fallbackTasks.push(task)
+}
+
+// forwardExpiredLabeledTasks periodically hands the backlog tasks with a label selector which no
+// compatible poller took in time to the parent partition. Tasks the parent partition doesn't take keep
+// waiting in memory, they are never written back to the backlog.
+func (tr *taskReader) forwardExpiredLabeledTasks() {
+	ticker := time.NewTicker(labeledTasksCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, task := range tr.tlMgr.matcher.RemoveExpiredLabeledTasks(time.Now()) {
+				if tr.tlMgr.matcher.TryForward(tr.cancelCtx, task) {
+					task.finish(nil)
+					continue
 				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
-					break dispatchLoop
+				if tr.tlMgr.matcher.AddLabeledTask(task) {
+					continue
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
+				// the labeled tasks were filled up in the meantime
+				task.labelSelectorDropped = true
+				tr.scope().IncCounter(metrics.LabeledTasksFallbackPerTaskListCounter)
+				tr.fallbackTasks.push(task)
 			}
 		case <-tr.dispatcherShutdownC:
-			break dispatchLoop
+			return
 		}
 	}
 }
@@ -130,7 +305,15 @@ getTasksPumpLoop:
 			{
 				lastTimeWriteTask = time.Now()
 
+				tr.fetchLock.Lock()
 				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch()
+				if err == nil {
+					tr.fetchedLevel = readLevel
+					if len(tasks) > 0 {
+						tr.fetchedLevel = tasks[len(tasks)-1].TaskID
+					}
+				}
+				tr.fetchLock.Unlock()
 				if err != nil {
 					tr.Signal() // re-enqueue the event
 					// TODO: Should we ever stop retrying on db errors?
@@ -244,6 +427,7 @@ func (tr *taskReader) handleIdleTimeout() {
 func (tr *taskReader) addTasksToBuffer(
 	tasks []*persistence.TaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
 	now := time.Now()
+	tr.deadLetterExpiredTasks(tasks, now)
 	for _, t := range tasks {
 		if tr.isTaskExpired(t, now) {
 			tr.scope().IncCounter(metrics.ExpiredTasksPerTaskListCounter)
@@ -259,12 +443,42 @@ func (tr *taskReader) addTasksToBuffer(
 	return true
 }
 
+// deadLetterExpiredTasks moves the expired tasks of the batch to the dead-letter task list
+// before they are skipped. Tasks that fail to be dead-lettered are dropped as before
+func (tr *taskReader) deadLetterExpiredTasks(tasks []*persistence.TaskInfo, now time.Time) {
+	if !tr.tlMgr.deadLetter.isEnabled() {
+		return
+	}
+	var expired []*persistence.TaskInfo
+	for _, t := range tasks {
+		if tr.isTaskExpired(t, now) {
+			expired = append(expired, t)
+		}
+	}
+	if len(expired) > 0 {
+		tr.tlMgr.deadLetter.add(common.DeadLetterReasonExpired, expired) //nolint:errcheck
+	}
+}
+
 func (tr *taskReader) addSingleTaskToBuffer(
 	task *persistence.TaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
+	if tr.tlMgr.isOutstandingTasksLimitReached() {
+		tr.scope().IncCounter(metrics.OutstandingTasksLimitPerTaskListCounter)
+		for tr.tlMgr.isOutstandingTasksLimitReached() {
+			select {
+			case <-time.After(outstandingTasksCheckInterval):
+			case <-tr.tlMgr.shutdownCh:
+				return false
+			}
+		}
+	}
 	err := tr.tlMgr.taskAckManager.ReadItem(task.TaskID)
 	if err != nil {
 		tr.logger().Fatal("critical bug when adding item to ackManager")
 	}
+	if tr.tlMgr.config.EnableTaskPriority() {
+		return tr.addSingleTaskToBacklog(task, lastWriteTime, idleTimer)
+	}
 	for {
 		select {
 		case tr.taskBuffer <- task:
@@ -302,3 +516,24 @@ func (tr *taskReader) logger() log.Logger {
 func (tr *taskReader) scope() metrics.Scope {
 	return tr.tlMgr.metricScope()
 }
+
+// addSingleTaskToBacklog adds the task to the priority backlog, blocking while the backlog is full
+func (tr *taskReader) addSingleTaskToBacklog(
+	task *persistence.TaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
+	for {
+		added, changedC := tr.backlog.push(task, tr.tlMgr.config.TaskPriorityBufferSize())
+		if added {
+			return true
+		}
+		select {
+		case <-changedC:
+		case <-idleTimer.C:
+			if tr.isIdle(lastWriteTime) {
+				tr.handleIdleTimeout()
+				return false
+			}
+		case <-tr.tlMgr.shutdownCh:
+			return false
+		}
+	}
+}
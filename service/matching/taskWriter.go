@@ -24,6 +24,7 @@ package matching
 import (
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -57,8 +58,10 @@ type (
 		taskIDBlock  taskIDBlock
 		maxReadLevel int64
 		stopped      int64 // set to 1 if the writer is stopped or is shutting down
+		draining     int64 // set to 1 once the writer no longer accepts appends
 		logger       log.Logger
 		stopCh       chan struct{} // shutdown signal for all routines in this class
+		drainCh      chan chan struct{}
 	}
 )
 
@@ -71,6 +74,7 @@ func newTaskWriter(tlMgr *taskListManagerImpl) *taskWriter {
 		config:     tlMgr.config,
 		taskListID: tlMgr.taskListID,
 		stopCh:     make(chan struct{}),
+		drainCh:    make(chan chan struct{}),
 		appendCh:   make(chan *writeTaskRequest, tlMgr.config.OutstandingTaskAppendsThreshold()),
 		logger:     tlMgr.logger,
 	}
@@ -93,10 +97,33 @@ func (w *taskWriter) isStopped() bool {
 	return atomic.LoadInt64(&w.stopped) == 1
 }
 
+// Drain stops accepting new appends and waits until the outstanding ones are written to
+// persistence, or until the timeout expires. The taskWriter must still be stopped afterwards
+func (w *taskWriter) Drain(timeout time.Duration) {
+	if !atomic.CompareAndSwapInt64(&w.draining, 0, 1) {
+		return
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	doneC := make(chan struct{})
+	select {
+	case w.drainCh <- doneC:
+	case <-timer.C:
+		return
+	case <-w.stopCh:
+		return
+	}
+	select {
+	case <-doneC:
+	case <-timer.C:
+	case <-w.stopCh:
+	}
+}
+
 func (w *taskWriter) appendTask(execution *types.WorkflowExecution,
 	taskInfo *persistence.TaskInfo) (*persistence.CreateTasksResponse, error) {
 
-	if w.isStopped() {
+	if w.isStopped() || atomic.LoadInt64(&w.draining) == 1 {
 		return nil, errShutdown
 	}
 
@@ -148,55 +175,20 @@ writerLoop:
 	for {
 		select {
 		case request := <-w.appendCh:
-			{
-				// read a batch of requests from the channel
-				reqs := []*writeTaskRequest{request}
-				reqs = w.getWriteBatch(reqs)
-				batchSize := len(reqs)
-
-				maxReadLevel := int64(0)
-
-				taskIDs, err := w.allocTaskIDs(batchSize)
-				if err != nil {
-					w.sendWriteResponse(reqs, err, nil)
-					continue writerLoop
-				}
-
-				tasks := []*persistence.CreateTaskInfo{}
-				for i, req := range reqs {
-					tasks = append(tasks, &persistence.CreateTaskInfo{
-						TaskID:    taskIDs[i],
-						Execution: *req.execution,
-						Data:      req.taskInfo,
-					})
-					maxReadLevel = taskIDs[i]
-				}
-
-				r, err := w.tlMgr.db.CreateTasks(tasks)
-				switch err.(type) {
-				case nil:
-					// Do nothing
-				case *persistence.ConditionFailedError:
-					// Stop and reload task list manager
-					w.tlMgr.Stop()
+			// read a batch of requests from the channel
+			w.writeBatch(w.getWriteBatch([]*writeTaskRequest{request}))
+		case doneC := <-w.drainCh:
+			// appends are no longer accepted, write the outstanding ones
+		drainLoop:
+			for {
+				select {
+				case request := <-w.appendCh:
+					w.writeBatch(w.getWriteBatch([]*writeTaskRequest{request}))
 				default:
-					w.logger.Error("Persistent store operation failure",
-						tag.StoreOperationCreateTasks,
-						tag.Error(err),
-						tag.WorkflowTaskListName(w.taskListID.name),
-						tag.WorkflowTaskListType(w.taskListID.taskType),
-						tag.Number(taskIDs[0]),
-						tag.NextNumber(taskIDs[batchSize-1]),
-					)
-				}
-
-				// Update the maxReadLevel after the writes are completed.
-				if maxReadLevel > 0 {
-					atomic.StoreInt64(&w.maxReadLevel, maxReadLevel)
+					break drainLoop
 				}
-
-				w.sendWriteResponse(reqs, err, r)
 			}
+			close(doneC)
 		case <-w.stopCh:
 			// we don't close the appendCh here
 			// because that can cause on a send on closed
@@ -206,6 +198,52 @@ writerLoop:
 	}
 }
 
+func (w *taskWriter) writeBatch(reqs []*writeTaskRequest) {
+	batchSize := len(reqs)
+	maxReadLevel := int64(0)
+
+	taskIDs, err := w.allocTaskIDs(batchSize)
+	if err != nil {
+		w.sendWriteResponse(reqs, err, nil)
+		return
+	}
+
+	tasks := []*persistence.CreateTaskInfo{}
+	for i, req := range reqs {
+		tasks = append(tasks, &persistence.CreateTaskInfo{
+			TaskID:    taskIDs[i],
+			Execution: *req.execution,
+			Data:      req.taskInfo,
+		})
+		maxReadLevel = taskIDs[i]
+	}
+
+	r, err := w.tlMgr.db.CreateTasks(tasks)
+	switch err.(type) {
+	case nil:
+		// Do nothing
+	case *persistence.ConditionFailedError:
+		// Stop and reload task list manager
+		w.tlMgr.Stop()
+	default:
+		w.logger.Error("Persistent store operation failure",
+			tag.StoreOperationCreateTasks,
+			tag.Error(err),
+			tag.WorkflowTaskListName(w.taskListID.name),
+			tag.WorkflowTaskListType(w.taskListID.taskType),
+			tag.Number(taskIDs[0]),
+			tag.NextNumber(taskIDs[batchSize-1]),
+		)
+	}
+
+	// Update the maxReadLevel after the writes are completed.
+	if maxReadLevel > 0 {
+		atomic.StoreInt64(&w.maxReadLevel, maxReadLevel)
+	}
+
+	w.sendWriteResponse(reqs, err, r)
+}
+
 func (w *taskWriter) getWriteBatch(reqs []*writeTaskRequest) []*writeTaskRequest {
 readLoop:
 	for i := 0; i < w.config.MaxTaskBatchSize(); i++ {
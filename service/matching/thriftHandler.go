@@ -25,6 +25,7 @@ import (
 
 	"go.uber.org/yarpc"
 
+	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/health"
 	"github.com/uber/cadence/.gen/go/health/metaserver"
 	m "github.com/uber/cadence/.gen/go/matching"
@@ -113,3 +114,39 @@ func (t ThriftHandler) RespondQueryTaskCompleted(ctx context.Context, request *m
 	err := t.h.RespondQueryTaskCompleted(ctx, thrift.ToMatchingRespondQueryTaskCompletedRequest(request))
 	return thrift.FromError(err)
 }
+
+// DescribeTaskListConfig forwards request to the underlying handler
+func (t ThriftHandler) DescribeTaskListConfig(ctx context.Context, request *m.DescribeTaskListConfigRequest) (*admin.DescribeTaskListConfigResponse, error) {
+	response, err := t.h.DescribeTaskListConfig(ctx, thrift.ToMatchingDescribeTaskListConfigRequest(request))
+	return thrift.FromDescribeTaskListConfigResponse(response), thrift.FromError(err)
+}
+
+// UpdateTaskListConfig forwards request to the underlying handler
+func (t ThriftHandler) UpdateTaskListConfig(ctx context.Context, request *m.UpdateTaskListConfigRequest) (*admin.UpdateTaskListConfigResponse, error) {
+	response, err := t.h.UpdateTaskListConfig(ctx, thrift.ToMatchingUpdateTaskListConfigRequest(request))
+	return thrift.FromUpdateTaskListConfigResponse(response), thrift.FromError(err)
+}
+
+// RequeueDeadLetterTasks forwards request to the underlying handler
+func (t ThriftHandler) RequeueDeadLetterTasks(ctx context.Context, request *m.RequeueDeadLetterTasksRequest) (*admin.RequeueDeadLetterTasksResponse, error) {
+	response, err := t.h.RequeueDeadLetterTasks(ctx, thrift.ToMatchingRequeueDeadLetterTasksRequest(request))
+	return thrift.FromRequeueDeadLetterTasksResponse(response), thrift.FromError(err)
+}
+
+// MigrateTaskList forwards request to the underlying handler
+func (t ThriftHandler) MigrateTaskList(ctx context.Context, request *m.MigrateTaskListRequest) (*admin.MigrateTaskListResponse, error) {
+	response, err := t.h.MigrateTaskList(ctx, thrift.ToMatchingMigrateTaskListRequest(request))
+	return thrift.FromMigrateTaskListResponse(response), thrift.FromError(err)
+}
+
+// DeleteTaskList forwards request to the underlying handler
+func (t ThriftHandler) DeleteTaskList(ctx context.Context, request *m.DeleteTaskListRequest) error {
+	err := t.h.DeleteTaskList(ctx, thrift.ToMatchingDeleteTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// ListStickyTaskLists forwards request to the underlying handler
+func (t ThriftHandler) ListStickyTaskLists(ctx context.Context, request *m.ListStickyTaskListsRequest) (*m.ListStickyTaskListsResponse, error) {
+	response, err := t.h.ListStickyTaskLists(ctx, thrift.ToMatchingListStickyTaskListsRequest(request))
+	return thrift.FromMatchingListStickyTaskListsResponse(response), thrift.FromError(err)
+}
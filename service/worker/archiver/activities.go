@@ -110,10 +110,15 @@ func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 			err = cadence.NewCustomError(err.Error())
 		}
 	}()
-	err = container.HistoryV2Manager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
-		BranchToken: request.BranchToken,
-		ShardID:     common.IntPtr(request.ShardID),
-	})
+	if container.PayloadStore != nil {
+		err = container.PayloadStore.DeleteHistory(ctx, container.HistoryV2Manager, request.DomainID, request.BranchToken, request.ShardID)
+	}
+	if err == nil {
+		err = container.HistoryV2Manager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			BranchToken: request.BranchToken,
+			ShardID:     common.IntPtr(request.ShardID),
+		})
+	}
 	if err == nil {
 		return nil
 	}
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -60,6 +61,7 @@ type (
 		DomainCache      cache.DomainCache
 		Config           *Config
 		ArchiverProvider provider.ArchiverProvider
+		PayloadStore     *payload.Store
 	}
 
 	// Config for ClientWorker
@@ -68,6 +70,7 @@ type (
 		ArchivalsPerIteration           dynamicconfig.IntPropertyFn
 		TimeLimitPerArchivalIteration   dynamicconfig.DurationPropertyFn
 		AllowArchivingIncompleteHistory dynamicconfig.BoolPropertyFn
+		PayloadOffloadThreshold         dynamicconfig.IntPropertyFnWithDomainFilter
 	}
 
 	contextKey int
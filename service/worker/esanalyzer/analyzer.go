@@ -46,19 +46,18 @@ import (
 )
 
 type (
-	// Analyzer is the background sub-system to query ElasticSearch and execute mitigations
+	// Analyzer is the background sub-system to query visibility and execute mitigations
 	Analyzer struct {
-		svcClient           workflowserviceclient.Interface
-		frontendClient      frontend.Client
-		clientBean          client.Bean
-		esClient            es.GenericClient
-		logger              log.Logger
-		scopedMetricClient  metrics.Scope
-		tallyScope          tally.Scope
-		visibilityIndexName string
-		resource            resource.Resource
-		domainCache         cache.DomainCache
-		config              *Config
+		svcClient          workflowserviceclient.Interface
+		frontendClient     frontend.Client
+		clientBean         client.Bean
+		querier            workflowQuerier
+		logger             log.Logger
+		scopedMetricClient metrics.Scope
+		tallyScope         tally.Scope
+		resource           resource.Resource
+		domainCache        cache.DomainCache
+		config             *Config
 	}
 
 	// Config contains all configs for ElasticSearch Analyzer
@@ -79,7 +78,9 @@ type (
 
 const startUpDelay = time.Second * 10
 
-// New returns a new instance as daemon
+// New returns a new instance as daemon.
+// ElasticSearch is queried when esClient is set, otherwise the analysis falls back
+// to the basic visibility list APIs of the resource's visibility manager.
 func New(
 	svcClient workflowserviceclient.Interface,
 	frontendClient frontend.Client,
@@ -93,18 +94,24 @@ func New(
 	domainCache cache.DomainCache,
 	config *Config,
 ) *Analyzer {
+	var querier workflowQuerier
+	if esClient != nil && esConfig != nil {
+		querier = newESQuerier(esClient, esConfig.Indices[common.VisibilityAppName])
+	} else {
+		querier = newBasicQuerier(resource.GetVisibilityManager(), domainCache)
+	}
+
 	return &Analyzer{
-		svcClient:           svcClient,
-		frontendClient:      frontendClient,
-		clientBean:          clientBean,
-		esClient:            esClient,
-		logger:              logger,
-		scopedMetricClient:  getScopedMetricsClient(metricsClient),
-		tallyScope:          tallyScope,
-		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
-		resource:            resource,
-		domainCache:         domainCache,
-		config:              config,
+		svcClient:          svcClient,
+		frontendClient:     frontendClient,
+		clientBean:         clientBean,
+		querier:            querier,
+		logger:             logger,
+		scopedMetricClient: getScopedMetricsClient(metricsClient),
+		tallyScope:         tallyScope,
+		resource:           resource,
+		domainCache:        domainCache,
+		config:             config,
 	}
 }
 
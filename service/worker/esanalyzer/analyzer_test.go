@@ -139,7 +139,7 @@ func (s *esanalyzerWorkflowTestSuite) SetupTest() {
 		domainCache:        s.mockDomainCache,
 		logger:             s.logger,
 		scopedMetricClient: getScopedMetricsClient(s.mockMetricClient),
-		esClient:           s.mockESClient,
+		querier:            newESQuerier(s.mockESClient, "test-index"),
 		config:             &s.config,
 	}
 	s.activityEnv.SetTestTimeout(time.Second * 5)
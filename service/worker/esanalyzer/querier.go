@@ -0,0 +1,429 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package esanalyzer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// basicVisibilityPageSize is the page size used when listing basic visibility records
+	basicVisibilityPageSize = 1000
+	// basicVisibilityMaxScanned bounds the number of records read from basic visibility
+	// for a single domain or workflow type, since there is no server side aggregation
+	basicVisibilityMaxScanned = 10000
+)
+
+type (
+	// workflowQuerier abstracts the visibility queries the analyzer relies on,
+	// so that the analysis can run on top of both advanced and basic visibility stores
+	workflowQuerier interface {
+		// getWorkflowTypes returns the per domain workflow types which closed after startTime,
+		// along with their number of workflows and average execution time.
+		// An empty domainIDs means all domains are considered.
+		getWorkflowTypes(
+			ctx context.Context,
+			startTime int64,
+			domainIDs []string,
+			maxNumDomains int,
+			maxNumWorkflowTypes int,
+		) ([]WorkflowTypeInfo, error)
+		// findOpenWorkflows returns open workflows of the given type which started between
+		// minStartTime and maxStartTime, up to maxNumWorkflows, as well as the total number of matches
+		findOpenWorkflows(
+			ctx context.Context,
+			domainID string,
+			workflowType string,
+			minStartTime int64,
+			maxStartTime int64,
+			maxNumWorkflows int,
+		) ([]WorkflowInfo, int64, error)
+	}
+
+	esQuerier struct {
+		esClient            elasticsearch.GenericClient
+		visibilityIndexName string
+	}
+
+	basicQuerier struct {
+		visibilityManager persistence.VisibilityManager
+		domainCache       cache.DomainCache
+	}
+)
+
+var _ workflowQuerier = (*esQuerier)(nil)
+var _ workflowQuerier = (*basicQuerier)(nil)
+
+func newESQuerier(esClient elasticsearch.GenericClient, visibilityIndexName string) workflowQuerier {
+	return &esQuerier{
+		esClient:            esClient,
+		visibilityIndexName: visibilityIndexName,
+	}
+}
+
+func newBasicQuerier(visibilityManager persistence.VisibilityManager, domainCache cache.DomainCache) workflowQuerier {
+	return &basicQuerier{
+		visibilityManager: visibilityManager,
+		domainCache:       domainCache,
+	}
+}
+
+func (q *esQuerier) getWorkflowTypes(
+	ctx context.Context,
+	startTime int64,
+	domainIDs []string,
+	maxNumDomains int,
+	maxNumWorkflowTypes int,
+) ([]WorkflowTypeInfo, error) {
+	query, err := getWorkflowTypesQuery(startTime, domainIDs, maxNumDomains, maxNumWorkflowTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := q.esClient.SearchRaw(ctx, q.visibilityIndexName, query)
+	if err != nil {
+		return nil, err
+	}
+	agg, foundAggregation := response.Aggregations[domainsAggKey]
+	if !foundAggregation {
+		return nil, types.InternalServiceError{
+			Message: fmt.Sprintf("ElasticSearch error: aggeration failed. Query: %v", query),
+		}
+	}
+
+	var domains struct {
+		Buckets []DomainInfo `json:"buckets"`
+	}
+	err = json.Unmarshal(agg, &domains)
+	if err != nil {
+		return nil, types.InternalServiceError{
+			Message: "ElasticSearch error parsing aggeration",
+		}
+	}
+
+	return normalizeDomainInfos(domains.Buckets), nil
+}
+
+func (q *esQuerier) findOpenWorkflows(
+	ctx context.Context,
+	domainID string,
+	workflowType string,
+	minStartTime int64,
+	maxStartTime int64,
+	maxNumWorkflows int,
+) ([]WorkflowInfo, int64, error) {
+	query, err := getFindOpenWorkflowsQuery(minStartTime, maxStartTime, domainID, workflowType, maxNumWorkflows)
+	if err != nil {
+		return nil, 0, err
+	}
+	response, err := q.esClient.SearchRaw(ctx, q.visibilityIndexName, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	workflows := []WorkflowInfo{}
+	for _, hit := range response.Hits.Hits {
+		workflows = append(workflows, WorkflowInfo{
+			DomainID:   hit.DomainID,
+			WorkflowID: hit.WorkflowID,
+			RunID:      hit.RunID,
+		})
+	}
+	return workflows, response.Hits.TotalHits, nil
+}
+
+func (q *basicQuerier) getWorkflowTypes(
+	ctx context.Context,
+	startTime int64,
+	domainIDs []string,
+	maxNumDomains int,
+	maxNumWorkflowTypes int,
+) ([]WorkflowTypeInfo, error) {
+	if len(domainIDs) == 0 {
+		for domainID := range q.domainCache.GetAllDomain() {
+			domainIDs = append(domainIDs, domainID)
+		}
+		// make the domain selection deterministic when the number of domains is limited
+		sort.Strings(domainIDs)
+	}
+	if len(domainIDs) > maxNumDomains {
+		domainIDs = domainIDs[:maxNumDomains]
+	}
+
+	results := []WorkflowTypeInfo{}
+	for _, domainID := range domainIDs {
+		domainEntry, err := q.domainCache.GetDomainByID(domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		typeInfos, err := q.getDomainWorkflowTypes(ctx, domainID, domainEntry.GetInfo().Name, startTime)
+		if err != nil {
+			return nil, err
+		}
+		if len(typeInfos) > maxNumWorkflowTypes {
+			typeInfos = typeInfos[:maxNumWorkflowTypes]
+		}
+		results = append(results, typeInfos...)
+	}
+	return results, nil
+}
+
+// getDomainWorkflowTypes samples closed workflows of a domain and aggregates them by workflow type,
+// ordered by the number of workflows like the ElasticSearch terms aggregation
+func (q *basicQuerier) getDomainWorkflowTypes(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+	startTime int64,
+) ([]WorkflowTypeInfo, error) {
+	infos := map[string]*WorkflowTypeInfo{}
+	totalDurations := map[string]int64{}
+
+	request := &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:   domainID,
+		Domain:       domainName,
+		EarliestTime: startTime,
+		LatestTime:   time.Now().UnixNano(),
+		PageSize:     basicVisibilityPageSize,
+	}
+	numScanned := 0
+	for numScanned < basicVisibilityMaxScanned {
+		response, err := q.visibilityManager.ListClosedWorkflowExecutions(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range response.Executions {
+			numScanned++
+			if execution.Type == nil || execution.StartTime == nil || execution.CloseTime == nil {
+				continue
+			}
+			wfType := execution.Type.GetName()
+			info, ok := infos[wfType]
+			if !ok {
+				info = &WorkflowTypeInfo{DomainID: domainID, Name: wfType}
+				infos[wfType] = info
+			}
+			info.NumWorkflows++
+			totalDurations[wfType] += execution.GetCloseTime() - execution.GetStartTime()
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	results := make([]WorkflowTypeInfo, 0, len(infos))
+	for wfType, info := range infos {
+		info.Duration.AvgExecTimeNanoseconds = float64(totalDurations[wfType]) / float64(info.NumWorkflows)
+		results = append(results, *info)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].NumWorkflows != results[j].NumWorkflows {
+			return results[i].NumWorkflows > results[j].NumWorkflows
+		}
+		return results[i].Name < results[j].Name
+	})
+	return results, nil
+}
+
+func (q *basicQuerier) findOpenWorkflows(
+	ctx context.Context,
+	domainID string,
+	workflowType string,
+	minStartTime int64,
+	maxStartTime int64,
+	maxNumWorkflows int,
+) ([]WorkflowInfo, int64, error) {
+	domainEntry, err := q.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	request := &persistence.ListWorkflowExecutionsByTypeRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:   domainID,
+			Domain:       domainEntry.GetInfo().Name,
+			EarliestTime: minStartTime,
+			LatestTime:   maxStartTime,
+			PageSize:     basicVisibilityPageSize,
+		},
+		WorkflowTypeName: workflowType,
+	}
+
+	workflows := []WorkflowInfo{}
+	var total int64
+	for total < basicVisibilityMaxScanned {
+		response, err := q.visibilityManager.ListOpenWorkflowExecutionsByType(ctx, request)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, execution := range response.Executions {
+			total++
+			if len(workflows) < maxNumWorkflows {
+				workflows = append(workflows, WorkflowInfo{
+					DomainID:   domainID,
+					WorkflowID: execution.GetExecution().GetWorkflowID(),
+					RunID:      execution.GetExecution().GetRunID(),
+				})
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	return workflows, total, nil
+}
+
+func getFindOpenWorkflowsQuery(
+	minStartTime int64,
+	maxStartTime int64,
+	domainID string,
+	workflowType string,
+	maxNumWorkflows int,
+) (string, error) {
+	wfTypeMarshaled, err := json.Marshal(workflowType)
+	if err != nil {
+		return "", err
+	}
+	// No need to marshal domainID: it comes from domainEntry and its type is uuid
+	return fmt.Sprintf(`
+    {
+      "query": {
+          "bool": {
+              "must": [
+                  {
+                      "range" : {
+                          "StartTime" : {
+                              "gte" : "%d",
+                              "lte" : "%d"
+                          }
+                      }
+                  },
+                  {
+                      "match" : {
+                          "DomainID" : "%s"
+                      }
+                  },
+                  {
+                      "match" : {
+                          "WorkflowType" : %s
+                      }
+                  }
+              ],
+              "must_not": {
+                "exists": {
+                  "field": "CloseTime"
+                }
+              }
+          }
+      },
+      "size": %d
+    }
+    `, minStartTime, maxStartTime, domainID, string(wfTypeMarshaled), maxNumWorkflows), nil
+}
+
+func getWorkflowTypesQuery(
+	startTime int64,
+	domainIDs []string,
+	maxNumDomains int,
+	maxNumWorkflowTypes int,
+) (string, error) {
+	domainsLimitQuery := ""
+	if len(domainIDs) > 0 {
+		marshaledDomains, err := json.Marshal(domainIDs)
+		if err != nil {
+			return "", err
+		}
+		domainsLimitQuery = fmt.Sprintf(`,
+				{
+						"terms" : {
+								"DomainID" : %s
+						}
+				}
+			`, string(marshaledDomains))
+	}
+
+	return fmt.Sprintf(`
+		{
+      "query": {
+        "bool": {
+          "must": [
+            {
+              "range" : {
+                "StartTime" : {
+                  "gte" : "%d"
+                }
+              }
+            },
+            {
+              "exists": {
+                "field": "CloseTime"
+              }
+            }
+						%s
+          ]
+        }
+      },
+      "size": 0,
+      "aggs" : {
+        "%s" : {
+          "terms" : { "field" : "DomainID", "size": %d },
+          "aggs": {
+            "%s" : {
+              "terms" : { "field" : "WorkflowType", "size": %d },
+              "aggs": {
+                "duration" : {
+                  "avg" : {
+                    "script" : "(doc['CloseTime'].value - doc['StartTime'].value)"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+	`, startTime, domainsLimitQuery, domainsAggKey, maxNumDomains, wfTypesAggKey, maxNumWorkflowTypes), nil
+}
+
+func normalizeDomainInfos(infos []DomainInfo) []WorkflowTypeInfo {
+	results := []WorkflowTypeInfo{}
+	for _, domainInfo := range infos {
+		for _, wfType := range domainInfo.WFTypeContainer.WorkflowTypes {
+			results = append(results, WorkflowTypeInfo{
+				DomainID: domainInfo.DomainID,
+				Name:     wfType.Name,
+			})
+		}
+	}
+	return results
+}
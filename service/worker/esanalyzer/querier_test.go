@@ -0,0 +1,193 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package esanalyzer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type basicQuerierTestSuite struct {
+	suite.Suite
+	controller            *gomock.Controller
+	mockDomainCache       *cache.MockDomainCache
+	mockVisibilityManager *mocks.VisibilityManager
+	querier               workflowQuerier
+	domainID              string
+	domainName            string
+}
+
+func TestBasicQuerierTestSuite(t *testing.T) {
+	suite.Run(t, new(basicQuerierTestSuite))
+}
+
+func (s *basicQuerierTestSuite) SetupTest() {
+	s.domainID = "deadbeef-0123-4567-890a-bcdef0123460"
+	s.domainName = "test-domain"
+
+	s.controller = gomock.NewController(s.T())
+	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
+	s.mockVisibilityManager = &mocks.VisibilityManager{}
+	s.querier = newBasicQuerier(s.mockVisibilityManager, s.mockDomainCache)
+
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 1},
+		"",
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(domainEntry, nil).AnyTimes()
+}
+
+func (s *basicQuerierTestSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockVisibilityManager.AssertExpectations(s.T())
+}
+
+func (s *basicQuerierTestSuite) TestGetWorkflowTypes() {
+	now := time.Now()
+	s.mockVisibilityManager.On("ListClosedWorkflowExecutions", mock.Anything, mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsRequest) bool {
+			return request.DomainUUID == s.domainID && request.Domain == s.domainName && len(request.NextPageToken) == 0
+		},
+	)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			s.closedExecution("type1", now, 10*time.Second),
+			s.closedExecution("type2", now, 10*time.Second),
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	s.mockVisibilityManager.On("ListClosedWorkflowExecutions", mock.Anything, mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsRequest) bool {
+			return string(request.NextPageToken) == "token"
+		},
+	)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			s.closedExecution("type2", now, 20*time.Second),
+		},
+	}, nil).Once()
+
+	results, err := s.querier.getWorkflowTypes(context.Background(), 0, []string{s.domainID}, 10, 10)
+	s.NoError(err)
+	s.Equal([]WorkflowTypeInfo{
+		{
+			DomainID:     s.domainID,
+			Name:         "type2",
+			NumWorkflows: 2,
+			Duration:     Duration{AvgExecTimeNanoseconds: float64(15 * time.Second)},
+		},
+		{
+			DomainID:     s.domainID,
+			Name:         "type1",
+			NumWorkflows: 1,
+			Duration:     Duration{AvgExecTimeNanoseconds: float64(10 * time.Second)},
+		},
+	}, results)
+
+	s.mockVisibilityManager.On("ListClosedWorkflowExecutions", mock.Anything, mock.Anything).
+		Return(&persistence.ListWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{
+				s.closedExecution("type1", now, 10*time.Second),
+				s.closedExecution("type2", now, 10*time.Second),
+				s.closedExecution("type2", now, 20*time.Second),
+			},
+		}, nil).Once()
+	results, err = s.querier.getWorkflowTypes(context.Background(), 0, []string{s.domainID}, 10, 1)
+	s.NoError(err)
+	s.Equal(1, len(results))
+	s.Equal("type2", results[0].Name)
+}
+
+func (s *basicQuerierTestSuite) TestGetWorkflowTypesAllDomains() {
+	s.mockDomainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		s.domainID:                             nil,
+		"ffffffff-0123-4567-890a-bcdef0123460": nil,
+	}).Times(1)
+	s.mockVisibilityManager.On("ListClosedWorkflowExecutions", mock.Anything, mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsRequest) bool {
+			return request.DomainUUID == s.domainID
+		},
+	)).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+
+	results, err := s.querier.getWorkflowTypes(context.Background(), 0, nil, 1, 10)
+	s.NoError(err)
+	s.Empty(results)
+}
+
+func (s *basicQuerierTestSuite) TestFindOpenWorkflows() {
+	minStartTime := int64(100)
+	maxStartTime := int64(200)
+	s.mockVisibilityManager.On("ListOpenWorkflowExecutionsByType", mock.Anything, mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+			return request.DomainUUID == s.domainID &&
+				request.WorkflowTypeName == "type1" &&
+				request.EarliestTime == minStartTime &&
+				request.LatestTime == maxStartTime &&
+				len(request.NextPageToken) == 0
+		},
+	)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}},
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	s.mockVisibilityManager.On("ListOpenWorkflowExecutionsByType", mock.Anything, mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+			return string(request.NextPageToken) == "token"
+		},
+	)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"}},
+		},
+	}, nil).Once()
+
+	workflows, total, err := s.querier.findOpenWorkflows(context.Background(), s.domainID, "type1", minStartTime, maxStartTime, 2)
+	s.NoError(err)
+	s.Equal(int64(3), total)
+	s.Equal([]WorkflowInfo{
+		{DomainID: s.domainID, WorkflowID: "wid1", RunID: "rid1"},
+		{DomainID: s.domainID, WorkflowID: "wid2", RunID: "rid2"},
+	}, workflows)
+}
+
+func (s *basicQuerierTestSuite) closedExecution(
+	workflowType string,
+	closeTime time.Time,
+	duration time.Duration,
+) *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		Type:      &types.WorkflowType{Name: workflowType},
+		StartTime: common.Int64Ptr(closeTime.Add(-duration).UnixNano()),
+		CloseTime: common.Int64Ptr(closeTime.UnixNano()),
+	}
+}
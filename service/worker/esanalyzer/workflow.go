@@ -33,7 +33,6 @@ import (
 	"go.uber.org/cadence/workflow"
 	"go.uber.org/zap"
 
-	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 )
@@ -151,7 +150,7 @@ func initWorkflow(a *Analyzer) {
 		activity.RegisterOptions{Name: getLongRunCheckEntriesActivity})
 }
 
-// workflowFunc queries ElasticSearch to detect issues and mitigates them
+// workflowFunc queries visibility to detect issues and mitigates them
 func (w *Workflow) workflowFunc(ctx workflow.Context) error {
 	if w.analyzer.config.ESAnalyzerPause() {
 		logger := workflow.GetLogger(ctx)
@@ -230,52 +229,6 @@ func (w *Workflow) workflowFunc(ctx workflow.Context) error {
 	return err
 }
 
-func getLongRunningWorkflowsQuery(
-	maxWorkflowStartTime int64,
-	domainID string,
-	workflowType string,
-	maxNumWorkflows int,
-) (string, error) {
-	wfTypeMarshaled, err := json.Marshal(workflowType)
-	if err != nil {
-		return "", err
-	}
-	// No need to marshal domainID: it comes from domainEntry and its type is uuid
-	return fmt.Sprintf(`
-    {
-      "query": {
-          "bool": {
-              "must": [
-                  {
-                      "range" : {
-                          "StartTime" : {
-                              "lte" : "%d"
-                          }
-                      }
-                  },
-                  {
-                      "match" : {
-                          "DomainID" : "%s"
-                      }
-                  },
-                  {
-                      "match" : {
-                          "WorkflowType" : %s
-                      }
-                  }
-              ],
-              "must_not": {
-                "exists": {
-                  "field": "CloseTime"
-                }
-              }
-          }
-      },
-      "size": %d
-    }
-    `, maxWorkflowStartTime, domainID, string(wfTypeMarshaled), maxNumWorkflows), nil
-}
-
 func (w *Workflow) getLongRunCheckEntries(ctx context.Context) ([]LongRunCheckEntry, error) {
 	logger := activity.GetLogger(ctx)
 
@@ -344,20 +297,24 @@ func (w *Workflow) findLongRunningWorkflows(ctx context.Context, entry LongRunCh
 			maxNumWorkflows = w.analyzer.config.ESAnalyzerNumWorkflowsToRefresh(entry.DomainName, entry.WorkflowType)
 		}
 	}
-	query, err := getLongRunningWorkflowsQuery(maxWorkflowStartTime, domainID, entry.WorkflowType, maxNumWorkflows)
-	if err != nil {
-		return nil, err
-	}
-	response, err := w.analyzer.esClient.SearchRaw(ctx, w.analyzer.visibilityIndexName, query)
+	workflows, totalHits, err := w.analyzer.querier.findOpenWorkflows(
+		ctx,
+		domainID,
+		entry.WorkflowType,
+		0,
+		maxWorkflowStartTime,
+		maxNumWorkflows,
+	)
 	if err != nil {
-		logger.Error("Failed to query ElasticSearch for stuck workflows",
+		logger.Error("Failed to query visibility for long running workflows",
 			zap.Error(err),
-			zap.String("VisibilityQuery", query),
+			zap.String("DomainName", entry.DomainName),
+			zap.String("WorkflowType", entry.WorkflowType),
 		)
 		return nil, err
 	}
 
-	if response.Hits.TotalHits > 0 {
+	if totalHits > 0 {
 		logger.Warn("Slow running workflows detected",
 			zap.String("DomainName", entry.DomainName),
 			zap.String("WorkflowType", entry.WorkflowType))
@@ -369,9 +326,8 @@ func (w *Workflow) findLongRunningWorkflows(ctx context.Context, entry LongRunCh
 	)
 	tagged.AddCounter(
 		metrics.ESAnalyzerNumLongRunningWorkflows,
-		response.Hits.TotalHits)
+		totalHits)
 
-	workflows := w.ESResponseToWorkflowInfo(response)
 	return workflows, nil
 }
 
@@ -432,68 +388,6 @@ func (w *Workflow) refreshStuckWorkflowsFromSameWorkflowType(
 	return nil
 }
 
-func getFindStuckWorkflowsQuery(
-	startDateTime int64,
-	endTime int64,
-	domainID string,
-	workflowType string,
-	maxNumWorkflows int,
-) (string, error) {
-	wfTypeMarshaled, err := json.Marshal(workflowType)
-	if err != nil {
-		return "", err
-	}
-	// No need to marshal domainID: it comes from domainEntry and its type is uuid
-	return fmt.Sprintf(`
-    {
-      "query": {
-          "bool": {
-              "must": [
-                  {
-                      "range" : {
-                          "StartTime" : {
-                              "gte" : "%d",
-                              "lte" : "%d"
-                          }
-                      }
-                  },
-                  {
-                      "match" : {
-                          "DomainID" : "%s"
-                      }
-                  },
-                  {
-                      "match" : {
-                          "WorkflowType" : %s
-                      }
-                  }
-              ],
-              "must_not": {
-                "exists": {
-                  "field": "CloseTime"
-                }
-              }
-          }
-      },
-      "size": %d
-    }
-    `, startDateTime, endTime, domainID, string(wfTypeMarshaled), maxNumWorkflows), nil
-}
-
-func (w *Workflow) ESResponseToWorkflowInfo(response *elasticsearch.RawResponse) []WorkflowInfo {
-	workflows := []WorkflowInfo{}
-	if response.Hits.Hits != nil {
-		for _, hit := range response.Hits.Hits {
-			workflows = append(workflows, WorkflowInfo{
-				DomainID:   hit.DomainID,
-				WorkflowID: hit.WorkflowID,
-				RunID:      hit.RunID,
-			})
-		}
-	}
-	return workflows
-}
-
 // findStuckWorkflows is activity to find open workflows that are live significantly longer than average
 func (w *Workflow) findStuckWorkflows(ctx context.Context, info WorkflowTypeInfo) ([]WorkflowInfo, error) {
 	logger := activity.GetLogger(ctx)
@@ -532,9 +426,16 @@ func (w *Workflow) findStuckWorkflows(ctx context.Context, info WorkflowTypeInfo
 	}
 
 	maxNumWorkflows := w.analyzer.config.ESAnalyzerNumWorkflowsToRefresh(domainName, info.Name)
-	query, err := getFindStuckWorkflowsQuery(startDateTime, endTime, info.DomainID, info.Name, maxNumWorkflows)
+	workflows, _, err := w.analyzer.querier.findOpenWorkflows(
+		ctx,
+		info.DomainID,
+		info.Name,
+		startDateTime,
+		endTime,
+		maxNumWorkflows,
+	)
 	if err != nil {
-		logger.Error("Failed to create ElasticSearch query for stuck workflows",
+		logger.Error("Failed to query visibility for stuck workflows",
 			zap.Error(err),
 			zap.Int64("startDateTime", startDateTime),
 			zap.Int64("endTime", endTime),
@@ -543,17 +444,6 @@ func (w *Workflow) findStuckWorkflows(ctx context.Context, info WorkflowTypeInfo
 		)
 		return nil, err
 	}
-	response, err := w.analyzer.esClient.SearchRaw(ctx, w.analyzer.visibilityIndexName, query)
-	if err != nil {
-		logger.Error("Failed to query ElasticSearch for stuck workflows",
-			zap.Error(err),
-			zap.String("VisibilityQuery", query),
-		)
-		return nil, err
-	}
-
-	// Return a simpler structure to reduce activity output size
-	workflows := w.ESResponseToWorkflowInfo(response)
 
 	if len(workflows) > 0 {
 		w.analyzer.scopedMetricClient.AddCounter(
@@ -564,92 +454,26 @@ func (w *Workflow) findStuckWorkflows(ctx context.Context, info WorkflowTypeInfo
 	return workflows, nil
 }
 
-func (w *Workflow) getDomainsLimitQuery() (string, error) {
+func (w *Workflow) getLimitToDomainIDs() ([]string, error) {
 	limitToDomains := w.analyzer.config.ESAnalyzerLimitToDomains()
-
-	domainsLimitQuery := ""
-	if len(limitToDomains) > 0 {
-		var domainNames []string
-		err := json.Unmarshal([]byte(limitToDomains), &domainNames)
-		if err != nil {
-			return "", err
-		}
-		if len(domainNames) > 0 {
-			domainIDs := []string{}
-			for _, domainName := range domainNames {
-				domainEntry, err := w.analyzer.domainCache.GetDomain(domainName)
-				if err != nil {
-					return "", err
-				}
-				domainIDs = append(domainIDs, domainEntry.GetInfo().ID)
-			}
-
-			marshaledDomains, err := json.Marshal(domainIDs)
-			if err != nil {
-				return "", err
-			}
-			domainsLimitQuery = fmt.Sprintf(`,
-				{
-						"terms" : {
-								"DomainID" : %s
-						}
-				}
-			`, string(marshaledDomains))
-		}
+	if len(limitToDomains) == 0 {
+		return nil, nil
 	}
-	return domainsLimitQuery, nil
-}
 
-func (w *Workflow) getWorkflowTypesQuery() (string, error) {
-	domainsLimitQuery, err := w.getDomainsLimitQuery()
+	var domainNames []string
+	err := json.Unmarshal([]byte(limitToDomains), &domainNames)
 	if err != nil {
-		return "", nil
+		return nil, err
 	}
-	startDateTime := time.Now().Add(-w.analyzer.config.ESAnalyzerTimeWindow()).UnixNano()
-	maxNumDomains := w.analyzer.config.ESAnalyzerMaxNumDomains()
-	maxNumWorkflowTypes := w.analyzer.config.ESAnalyzerMaxNumWorkflowTypes()
-
-	return fmt.Sprintf(`
-		{
-      "query": {
-        "bool": {
-          "must": [
-            {
-              "range" : {
-                "StartTime" : {
-                  "gte" : "%d"
-                }
-              }
-            },
-            {
-              "exists": {
-                "field": "CloseTime"
-              }
-            }
-						%s
-          ]
-        }
-      },
-      "size": 0,
-      "aggs" : {
-        "%s" : {
-          "terms" : { "field" : "DomainID", "size": %d },
-          "aggs": {
-            "%s" : {
-              "terms" : { "field" : "WorkflowType", "size": %d },
-              "aggs": {
-                "duration" : {
-                  "avg" : {
-                    "script" : "(doc['CloseTime'].value - doc['StartTime'].value)"
-                  }
-                }
-              }
-            }
-          }
-        }
-      }
-    }
-	`, startDateTime, domainsLimitQuery, domainsAggKey, maxNumDomains, wfTypesAggKey, maxNumWorkflowTypes), nil
+	domainIDs := []string{}
+	for _, domainName := range domainNames {
+		domainEntry, err := w.analyzer.domainCache.GetDomain(domainName)
+		if err != nil {
+			return nil, err
+		}
+		domainIDs = append(domainIDs, domainEntry.GetInfo().ID)
+	}
+	return domainIDs, nil
 }
 
 func (w *Workflow) getWorkflowTypesFromDynamicConfig(
@@ -697,20 +521,7 @@ func (w *Workflow) getWorkflowTypesFromDynamicConfig(
 
 }
 
-func normalizeDomainInfos(infos []DomainInfo) []WorkflowTypeInfo {
-	results := []WorkflowTypeInfo{}
-	for _, domainInfo := range infos {
-		for _, wfType := range domainInfo.WFTypeContainer.WorkflowTypes {
-			results = append(results, WorkflowTypeInfo{
-				DomainID: domainInfo.DomainID,
-				Name:     wfType.Name,
-			})
-		}
-	}
-	return results
-}
-
-// getWorkflowTypes is activity to get workflow type list from ElasticSearch
+// getWorkflowTypes is activity to get workflow type list from visibility
 func (w *Workflow) getWorkflowTypes(ctx context.Context) ([]WorkflowTypeInfo, error) {
 	logger := activity.GetLogger(ctx)
 
@@ -724,39 +535,27 @@ func (w *Workflow) getWorkflowTypes(ctx context.Context) ([]WorkflowTypeInfo, er
 		return w.getWorkflowTypesFromDynamicConfig(ctx, limitToTypes, logger)
 	}
 
-	query, err := w.getWorkflowTypesQuery()
+	domainIDs, err := w.getLimitToDomainIDs()
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := w.analyzer.esClient.SearchRaw(ctx, w.analyzer.visibilityIndexName, query)
+	startDateTime := time.Now().Add(-w.analyzer.config.ESAnalyzerTimeWindow()).UnixNano()
+	results, err := w.analyzer.querier.getWorkflowTypes(
+		ctx,
+		startDateTime,
+		domainIDs,
+		w.analyzer.config.ESAnalyzerMaxNumDomains(),
+		w.analyzer.config.ESAnalyzerMaxNumWorkflowTypes(),
+	)
 	if err != nil {
-		logger.Error("Failed to query ElasticSearch to find workflow type info",
-			zap.Error(err),
-			zap.String("VisibilityQuery", query),
-		)
+		logger.Error("Failed to query visibility to find workflow type info", zap.Error(err))
 		return nil, err
 	}
-	agg, foundAggregation := response.Aggregations[domainsAggKey]
-	if !foundAggregation {
-		return nil, types.InternalServiceError{
-			Message: fmt.Sprintf("ElasticSearch error: aggeration failed. Query: %v", query),
-		}
-	}
-
-	var domains struct {
-		Buckets []DomainInfo `json:"buckets"`
-	}
-	err = json.Unmarshal(agg, &domains)
-	if err != nil {
-		return nil, types.InternalServiceError{
-			Message: "ElasticSearch error parsing aggeration",
-		}
-	}
 
 	// This log is supposed to be fired at max once an hour; it's not invasive and can help
 	// get some workflow statistics. Size can be quite big though; not sure what the limit is.
-	logger.Info(fmt.Sprintf("WorkflowType stats: %#v", domains.Buckets))
+	logger.Info(fmt.Sprintf("WorkflowType stats: %#v", results))
 
-	return normalizeDomainInfos(domains.Buckets), nil
+	return results, nil
 }
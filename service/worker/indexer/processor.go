@@ -267,6 +267,8 @@ func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[s
 		case indexer.FieldTypeBinary:
 			if k == definition.Memo {
 				doc[k] = v.GetBinaryData()
+			} else if definition.IsSystemIndexedKey(k) { // search attributes maintained by Cadence
+				doc[k] = p.decodeSearchAttrBinary(v.GetBinaryData(), k)
 			} else { // custom search attributes
 				attr[k] = p.decodeSearchAttrBinary(v.GetBinaryData(), k)
 			}
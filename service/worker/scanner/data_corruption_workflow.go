@@ -183,7 +183,7 @@ func getDefaultDAO(
 	if err != nil {
 		return nil, err
 	}
-	pr := persistence.NewPersistenceRetryer(execManager, res.GetHistoryManager(), c.CreatePersistenceRetryPolicy())
+	pr := persistence.NewPersistenceRetryer(execManager, res.GetHistoryManager(), res.GetShardManager(), c.CreatePersistenceRetryPolicy())
 	return pr, nil
 }
 
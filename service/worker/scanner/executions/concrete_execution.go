@@ -133,7 +133,12 @@ func FixerManager(_ context.Context, pr persistence.Retryer, _ shardscanner.FixS
 	var ivs []invariant.Invariant
 	var collections []invariant.Collection
 
-	collections = append(collections, invariant.CollectionHistory, invariant.CollectionMutableState)
+	collections = append(
+		collections,
+		invariant.CollectionHistory,
+		invariant.CollectionMutableState,
+		invariant.CollectionPendingInfo,
+	)
 
 	for _, fn := range ConcreteExecutionType.ToInvariants(collections) {
 		ivs = append(ivs, fn(pr))
@@ -151,6 +156,9 @@ func ConcreteExecutionConfig(ctx shardscanner.Context) shardscanner.CustomScanne
 	if ctx.Config.DynamicCollection.GetBoolProperty(dynamicconfig.ConcreteExecutionsScannerInvariantCollectionMutableState, true)() {
 		res[invariant.CollectionMutableState.String()] = strconv.FormatBool(true)
 	}
+	if ctx.Config.DynamicCollection.GetBoolProperty(dynamicconfig.ConcreteExecutionsScannerInvariantCollectionPendingInfo, false)() {
+		res[invariant.CollectionPendingInfo.String()] = strconv.FormatBool(true)
+	}
 
 	return res
 }
@@ -101,6 +101,8 @@ func (st ScanType) ToInvariants(collections []invariant.Collection) []InvariantF
 				fns = append(fns, invariant.NewHistoryExists)
 			case invariant.CollectionMutableState:
 				fns = append(fns, invariant.NewOpenCurrentExecution)
+			case invariant.CollectionPendingInfo:
+				fns = append(fns, invariant.NewPendingInfoValid)
 			}
 		}
 		return fns
@@ -27,6 +27,13 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/reconciliation/scanresults"
+	"github.com/uber/cadence/service/worker/scanner/executions"
+	"github.com/uber/cadence/service/worker/scanner/shardscanner"
+	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scanner/visibility"
 )
 
 type scannerTestSuite struct {
@@ -46,3 +53,23 @@ func (s *scannerTestSuite) SetupTest() {
 func (s *scannerTestSuite) TearDownTest() {
 	s.mockCtrl.Finish()
 }
+
+func (s *scannerTestSuite) TestScanResultsTypesMatchScannerConfigs() {
+	configs := map[string]func(*dynamicconfig.Collection) *shardscanner.ScannerConfig{
+		"concrete_execution": executions.ConcreteExecutionScannerConfig,
+		"current_execution":  executions.CurrentExecutionScannerConfig,
+		"timers":             timers.ScannerConfig,
+		"visibility":         visibility.ScannerConfig,
+	}
+	s.Len(scanresults.ScanTypeNames(), len(configs))
+	for name, config := range configs {
+		scanType, ok := scanresults.GetScanType(name)
+		s.True(ok, name)
+		scannerConfig := config(dynamicconfig.NewNopCollection())
+		s.Equal(scannerConfig.StartWorkflowOptions.ID, scanType.ScannerWorkflowID, name)
+		s.Equal(scannerConfig.StartFixerOptions.ID, scanType.FixerWorkflowID, name)
+		s.Equal(scannerConfig.StartFixerOptions.TaskList, scanType.FixerTaskList, name)
+		s.Equal(scannerConfig.StartFixerOptions.ExecutionStartToCloseTimeout, scanType.FixerExecutionStartToClose, name)
+		s.Equal(scannerConfig.FixerWFTypeName, scanType.FixerWorkflowType, name)
+	}
+}
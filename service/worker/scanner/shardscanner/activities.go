@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/scanresults"
 	"github.com/uber/cadence/common/reconciliation/store"
 )
 
@@ -51,7 +52,7 @@ const (
 	// ActivityFixShard is the activity name for FixShardActivity
 	ActivityFixShard = "cadence-sys-shardscanner-fixshard-activity"
 	// ShardCorruptKeysQuery is the query name for the query used to get all completed shards with at least one corruption
-	ShardCorruptKeysQuery = "shard_corrupt_keys"
+	ShardCorruptKeysQuery = scanresults.ShardCorruptKeysQuery
 )
 
 // ScannerConfigActivity will read dynamic config, apply overwrites and return a resolved config.
@@ -170,7 +171,7 @@ func scanShard(
 		return nil, err
 	}
 
-	pr := persistence.NewPersistenceRetryer(execManager, resources.GetHistoryManager(), c.CreatePersistenceRetryPolicy())
+	pr := persistence.NewPersistenceRetryer(execManager, resources.GetHistoryManager(), resources.GetShardManager(), c.CreatePersistenceRetryPolicy())
 
 	scanner := NewScanner(
 		shardID,
@@ -363,7 +364,7 @@ func fixShard(
 		return nil, err
 	}
 
-	pr := persistence.NewPersistenceRetryer(execManager, resource.GetHistoryManager(), c.CreatePersistenceRetryPolicy())
+	pr := persistence.NewPersistenceRetryer(execManager, resource.GetHistoryManager(), resource.GetShardManager(), c.CreatePersistenceRetryPolicy())
 
 	fixer := NewFixer(
 		activityCtx,
@@ -27,42 +27,51 @@ import (
 	"sort"
 
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/scanresults"
 	"github.com/uber/cadence/common/reconciliation/store"
 )
 
 const (
-
 	// ShardStatusSuccess indicates the scan on the shard ran successfully
-	ShardStatusSuccess ShardStatus = "success"
+	ShardStatusSuccess = scanresults.ShardStatusSuccess
 	// ShardStatusControlFlowFailure indicates the scan on the shard failed
-	ShardStatusControlFlowFailure ShardStatus = "control_flow_failure"
+	ShardStatusControlFlowFailure = scanresults.ShardStatusControlFlowFailure
 	// ShardStatusRunning indicates the shard has not completed yet
-	ShardStatusRunning ShardStatus = "running"
+	ShardStatusRunning = scanresults.ShardStatusRunning
 
 	maxShardQueryResult = 1000
 )
 
 type (
 	// ShardStatus is the type which indicates the status of a shard scan.
-	ShardStatus string
-
-	// ShardStatusResult indicates the status for all shards
-	ShardStatusResult map[int]ShardStatus
+	ShardStatus = scanresults.ShardStatus
 
 	// ShardStatusSummaryResult indicates the counts of shards in each status
-	ShardStatusSummaryResult map[ShardStatus]int
+	ShardStatusSummaryResult = scanresults.ShardStatusSummaryResult
 
 	// AggregateScanReportResult indicates the result of summing together all
 	// shard reports which have finished scan.
-	AggregateScanReportResult ScanStats
+	AggregateScanReportResult = scanresults.AggregateScanReportResult
+
+	// ShardCorruptKeysResult is a map of all shards which have finished scan successfully and have at least one corruption
+	ShardCorruptKeysResult = scanresults.ShardCorruptKeysResult
+
+	// PaginatedShardQueryRequest is the request used for queries which return results over all shards
+	PaginatedShardQueryRequest = scanresults.PaginatedShardQueryRequest
+
+	// ShardQueryPaginationToken is used to return information used to make the next query
+	ShardQueryPaginationToken = scanresults.ShardQueryPaginationToken
+
+	// ShardCorruptKeysQueryResult is the query result for ShardCorruptKeysQuery
+	ShardCorruptKeysQueryResult = scanresults.ShardCorruptKeysQueryResult
+
+	// ShardStatusResult indicates the status for all shards
+	ShardStatusResult map[int]ShardStatus
 
 	// AggregateFixReportResult indicates the result of summing together all
 	// shard reports that have finished for fix.
 	AggregateFixReportResult FixStats
 
-	// ShardCorruptKeysResult is a map of all shards which have finished scan successfully and have at least one corruption
-	ShardCorruptKeysResult map[int]store.Keys
-
 	// ScanReportError is a type that is used to send either error or report on a channel.
 	// Exactly one of Report and ErrorStr should be non-nil.
 	ScanReportError struct {
@@ -112,37 +121,12 @@ type (
 		EndIndex   int
 	}
 
-	// PaginatedShardQueryRequest is the request used for queries which return results over all shards
-	PaginatedShardQueryRequest struct {
-		// StartingShardID is the first shard to start iteration from.
-		// Setting to nil will start iteration from the beginning of the shards.
-		StartingShardID *int
-		// LimitShards indicates the maximum number of results that can be returned.
-		// If nil or larger than allowed maximum, will default to maximum allowed.
-		LimitShards *int
-	}
-
-	// ShardQueryPaginationToken is used to return information used to make the next query
-	ShardQueryPaginationToken struct {
-		// NextShardID is one greater than the highest shard returned in the current query.
-		// NextShardID is nil if IsDone is true.
-		// It is possible to get NextShardID != nil and on the next call to get an empty result with IsDone = true.
-		NextShardID *int
-		IsDone      bool
-	}
-
 	// ShardStatusQueryResult is the query result for ShardStatusQuery
 	ShardStatusQueryResult struct {
 		Result                    ShardStatusResult
 		ShardQueryPaginationToken ShardQueryPaginationToken
 	}
 
-	// ShardCorruptKeysQueryResult is the query result for ShardCorruptKeysQuery
-	ShardCorruptKeysQueryResult struct {
-		Result                    ShardCorruptKeysResult
-		ShardQueryPaginationToken ShardQueryPaginationToken
-	}
-
 	// ShardSizeQueryResult is the result from ShardSizeQuery.
 	// Contains sorted list of shards, sorted by the number of executions per shard.
 	ShardSizeQueryResult []ShardSizeTuple
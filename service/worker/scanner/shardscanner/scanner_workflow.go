@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/pagination"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/scanresults"
 )
 
 const (
@@ -39,9 +40,9 @@ const (
 	// ShardStatusQuery is the query name for the query used to get the status of all shards
 	ShardStatusQuery = "shard_status"
 	// ShardStatusSummaryQuery is the query name for the query used to get the shard status -> counts map
-	ShardStatusSummaryQuery = "shard_status_summary"
+	ShardStatusSummaryQuery = scanresults.ShardStatusSummaryQuery
 	// AggregateReportQuery is the query name for the query used to get the aggregate result of all finished shards
-	AggregateReportQuery = "aggregate_report"
+	AggregateReportQuery = scanresults.AggregateReportQuery
 	// ShardSizeQuery is the query name for the query used to get the number of executions per shard in sorted order
 	ShardSizeQuery = "shard_size"
 	// DomainReportQuery is the query name for the query used to get the reports per domains for all finished shards
@@ -429,6 +429,59 @@ func (s *workflowsSuite) TestGetCorruptedKeys_Success() {
 	}, result)
 }
 
+func (s *workflowsSuite) TestGetCorruptedKeys_FilterShards() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(shardscanner.ActivityFixerCorruptedKeys, mock.Anything, shardscanner.FixerCorruptedKeysActivityParams{
+		ScannerWorkflowWorkflowID: "test_wid",
+		ScannerWorkflowRunID:      "test_rid",
+		StartingShardID:           nil,
+	}).Return(&shardscanner.FixerCorruptedKeysActivityResult{
+		CorruptedKeys: []shardscanner.CorruptedKeysEntry{{ShardID: 1}, {ShardID: 5}, {ShardID: 10}},
+		MinShard:      common.IntPtr(1),
+		MaxShard:      common.IntPtr(10),
+		ShardQueryPaginationToken: shardscanner.ShardQueryPaginationToken{
+			NextShardID: common.IntPtr(11),
+			IsDone:      false,
+		},
+	}, nil)
+	env.OnActivity(shardscanner.ActivityFixerCorruptedKeys, mock.Anything, shardscanner.FixerCorruptedKeysActivityParams{
+		ScannerWorkflowWorkflowID: "test_wid",
+		ScannerWorkflowRunID:      "test_rid",
+		StartingShardID:           common.IntPtr(11),
+	}).Return(&shardscanner.FixerCorruptedKeysActivityResult{
+		CorruptedKeys: []shardscanner.CorruptedKeysEntry{{ShardID: 11}, {ShardID: 12}},
+		MinShard:      common.IntPtr(11),
+		MaxShard:      common.IntPtr(12),
+		ShardQueryPaginationToken: shardscanner.ShardQueryPaginationToken{
+			NextShardID: nil,
+			IsDone:      true,
+		},
+	}, nil)
+
+	env.ExecuteWorkflow(shardscanner.GetCorruptedKeys, shardscanner.FixerWorkflowParams{
+		ScannerWorkflowWorkflowID: "test_wid",
+		ScannerWorkflowRunID:      "test_rid",
+		ShardIDs:                  []int{5, 10, 12, 30},
+	})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result *shardscanner.FixerCorruptedKeysActivityResult
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(&shardscanner.FixerCorruptedKeysActivityResult{
+		CorruptedKeys: []shardscanner.CorruptedKeysEntry{
+			{ShardID: 5},
+			{ShardID: 10},
+			{ShardID: 12},
+		},
+		MinShard: common.IntPtr(5),
+		MaxShard: common.IntPtr(12),
+		ShardQueryPaginationToken: shardscanner.ShardQueryPaginationToken{
+			NextShardID: nil,
+			IsDone:      true,
+		},
+	}, result)
+}
+
 func (s *workflowsSuite) TestGetCorruptedKeys_Error() {
 	env := s.NewTestWorkflowEnvironment()
 	env.OnActivity(shardscanner.ActivityFixerCorruptedKeys, mock.Anything, shardscanner.FixerCorruptedKeysActivityParams{
@@ -37,7 +37,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
-	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/scanresults"
 	"github.com/uber/cadence/common/reconciliation/store"
 	"github.com/uber/cadence/common/resource"
 )
@@ -113,11 +113,7 @@ type (
 	}
 
 	// FixerWorkflowParams are the parameters to the fix workflow
-	FixerWorkflowParams struct {
-		ScannerWorkflowWorkflowID     string
-		ScannerWorkflowRunID          string
-		FixerWorkflowConfigOverwrites FixerWorkflowConfigOverwrites
-	}
+	FixerWorkflowParams = scanresults.FixerWorkflowParams
 
 	// ScanReport is the report of running Scan on a single shard.
 	ScanReport struct {
@@ -140,12 +136,7 @@ type (
 	}
 
 	// ScanStats indicates the stats of entities which were handled by shard Scan.
-	ScanStats struct {
-		EntitiesCount    int64
-		CorruptedCount   int64
-		CheckFailedCount int64
-		CorruptionByType map[invariant.Name]int64
-	}
+	ScanStats = scanresults.ScanStats
 
 	// ScanResult indicates the result of running scan on a shard.
 	// Exactly one of ControlFlowFailure or ScanKeys will be non-nil
@@ -277,11 +268,7 @@ type (
 	// FixerWorkflowConfigOverwrites enables overwriting the default values.
 	// If provided workflow will favor overwrites over defaults.
 	// Any overwrites that are left as nil will fall back to defaults.
-	FixerWorkflowConfigOverwrites struct {
-		Concurrency             *int
-		BlobstoreFlushThreshold *int
-		ActivityBatchSize       *int
-	}
+	FixerWorkflowConfigOverwrites = scanresults.FixerWorkflowConfigOverwrites
 
 	// ResolvedFixerWorkflowConfig is the resolved config after reading defaults and applying overwrites.
 	ResolvedFixerWorkflowConfig struct {
@@ -346,6 +333,9 @@ func GetCorruptedKeys(
 		}
 
 		fixerCorruptedKeysActivityParams.StartingShardID = corruptedKeysResult.ShardQueryPaginationToken.NextShardID
+		if len(params.ShardIDs) > 0 {
+			corruptedKeysResult = filterCorruptedKeys(corruptedKeysResult, params.ShardIDs)
+		}
 		if len(corruptedKeysResult.CorruptedKeys) == 0 {
 			continue
 		}
@@ -368,6 +358,35 @@ func GetCorruptedKeys(
 	}, nil
 }
 
+// filterCorruptedKeys keeps only the corrupted keys of the given shards and recomputes the shard bounds
+func filterCorruptedKeys(
+	result *FixerCorruptedKeysActivityResult,
+	shardIDs []int,
+) *FixerCorruptedKeysActivityResult {
+	allowed := make(map[int]struct{}, len(shardIDs))
+	for _, shardID := range shardIDs {
+		allowed[shardID] = struct{}{}
+	}
+
+	filtered := &FixerCorruptedKeysActivityResult{
+		ShardQueryPaginationToken: result.ShardQueryPaginationToken,
+	}
+	for _, entry := range result.CorruptedKeys {
+		if _, ok := allowed[entry.ShardID]; !ok {
+			continue
+		}
+		filtered.CorruptedKeys = append(filtered.CorruptedKeys, entry)
+		shardID := entry.ShardID
+		if filtered.MinShard == nil || *filtered.MinShard > shardID {
+			filtered.MinShard = &shardID
+		}
+		if filtered.MaxShard == nil || *filtered.MaxShard < shardID {
+			filtered.MaxShard = &shardID
+		}
+	}
+	return filtered
+}
+
 func getBatchIndices(
 	batchSize int,
 	concurrency int,
@@ -0,0 +1,185 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/store"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/scanner/shardscanner"
+)
+
+const (
+	// ScannerWFTypeName defines workflow type name for visibility scanner
+	ScannerWFTypeName   = "cadence-sys-visibility-scanner-workflow"
+	wfid                = "cadence-sys-visibility-scanner"
+	scannerTaskListName = "cadence-sys-visibility-scanner-tasklist-0"
+
+	// FixerWFTypeName defines workflow type name for visibility fixer
+	FixerWFTypeName   = "cadence-sys-visibility-fixer-workflow"
+	fixerTaskListName = "cadence-sys-visibility-fixer-tasklist-0"
+	fixerwfid         = "cadence-sys-visibility-fixer"
+)
+
+// ScannerWorkflow starts visibility scanner.
+func ScannerWorkflow(
+	ctx workflow.Context,
+	params shardscanner.ScannerWorkflowParams,
+) error {
+	wf, err := shardscanner.NewScannerWorkflow(ctx, ScannerWFTypeName, params)
+	if err != nil {
+		return err
+	}
+
+	return wf.Start(ctx)
+}
+
+// FixerWorkflow starts visibility fixer.
+func FixerWorkflow(
+	ctx workflow.Context,
+	params shardscanner.FixerWorkflowParams,
+) error {
+	wf, err := shardscanner.NewFixerWorkflow(ctx, FixerWFTypeName, params)
+	if err != nil {
+		return err
+	}
+
+	return wf.Start(ctx)
+}
+
+// ScannerHooks provides hooks for visibility scanner.
+func ScannerHooks() *shardscanner.ScannerHooks {
+	h, err := shardscanner.NewScannerHooks(Manager, Iterator)
+	if err != nil {
+		return nil
+	}
+
+	return h
+}
+
+// FixerHooks provides hooks needed for visibility fixer.
+func FixerHooks() *shardscanner.FixerHooks {
+	h, err := shardscanner.NewFixerHooks(FixerManager, FixerIterator)
+	if err != nil {
+		return nil
+	}
+	return h
+}
+
+// Manager provides invariant manager for visibility scanner.
+func Manager(
+	ctx context.Context,
+	pr persistence.Retryer,
+	_ shardscanner.ScanShardActivityParams,
+) invariant.Manager {
+	sc, err := shardscanner.GetScannerContext(ctx)
+	if err != nil {
+		return nil
+	}
+	return invariant.NewInvariantManager(getInvariants(pr, sc.Resource))
+}
+
+// Iterator provides iterator for visibility scanner.
+func Iterator(
+	ctx context.Context,
+	pr persistence.Retryer,
+	params shardscanner.ScanShardActivityParams,
+) pagination.Iterator {
+	return fetcher.ConcreteExecutionIterator(ctx, pr, params.PageSize)
+}
+
+// FixerIterator provides iterator for visibility fixer.
+func FixerIterator(
+	ctx context.Context,
+	client blobstore.Client,
+	keys store.Keys,
+	_ shardscanner.FixShardActivityParams,
+) store.ScanOutputIterator {
+	return store.NewBlobstoreIterator(ctx, client, keys, &entity.ConcreteExecution{})
+}
+
+// FixerManager provides invariant manager for visibility fixer.
+func FixerManager(
+	ctx context.Context,
+	pr persistence.Retryer,
+	_ shardscanner.FixShardActivityParams,
+) invariant.Manager {
+	fc, err := shardscanner.GetFixerContext(ctx)
+	if err != nil {
+		return nil
+	}
+	return invariant.NewInvariantManager(getInvariants(pr, fc.Resource))
+}
+
+// ScannerConfig configures visibility scanner
+func ScannerConfig(dc *dynamicconfig.Collection) *shardscanner.ScannerConfig {
+	return &shardscanner.ScannerConfig{
+		ScannerWFTypeName: ScannerWFTypeName,
+		FixerWFTypeName:   FixerWFTypeName,
+		DynamicParams: shardscanner.DynamicParams{
+			ScannerEnabled:          dc.GetBoolProperty(dynamicconfig.VisibilityScannerEnabled, false),
+			FixerEnabled:            dc.GetBoolProperty(dynamicconfig.VisibilityFixerEnabled, false),
+			Concurrency:             dc.GetIntProperty(dynamicconfig.VisibilityScannerConcurrency, 5),
+			PageSize:                dc.GetIntProperty(dynamicconfig.VisibilityScannerPersistencePageSize, 1000),
+			BlobstoreFlushThreshold: dc.GetIntProperty(dynamicconfig.VisibilityScannerBlobstoreFlushThreshold, 100),
+			ActivityBatchSize:       dc.GetIntProperty(dynamicconfig.VisibilityScannerActivityBatchSize, 25),
+			AllowDomain:             dc.GetBoolPropertyFilteredByDomain(dynamicconfig.VisibilityFixerDomainAllow, false),
+		},
+		DynamicCollection: dc,
+		ScannerHooks:      ScannerHooks,
+		FixerHooks:        FixerHooks,
+
+		StartWorkflowOptions: client.StartWorkflowOptions{
+			ID:                           wfid,
+			TaskList:                     scannerTaskListName,
+			ExecutionStartToCloseTimeout: 20 * 365 * 24 * time.Hour,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+			CronSchedule:                 "* * * * *",
+		},
+		StartFixerOptions: client.StartWorkflowOptions{
+			ID:                           fixerwfid,
+			TaskList:                     fixerTaskListName,
+			ExecutionStartToCloseTimeout: 20 * 365 * 24 * time.Hour,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+			CronSchedule:                 "* * * * *",
+		},
+	}
+}
+
+func getInvariants(pr persistence.Retryer, res resource.Resource) []invariant.Invariant {
+	return []invariant.Invariant{
+		invariant.NewVisibilityRecordConsistent(pr, res.GetVisibilityManager(), res.GetDomainCache()),
+	}
+}
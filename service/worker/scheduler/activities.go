@@ -0,0 +1,171 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/schedule"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	waitWorkflowPollInterval = 10 * time.Second
+)
+
+// StartWorkflowActivity starts the workflow of a scheduled run and returns its runID. The request ID is
+// derived from the activity, so that a retry of the activity is deduplicated with the start of a previous attempt
+func StartWorkflowActivity(ctx context.Context, params *StartWorkflowActivityParams) (string, error) {
+	if err := checkDomainActive(ctx, params.Domain); err != nil {
+		return "", err
+	}
+
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              params.Domain,
+		WorkflowID:                          params.WorkflowID,
+		WorkflowType:                        &types.WorkflowType{Name: params.Action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: params.Action.TaskList},
+		Input:                               params.Action.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(params.Action.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(params.Action.TaskStartToCloseTimeoutSeconds),
+		Identity:                            schedule.WorkflowTypeName,
+		RequestID:                           getStartRequestID(ctx),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyRejectDuplicate.Ptr(),
+	}
+	resp, err := getClient(ctx).StartWorkflowExecution(ctx, request)
+	if err != nil {
+		switch err := err.(type) {
+		case *types.WorkflowExecutionAlreadyStartedError:
+			if err.StartRequestID == request.RequestID {
+				// the run was started by a previous attempt of this activity
+				return err.RunID, nil
+			}
+			return "", cadence.NewCustomError(runAlreadyStartedReason, err.Error())
+		case *types.BadRequestError, *types.EntityNotExistsError:
+			return "", cadence.NewCustomError(nonRetriableReason, err.Error())
+		}
+		return "", err
+	}
+	return resp.GetRunID(), nil
+}
+
+// CancelWorkflowActivity requests cancellation of the workflow of a scheduled run
+func CancelWorkflowActivity(ctx context.Context, params *WorkflowActivityParams) error {
+	err := getClient(ctx).RequestCancelWorkflowExecution(ctx, &types.RequestCancelWorkflowExecutionRequest{
+		Domain: params.Domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: params.WorkflowID,
+			RunID:      params.RunID,
+		},
+		Identity:  schedule.WorkflowTypeName,
+		RequestID: uuid.New().String(),
+	})
+	switch err.(type) {
+	case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.CancellationAlreadyRequestedError:
+		return nil
+	}
+	return err
+}
+
+// IsWorkflowRunningActivity returns whether the workflow of a scheduled run is still running
+func IsWorkflowRunningActivity(ctx context.Context, params *WorkflowActivityParams) (bool, error) {
+	return isWorkflowRunning(ctx, getClient(ctx), params)
+}
+
+// WaitWorkflowCompletionActivity blocks until the workflow of a scheduled run is closed
+func WaitWorkflowCompletionActivity(ctx context.Context, params *WorkflowActivityParams) error {
+	client := getClient(ctx)
+	for {
+		running, err := isWorkflowRunning(ctx, client, params)
+		if err != nil {
+			return err
+		}
+		if !running {
+			return nil
+		}
+		activity.RecordHeartbeat(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitWorkflowPollInterval):
+		}
+	}
+}
+
+func isWorkflowRunning(ctx context.Context, client frontend.Client, params *WorkflowActivityParams) (bool, error) {
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: params.Domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: params.WorkflowID,
+			RunID:      params.RunID,
+		},
+	})
+	if err != nil {
+		// EntityNotExistsError means wf is deleted
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	return info != nil && info.CloseStatus == nil, nil
+}
+
+// checkDomainActive returns a non-retriable error if the domain is not active in the current cluster, so that
+// only the copy of the schedule in the active cluster starts the run
+func checkDomainActive(ctx context.Context, domain string) error {
+	s := ctx.Value(schedulerContextKey).(*Scheduler)
+	entry, err := s.domainCache.GetDomain(domain)
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return cadence.NewCustomError(nonRetriableReason, err.Error())
+		}
+		return err
+	}
+	if entry.IsDomainPendingActive() {
+		// retried until the failover of the domain completes
+		return fmt.Errorf("domain %v is pending active", domain)
+	}
+	if active, _ := entry.IsActiveIn(s.clusterMetadata.GetCurrentClusterName()); !active {
+		return cadence.NewCustomError(domainNotActiveReason, fmt.Sprintf("domain %v is not active in the current cluster", domain))
+	}
+	return nil
+}
+
+func getStartRequestID(ctx context.Context) string {
+	info := activity.GetInfo(ctx)
+	name := fmt.Sprintf("%v/%v", info.WorkflowExecution.RunID, info.ActivityID)
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)).String()
+}
+
+func getClient(ctx context.Context) frontend.Client {
+	s := ctx.Value(schedulerContextKey).(*Scheduler)
+	return s.clientBean.GetFrontendClient()
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/schedule"
+	"github.com/uber/cadence/common/types"
+)
+
+type scheduleActivitiesTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	controller   *gomock.Controller
+	mockResource *resource.Test
+	activityEnv  *testsuite.TestActivityEnvironment
+}
+
+func TestScheduleActivitiesTestSuite(t *testing.T) {
+	suite.Run(t, new(scheduleActivitiesTestSuite))
+}
+
+func (s *scheduleActivitiesTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockResource = resource.NewTest(s.controller, metrics.Worker)
+
+	ctx := &Scheduler{
+		svcClient:       s.mockResource.GetSDKClient(),
+		clientBean:      s.mockResource.ClientBean,
+		domainCache:     s.mockResource.DomainCache,
+		clusterMetadata: s.mockResource.ClusterMetadata,
+	}
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), schedulerContextKey, ctx),
+	})
+	s.activityEnv.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.activityEnv.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+	s.activityEnv.RegisterActivityWithOptions(IsWorkflowRunningActivity, activity.RegisterOptions{Name: isWorkflowRunningActivityName})
+}
+
+func (s *scheduleActivitiesTestSuite) TearDownTest() {
+	s.mockResource.Finish(s.T())
+	s.controller.Finish()
+}
+
+func (s *scheduleActivitiesTestSuite) TestStartWorkflowActivity() {
+	s.mockResource.DomainCache.EXPECT().GetDomain("d").Return(newLocalDomainCacheEntry("d"), nil)
+	params := &StartWorkflowActivityParams{
+		Domain:     "d",
+		WorkflowID: "s-2021-06-01T00:01:00Z",
+		Action: schedule.Action{
+			WorkflowType:                        "wt",
+			TaskList:                            "tl",
+			Input:                               []byte("input"),
+			ExecutionStartToCloseTimeoutSeconds: 10,
+			TaskStartToCloseTimeoutSeconds:      5,
+		},
+	}
+	s.mockResource.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+			s.Equal("d", request.Domain)
+			s.Equal(params.WorkflowID, request.WorkflowID)
+			s.Equal("wt", request.WorkflowType.Name)
+			s.Equal("tl", request.TaskList.Name)
+			s.Equal([]byte("input"), request.Input)
+			s.Equal(common.Int32Ptr(10), request.ExecutionStartToCloseTimeoutSeconds)
+			s.Equal(common.Int32Ptr(5), request.TaskStartToCloseTimeoutSeconds)
+			s.Equal(types.WorkflowIDReusePolicyRejectDuplicate.Ptr(), request.WorkflowIDReusePolicy)
+			s.NotEmpty(request.RequestID)
+			return &types.StartWorkflowExecutionResponse{RunID: "rid"}, nil
+		})
+
+	result, err := s.activityEnv.ExecuteActivity(startWorkflowActivityName, params)
+	s.NoError(err)
+	var runID string
+	s.NoError(result.Get(&runID))
+	s.Equal("rid", runID)
+}
+
+func (s *scheduleActivitiesTestSuite) TestStartWorkflowActivity_AlreadyStarted() {
+	s.mockResource.DomainCache.EXPECT().GetDomain(gomock.Any()).Return(newLocalDomainCacheEntry("d"), nil).Times(2)
+	// started by a previous attempt of the activity
+	var requestID string
+	s.mockResource.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+			requestID = request.RequestID
+			return nil, &types.WorkflowExecutionAlreadyStartedError{StartRequestID: request.RequestID, RunID: "rid"}
+		})
+
+	result, err := s.activityEnv.ExecuteActivity(startWorkflowActivityName, &StartWorkflowActivityParams{})
+	s.NoError(err)
+	var runID string
+	s.NoError(result.Get(&runID))
+	s.Equal("rid", runID)
+
+	// started by another request
+	s.mockResource.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+			s.Equal(requestID, request.RequestID)
+			return nil, &types.WorkflowExecutionAlreadyStartedError{StartRequestID: "another request", RunID: "rid"}
+		})
+
+	_, err = s.activityEnv.ExecuteActivity(startWorkflowActivityName, &StartWorkflowActivityParams{})
+	s.Error(err)
+	customErr, ok := err.(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(runAlreadyStartedReason, customErr.Reason())
+}
+
+func (s *scheduleActivitiesTestSuite) TestStartWorkflowActivity_BadRequest() {
+	s.mockResource.DomainCache.EXPECT().GetDomain(gomock.Any()).Return(newLocalDomainCacheEntry("d"), nil)
+	s.mockResource.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, &types.BadRequestError{Message: "bad request"})
+
+	_, err := s.activityEnv.ExecuteActivity(startWorkflowActivityName, &StartWorkflowActivityParams{})
+	s.Error(err)
+	customErr, ok := err.(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(nonRetriableReason, customErr.Reason())
+}
+
+func (s *scheduleActivitiesTestSuite) TestStartWorkflowActivity_DomainNotActive() {
+	entry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "did", Name: "d"},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		common.EmptyVersion,
+	)
+	s.mockResource.DomainCache.EXPECT().GetDomain("d").Return(entry, nil)
+
+	_, err := s.activityEnv.ExecuteActivity(startWorkflowActivityName, &StartWorkflowActivityParams{Domain: "d"})
+	s.Error(err)
+	customErr, ok := err.(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(domainNotActiveReason, customErr.Reason())
+}
+
+func (s *scheduleActivitiesTestSuite) TestStartWorkflowActivity_DomainNotExists() {
+	s.mockResource.DomainCache.EXPECT().GetDomain("d").Return(nil, &types.EntityNotExistsError{})
+
+	_, err := s.activityEnv.ExecuteActivity(startWorkflowActivityName, &StartWorkflowActivityParams{Domain: "d"})
+	s.Error(err)
+	customErr, ok := err.(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(nonRetriableReason, customErr.Reason())
+}
+
+func (s *scheduleActivitiesTestSuite) TestCancelWorkflowActivity() {
+	s.mockResource.FrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.WorkflowExecutionAlreadyCompletedError{})
+
+	_, err := s.activityEnv.ExecuteActivity(cancelWorkflowActivityName, &WorkflowActivityParams{Domain: "d", WorkflowID: "wid", RunID: "rid"})
+	s.NoError(err)
+}
+
+func (s *scheduleActivitiesTestSuite) TestIsWorkflowRunningActivity() {
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+		}, nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+				CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+			},
+		}, nil)
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, &types.EntityNotExistsError{})
+
+	for _, expected := range []bool{true, false, false} {
+		result, err := s.activityEnv.ExecuteActivity(isWorkflowRunningActivityName, &WorkflowActivityParams{Domain: "d", WorkflowID: "wid", RunID: "rid"})
+		s.NoError(err)
+		var running bool
+		s.NoError(result.Get(&running))
+		s.Equal(expected, running)
+	}
+}
+
+func newLocalDomainCacheEntry(domain string) *cache.DomainCacheEntry {
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domain + "-id", Name: domain},
+		&persistence.DomainConfig{},
+		cluster.TestCurrentClusterName,
+	)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/schedule"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the scheduler sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+		// DomainCache is used to check whether the domain of a schedule is active in the current cluster
+		DomainCache cache.DomainCache
+		// ClusterMetadata is the metadata of the current cluster
+		ClusterMetadata cluster.Metadata
+	}
+
+	// Scheduler is the background sub-system that hosts the schedule workflows.
+	// It is also the context object that gets passed around within the schedule activities.
+	// Every cluster of a global domain hosts a copy of the schedules of the domain, only the copy
+	// in the cluster where the domain is active starts the scheduled runs
+	Scheduler struct {
+		svcClient       workflowserviceclient.Interface
+		clientBean      client.Bean
+		domainCache     cache.DomainCache
+		clusterMetadata cluster.Metadata
+		metricsClient   metrics.Client
+		tallyScope      tally.Scope
+		logger          log.Logger
+		worker          worker.Worker
+	}
+)
+
+// New returns a new instance of Scheduler
+func New(params *BootstrapParams) *Scheduler {
+	return &Scheduler{
+		svcClient:       params.ServiceClient,
+		metricsClient:   params.MetricsClient,
+		tallyScope:      params.TallyScope,
+		logger:          params.Logger.WithTags(tag.ComponentScheduler),
+		clientBean:      params.ClientBean,
+		domainCache:     params.DomainCache,
+		clusterMetadata: params.ClusterMetadata,
+	}
+}
+
+// Start starts the worker
+func (s *Scheduler) Start() error {
+	ctx := context.WithValue(context.Background(), schedulerContextKey, s)
+	workerOpts := worker.Options{
+		MetricsScope:              s.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	scheduleWorker := worker.New(s.svcClient, common.SystemLocalDomainName, schedule.TaskListName, workerOpts)
+	scheduleWorker.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: schedule.WorkflowTypeName})
+	scheduleWorker.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	scheduleWorker.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+	scheduleWorker.RegisterActivityWithOptions(IsWorkflowRunningActivity, activity.RegisterOptions{Name: isWorkflowRunningActivityName})
+	scheduleWorker.RegisterActivityWithOptions(WaitWorkflowCompletionActivity, activity.RegisterOptions{Name: waitWorkflowCompletionActivityName})
+	s.worker = scheduleWorker
+	return scheduleWorker.Start()
+}
+
+// Stop stops the worker
+func (s *Scheduler) Stop() {
+	s.worker.Stop()
+}
@@ -0,0 +1,514 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common/schedule"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	schedulerContextKey contextKey = "schedulerContext"
+
+	startWorkflowActivityName          = "cadence-sys-schedule-startWorkflow-activity"
+	cancelWorkflowActivityName         = "cadence-sys-schedule-cancelWorkflow-activity"
+	isWorkflowRunningActivityName      = "cadence-sys-schedule-isWorkflowRunning-activity"
+	waitWorkflowCompletionActivityName = "cadence-sys-schedule-waitWorkflowCompletion-activity"
+
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+
+	maxRecentRuns       = 10
+	numUpcomingRuns     = 5
+	maxIterationsPerRun = 500
+	nonRetriableReason  = "non-retriable-error"
+	// runAlreadyStartedReason is the reason of the error returned when the workflow of a scheduled run
+	// was started by another request, so the run is skipped
+	runAlreadyStartedReason = "run-already-started"
+	// domainNotActiveReason is the reason of the error returned when the domain of a schedule is not active
+	// in the current cluster, the run is started by the copy of the schedule in the active cluster
+	domainNotActiveReason = "domain-not-active"
+)
+
+type (
+	// StartWorkflowActivityParams params for StartWorkflowActivity
+	StartWorkflowActivityParams struct {
+		Domain     string
+		WorkflowID string
+		Action     schedule.Action
+	}
+
+	// WorkflowActivityParams params for the activities operating on a started workflow
+	WorkflowActivityParams struct {
+		Domain     string
+		WorkflowID string
+		RunID      string
+	}
+
+	scheduler struct {
+		ctx    workflow.Context
+		logger *zap.Logger
+		params *schedule.Params
+
+		jitterScheduledTime time.Time
+		jitter              time.Duration
+		waitFuture          workflow.Future
+		cancelWait          workflow.CancelFunc
+
+		upcomingRunsKey *upcomingRunsKey
+		upcomingRuns    []time.Time
+	}
+
+	upcomingRunsKey struct {
+		spec  schedule.Spec
+		after time.Time
+	}
+
+	// runTimesResult is the result of the side effect that computes scheduled times
+	runTimesResult struct {
+		Times []time.Time
+		Error string
+	}
+)
+
+// ScheduleWorkflow is the workflow that starts workflows according to a schedule
+func ScheduleWorkflow(ctx workflow.Context, params *schedule.Params) error {
+	if err := validateParams(ctx, params); err != nil {
+		return err
+	}
+	schedule.SetDefaultParams(params)
+
+	s := &scheduler{
+		ctx:    ctx,
+		logger: workflow.GetLogger(ctx),
+		params: params,
+	}
+	if err := workflow.SetQueryHandler(ctx, schedule.QueryType, func() (*schedule.Description, error) {
+		return s.describe(), nil
+	}); err != nil {
+		return err
+	}
+	if params.State.LastProcessedTime.IsZero() {
+		params.State.LastProcessedTime = workflow.Now(ctx)
+	}
+
+	updateCh := workflow.GetSignalChannel(ctx, schedule.UpdateSignal)
+	pauseCh := workflow.GetSignalChannel(ctx, schedule.PauseSignal)
+	unpauseCh := workflow.GetSignalChannel(ctx, schedule.UnpauseSignal)
+	backfillCh := workflow.GetSignalChannel(ctx, schedule.BackfillSignal)
+
+	for iterations := 0; iterations < maxIterationsPerRun; iterations++ {
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(updateCh, func(c workflow.Channel, more bool) {
+			var update schedule.UpdateParams
+			c.Receive(ctx, &update)
+			s.update(update)
+		})
+		selector.AddReceive(pauseCh, func(c workflow.Channel, more bool) {
+			var pause schedule.PauseParams
+			c.Receive(ctx, &pause)
+			params.Paused = true
+			params.PauseReason = pause.Reason
+		})
+		selector.AddReceive(unpauseCh, func(c workflow.Channel, more bool) {
+			c.Receive(ctx, nil)
+			if params.Paused {
+				// runs scheduled while the schedule was paused are not caught up
+				params.Paused = false
+				params.PauseReason = ""
+				params.State.LastProcessedTime = workflow.Now(ctx)
+			}
+		})
+		selector.AddReceive(backfillCh, func(c workflow.Channel, more bool) {
+			var backfill schedule.BackfillParams
+			c.Receive(ctx, &backfill)
+			iterations += s.backfill(backfill)
+		})
+
+		if !params.Paused {
+			if scheduledTime := s.getNextRunTime(); !scheduledTime.IsZero() {
+				delay := scheduledTime.Sub(workflow.Now(ctx)) + s.getJitter(scheduledTime)
+				if delay < 0 {
+					delay = 0
+				}
+				selector.AddFuture(workflow.NewTimer(timerCtx, delay), func(f workflow.Future) {
+					if err := f.Get(ctx, nil); err != nil {
+						// timer is canceled
+						return
+					}
+					s.takeAction(scheduledTime, params.Policies.OverlapPolicy)
+					params.State.LastProcessedTime = scheduledTime
+				})
+			}
+		}
+
+		if s.waitFuture == nil && len(params.State.BufferedRuns) > 0 && params.State.LastRun != nil {
+			var waitCtx workflow.Context
+			waitCtx, s.cancelWait = workflow.WithCancel(ctx)
+			waitCtx = workflow.WithActivityOptions(waitCtx, getWaitActivityOptions())
+			s.waitFuture = workflow.ExecuteActivity(waitCtx, waitWorkflowCompletionActivityName, s.lastRunParams())
+		}
+		if s.waitFuture != nil {
+			selector.AddFuture(s.waitFuture, func(f workflow.Future) {
+				s.waitFuture = nil
+				s.cancelWait = nil
+				if len(params.State.BufferedRuns) == 0 {
+					return
+				}
+				scheduledTime := params.State.BufferedRuns[0]
+				params.State.BufferedRuns = params.State.BufferedRuns[1:]
+				s.startRun(scheduledTime)
+			})
+		}
+
+		selector.AddReceive(ctx.Done(), func(c workflow.Channel, more bool) {})
+
+		selector.Select(ctx)
+		cancelTimer()
+		if ctx.Err() != nil {
+			if s.cancelWait != nil {
+				s.cancelWait()
+			}
+			return ctx.Err()
+		}
+	}
+
+	// drain signals received in the current decision so that they are not lost on continue as new
+	for {
+		var update schedule.UpdateParams
+		var pause schedule.PauseParams
+		var backfill schedule.BackfillParams
+		switch {
+		case updateCh.ReceiveAsync(&update):
+			s.update(update)
+		case pauseCh.ReceiveAsync(&pause):
+			params.Paused = true
+			params.PauseReason = pause.Reason
+		case unpauseCh.ReceiveAsync(nil):
+			if params.Paused {
+				params.Paused = false
+				params.PauseReason = ""
+				params.State.LastProcessedTime = workflow.Now(ctx)
+			}
+		case backfillCh.ReceiveAsync(&backfill):
+			s.backfill(backfill)
+		default:
+			if s.cancelWait != nil {
+				s.cancelWait()
+			}
+			return workflow.NewContinueAsNewError(ctx, schedule.WorkflowTypeName, params)
+		}
+	}
+}
+
+func (s *scheduler) takeAction(scheduledTime time.Time, overlapPolicy string) {
+	state := &s.params.State
+	if overlapPolicy == schedule.OverlapPolicyBuffer && len(state.BufferedRuns) > 0 {
+		// keep the order of buffered runs
+		s.bufferRun(scheduledTime)
+		return
+	}
+	if state.LastRun != nil && s.isLastRunRunning() {
+		switch overlapPolicy {
+		case schedule.OverlapPolicyBuffer:
+			s.bufferRun(scheduledTime)
+			return
+		case schedule.OverlapPolicyCancelPrevious:
+			ao := workflow.WithActivityOptions(s.ctx, getActivityOptions())
+			if err := workflow.ExecuteActivity(ao, cancelWorkflowActivityName, s.lastRunParams()).Get(s.ctx, nil); err != nil {
+				s.logger.Error("Failed to cancel previous run of schedule", zap.String("WorkflowID", state.LastRun.WorkflowID), zap.Error(err))
+			}
+		default:
+			state.SkippedRuns++
+			return
+		}
+	}
+	s.startRun(scheduledTime)
+}
+
+func (s *scheduler) bufferRun(scheduledTime time.Time) {
+	state := &s.params.State
+	if len(state.BufferedRuns) >= schedule.MaxBufferedRuns {
+		state.SkippedRuns++
+		return
+	}
+	state.BufferedRuns = append(state.BufferedRuns, scheduledTime)
+}
+
+func (s *scheduler) isLastRunRunning() bool {
+	ao := workflow.WithActivityOptions(s.ctx, getActivityOptions())
+	var running bool
+	if err := workflow.ExecuteActivity(ao, isWorkflowRunningActivityName, s.lastRunParams()).Get(s.ctx, &running); err != nil {
+		// be conservative and treat the previous run as running so that runs do not overlap
+		s.logger.Error("Failed to check previous run of schedule", zap.String("WorkflowID", s.params.State.LastRun.WorkflowID), zap.Error(err))
+		return true
+	}
+	return running
+}
+
+func (s *scheduler) startRun(scheduledTime time.Time) {
+	state := &s.params.State
+	activityParams := &StartWorkflowActivityParams{
+		Domain:     s.params.Domain,
+		WorkflowID: getRunWorkflowID(s.params, scheduledTime),
+		Action:     s.params.Action,
+	}
+	ao := workflow.WithActivityOptions(s.ctx, getActivityOptions())
+	var runID string
+	if err := workflow.ExecuteActivity(ao, startWorkflowActivityName, activityParams).Get(s.ctx, &runID); err != nil {
+		if customErr, ok := err.(*cadence.CustomError); ok {
+			switch customErr.Reason() {
+			case runAlreadyStartedReason:
+				s.logger.Warn("Scheduled run was already started by another request", zap.String("WorkflowID", activityParams.WorkflowID))
+				state.SkippedRuns++
+				return
+			case domainNotActiveReason:
+				// the run is started by the copy of the schedule in the cluster where the domain is active
+				return
+			}
+		}
+		s.logger.Error("Failed to start scheduled run", zap.String("WorkflowID", activityParams.WorkflowID), zap.Error(err))
+		state.FailedRuns++
+		return
+	}
+
+	run := schedule.RunInfo{
+		ScheduledTime: scheduledTime,
+		StartTime:     workflow.Now(s.ctx),
+		WorkflowID:    activityParams.WorkflowID,
+		RunID:         runID,
+	}
+	state.LastRun = &run
+	state.RecentRuns = append(state.RecentRuns, run)
+	if len(state.RecentRuns) > maxRecentRuns {
+		state.RecentRuns = state.RecentRuns[len(state.RecentRuns)-maxRecentRuns:]
+	}
+	state.TotalRuns++
+}
+
+func (s *scheduler) update(update schedule.UpdateParams) {
+	params := &schedule.Params{
+		Domain:     s.params.Domain,
+		ScheduleID: s.params.ScheduleID,
+		Spec:       update.Spec,
+		Action:     update.Action,
+		Policies:   update.Policies,
+	}
+	if err := validateParams(s.ctx, params); err != nil {
+		s.logger.Warn("Ignored invalid schedule update", zap.Error(err))
+		return
+	}
+	schedule.SetDefaultParams(params)
+
+	s.params.Spec = params.Spec
+	s.params.Action = params.Action
+	s.params.Policies = params.Policies
+	s.params.State.LastProcessedTime = workflow.Now(s.ctx)
+	if s.params.Policies.OverlapPolicy != schedule.OverlapPolicyBuffer {
+		s.params.State.SkippedRuns += int64(len(s.params.State.BufferedRuns))
+		s.params.State.BufferedRuns = nil
+		if s.cancelWait != nil {
+			s.cancelWait()
+		}
+	}
+}
+
+func (s *scheduler) backfill(backfill schedule.BackfillParams) int {
+	overlapPolicy := backfill.OverlapPolicy
+	if overlapPolicy == "" {
+		overlapPolicy = s.params.Policies.OverlapPolicy
+	}
+	if err := schedule.ValidateOverlapPolicy(overlapPolicy); err != nil {
+		s.logger.Warn("Ignored invalid schedule backfill", zap.Error(err))
+		return 0
+	}
+
+	scheduledTimes, err := s.getRunTimes(s.params.Spec, backfill.StartTime.Add(-time.Nanosecond), backfill.EndTime, schedule.MaxBackfillRuns)
+	if err != nil {
+		s.logger.Warn("Ignored invalid schedule backfill", zap.Error(err))
+		return 0
+	}
+	for _, scheduledTime := range scheduledTimes {
+		s.takeAction(scheduledTime, overlapPolicy)
+	}
+	return len(scheduledTimes)
+}
+
+func (s *scheduler) describe() *schedule.Description {
+	params := s.params
+	desc := &schedule.Description{
+		Domain:       params.Domain,
+		ScheduleID:   params.ScheduleID,
+		Spec:         params.Spec,
+		Action:       params.Action,
+		Policies:     params.Policies,
+		Paused:       params.Paused,
+		PauseReason:  params.PauseReason,
+		LastRun:      params.State.LastRun,
+		RecentRuns:   params.State.RecentRuns,
+		BufferedRuns: len(params.State.BufferedRuns),
+		TotalRuns:    params.State.TotalRuns,
+		SkippedRuns:  params.State.SkippedRuns,
+		FailedRuns:   params.State.FailedRuns,
+	}
+	if !params.Paused {
+		// query handlers must not record side effects, the upcoming runs are computed by the workflow loop
+		desc.UpcomingRuns = s.upcomingRuns
+	}
+	return desc
+}
+
+func (s *scheduler) getNextRunTime() time.Time {
+	upcomingRuns := s.getUpcomingRuns()
+	if len(upcomingRuns) == 0 {
+		return time.Time{}
+	}
+	return upcomingRuns[0]
+}
+
+// getUpcomingRuns returns the next runs of the schedule. They are only recomputed when the spec or the progress
+// of the schedule changes, so that a side effect is not recorded on every decision
+func (s *scheduler) getUpcomingRuns() []time.Time {
+	key := upcomingRunsKey{spec: s.params.Spec, after: s.params.State.LastProcessedTime}
+	if s.upcomingRunsKey == nil || *s.upcomingRunsKey != key {
+		upcomingRuns, err := s.getRunTimes(key.spec, key.after, time.Time{}, numUpcomingRuns)
+		if err != nil {
+			s.logger.Error("Failed to get upcoming runs of schedule", zap.Error(err))
+		}
+		s.upcomingRunsKey = &key
+		s.upcomingRuns = upcomingRuns
+	}
+	return s.upcomingRuns
+}
+
+// getRunTimes computes the scheduled times in a side effect, as the time zone of the spec is loaded
+// from the tzdata of the host, which may differ between the hosts replaying the workflow
+func (s *scheduler) getRunTimes(spec schedule.Spec, after time.Time, before time.Time, maxCount int) ([]time.Time, error) {
+	var result runTimesResult
+	if err := workflow.SideEffect(s.ctx, func(ctx workflow.Context) interface{} {
+		times, err := schedule.GetRunTimes(spec, after, before, maxCount)
+		if err != nil {
+			return runTimesResult{Error: err.Error()}
+		}
+		return runTimesResult{Times: times}
+	}).Get(&result); err != nil {
+		return nil, err
+	}
+	if result.Error != "" {
+		return nil, errors.New(result.Error)
+	}
+	return result.Times, nil
+}
+
+func (s *scheduler) getJitter(scheduledTime time.Time) time.Duration {
+	maxJitter := s.params.Spec.Jitter
+	if maxJitter <= 0 {
+		return 0
+	}
+	// jitter is recorded once per scheduled time so that signals do not reshuffle it
+	if !s.jitterScheduledTime.Equal(scheduledTime) {
+		var jitter time.Duration
+		if err := workflow.SideEffect(s.ctx, func(ctx workflow.Context) interface{} {
+			return time.Duration(rand.Int63n(int64(maxJitter)))
+		}).Get(&jitter); err != nil {
+			jitter = 0
+		}
+		s.jitterScheduledTime = scheduledTime
+		s.jitter = jitter
+	}
+	return s.jitter
+}
+
+func (s *scheduler) lastRunParams() *WorkflowActivityParams {
+	return &WorkflowActivityParams{
+		Domain:     s.params.Domain,
+		WorkflowID: s.params.State.LastRun.WorkflowID,
+		RunID:      s.params.State.LastRun.RunID,
+	}
+}
+
+func getRunWorkflowID(params *schedule.Params, scheduledTime time.Time) string {
+	prefix := params.Action.WorkflowIDPrefix
+	if prefix == "" {
+		prefix = params.ScheduleID
+	}
+	return fmt.Sprintf("%v-%v", prefix, scheduledTime.UTC().Format(time.RFC3339))
+}
+
+func getActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    30 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          time.Minute,
+			ExpirationInterval:       10 * time.Minute,
+			NonRetriableErrorReasons: []string{nonRetriableReason, runAlreadyStartedReason, domainNotActiveReason},
+		},
+	}
+}
+
+func getWaitActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    InfiniteDuration,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          time.Minute,
+			ExpirationInterval:       InfiniteDuration,
+			NonRetriableErrorReasons: []string{nonRetriableReason},
+		},
+	}
+}
+
+// validateParams validates the params in a side effect, as the time zone of the spec is loaded
+// from the tzdata of the host
+func validateParams(ctx workflow.Context, params *schedule.Params) error {
+	var errMsg string
+	if err := workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		if err := schedule.ValidateParams(params); err != nil {
+			return err.Error()
+		}
+		return ""
+	}).Get(&errMsg); err != nil {
+		return err
+	}
+	if errMsg != "" {
+		return errors.New(errMsg)
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/schedule"
+)
+
+type scheduleWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+	startTime   time.Time
+}
+
+func TestScheduleWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(scheduleWorkflowTestSuite))
+}
+
+func (s *scheduleWorkflowTestSuite) SetupTest() {
+	s.startTime = time.Date(2021, 6, 1, 0, 0, 30, 0, time.UTC)
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.SetStartTime(s.startTime)
+	s.workflowEnv.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: schedule.WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(IsWorkflowRunningActivity, activity.RegisterOptions{Name: isWorkflowRunningActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(WaitWorkflowCompletionActivity, activity.RegisterOptions{Name: waitWorkflowCompletionActivityName})
+}
+
+func (s *scheduleWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, &schedule.Params{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_StartRuns() {
+	var started []string
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params *StartWorkflowActivityParams) (string, error) {
+			s.Equal("d", params.Domain)
+			s.Equal("wt", params.Action.WorkflowType)
+			started = append(started, params.WorkflowID)
+			return "rid", nil
+		})
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(false, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(3), desc.TotalRuns)
+		s.Equal(schedule.OverlapPolicySkip, desc.Policies.OverlapPolicy)
+		s.Equal("UTC", desc.Spec.TimeZone)
+		s.Len(desc.UpcomingRuns, numUpcomingRuns)
+		s.Equal(time.Date(2021, 6, 1, 0, 4, 0, 0, time.UTC), desc.UpcomingRuns[0])
+		s.workflowEnv.CancelWorkflow()
+	}, 3*time.Minute+time.Second)
+
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Equal([]string{
+		"s-2021-06-01T00:01:00Z",
+		"s-2021-06-01T00:02:00Z",
+		"s-2021-06-01T00:03:00Z",
+	}, started)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapPolicySkip() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Once()
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(true, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(1), desc.TotalRuns)
+		s.Equal(int64(2), desc.SkippedRuns)
+		s.workflowEnv.CancelWorkflow()
+	}, 3*time.Minute+time.Second)
+
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapPolicyBuffer() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Twice()
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(true, nil)
+	s.workflowEnv.OnActivity(waitWorkflowCompletionActivityName, mock.Anything, mock.Anything).After(30 * time.Second).Return(nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(1), desc.TotalRuns)
+		s.Equal(1, desc.BufferedRuns)
+	}, time.Minute+45*time.Second)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(2), desc.TotalRuns)
+		s.Equal(0, desc.BufferedRuns)
+		s.Equal(time.Date(2021, 6, 1, 0, 2, 0, 0, time.UTC), desc.LastRun.ScheduledTime)
+		s.workflowEnv.CancelWorkflow()
+	}, 2*time.Minute+15*time.Second)
+
+	params := s.newParams()
+	params.Policies.OverlapPolicy = schedule.OverlapPolicyBuffer
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapPolicyCancelPrevious() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Twice()
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(true, nil).Once()
+	s.workflowEnv.OnActivity(cancelWorkflowActivityName, mock.Anything, &WorkflowActivityParams{
+		Domain:     "d",
+		WorkflowID: "s-2021-06-01T00:01:00Z",
+		RunID:      "rid",
+	}).Return(nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(2), desc.TotalRuns)
+		s.Len(desc.RecentRuns, 2)
+		s.workflowEnv.CancelWorkflow()
+	}, 2*time.Minute+time.Second)
+
+	params := s.newParams()
+	params.Policies.OverlapPolicy = schedule.OverlapPolicyCancelPrevious
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_StartFailure() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("", cadence.NewCustomError(nonRetriableReason, "mockErr"))
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(0), desc.TotalRuns)
+		s.Equal(int64(1), desc.FailedRuns)
+		s.workflowEnv.CancelWorkflow()
+	}, time.Minute+time.Second)
+
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_StartedByAnotherRequest() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("", cadence.NewCustomError(runAlreadyStartedReason, "mockErr"))
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(0), desc.TotalRuns)
+		s.Equal(int64(1), desc.SkippedRuns)
+		s.Equal(int64(0), desc.FailedRuns)
+		s.workflowEnv.CancelWorkflow()
+	}, time.Minute+time.Second)
+
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_DomainNotActive() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("", cadence.NewCustomError(domainNotActiveReason, "mockErr"))
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Nil(desc.LastRun)
+		s.Equal(int64(0), desc.TotalRuns)
+		s.Equal(int64(0), desc.SkippedRuns)
+		s.Equal(int64(0), desc.FailedRuns)
+		s.Equal(time.Date(2021, 6, 1, 0, 3, 0, 0, time.UTC), desc.UpcomingRuns[0])
+		s.workflowEnv.CancelWorkflow()
+	}, 2*time.Minute+time.Second)
+
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_PauseAndUnpause() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(schedule.PauseSignal, schedule.PauseParams{Reason: "maintenance"})
+	}, time.Second)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Paused)
+		s.Equal("maintenance", desc.PauseReason)
+		s.Empty(desc.UpcomingRuns)
+		s.Equal(int64(0), desc.TotalRuns)
+		s.workflowEnv.SignalWorkflow(schedule.UnpauseSignal, nil)
+	}, 10*time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.False(desc.Paused)
+		s.Equal(int64(1), desc.TotalRuns)
+		s.Equal(time.Date(2021, 6, 1, 0, 11, 0, 0, time.UTC), desc.LastRun.ScheduledTime)
+		s.workflowEnv.CancelWorkflow()
+	}, 11*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Update() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params *StartWorkflowActivityParams) (string, error) {
+			s.Equal("wt2", params.Action.WorkflowType)
+			s.Equal("s-2021-06-01T01:00:00Z", params.WorkflowID)
+			return "rid", nil
+		}).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		// invalid update is ignored
+		s.workflowEnv.SignalWorkflow(schedule.UpdateSignal, schedule.UpdateParams{
+			Spec:   schedule.Spec{CronExpression: "invalid"},
+			Action: schedule.Action{WorkflowType: "wt2", TaskList: "tl"},
+		})
+		s.workflowEnv.SignalWorkflow(schedule.UpdateSignal, schedule.UpdateParams{
+			Spec:   schedule.Spec{CronExpression: "0 * * * *"},
+			Action: schedule.Action{WorkflowType: "wt2", TaskList: "tl"},
+		})
+	}, time.Second)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal("0 * * * *", desc.Spec.CronExpression)
+		s.Equal(int64(1), desc.TotalRuns)
+		s.workflowEnv.CancelWorkflow()
+	}, time.Hour)
+
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Backfill() {
+	var started []string
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params *StartWorkflowActivityParams) (string, error) {
+			started = append(started, params.WorkflowID)
+			return "rid", nil
+		})
+	s.workflowEnv.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything).Return(false, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(schedule.PauseSignal, schedule.PauseParams{})
+		s.workflowEnv.SignalWorkflow(schedule.BackfillSignal, schedule.BackfillParams{
+			StartTime: time.Date(2021, 5, 31, 0, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2021, 5, 31, 0, 3, 0, 0, time.UTC),
+		})
+	}, time.Second)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.Equal(int64(3), s.describe().TotalRuns)
+		s.workflowEnv.CancelWorkflow()
+	}, time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Equal([]string{
+		"s-2021-05-31T00:00:00Z",
+		"s-2021-05-31T00:01:00Z",
+		"s-2021-05-31T00:02:00Z",
+	}, started)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Jitter() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.Equal(int64(0), s.describe().TotalRuns)
+	}, 59*time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(1), desc.TotalRuns)
+		s.True(desc.LastRun.StartTime.Sub(desc.LastRun.ScheduledTime) < 10*time.Minute)
+		s.workflowEnv.CancelWorkflow()
+	}, 70*time.Minute)
+
+	params := s.newParams()
+	params.Spec.CronExpression = "0 * * * *"
+	params.Spec.Jitter = 10 * time.Minute
+	s.workflowEnv.ExecuteWorkflow(schedule.WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *scheduleWorkflowTestSuite) newParams() *schedule.Params {
+	return &schedule.Params{
+		Domain:     "d",
+		ScheduleID: "s",
+		Spec:       schedule.Spec{CronExpression: "* * * * *"},
+		Action:     schedule.Action{WorkflowType: "wt", TaskList: "tl"},
+	}
+}
+
+func (s *scheduleWorkflowTestSuite) describe() *schedule.Description {
+	result, err := s.workflowEnv.QueryWorkflow(schedule.QueryType)
+	s.NoError(err)
+	var desc schedule.Description
+	s.NoError(result.Get(&desc))
+	return &desc
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
@@ -50,6 +51,8 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scanner/visibility"
+	"github.com/uber/cadence/service/worker/scheduler"
 	"github.com/uber/cadence/service/worker/shadower"
 	"github.com/uber/cadence/service/worker/watchdog"
 )
@@ -81,6 +84,7 @@ type (
 		PersistenceGlobalMaxQPS             dynamicconfig.IntPropertyFn
 		PersistenceMaxQPS                   dynamicconfig.IntPropertyFn
 		EnableBatcher                       dynamicconfig.BoolPropertyFn
+		EnableScheduler                     dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker       dynamicconfig.BoolPropertyFn
 		NumParentClosePolicySystemWorkflows dynamicconfig.IntPropertyFn
 		EnableFailoverManager               dynamicconfig.BoolPropertyFn
@@ -134,6 +138,7 @@ func NewConfig(params *resource.Params) *Config {
 			ArchivalsPerIteration:           dc.GetIntProperty(dynamicconfig.WorkerArchivalsPerIteration, 1000),
 			TimeLimitPerArchivalIteration:   dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
 			AllowArchivingIncompleteHistory: dc.GetBoolProperty(dynamicconfig.AllowArchivingIncompleteHistory, false),
+			PayloadOffloadThreshold:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 0),
 		},
 		ScannerCfg: &scanner.Config{
 			ScannerPersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 5),
@@ -151,6 +156,7 @@ func NewConfig(params *resource.Params) *Config {
 				executions.ConcreteExecutionScannerConfig(dc),
 				executions.CurrentExecutionScannerConfig(dc),
 				timers.ScannerConfig(dc),
+				visibility.ScannerConfig(dc),
 			},
 			MaxWorkflowRetentionInDays: dc.GetIntProperty(dynamicconfig.MaxRetentionDays, domain.DefaultMaxWorkflowRetentionInDays),
 		},
@@ -179,6 +185,7 @@ func NewConfig(params *resource.Params) *Config {
 			CorruptWorkflowWatchdogPause: dc.GetBoolProperty(dynamicconfig.CorruptWorkflowWatchdogPause, common.DefaultCorruptWorkflowWatchdogPause),
 		},
 		EnableBatcher:                       dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
+		EnableScheduler:                     dc.GetBoolProperty(dynamicconfig.EnableScheduler, true),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicconfig.EnableESAnalyzer, false),
@@ -246,6 +253,9 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.EnableScheduler() {
+		s.startScheduler()
+	}
 	if s.config.EnableWorkflowShadower() {
 		s.ensureDomainExists(common.ShadowerLocalDomainName)
 		s.startWorkflowShadower()
@@ -404,6 +414,7 @@ func (s *Service) startArchiver() {
 		DomainCache:      s.GetDomainCache(),
 		Config:           s.config.ArchiverConfig,
 		ArchiverProvider: s.GetArchiverProvider(),
+		PayloadStore:     payload.NewStore(s.GetBlobstoreClient(), s.config.ArchiverConfig.PayloadOffloadThreshold, nil),
 	}
 	clientWorker := archiver.NewClientWorker(bc)
 	if err := clientWorker.Start(); err != nil {
@@ -427,6 +438,22 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startScheduler() {
+	params := &scheduler.BootstrapParams{
+		ServiceClient:   s.params.PublicClient,
+		MetricsClient:   s.GetMetricsClient(),
+		Logger:          s.GetLogger(),
+		TallyScope:      s.params.MetricScope,
+		ClientBean:      s.GetClientBean(),
+		DomainCache:     s.GetDomainCache(),
+		ClusterMetadata: s.GetClusterMetadata(),
+	}
+	if err := scheduler.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) startWorkflowShadower() {
 	params := &shadower.BootstrapParams{
 		ServiceClient: s.params.PublicClient,
@@ -0,0 +1,373 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shadower
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	cshared "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/.gen/go/shadower"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// DiffWorkflowName is the workflow type name of the shadow diff workflow,
+	// which replays the same executions against a baseline and a candidate worker task list
+	DiffWorkflowName = "cadence-shadow-diff-workflow"
+	// DiffReportQuery is the query type for getting the non-determinism report of a shadow diff workflow
+	DiffReportQuery = "diff_report"
+
+	defaultMaxDivergencesPerReport = 1000
+	// maxDivergenceDecisionLength caps the decisions kept for each divergence,
+	// so that the report can still be carried over continue as new
+	maxDivergenceDecisionLength = 1024
+)
+
+type (
+	// DiffWorkflowParams is the input of the shadow diff workflow
+	DiffWorkflowParams struct {
+		Domain        string
+		WorkflowQuery string
+		// BaselineTaskList is polled by workers running the currently deployed version,
+		// it is also used for scanning workflows
+		BaselineTaskList string
+		// CandidateTaskList is polled by workers running the version to be verified
+		CandidateTaskList string
+		SamplingRate      float64
+		Concurrency       int32
+		ExitCondition     *shadower.ExitCondition
+		NextPageToken     []byte
+		LastRunReport     *DiffReport
+	}
+
+	// DiffReport is the non-determinism report of the shadow diff workflow
+	DiffReport struct {
+		// Compared is the number of executions successfully replayed by the baseline workers,
+		// these are the only executions checked against the candidate workers
+		Compared int32
+		// Skipped is the number of executions skipped by either baseline or candidate workers
+		Skipped int32
+		// BaselineFailed is the number of executions which already failed to replay on the baseline workers
+		BaselineFailed int32
+		// Diverged is the number of executions which replayed on the baseline workers but failed on the candidate workers
+		Diverged int32
+		// Divergences contains details for the first diverging decision of each diverged execution,
+		// the list is capped so that the report can still be carried over continue as new
+		Divergences []Divergence
+	}
+
+	// Divergence describes the first diverging decision of a single execution.
+	// EventID, ExpectedDecision and ActualDecision are only set if the candidate worker reports
+	// the mismatch in its replay result, the diverging decision is logged by the candidate worker otherwise.
+	Divergence struct {
+		WorkflowID       string
+		RunID            string
+		WorkflowType     string
+		EventID          int64
+		ExpectedDecision string
+		ActualDecision   string
+	}
+
+	// diffReplayResult is a superset of the replay activity result,
+	// the mismatch is only populated by worker versions which are able to report the diverging decision
+	diffReplayResult struct {
+		shadower.ReplayWorkflowActivityResult
+		Mismatch *replayMismatch `json:"mismatch,omitempty"`
+	}
+
+	replayMismatch struct {
+		WorkflowType     string `json:"workflowType"`
+		EventID          int64  `json:"eventID"`
+		ExpectedDecision string `json:"expectedDecision"`
+		ActualDecision   string `json:"actualDecision"`
+	}
+)
+
+func diffWorkflow(
+	ctx workflow.Context,
+	params DiffWorkflowParams,
+) (DiffReport, error) {
+	profile := beginWorkflow(ctx, params.Domain, params.CandidateTaskList, params.LastRunReport == nil)
+
+	var config workflowConfig
+	config, err := getWorkflowConfig(ctx)
+	if err != nil {
+		return DiffReport{}, profile.endWorkflow(err)
+	}
+
+	if err := validateAndFillDiffWorkflowParams(&params, &config); err != nil {
+		return DiffReport{}, profile.endWorkflow(err)
+	}
+
+	report := DiffReport{}
+	if params.LastRunReport != nil {
+		report = *params.LastRunReport
+	}
+	if err := workflow.SetQueryHandler(ctx, DiffReportQuery, func() (DiffReport, error) {
+		return report, nil
+	}); err != nil {
+		return DiffReport{}, profile.endWorkflow(err)
+	}
+
+	workflowTimeout := time.Duration(workflow.GetInfo(ctx).ExecutionStartToCloseTimeoutSeconds) * time.Second
+	retryPolicy := &cadence.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+		ExpirationInterval: workflowTimeout, // retry until workflow timeout
+		NonRetriableErrorReasons: []string{
+			shadower.ErrReasonDomainNotExists,
+			shadower.ErrReasonInvalidQuery,
+			shadower.ErrReasonWorkflowTypeNotRegistered,
+			shadower.ErrNonRetryableType, // java non-retryable error type
+		},
+	}
+	scanWorkflowCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskList:               params.BaselineTaskList,
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            retryPolicy,
+	})
+	replayActivityOptions := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		// each replay activity only replays a single execution
+		StartToCloseTimeout: 5 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy:         retryPolicy,
+	}
+	replayActivityOptions.TaskList = params.BaselineTaskList
+	baselineCtx := workflow.WithActivityOptions(ctx, replayActivityOptions)
+	replayActivityOptions.TaskList = params.CandidateTaskList
+	candidateCtx := workflow.WithActivityOptions(ctx, replayActivityOptions)
+	describeCtx := workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: time.Minute,
+		RetryPolicy:            retryPolicy,
+	})
+
+	// processed tracks the number of executions handled in the current run
+	processed := int32(0)
+	startCompared := report.Compared
+	scanParams := shadower.ScanWorkflowActivityParams{
+		Domain:        common.StringPtr(params.Domain),
+		WorkflowQuery: common.StringPtr(params.WorkflowQuery),
+		NextPageToken: params.NextPageToken,
+		PageSize:      common.Int32Ptr(config.ScanWorkflowPageSize),
+		SamplingRate:  common.Float64Ptr(params.SamplingRate),
+	}
+	for {
+		var scanResult shadower.ScanWorkflowActivityResult
+		if err := workflow.ExecuteActivity(scanWorkflowCtx, shadower.ScanWorkflowActivityName, scanParams).Get(scanWorkflowCtx, &scanResult); err != nil {
+			return DiffReport{}, profile.endWorkflow(err)
+		}
+
+		for start := 0; start < len(scanResult.Executions); start += int(params.Concurrency) {
+			end := start + int(params.Concurrency)
+			if end > len(scanResult.Executions) {
+				end = len(scanResult.Executions)
+			}
+			batch := scanResult.Executions[start:end]
+
+			baselineFutures := make([]workflow.Future, 0, len(batch))
+			candidateFutures := make([]workflow.Future, 0, len(batch))
+			for _, execution := range batch {
+				replayParams := shadower.ReplayWorkflowActivityParams{
+					Domain:     common.StringPtr(params.Domain),
+					Executions: []*shared.WorkflowExecution{execution},
+				}
+				baselineFutures = append(baselineFutures, workflow.ExecuteActivity(baselineCtx, shadower.ReplayWorkflowActivityName, replayParams))
+				candidateFutures = append(candidateFutures, workflow.ExecuteActivity(candidateCtx, shadower.ReplayWorkflowActivityName, replayParams))
+			}
+
+			for idx, execution := range batch {
+				var baselineResult, candidateResult diffReplayResult
+				if err := baselineFutures[idx].Get(baselineCtx, &baselineResult); err != nil {
+					return DiffReport{}, profile.endWorkflow(err)
+				}
+				if err := candidateFutures[idx].Get(candidateCtx, &candidateResult); err != nil {
+					return DiffReport{}, profile.endWorkflow(err)
+				}
+				if divergence := report.add(execution, baselineResult, candidateResult); divergence != nil && divergence.WorkflowType == "" {
+					// the workflow type is only a hint for the divergence, failing to get it should not fail the workflow
+					if err := workflow.ExecuteLocalActivity(describeCtx, getWorkflowTypeLocalActivity, params.Domain, execution).Get(describeCtx, &divergence.WorkflowType); err != nil {
+						profile.logger.Warn("Failed to get workflow type of diverged execution", zap.Error(err))
+					}
+				}
+				processed++
+			}
+
+			if diffExitConditionMet(ctx, params.ExitCondition, profile.startTime, report.Compared-startCompared) {
+				return report, profile.endWorkflow(nil)
+			}
+		}
+
+		scanParams.NextPageToken = scanResult.NextPageToken
+		if len(scanParams.NextPageToken) == 0 {
+			break
+		}
+
+		if processed >= config.MaxShadowCountPerRun {
+			params.NextPageToken = scanParams.NextPageToken
+			if params.ExitCondition != nil {
+				exitCondition := *params.ExitCondition
+				if expirationInterval := exitCondition.GetExpirationIntervalInSeconds(); expirationInterval != 0 {
+					exitCondition.ExpirationIntervalInSeconds = common.Int32Ptr(expirationInterval - int32(workflow.Now(ctx).Sub(profile.startTime).Seconds()))
+				}
+				if shadowCount := exitCondition.GetShadowCount(); shadowCount != 0 {
+					exitCondition.ShadowCount = common.Int32Ptr(shadowCount - (report.Compared - startCompared))
+				}
+				params.ExitCondition = &exitCondition
+			}
+			params.LastRunReport = &report
+			return DiffReport{}, profile.endWorkflow(workflow.NewContinueAsNewError(ctx, DiffWorkflowName, params))
+		}
+	}
+
+	return report, profile.endWorkflow(nil)
+}
+
+func validateAndFillDiffWorkflowParams(
+	params *DiffWorkflowParams,
+	config *workflowConfig,
+) error {
+	if len(params.Domain) == 0 {
+		return errors.New("domain is not set on shadow diff workflow params")
+	}
+
+	if len(params.BaselineTaskList) == 0 {
+		return errors.New("BaselineTaskList is not set on shadow diff workflow params")
+	}
+
+	if len(params.CandidateTaskList) == 0 {
+		return errors.New("CandidateTaskList is not set on shadow diff workflow params")
+	}
+
+	if params.BaselineTaskList == params.CandidateTaskList {
+		return errors.New("BaselineTaskList and CandidateTaskList must be different on shadow diff workflow params")
+	}
+
+	if params.SamplingRate == 0 {
+		params.SamplingRate = config.DefaultSamplingRate
+	}
+
+	if params.Concurrency == 0 {
+		params.Concurrency = config.DefaultReplayConcurrency
+	}
+
+	if params.Concurrency > config.MaxReplayConcurrency {
+		params.Concurrency = config.MaxReplayConcurrency
+	}
+
+	return nil
+}
+
+func diffExitConditionMet(
+	ctx workflow.Context,
+	exitCondition *shadower.ExitCondition,
+	startTime time.Time,
+	compared int32,
+) bool {
+	if exitCondition == nil {
+		return false
+	}
+
+	expirationInterval := time.Duration(exitCondition.GetExpirationIntervalInSeconds()) * time.Second
+	if expirationInterval != 0 &&
+		workflow.Now(ctx).Sub(startTime) > expirationInterval {
+		return true
+	}
+
+	shadowCount := exitCondition.GetShadowCount()
+	return shadowCount != 0 && compared >= shadowCount
+}
+
+// add counts the replay results of a single execution and returns the divergence recorded for it, if any
+func (r *DiffReport) add(
+	execution *shared.WorkflowExecution,
+	baselineResult diffReplayResult,
+	candidateResult diffReplayResult,
+) *Divergence {
+	switch {
+	case baselineResult.GetSkipped() != 0 || candidateResult.GetSkipped() != 0:
+		r.Skipped++
+	case baselineResult.GetFailed() != 0:
+		// execution can't be replayed by the current version either,
+		// so it says nothing about the candidate version
+		r.BaselineFailed++
+	default:
+		r.Compared++
+		if candidateResult.GetFailed() == 0 {
+			return nil
+		}
+
+		r.Diverged++
+		if len(r.Divergences) >= defaultMaxDivergencesPerReport {
+			return nil
+		}
+		divergence := Divergence{
+			WorkflowID: execution.GetWorkflowId(),
+			RunID:      execution.GetRunId(),
+		}
+		if mismatch := candidateResult.Mismatch; mismatch != nil {
+			divergence.WorkflowType = mismatch.WorkflowType
+			divergence.EventID = mismatch.EventID
+			divergence.ExpectedDecision = truncateDecision(mismatch.ExpectedDecision)
+			divergence.ActualDecision = truncateDecision(mismatch.ActualDecision)
+		}
+		r.Divergences = append(r.Divergences, divergence)
+		return &r.Divergences[len(r.Divergences)-1]
+	}
+	return nil
+}
+
+func truncateDecision(
+	decision string,
+) string {
+	if len(decision) <= maxDivergenceDecisionLength {
+		return decision
+	}
+	return decision[:maxDivergenceDecisionLength]
+}
+
+func getWorkflowTypeLocalActivity(
+	ctx context.Context,
+	domain string,
+	execution *shared.WorkflowExecution,
+) (string, error) {
+	worker := ctx.Value(workerContextKey).(*Worker)
+	resp, err := worker.serviceClient.DescribeWorkflowExecution(ctx, &cshared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &cshared.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      execution.RunId,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetWorkflowExecutionInfo().GetType().GetName(), nil
+}
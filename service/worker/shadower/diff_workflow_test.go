@@ -0,0 +1,243 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shadower
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	cshared "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/.gen/go/shadower"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	testBaselineTaskListName  = "test-baseline-tl"
+	testCandidateTaskListName = "test-candidate-tl"
+)
+
+func (s *workflowSuite) TestDiffWorkflow_InvalidParams() {
+	s.env.ExecuteWorkflow(diffWorkflow, DiffWorkflowParams{
+		Domain:            testActiveDomainName,
+		BaselineTaskList:  testBaselineTaskListName,
+		CandidateTaskList: testBaselineTaskListName,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func (s *workflowSuite) TestDiffWorkflow_Report() {
+	numExecutions := 10
+	executions := make([]*shared.WorkflowExecution, 0, numExecutions)
+	for i := 0; i != numExecutions; i++ {
+		executions = append(executions, &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(fmt.Sprintf("wid-%d", i)),
+			RunId:      common.StringPtr(fmt.Sprintf("rid-%d", i)),
+		})
+	}
+	// replace the replay activity with one returning the superset reported by newer workers
+	s.env.RegisterActivityWithOptions(
+		testDiffReplayWorkflowActivity,
+		activity.RegisterOptions{Name: shadower.ReplayWorkflowActivityName, DisableAlreadyRegisteredCheck: true},
+	)
+	s.env.OnActivity(shadower.ScanWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		shadower.ScanWorkflowActivityResult{Executions: executions},
+		nil,
+	).Once()
+	// wid-0 fails on baseline, wid-1 is skipped, wid-2 and wid-3 diverge on candidate,
+	// only the candidate worker replaying wid-2 reports the mismatch
+	s.env.OnActivity(shadower.ReplayWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params shadower.ReplayWorkflowActivityParams) (diffReplayResult, error) {
+			s.Len(params.Executions, 1)
+			isCandidate := activity.GetInfo(ctx).TaskList == testCandidateTaskListName
+			switch workflowID := params.Executions[0].GetWorkflowId(); {
+			case workflowID == "wid-0" && !isCandidate:
+				return diffReplayResult{ReplayWorkflowActivityResult: shadower.ReplayWorkflowActivityResult{Failed: common.Int32Ptr(1)}}, nil
+			case workflowID == "wid-1":
+				return diffReplayResult{ReplayWorkflowActivityResult: shadower.ReplayWorkflowActivityResult{Skipped: common.Int32Ptr(1)}}, nil
+			case workflowID == "wid-2" && isCandidate:
+				return diffReplayResult{
+					ReplayWorkflowActivityResult: shadower.ReplayWorkflowActivityResult{Failed: common.Int32Ptr(1)},
+					Mismatch: &replayMismatch{
+						WorkflowType:     "wt-2",
+						EventID:          5,
+						ExpectedDecision: "ScheduleActivityTask",
+						ActualDecision:   "StartTimer",
+					},
+				}, nil
+			case workflowID == "wid-3" && isCandidate:
+				return diffReplayResult{ReplayWorkflowActivityResult: shadower.ReplayWorkflowActivityResult{Failed: common.Int32Ptr(1)}}, nil
+			}
+			return diffReplayResult{ReplayWorkflowActivityResult: shadower.ReplayWorkflowActivityResult{Succeeded: common.Int32Ptr(1)}}, nil
+		},
+	).Times(2 * numExecutions)
+	s.mockServiceClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *cshared.DescribeWorkflowExecutionRequest, _ ...yarpc.CallOption) (*cshared.DescribeWorkflowExecutionResponse, error) {
+			s.Equal(testActiveDomainName, request.GetDomain())
+			s.Equal("wid-3", request.GetExecution().GetWorkflowId())
+			return &cshared.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &cshared.WorkflowExecutionInfo{Type: &cshared.WorkflowType{Name: common.StringPtr("wt-3")}},
+			}, nil
+		},
+	).Times(1)
+
+	s.env.ExecuteWorkflow(diffWorkflow, DiffWorkflowParams{
+		Domain:            testActiveDomainName,
+		WorkflowQuery:     testWorkflowQuery,
+		BaselineTaskList:  testBaselineTaskListName,
+		CandidateTaskList: testCandidateTaskListName,
+		Concurrency:       3,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var report DiffReport
+	s.NoError(s.env.GetWorkflowResult(&report))
+	s.Equal(int32(numExecutions-2), report.Compared)
+	s.Equal(int32(1), report.Skipped)
+	s.Equal(int32(1), report.BaselineFailed)
+	s.Equal(int32(2), report.Diverged)
+	s.Equal([]Divergence{
+		{
+			WorkflowID:       "wid-2",
+			RunID:            "rid-2",
+			WorkflowType:     "wt-2",
+			EventID:          5,
+			ExpectedDecision: "ScheduleActivityTask",
+			ActualDecision:   "StartTimer",
+		},
+		{WorkflowID: "wid-3", RunID: "rid-3", WorkflowType: "wt-3"},
+	}, report.Divergences)
+
+	queryResult, err := s.env.QueryWorkflow(DiffReportQuery)
+	s.NoError(err)
+	var queriedReport DiffReport
+	s.NoError(queryResult.Get(&queriedReport))
+	s.Equal(report, queriedReport)
+}
+
+func (s *workflowSuite) TestDiffWorkflow_ContinueAsNew() {
+	pageSize := defaultMaxShadowCountPerRun / 2
+	s.env.OnActivity(shadower.ScanWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		shadower.ScanWorkflowActivityResult{
+			Executions:    make([]*shared.WorkflowExecution, pageSize),
+			NextPageToken: []byte{1, 2, 3},
+		},
+		nil,
+	).Times(2)
+	s.env.OnActivity(shadower.ReplayWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		shadower.ReplayWorkflowActivityResult{Succeeded: common.Int32Ptr(1)},
+		nil,
+	).Times(4 * pageSize)
+
+	lastRunReport := &DiffReport{Compared: 5, Diverged: 1, Divergences: []Divergence{{WorkflowID: "wid", RunID: "rid"}}}
+	s.env.ExecuteWorkflow(diffWorkflow, DiffWorkflowParams{
+		Domain:            testActiveDomainName,
+		WorkflowQuery:     testWorkflowQuery,
+		BaselineTaskList:  testBaselineTaskListName,
+		CandidateTaskList: testCandidateTaskListName,
+		Concurrency:       defaultMaxReplayConcurrency,
+		ExitCondition: &shadower.ExitCondition{
+			ShadowCount: common.Int32Ptr(defaultMaxShadowCountPerRun * 10),
+		},
+		LastRunReport: lastRunReport,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	continueAsNewErr, ok := s.env.GetWorkflowError().(*workflow.ContinueAsNewError)
+	s.True(ok)
+	s.Equal(DiffWorkflowName, continueAsNewErr.WorkflowType().Name)
+	params, ok := continueAsNewErr.Args()[0].(DiffWorkflowParams)
+	s.True(ok)
+	s.Equal([]byte{1, 2, 3}, params.NextPageToken)
+	s.Equal(int32(defaultMaxShadowCountPerRun*9), params.ExitCondition.GetShadowCount())
+	s.Equal(int32(defaultMaxShadowCountPerRun+5), params.LastRunReport.Compared)
+	s.Equal(int32(1), params.LastRunReport.Diverged)
+	s.Len(params.LastRunReport.Divergences, 1)
+}
+
+func (s *workflowSuite) TestDiffReport_Mismatch() {
+	var candidateResult diffReplayResult
+	s.NoError(json.Unmarshal(
+		[]byte(`{"failed":1,"mismatch":{"workflowType":"wt","eventID":5,"expectedDecision":"ScheduleActivityTask","actualDecision":"StartTimer"}}`),
+		&candidateResult,
+	))
+	baselineResult := diffReplayResult{
+		ReplayWorkflowActivityResult: shadower.ReplayWorkflowActivityResult{Succeeded: common.Int32Ptr(1)},
+	}
+
+	report := DiffReport{}
+	divergence := report.add(&shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("wid"),
+		RunId:      common.StringPtr("rid"),
+	}, baselineResult, candidateResult)
+
+	expected := Divergence{
+		WorkflowID:       "wid",
+		RunID:            "rid",
+		WorkflowType:     "wt",
+		EventID:          5,
+		ExpectedDecision: "ScheduleActivityTask",
+		ActualDecision:   "StartTimer",
+	}
+	s.Equal(&expected, divergence)
+	s.Equal(DiffReport{
+		Compared:    1,
+		Diverged:    1,
+		Divergences: []Divergence{expected},
+	}, report)
+}
+
+func (s *workflowSuite) TestDiffReport_MismatchTruncated() {
+	candidateResult := diffReplayResult{
+		ReplayWorkflowActivityResult: shadower.ReplayWorkflowActivityResult{Failed: common.Int32Ptr(1)},
+		Mismatch: &replayMismatch{
+			ExpectedDecision: strings.Repeat("e", maxDivergenceDecisionLength+1),
+			ActualDecision:   "StartTimer",
+		},
+	}
+	baselineResult := diffReplayResult{
+		ReplayWorkflowActivityResult: shadower.ReplayWorkflowActivityResult{Succeeded: common.Int32Ptr(1)},
+	}
+
+	report := DiffReport{}
+	divergence := report.add(&shared.WorkflowExecution{}, baselineResult, candidateResult)
+	s.Len(divergence.ExpectedDecision, maxDivergenceDecisionLength)
+	s.Equal("StartTimer", divergence.ActualDecision)
+}
+
+func testDiffReplayWorkflowActivity(
+	ctx context.Context,
+	params shadower.ReplayWorkflowActivityParams,
+) (diffReplayResult, error) {
+	return diffReplayResult{}, nil
+}
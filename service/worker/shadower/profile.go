@@ -26,8 +26,6 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/cadence/workflow"
 	"go.uber.org/zap"
-
-	"github.com/uber/cadence/.gen/go/shadower"
 )
 
 type (
@@ -54,17 +52,19 @@ const (
 
 func beginWorkflow(
 	ctx workflow.Context,
-	params *shadower.WorkflowParams,
+	domain string,
+	taskList string,
+	firstRun bool,
 ) *workflowProfile {
 	taggedScope := workflow.GetMetricsScope(ctx).Tagged(map[string]string{
-		tagShadowDomain:   params.GetDomain(),
-		tagShadowTaskList: params.GetTaskList(),
+		tagShadowDomain:   domain,
+		tagShadowTaskList: taskList,
 	})
 	taggedLogger := workflow.GetLogger(ctx).With(
-		zap.String(tagShadowDomain, params.GetDomain()),
-		zap.String(tagShadowTaskList, params.GetTaskList()),
+		zap.String(tagShadowDomain, domain),
+		zap.String(tagShadowTaskList, taskList),
 	)
-	if params.LastRunResult == nil {
+	if firstRun {
 		taggedScope.Counter(shadowWorkflowStarted).Inc(1)
 		taggedLogger.Info("Shadow workflow started")
 	}
@@ -44,6 +44,7 @@ type (
 	Worker struct {
 		decisionWorker worker.Worker
 		domainCache    cache.DomainCache
+		serviceClient  workflowserviceclient.Interface
 	}
 
 	contextKey string
@@ -56,7 +57,8 @@ const (
 // New creates a new worker for processing decision tasks from shadow workflow
 func New(params *BootstrapParams) *Worker {
 	w := &Worker{
-		domainCache: params.DomainCache,
+		domainCache:   params.DomainCache,
+		serviceClient: params.ServiceClient,
 	}
 	ctx := context.WithValue(context.Background(), workerContextKey, w)
 	w.decisionWorker = worker.New(
@@ -58,13 +58,17 @@ func register(worker worker.Worker) {
 		shadowWorkflow,
 		workflow.RegisterOptions{Name: shadower.WorkflowName},
 	)
+	worker.RegisterWorkflowWithOptions(
+		diffWorkflow,
+		workflow.RegisterOptions{Name: DiffWorkflowName},
+	)
 }
 
 func shadowWorkflow(
 	ctx workflow.Context,
 	params shadower.WorkflowParams,
 ) (shadower.WorkflowResult, error) {
-	profile := beginWorkflow(ctx, &params)
+	profile := beginWorkflow(ctx, params.GetDomain(), params.GetTaskList(), params.LastRunResult == nil)
 
 	var config workflowConfig
 	config, err := getWorkflowConfig(ctx)
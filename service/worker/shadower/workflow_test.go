@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/cadence"
+	"go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
 	"go.uber.org/cadence/activity"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
@@ -57,8 +58,9 @@ type workflowSuite struct {
 	suite.Suite
 	testsuite.WorkflowTestSuite
 
-	controller      *gomock.Controller
-	mockDomainCache *cache.MockDomainCache
+	controller        *gomock.Controller
+	mockDomainCache   *cache.MockDomainCache
+	mockServiceClient *workflowservicetest.MockClient
 
 	env *testsuite.TestWorkflowEnvironment
 }
@@ -73,6 +75,7 @@ func (s *workflowSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
+	s.mockServiceClient = workflowservicetest.NewMockClient(s.controller)
 
 	activeDomainCache := cache.NewGlobalDomainCacheEntryForTest(
 		&persistence.DomainInfo{ID: "random domainID", Name: testActiveDomainName},
@@ -104,7 +107,8 @@ func (s *workflowSuite) SetupTest() {
 
 	activityContext := context.Background()
 	activityContext = context.WithValue(activityContext, workerContextKey, &Worker{
-		domainCache: s.mockDomainCache,
+		domainCache:   s.mockDomainCache,
+		serviceClient: s.mockServiceClient,
 	})
 
 	s.env = s.NewTestWorkflowEnvironment()
@@ -115,6 +119,10 @@ func (s *workflowSuite) SetupTest() {
 		shadowWorkflow,
 		workflow.RegisterOptions{Name: shadower.WorkflowName},
 	)
+	s.env.RegisterWorkflowWithOptions(
+		diffWorkflow,
+		workflow.RegisterOptions{Name: DiffWorkflowName},
+	)
 	s.env.RegisterActivityWithOptions(
 		testScanWorkflowActivity,
 		activity.RegisterOptions{Name: shadower.ScanWorkflowActivityName},
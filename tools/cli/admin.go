@@ -28,6 +28,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/scanresults"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 )
 
@@ -278,7 +279,7 @@ func newAdminShardManagementCommands() []cli.Command {
 					Name: FlagTimerType,
 					Usage: "timer types: 0 - DecisionTimeoutTask, 1 - TaskTypeActivityTimeout, " +
 						"2 - TaskTypeUserTimer, 3 - TaskTypeWorkflowTimeout, 4 - TaskTypeDeleteHistoryEvent, " +
-						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer",
+						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer, 7 - TaskTypeWorkflowInactivityTimeout",
 					Value: &cli.IntSlice{-1},
 				},
 				cli.BoolFlag{
@@ -684,6 +685,202 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminListTaskList(c)
 			},
 		},
+		{
+			Name:    "backlog",
+			Aliases: []string{"b"},
+			Usage:   "Aggregate the persisted tasks of a tasklist partition by domain, workflow type and activity type",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagPartition,
+					Usage: "Optional TaskList partition, 0 is the root partition",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 1000,
+					Usage: "Number of tasks to aggregate per request, at most 1000",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxTaskCount,
+					Value: 100000,
+					Usage: "Maximum number of tasks to scan",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminTaskListBacklog(c)
+			},
+		},
+		{
+			Name:    "list-dead-letter",
+			Aliases: []string{"ldl"},
+			Usage:   "List the tasks of a tasklist partition that expired or repeatedly failed to be dispatched",
+			Flags:   getTaskListDeadLetterFlags(),
+			Action: func(c *cli.Context) {
+				AdminListDeadLetterTasks(c)
+			},
+		},
+		{
+			Name:    "requeue-dead-letter",
+			Aliases: []string{"rdl"},
+			Usage:   "Move dead-lettered tasks back to the backlog of a tasklist partition",
+			Flags:   getTaskListDeadLetterFlags(),
+			Action: func(c *cli.Context) {
+				AdminRequeueDeadLetterTasks(c)
+			},
+		},
+		{
+			Name:    "purge-dead-letter",
+			Aliases: []string{"pdl"},
+			Usage:   "Delete dead-lettered tasks of a tasklist partition",
+			Flags:   getTaskListDeadLetterFlags(),
+			Action: func(c *cli.Context) {
+				AdminPurgeDeadLetterTasks(c)
+			},
+		},
+		{
+			Name:    "list-idle",
+			Aliases: []string{"li"},
+			Usage:   "List the task lists of a domain which are not owned by any matching host for a while (SQL databases only)",
+			Flags: append(
+				getDBFlags(),
+				cli.StringFlag{
+					Name:  FlagIdleTime,
+					Value: "2d",
+					Usage: "Task lists not updated within this time range are considered idle, e.g. 12h, 2d",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of task lists to read from the database per page",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminListIdleTaskLists(c)
+			},
+		},
+		{
+			Name:    "migrate",
+			Aliases: []string{"mig"},
+			Usage:   "Move the pending tasks of a tasklist partition to the root partition of another task list",
+			Flags: append(
+				getTaskListBulkFlags(),
+				cli.StringFlag{
+					Name:  FlagTargetTaskList,
+					Usage: "Name of the task list the tasks are moved to",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxTaskCount,
+					Value: 100000,
+					Usage: "Maximum number of tasks to move",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminMigrateTaskList(c)
+			},
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a tasklist partition and all its tasks immediately",
+			Flags: append(
+				getTaskListBulkFlags(),
+				cli.BoolFlag{
+					Name:  FlagForce,
+					Usage: "Skip the confirmation prompt",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminDeleteTaskList(c)
+			},
+		},
+		{
+			Name:    "list-sticky",
+			Aliases: []string{"ls"},
+			Usage:   "List the sticky task lists of a domain with their pinned workflows and worker",
+			Action: func(c *cli.Context) {
+				AdminListStickyTaskLists(c)
+			},
+		},
+		{
+			Name:    "reset-sticky",
+			Aliases: []string{"rs"},
+			Usage:   "Reset the stickiness of all workflows pinned to the sticky task lists of a worker",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the worker owning the sticky task lists",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Name of the sticky task list",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminResetStickyTaskLists(c)
+			},
+		},
+	}
+}
+
+func getTaskListBulkFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList name",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListTypeWithAlias,
+			Value: "decision",
+			Usage: "Optional TaskList type [decision|activity]",
+		},
+		cli.IntFlag{
+			Name:  FlagPartition,
+			Usage: "Optional TaskList partition, 0 is the root partition",
+		},
+	}
+}
+
+func getTaskListDeadLetterFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList name",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListTypeWithAlias,
+			Value: "decision",
+			Usage: "Optional TaskList type [decision|activity]",
+		},
+		cli.IntFlag{
+			Name:  FlagPartition,
+			Usage: "Optional TaskList partition, 0 is the root partition",
+		},
+		cli.IntFlag{
+			Name:  FlagPageSize,
+			Value: 100,
+			Usage: "Number of dead-lettered tasks to list per request, at most 1000",
+		},
+		cli.StringFlag{
+			Name:  FlagDeadLetterReason,
+			Usage: "Optional dead-letter reason [expired|dispatch-failed], all reasons if not set",
+		},
+		cli.Int64Flag{
+			Name:  FlagTaskID,
+			Usage: "Optional ID of the single dead-lettered task to operate on",
+		},
+		cli.IntFlag{
+			Name:  FlagMaxTaskCount,
+			Value: 1000,
+			Usage: "Maximum number of tasks to operate on per reason",
+		},
 	}
 }
 
@@ -934,6 +1131,11 @@ func newDBCommands() []cli.Command {
 				AdminDBClean(c)
 			},
 		},
+		{
+			Name:        "scan-results",
+			Usage:       "inspect and fix the results of the shard scanners run by the worker service",
+			Subcommands: newDBScanResultsCommands(),
+		},
 		{
 			Name:  "decode_thrift",
 			Usage: "decode thrift object, print into JSON if the data is matching with any supported struct",
@@ -955,6 +1157,100 @@ func newDBCommands() []cli.Command {
 	}
 }
 
+func newDBScanResultsCommands() []cli.Command {
+	scanTypeFlag := cli.StringFlag{
+		Name:     FlagScanType,
+		Usage:    "Scanner to inspect: " + strings.Join(scanresults.ScanTypeNames(), ", "),
+		Required: true,
+	}
+	runIDFlag := cli.StringFlag{
+		Name:  FlagRunIDWithAlias,
+		Usage: "RunID of the scanner workflow, defaults to the latest run",
+	}
+
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "list scanner workflow runs closed/open",
+			Flags: []cli.Flag{
+				scanTypeFlag,
+				cli.BoolFlag{
+					Name:  FlagOpenWithAlias,
+					Usage: "List for open workflow executions, default is to list for closed ones",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 10,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBScanResultsList(c)
+			},
+		},
+		{
+			Name:  "show",
+			Usage: "show entity counts and per invariant corruption counts of a scanner run",
+			Flags: []cli.Flag{
+				scanTypeFlag,
+				runIDFlag,
+				getFormatFlag(),
+			},
+			Action: func(c *cli.Context) {
+				AdminDBScanResultsShow(c)
+			},
+		},
+		{
+			Name:  "corrupted",
+			Usage: "page through the corrupted entities found by a scanner run",
+			Flags: []cli.Flag{
+				scanTypeFlag,
+				runIDFlag,
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "Only show corrupted entities of the given shard",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "Show more pages, default is to show one page",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 10,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBScanResultsCorrupted(c)
+			},
+		},
+		{
+			Name:  "fix",
+			Usage: "start a fixer workflow for the corruptions found by a closed scanner run, the fixer must be enabled in the worker",
+			Flags: []cli.Flag{
+				scanTypeFlag,
+				cli.StringFlag{
+					Name:     FlagRunIDWithAlias,
+					Usage:    "RunID of the closed scanner workflow",
+					Required: true,
+				},
+				cli.IntSliceFlag{
+					Name:  FlagShardIDs,
+					Usage: "Only fix corruptions found on the given shards, default is to fix all shards",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBScanResultsFix(c)
+			},
+		},
+	}
+}
+
 func getQueueCommandFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.IntFlag{
@@ -172,9 +172,13 @@ func fixExecution(
 	historyV2Mgr := initializeHistoryManager(c)
 	defer historyV2Mgr.Close()
 
+	shardManager := initializeShardManager(c)
+	defer shardManager.Close()
+
 	pr := persistence.NewPersistenceRetryer(
 		execManager,
 		historyV2Mgr,
+		shardManager,
 		common.CreatePersistenceRetryPolicy(),
 	)
 
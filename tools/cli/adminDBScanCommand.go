@@ -124,9 +124,13 @@ func checkExecution(
 	historyV2Mgr := initializeHistoryManager(c)
 	defer historyV2Mgr.Close()
 
+	shardManager := initializeShardManager(c)
+	defer shardManager.Close()
+
 	pr := persistence.NewPersistenceRetryer(
 		execManager,
 		historyV2Mgr,
+		shardManager,
 		common.CreatePersistenceRetryPolicy(),
 	)
 
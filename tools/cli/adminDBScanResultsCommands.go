@@ -0,0 +1,166 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// ScanResultsInvariantRow is a row of per invariant corruption counts of a scan run
+	ScanResultsInvariantRow struct {
+		Invariant string `header:"Invariant" json:"invariant"`
+		Corrupted int64  `header:"Corrupted" json:"corrupted"`
+	}
+
+	// ScanResultsCorruptedEntity is a single corrupted entity found by a scan run
+	ScanResultsCorruptedEntity struct {
+		ShardID int32           `json:"shardID"`
+		Entity  json.RawMessage `json:"entity"`
+	}
+)
+
+// AdminDBScanResultsList lists runs of a shard scanner workflow
+func AdminDBScanResultsList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	scanType := getRequiredOption(c, FlagScanType)
+
+	getRunsPage := func(nextPageToken []byte) ([]*types.WorkflowExecutionInfo, []byte) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+		resp, err := adminClient.ListScanRuns(ctx, &types.ListScanRunsRequest{
+			ScanType:      scanType,
+			Open:          c.Bool(FlagOpen),
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			ErrorAndExit("Failed to list scanner workflow runs", err)
+		}
+		return resp.Executions, resp.NextPageToken
+	}
+	displayPagedWorkflows(c, getRunsPage, !c.Bool(FlagMore))
+}
+
+// AdminDBScanResultsShow shows the aggregated results of a shard scanner run
+func AdminDBScanResultsShow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeScanResults(ctx, &types.DescribeScanResultsRequest{
+		ScanType: getRequiredOption(c, FlagScanType),
+		RunID:    c.String(FlagRunID),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe scan results", err)
+	}
+
+	if c.String(FlagFormat) == formatJSON {
+		prettyPrintJSONObject(resp)
+		return
+	}
+	var corruptionByType []ScanResultsInvariantRow
+	for name, count := range resp.CorruptionByType {
+		corruptionByType = append(corruptionByType, ScanResultsInvariantRow{
+			Invariant: name,
+			Corrupted: count,
+		})
+	}
+	sort.Slice(corruptionByType, func(i, j int) bool {
+		return corruptionByType[i].Invariant < corruptionByType[j].Invariant
+	})
+	fmt.Printf("Shards: %v\n", resp.ShardStatus)
+	fmt.Printf("Entities: %v, corrupted: %v, check failed: %v, shards with corruptions: %v\n",
+		resp.EntitiesCount, resp.CorruptedCount, resp.CheckFailedCount, len(resp.CorruptedShardIDs))
+	Render(c, corruptionByType, RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true})
+}
+
+// AdminDBScanResultsCorrupted pages through the corrupted entities found by a shard scanner run
+func AdminDBScanResultsCorrupted(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &types.ListScanCorruptionsRequest{
+		ScanType: getRequiredOption(c, FlagScanType),
+		RunID:    c.String(FlagRunID),
+		PageSize: int32(c.Int(FlagPageSize)),
+	}
+	if c.IsSet(FlagShardID) {
+		shardID := int32(c.Int(FlagShardID))
+		request.ShardID = &shardID
+	}
+
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListScanCorruptions(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list corrupted entities", err)
+		}
+		printScanResultsCorrupted(resp.Entities)
+		if len(resp.NextPageToken) == 0 || !c.Bool(FlagMore) || !showNextPage() {
+			return
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// AdminDBScanResultsFix starts a fixer workflow for the corruptions found by a shard scanner run
+func AdminDBScanResultsFix(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	request := &types.FixScanResultsRequest{
+		ScanType: getRequiredOption(c, FlagScanType),
+		RunID:    getRequiredOption(c, FlagRunID),
+	}
+	for _, shardID := range c.IntSlice(FlagShardIDs) {
+		request.ShardIDs = append(request.ShardIDs, int32(shardID))
+	}
+	resp, err := adminClient.FixScanResults(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to start fixer workflow", err)
+	}
+	fmt.Println("Fixer workflow started")
+	fmt.Println("wid: " + resp.GetExecution().GetWorkflowID())
+	fmt.Println("rid: " + resp.GetExecution().GetRunID())
+}
+
+func printScanResultsCorrupted(entities []*types.ScanCorruptedEntity) {
+	for _, corrupted := range entities {
+		data, err := json.Marshal(ScanResultsCorruptedEntity{
+			ShardID: corrupted.ShardID,
+			Entity:  corrupted.Entity,
+		})
+		if err != nil {
+			ErrorAndExit("Failed to serialize corrupted entity", err)
+		}
+		fmt.Println(string(data))
+	}
+}
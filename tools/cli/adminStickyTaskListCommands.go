@@ -0,0 +1,92 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type StickyTaskListRow struct {
+	Name            string    `header:"Sticky Task List"`
+	PinnedWorkflows int32     `header:"Pinned Workflows"`
+	Identity        string    `header:"Worker Identity"`
+	LastPollTime    time.Time `header:"Last Poll Time"`
+}
+
+// AdminListStickyTaskLists displays the sticky task lists the open workflows of a domain are pinned to, with
+// the identity of the worker owning them, their last poll time and the number of workflows pinned to them
+func AdminListStickyTaskLists(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := cFactory.ServerAdminClient(c).ListStickyTaskLists(ctx, &types.ListStickyTaskListsRequest{Domain: domain})
+	if err != nil {
+		ErrorAndExit("Failed to list sticky task lists.", err)
+	}
+	taskLists := resp.GetTaskLists()
+	if len(taskLists) == 0 {
+		fmt.Println(colorMagenta("No workflow pinned to a sticky task list found."))
+		return
+	}
+
+	rows := make([]StickyTaskListRow, 0, len(taskLists))
+	for _, taskList := range taskLists {
+		row := StickyTaskListRow{
+			Name:            taskList.GetName(),
+			PinnedWorkflows: taskList.PinnedWorkflows,
+			Identity:        taskList.GetIdentity(),
+		}
+		if taskList.LastPollTimestamp != nil {
+			row.LastPollTime = time.Unix(0, taskList.GetLastPollTimestamp())
+		}
+		rows = append(rows, row)
+	}
+	RenderTable(os.Stdout, rows, RenderOptions{Color: true, Border: true, PrintDateTime: true})
+}
+
+// AdminResetStickyTaskLists resets the stickiness of all the workflows pinned to the sticky task lists
+// of a worker, so that their next decision task is dispatched to the normal task list of the workflow
+func AdminResetStickyTaskLists(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	identity := c.String(FlagIdentity)
+	taskList := c.String(FlagTaskList)
+	if identity == "" && taskList == "" {
+		ErrorAndExit(fmt.Sprintf("Either %v or %v must be set.", FlagIdentity, FlagTaskList), nil)
+	}
+
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	resp, err := cFactory.ServerAdminClient(c).ResetStickyTaskLists(ctx, &types.ResetStickyTaskListsRequest{
+		Domain:   domain,
+		Identity: identity,
+		TaskList: taskList,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to reset sticky task lists.", err)
+	}
+	fmt.Printf("Reset sticky task list of %v workflows.\n", resp.ResetWorkflows)
+}
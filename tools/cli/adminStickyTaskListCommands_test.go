@@ -0,0 +1,54 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func (s *cliAppSuite) TestAdminListStickyTaskLists() {
+	s.serverAdminClient.EXPECT().ListStickyTaskLists(gomock.Any(), &types.ListStickyTaskListsRequest{Domain: domainName}).
+		Return(&types.ListStickyTaskListsResponse{
+			TaskLists: []*types.StickyTaskListInfo{
+				{Name: "host2:sticky", Identity: "worker@host2", LastPollTimestamp: common.Int64Ptr(1), PinnedWorkflows: 2},
+				{Name: "host1:sticky", Identity: "worker@host1", PinnedWorkflows: 1},
+			},
+		}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "list-sticky"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminResetStickyTaskLists() {
+	s.serverAdminClient.EXPECT().ResetStickyTaskLists(gomock.Any(), &types.ResetStickyTaskListsRequest{
+		Domain:   domainName,
+		Identity: "worker@host1",
+	}).Return(&types.ResetStickyTaskListsResponse{ResetWorkflows: 2}, nil)
+	s.Equal(0, s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "tasklist", "reset-sticky", "--identity", "worker@host1"}))
+
+	s.serverAdminClient.EXPECT().ResetStickyTaskLists(gomock.Any(), &types.ResetStickyTaskListsRequest{
+		Domain:   domainName,
+		TaskList: "host1:sticky",
+	}).Return(&types.ResetStickyTaskListsResponse{ResetWorkflows: 1}, nil)
+	s.Equal(0, s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "tasklist", "reset-sticky", "--tl", "host1:sticky"}))
+}
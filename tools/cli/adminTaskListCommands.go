@@ -21,12 +21,18 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -43,6 +49,63 @@ type (
 		StartID   int64 `header:"Lease Start TaskID"`
 		EndID     int64 `header:"Lease End TaskID"`
 	}
+	TaskListPartitionCountRow struct {
+		ReadPartitions  int32 `header:"Read Partitions"`
+		WritePartitions int32 `header:"Write Partitions"`
+	}
+	TaskListScalingDecisionRow struct {
+		Time            time.Time `header:"Time"`
+		ReadPartitions  int32     `header:"Read Partitions"`
+		WritePartitions int32     `header:"Write Partitions"`
+		Reason          string    `header:"Reason"`
+	}
+	TaskListBacklogSummaryRow struct {
+		Partition   string `header:"Partition"`
+		AckLevel    int64  `header:"Ack Level"`
+		Scanned     int    `header:"Scanned Tasks"`
+		Stale       int    `header:"Stale Tasks"`
+		LastTaskID  int64  `header:"Last TaskID"`
+		HasMoreTask bool   `header:"Has More Tasks"`
+	}
+	TaskListBacklogRow struct {
+		Name    string  `header:"Name"`
+		Count   int     `header:"Tasks"`
+		Percent float64 `header:"Percent"`
+	}
+	TaskListDeadLetterRow struct {
+		Reason         string    `header:"Reason"`
+		TaskID         int64     `header:"Task ID"`
+		WorkflowID     string    `header:"Workflow ID"`
+		RunID          string    `header:"Run ID"`
+		ScheduleID     int64     `header:"Schedule ID"`
+		DeadLetteredAt time.Time `header:"Dead-Lettered At"`
+	}
+
+	TaskListIdleRow struct {
+		Name        string    `header:"Task List Name"`
+		Type        string    `header:"Type"`
+		AckLevel    int64     `header:"Ack Level"`
+		LastUpdated time.Time `header:"Last Updated"`
+	}
+
+	// taskListBacklog is the aggregated view of the persisted tasks of a task list partition
+	taskListBacklog struct {
+		AckLevel       int64
+		Scanned        int
+		Stale          int
+		LastTaskID     int64
+		HasMoreTasks   bool
+		ByDomain       map[string]int
+		ByWorkflowType map[string]int
+		ByActivityType map[string]int
+	}
+)
+
+const (
+	// deadLetterTasksBatchSize is the max number of dead-lettered tasks requeued or purged by a single request
+	deadLetterTasksBatchSize = 1000
+	// migrateTaskListBatchSize is the max number of tasks migrated by a single request
+	migrateTaskListBatchSize = 100
 )
 
 // AdminDescribeTaskList displays poller and status information of task list.
@@ -76,6 +139,11 @@ func AdminDescribeTaskList(c *cli.Context) {
 	printTaskListStatus(taskListStatus)
 	fmt.Printf("\n")
 
+	if partitionConfig := response.GetPartitionConfig(); partitionConfig != nil {
+		printTaskListPartitionConfig(partitionConfig)
+		fmt.Printf("\n")
+	}
+
 	pollers := response.Pollers
 	if len(pollers) == 0 {
 		ErrorAndExit(colorMagenta("No poller for tasklist: "+taskList), nil)
@@ -110,6 +178,505 @@ func AdminListTaskList(c *cli.Context) {
 	RenderTable(os.Stdout, table, RenderOptions{Color: true, Border: true})
 }
 
+// AdminTaskListBacklog pages through the persisted tasks of a task list partition and displays them
+// aggregated by domain, workflow type and activity type. Each page is aggregated by the frontend
+// and the ack level of the task list is left untouched.
+func AdminTaskListBacklog(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	partition := c.Int(FlagPartition)
+	pageSize := c.Int(FlagPageSize)
+	maxTasks := c.Int(FlagMaxTaskCount)
+	taskListType := types.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
+	if partition < 0 {
+		ErrorAndExit("Partition must not be negative.", nil)
+	}
+	if pageSize <= 0 || maxTasks <= 0 {
+		ErrorAndExit("Page size and max task count must be positive.", nil)
+	}
+
+	partitionName := getTaskListPartitionName(taskList, partition)
+	backlog, err := scanTaskListBacklog(
+		func() (context.Context, context.CancelFunc) { return newContext(c) },
+		cFactory.ServerAdminClient(c),
+		&types.GetTaskListBacklogRequest{
+			Domain:       domain,
+			TaskList:     &types.TaskList{Name: partitionName},
+			TaskListType: &taskListType,
+			PageSize:     int32(pageSize),
+		},
+		maxTasks,
+	)
+	if err != nil {
+		ErrorAndExit("Failed to scan task list backlog.", err)
+	}
+
+	RenderTable(os.Stdout, []TaskListBacklogSummaryRow{{
+		Partition:   partitionName,
+		AckLevel:    backlog.AckLevel,
+		Scanned:     backlog.Scanned,
+		Stale:       backlog.Stale,
+		LastTaskID:  backlog.LastTaskID,
+		HasMoreTask: backlog.HasMoreTasks,
+	}}, RenderOptions{Color: true})
+	fmt.Printf("\nTasks by domain:\n")
+	RenderTable(os.Stdout, newTaskListBacklogRows(backlog.ByDomain, backlog.Scanned), RenderOptions{Color: true, Border: true})
+	fmt.Printf("\nTasks by workflow type:\n")
+	RenderTable(os.Stdout, newTaskListBacklogRows(backlog.ByWorkflowType, backlog.Scanned), RenderOptions{Color: true, Border: true})
+	if taskListType == types.TaskListTypeActivity {
+		fmt.Printf("\nTasks by activity type:\n")
+		RenderTable(os.Stdout, newTaskListBacklogRows(backlog.ByActivityType, backlog.Scanned), RenderOptions{Color: true, Border: true})
+	}
+}
+
+// scanTaskListBacklog merges the pages of the task list backlog returned by the frontend
+// until the end of the backlog or maxTasks is reached
+func scanTaskListBacklog(
+	newCtx func() (context.Context, context.CancelFunc),
+	adminClient admin.Client,
+	request *types.GetTaskListBacklogRequest,
+	maxTasks int,
+) (*taskListBacklog, error) {
+	backlog := &taskListBacklog{
+		ByDomain:       make(map[string]int),
+		ByWorkflowType: make(map[string]int),
+		ByActivityType: make(map[string]int),
+	}
+
+	req := *request
+	for backlog.Scanned < maxTasks {
+		req.PageSize = int32(common.MinInt(int(request.PageSize), maxTasks-backlog.Scanned))
+		ctx, cancel := newCtx()
+		resp, err := adminClient.GetTaskListBacklog(ctx, &req)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		backlog.add(resp)
+		if len(resp.NextPageToken) == 0 {
+			return backlog, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	backlog.HasMoreTasks = true
+	return backlog, nil
+}
+
+func (b *taskListBacklog) add(page *types.GetTaskListBacklogResponse) {
+	if b.Scanned == 0 {
+		b.AckLevel = page.AckLevel
+	}
+	b.Scanned += int(page.Scanned)
+	b.Stale += int(page.Stale)
+	b.LastTaskID = page.LastTaskID
+	for name, count := range page.ByDomain {
+		b.ByDomain[name] += int(count)
+	}
+	for name, count := range page.ByWorkflowType {
+		b.ByWorkflowType[name] += int(count)
+	}
+	for name, count := range page.ByActivityType {
+		b.ByActivityType[name] += int(count)
+	}
+}
+
+func newTaskListBacklogRows(counts map[string]int, total int) []TaskListBacklogRow {
+	rows := make([]TaskListBacklogRow, 0, len(counts))
+	for name, count := range counts {
+		rows = append(rows, TaskListBacklogRow{
+			Name:    name,
+			Count:   count,
+			Percent: float64(count*10000/total) / 100,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Count != rows[j].Count {
+			return rows[i].Count > rows[j].Count
+		}
+		return rows[i].Name < rows[j].Name
+	})
+	return rows
+}
+
+func getTaskListPartitionName(taskList string, partition int) string {
+	if partition == 0 {
+		return taskList
+	}
+	return fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, taskList, partition)
+}
+
+// AdminListDeadLetterTasks lists the dead-lettered tasks of a tasklist partition
+func AdminListDeadLetterTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain, taskList, taskListType := getTaskListPartitionWithTypeFromFlags(c)
+	pageSize := c.Int(FlagPageSize)
+	maxTasks := c.Int(FlagMaxTaskCount)
+	if pageSize <= 0 || maxTasks <= 0 {
+		ErrorAndExit("Page size and max task count must be positive.", nil)
+	}
+
+	var rows []TaskListDeadLetterRow
+	for _, reason := range getDeadLetterReasonsFromFlags(c) {
+		tasks, err := listDeadLetterTasks(
+			func() (context.Context, context.CancelFunc) { return newContext(c) },
+			adminClient,
+			&types.ListDeadLetterTasksRequest{
+				Domain:       domain,
+				TaskList:     taskList,
+				TaskListType: taskListType,
+				Reason:       reason,
+				PageSize:     int32(pageSize),
+			},
+			maxTasks,
+		)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to list %v dead-lettered tasks.", reason), err)
+		}
+		for _, task := range tasks {
+			rows = append(rows, TaskListDeadLetterRow{
+				Reason:         reason,
+				TaskID:         task.TaskID,
+				WorkflowID:     task.WorkflowID,
+				RunID:          task.RunID,
+				ScheduleID:     task.ScheduleID,
+				DeadLetteredAt: time.Unix(0, task.DeadLetteredTimestamp),
+			})
+		}
+	}
+	if len(rows) == 0 {
+		fmt.Println(colorMagenta("No dead-lettered task found."))
+		return
+	}
+	RenderTable(os.Stdout, rows, RenderOptions{Color: true, Border: true, PrintDateTime: true})
+}
+
+// AdminRequeueDeadLetterTasks moves dead-lettered tasks of a tasklist partition back to its backlog.
+// Activity tasks which expired were already timed out by history and are not requeued
+func AdminRequeueDeadLetterTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain, taskList, taskListType := getTaskListPartitionWithTypeFromFlags(c)
+	maxTasks := getDeadLetterMaxTaskCountFromFlags(c)
+	for _, reason := range getDeadLetterReasonsFromFlags(c) {
+		resp, err := requeueDeadLetterTasks(
+			func() (context.Context, context.CancelFunc) { return newContext(c) },
+			adminClient,
+			&types.RequeueDeadLetterTasksRequest{
+				Domain:       domain,
+				TaskList:     taskList,
+				TaskListType: taskListType,
+				Reason:       reason,
+				TaskID:       c.Int64(FlagTaskID),
+			},
+			maxTasks,
+		)
+		if resp != nil {
+			fmt.Printf("Requeued %v %v dead-lettered tasks.\n", resp.Requeued, reason)
+			if resp.Skipped > 0 {
+				fmt.Printf("Skipped %v %v dead-lettered tasks which were already timed out by history.\n", resp.Skipped, reason)
+			}
+		}
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to requeue %v dead-lettered tasks.", reason), err)
+		}
+	}
+}
+
+// AdminPurgeDeadLetterTasks deletes dead-lettered tasks of a tasklist partition
+func AdminPurgeDeadLetterTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain, taskList, taskListType := getTaskListPartitionWithTypeFromFlags(c)
+	maxTasks := getDeadLetterMaxTaskCountFromFlags(c)
+	for _, reason := range getDeadLetterReasonsFromFlags(c) {
+		purged, err := purgeDeadLetterTasks(
+			func() (context.Context, context.CancelFunc) { return newContext(c) },
+			adminClient,
+			&types.PurgeDeadLetterTasksRequest{
+				Domain:       domain,
+				TaskList:     taskList,
+				TaskListType: taskListType,
+				Reason:       reason,
+				TaskID:       c.Int64(FlagTaskID),
+			},
+			maxTasks,
+		)
+		fmt.Printf("Purged %v %v dead-lettered tasks.\n", purged, reason)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to purge %v dead-lettered tasks.", reason), err)
+		}
+	}
+}
+
+func getTaskListPartitionWithTypeFromFlags(c *cli.Context) (string, *types.TaskList, *types.TaskListType) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskListType := types.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
+	return domain, &types.TaskList{Name: getTaskListPartitionFromFlags(c)}, &taskListType
+}
+
+func getDeadLetterMaxTaskCountFromFlags(c *cli.Context) int {
+	maxTasks := c.Int(FlagMaxTaskCount)
+	if maxTasks <= 0 {
+		ErrorAndExit("Max task count must be positive.", nil)
+	}
+	return maxTasks
+}
+
+func getDeadLetterReasonsFromFlags(c *cli.Context) []string {
+	if !c.IsSet(FlagDeadLetterReason) {
+		return common.DeadLetterReasons
+	}
+	reason := c.String(FlagDeadLetterReason)
+	for _, r := range common.DeadLetterReasons {
+		if r == reason {
+			return []string{reason}
+		}
+	}
+	ErrorAndExit(fmt.Sprintf("Invalid dead-letter reason %v, must be one of %v.", reason, common.DeadLetterReasons), nil)
+	return nil
+}
+
+// listDeadLetterTasks pages through the dead-lettered tasks returned by the frontend until the end
+// of the dead-letter task list or maxTasks is reached
+func listDeadLetterTasks(
+	newCtx func() (context.Context, context.CancelFunc),
+	adminClient admin.Client,
+	request *types.ListDeadLetterTasksRequest,
+	maxTasks int,
+) ([]*types.DeadLetterTask, error) {
+	var tasks []*types.DeadLetterTask
+	req := *request
+	for len(tasks) < maxTasks {
+		req.PageSize = int32(common.MinInt(int(request.PageSize), maxTasks-len(tasks)))
+		ctx, cancel := newCtx()
+		resp, err := adminClient.ListDeadLetterTasks(ctx, &req)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, resp.Tasks...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	return tasks, nil
+}
+
+// requeueDeadLetterTasks asks the frontend to requeue up to maxTasks dead-lettered tasks, in batches
+// of at most deadLetterTasksBatchSize, until fewer tasks than requested are requeued. The returned
+// counts are set even on error
+func requeueDeadLetterTasks(
+	newCtx func() (context.Context, context.CancelFunc),
+	adminClient admin.Client,
+	request *types.RequeueDeadLetterTasksRequest,
+	maxTasks int,
+) (*types.RequeueDeadLetterTasksResponse, error) {
+	result := &types.RequeueDeadLetterTasksResponse{}
+	req := *request
+	for int(result.Requeued) < maxTasks {
+		req.MaxTasks = int32(common.MinInt(deadLetterTasksBatchSize, maxTasks-int(result.Requeued)))
+		ctx, cancel := newCtx()
+		resp, err := adminClient.RequeueDeadLetterTasks(ctx, &req)
+		cancel()
+		if err != nil {
+			return result, err
+		}
+		result.Requeued += resp.Requeued
+		result.Skipped += resp.Skipped
+		if request.TaskID > 0 || resp.Requeued < req.MaxTasks {
+			break
+		}
+	}
+	return result, nil
+}
+
+// purgeDeadLetterTasks asks the frontend to delete up to maxTasks dead-lettered tasks, in batches
+// of at most deadLetterTasksBatchSize, until fewer tasks than requested are deleted. The returned
+// count is set even on error
+func purgeDeadLetterTasks(
+	newCtx func() (context.Context, context.CancelFunc),
+	adminClient admin.Client,
+	request *types.PurgeDeadLetterTasksRequest,
+	maxTasks int,
+) (int, error) {
+	purged := 0
+	req := *request
+	for purged < maxTasks {
+		req.MaxTasks = int32(common.MinInt(deadLetterTasksBatchSize, maxTasks-purged))
+		ctx, cancel := newCtx()
+		resp, err := adminClient.PurgeDeadLetterTasks(ctx, &req)
+		cancel()
+		if err != nil {
+			return purged, err
+		}
+		purged += int(resp.Purged)
+		if request.TaskID > 0 || resp.Purged < req.MaxTasks {
+			break
+		}
+	}
+	return purged, nil
+}
+
+// AdminListIdleTaskLists displays the task lists of a domain which were not updated for the given time range.
+// The owner of a task list updates it periodically, so an idle task list is not owned by any matching host
+func AdminListIdleTaskLists(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	idleSince, err := parseTimeRange(c.String(FlagIdleTime))
+	if err != nil {
+		ErrorAndExit("Invalid idle time.", err)
+	}
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		ErrorAndExit("Page size must be positive.", nil)
+	}
+
+	ctx, cancel := newContext(c)
+	domainResp, err := initializeDomainManager(c).GetDomain(ctx, &persistence.GetDomainRequest{Name: domain})
+	cancel()
+	if err != nil {
+		ErrorAndExit("Failed to get domain.", err)
+	}
+	taskLists, err := listIdleTaskLists(
+		func() (context.Context, context.CancelFunc) { return newContext(c) },
+		initializeTaskManager(c),
+		domainResp.Info.ID,
+		idleSince,
+		pageSize,
+	)
+	if err != nil {
+		ErrorAndExit("Failed to list task lists.", err)
+	}
+	if len(taskLists) == 0 {
+		fmt.Println(colorMagenta("No idle task list found."))
+		return
+	}
+	rows := make([]TaskListIdleRow, 0, len(taskLists))
+	for _, taskList := range taskLists {
+		taskListType := "Decision"
+		if taskList.TaskType == persistence.TaskListTypeActivity {
+			taskListType = "Activity"
+		}
+		rows = append(rows, TaskListIdleRow{
+			Name:        taskList.Name,
+			Type:        taskListType,
+			AckLevel:    taskList.AckLevel,
+			LastUpdated: taskList.LastUpdated,
+		})
+	}
+	RenderTable(os.Stdout, rows, RenderOptions{Color: true, Border: true, PrintDateTime: true})
+}
+
+// listIdleTaskLists pages through all task lists and returns the normal task lists of the domain last updated before idleSince
+func listIdleTaskLists(
+	newCtx func() (context.Context, context.CancelFunc),
+	taskManager persistence.TaskManager,
+	domainID string,
+	idleSince time.Time,
+	pageSize int,
+) ([]persistence.TaskListInfo, error) {
+	var result []persistence.TaskListInfo
+	request := &persistence.ListTaskListRequest{PageSize: pageSize}
+	for {
+		ctx, cancel := newCtx()
+		resp, err := taskManager.ListTaskList(ctx, request)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		for _, taskList := range resp.Items {
+			if taskList.DomainID == domainID &&
+				taskList.Kind == persistence.TaskListKindNormal &&
+				taskList.LastUpdated.Before(idleSince) {
+				result = append(result, taskList)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return result, nil
+		}
+		request.PageToken = resp.NextPageToken
+	}
+}
+
+// AdminMigrateTaskList moves the backlog of a tasklist partition to the root partition of another task list
+func AdminMigrateTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain, taskList, taskListType := getTaskListPartitionWithTypeFromFlags(c)
+	maxTasks := c.Int(FlagMaxTaskCount)
+	if maxTasks <= 0 {
+		ErrorAndExit("Max task count must be positive.", nil)
+	}
+
+	resp, err := migrateTaskList(
+		func() (context.Context, context.CancelFunc) { return newContext(c) },
+		adminClient,
+		&types.MigrateTaskListRequest{
+			Domain:         domain,
+			TaskList:       taskList,
+			TaskListType:   taskListType,
+			TargetTaskList: getRequiredOption(c, FlagTargetTaskList),
+		},
+		maxTasks,
+	)
+	fmt.Printf("Migrated %v tasks, dropped %v expired tasks.\n", resp.Migrated, resp.Expired)
+	if err != nil {
+		ErrorAndExit("Failed to migrate task list.", err)
+	}
+}
+
+// migrateTaskList asks the frontend to migrate up to maxTasks tasks, in batches of at most
+// migrateTaskListBatchSize, until fewer tasks than requested are migrated. The returned counts
+// are set even on error
+func migrateTaskList(
+	newCtx func() (context.Context, context.CancelFunc),
+	adminClient admin.Client,
+	request *types.MigrateTaskListRequest,
+	maxTasks int,
+) (*types.MigrateTaskListResponse, error) {
+	result := &types.MigrateTaskListResponse{}
+	req := *request
+	for done := 0; done < maxTasks; done = int(result.Migrated + result.Expired) {
+		req.MaxTasks = int32(common.MinInt(migrateTaskListBatchSize, maxTasks-done))
+		ctx, cancel := newCtx()
+		resp, err := adminClient.MigrateTaskList(ctx, &req)
+		cancel()
+		if err != nil {
+			return result, err
+		}
+		result.Migrated += resp.Migrated
+		result.Expired += resp.Expired
+		if resp.Migrated+resp.Expired < req.MaxTasks {
+			break
+		}
+	}
+	return result, nil
+}
+
+// AdminDeleteTaskList deletes a tasklist partition and all its tasks
+func AdminDeleteTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain, taskList, taskListType := getTaskListPartitionWithTypeFromFlags(c)
+	if !c.Bool(FlagForce) {
+		prompt(fmt.Sprintf("Deleting task list %v and all its tasks, continue? Y/N", taskList.GetName()))
+	}
+
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	err := adminClient.DeleteTaskList(ctx, &types.DeleteTaskListRequest{
+		Domain:       domain,
+		TaskList:     taskList,
+		TaskListType: taskListType,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to delete task list.", err)
+	}
+	fmt.Printf("Deleted task list %v.\n", taskList.GetName())
+}
+
 func printTaskListStatus(taskListStatus *types.TaskListStatus) {
 	table := []TaskListStatusRow{{
 		ReadLevel: taskListStatus.GetReadLevel(),
@@ -120,3 +687,24 @@ func printTaskListStatus(taskListStatus *types.TaskListStatus) {
 	}}
 	RenderTable(os.Stdout, table, RenderOptions{Color: true})
 }
+
+func printTaskListPartitionConfig(partitionConfig *types.TaskListPartitionConfig) {
+	RenderTable(os.Stdout, []TaskListPartitionCountRow{{
+		ReadPartitions:  partitionConfig.NumReadPartitions,
+		WritePartitions: partitionConfig.NumWritePartitions,
+	}}, RenderOptions{Color: true})
+	if len(partitionConfig.GetScalingDecisions()) == 0 {
+		return
+	}
+	fmt.Printf("\n")
+	var table []TaskListScalingDecisionRow
+	for _, decision := range partitionConfig.GetScalingDecisions() {
+		table = append(table, TaskListScalingDecisionRow{
+			Time:            time.Unix(0, decision.Timestamp),
+			ReadPartitions:  decision.NumReadPartitions,
+			WritePartitions: decision.NumWritePartitions,
+			Reason:          decision.Reason,
+		})
+	}
+	RenderTable(os.Stdout, table, RenderOptions{Color: true})
+}
@@ -0,0 +1,281 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestScanTaskListBacklog(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	adminClient := admin.NewMockClient(mockCtrl)
+	taskListType := types.TaskListTypeActivity
+	request := &types.GetTaskListBacklogRequest{
+		Domain:       "domain",
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: &taskListType,
+		PageSize:     2,
+	}
+	expectPage := func(token []byte, pageSize int32, page *types.GetTaskListBacklogResponse) {
+		req := *request
+		req.PageSize = pageSize
+		req.NextPageToken = token
+		adminClient.EXPECT().GetTaskListBacklog(gomock.Any(), &req).Return(page, nil).Times(1)
+	}
+	expectPage(nil, 2, &types.GetTaskListBacklogResponse{
+		AckLevel:       10,
+		Scanned:        2,
+		LastTaskID:     12,
+		ByDomain:       map[string]int32{"domain": 2},
+		ByWorkflowType: map[string]int32{"typeA": 1, "typeB": 1},
+		ByActivityType: map[string]int32{"activityA": 2},
+		NextPageToken:  []byte("page2"),
+	})
+	expectPage([]byte("page2"), 2, &types.GetTaskListBacklogResponse{
+		AckLevel:       10,
+		Scanned:        2,
+		Stale:          1,
+		LastTaskID:     16,
+		ByDomain:       map[string]int32{"domain": 1, "other-domain": 1},
+		ByWorkflowType: map[string]int32{"typeA": 1, "<unknown>": 1},
+		ByActivityType: map[string]int32{"activityA": 1, "<unknown>": 1},
+		NextPageToken:  []byte("page3"),
+	})
+	expectPage([]byte("page3"), 1, &types.GetTaskListBacklogResponse{
+		AckLevel:       10,
+		Scanned:        1,
+		LastTaskID:     17,
+		ByDomain:       map[string]int32{"domain": 1},
+		ByWorkflowType: map[string]int32{"typeA": 1},
+		ByActivityType: map[string]int32{"activityA": 1},
+		NextPageToken:  []byte("page4"),
+	})
+
+	newCtx := func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) }
+	backlog, err := scanTaskListBacklog(newCtx, adminClient, request, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), backlog.AckLevel)
+	assert.Equal(t, 5, backlog.Scanned)
+	assert.Equal(t, 1, backlog.Stale)
+	assert.Equal(t, int64(17), backlog.LastTaskID)
+	assert.True(t, backlog.HasMoreTasks)
+	assert.Equal(t, map[string]int{"domain": 4, "other-domain": 1}, backlog.ByDomain)
+	assert.Equal(t, map[string]int{"typeA": 3, "typeB": 1, "<unknown>": 1}, backlog.ByWorkflowType)
+	assert.Equal(t, map[string]int{"activityA": 4, "<unknown>": 1}, backlog.ByActivityType)
+
+	assert.Equal(t, []TaskListBacklogRow{
+		{Name: "typeA", Count: 3, Percent: 60},
+		{Name: "<unknown>", Count: 1, Percent: 20},
+		{Name: "typeB", Count: 1, Percent: 20},
+	}, newTaskListBacklogRows(backlog.ByWorkflowType, backlog.Scanned))
+}
+
+func TestScanTaskListBacklog_EndOfBacklog(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	adminClient := admin.NewMockClient(mockCtrl)
+	request := &types.GetTaskListBacklogRequest{Domain: "domain", TaskList: &types.TaskList{Name: "tl"}, PageSize: 10}
+	adminClient.EXPECT().GetTaskListBacklog(gomock.Any(), gomock.Any()).Return(&types.GetTaskListBacklogResponse{
+		Scanned:        1,
+		LastTaskID:     3,
+		ByDomain:       map[string]int32{"domain": 1},
+		ByWorkflowType: map[string]int32{"typeA": 1},
+	}, nil).Times(1)
+
+	newCtx := func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) }
+	backlog, err := scanTaskListBacklog(newCtx, adminClient, request, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, backlog.Scanned)
+	assert.False(t, backlog.HasMoreTasks)
+	assert.Empty(t, backlog.ByActivityType)
+}
+
+func TestGetTaskListPartitionName(t *testing.T) {
+	assert.Equal(t, "tl", getTaskListPartitionName("tl", 0))
+	assert.Equal(t, "/__cadence_sys/tl/3", getTaskListPartitionName("tl", 3))
+}
+
+func TestListDeadLetterTasks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	adminClient := admin.NewMockClient(mockCtrl)
+	taskListType := types.TaskListTypeActivity
+	request := &types.ListDeadLetterTasksRequest{
+		Domain:       "domain",
+		TaskList:     &types.TaskList{Name: "tasklist"},
+		TaskListType: &taskListType,
+		Reason:       common.DeadLetterReasonExpired,
+		PageSize:     2,
+	}
+	expectPage := func(token []byte, pageSize int32, page *types.ListDeadLetterTasksResponse) {
+		req := *request
+		req.PageSize = pageSize
+		req.NextPageToken = token
+		adminClient.EXPECT().ListDeadLetterTasks(gomock.Any(), &req).Return(page, nil).Times(1)
+	}
+	expectPage(nil, 2, &types.ListDeadLetterTasksResponse{
+		Tasks:         []*types.DeadLetterTask{{TaskID: 1}, {TaskID: 3}},
+		NextPageToken: []byte("page2"),
+	})
+	expectPage([]byte("page2"), 1, &types.ListDeadLetterTasksResponse{
+		Tasks:         []*types.DeadLetterTask{{TaskID: 4}},
+		NextPageToken: []byte("page3"),
+	})
+
+	newCtx := func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) }
+	tasks, err := listDeadLetterTasks(newCtx, adminClient, request, 3)
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 3)
+
+	expectPage(nil, 2, &types.ListDeadLetterTasksResponse{})
+	tasks, err = listDeadLetterTasks(newCtx, adminClient, request, 3)
+	assert.NoError(t, err)
+	assert.Empty(t, tasks)
+}
+
+func TestRequeueDeadLetterTasks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	adminClient := admin.NewMockClient(mockCtrl)
+	request := &types.RequeueDeadLetterTasksRequest{
+		Domain:   "domain",
+		TaskList: &types.TaskList{Name: "tasklist"},
+		Reason:   common.DeadLetterReasonDispatchFailed,
+	}
+	expectBatch := func(maxTasks int32, resp *types.RequeueDeadLetterTasksResponse) *gomock.Call {
+		req := *request
+		req.MaxTasks = maxTasks
+		return adminClient.EXPECT().RequeueDeadLetterTasks(gomock.Any(), &req).Return(resp, nil).Times(1)
+	}
+	newCtx := func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) }
+
+	gomock.InOrder(
+		expectBatch(1000, &types.RequeueDeadLetterTasksResponse{Requeued: 1000}),
+		expectBatch(500, &types.RequeueDeadLetterTasksResponse{Requeued: 200}),
+	)
+	resp, err := requeueDeadLetterTasks(newCtx, adminClient, request, 1500)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.RequeueDeadLetterTasksResponse{Requeued: 1200}, resp)
+
+	// expired activity tasks are skipped by the server and end the requeue
+	expectBatch(1000, &types.RequeueDeadLetterTasksResponse{Skipped: 10})
+	resp, err = requeueDeadLetterTasks(newCtx, adminClient, request, 1500)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.RequeueDeadLetterTasksResponse{Skipped: 10}, resp)
+
+	adminClient.EXPECT().RequeueDeadLetterTasks(gomock.Any(), gomock.Any()).Return(nil, &types.ServiceBusyError{}).Times(1)
+	resp, err = requeueDeadLetterTasks(newCtx, adminClient, request, 1500)
+	assert.Error(t, err)
+	assert.Equal(t, &types.RequeueDeadLetterTasksResponse{}, resp)
+}
+
+func TestPurgeDeadLetterTasks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	adminClient := admin.NewMockClient(mockCtrl)
+	request := &types.PurgeDeadLetterTasksRequest{
+		Domain:   "domain",
+		TaskList: &types.TaskList{Name: "tasklist"},
+		Reason:   common.DeadLetterReasonExpired,
+		TaskID:   7,
+	}
+	req := *request
+	req.MaxTasks = 1
+	adminClient.EXPECT().PurgeDeadLetterTasks(gomock.Any(), &req).Return(&types.PurgeDeadLetterTasksResponse{Purged: 1}, nil).Times(1)
+
+	newCtx := func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) }
+	purged, err := purgeDeadLetterTasks(newCtx, adminClient, request, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+}
+
+func TestListIdleTaskLists(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	taskManager := persistence.NewMockTaskManager(mockCtrl)
+	newCtx := func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) }
+	idleSince := time.Now().Add(-time.Hour)
+
+	idle := persistence.TaskListInfo{DomainID: "domainID", Name: "idle", LastUpdated: idleSince.Add(-time.Minute)}
+	gomock.InOrder(
+		taskManager.EXPECT().ListTaskList(gomock.Any(), &persistence.ListTaskListRequest{PageSize: 2}).Return(&persistence.ListTaskListResponse{
+			Items: []persistence.TaskListInfo{
+				idle,
+				{DomainID: "domainID", Name: "active", LastUpdated: time.Now()},
+			},
+			NextPageToken: []byte("token"),
+		}, nil),
+		taskManager.EXPECT().ListTaskList(gomock.Any(), &persistence.ListTaskListRequest{PageSize: 2, PageToken: []byte("token")}).Return(&persistence.ListTaskListResponse{
+			Items: []persistence.TaskListInfo{
+				{DomainID: "otherDomainID", Name: "idle", LastUpdated: idleSince.Add(-time.Minute)},
+				{DomainID: "domainID", Name: "sticky", Kind: persistence.TaskListKindSticky, LastUpdated: idleSince.Add(-time.Minute)},
+			},
+		}, nil),
+	)
+	taskLists, err := listIdleTaskLists(newCtx, taskManager, "domainID", idleSince, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []persistence.TaskListInfo{idle}, taskLists)
+}
+
+func TestMigrateTaskList(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	adminClient := admin.NewMockClient(mockCtrl)
+	request := &types.MigrateTaskListRequest{
+		Domain:         "domain",
+		TaskList:       &types.TaskList{Name: "source"},
+		TargetTaskList: "target",
+	}
+	expectBatch := func(maxTasks int32, resp *types.MigrateTaskListResponse) *gomock.Call {
+		req := *request
+		req.MaxTasks = maxTasks
+		return adminClient.EXPECT().MigrateTaskList(gomock.Any(), &req).Return(resp, nil).Times(1)
+	}
+	newCtx := func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) }
+
+	gomock.InOrder(
+		expectBatch(100, &types.MigrateTaskListResponse{Migrated: 90, Expired: 10}),
+		expectBatch(50, &types.MigrateTaskListResponse{Migrated: 20}),
+	)
+	resp, err := migrateTaskList(newCtx, adminClient, request, 150)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.MigrateTaskListResponse{Migrated: 110, Expired: 10}, resp)
+
+	gomock.InOrder(
+		expectBatch(100, &types.MigrateTaskListResponse{Migrated: 100}),
+		adminClient.EXPECT().MigrateTaskList(gomock.Any(), gomock.Any()).Return(nil, &types.ServiceBusyError{}).Times(1),
+	)
+	resp, err = migrateTaskList(newCtx, adminClient, request, 150)
+	assert.Error(t, err)
+	assert.Equal(t, &types.MigrateTaskListResponse{Migrated: 100}, resp)
+}
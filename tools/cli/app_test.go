@@ -21,6 +21,7 @@
 package cli
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
@@ -31,11 +32,13 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/schedule"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -393,6 +396,24 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "--reason", "maintenance"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.serverFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -609,6 +630,160 @@ func (s *cliAppSuite) TestAdminFailover() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestCreateSchedule() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.CreateScheduleRequest, _ ...yarpc.CallOption) error {
+			s.Equal(domainName, request.Domain)
+			s.Equal("sid", request.ScheduleID)
+			s.Equal("0 9 * * *", request.Spec.CronExpression)
+			s.Equal("America/New_York", request.Spec.TimeZone)
+			s.Equal(int32(60), request.Spec.JitterInSeconds)
+			s.Equal(types.ScheduleOverlapPolicyBuffer, request.Policies.GetOverlapPolicy())
+			s.Equal("wt", request.Action.WorkflowType.Name)
+			s.Equal("tl", request.Action.TaskList.Name)
+			s.Equal(int32(60), request.Action.ExecutionStartToCloseTimeoutSeconds)
+			s.NotEmpty(request.RequestID)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "create", "--sid", "sid", "--cron", "0 9 * * *", "--tz", "America/New_York",
+		"--jitter_seconds", "60", "--op", schedule.OverlapPolicyBuffer, "--wt", "wt", "--tl", "tl", "--et", "60"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule_AlreadyExists() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(&types.WorkflowExecutionAlreadyStartedError{})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "--sid", "sid", "--cron", "0 9 * * *",
+		"--wt", "wt", "--tl", "tl", "--et", "60"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestDescribeSchedule() {
+	s.serverFrontendClient.EXPECT().DescribeSchedule(gomock.Any(), &types.DescribeScheduleRequest{
+		Domain:     domainName,
+		ScheduleID: "sid",
+	}).Return(s.newDescribeScheduleResponse(), nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "describe", "--sid", "sid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateSchedule() {
+	s.serverFrontendClient.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).Return(s.newDescribeScheduleResponse(), nil)
+	s.serverFrontendClient.EXPECT().UpdateSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateScheduleRequest, _ ...yarpc.CallOption) error {
+			s.Equal(domainName, request.Domain)
+			s.Equal("sid", request.ScheduleID)
+			s.Equal("0 10 * * *", request.Spec.CronExpression)
+			s.Equal("UTC", request.Spec.TimeZone)
+			s.Equal("wt", request.Action.WorkflowType.Name)
+			s.Equal("tl2", request.Action.TaskList.Name)
+			s.Equal(types.ScheduleOverlapPolicySkip, request.Policies.GetOverlapPolicy())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "update", "--sid", "sid", "--cron", "0 10 * * *", "--tl", "tl2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseSchedule() {
+	s.serverFrontendClient.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.PauseScheduleRequest, _ ...yarpc.CallOption) error {
+			s.Equal("sid", request.ScheduleID)
+			s.Equal("maintenance", request.Reason)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "pause", "--sid", "sid", "--reason", "maintenance"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDeleteSchedule() {
+	s.serverFrontendClient.EXPECT().DeleteSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.DeleteScheduleRequest, _ ...yarpc.CallOption) error {
+			s.Equal(domainName, request.Domain)
+			s.Equal("sid", request.ScheduleID)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "delete", "--sid", "sid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestBackfillSchedule() {
+	s.serverFrontendClient.EXPECT().BackfillSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.BackfillScheduleRequest, _ ...yarpc.CallOption) error {
+			s.Equal(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC).UnixNano(), request.GetStartTimeNano())
+			s.Equal(time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC).UnixNano(), request.GetEndTimeNano())
+			s.Nil(request.OverlapPolicy)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "backfill", "--sid", "sid",
+		"--earliest_time", "2021-06-01T00:00:00Z", "--latest_time", "2021-06-02T00:00:00Z"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListSchedules() {
+	// the first page only holds schedules of other domains
+	s.serverFrontendClient.EXPECT().ListSchedules(gomock.Any(), &types.ListSchedulesRequest{
+		Domain:   domainName,
+		PageSize: 100,
+	}).Return(&types.ListSchedulesResponse{NextPageToken: []byte("token")}, nil)
+	s.serverFrontendClient.EXPECT().ListSchedules(gomock.Any(), &types.ListSchedulesRequest{
+		Domain:        domainName,
+		PageSize:      100,
+		NextPageToken: []byte("token"),
+	}).Return(&types.ListSchedulesResponse{ScheduleIDs: []string{"sid"}, NextPageToken: []byte("token2")}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "list"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) newDescribeScheduleResponse() *types.DescribeScheduleResponse {
+	return &types.DescribeScheduleResponse{
+		Spec: &types.ScheduleSpec{CronExpression: "0 9 * * *", TimeZone: "UTC"},
+		Action: &types.ScheduleAction{
+			WorkflowType:                        &types.WorkflowType{Name: "wt"},
+			TaskList:                            &types.TaskList{Name: "tl"},
+			ExecutionStartToCloseTimeoutSeconds: 60,
+		},
+		Policies: &types.SchedulePolicies{OverlapPolicy: types.ScheduleOverlapPolicySkip.Ptr()},
+	}
+}
+
+func (s *cliAppSuite) TestAdminDBScanResultsShow() {
+	s.serverAdminClient.EXPECT().DescribeScanResults(gomock.Any(), &types.DescribeScanResultsRequest{
+		ScanType: "concrete_execution",
+		RunID:    "test-run-id",
+	}).Return(&types.DescribeScanResultsResponse{
+		EntitiesCount:     10,
+		CorruptedCount:    2,
+		CorruptionByType:  map[string]int64{"history_exists": 2},
+		ShardStatus:       map[string]int64{"success": 4},
+		CorruptedShardIDs: []int32{1},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "db", "scan-results", "show", "--scan_type", "concrete_execution", "--rid", "test-run-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDBScanResultsCorrupted() {
+	s.serverAdminClient.EXPECT().ListScanCorruptions(gomock.Any(), &types.ListScanCorruptionsRequest{
+		ScanType: "timers",
+		ShardID:  common.Int32Ptr(3),
+		PageSize: 10,
+	}).Return(&types.ListScanCorruptionsResponse{
+		Entities: []*types.ScanCorruptedEntity{{ShardID: 3, Entity: []byte(`{"Execution":{}}`)}},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "db", "scan-results", "corrupted", "--scan_type", "timers", "--shard_id", "3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDBScanResultsFix() {
+	s.serverAdminClient.EXPECT().FixScanResults(gomock.Any(), &types.FixScanResultsRequest{
+		ScanType: "timers",
+		RunID:    "test-run-id",
+		ShardIDs: []int32{1, 3},
+	}).Return(&types.FixScanResultsResponse{
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: uuid.New()},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "db", "scan-results", "fix", "--scan_type", "timers", "--rid", "test-run-id", "--shard_ids", "1", "--shard_ids", "3"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -775,13 +950,13 @@ func (s *cliAppSuite) TestAnyToString_DecodeMapValues() {
 	execution := &types.WorkflowExecutionInfo{
 		Memo: &types.Memo{Fields: fields},
 	}
-	s.Equal("{HistoryLength:0, Memo:{Fields:map{TestKey:testValue}}, IsCron:false}", anyToString(execution, true, 0))
+	s.Equal("{HistoryLength:0, Memo:{Fields:map{TestKey:testValue}}, IsCron:false, IsPaused:false}", anyToString(execution, true, 0))
 
 	fields["TestKey2"] = []byte(`anotherTestValue`)
 	execution.Memo = &types.Memo{Fields: fields}
 	got := anyToString(execution, true, 0)
-	expected := got == "{HistoryLength:0, Memo:{Fields:map{TestKey2:anotherTestValue, TestKey:testValue}}, IsCron:false}" ||
-		got == "{HistoryLength:0, Memo:{Fields:map{TestKey:testValue, TestKey2:anotherTestValue}}, IsCron:false}"
+	expected := got == "{HistoryLength:0, Memo:{Fields:map{TestKey2:anotherTestValue, TestKey:testValue}}, IsCron:false, IsPaused:false}" ||
+		got == "{HistoryLength:0, Memo:{Fields:map{TestKey:testValue, TestKey2:anotherTestValue}}, IsCron:false, IsPaused:false}"
 	s.True(expected)
 }
 
@@ -817,6 +992,22 @@ func (s *cliAppSuite) TestGetWorkflowIdReusePolicy_Failed_Negative() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestGetInactivityTimeoutPolicy() {
+	s.Equal(types.InactivityTimeoutPolicyFail, *getInactivityTimeoutPolicy("fail"))
+	s.Equal(types.InactivityTimeoutPolicyNotify, *getInactivityTimeoutPolicy("NOTIFY"))
+}
+
+func (s *cliAppSuite) TestGetInactivityTimeoutPolicy_Failed_Unknown() {
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	var errorCode int
+	osExit = func(code int) {
+		errorCode = code
+	}
+	getInactivityTimeoutPolicy("ignore")
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestGetSearchAttributes() {
 	resp := &types.GetSearchAttributesResponse{}
 	s.serverFrontendClient.EXPECT().GetSearchAttributes(gomock.Any()).Return(resp, nil).Times(2)
@@ -98,6 +98,19 @@ var resetTypesMap = map[string]string{
 	resetTypeLastDecisionScheduled:  "",
 }
 
+var resetChildPolicyMap = map[string]types.ResetChildPolicy{
+	"Default":              types.ResetChildPolicyDefault,
+	"Reattach":             types.ResetChildPolicyReattach,
+	"ReattachAndTerminate": types.ResetChildPolicyReattachAndTerminate,
+}
+
+const (
+	resetReapplyNone                   = "None"
+	resetReapplySignal                 = "Signal"
+	resetReapplyUpsertSearchAttributes = "UpsertSearchAttributes"
+	resetReapplyCancelRequest          = "CancelRequest"
+)
+
 type jsonType int
 
 const (
@@ -212,6 +212,8 @@ const (
 	FlagResetPointsOnly                   = "reset_points_only"
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
 	FlagSkipSignalReapply                 = "skip_signal_reapply"
+	FlagResetChildPolicy                  = "reset_child_policy"
+	FlagResetReapplyPolicy                = "reapply_policy"
 	FlagListQuery                         = "query"
 	FlagListQueryWithAlias                = FlagListQuery + ", q"
 	FlagExcludeWorkflowIDByQuery          = "exclude_query"
@@ -281,6 +283,8 @@ const (
 	FlagShardMultiplier                   = "shard_multiplier"
 	FlagBucketSize                        = "bucket_size"
 	DelayStartSeconds                     = "delay_start_seconds"
+	FlagInactivityTimeout                 = "inactivity_timeout"
+	FlagInactivityTimeoutPolicy           = "inactivity_timeout_policy"
 	FlagConnectionAttributes              = "conn_attrs"
 	FlagJWT                               = "jwt"
 	FlagJWTPrivateKey                     = "jwt-private-key"
@@ -291,6 +295,26 @@ const (
 	FlagTransport                         = "transport"
 	FlagTransportWithAlias                = FlagTransport + ", t"
 	FlagFormat                            = "format"
+	FlagShardIDs                          = "shard_ids"
+	FlagPartition                         = "partition"
+	FlagMaxTaskCount                      = "max_task_count"
+	FlagMaxDispatchPerSecond              = "max_dispatch_rps"
+	FlagMaxBacklogSize                    = "max_backlog_size"
+	FlagRejectWhenBacklogFull             = "reject_when_backlog_full"
+	FlagMaxOutstandingTasks               = "max_outstanding_tasks"
+	FlagDeadLetterReason                  = "dead_letter_reason"
+	FlagTargetTaskList                    = "target_tasklist"
+	FlagIdleTime                          = "idle_time"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sid"
+	FlagTimeZone                          = "time_zone"
+	FlagTimeZoneWithAlias                 = FlagTimeZone + ", tz"
+	FlagJitterSeconds                     = "jitter_seconds"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", op"
+	FlagScheduleStartTime                 = "schedule_start_time"
+	FlagScheduleEndTime                   = "schedule_end_time"
+	FlagWorkflowIDPrefix                  = "workflow_id_prefix"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -470,6 +494,15 @@ func getFlagsForStart() []cli.Flag {
 			Name:  DelayStartSeconds,
 			Usage: "Optional workflow start delay in seconds. If set workflow start will be delayed this many seconds",
 		},
+		cli.IntFlag{
+			Name:  FlagInactivityTimeout,
+			Usage: "Optional inactivity timeout in seconds. If no decision task completes within this window, the workflow is flagged as inactive",
+		},
+		cli.StringFlag{
+			Name:  FlagInactivityTimeoutPolicy,
+			Usage: "Optional action taken when the inactivity timeout is reached. Available options: fail, notify",
+			Value: "fail",
+		},
 	}
 }
 
@@ -536,6 +569,20 @@ func getFlagsForTerminate() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution, cli.StringFlag{
+		Name:  FlagReasonWithAlias,
+		Usage: "The reason you want to pause the workflow",
+	})
+}
+
+func getFlagsForUnpause() []cli.Flag {
+	return append(flagsForExecution, cli.StringFlag{
+		Name:  FlagReasonWithAlias,
+		Usage: "The reason you want to unpause the workflow",
+	})
+}
+
 func getFormatFlag() cli.Flag {
 	return cli.StringFlag{
 		Name:  FlagFormat,
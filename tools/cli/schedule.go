@@ -0,0 +1,260 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/schedule"
+)
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "create",
+			Usage: "Create a schedule that starts workflows periodically",
+			Flags: append(getFlagsForScheduleSpec(),
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList of the started workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "WorkflowTypeName of the started workflows",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Execution start to close timeout of the started workflows in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagDecisionTimeoutWithAlias,
+					Value: defaultDecisionTimeoutInSeconds,
+					Usage: "Decision task start to close timeout of the started workflows in seconds",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDPrefix,
+					Usage: "Optional workflowID prefix of the started workflows, suffixed with the scheduled time. Default to the scheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Optional input for the started workflows, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+				},
+				cli.StringFlag{
+					Name: FlagInputFileWithAlias,
+					Usage: "Optional input for the started workflows from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+						"Input from file will be overwrite by input from command line",
+				},
+			),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe a schedule, including its recent and upcoming runs",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:  "update",
+			Usage: "Update a schedule, only the provided options are changed",
+			Flags: append(getFlagsForScheduleSpec(),
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList of the started workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "WorkflowTypeName of the started workflows",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Execution start to close timeout of the started workflows in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagDecisionTimeoutWithAlias,
+					Usage: "Decision task start to close timeout of the started workflows in seconds",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDPrefix,
+					Usage: "WorkflowID prefix of the started workflows, suffixed with the scheduled time",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the started workflows, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+				},
+				cli.StringFlag{
+					Name: FlagInputFileWithAlias,
+					Usage: "Input for the started workflows from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+						"Input from file will be overwrite by input from command line",
+				},
+			),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a domain",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Result page size",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page of default page size 100",
+				},
+				cli.BoolFlag{
+					Name:  FlagAllWithAlias,
+					Usage: "List all pages",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListSchedules(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule, runs scheduled while paused are not started",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Optional reason to pause the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseSchedule(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a paused schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, workflows already started by the schedule are not affected",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Optional reason to delete the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Start the runs a schedule would have started in the given time range",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Start of the backfill time range (inclusive). Supported formats are '2006-01-02T15:04:05Z', raw UnixNano and time range (N<duration>) in the past",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "End of the backfill time range (exclusive). Supported formats are '2006-01-02T15:04:05Z', raw UnixNano and time range (N<duration>) in the past",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy of the backfill, default to the overlap policy of the schedule. Policies supported: " + strings.Join(schedule.AllOverlapPolicies, ","),
+				},
+			},
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+	}
+}
+
+func getFlagsForScheduleSpec() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagScheduleIDWithAlias,
+			Usage: "ScheduleID",
+		},
+		cli.StringFlag{
+			Name: FlagCronSchedule,
+			Usage: "Cron schedule of the schedule. Cron spec is as following: \n" +
+				"\t┌───────────── minute (0 - 59) \n" +
+				"\t│ ┌───────────── hour (0 - 23) \n" +
+				"\t│ │ ┌───────────── day of the month (1 - 31) \n" +
+				"\t│ │ │ ┌───────────── month (1 - 12) \n" +
+				"\t│ │ │ │ ┌───────────── day of the week (0 - 6) (Sunday to Saturday) \n" +
+				"\t│ │ │ │ │ \n" +
+				"\t* * * * *",
+		},
+		cli.StringFlag{
+			Name:  FlagTimeZoneWithAlias,
+			Usage: "IANA time zone name the cron schedule is evaluated in, e.g. America/New_York. Default to UTC",
+		},
+		cli.IntFlag{
+			Name:  FlagJitterSeconds,
+			Usage: "Upper bound of a random delay in seconds added to each scheduled run",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicyWithAlias,
+			Usage: "Policy when a run is scheduled while the previous run is still running, default to skip. Policies supported: " + strings.Join(schedule.AllOverlapPolicies, ","),
+		},
+		cli.StringFlag{
+			Name:  FlagScheduleStartTime,
+			Usage: "Optional time before which no run is scheduled. Supported formats are '2006-01-02T15:04:05Z' and raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagScheduleEndTime,
+			Usage: "Optional time after which no run is scheduled. Supported formats are '2006-01-02T15:04:05Z' and raw UnixNano",
+		},
+	}
+}
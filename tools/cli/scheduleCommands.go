@@ -0,0 +1,292 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/schedule"
+	"github.com/uber/cadence/common/types"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	spec := &types.ScheduleSpec{
+		CronExpression: getRequiredOption(c, FlagCronSchedule),
+	}
+	action := &types.ScheduleAction{
+		WorkflowType:                   &types.WorkflowType{Name: getRequiredOption(c, FlagWorkflowType)},
+		TaskList:                       &types.TaskList{Name: getRequiredOption(c, FlagTaskList)},
+		TaskStartToCloseTimeoutSeconds: int32(c.Int(FlagDecisionTimeout)),
+	}
+	et := c.Int(FlagExecutionTimeout)
+	if et <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagExecutionTimeout), nil)
+	}
+	action.ExecutionStartToCloseTimeoutSeconds = int32(et)
+	policies := &types.SchedulePolicies{}
+	applyScheduleFlags(c, spec, action, policies)
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := client.CreateSchedule(tcCtx, &types.CreateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       spec,
+		Action:     action,
+		Policies:   policies,
+		Identity:   getCliIdentity(),
+		RequestID:  uuid.New(),
+	})
+	if err != nil {
+		if _, ok := err.(*types.WorkflowExecutionAlreadyStartedError); ok {
+			ErrorAndExit(fmt.Sprintf("Schedule %s already exists", scheduleID), nil)
+		}
+		ErrorAndExit("Failed to create schedule", err)
+	}
+	fmt.Println("Schedule created: " + scheduleID)
+}
+
+// DescribeSchedule describes a schedule
+func DescribeSchedule(c *cli.Context) {
+	prettyPrintJSONObject(describeSchedule(c))
+}
+
+// UpdateSchedule updates the spec, action and policies of a schedule
+func UpdateSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	desc := describeSchedule(c)
+	spec := desc.Spec
+	action := desc.Action
+	policies := desc.Policies
+	if c.IsSet(FlagCronSchedule) {
+		spec.CronExpression = c.String(FlagCronSchedule)
+	}
+	if c.IsSet(FlagWorkflowType) {
+		action.WorkflowType = &types.WorkflowType{Name: c.String(FlagWorkflowType)}
+	}
+	if c.IsSet(FlagTaskList) {
+		action.TaskList = &types.TaskList{Name: c.String(FlagTaskList)}
+	}
+	if c.IsSet(FlagExecutionTimeout) {
+		action.ExecutionStartToCloseTimeoutSeconds = int32(c.Int(FlagExecutionTimeout))
+	}
+	if c.IsSet(FlagDecisionTimeout) {
+		action.TaskStartToCloseTimeoutSeconds = int32(c.Int(FlagDecisionTimeout))
+	}
+	applyScheduleFlags(c, spec, action, policies)
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := client.UpdateSchedule(tcCtx, &types.UpdateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       spec,
+		Action:     action,
+		Policies:   policies,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update schedule", err)
+	}
+	fmt.Println("Schedule updated: " + scheduleID)
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := client.PauseSchedule(tcCtx, &types.PauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Reason:     c.String(FlagReason),
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to pause schedule", err)
+	}
+	fmt.Println("Schedule paused: " + scheduleID)
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := client.UnpauseSchedule(tcCtx, &types.UnpauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to unpause schedule", err)
+	}
+	fmt.Println("Schedule unpaused: " + scheduleID)
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := client.DeleteSchedule(tcCtx, &types.DeleteScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to delete schedule", err)
+	}
+	fmt.Println("Schedule deleted: " + scheduleID)
+}
+
+// BackfillSchedule starts the runs a schedule would have started in a time range
+func BackfillSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	startTime := parseTime(getRequiredOption(c, FlagEarliestTime), 0)
+	endTime := parseTime(getRequiredOption(c, FlagLatestTime), 0)
+	if startTime >= endTime {
+		ErrorAndExit(fmt.Sprintf("Option %s must be before %s.", FlagEarliestTime, FlagLatestTime), nil)
+	}
+	request := &types.BackfillScheduleRequest{
+		Domain:        domain,
+		ScheduleID:    scheduleID,
+		StartTimeNano: common.Int64Ptr(startTime),
+		EndTimeNano:   common.Int64Ptr(endTime),
+		Identity:      getCliIdentity(),
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		request.OverlapPolicy = getOverlapPolicy(c)
+	}
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.BackfillSchedule(tcCtx, request); err != nil {
+		ErrorAndExit("Failed to backfill schedule", err)
+	}
+	fmt.Println("Schedule backfill requested: " + scheduleID)
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	printAll := c.Bool(FlagAll)
+	request := &types.ListSchedulesRequest{
+		Domain:   domain,
+		PageSize: int32(c.Int(FlagPageSize)),
+	}
+
+	client := getCadenceClient(c)
+	for {
+		tcCtx, cancel := newContext(c)
+		resp, err := client.ListSchedules(tcCtx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list schedules", err)
+		}
+		for _, scheduleID := range resp.GetScheduleIDs() {
+			fmt.Println(scheduleID)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return
+		}
+		// a page may only hold schedules of other domains, which are filtered out by the server
+		if !printAll && len(resp.GetScheduleIDs()) > 0 && (!c.Bool(FlagMore) || !showNextPage()) {
+			return
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func applyScheduleFlags(
+	c *cli.Context,
+	spec *types.ScheduleSpec,
+	action *types.ScheduleAction,
+	policies *types.SchedulePolicies,
+) {
+	if c.IsSet(FlagTimeZone) {
+		spec.TimeZone = c.String(FlagTimeZone)
+	}
+	if c.IsSet(FlagJitterSeconds) {
+		spec.JitterInSeconds = int32(c.Int(FlagJitterSeconds))
+	}
+	if c.IsSet(FlagScheduleStartTime) {
+		spec.StartTimeNano = common.Int64Ptr(parseTime(c.String(FlagScheduleStartTime), 0))
+	}
+	if c.IsSet(FlagScheduleEndTime) {
+		spec.EndTimeNano = common.Int64Ptr(parseTime(c.String(FlagScheduleEndTime), 0))
+	}
+	if c.IsSet(FlagWorkflowIDPrefix) {
+		action.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
+	}
+	if c.IsSet(FlagInput) || c.IsSet(FlagInputFile) {
+		action.Input = []byte(processJSONInput(c))
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		policies.OverlapPolicy = getOverlapPolicy(c)
+	}
+}
+
+func getOverlapPolicy(c *cli.Context) *types.ScheduleOverlapPolicy {
+	overlapPolicy := c.String(FlagOverlapPolicy)
+	if err := schedule.ValidateOverlapPolicy(overlapPolicy); err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagOverlapPolicy), err)
+	}
+	return schedule.FromOverlapPolicy(overlapPolicy)
+}
+
+func describeSchedule(c *cli.Context) *types.DescribeScheduleResponse {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.DescribeSchedule(tcCtx, &types.DescribeScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe schedule", err)
+	}
+	return resp
+}
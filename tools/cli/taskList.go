@@ -57,5 +57,67 @@ func newTaskListCommands() []cli.Command {
 				ListTaskListPartitions(c)
 			},
 		},
+		{
+			Name:    "describe-config",
+			Aliases: []string{"dc"},
+			Usage:   "Describe the server side limits configured on a tasklist partition",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagPartition,
+					Usage: "Optional TaskList partition, 0 is the root partition",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeTaskListConfig(c)
+			},
+		},
+		{
+			Name:    "update-config",
+			Aliases: []string{"uc"},
+			Usage:   "Update the server side limits of a tasklist partition, a limit set to 0 is not enforced",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagPartition,
+					Usage: "Optional TaskList partition, 0 is the root partition",
+				},
+				cli.Float64Flag{
+					Name:  FlagMaxDispatchPerSecond,
+					Usage: "Max rate at which tasks are dispatched to pollers, overrides the rate provided by the workers",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxBacklogSize,
+					Usage: "Max number of tasks in the backlog of the partition",
+				},
+				cli.BoolFlag{
+					Name:  FlagRejectWhenBacklogFull,
+					Usage: "Reject new tasks once the backlog reaches the max backlog size, use --" + FlagRejectWhenBacklogFull + "=false to disable",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxOutstandingTasks,
+					Usage: "Max number of tasks loaded from the backlog and not yet delivered to a poller",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateTaskListConfig(c)
+			},
+		},
 	}
 }
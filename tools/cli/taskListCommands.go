@@ -21,9 +21,11 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 
 	"github.com/urfave/cli"
@@ -40,6 +42,13 @@ type (
 		DecisionPartition string `header:"Decision Task List Partition"`
 		Host              string `header:"Host"`
 	}
+	TaskListConfigRow struct {
+		Partition             string  `header:"Partition"`
+		MaxDispatchPerSecond  float64 `header:"Max Dispatch RPS"`
+		MaxBacklogSize        int64   `header:"Max Backlog Size"`
+		RejectWhenBacklogFull bool    `header:"Reject When Backlog Full"`
+		MaxOutstandingTasks   int32   `header:"Max Outstanding Tasks"`
+	}
 )
 
 // DescribeTaskList show pollers info of a given tasklist
@@ -97,6 +106,70 @@ func ListTaskListPartitions(c *cli.Context) {
 	}
 }
 
+// DescribeTaskListConfig displays the server side limits configured on a tasklist partition
+func DescribeTaskListConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	partition := getTaskListPartitionFromFlags(c)
+	taskListType := strToTaskListType(c.String(FlagTaskListType))
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeTaskListConfig(ctx, &types.DescribeTaskListConfigRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: partition},
+		TaskListType: &taskListType,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeTaskListConfig failed.", err)
+	}
+	printTaskListConfig(partition, response.Config)
+}
+
+// UpdateTaskListConfig updates the server side limits of a tasklist partition, the limits which
+// are not set are left as is. The update is applied by the matching host owning the partition
+func UpdateTaskListConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	partition := getTaskListPartitionFromFlags(c)
+	taskListType := strToTaskListType(c.String(FlagTaskListType))
+
+	request := &types.UpdateTaskListConfigRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: partition},
+		TaskListType: &taskListType,
+	}
+	if c.IsSet(FlagMaxDispatchPerSecond) {
+		request.MaxDispatchPerSecond = common.Float64Ptr(c.Float64(FlagMaxDispatchPerSecond))
+	}
+	if c.IsSet(FlagMaxBacklogSize) {
+		request.MaxBacklogSize = common.Int64Ptr(c.Int64(FlagMaxBacklogSize))
+	}
+	if c.IsSet(FlagRejectWhenBacklogFull) {
+		request.RejectWhenBacklogFull = common.BoolPtr(c.Bool(FlagRejectWhenBacklogFull))
+	}
+	if c.IsSet(FlagMaxOutstandingTasks) {
+		request.MaxOutstandingTasks = common.Int32Ptr(int32(c.Int(FlagMaxOutstandingTasks)))
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.UpdateTaskListConfig(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation UpdateTaskListConfig failed.", err)
+	}
+	printTaskListConfig(partition, response.Config)
+}
+
+func getTaskListPartitionFromFlags(c *cli.Context) string {
+	taskList := getRequiredOption(c, FlagTaskList)
+	partition := c.Int(FlagPartition)
+	if partition < 0 {
+		ErrorAndExit("Partition must not be negative.", nil)
+	}
+	return getTaskListPartitionName(taskList, partition)
+}
+
 func printTaskListPollers(pollers []*types.PollerInfo, taskListType types.TaskListType) {
 	table := []TaskListPollerRow{}
 	for _, poller := range pollers {
@@ -125,3 +198,17 @@ func printTaskListPartitions(taskListType string, partitions []*types.TaskListPa
 		"Decision Task List Partition": taskListType == "Decision",
 	}})
 }
+
+func printTaskListConfig(partition string, config *types.TaskListConfig) {
+	if config == nil {
+		fmt.Println(colorMagenta("No limit is configured on the task list."))
+		return
+	}
+	RenderTable(os.Stdout, []TaskListConfigRow{{
+		Partition:             partition,
+		MaxDispatchPerSecond:  config.MaxDispatchPerSecond,
+		MaxBacklogSize:        config.MaxBacklogSize,
+		RejectWhenBacklogFull: config.RejectWhenBacklogFull,
+		MaxOutstandingTasks:   config.MaxOutstandingTasks,
+	}}, RenderOptions{Color: true})
+}
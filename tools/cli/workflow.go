@@ -105,6 +105,22 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution, no decision or activity task is dispatched until it is unpaused",
+			Flags: getFlagsForPause(),
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused workflow execution",
+			Flags: getFlagsForUnpause(),
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -251,6 +267,18 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagSkipSignalReapply,
 					Usage: "whether or not skipping signals reapply after the reset point",
 				},
+				cli.StringFlag{
+					Name: FlagResetChildPolicy,
+					Usage: "how to handle child workflows of the reset workflow. Support one of these: Default, Reattach, ReattachAndTerminate. " +
+						"Default fails the reset when children are pending at the reset point, " +
+						"Reattach keeps those children attached to the new run, " +
+						"ReattachAndTerminate additionally terminates children started after the reset point",
+				},
+				cli.StringFlag{
+					Name: FlagResetReapplyPolicy,
+					Usage: "comma separated event categories to reapply after the reset point. Support any of these: Signal, UpsertSearchAttributes, CancelRequest, " +
+						"or None to reapply nothing. When not set, signals are reapplied unless --" + FlagSkipSignalReapply + " is specified",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetWorkflow(c)
@@ -333,6 +361,18 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagSkipSignalReapply,
 					Usage: "whether or not skipping signals reapply after the reset point",
 				},
+				cli.StringFlag{
+					Name: FlagResetChildPolicy,
+					Usage: "how to handle child workflows of the reset workflow. Support one of these: Default, Reattach, ReattachAndTerminate. " +
+						"Default fails the reset when children are pending at the reset point, " +
+						"Reattach keeps those children attached to the new run, " +
+						"ReattachAndTerminate additionally terminates children started after the reset point",
+				},
+				cli.StringFlag{
+					Name: FlagResetReapplyPolicy,
+					Usage: "comma separated event categories to reapply after the reset point. Support any of these: Signal, UpsertSearchAttributes, CancelRequest, " +
+						"or None to reapply nothing. When not set, signals are reapplied unless --" + FlagSkipSignalReapply + " is specified",
+				},
 				cli.StringFlag{
 					Name: FlagEarliestTimeWithAlias,
 					Usage: "EarliestTime of decision start time, required for resetType of DecisionCompletedTime." +
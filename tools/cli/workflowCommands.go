@@ -306,6 +306,11 @@ func constructStartWorkflowRequest(c *cli.Context) *types.StartWorkflowExecution
 		startRequest.DelayStartSeconds = common.Int32Ptr(int32(c.Int(DelayStartSeconds)))
 	}
 
+	if c.IsSet(FlagInactivityTimeout) {
+		startRequest.InactivityTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagInactivityTimeout)))
+		startRequest.InactivityTimeoutPolicy = getInactivityTimeoutPolicy(c.String(FlagInactivityTimeoutPolicy))
+	}
+
 	headerFields := processHeader(c)
 	if len(headerFields) != 0 {
 		startRequest.Header = &types.Header{Fields: headerFields}
@@ -472,6 +477,68 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	wfClient := getWorkflowClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := wfClient.PauseWorkflowExecution(
+		ctx,
+		&types.PauseWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:   reason,
+			Identity: getCliIdentity(),
+		},
+	)
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	wfClient := getWorkflowClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := wfClient.UnpauseWorkflowExecution(
+		ctx,
+		&types.UnpauseWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:   reason,
+			Identity: getCliIdentity(),
+		},
+	)
+
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -819,16 +886,31 @@ type pendingActivityInfo struct {
 	ActivityID             string
 	ActivityType           *types.ActivityType
 	State                  *types.PendingActivityState
-	ScheduledTimestamp     *string `json:",omitempty"` // change from *int64
-	LastStartedTimestamp   *string `json:",omitempty"` // change from *int64
-	HeartbeatDetails       *string `json:",omitempty"` // change from []byte
-	LastHeartbeatTimestamp *string `json:",omitempty"` // change from *int64
-	Attempt                int32   `json:",omitempty"`
-	MaximumAttempts        int32   `json:",omitempty"`
-	ExpirationTimestamp    *string `json:",omitempty"` // change from *int64
-	LastFailureReason      *string `json:",omitempty"`
-	LastWorkerIdentity     string  `json:",omitempty"`
-	LastFailureDetails     *string `json:",omitempty"` // change from []byte
+	ScheduledTimestamp     *string                `json:",omitempty"` // change from *int64
+	LastStartedTimestamp   *string                `json:",omitempty"` // change from *int64
+	HeartbeatDetails       *string                `json:",omitempty"` // change from []byte
+	LastHeartbeatTimestamp *string                `json:",omitempty"` // change from *int64
+	Attempt                int32                  `json:",omitempty"`
+	MaximumAttempts        int32                  `json:",omitempty"`
+	ExpirationTimestamp    *string                `json:",omitempty"` // change from *int64
+	LastFailureReason      *string                `json:",omitempty"`
+	LastWorkerIdentity     string                 `json:",omitempty"`
+	LastFailureDetails     *string                `json:",omitempty"` // change from []byte
+	AttemptLog             []*activityAttemptInfo `json:",omitempty"`
+}
+
+// activityAttemptInfo has same fields as types.ActivityAttemptInfo, but different field type for better display
+type activityAttemptInfo struct {
+	Attempt           int32
+	StartedTimestamp  *string `json:",omitempty"` // change from int64
+	FinishedTimestamp *string `json:",omitempty"` // change from int64
+	Identity          string  `json:",omitempty"`
+	FailureReason     string  `json:",omitempty"`
+	FailureDetails    *string `json:",omitempty"` // change from []byte
+	HeartbeatDetails  *string `json:",omitempty"` // change from []byte
+	// set when the details were too large to be kept in the attempt log
+	FailureDetailsTruncated   bool `json:",omitempty"`
+	HeartbeatDetailsTruncated bool `json:",omitempty"`
 }
 
 type pendingDecisionInfo struct {
@@ -879,6 +961,7 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 		if pa.LastFailureDetails != nil {
 			tmpAct.LastFailureDetails = common.StringPtr(string(pa.LastFailureDetails))
 		}
+		tmpAct.AttemptLog = convertActivityAttemptLog(pa.AttemptLog)
 		pendingActs = append(pendingActs, tmpAct)
 	}
 
@@ -911,6 +994,33 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 	}
 }
 
+func convertActivityAttemptLog(attemptLog []*types.ActivityAttemptInfo) []*activityAttemptInfo {
+	var result []*activityAttemptInfo
+	for _, attempt := range attemptLog {
+		tmpAttempt := &activityAttemptInfo{
+			Attempt:                   attempt.Attempt,
+			Identity:                  attempt.Identity,
+			FailureReason:             attempt.FailureReason,
+			FailureDetailsTruncated:   attempt.FailureDetailsTruncated,
+			HeartbeatDetailsTruncated: attempt.HeartbeatDetailsTruncated,
+		}
+		if attempt.StartedTimestamp != 0 {
+			tmpAttempt.StartedTimestamp = common.StringPtr(convertTime(attempt.StartedTimestamp, false))
+		}
+		if attempt.FinishedTimestamp != 0 {
+			tmpAttempt.FinishedTimestamp = common.StringPtr(convertTime(attempt.FinishedTimestamp, false))
+		}
+		if attempt.FailureDetails != nil {
+			tmpAttempt.FailureDetails = common.StringPtr(string(attempt.FailureDetails))
+		}
+		if attempt.HeartbeatDetails != nil {
+			tmpAttempt.HeartbeatDetails = common.StringPtr(string(attempt.HeartbeatDetails))
+		}
+		result = append(result, tmpAttempt)
+	}
+	return result
+}
+
 func convertSearchAttributesToMapOfInterface(searchAttributes *types.SearchAttributes,
 	wfClient frontend.Client, c *cli.Context) map[string]interface{} {
 
@@ -1312,6 +1422,16 @@ func getWorkflowIDReusePolicy(value int) *types.WorkflowIDReusePolicy {
 	return nil
 }
 
+func getInactivityTimeoutPolicy(value string) *types.InactivityTimeoutPolicy {
+	var policy types.InactivityTimeoutPolicy
+	if err := policy.UnmarshalText([]byte(value)); err == nil &&
+		policy >= types.InactivityTimeoutPolicyFail && policy <= types.InactivityTimeoutPolicyNotify {
+		return policy.Ptr()
+	}
+	ErrorAndExit(fmt.Sprintf("Option %v value is not in supported range.", FlagInactivityTimeoutPolicy), nil)
+	return nil
+}
+
 // default will print decoded raw
 func printListResults(executions []*types.WorkflowExecutionInfo, inJSON bool, more bool) {
 	for i, execution := range executions {
@@ -1395,6 +1515,8 @@ func ResetWorkflow(c *cli.Context) {
 		DecisionFinishEventID: decisionFinishID,
 		RequestID:             uuid.New(),
 		SkipSignalReapply:     c.Bool(FlagSkipSignalReapply),
+		ResetChildPolicy:      getResetChildPolicy(c),
+		ReapplyPolicy:         getResetReapplyPolicy(c),
 	})
 	if err != nil {
 		ErrorAndExit("reset failed", err)
@@ -1402,6 +1524,38 @@ func ResetWorkflow(c *cli.Context) {
 	prettyPrintJSONObject(resp)
 }
 
+func getResetChildPolicy(c *cli.Context) *types.ResetChildPolicy {
+	if !c.IsSet(FlagResetChildPolicy) {
+		return nil
+	}
+	policy, ok := resetChildPolicyMap[c.String(FlagResetChildPolicy)]
+	if !ok {
+		ErrorAndExit("Not supported reset child policy", nil)
+	}
+	return policy.Ptr()
+}
+
+func getResetReapplyPolicy(c *cli.Context) *types.ResetReapplyPolicy {
+	if !c.IsSet(FlagResetReapplyPolicy) {
+		return nil
+	}
+	policy := &types.ResetReapplyPolicy{}
+	for _, category := range strings.Split(c.String(FlagResetReapplyPolicy), ",") {
+		switch strings.TrimSpace(category) {
+		case resetReapplyNone:
+		case resetReapplySignal:
+			policy.ReapplySignals = true
+		case resetReapplyUpsertSearchAttributes:
+			policy.ReapplyUpsertSearchAttributes = true
+		case resetReapplyCancelRequest:
+			policy.ReapplyCancelRequests = true
+		default:
+			ErrorAndExit("Not supported reset reapply policy", fmt.Errorf("unknown event category %v", category))
+		}
+	}
+	return policy
+}
+
 func processResets(c *cli.Context, domain string, wes chan types.WorkflowExecution, done chan bool, wg *sync.WaitGroup, params batchResetParamsType) {
 	for {
 		select {
@@ -1442,6 +1596,8 @@ type batchResetParamsType struct {
 	resetType            string
 	decisionOffset       int
 	skipSignalReapply    bool
+	resetChildPolicy     *types.ResetChildPolicy
+	reapplyPolicy        *types.ResetReapplyPolicy
 }
 
 // ResetInBatch resets workflow in batch
@@ -1481,6 +1637,8 @@ func ResetInBatch(c *cli.Context) {
 		resetType:            resetType,
 		decisionOffset:       decisionOffset,
 		skipSignalReapply:    c.Bool(FlagSkipSignalReapply),
+		resetChildPolicy:     getResetChildPolicy(c),
+		reapplyPolicy:        getResetReapplyPolicy(c),
 	}
 
 	if inFileName == "" && query == "" {
@@ -1678,6 +1836,8 @@ func doReset(c *cli.Context, domain, wid, rid string, params batchResetParamsTyp
 			RequestID:             uuid.New(),
 			Reason:                fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), params.reason),
 			SkipSignalReapply:     params.skipSignalReapply,
+			ResetChildPolicy:      params.resetChildPolicy,
+			ReapplyPolicy:         params.reapplyPolicy,
 		})
 
 		if err != nil {